@@ -2,12 +2,15 @@ package main
 
 import (
 	"car-service/internal/conf"
+	"car-service/internal/server"
 	"flag"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/lovechung/go-kit/bootstrap"
+	// 内置时区数据，镜像中未安装tzdata时围栏仍可按指定时区判断生效时间
+	_ "time/tzdata"
 )
 
 // go build -ldflags "-X main.Service.Version=x.y.z"
@@ -26,14 +29,15 @@ func init() {
 	Flags.Init()
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, rr registry.Registrar) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, ss *server.Scheduler, ts *server.TelemetryServer,
+	ns *server.NotificationServer, rr registry.Registrar) *kratos.App {
 	return kratos.New(
 		kratos.ID(Service.GetInstanceId()),
 		kratos.Name(Service.Name+"-"+Service.Env),
 		kratos.Version(Service.Version),
 		kratos.Metadata(Service.Metadata),
 		kratos.Logger(logger),
-		kratos.Server(gs),
+		kratos.Server(gs, ss, ts, ns),
 		kratos.Registrar(rr),
 	)
 }
@@ -85,7 +89,8 @@ func main() {
 
 	logger := loadLogger(bc)
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, rc, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Maintenance, bc.Insurance, bc.Transfer,
+		bc.Attachment, bc.Tenant, bc.Valuation, bc.Telemetry, bc.Scheduler, bc.Notification, rc, logger)
 	if err != nil {
 		panic(err)
 	}
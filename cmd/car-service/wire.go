@@ -17,6 +17,8 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, *conf.Registry, log.Logger) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Data, *conf.Maintenance, *conf.Insurance, *conf.Transfer,
+	*conf.Attachment, *conf.Tenant, *conf.Valuation, *conf.Telemetry, *conf.Scheduler,
+	*conf.Notification, *conf.Registry, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
@@ -19,7 +19,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, maintenance *conf.Maintenance, insurance *conf.Insurance, transfer *conf.Transfer, attachment *conf.Attachment, tenant *conf.Tenant, valuation *conf.Valuation, telemetry *conf.Telemetry, scheduler *conf.Scheduler, notification *conf.Notification, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
 	client := data.NewDB(confData, logger)
 	rueidisClient := data.NewRedis(confData, logger)
 	discovery := data.NewDiscovery(registry)
@@ -29,12 +29,92 @@ func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Regist
 		return nil, nil, err
 	}
 	carRepo := data.NewCarRepo(dataData, logger)
+	catalogRepo := data.NewCatalogRepo(dataData, logger)
+	attributeRepo := data.NewAttributeRepo(dataData, logger)
+	leaseRepo := data.NewLeaseRepo(dataData, logger)
+	valuationRepo := data.NewValuationRepo(dataData, logger)
+	valuationUseCase := biz.NewValuationUseCase(valuationRepo, carRepo, valuation, logger)
+	eventPublisher := data.NewEventPublisher(dataData)
 	transaction := data.NewTransaction(dataData)
-	carUseCase := biz.NewCarUseCase(carRepo, transaction, logger)
+	carUseCase := biz.NewCarUseCase(carRepo, catalogRepo, attributeRepo, leaseRepo, valuationUseCase, eventPublisher, tenant, transaction, logger)
 	carService := service.NewCarService(carUseCase, logger)
-	grpcServer := server.NewGRPCServer(confServer, carService, logger)
+	auditLogRepo := data.NewAuditLogRepo(dataData, logger)
+	auditLogUseCase := biz.NewAuditLogUseCase(auditLogRepo, logger)
+	auditService := service.NewAuditService(auditLogUseCase, logger)
+	maintenanceRepo := data.NewMaintenanceRepo(dataData, logger)
+	maintenanceUseCase := biz.NewMaintenanceUseCase(maintenanceRepo, carRepo, maintenance, logger)
+	maintenanceService := service.NewMaintenanceService(maintenanceUseCase, logger)
+	insuranceRepo := data.NewInsuranceRepo(dataData, logger)
+	insuranceUseCase := biz.NewInsuranceUseCase(insuranceRepo, carRepo, eventPublisher, insurance, logger)
+	insuranceService := service.NewInsuranceService(insuranceUseCase, logger)
+	catalogUseCase := biz.NewCatalogUseCase(catalogRepo, carRepo, logger)
+	catalogService := service.NewCatalogService(catalogUseCase, logger)
+	transferRepo := data.NewTransferRepo(dataData, logger)
+	transferUseCase := biz.NewTransferUseCase(transferRepo, carUseCase, leaseRepo, transaction, transfer, logger)
+	transferService := service.NewTransferService(transferUseCase, logger)
+	odometerRepo := data.NewOdometerRepo(dataData, logger)
+	odometerUseCase := biz.NewOdometerUseCase(odometerRepo, carRepo, logger)
+	odometerService := service.NewOdometerService(odometerUseCase, logger)
+	attachmentRepo := data.NewAttachmentRepo(dataData, logger)
+	blobStore := data.NewBlobStore(confData, logger)
+	attachmentUseCase := biz.NewAttachmentUseCase(attachmentRepo, carRepo, blobStore, attachment, logger)
+	attachmentService := service.NewAttachmentService(attachmentUseCase, logger)
+	fleetRepo := data.NewFleetRepo(dataData, logger)
+	fleetUseCase := biz.NewFleetUseCase(fleetRepo, logger)
+	fleetService := service.NewFleetService(fleetUseCase, logger)
+	attributeUseCase := biz.NewAttributeUseCase(attributeRepo, logger)
+	attributeService := service.NewAttributeService(attributeUseCase, logger)
+	reservationRepo := data.NewReservationRepo(dataData, logger)
+	reservationUseCase := biz.NewReservationUseCase(reservationRepo, carRepo, transaction, logger)
+	reservationService := service.NewReservationService(reservationUseCase, logger)
+	tripRepo := data.NewTripRepo(dataData, logger)
+	tripUseCase := biz.NewTripUseCase(tripRepo, carRepo, logger)
+	tripService := service.NewTripService(tripUseCase, logger)
+	recallRepo := data.NewRecallRepo(dataData, logger)
+	recallUseCase := biz.NewRecallUseCase(recallRepo, eventPublisher, logger)
+	recallService := service.NewRecallService(recallUseCase, logger)
+	violationRepo := data.NewViolationRepo(dataData, logger)
+	violationUseCase := biz.NewViolationUseCase(violationRepo, carRepo, transaction, logger)
+	violationService := service.NewViolationService(violationUseCase, logger)
+	valuationService := service.NewValuationService(valuationUseCase, logger)
+	listingRepo := data.NewListingRepo(dataData, logger)
+	listingUseCase := biz.NewListingUseCase(listingRepo, carUseCase, transaction, logger)
+	listingService := service.NewListingService(listingUseCase, logger)
+	warrantyRepo := data.NewWarrantyRepo(dataData, logger)
+	warrantyUseCase := biz.NewWarrantyUseCase(warrantyRepo, carRepo, logger)
+	warrantyService := service.NewWarrantyService(warrantyUseCase, logger)
+	incidentRepo := data.NewIncidentRepo(dataData, logger)
+	incidentUseCase := biz.NewIncidentUseCase(incidentRepo, carRepo, attachmentRepo, insuranceRepo, logger)
+	incidentService := service.NewIncidentService(incidentUseCase, logger)
+	leaseUseCase := biz.NewLeaseUseCase(leaseRepo, carRepo, odometerRepo, transaction, logger)
+	leaseService := service.NewLeaseService(leaseUseCase, logger)
+	chargingRepo := data.NewChargingRepo(dataData, logger)
+	chargingUseCase := biz.NewChargingUseCase(chargingRepo, carRepo, transaction, logger)
+	chargingService := service.NewChargingService(chargingUseCase, logger)
+	telemetryRepo := data.NewTelemetryRepo(dataData, telemetry, logger)
+	telemetryUseCase := biz.NewTelemetryUseCase(telemetryRepo, carRepo, telemetry, logger)
+	telemetryService := service.NewTelemetryService(telemetryUseCase, logger)
+	geofenceRepo := data.NewGeofenceRepo(dataData, logger)
+	geofenceUseCase := biz.NewGeofenceUseCase(geofenceRepo, carRepo, telemetryRepo, eventPublisher, transaction, logger)
+	geofenceService := service.NewGeofenceService(geofenceUseCase, logger)
+	jobRunRepo := data.NewJobRunRepo(dataData, logger)
+	locker := data.NewLocker(dataData)
+	jobUseCase := biz.NewJobUseCase(jobRunRepo, locker, scheduler, logger)
+	jobService := service.NewJobService(jobUseCase, logger)
+	grpcServer := server.NewGRPCServer(confServer, carService, auditService, maintenanceService, insuranceService, catalogService, transferService, odometerService, attachmentService, fleetService, attributeService, reservationService, tripService, recallService, violationService, valuationService, listingService, warrantyService, incidentService, leaseService, chargingService, telemetryService, geofenceService, jobService, logger)
+	serverScheduler, err := server.NewScheduler(scheduler, jobUseCase, insuranceUseCase, transferUseCase, carUseCase, telemetryUseCase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	telemetryServer := server.NewTelemetryServer(telemetryUseCase, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	eventConsumer := data.NewEventConsumer(dataData)
+	v := data.NewNotificationChannels(notification, logger)
+	notificationUseCase := biz.NewNotificationUseCase(notificationRepo, carRepo, eventConsumer, v, notification, logger)
+	notificationServer := server.NewNotificationServer(notificationUseCase, logger)
 	registrar := data.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, registrar)
+	app := newApp(logger, grpcServer, serverScheduler, telemetryServer, notificationServer, registrar)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -0,0 +1,218 @@
+package biz
+
+import (
+	"bufio"
+	"car-service/internal/conf"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// 内容类型探测所需的字节数
+const sniffLen = 512
+
+type Attachment struct {
+	ID          int64
+	TenantID    *int64
+	CarID       *int64
+	Kind        *string
+	FileName    *string
+	ContentType *string
+	Size        *int64
+	Checksum    *string
+	StorageKey  *string
+	CreatedAt   *time.Time
+}
+
+type AttachmentReply struct {
+	Id          int64
+	CarId       int64
+	Kind        string
+	FileName    string
+	ContentType string
+	Size        int64
+	Checksum    string
+	StorageKey  string
+	CreatedAt   time.Time
+}
+
+// AttachmentMeta 上传时由客户端提供的元数据
+type AttachmentMeta struct {
+	CarId    int64
+	Kind     string
+	FileName string
+	// Checksum 可选，sha256十六进制，提供时校验上传内容
+	Checksum string
+}
+
+// BlobStore 附件内容存储
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type AttachmentRepo interface {
+	ListByCar(ctx context.Context, carId int64) ([]*AttachmentReply, error)
+	GetById(ctx context.Context, id int64) (*AttachmentReply, error)
+	Save(context.Context, *Attachment) (int64, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type AttachmentUseCase struct {
+	r    AttachmentRepo
+	cr   CarRepo
+	blob BlobStore
+	c    *conf.Attachment
+	log  *log.Helper
+}
+
+func NewAttachmentUseCase(r AttachmentRepo, cr CarRepo, blob BlobStore, c *conf.Attachment, logger log.Logger) *AttachmentUseCase {
+	return &AttachmentUseCase{r: r, cr: cr, blob: blob, c: c, log: log.NewHelper(logger)}
+}
+
+func (uc *AttachmentUseCase) ListAttachment(ctx context.Context, carId int64) ([]*AttachmentReply, error) {
+	return uc.r.ListByCar(ctx, carId)
+}
+
+func (uc *AttachmentUseCase) GetAttachmentById(ctx context.Context, id int64) (*AttachmentReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+// Upload 流式写入存储，同时探测内容类型、限制大小并计算校验和
+func (uc *AttachmentUseCase) Upload(ctx context.Context, meta *AttachmentMeta, r io.Reader) (*AttachmentReply, error) {
+	c, err := uc.cr.GetById(ctx, meta.CarId)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReaderSize(r, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	if len(head) == 0 {
+		return nil, ex.AttachmentEmpty
+	}
+	contentType := http.DetectContentType(head)
+	if !uc.allowed(contentType) {
+		return nil, ex.AttachmentTypeNotAllowed
+	}
+
+	key, err := storageKey(meta.CarId)
+	if err != nil {
+		return nil, err
+	}
+	// 多读一个字节用于判断是否超限
+	maxSize := uc.c.GetMaxSize()
+	counter := &countingReader{r: br}
+	if maxSize > 0 {
+		counter.r = io.LimitReader(br, maxSize+1)
+	}
+	hash := sha256.New()
+	if err := uc.blob.Put(ctx, key, io.TeeReader(counter, hash), contentType); err != nil {
+		return nil, err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	var rejectErr error
+	if maxSize > 0 && counter.n > maxSize {
+		rejectErr = ex.AttachmentTooLarge
+	} else if meta.Checksum != "" && !strings.EqualFold(meta.Checksum, checksum) {
+		rejectErr = ex.AttachmentChecksumMismatch
+	}
+	if rejectErr != nil {
+		uc.deleteBlob(ctx, key)
+		return nil, rejectErr
+	}
+
+	a := &Attachment{
+		TenantID:    &c.TenantId,
+		CarID:       &meta.CarId,
+		Kind:        &meta.Kind,
+		FileName:    &meta.FileName,
+		ContentType: &contentType,
+		Size:        &counter.n,
+		Checksum:    &checksum,
+		StorageKey:  &key,
+	}
+	id, err := uc.r.Save(ctx, a)
+	if err != nil {
+		uc.deleteBlob(ctx, key)
+		return nil, err
+	}
+	return uc.r.GetById(ctx, id)
+}
+
+// Download 返回附件元数据及内容，调用方负责关闭
+func (uc *AttachmentUseCase) Download(ctx context.Context, id int64) (*AttachmentReply, io.ReadCloser, error) {
+	a, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	rc, err := uc.blob.Get(ctx, a.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return a, rc, nil
+}
+
+func (uc *AttachmentUseCase) DeleteAttachment(ctx context.Context, id int64) error {
+	a, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.r.Delete(ctx, id); err != nil {
+		return err
+	}
+	uc.deleteBlob(ctx, a.StorageKey)
+	return nil
+}
+
+func (uc *AttachmentUseCase) allowed(contentType string) bool {
+	types := uc.c.GetAllowedTypes()
+	if len(types) == 0 {
+		return true
+	}
+	// 去掉charset等参数后比较
+	mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+	for _, t := range types {
+		if strings.EqualFold(t, mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+func (uc *AttachmentUseCase) deleteBlob(ctx context.Context, key string) {
+	if err := uc.blob.Delete(ctx, key); err != nil {
+		uc.log.WithContext(ctx).Errorf("删除附件内容失败: %s, %v", key, err)
+	}
+}
+
+func storageKey(carId int64) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("cars/%d/%s", carId, hex.EncodeToString(b)), nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
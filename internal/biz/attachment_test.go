@@ -0,0 +1,80 @@
+package biz
+
+import (
+	"bytes"
+	"car-service/internal/conf"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestAttachmentUpload(t *testing.T) {
+	png := func(size int) []byte {
+		b := make([]byte, size)
+		copy(b, "\x89PNG\r\n\x1a\n")
+		return b
+	}
+	sum := func(b []byte) string {
+		h := sha256.Sum256(b)
+		return hex.EncodeToString(h[:])
+	}
+	const maxSize = 1024
+	tests := []struct {
+		name     string
+		carId    int64
+		content  []byte
+		checksum string
+		err      error
+	}{
+		{"within limit", 1, png(100), "", nil},
+		{"at limit", 1, png(maxSize), "", nil},
+		{"over limit", 1, png(maxSize + 1), "", ex.AttachmentTooLarge},
+		{"far over limit", 1, png(maxSize * 4), "", ex.AttachmentTooLarge},
+		{"over limit with matching checksum", 1, png(maxSize + 1), sum(png(maxSize + 1)), ex.AttachmentTooLarge},
+		{"checksum match", 1, png(100), sum(png(100)), nil},
+		{"checksum match upper case", 1, png(100), strings.ToUpper(sum(png(100))), nil},
+		{"checksum mismatch", 1, png(100), sum(png(101)), ex.AttachmentChecksumMismatch},
+		{"empty", 1, nil, "", ex.AttachmentEmpty},
+		{"type not allowed", 1, []byte("%PDF-1.4 hello"), "", ex.AttachmentTypeNotAllowed},
+		{"car not found", 2, png(100), "", ex.CarNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &fakeAttachmentRepo{}
+			blob := &fakeBlobStore{}
+			uc := NewAttachmentUseCase(r, &fakeCarRepo{cars: map[int64]*CarReply{1: {Id: 1, TenantId: 3}}}, blob,
+				&conf.Attachment{MaxSize: maxSize, AllowedTypes: []string{"image/png"}}, log.DefaultLogger)
+
+			got, err := uc.Upload(context.Background(), &AttachmentMeta{
+				CarId: tt.carId, Kind: "photo", FileName: "a.png", Checksum: tt.checksum,
+			}, bytes.NewReader(tt.content))
+			// 同一错误码的错误errors.Is视为相同，按实例比较
+			if err != tt.err {
+				t.Fatalf("Upload() error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				if len(r.saved) != 0 {
+					t.Errorf("saved %d attachments, want none", len(r.saved))
+				}
+				if len(blob.blobs) != 0 {
+					t.Errorf("left %d blobs in the store, want none", len(blob.blobs))
+				}
+				return
+			}
+			if got.Size != int64(len(tt.content)) || got.Checksum != sum(tt.content) || got.ContentType != "image/png" {
+				t.Errorf("Upload() = size %d checksum %s type %s", got.Size, got.Checksum, got.ContentType)
+			}
+			if tenantId := r.saved[0].TenantID; tenantId == nil || *tenantId != 3 {
+				t.Errorf("TenantID = %v, want 3", tenantId)
+			}
+			if !bytes.Equal(blob.blobs[got.StorageKey], tt.content) {
+				t.Errorf("stored content differs from upload")
+			}
+		})
+	}
+}
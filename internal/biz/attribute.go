@@ -0,0 +1,209 @@
+package biz
+
+import (
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 自定义属性类型
+const (
+	AttributeTypeString = "string"
+	AttributeTypeInt    = "int"
+	AttributeTypeFloat  = "float"
+	AttributeTypeBool   = "bool"
+	AttributeTypeDate   = "date"
+	AttributeTypeEnum   = "enum"
+)
+
+const attributeDateLayout = "2006-01-02"
+
+type AttributeDefinition struct {
+	ID         int64
+	TenantID   *int64
+	Name       string
+	ValueType  *string
+	Required   *bool
+	EnumValues *string
+	CreatedAt  *time.Time
+}
+
+type AttributeDefinitionReply struct {
+	Id         int64
+	Name       string
+	ValueType  string
+	Required   bool
+	EnumValues []string
+	CreatedAt  time.Time
+}
+
+// Normalize 校验属性值并转换为统一的存储格式
+func (d *AttributeDefinitionReply) Normalize(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	switch d.ValueType {
+	case AttributeTypeString:
+		return raw, nil
+	case AttributeTypeInt:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return "", ex.InvalidAttributeValue
+		}
+		return strconv.FormatInt(v, 10), nil
+	case AttributeTypeFloat:
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return "", ex.InvalidAttributeValue
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case AttributeTypeBool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return "", ex.InvalidAttributeValue
+		}
+		return strconv.FormatBool(v), nil
+	case AttributeTypeDate:
+		v, err := time.ParseInLocation(attributeDateLayout, raw, time.Local)
+		if err != nil {
+			return "", ex.InvalidAttributeValue
+		}
+		return v.Format(attributeDateLayout), nil
+	case AttributeTypeEnum:
+		for _, e := range d.EnumValues {
+			if e == raw {
+				return raw, nil
+			}
+		}
+		return "", ex.InvalidAttributeValue
+	}
+	return "", ex.InvalidAttributeType
+}
+
+type CarAttribute struct {
+	ID           int64
+	CarID        *int64
+	DefinitionID *int64
+	Value        string
+}
+
+type AttributeRepo interface {
+	ListDefinition(ctx context.Context, tenantId int64) ([]*AttributeDefinitionReply, error)
+	GetDefinitionById(ctx context.Context, id int64) (*AttributeDefinitionReply, error)
+	SaveDefinition(context.Context, *AttributeDefinition) (int64, error)
+	UpdateDefinition(context.Context, *AttributeDefinition) error
+	// DeleteDefinition 同时删除各汽车上该属性的值
+	DeleteDefinition(ctx context.Context, id int64) error
+	// SetCarAttributes 覆盖汽车的属性值，值为空表示删除，支持事务
+	SetCarAttributes(ctx context.Context, carId int64, values []*CarAttribute) error
+}
+
+type AttributeUseCase struct {
+	r   AttributeRepo
+	log *log.Helper
+}
+
+func NewAttributeUseCase(r AttributeRepo, logger log.Logger) *AttributeUseCase {
+	return &AttributeUseCase{r: r, log: log.NewHelper(logger)}
+}
+
+func (uc *AttributeUseCase) ListDefinition(ctx context.Context) ([]*AttributeDefinitionReply, error) {
+	tenantId, ok := auth.GetTenantId(ctx)
+	if !ok {
+		return nil, ex.TenantRequired
+	}
+	return uc.r.ListDefinition(ctx, tenantId)
+}
+
+func (uc *AttributeUseCase) SaveDefinition(ctx context.Context, d *AttributeDefinition, enumValues []string) error {
+	if err := fillDefinition(d, enumValues); err != nil {
+		return err
+	}
+	_, err := uc.r.SaveDefinition(ctx, d)
+	return err
+}
+
+// UpdateDefinition 属性类型创建后不可修改
+func (uc *AttributeUseCase) UpdateDefinition(ctx context.Context, d *AttributeDefinition, enumValues []string) error {
+	old, err := uc.r.GetDefinitionById(ctx, d.ID)
+	if err != nil {
+		return err
+	}
+	d.ValueType = &old.ValueType
+	if err := fillDefinition(d, enumValues); err != nil {
+		return err
+	}
+	return uc.r.UpdateDefinition(ctx, d)
+}
+
+func (uc *AttributeUseCase) DeleteDefinition(ctx context.Context, id int64) error {
+	return uc.r.DeleteDefinition(ctx, id)
+}
+
+// fillDefinition 校验属性定义并序列化枚举可选值
+func fillDefinition(d *AttributeDefinition, enumValues []string) error {
+	d.Name = strings.TrimSpace(d.Name)
+	if d.Name == "" {
+		return ex.AttributeNameRequired
+	}
+	if d.ValueType == nil {
+		return ex.InvalidAttributeType
+	}
+	switch *d.ValueType {
+	case AttributeTypeString, AttributeTypeInt, AttributeTypeFloat, AttributeTypeBool, AttributeTypeDate:
+	case AttributeTypeEnum:
+		if len(enumValues) == 0 {
+			return ex.EnumValuesRequired
+		}
+	default:
+		return ex.InvalidAttributeType
+	}
+	// 仅枚举类型保存可选值
+	if *d.ValueType == AttributeTypeEnum {
+		b, _ := json.Marshal(enumValues)
+		v := string(b)
+		d.EnumValues = &v
+	}
+	return nil
+}
+
+// resolveAttributes 将按名称提交的属性值校验并转换为属性定义ID及存储值
+func resolveAttributes(defs []*AttributeDefinitionReply, attrs map[string]string, checkRequired bool) ([]*CarAttribute, error) {
+	byName := make(map[string]*AttributeDefinitionReply, len(defs))
+	for _, d := range defs {
+		byName[d.Name] = d
+	}
+
+	values := make([]*CarAttribute, 0, len(attrs))
+	for name, raw := range attrs {
+		d, ok := byName[name]
+		if !ok {
+			return nil, ex.AttributeUndefined
+		}
+		id := d.Id
+		if strings.TrimSpace(raw) == "" {
+			if d.Required {
+				return nil, ex.AttributeRequired
+			}
+			values = append(values, &CarAttribute{DefinitionID: &id})
+			continue
+		}
+		v, err := d.Normalize(raw)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, &CarAttribute{DefinitionID: &id, Value: v})
+	}
+
+	if checkRequired {
+		for _, d := range defs {
+			if d.Required && strings.TrimSpace(attrs[d.Name]) == "" {
+				return nil, ex.AttributeRequired
+			}
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,53 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+type AuditLog struct {
+	ID        int64
+	TenantID  *int64
+	CarID     int64
+	Op        string
+	Actor     *int64
+	Diff      *string
+	TraceID   *string
+	CreatedAt *time.Time
+}
+
+type AuditLogReply struct {
+	Id        int64
+	CarId     int64
+	Op        string
+	Actor     int64
+	Diff      string
+	TraceId   string
+	CreatedAt time.Time
+}
+
+type AuditLogFilter struct {
+	CarId     *int64
+	Actor     *int64
+	StartTime *time.Time
+	EndTime   *time.Time
+}
+
+type AuditLogRepo interface {
+	ListAuditLog(ctx context.Context, page, pageSize int, filter *AuditLogFilter) ([]*AuditLogReply, int, error)
+}
+
+type AuditLogUseCase struct {
+	r   AuditLogRepo
+	log *log.Helper
+}
+
+func NewAuditLogUseCase(r AuditLogRepo, logger log.Logger) *AuditLogUseCase {
+	return &AuditLogUseCase{r: r, log: log.NewHelper(logger)}
+}
+
+func (uc *AuditLogUseCase) ListAuditLog(ctx context.Context,
+	page, pageSize int, filter *AuditLogFilter) ([]*AuditLogReply, int, error) {
+	return uc.r.ListAuditLog(ctx, page, pageSize, filter)
+}
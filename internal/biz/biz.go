@@ -1,13 +1,55 @@
 package biz
 
 import (
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
 	"context"
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"time"
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewCarUseCase)
+var ProviderSet = wire.NewSet(NewCarUseCase, NewAuditLogUseCase, NewMaintenanceUseCase, NewInsuranceUseCase, NewCatalogUseCase,
+	NewTransferUseCase, NewOdometerUseCase, NewAttachmentUseCase, NewFleetUseCase,
+	NewAttributeUseCase, NewReservationUseCase, NewTripUseCase, NewRecallUseCase,
+	NewViolationUseCase, NewValuationUseCase, NewListingUseCase,
+	NewWarrantyUseCase, NewIncidentUseCase, NewLeaseUseCase,
+	NewChargingUseCase, NewTelemetryUseCase, NewGeofenceUseCase, NewJobUseCase,
+	NewNotificationUseCase)
 
 type Transaction interface {
 	ExecTx(context.Context, func(ctx context.Context) error) error
 }
+
+// Locker 分布式锁，保证多副本下同一时刻只有一个实例执行
+type Locker interface {
+	TryLock(ctx context.Context, key string, ttl time.Duration) (unlock func(), ok bool, err error)
+}
+
+// EventPublisher 领域事件发布
+type EventPublisher interface {
+	Publish(ctx context.Context, topic string, event interface{}) error
+}
+
+// EventConsumer 以消费组方式读取领域事件，handle返回错误的消息不确认，空闲一段时间后重新认领处理
+type EventConsumer interface {
+	Consume(ctx context.Context, group string, topics []string,
+		handle func(ctx context.Context, topic string, payload []byte) error) error
+}
+
+// publishCommitted 发布事务已提交的变更事件，发布失败只记录日志，不影响已完成的变更
+func publishCommitted(ctx context.Context, pub EventPublisher, logger *log.Helper, topic string, event interface{}) {
+	if err := pub.Publish(ctx, topic, event); err != nil {
+		logger.WithContext(ctx).Errorf("发布事件失败: %s, %v", topic, err)
+	}
+}
+
+// currentUser 获取当前操作人，网关未透传操作人时视为未认证
+func currentUser(ctx context.Context) (int64, error) {
+	actor, ok := auth.GetUserId(ctx)
+	if !ok {
+		return 0, ex.Unauthorized
+	}
+	return actor, nil
+}
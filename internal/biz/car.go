@@ -1,61 +1,413 @@
 package biz
 
 import (
+	"car-service/internal/conf"
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
+)
+
+const (
+	// 关键词最小长度，与全文索引ngram_token_size一致
+	minSearchKeywordLen = 2
+	// 全文检索返回的候选数量上限，排序分页在内存中完成
+	maxSearchCandidates = 200
+	// 综合得分中模糊匹配的权重，其余为全文相关度
+	fuzzyScoreWeight = 0.6
+	// 核对车主名称时每批查询的汽车数
+	ownerNameSyncBatch = 500
 )
 
 type Car struct {
-	ID           int64
-	UserID       *int64
-	Model        *string
-	RegisteredAt *time.Time
+	ID              int64
+	TenantID        *int64
+	UserID          *int64
+	Model           *string
+	ModelID         *int64
+	Vin             *string
+	Plate           *string
+	OwnerName       *string
+	RegisteredAt    *time.Time
+	BatteryCapacity *float64
+	BatterySoh      *float64
 }
 
 type CarReply struct {
-	Id           int64
-	Model        string
-	RegisteredAt time.Time `sql:"registered_at"`
-	UserName     string
+	Id              int64
+	TenantId        int64
+	UserId          int64
+	Model           string
+	ModelId         int64
+	Vin             string
+	Plate           string
+	RegisteredAt    time.Time `sql:"registered_at"`
+	UserName        string
+	CurrentMileage  int64
+	Tags            []string
+	Attributes      map[string]string
+	BatteryCapacity float64
+	BatterySoh      float64
+}
+
+const TopicOwnershipTransferred = "car.ownership.transferred"
+
+// OwnershipTransferredEvent 车主变更事件，在事务提交后发布
+type OwnershipTransferredEvent struct {
+	CarId         int64     `json:"car_id"`
+	FromUserId    int64     `json:"from_user_id"`
+	ToUserId      int64     `json:"to_user_id"`
+	TransferredAt time.Time `json:"transferred_at"`
+}
+
+// CarFilter 汽车列表查询条件
+type CarFilter struct {
+	Model   *string
+	FleetId *int64
+	Tag     *string
+	// Attributes 按自定义属性名称精确匹配
+	Attributes map[string]string
+	// FreeFrom/FreeTo 该时间段内没有有效预约
+	FreeFrom *time.Time
+	FreeTo   *time.Time
+}
+
+// CarSearchHit 搜索结果，Relevance为全文相关度，Score为综合得分
+type CarSearchHit struct {
+	Car       *CarReply
+	Relevance float64
+	Score     float64
+}
+
+// CarQuota 租户汽车配额使用情况，Limit为0表示不限制
+type CarQuota struct {
+	TenantId int64
+	Used     int
+	Limit    int64
 }
 
 type CarRepo interface {
-	ListCar(ctx context.Context, page, pageSize int, model *string) ([]*CarReply, int, error)
+	ListCar(ctx context.Context, page, pageSize int, filter *CarFilter) ([]*CarReply, int, error)
+	// SearchCars 按全文相关度返回候选汽车
+	SearchCars(ctx context.Context, keyword string, limit int) ([]*CarSearchHit, error)
 	GetById(ctx context.Context, id int64) (*CarReply, error)
+	// CountByTenant 支持事务
+	CountByTenant(ctx context.Context, tenantId int64) (int, error)
+	// LockTenant 锁定租户直到事务结束，同一租户的新建汽车串行执行，需在事务中调用
+	LockTenant(ctx context.Context, tenantId int64) error
+	// LockById 锁定汽车行直到事务结束，需在事务中调用
+	LockById(ctx context.Context, id int64) error
 	Save(context.Context, *Car) (int64, error)
+	// Update 支持事务
 	Update(context.Context, *Car) error
 	Delete(ctx context.Context, id int64) error
+	ListUnmappedModels(ctx context.Context) ([]*UnmappedModel, error)
+	BindModel(ctx context.Context, model string, modelId int64, name string) (int, error)
+	// ChangeOwner 仅当车主仍为from时变更为to，支持事务
+	ChangeOwner(ctx context.Context, id, from, to int64) error
+	// SyncOwnerNames 按ID顺序取afterId之后的一批汽车，与用户服务核对并更新冗余的车主名称，
+	// 返回本批最后一辆汽车的ID，没有更多汽车时返回0
+	SyncOwnerNames(ctx context.Context, afterId int64, limit int) (int64, int, error)
 }
 
 type CarUseCase struct {
 	r   CarRepo
+	cr  CatalogRepo
+	ar  AttributeRepo
+	lr  LeaseRepo
+	vu  *ValuationUseCase
+	pub EventPublisher
+	c   *conf.Tenant
 	log *log.Helper
 	tx  Transaction
 }
 
-func NewCarUseCase(r CarRepo, tx Transaction, logger log.Logger) *CarUseCase {
-	return &CarUseCase{r: r, tx: tx, log: log.NewHelper(logger)}
+func NewCarUseCase(r CarRepo, cr CatalogRepo, ar AttributeRepo, lr LeaseRepo, vu *ValuationUseCase,
+	pub EventPublisher, c *conf.Tenant, tx Transaction, logger log.Logger) *CarUseCase {
+	return &CarUseCase{r: r, cr: cr, ar: ar, lr: lr, vu: vu, pub: pub, c: c, tx: tx, log: log.NewHelper(logger)}
 }
 
 func (uc *CarUseCase) ListCar(ctx context.Context,
-	page, pageSize int, model *string) ([]*CarReply, int, error) {
-	return uc.r.ListCar(ctx, page, pageSize, model)
+	page, pageSize int, filter *CarFilter) ([]*CarReply, int, error) {
+	// 属性条件按定义的类型统一格式
+	if len(filter.Attributes) > 0 {
+		tenantId, ok := auth.GetTenantId(ctx)
+		if !ok {
+			return nil, 0, ex.TenantRequired
+		}
+		defs, err := uc.ar.ListDefinition(ctx, tenantId)
+		if err != nil {
+			return nil, 0, err
+		}
+		values, err := resolveAttributes(defs, filter.Attributes, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		names := make(map[int64]string, len(defs))
+		for _, d := range defs {
+			names[d.Id] = d.Name
+		}
+		attrs := make(map[string]string, len(values))
+		for _, v := range values {
+			attrs[names[*v.DefinitionID]] = v.Value
+		}
+		filter.Attributes = attrs
+	}
+	return uc.r.ListCar(ctx, page, pageSize, filter)
+}
+
+// SearchCars 在车型、VIN、车牌及车主名称中搜索，结合模糊匹配重新排序
+func (uc *CarUseCase) SearchCars(ctx context.Context,
+	page, pageSize int, keyword string) ([]*CarSearchHit, int, error) {
+	keyword = strings.TrimSpace(keyword)
+	if utf8.RuneCountInString(keyword) < minSearchKeywordLen {
+		return nil, 0, ex.SearchKeywordTooShort
+	}
+
+	hits, err := uc.r.SearchCars(ctx, keyword, maxSearchCandidates)
+	if err != nil {
+		return nil, 0, err
+	}
+	var maxRelevance float64
+	for _, h := range hits {
+		if h.Relevance > maxRelevance {
+			maxRelevance = h.Relevance
+		}
+	}
+	for _, h := range hits {
+		h.Score = fuzzyScoreWeight * fuzzyScore(keyword, h.Car)
+		if maxRelevance > 0 {
+			h.Score += (1 - fuzzyScoreWeight) * h.Relevance / maxRelevance
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Score > hits[j].Score
+	})
+
+	total := len(hits)
+	start := pagination.GetOffset(page, pageSize)
+	if start >= total {
+		return []*CarSearchHit{}, total, nil
+	}
+	end := start + pageSize
+	if end > total {
+		end = total
+	}
+	return hits[start:end], total, nil
 }
 
 func (uc *CarUseCase) GetCarById(ctx context.Context, id int64) (*CarReply, error) {
 	return uc.r.GetById(ctx, id)
 }
 
-func (uc *CarUseCase) SaveCar(ctx context.Context, c *Car) error {
-	_, err := uc.r.Save(ctx, c)
-	return err
+func (uc *CarUseCase) SaveCar(ctx context.Context, c *Car, attrs map[string]string) error {
+	if c.ModelID == nil || *c.ModelID == 0 {
+		return ex.ModelIdRequired
+	}
+	// 车型文本统一取自车型目录
+	m, err := uc.cr.GetVehicleModelById(ctx, *c.ModelID)
+	if err != nil {
+		return err
+	}
+	name := m.FullName()
+	c.Model = &name
+	if c.BatteryCapacity != nil && *c.BatteryCapacity < 0 {
+		return ex.InvalidBatteryCapacity
+	}
+	// VIN统一大写
+	if c.Vin != nil {
+		vin := strings.ToUpper(strings.TrimSpace(*c.Vin))
+		c.Vin = &vin
+	}
+
+	// 校验自定义属性，管理员可为指定租户新建汽车
+	tenantId, ok := auth.GetTenantId(ctx)
+	if c.TenantID != nil {
+		tenantId, ok = *c.TenantID, true
+	}
+	if !ok {
+		return ex.TenantRequired
+	}
+	defs, err := uc.ar.ListDefinition(ctx, tenantId)
+	if err != nil {
+		return err
+	}
+	values, err := resolveAttributes(defs, attrs, true)
+	if err != nil {
+		return err
+	}
+
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		// 管理员不受配额限制，配额检查与新建在同一租户锁内完成，避免并发新建同时通过检查
+		if !auth.IsAdmin(ctx) {
+			if err := uc.r.LockTenant(ctx, tenantId); err != nil {
+				return err
+			}
+			q, err := uc.GetCarQuota(ctx)
+			if err != nil {
+				return err
+			}
+			if q.Limit > 0 && int64(q.Used) >= q.Limit {
+				return ex.TenantQuotaExceeded
+			}
+		}
+		id, err := uc.r.Save(ctx, c)
+		if err != nil {
+			return err
+		}
+		return uc.ar.SetCarAttributes(ctx, id, values)
+	})
+}
+
+// SetCarAttributes 修改汽车的自定义属性，值为空表示删除
+func (uc *CarUseCase) SetCarAttributes(ctx context.Context, carId int64, attrs map[string]string) error {
+	c, err := uc.r.GetById(ctx, carId)
+	if err != nil {
+		return err
+	}
+	defs, err := uc.ar.ListDefinition(ctx, c.TenantId)
+	if err != nil {
+		return err
+	}
+	values, err := resolveAttributes(defs, attrs, false)
+	if err != nil {
+		return err
+	}
+	return uc.ar.SetCarAttributes(ctx, carId, values)
+}
+
+// GetCarQuota 查询当前租户的汽车配额
+func (uc *CarUseCase) GetCarQuota(ctx context.Context) (*CarQuota, error) {
+	tenantId, ok := auth.GetTenantId(ctx)
+	if !ok {
+		return nil, ex.TenantRequired
+	}
+	n, err := uc.r.CountByTenant(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := uc.c.GetDefaultCarQuota()
+	if v, ok := uc.c.GetCarQuotas()[tenantId]; ok {
+		limit = v
+	}
+	return &CarQuota{TenantId: tenantId, Used: n, Limit: limit}, nil
 }
 
 func (uc *CarUseCase) UpdateCar(ctx context.Context, c *Car) error {
 	return uc.r.Update(ctx, c)
 }
 
+// TradeCar 直接变更车主，车主变更记录由数据层在同一事务中写入，
+// 同时记录成交价及当时的估值，成交价作为同车型估值的参考
+func (uc *CarUseCase) TradeCar(ctx context.Context, id, userId int64, price *float64, mileage *int64) error {
+	if price != nil && *price < 0 {
+		return ex.InvalidTradePrice
+	}
+	c, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	var e *OwnershipTransferredEvent
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		e, err = uc.transferOwner(ctx, c, nil, userId, price, mileage)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	uc.publishTransferred(ctx, e)
+	return nil
+}
+
+// transferOwner 锁定汽车后变更车主并记录成交，from不为空时要求当前车主仍为from，需在事务中调用；
+// 汽车c需在事务外查询，避免持锁期间调用用户服务，车主在查询后被并发变更时由ChangeOwner返回冲突。
+// 返回待事务提交后发布的车主变更事件，车主未变化时为nil
+func (uc *CarUseCase) transferOwner(ctx context.Context, c *CarReply, from *int64, to int64,
+	price *float64, mileage *int64) (*OwnershipTransferredEvent, error) {
+	if from != nil && *from != c.UserId {
+		return nil, ex.CarOwnerChanged
+	}
+	if c.UserId == to {
+		return nil, nil
+	}
+	if err := uc.r.LockById(ctx, c.Id); err != nil {
+		return nil, err
+	}
+	if err := checkLeaseTransfer(ctx, uc.lr, c.Id); err != nil {
+		return nil, err
+	}
+	if err := uc.r.ChangeOwner(ctx, c.Id, c.UserId, to); err != nil {
+		return nil, err
+	}
+	if err := uc.vu.recordTrade(ctx, c, to, price, mileage); err != nil {
+		return nil, err
+	}
+	return &OwnershipTransferredEvent{CarId: c.Id, FromUserId: c.UserId, ToUserId: to, TransferredAt: time.Now()}, nil
+}
+
+func (uc *CarUseCase) publishTransferred(ctx context.Context, e *OwnershipTransferredEvent) {
+	if e != nil {
+		publishCommitted(ctx, uc.pub, uc.log, TopicOwnershipTransferred, e)
+	}
+}
+
 func (uc *CarUseCase) DeleteCar(ctx context.Context, id int64) error {
 	return uc.r.Delete(ctx, id)
 }
+
+// SyncOwnerNames 与用户服务核对全部汽车的车主名称，用户改名后同步，由定时任务调用
+func (uc *CarUseCase) SyncOwnerNames(ctx context.Context) error {
+	var afterId int64
+	total := 0
+	for {
+		next, n, err := uc.r.SyncOwnerNames(ctx, afterId, ownerNameSyncBatch)
+		total += n
+		if err != nil {
+			return err
+		}
+		if next == 0 {
+			break
+		}
+		afterId = next
+	}
+	if total > 0 {
+		uc.log.WithContext(ctx).Infof("同步车主名称完成，共%d辆", total)
+	}
+	return nil
+}
+
+// fuzzyScore 关键词与各字段的最高相似度，容忍少量拼写错误
+func fuzzyScore(keyword string, c *CarReply) float64 {
+	var best float64
+	for _, f := range []string{c.Model, c.Vin, c.Plate, c.UserName} {
+		if s := fieldSimilarity(keyword, f); s > best {
+			best = s
+		}
+	}
+	return best
+}
+
+// fieldSimilarity 字段包含关键词视为完全匹配，否则分别与整个字段及其中每个词比较
+func fieldSimilarity(keyword, field string) float64 {
+	k, f := normalizeModel(keyword), normalizeModel(field)
+	if k == "" || f == "" {
+		return 0
+	}
+	if strings.Contains(f, k) {
+		return 1
+	}
+	best := similarity(keyword, field)
+	for _, w := range strings.Fields(field) {
+		if s := similarity(keyword, w); s > best {
+			best = s
+		}
+	}
+	return best
+}
@@ -0,0 +1,66 @@
+package biz
+
+import (
+	"car-service/internal/conf"
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/metadata"
+	"strconv"
+	"testing"
+)
+
+// tenantContext 模拟网关透传的租户及角色
+func tenantContext(tenantId int64, admin bool) context.Context {
+	md := metadata.Metadata{}
+	md.Set("x-md-global-user-id", "1")
+	md.Set("x-md-global-tenant-id", strconv.FormatInt(tenantId, 10))
+	if admin {
+		md.Set("x-md-global-role", auth.RoleAdmin)
+	}
+	return metadata.NewServerContext(context.Background(), md)
+}
+
+func TestSaveCarQuota(t *testing.T) {
+	modelId := int64(1)
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		quotas map[int64]int64
+		// 租户1已有的汽车数量
+		used  int
+		err   error
+		saved bool
+	}{
+		{"under default quota", tenantContext(1, false), nil, 1, nil, true},
+		{"reaches default quota", tenantContext(1, false), nil, 2, ex.TenantQuotaExceeded, false},
+		{"tenant quota overrides default", tenantContext(1, false), map[int64]int64{1: 3}, 2, nil, true},
+		{"unlimited tenant quota", tenantContext(1, false), map[int64]int64{1: 0}, 5, nil, true},
+		{"other tenant's cars not counted", tenantContext(2, false), nil, 2, nil, true},
+		{"admin not limited", tenantContext(1, true), nil, 2, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeTx{}
+			cars := make(map[int64]*CarReply)
+			for i := 1; i <= tt.used; i++ {
+				cars[int64(i)] = &CarReply{Id: int64(i), TenantId: 1}
+			}
+			r := &fakeCarRepo{cars: cars, tx: tx}
+			cr := &fakeCatalogRepo{models: map[int64]*VehicleModelReply{
+				modelId: {Id: modelId, BrandName: "Tesla", Name: "Model 3"},
+			}}
+			c := &conf.Tenant{DefaultCarQuota: 2, CarQuotas: tt.quotas}
+			uc := NewCarUseCase(r, cr, &fakeAttributeRepo{}, nil, nil, nil, c, tx, log.DefaultLogger)
+
+			err := uc.SaveCar(tt.ctx, &Car{ModelID: &modelId}, nil)
+			if err != tt.err {
+				t.Fatalf("SaveCar() error = %v, want %v", err, tt.err)
+			}
+			if saved := len(cars) > tt.used; saved != tt.saved {
+				t.Errorf("saved = %v, want %v", saved, tt.saved)
+			}
+		})
+	}
+}
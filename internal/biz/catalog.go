@@ -0,0 +1,262 @@
+package biz
+
+import (
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// 模糊匹配建议的最低相似度及返回数量
+const (
+	minMatchScore  = 0.5
+	maxSuggestions = 3
+)
+
+type Brand struct {
+	ID   int64
+	Name string
+}
+
+type BrandReply struct {
+	Id   int64
+	Name string
+}
+
+type VehicleModel struct {
+	ID       int64
+	BrandID  *int64
+	Name     *string
+	FuelType *string
+	Seats    *int32
+	BodyType *string
+}
+
+type VehicleModelReply struct {
+	Id        int64
+	BrandId   int64
+	BrandName string
+	Name      string
+	FuelType  string
+	Seats     int32
+	BodyType  string
+}
+
+// FullName 品牌+车型的完整名称，作为汽车的车型文本
+func (m *VehicleModelReply) FullName() string {
+	return strings.TrimSpace(m.BrandName + " " + m.Name)
+}
+
+// UnmappedModel 尚未关联车型目录的自由文本车型
+type UnmappedModel struct {
+	Model    string
+	CarCount int
+}
+
+type ModelMatch struct {
+	Model *VehicleModelReply
+	Score float64
+}
+
+type ModelMapping struct {
+	Model       string
+	CarCount    int
+	Suggestions []*ModelMatch
+}
+
+type CatalogRepo interface {
+	ListBrand(ctx context.Context) ([]*BrandReply, error)
+	SaveBrand(context.Context, *Brand) (int64, error)
+	UpdateBrand(context.Context, *Brand) error
+	DeleteBrand(ctx context.Context, id int64) error
+	ListVehicleModel(ctx context.Context, page, pageSize int, brandId *int64) ([]*VehicleModelReply, int, error)
+	ListAllVehicleModel(ctx context.Context) ([]*VehicleModelReply, error)
+	GetVehicleModelById(ctx context.Context, id int64) (*VehicleModelReply, error)
+	SaveVehicleModel(context.Context, *VehicleModel) (int64, error)
+	UpdateVehicleModel(context.Context, *VehicleModel) error
+	DeleteVehicleModel(ctx context.Context, id int64) error
+}
+
+type CatalogUseCase struct {
+	r   CatalogRepo
+	cr  CarRepo
+	log *log.Helper
+}
+
+func NewCatalogUseCase(r CatalogRepo, cr CarRepo, logger log.Logger) *CatalogUseCase {
+	return &CatalogUseCase{r: r, cr: cr, log: log.NewHelper(logger)}
+}
+
+func (uc *CatalogUseCase) ListBrand(ctx context.Context) ([]*BrandReply, error) {
+	return uc.r.ListBrand(ctx)
+}
+
+// 品牌及车型目录由所有租户共享，仅管理员可以维护
+
+func (uc *CatalogUseCase) SaveBrand(ctx context.Context, b *Brand) error {
+	if !auth.IsAdmin(ctx) {
+		return ex.CatalogForbidden
+	}
+	_, err := uc.r.SaveBrand(ctx, b)
+	return err
+}
+
+func (uc *CatalogUseCase) UpdateBrand(ctx context.Context, b *Brand) error {
+	if !auth.IsAdmin(ctx) {
+		return ex.CatalogForbidden
+	}
+	return uc.r.UpdateBrand(ctx, b)
+}
+
+func (uc *CatalogUseCase) DeleteBrand(ctx context.Context, id int64) error {
+	if !auth.IsAdmin(ctx) {
+		return ex.CatalogForbidden
+	}
+	return uc.r.DeleteBrand(ctx, id)
+}
+
+func (uc *CatalogUseCase) ListVehicleModel(ctx context.Context,
+	page, pageSize int, brandId *int64) ([]*VehicleModelReply, int, error) {
+	return uc.r.ListVehicleModel(ctx, page, pageSize, brandId)
+}
+
+func (uc *CatalogUseCase) GetVehicleModelById(ctx context.Context, id int64) (*VehicleModelReply, error) {
+	return uc.r.GetVehicleModelById(ctx, id)
+}
+
+func (uc *CatalogUseCase) SaveVehicleModel(ctx context.Context, m *VehicleModel) error {
+	if !auth.IsAdmin(ctx) {
+		return ex.CatalogForbidden
+	}
+	if m.BrandID == nil {
+		return ex.BrandIdRequired
+	}
+	_, err := uc.r.SaveVehicleModel(ctx, m)
+	return err
+}
+
+func (uc *CatalogUseCase) UpdateVehicleModel(ctx context.Context, m *VehicleModel) error {
+	if !auth.IsAdmin(ctx) {
+		return ex.CatalogForbidden
+	}
+	return uc.r.UpdateVehicleModel(ctx, m)
+}
+
+func (uc *CatalogUseCase) DeleteVehicleModel(ctx context.Context, id int64) error {
+	if !auth.IsAdmin(ctx) {
+		return ex.CatalogForbidden
+	}
+	return uc.r.DeleteVehicleModel(ctx, id)
+}
+
+// SuggestModelMapping 为存量的自由文本车型给出车型目录的模糊匹配建议
+func (uc *CatalogUseCase) SuggestModelMapping(ctx context.Context) ([]*ModelMapping, error) {
+	unmapped, err := uc.cr.ListUnmappedModels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	models, err := uc.r.ListAllVehicleModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*ModelMapping, 0, len(unmapped))
+	for _, u := range unmapped {
+		list = append(list, &ModelMapping{
+			Model:       u.Model,
+			CarCount:    u.CarCount,
+			Suggestions: matchModels(u.Model, models),
+		})
+	}
+	return list, nil
+}
+
+// ApplyModelMapping 将某个自由文本车型的汽车统一关联到目录车型
+func (uc *CatalogUseCase) ApplyModelMapping(ctx context.Context, model string, modelId int64) (int, error) {
+	m, err := uc.r.GetVehicleModelById(ctx, modelId)
+	if err != nil {
+		return 0, err
+	}
+	return uc.cr.BindModel(ctx, model, m.Id, m.FullName())
+}
+
+func matchModels(text string, models []*VehicleModelReply) []*ModelMatch {
+	matches := make([]*ModelMatch, 0)
+	for _, m := range models {
+		// 分别与车型名、品牌+车型名比较，取较高者
+		score := similarity(text, m.Name)
+		if s := similarity(text, m.FullName()); s > score {
+			score = s
+		}
+		if score >= minMatchScore {
+			matches = append(matches, &ModelMatch{Model: m, Score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	if len(matches) > maxSuggestions {
+		matches = matches[:maxSuggestions]
+	}
+	return matches
+}
+
+// similarity 归一化后基于编辑距离的相似度，取值0~1
+func similarity(a, b string) float64 {
+	a, b = normalizeModel(a), normalizeModel(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	n := len(ra)
+	if len(rb) > n {
+		n = len(rb)
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(n)
+}
+
+// normalizeModel 忽略大小写、空格和标点
+func normalizeModel(s string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(v int, vs ...int) int {
+	for _, x := range vs {
+		if x < v {
+			v = x
+		}
+	}
+	return v
+}
@@ -0,0 +1,242 @@
+package biz
+
+import (
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"math"
+	"time"
+)
+
+// 充电桩类型
+const (
+	ChargerTypeAC = "ac"
+	ChargerTypeDC = "dc"
+)
+
+const batteryTrendMonthLayout = "2006-01"
+
+type ChargingSession struct {
+	ID          int64
+	TenantID    *int64
+	CarID       *int64
+	StartedAt   *time.Time
+	EndedAt     *time.Time
+	Energy      *float64
+	ChargerType *string
+	Cost        *float64
+	Station     *string
+	StartSoc    *float64
+	EndSoc      *float64
+	Soh         *float64
+	CreatedAt   *time.Time
+}
+
+type ChargingSessionReply struct {
+	Id          int64
+	CarId       int64
+	StartedAt   time.Time
+	EndedAt     time.Time
+	Energy      float64
+	ChargerType string
+	Cost        float64
+	Station     string
+	StartSoc    float64
+	EndSoc      float64
+	Soh         float64
+}
+
+type ChargingFilter struct {
+	CarId *int64
+	From  *time.Time
+	To    *time.Time
+}
+
+// BatteryHealthPoint 按月汇总的电池健康情况，EstimatedCapacity由充入电量与电量百分比变化折算，含充电损耗
+type BatteryHealthPoint struct {
+	Month             string
+	Sessions          int
+	Energy            float64
+	AvgSoh            float64
+	MinSoh            float64
+	EstimatedCapacity float64
+}
+
+// BatteryDegradation 电池衰减分析，SohPerYear为健康度每年变化的百分点，负数表示衰减
+type BatteryDegradation struct {
+	CarId           int64
+	NominalCapacity float64
+	CurrentSoh      float64
+	SohPerYear      float64
+	Points          []*BatteryHealthPoint
+}
+
+type ChargingRepo interface {
+	ListChargingSession(ctx context.Context, page, pageSize int, filter *ChargingFilter) ([]*ChargingSessionReply, int, error)
+	// ExistsOverlap 该汽车在指定时间段内是否已有充电记录
+	ExistsOverlap(ctx context.Context, carId int64, start, end time.Time) (bool, error)
+	// ExistsSohAfter 该汽车在指定时间之后是否已有健康度上报
+	ExistsSohAfter(ctx context.Context, carId int64, at time.Time) (bool, error)
+	// Save 支持事务
+	Save(context.Context, *ChargingSession) (int64, error)
+	// BatteryHealthTrend 按月汇总充电记录，原生SQL不经过ent隐私规则，调用方需先校验汽车归属
+	BatteryHealthTrend(ctx context.Context, carId int64, from, to *time.Time) ([]*BatteryHealthPoint, error)
+}
+
+type ChargingUseCase struct {
+	r   ChargingRepo
+	cr  CarRepo
+	tx  Transaction
+	log *log.Helper
+}
+
+func NewChargingUseCase(r ChargingRepo, cr CarRepo, tx Transaction, logger log.Logger) *ChargingUseCase {
+	return &ChargingUseCase{r: r, cr: cr, tx: tx, log: log.NewHelper(logger)}
+}
+
+func (uc *ChargingUseCase) ListChargingSession(ctx context.Context,
+	page, pageSize int, filter *ChargingFilter) ([]*ChargingSessionReply, int, error) {
+	return uc.r.ListChargingSession(ctx, page, pageSize, filter)
+}
+
+// SetBatteryInfo 登记电池标称容量及健康度，标称容量大于0即视为电动车
+func (uc *ChargingUseCase) SetBatteryInfo(ctx context.Context, carId int64, capacity, soh *float64) error {
+	if capacity != nil && *capacity < 0 {
+		return ex.InvalidBatteryCapacity
+	}
+	if soh != nil && (*soh < 0 || *soh > 100) {
+		return ex.InvalidStateOfHealth
+	}
+	if _, err := uc.cr.GetById(ctx, carId); err != nil {
+		return err
+	}
+	return uc.cr.Update(ctx, &Car{ID: carId, BatteryCapacity: capacity, BatterySoh: soh})
+}
+
+// RecordChargingSession 记录充电，上报的健康度为该车最新数据时同步到汽车
+func (uc *ChargingUseCase) RecordChargingSession(ctx context.Context, s *ChargingSession) (int64, error) {
+	if s.CarID == nil {
+		return 0, ex.CarIdRequired
+	}
+	if s.StartedAt == nil || s.EndedAt == nil || !s.EndedAt.After(*s.StartedAt) || s.EndedAt.After(time.Now()) {
+		return 0, ex.InvalidChargingPeriod
+	}
+	if s.Energy == nil || *s.Energy <= 0 {
+		return 0, ex.InvalidChargingEnergy
+	}
+	if s.ChargerType == nil || (*s.ChargerType != ChargerTypeAC && *s.ChargerType != ChargerTypeDC) {
+		return 0, ex.InvalidChargerType
+	}
+	if s.Cost != nil && *s.Cost < 0 {
+		return 0, ex.InvalidChargingCost
+	}
+	if !validPercent(s.StartSoc) || !validPercent(s.EndSoc) ||
+		(s.StartSoc != nil && s.EndSoc != nil && *s.EndSoc < *s.StartSoc) {
+		return 0, ex.InvalidStateOfCharge
+	}
+	if !validPercent(s.Soh) {
+		return 0, ex.InvalidStateOfHealth
+	}
+	c, err := uc.cr.GetById(ctx, *s.CarID)
+	if err != nil {
+		return 0, err
+	}
+	if c.BatteryCapacity <= 0 {
+		return 0, ex.NotElectricCar
+	}
+	s.TenantID = &c.TenantId
+
+	var id int64
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		// 锁定汽车，避免并发记录重叠的充电
+		if err := uc.cr.LockById(ctx, c.Id); err != nil {
+			return err
+		}
+		overlap, err := uc.r.ExistsOverlap(ctx, c.Id, *s.StartedAt, *s.EndedAt)
+		if err != nil {
+			return err
+		}
+		if overlap {
+			return ex.ChargingSessionOverlap
+		}
+		if id, err = uc.r.Save(ctx, s); err != nil {
+			return err
+		}
+		if s.Soh == nil || *s.Soh == 0 {
+			return nil
+		}
+		// 补录的历史记录不覆盖最新健康度
+		later, err := uc.r.ExistsSohAfter(ctx, c.Id, *s.EndedAt)
+		if err != nil || later {
+			return err
+		}
+		return uc.cr.Update(ctx, &Car{ID: c.Id, BatterySoh: s.Soh})
+	})
+	return id, err
+}
+
+// GetBatteryDegradation 按月统计健康度及折算容量，并按月均健康度线性拟合年衰减
+func (uc *ChargingUseCase) GetBatteryDegradation(ctx context.Context, carId int64, from, to *time.Time) (*BatteryDegradation, error) {
+	c, err := uc.cr.GetById(ctx, carId)
+	if err != nil {
+		return nil, err
+	}
+	if c.BatteryCapacity <= 0 {
+		return nil, ex.NotElectricCar
+	}
+	points, err := uc.r.BatteryHealthTrend(ctx, carId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &BatteryDegradation{
+		CarId:           c.Id,
+		NominalCapacity: c.BatteryCapacity,
+		CurrentSoh:      c.BatterySoh,
+		SohPerYear:      sohSlope(points),
+		Points:          points,
+	}, nil
+}
+
+// sohSlope 以月份为自变量对月均健康度做最小二乘拟合，不足两个月时为0
+func sohSlope(points []*BatteryHealthPoint) float64 {
+	var xs, ys []float64
+	var origin time.Time
+	for _, p := range points {
+		if p.AvgSoh <= 0 {
+			continue
+		}
+		m, err := time.ParseInLocation(batteryTrendMonthLayout, p.Month, time.Local)
+		if err != nil {
+			continue
+		}
+		if origin.IsZero() {
+			origin = m
+		}
+		months := (m.Year()-origin.Year())*12 + int(m.Month()-origin.Month())
+		xs = append(xs, float64(months))
+		ys = append(ys, p.AvgSoh)
+	}
+	if len(xs) < 2 {
+		return 0
+	}
+
+	var sumX, sumY, sumXY, sumXX float64
+	n := float64(len(xs))
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+		sumXY += xs[i] * ys[i]
+		sumXX += xs[i] * xs[i]
+	}
+	d := n*sumXX - sumX*sumX
+	if d == 0 {
+		return 0
+	}
+	slope := (n*sumXY - sumX*sumY) / d
+	return math.Round(slope*12*100) / 100
+}
+
+// validPercent 百分比为空或在0到100之间
+func validPercent(v *float64) bool {
+	return v == nil || (*v >= 0 && *v <= 100)
+}
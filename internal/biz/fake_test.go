@@ -0,0 +1,423 @@
+package biz
+
+import (
+	"bytes"
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/metadata"
+)
+
+// 测试用的内存实现，只实现被测用例用到的方法，其余方法调用时panic
+
+// fakeTx 记录是否处于事务中及事务中是否已锁定汽车或租户，不支持回滚
+type fakeTx struct {
+	active       bool
+	locked       bool
+	tenantLocked bool
+}
+
+func (tx *fakeTx) ExecTx(ctx context.Context, f func(ctx context.Context) error) error {
+	if tx.active {
+		return f(ctx)
+	}
+	tx.active = true
+	defer func() {
+		tx.active, tx.locked, tx.tenantLocked = false, false, false
+	}()
+	return f(ctx)
+}
+
+type fakeCarRepo struct {
+	CarRepo
+	cars map[int64]*CarReply
+	// 不为nil时校验LockById在事务中调用，且持锁期间不调用GetById（会请求用户服务）
+	tx *fakeTx
+}
+
+func (r *fakeCarRepo) GetById(_ context.Context, id int64) (*CarReply, error) {
+	if r.tx != nil && r.tx.locked {
+		return nil, errors.New("GetById called while holding the car lock")
+	}
+	c, ok := r.cars[id]
+	if !ok {
+		return nil, ex.CarNotFound
+	}
+	cp := *c
+	return &cp, nil
+}
+
+func (r *fakeCarRepo) LockById(_ context.Context, id int64) error {
+	if r.tx == nil || !r.tx.active {
+		return errors.New("LockById called outside a transaction")
+	}
+	if _, ok := r.cars[id]; !ok {
+		return ex.CarNotFound
+	}
+	r.tx.locked = true
+	return nil
+}
+
+func (r *fakeCarRepo) LockTenant(context.Context, int64) error {
+	if r.tx == nil || !r.tx.active {
+		return errors.New("LockTenant called outside a transaction")
+	}
+	r.tx.tenantLocked = true
+	return nil
+}
+
+func (r *fakeCarRepo) CountByTenant(_ context.Context, tenantId int64) (int, error) {
+	if r.tx != nil && !r.tx.tenantLocked {
+		return 0, errors.New("CountByTenant called without holding the tenant lock")
+	}
+	n := 0
+	for _, c := range r.cars {
+		if c.TenantId == tenantId {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Save 未指定租户时按请求所属租户保存，与租户钩子一致
+func (r *fakeCarRepo) Save(ctx context.Context, c *Car) (int64, error) {
+	tenantId, _ := auth.GetTenantId(ctx)
+	if c.TenantID != nil {
+		tenantId = *c.TenantID
+	}
+	id := int64(len(r.cars) + 1)
+	r.cars[id] = &CarReply{Id: id, TenantId: tenantId, Model: *c.Model}
+	return id, nil
+}
+
+func (r *fakeCarRepo) ChangeOwner(_ context.Context, id, from, to int64) error {
+	c, ok := r.cars[id]
+	if !ok || c.UserId != from {
+		return ex.CarOwnerChanged
+	}
+	c.UserId = to
+	return nil
+}
+
+type fakeCatalogRepo struct {
+	CatalogRepo
+	models map[int64]*VehicleModelReply
+}
+
+func (r *fakeCatalogRepo) GetVehicleModelById(_ context.Context, id int64) (*VehicleModelReply, error) {
+	m, ok := r.models[id]
+	if !ok {
+		return nil, ex.VehicleModelNotFound
+	}
+	return m, nil
+}
+
+type fakeAttributeRepo struct {
+	AttributeRepo
+}
+
+func (r *fakeAttributeRepo) ListDefinition(context.Context, int64) ([]*AttributeDefinitionReply, error) {
+	return nil, nil
+}
+
+func (r *fakeAttributeRepo) SetCarAttributes(context.Context, int64, []*CarAttribute) error {
+	return nil
+}
+
+type fakeLeaseRepo struct {
+	LeaseRepo
+	leases   map[int64]*LeaseContractReply
+	blocking bool
+}
+
+func (r *fakeLeaseRepo) GetById(_ context.Context, id int64) (*LeaseContractReply, error) {
+	l, ok := r.leases[id]
+	if !ok {
+		return nil, ex.LeaseNotFound
+	}
+	return l, nil
+}
+
+func (r *fakeLeaseRepo) ExistsBlocking(context.Context, int64, time.Time) (bool, error) {
+	return r.blocking, nil
+}
+
+type fakeValuationRepo struct {
+	ValuationRepo
+	trades []*TradeRecord
+}
+
+func (r *fakeValuationRepo) ListComparables(context.Context, int64, time.Time, int) ([]*TradeRecordReply, error) {
+	return nil, nil
+}
+
+func (r *fakeValuationRepo) SaveTradeRecord(_ context.Context, t *TradeRecord) (int64, error) {
+	r.trades = append(r.trades, t)
+	return int64(len(r.trades)), nil
+}
+
+type fakeTransferRepo struct {
+	TransferRepo
+	transfers map[int64]*TransferReply
+}
+
+func (r *fakeTransferRepo) GetById(_ context.Context, id int64) (*TransferReply, error) {
+	t, ok := r.transfers[id]
+	if !ok {
+		return nil, ex.TransferNotFound
+	}
+	cp := *t
+	return &cp, nil
+}
+
+func (r *fakeTransferRepo) ExistsInProgress(_ context.Context, carId int64) (bool, error) {
+	for _, t := range r.transfers {
+		if t.CarId == carId && t.InProgress() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeTransferRepo) Save(_ context.Context, t *Transfer) (int64, error) {
+	if r.transfers == nil {
+		r.transfers = make(map[int64]*TransferReply)
+	}
+	id := int64(len(r.transfers) + 1)
+	r.transfers[id] = &TransferReply{
+		Id:              id,
+		CarId:           *t.CarID,
+		SellerId:        *t.SellerID,
+		BuyerId:         *t.BuyerID,
+		Status:          TransferStatusPending,
+		RequireApproval: *t.RequireApproval,
+		ExpiresAt:       *t.ExpiresAt,
+	}
+	return id, nil
+}
+
+// Transit 与数据层一致，仅当仍处于from状态且未完成时更新
+func (r *fakeTransferRepo) Transit(_ context.Context, t *Transfer, from string) error {
+	cur, ok := r.transfers[t.ID]
+	if !ok || cur.Status != from || cur.CompletedAt != nil {
+		return ex.TransferStatusConflict
+	}
+	if t.Status != nil {
+		cur.Status = *t.Status
+	}
+	if t.ApprovedBy != nil {
+		cur.ApprovedBy = *t.ApprovedBy
+	}
+	if t.AcceptedAt != nil {
+		cur.AcceptedAt = t.AcceptedAt
+	}
+	if t.CompletedAt != nil {
+		cur.CompletedAt = t.CompletedAt
+	}
+	return nil
+}
+
+// userContext 模拟网关透传的操作人，userId为0时不透传
+func userContext(userId int64, admin bool) context.Context {
+	md := metadata.Metadata{}
+	if userId != 0 {
+		md.Set("x-md-global-user-id", strconv.FormatInt(userId, 10))
+	}
+	if admin {
+		md.Set("x-md-global-role", auth.RoleAdmin)
+	}
+	return metadata.NewServerContext(context.Background(), md)
+}
+
+type fakeAttachmentRepo struct {
+	AttachmentRepo
+	saved []*Attachment
+}
+
+func (r *fakeAttachmentRepo) Save(_ context.Context, a *Attachment) (int64, error) {
+	r.saved = append(r.saved, a)
+	return int64(len(r.saved)), nil
+}
+
+func (r *fakeAttachmentRepo) GetById(_ context.Context, id int64) (*AttachmentReply, error) {
+	if id <= 0 || int(id) > len(r.saved) {
+		return nil, ex.AttachmentNotFound
+	}
+	a := r.saved[id-1]
+	return &AttachmentReply{
+		Id:          id,
+		CarId:       *a.CarID,
+		Kind:        *a.Kind,
+		FileName:    *a.FileName,
+		ContentType: *a.ContentType,
+		Size:        *a.Size,
+		Checksum:    *a.Checksum,
+		StorageKey:  *a.StorageKey,
+	}, nil
+}
+
+type fakeBlobStore struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+}
+
+func (s *fakeBlobStore) Put(_ context.Context, key string, r io.Reader, _ string) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.blobs == nil {
+		s.blobs = make(map[string][]byte)
+	}
+	s.blobs[key] = b
+	return nil
+}
+
+func (s *fakeBlobStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.blobs[key]
+	if !ok {
+		return nil, ex.AttachmentNotFound
+	}
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
+
+func (s *fakeBlobStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.blobs, key)
+	return nil
+}
+
+type fakePublisher struct {
+	mu     sync.Mutex
+	events map[string][]interface{}
+}
+
+func (p *fakePublisher) Publish(_ context.Context, topic string, event interface{}) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.events == nil {
+		p.events = make(map[string][]interface{})
+	}
+	p.events[topic] = append(p.events[topic], event)
+	return nil
+}
+
+type fakeInsuranceRepo struct {
+	InsuranceRepo
+	policies map[int64]*InsurancePolicy
+}
+
+func (r *fakeInsuranceRepo) ListExpiring(_ context.Context, from, to time.Time) ([]*InsurancePolicyReply, error) {
+	list := make([]*InsurancePolicyReply, 0)
+	for id := range r.policies {
+		p, _ := r.GetById(context.Background(), id)
+		if !p.EndDate.Before(from) && !p.EndDate.After(to) && r.policies[id].RemindedAt == nil {
+			list = append(list, p)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeInsuranceRepo) GetById(_ context.Context, id int64) (*InsurancePolicyReply, error) {
+	p, ok := r.policies[id]
+	if !ok {
+		return nil, ex.InsurancePolicyNotFound
+	}
+	return &InsurancePolicyReply{Id: p.ID, CarId: *p.CarID, EndDate: *p.EndDate}, nil
+}
+
+// Update 与SetInsurancePolicy一致，只更新非nil字段
+func (r *fakeInsuranceRepo) Update(_ context.Context, p *InsurancePolicy) error {
+	cur, ok := r.policies[p.ID]
+	if !ok {
+		return nil
+	}
+	if p.EndDate != nil {
+		cur.EndDate = p.EndDate
+	}
+	if p.Premium != nil {
+		cur.Premium = p.Premium
+	}
+	if p.RemindedAt != nil {
+		cur.RemindedAt = p.RemindedAt
+	}
+	return nil
+}
+
+func (r *fakeInsuranceRepo) ClearReminded(_ context.Context, id int64) error {
+	if p, ok := r.policies[id]; ok {
+		p.RemindedAt = nil
+	}
+	return nil
+}
+
+type fakeOdometerRepo struct {
+	OdometerRepo
+	readings []*OdometerReadingReply
+}
+
+// GetNeighbours 与数据层一致，不含疑似调表的读数
+func (r *fakeOdometerRepo) GetNeighbours(_ context.Context, carId int64, at time.Time) (prev, next *OdometerReadingReply, err error) {
+	for _, o := range r.readings {
+		if o.CarId != carId || o.Suspicious {
+			continue
+		}
+		if !o.RecordedAt.After(at) {
+			if prev == nil || o.RecordedAt.After(prev.RecordedAt) {
+				prev = o
+			}
+		} else if next == nil || o.RecordedAt.Before(next.RecordedAt) {
+			next = o
+		}
+	}
+	return prev, next, nil
+}
+
+// fakeLocker 已持有的锁不能再次取得，不会过期
+type fakeLocker struct {
+	held map[string]time.Duration
+	err  error
+}
+
+func (l *fakeLocker) TryLock(_ context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	if l.err != nil {
+		return nil, false, l.err
+	}
+	if _, ok := l.held[key]; ok {
+		return nil, false, nil
+	}
+	l.held[key] = ttl
+	return func() { delete(l.held, key) }, true, nil
+}
+
+type fakeJobRunRepo struct {
+	JobRunRepo
+	runs    map[int64]*JobRunReply
+	saveErr error
+}
+
+func (r *fakeJobRunRepo) Save(_ context.Context, j *JobRun) (int64, error) {
+	if r.saveErr != nil {
+		return 0, r.saveErr
+	}
+	id := int64(len(r.runs) + 1)
+	r.runs[id] = &JobRunReply{Id: id, Name: j.Name, ScheduledAt: j.ScheduledAt, Status: *j.Status, Instance: *j.Instance}
+	return id, nil
+}
+
+func (r *fakeJobRunRepo) Finish(_ context.Context, id int64, status, errMsg string, _ time.Time) error {
+	j := r.runs[id]
+	j.Status, j.Error = status, errMsg
+	return nil
+}
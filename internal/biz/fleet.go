@@ -0,0 +1,150 @@
+package biz
+
+import (
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+)
+
+type Fleet struct {
+	ID          int64
+	TenantID    *int64
+	Name        string
+	Description *string
+	CreatedAt   *time.Time
+}
+
+type FleetReply struct {
+	Id          int64
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+type Tag struct {
+	ID       int64
+	TenantID *int64
+	Name     string
+}
+
+type TagReply struct {
+	Id   int64
+	Name string
+}
+
+type FleetRepo interface {
+	ListFleet(ctx context.Context, page, pageSize int) ([]*FleetReply, int, error)
+	GetFleetById(ctx context.Context, id int64) (*FleetReply, error)
+	SaveFleet(context.Context, *Fleet) (int64, error)
+	UpdateFleet(context.Context, *Fleet) error
+	DeleteFleet(ctx context.Context, id int64) error
+	// AddFleetCars 已在车队中的汽车忽略
+	AddFleetCars(ctx context.Context, fleetId int64, carIds []int64) error
+	RemoveFleetCars(ctx context.Context, fleetId int64, carIds []int64) error
+	ListTag(ctx context.Context) ([]*TagReply, error)
+	// TagCar 不存在的标签自动创建
+	TagCar(ctx context.Context, carId int64, names []string) error
+	UntagCar(ctx context.Context, carId int64, names []string) error
+}
+
+type FleetUseCase struct {
+	r   FleetRepo
+	log *log.Helper
+}
+
+func NewFleetUseCase(r FleetRepo, logger log.Logger) *FleetUseCase {
+	return &FleetUseCase{r: r, log: log.NewHelper(logger)}
+}
+
+func (uc *FleetUseCase) ListFleet(ctx context.Context, page, pageSize int) ([]*FleetReply, int, error) {
+	return uc.r.ListFleet(ctx, page, pageSize)
+}
+
+func (uc *FleetUseCase) GetFleetById(ctx context.Context, id int64) (*FleetReply, error) {
+	return uc.r.GetFleetById(ctx, id)
+}
+
+func (uc *FleetUseCase) SaveFleet(ctx context.Context, f *Fleet) error {
+	f.Name = strings.TrimSpace(f.Name)
+	if f.Name == "" {
+		return ex.FleetNameRequired
+	}
+	_, err := uc.r.SaveFleet(ctx, f)
+	return err
+}
+
+func (uc *FleetUseCase) UpdateFleet(ctx context.Context, f *Fleet) error {
+	f.Name = strings.TrimSpace(f.Name)
+	if f.Name == "" {
+		return ex.FleetNameRequired
+	}
+	return uc.r.UpdateFleet(ctx, f)
+}
+
+func (uc *FleetUseCase) DeleteFleet(ctx context.Context, id int64) error {
+	return uc.r.DeleteFleet(ctx, id)
+}
+
+func (uc *FleetUseCase) AddFleetCars(ctx context.Context, fleetId int64, carIds []int64) error {
+	if len(carIds) == 0 {
+		return ex.CarIdRequired
+	}
+	return uc.r.AddFleetCars(ctx, fleetId, uniqueIds(carIds))
+}
+
+func (uc *FleetUseCase) RemoveFleetCars(ctx context.Context, fleetId int64, carIds []int64) error {
+	if len(carIds) == 0 {
+		return ex.CarIdRequired
+	}
+	return uc.r.RemoveFleetCars(ctx, fleetId, uniqueIds(carIds))
+}
+
+func (uc *FleetUseCase) ListTag(ctx context.Context) ([]*TagReply, error) {
+	return uc.r.ListTag(ctx)
+}
+
+func (uc *FleetUseCase) TagCar(ctx context.Context, carId int64, names []string) error {
+	names = normalizeTags(names)
+	if len(names) == 0 {
+		return ex.TagNameRequired
+	}
+	return uc.r.TagCar(ctx, carId, names)
+}
+
+func (uc *FleetUseCase) UntagCar(ctx context.Context, carId int64, names []string) error {
+	names = normalizeTags(names)
+	if len(names) == 0 {
+		return ex.TagNameRequired
+	}
+	return uc.r.UntagCar(ctx, carId, names)
+}
+
+// normalizeTags 去除首尾空格、空标签及重复标签
+func normalizeTags(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	list := make([]string, 0, len(names))
+	for _, n := range names {
+		n = strings.TrimSpace(n)
+		if n == "" || seen[n] {
+			continue
+		}
+		seen[n] = true
+		list = append(list, n)
+	}
+	return list
+}
+
+func uniqueIds(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	list := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		list = append(list, id)
+	}
+	return list
+}
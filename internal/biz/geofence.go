@@ -0,0 +1,470 @@
+package biz
+
+import (
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+const TopicGeofenceAlert = "car.geofence.alert"
+
+// 围栏告警类型
+const (
+	GeofenceAlertEnter = "enter"
+	GeofenceAlertExit  = "exit"
+	GeofenceAlertBoth  = "both"
+)
+
+const geofenceClockLayout = "15:04"
+
+// 围栏内外状态，GeofenceStateUnknown表示尚无该汽车的状态，GeofenceStateStale表示位置早于已记录的状态
+const (
+	GeofenceStateUnknown = iota
+	GeofenceStateInside
+	GeofenceStateOutside
+	GeofenceStateStale
+)
+
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Valid 经纬度是否在有效范围内
+func (p GeoPoint) Valid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180
+}
+
+// Polygon 多边形围栏，顶点按顺序首尾相连，相邻顶点经度差超过180度的边视为跨越180度经线
+type Polygon []GeoPoint
+
+// Valid 至少3个坐标有效的顶点，跨越180度经线的多边形展开后各边经度差仍不能超过180度，即不能环绕极点
+func (pg Polygon) Valid() bool {
+	if len(pg) < 3 {
+		return false
+	}
+	for _, v := range pg {
+		if !v.Valid() {
+			return false
+		}
+	}
+	pg, _ = pg.unwrap()
+	for i, j := 0, len(pg)-1; i < len(pg); j, i = i, i+1 {
+		if math.Abs(pg[i].Lng-pg[j].Lng) > 180 {
+			return false
+		}
+	}
+	return true
+}
+
+// unwrap 跨越180度经线的多边形将西经顶点加360度，使经度连续，返回展开后的多边形及是否展开
+func (pg Polygon) unwrap() (Polygon, bool) {
+	crosses := false
+	for i, j := 0, len(pg)-1; i < len(pg); j, i = i, i+1 {
+		if math.Abs(pg[i].Lng-pg[j].Lng) > 180 {
+			crosses = true
+			break
+		}
+	}
+	if !crosses {
+		return pg, false
+	}
+	out := make(Polygon, len(pg))
+	for i, v := range pg {
+		if v.Lng < 0 {
+			v.Lng += 360
+		}
+		out[i] = v
+	}
+	return out, true
+}
+
+// Contains 射线法判断点是否在多边形内，落在边上视为在内
+func (pg Polygon) Contains(p GeoPoint) bool {
+	if len(pg) < 3 {
+		return false
+	}
+	// 跨越180度经线时点按同样方式展开
+	pg, unwrapped := pg.unwrap()
+	if unwrapped && p.Lng < 0 {
+		p.Lng += 360
+	}
+	// 先用外接矩形快速排除
+	minLat, maxLat, minLng, maxLng := pg[0].Lat, pg[0].Lat, pg[0].Lng, pg[0].Lng
+	for _, v := range pg[1:] {
+		if v.Lat < minLat {
+			minLat = v.Lat
+		}
+		if v.Lat > maxLat {
+			maxLat = v.Lat
+		}
+		if v.Lng < minLng {
+			minLng = v.Lng
+		}
+		if v.Lng > maxLng {
+			maxLng = v.Lng
+		}
+	}
+	if p.Lat < minLat || p.Lat > maxLat || p.Lng < minLng || p.Lng > maxLng {
+		return false
+	}
+
+	inside := false
+	for i, j := 0, len(pg)-1; i < len(pg); j, i = i, i+1 {
+		a, b := pg[i], pg[j]
+		if onSegment(p, a, b) {
+			return true
+		}
+		// 以经度为x、纬度为y，统计向右的水平射线与各边的交点数
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) {
+			x := (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat) + a.Lng
+			if p.Lng < x {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// onSegment 点是否落在线段ab上
+func onSegment(p, a, b GeoPoint) bool {
+	cross := (b.Lng-a.Lng)*(p.Lat-a.Lat) - (b.Lat-a.Lat)*(p.Lng-a.Lng)
+	if cross != 0 {
+		return false
+	}
+	return p.Lng >= minFloat(a.Lng, b.Lng) && p.Lng <= maxFloat(a.Lng, b.Lng) &&
+		p.Lat >= minFloat(a.Lat, b.Lat) && p.Lat <= maxFloat(a.Lat, b.Lat)
+}
+
+// GeofenceSchedule 围栏生效时间，Days为空表示每天，Start或End为空表示全天，
+// 星期和时段按TimeZone（IANA时区名）计算，为空时使用服务器时区
+type GeofenceSchedule struct {
+	Days     []int
+	Start    string
+	End      string
+	TimeZone string
+}
+
+// Active 指定时间围栏是否生效，跨零点的时段零点后归属前一天
+func (s *GeofenceSchedule) Active(t time.Time) bool {
+	if s.TimeZone != "" {
+		// 时区已在保存时校验
+		if loc, err := loadLocation(s.TimeZone); err == nil {
+			t = t.In(loc)
+		}
+	}
+	if s.Start == "" || s.End == "" {
+		return s.onDay(t.Weekday())
+	}
+	start, _ := time.Parse(geofenceClockLayout, s.Start)
+	end, _ := time.Parse(geofenceClockLayout, s.End)
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+	m := t.Hour()*60 + t.Minute()
+
+	if from <= to {
+		return m >= from && m < to && s.onDay(t.Weekday())
+	}
+	if m >= from {
+		return s.onDay(t.Weekday())
+	}
+	if m < to {
+		return s.onDay((t.Weekday() + 6) % 7)
+	}
+	return false
+}
+
+// geofenceLocations 已加载的时区，避免每次判断都读取时区数据
+var geofenceLocations sync.Map
+
+func loadLocation(name string) (*time.Location, error) {
+	if v, ok := geofenceLocations.Load(name); ok {
+		return v.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	geofenceLocations.Store(name, loc)
+	return loc, nil
+}
+
+func (s *GeofenceSchedule) onDay(d time.Weekday) bool {
+	if len(s.Days) == 0 {
+		return true
+	}
+	for _, v := range s.Days {
+		if v == int(d) {
+			return true
+		}
+	}
+	return false
+}
+
+type Geofence struct {
+	ID        int64
+	TenantID  *int64
+	Name      string
+	Polygon   *string
+	Days      *string
+	StartTime *string
+	EndTime   *string
+	TimeZone  *string
+	AlertOn   *string
+	Enabled   *bool
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+type GeofenceReply struct {
+	Id        int64
+	Name      string
+	Polygon   Polygon
+	Schedule  GeofenceSchedule
+	AlertOn   string
+	Enabled   bool
+	CarIds    []int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Alerts 该类型的进出是否需要告警
+func (g *GeofenceReply) Alerts(kind string) bool {
+	return g.AlertOn == GeofenceAlertBoth || g.AlertOn == kind
+}
+
+// GeofenceInput 新建或修改围栏的参数，修改时按整体覆盖
+type GeofenceInput struct {
+	Id       int64
+	Name     string
+	Polygon  Polygon
+	Schedule GeofenceSchedule
+	AlertOn  *string
+	Enabled  *bool
+	CarIds   []int64
+}
+
+// GeofenceEvent 汽车进出围栏的告警事件
+type GeofenceEvent struct {
+	FenceId    int64     `json:"fence_id"`
+	FenceName  string    `json:"fence_name"`
+	CarId      int64     `json:"car_id"`
+	Type       string    `json:"type"`
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+type GeofenceRepo interface {
+	ListGeofence(ctx context.Context, page, pageSize int) ([]*GeofenceReply, int, error)
+	GetById(ctx context.Context, id int64) (*GeofenceReply, error)
+	// ListByCar 查询汽车关联的已启用围栏
+	ListByCar(ctx context.Context, carId int64) ([]*GeofenceReply, error)
+	// Save 及 Update 同时覆盖关联的汽车，支持事务
+	Save(ctx context.Context, g *Geofence, carIds []int64) (int64, error)
+	Update(ctx context.Context, g *Geofence, carIds []int64) error
+	Delete(ctx context.Context, id int64) error
+	// SwapState 记录汽车在围栏内外的状态并返回之前的状态，位置早于已记录状态时不更新
+	SwapState(ctx context.Context, carId, fenceId int64, inside bool, at time.Time) (int, error)
+}
+
+type GeofenceUseCase struct {
+	r   GeofenceRepo
+	cr  CarRepo
+	tr  TelemetryRepo
+	pub EventPublisher
+	tx  Transaction
+	log *log.Helper
+}
+
+func NewGeofenceUseCase(r GeofenceRepo, cr CarRepo, tr TelemetryRepo, pub EventPublisher,
+	tx Transaction, logger log.Logger) *GeofenceUseCase {
+	return &GeofenceUseCase{r: r, cr: cr, tr: tr, pub: pub, tx: tx, log: log.NewHelper(logger)}
+}
+
+func (uc *GeofenceUseCase) ListGeofence(ctx context.Context, page, pageSize int) ([]*GeofenceReply, int, error) {
+	return uc.r.ListGeofence(ctx, page, pageSize)
+}
+
+func (uc *GeofenceUseCase) GetGeofenceById(ctx context.Context, id int64) (*GeofenceReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+func (uc *GeofenceUseCase) SaveGeofence(ctx context.Context, in *GeofenceInput) (int64, error) {
+	g, err := fillGeofence(in)
+	if err != nil {
+		return 0, err
+	}
+	var id int64
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		id, err = uc.r.Save(ctx, g, uniqueIds(in.CarIds))
+		return err
+	})
+	return id, err
+}
+
+func (uc *GeofenceUseCase) UpdateGeofence(ctx context.Context, in *GeofenceInput) error {
+	if _, err := uc.r.GetById(ctx, in.Id); err != nil {
+		return err
+	}
+	g, err := fillGeofence(in)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	g.UpdatedAt = &now
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		return uc.r.Update(ctx, g, uniqueIds(in.CarIds))
+	})
+}
+
+func (uc *GeofenceUseCase) DeleteGeofence(ctx context.Context, id int64) error {
+	return uc.r.Delete(ctx, id)
+}
+
+// ReportPosition 接收汽车位置，逐个判断关联围栏的内外状态，状态变化且在生效时间内时发布告警；
+// 首次判断的围栏只记录状态，不告警
+func (uc *GeofenceUseCase) ReportPosition(ctx context.Context, carId int64, p GeoPoint, at *time.Time) ([]*GeofenceEvent, error) {
+	if !p.Valid() {
+		return nil, ex.InvalidCoordinate
+	}
+	now := time.Now()
+	if at == nil {
+		at = &now
+	}
+	if at.After(now) {
+		return nil, ex.InvalidPositionTime
+	}
+	c, err := uc.cr.GetById(ctx, carId)
+	if err != nil {
+		return nil, err
+	}
+	// 位置同时作为汽车的最后位置
+	err = uc.tr.SetLastLocation(ctx, []*TelemetryPoint{{
+		TenantId:   c.TenantId,
+		CarId:      c.Id,
+		RecordedAt: *at,
+		Lat:        p.Lat,
+		Lng:        p.Lng,
+	}})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("更新汽车最后位置失败: %v", err)
+	}
+
+	fences, err := uc.r.ListByCar(ctx, c.Id)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*GeofenceEvent, 0)
+	for _, f := range fences {
+		inside := f.Polygon.Contains(p)
+		prev, err := uc.r.SwapState(ctx, c.Id, f.Id, inside, *at)
+		if err != nil {
+			return nil, err
+		}
+		if prev == GeofenceStateUnknown || prev == GeofenceStateStale || (prev == GeofenceStateInside) == inside {
+			continue
+		}
+		kind := GeofenceAlertExit
+		if inside {
+			kind = GeofenceAlertEnter
+		}
+		if !f.Alerts(kind) || !f.Schedule.Active(*at) {
+			continue
+		}
+		e := &GeofenceEvent{
+			FenceId:    f.Id,
+			FenceName:  f.Name,
+			CarId:      c.Id,
+			Type:       kind,
+			Lat:        p.Lat,
+			Lng:        p.Lng,
+			RecordedAt: *at,
+		}
+		if err := uc.pub.Publish(ctx, TopicGeofenceAlert, e); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// fillGeofence 校验围栏参数并序列化多边形及生效星期
+func fillGeofence(in *GeofenceInput) (*Geofence, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, ex.GeofenceNameRequired
+	}
+	if !in.Polygon.Valid() {
+		return nil, ex.InvalidPolygon
+	}
+	s := in.Schedule
+	if (s.Start == "") != (s.End == "") {
+		return nil, ex.InvalidGeofenceSchedule
+	}
+	if s.Start != "" {
+		start, err := time.Parse(geofenceClockLayout, s.Start)
+		if err != nil {
+			return nil, ex.InvalidGeofenceSchedule
+		}
+		end, err := time.Parse(geofenceClockLayout, s.End)
+		if err != nil || start.Equal(end) {
+			return nil, ex.InvalidGeofenceSchedule
+		}
+	}
+	for _, d := range s.Days {
+		if d < 0 || d > 6 {
+			return nil, ex.InvalidGeofenceSchedule
+		}
+	}
+	if s.TimeZone != "" {
+		if _, err := loadLocation(s.TimeZone); err != nil {
+			return nil, ex.InvalidGeofenceTimeZone
+		}
+	}
+	if in.AlertOn != nil {
+		switch *in.AlertOn {
+		case GeofenceAlertEnter, GeofenceAlertExit, GeofenceAlertBoth:
+		default:
+			return nil, ex.InvalidGeofenceAlert
+		}
+	}
+
+	b, _ := json.Marshal(in.Polygon)
+	polygon := string(b)
+	days := ""
+	if len(s.Days) > 0 {
+		b, _ = json.Marshal(s.Days)
+		days = string(b)
+	}
+	return &Geofence{
+		ID:        in.Id,
+		Name:      name,
+		Polygon:   &polygon,
+		Days:      &days,
+		StartTime: &s.Start,
+		EndTime:   &s.End,
+		TimeZone:  &s.TimeZone,
+		AlertOn:   in.AlertOn,
+		Enabled:   in.Enabled,
+	}, nil
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolygonContains(t *testing.T) {
+	square := Polygon{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 10}, {Lat: 10, Lng: 10}, {Lat: 10, Lng: 0}}
+	diamond := Polygon{{Lat: 0, Lng: 5}, {Lat: 5, Lng: 10}, {Lat: 10, Lng: 5}, {Lat: 5, Lng: 0}}
+	// U形，缺口为经度4到6、纬度4到10
+	concave := Polygon{
+		{Lat: 0, Lng: 0}, {Lat: 0, Lng: 10}, {Lat: 10, Lng: 10}, {Lat: 10, Lng: 6},
+		{Lat: 4, Lng: 6}, {Lat: 4, Lng: 4}, {Lat: 10, Lng: 4}, {Lat: 10, Lng: 0},
+	}
+	// 跨越180度经线，东经170到西经170
+	antimeridian := Polygon{{Lat: 0, Lng: 170}, {Lat: 0, Lng: -170}, {Lat: 10, Lng: -170}, {Lat: 10, Lng: 170}}
+
+	tests := []struct {
+		name string
+		pg   Polygon
+		p    GeoPoint
+		want bool
+	}{
+		{"square inside", square, GeoPoint{Lat: 5, Lng: 5}, true},
+		{"square outside", square, GeoPoint{Lat: 5, Lng: 11}, false},
+		{"square vertex", square, GeoPoint{Lat: 10, Lng: 10}, true},
+		{"square edge", square, GeoPoint{Lat: 0, Lng: 3}, true},
+		{"square left edge", square, GeoPoint{Lat: 7, Lng: 0}, true},
+		{"ray through vertex inside", diamond, GeoPoint{Lat: 5, Lng: 2}, true},
+		{"ray through vertex outside", diamond, GeoPoint{Lat: 0, Lng: 1}, false},
+		{"diamond vertex", diamond, GeoPoint{Lat: 5, Lng: 0}, true},
+		{"diamond corner of bounding box", diamond, GeoPoint{Lat: 1, Lng: 1}, false},
+		{"concave arm", concave, GeoPoint{Lat: 8, Lng: 2}, true},
+		{"concave notch", concave, GeoPoint{Lat: 8, Lng: 5}, false},
+		{"concave base", concave, GeoPoint{Lat: 2, Lng: 5}, true},
+		{"concave notch edge", concave, GeoPoint{Lat: 4, Lng: 5}, true},
+		{"concave notch vertex", concave, GeoPoint{Lat: 4, Lng: 6}, true},
+		{"antimeridian east side", antimeridian, GeoPoint{Lat: 5, Lng: 175}, true},
+		{"antimeridian west side", antimeridian, GeoPoint{Lat: 5, Lng: -175}, true},
+		{"antimeridian on 180", antimeridian, GeoPoint{Lat: 5, Lng: 180}, true},
+		{"antimeridian on -180", antimeridian, GeoPoint{Lat: 5, Lng: -180}, true},
+		{"antimeridian outside east", antimeridian, GeoPoint{Lat: 5, Lng: 165}, false},
+		{"antimeridian outside west", antimeridian, GeoPoint{Lat: 5, Lng: -165}, false},
+		{"antimeridian prime meridian", antimeridian, GeoPoint{Lat: 5, Lng: 0}, false},
+		{"too few vertices", square[:2], GeoPoint{Lat: 0, Lng: 5}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pg.Contains(tt.p); got != tt.want {
+				t.Errorf("Contains(%v) = %v, want %v", tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolygonValid(t *testing.T) {
+	tests := []struct {
+		name string
+		pg   Polygon
+		want bool
+	}{
+		{"triangle", Polygon{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 10}, {Lat: 10, Lng: 0}}, true},
+		{"antimeridian", Polygon{{Lat: 0, Lng: 170}, {Lat: 0, Lng: -170}, {Lat: 10, Lng: -170}, {Lat: 10, Lng: 170}}, true},
+		{"too few vertices", Polygon{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 10}}, false},
+		{"invalid coordinate", Polygon{{Lat: 0, Lng: 0}, {Lat: 91, Lng: 10}, {Lat: 10, Lng: 0}}, false},
+		{"encircles pole", Polygon{{Lat: 80, Lng: 0}, {Lat: 80, Lng: 120}, {Lat: 80, Lng: -120}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pg.Valid(); got != tt.want {
+				t.Errorf("Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeofenceScheduleActive(t *testing.T) {
+	// 2026-10-19为周一
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name string
+		s    GeofenceSchedule
+		t    time.Time
+		want bool
+	}{
+		{"all day every day", GeofenceSchedule{}, at(19, 3, 0), true},
+		{"all day on listed day", GeofenceSchedule{Days: []int{1}}, at(19, 23, 59), true},
+		{"all day on other day", GeofenceSchedule{Days: []int{1}}, at(20, 0, 0), false},
+		{"before window", GeofenceSchedule{Start: "09:00", End: "18:00"}, at(19, 8, 59), false},
+		{"window start", GeofenceSchedule{Start: "09:00", End: "18:00"}, at(19, 9, 0), true},
+		{"window end exclusive", GeofenceSchedule{Start: "09:00", End: "18:00"}, at(19, 18, 0), false},
+		{"window on other day", GeofenceSchedule{Days: []int{2}, Start: "09:00", End: "18:00"}, at(19, 10, 0), false},
+		{"overnight before midnight", GeofenceSchedule{Days: []int{5}, Start: "22:00", End: "06:00"}, at(23, 23, 0), true},
+		{"overnight after midnight", GeofenceSchedule{Days: []int{5}, Start: "22:00", End: "06:00"}, at(24, 2, 0), true},
+		{"overnight end exclusive", GeofenceSchedule{Days: []int{5}, Start: "22:00", End: "06:00"}, at(24, 6, 0), false},
+		{"overnight gap", GeofenceSchedule{Start: "22:00", End: "06:00"}, at(19, 12, 0), false},
+		{"overnight next evening", GeofenceSchedule{Days: []int{5}, Start: "22:00", End: "06:00"}, at(24, 23, 0), false},
+		{"overnight belongs to previous day", GeofenceSchedule{Days: []int{5}, Start: "22:00", End: "06:00"}, at(23, 2, 0), false},
+		{"overnight saturday into sunday", GeofenceSchedule{Days: []int{6}, Start: "22:00", End: "06:00"}, at(25, 1, 0), true},
+		{"time zone window", GeofenceSchedule{Start: "09:00", End: "18:00", TimeZone: "Asia/Shanghai"}, at(19, 2, 0), true},
+		{"time zone outside window", GeofenceSchedule{Start: "09:00", End: "18:00", TimeZone: "Asia/Shanghai"}, at(19, 11, 0), false},
+		{"time zone shifts day", GeofenceSchedule{Days: []int{2}, TimeZone: "Asia/Shanghai"}, at(19, 20, 0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Active(tt.t); got != tt.want {
+				t.Errorf("Active(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package biz
+
+import (
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+)
+
+// 事故严重程度
+const (
+	IncidentSeverityMinor     = "minor"
+	IncidentSeverityModerate  = "moderate"
+	IncidentSeveritySevere    = "severe"
+	IncidentSeverityTotalLoss = "total_loss"
+)
+
+// 事故处理状态
+const (
+	IncidentStatusReported      = "reported"
+	IncidentStatusAssessing     = "assessing"
+	IncidentStatusClaimFiled    = "claim_filed"
+	IncidentStatusClaimApproved = "claim_approved"
+	IncidentStatusClaimRejected = "claim_rejected"
+	IncidentStatusSettled       = "settled"
+	IncidentStatusClosed        = "closed"
+)
+
+// incidentTransitions 事故处理状态流转，已结案和已关闭为终态
+var incidentTransitions = map[string][]string{
+	IncidentStatusReported:      {IncidentStatusAssessing, IncidentStatusClosed},
+	IncidentStatusAssessing:     {IncidentStatusClaimFiled, IncidentStatusClosed},
+	IncidentStatusClaimFiled:    {IncidentStatusClaimApproved, IncidentStatusClaimRejected},
+	IncidentStatusClaimApproved: {IncidentStatusSettled},
+	IncidentStatusClaimRejected: {IncidentStatusClosed},
+}
+
+type Incident struct {
+	ID            int64
+	TenantID      *int64
+	CarID         *int64
+	ReporterID    *int64
+	OccurredAt    *time.Time
+	Location      *string
+	Severity      *string
+	Description   *string
+	PhotoIds      *string
+	ThirdParties  *string
+	Status        *string
+	PolicyID      *int64
+	ClaimNo       *string
+	ClaimAmount   *float64
+	SettledAmount *float64
+	SettledAt     *time.Time
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
+
+// ThirdParty 事故涉及的第三方
+type ThirdParty struct {
+	Name    string `json:"name"`
+	Phone   string `json:"phone,omitempty"`
+	Plate   string `json:"plate,omitempty"`
+	Insurer string `json:"insurer,omitempty"`
+}
+
+type IncidentReply struct {
+	Id            int64
+	CarId         int64
+	ReporterId    int64
+	OccurredAt    time.Time
+	Location      string
+	Severity      string
+	Description   string
+	PhotoIds      []int64
+	ThirdParties  []*ThirdParty
+	Status        string
+	PolicyId      int64
+	ClaimNo       string
+	ClaimAmount   float64
+	SettledAmount float64
+	SettledAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type IncidentFilter struct {
+	CarId  *int64
+	Status *string
+}
+
+// IncidentTransition 状态变更及该状态需要补充的理赔信息
+type IncidentTransition struct {
+	Status        string
+	ClaimNo       *string
+	ClaimAmount   *float64
+	SettledAmount *float64
+}
+
+type IncidentRepo interface {
+	ListIncident(ctx context.Context, page, pageSize int, filter *IncidentFilter) ([]*IncidentReply, int, error)
+	GetById(ctx context.Context, id int64) (*IncidentReply, error)
+	Save(context.Context, *Incident) (int64, error)
+	// Transit 仅当状态仍为from时更新，否则返回状态冲突
+	Transit(ctx context.Context, i *Incident, from string) error
+}
+
+type IncidentUseCase struct {
+	r   IncidentRepo
+	cr  CarRepo
+	ar  AttachmentRepo
+	ir  InsuranceRepo
+	log *log.Helper
+}
+
+func NewIncidentUseCase(r IncidentRepo, cr CarRepo, ar AttachmentRepo, ir InsuranceRepo, logger log.Logger) *IncidentUseCase {
+	return &IncidentUseCase{r: r, cr: cr, ar: ar, ir: ir, log: log.NewHelper(logger)}
+}
+
+// ListIncident 按汽车查询事故历史
+func (uc *IncidentUseCase) ListIncident(ctx context.Context,
+	page, pageSize int, filter *IncidentFilter) ([]*IncidentReply, int, error) {
+	return uc.r.ListIncident(ctx, page, pageSize, filter)
+}
+
+func (uc *IncidentUseCase) GetIncidentById(ctx context.Context, id int64) (*IncidentReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+// ReportIncident 上报事故，照片须为该汽车的附件，可同时关联保单
+func (uc *IncidentUseCase) ReportIncident(ctx context.Context, i *Incident, photoIds []int64, thirdParties []*ThirdParty) (int64, error) {
+	if i.CarID == nil {
+		return 0, ex.CarIdRequired
+	}
+	if i.OccurredAt == nil || i.OccurredAt.After(time.Now()) {
+		return 0, ex.InvalidIncidentTime
+	}
+	switch {
+	case i.Severity == nil:
+		return 0, ex.InvalidIncidentSeverity
+	case *i.Severity == IncidentSeverityMinor, *i.Severity == IncidentSeverityModerate,
+		*i.Severity == IncidentSeveritySevere, *i.Severity == IncidentSeverityTotalLoss:
+	default:
+		return 0, ex.InvalidIncidentSeverity
+	}
+	c, err := uc.cr.GetById(ctx, *i.CarID)
+	if err != nil {
+		return 0, err
+	}
+
+	photoIds = uniqueIds(photoIds)
+	for _, id := range photoIds {
+		a, err := uc.ar.GetById(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		if a.CarId != c.Id {
+			return 0, ex.IncidentPhotoMismatch
+		}
+	}
+	parties := make([]*ThirdParty, 0, len(thirdParties))
+	for _, p := range thirdParties {
+		p.Name = strings.TrimSpace(p.Name)
+		if p.Name == "" {
+			return 0, ex.ThirdPartyNameRequired
+		}
+		parties = append(parties, p)
+	}
+	if i.PolicyID != nil && *i.PolicyID <= 0 {
+		i.PolicyID = nil
+	}
+	if i.PolicyID != nil {
+		if err := uc.checkPolicy(ctx, c.Id, *i.OccurredAt, *i.PolicyID); err != nil {
+			return 0, err
+		}
+	}
+
+	b, _ := json.Marshal(photoIds)
+	photos := string(b)
+	b, _ = json.Marshal(parties)
+	parts := string(b)
+	i.PhotoIds = &photos
+	i.ThirdParties = &parts
+	i.TenantID = &c.TenantId
+	if actor, ok := auth.GetUserId(ctx); ok {
+		i.ReporterID = &actor
+	}
+	return uc.r.Save(ctx, i)
+}
+
+// LinkInsurancePolicy 关联保单，保单须属于该汽车且在事故发生时有效，提交理赔后不可修改
+func (uc *IncidentUseCase) LinkInsurancePolicy(ctx context.Context, id, policyId int64) error {
+	i, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if i.Status != IncidentStatusReported && i.Status != IncidentStatusAssessing {
+		return ex.IncidentStatusConflict
+	}
+	if err := uc.checkPolicy(ctx, i.CarId, i.OccurredAt, policyId); err != nil {
+		return err
+	}
+	now := time.Now()
+	return uc.r.Transit(ctx, &Incident{ID: id, PolicyID: &policyId, UpdatedAt: &now}, i.Status)
+}
+
+// UpdateIncidentStatus 推进事故处理状态：提交理赔需已关联保单及理赔单号，结案需理赔金额
+func (uc *IncidentUseCase) UpdateIncidentStatus(ctx context.Context, id int64, t *IncidentTransition) error {
+	i, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	allowed := false
+	for _, s := range incidentTransitions[i.Status] {
+		if s == t.Status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ex.IncidentStatusConflict
+	}
+
+	now := time.Now()
+	update := &Incident{ID: id, Status: &t.Status, UpdatedAt: &now}
+	switch t.Status {
+	case IncidentStatusClaimFiled:
+		if i.PolicyId == 0 {
+			return ex.IncidentPolicyRequired
+		}
+		if t.ClaimNo == nil || strings.TrimSpace(*t.ClaimNo) == "" {
+			return ex.ClaimNoRequired
+		}
+		if t.ClaimAmount != nil && *t.ClaimAmount < 0 {
+			return ex.InvalidClaimAmount
+		}
+		update.ClaimNo = t.ClaimNo
+		update.ClaimAmount = t.ClaimAmount
+	case IncidentStatusSettled:
+		if t.SettledAmount == nil || *t.SettledAmount < 0 {
+			return ex.InvalidClaimAmount
+		}
+		update.SettledAmount = t.SettledAmount
+		update.SettledAt = &now
+	}
+	return uc.r.Transit(ctx, update, i.Status)
+}
+
+func (uc *IncidentUseCase) checkPolicy(ctx context.Context, carId int64, occurredAt time.Time, policyId int64) error {
+	p, err := uc.ir.GetById(ctx, policyId)
+	if err != nil {
+		return err
+	}
+	if p.CarId != carId || occurredAt.Before(p.StartDate) || occurredAt.After(p.EndDate) {
+		return ex.IncidentPolicyMismatch
+	}
+	return nil
+}
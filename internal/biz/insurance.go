@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"car-service/internal/conf"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+const TopicInsuranceExpiring = "car.insurance.expiring"
+
+type InsurancePolicy struct {
+	ID           int64
+	TenantID     *int64
+	CarID        *int64
+	Provider     *string
+	PolicyNumber *string
+	Coverage     *string
+	StartDate    *time.Time
+	EndDate      *time.Time
+	Premium      *float64
+	RemindedAt   *time.Time
+}
+
+type InsurancePolicyReply struct {
+	Id           int64
+	CarId        int64
+	Provider     string
+	PolicyNumber string
+	Coverage     string
+	StartDate    time.Time
+	EndDate      time.Time
+	Premium      float64
+}
+
+// InsuranceExpiringEvent 保险即将到期提醒
+type InsuranceExpiringEvent struct {
+	PolicyId     int64     `json:"policy_id"`
+	CarId        int64     `json:"car_id"`
+	Provider     string    `json:"provider"`
+	PolicyNumber string    `json:"policy_number"`
+	EndDate      time.Time `json:"end_date"`
+}
+
+type InsuranceRepo interface {
+	ListInsurancePolicy(ctx context.Context, page, pageSize int, carId *int64) ([]*InsurancePolicyReply, int, error)
+	ListExpiring(ctx context.Context, from, to time.Time) ([]*InsurancePolicyReply, error)
+	GetById(ctx context.Context, id int64) (*InsurancePolicyReply, error)
+	Save(context.Context, *InsurancePolicy) (int64, error)
+	Update(context.Context, *InsurancePolicy) error
+	// ClearReminded 清除提醒时间，保单到期前会再次提醒
+	ClearReminded(ctx context.Context, id int64) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type InsuranceUseCase struct {
+	r   InsuranceRepo
+	cr  CarRepo
+	pub EventPublisher
+	c   *conf.Insurance
+	log *log.Helper
+}
+
+func NewInsuranceUseCase(r InsuranceRepo, cr CarRepo, pub EventPublisher,
+	c *conf.Insurance, logger log.Logger) *InsuranceUseCase {
+	return &InsuranceUseCase{r: r, cr: cr, pub: pub, c: c, log: log.NewHelper(logger)}
+}
+
+func (uc *InsuranceUseCase) ListInsurancePolicy(ctx context.Context,
+	page, pageSize int, carId *int64) ([]*InsurancePolicyReply, int, error) {
+	return uc.r.ListInsurancePolicy(ctx, page, pageSize, carId)
+}
+
+func (uc *InsuranceUseCase) GetInsurancePolicyById(ctx context.Context, id int64) (*InsurancePolicyReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+func (uc *InsuranceUseCase) SaveInsurancePolicy(ctx context.Context, p *InsurancePolicy) error {
+	if p.CarID == nil {
+		return ex.CarIdRequired
+	}
+	if p.StartDate != nil && p.EndDate != nil && !p.EndDate.After(*p.StartDate) {
+		return ex.InvalidPolicyPeriod
+	}
+	// 校验汽车是否存在，保单与汽车属于同一租户
+	c, err := uc.cr.GetById(ctx, *p.CarID)
+	if err != nil {
+		return err
+	}
+	p.TenantID = &c.TenantId
+	_, err = uc.r.Save(ctx, p)
+	return err
+}
+
+// UpdateInsurancePolicy 到期日变更（如续保或延期）后清除提醒时间，按新的到期日重新提醒
+func (uc *InsuranceUseCase) UpdateInsurancePolicy(ctx context.Context, p *InsurancePolicy) error {
+	if p.CarID != nil {
+		c, err := uc.cr.GetById(ctx, *p.CarID)
+		if err != nil {
+			return err
+		}
+		p.TenantID = &c.TenantId
+	}
+	var endDateChanged bool
+	if p.EndDate != nil {
+		old, err := uc.r.GetById(ctx, p.ID)
+		if err != nil {
+			return err
+		}
+		endDateChanged = !p.EndDate.Equal(old.EndDate)
+	}
+	if err := uc.r.Update(ctx, p); err != nil {
+		return err
+	}
+	if endDateChanged {
+		return uc.r.ClearReminded(ctx, p.ID)
+	}
+	return nil
+}
+
+func (uc *InsuranceUseCase) DeleteInsurancePolicy(ctx context.Context, id int64) error {
+	return uc.r.Delete(ctx, id)
+}
+
+// RemindExpiring 查找N天内到期且尚未提醒过的保单，发送到期提醒事件，由定时任务调用
+func (uc *InsuranceUseCase) RemindExpiring(ctx context.Context) error {
+	now := time.Now()
+	policies, err := uc.r.ListExpiring(ctx, now, now.AddDate(0, 0, int(uc.c.GetRemindDays())))
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		err := uc.pub.Publish(ctx, TopicInsuranceExpiring, &InsuranceExpiringEvent{
+			PolicyId:     p.Id,
+			CarId:        p.CarId,
+			Provider:     p.Provider,
+			PolicyNumber: p.PolicyNumber,
+			EndDate:      p.EndDate,
+		})
+		if err != nil {
+			return err
+		}
+		// 标记已提醒，避免重复发送
+		if err := uc.r.Update(ctx, &InsurancePolicy{ID: p.Id, RemindedAt: &now}); err != nil {
+			return err
+		}
+	}
+	uc.log.WithContext(ctx).Infof("保险到期提醒完成，共%d条", len(policies))
+	return nil
+}
@@ -0,0 +1,65 @@
+package biz
+
+import (
+	"car-service/internal/conf"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestInsuranceRemindAfterUpdate(t *testing.T) {
+	now := time.Now()
+	endDate := now.AddDate(0, 0, 10)
+	date := func(t time.Time) *time.Time { return &t }
+	premium := 3000.0
+
+	tests := []struct {
+		name        string
+		update      *InsurancePolicy
+		wantCleared bool
+		// 更新后再次执行提醒时发送的事件数
+		wantReminders int
+	}{
+		{"end date extended within window", &InsurancePolicy{ID: 1, EndDate: date(endDate.AddDate(0, 0, 5))}, true, 1},
+		{"renewed beyond window", &InsurancePolicy{ID: 1, EndDate: date(endDate.AddDate(1, 0, 0))}, true, 0},
+		{"end date unchanged", &InsurancePolicy{ID: 1, EndDate: date(endDate)}, false, 0},
+		{"end date not given", &InsurancePolicy{ID: 1, Premium: &premium}, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			carId := int64(1)
+			r := &fakeInsuranceRepo{policies: map[int64]*InsurancePolicy{
+				1: {ID: 1, CarID: &carId, EndDate: date(endDate)},
+			}}
+			pub := &fakePublisher{}
+			uc := NewInsuranceUseCase(r, &fakeCarRepo{}, pub, &conf.Insurance{RemindDays: 30}, log.DefaultLogger)
+			ctx := context.Background()
+
+			if err := uc.RemindExpiring(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if n := len(pub.events[TopicInsuranceExpiring]); n != 1 {
+				t.Fatalf("first reminder sent %d events, want 1", n)
+			}
+			if r.policies[1].RemindedAt == nil {
+				t.Fatal("RemindedAt not set after reminder")
+			}
+
+			if err := uc.UpdateInsurancePolicy(ctx, tt.update); err != nil {
+				t.Fatal(err)
+			}
+			if cleared := r.policies[1].RemindedAt == nil; cleared != tt.wantCleared {
+				t.Errorf("RemindedAt cleared = %v, want %v", cleared, tt.wantCleared)
+			}
+
+			if err := uc.RemindExpiring(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if n := len(pub.events[TopicInsuranceExpiring]) - 1; n != tt.wantReminders {
+				t.Errorf("reminders after update = %d, want %d", n, tt.wantReminders)
+			}
+		})
+	}
+}
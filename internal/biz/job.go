@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"car-service/internal/conf"
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"time"
+)
+
+// 定时任务执行状态，skipped表示已由其他实例执行，不写入执行记录
+const (
+	JobStatusRunning   = "running"
+	JobStatusSucceeded = "succeeded"
+	JobStatusFailed    = "failed"
+	JobStatusSkipped   = "skipped"
+)
+
+const jobLockKeyPrefix = "car-service:lock:job:"
+
+type JobRun struct {
+	ID          int64
+	Name        string
+	ScheduledAt time.Time
+	StartedAt   time.Time
+	FinishedAt  *time.Time
+	Status      *string
+	Error       *string
+	Instance    *string
+	CreatedAt   *time.Time
+}
+
+type JobRunReply struct {
+	Id          int64
+	Name        string
+	ScheduledAt time.Time
+	StartedAt   time.Time
+	FinishedAt  *time.Time
+	Status      string
+	Error       string
+	Instance    string
+}
+
+type JobRunFilter struct {
+	Name   *string
+	Status *string
+}
+
+type JobRunRepo interface {
+	ListJobRun(ctx context.Context, page, pageSize int, filter *JobRunFilter) ([]*JobRunReply, int, error)
+	Save(context.Context, *JobRun) (int64, error)
+	// Finish 记录执行结果
+	Finish(ctx context.Context, id int64, status, errMsg string, at time.Time) error
+	// DeleteBefore 删除指定时间之前开始的执行记录
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+type JobUseCase struct {
+	r      JobRunRepo
+	locker Locker
+	c      *conf.Scheduler
+	log    *log.Helper
+}
+
+func NewJobUseCase(r JobRunRepo, locker Locker, c *conf.Scheduler, logger log.Logger) *JobUseCase {
+	return &JobUseCase{r: r, locker: locker, c: c, log: log.NewHelper(logger)}
+}
+
+// ListJobRun 查询定时任务执行记录，仅管理员可用
+func (uc *JobUseCase) ListJobRun(ctx context.Context,
+	page, pageSize int, filter *JobRunFilter) ([]*JobRunReply, int, error) {
+	if !auth.IsAdmin(ctx) {
+		return nil, 0, ex.JobForbidden
+	}
+	return uc.r.ListJobRun(ctx, page, pageSize, filter)
+}
+
+// Run 执行一次计划的任务并记录结果。同一任务同一计划时间只有一个实例能取得锁，
+// 锁在执行结束后不释放，直到超时自动过期，避免时钟略慢的实例重复执行
+func (uc *JobUseCase) Run(ctx context.Context, name string, scheduledAt time.Time, timeout time.Duration,
+	instance string, run func(ctx context.Context) error) (string, error) {
+	key := jobLockKeyPrefix + name + ":" + strconv.FormatInt(scheduledAt.Unix(), 10)
+	_, ok, err := uc.locker.TryLock(ctx, key, timeout)
+	if err != nil {
+		return JobStatusFailed, err
+	}
+	if !ok {
+		return JobStatusSkipped, nil
+	}
+
+	// 执行记录写入失败不影响任务本身
+	start := time.Now()
+	running := JobStatusRunning
+	id, err := uc.r.Save(ctx, &JobRun{
+		Name:        name,
+		ScheduledAt: scheduledAt,
+		StartedAt:   start,
+		Status:      &running,
+		Instance:    &instance,
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("记录定时任务执行失败: %s, %v", name, err)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	err = run(runCtx)
+	cancel()
+
+	status, errMsg := JobStatusSucceeded, ""
+	if err != nil {
+		status, errMsg = JobStatusFailed, err.Error()
+	}
+	if id > 0 {
+		// 任务超时后ctx可能已取消，结果单独写入
+		if err := uc.r.Finish(context.Background(), id, status, errMsg, time.Now()); err != nil {
+			uc.log.WithContext(ctx).Errorf("记录定时任务结果失败: %s, %v", name, err)
+		}
+	}
+	return status, err
+}
+
+// PurgeJobRuns 清理超过保留时间的执行记录
+func (uc *JobUseCase) PurgeJobRuns(ctx context.Context) error {
+	retention := uc.c.GetHistoryRetention().AsDuration()
+	if retention <= 0 {
+		return nil
+	}
+	n, err := uc.r.DeleteBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		uc.log.WithContext(ctx).Infof("清理定时任务执行记录，共%d条", n)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"testing"
+	"time"
+)
+
+func TestJobRun(t *testing.T) {
+	scheduledAt := time.Date(2024, 5, 1, 3, 0, 0, 0, time.UTC)
+	key := jobLockKeyPrefix + "purge:1714532400"
+	errRun := errors.New("run failed")
+	errLock := errors.New("redis unavailable")
+	errSave := errors.New("db unavailable")
+
+	tests := []struct {
+		name string
+		// 执行前已被其他实例持有的锁
+		held    bool
+		lockErr error
+		saveErr error
+		runErr  error
+		status  string
+		err     error
+		ran     bool
+		// 执行记录的最终状态，为空表示没有执行记录
+		record string
+	}{
+		{"acquires lock and succeeds", false, nil, nil, nil, JobStatusSucceeded, nil, true, JobStatusSucceeded},
+		{"acquires lock and fails", false, nil, nil, errRun, JobStatusFailed, errRun, true, JobStatusFailed},
+		{"lock held by another instance", true, nil, nil, nil, JobStatusSkipped, nil, false, ""},
+		{"lock error", false, errLock, nil, nil, JobStatusFailed, errLock, false, ""},
+		{"record not saved still runs", false, nil, errSave, nil, JobStatusSucceeded, nil, true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locker := &fakeLocker{held: make(map[string]time.Duration), err: tt.lockErr}
+			if tt.held {
+				locker.held[key] = time.Minute
+			}
+			r := &fakeJobRunRepo{runs: make(map[int64]*JobRunReply), saveErr: tt.saveErr}
+			uc := NewJobUseCase(r, locker, nil, log.DefaultLogger)
+
+			ran := false
+			status, err := uc.Run(context.Background(), "purge", scheduledAt, 10*time.Minute, "instance-1",
+				func(ctx context.Context) error {
+					ran = true
+					if _, ok := ctx.Deadline(); !ok {
+						t.Error("run ctx has no deadline")
+					}
+					return tt.runErr
+				})
+			if status != tt.status || err != tt.err {
+				t.Fatalf("Run() = %s, %v, want %s, %v", status, err, tt.status, tt.err)
+			}
+			if ran != tt.ran {
+				t.Errorf("ran = %v, want %v", ran, tt.ran)
+			}
+			// 执行后不释放锁，直到超时自动过期
+			if tt.lockErr == nil {
+				if ttl, ok := locker.held[key]; !ok {
+					t.Error("lock released after run")
+				} else if !tt.held && ttl != 10*time.Minute {
+					t.Errorf("lock ttl = %s, want %s", ttl, 10*time.Minute)
+				}
+			}
+			var record string
+			if j, ok := r.runs[1]; ok {
+				record = j.Status
+				if tt.runErr != nil && j.Error != tt.runErr.Error() {
+					t.Errorf("record error = %q, want %q", j.Error, tt.runErr.Error())
+				}
+			}
+			if record != tt.record || len(r.runs) > 1 {
+				t.Errorf("record = %q (%d runs), want %q", record, len(r.runs), tt.record)
+			}
+		})
+	}
+}
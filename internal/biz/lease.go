@@ -0,0 +1,258 @@
+package biz
+
+import (
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"math"
+	"strings"
+	"time"
+)
+
+// 租约状态，到期的租约仍为active，按结束日期判断是否生效
+const (
+	LeaseStatusActive     = "active"
+	LeaseStatusTerminated = "terminated"
+)
+
+type LeaseContract struct {
+	ID               int64
+	TenantID         *int64
+	CarID            *int64
+	Lessor           *string
+	ContractNo       *string
+	LesseeID         *int64
+	StartDate        *time.Time
+	EndDate          *time.Time
+	MonthlyPayment   *float64
+	MileageAllowance *int64
+	ExcessRate       *float64
+	StartMileage     *int64
+	TransferAllowed  *bool
+	Status           *string
+	TerminatedAt     *time.Time
+	CreatedAt        *time.Time
+}
+
+type LeaseContractReply struct {
+	Id               int64
+	CarId            int64
+	Lessor           string
+	ContractNo       string
+	LesseeId         int64
+	StartDate        time.Time
+	EndDate          time.Time
+	MonthlyPayment   float64
+	MileageAllowance int64
+	ExcessRate       float64
+	StartMileage     *int64
+	TransferAllowed  bool
+	Status           string
+	TerminatedAt     *time.Time
+	CreatedAt        time.Time
+}
+
+// PeriodEnd 租约实际结束时间，提前终止时为终止时间
+func (l *LeaseContractReply) PeriodEnd() time.Time {
+	if l.TerminatedAt != nil && l.TerminatedAt.Before(l.EndDate) {
+		return *l.TerminatedAt
+	}
+	return l.EndDate
+}
+
+type LeaseFilter struct {
+	CarId  *int64
+	Status *string
+}
+
+// ExcessMileage 超里程费用，里程限额按租期天数折算
+type ExcessMileage struct {
+	LeaseId      int64
+	CarId        int64
+	PeriodStart  time.Time
+	PeriodEnd    time.Time
+	StartMileage int64
+	EndMileage   int64
+	Driven       int64
+	Allowance    int64
+	Excess       int64
+	ExcessRate   float64
+	Charge       float64
+}
+
+type LeaseRepo interface {
+	ListLease(ctx context.Context, page, pageSize int, filter *LeaseFilter) ([]*LeaseContractReply, int, error)
+	GetById(ctx context.Context, id int64) (*LeaseContractReply, error)
+	// ExistsOverlap 该汽车在指定时间段内是否已有未终止的租约
+	ExistsOverlap(ctx context.Context, carId int64, start, end time.Time) (bool, error)
+	// ExistsBlocking 该汽车在指定时间是否处于禁止过户的租期内
+	ExistsBlocking(ctx context.Context, carId int64, at time.Time) (bool, error)
+	Save(context.Context, *LeaseContract) (int64, error)
+	// Terminate 仅当租约仍为active时终止，否则返回状态冲突
+	Terminate(ctx context.Context, id int64, at time.Time) error
+}
+
+type LeaseUseCase struct {
+	r   LeaseRepo
+	cr  CarRepo
+	or  OdometerRepo
+	tx  Transaction
+	log *log.Helper
+}
+
+func NewLeaseUseCase(r LeaseRepo, cr CarRepo, or OdometerRepo, tx Transaction, logger log.Logger) *LeaseUseCase {
+	return &LeaseUseCase{r: r, cr: cr, or: or, tx: tx, log: log.NewHelper(logger)}
+}
+
+func (uc *LeaseUseCase) ListLease(ctx context.Context,
+	page, pageSize int, filter *LeaseFilter) ([]*LeaseContractReply, int, error) {
+	return uc.r.ListLease(ctx, page, pageSize, filter)
+}
+
+func (uc *LeaseUseCase) GetLeaseById(ctx context.Context, id int64) (*LeaseContractReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+// CreateLease 登记租约，锁定汽车后检查租期冲突，保证同一汽车的租期不重叠，承租人默认为当前车主
+func (uc *LeaseUseCase) CreateLease(ctx context.Context, l *LeaseContract) (int64, error) {
+	if l.CarID == nil {
+		return 0, ex.CarIdRequired
+	}
+	if l.Lessor == nil || strings.TrimSpace(*l.Lessor) == "" {
+		return 0, ex.LessorRequired
+	}
+	if l.StartDate == nil || l.EndDate == nil || !l.EndDate.After(*l.StartDate) {
+		return 0, ex.InvalidLeasePeriod
+	}
+	if l.MonthlyPayment == nil || *l.MonthlyPayment < 0 {
+		return 0, ex.InvalidLeasePayment
+	}
+	if (l.MileageAllowance != nil && *l.MileageAllowance < 0) || (l.ExcessRate != nil && *l.ExcessRate < 0) ||
+		(l.StartMileage != nil && *l.StartMileage < 0) {
+		return 0, ex.InvalidMileageAllowance
+	}
+	c, err := uc.cr.GetById(ctx, *l.CarID)
+	if err != nil {
+		return 0, err
+	}
+	lessor := strings.TrimSpace(*l.Lessor)
+	l.Lessor = &lessor
+	if l.LesseeID == nil {
+		l.LesseeID = &c.UserId
+	}
+	l.TenantID = &c.TenantId
+
+	var id int64
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.cr.LockById(ctx, c.Id); err != nil {
+			return err
+		}
+		overlap, err := uc.r.ExistsOverlap(ctx, c.Id, *l.StartDate, *l.EndDate)
+		if err != nil {
+			return err
+		}
+		if overlap {
+			return ex.LeaseOverlap
+		}
+		id, err = uc.r.Save(ctx, l)
+		return err
+	})
+	return id, err
+}
+
+// TerminateLease 提前终止租约，终止后不再限制过户；已终止或已到期的租约不能终止
+func (uc *LeaseUseCase) TerminateLease(ctx context.Context, id int64) error {
+	l, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if l.Status != LeaseStatusActive || !l.EndDate.After(now) {
+		return ex.LeaseStatusConflict
+	}
+	return uc.r.Terminate(ctx, id, now)
+}
+
+// GetExcessMileage 根据里程读数计算截至asOf（默认当前，不晚于租约结束）的超里程费用
+func (uc *LeaseUseCase) GetExcessMileage(ctx context.Context, id int64, asOf *time.Time) (*ExcessMileage, error) {
+	l, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	end := l.PeriodEnd()
+	if asOf == nil {
+		now := time.Now()
+		asOf = &now
+	}
+	if asOf.Before(end) {
+		end = *asOf
+	}
+	if !end.After(l.StartDate) {
+		return nil, ex.InvalidLeasePeriod
+	}
+
+	// 起租里程未登记时，取起租前最近一次读数，没有则取起租后第一次读数
+	var startMileage int64
+	startAt := l.StartDate
+	if l.StartMileage != nil {
+		startMileage = *l.StartMileage
+	} else {
+		prev, next, err := uc.or.GetNeighbours(ctx, l.CarId, l.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case prev != nil:
+			startMileage = prev.Mileage
+		case next != nil && !next.RecordedAt.After(end):
+			startMileage, startAt = next.Mileage, next.RecordedAt
+		default:
+			return nil, ex.LeaseMileageUnavailable
+		}
+	}
+	last, _, err := uc.or.GetNeighbours(ctx, l.CarId, end)
+	if err != nil {
+		return nil, err
+	}
+	if last == nil || last.RecordedAt.Before(startAt) {
+		return nil, ex.LeaseMileageUnavailable
+	}
+
+	rsp := &ExcessMileage{
+		LeaseId:      l.Id,
+		CarId:        l.CarId,
+		PeriodStart:  l.StartDate,
+		PeriodEnd:    end,
+		StartMileage: startMileage,
+		EndMileage:   last.Mileage,
+		ExcessRate:   l.ExcessRate,
+	}
+	// 疑似调表的读数已排除；登记的起租里程高于结束读数时按0计算
+	if rsp.EndMileage > rsp.StartMileage {
+		rsp.Driven = rsp.EndMileage - rsp.StartMileage
+	}
+	// 不限里程
+	if l.MileageAllowance == 0 {
+		rsp.Allowance = rsp.Driven
+		return rsp, nil
+	}
+	years := end.Sub(l.StartDate).Hours() / (365 * 24)
+	rsp.Allowance = int64(math.Round(float64(l.MileageAllowance) * years))
+	if rsp.Driven > rsp.Allowance {
+		rsp.Excess = rsp.Driven - rsp.Allowance
+	}
+	rsp.Charge = math.Round(float64(rsp.Excess)*l.ExcessRate*100) / 100
+	return rsp, nil
+}
+
+// checkLeaseTransfer 汽车处于禁止过户的租期内时拒绝变更车主
+func checkLeaseTransfer(ctx context.Context, r LeaseRepo, carId int64) error {
+	blocked, err := r.ExistsBlocking(ctx, carId, time.Now())
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ex.LeaseForbidsTransfer
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package biz
+
+import (
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestGetExcessMileage(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	// 2025年为365天，整年租期的限额即年限额
+	end := start.AddDate(1, 0, 0)
+	day := func(n int) time.Time { return start.AddDate(0, 0, n) }
+	reading := func(at time.Time, mileage int64, suspicious bool) *OdometerReadingReply {
+		return &OdometerReadingReply{CarId: 1, Mileage: mileage, Suspicious: suspicious, RecordedAt: at}
+	}
+	int64Ptr := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name         string
+		allowance    int64
+		startMileage *int64
+		terminatedAt *time.Time
+		asOf         *time.Time
+		readings     []*OdometerReadingReply
+		err          error
+		want         ExcessMileage
+	}{
+		{
+			name:      "within allowance",
+			allowance: 10000,
+			readings:  []*OdometerReadingReply{reading(start, 1000, false), reading(end, 9000, false)},
+			want:      ExcessMileage{StartMileage: 1000, EndMileage: 9000, Driven: 8000, Allowance: 10000},
+		},
+		{
+			name:      "over allowance",
+			allowance: 10000,
+			readings:  []*OdometerReadingReply{reading(start, 1000, false), reading(end, 13000, false)},
+			want:      ExcessMileage{StartMileage: 1000, EndMileage: 13000, Driven: 12000, Allowance: 10000, Excess: 2000, Charge: 1000},
+		},
+		{
+			name:      "prorated to as of",
+			allowance: 10000,
+			asOf:      timePtr(day(73)),
+			readings:  []*OdometerReadingReply{reading(start, 1000, false), reading(day(72), 4000, false), reading(end, 20000, false)},
+			want:      ExcessMileage{StartMileage: 1000, EndMileage: 4000, Driven: 3000, Allowance: 2000, Excess: 1000, Charge: 500},
+		},
+		{
+			name:         "prorated to early termination",
+			allowance:    10000,
+			terminatedAt: timePtr(day(73)),
+			readings:     []*OdometerReadingReply{reading(start, 1000, false), reading(day(73), 4000, false), reading(end, 20000, false)},
+			want:         ExcessMileage{StartMileage: 1000, EndMileage: 4000, Driven: 3000, Allowance: 2000, Excess: 1000, Charge: 500},
+		},
+		{
+			name:      "unlimited allowance",
+			allowance: 0,
+			readings:  []*OdometerReadingReply{reading(start, 1000, false), reading(end, 50000, false)},
+			want:      ExcessMileage{StartMileage: 1000, EndMileage: 50000, Driven: 49000, Allowance: 49000},
+		},
+		{
+			name:      "rollback at end ignored",
+			allowance: 10000,
+			readings: []*OdometerReadingReply{
+				reading(start, 1000, false), reading(day(300), 12000, false), reading(day(364), 500, true),
+			},
+			want: ExcessMileage{StartMileage: 1000, EndMileage: 12000, Driven: 11000, Allowance: 10000, Excess: 1000, Charge: 500},
+		},
+		{
+			name:      "rollback before start ignored",
+			allowance: 10000,
+			readings: []*OdometerReadingReply{
+				reading(day(-10), 1000, false), reading(day(-1), 10, true), reading(end, 13000, false),
+			},
+			want: ExcessMileage{StartMileage: 1000, EndMileage: 13000, Driven: 12000, Allowance: 10000, Excess: 2000, Charge: 1000},
+		},
+		{
+			name:         "registered start mileage",
+			allowance:    10000,
+			startMileage: int64Ptr(2000),
+			readings:     []*OdometerReadingReply{reading(end, 13000, false)},
+			want:         ExcessMileage{StartMileage: 2000, EndMileage: 13000, Driven: 11000, Allowance: 10000, Excess: 1000, Charge: 500},
+		},
+		{
+			name:      "first reading after start",
+			allowance: 10000,
+			readings:  []*OdometerReadingReply{reading(day(5), 1000, false), reading(end, 9000, false)},
+			want:      ExcessMileage{StartMileage: 1000, EndMileage: 9000, Driven: 8000, Allowance: 10000},
+		},
+		{
+			name:      "no readings",
+			allowance: 10000,
+			err:       ex.LeaseMileageUnavailable,
+		},
+		{
+			name:      "only rollback readings",
+			allowance: 10000,
+			readings:  []*OdometerReadingReply{reading(start, 1000, true), reading(end, 500, true)},
+			err:       ex.LeaseMileageUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := &fakeLeaseRepo{leases: map[int64]*LeaseContractReply{1: {
+				Id:               1,
+				CarId:            1,
+				StartDate:        start,
+				EndDate:          end,
+				MileageAllowance: tt.allowance,
+				ExcessRate:       0.5,
+				StartMileage:     tt.startMileage,
+				Status:           LeaseStatusActive,
+				TerminatedAt:     tt.terminatedAt,
+			}}}
+			uc := NewLeaseUseCase(lr, &fakeCarRepo{}, &fakeOdometerRepo{readings: tt.readings}, &fakeTx{}, log.DefaultLogger)
+
+			asOf := tt.asOf
+			if asOf == nil {
+				asOf = timePtr(end.AddDate(1, 0, 0))
+			}
+			got, err := uc.GetExcessMileage(context.Background(), 1, asOf)
+			if err != tt.err {
+				t.Fatalf("GetExcessMileage() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			tt.want.LeaseId, tt.want.CarId, tt.want.PeriodStart, tt.want.ExcessRate = 1, 1, start, 0.5
+			tt.want.PeriodEnd = end
+			if tt.terminatedAt != nil {
+				tt.want.PeriodEnd = *tt.terminatedAt
+			}
+			if tt.asOf != nil {
+				tt.want.PeriodEnd = *tt.asOf
+			}
+			if *got != tt.want {
+				t.Errorf("GetExcessMileage() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,205 @@
+package biz
+
+import (
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+)
+
+// 出售信息状态
+const (
+	ListingStatusActive  = "active"
+	ListingStatusSold    = "sold"
+	ListingStatusClosed  = "closed"
+	ListingStatusExpired = "expired"
+)
+
+// 出售信息默认有效期
+const defaultListingTtl = 30 * 24 * time.Hour
+
+// 出售信息排序方式
+const (
+	ListingSortNewest    = "newest"
+	ListingSortPriceAsc  = "price_asc"
+	ListingSortPriceDesc = "price_desc"
+)
+
+type Listing struct {
+	ID          int64
+	TenantID    *int64
+	CarID       *int64
+	SellerID    *int64
+	Price       *float64
+	Description *string
+	Status      *string
+	ExpiresAt   *time.Time
+	BuyerID     *int64
+	ClosedAt    *time.Time
+	CreatedAt   *time.Time
+}
+
+type ListingReply struct {
+	Id           int64
+	CarId        int64
+	SellerId     int64
+	Price        float64
+	Description  string
+	Status       string
+	ExpiresAt    time.Time
+	BuyerId      int64
+	ClosedAt     *time.Time
+	CreatedAt    time.Time
+	Model        string
+	ModelId      int64
+	RegisteredAt time.Time
+}
+
+// Active 在售且未过期
+func (l *ListingReply) Active() bool {
+	return l.Status == ListingStatusActive && l.ExpiresAt.After(time.Now())
+}
+
+// ListingFilter 出售信息搜索条件，Status为空时只返回在售且未过期的
+type ListingFilter struct {
+	Keyword  *string
+	ModelId  *int64
+	SellerId *int64
+	MinPrice *float64
+	MaxPrice *float64
+	Status   *string
+	Sort     string
+}
+
+type ListingRepo interface {
+	SearchListing(ctx context.Context, page, pageSize int, filter *ListingFilter) ([]*ListingReply, int, error)
+	GetById(ctx context.Context, id int64) (*ListingReply, error)
+	ExistsActive(ctx context.Context, carId int64) (bool, error)
+	Save(context.Context, *Listing) (int64, error)
+	// Transit 仅当状态仍为在售时更新，否则返回状态冲突，支持事务
+	Transit(ctx context.Context, l *Listing) error
+}
+
+type ListingUseCase struct {
+	r   ListingRepo
+	cu  *CarUseCase
+	tx  Transaction
+	log *log.Helper
+}
+
+func NewListingUseCase(r ListingRepo, cu *CarUseCase, tx Transaction, logger log.Logger) *ListingUseCase {
+	return &ListingUseCase{r: r, cu: cu, tx: tx, log: log.NewHelper(logger)}
+}
+
+func (uc *ListingUseCase) SearchListing(ctx context.Context,
+	page, pageSize int, filter *ListingFilter) ([]*ListingReply, int, error) {
+	if filter.Keyword != nil {
+		kw := strings.TrimSpace(*filter.Keyword)
+		filter.Keyword = &kw
+	}
+	return uc.r.SearchListing(ctx, page, pageSize, filter)
+}
+
+func (uc *ListingUseCase) GetListingById(ctx context.Context, id int64) (*ListingReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+// CreateListing 车主发布出售信息，同一辆车只能有一条在售信息
+func (uc *ListingUseCase) CreateListing(ctx context.Context, l *Listing) (int64, error) {
+	if l.CarID == nil {
+		return 0, ex.CarIdRequired
+	}
+	if l.Price == nil || *l.Price <= 0 {
+		return 0, ex.InvalidListingPrice
+	}
+	now := time.Now()
+	if l.ExpiresAt == nil {
+		expiresAt := now.Add(defaultListingTtl)
+		l.ExpiresAt = &expiresAt
+	}
+	if !l.ExpiresAt.After(now) {
+		return 0, ex.InvalidListingExpiry
+	}
+
+	actor, err := currentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+	c, err := uc.cu.GetCarById(ctx, *l.CarID)
+	if err != nil {
+		return 0, err
+	}
+	if actor != c.UserId {
+		return 0, ex.NotCarOwner
+	}
+	exists, err := uc.r.ExistsActive(ctx, *l.CarID)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		return 0, ex.ListingExists
+	}
+
+	l.TenantID = &c.TenantId
+	l.SellerID = &c.UserId
+	return uc.r.Save(ctx, l)
+}
+
+// CloseListing 卖方关闭出售信息，指定买方时在同一事务中完成过户并按标价记录成交
+func (uc *ListingUseCase) CloseListing(ctx context.Context, id int64, buyerId *int64) error {
+	l, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !auth.IsAdmin(ctx) {
+		actor, err := currentUser(ctx)
+		if err != nil {
+			return err
+		}
+		if actor != l.SellerId {
+			return ex.NotCarOwner
+		}
+	}
+	if l.Status != ListingStatusActive {
+		return ex.ListingStatusConflict
+	}
+
+	now := time.Now()
+	if !l.Active() {
+		// 已过期的顺便置为过期
+		status := ListingStatusExpired
+		if err := uc.r.Transit(ctx, &Listing{ID: id, Status: &status, ClosedAt: &now}); err != nil {
+			return err
+		}
+		return ex.ListingExpired
+	}
+
+	if buyerId == nil || *buyerId == 0 {
+		status := ListingStatusClosed
+		return uc.r.Transit(ctx, &Listing{ID: id, Status: &status, ClosedAt: &now})
+	}
+	if *buyerId == l.SellerId {
+		return ex.InvalidTransferBuyer
+	}
+	c, err := uc.cu.r.GetById(ctx, l.CarId)
+	if err != nil {
+		return err
+	}
+	status := ListingStatusSold
+	var e *OwnershipTransferredEvent
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.r.Transit(ctx, &Listing{ID: id, Status: &status, BuyerID: buyerId, ClosedAt: &now}); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.cu.transferOwner(ctx, c, &l.SellerId, *buyerId, &l.Price, nil)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	uc.cu.publishTransferred(ctx, e)
+	return nil
+}
@@ -0,0 +1,126 @@
+package biz
+
+import (
+	"car-service/internal/conf"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+type MaintenanceRecord struct {
+	ID         int64
+	TenantID   *int64
+	CarID      *int64
+	ServicedAt *time.Time
+	Mileage    *int64
+	Type       *string
+	Cost       *float64
+	Shop       *string
+	Notes      *string
+}
+
+type MaintenanceRecordReply struct {
+	Id         int64
+	CarId      int64
+	ServicedAt time.Time
+	Mileage    int64
+	Type       string
+	Cost       float64
+	Shop       string
+	Notes      string
+}
+
+type NextServiceDue struct {
+	DueAt      time.Time
+	DueMileage int64
+}
+
+type MaintenanceRepo interface {
+	ListByCar(ctx context.Context, page, pageSize int, carId int64) ([]*MaintenanceRecordReply, int, error)
+	GetById(ctx context.Context, id int64) (*MaintenanceRecordReply, error)
+	GetLatest(ctx context.Context, carId int64) (*MaintenanceRecordReply, error)
+	Save(context.Context, *MaintenanceRecord) (int64, error)
+	Update(context.Context, *MaintenanceRecord) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type MaintenanceUseCase struct {
+	r   MaintenanceRepo
+	cr  CarRepo
+	c   *conf.Maintenance
+	log *log.Helper
+}
+
+func NewMaintenanceUseCase(r MaintenanceRepo, cr CarRepo, c *conf.Maintenance, logger log.Logger) *MaintenanceUseCase {
+	return &MaintenanceUseCase{r: r, cr: cr, c: c, log: log.NewHelper(logger)}
+}
+
+func (uc *MaintenanceUseCase) ListMaintenanceRecord(ctx context.Context,
+	page, pageSize int, carId int64) ([]*MaintenanceRecordReply, int, error) {
+	return uc.r.ListByCar(ctx, page, pageSize, carId)
+}
+
+func (uc *MaintenanceUseCase) GetMaintenanceRecordById(ctx context.Context, id int64) (*MaintenanceRecordReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+func (uc *MaintenanceUseCase) SaveMaintenanceRecord(ctx context.Context, m *MaintenanceRecord) error {
+	if m.CarID == nil {
+		return ex.CarIdRequired
+	}
+	// 校验汽车是否存在，保养记录与汽车属于同一租户
+	c, err := uc.cr.GetById(ctx, *m.CarID)
+	if err != nil {
+		return err
+	}
+	m.TenantID = &c.TenantId
+	_, err = uc.r.Save(ctx, m)
+	return err
+}
+
+func (uc *MaintenanceUseCase) UpdateMaintenanceRecord(ctx context.Context, m *MaintenanceRecord) error {
+	if m.CarID != nil {
+		c, err := uc.cr.GetById(ctx, *m.CarID)
+		if err != nil {
+			return err
+		}
+		m.TenantID = &c.TenantId
+	}
+	return uc.r.Update(ctx, m)
+}
+
+func (uc *MaintenanceUseCase) DeleteMaintenanceRecord(ctx context.Context, id int64) error {
+	return uc.r.Delete(ctx, id)
+}
+
+// GetNextServiceDue 根据车型保养间隔计算下次保养的时间和里程
+func (uc *MaintenanceUseCase) GetNextServiceDue(ctx context.Context, carId int64) (*NextServiceDue, error) {
+	c, err := uc.cr.GetById(ctx, carId)
+	if err != nil {
+		return nil, err
+	}
+	interval := uc.interval(c.Model)
+
+	// 以最近一次保养为基准，从未保养过则以上牌时间为基准
+	lastAt, lastMileage := c.RegisteredAt, int64(0)
+	latest, err := uc.r.GetLatest(ctx, carId)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil {
+		lastAt, lastMileage = latest.ServicedAt, latest.Mileage
+	}
+
+	return &NextServiceDue{
+		DueAt:      lastAt.Add(interval.GetPeriod().AsDuration()),
+		DueMileage: lastMileage + interval.GetMileage(),
+	}, nil
+}
+
+func (uc *MaintenanceUseCase) interval(model string) *conf.Maintenance_Interval {
+	if i, ok := uc.c.GetModels()[model]; ok {
+		return i
+	}
+	return uc.c.GetDefault()
+}
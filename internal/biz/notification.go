@@ -0,0 +1,268 @@
+package biz
+
+import (
+	"bytes"
+	"car-service/internal/conf"
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"time"
+)
+
+// 通知投递渠道
+const (
+	NotificationChannelWebhook = "webhook"
+	NotificationChannelSmtp    = "smtp"
+	NotificationChannelLog     = "log"
+)
+
+const (
+	notificationGroup         = "car-service-notification"
+	notificationDedupePrefix  = "car-service:notification:"
+	defaultNotificationLocale = "zh-CN"
+	defaultNotificationDedupe = 7 * 24 * time.Hour
+	// 投递中的去重key有效期，须长于一次投递（含重试）的耗时；进程在投递中崩溃时，过期后可重新投递
+	notificationClaimTtl     = 10 * time.Minute
+	defaultNotificationRetry = time.Second
+)
+
+// notificationTopics 需要发送通知的领域事件
+var notificationTopics = []string{TopicOwnershipTransferred, TopicInsuranceExpiring, TopicRecallFound}
+
+// Notification 渲染后发给一个用户的通知，EventId由事件内容生成，Id由EventId及收件人生成，用于去重
+type Notification struct {
+	Id       string
+	EventId  string
+	Topic    string
+	TenantId int64
+	UserId   int64
+	CarId    int64
+	Locale   string
+	Subject  string
+	Body     string
+}
+
+// NotificationChannel 通知投递渠道
+type NotificationChannel interface {
+	Name() string
+	Send(ctx context.Context, n *Notification) error
+}
+
+// NotificationAddresser 由不按用户投递的渠道实现，返回通知实际投递的地址（如租户的收件邮箱），
+// 同一事件的多个用户解析为同一地址时只投递一次
+type NotificationAddresser interface {
+	Address(n *Notification) string
+}
+
+type NotificationRepo interface {
+	// Claim 以投递中状态占用去重key，已投递或正在投递时返回false
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// MarkSent 投递成功后将去重key标记为已投递，并延长有效期
+	MarkSent(ctx context.Context, key string, ttl time.Duration) error
+	// Sent 去重key是否已标记为已投递
+	Sent(ctx context.Context, key string) (bool, error)
+	// Release 投递失败时释放去重key，以便重新处理时再次投递
+	Release(ctx context.Context, key string) error
+}
+
+type NotificationUseCase struct {
+	r        NotificationRepo
+	cr       CarRepo
+	consumer EventConsumer
+	channels []NotificationChannel
+	c        *conf.Notification
+	log      *log.Helper
+}
+
+func NewNotificationUseCase(r NotificationRepo, cr CarRepo, consumer EventConsumer, channels []NotificationChannel,
+	c *conf.Notification, logger log.Logger) *NotificationUseCase {
+	return &NotificationUseCase{r: r, cr: cr, consumer: consumer, channels: channels, c: c, log: log.NewHelper(logger)}
+}
+
+// Run 持续消费领域事件并发送通知，直到ctx取消
+func (uc *NotificationUseCase) Run(ctx context.Context) error {
+	if len(uc.channels) == 0 {
+		uc.log.Info("未启用通知渠道，不发送通知")
+		return nil
+	}
+	// 通知跨租户发送
+	return uc.consumer.Consume(auth.NewSystemContext(ctx), notificationGroup, notificationTopics, uc.Handle)
+}
+
+// Handle 将一个领域事件渲染为收件人各自语言的通知并逐个渠道投递，任一投递失败即返回错误
+func (uc *NotificationUseCase) Handle(ctx context.Context, topic string, payload []byte) error {
+	var (
+		carId      int64
+		event      interface{}
+		recipients []int64
+	)
+	switch topic {
+	case TopicOwnershipTransferred:
+		e := &OwnershipTransferredEvent{}
+		if err := json.Unmarshal(payload, e); err != nil {
+			return uc.discard(ctx, topic, err)
+		}
+		carId, event, recipients = e.CarId, e, []int64{e.FromUserId, e.ToUserId}
+	case TopicInsuranceExpiring:
+		e := &InsuranceExpiringEvent{}
+		if err := json.Unmarshal(payload, e); err != nil {
+			return uc.discard(ctx, topic, err)
+		}
+		carId, event = e.CarId, e
+	case TopicRecallFound:
+		e := &RecallFoundEvent{}
+		if err := json.Unmarshal(payload, e); err != nil {
+			return uc.discard(ctx, topic, err)
+		}
+		carId, event = e.CarId, e
+	default:
+		return nil
+	}
+
+	c, err := uc.cr.GetById(ctx, carId)
+	if errors.Is(err, ex.CarNotFound) {
+		// 汽车已删除时不再通知
+		return uc.discard(ctx, topic, err)
+	}
+	if err != nil {
+		return err
+	}
+	// 未指定收件人时通知当前车主
+	if len(recipients) == 0 {
+		recipients = []int64{c.UserId}
+	}
+
+	locale := uc.locale(c.TenantId)
+	subject, body, err := renderNotification(topic, locale, uc.c.GetDefaultLocale(), &notificationData{Car: c, Event: event})
+	if err != nil {
+		return uc.discard(ctx, topic, err)
+	}
+	digest := sha1.Sum(append([]byte(topic), payload...))
+	eventId := hex.EncodeToString(digest[:])
+	for _, userId := range recipients {
+		n := &Notification{
+			Id:       eventId + ":" + strconv.FormatInt(userId, 10),
+			EventId:  eventId,
+			Topic:    topic,
+			TenantId: c.TenantId,
+			UserId:   userId,
+			CarId:    c.Id,
+			Locale:   locale,
+			Subject:  subject,
+			Body:     body,
+		}
+		if err := uc.deliver(ctx, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver 逐个渠道投递，已投递过的渠道跳过；失败的渠道释放去重key后返回错误，由消息重新处理时重试。
+// 去重key投递前短期占用，投递成功后才标记为已投递并保留dedupe_ttl
+func (uc *NotificationUseCase) deliver(ctx context.Context, n *Notification) error {
+	ttl := uc.c.GetDedupeTtl().AsDuration()
+	if ttl <= 0 {
+		ttl = defaultNotificationDedupe
+	}
+	var failed error
+	for _, ch := range uc.channels {
+		key := notificationDedupePrefix + ch.Name() + ":" + n.Id
+		if a, ok := ch.(NotificationAddresser); ok {
+			key = notificationDedupePrefix + ch.Name() + ":" + n.EventId + ":" + a.Address(n)
+		}
+		ok, err := uc.r.Claim(ctx, key, notificationClaimTtl)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			sent, err := uc.r.Sent(ctx, key)
+			if err != nil {
+				return err
+			}
+			if !sent {
+				// 其他实例正在投递，消息暂不确认，待其投递结果确定后再处理
+				failed = fmt.Errorf("通知正在投递: %s", key)
+			}
+			continue
+		}
+		if err := uc.send(ctx, ch, n); err != nil {
+			uc.log.WithContext(ctx).Errorf("通知投递失败: %s %s, %v", ch.Name(), n.Id, err)
+			if err := uc.r.Release(ctx, key); err != nil {
+				uc.log.WithContext(ctx).Errorf("释放通知去重key失败: %s, %v", key, err)
+			}
+			failed = err
+			continue
+		}
+		if err := uc.r.MarkSent(ctx, key, ttl); err != nil {
+			// 占用到期后可能重复投递
+			uc.log.WithContext(ctx).Errorf("标记通知已投递失败: %s, %v", key, err)
+		}
+	}
+	return failed
+}
+
+// send 投递失败时按间隔重试，间隔逐次翻倍
+func (uc *NotificationUseCase) send(ctx context.Context, ch NotificationChannel, n *Notification) error {
+	backoff := uc.c.GetRetryBackoff().AsDuration()
+	if backoff <= 0 {
+		backoff = defaultNotificationRetry
+	}
+	retries := int(uc.c.GetMaxRetries())
+	for i := 0; ; i++ {
+		err := ch.Send(ctx, n)
+		if err == nil || i >= retries {
+			return err
+		}
+		uc.log.WithContext(ctx).Warnf("通知投递失败，第%d次重试: %s %s, %v", i+1, ch.Name(), n.Id, err)
+		select {
+		case <-time.After(backoff << i):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// locale 租户配置的语言，未配置时使用默认语言
+func (uc *NotificationUseCase) locale(tenantId int64) string {
+	if l, ok := uc.c.GetTenantLocales()[tenantId]; ok && l != "" {
+		return l
+	}
+	if l := uc.c.GetDefaultLocale(); l != "" {
+		return l
+	}
+	return defaultNotificationLocale
+}
+
+// discard 无法处理的事件记录日志后确认，不再重试
+func (uc *NotificationUseCase) discard(ctx context.Context, topic string, err error) error {
+	uc.log.WithContext(ctx).Warnf("忽略无法发送通知的事件: %s, %v", topic, err)
+	return nil
+}
+
+// renderNotification 按语言选择模板渲染标题及正文，缺少该语言时依次使用默认语言及中文模板
+func renderNotification(topic, locale, fallback string, data *notificationData) (string, string, error) {
+	templates := notificationTemplates[topic]
+	t, ok := templates[locale]
+	if !ok {
+		t, ok = templates[fallback]
+	}
+	if !ok {
+		t = templates[defaultNotificationLocale]
+	}
+	var subject, body bytes.Buffer
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return "", "", err
+	}
+	if err := t.body.Execute(&body, data); err != nil {
+		return "", "", err
+	}
+	return subject.String(), body.String(), nil
+}
@@ -0,0 +1,65 @@
+package biz
+
+import (
+	"text/template"
+)
+
+// notificationData 渲染通知模板的数据，Event为对应topic的事件
+type notificationData struct {
+	Car   *CarReply
+	Event interface{}
+}
+
+type notificationTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+func newNotificationTemplate(subject, body string) *notificationTemplate {
+	return &notificationTemplate{
+		subject: template.Must(template.New("subject").Parse(subject)),
+		body:    template.Must(template.New("body").Parse(body)),
+	}
+}
+
+// notificationTemplates 按事件及语言配置的通知模板，每个事件至少需要中文模板
+var notificationTemplates = map[string]map[string]*notificationTemplate{
+	TopicOwnershipTransferred: {
+		"zh-CN": newNotificationTemplate(
+			"车辆过户通知：{{.Car.Plate}}",
+			"车牌号为{{.Car.Plate}}（VIN：{{.Car.Vin}}）的{{.Car.Model}}已于"+
+				`{{.Event.TransferredAt.Format "2006-01-02 15:04"}}完成过户，当前车主为{{.Car.UserName}}。`,
+		),
+		"en-US": newNotificationTemplate(
+			"Ownership transferred: {{.Car.Plate}}",
+			"The ownership of {{.Car.Model}} {{.Car.Plate}} (VIN {{.Car.Vin}}) was transferred on "+
+				`{{.Event.TransferredAt.Format "2006-01-02 15:04"}}. The current owner is {{.Car.UserName}}.`,
+		),
+	},
+	TopicInsuranceExpiring: {
+		"zh-CN": newNotificationTemplate(
+			"保险即将到期：{{.Car.Plate}}",
+			"车牌号为{{.Car.Plate}}的汽车在{{.Event.Provider}}投保的保单{{.Event.PolicyNumber}}将于"+
+				`{{.Event.EndDate.Format "2006-01-02"}}到期，请及时续保。`,
+		),
+		"en-US": newNotificationTemplate(
+			"Insurance expiring: {{.Car.Plate}}",
+			"Policy {{.Event.PolicyNumber}} from {{.Event.Provider}} for {{.Car.Plate}} expires on "+
+				`{{.Event.EndDate.Format "2006-01-02"}}. Please renew it in time.`,
+		),
+	},
+	TopicRecallFound: {
+		"zh-CN": newNotificationTemplate(
+			"召回通知：{{.Car.Plate}}",
+			"车牌号为{{.Car.Plate}}（VIN：{{.Car.Vin}}）的汽车属于召回活动{{.Event.CampaignNo}}的范围。"+
+				"涉及部件：{{.Event.Component}}。{{.Event.Description}}"+
+				"{{if .Event.Remedy}}整改措施：{{.Event.Remedy}}{{end}}",
+		),
+		"en-US": newNotificationTemplate(
+			"Recall notice: {{.Car.Plate}}",
+			"{{.Car.Plate}} (VIN {{.Car.Vin}}) is affected by recall campaign {{.Event.CampaignNo}}. "+
+				"Component: {{.Event.Component}}. {{.Event.Description}}"+
+				"{{if .Event.Remedy}} Remedy: {{.Event.Remedy}}{{end}}",
+		),
+	},
+}
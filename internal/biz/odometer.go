@@ -0,0 +1,102 @@
+package biz
+
+import (
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+type OdometerReading struct {
+	ID         int64
+	TenantID   *int64
+	CarID      *int64
+	Mileage    *int64
+	Source     *string
+	Suspicious *bool
+	RecordedAt *time.Time
+}
+
+type OdometerReadingReply struct {
+	Id         int64
+	CarId      int64
+	Mileage    int64
+	Source     string
+	Suspicious bool
+	RecordedAt time.Time
+}
+
+type OdometerFilter struct {
+	CarId     int64
+	StartTime *time.Time
+	EndTime   *time.Time
+}
+
+type OdometerRepo interface {
+	ListOdometerReading(ctx context.Context, page, pageSize int, filter *OdometerFilter) ([]*OdometerReadingReply, int, error)
+	// GetNeighbours 查询指定时间前后最近的两条正常读数（不含疑似调表的读数），不存在时为nil
+	GetNeighbours(ctx context.Context, carId int64, at time.Time) (prev, next *OdometerReadingReply, err error)
+	Save(context.Context, *OdometerReading) (int64, error)
+}
+
+type OdometerUseCase struct {
+	r   OdometerRepo
+	cr  CarRepo
+	log *log.Helper
+}
+
+func NewOdometerUseCase(r OdometerRepo, cr CarRepo, logger log.Logger) *OdometerUseCase {
+	return &OdometerUseCase{r: r, cr: cr, log: log.NewHelper(logger)}
+}
+
+func (uc *OdometerUseCase) ListOdometerReading(ctx context.Context,
+	page, pageSize int, filter *OdometerFilter) ([]*OdometerReadingReply, int, error) {
+	return uc.r.ListOdometerReading(ctx, page, pageSize, filter)
+}
+
+// RecordReading 记录里程读数，读数回退时标记为疑似调表
+func (uc *OdometerUseCase) RecordReading(ctx context.Context, o *OdometerReading) (*OdometerReadingReply, error) {
+	if o.CarID == nil {
+		return nil, ex.CarIdRequired
+	}
+	if o.Mileage == nil || *o.Mileage < 0 {
+		return nil, ex.InvalidMileage
+	}
+	c, err := uc.cr.GetById(ctx, *o.CarID)
+	if err != nil {
+		return nil, err
+	}
+	o.TenantID = &c.TenantId
+	if o.RecordedAt == nil {
+		now := time.Now()
+		o.RecordedAt = &now
+	}
+
+	// 补录历史读数时，还需不高于其后的读数
+	prev, next, err := uc.r.GetNeighbours(ctx, *o.CarID, *o.RecordedAt)
+	if err != nil {
+		return nil, err
+	}
+	suspicious := (prev != nil && *o.Mileage < prev.Mileage) ||
+		(next != nil && *o.Mileage > next.Mileage)
+	if suspicious {
+		uc.log.WithContext(ctx).Warnf("里程读数回退，疑似调表: car=%d, mileage=%d", *o.CarID, *o.Mileage)
+	}
+	o.Suspicious = &suspicious
+
+	id, err := uc.r.Save(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	reply := &OdometerReadingReply{
+		Id:         id,
+		CarId:      *o.CarID,
+		Mileage:    *o.Mileage,
+		Suspicious: suspicious,
+		RecordedAt: *o.RecordedAt,
+	}
+	if o.Source != nil {
+		reply.Source = *o.Source
+	}
+	return reply, nil
+}
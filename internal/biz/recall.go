@@ -0,0 +1,296 @@
+package biz
+
+import (
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+)
+
+const TopicRecallFound = "car.recall.found"
+
+// 召回整改状态
+const (
+	CarRecallStatusOpen      = "open"
+	CarRecallStatusScheduled = "scheduled"
+	CarRecallStatusRemedied  = "remedied"
+	CarRecallStatusDismissed = "dismissed"
+)
+
+// carRecallTransitions 召回整改状态流转，已整改和已排除为终态
+var carRecallTransitions = map[string][]string{
+	CarRecallStatusOpen:      {CarRecallStatusScheduled, CarRecallStatusRemedied, CarRecallStatusDismissed},
+	CarRecallStatusScheduled: {CarRecallStatusOpen, CarRecallStatusRemedied, CarRecallStatusDismissed},
+}
+
+type Recall struct {
+	ID           int64
+	CampaignNo   string
+	Manufacturer *string
+	ModelID      *int64
+	Model        *string
+	YearFrom     *int
+	YearTo       *int
+	VinFrom      *string
+	VinTo        *string
+	Component    *string
+	Description  *string
+	Remedy       *string
+	IssuedAt     *time.Time
+	CreatedAt    *time.Time
+}
+
+type RecallReply struct {
+	Id           int64
+	CampaignNo   string
+	Manufacturer string
+	ModelId      int64
+	Model        string
+	YearFrom     int
+	YearTo       int
+	VinFrom      string
+	VinTo        string
+	Component    string
+	Description  string
+	Remedy       string
+	IssuedAt     time.Time
+	CreatedAt    time.Time
+}
+
+// Matches 汽车是否在召回范围内：车型一致，登记年份及VIN在范围内
+func (r *RecallReply) Matches(c *CarReply) bool {
+	if r.ModelId > 0 {
+		if c.ModelId != r.ModelId {
+			return false
+		}
+	} else if normalizeModel(r.Model) == "" || normalizeModel(c.Model) != normalizeModel(r.Model) {
+		return false
+	}
+
+	year := c.RegisteredAt.Year()
+	if (r.YearFrom > 0 && year < r.YearFrom) || (r.YearTo > 0 && year > r.YearTo) {
+		return false
+	}
+
+	if r.VinFrom != "" || r.VinTo != "" {
+		vin := strings.ToUpper(c.Vin)
+		if vin == "" {
+			return false
+		}
+		// 同长度的VIN按字典序比较即为序号比较
+		if r.VinFrom != "" && (len(vin) != len(r.VinFrom) || vin < r.VinFrom) {
+			return false
+		}
+		if r.VinTo != "" && (len(vin) != len(r.VinTo) || vin > r.VinTo) {
+			return false
+		}
+	}
+	return true
+}
+
+// RecallFoundEvent 汽车新匹配到召回的事件
+type RecallFoundEvent struct {
+	RecallId    int64  `json:"recall_id"`
+	CampaignNo  string `json:"campaign_no"`
+	CarId       int64  `json:"car_id"`
+	Component   string `json:"component"`
+	Description string `json:"description"`
+	Remedy      string `json:"remedy"`
+}
+
+type CarRecall struct {
+	ID         int64
+	TenantID   *int64
+	CarID      *int64
+	RecallID   *int64
+	Status     *string
+	Notes      *string
+	RemediedAt *time.Time
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+}
+
+type CarRecallReply struct {
+	Id         int64
+	CarId      int64
+	RecallId   int64
+	Status     string
+	Notes      string
+	RemediedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Recall     *RecallReply
+}
+
+type CarRecallFilter struct {
+	CarId    *int64
+	RecallId *int64
+	Status   *string
+	// OpenOnly 仅返回待整改及已预约整改的召回
+	OpenOnly bool
+}
+
+// RecallImportResult 单个召回的导入及匹配结果
+type RecallImportResult struct {
+	RecallId   int64
+	CampaignNo string
+	Matched    int
+}
+
+type RecallRepo interface {
+	ListRecall(ctx context.Context, page, pageSize int, modelId *int64) ([]*RecallReply, int, error)
+	GetById(ctx context.Context, id int64) (*RecallReply, error)
+	// Upsert 按召回编号新增或更新
+	Upsert(context.Context, *Recall) (int64, error)
+	// ListCandidateCars 按车型、年份及VIN范围初筛可能受影响的汽车
+	ListCandidateCars(ctx context.Context, r *RecallReply) ([]*CarReply, error)
+	// LinkCars 关联受影响的汽车，已关联的跳过，返回新关联的汽车ID
+	LinkCars(ctx context.Context, recallId int64, cars []*CarReply) ([]int64, error)
+	ListCarRecall(ctx context.Context, page, pageSize int, filter *CarRecallFilter) ([]*CarRecallReply, int, error)
+	GetCarRecallById(ctx context.Context, id int64) (*CarRecallReply, error)
+	// TransitCarRecall 仅当整改状态仍为from时更新，否则返回状态冲突
+	TransitCarRecall(ctx context.Context, cr *CarRecall, from string) error
+}
+
+type RecallUseCase struct {
+	r   RecallRepo
+	pub EventPublisher
+	log *log.Helper
+}
+
+func NewRecallUseCase(r RecallRepo, pub EventPublisher, logger log.Logger) *RecallUseCase {
+	return &RecallUseCase{r: r, pub: pub, log: log.NewHelper(logger)}
+}
+
+func (uc *RecallUseCase) ListRecall(ctx context.Context, page, pageSize int, modelId *int64) ([]*RecallReply, int, error) {
+	return uc.r.ListRecall(ctx, page, pageSize, modelId)
+}
+
+func (uc *RecallUseCase) GetRecallById(ctx context.Context, id int64) (*RecallReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+// ImportRecalls 导入厂商召回并立即匹配所有租户的汽车，仅管理员可用
+func (uc *RecallUseCase) ImportRecalls(ctx context.Context, recalls []*Recall) ([]*RecallImportResult, error) {
+	if !auth.IsAdmin(ctx) {
+		return nil, ex.RecallForbidden
+	}
+	for _, r := range recalls {
+		if err := fillRecall(r); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*RecallImportResult, 0, len(recalls))
+	for _, r := range recalls {
+		id, err := uc.r.Upsert(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		n, err := uc.MatchRecall(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &RecallImportResult{RecallId: id, CampaignNo: r.CampaignNo, Matched: n})
+	}
+	return results, nil
+}
+
+// MatchRecall 重新匹配召回影响的汽车（如新增汽车后），返回新关联数量
+func (uc *RecallUseCase) MatchRecall(ctx context.Context, id int64) (int, error) {
+	if !auth.IsAdmin(ctx) {
+		return 0, ex.RecallForbidden
+	}
+	r, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	candidates, err := uc.r.ListCandidateCars(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	matched := make([]*CarReply, 0, len(candidates))
+	for _, c := range candidates {
+		if r.Matches(c) {
+			matched = append(matched, c)
+		}
+	}
+	if len(matched) == 0 {
+		return 0, nil
+	}
+	carIds, err := uc.r.LinkCars(ctx, id, matched)
+	if err != nil {
+		return 0, err
+	}
+	for _, carId := range carIds {
+		publishCommitted(ctx, uc.pub, uc.log, TopicRecallFound, &RecallFoundEvent{
+			RecallId:    r.Id,
+			CampaignNo:  r.CampaignNo,
+			CarId:       carId,
+			Component:   r.Component,
+			Description: r.Description,
+			Remedy:      r.Remedy,
+		})
+	}
+	uc.log.WithContext(ctx).Infof("召回[%s]新匹配汽车%d辆", r.CampaignNo, len(carIds))
+	return len(carIds), nil
+}
+
+func (uc *RecallUseCase) ListCarRecall(ctx context.Context, page, pageSize int, filter *CarRecallFilter) ([]*CarRecallReply, int, error) {
+	return uc.r.ListCarRecall(ctx, page, pageSize, filter)
+}
+
+// UpdateCarRecallStatus 推进汽车的召回整改状态
+func (uc *RecallUseCase) UpdateCarRecallStatus(ctx context.Context, id int64, status string, notes *string) error {
+	cr, err := uc.r.GetCarRecallById(ctx, id)
+	if err != nil {
+		return err
+	}
+	allowed := false
+	for _, s := range carRecallTransitions[cr.Status] {
+		if s == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ex.CarRecallStatusConflict
+	}
+
+	now := time.Now()
+	update := &CarRecall{ID: id, Status: &status, Notes: notes, UpdatedAt: &now}
+	if status == CarRecallStatusRemedied {
+		update.RemediedAt = &now
+	}
+	return uc.r.TransitCarRecall(ctx, update, cr.Status)
+}
+
+// fillRecall 校验召回范围并统一VIN格式
+func fillRecall(r *Recall) error {
+	r.CampaignNo = strings.TrimSpace(r.CampaignNo)
+	if r.CampaignNo == "" {
+		return ex.CampaignNoRequired
+	}
+	if (r.ModelID == nil || *r.ModelID == 0) && (r.Model == nil || normalizeModel(*r.Model) == "") {
+		return ex.RecallModelRequired
+	}
+	if r.YearFrom != nil && r.YearTo != nil && *r.YearTo > 0 && *r.YearFrom > *r.YearTo {
+		return ex.InvalidRecallYearRange
+	}
+	if r.VinFrom != nil {
+		v := strings.ToUpper(strings.TrimSpace(*r.VinFrom))
+		r.VinFrom = &v
+	}
+	if r.VinTo != nil {
+		v := strings.ToUpper(strings.TrimSpace(*r.VinTo))
+		r.VinTo = &v
+	}
+	if r.VinFrom != nil && r.VinTo != nil && *r.VinFrom != "" && *r.VinTo != "" &&
+		(len(*r.VinFrom) != len(*r.VinTo) || *r.VinFrom > *r.VinTo) {
+		return ex.InvalidRecallVinRange
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package biz
+
+import (
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// 预约状态
+const (
+	ReservationStatusBooked    = "booked"
+	ReservationStatusCancelled = "cancelled"
+)
+
+type Reservation struct {
+	ID          int64
+	TenantID    *int64
+	CarID       *int64
+	UserID      *int64
+	StartAt     *time.Time
+	EndAt       *time.Time
+	Purpose     *string
+	Status      *string
+	CancelledAt *time.Time
+	CreatedAt   *time.Time
+}
+
+type ReservationReply struct {
+	Id          int64
+	CarId       int64
+	UserId      int64
+	StartAt     time.Time
+	EndAt       time.Time
+	Purpose     string
+	Status      string
+	CancelledAt *time.Time
+	CreatedAt   time.Time
+}
+
+type ReservationFilter struct {
+	CarId  *int64
+	UserId *int64
+	Status *string
+	// From/To 与该时间段有交集的预约
+	From *time.Time
+	To   *time.Time
+}
+
+type ReservationRepo interface {
+	ListReservation(ctx context.Context, page, pageSize int, filter *ReservationFilter) ([]*ReservationReply, int, error)
+	GetById(ctx context.Context, id int64) (*ReservationReply, error)
+	// ExistsOverlap 是否存在与时间段有交集的有效预约，支持事务
+	ExistsOverlap(ctx context.Context, carId int64, start, end time.Time) (bool, error)
+	// Save 支持事务
+	Save(context.Context, *Reservation) (int64, error)
+	// Cancel 仅当预约仍为已预约状态时取消，否则返回状态冲突
+	Cancel(ctx context.Context, id int64, at time.Time) error
+}
+
+type ReservationUseCase struct {
+	r   ReservationRepo
+	cr  CarRepo
+	tx  Transaction
+	log *log.Helper
+}
+
+func NewReservationUseCase(r ReservationRepo, cr CarRepo, tx Transaction, logger log.Logger) *ReservationUseCase {
+	return &ReservationUseCase{r: r, cr: cr, tx: tx, log: log.NewHelper(logger)}
+}
+
+func (uc *ReservationUseCase) ListReservation(ctx context.Context,
+	page, pageSize int, filter *ReservationFilter) ([]*ReservationReply, int, error) {
+	return uc.r.ListReservation(ctx, page, pageSize, filter)
+}
+
+func (uc *ReservationUseCase) GetReservationById(ctx context.Context, id int64) (*ReservationReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+// BookCar 预约汽车，锁定汽车后检查时间冲突，保证同一辆车的预约不重叠
+func (uc *ReservationUseCase) BookCar(ctx context.Context, r *Reservation) (int64, error) {
+	if r.CarID == nil {
+		return 0, ex.CarIdRequired
+	}
+	if r.StartAt == nil || r.EndAt == nil || !r.EndAt.After(*r.StartAt) || r.EndAt.Before(time.Now()) {
+		return 0, ex.InvalidReservationPeriod
+	}
+	// 默认为当前操作人预约
+	if actor, ok := auth.GetUserId(ctx); ok && (r.UserID == nil || *r.UserID == 0) {
+		r.UserID = &actor
+	}
+	if r.UserID == nil || *r.UserID == 0 {
+		return 0, ex.ReservationUserRequired
+	}
+	c, err := uc.cr.GetById(ctx, *r.CarID)
+	if err != nil {
+		return 0, err
+	}
+	r.TenantID = &c.TenantId
+
+	var id int64
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.cr.LockById(ctx, c.Id); err != nil {
+			return err
+		}
+		exists, err := uc.r.ExistsOverlap(ctx, c.Id, *r.StartAt, *r.EndAt)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ex.ReservationConflict
+		}
+		id, err = uc.r.Save(ctx, r)
+		return err
+	})
+	return id, err
+}
+
+// CancelReservation 预约人或管理员取消尚未结束的预约
+func (uc *ReservationUseCase) CancelReservation(ctx context.Context, id int64) error {
+	r, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !auth.IsAdmin(ctx) {
+		actor, err := currentUser(ctx)
+		if err != nil {
+			return err
+		}
+		if actor != r.UserId {
+			return ex.NotReservationOwner
+		}
+	}
+	now := time.Now()
+	if !r.EndAt.After(now) {
+		return ex.ReservationStatusConflict
+	}
+	return uc.r.Cancel(ctx, id, now)
+}
+
+// ListAvailableCar 查询该时间段内没有预约的汽车
+func (uc *ReservationUseCase) ListAvailableCar(ctx context.Context,
+	page, pageSize int, start, end time.Time, fleetId *int64) ([]*CarReply, int, error) {
+	if !end.After(start) {
+		return nil, 0, ex.InvalidReservationPeriod
+	}
+	return uc.cr.ListCar(ctx, page, pageSize, &CarFilter{
+		FleetId:  fleetId,
+		FreeFrom: &start,
+		FreeTo:   &end,
+	})
+}
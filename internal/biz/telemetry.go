@@ -0,0 +1,290 @@
+package biz
+
+import (
+	"car-service/internal/conf"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// 未配置时的默认值
+const (
+	defaultTelemetryBatchSize     = 500
+	defaultTelemetryFlushInterval = time.Second
+	defaultTelemetryQueueSize     = 10000
+	defaultTelemetryWriteTimeout  = 10 * time.Second
+	// 车速上限（km/h），超出视为异常数据
+	maxTelemetrySpeed = 400
+)
+
+// TelemetryPoint 车载终端上报的一个数据点，Speed及FuelLevel为空表示未上报
+type TelemetryPoint struct {
+	TenantId   int64
+	CarId      int64
+	RecordedAt time.Time
+	Lat        float64
+	Lng        float64
+	Speed      *float64
+	FuelLevel  *float64
+}
+
+// IngestResult 一次上报中接受及拒绝的点数
+type IngestResult struct {
+	Accepted int
+	Rejected int
+}
+
+type TelemetryRepo interface {
+	// SaveBatch 按上报时间所在月份写入对应分表
+	SaveBatch(ctx context.Context, points []*TelemetryPoint) error
+	// SetLastLocation 更新各汽车的最后位置，早于已缓存位置的点不覆盖
+	SetLastLocation(ctx context.Context, points []*TelemetryPoint) error
+	GetLastLocation(ctx context.Context, carId int64) (*TelemetryPoint, error)
+	// DropTablesBefore 删除早于指定月份的分表，返回已删除的表名
+	DropTablesBefore(ctx context.Context, month time.Time) ([]string, error)
+}
+
+type TelemetryUseCase struct {
+	r     TelemetryRepo
+	cr    CarRepo
+	c     *conf.Telemetry
+	queue chan *TelemetryPoint
+	log   *log.Helper
+}
+
+func NewTelemetryUseCase(r TelemetryRepo, cr CarRepo, c *conf.Telemetry, logger log.Logger) *TelemetryUseCase {
+	size := int(c.GetQueueSize())
+	if size <= 0 {
+		size = defaultTelemetryQueueSize
+	}
+	return &TelemetryUseCase{r: r, cr: cr, c: c, queue: make(chan *TelemetryPoint, size), log: log.NewHelper(logger)}
+}
+
+// GetLastKnownLocation 从缓存读取汽车最后上报的位置
+func (uc *TelemetryUseCase) GetLastKnownLocation(ctx context.Context, carId int64) (*TelemetryPoint, error) {
+	if _, err := uc.cr.GetById(ctx, carId); err != nil {
+		return nil, err
+	}
+	return uc.r.GetLastLocation(ctx, carId)
+}
+
+// PurgeExpired 删除整月都已超过保留时间的分表，由定时任务调用
+func (uc *TelemetryUseCase) PurgeExpired(ctx context.Context) error {
+	retention := uc.c.GetRetention().AsDuration()
+	if retention <= 0 {
+		return nil
+	}
+	cutoff := time.Now().Add(-retention)
+	month := time.Date(cutoff.Year(), cutoff.Month(), 1, 0, 0, 0, 0, cutoff.Location())
+	tables, err := uc.r.DropTablesBefore(ctx, month)
+	if len(tables) > 0 {
+		uc.log.WithContext(ctx).Infof("删除过期遥测分表: %v", tables)
+	}
+	return err
+}
+
+// NewIngestion 开始一次流式上报，汽车归属在同一次上报内只校验一次
+func (uc *TelemetryUseCase) NewIngestion() *TelemetryIngestion {
+	return &TelemetryIngestion{uc: uc, cars: make(map[int64]*CarReply)}
+}
+
+// TelemetryIngestion 校验上报的数据点并按批放入写入队列
+type TelemetryIngestion struct {
+	uc     *TelemetryUseCase
+	cars   map[int64]*CarReply
+	batch  []*TelemetryPoint
+	result IngestResult
+}
+
+// Reject 记录一个无法解析的数据点
+func (in *TelemetryIngestion) Reject() {
+	in.result.Rejected++
+}
+
+// Add 校验数据点，无效或不属于当前租户的点计入拒绝数；仅在查询汽车失败、队列持续已满或请求取消时返回错误
+func (in *TelemetryIngestion) Add(ctx context.Context, p *TelemetryPoint) error {
+	if !in.uc.valid(p) {
+		in.Reject()
+		return nil
+	}
+	c, ok := in.cars[p.CarId]
+	if !ok {
+		var err error
+		c, err = in.uc.cr.GetById(ctx, p.CarId)
+		if errors.Is(err, ex.CarNotFound) {
+			// 汽车不存在或不属于当前租户时记为nil
+			c = nil
+		} else if err != nil {
+			return err
+		}
+		in.cars[p.CarId] = c
+	}
+	if c == nil {
+		in.Reject()
+		return nil
+	}
+	p.TenantId = c.TenantId
+	in.batch = append(in.batch, p)
+	if len(in.batch) >= in.uc.batchSize() {
+		return in.Flush(ctx)
+	}
+	return nil
+}
+
+// Flush 将已校验的数据点放入写入队列
+func (in *TelemetryIngestion) Flush(ctx context.Context) error {
+	if len(in.batch) == 0 {
+		return nil
+	}
+	n, err := in.uc.enqueue(ctx, in.batch)
+	in.result.Accepted += n
+	in.batch = in.batch[:0]
+	return err
+}
+
+func (in *TelemetryIngestion) Result() *IngestResult {
+	return &in.result
+}
+
+// enqueue 放入队列后用已放入的点更新最后位置，未入队的点不影响缓存；队列已满时阻塞，从而暂停读取上报流，
+// 超过等待时间仍无法放入则返回繁忙，已放入的点数照常返回
+func (uc *TelemetryUseCase) enqueue(ctx context.Context, points []*TelemetryPoint) (int, error) {
+	n, err := uc.push(ctx, points)
+	if n > 0 {
+		if err := uc.r.SetLastLocation(ctx, latestPoints(points[:n])); err != nil {
+			uc.log.WithContext(ctx).Warnf("更新汽车最后位置失败: %v", err)
+		}
+	}
+	return n, err
+}
+
+func (uc *TelemetryUseCase) push(ctx context.Context, points []*TelemetryPoint) (int, error) {
+	var timeout <-chan time.Time
+	if d := uc.c.GetEnqueueTimeout().AsDuration(); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	for i, p := range points {
+		select {
+		case uc.queue <- p:
+		case <-timeout:
+			uc.log.WithContext(ctx).Warnf("遥测写入队列已满，拒绝上报: 剩余%d个点", len(points)-i)
+			return i, ex.TelemetryBusy
+		case <-ctx.Done():
+			return i, ctx.Err()
+		}
+	}
+	return len(points), nil
+}
+
+// RunWriter 从队列中取出数据点批量写入，stop关闭后写完队列中剩余的数据再返回
+func (uc *TelemetryUseCase) RunWriter(stop <-chan struct{}) {
+	interval := uc.c.GetFlushInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultTelemetryFlushInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	size := uc.batchSize()
+	batch := make([]*TelemetryPoint, 0, size)
+	flush := func() {
+		if len(batch) > 0 {
+			uc.write(batch)
+			batch = make([]*TelemetryPoint, 0, size)
+		}
+	}
+	for {
+		select {
+		case p := <-uc.queue:
+			batch = append(batch, p)
+			if len(batch) >= size {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-stop:
+			for {
+				select {
+				case p := <-uc.queue:
+					batch = append(batch, p)
+					if len(batch) >= size {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// write 写入一批数据点，失败时按间隔重试，写入期间队列积压即形成对上报端的背压
+func (uc *TelemetryUseCase) write(batch []*TelemetryPoint) {
+	timeout := uc.c.GetWriteTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultTelemetryWriteTimeout
+	}
+	retries := int(uc.c.GetMaxRetries())
+	for i := 0; ; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		err := uc.r.SaveBatch(ctx, batch)
+		cancel()
+		if err == nil {
+			return
+		}
+		if i >= retries {
+			uc.log.Errorf("遥测数据写入失败，丢弃%d个点: %v", len(batch), err)
+			return
+		}
+		uc.log.Warnf("遥测数据写入失败，第%d次重试: %v", i+1, err)
+		time.Sleep(time.Duration(i+1) * time.Second)
+	}
+}
+
+// valid 校验坐标、车速、油量及上报时间
+func (uc *TelemetryUseCase) valid(p *TelemetryPoint) bool {
+	if p.CarId <= 0 || p.Lat < -90 || p.Lat > 90 || p.Lng < -180 || p.Lng > 180 {
+		return false
+	}
+	if p.Speed != nil && (*p.Speed < 0 || *p.Speed > maxTelemetrySpeed) {
+		return false
+	}
+	if !validPercent(p.FuelLevel) {
+		return false
+	}
+	now := time.Now()
+	if p.RecordedAt.IsZero() || p.RecordedAt.After(now.Add(uc.c.GetMaxClockSkew().AsDuration())) {
+		return false
+	}
+	if maxAge := uc.c.GetMaxAge().AsDuration(); maxAge > 0 && p.RecordedAt.Before(now.Add(-maxAge)) {
+		return false
+	}
+	return true
+}
+
+func (uc *TelemetryUseCase) batchSize() int {
+	if n := int(uc.c.GetBatchSize()); n > 0 {
+		return n
+	}
+	return defaultTelemetryBatchSize
+}
+
+// latestPoints 每辆汽车取上报时间最晚的一个点
+func latestPoints(points []*TelemetryPoint) []*TelemetryPoint {
+	byCar := make(map[int64]*TelemetryPoint)
+	for _, p := range points {
+		if cur, ok := byCar[p.CarId]; !ok || p.RecordedAt.After(cur.RecordedAt) {
+			byCar[p.CarId] = p
+		}
+	}
+	list := make([]*TelemetryPoint, 0, len(byCar))
+	for _, p := range byCar {
+		list = append(list, p)
+	}
+	return list
+}
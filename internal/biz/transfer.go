@@ -0,0 +1,281 @@
+package biz
+
+import (
+	"car-service/internal/conf"
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// 过户状态
+const (
+	TransferStatusPending   = "pending"
+	TransferStatusAccepted  = "accepted"
+	TransferStatusRejected  = "rejected"
+	TransferStatusCancelled = "cancelled"
+	TransferStatusExpired   = "expired"
+)
+
+type Transfer struct {
+	ID              int64
+	TenantID        *int64
+	CarID           *int64
+	SellerID        *int64
+	BuyerID         *int64
+	Status          *string
+	RequireApproval *bool
+	ApprovedBy      *int64
+	ExpiresAt       *time.Time
+	AcceptedAt      *time.Time
+	CompletedAt     *time.Time
+	CreatedAt       *time.Time
+}
+
+type TransferReply struct {
+	Id              int64
+	CarId           int64
+	SellerId        int64
+	BuyerId         int64
+	Status          string
+	RequireApproval bool
+	ApprovedBy      int64
+	ExpiresAt       time.Time
+	AcceptedAt      *time.Time
+	CompletedAt     *time.Time
+	CreatedAt       time.Time
+}
+
+// InProgress 待买方接受，或已接受但待管理员审批
+func (t *TransferReply) InProgress() bool {
+	return t.Status == TransferStatusPending ||
+		(t.Status == TransferStatusAccepted && t.CompletedAt == nil)
+}
+
+type TransferFilter struct {
+	CarId  *int64
+	UserId *int64
+	Status *string
+}
+
+type TransferRepo interface {
+	ListTransfer(ctx context.Context, page, pageSize int, filter *TransferFilter) ([]*TransferReply, int, error)
+	GetById(ctx context.Context, id int64) (*TransferReply, error)
+	ExistsInProgress(ctx context.Context, carId int64) (bool, error)
+	Save(context.Context, *Transfer) (int64, error)
+	// Transit 仅当过户单仍处于from状态且未完成时更新，否则返回状态冲突
+	Transit(ctx context.Context, t *Transfer, from string) error
+	ExpireBefore(ctx context.Context, t time.Time) (int, error)
+}
+
+type TransferUseCase struct {
+	r   TransferRepo
+	cu  *CarUseCase
+	lr  LeaseRepo
+	tx  Transaction
+	c   *conf.Transfer
+	log *log.Helper
+}
+
+func NewTransferUseCase(r TransferRepo, cu *CarUseCase, lr LeaseRepo, tx Transaction,
+	c *conf.Transfer, logger log.Logger) *TransferUseCase {
+	return &TransferUseCase{r: r, cu: cu, lr: lr, tx: tx, c: c, log: log.NewHelper(logger)}
+}
+
+func (uc *TransferUseCase) ListTransfer(ctx context.Context,
+	page, pageSize int, filter *TransferFilter) ([]*TransferReply, int, error) {
+	return uc.r.ListTransfer(ctx, page, pageSize, filter)
+}
+
+func (uc *TransferUseCase) GetTransferById(ctx context.Context, id int64) (*TransferReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+// InitiateTransfer 卖方（当前车主）发起过户
+func (uc *TransferUseCase) InitiateTransfer(ctx context.Context, carId, buyerId int64, requireApproval bool) (int64, error) {
+	actor, err := currentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+	c, err := uc.cu.GetCarById(ctx, carId)
+	if err != nil {
+		return 0, err
+	}
+	if actor != c.UserId {
+		return 0, ex.NotCarOwner
+	}
+	if buyerId == 0 || buyerId == c.UserId {
+		return 0, ex.InvalidTransferBuyer
+	}
+
+	// 锁定汽车后再检查并创建，同一辆车的并发发起串行执行，避免同时存在多个进行中的过户
+	var id int64
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.cu.r.LockById(ctx, carId); err != nil {
+			return err
+		}
+		exists, err := uc.r.ExistsInProgress(ctx, carId)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ex.TransferInProgress
+		}
+		if err := checkLeaseTransfer(ctx, uc.lr, carId); err != nil {
+			return err
+		}
+
+		expiresAt := time.Now().Add(uc.c.GetTtl().AsDuration())
+		id, err = uc.r.Save(ctx, &Transfer{
+			TenantID:        &c.TenantId,
+			CarID:           &carId,
+			SellerID:        &c.UserId,
+			BuyerID:         &buyerId,
+			RequireApproval: &requireApproval,
+			ExpiresAt:       &expiresAt,
+		})
+		return err
+	})
+	return id, err
+}
+
+// AcceptTransfer 买方接受，无需审批时直接完成过户
+func (uc *TransferUseCase) AcceptTransfer(ctx context.Context, id int64) error {
+	t, err := uc.getInProgress(ctx, id)
+	if err != nil {
+		return err
+	}
+	if t.Status != TransferStatusPending {
+		return ex.TransferStatusConflict
+	}
+	actor, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if actor != t.BuyerId {
+		return ex.NotTransferBuyer
+	}
+
+	now := time.Now()
+	status := TransferStatusAccepted
+	if t.RequireApproval {
+		return uc.r.Transit(ctx, &Transfer{ID: id, Status: &status, AcceptedAt: &now}, TransferStatusPending)
+	}
+	return uc.complete(ctx, t, &Transfer{ID: id, Status: &status, AcceptedAt: &now, CompletedAt: &now})
+}
+
+// ApproveTransfer 管理员审批买方已接受的过户
+func (uc *TransferUseCase) ApproveTransfer(ctx context.Context, id int64) error {
+	actor, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if !auth.IsAdmin(ctx) {
+		return ex.TransferApprovalForbidden
+	}
+	t, err := uc.getInProgress(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !t.RequireApproval || t.Status != TransferStatusAccepted {
+		return ex.TransferStatusConflict
+	}
+
+	now := time.Now()
+	return uc.complete(ctx, t, &Transfer{ID: id, ApprovedBy: &actor, CompletedAt: &now})
+}
+
+// RejectTransfer 买方或管理员拒绝
+func (uc *TransferUseCase) RejectTransfer(ctx context.Context, id int64) error {
+	actor, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	t, err := uc.getInProgress(ctx, id)
+	if err != nil {
+		return err
+	}
+	// 卖方应使用取消；买方只能拒绝待接受的过户，审批阶段由管理员拒绝
+	if !auth.IsAdmin(ctx) {
+		if actor != t.BuyerId {
+			return ex.TransferRejectForbidden
+		}
+		if t.Status != TransferStatusPending {
+			return ex.TransferStatusConflict
+		}
+	}
+	status := TransferStatusRejected
+	return uc.r.Transit(ctx, &Transfer{ID: id, Status: &status}, t.Status)
+}
+
+// CancelTransfer 卖方取消
+func (uc *TransferUseCase) CancelTransfer(ctx context.Context, id int64) error {
+	actor, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	t, err := uc.getInProgress(ctx, id)
+	if err != nil {
+		return err
+	}
+	if actor != t.SellerId {
+		return ex.NotCarOwner
+	}
+	status := TransferStatusCancelled
+	return uc.r.Transit(ctx, &Transfer{ID: id, Status: &status}, t.Status)
+}
+
+// ExpireTransfers 将超时未完成的过户置为过期，由定时任务调用
+func (uc *TransferUseCase) ExpireTransfers(ctx context.Context) error {
+	n, err := uc.r.ExpireBefore(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		uc.log.WithContext(ctx).Infof("过户超时过期，共%d条", n)
+	}
+	return nil
+}
+
+// complete 在同一事务中完成过户状态变更和车主变更，与交易及出售共用锁车、租约检查和成交记录，
+// 任一步失败整体回滚，提交后发布车主变更事件
+func (uc *TransferUseCase) complete(ctx context.Context, t *TransferReply, update *Transfer) error {
+	c, err := uc.cu.r.GetById(ctx, t.CarId)
+	if err != nil {
+		return err
+	}
+	var e *OwnershipTransferredEvent
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.r.Transit(ctx, update, t.Status); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.cu.transferOwner(ctx, c, &t.SellerId, t.BuyerId, nil, nil)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	uc.cu.publishTransferred(ctx, e)
+	return nil
+}
+
+// getInProgress 查询进行中的过户，已超时的顺便置为过期
+func (uc *TransferUseCase) getInProgress(ctx context.Context, id int64) (*TransferReply, error) {
+	t, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !t.InProgress() {
+		return nil, ex.TransferStatusConflict
+	}
+	if t.ExpiresAt.Before(time.Now()) {
+		status := TransferStatusExpired
+		if err := uc.r.Transit(ctx, &Transfer{ID: id, Status: &status}, t.Status); err != nil {
+			return nil, err
+		}
+		return nil, ex.TransferExpired
+	}
+	return t, nil
+}
@@ -0,0 +1,208 @@
+package biz
+
+import (
+	"car-service/internal/conf"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestTransferStateMachine(t *testing.T) {
+	const (
+		carId  = 1
+		seller = 10
+		buyer  = 20
+		other  = 30
+		admin  = 99
+	)
+	type step struct {
+		action string
+		actor  int64
+		admin  bool
+		err    error
+	}
+	tests := []struct {
+		name            string
+		requireApproval bool
+		ttl             time.Duration
+		leaseBlocks     bool
+		steps           []step
+		// 最后一个过户单的状态，为空时不校验
+		wantStatus string
+		wantOwner  int64
+	}{
+		{
+			name:       "accept completes without approval",
+			steps:      []step{{"initiate", seller, false, nil}, {"accept", buyer, false, nil}},
+			wantStatus: TransferStatusAccepted,
+			wantOwner:  buyer,
+		},
+		{
+			name:      "initiate requires user",
+			steps:     []step{{"initiate", 0, false, ex.Unauthorized}},
+			wantOwner: seller,
+		},
+		{
+			name:      "initiate by non-owner",
+			steps:     []step{{"initiate", other, false, ex.NotCarOwner}},
+			wantOwner: seller,
+		},
+		{
+			name:        "initiate during blocking lease",
+			leaseBlocks: true,
+			steps:       []step{{"initiate", seller, false, ex.LeaseForbidsTransfer}},
+			wantOwner:   seller,
+		},
+		{
+			name:       "second initiate while pending",
+			steps:      []step{{"initiate", seller, false, nil}, {"initiate", seller, false, ex.TransferInProgress}},
+			wantStatus: TransferStatusPending,
+			wantOwner:  seller,
+		},
+		{
+			name:       "accept by non-buyer",
+			steps:      []step{{"initiate", seller, false, nil}, {"accept", other, false, ex.NotTransferBuyer}},
+			wantStatus: TransferStatusPending,
+			wantOwner:  seller,
+		},
+		{
+			name:            "approval flow",
+			requireApproval: true,
+			steps: []step{
+				{"initiate", seller, false, nil},
+				{"accept", buyer, false, nil},
+				{"approve", buyer, false, ex.TransferApprovalForbidden},
+				{"approve", admin, true, nil},
+			},
+			wantStatus: TransferStatusAccepted,
+			wantOwner:  buyer,
+		},
+		{
+			name:            "accepted awaits approval",
+			requireApproval: true,
+			steps:           []step{{"initiate", seller, false, nil}, {"accept", buyer, false, nil}},
+			wantStatus:      TransferStatusAccepted,
+			wantOwner:       seller,
+		},
+		{
+			name:            "approve before accept",
+			requireApproval: true,
+			steps:           []step{{"initiate", seller, false, nil}, {"approve", admin, true, ex.TransferStatusConflict}},
+			wantStatus:      TransferStatusPending,
+			wantOwner:       seller,
+		},
+		{
+			name:       "approve without approval required",
+			steps:      []step{{"initiate", seller, false, nil}, {"approve", admin, true, ex.TransferStatusConflict}},
+			wantStatus: TransferStatusPending,
+			wantOwner:  seller,
+		},
+		{
+			name: "buyer rejects pending",
+			steps: []step{
+				{"initiate", seller, false, nil},
+				{"reject", buyer, false, nil},
+				{"accept", buyer, false, ex.TransferStatusConflict},
+			},
+			wantStatus: TransferStatusRejected,
+			wantOwner:  seller,
+		},
+		{
+			name:       "seller cannot reject",
+			steps:      []step{{"initiate", seller, false, nil}, {"reject", seller, false, ex.TransferRejectForbidden}},
+			wantStatus: TransferStatusPending,
+			wantOwner:  seller,
+		},
+		{
+			name:            "buyer cannot reject after accepting",
+			requireApproval: true,
+			steps: []step{
+				{"initiate", seller, false, nil},
+				{"accept", buyer, false, nil},
+				{"reject", buyer, false, ex.TransferStatusConflict},
+				{"reject", admin, true, nil},
+			},
+			wantStatus: TransferStatusRejected,
+			wantOwner:  seller,
+		},
+		{
+			name:       "seller cancels",
+			steps:      []step{{"initiate", seller, false, nil}, {"cancel", seller, false, nil}, {"initiate", seller, false, nil}},
+			wantStatus: TransferStatusPending,
+			wantOwner:  seller,
+		},
+		{
+			name:      "owner changed before accept",
+			steps:     []step{{"initiate", seller, false, nil}, {"resell", other, false, nil}, {"accept", buyer, false, ex.CarOwnerChanged}},
+			wantOwner: other,
+		},
+		{
+			name:       "accept after expiry",
+			ttl:        -time.Minute,
+			steps:      []step{{"initiate", seller, false, nil}, {"accept", buyer, false, ex.TransferExpired}},
+			wantStatus: TransferStatusExpired,
+			wantOwner:  seller,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeTx{}
+			cr := &fakeCarRepo{cars: map[int64]*CarReply{carId: {Id: carId, TenantId: 1, UserId: seller}}, tx: tx}
+			lr := &fakeLeaseRepo{blocking: tt.leaseBlocks}
+			pub := &fakePublisher{}
+			vu := NewValuationUseCase(&fakeValuationRepo{}, cr, &conf.Valuation{}, log.DefaultLogger)
+			cu := NewCarUseCase(cr, nil, nil, lr, vu, pub, &conf.Tenant{}, tx, log.DefaultLogger)
+			ttl := tt.ttl
+			if ttl == 0 {
+				ttl = time.Hour
+			}
+			r := &fakeTransferRepo{}
+			uc := NewTransferUseCase(r, cu, lr, tx, &conf.Transfer{Ttl: durationpb.New(ttl)}, log.DefaultLogger)
+
+			var id int64
+			for i, s := range tt.steps {
+				ctx := userContext(s.actor, s.admin)
+				var err error
+				switch s.action {
+				case "initiate":
+					var newId int64
+					if newId, err = uc.InitiateTransfer(ctx, carId, buyer, tt.requireApproval); err == nil {
+						id = newId
+					}
+				case "accept":
+					err = uc.AcceptTransfer(ctx, id)
+				case "approve":
+					err = uc.ApproveTransfer(ctx, id)
+				case "reject":
+					err = uc.RejectTransfer(ctx, id)
+				case "cancel":
+					err = uc.CancelTransfer(ctx, id)
+				case "resell":
+					// 过户进行中汽车被其他途径卖出
+					err = cr.ChangeOwner(context.Background(), carId, seller, s.actor)
+				}
+				// 同一错误码的错误errors.Is视为相同，按实例比较
+				if err != s.err {
+					t.Fatalf("step %d %s: error = %v, want %v", i, s.action, err, s.err)
+				}
+			}
+
+			if tt.wantStatus != "" {
+				if got := r.transfers[id].Status; got != tt.wantStatus {
+					t.Errorf("status = %s, want %s", got, tt.wantStatus)
+				}
+			}
+			if got := cr.cars[carId].UserId; got != tt.wantOwner {
+				t.Errorf("owner = %d, want %d", got, tt.wantOwner)
+			}
+			transferred := len(pub.events[TopicOwnershipTransferred])
+			if want := tt.wantOwner == buyer; (transferred == 1) != want || transferred > 1 {
+				t.Errorf("published %d ownership events", transferred)
+			}
+		})
+	}
+}
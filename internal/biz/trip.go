@@ -0,0 +1,184 @@
+package biz
+
+import (
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// 补能单位
+const (
+	RefuelUnitLiter = "L"
+	RefuelUnitKwh   = "kWh"
+)
+
+type Trip struct {
+	ID            int64
+	TenantID      *int64
+	CarID         *int64
+	DriverID      *int64
+	Purpose       *string
+	StartOdometer *int64
+	EndOdometer   *int64
+	StartedAt     *time.Time
+	EndedAt       *time.Time
+	CreatedAt     *time.Time
+}
+
+type TripReply struct {
+	Id            int64
+	CarId         int64
+	DriverId      int64
+	Purpose       string
+	StartOdometer int64
+	EndOdometer   int64
+	StartedAt     time.Time
+	EndedAt       time.Time
+}
+
+// Distance 行驶里程
+func (t *TripReply) Distance() int64 {
+	return t.EndOdometer - t.StartOdometer
+}
+
+type Refuel struct {
+	ID         int64
+	TenantID   *int64
+	CarID      *int64
+	Amount     *float64
+	Unit       *string
+	Cost       *float64
+	Station    *string
+	Odometer   *int64
+	RefueledAt *time.Time
+}
+
+type RefuelReply struct {
+	Id         int64
+	CarId      int64
+	Amount     float64
+	Unit       string
+	Cost       float64
+	Station    string
+	Odometer   int64
+	RefueledAt time.Time
+}
+
+type FuelReportFilter struct {
+	// TenantId 为空时不限租户，仅管理员可用
+	TenantId *int64
+	CarId    *int64
+	From     *time.Time
+	To       *time.Time
+	// Monthly 按月汇总，否则按车汇总整个区间
+	Monthly bool
+}
+
+// FuelReport 能耗及费用汇总，Period为空表示整个查询区间，加油（L）与充电（kWh）分别统计
+type FuelReport struct {
+	CarId    int64
+	Period   string
+	Distance int64
+	// Amount 加油量（L）
+	Amount float64
+	// Energy 充电量（kWh）
+	Energy float64
+	Cost   float64
+	// AmountPer100Km 百公里油耗（L），无里程时为0
+	AmountPer100Km float64
+	// EnergyPer100Km 百公里电耗（kWh），无里程时为0
+	EnergyPer100Km float64
+	// CostPerKm 每公里费用，无里程时为0
+	CostPerKm float64
+}
+
+type TripRepo interface {
+	ListTrip(ctx context.Context, page, pageSize int, carId *int64) ([]*TripReply, int, error)
+	SaveTrip(context.Context, *Trip) (int64, error)
+	ListRefuel(ctx context.Context, page, pageSize int, carId *int64) ([]*RefuelReply, int, error)
+	SaveRefuel(context.Context, *Refuel) (int64, error)
+	FuelReport(ctx context.Context, filter *FuelReportFilter) ([]*FuelReport, error)
+}
+
+type TripUseCase struct {
+	r   TripRepo
+	cr  CarRepo
+	log *log.Helper
+}
+
+func NewTripUseCase(r TripRepo, cr CarRepo, logger log.Logger) *TripUseCase {
+	return &TripUseCase{r: r, cr: cr, log: log.NewHelper(logger)}
+}
+
+func (uc *TripUseCase) ListTrip(ctx context.Context, page, pageSize int, carId *int64) ([]*TripReply, int, error) {
+	return uc.r.ListTrip(ctx, page, pageSize, carId)
+}
+
+func (uc *TripUseCase) RecordTrip(ctx context.Context, t *Trip) (int64, error) {
+	if t.CarID == nil {
+		return 0, ex.CarIdRequired
+	}
+	if t.StartOdometer == nil || t.EndOdometer == nil || *t.StartOdometer < 0 || *t.EndOdometer < *t.StartOdometer {
+		return 0, ex.InvalidTripOdometer
+	}
+	if t.StartedAt != nil && t.EndedAt != nil && t.EndedAt.Before(*t.StartedAt) {
+		return 0, ex.InvalidTripPeriod
+	}
+	// 默认当前操作人为司机，结束时间为当前时间
+	if actor, ok := auth.GetUserId(ctx); ok && (t.DriverID == nil || *t.DriverID == 0) {
+		t.DriverID = &actor
+	}
+	if t.EndedAt == nil {
+		now := time.Now()
+		t.EndedAt = &now
+	}
+	c, err := uc.cr.GetById(ctx, *t.CarID)
+	if err != nil {
+		return 0, err
+	}
+	t.TenantID = &c.TenantId
+	return uc.r.SaveTrip(ctx, t)
+}
+
+func (uc *TripUseCase) ListRefuel(ctx context.Context, page, pageSize int, carId *int64) ([]*RefuelReply, int, error) {
+	return uc.r.ListRefuel(ctx, page, pageSize, carId)
+}
+
+func (uc *TripUseCase) RecordRefuel(ctx context.Context, r *Refuel) (int64, error) {
+	if r.CarID == nil {
+		return 0, ex.CarIdRequired
+	}
+	if r.Amount == nil || *r.Amount <= 0 || (r.Cost != nil && *r.Cost < 0) {
+		return 0, ex.InvalidRefuelAmount
+	}
+	if r.Unit == nil || *r.Unit == "" {
+		unit := RefuelUnitLiter
+		r.Unit = &unit
+	}
+	if *r.Unit != RefuelUnitLiter && *r.Unit != RefuelUnitKwh {
+		return 0, ex.InvalidRefuelUnit
+	}
+	c, err := uc.cr.GetById(ctx, *r.CarID)
+	if err != nil {
+		return 0, err
+	}
+	r.TenantID = &c.TenantId
+	return uc.r.SaveRefuel(ctx, r)
+}
+
+// FuelReport 按车（及月份）汇总里程、能耗及费用，非管理员仅限当前租户
+func (uc *TripUseCase) FuelReport(ctx context.Context, filter *FuelReportFilter) ([]*FuelReport, error) {
+	if filter.From != nil && filter.To != nil && !filter.To.After(*filter.From) {
+		return nil, ex.InvalidTripPeriod
+	}
+	if !auth.IsAdmin(ctx) {
+		tenantId, ok := auth.GetTenantId(ctx)
+		if !ok {
+			return nil, ex.TenantRequired
+		}
+		filter.TenantId = &tenantId
+	}
+	return uc.r.FuelReport(ctx, filter)
+}
@@ -0,0 +1,319 @@
+package biz
+
+import (
+	"car-service/internal/conf"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"math"
+	"sort"
+	"time"
+)
+
+// 内置估值策略
+const (
+	PricingStrategyComparable   = "comparable"
+	PricingStrategyDepreciation = "depreciation"
+)
+
+// 未配置时的默认估值参数
+const (
+	defaultAnnualRate       = 0.15
+	defaultMileageRate      = 0.02
+	defaultResidualFloor    = 0.1
+	defaultMaxComparables   = 10
+	defaultComparableWindow = 365 * 24 * time.Hour
+	// 折旧曲线覆盖的车龄年数
+	valuationCurveYears = 10
+)
+
+type TradeRecord struct {
+	ID             int64
+	TenantID       *int64
+	CarID          *int64
+	ModelID        *int64
+	SellerID       *int64
+	BuyerID        *int64
+	Price          *float64
+	EstimatedValue *float64
+	Mileage        *int64
+	AgeMonths      *int
+	TradedAt       *time.Time
+}
+
+type TradeRecordReply struct {
+	Id             int64
+	CarId          int64
+	ModelId        int64
+	Price          float64
+	EstimatedValue float64
+	Mileage        int64
+	AgeMonths      int
+	TradedAt       time.Time
+}
+
+// ValuationInput 估值对象，Mileage及AgeMonths为估值时点的里程和车龄
+type ValuationInput struct {
+	Car       *CarReply
+	Mileage   int64
+	AgeMonths int
+	At        time.Time
+}
+
+// PriceEstimate 策略给出的估值，Comparables为参考的成交记录数
+type PriceEstimate struct {
+	Value       float64
+	Comparables int
+}
+
+// PricingStrategy 估值策略，无法估值（如缺少基准价或参考成交价）时返回ok=false，由下一个策略接手
+type PricingStrategy interface {
+	Name() string
+	Estimate(ctx context.Context, in *ValuationInput) (est *PriceEstimate, ok bool, err error)
+}
+
+type ValuationPoint struct {
+	AgeYears int
+	Value    float64
+}
+
+type Valuation struct {
+	CarId       int64
+	ModelId     int64
+	Strategy    string
+	BasePrice   float64
+	Value       float64
+	Mileage     int64
+	AgeMonths   int
+	Comparables int
+	// Curve 按当前年均里程推算的各车龄估值
+	Curve []*ValuationPoint
+}
+
+type ValuationRepo interface {
+	// ListComparables 同车型since之后有成交价的交易，按时间倒序
+	ListComparables(ctx context.Context, modelId int64, since time.Time, limit int) ([]*TradeRecordReply, error)
+	// SaveTradeRecord 支持事务
+	SaveTradeRecord(context.Context, *TradeRecord) (int64, error)
+}
+
+// depreciation 按车龄指数折旧，并按里程线性折旧，不低于残值下限
+type depreciation struct {
+	annualRate    float64
+	mileageRate   float64
+	residualFloor float64
+}
+
+func (d depreciation) factor(ageMonths int, mileage int64) float64 {
+	f := math.Pow(1-d.annualRate, float64(ageMonths)/12) * (1 - d.mileageRate*float64(mileage)/10000)
+	return math.Max(f, d.residualFloor)
+}
+
+// depreciationStrategy 基准价乘以折旧系数，未配置基准价的车型无法估值
+type depreciationStrategy struct {
+	d          depreciation
+	basePrices map[int64]float64
+}
+
+func (s depreciationStrategy) Name() string {
+	return PricingStrategyDepreciation
+}
+
+func (s depreciationStrategy) Estimate(_ context.Context, in *ValuationInput) (*PriceEstimate, bool, error) {
+	base, ok := s.basePrices[in.Car.ModelId]
+	if !ok || base <= 0 {
+		return nil, false, nil
+	}
+	return &PriceEstimate{Value: base * s.d.factor(in.AgeMonths, in.Mileage)}, true, nil
+}
+
+// comparableStrategy 同车型近期成交价按车龄及里程差异换算后取中位数
+type comparableStrategy struct {
+	r      ValuationRepo
+	d      depreciation
+	window time.Duration
+	limit  int
+}
+
+func (s comparableStrategy) Name() string {
+	return PricingStrategyComparable
+}
+
+func (s comparableStrategy) Estimate(ctx context.Context, in *ValuationInput) (*PriceEstimate, bool, error) {
+	if in.Car.ModelId == 0 {
+		return nil, false, nil
+	}
+	list, err := s.r.ListComparables(ctx, in.Car.ModelId, in.At.Add(-s.window), s.limit)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(list) == 0 {
+		return nil, false, nil
+	}
+
+	target := s.d.factor(in.AgeMonths, in.Mileage)
+	prices := make([]float64, 0, len(list))
+	for _, t := range list {
+		prices = append(prices, t.Price*target/s.d.factor(t.AgeMonths, t.Mileage))
+	}
+	sort.Float64s(prices)
+	value := prices[len(prices)/2]
+	if len(prices)%2 == 0 {
+		value = (prices[len(prices)/2-1] + value) / 2
+	}
+	return &PriceEstimate{Value: value, Comparables: len(list)}, true, nil
+}
+
+type ValuationUseCase struct {
+	r          ValuationRepo
+	cr         CarRepo
+	c          *conf.Valuation
+	d          depreciation
+	strategies map[string]PricingStrategy
+	log        *log.Helper
+}
+
+func NewValuationUseCase(r ValuationRepo, cr CarRepo, c *conf.Valuation, logger log.Logger) *ValuationUseCase {
+	d := depreciation{
+		annualRate:    orDefault(c.GetAnnualRate(), defaultAnnualRate),
+		mileageRate:   orDefault(c.GetMileageRate(), defaultMileageRate),
+		residualFloor: orDefault(c.GetResidualFloor(), defaultResidualFloor),
+	}
+	window := defaultComparableWindow
+	if c.GetComparableWindow() != nil {
+		window = c.GetComparableWindow().AsDuration()
+	}
+	limit := int(c.GetMaxComparables())
+	if limit <= 0 {
+		limit = defaultMaxComparables
+	}
+
+	uc := &ValuationUseCase{r: r, cr: cr, c: c, d: d, strategies: make(map[string]PricingStrategy), log: log.NewHelper(logger)}
+	uc.RegisterStrategy(depreciationStrategy{d: d, basePrices: c.GetBasePrices()})
+	uc.RegisterStrategy(comparableStrategy{r: r, d: d, window: window, limit: limit})
+	return uc
+}
+
+// RegisterStrategy 注册自定义估值策略，按名称在配置的strategies中启用，同名覆盖
+func (uc *ValuationUseCase) RegisterStrategy(s PricingStrategy) {
+	uc.strategies[s.Name()] = s
+}
+
+// GetValuation 估算汽车当前市场价，未提供里程时取最近的里程读数
+func (uc *ValuationUseCase) GetValuation(ctx context.Context, carId int64, mileage *int64) (*Valuation, error) {
+	c, err := uc.cr.GetById(ctx, carId)
+	if err != nil {
+		return nil, err
+	}
+	return uc.estimate(ctx, c, mileage, time.Now())
+}
+
+// recordTrade 记录交易的成交价及估值，无法估值时估值记为0，支持事务
+func (uc *ValuationUseCase) recordTrade(ctx context.Context, c *CarReply, buyerId int64, price *float64, mileage *int64) error {
+	now := time.Now()
+	v, err := uc.estimate(ctx, c, mileage, now)
+	if err != nil && err != ex.ValuationUnavailable {
+		return err
+	}
+	if v == nil {
+		v = &Valuation{Mileage: c.CurrentMileage, AgeMonths: ageMonths(c.RegisteredAt, now)}
+	}
+	_, err = uc.r.SaveTradeRecord(ctx, &TradeRecord{
+		TenantID:       &c.TenantId,
+		CarID:          &c.Id,
+		ModelID:        &c.ModelId,
+		SellerID:       &c.UserId,
+		BuyerID:        &buyerId,
+		Price:          price,
+		EstimatedValue: &v.Value,
+		Mileage:        &v.Mileage,
+		AgeMonths:      &v.AgeMonths,
+		TradedAt:       &now,
+	})
+	return err
+}
+
+// estimate 按配置的顺序依次尝试估值策略，取第一个能给出估值的结果
+func (uc *ValuationUseCase) estimate(ctx context.Context, c *CarReply, mileage *int64, at time.Time) (*Valuation, error) {
+	in := &ValuationInput{
+		Car:       c,
+		Mileage:   c.CurrentMileage,
+		AgeMonths: ageMonths(c.RegisteredAt, at),
+		At:        at,
+	}
+	if mileage != nil {
+		if *mileage < 0 {
+			return nil, ex.InvalidMileage
+		}
+		in.Mileage = *mileage
+	}
+
+	names := uc.c.GetStrategies()
+	if len(names) == 0 {
+		names = []string{PricingStrategyComparable, PricingStrategyDepreciation}
+	}
+	for _, name := range names {
+		s, ok := uc.strategies[name]
+		if !ok {
+			uc.log.WithContext(ctx).Warnf("未知的估值策略: %s", name)
+			continue
+		}
+		est, ok, err := s.Estimate(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		return &Valuation{
+			CarId:       c.Id,
+			ModelId:     c.ModelId,
+			Strategy:    name,
+			BasePrice:   uc.c.GetBasePrices()[c.ModelId],
+			Value:       roundPrice(est.Value),
+			Mileage:     in.Mileage,
+			AgeMonths:   in.AgeMonths,
+			Comparables: est.Comparables,
+			Curve:       uc.curve(in, est.Value),
+		}, nil
+	}
+	return nil, ex.ValuationUnavailable
+}
+
+// curve 以当前估值为锚点，按当前年均里程推算各车龄的估值
+func (uc *ValuationUseCase) curve(in *ValuationInput, value float64) []*ValuationPoint {
+	var perYear float64
+	if in.AgeMonths > 0 {
+		perYear = float64(in.Mileage) * 12 / float64(in.AgeMonths)
+	}
+	current := uc.d.factor(in.AgeMonths, in.Mileage)
+	points := make([]*ValuationPoint, 0, valuationCurveYears+1)
+	for y := 0; y <= valuationCurveYears; y++ {
+		f := uc.d.factor(y*12, int64(perYear*float64(y)))
+		points = append(points, &ValuationPoint{AgeYears: y, Value: roundPrice(value * f / current)})
+	}
+	return points
+}
+
+// ageMonths 登记至估值时点的整月数
+func ageMonths(registeredAt, at time.Time) int {
+	m := (at.Year()-registeredAt.Year())*12 + int(at.Month()-registeredAt.Month())
+	if at.Day() < registeredAt.Day() {
+		m--
+	}
+	if m < 0 {
+		return 0
+	}
+	return m
+}
+
+func roundPrice(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+func orDefault(v, def float64) float64 {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
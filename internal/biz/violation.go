@@ -0,0 +1,239 @@
+package biz
+
+import (
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+)
+
+// 违章处理状态
+const (
+	ViolationStatusUnpaid   = "unpaid"
+	ViolationStatusDisputed = "disputed"
+	ViolationStatusPaid     = "paid"
+	ViolationStatusWaived   = "waived"
+)
+
+// violationTransitions 违章处理状态流转，已缴纳和已撤销为终态
+var violationTransitions = map[string][]string{
+	ViolationStatusUnpaid:   {ViolationStatusDisputed, ViolationStatusPaid, ViolationStatusWaived},
+	ViolationStatusDisputed: {ViolationStatusUnpaid, ViolationStatusPaid, ViolationStatusWaived},
+}
+
+type Violation struct {
+	ID          int64
+	TenantID    *int64
+	CarID       *int64
+	UserID      *int64
+	ReferenceNo *string
+	OccurredAt  *time.Time
+	Location    *string
+	Amount      *float64
+	Description *string
+	Status      *string
+	ResolvedAt  *time.Time
+	ResolvedBy  *int64
+	CreatedAt   *time.Time
+}
+
+type ViolationReply struct {
+	Id          int64
+	CarId       int64
+	UserId      int64
+	ReferenceNo string
+	OccurredAt  time.Time
+	Location    string
+	Amount      float64
+	Description string
+	Status      string
+	ResolvedAt  *time.Time
+	ResolvedBy  int64
+	CreatedAt   time.Time
+}
+
+type ViolationFilter struct {
+	CarId  *int64
+	UserId *int64
+	Status *string
+}
+
+// ViolationImportResult Imported为新增数量，Skipped为编号重复跳过的数量
+type ViolationImportResult struct {
+	Imported int
+	Skipped  int
+}
+
+// OutstandingBalance 用户未结清（待缴纳及申诉中）的罚款
+type OutstandingBalance struct {
+	UserId int64
+	Count  int
+	Amount float64
+}
+
+type OwnerHistory struct {
+	ID        int64
+	TenantID  *int64
+	CarID     *int64
+	UserID    *int64
+	StartedAt *time.Time
+	EndedAt   *time.Time
+}
+
+type OwnerHistoryReply struct {
+	Id        int64
+	CarId     int64
+	UserId    int64
+	StartedAt time.Time
+	EndedAt   *time.Time
+}
+
+type ViolationRepo interface {
+	ListViolation(ctx context.Context, page, pageSize int, filter *ViolationFilter) ([]*ViolationReply, int, error)
+	GetById(ctx context.Context, id int64) (*ViolationReply, error)
+	ExistsReferenceNo(ctx context.Context, referenceNo string) (bool, error)
+	Save(context.Context, *Violation) (int64, error)
+	// Transit 仅当处理状态仍为from时更新，否则返回状态冲突
+	Transit(ctx context.Context, v *Violation, from string) error
+	OutstandingBalance(ctx context.Context, userId int64) (*OutstandingBalance, error)
+	ListOwnerHistory(ctx context.Context, carId int64) ([]*OwnerHistoryReply, error)
+	// OwnerAt 查询某一时刻的车主，无持有记录时返回false
+	OwnerAt(ctx context.Context, carId int64, at time.Time) (int64, bool, error)
+}
+
+type ViolationUseCase struct {
+	r   ViolationRepo
+	cr  CarRepo
+	tx  Transaction
+	log *log.Helper
+}
+
+func NewViolationUseCase(r ViolationRepo, cr CarRepo, tx Transaction, logger log.Logger) *ViolationUseCase {
+	return &ViolationUseCase{r: r, cr: cr, tx: tx, log: log.NewHelper(logger)}
+}
+
+func (uc *ViolationUseCase) ListViolation(ctx context.Context,
+	page, pageSize int, filter *ViolationFilter) ([]*ViolationReply, int, error) {
+	return uc.r.ListViolation(ctx, page, pageSize, filter)
+}
+
+func (uc *ViolationUseCase) GetViolationById(ctx context.Context, id int64) (*ViolationReply, error) {
+	return uc.r.GetById(ctx, id)
+}
+
+// ImportViolations 导入违章，罚款归属违章发生时的车主，编号重复的跳过
+func (uc *ViolationUseCase) ImportViolations(ctx context.Context, violations []*Violation) (*ViolationImportResult, error) {
+	for _, v := range violations {
+		if v.CarID == nil {
+			return nil, ex.CarIdRequired
+		}
+		if v.OccurredAt == nil {
+			return nil, ex.ViolationTimeRequired
+		}
+		if v.Amount == nil || *v.Amount < 0 {
+			return nil, ex.InvalidViolationAmount
+		}
+		if v.ReferenceNo != nil {
+			no := strings.TrimSpace(*v.ReferenceNo)
+			v.ReferenceNo = &no
+		}
+	}
+	// 查询汽车会调用用户服务，在事务外完成
+	cars := make(map[int64]*CarReply)
+	for _, v := range violations {
+		if _, ok := cars[*v.CarID]; ok {
+			continue
+		}
+		c, err := uc.cr.GetById(ctx, *v.CarID)
+		if err != nil {
+			return nil, err
+		}
+		cars[*v.CarID] = c
+	}
+
+	rsp := &ViolationImportResult{}
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		for _, v := range violations {
+			if v.ReferenceNo != nil && *v.ReferenceNo != "" {
+				exists, err := uc.r.ExistsReferenceNo(ctx, *v.ReferenceNo)
+				if err != nil {
+					return err
+				}
+				if exists {
+					rsp.Skipped++
+					continue
+				}
+			}
+			c := cars[*v.CarID]
+			userId, err := uc.ownerAt(ctx, c.Id, *v.OccurredAt)
+			if err != nil {
+				return err
+			}
+			v.UserID = &userId
+			v.TenantID = &c.TenantId
+			if _, err := uc.r.Save(ctx, v); err != nil {
+				return err
+			}
+			rsp.Imported++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// ResolveViolation 推进违章处理状态并记录操作人，进入终态时记录处理时间；撤销罚款仅管理员可操作
+func (uc *ViolationUseCase) ResolveViolation(ctx context.Context, id int64, status string) error {
+	actor, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if status == ViolationStatusWaived && !auth.IsAdmin(ctx) {
+		return ex.ViolationWaiveForbidden
+	}
+	v, err := uc.r.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	allowed := false
+	for _, s := range violationTransitions[v.Status] {
+		if s == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ex.ViolationStatusConflict
+	}
+
+	update := &Violation{ID: id, Status: &status, ResolvedBy: &actor}
+	if _, ok := violationTransitions[status]; !ok {
+		now := time.Now()
+		update.ResolvedAt = &now
+	}
+	return uc.r.Transit(ctx, update, v.Status)
+}
+
+func (uc *ViolationUseCase) GetOutstandingBalance(ctx context.Context, userId int64) (*OutstandingBalance, error) {
+	return uc.r.OutstandingBalance(ctx, userId)
+}
+
+func (uc *ViolationUseCase) ListOwnerHistory(ctx context.Context, carId int64) ([]*OwnerHistoryReply, error) {
+	return uc.r.ListOwnerHistory(ctx, carId)
+}
+
+// ownerAt 违章发生时的车主，没有覆盖该时刻的持有记录时无法确定车主，不归属给当前车主
+func (uc *ViolationUseCase) ownerAt(ctx context.Context, carId int64, at time.Time) (int64, error) {
+	userId, ok, err := uc.r.OwnerAt(ctx, carId, at)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ex.ViolationOwnerUnknown
+	}
+	return userId, nil
+}
@@ -0,0 +1,271 @@
+package biz
+
+import (
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+)
+
+// 质保检查结果
+const (
+	WarrantyResultCovered         = "covered"
+	WarrantyResultNoWarranty      = "no_warranty"
+	WarrantyResultNotCovered      = "component_not_covered"
+	WarrantyResultNotStarted      = "not_started"
+	WarrantyResultExpired         = "expired"
+	WarrantyResultMileageExceeded = "mileage_exceeded"
+)
+
+type WarrantyDefinition struct {
+	ID             int64
+	ModelID        *int64
+	Name           string
+	Components     *string
+	DurationMonths *int
+	MileageLimit   *int64
+	CreatedAt      *time.Time
+}
+
+type WarrantyDefinitionReply struct {
+	Id             int64
+	ModelId        int64
+	Name           string
+	Components     []string
+	DurationMonths int
+	MileageLimit   int64
+	CreatedAt      time.Time
+}
+
+type CarWarranty struct {
+	ID           int64
+	TenantID     *int64
+	CarID        *int64
+	DefinitionID *int64
+	Name         *string
+	Components   *string
+	StartAt      *time.Time
+	EndAt        *time.Time
+	MileageLimit *int64
+	CreatedAt    *time.Time
+}
+
+type CarWarrantyReply struct {
+	Id           int64
+	CarId        int64
+	DefinitionId int64
+	Name         string
+	Components   []string
+	StartAt      time.Time
+	EndAt        time.Time
+	MileageLimit int64
+}
+
+// Covers 是否覆盖该部件，未列出部件表示整车质保
+func (w *CarWarrantyReply) Covers(component string) bool {
+	if len(w.Components) == 0 {
+		return true
+	}
+	for _, c := range w.Components {
+		if strings.EqualFold(c, component) {
+			return true
+		}
+	}
+	return false
+}
+
+// check 部件已覆盖时，检查日期及里程是否在质保范围内
+func (w *CarWarrantyReply) check(date time.Time, mileage int64) string {
+	if date.Before(w.StartAt) {
+		return WarrantyResultNotStarted
+	}
+	if !date.Before(w.EndAt) {
+		return WarrantyResultExpired
+	}
+	if w.MileageLimit > 0 && mileage > w.MileageLimit {
+		return WarrantyResultMileageExceeded
+	}
+	return WarrantyResultCovered
+}
+
+// WarrantyCheck 质保检查结果，Warranty为覆盖该部件的质保，无则为空
+type WarrantyCheck struct {
+	CarId     int64
+	Component string
+	Date      time.Time
+	Mileage   int64
+	Covered   bool
+	Result    string
+	Warranty  *CarWarrantyReply
+}
+
+type WarrantyRepo interface {
+	ListDefinition(ctx context.Context, modelId int64) ([]*WarrantyDefinitionReply, error)
+	GetDefinitionById(ctx context.Context, id int64) (*WarrantyDefinitionReply, error)
+	SaveDefinition(context.Context, *WarrantyDefinition) (int64, error)
+	UpdateDefinition(context.Context, *WarrantyDefinition) error
+	// DeleteDefinition 已生成的汽车质保保留
+	DeleteDefinition(ctx context.Context, id int64) error
+	ListCarWarranty(ctx context.Context, carId int64) ([]*CarWarrantyReply, error)
+	SaveCarWarranties(context.Context, []*CarWarranty) error
+}
+
+type WarrantyUseCase struct {
+	r   WarrantyRepo
+	cr  CarRepo
+	log *log.Helper
+}
+
+func NewWarrantyUseCase(r WarrantyRepo, cr CarRepo, logger log.Logger) *WarrantyUseCase {
+	return &WarrantyUseCase{r: r, cr: cr, log: log.NewHelper(logger)}
+}
+
+func (uc *WarrantyUseCase) ListDefinition(ctx context.Context, modelId int64) ([]*WarrantyDefinitionReply, error) {
+	return uc.r.ListDefinition(ctx, modelId)
+}
+
+func (uc *WarrantyUseCase) SaveDefinition(ctx context.Context, d *WarrantyDefinition, components []string) (int64, error) {
+	if err := fillWarrantyDefinition(d, components); err != nil {
+		return 0, err
+	}
+	return uc.r.SaveDefinition(ctx, d)
+}
+
+// UpdateDefinition 只影响之后生成的汽车质保，车型不可修改
+func (uc *WarrantyUseCase) UpdateDefinition(ctx context.Context, d *WarrantyDefinition, components []string) error {
+	old, err := uc.r.GetDefinitionById(ctx, d.ID)
+	if err != nil {
+		return err
+	}
+	d.ModelID = &old.ModelId
+	if err := fillWarrantyDefinition(d, components); err != nil {
+		return err
+	}
+	return uc.r.UpdateDefinition(ctx, d)
+}
+
+func (uc *WarrantyUseCase) DeleteDefinition(ctx context.Context, id int64) error {
+	return uc.r.DeleteDefinition(ctx, id)
+}
+
+func (uc *WarrantyUseCase) ListCarWarranty(ctx context.Context, carId int64) ([]*CarWarrantyReply, error) {
+	c, err := uc.cr.GetById(ctx, carId)
+	if err != nil {
+		return nil, err
+	}
+	return uc.ensureCarWarranties(ctx, c)
+}
+
+// CheckWarranty 检查部件在指定日期和里程下是否在质保范围内，默认取当前时间及最近的里程读数
+func (uc *WarrantyUseCase) CheckWarranty(ctx context.Context, carId int64, component string, date *time.Time, mileage *int64) (*WarrantyCheck, error) {
+	component = strings.TrimSpace(component)
+	if component == "" {
+		return nil, ex.WarrantyComponentRequired
+	}
+	c, err := uc.cr.GetById(ctx, carId)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &WarrantyCheck{CarId: carId, Component: component, Date: time.Now(), Mileage: c.CurrentMileage}
+	if date != nil {
+		rsp.Date = *date
+	}
+	if mileage != nil {
+		if *mileage < 0 {
+			return nil, ex.InvalidMileage
+		}
+		rsp.Mileage = *mileage
+	}
+
+	warranties, err := uc.ensureCarWarranties(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Result = WarrantyResultNoWarranty
+	if len(warranties) > 0 {
+		rsp.Result = WarrantyResultNotCovered
+	}
+	for _, w := range warranties {
+		if !w.Covers(component) {
+			continue
+		}
+		rsp.Result = w.check(rsp.Date, rsp.Mileage)
+		rsp.Warranty = w
+		if rsp.Result == WarrantyResultCovered {
+			rsp.Covered = true
+			break
+		}
+	}
+	return rsp, nil
+}
+
+// ensureCarWarranties 按车型条款补齐汽车的质保，质保自登记时间起算
+func (uc *WarrantyUseCase) ensureCarWarranties(ctx context.Context, c *CarReply) ([]*CarWarrantyReply, error) {
+	list, err := uc.r.ListCarWarranty(ctx, c.Id)
+	if err != nil {
+		return nil, err
+	}
+	if c.ModelId == 0 {
+		return list, nil
+	}
+	defs, err := uc.r.ListDefinition(ctx, c.ModelId)
+	if err != nil {
+		return nil, err
+	}
+
+	exists := make(map[int64]bool, len(list))
+	for _, w := range list {
+		exists[w.DefinitionId] = true
+	}
+	missing := make([]*CarWarranty, 0)
+	for _, d := range defs {
+		if exists[d.Id] {
+			continue
+		}
+		d := d
+		components, _ := json.Marshal(d.Components)
+		v := string(components)
+		endAt := c.RegisteredAt.AddDate(0, d.DurationMonths, 0)
+		missing = append(missing, &CarWarranty{
+			TenantID:     &c.TenantId,
+			CarID:        &c.Id,
+			DefinitionID: &d.Id,
+			Name:         &d.Name,
+			Components:   &v,
+			StartAt:      &c.RegisteredAt,
+			EndAt:        &endAt,
+			MileageLimit: &d.MileageLimit,
+		})
+	}
+	if len(missing) == 0 {
+		return list, nil
+	}
+	if err := uc.r.SaveCarWarranties(ctx, missing); err != nil {
+		return nil, err
+	}
+	// 重新查询，包含并发请求创建的质保
+	return uc.r.ListCarWarranty(ctx, c.Id)
+}
+
+// fillWarrantyDefinition 校验质保条款并序列化覆盖部件
+func fillWarrantyDefinition(d *WarrantyDefinition, components []string) error {
+	if d.ModelID == nil || *d.ModelID == 0 {
+		return ex.ModelIdRequired
+	}
+	d.Name = strings.TrimSpace(d.Name)
+	if d.Name == "" {
+		return ex.WarrantyNameRequired
+	}
+	if d.DurationMonths == nil || *d.DurationMonths <= 0 {
+		return ex.InvalidWarrantyDuration
+	}
+	if d.MileageLimit != nil && *d.MileageLimit < 0 {
+		return ex.InvalidMileage
+	}
+	b, _ := json.Marshal(normalizeTags(components))
+	v := string(b)
+	d.Components = &v
+	return nil
+}
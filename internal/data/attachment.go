@@ -0,0 +1,77 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/attachment"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type attachmentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAttachmentRepo(data *Data, logger log.Logger) biz.AttachmentRepo {
+	return &attachmentRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r attachmentRepo) ListByCar(ctx context.Context, carId int64) ([]*biz.AttachmentReply, error) {
+	attachments, err := r.data.db.Attachment.Query().
+		Where(attachment.CarID(carId)).
+		Order(ent.Desc(attachment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.AttachmentReply, 0, len(attachments))
+	for _, a := range attachments {
+		list = append(list, convertAttachment(a))
+	}
+	return list, nil
+}
+
+func (r attachmentRepo) GetById(ctx context.Context, id int64) (*biz.AttachmentReply, error) {
+	a, err := r.data.db.Attachment.Get(ctx, id)
+	if err != nil {
+		return nil, ex.AttachmentNotFound
+	}
+	return convertAttachment(a), nil
+}
+
+func (r attachmentRepo) Save(ctx context.Context, a *biz.Attachment) (int64, error) {
+	rsp, err := r.data.db.Attachment.
+		Create().
+		SetAttachment(a).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r attachmentRepo) Delete(ctx context.Context, id int64) error {
+	return r.data.db.Attachment.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func convertAttachment(a *ent.Attachment) *biz.AttachmentReply {
+	return &biz.AttachmentReply{
+		Id:          a.ID,
+		CarId:       a.CarID,
+		Kind:        a.Kind,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Checksum:    a.Checksum,
+		StorageKey:  a.StorageKey,
+		CreatedAt:   a.CreatedAt,
+	}
+}
@@ -0,0 +1,150 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/carattribute"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type attributeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAttributeRepo(data *Data, logger log.Logger) biz.AttributeRepo {
+	return &attributeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r attributeRepo) ListDefinition(ctx context.Context, tenantId int64) ([]*biz.AttributeDefinitionReply, error) {
+	defs, err := r.data.db.AttributeDefinition.Query().
+		Where(attributedefinition.TenantID(tenantId)).
+		Order(ent.Asc(attributedefinition.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.AttributeDefinitionReply, 0, len(defs))
+	for _, d := range defs {
+		list = append(list, convertAttributeDefinition(d))
+	}
+	return list, nil
+}
+
+func (r attributeRepo) GetDefinitionById(ctx context.Context, id int64) (*biz.AttributeDefinitionReply, error) {
+	d, err := r.data.db.AttributeDefinition.Get(ctx, id)
+	if err != nil {
+		return nil, ex.AttributeDefinitionNotFound
+	}
+	return convertAttributeDefinition(d), nil
+}
+
+func (r attributeRepo) SaveDefinition(ctx context.Context, d *biz.AttributeDefinition) (int64, error) {
+	rsp, err := r.data.db.AttributeDefinition.
+		Create().
+		SetAttributeDefinition(d).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r attributeRepo) UpdateDefinition(ctx context.Context, d *biz.AttributeDefinition) error {
+	return r.data.db.AttributeDefinition.
+		Update().
+		Where(attributedefinition.ID(d.ID)).
+		SetAttributeDefinition(d).
+		Exec(ctx)
+}
+
+func (r attributeRepo) DeleteDefinition(ctx context.Context, id int64) error {
+	if _, err := r.data.db.AttributeDefinition.Get(ctx, id); err != nil {
+		return ex.AttributeDefinitionNotFound
+	}
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		_, err := r.data.CarAttribute(ctx).
+			Delete().
+			Where(carattribute.DefinitionID(id)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return r.data.AttributeDefinition(ctx).
+			DeleteOneID(id).
+			Exec(ctx)
+	})
+}
+
+func (r attributeRepo) SetCarAttributes(ctx context.Context, carId int64, values []*biz.CarAttribute) error {
+	if len(values) == 0 {
+		return nil
+	}
+	defIds := make([]int64, 0, len(values))
+	bulk := make([]*ent.CarAttributeCreate, 0, len(values))
+	for _, v := range values {
+		defIds = append(defIds, *v.DefinitionID)
+		if v.Value == "" {
+			continue
+		}
+		v.CarID = &carId
+		bulk = append(bulk, r.data.CarAttribute(ctx).Create().SetCarAttribute(v))
+	}
+
+	// 先删除旧值再写入
+	_, err := r.data.CarAttribute(ctx).
+		Delete().
+		Where(carattribute.CarID(carId), carattribute.DefinitionIDIn(defIds...)).
+		Exec(ctx)
+	if err != nil || len(bulk) == 0 {
+		return err
+	}
+	return r.data.CarAttribute(ctx).
+		CreateBulk(bulk...).
+		Exec(ctx)
+}
+
+// carAttributes 查询汽车的自定义属性，按属性名称返回
+func (d *Data) carAttributes(ctx context.Context, carIds ...int64) (map[int64]map[string]string, error) {
+	values, err := d.db.CarAttribute.Query().
+		Where(carattribute.CarIDIn(carIds...)).
+		WithDefinition().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int64]map[string]string, len(carIds))
+	for _, v := range values {
+		if v.Edges.Definition == nil {
+			continue
+		}
+		if m[v.CarID] == nil {
+			m[v.CarID] = make(map[string]string)
+		}
+		m[v.CarID][v.Edges.Definition.Name] = v.Value
+	}
+	return m, nil
+}
+
+func convertAttributeDefinition(d *ent.AttributeDefinition) *biz.AttributeDefinitionReply {
+	reply := &biz.AttributeDefinitionReply{
+		Id:        d.ID,
+		Name:      d.Name,
+		ValueType: d.ValueType,
+		Required:  d.Required,
+		CreatedAt: d.CreatedAt,
+	}
+	if d.EnumValues != "" {
+		_ = json.Unmarshal([]byte(d.EnumValues), &reply.EnumValues)
+	}
+	return reply
+}
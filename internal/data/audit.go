@@ -0,0 +1,189 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/auditlog"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/pkg/auth"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type auditLogRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAuditLogRepo(data *Data, logger log.Logger) biz.AuditLogRepo {
+	return &auditLogRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r auditLogRepo) ListAuditLog(ctx context.Context, page, pageSize int, filter *biz.AuditLogFilter) ([]*biz.AuditLogReply, int, error) {
+	var list []*biz.AuditLogReply
+	// 组装查询条件
+	cond := make([]predicate.AuditLog, 0)
+	if filter.CarId != nil {
+		cond = append(cond, auditlog.CarID(*filter.CarId))
+	}
+	if filter.Actor != nil {
+		cond = append(cond, auditlog.Actor(*filter.Actor))
+	}
+	if filter.StartTime != nil {
+		cond = append(cond, auditlog.CreatedAtGTE(*filter.StartTime))
+	}
+	if filter.EndTime != nil {
+		cond = append(cond, auditlog.CreatedAtLT(*filter.EndTime))
+	}
+
+	q := r.data.db.AuditLog.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	logs := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID)).
+		AllX(ctx)
+
+	for _, l := range logs {
+		list = append(list, &biz.AuditLogReply{
+			Id:        l.ID,
+			CarId:     l.CarID,
+			Op:        l.Op,
+			Actor:     l.Actor,
+			Diff:      l.Diff,
+			TraceId:   l.TraceID,
+			CreatedAt: l.CreatedAt,
+		})
+	}
+	return list, total, nil
+}
+
+// carAuditHook 记录汽车的每一次变更
+func carAuditHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		cm, ok := m.(*ent.CarMutation)
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+
+		// 变更前先查出旧值及所属租户，审计记录与汽车属于同一租户
+		olds := make(map[int64]map[string]json.RawMessage)
+		tenants := make(map[int64]int64)
+		if !cm.Op().Is(ent.OpCreate) {
+			ids, err := cm.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			cars, err := cm.Client().Car.Query().Where(car.IDIn(ids...)).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range cars {
+				olds[c.ID] = toFieldMap(c)
+				tenants[c.ID] = c.TenantID
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		builders := make([]*ent.AuditLogCreate, 0)
+		if c, ok := v.(*ent.Car); ok && cm.Op().Is(ent.OpCreate) {
+			diff := diffFields(nil, toFieldMap(c))
+			builders = append(builders, newAuditLog(ctx, cm, c.ID, c.TenantID, diff))
+		} else {
+			for id, old := range olds {
+				var diff map[string]*fieldChange
+				if cm.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					diff = diffFields(old, nil)
+				} else {
+					diff = diffFields(old, mutatedFields(cm, old))
+				}
+				if len(diff) == 0 {
+					continue
+				}
+				builders = append(builders, newAuditLog(ctx, cm, id, tenants[id], diff))
+			}
+		}
+		if len(builders) > 0 {
+			if err := cm.Client().AuditLog.CreateBulk(builders...).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
+
+type fieldChange struct {
+	Old json.RawMessage `json:"old,omitempty"`
+	New json.RawMessage `json:"new,omitempty"`
+}
+
+func newAuditLog(ctx context.Context, m *ent.CarMutation, carId, tenantId int64,
+	diff map[string]*fieldChange) *ent.AuditLogCreate {
+	b, _ := json.Marshal(diff)
+	c := m.Client().AuditLog.Create().
+		SetTenantID(tenantId).
+		SetCarID(carId).
+		SetOp(m.Op().String()).
+		SetDiff(string(b))
+	if actor, ok := auth.GetUserId(ctx); ok {
+		c.SetActor(actor)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		c.SetTraceID(sc.TraceID().String())
+	}
+	return c
+}
+
+// toFieldMap 将实体按字段名展开
+func toFieldMap(c *ent.Car) map[string]json.RawMessage {
+	fields := make(map[string]json.RawMessage)
+	b, _ := json.Marshal(c)
+	_ = json.Unmarshal(b, &fields)
+	delete(fields, car.FieldID)
+	delete(fields, "edges")
+	return fields
+}
+
+// mutatedFields 将本次变更合并到旧值上
+func mutatedFields(m *ent.CarMutation, old map[string]json.RawMessage) map[string]json.RawMessage {
+	fields := make(map[string]json.RawMessage, len(old))
+	for k, v := range old {
+		fields[k] = v
+	}
+	for _, name := range m.Fields() {
+		if v, ok := m.Field(name); ok {
+			fields[name], _ = json.Marshal(v)
+		}
+	}
+	for _, name := range m.ClearedFields() {
+		delete(fields, name)
+	}
+	return fields
+}
+
+func diffFields(old, new map[string]json.RawMessage) map[string]*fieldChange {
+	diff := make(map[string]*fieldChange)
+	for k, v := range old {
+		if string(new[k]) != string(v) {
+			diff[k] = &fieldChange{Old: v, New: new[k]}
+		}
+	}
+	for k, v := range new {
+		if _, ok := old[k]; !ok {
+			diff[k] = &fieldChange{New: v}
+		}
+	}
+	return diff
+}
@@ -0,0 +1,118 @@
+package data
+
+import (
+	"car-service/internal/data/ent"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCarAuditDiff(t *testing.T) {
+	registeredAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	old := &ent.Car{
+		ID:           1,
+		TenantID:     2,
+		UserID:       10,
+		Model:        "Model 3",
+		Vin:          "VIN0001",
+		Plate:        "沪A00001",
+		OwnerName:    "张三",
+		RegisteredAt: registeredAt,
+	}
+	client := ent.NewClient()
+	raw := func(v interface{}) string {
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+
+	tests := []struct {
+		name string
+		// nil表示删除
+		mutation *ent.CarMutation
+		create   bool
+		want     map[string][2]string
+	}{
+		{
+			name:     "update changed fields only",
+			mutation: client.Car.UpdateOneID(1).SetPlate("沪B00002").SetUserID(11).SetModel("Model 3").Mutation(),
+			want: map[string][2]string{
+				"plate":   {raw("沪A00001"), raw("沪B00002")},
+				"user_id": {raw(10), raw(11)},
+			},
+		},
+		{
+			name:     "update without changes",
+			mutation: client.Car.UpdateOneID(1).SetVin("VIN0001").Mutation(),
+			want:     map[string][2]string{},
+		},
+		{
+			name:     "set previously empty field",
+			mutation: client.Car.UpdateOneID(1).SetModelID(5).Mutation(),
+			want: map[string][2]string{
+				"model_id": {"", raw(5)},
+			},
+		},
+		{
+			name:     "clear field",
+			mutation: client.Car.UpdateOneID(1).ClearPlate().Mutation(),
+			want: map[string][2]string{
+				"plate": {raw("沪A00001"), ""},
+			},
+		},
+		{
+			name: "delete",
+			want: map[string][2]string{
+				"tenant_id":     {raw(2), ""},
+				"user_id":       {raw(10), ""},
+				"model":         {raw("Model 3"), ""},
+				"vin":           {raw("VIN0001"), ""},
+				"plate":         {raw("沪A00001"), ""},
+				"owner_name":    {raw("张三"), ""},
+				"registered_at": {raw(registeredAt), ""},
+			},
+		},
+		{
+			name:   "create",
+			create: true,
+			want: map[string][2]string{
+				"tenant_id":     {"", raw(2)},
+				"user_id":       {"", raw(10)},
+				"model":         {"", raw("Model 3")},
+				"vin":           {"", raw("VIN0001")},
+				"plate":         {"", raw("沪A00001")},
+				"owner_name":    {"", raw("张三")},
+				"registered_at": {"", raw(registeredAt)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diff map[string]*fieldChange
+			switch {
+			case tt.create:
+				diff = diffFields(nil, toFieldMap(old))
+			case tt.mutation == nil:
+				diff = diffFields(toFieldMap(old), nil)
+			default:
+				fields := toFieldMap(old)
+				diff = diffFields(fields, mutatedFields(tt.mutation, fields))
+			}
+			if len(diff) != len(tt.want) {
+				t.Errorf("diff has %d fields, want %d: %s", len(diff), len(tt.want), raw(diff))
+			}
+			for k, want := range tt.want {
+				got, ok := diff[k]
+				if !ok {
+					t.Errorf("diff missing field %s", k)
+					continue
+				}
+				if string(got.Old) != want[0] || string(got.New) != want[1] {
+					t.Errorf("diff[%s] = %s -> %s, want %s -> %s", k, got.Old, got.New, want[0], want[1])
+				}
+			}
+			if _, ok := diff["id"]; ok {
+				t.Errorf("diff contains id")
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/conf"
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var errInvalidBlobKey = errors.New("invalid blob key")
+
+// NewBlobStore 按配置选择附件存储，默认使用本地文件系统
+func NewBlobStore(conf *conf.Data, logger log.Logger) biz.BlobStore {
+	thisLog := log.NewHelper(logger)
+
+	c := conf.GetBlob()
+	switch c.GetDriver() {
+	case "s3":
+		s3 := c.GetS3()
+		client, err := minio.New(s3.Endpoint, &minio.Options{
+			Creds:  credentials.NewStaticV4(s3.AccessKey, s3.SecretKey, ""),
+			Secure: s3.UseSsl,
+			Region: s3.Region,
+		})
+		if err != nil {
+			thisLog.Fatalf("对象存储连接失败: %v", err)
+		}
+		return &s3BlobStore{client: client, bucket: s3.Bucket}
+	default:
+		return &localBlobStore{root: c.GetLocal().GetRoot()}
+	}
+}
+
+// localBlobStore 本地文件系统存储
+type localBlobStore struct {
+	root string
+}
+
+func (s *localBlobStore) Put(_ context.Context, key string, r io.Reader, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// 先写临时文件再重命名，避免读到写了一半的内容
+	f, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (s *localBlobStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (s *localBlobStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *localBlobStore) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" || strings.Contains(key, "..") {
+		return "", errInvalidBlobKey
+	}
+	return filepath.Join(s.root, clean), nil
+}
+
+// s3BlobStore S3兼容的对象存储，本地可使用MinIO
+type s3BlobStore struct {
+	client *minio.Client
+	bucket string
+}
+
+func (s *s3BlobStore) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, -1, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s *s3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+}
+
+func (s *s3BlobStore) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
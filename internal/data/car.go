@@ -3,14 +3,21 @@ package data
 import (
 	"car-service/internal/biz"
 	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/attributedefinition"
 	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/fleet"
 	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/tag"
 	ex "car-service/internal/pkg/errors"
 	"context"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
 	userV1 "github.com/lovechung/api-base/api/user"
 	"github.com/lovechung/go-kit/util/pagination"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"strings"
 )
 
 type carRepo struct {
@@ -25,12 +32,26 @@ func NewCarRepo(data *Data, logger log.Logger) biz.CarRepo {
 	}
 }
 
-func (r carRepo) ListCar(ctx context.Context, page, pageSize int, model *string) ([]*biz.CarReply, int, error) {
-	var list []*biz.CarReply
+func (r carRepo) ListCar(ctx context.Context, page, pageSize int, filter *biz.CarFilter) ([]*biz.CarReply, int, error) {
 	// 组装查询条件
 	cond := make([]predicate.Car, 0)
-	if model != nil {
-		cond = append(cond, car.ModelContains(*model))
+	if filter.Model != nil {
+		cond = append(cond, car.ModelContains(*filter.Model))
+	}
+	if filter.FleetId != nil {
+		cond = append(cond, car.HasFleetsWith(fleet.ID(*filter.FleetId)))
+	}
+	if filter.Tag != nil {
+		cond = append(cond, car.HasTagsWith(tag.Name(*filter.Tag)))
+	}
+	if filter.FreeFrom != nil && filter.FreeTo != nil {
+		cond = append(cond, car.Not(car.HasReservationsWith(overlaps(*filter.FreeFrom, *filter.FreeTo))))
+	}
+	for name, value := range filter.Attributes {
+		cond = append(cond, car.HasAttributesWith(
+			carattribute.Value(value),
+			carattribute.HasDefinitionWith(attributedefinition.Name(name)),
+		))
 	}
 
 	q := r.data.db.Car.Query().Where(cond...)
@@ -42,37 +63,72 @@ func (r carRepo) ListCar(ctx context.Context, page, pageSize int, model *string)
 		Order(ent.Desc(car.FieldRegisteredAt)).
 		AllX(ctx)
 
-	if len(cars) == 0 {
-		return list, 0, nil
+	list, err := r.toCarReplies(ctx, cars)
+	if err != nil || len(list) == 0 {
+		return list, 0, err
 	}
-	// 查询用户名称
-	userIds := make([]int64, 0)
-	for _, c := range cars {
-		userIds = append(userIds, c.UserID)
+	return list, total, nil
+}
+
+func (r carRepo) SearchCars(ctx context.Context, keyword string, limit int) ([]*biz.CarSearchHit, error) {
+	var v []struct {
+		ID        int64   `json:"id"`
+		Relevance float64 `json:"relevance"`
+	}
+	err := r.data.db.Car.Query().
+		Limit(limit).
+		Modify(func(s *sql.Selector) {
+			match := fmt.Sprintf("MATCH(%s) AGAINST(? IN NATURAL LANGUAGE MODE)", strings.Join([]string{
+				s.C(car.FieldModel), s.C(car.FieldVin), s.C(car.FieldPlate), s.C(car.FieldOwnerName),
+			}, ", "))
+			s.Select(s.C(car.FieldID)).
+				AppendSelectExprAs(sql.ExprP(match, keyword), "relevance").
+				Where(sql.ExprP(match, keyword)).
+				OrderExpr(sql.ExprP(match+" DESC", keyword))
+		}).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if len(v) == 0 {
+		return []*biz.CarSearchHit{}, nil
 	}
 
-	// grpc调用
-	reply, err := r.data.uc.GetUserNameMap(ctx, &userV1.UserIdsReq{Ids: userIds})
+	ids := make([]int64, 0, len(v))
+	for _, c := range v {
+		ids = append(ids, c.ID)
+	}
+	cars, err := r.data.db.Car.Query().Where(car.IDIn(ids...)).All(ctx)
 	if err != nil {
-		return list, 0, err
+		return nil, err
 	}
-	for _, c := range cars {
-		list = append(list, &biz.CarReply{
-			Id:           c.ID,
-			Model:        c.Model,
-			RegisteredAt: c.RegisteredAt,
-			UserName:     reply.NameMap[c.UserID],
-		})
+	replies, err := r.toCarReplies(ctx, cars)
+	if err != nil {
+		return nil, err
+	}
+	replyMap := make(map[int64]*biz.CarReply, len(replies))
+	for _, c := range replies {
+		replyMap[c.Id] = c
 	}
 
-	return list, total, nil
+	// 保持全文相关度顺序
+	hits := make([]*biz.CarSearchHit, 0, len(v))
+	for _, c := range v {
+		if reply, ok := replyMap[c.ID]; ok {
+			hits = append(hits, &biz.CarSearchHit{Car: reply, Relevance: c.Relevance})
+		}
+	}
+	return hits, nil
 }
 
 func (r carRepo) GetById(ctx context.Context, id int64) (*biz.CarReply, error) {
 	c, err := r.data.db.Car.Get(ctx, id)
-	if err != nil {
+	if ent.IsNotFound(err) {
 		return nil, ex.CarNotFound
 	}
+	if err != nil {
+		return nil, err
+	}
 
 	// grpc调用
 	reply, err := r.data.uc.GetUserName(ctx, &wrapperspb.Int64Value{Value: c.UserID})
@@ -80,32 +136,277 @@ func (r carRepo) GetById(ctx context.Context, id int64) (*biz.CarReply, error) {
 		return nil, err
 	}
 
+	// 查询当前里程
+	mileage, err := r.data.currentMileage(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询标签
+	tags, err := r.data.carTags(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询自定义属性
+	attrs, err := r.data.carAttributes(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &biz.CarReply{
-		Id:           c.ID,
-		Model:        c.Model,
-		RegisteredAt: c.RegisteredAt,
-		UserName:     reply.Value,
+		Id:              c.ID,
+		TenantId:        c.TenantID,
+		UserId:          c.UserID,
+		Model:           c.Model,
+		ModelId:         c.ModelID,
+		Vin:             c.Vin,
+		Plate:           c.Plate,
+		RegisteredAt:    c.RegisteredAt,
+		UserName:        reply.Value,
+		CurrentMileage:  mileage[c.ID],
+		Tags:            tags[c.ID],
+		Attributes:      attrs[c.ID],
+		BatteryCapacity: c.BatteryCapacity,
+		BatterySoh:      c.BatterySoh,
 	}, nil
 }
 
+func (r carRepo) CountByTenant(ctx context.Context, tenantId int64) (int, error) {
+	return r.data.Car(ctx).Query().
+		Where(car.TenantID(tenantId)).
+		Count(ctx)
+}
+
+func (r carRepo) LockById(ctx context.Context, id int64) error {
+	_, err := r.data.Car(ctx).Query().
+		Where(car.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return ex.CarNotFound
+	}
+	return err
+}
+
+// LockTenant 写入或更新租户的锁记录，行锁持有到事务结束，锁表见migrations/0004_tenant_lock.sql
+func (r carRepo) LockTenant(ctx context.Context, tenantId int64) error {
+	const query = "INSERT INTO `tenant_lock` (`tenant_id`) VALUES (?) ON DUPLICATE KEY UPDATE `tenant_id` = `tenant_id`"
+	if tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx); ok {
+		_, err := tx.ExecContext(ctx, query, tenantId)
+		return err
+	}
+	_, err := r.data.db.ExecContext(ctx, query, tenantId)
+	return err
+}
+
+// 汽车的变更均在事务中执行，与审计钩子写入的审计记录一起提交
+
 func (r carRepo) Save(ctx context.Context, c *biz.Car) (int64, error) {
-	rsp, err := r.data.db.Car.
-		Create().
-		SetCar(c).
-		Save(ctx)
-	return rsp.ID, err
+	var id int64
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		rsp, err := r.data.Car(ctx).
+			Create().
+			SetCar(c).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		id = rsp.ID
+		return nil
+	})
+	return id, err
 }
 
 func (r carRepo) Update(ctx context.Context, c *biz.Car) error {
-	return r.data.db.Car.
-		Update().
-		Where(car.ID(c.ID)).
-		SetCar(c).
-		Exec(ctx)
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		return r.data.Car(ctx).
+			Update().
+			Where(car.ID(c.ID)).
+			SetCar(c).
+			Exec(ctx)
+	})
 }
 
 func (r carRepo) Delete(ctx context.Context, id int64) error {
-	return r.data.db.Car.
-		DeleteOneID(id).
-		Exec(ctx)
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		return r.data.Car(ctx).
+			DeleteOneID(id).
+			Exec(ctx)
+	})
+}
+
+func (r carRepo) ListUnmappedModels(ctx context.Context) ([]*biz.UnmappedModel, error) {
+	var v []struct {
+		Model string `json:"model"`
+		Count int    `json:"count"`
+	}
+	err := r.data.db.Car.Query().
+		Where(car.ModelIDIsNil(), car.ModelNotNil(), car.ModelNEQ("")).
+		GroupBy(car.FieldModel).
+		Aggregate(ent.Count()).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.UnmappedModel, 0, len(v))
+	for _, m := range v {
+		list = append(list, &biz.UnmappedModel{Model: m.Model, CarCount: m.Count})
+	}
+	return list, nil
+}
+
+func (r carRepo) BindModel(ctx context.Context, model string, modelId int64, name string) (int, error) {
+	var n int
+	err := r.data.ExecTx(ctx, func(ctx context.Context) (err error) {
+		n, err = r.data.Car(ctx).
+			Update().
+			Where(car.Model(model), car.ModelIDIsNil()).
+			SetModelID(modelId).
+			SetModel(name).
+			Save(ctx)
+		return err
+	})
+	return n, err
+}
+
+func (r carRepo) ChangeOwner(ctx context.Context, id, from, to int64) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		n, err := r.data.Car(ctx).
+			Update().
+			Where(car.ID(id), car.UserID(from)).
+			SetUserID(to).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ex.CarOwnerChanged
+		}
+		return nil
+	})
+}
+
+func (r carRepo) SyncOwnerNames(ctx context.Context, afterId int64, limit int) (int64, int, error) {
+	cars, err := r.data.db.Car.Query().
+		Where(car.IDGT(afterId)).
+		Order(ent.Asc(car.FieldID)).
+		Limit(limit).
+		Select(car.FieldID, car.FieldUserID, car.FieldOwnerName).
+		All(ctx)
+	if err != nil || len(cars) == 0 {
+		return 0, 0, err
+	}
+
+	userIds := make([]int64, 0, len(cars))
+	for _, c := range cars {
+		userIds = append(userIds, c.UserID)
+	}
+	// grpc调用
+	reply, err := r.data.uc.GetUserNameMap(ctx, &userV1.UserIdsReq{Ids: userIds})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	updated := 0
+	for _, c := range cars {
+		name, ok := reply.NameMap[c.UserID]
+		if !ok || name == c.OwnerName {
+			continue
+		}
+		// 车主已变更的汽车由变更时的钩子同步名称，此处跳过
+		var n int
+		err := r.data.ExecTx(ctx, func(ctx context.Context) (err error) {
+			n, err = r.data.Car(ctx).
+				Update().
+				Where(car.ID(c.ID), car.UserID(c.UserID)).
+				SetOwnerName(name).
+				Save(ctx)
+			return err
+		})
+		if err != nil {
+			return 0, updated, err
+		}
+		updated += n
+	}
+	return cars[len(cars)-1].ID, updated, nil
+}
+
+// toCarReplies 补充车主名称及当前里程
+func (r carRepo) toCarReplies(ctx context.Context, cars []*ent.Car) ([]*biz.CarReply, error) {
+	var list []*biz.CarReply
+	if len(cars) == 0 {
+		return list, nil
+	}
+	// 查询用户名称
+	userIds := make([]int64, 0)
+	carIds := make([]int64, 0)
+	for _, c := range cars {
+		userIds = append(userIds, c.UserID)
+		carIds = append(carIds, c.ID)
+	}
+
+	// 查询当前里程
+	mileage, err := r.data.currentMileage(ctx, carIds...)
+	if err != nil {
+		return list, err
+	}
+
+	// 查询标签
+	tags, err := r.data.carTags(ctx, carIds...)
+	if err != nil {
+		return list, err
+	}
+
+	// 查询自定义属性
+	attrs, err := r.data.carAttributes(ctx, carIds...)
+	if err != nil {
+		return list, err
+	}
+
+	// grpc调用
+	reply, err := r.data.uc.GetUserNameMap(ctx, &userV1.UserIdsReq{Ids: userIds})
+	if err != nil {
+		return list, err
+	}
+	for _, c := range cars {
+		list = append(list, &biz.CarReply{
+			Id:              c.ID,
+			TenantId:        c.TenantID,
+			UserId:          c.UserID,
+			Model:           c.Model,
+			ModelId:         c.ModelID,
+			Vin:             c.Vin,
+			Plate:           c.Plate,
+			RegisteredAt:    c.RegisteredAt,
+			UserName:        reply.NameMap[c.UserID],
+			CurrentMileage:  mileage[c.ID],
+			Tags:            tags[c.ID],
+			Attributes:      attrs[c.ID],
+			BatteryCapacity: c.BatteryCapacity,
+			BatterySoh:      c.BatterySoh,
+		})
+	}
+	return list, nil
+}
+
+// carOwnerNameHook 车主变更时同步冗余的车主名称，保证全文索引可按车主检索
+func carOwnerNameHook(uc userV1.UserClient) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			cm, ok := m.(*ent.CarMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			if userId, ok := cm.UserID(); ok {
+				reply, err := uc.GetUserName(ctx, &wrapperspb.Int64Value{Value: userId})
+				if err != nil {
+					return nil, err
+				}
+				cm.SetOwnerName(reply.Value)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 }
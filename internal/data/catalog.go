@@ -0,0 +1,155 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/brand"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/vehiclemodel"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+)
+
+type catalogRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCatalogRepo(data *Data, logger log.Logger) biz.CatalogRepo {
+	return &catalogRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r catalogRepo) ListBrand(ctx context.Context) ([]*biz.BrandReply, error) {
+	brands, err := r.data.db.Brand.Query().
+		Order(ent.Asc(brand.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.BrandReply, 0, len(brands))
+	for _, b := range brands {
+		list = append(list, &biz.BrandReply{Id: b.ID, Name: b.Name})
+	}
+	return list, nil
+}
+
+func (r catalogRepo) SaveBrand(ctx context.Context, b *biz.Brand) (int64, error) {
+	rsp, err := r.data.db.Brand.
+		Create().
+		SetBrand(b).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r catalogRepo) UpdateBrand(ctx context.Context, b *biz.Brand) error {
+	return r.data.db.Brand.
+		Update().
+		Where(brand.ID(b.ID)).
+		SetBrand(b).
+		Exec(ctx)
+}
+
+func (r catalogRepo) DeleteBrand(ctx context.Context, id int64) error {
+	return r.data.db.Brand.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func (r catalogRepo) ListVehicleModel(ctx context.Context, page, pageSize int, brandId *int64) ([]*biz.VehicleModelReply, int, error) {
+	var list []*biz.VehicleModelReply
+	// 组装查询条件
+	cond := make([]predicate.VehicleModel, 0)
+	if brandId != nil {
+		cond = append(cond, vehiclemodel.BrandID(*brandId))
+	}
+
+	q := r.data.db.VehicleModel.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	models := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Asc(vehiclemodel.FieldBrandID), ent.Asc(vehiclemodel.FieldName)).
+		WithBrand().
+		AllX(ctx)
+
+	for _, m := range models {
+		list = append(list, convertVehicleModel(m))
+	}
+	return list, total, nil
+}
+
+func (r catalogRepo) ListAllVehicleModel(ctx context.Context) ([]*biz.VehicleModelReply, error) {
+	models, err := r.data.db.VehicleModel.Query().
+		WithBrand().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.VehicleModelReply, 0, len(models))
+	for _, m := range models {
+		list = append(list, convertVehicleModel(m))
+	}
+	return list, nil
+}
+
+func (r catalogRepo) GetVehicleModelById(ctx context.Context, id int64) (*biz.VehicleModelReply, error) {
+	m, err := r.data.db.VehicleModel.Query().
+		Where(vehiclemodel.ID(id)).
+		WithBrand().
+		Only(ctx)
+	if err != nil {
+		return nil, ex.VehicleModelNotFound
+	}
+	return convertVehicleModel(m), nil
+}
+
+func (r catalogRepo) SaveVehicleModel(ctx context.Context, m *biz.VehicleModel) (int64, error) {
+	rsp, err := r.data.db.VehicleModel.
+		Create().
+		SetVehicleModel(m).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r catalogRepo) UpdateVehicleModel(ctx context.Context, m *biz.VehicleModel) error {
+	return r.data.db.VehicleModel.
+		Update().
+		Where(vehiclemodel.ID(m.ID)).
+		SetVehicleModel(m).
+		Exec(ctx)
+}
+
+func (r catalogRepo) DeleteVehicleModel(ctx context.Context, id int64) error {
+	return r.data.db.VehicleModel.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func convertVehicleModel(m *ent.VehicleModel) *biz.VehicleModelReply {
+	reply := &biz.VehicleModelReply{
+		Id:       m.ID,
+		BrandId:  m.BrandID,
+		Name:     m.Name,
+		FuelType: m.FuelType,
+		Seats:    m.Seats,
+		BodyType: m.BodyType,
+	}
+	if m.Edges.Brand != nil {
+		reply.BrandName = m.Edges.Brand.Name
+	}
+	return reply
+}
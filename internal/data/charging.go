@@ -0,0 +1,148 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"strings"
+	"time"
+)
+
+type chargingRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewChargingRepo(data *Data, logger log.Logger) biz.ChargingRepo {
+	return &chargingRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r chargingRepo) ListChargingSession(ctx context.Context, page, pageSize int, filter *biz.ChargingFilter) ([]*biz.ChargingSessionReply, int, error) {
+	var list []*biz.ChargingSessionReply
+	// 组装查询条件
+	cond := make([]predicate.ChargingSession, 0)
+	if filter.CarId != nil {
+		cond = append(cond, chargingsession.CarID(*filter.CarId))
+	}
+	if filter.From != nil {
+		cond = append(cond, chargingsession.EndedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		cond = append(cond, chargingsession.EndedAtLT(*filter.To))
+	}
+
+	q := r.data.db.ChargingSession.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	sessions := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(chargingsession.FieldEndedAt)).
+		AllX(ctx)
+
+	for _, s := range sessions {
+		list = append(list, convertChargingSession(s))
+	}
+	return list, total, nil
+}
+
+func (r chargingRepo) ExistsOverlap(ctx context.Context, carId int64, start, end time.Time) (bool, error) {
+	return r.data.ChargingSession(ctx).Query().
+		Where(
+			chargingsession.CarID(carId),
+			chargingsession.StartedAtLT(end),
+			chargingsession.EndedAtGT(start),
+		).
+		Exist(ctx)
+}
+
+func (r chargingRepo) ExistsSohAfter(ctx context.Context, carId int64, at time.Time) (bool, error) {
+	return r.data.ChargingSession(ctx).Query().
+		Where(
+			chargingsession.CarID(carId),
+			chargingsession.SohGT(0),
+			chargingsession.EndedAtGT(at),
+		).
+		Exist(ctx)
+}
+
+func (r chargingRepo) Save(ctx context.Context, s *biz.ChargingSession) (int64, error) {
+	rsp, err := r.data.ChargingSession(ctx).
+		Create().
+		SetChargingSession(s).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+// BatteryHealthTrend 健康度为0表示未上报，不参与统计；折算容量仅取电量百分比有变化的记录
+func (r chargingRepo) BatteryHealthTrend(ctx context.Context, carId int64, from, to *time.Time) ([]*biz.BatteryHealthPoint, error) {
+	where := []string{chargingsession.FieldCarID + " = ?"}
+	args := []interface{}{carId}
+	if from != nil {
+		where = append(where, chargingsession.FieldEndedAt+" >= ?")
+		args = append(args, *from)
+	}
+	if to != nil {
+		where = append(where, chargingsession.FieldEndedAt+" < ?")
+		args = append(args, *to)
+	}
+
+	query := fmt.Sprintf(`SELECT DATE_FORMAT(%[1]s, '%%Y-%%m') AS month,
+       COUNT(*),
+       SUM(%[2]s),
+       COALESCE(AVG(NULLIF(%[3]s, 0)), 0),
+       COALESCE(MIN(NULLIF(%[3]s, 0)), 0),
+       COALESCE(SUM(CASE WHEN %[5]s > %[4]s THEN %[2]s END) * 100 /
+                NULLIF(SUM(CASE WHEN %[5]s > %[4]s THEN %[5]s - %[4]s END), 0), 0)
+FROM %[6]s
+WHERE %[7]s
+GROUP BY month
+ORDER BY month`,
+		chargingsession.FieldEndedAt, chargingsession.FieldEnergy, chargingsession.FieldSoh,
+		chargingsession.FieldStartSoc, chargingsession.FieldEndSoc,
+		chargingsession.Table, strings.Join(where, " AND "),
+	)
+
+	rows, err := r.data.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*biz.BatteryHealthPoint
+	for rows.Next() {
+		p := &biz.BatteryHealthPoint{}
+		if err := rows.Scan(&p.Month, &p.Sessions, &p.Energy, &p.AvgSoh, &p.MinSoh, &p.EstimatedCapacity); err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, rows.Err()
+}
+
+func convertChargingSession(s *ent.ChargingSession) *biz.ChargingSessionReply {
+	return &biz.ChargingSessionReply{
+		Id:          s.ID,
+		CarId:       s.CarID,
+		StartedAt:   s.StartedAt,
+		EndedAt:     s.EndedAt,
+		Energy:      s.Energy,
+		ChargerType: s.ChargerType,
+		Cost:        s.Cost,
+		Station:     s.Station,
+		StartSoc:    s.StartSoc,
+		EndSoc:      s.EndSoc,
+		Soh:         s.Soh,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"car-service/internal/biz"
 	"car-service/internal/conf"
 	"car-service/internal/data/ent"
+	_ "car-service/internal/data/ent/runtime"
 	"context"
 	"database/sql"
 	"entgo.io/ent/dialect"
@@ -26,12 +27,40 @@ import (
 
 var ProviderSet = wire.NewSet(
 	NewTransaction,
+	NewLocker,
+	NewEventPublisher,
+	NewEventConsumer,
 	NewData,
 	NewDB,
 	NewRedis,
 	NewRegistrar,
 	NewDiscovery,
 	NewCarRepo,
+	NewAuditLogRepo,
+	NewMaintenanceRepo,
+	NewInsuranceRepo,
+	NewCatalogRepo,
+	NewTransferRepo,
+	NewOdometerRepo,
+	NewAttachmentRepo,
+	NewBlobStore,
+	NewFleetRepo,
+	NewAttributeRepo,
+	NewReservationRepo,
+	NewTripRepo,
+	NewRecallRepo,
+	NewViolationRepo,
+	NewValuationRepo,
+	NewListingRepo,
+	NewWarrantyRepo,
+	NewIncidentRepo,
+	NewLeaseRepo,
+	NewChargingRepo,
+	NewTelemetryRepo,
+	NewGeofenceRepo,
+	NewJobRunRepo,
+	NewNotificationRepo,
+	NewNotificationChannels,
 	NewUserServiceClient,
 )
 
@@ -40,11 +69,16 @@ type Data struct {
 	rds    rueidis.Client
 	rdsCmd rueidiscompat.Cmdable
 	uc     user.UserClient
+	log    *log.Helper
 }
 
 type contextTxKey struct{}
 
+// ExecTx 在事务中执行f，已处于事务中时直接加入该事务，由外层提交或回滚
 func (d *Data) ExecTx(ctx context.Context, f func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(contextTxKey{}).(*ent.Tx); ok {
+		return f(ctx)
+	}
 	tx, err := d.db.Tx(ctx)
 	if err != nil {
 		return err
@@ -65,6 +99,86 @@ func (d *Data) Car(ctx context.Context) *ent.CarClient {
 	return d.db.Car
 }
 
+func (d *Data) Transfer(ctx context.Context) *ent.TransferClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.Transfer
+	}
+	return d.db.Transfer
+}
+
+func (d *Data) AttributeDefinition(ctx context.Context) *ent.AttributeDefinitionClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.AttributeDefinition
+	}
+	return d.db.AttributeDefinition
+}
+
+func (d *Data) CarAttribute(ctx context.Context) *ent.CarAttributeClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.CarAttribute
+	}
+	return d.db.CarAttribute
+}
+
+func (d *Data) Reservation(ctx context.Context) *ent.ReservationClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.Reservation
+	}
+	return d.db.Reservation
+}
+
+func (d *Data) Violation(ctx context.Context) *ent.ViolationClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.Violation
+	}
+	return d.db.Violation
+}
+
+func (d *Data) TradeRecord(ctx context.Context) *ent.TradeRecordClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.TradeRecord
+	}
+	return d.db.TradeRecord
+}
+
+func (d *Data) Listing(ctx context.Context) *ent.ListingClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.Listing
+	}
+	return d.db.Listing
+}
+
+func (d *Data) LeaseContract(ctx context.Context) *ent.LeaseContractClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.LeaseContract
+	}
+	return d.db.LeaseContract
+}
+
+func (d *Data) ChargingSession(ctx context.Context) *ent.ChargingSessionClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.ChargingSession
+	}
+	return d.db.ChargingSession
+}
+
+func (d *Data) Geofence(ctx context.Context) *ent.GeofenceClient {
+	tx, ok := ctx.Value(contextTxKey{}).(*ent.Tx)
+	if ok {
+		return tx.Geofence
+	}
+	return d.db.Geofence
+}
+
 func NewTransaction(d *Data) biz.Transaction {
 	return d
 }
@@ -77,12 +191,15 @@ func NewData(db *ent.Client, rds rueidis.Client, uc user.UserClient, logger log.
 		}
 		rds.Close()
 	}
+	// 注册车主名称同步，供全文检索使用
+	db.Car.Use(carOwnerNameHook(uc))
 
 	return &Data{
 		db:     db,
 		rds:    rds,
 		rdsCmd: rueidiscompat.NewAdapter(rds),
 		uc:     uc,
+		log:    log.NewHelper(logger),
 	}, cleanup, nil
 }
 
@@ -119,6 +236,10 @@ func NewDB(conf *conf.Data, logger log.Logger) *ent.Client {
 		thisLog.WithContext(ctx).Debug(i...)
 	})
 	client := ent.NewClient(ent.Driver(sqlDrv))
+	// 注册汽车变更审计
+	client.Car.Use(carAuditHook)
+	// 注册车主变更记录，违章按发生时的车主归属
+	client.Car.Use(carOwnerHistoryHook)
 
 	// 运行自动创建表
 	//if err := db.Schema.Create(context.Background(), migrate.WithForeignKeys(false)); err != nil {
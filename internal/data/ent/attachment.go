@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attachment"
+	"car-service/internal/data/ent/car"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Attachment is the model entity for the Attachment schema.
+type Attachment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// FileName holds the value of the "file_name" field.
+	FileName string `json:"file_name,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
+	// StorageKey holds the value of the "storage_key" field.
+	StorageKey string `json:"storage_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttachmentQuery when eager-loading is set.
+	Edges AttachmentEdges `json:"edges"`
+}
+
+// AttachmentEdges holds the relations/edges for other nodes in the graph.
+type AttachmentEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Attachment) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attachment.FieldID, attachment.FieldTenantID, attachment.FieldCarID, attachment.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case attachment.FieldKind, attachment.FieldFileName, attachment.FieldContentType, attachment.FieldChecksum, attachment.FieldStorageKey:
+			values[i] = new(sql.NullString)
+		case attachment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Attachment", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Attachment fields.
+func (a *Attachment) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attachment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int64(value.Int64)
+		case attachment.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				a.TenantID = value.Int64
+			}
+		case attachment.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				a.CarID = value.Int64
+			}
+		case attachment.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				a.Kind = value.String
+			}
+		case attachment.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				a.FileName = value.String
+			}
+		case attachment.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				a.ContentType = value.String
+			}
+		case attachment.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				a.Size = value.Int64
+			}
+		case attachment.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				a.Checksum = value.String
+			}
+		case attachment.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				a.StorageKey = value.String
+			}
+		case attachment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the Attachment entity.
+func (a *Attachment) QueryCar() *CarQuery {
+	return (&AttachmentClient{config: a.config}).QueryCar(a)
+}
+
+// Update returns a builder for updating this Attachment.
+// Note that you need to call Attachment.Unwrap() before calling this method if this Attachment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Attachment) Update() *AttachmentUpdateOne {
+	return (&AttachmentClient{config: a.config}).UpdateOne(a)
+}
+
+// Unwrap unwraps the Attachment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Attachment) Unwrap() *Attachment {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Attachment is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Attachment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Attachment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(a.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("file_name=")
+	builder.WriteString(a.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(a.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", a.Size))
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(a.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(a.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Attachments is a parsable slice of Attachment.
+type Attachments []*Attachment
+
+func (a Attachments) config(cfg config) {
+	for _i := range a {
+		a[_i].config = cfg
+	}
+}
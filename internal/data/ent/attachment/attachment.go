@@ -0,0 +1,82 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attachment
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the attachment type in the database.
+	Label = "attachment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldFileName holds the string denoting the file_name field in the database.
+	FieldFileName = "file_name"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
+	// FieldStorageKey holds the string denoting the storage_key field in the database.
+	FieldStorageKey = "storage_key"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// Table holds the table name of the attachment in the database.
+	Table = "attachment"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "attachment"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+)
+
+// Columns holds all SQL columns for attachment fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldKind,
+	FieldFileName,
+	FieldContentType,
+	FieldSize,
+	FieldChecksum,
+	FieldStorageKey,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attachment"
+	"car-service/internal/data/ent/car"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentCreate is the builder for creating a Attachment entity.
+type AttachmentCreate struct {
+	config
+	mutation *AttachmentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ac *AttachmentCreate) SetTenantID(i int64) *AttachmentCreate {
+	ac.mutation.SetTenantID(i)
+	return ac
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableTenantID(i *int64) *AttachmentCreate {
+	if i != nil {
+		ac.SetTenantID(*i)
+	}
+	return ac
+}
+
+// SetCarID sets the "car_id" field.
+func (ac *AttachmentCreate) SetCarID(i int64) *AttachmentCreate {
+	ac.mutation.SetCarID(i)
+	return ac
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableCarID(i *int64) *AttachmentCreate {
+	if i != nil {
+		ac.SetCarID(*i)
+	}
+	return ac
+}
+
+// SetKind sets the "kind" field.
+func (ac *AttachmentCreate) SetKind(s string) *AttachmentCreate {
+	ac.mutation.SetKind(s)
+	return ac
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableKind(s *string) *AttachmentCreate {
+	if s != nil {
+		ac.SetKind(*s)
+	}
+	return ac
+}
+
+// SetFileName sets the "file_name" field.
+func (ac *AttachmentCreate) SetFileName(s string) *AttachmentCreate {
+	ac.mutation.SetFileName(s)
+	return ac
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableFileName(s *string) *AttachmentCreate {
+	if s != nil {
+		ac.SetFileName(*s)
+	}
+	return ac
+}
+
+// SetContentType sets the "content_type" field.
+func (ac *AttachmentCreate) SetContentType(s string) *AttachmentCreate {
+	ac.mutation.SetContentType(s)
+	return ac
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableContentType(s *string) *AttachmentCreate {
+	if s != nil {
+		ac.SetContentType(*s)
+	}
+	return ac
+}
+
+// SetSize sets the "size" field.
+func (ac *AttachmentCreate) SetSize(i int64) *AttachmentCreate {
+	ac.mutation.SetSize(i)
+	return ac
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableSize(i *int64) *AttachmentCreate {
+	if i != nil {
+		ac.SetSize(*i)
+	}
+	return ac
+}
+
+// SetChecksum sets the "checksum" field.
+func (ac *AttachmentCreate) SetChecksum(s string) *AttachmentCreate {
+	ac.mutation.SetChecksum(s)
+	return ac
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableChecksum(s *string) *AttachmentCreate {
+	if s != nil {
+		ac.SetChecksum(*s)
+	}
+	return ac
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (ac *AttachmentCreate) SetStorageKey(s string) *AttachmentCreate {
+	ac.mutation.SetStorageKey(s)
+	return ac
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableStorageKey(s *string) *AttachmentCreate {
+	if s != nil {
+		ac.SetStorageKey(*s)
+	}
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AttachmentCreate) SetCreatedAt(t time.Time) *AttachmentCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableCreatedAt(t *time.Time) *AttachmentCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *AttachmentCreate) SetID(i int64) *AttachmentCreate {
+	ac.mutation.SetID(i)
+	return ac
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ac *AttachmentCreate) SetCar(c *Car) *AttachmentCreate {
+	return ac.SetCarID(c.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (ac *AttachmentCreate) Mutation() *AttachmentMutation {
+	return ac.mutation
+}
+
+// Save creates the Attachment in the database.
+func (ac *AttachmentCreate) Save(ctx context.Context) (*Attachment, error) {
+	var (
+		err  error
+		node *Attachment
+	)
+	if err := ac.defaults(); err != nil {
+		return nil, err
+	}
+	if len(ac.hooks) == 0 {
+		if err = ac.check(); err != nil {
+			return nil, err
+		}
+		node, err = ac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AttachmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ac.check(); err != nil {
+				return nil, err
+			}
+			ac.mutation = mutation
+			if node, err = ac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ac.hooks) - 1; i >= 0; i-- {
+			if ac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ac.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ac.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Attachment)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AttachmentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AttachmentCreate) SaveX(ctx context.Context) *Attachment {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AttachmentCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AttachmentCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AttachmentCreate) defaults() error {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		if attachment.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized attachment.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := attachment.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AttachmentCreate) check() error {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Attachment.created_at"`)}
+	}
+	return nil
+}
+
+func (ac *AttachmentCreate) sqlSave(ctx context.Context) (*Attachment, error) {
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (ac *AttachmentCreate) createSpec() (*Attachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Attachment{config: ac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: attachment.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: attachment.FieldID,
+			},
+		}
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ac.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := ac.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := ac.mutation.FileName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldFileName,
+		})
+		_node.FileName = value
+	}
+	if value, ok := ac.mutation.ContentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldContentType,
+		})
+		_node.ContentType = value
+	}
+	if value, ok := ac.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldSize,
+		})
+		_node.Size = value
+	}
+	if value, ok := ac.mutation.Checksum(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldChecksum,
+		})
+		_node.Checksum = value
+	}
+	if value, ok := ac.mutation.StorageKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldStorageKey,
+		})
+		_node.StorageKey = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: attachment.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := ac.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.CarTable,
+			Columns: []string{attachment.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttachmentCreateBulk is the builder for creating many Attachment entities in bulk.
+type AttachmentCreateBulk struct {
+	config
+	builders []*AttachmentCreate
+}
+
+// Save creates the Attachment entities in the database.
+func (acb *AttachmentCreateBulk) Save(ctx context.Context) ([]*Attachment, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Attachment, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AttachmentCreateBulk) SaveX(ctx context.Context) []*Attachment {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
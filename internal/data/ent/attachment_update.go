@@ -0,0 +1,939 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attachment"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (au *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (au *AttachmentUpdate) SetTenantID(i int64) *AttachmentUpdate {
+	au.mutation.ResetTenantID()
+	au.mutation.SetTenantID(i)
+	return au
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableTenantID(i *int64) *AttachmentUpdate {
+	if i != nil {
+		au.SetTenantID(*i)
+	}
+	return au
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (au *AttachmentUpdate) AddTenantID(i int64) *AttachmentUpdate {
+	au.mutation.AddTenantID(i)
+	return au
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (au *AttachmentUpdate) ClearTenantID() *AttachmentUpdate {
+	au.mutation.ClearTenantID()
+	return au
+}
+
+// SetCarID sets the "car_id" field.
+func (au *AttachmentUpdate) SetCarID(i int64) *AttachmentUpdate {
+	au.mutation.SetCarID(i)
+	return au
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableCarID(i *int64) *AttachmentUpdate {
+	if i != nil {
+		au.SetCarID(*i)
+	}
+	return au
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (au *AttachmentUpdate) ClearCarID() *AttachmentUpdate {
+	au.mutation.ClearCarID()
+	return au
+}
+
+// SetKind sets the "kind" field.
+func (au *AttachmentUpdate) SetKind(s string) *AttachmentUpdate {
+	au.mutation.SetKind(s)
+	return au
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableKind(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetKind(*s)
+	}
+	return au
+}
+
+// ClearKind clears the value of the "kind" field.
+func (au *AttachmentUpdate) ClearKind() *AttachmentUpdate {
+	au.mutation.ClearKind()
+	return au
+}
+
+// SetFileName sets the "file_name" field.
+func (au *AttachmentUpdate) SetFileName(s string) *AttachmentUpdate {
+	au.mutation.SetFileName(s)
+	return au
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableFileName(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetFileName(*s)
+	}
+	return au
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (au *AttachmentUpdate) ClearFileName() *AttachmentUpdate {
+	au.mutation.ClearFileName()
+	return au
+}
+
+// SetContentType sets the "content_type" field.
+func (au *AttachmentUpdate) SetContentType(s string) *AttachmentUpdate {
+	au.mutation.SetContentType(s)
+	return au
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableContentType(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetContentType(*s)
+	}
+	return au
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (au *AttachmentUpdate) ClearContentType() *AttachmentUpdate {
+	au.mutation.ClearContentType()
+	return au
+}
+
+// SetSize sets the "size" field.
+func (au *AttachmentUpdate) SetSize(i int64) *AttachmentUpdate {
+	au.mutation.ResetSize()
+	au.mutation.SetSize(i)
+	return au
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableSize(i *int64) *AttachmentUpdate {
+	if i != nil {
+		au.SetSize(*i)
+	}
+	return au
+}
+
+// AddSize adds i to the "size" field.
+func (au *AttachmentUpdate) AddSize(i int64) *AttachmentUpdate {
+	au.mutation.AddSize(i)
+	return au
+}
+
+// ClearSize clears the value of the "size" field.
+func (au *AttachmentUpdate) ClearSize() *AttachmentUpdate {
+	au.mutation.ClearSize()
+	return au
+}
+
+// SetChecksum sets the "checksum" field.
+func (au *AttachmentUpdate) SetChecksum(s string) *AttachmentUpdate {
+	au.mutation.SetChecksum(s)
+	return au
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableChecksum(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetChecksum(*s)
+	}
+	return au
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (au *AttachmentUpdate) ClearChecksum() *AttachmentUpdate {
+	au.mutation.ClearChecksum()
+	return au
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (au *AttachmentUpdate) SetStorageKey(s string) *AttachmentUpdate {
+	au.mutation.SetStorageKey(s)
+	return au
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableStorageKey(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetStorageKey(*s)
+	}
+	return au
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (au *AttachmentUpdate) ClearStorageKey() *AttachmentUpdate {
+	au.mutation.ClearStorageKey()
+	return au
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (au *AttachmentUpdate) SetCreatedAt(t time.Time) *AttachmentUpdate {
+	au.mutation.SetCreatedAt(t)
+	return au
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableCreatedAt(t *time.Time) *AttachmentUpdate {
+	if t != nil {
+		au.SetCreatedAt(*t)
+	}
+	return au
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (au *AttachmentUpdate) SetCar(c *Car) *AttachmentUpdate {
+	return au.SetCarID(c.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (au *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return au.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (au *AttachmentUpdate) ClearCar() *AttachmentUpdate {
+	au.mutation.ClearCar()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(au.hooks) == 0 {
+		affected, err = au.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AttachmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			au.mutation = mutation
+			affected, err = au.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(au.hooks) - 1; i >= 0; i-- {
+			if au.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = au.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, au.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (au *AttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   attachment.Table,
+			Columns: attachment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: attachment.FieldID,
+			},
+		},
+	}
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldTenantID,
+		})
+	}
+	if value, ok := au.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldTenantID,
+		})
+	}
+	if au.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: attachment.FieldTenantID,
+		})
+	}
+	if value, ok := au.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldKind,
+		})
+	}
+	if au.mutation.KindCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldKind,
+		})
+	}
+	if value, ok := au.mutation.FileName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldFileName,
+		})
+	}
+	if au.mutation.FileNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldFileName,
+		})
+	}
+	if value, ok := au.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldContentType,
+		})
+	}
+	if au.mutation.ContentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldContentType,
+		})
+	}
+	if value, ok := au.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldSize,
+		})
+	}
+	if value, ok := au.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldSize,
+		})
+	}
+	if au.mutation.SizeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: attachment.FieldSize,
+		})
+	}
+	if value, ok := au.mutation.Checksum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldChecksum,
+		})
+	}
+	if au.mutation.ChecksumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldChecksum,
+		})
+	}
+	if value, ok := au.mutation.StorageKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldStorageKey,
+		})
+	}
+	if au.mutation.StorageKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldStorageKey,
+		})
+	}
+	if value, ok := au.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: attachment.FieldCreatedAt,
+		})
+	}
+	if au.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.CarTable,
+			Columns: []string{attachment.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.CarTable,
+			Columns: []string{attachment.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (auo *AttachmentUpdateOne) SetTenantID(i int64) *AttachmentUpdateOne {
+	auo.mutation.ResetTenantID()
+	auo.mutation.SetTenantID(i)
+	return auo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableTenantID(i *int64) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetTenantID(*i)
+	}
+	return auo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (auo *AttachmentUpdateOne) AddTenantID(i int64) *AttachmentUpdateOne {
+	auo.mutation.AddTenantID(i)
+	return auo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (auo *AttachmentUpdateOne) ClearTenantID() *AttachmentUpdateOne {
+	auo.mutation.ClearTenantID()
+	return auo
+}
+
+// SetCarID sets the "car_id" field.
+func (auo *AttachmentUpdateOne) SetCarID(i int64) *AttachmentUpdateOne {
+	auo.mutation.SetCarID(i)
+	return auo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableCarID(i *int64) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetCarID(*i)
+	}
+	return auo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (auo *AttachmentUpdateOne) ClearCarID() *AttachmentUpdateOne {
+	auo.mutation.ClearCarID()
+	return auo
+}
+
+// SetKind sets the "kind" field.
+func (auo *AttachmentUpdateOne) SetKind(s string) *AttachmentUpdateOne {
+	auo.mutation.SetKind(s)
+	return auo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableKind(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetKind(*s)
+	}
+	return auo
+}
+
+// ClearKind clears the value of the "kind" field.
+func (auo *AttachmentUpdateOne) ClearKind() *AttachmentUpdateOne {
+	auo.mutation.ClearKind()
+	return auo
+}
+
+// SetFileName sets the "file_name" field.
+func (auo *AttachmentUpdateOne) SetFileName(s string) *AttachmentUpdateOne {
+	auo.mutation.SetFileName(s)
+	return auo
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableFileName(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetFileName(*s)
+	}
+	return auo
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (auo *AttachmentUpdateOne) ClearFileName() *AttachmentUpdateOne {
+	auo.mutation.ClearFileName()
+	return auo
+}
+
+// SetContentType sets the "content_type" field.
+func (auo *AttachmentUpdateOne) SetContentType(s string) *AttachmentUpdateOne {
+	auo.mutation.SetContentType(s)
+	return auo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableContentType(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetContentType(*s)
+	}
+	return auo
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (auo *AttachmentUpdateOne) ClearContentType() *AttachmentUpdateOne {
+	auo.mutation.ClearContentType()
+	return auo
+}
+
+// SetSize sets the "size" field.
+func (auo *AttachmentUpdateOne) SetSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.ResetSize()
+	auo.mutation.SetSize(i)
+	return auo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableSize(i *int64) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetSize(*i)
+	}
+	return auo
+}
+
+// AddSize adds i to the "size" field.
+func (auo *AttachmentUpdateOne) AddSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.AddSize(i)
+	return auo
+}
+
+// ClearSize clears the value of the "size" field.
+func (auo *AttachmentUpdateOne) ClearSize() *AttachmentUpdateOne {
+	auo.mutation.ClearSize()
+	return auo
+}
+
+// SetChecksum sets the "checksum" field.
+func (auo *AttachmentUpdateOne) SetChecksum(s string) *AttachmentUpdateOne {
+	auo.mutation.SetChecksum(s)
+	return auo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableChecksum(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetChecksum(*s)
+	}
+	return auo
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (auo *AttachmentUpdateOne) ClearChecksum() *AttachmentUpdateOne {
+	auo.mutation.ClearChecksum()
+	return auo
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (auo *AttachmentUpdateOne) SetStorageKey(s string) *AttachmentUpdateOne {
+	auo.mutation.SetStorageKey(s)
+	return auo
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableStorageKey(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetStorageKey(*s)
+	}
+	return auo
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (auo *AttachmentUpdateOne) ClearStorageKey() *AttachmentUpdateOne {
+	auo.mutation.ClearStorageKey()
+	return auo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (auo *AttachmentUpdateOne) SetCreatedAt(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetCreatedAt(t)
+	return auo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableCreatedAt(t *time.Time) *AttachmentUpdateOne {
+	if t != nil {
+		auo.SetCreatedAt(*t)
+	}
+	return auo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (auo *AttachmentUpdateOne) SetCar(c *Car) *AttachmentUpdateOne {
+	return auo.SetCarID(c.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (auo *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return auo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (auo *AttachmentUpdateOne) ClearCar() *AttachmentUpdateOne {
+	auo.mutation.ClearCar()
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AttachmentUpdateOne) Select(field string, fields ...string) *AttachmentUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Attachment entity.
+func (auo *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+	var (
+		err  error
+		node *Attachment
+	)
+	if len(auo.hooks) == 0 {
+		node, err = auo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AttachmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			auo.mutation = mutation
+			node, err = auo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(auo.hooks) - 1; i >= 0; i-- {
+			if auo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = auo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, auo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Attachment)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AttachmentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (auo *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   attachment.Table,
+			Columns: attachment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: attachment.FieldID,
+			},
+		},
+	}
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachment.FieldID)
+		for _, f := range fields {
+			if !attachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldTenantID,
+		})
+	}
+	if value, ok := auo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldTenantID,
+		})
+	}
+	if auo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: attachment.FieldTenantID,
+		})
+	}
+	if value, ok := auo.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldKind,
+		})
+	}
+	if auo.mutation.KindCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldKind,
+		})
+	}
+	if value, ok := auo.mutation.FileName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldFileName,
+		})
+	}
+	if auo.mutation.FileNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldFileName,
+		})
+	}
+	if value, ok := auo.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldContentType,
+		})
+	}
+	if auo.mutation.ContentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldContentType,
+		})
+	}
+	if value, ok := auo.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldSize,
+		})
+	}
+	if value, ok := auo.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldSize,
+		})
+	}
+	if auo.mutation.SizeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: attachment.FieldSize,
+		})
+	}
+	if value, ok := auo.mutation.Checksum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldChecksum,
+		})
+	}
+	if auo.mutation.ChecksumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldChecksum,
+		})
+	}
+	if value, ok := auo.mutation.StorageKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldStorageKey,
+		})
+	}
+	if auo.mutation.StorageKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attachment.FieldStorageKey,
+		})
+	}
+	if value, ok := auo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: attachment.FieldCreatedAt,
+		})
+	}
+	if auo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.CarTable,
+			Columns: []string{attachment.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.CarTable,
+			Columns: []string{attachment.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Attachment{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attributedefinition"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// AttributeDefinition is the model entity for the AttributeDefinition schema.
+type AttributeDefinition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ValueType holds the value of the "value_type" field.
+	ValueType string `json:"value_type,omitempty"`
+	// Required holds the value of the "required" field.
+	Required bool `json:"required,omitempty"`
+	// EnumValues holds the value of the "enum_values" field.
+	EnumValues string `json:"enum_values,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttributeDefinitionQuery when eager-loading is set.
+	Edges AttributeDefinitionEdges `json:"edges"`
+}
+
+// AttributeDefinitionEdges holds the relations/edges for other nodes in the graph.
+type AttributeDefinitionEdges struct {
+	// Values holds the value of the values edge.
+	Values []*CarAttribute `json:"values,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ValuesOrErr returns the Values value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttributeDefinitionEdges) ValuesOrErr() ([]*CarAttribute, error) {
+	if e.loadedTypes[0] {
+		return e.Values, nil
+	}
+	return nil, &NotLoadedError{edge: "values"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttributeDefinition) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attributedefinition.FieldRequired:
+			values[i] = new(sql.NullBool)
+		case attributedefinition.FieldID, attributedefinition.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case attributedefinition.FieldName, attributedefinition.FieldValueType, attributedefinition.FieldEnumValues:
+			values[i] = new(sql.NullString)
+		case attributedefinition.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AttributeDefinition", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttributeDefinition fields.
+func (ad *AttributeDefinition) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attributedefinition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ad.ID = int64(value.Int64)
+		case attributedefinition.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ad.TenantID = value.Int64
+			}
+		case attributedefinition.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ad.Name = value.String
+			}
+		case attributedefinition.FieldValueType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value_type", values[i])
+			} else if value.Valid {
+				ad.ValueType = value.String
+			}
+		case attributedefinition.FieldRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field required", values[i])
+			} else if value.Valid {
+				ad.Required = value.Bool
+			}
+		case attributedefinition.FieldEnumValues:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enum_values", values[i])
+			} else if value.Valid {
+				ad.EnumValues = value.String
+			}
+		case attributedefinition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ad.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryValues queries the "values" edge of the AttributeDefinition entity.
+func (ad *AttributeDefinition) QueryValues() *CarAttributeQuery {
+	return (&AttributeDefinitionClient{config: ad.config}).QueryValues(ad)
+}
+
+// Update returns a builder for updating this AttributeDefinition.
+// Note that you need to call AttributeDefinition.Unwrap() before calling this method if this AttributeDefinition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ad *AttributeDefinition) Update() *AttributeDefinitionUpdateOne {
+	return (&AttributeDefinitionClient{config: ad.config}).UpdateOne(ad)
+}
+
+// Unwrap unwraps the AttributeDefinition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ad *AttributeDefinition) Unwrap() *AttributeDefinition {
+	_tx, ok := ad.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttributeDefinition is not a transactional entity")
+	}
+	ad.config.driver = _tx.drv
+	return ad
+}
+
+// String implements the fmt.Stringer.
+func (ad *AttributeDefinition) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttributeDefinition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ad.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ad.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ad.Name)
+	builder.WriteString(", ")
+	builder.WriteString("value_type=")
+	builder.WriteString(ad.ValueType)
+	builder.WriteString(", ")
+	builder.WriteString("required=")
+	builder.WriteString(fmt.Sprintf("%v", ad.Required))
+	builder.WriteString(", ")
+	builder.WriteString("enum_values=")
+	builder.WriteString(ad.EnumValues)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ad.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttributeDefinitions is a parsable slice of AttributeDefinition.
+type AttributeDefinitions []*AttributeDefinition
+
+func (ad AttributeDefinitions) config(cfg config) {
+	for _i := range ad {
+		ad[_i].config = cfg
+	}
+}
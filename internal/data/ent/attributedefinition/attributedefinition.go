@@ -0,0 +1,75 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attributedefinition
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the attributedefinition type in the database.
+	Label = "attribute_definition"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldValueType holds the string denoting the value_type field in the database.
+	FieldValueType = "value_type"
+	// FieldRequired holds the string denoting the required field in the database.
+	FieldRequired = "required"
+	// FieldEnumValues holds the string denoting the enum_values field in the database.
+	FieldEnumValues = "enum_values"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeValues holds the string denoting the values edge name in mutations.
+	EdgeValues = "values"
+	// Table holds the table name of the attributedefinition in the database.
+	Table = "attribute_definition"
+	// ValuesTable is the table that holds the values relation/edge.
+	ValuesTable = "car_attribute"
+	// ValuesInverseTable is the table name for the CarAttribute entity.
+	// It exists in this package in order to avoid circular dependency with the "carattribute" package.
+	ValuesInverseTable = "car_attribute"
+	// ValuesColumn is the table column denoting the values relation/edge.
+	ValuesColumn = "definition_id"
+)
+
+// Columns holds all SQL columns for attributedefinition fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldName,
+	FieldValueType,
+	FieldRequired,
+	FieldEnumValues,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultRequired holds the default value on creation for the "required" field.
+	DefaultRequired bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
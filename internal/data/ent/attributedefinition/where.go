@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attributedefinition
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// ValueType applies equality check predicate on the "value_type" field. It's identical to ValueTypeEQ.
+func ValueType(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValueType), v))
+	})
+}
+
+// Required applies equality check predicate on the "required" field. It's identical to RequiredEQ.
+func Required(v bool) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequired), v))
+	})
+}
+
+// EnumValues applies equality check predicate on the "enum_values" field. It's identical to EnumValuesEQ.
+func EnumValues(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEnumValues), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// ValueTypeEQ applies the EQ predicate on the "value_type" field.
+func ValueTypeEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeNEQ applies the NEQ predicate on the "value_type" field.
+func ValueTypeNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeIn applies the In predicate on the "value_type" field.
+func ValueTypeIn(vs ...string) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldValueType), v...))
+	})
+}
+
+// ValueTypeNotIn applies the NotIn predicate on the "value_type" field.
+func ValueTypeNotIn(vs ...string) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldValueType), v...))
+	})
+}
+
+// ValueTypeGT applies the GT predicate on the "value_type" field.
+func ValueTypeGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeGTE applies the GTE predicate on the "value_type" field.
+func ValueTypeGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeLT applies the LT predicate on the "value_type" field.
+func ValueTypeLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeLTE applies the LTE predicate on the "value_type" field.
+func ValueTypeLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeContains applies the Contains predicate on the "value_type" field.
+func ValueTypeContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeHasPrefix applies the HasPrefix predicate on the "value_type" field.
+func ValueTypeHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeHasSuffix applies the HasSuffix predicate on the "value_type" field.
+func ValueTypeHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeIsNil applies the IsNil predicate on the "value_type" field.
+func ValueTypeIsNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldValueType)))
+	})
+}
+
+// ValueTypeNotNil applies the NotNil predicate on the "value_type" field.
+func ValueTypeNotNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldValueType)))
+	})
+}
+
+// ValueTypeEqualFold applies the EqualFold predicate on the "value_type" field.
+func ValueTypeEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldValueType), v))
+	})
+}
+
+// ValueTypeContainsFold applies the ContainsFold predicate on the "value_type" field.
+func ValueTypeContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldValueType), v))
+	})
+}
+
+// RequiredEQ applies the EQ predicate on the "required" field.
+func RequiredEQ(v bool) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequired), v))
+	})
+}
+
+// RequiredNEQ applies the NEQ predicate on the "required" field.
+func RequiredNEQ(v bool) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRequired), v))
+	})
+}
+
+// EnumValuesEQ applies the EQ predicate on the "enum_values" field.
+func EnumValuesEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesNEQ applies the NEQ predicate on the "enum_values" field.
+func EnumValuesNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesIn applies the In predicate on the "enum_values" field.
+func EnumValuesIn(vs ...string) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEnumValues), v...))
+	})
+}
+
+// EnumValuesNotIn applies the NotIn predicate on the "enum_values" field.
+func EnumValuesNotIn(vs ...string) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEnumValues), v...))
+	})
+}
+
+// EnumValuesGT applies the GT predicate on the "enum_values" field.
+func EnumValuesGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesGTE applies the GTE predicate on the "enum_values" field.
+func EnumValuesGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesLT applies the LT predicate on the "enum_values" field.
+func EnumValuesLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesLTE applies the LTE predicate on the "enum_values" field.
+func EnumValuesLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesContains applies the Contains predicate on the "enum_values" field.
+func EnumValuesContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesHasPrefix applies the HasPrefix predicate on the "enum_values" field.
+func EnumValuesHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesHasSuffix applies the HasSuffix predicate on the "enum_values" field.
+func EnumValuesHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesIsNil applies the IsNil predicate on the "enum_values" field.
+func EnumValuesIsNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEnumValues)))
+	})
+}
+
+// EnumValuesNotNil applies the NotNil predicate on the "enum_values" field.
+func EnumValuesNotNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEnumValues)))
+	})
+}
+
+// EnumValuesEqualFold applies the EqualFold predicate on the "enum_values" field.
+func EnumValuesEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEnumValues), v))
+	})
+}
+
+// EnumValuesContainsFold applies the ContainsFold predicate on the "enum_values" field.
+func EnumValuesContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEnumValues), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttributeDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasValues applies the HasEdge predicate on the "values" edge.
+func HasValues() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ValuesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ValuesTable, ValuesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasValuesWith applies the HasEdge predicate on the "values" edge with a given conditions (other predicates).
+func HasValuesWith(preds ...predicate.CarAttribute) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ValuesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ValuesTable, ValuesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttributeDefinition) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttributeDefinition) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttributeDefinition) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
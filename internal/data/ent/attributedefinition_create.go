@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/carattribute"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttributeDefinitionCreate is the builder for creating a AttributeDefinition entity.
+type AttributeDefinitionCreate struct {
+	config
+	mutation *AttributeDefinitionMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (adc *AttributeDefinitionCreate) SetTenantID(i int64) *AttributeDefinitionCreate {
+	adc.mutation.SetTenantID(i)
+	return adc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableTenantID(i *int64) *AttributeDefinitionCreate {
+	if i != nil {
+		adc.SetTenantID(*i)
+	}
+	return adc
+}
+
+// SetName sets the "name" field.
+func (adc *AttributeDefinitionCreate) SetName(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetName(s)
+	return adc
+}
+
+// SetValueType sets the "value_type" field.
+func (adc *AttributeDefinitionCreate) SetValueType(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetValueType(s)
+	return adc
+}
+
+// SetNillableValueType sets the "value_type" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableValueType(s *string) *AttributeDefinitionCreate {
+	if s != nil {
+		adc.SetValueType(*s)
+	}
+	return adc
+}
+
+// SetRequired sets the "required" field.
+func (adc *AttributeDefinitionCreate) SetRequired(b bool) *AttributeDefinitionCreate {
+	adc.mutation.SetRequired(b)
+	return adc
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableRequired(b *bool) *AttributeDefinitionCreate {
+	if b != nil {
+		adc.SetRequired(*b)
+	}
+	return adc
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (adc *AttributeDefinitionCreate) SetEnumValues(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetEnumValues(s)
+	return adc
+}
+
+// SetNillableEnumValues sets the "enum_values" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableEnumValues(s *string) *AttributeDefinitionCreate {
+	if s != nil {
+		adc.SetEnumValues(*s)
+	}
+	return adc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (adc *AttributeDefinitionCreate) SetCreatedAt(t time.Time) *AttributeDefinitionCreate {
+	adc.mutation.SetCreatedAt(t)
+	return adc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableCreatedAt(t *time.Time) *AttributeDefinitionCreate {
+	if t != nil {
+		adc.SetCreatedAt(*t)
+	}
+	return adc
+}
+
+// SetID sets the "id" field.
+func (adc *AttributeDefinitionCreate) SetID(i int64) *AttributeDefinitionCreate {
+	adc.mutation.SetID(i)
+	return adc
+}
+
+// AddValueIDs adds the "values" edge to the CarAttribute entity by IDs.
+func (adc *AttributeDefinitionCreate) AddValueIDs(ids ...int64) *AttributeDefinitionCreate {
+	adc.mutation.AddValueIDs(ids...)
+	return adc
+}
+
+// AddValues adds the "values" edges to the CarAttribute entity.
+func (adc *AttributeDefinitionCreate) AddValues(c ...*CarAttribute) *AttributeDefinitionCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return adc.AddValueIDs(ids...)
+}
+
+// Mutation returns the AttributeDefinitionMutation object of the builder.
+func (adc *AttributeDefinitionCreate) Mutation() *AttributeDefinitionMutation {
+	return adc.mutation
+}
+
+// Save creates the AttributeDefinition in the database.
+func (adc *AttributeDefinitionCreate) Save(ctx context.Context) (*AttributeDefinition, error) {
+	var (
+		err  error
+		node *AttributeDefinition
+	)
+	if err := adc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(adc.hooks) == 0 {
+		if err = adc.check(); err != nil {
+			return nil, err
+		}
+		node, err = adc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AttributeDefinitionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = adc.check(); err != nil {
+				return nil, err
+			}
+			adc.mutation = mutation
+			if node, err = adc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(adc.hooks) - 1; i >= 0; i-- {
+			if adc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = adc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, adc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AttributeDefinition)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AttributeDefinitionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (adc *AttributeDefinitionCreate) SaveX(ctx context.Context) *AttributeDefinition {
+	v, err := adc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adc *AttributeDefinitionCreate) Exec(ctx context.Context) error {
+	_, err := adc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adc *AttributeDefinitionCreate) ExecX(ctx context.Context) {
+	if err := adc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (adc *AttributeDefinitionCreate) defaults() error {
+	if _, ok := adc.mutation.Required(); !ok {
+		v := attributedefinition.DefaultRequired
+		adc.mutation.SetRequired(v)
+	}
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		if attributedefinition.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized attributedefinition.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := attributedefinition.DefaultCreatedAt()
+		adc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (adc *AttributeDefinitionCreate) check() error {
+	if _, ok := adc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AttributeDefinition.name"`)}
+	}
+	if _, ok := adc.mutation.Required(); !ok {
+		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "AttributeDefinition.required"`)}
+	}
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttributeDefinition.created_at"`)}
+	}
+	return nil
+}
+
+func (adc *AttributeDefinitionCreate) sqlSave(ctx context.Context) (*AttributeDefinition, error) {
+	_node, _spec := adc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, adc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (adc *AttributeDefinitionCreate) createSpec() (*AttributeDefinition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttributeDefinition{config: adc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: attributedefinition.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: attributedefinition.FieldID,
+			},
+		}
+	)
+	if id, ok := adc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := adc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attributedefinition.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := adc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attributedefinition.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := adc.mutation.ValueType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attributedefinition.FieldValueType,
+		})
+		_node.ValueType = value
+	}
+	if value, ok := adc.mutation.Required(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: attributedefinition.FieldRequired,
+		})
+		_node.Required = value
+	}
+	if value, ok := adc.mutation.EnumValues(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attributedefinition.FieldEnumValues,
+		})
+		_node.EnumValues = value
+	}
+	if value, ok := adc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: attributedefinition.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := adc.mutation.ValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attributedefinition.ValuesTable,
+			Columns: []string{attributedefinition.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttributeDefinitionCreateBulk is the builder for creating many AttributeDefinition entities in bulk.
+type AttributeDefinitionCreateBulk struct {
+	config
+	builders []*AttributeDefinitionCreate
+}
+
+// Save creates the AttributeDefinition entities in the database.
+func (adcb *AttributeDefinitionCreateBulk) Save(ctx context.Context) ([]*AttributeDefinition, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(adcb.builders))
+	nodes := make([]*AttributeDefinition, len(adcb.builders))
+	mutators := make([]Mutator, len(adcb.builders))
+	for i := range adcb.builders {
+		func(i int, root context.Context) {
+			builder := adcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttributeDefinitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, adcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, adcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, adcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adcb *AttributeDefinitionCreateBulk) SaveX(ctx context.Context) []*AttributeDefinition {
+	v, err := adcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adcb *AttributeDefinitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := adcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adcb *AttributeDefinitionCreateBulk) ExecX(ctx context.Context) {
+	if err := adcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
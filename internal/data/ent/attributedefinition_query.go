@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttributeDefinitionQuery is the builder for querying AttributeDefinition entities.
+type AttributeDefinitionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.AttributeDefinition
+	// eager-loading edges.
+	withValues *CarAttributeQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AttributeDefinitionQuery builder.
+func (adq *AttributeDefinitionQuery) Where(ps ...predicate.AttributeDefinition) *AttributeDefinitionQuery {
+	adq.predicates = append(adq.predicates, ps...)
+	return adq
+}
+
+// Limit adds a limit step to the query.
+func (adq *AttributeDefinitionQuery) Limit(limit int) *AttributeDefinitionQuery {
+	adq.limit = &limit
+	return adq
+}
+
+// Offset adds an offset step to the query.
+func (adq *AttributeDefinitionQuery) Offset(offset int) *AttributeDefinitionQuery {
+	adq.offset = &offset
+	return adq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (adq *AttributeDefinitionQuery) Unique(unique bool) *AttributeDefinitionQuery {
+	adq.unique = &unique
+	return adq
+}
+
+// Order adds an order step to the query.
+func (adq *AttributeDefinitionQuery) Order(o ...OrderFunc) *AttributeDefinitionQuery {
+	adq.order = append(adq.order, o...)
+	return adq
+}
+
+// QueryValues chains the current query on the "values" edge.
+func (adq *AttributeDefinitionQuery) QueryValues() *CarAttributeQuery {
+	query := &CarAttributeQuery{config: adq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := adq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := adq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attributedefinition.Table, attributedefinition.FieldID, selector),
+			sqlgraph.To(carattribute.Table, carattribute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attributedefinition.ValuesTable, attributedefinition.ValuesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(adq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AttributeDefinition entity from the query.
+// Returns a *NotFoundError when no AttributeDefinition was found.
+func (adq *AttributeDefinitionQuery) First(ctx context.Context) (*AttributeDefinition, error) {
+	nodes, err := adq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{attributedefinition.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) FirstX(ctx context.Context) *AttributeDefinition {
+	node, err := adq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AttributeDefinition ID from the query.
+// Returns a *NotFoundError when no AttributeDefinition ID was found.
+func (adq *AttributeDefinitionQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = adq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{attributedefinition.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := adq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AttributeDefinition entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AttributeDefinition entity is found.
+// Returns a *NotFoundError when no AttributeDefinition entities are found.
+func (adq *AttributeDefinitionQuery) Only(ctx context.Context) (*AttributeDefinition, error) {
+	nodes, err := adq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{attributedefinition.Label}
+	default:
+		return nil, &NotSingularError{attributedefinition.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) OnlyX(ctx context.Context) *AttributeDefinition {
+	node, err := adq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AttributeDefinition ID in the query.
+// Returns a *NotSingularError when more than one AttributeDefinition ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (adq *AttributeDefinitionQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = adq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{attributedefinition.Label}
+	default:
+		err = &NotSingularError{attributedefinition.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := adq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AttributeDefinitions.
+func (adq *AttributeDefinitionQuery) All(ctx context.Context) ([]*AttributeDefinition, error) {
+	if err := adq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return adq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) AllX(ctx context.Context) []*AttributeDefinition {
+	nodes, err := adq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AttributeDefinition IDs.
+func (adq *AttributeDefinitionQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := adq.Select(attributedefinition.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := adq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (adq *AttributeDefinitionQuery) Count(ctx context.Context) (int, error) {
+	if err := adq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return adq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) CountX(ctx context.Context) int {
+	count, err := adq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (adq *AttributeDefinitionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := adq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return adq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (adq *AttributeDefinitionQuery) ExistX(ctx context.Context) bool {
+	exist, err := adq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AttributeDefinitionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (adq *AttributeDefinitionQuery) Clone() *AttributeDefinitionQuery {
+	if adq == nil {
+		return nil
+	}
+	return &AttributeDefinitionQuery{
+		config:     adq.config,
+		limit:      adq.limit,
+		offset:     adq.offset,
+		order:      append([]OrderFunc{}, adq.order...),
+		predicates: append([]predicate.AttributeDefinition{}, adq.predicates...),
+		withValues: adq.withValues.Clone(),
+		// clone intermediate query.
+		sql:    adq.sql.Clone(),
+		path:   adq.path,
+		unique: adq.unique,
+	}
+}
+
+// WithValues tells the query-builder to eager-load the nodes that are connected to
+// the "values" edge. The optional arguments are used to configure the query builder of the edge.
+func (adq *AttributeDefinitionQuery) WithValues(opts ...func(*CarAttributeQuery)) *AttributeDefinitionQuery {
+	query := &CarAttributeQuery{config: adq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	adq.withValues = query
+	return adq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AttributeDefinition.Query().
+//		GroupBy(attributedefinition.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (adq *AttributeDefinitionQuery) GroupBy(field string, fields ...string) *AttributeDefinitionGroupBy {
+	grbuild := &AttributeDefinitionGroupBy{config: adq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := adq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return adq.sqlQuery(ctx), nil
+	}
+	grbuild.label = attributedefinition.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.AttributeDefinition.Query().
+//		Select(attributedefinition.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (adq *AttributeDefinitionQuery) Select(fields ...string) *AttributeDefinitionSelect {
+	adq.fields = append(adq.fields, fields...)
+	selbuild := &AttributeDefinitionSelect{AttributeDefinitionQuery: adq}
+	selbuild.label = attributedefinition.Label
+	selbuild.flds, selbuild.scan = &adq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (adq *AttributeDefinitionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range adq.fields {
+		if !attributedefinition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if adq.path != nil {
+		prev, err := adq.path(ctx)
+		if err != nil {
+			return err
+		}
+		adq.sql = prev
+	}
+	if attributedefinition.Policy == nil {
+		return errors.New("ent: uninitialized attributedefinition.Policy (forgotten import ent/runtime?)")
+	}
+	if err := attributedefinition.Policy.EvalQuery(ctx, adq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (adq *AttributeDefinitionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AttributeDefinition, error) {
+	var (
+		nodes       = []*AttributeDefinition{}
+		_spec       = adq.querySpec()
+		loadedTypes = [1]bool{
+			adq.withValues != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*AttributeDefinition).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &AttributeDefinition{config: adq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(adq.modifiers) > 0 {
+		_spec.Modifiers = adq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, adq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := adq.withValues; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*AttributeDefinition)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Values = []*CarAttribute{}
+		}
+		query.Where(predicate.CarAttribute(func(s *sql.Selector) {
+			s.Where(sql.InValues(attributedefinition.ValuesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.DefinitionID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "definition_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Values = append(node.Edges.Values, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (adq *AttributeDefinitionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := adq.querySpec()
+	if len(adq.modifiers) > 0 {
+		_spec.Modifiers = adq.modifiers
+	}
+	_spec.Node.Columns = adq.fields
+	if len(adq.fields) > 0 {
+		_spec.Unique = adq.unique != nil && *adq.unique
+	}
+	return sqlgraph.CountNodes(ctx, adq.driver, _spec)
+}
+
+func (adq *AttributeDefinitionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := adq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (adq *AttributeDefinitionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   attributedefinition.Table,
+			Columns: attributedefinition.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: attributedefinition.FieldID,
+			},
+		},
+		From:   adq.sql,
+		Unique: true,
+	}
+	if unique := adq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := adq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attributedefinition.FieldID)
+		for i := range fields {
+			if fields[i] != attributedefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := adq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := adq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := adq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := adq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (adq *AttributeDefinitionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(adq.driver.Dialect())
+	t1 := builder.Table(attributedefinition.Table)
+	columns := adq.fields
+	if len(columns) == 0 {
+		columns = attributedefinition.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if adq.sql != nil {
+		selector = adq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if adq.unique != nil && *adq.unique {
+		selector.Distinct()
+	}
+	for _, m := range adq.modifiers {
+		m(selector)
+	}
+	for _, p := range adq.predicates {
+		p(selector)
+	}
+	for _, p := range adq.order {
+		p(selector)
+	}
+	if offset := adq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := adq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (adq *AttributeDefinitionQuery) ForUpdate(opts ...sql.LockOption) *AttributeDefinitionQuery {
+	if adq.driver.Dialect() == dialect.Postgres {
+		adq.Unique(false)
+	}
+	adq.modifiers = append(adq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return adq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (adq *AttributeDefinitionQuery) ForShare(opts ...sql.LockOption) *AttributeDefinitionQuery {
+	if adq.driver.Dialect() == dialect.Postgres {
+		adq.Unique(false)
+	}
+	adq.modifiers = append(adq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return adq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (adq *AttributeDefinitionQuery) Modify(modifiers ...func(s *sql.Selector)) *AttributeDefinitionSelect {
+	adq.modifiers = append(adq.modifiers, modifiers...)
+	return adq.Select()
+}
+
+// AttributeDefinitionGroupBy is the group-by builder for AttributeDefinition entities.
+type AttributeDefinitionGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (adgb *AttributeDefinitionGroupBy) Aggregate(fns ...AggregateFunc) *AttributeDefinitionGroupBy {
+	adgb.fns = append(adgb.fns, fns...)
+	return adgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (adgb *AttributeDefinitionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := adgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	adgb.sql = query
+	return adgb.sqlScan(ctx, v)
+}
+
+func (adgb *AttributeDefinitionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range adgb.fields {
+		if !attributedefinition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := adgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := adgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (adgb *AttributeDefinitionGroupBy) sqlQuery() *sql.Selector {
+	selector := adgb.sql.Select()
+	aggregation := make([]string, 0, len(adgb.fns))
+	for _, fn := range adgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(adgb.fields)+len(adgb.fns))
+		for _, f := range adgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(adgb.fields...)...)
+}
+
+// AttributeDefinitionSelect is the builder for selecting fields of AttributeDefinition entities.
+type AttributeDefinitionSelect struct {
+	*AttributeDefinitionQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ads *AttributeDefinitionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ads.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ads.sql = ads.AttributeDefinitionQuery.sqlQuery(ctx)
+	return ads.sqlScan(ctx, v)
+}
+
+func (ads *AttributeDefinitionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ads.sql.Query()
+	if err := ads.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ads *AttributeDefinitionSelect) Modify(modifiers ...func(s *sql.Selector)) *AttributeDefinitionSelect {
+	ads.modifiers = append(ads.modifiers, modifiers...)
+	return ads
+}
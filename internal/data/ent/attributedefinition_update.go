@@ -0,0 +1,763 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttributeDefinitionUpdate is the builder for updating AttributeDefinition entities.
+type AttributeDefinitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttributeDefinitionMutation
+}
+
+// Where appends a list predicates to the AttributeDefinitionUpdate builder.
+func (adu *AttributeDefinitionUpdate) Where(ps ...predicate.AttributeDefinition) *AttributeDefinitionUpdate {
+	adu.mutation.Where(ps...)
+	return adu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (adu *AttributeDefinitionUpdate) SetTenantID(i int64) *AttributeDefinitionUpdate {
+	adu.mutation.ResetTenantID()
+	adu.mutation.SetTenantID(i)
+	return adu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableTenantID(i *int64) *AttributeDefinitionUpdate {
+	if i != nil {
+		adu.SetTenantID(*i)
+	}
+	return adu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (adu *AttributeDefinitionUpdate) AddTenantID(i int64) *AttributeDefinitionUpdate {
+	adu.mutation.AddTenantID(i)
+	return adu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (adu *AttributeDefinitionUpdate) ClearTenantID() *AttributeDefinitionUpdate {
+	adu.mutation.ClearTenantID()
+	return adu
+}
+
+// SetName sets the "name" field.
+func (adu *AttributeDefinitionUpdate) SetName(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetName(s)
+	return adu
+}
+
+// SetValueType sets the "value_type" field.
+func (adu *AttributeDefinitionUpdate) SetValueType(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetValueType(s)
+	return adu
+}
+
+// SetNillableValueType sets the "value_type" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableValueType(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetValueType(*s)
+	}
+	return adu
+}
+
+// ClearValueType clears the value of the "value_type" field.
+func (adu *AttributeDefinitionUpdate) ClearValueType() *AttributeDefinitionUpdate {
+	adu.mutation.ClearValueType()
+	return adu
+}
+
+// SetRequired sets the "required" field.
+func (adu *AttributeDefinitionUpdate) SetRequired(b bool) *AttributeDefinitionUpdate {
+	adu.mutation.SetRequired(b)
+	return adu
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableRequired(b *bool) *AttributeDefinitionUpdate {
+	if b != nil {
+		adu.SetRequired(*b)
+	}
+	return adu
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (adu *AttributeDefinitionUpdate) SetEnumValues(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetEnumValues(s)
+	return adu
+}
+
+// SetNillableEnumValues sets the "enum_values" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableEnumValues(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetEnumValues(*s)
+	}
+	return adu
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (adu *AttributeDefinitionUpdate) ClearEnumValues() *AttributeDefinitionUpdate {
+	adu.mutation.ClearEnumValues()
+	return adu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (adu *AttributeDefinitionUpdate) SetCreatedAt(t time.Time) *AttributeDefinitionUpdate {
+	adu.mutation.SetCreatedAt(t)
+	return adu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableCreatedAt(t *time.Time) *AttributeDefinitionUpdate {
+	if t != nil {
+		adu.SetCreatedAt(*t)
+	}
+	return adu
+}
+
+// AddValueIDs adds the "values" edge to the CarAttribute entity by IDs.
+func (adu *AttributeDefinitionUpdate) AddValueIDs(ids ...int64) *AttributeDefinitionUpdate {
+	adu.mutation.AddValueIDs(ids...)
+	return adu
+}
+
+// AddValues adds the "values" edges to the CarAttribute entity.
+func (adu *AttributeDefinitionUpdate) AddValues(c ...*CarAttribute) *AttributeDefinitionUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return adu.AddValueIDs(ids...)
+}
+
+// Mutation returns the AttributeDefinitionMutation object of the builder.
+func (adu *AttributeDefinitionUpdate) Mutation() *AttributeDefinitionMutation {
+	return adu.mutation
+}
+
+// ClearValues clears all "values" edges to the CarAttribute entity.
+func (adu *AttributeDefinitionUpdate) ClearValues() *AttributeDefinitionUpdate {
+	adu.mutation.ClearValues()
+	return adu
+}
+
+// RemoveValueIDs removes the "values" edge to CarAttribute entities by IDs.
+func (adu *AttributeDefinitionUpdate) RemoveValueIDs(ids ...int64) *AttributeDefinitionUpdate {
+	adu.mutation.RemoveValueIDs(ids...)
+	return adu
+}
+
+// RemoveValues removes "values" edges to CarAttribute entities.
+func (adu *AttributeDefinitionUpdate) RemoveValues(c ...*CarAttribute) *AttributeDefinitionUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return adu.RemoveValueIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (adu *AttributeDefinitionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(adu.hooks) == 0 {
+		affected, err = adu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AttributeDefinitionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			adu.mutation = mutation
+			affected, err = adu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(adu.hooks) - 1; i >= 0; i-- {
+			if adu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = adu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, adu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adu *AttributeDefinitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := adu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (adu *AttributeDefinitionUpdate) Exec(ctx context.Context) error {
+	_, err := adu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adu *AttributeDefinitionUpdate) ExecX(ctx context.Context) {
+	if err := adu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (adu *AttributeDefinitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   attributedefinition.Table,
+			Columns: attributedefinition.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: attributedefinition.FieldID,
+			},
+		},
+	}
+	if ps := adu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := adu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attributedefinition.FieldTenantID,
+		})
+	}
+	if value, ok := adu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attributedefinition.FieldTenantID,
+		})
+	}
+	if adu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: attributedefinition.FieldTenantID,
+		})
+	}
+	if value, ok := adu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attributedefinition.FieldName,
+		})
+	}
+	if value, ok := adu.mutation.ValueType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attributedefinition.FieldValueType,
+		})
+	}
+	if adu.mutation.ValueTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attributedefinition.FieldValueType,
+		})
+	}
+	if value, ok := adu.mutation.Required(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: attributedefinition.FieldRequired,
+		})
+	}
+	if value, ok := adu.mutation.EnumValues(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attributedefinition.FieldEnumValues,
+		})
+	}
+	if adu.mutation.EnumValuesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attributedefinition.FieldEnumValues,
+		})
+	}
+	if value, ok := adu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: attributedefinition.FieldCreatedAt,
+		})
+	}
+	if adu.mutation.ValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attributedefinition.ValuesTable,
+			Columns: []string{attributedefinition.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := adu.mutation.RemovedValuesIDs(); len(nodes) > 0 && !adu.mutation.ValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attributedefinition.ValuesTable,
+			Columns: []string{attributedefinition.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := adu.mutation.ValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attributedefinition.ValuesTable,
+			Columns: []string{attributedefinition.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, adu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attributedefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AttributeDefinitionUpdateOne is the builder for updating a single AttributeDefinition entity.
+type AttributeDefinitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttributeDefinitionMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (aduo *AttributeDefinitionUpdateOne) SetTenantID(i int64) *AttributeDefinitionUpdateOne {
+	aduo.mutation.ResetTenantID()
+	aduo.mutation.SetTenantID(i)
+	return aduo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableTenantID(i *int64) *AttributeDefinitionUpdateOne {
+	if i != nil {
+		aduo.SetTenantID(*i)
+	}
+	return aduo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (aduo *AttributeDefinitionUpdateOne) AddTenantID(i int64) *AttributeDefinitionUpdateOne {
+	aduo.mutation.AddTenantID(i)
+	return aduo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearTenantID() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearTenantID()
+	return aduo
+}
+
+// SetName sets the "name" field.
+func (aduo *AttributeDefinitionUpdateOne) SetName(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetName(s)
+	return aduo
+}
+
+// SetValueType sets the "value_type" field.
+func (aduo *AttributeDefinitionUpdateOne) SetValueType(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetValueType(s)
+	return aduo
+}
+
+// SetNillableValueType sets the "value_type" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableValueType(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetValueType(*s)
+	}
+	return aduo
+}
+
+// ClearValueType clears the value of the "value_type" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearValueType() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearValueType()
+	return aduo
+}
+
+// SetRequired sets the "required" field.
+func (aduo *AttributeDefinitionUpdateOne) SetRequired(b bool) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetRequired(b)
+	return aduo
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableRequired(b *bool) *AttributeDefinitionUpdateOne {
+	if b != nil {
+		aduo.SetRequired(*b)
+	}
+	return aduo
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (aduo *AttributeDefinitionUpdateOne) SetEnumValues(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetEnumValues(s)
+	return aduo
+}
+
+// SetNillableEnumValues sets the "enum_values" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableEnumValues(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetEnumValues(*s)
+	}
+	return aduo
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearEnumValues() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearEnumValues()
+	return aduo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aduo *AttributeDefinitionUpdateOne) SetCreatedAt(t time.Time) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetCreatedAt(t)
+	return aduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableCreatedAt(t *time.Time) *AttributeDefinitionUpdateOne {
+	if t != nil {
+		aduo.SetCreatedAt(*t)
+	}
+	return aduo
+}
+
+// AddValueIDs adds the "values" edge to the CarAttribute entity by IDs.
+func (aduo *AttributeDefinitionUpdateOne) AddValueIDs(ids ...int64) *AttributeDefinitionUpdateOne {
+	aduo.mutation.AddValueIDs(ids...)
+	return aduo
+}
+
+// AddValues adds the "values" edges to the CarAttribute entity.
+func (aduo *AttributeDefinitionUpdateOne) AddValues(c ...*CarAttribute) *AttributeDefinitionUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return aduo.AddValueIDs(ids...)
+}
+
+// Mutation returns the AttributeDefinitionMutation object of the builder.
+func (aduo *AttributeDefinitionUpdateOne) Mutation() *AttributeDefinitionMutation {
+	return aduo.mutation
+}
+
+// ClearValues clears all "values" edges to the CarAttribute entity.
+func (aduo *AttributeDefinitionUpdateOne) ClearValues() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearValues()
+	return aduo
+}
+
+// RemoveValueIDs removes the "values" edge to CarAttribute entities by IDs.
+func (aduo *AttributeDefinitionUpdateOne) RemoveValueIDs(ids ...int64) *AttributeDefinitionUpdateOne {
+	aduo.mutation.RemoveValueIDs(ids...)
+	return aduo
+}
+
+// RemoveValues removes "values" edges to CarAttribute entities.
+func (aduo *AttributeDefinitionUpdateOne) RemoveValues(c ...*CarAttribute) *AttributeDefinitionUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return aduo.RemoveValueIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aduo *AttributeDefinitionUpdateOne) Select(field string, fields ...string) *AttributeDefinitionUpdateOne {
+	aduo.fields = append([]string{field}, fields...)
+	return aduo
+}
+
+// Save executes the query and returns the updated AttributeDefinition entity.
+func (aduo *AttributeDefinitionUpdateOne) Save(ctx context.Context) (*AttributeDefinition, error) {
+	var (
+		err  error
+		node *AttributeDefinition
+	)
+	if len(aduo.hooks) == 0 {
+		node, err = aduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AttributeDefinitionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			aduo.mutation = mutation
+			node, err = aduo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aduo.hooks) - 1; i >= 0; i-- {
+			if aduo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aduo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, aduo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AttributeDefinition)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AttributeDefinitionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aduo *AttributeDefinitionUpdateOne) SaveX(ctx context.Context) *AttributeDefinition {
+	node, err := aduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aduo *AttributeDefinitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := aduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aduo *AttributeDefinitionUpdateOne) ExecX(ctx context.Context) {
+	if err := aduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aduo *AttributeDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *AttributeDefinition, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   attributedefinition.Table,
+			Columns: attributedefinition.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: attributedefinition.FieldID,
+			},
+		},
+	}
+	id, ok := aduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttributeDefinition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attributedefinition.FieldID)
+		for _, f := range fields {
+			if !attributedefinition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attributedefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aduo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attributedefinition.FieldTenantID,
+		})
+	}
+	if value, ok := aduo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attributedefinition.FieldTenantID,
+		})
+	}
+	if aduo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: attributedefinition.FieldTenantID,
+		})
+	}
+	if value, ok := aduo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attributedefinition.FieldName,
+		})
+	}
+	if value, ok := aduo.mutation.ValueType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attributedefinition.FieldValueType,
+		})
+	}
+	if aduo.mutation.ValueTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attributedefinition.FieldValueType,
+		})
+	}
+	if value, ok := aduo.mutation.Required(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: attributedefinition.FieldRequired,
+		})
+	}
+	if value, ok := aduo.mutation.EnumValues(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attributedefinition.FieldEnumValues,
+		})
+	}
+	if aduo.mutation.EnumValuesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: attributedefinition.FieldEnumValues,
+		})
+	}
+	if value, ok := aduo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: attributedefinition.FieldCreatedAt,
+		})
+	}
+	if aduo.mutation.ValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attributedefinition.ValuesTable,
+			Columns: []string{attributedefinition.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aduo.mutation.RemovedValuesIDs(); len(nodes) > 0 && !aduo.mutation.ValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attributedefinition.ValuesTable,
+			Columns: []string{attributedefinition.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aduo.mutation.ValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attributedefinition.ValuesTable,
+			Columns: []string{attributedefinition.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AttributeDefinition{config: aduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attributedefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
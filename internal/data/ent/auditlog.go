@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/auditlog"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// Op holds the value of the "op" field.
+	Op string `json:"op,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor int64 `json:"actor,omitempty"`
+	// Diff holds the value of the "diff" field.
+	Diff string `json:"diff,omitempty"`
+	// TraceID holds the value of the "trace_id" field.
+	TraceID string `json:"trace_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID, auditlog.FieldTenantID, auditlog.FieldCarID, auditlog.FieldActor:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldOp, auditlog.FieldDiff, auditlog.FieldTraceID:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AuditLog", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = int64(value.Int64)
+		case auditlog.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				al.TenantID = value.Int64
+			}
+		case auditlog.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				al.CarID = value.Int64
+			}
+		case auditlog.FieldOp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field op", values[i])
+			} else if value.Valid {
+				al.Op = value.String
+			}
+		case auditlog.FieldActor:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				al.Actor = value.Int64
+			}
+		case auditlog.FieldDiff:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field diff", values[i])
+			} else if value.Valid {
+				al.Diff = value.String
+			}
+		case auditlog.FieldTraceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trace_id", values[i])
+			} else if value.Valid {
+				al.TraceID = value.String
+			}
+		case auditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				al.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return (&AuditLogClient{config: al.config}).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("op=")
+	builder.WriteString(al.Op)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(fmt.Sprintf("%v", al.Actor))
+	builder.WriteString(", ")
+	builder.WriteString("diff=")
+	builder.WriteString(al.Diff)
+	builder.WriteString(", ")
+	builder.WriteString("trace_id=")
+	builder.WriteString(al.TraceID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(al.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
+
+func (al AuditLogs) config(cfg config) {
+	for _i := range al {
+		al[_i].config = cfg
+	}
+}
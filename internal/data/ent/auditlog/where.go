@@ -0,0 +1,855 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlog
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// Op applies equality check predicate on the "op" field. It's identical to OpEQ.
+func Op(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOp), v))
+	})
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// Diff applies equality check predicate on the "diff" field. It's identical to DiffEQ.
+func Diff(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiff), v))
+	})
+}
+
+// TraceID applies equality check predicate on the "trace_id" field. It's identical to TraceIDEQ.
+func TraceID(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTraceID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDGT applies the GT predicate on the "car_id" field.
+func CarIDGT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDGTE applies the GTE predicate on the "car_id" field.
+func CarIDGTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDLT applies the LT predicate on the "car_id" field.
+func CarIDLT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDLTE applies the LTE predicate on the "car_id" field.
+func CarIDLTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCarID), v))
+	})
+}
+
+// OpEQ applies the EQ predicate on the "op" field.
+func OpEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOp), v))
+	})
+}
+
+// OpNEQ applies the NEQ predicate on the "op" field.
+func OpNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOp), v))
+	})
+}
+
+// OpIn applies the In predicate on the "op" field.
+func OpIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOp), v...))
+	})
+}
+
+// OpNotIn applies the NotIn predicate on the "op" field.
+func OpNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOp), v...))
+	})
+}
+
+// OpGT applies the GT predicate on the "op" field.
+func OpGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOp), v))
+	})
+}
+
+// OpGTE applies the GTE predicate on the "op" field.
+func OpGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOp), v))
+	})
+}
+
+// OpLT applies the LT predicate on the "op" field.
+func OpLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOp), v))
+	})
+}
+
+// OpLTE applies the LTE predicate on the "op" field.
+func OpLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOp), v))
+	})
+}
+
+// OpContains applies the Contains predicate on the "op" field.
+func OpContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOp), v))
+	})
+}
+
+// OpHasPrefix applies the HasPrefix predicate on the "op" field.
+func OpHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOp), v))
+	})
+}
+
+// OpHasSuffix applies the HasSuffix predicate on the "op" field.
+func OpHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOp), v))
+	})
+}
+
+// OpEqualFold applies the EqualFold predicate on the "op" field.
+func OpEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOp), v))
+	})
+}
+
+// OpContainsFold applies the ContainsFold predicate on the "op" field.
+func OpContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOp), v))
+	})
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...int64) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActor), v...))
+	})
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...int64) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActor), v...))
+	})
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActor), v))
+	})
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActor), v))
+	})
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldActor)))
+	})
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldActor)))
+	})
+}
+
+// DiffEQ applies the EQ predicate on the "diff" field.
+func DiffEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiff), v))
+	})
+}
+
+// DiffNEQ applies the NEQ predicate on the "diff" field.
+func DiffNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiff), v))
+	})
+}
+
+// DiffIn applies the In predicate on the "diff" field.
+func DiffIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiff), v...))
+	})
+}
+
+// DiffNotIn applies the NotIn predicate on the "diff" field.
+func DiffNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiff), v...))
+	})
+}
+
+// DiffGT applies the GT predicate on the "diff" field.
+func DiffGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiff), v))
+	})
+}
+
+// DiffGTE applies the GTE predicate on the "diff" field.
+func DiffGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiff), v))
+	})
+}
+
+// DiffLT applies the LT predicate on the "diff" field.
+func DiffLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiff), v))
+	})
+}
+
+// DiffLTE applies the LTE predicate on the "diff" field.
+func DiffLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiff), v))
+	})
+}
+
+// DiffContains applies the Contains predicate on the "diff" field.
+func DiffContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDiff), v))
+	})
+}
+
+// DiffHasPrefix applies the HasPrefix predicate on the "diff" field.
+func DiffHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDiff), v))
+	})
+}
+
+// DiffHasSuffix applies the HasSuffix predicate on the "diff" field.
+func DiffHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDiff), v))
+	})
+}
+
+// DiffIsNil applies the IsNil predicate on the "diff" field.
+func DiffIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDiff)))
+	})
+}
+
+// DiffNotNil applies the NotNil predicate on the "diff" field.
+func DiffNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDiff)))
+	})
+}
+
+// DiffEqualFold applies the EqualFold predicate on the "diff" field.
+func DiffEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDiff), v))
+	})
+}
+
+// DiffContainsFold applies the ContainsFold predicate on the "diff" field.
+func DiffContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDiff), v))
+	})
+}
+
+// TraceIDEQ applies the EQ predicate on the "trace_id" field.
+func TraceIDEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDNEQ applies the NEQ predicate on the "trace_id" field.
+func TraceIDNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDIn applies the In predicate on the "trace_id" field.
+func TraceIDIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTraceID), v...))
+	})
+}
+
+// TraceIDNotIn applies the NotIn predicate on the "trace_id" field.
+func TraceIDNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTraceID), v...))
+	})
+}
+
+// TraceIDGT applies the GT predicate on the "trace_id" field.
+func TraceIDGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDGTE applies the GTE predicate on the "trace_id" field.
+func TraceIDGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDLT applies the LT predicate on the "trace_id" field.
+func TraceIDLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDLTE applies the LTE predicate on the "trace_id" field.
+func TraceIDLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDContains applies the Contains predicate on the "trace_id" field.
+func TraceIDContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDHasPrefix applies the HasPrefix predicate on the "trace_id" field.
+func TraceIDHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDHasSuffix applies the HasSuffix predicate on the "trace_id" field.
+func TraceIDHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDIsNil applies the IsNil predicate on the "trace_id" field.
+func TraceIDIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTraceID)))
+	})
+}
+
+// TraceIDNotNil applies the NotNil predicate on the "trace_id" field.
+func TraceIDNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTraceID)))
+	})
+}
+
+// TraceIDEqualFold applies the EqualFold predicate on the "trace_id" field.
+func TraceIDEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTraceID), v))
+	})
+}
+
+// TraceIDContainsFold applies the ContainsFold predicate on the "trace_id" field.
+func TraceIDContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTraceID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/auditlog"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (alc *AuditLogCreate) SetTenantID(i int64) *AuditLogCreate {
+	alc.mutation.SetTenantID(i)
+	return alc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTenantID(i *int64) *AuditLogCreate {
+	if i != nil {
+		alc.SetTenantID(*i)
+	}
+	return alc
+}
+
+// SetCarID sets the "car_id" field.
+func (alc *AuditLogCreate) SetCarID(i int64) *AuditLogCreate {
+	alc.mutation.SetCarID(i)
+	return alc
+}
+
+// SetOp sets the "op" field.
+func (alc *AuditLogCreate) SetOp(s string) *AuditLogCreate {
+	alc.mutation.SetOp(s)
+	return alc
+}
+
+// SetActor sets the "actor" field.
+func (alc *AuditLogCreate) SetActor(i int64) *AuditLogCreate {
+	alc.mutation.SetActor(i)
+	return alc
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActor(i *int64) *AuditLogCreate {
+	if i != nil {
+		alc.SetActor(*i)
+	}
+	return alc
+}
+
+// SetDiff sets the "diff" field.
+func (alc *AuditLogCreate) SetDiff(s string) *AuditLogCreate {
+	alc.mutation.SetDiff(s)
+	return alc
+}
+
+// SetNillableDiff sets the "diff" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableDiff(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetDiff(*s)
+	}
+	return alc
+}
+
+// SetTraceID sets the "trace_id" field.
+func (alc *AuditLogCreate) SetTraceID(s string) *AuditLogCreate {
+	alc.mutation.SetTraceID(s)
+	return alc
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTraceID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetTraceID(*s)
+	}
+	return alc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(i int64) *AuditLogCreate {
+	alc.mutation.SetID(i)
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	var (
+		err  error
+		node *AuditLog
+	)
+	if err := alc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(alc.hooks) == 0 {
+		if err = alc.check(); err != nil {
+			return nil, err
+		}
+		node, err = alc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = alc.check(); err != nil {
+				return nil, err
+			}
+			alc.mutation = mutation
+			if node, err = alc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(alc.hooks) - 1; i >= 0; i-- {
+			if alc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = alc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, alc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AuditLog)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AuditLogMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() error {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		if auditlog.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized auditlog.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.CarID(); !ok {
+		return &ValidationError{Name: "car_id", err: errors.New(`ent: missing required field "AuditLog.car_id"`)}
+	}
+	if _, ok := alc.mutation.GetOp(); !ok {
+		return &ValidationError{Name: "op", err: errors.New(`ent: missing required field "AuditLog.op"`)}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: auditlog.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: auditlog.FieldID,
+			},
+		}
+	)
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := alc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := alc.mutation.CarID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldCarID,
+		})
+		_node.CarID = value
+	}
+	if value, ok := alc.mutation.GetOp(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldOp,
+		})
+		_node.Op = value
+	}
+	if value, ok := alc.mutation.Actor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldActor,
+		})
+		_node.Actor = value
+	}
+	if value, ok := alc.mutation.Diff(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldDiff,
+		})
+		_node.Diff = value
+	}
+	if value, ok := alc.mutation.TraceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldTraceID,
+		})
+		_node.TraceID = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	builders []*AuditLogCreate
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/auditlog"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (alu *AuditLogUpdate) SetTenantID(i int64) *AuditLogUpdate {
+	alu.mutation.ResetTenantID()
+	alu.mutation.SetTenantID(i)
+	return alu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTenantID(i *int64) *AuditLogUpdate {
+	if i != nil {
+		alu.SetTenantID(*i)
+	}
+	return alu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (alu *AuditLogUpdate) AddTenantID(i int64) *AuditLogUpdate {
+	alu.mutation.AddTenantID(i)
+	return alu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (alu *AuditLogUpdate) ClearTenantID() *AuditLogUpdate {
+	alu.mutation.ClearTenantID()
+	return alu
+}
+
+// SetCarID sets the "car_id" field.
+func (alu *AuditLogUpdate) SetCarID(i int64) *AuditLogUpdate {
+	alu.mutation.ResetCarID()
+	alu.mutation.SetCarID(i)
+	return alu
+}
+
+// AddCarID adds i to the "car_id" field.
+func (alu *AuditLogUpdate) AddCarID(i int64) *AuditLogUpdate {
+	alu.mutation.AddCarID(i)
+	return alu
+}
+
+// SetOp sets the "op" field.
+func (alu *AuditLogUpdate) SetOp(s string) *AuditLogUpdate {
+	alu.mutation.SetOp(s)
+	return alu
+}
+
+// SetActor sets the "actor" field.
+func (alu *AuditLogUpdate) SetActor(i int64) *AuditLogUpdate {
+	alu.mutation.ResetActor()
+	alu.mutation.SetActor(i)
+	return alu
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableActor(i *int64) *AuditLogUpdate {
+	if i != nil {
+		alu.SetActor(*i)
+	}
+	return alu
+}
+
+// AddActor adds i to the "actor" field.
+func (alu *AuditLogUpdate) AddActor(i int64) *AuditLogUpdate {
+	alu.mutation.AddActor(i)
+	return alu
+}
+
+// ClearActor clears the value of the "actor" field.
+func (alu *AuditLogUpdate) ClearActor() *AuditLogUpdate {
+	alu.mutation.ClearActor()
+	return alu
+}
+
+// SetDiff sets the "diff" field.
+func (alu *AuditLogUpdate) SetDiff(s string) *AuditLogUpdate {
+	alu.mutation.SetDiff(s)
+	return alu
+}
+
+// SetNillableDiff sets the "diff" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableDiff(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetDiff(*s)
+	}
+	return alu
+}
+
+// ClearDiff clears the value of the "diff" field.
+func (alu *AuditLogUpdate) ClearDiff() *AuditLogUpdate {
+	alu.mutation.ClearDiff()
+	return alu
+}
+
+// SetTraceID sets the "trace_id" field.
+func (alu *AuditLogUpdate) SetTraceID(s string) *AuditLogUpdate {
+	alu.mutation.SetTraceID(s)
+	return alu
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTraceID(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetTraceID(*s)
+	}
+	return alu
+}
+
+// ClearTraceID clears the value of the "trace_id" field.
+func (alu *AuditLogUpdate) ClearTraceID() *AuditLogUpdate {
+	alu.mutation.ClearTraceID()
+	return alu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alu *AuditLogUpdate) SetCreatedAt(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetCreatedAt(t)
+	return alu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableCreatedAt(t *time.Time) *AuditLogUpdate {
+	if t != nil {
+		alu.SetCreatedAt(*t)
+	}
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(alu.hooks) == 0 {
+		affected, err = alu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			alu.mutation = mutation
+			affected, err = alu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(alu.hooks) - 1; i >= 0; i-- {
+			if alu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = alu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, alu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if value, ok := alu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if alu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if value, ok := alu.mutation.CarID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldCarID,
+		})
+	}
+	if value, ok := alu.mutation.AddedCarID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldCarID,
+		})
+	}
+	if value, ok := alu.mutation.GetOp(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldOp,
+		})
+	}
+	if value, ok := alu.mutation.Actor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldActor,
+		})
+	}
+	if value, ok := alu.mutation.AddedActor(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldActor,
+		})
+	}
+	if alu.mutation.ActorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: auditlog.FieldActor,
+		})
+	}
+	if value, ok := alu.mutation.Diff(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldDiff,
+		})
+	}
+	if alu.mutation.DiffCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldDiff,
+		})
+	}
+	if value, ok := alu.mutation.TraceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldTraceID,
+		})
+	}
+	if alu.mutation.TraceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldTraceID,
+		})
+	}
+	if value, ok := alu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldCreatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (aluo *AuditLogUpdateOne) SetTenantID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetTenantID()
+	aluo.mutation.SetTenantID(i)
+	return aluo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTenantID(i *int64) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetTenantID(*i)
+	}
+	return aluo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (aluo *AuditLogUpdateOne) AddTenantID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddTenantID(i)
+	return aluo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (aluo *AuditLogUpdateOne) ClearTenantID() *AuditLogUpdateOne {
+	aluo.mutation.ClearTenantID()
+	return aluo
+}
+
+// SetCarID sets the "car_id" field.
+func (aluo *AuditLogUpdateOne) SetCarID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetCarID()
+	aluo.mutation.SetCarID(i)
+	return aluo
+}
+
+// AddCarID adds i to the "car_id" field.
+func (aluo *AuditLogUpdateOne) AddCarID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddCarID(i)
+	return aluo
+}
+
+// SetOp sets the "op" field.
+func (aluo *AuditLogUpdateOne) SetOp(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetOp(s)
+	return aluo
+}
+
+// SetActor sets the "actor" field.
+func (aluo *AuditLogUpdateOne) SetActor(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetActor()
+	aluo.mutation.SetActor(i)
+	return aluo
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableActor(i *int64) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetActor(*i)
+	}
+	return aluo
+}
+
+// AddActor adds i to the "actor" field.
+func (aluo *AuditLogUpdateOne) AddActor(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddActor(i)
+	return aluo
+}
+
+// ClearActor clears the value of the "actor" field.
+func (aluo *AuditLogUpdateOne) ClearActor() *AuditLogUpdateOne {
+	aluo.mutation.ClearActor()
+	return aluo
+}
+
+// SetDiff sets the "diff" field.
+func (aluo *AuditLogUpdateOne) SetDiff(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetDiff(s)
+	return aluo
+}
+
+// SetNillableDiff sets the "diff" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableDiff(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetDiff(*s)
+	}
+	return aluo
+}
+
+// ClearDiff clears the value of the "diff" field.
+func (aluo *AuditLogUpdateOne) ClearDiff() *AuditLogUpdateOne {
+	aluo.mutation.ClearDiff()
+	return aluo
+}
+
+// SetTraceID sets the "trace_id" field.
+func (aluo *AuditLogUpdateOne) SetTraceID(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetTraceID(s)
+	return aluo
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTraceID(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetTraceID(*s)
+	}
+	return aluo
+}
+
+// ClearTraceID clears the value of the "trace_id" field.
+func (aluo *AuditLogUpdateOne) ClearTraceID() *AuditLogUpdateOne {
+	aluo.mutation.ClearTraceID()
+	return aluo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aluo *AuditLogUpdateOne) SetCreatedAt(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetCreatedAt(t)
+	return aluo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableCreatedAt(t *time.Time) *AuditLogUpdateOne {
+	if t != nil {
+		aluo.SetCreatedAt(*t)
+	}
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	var (
+		err  error
+		node *AuditLog
+	)
+	if len(aluo.hooks) == 0 {
+		node, err = aluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			aluo.mutation = mutation
+			node, err = aluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aluo.hooks) - 1; i >= 0; i-- {
+			if aluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, aluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AuditLog)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AuditLogMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if value, ok := aluo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if aluo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if value, ok := aluo.mutation.CarID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldCarID,
+		})
+	}
+	if value, ok := aluo.mutation.AddedCarID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldCarID,
+		})
+	}
+	if value, ok := aluo.mutation.GetOp(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldOp,
+		})
+	}
+	if value, ok := aluo.mutation.Actor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldActor,
+		})
+	}
+	if value, ok := aluo.mutation.AddedActor(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldActor,
+		})
+	}
+	if aluo.mutation.ActorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: auditlog.FieldActor,
+		})
+	}
+	if value, ok := aluo.mutation.Diff(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldDiff,
+		})
+	}
+	if aluo.mutation.DiffCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldDiff,
+		})
+	}
+	if value, ok := aluo.mutation.TraceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldTraceID,
+		})
+	}
+	if aluo.mutation.TraceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldTraceID,
+		})
+	}
+	if value, ok := aluo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldCreatedAt,
+		})
+	}
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
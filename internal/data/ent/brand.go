@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/brand"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Brand is the model entity for the Brand schema.
+type Brand struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BrandQuery when eager-loading is set.
+	Edges BrandEdges `json:"edges"`
+}
+
+// BrandEdges holds the relations/edges for other nodes in the graph.
+type BrandEdges struct {
+	// VehicleModels holds the value of the vehicle_models edge.
+	VehicleModels []*VehicleModel `json:"vehicle_models,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VehicleModelsOrErr returns the VehicleModels value or an error if the edge
+// was not loaded in eager-loading.
+func (e BrandEdges) VehicleModelsOrErr() ([]*VehicleModel, error) {
+	if e.loadedTypes[0] {
+		return e.VehicleModels, nil
+	}
+	return nil, &NotLoadedError{edge: "vehicle_models"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Brand) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case brand.FieldID:
+			values[i] = new(sql.NullInt64)
+		case brand.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Brand", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Brand fields.
+func (b *Brand) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case brand.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			b.ID = int64(value.Int64)
+		case brand.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				b.Name = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryVehicleModels queries the "vehicle_models" edge of the Brand entity.
+func (b *Brand) QueryVehicleModels() *VehicleModelQuery {
+	return (&BrandClient{config: b.config}).QueryVehicleModels(b)
+}
+
+// Update returns a builder for updating this Brand.
+// Note that you need to call Brand.Unwrap() before calling this method if this Brand
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Brand) Update() *BrandUpdateOne {
+	return (&BrandClient{config: b.config}).UpdateOne(b)
+}
+
+// Unwrap unwraps the Brand entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Brand) Unwrap() *Brand {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Brand is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Brand) String() string {
+	var builder strings.Builder
+	builder.WriteString("Brand(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("name=")
+	builder.WriteString(b.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Brands is a parsable slice of Brand.
+type Brands []*Brand
+
+func (b Brands) config(cfg config) {
+	for _i := range b {
+		b[_i].config = cfg
+	}
+}
@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package brand
+
+const (
+	// Label holds the string label denoting the brand type in the database.
+	Label = "brand"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeVehicleModels holds the string denoting the vehicle_models edge name in mutations.
+	EdgeVehicleModels = "vehicle_models"
+	// Table holds the table name of the brand in the database.
+	Table = "brand"
+	// VehicleModelsTable is the table that holds the vehicle_models relation/edge.
+	VehicleModelsTable = "vehicle_model"
+	// VehicleModelsInverseTable is the table name for the VehicleModel entity.
+	// It exists in this package in order to avoid circular dependency with the "vehiclemodel" package.
+	VehicleModelsInverseTable = "vehicle_model"
+	// VehicleModelsColumn is the table column denoting the vehicle_models relation/edge.
+	VehicleModelsColumn = "brand_id"
+)
+
+// Columns holds all SQL columns for brand fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/brand"
+	"car-service/internal/data/ent/vehiclemodel"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BrandCreate is the builder for creating a Brand entity.
+type BrandCreate struct {
+	config
+	mutation *BrandMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (bc *BrandCreate) SetName(s string) *BrandCreate {
+	bc.mutation.SetName(s)
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BrandCreate) SetID(i int64) *BrandCreate {
+	bc.mutation.SetID(i)
+	return bc
+}
+
+// AddVehicleModelIDs adds the "vehicle_models" edge to the VehicleModel entity by IDs.
+func (bc *BrandCreate) AddVehicleModelIDs(ids ...int64) *BrandCreate {
+	bc.mutation.AddVehicleModelIDs(ids...)
+	return bc
+}
+
+// AddVehicleModels adds the "vehicle_models" edges to the VehicleModel entity.
+func (bc *BrandCreate) AddVehicleModels(v ...*VehicleModel) *BrandCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return bc.AddVehicleModelIDs(ids...)
+}
+
+// Mutation returns the BrandMutation object of the builder.
+func (bc *BrandCreate) Mutation() *BrandMutation {
+	return bc.mutation
+}
+
+// Save creates the Brand in the database.
+func (bc *BrandCreate) Save(ctx context.Context) (*Brand, error) {
+	var (
+		err  error
+		node *Brand
+	)
+	if len(bc.hooks) == 0 {
+		if err = bc.check(); err != nil {
+			return nil, err
+		}
+		node, err = bc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BrandMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bc.check(); err != nil {
+				return nil, err
+			}
+			bc.mutation = mutation
+			if node, err = bc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bc.hooks) - 1; i >= 0; i-- {
+			if bc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Brand)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BrandMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BrandCreate) SaveX(ctx context.Context) *Brand {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BrandCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BrandCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BrandCreate) check() error {
+	if _, ok := bc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Brand.name"`)}
+	}
+	return nil
+}
+
+func (bc *BrandCreate) sqlSave(ctx context.Context) (*Brand, error) {
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (bc *BrandCreate) createSpec() (*Brand, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Brand{config: bc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: brand.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: brand.FieldID,
+			},
+		}
+	)
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: brand.FieldName,
+		})
+		_node.Name = value
+	}
+	if nodes := bc.mutation.VehicleModelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brand.VehicleModelsTable,
+			Columns: []string{brand.VehicleModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BrandCreateBulk is the builder for creating many Brand entities in bulk.
+type BrandCreateBulk struct {
+	config
+	builders []*BrandCreate
+}
+
+// Save creates the Brand entities in the database.
+func (bcb *BrandCreateBulk) Save(ctx context.Context) ([]*Brand, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Brand, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BrandMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BrandCreateBulk) SaveX(ctx context.Context) []*Brand {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BrandCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BrandCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
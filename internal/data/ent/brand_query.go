@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/brand"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/vehiclemodel"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BrandQuery is the builder for querying Brand entities.
+type BrandQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Brand
+	// eager-loading edges.
+	withVehicleModels *VehicleModelQuery
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BrandQuery builder.
+func (bq *BrandQuery) Where(ps ...predicate.Brand) *BrandQuery {
+	bq.predicates = append(bq.predicates, ps...)
+	return bq
+}
+
+// Limit adds a limit step to the query.
+func (bq *BrandQuery) Limit(limit int) *BrandQuery {
+	bq.limit = &limit
+	return bq
+}
+
+// Offset adds an offset step to the query.
+func (bq *BrandQuery) Offset(offset int) *BrandQuery {
+	bq.offset = &offset
+	return bq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bq *BrandQuery) Unique(unique bool) *BrandQuery {
+	bq.unique = &unique
+	return bq
+}
+
+// Order adds an order step to the query.
+func (bq *BrandQuery) Order(o ...OrderFunc) *BrandQuery {
+	bq.order = append(bq.order, o...)
+	return bq
+}
+
+// QueryVehicleModels chains the current query on the "vehicle_models" edge.
+func (bq *BrandQuery) QueryVehicleModels() *VehicleModelQuery {
+	query := &VehicleModelQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(brand.Table, brand.FieldID, selector),
+			sqlgraph.To(vehiclemodel.Table, vehiclemodel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, brand.VehicleModelsTable, brand.VehicleModelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Brand entity from the query.
+// Returns a *NotFoundError when no Brand was found.
+func (bq *BrandQuery) First(ctx context.Context) (*Brand, error) {
+	nodes, err := bq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{brand.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bq *BrandQuery) FirstX(ctx context.Context) *Brand {
+	node, err := bq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Brand ID from the query.
+// Returns a *NotFoundError when no Brand ID was found.
+func (bq *BrandQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = bq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{brand.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bq *BrandQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := bq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Brand entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Brand entity is found.
+// Returns a *NotFoundError when no Brand entities are found.
+func (bq *BrandQuery) Only(ctx context.Context) (*Brand, error) {
+	nodes, err := bq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{brand.Label}
+	default:
+		return nil, &NotSingularError{brand.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bq *BrandQuery) OnlyX(ctx context.Context) *Brand {
+	node, err := bq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Brand ID in the query.
+// Returns a *NotSingularError when more than one Brand ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bq *BrandQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = bq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{brand.Label}
+	default:
+		err = &NotSingularError{brand.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bq *BrandQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := bq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Brands.
+func (bq *BrandQuery) All(ctx context.Context) ([]*Brand, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return bq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bq *BrandQuery) AllX(ctx context.Context) []*Brand {
+	nodes, err := bq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Brand IDs.
+func (bq *BrandQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := bq.Select(brand.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bq *BrandQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := bq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bq *BrandQuery) Count(ctx context.Context) (int, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return bq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bq *BrandQuery) CountX(ctx context.Context) int {
+	count, err := bq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bq *BrandQuery) Exist(ctx context.Context) (bool, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return bq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bq *BrandQuery) ExistX(ctx context.Context) bool {
+	exist, err := bq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BrandQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bq *BrandQuery) Clone() *BrandQuery {
+	if bq == nil {
+		return nil
+	}
+	return &BrandQuery{
+		config:            bq.config,
+		limit:             bq.limit,
+		offset:            bq.offset,
+		order:             append([]OrderFunc{}, bq.order...),
+		predicates:        append([]predicate.Brand{}, bq.predicates...),
+		withVehicleModels: bq.withVehicleModels.Clone(),
+		// clone intermediate query.
+		sql:    bq.sql.Clone(),
+		path:   bq.path,
+		unique: bq.unique,
+	}
+}
+
+// WithVehicleModels tells the query-builder to eager-load the nodes that are connected to
+// the "vehicle_models" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BrandQuery) WithVehicleModels(opts ...func(*VehicleModelQuery)) *BrandQuery {
+	query := &VehicleModelQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withVehicleModels = query
+	return bq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Brand.Query().
+//		GroupBy(brand.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (bq *BrandQuery) GroupBy(field string, fields ...string) *BrandGroupBy {
+	grbuild := &BrandGroupBy{config: bq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bq.sqlQuery(ctx), nil
+	}
+	grbuild.label = brand.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Brand.Query().
+//		Select(brand.FieldName).
+//		Scan(ctx, &v)
+//
+func (bq *BrandQuery) Select(fields ...string) *BrandSelect {
+	bq.fields = append(bq.fields, fields...)
+	selbuild := &BrandSelect{BrandQuery: bq}
+	selbuild.label = brand.Label
+	selbuild.flds, selbuild.scan = &bq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (bq *BrandQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range bq.fields {
+		if !brand.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bq.path != nil {
+		prev, err := bq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bq.sql = prev
+	}
+	return nil
+}
+
+func (bq *BrandQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Brand, error) {
+	var (
+		nodes       = []*Brand{}
+		_spec       = bq.querySpec()
+		loadedTypes = [1]bool{
+			bq.withVehicleModels != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Brand).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Brand{config: bq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := bq.withVehicleModels; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Brand)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.VehicleModels = []*VehicleModel{}
+		}
+		query.Where(predicate.VehicleModel(func(s *sql.Selector) {
+			s.Where(sql.InValues(brand.VehicleModelsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.BrandID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "brand_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.VehicleModels = append(node.Edges.VehicleModels, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (bq *BrandQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bq.querySpec()
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
+	_spec.Node.Columns = bq.fields
+	if len(bq.fields) > 0 {
+		_spec.Unique = bq.unique != nil && *bq.unique
+	}
+	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
+}
+
+func (bq *BrandQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := bq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (bq *BrandQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   brand.Table,
+			Columns: brand.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: brand.FieldID,
+			},
+		},
+		From:   bq.sql,
+		Unique: true,
+	}
+	if unique := bq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := bq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, brand.FieldID)
+		for i := range fields {
+			if fields[i] != brand.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bq *BrandQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bq.driver.Dialect())
+	t1 := builder.Table(brand.Table)
+	columns := bq.fields
+	if len(columns) == 0 {
+		columns = brand.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bq.sql != nil {
+		selector = bq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bq.unique != nil && *bq.unique {
+		selector.Distinct()
+	}
+	for _, m := range bq.modifiers {
+		m(selector)
+	}
+	for _, p := range bq.predicates {
+		p(selector)
+	}
+	for _, p := range bq.order {
+		p(selector)
+	}
+	if offset := bq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (bq *BrandQuery) ForUpdate(opts ...sql.LockOption) *BrandQuery {
+	if bq.driver.Dialect() == dialect.Postgres {
+		bq.Unique(false)
+	}
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return bq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (bq *BrandQuery) ForShare(opts ...sql.LockOption) *BrandQuery {
+	if bq.driver.Dialect() == dialect.Postgres {
+		bq.Unique(false)
+	}
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return bq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bq *BrandQuery) Modify(modifiers ...func(s *sql.Selector)) *BrandSelect {
+	bq.modifiers = append(bq.modifiers, modifiers...)
+	return bq.Select()
+}
+
+// BrandGroupBy is the group-by builder for Brand entities.
+type BrandGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bgb *BrandGroupBy) Aggregate(fns ...AggregateFunc) *BrandGroupBy {
+	bgb.fns = append(bgb.fns, fns...)
+	return bgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bgb *BrandGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bgb.sql = query
+	return bgb.sqlScan(ctx, v)
+}
+
+func (bgb *BrandGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bgb.fields {
+		if !brand.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bgb *BrandGroupBy) sqlQuery() *sql.Selector {
+	selector := bgb.sql.Select()
+	aggregation := make([]string, 0, len(bgb.fns))
+	for _, fn := range bgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bgb.fields)+len(bgb.fns))
+		for _, f := range bgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bgb.fields...)...)
+}
+
+// BrandSelect is the builder for selecting fields of Brand entities.
+type BrandSelect struct {
+	*BrandQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bs *BrandSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bs.sql = bs.BrandQuery.sqlQuery(ctx)
+	return bs.sqlScan(ctx, v)
+}
+
+func (bs *BrandSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bs.sql.Query()
+	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bs *BrandSelect) Modify(modifiers ...func(s *sql.Selector)) *BrandSelect {
+	bs.modifiers = append(bs.modifiers, modifiers...)
+	return bs
+}
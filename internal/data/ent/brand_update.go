@@ -0,0 +1,452 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/brand"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/vehiclemodel"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BrandUpdate is the builder for updating Brand entities.
+type BrandUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BrandMutation
+}
+
+// Where appends a list predicates to the BrandUpdate builder.
+func (bu *BrandUpdate) Where(ps ...predicate.Brand) *BrandUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetName sets the "name" field.
+func (bu *BrandUpdate) SetName(s string) *BrandUpdate {
+	bu.mutation.SetName(s)
+	return bu
+}
+
+// AddVehicleModelIDs adds the "vehicle_models" edge to the VehicleModel entity by IDs.
+func (bu *BrandUpdate) AddVehicleModelIDs(ids ...int64) *BrandUpdate {
+	bu.mutation.AddVehicleModelIDs(ids...)
+	return bu
+}
+
+// AddVehicleModels adds the "vehicle_models" edges to the VehicleModel entity.
+func (bu *BrandUpdate) AddVehicleModels(v ...*VehicleModel) *BrandUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return bu.AddVehicleModelIDs(ids...)
+}
+
+// Mutation returns the BrandMutation object of the builder.
+func (bu *BrandUpdate) Mutation() *BrandMutation {
+	return bu.mutation
+}
+
+// ClearVehicleModels clears all "vehicle_models" edges to the VehicleModel entity.
+func (bu *BrandUpdate) ClearVehicleModels() *BrandUpdate {
+	bu.mutation.ClearVehicleModels()
+	return bu
+}
+
+// RemoveVehicleModelIDs removes the "vehicle_models" edge to VehicleModel entities by IDs.
+func (bu *BrandUpdate) RemoveVehicleModelIDs(ids ...int64) *BrandUpdate {
+	bu.mutation.RemoveVehicleModelIDs(ids...)
+	return bu
+}
+
+// RemoveVehicleModels removes "vehicle_models" edges to VehicleModel entities.
+func (bu *BrandUpdate) RemoveVehicleModels(v ...*VehicleModel) *BrandUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return bu.RemoveVehicleModelIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BrandUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bu.hooks) == 0 {
+		affected, err = bu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BrandMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bu.mutation = mutation
+			affected, err = bu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bu.hooks) - 1; i >= 0; i-- {
+			if bu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BrandUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BrandUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BrandUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (bu *BrandUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   brand.Table,
+			Columns: brand.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: brand.FieldID,
+			},
+		},
+	}
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: brand.FieldName,
+		})
+	}
+	if bu.mutation.VehicleModelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brand.VehicleModelsTable,
+			Columns: []string{brand.VehicleModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedVehicleModelsIDs(); len(nodes) > 0 && !bu.mutation.VehicleModelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brand.VehicleModelsTable,
+			Columns: []string{brand.VehicleModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.VehicleModelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brand.VehicleModelsTable,
+			Columns: []string{brand.VehicleModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{brand.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BrandUpdateOne is the builder for updating a single Brand entity.
+type BrandUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BrandMutation
+}
+
+// SetName sets the "name" field.
+func (buo *BrandUpdateOne) SetName(s string) *BrandUpdateOne {
+	buo.mutation.SetName(s)
+	return buo
+}
+
+// AddVehicleModelIDs adds the "vehicle_models" edge to the VehicleModel entity by IDs.
+func (buo *BrandUpdateOne) AddVehicleModelIDs(ids ...int64) *BrandUpdateOne {
+	buo.mutation.AddVehicleModelIDs(ids...)
+	return buo
+}
+
+// AddVehicleModels adds the "vehicle_models" edges to the VehicleModel entity.
+func (buo *BrandUpdateOne) AddVehicleModels(v ...*VehicleModel) *BrandUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return buo.AddVehicleModelIDs(ids...)
+}
+
+// Mutation returns the BrandMutation object of the builder.
+func (buo *BrandUpdateOne) Mutation() *BrandMutation {
+	return buo.mutation
+}
+
+// ClearVehicleModels clears all "vehicle_models" edges to the VehicleModel entity.
+func (buo *BrandUpdateOne) ClearVehicleModels() *BrandUpdateOne {
+	buo.mutation.ClearVehicleModels()
+	return buo
+}
+
+// RemoveVehicleModelIDs removes the "vehicle_models" edge to VehicleModel entities by IDs.
+func (buo *BrandUpdateOne) RemoveVehicleModelIDs(ids ...int64) *BrandUpdateOne {
+	buo.mutation.RemoveVehicleModelIDs(ids...)
+	return buo
+}
+
+// RemoveVehicleModels removes "vehicle_models" edges to VehicleModel entities.
+func (buo *BrandUpdateOne) RemoveVehicleModels(v ...*VehicleModel) *BrandUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return buo.RemoveVehicleModelIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BrandUpdateOne) Select(field string, fields ...string) *BrandUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Brand entity.
+func (buo *BrandUpdateOne) Save(ctx context.Context) (*Brand, error) {
+	var (
+		err  error
+		node *Brand
+	)
+	if len(buo.hooks) == 0 {
+		node, err = buo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BrandMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			buo.mutation = mutation
+			node, err = buo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(buo.hooks) - 1; i >= 0; i-- {
+			if buo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = buo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, buo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Brand)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BrandMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BrandUpdateOne) SaveX(ctx context.Context) *Brand {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BrandUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BrandUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (buo *BrandUpdateOne) sqlSave(ctx context.Context) (_node *Brand, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   brand.Table,
+			Columns: brand.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: brand.FieldID,
+			},
+		},
+	}
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Brand.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, brand.FieldID)
+		for _, f := range fields {
+			if !brand.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != brand.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: brand.FieldName,
+		})
+	}
+	if buo.mutation.VehicleModelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brand.VehicleModelsTable,
+			Columns: []string{brand.VehicleModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedVehicleModelsIDs(); len(nodes) > 0 && !buo.mutation.VehicleModelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brand.VehicleModelsTable,
+			Columns: []string{brand.VehicleModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.VehicleModelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brand.VehicleModelsTable,
+			Columns: []string{brand.VehicleModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Brand{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{brand.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/vehiclemodel"
 	"fmt"
 	"strings"
 	"time"
@@ -16,12 +17,283 @@ type Car struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int64 `json:"user_id,omitempty"`
 	// Model holds the value of the "model" field.
 	Model string `json:"model,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID int64 `json:"model_id,omitempty"`
+	// Vin holds the value of the "vin" field.
+	Vin string `json:"vin,omitempty"`
+	// Plate holds the value of the "plate" field.
+	Plate string `json:"plate,omitempty"`
+	// OwnerName holds the value of the "owner_name" field.
+	OwnerName string `json:"owner_name,omitempty"`
 	// RegisteredAt holds the value of the "registered_at" field.
 	RegisteredAt time.Time `json:"registered_at,omitempty"`
+	// BatteryCapacity holds the value of the "battery_capacity" field.
+	BatteryCapacity float64 `json:"battery_capacity,omitempty"`
+	// BatterySoh holds the value of the "battery_soh" field.
+	BatterySoh float64 `json:"battery_soh,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CarQuery when eager-loading is set.
+	Edges CarEdges `json:"edges"`
+}
+
+// CarEdges holds the relations/edges for other nodes in the graph.
+type CarEdges struct {
+	// VehicleModel holds the value of the vehicle_model edge.
+	VehicleModel *VehicleModel `json:"vehicle_model,omitempty"`
+	// MaintenanceRecords holds the value of the maintenance_records edge.
+	MaintenanceRecords []*MaintenanceRecord `json:"maintenance_records,omitempty"`
+	// InsurancePolicies holds the value of the insurance_policies edge.
+	InsurancePolicies []*InsurancePolicy `json:"insurance_policies,omitempty"`
+	// Transfers holds the value of the transfers edge.
+	Transfers []*Transfer `json:"transfers,omitempty"`
+	// OdometerReadings holds the value of the odometer_readings edge.
+	OdometerReadings []*OdometerReading `json:"odometer_readings,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// Fleets holds the value of the fleets edge.
+	Fleets []*Fleet `json:"fleets,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Attributes holds the value of the attributes edge.
+	Attributes []*CarAttribute `json:"attributes,omitempty"`
+	// Reservations holds the value of the reservations edge.
+	Reservations []*Reservation `json:"reservations,omitempty"`
+	// Trips holds the value of the trips edge.
+	Trips []*Trip `json:"trips,omitempty"`
+	// Refuels holds the value of the refuels edge.
+	Refuels []*Refuel `json:"refuels,omitempty"`
+	// Recalls holds the value of the recalls edge.
+	Recalls []*CarRecall `json:"recalls,omitempty"`
+	// OwnerHistories holds the value of the owner_histories edge.
+	OwnerHistories []*OwnerHistory `json:"owner_histories,omitempty"`
+	// Violations holds the value of the violations edge.
+	Violations []*Violation `json:"violations,omitempty"`
+	// TradeRecords holds the value of the trade_records edge.
+	TradeRecords []*TradeRecord `json:"trade_records,omitempty"`
+	// Listings holds the value of the listings edge.
+	Listings []*Listing `json:"listings,omitempty"`
+	// Warranties holds the value of the warranties edge.
+	Warranties []*CarWarranty `json:"warranties,omitempty"`
+	// Incidents holds the value of the incidents edge.
+	Incidents []*Incident `json:"incidents,omitempty"`
+	// LeaseContracts holds the value of the lease_contracts edge.
+	LeaseContracts []*LeaseContract `json:"lease_contracts,omitempty"`
+	// ChargingSessions holds the value of the charging_sessions edge.
+	ChargingSessions []*ChargingSession `json:"charging_sessions,omitempty"`
+	// Geofences holds the value of the geofences edge.
+	Geofences []*Geofence `json:"geofences,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [22]bool
+}
+
+// VehicleModelOrErr returns the VehicleModel value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarEdges) VehicleModelOrErr() (*VehicleModel, error) {
+	if e.loadedTypes[0] {
+		if e.VehicleModel == nil {
+			// The edge vehicle_model was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: vehiclemodel.Label}
+		}
+		return e.VehicleModel, nil
+	}
+	return nil, &NotLoadedError{edge: "vehicle_model"}
+}
+
+// MaintenanceRecordsOrErr returns the MaintenanceRecords value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) MaintenanceRecordsOrErr() ([]*MaintenanceRecord, error) {
+	if e.loadedTypes[1] {
+		return e.MaintenanceRecords, nil
+	}
+	return nil, &NotLoadedError{edge: "maintenance_records"}
+}
+
+// InsurancePoliciesOrErr returns the InsurancePolicies value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) InsurancePoliciesOrErr() ([]*InsurancePolicy, error) {
+	if e.loadedTypes[2] {
+		return e.InsurancePolicies, nil
+	}
+	return nil, &NotLoadedError{edge: "insurance_policies"}
+}
+
+// TransfersOrErr returns the Transfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) TransfersOrErr() ([]*Transfer, error) {
+	if e.loadedTypes[3] {
+		return e.Transfers, nil
+	}
+	return nil, &NotLoadedError{edge: "transfers"}
+}
+
+// OdometerReadingsOrErr returns the OdometerReadings value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) OdometerReadingsOrErr() ([]*OdometerReading, error) {
+	if e.loadedTypes[4] {
+		return e.OdometerReadings, nil
+	}
+	return nil, &NotLoadedError{edge: "odometer_readings"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[5] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// FleetsOrErr returns the Fleets value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) FleetsOrErr() ([]*Fleet, error) {
+	if e.loadedTypes[6] {
+		return e.Fleets, nil
+	}
+	return nil, &NotLoadedError{edge: "fleets"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[7] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// AttributesOrErr returns the Attributes value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) AttributesOrErr() ([]*CarAttribute, error) {
+	if e.loadedTypes[8] {
+		return e.Attributes, nil
+	}
+	return nil, &NotLoadedError{edge: "attributes"}
+}
+
+// ReservationsOrErr returns the Reservations value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) ReservationsOrErr() ([]*Reservation, error) {
+	if e.loadedTypes[9] {
+		return e.Reservations, nil
+	}
+	return nil, &NotLoadedError{edge: "reservations"}
+}
+
+// TripsOrErr returns the Trips value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) TripsOrErr() ([]*Trip, error) {
+	if e.loadedTypes[10] {
+		return e.Trips, nil
+	}
+	return nil, &NotLoadedError{edge: "trips"}
+}
+
+// RefuelsOrErr returns the Refuels value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) RefuelsOrErr() ([]*Refuel, error) {
+	if e.loadedTypes[11] {
+		return e.Refuels, nil
+	}
+	return nil, &NotLoadedError{edge: "refuels"}
+}
+
+// RecallsOrErr returns the Recalls value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) RecallsOrErr() ([]*CarRecall, error) {
+	if e.loadedTypes[12] {
+		return e.Recalls, nil
+	}
+	return nil, &NotLoadedError{edge: "recalls"}
+}
+
+// OwnerHistoriesOrErr returns the OwnerHistories value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) OwnerHistoriesOrErr() ([]*OwnerHistory, error) {
+	if e.loadedTypes[13] {
+		return e.OwnerHistories, nil
+	}
+	return nil, &NotLoadedError{edge: "owner_histories"}
+}
+
+// ViolationsOrErr returns the Violations value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) ViolationsOrErr() ([]*Violation, error) {
+	if e.loadedTypes[14] {
+		return e.Violations, nil
+	}
+	return nil, &NotLoadedError{edge: "violations"}
+}
+
+// TradeRecordsOrErr returns the TradeRecords value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) TradeRecordsOrErr() ([]*TradeRecord, error) {
+	if e.loadedTypes[15] {
+		return e.TradeRecords, nil
+	}
+	return nil, &NotLoadedError{edge: "trade_records"}
+}
+
+// ListingsOrErr returns the Listings value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) ListingsOrErr() ([]*Listing, error) {
+	if e.loadedTypes[16] {
+		return e.Listings, nil
+	}
+	return nil, &NotLoadedError{edge: "listings"}
+}
+
+// WarrantiesOrErr returns the Warranties value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) WarrantiesOrErr() ([]*CarWarranty, error) {
+	if e.loadedTypes[17] {
+		return e.Warranties, nil
+	}
+	return nil, &NotLoadedError{edge: "warranties"}
+}
+
+// IncidentsOrErr returns the Incidents value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) IncidentsOrErr() ([]*Incident, error) {
+	if e.loadedTypes[18] {
+		return e.Incidents, nil
+	}
+	return nil, &NotLoadedError{edge: "incidents"}
+}
+
+// LeaseContractsOrErr returns the LeaseContracts value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) LeaseContractsOrErr() ([]*LeaseContract, error) {
+	if e.loadedTypes[19] {
+		return e.LeaseContracts, nil
+	}
+	return nil, &NotLoadedError{edge: "lease_contracts"}
+}
+
+// ChargingSessionsOrErr returns the ChargingSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) ChargingSessionsOrErr() ([]*ChargingSession, error) {
+	if e.loadedTypes[20] {
+		return e.ChargingSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "charging_sessions"}
+}
+
+// GeofencesOrErr returns the Geofences value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) GeofencesOrErr() ([]*Geofence, error) {
+	if e.loadedTypes[21] {
+		return e.Geofences, nil
+	}
+	return nil, &NotLoadedError{edge: "geofences"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -29,9 +301,11 @@ func (*Car) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case car.FieldID, car.FieldUserID:
+		case car.FieldBatteryCapacity, car.FieldBatterySoh:
+			values[i] = new(sql.NullFloat64)
+		case car.FieldID, car.FieldTenantID, car.FieldUserID, car.FieldModelID:
 			values[i] = new(sql.NullInt64)
-		case car.FieldModel:
+		case car.FieldModel, car.FieldVin, car.FieldPlate, car.FieldOwnerName:
 			values[i] = new(sql.NullString)
 		case car.FieldRegisteredAt:
 			values[i] = new(sql.NullTime)
@@ -56,6 +330,12 @@ func (c *Car) assignValues(columns []string, values []interface{}) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			c.ID = int64(value.Int64)
+		case car.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				c.TenantID = value.Int64
+			}
 		case car.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -68,17 +348,163 @@ func (c *Car) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				c.Model = value.String
 			}
+		case car.FieldModelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				c.ModelID = value.Int64
+			}
+		case car.FieldVin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vin", values[i])
+			} else if value.Valid {
+				c.Vin = value.String
+			}
+		case car.FieldPlate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plate", values[i])
+			} else if value.Valid {
+				c.Plate = value.String
+			}
+		case car.FieldOwnerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_name", values[i])
+			} else if value.Valid {
+				c.OwnerName = value.String
+			}
 		case car.FieldRegisteredAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field registered_at", values[i])
 			} else if value.Valid {
 				c.RegisteredAt = value.Time
 			}
+		case car.FieldBatteryCapacity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field battery_capacity", values[i])
+			} else if value.Valid {
+				c.BatteryCapacity = value.Float64
+			}
+		case car.FieldBatterySoh:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field battery_soh", values[i])
+			} else if value.Valid {
+				c.BatterySoh = value.Float64
+			}
 		}
 	}
 	return nil
 }
 
+// QueryVehicleModel queries the "vehicle_model" edge of the Car entity.
+func (c *Car) QueryVehicleModel() *VehicleModelQuery {
+	return (&CarClient{config: c.config}).QueryVehicleModel(c)
+}
+
+// QueryMaintenanceRecords queries the "maintenance_records" edge of the Car entity.
+func (c *Car) QueryMaintenanceRecords() *MaintenanceRecordQuery {
+	return (&CarClient{config: c.config}).QueryMaintenanceRecords(c)
+}
+
+// QueryInsurancePolicies queries the "insurance_policies" edge of the Car entity.
+func (c *Car) QueryInsurancePolicies() *InsurancePolicyQuery {
+	return (&CarClient{config: c.config}).QueryInsurancePolicies(c)
+}
+
+// QueryTransfers queries the "transfers" edge of the Car entity.
+func (c *Car) QueryTransfers() *TransferQuery {
+	return (&CarClient{config: c.config}).QueryTransfers(c)
+}
+
+// QueryOdometerReadings queries the "odometer_readings" edge of the Car entity.
+func (c *Car) QueryOdometerReadings() *OdometerReadingQuery {
+	return (&CarClient{config: c.config}).QueryOdometerReadings(c)
+}
+
+// QueryAttachments queries the "attachments" edge of the Car entity.
+func (c *Car) QueryAttachments() *AttachmentQuery {
+	return (&CarClient{config: c.config}).QueryAttachments(c)
+}
+
+// QueryFleets queries the "fleets" edge of the Car entity.
+func (c *Car) QueryFleets() *FleetQuery {
+	return (&CarClient{config: c.config}).QueryFleets(c)
+}
+
+// QueryTags queries the "tags" edge of the Car entity.
+func (c *Car) QueryTags() *TagQuery {
+	return (&CarClient{config: c.config}).QueryTags(c)
+}
+
+// QueryAttributes queries the "attributes" edge of the Car entity.
+func (c *Car) QueryAttributes() *CarAttributeQuery {
+	return (&CarClient{config: c.config}).QueryAttributes(c)
+}
+
+// QueryReservations queries the "reservations" edge of the Car entity.
+func (c *Car) QueryReservations() *ReservationQuery {
+	return (&CarClient{config: c.config}).QueryReservations(c)
+}
+
+// QueryTrips queries the "trips" edge of the Car entity.
+func (c *Car) QueryTrips() *TripQuery {
+	return (&CarClient{config: c.config}).QueryTrips(c)
+}
+
+// QueryRefuels queries the "refuels" edge of the Car entity.
+func (c *Car) QueryRefuels() *RefuelQuery {
+	return (&CarClient{config: c.config}).QueryRefuels(c)
+}
+
+// QueryRecalls queries the "recalls" edge of the Car entity.
+func (c *Car) QueryRecalls() *CarRecallQuery {
+	return (&CarClient{config: c.config}).QueryRecalls(c)
+}
+
+// QueryOwnerHistories queries the "owner_histories" edge of the Car entity.
+func (c *Car) QueryOwnerHistories() *OwnerHistoryQuery {
+	return (&CarClient{config: c.config}).QueryOwnerHistories(c)
+}
+
+// QueryViolations queries the "violations" edge of the Car entity.
+func (c *Car) QueryViolations() *ViolationQuery {
+	return (&CarClient{config: c.config}).QueryViolations(c)
+}
+
+// QueryTradeRecords queries the "trade_records" edge of the Car entity.
+func (c *Car) QueryTradeRecords() *TradeRecordQuery {
+	return (&CarClient{config: c.config}).QueryTradeRecords(c)
+}
+
+// QueryListings queries the "listings" edge of the Car entity.
+func (c *Car) QueryListings() *ListingQuery {
+	return (&CarClient{config: c.config}).QueryListings(c)
+}
+
+// QueryWarranties queries the "warranties" edge of the Car entity.
+func (c *Car) QueryWarranties() *CarWarrantyQuery {
+	return (&CarClient{config: c.config}).QueryWarranties(c)
+}
+
+// QueryIncidents queries the "incidents" edge of the Car entity.
+func (c *Car) QueryIncidents() *IncidentQuery {
+	return (&CarClient{config: c.config}).QueryIncidents(c)
+}
+
+// QueryLeaseContracts queries the "lease_contracts" edge of the Car entity.
+func (c *Car) QueryLeaseContracts() *LeaseContractQuery {
+	return (&CarClient{config: c.config}).QueryLeaseContracts(c)
+}
+
+// QueryChargingSessions queries the "charging_sessions" edge of the Car entity.
+func (c *Car) QueryChargingSessions() *ChargingSessionQuery {
+	return (&CarClient{config: c.config}).QueryChargingSessions(c)
+}
+
+// QueryGeofences queries the "geofences" edge of the Car entity.
+func (c *Car) QueryGeofences() *GeofenceQuery {
+	return (&CarClient{config: c.config}).QueryGeofences(c)
+}
+
 // Update returns a builder for updating this Car.
 // Note that you need to call Car.Unwrap() before calling this method if this Car
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -102,14 +528,35 @@ func (c *Car) String() string {
 	var builder strings.Builder
 	builder.WriteString("Car(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", c.UserID))
 	builder.WriteString(", ")
 	builder.WriteString("model=")
 	builder.WriteString(c.Model)
 	builder.WriteString(", ")
+	builder.WriteString("model_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.ModelID))
+	builder.WriteString(", ")
+	builder.WriteString("vin=")
+	builder.WriteString(c.Vin)
+	builder.WriteString(", ")
+	builder.WriteString("plate=")
+	builder.WriteString(c.Plate)
+	builder.WriteString(", ")
+	builder.WriteString("owner_name=")
+	builder.WriteString(c.OwnerName)
+	builder.WriteString(", ")
 	builder.WriteString("registered_at=")
 	builder.WriteString(c.RegisteredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("battery_capacity=")
+	builder.WriteString(fmt.Sprintf("%v", c.BatteryCapacity))
+	builder.WriteString(", ")
+	builder.WriteString("battery_soh=")
+	builder.WriteString(fmt.Sprintf("%v", c.BatterySoh))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,8 @@ package car
 
 import (
 	"time"
+
+	"entgo.io/ent"
 )
 
 const (
@@ -11,24 +13,249 @@ const (
 	Label = "car"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// FieldModel holds the string denoting the model field in the database.
 	FieldModel = "model"
+	// FieldModelID holds the string denoting the model_id field in the database.
+	FieldModelID = "model_id"
+	// FieldVin holds the string denoting the vin field in the database.
+	FieldVin = "vin"
+	// FieldPlate holds the string denoting the plate field in the database.
+	FieldPlate = "plate"
+	// FieldOwnerName holds the string denoting the owner_name field in the database.
+	FieldOwnerName = "owner_name"
 	// FieldRegisteredAt holds the string denoting the registered_at field in the database.
 	FieldRegisteredAt = "registered_at"
+	// FieldBatteryCapacity holds the string denoting the battery_capacity field in the database.
+	FieldBatteryCapacity = "battery_capacity"
+	// FieldBatterySoh holds the string denoting the battery_soh field in the database.
+	FieldBatterySoh = "battery_soh"
+	// EdgeVehicleModel holds the string denoting the vehicle_model edge name in mutations.
+	EdgeVehicleModel = "vehicle_model"
+	// EdgeMaintenanceRecords holds the string denoting the maintenance_records edge name in mutations.
+	EdgeMaintenanceRecords = "maintenance_records"
+	// EdgeInsurancePolicies holds the string denoting the insurance_policies edge name in mutations.
+	EdgeInsurancePolicies = "insurance_policies"
+	// EdgeTransfers holds the string denoting the transfers edge name in mutations.
+	EdgeTransfers = "transfers"
+	// EdgeOdometerReadings holds the string denoting the odometer_readings edge name in mutations.
+	EdgeOdometerReadings = "odometer_readings"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeFleets holds the string denoting the fleets edge name in mutations.
+	EdgeFleets = "fleets"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeAttributes holds the string denoting the attributes edge name in mutations.
+	EdgeAttributes = "attributes"
+	// EdgeReservations holds the string denoting the reservations edge name in mutations.
+	EdgeReservations = "reservations"
+	// EdgeTrips holds the string denoting the trips edge name in mutations.
+	EdgeTrips = "trips"
+	// EdgeRefuels holds the string denoting the refuels edge name in mutations.
+	EdgeRefuels = "refuels"
+	// EdgeRecalls holds the string denoting the recalls edge name in mutations.
+	EdgeRecalls = "recalls"
+	// EdgeOwnerHistories holds the string denoting the owner_histories edge name in mutations.
+	EdgeOwnerHistories = "owner_histories"
+	// EdgeViolations holds the string denoting the violations edge name in mutations.
+	EdgeViolations = "violations"
+	// EdgeTradeRecords holds the string denoting the trade_records edge name in mutations.
+	EdgeTradeRecords = "trade_records"
+	// EdgeListings holds the string denoting the listings edge name in mutations.
+	EdgeListings = "listings"
+	// EdgeWarranties holds the string denoting the warranties edge name in mutations.
+	EdgeWarranties = "warranties"
+	// EdgeIncidents holds the string denoting the incidents edge name in mutations.
+	EdgeIncidents = "incidents"
+	// EdgeLeaseContracts holds the string denoting the lease_contracts edge name in mutations.
+	EdgeLeaseContracts = "lease_contracts"
+	// EdgeChargingSessions holds the string denoting the charging_sessions edge name in mutations.
+	EdgeChargingSessions = "charging_sessions"
+	// EdgeGeofences holds the string denoting the geofences edge name in mutations.
+	EdgeGeofences = "geofences"
 	// Table holds the table name of the car in the database.
 	Table = "car"
+	// VehicleModelTable is the table that holds the vehicle_model relation/edge.
+	VehicleModelTable = "car"
+	// VehicleModelInverseTable is the table name for the VehicleModel entity.
+	// It exists in this package in order to avoid circular dependency with the "vehiclemodel" package.
+	VehicleModelInverseTable = "vehicle_model"
+	// VehicleModelColumn is the table column denoting the vehicle_model relation/edge.
+	VehicleModelColumn = "model_id"
+	// MaintenanceRecordsTable is the table that holds the maintenance_records relation/edge.
+	MaintenanceRecordsTable = "maintenance_record"
+	// MaintenanceRecordsInverseTable is the table name for the MaintenanceRecord entity.
+	// It exists in this package in order to avoid circular dependency with the "maintenancerecord" package.
+	MaintenanceRecordsInverseTable = "maintenance_record"
+	// MaintenanceRecordsColumn is the table column denoting the maintenance_records relation/edge.
+	MaintenanceRecordsColumn = "car_id"
+	// InsurancePoliciesTable is the table that holds the insurance_policies relation/edge.
+	InsurancePoliciesTable = "insurance_policy"
+	// InsurancePoliciesInverseTable is the table name for the InsurancePolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "insurancepolicy" package.
+	InsurancePoliciesInverseTable = "insurance_policy"
+	// InsurancePoliciesColumn is the table column denoting the insurance_policies relation/edge.
+	InsurancePoliciesColumn = "car_id"
+	// TransfersTable is the table that holds the transfers relation/edge.
+	TransfersTable = "transfer"
+	// TransfersInverseTable is the table name for the Transfer entity.
+	// It exists in this package in order to avoid circular dependency with the "transfer" package.
+	TransfersInverseTable = "transfer"
+	// TransfersColumn is the table column denoting the transfers relation/edge.
+	TransfersColumn = "car_id"
+	// OdometerReadingsTable is the table that holds the odometer_readings relation/edge.
+	OdometerReadingsTable = "odometer_reading"
+	// OdometerReadingsInverseTable is the table name for the OdometerReading entity.
+	// It exists in this package in order to avoid circular dependency with the "odometerreading" package.
+	OdometerReadingsInverseTable = "odometer_reading"
+	// OdometerReadingsColumn is the table column denoting the odometer_readings relation/edge.
+	OdometerReadingsColumn = "car_id"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachment"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachment"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "car_id"
+	// FleetsTable is the table that holds the fleets relation/edge. The primary key declared below.
+	FleetsTable = "fleet_cars"
+	// FleetsInverseTable is the table name for the Fleet entity.
+	// It exists in this package in order to avoid circular dependency with the "fleet" package.
+	FleetsInverseTable = "fleet"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_cars"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tag"
+	// AttributesTable is the table that holds the attributes relation/edge.
+	AttributesTable = "car_attribute"
+	// AttributesInverseTable is the table name for the CarAttribute entity.
+	// It exists in this package in order to avoid circular dependency with the "carattribute" package.
+	AttributesInverseTable = "car_attribute"
+	// AttributesColumn is the table column denoting the attributes relation/edge.
+	AttributesColumn = "car_id"
+	// ReservationsTable is the table that holds the reservations relation/edge.
+	ReservationsTable = "reservation"
+	// ReservationsInverseTable is the table name for the Reservation entity.
+	// It exists in this package in order to avoid circular dependency with the "reservation" package.
+	ReservationsInverseTable = "reservation"
+	// ReservationsColumn is the table column denoting the reservations relation/edge.
+	ReservationsColumn = "car_id"
+	// TripsTable is the table that holds the trips relation/edge.
+	TripsTable = "trip"
+	// TripsInverseTable is the table name for the Trip entity.
+	// It exists in this package in order to avoid circular dependency with the "trip" package.
+	TripsInverseTable = "trip"
+	// TripsColumn is the table column denoting the trips relation/edge.
+	TripsColumn = "car_id"
+	// RefuelsTable is the table that holds the refuels relation/edge.
+	RefuelsTable = "refuel"
+	// RefuelsInverseTable is the table name for the Refuel entity.
+	// It exists in this package in order to avoid circular dependency with the "refuel" package.
+	RefuelsInverseTable = "refuel"
+	// RefuelsColumn is the table column denoting the refuels relation/edge.
+	RefuelsColumn = "car_id"
+	// RecallsTable is the table that holds the recalls relation/edge.
+	RecallsTable = "car_recall"
+	// RecallsInverseTable is the table name for the CarRecall entity.
+	// It exists in this package in order to avoid circular dependency with the "carrecall" package.
+	RecallsInverseTable = "car_recall"
+	// RecallsColumn is the table column denoting the recalls relation/edge.
+	RecallsColumn = "car_id"
+	// OwnerHistoriesTable is the table that holds the owner_histories relation/edge.
+	OwnerHistoriesTable = "owner_history"
+	// OwnerHistoriesInverseTable is the table name for the OwnerHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "ownerhistory" package.
+	OwnerHistoriesInverseTable = "owner_history"
+	// OwnerHistoriesColumn is the table column denoting the owner_histories relation/edge.
+	OwnerHistoriesColumn = "car_id"
+	// ViolationsTable is the table that holds the violations relation/edge.
+	ViolationsTable = "violation"
+	// ViolationsInverseTable is the table name for the Violation entity.
+	// It exists in this package in order to avoid circular dependency with the "violation" package.
+	ViolationsInverseTable = "violation"
+	// ViolationsColumn is the table column denoting the violations relation/edge.
+	ViolationsColumn = "car_id"
+	// TradeRecordsTable is the table that holds the trade_records relation/edge.
+	TradeRecordsTable = "trade_record"
+	// TradeRecordsInverseTable is the table name for the TradeRecord entity.
+	// It exists in this package in order to avoid circular dependency with the "traderecord" package.
+	TradeRecordsInverseTable = "trade_record"
+	// TradeRecordsColumn is the table column denoting the trade_records relation/edge.
+	TradeRecordsColumn = "car_id"
+	// ListingsTable is the table that holds the listings relation/edge.
+	ListingsTable = "listing"
+	// ListingsInverseTable is the table name for the Listing entity.
+	// It exists in this package in order to avoid circular dependency with the "listing" package.
+	ListingsInverseTable = "listing"
+	// ListingsColumn is the table column denoting the listings relation/edge.
+	ListingsColumn = "car_id"
+	// WarrantiesTable is the table that holds the warranties relation/edge.
+	WarrantiesTable = "car_warranty"
+	// WarrantiesInverseTable is the table name for the CarWarranty entity.
+	// It exists in this package in order to avoid circular dependency with the "carwarranty" package.
+	WarrantiesInverseTable = "car_warranty"
+	// WarrantiesColumn is the table column denoting the warranties relation/edge.
+	WarrantiesColumn = "car_id"
+	// IncidentsTable is the table that holds the incidents relation/edge.
+	IncidentsTable = "incident"
+	// IncidentsInverseTable is the table name for the Incident entity.
+	// It exists in this package in order to avoid circular dependency with the "incident" package.
+	IncidentsInverseTable = "incident"
+	// IncidentsColumn is the table column denoting the incidents relation/edge.
+	IncidentsColumn = "car_id"
+	// LeaseContractsTable is the table that holds the lease_contracts relation/edge.
+	LeaseContractsTable = "lease_contract"
+	// LeaseContractsInverseTable is the table name for the LeaseContract entity.
+	// It exists in this package in order to avoid circular dependency with the "leasecontract" package.
+	LeaseContractsInverseTable = "lease_contract"
+	// LeaseContractsColumn is the table column denoting the lease_contracts relation/edge.
+	LeaseContractsColumn = "car_id"
+	// ChargingSessionsTable is the table that holds the charging_sessions relation/edge.
+	ChargingSessionsTable = "charging_session"
+	// ChargingSessionsInverseTable is the table name for the ChargingSession entity.
+	// It exists in this package in order to avoid circular dependency with the "chargingsession" package.
+	ChargingSessionsInverseTable = "charging_session"
+	// ChargingSessionsColumn is the table column denoting the charging_sessions relation/edge.
+	ChargingSessionsColumn = "car_id"
+	// GeofencesTable is the table that holds the geofences relation/edge. The primary key declared below.
+	GeofencesTable = "geofence_cars"
+	// GeofencesInverseTable is the table name for the Geofence entity.
+	// It exists in this package in order to avoid circular dependency with the "geofence" package.
+	GeofencesInverseTable = "geofence"
 )
 
 // Columns holds all SQL columns for car fields.
 var Columns = []string{
 	FieldID,
+	FieldTenantID,
 	FieldUserID,
 	FieldModel,
+	FieldModelID,
+	FieldVin,
+	FieldPlate,
+	FieldOwnerName,
 	FieldRegisteredAt,
+	FieldBatteryCapacity,
+	FieldBatterySoh,
 }
 
+var (
+	// FleetsPrimaryKey and FleetsColumn2 are the table columns denoting the
+	// primary key for the fleets relation (M2M).
+	FleetsPrimaryKey = []string{"fleet_id", "car_id"}
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "car_id"}
+	// GeofencesPrimaryKey and GeofencesColumn2 are the table columns denoting the
+	// primary key for the geofences relation (M2M).
+	GeofencesPrimaryKey = []string{"geofence_id", "car_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -39,7 +266,15 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
 var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
 	// DefaultRegisteredAt holds the default value on creation for the "registered_at" field.
 	DefaultRegisteredAt func() time.Time
 )
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -80,6 +81,13 @@ func IDLTE(id int64) predicate.Car {
 	})
 }
 
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v int64) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -94,6 +102,34 @@ func Model(v string) predicate.Car {
 	})
 }
 
+// ModelID applies equality check predicate on the "model_id" field. It's identical to ModelIDEQ.
+func ModelID(v int64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModelID), v))
+	})
+}
+
+// Vin applies equality check predicate on the "vin" field. It's identical to VinEQ.
+func Vin(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVin), v))
+	})
+}
+
+// Plate applies equality check predicate on the "plate" field. It's identical to PlateEQ.
+func Plate(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPlate), v))
+	})
+}
+
+// OwnerName applies equality check predicate on the "owner_name" field. It's identical to OwnerNameEQ.
+func OwnerName(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerName), v))
+	})
+}
+
 // RegisteredAt applies equality check predicate on the "registered_at" field. It's identical to RegisteredAtEQ.
 func RegisteredAt(v time.Time) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -101,6 +137,110 @@ func RegisteredAt(v time.Time) predicate.Car {
 	})
 }
 
+// BatteryCapacity applies equality check predicate on the "battery_capacity" field. It's identical to BatteryCapacityEQ.
+func BatteryCapacity(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBatteryCapacity), v))
+	})
+}
+
+// BatterySoh applies equality check predicate on the "battery_soh" field. It's identical to BatterySohEQ.
+func BatterySoh(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBatterySoh), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v int64) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -316,22 +456,22 @@ func ModelContainsFold(v string) predicate.Car {
 	})
 }
 
-// RegisteredAtEQ applies the EQ predicate on the "registered_at" field.
-func RegisteredAtEQ(v time.Time) predicate.Car {
+// ModelIDEQ applies the EQ predicate on the "model_id" field.
+func ModelIDEQ(v int64) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldRegisteredAt), v))
+		s.Where(sql.EQ(s.C(FieldModelID), v))
 	})
 }
 
-// RegisteredAtNEQ applies the NEQ predicate on the "registered_at" field.
-func RegisteredAtNEQ(v time.Time) predicate.Car {
+// ModelIDNEQ applies the NEQ predicate on the "model_id" field.
+func ModelIDNEQ(v int64) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldRegisteredAt), v))
+		s.Where(sql.NEQ(s.C(FieldModelID), v))
 	})
 }
 
-// RegisteredAtIn applies the In predicate on the "registered_at" field.
-func RegisteredAtIn(vs ...time.Time) predicate.Car {
+// ModelIDIn applies the In predicate on the "model_id" field.
+func ModelIDIn(vs ...int64) predicate.Car {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -343,12 +483,12 @@ func RegisteredAtIn(vs ...time.Time) predicate.Car {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.In(s.C(FieldRegisteredAt), v...))
+		s.Where(sql.In(s.C(FieldModelID), v...))
 	})
 }
 
-// RegisteredAtNotIn applies the NotIn predicate on the "registered_at" field.
-func RegisteredAtNotIn(vs ...time.Time) predicate.Car {
+// ModelIDNotIn applies the NotIn predicate on the "model_id" field.
+func ModelIDNotIn(vs ...int64) predicate.Car {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -360,35 +500,1268 @@ func RegisteredAtNotIn(vs ...time.Time) predicate.Car {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.NotIn(s.C(FieldRegisteredAt), v...))
+		s.Where(sql.NotIn(s.C(FieldModelID), v...))
 	})
 }
 
-// RegisteredAtGT applies the GT predicate on the "registered_at" field.
-func RegisteredAtGT(v time.Time) predicate.Car {
+// ModelIDIsNil applies the IsNil predicate on the "model_id" field.
+func ModelIDIsNil() predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldRegisteredAt), v))
+		s.Where(sql.IsNull(s.C(FieldModelID)))
 	})
 }
 
-// RegisteredAtGTE applies the GTE predicate on the "registered_at" field.
-func RegisteredAtGTE(v time.Time) predicate.Car {
+// ModelIDNotNil applies the NotNil predicate on the "model_id" field.
+func ModelIDNotNil() predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldRegisteredAt), v))
+		s.Where(sql.NotNull(s.C(FieldModelID)))
 	})
 }
 
-// RegisteredAtLT applies the LT predicate on the "registered_at" field.
-func RegisteredAtLT(v time.Time) predicate.Car {
+// VinEQ applies the EQ predicate on the "vin" field.
+func VinEQ(v string) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldRegisteredAt), v))
+		s.Where(sql.EQ(s.C(FieldVin), v))
 	})
 }
 
-// RegisteredAtLTE applies the LTE predicate on the "registered_at" field.
-func RegisteredAtLTE(v time.Time) predicate.Car {
+// VinNEQ applies the NEQ predicate on the "vin" field.
+func VinNEQ(v string) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldRegisteredAt), v))
+		s.Where(sql.NEQ(s.C(FieldVin), v))
+	})
+}
+
+// VinIn applies the In predicate on the "vin" field.
+func VinIn(vs ...string) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVin), v...))
+	})
+}
+
+// VinNotIn applies the NotIn predicate on the "vin" field.
+func VinNotIn(vs ...string) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVin), v...))
+	})
+}
+
+// VinGT applies the GT predicate on the "vin" field.
+func VinGT(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVin), v))
+	})
+}
+
+// VinGTE applies the GTE predicate on the "vin" field.
+func VinGTE(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVin), v))
+	})
+}
+
+// VinLT applies the LT predicate on the "vin" field.
+func VinLT(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVin), v))
+	})
+}
+
+// VinLTE applies the LTE predicate on the "vin" field.
+func VinLTE(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVin), v))
+	})
+}
+
+// VinContains applies the Contains predicate on the "vin" field.
+func VinContains(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldVin), v))
+	})
+}
+
+// VinHasPrefix applies the HasPrefix predicate on the "vin" field.
+func VinHasPrefix(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldVin), v))
+	})
+}
+
+// VinHasSuffix applies the HasSuffix predicate on the "vin" field.
+func VinHasSuffix(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldVin), v))
+	})
+}
+
+// VinIsNil applies the IsNil predicate on the "vin" field.
+func VinIsNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldVin)))
+	})
+}
+
+// VinNotNil applies the NotNil predicate on the "vin" field.
+func VinNotNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldVin)))
+	})
+}
+
+// VinEqualFold applies the EqualFold predicate on the "vin" field.
+func VinEqualFold(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldVin), v))
+	})
+}
+
+// VinContainsFold applies the ContainsFold predicate on the "vin" field.
+func VinContainsFold(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldVin), v))
+	})
+}
+
+// PlateEQ applies the EQ predicate on the "plate" field.
+func PlateEQ(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPlate), v))
+	})
+}
+
+// PlateNEQ applies the NEQ predicate on the "plate" field.
+func PlateNEQ(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPlate), v))
+	})
+}
+
+// PlateIn applies the In predicate on the "plate" field.
+func PlateIn(vs ...string) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPlate), v...))
+	})
+}
+
+// PlateNotIn applies the NotIn predicate on the "plate" field.
+func PlateNotIn(vs ...string) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPlate), v...))
+	})
+}
+
+// PlateGT applies the GT predicate on the "plate" field.
+func PlateGT(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPlate), v))
+	})
+}
+
+// PlateGTE applies the GTE predicate on the "plate" field.
+func PlateGTE(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPlate), v))
+	})
+}
+
+// PlateLT applies the LT predicate on the "plate" field.
+func PlateLT(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPlate), v))
+	})
+}
+
+// PlateLTE applies the LTE predicate on the "plate" field.
+func PlateLTE(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPlate), v))
+	})
+}
+
+// PlateContains applies the Contains predicate on the "plate" field.
+func PlateContains(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPlate), v))
+	})
+}
+
+// PlateHasPrefix applies the HasPrefix predicate on the "plate" field.
+func PlateHasPrefix(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPlate), v))
+	})
+}
+
+// PlateHasSuffix applies the HasSuffix predicate on the "plate" field.
+func PlateHasSuffix(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPlate), v))
+	})
+}
+
+// PlateIsNil applies the IsNil predicate on the "plate" field.
+func PlateIsNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPlate)))
+	})
+}
+
+// PlateNotNil applies the NotNil predicate on the "plate" field.
+func PlateNotNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPlate)))
+	})
+}
+
+// PlateEqualFold applies the EqualFold predicate on the "plate" field.
+func PlateEqualFold(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPlate), v))
+	})
+}
+
+// PlateContainsFold applies the ContainsFold predicate on the "plate" field.
+func PlateContainsFold(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPlate), v))
+	})
+}
+
+// OwnerNameEQ applies the EQ predicate on the "owner_name" field.
+func OwnerNameEQ(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameNEQ applies the NEQ predicate on the "owner_name" field.
+func OwnerNameNEQ(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameIn applies the In predicate on the "owner_name" field.
+func OwnerNameIn(vs ...string) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOwnerName), v...))
+	})
+}
+
+// OwnerNameNotIn applies the NotIn predicate on the "owner_name" field.
+func OwnerNameNotIn(vs ...string) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOwnerName), v...))
+	})
+}
+
+// OwnerNameGT applies the GT predicate on the "owner_name" field.
+func OwnerNameGT(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameGTE applies the GTE predicate on the "owner_name" field.
+func OwnerNameGTE(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameLT applies the LT predicate on the "owner_name" field.
+func OwnerNameLT(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameLTE applies the LTE predicate on the "owner_name" field.
+func OwnerNameLTE(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameContains applies the Contains predicate on the "owner_name" field.
+func OwnerNameContains(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameHasPrefix applies the HasPrefix predicate on the "owner_name" field.
+func OwnerNameHasPrefix(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameHasSuffix applies the HasSuffix predicate on the "owner_name" field.
+func OwnerNameHasSuffix(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameIsNil applies the IsNil predicate on the "owner_name" field.
+func OwnerNameIsNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOwnerName)))
+	})
+}
+
+// OwnerNameNotNil applies the NotNil predicate on the "owner_name" field.
+func OwnerNameNotNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOwnerName)))
+	})
+}
+
+// OwnerNameEqualFold applies the EqualFold predicate on the "owner_name" field.
+func OwnerNameEqualFold(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOwnerName), v))
+	})
+}
+
+// OwnerNameContainsFold applies the ContainsFold predicate on the "owner_name" field.
+func OwnerNameContainsFold(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOwnerName), v))
+	})
+}
+
+// RegisteredAtEQ applies the EQ predicate on the "registered_at" field.
+func RegisteredAtEQ(v time.Time) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRegisteredAt), v))
+	})
+}
+
+// RegisteredAtNEQ applies the NEQ predicate on the "registered_at" field.
+func RegisteredAtNEQ(v time.Time) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRegisteredAt), v))
+	})
+}
+
+// RegisteredAtIn applies the In predicate on the "registered_at" field.
+func RegisteredAtIn(vs ...time.Time) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRegisteredAt), v...))
+	})
+}
+
+// RegisteredAtNotIn applies the NotIn predicate on the "registered_at" field.
+func RegisteredAtNotIn(vs ...time.Time) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRegisteredAt), v...))
+	})
+}
+
+// RegisteredAtGT applies the GT predicate on the "registered_at" field.
+func RegisteredAtGT(v time.Time) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRegisteredAt), v))
+	})
+}
+
+// RegisteredAtGTE applies the GTE predicate on the "registered_at" field.
+func RegisteredAtGTE(v time.Time) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRegisteredAt), v))
+	})
+}
+
+// RegisteredAtLT applies the LT predicate on the "registered_at" field.
+func RegisteredAtLT(v time.Time) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRegisteredAt), v))
+	})
+}
+
+// RegisteredAtLTE applies the LTE predicate on the "registered_at" field.
+func RegisteredAtLTE(v time.Time) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRegisteredAt), v))
+	})
+}
+
+// BatteryCapacityEQ applies the EQ predicate on the "battery_capacity" field.
+func BatteryCapacityEQ(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBatteryCapacity), v))
+	})
+}
+
+// BatteryCapacityNEQ applies the NEQ predicate on the "battery_capacity" field.
+func BatteryCapacityNEQ(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBatteryCapacity), v))
+	})
+}
+
+// BatteryCapacityIn applies the In predicate on the "battery_capacity" field.
+func BatteryCapacityIn(vs ...float64) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBatteryCapacity), v...))
+	})
+}
+
+// BatteryCapacityNotIn applies the NotIn predicate on the "battery_capacity" field.
+func BatteryCapacityNotIn(vs ...float64) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBatteryCapacity), v...))
+	})
+}
+
+// BatteryCapacityGT applies the GT predicate on the "battery_capacity" field.
+func BatteryCapacityGT(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBatteryCapacity), v))
+	})
+}
+
+// BatteryCapacityGTE applies the GTE predicate on the "battery_capacity" field.
+func BatteryCapacityGTE(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBatteryCapacity), v))
+	})
+}
+
+// BatteryCapacityLT applies the LT predicate on the "battery_capacity" field.
+func BatteryCapacityLT(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBatteryCapacity), v))
+	})
+}
+
+// BatteryCapacityLTE applies the LTE predicate on the "battery_capacity" field.
+func BatteryCapacityLTE(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBatteryCapacity), v))
+	})
+}
+
+// BatteryCapacityIsNil applies the IsNil predicate on the "battery_capacity" field.
+func BatteryCapacityIsNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBatteryCapacity)))
+	})
+}
+
+// BatteryCapacityNotNil applies the NotNil predicate on the "battery_capacity" field.
+func BatteryCapacityNotNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBatteryCapacity)))
+	})
+}
+
+// BatterySohEQ applies the EQ predicate on the "battery_soh" field.
+func BatterySohEQ(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBatterySoh), v))
+	})
+}
+
+// BatterySohNEQ applies the NEQ predicate on the "battery_soh" field.
+func BatterySohNEQ(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBatterySoh), v))
+	})
+}
+
+// BatterySohIn applies the In predicate on the "battery_soh" field.
+func BatterySohIn(vs ...float64) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBatterySoh), v...))
+	})
+}
+
+// BatterySohNotIn applies the NotIn predicate on the "battery_soh" field.
+func BatterySohNotIn(vs ...float64) predicate.Car {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBatterySoh), v...))
+	})
+}
+
+// BatterySohGT applies the GT predicate on the "battery_soh" field.
+func BatterySohGT(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBatterySoh), v))
+	})
+}
+
+// BatterySohGTE applies the GTE predicate on the "battery_soh" field.
+func BatterySohGTE(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBatterySoh), v))
+	})
+}
+
+// BatterySohLT applies the LT predicate on the "battery_soh" field.
+func BatterySohLT(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBatterySoh), v))
+	})
+}
+
+// BatterySohLTE applies the LTE predicate on the "battery_soh" field.
+func BatterySohLTE(v float64) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBatterySoh), v))
+	})
+}
+
+// BatterySohIsNil applies the IsNil predicate on the "battery_soh" field.
+func BatterySohIsNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBatterySoh)))
+	})
+}
+
+// BatterySohNotNil applies the NotNil predicate on the "battery_soh" field.
+func BatterySohNotNil() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBatterySoh)))
+	})
+}
+
+// HasVehicleModel applies the HasEdge predicate on the "vehicle_model" edge.
+func HasVehicleModel() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VehicleModelTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VehicleModelTable, VehicleModelColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVehicleModelWith applies the HasEdge predicate on the "vehicle_model" edge with a given conditions (other predicates).
+func HasVehicleModelWith(preds ...predicate.VehicleModel) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VehicleModelInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VehicleModelTable, VehicleModelColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMaintenanceRecords applies the HasEdge predicate on the "maintenance_records" edge.
+func HasMaintenanceRecords() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MaintenanceRecordsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceRecordsTable, MaintenanceRecordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMaintenanceRecordsWith applies the HasEdge predicate on the "maintenance_records" edge with a given conditions (other predicates).
+func HasMaintenanceRecordsWith(preds ...predicate.MaintenanceRecord) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MaintenanceRecordsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceRecordsTable, MaintenanceRecordsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInsurancePolicies applies the HasEdge predicate on the "insurance_policies" edge.
+func HasInsurancePolicies() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InsurancePoliciesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InsurancePoliciesTable, InsurancePoliciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInsurancePoliciesWith applies the HasEdge predicate on the "insurance_policies" edge with a given conditions (other predicates).
+func HasInsurancePoliciesWith(preds ...predicate.InsurancePolicy) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InsurancePoliciesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InsurancePoliciesTable, InsurancePoliciesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransfers applies the HasEdge predicate on the "transfers" edge.
+func HasTransfers() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TransfersTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransfersTable, TransfersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransfersWith applies the HasEdge predicate on the "transfers" edge with a given conditions (other predicates).
+func HasTransfersWith(preds ...predicate.Transfer) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TransfersInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransfersTable, TransfersColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOdometerReadings applies the HasEdge predicate on the "odometer_readings" edge.
+func HasOdometerReadings() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OdometerReadingsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OdometerReadingsTable, OdometerReadingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOdometerReadingsWith applies the HasEdge predicate on the "odometer_readings" edge with a given conditions (other predicates).
+func HasOdometerReadingsWith(preds ...predicate.OdometerReading) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OdometerReadingsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OdometerReadingsTable, OdometerReadingsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AttachmentsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AttachmentsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFleets applies the HasEdge predicate on the "fleets" edge.
+func HasFleets() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(FleetsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, FleetsTable, FleetsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFleetsWith applies the HasEdge predicate on the "fleets" edge with a given conditions (other predicates).
+func HasFleetsWith(preds ...predicate.Fleet) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(FleetsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, FleetsTable, FleetsPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TagsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TagsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttributes applies the HasEdge predicate on the "attributes" edge.
+func HasAttributes() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AttributesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttributesTable, AttributesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttributesWith applies the HasEdge predicate on the "attributes" edge with a given conditions (other predicates).
+func HasAttributesWith(preds ...predicate.CarAttribute) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AttributesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttributesTable, AttributesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReservations applies the HasEdge predicate on the "reservations" edge.
+func HasReservations() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReservationsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReservationsTable, ReservationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReservationsWith applies the HasEdge predicate on the "reservations" edge with a given conditions (other predicates).
+func HasReservationsWith(preds ...predicate.Reservation) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReservationsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReservationsTable, ReservationsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTrips applies the HasEdge predicate on the "trips" edge.
+func HasTrips() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TripsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TripsTable, TripsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTripsWith applies the HasEdge predicate on the "trips" edge with a given conditions (other predicates).
+func HasTripsWith(preds ...predicate.Trip) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TripsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TripsTable, TripsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRefuels applies the HasEdge predicate on the "refuels" edge.
+func HasRefuels() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RefuelsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RefuelsTable, RefuelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefuelsWith applies the HasEdge predicate on the "refuels" edge with a given conditions (other predicates).
+func HasRefuelsWith(preds ...predicate.Refuel) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RefuelsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RefuelsTable, RefuelsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecalls applies the HasEdge predicate on the "recalls" edge.
+func HasRecalls() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RecallsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecallsTable, RecallsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecallsWith applies the HasEdge predicate on the "recalls" edge with a given conditions (other predicates).
+func HasRecallsWith(preds ...predicate.CarRecall) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RecallsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecallsTable, RecallsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOwnerHistories applies the HasEdge predicate on the "owner_histories" edge.
+func HasOwnerHistories() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OwnerHistoriesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OwnerHistoriesTable, OwnerHistoriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerHistoriesWith applies the HasEdge predicate on the "owner_histories" edge with a given conditions (other predicates).
+func HasOwnerHistoriesWith(preds ...predicate.OwnerHistory) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OwnerHistoriesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OwnerHistoriesTable, OwnerHistoriesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasViolations applies the HasEdge predicate on the "violations" edge.
+func HasViolations() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ViolationsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ViolationsTable, ViolationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasViolationsWith applies the HasEdge predicate on the "violations" edge with a given conditions (other predicates).
+func HasViolationsWith(preds ...predicate.Violation) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ViolationsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ViolationsTable, ViolationsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTradeRecords applies the HasEdge predicate on the "trade_records" edge.
+func HasTradeRecords() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TradeRecordsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TradeRecordsTable, TradeRecordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTradeRecordsWith applies the HasEdge predicate on the "trade_records" edge with a given conditions (other predicates).
+func HasTradeRecordsWith(preds ...predicate.TradeRecord) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TradeRecordsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TradeRecordsTable, TradeRecordsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasListings applies the HasEdge predicate on the "listings" edge.
+func HasListings() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ListingsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ListingsTable, ListingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListingsWith applies the HasEdge predicate on the "listings" edge with a given conditions (other predicates).
+func HasListingsWith(preds ...predicate.Listing) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ListingsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ListingsTable, ListingsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWarranties applies the HasEdge predicate on the "warranties" edge.
+func HasWarranties() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WarrantiesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WarrantiesTable, WarrantiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarrantiesWith applies the HasEdge predicate on the "warranties" edge with a given conditions (other predicates).
+func HasWarrantiesWith(preds ...predicate.CarWarranty) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WarrantiesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WarrantiesTable, WarrantiesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIncidents applies the HasEdge predicate on the "incidents" edge.
+func HasIncidents() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(IncidentsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IncidentsTable, IncidentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIncidentsWith applies the HasEdge predicate on the "incidents" edge with a given conditions (other predicates).
+func HasIncidentsWith(preds ...predicate.Incident) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(IncidentsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IncidentsTable, IncidentsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeaseContracts applies the HasEdge predicate on the "lease_contracts" edge.
+func HasLeaseContracts() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LeaseContractsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeaseContractsTable, LeaseContractsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeaseContractsWith applies the HasEdge predicate on the "lease_contracts" edge with a given conditions (other predicates).
+func HasLeaseContractsWith(preds ...predicate.LeaseContract) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LeaseContractsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeaseContractsTable, LeaseContractsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChargingSessions applies the HasEdge predicate on the "charging_sessions" edge.
+func HasChargingSessions() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ChargingSessionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChargingSessionsTable, ChargingSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChargingSessionsWith applies the HasEdge predicate on the "charging_sessions" edge with a given conditions (other predicates).
+func HasChargingSessionsWith(preds ...predicate.ChargingSession) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ChargingSessionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChargingSessionsTable, ChargingSessionsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGeofences applies the HasEdge predicate on the "geofences" edge.
+func HasGeofences() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GeofencesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, GeofencesTable, GeofencesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGeofencesWith applies the HasEdge predicate on the "geofences" edge with a given conditions (other predicates).
+func HasGeofencesWith(preds ...predicate.Geofence) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GeofencesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, GeofencesTable, GeofencesPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
 	})
 }
 
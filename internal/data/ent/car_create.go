@@ -3,7 +3,29 @@
 package ent
 
 import (
+	"car-service/internal/data/ent/attachment"
 	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/ownerhistory"
+	"car-service/internal/data/ent/refuel"
+	"car-service/internal/data/ent/reservation"
+	"car-service/internal/data/ent/tag"
+	"car-service/internal/data/ent/traderecord"
+	"car-service/internal/data/ent/transfer"
+	"car-service/internal/data/ent/trip"
+	"car-service/internal/data/ent/vehiclemodel"
+	"car-service/internal/data/ent/violation"
 	"context"
 	"errors"
 	"fmt"
@@ -20,6 +42,20 @@ type CarCreate struct {
 	hooks    []Hook
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (cc *CarCreate) SetTenantID(i int64) *CarCreate {
+	cc.mutation.SetTenantID(i)
+	return cc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cc *CarCreate) SetNillableTenantID(i *int64) *CarCreate {
+	if i != nil {
+		cc.SetTenantID(*i)
+	}
+	return cc
+}
+
 // SetUserID sets the "user_id" field.
 func (cc *CarCreate) SetUserID(i int64) *CarCreate {
 	cc.mutation.SetUserID(i)
@@ -48,6 +84,62 @@ func (cc *CarCreate) SetNillableModel(s *string) *CarCreate {
 	return cc
 }
 
+// SetModelID sets the "model_id" field.
+func (cc *CarCreate) SetModelID(i int64) *CarCreate {
+	cc.mutation.SetModelID(i)
+	return cc
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (cc *CarCreate) SetNillableModelID(i *int64) *CarCreate {
+	if i != nil {
+		cc.SetModelID(*i)
+	}
+	return cc
+}
+
+// SetVin sets the "vin" field.
+func (cc *CarCreate) SetVin(s string) *CarCreate {
+	cc.mutation.SetVin(s)
+	return cc
+}
+
+// SetNillableVin sets the "vin" field if the given value is not nil.
+func (cc *CarCreate) SetNillableVin(s *string) *CarCreate {
+	if s != nil {
+		cc.SetVin(*s)
+	}
+	return cc
+}
+
+// SetPlate sets the "plate" field.
+func (cc *CarCreate) SetPlate(s string) *CarCreate {
+	cc.mutation.SetPlate(s)
+	return cc
+}
+
+// SetNillablePlate sets the "plate" field if the given value is not nil.
+func (cc *CarCreate) SetNillablePlate(s *string) *CarCreate {
+	if s != nil {
+		cc.SetPlate(*s)
+	}
+	return cc
+}
+
+// SetOwnerName sets the "owner_name" field.
+func (cc *CarCreate) SetOwnerName(s string) *CarCreate {
+	cc.mutation.SetOwnerName(s)
+	return cc
+}
+
+// SetNillableOwnerName sets the "owner_name" field if the given value is not nil.
+func (cc *CarCreate) SetNillableOwnerName(s *string) *CarCreate {
+	if s != nil {
+		cc.SetOwnerName(*s)
+	}
+	return cc
+}
+
 // SetRegisteredAt sets the "registered_at" field.
 func (cc *CarCreate) SetRegisteredAt(t time.Time) *CarCreate {
 	cc.mutation.SetRegisteredAt(t)
@@ -62,12 +154,374 @@ func (cc *CarCreate) SetNillableRegisteredAt(t *time.Time) *CarCreate {
 	return cc
 }
 
+// SetBatteryCapacity sets the "battery_capacity" field.
+func (cc *CarCreate) SetBatteryCapacity(f float64) *CarCreate {
+	cc.mutation.SetBatteryCapacity(f)
+	return cc
+}
+
+// SetNillableBatteryCapacity sets the "battery_capacity" field if the given value is not nil.
+func (cc *CarCreate) SetNillableBatteryCapacity(f *float64) *CarCreate {
+	if f != nil {
+		cc.SetBatteryCapacity(*f)
+	}
+	return cc
+}
+
+// SetBatterySoh sets the "battery_soh" field.
+func (cc *CarCreate) SetBatterySoh(f float64) *CarCreate {
+	cc.mutation.SetBatterySoh(f)
+	return cc
+}
+
+// SetNillableBatterySoh sets the "battery_soh" field if the given value is not nil.
+func (cc *CarCreate) SetNillableBatterySoh(f *float64) *CarCreate {
+	if f != nil {
+		cc.SetBatterySoh(*f)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CarCreate) SetID(i int64) *CarCreate {
 	cc.mutation.SetID(i)
 	return cc
 }
 
+// SetVehicleModelID sets the "vehicle_model" edge to the VehicleModel entity by ID.
+func (cc *CarCreate) SetVehicleModelID(id int64) *CarCreate {
+	cc.mutation.SetVehicleModelID(id)
+	return cc
+}
+
+// SetNillableVehicleModelID sets the "vehicle_model" edge to the VehicleModel entity by ID if the given value is not nil.
+func (cc *CarCreate) SetNillableVehicleModelID(id *int64) *CarCreate {
+	if id != nil {
+		cc = cc.SetVehicleModelID(*id)
+	}
+	return cc
+}
+
+// SetVehicleModel sets the "vehicle_model" edge to the VehicleModel entity.
+func (cc *CarCreate) SetVehicleModel(v *VehicleModel) *CarCreate {
+	return cc.SetVehicleModelID(v.ID)
+}
+
+// AddMaintenanceRecordIDs adds the "maintenance_records" edge to the MaintenanceRecord entity by IDs.
+func (cc *CarCreate) AddMaintenanceRecordIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddMaintenanceRecordIDs(ids...)
+	return cc
+}
+
+// AddMaintenanceRecords adds the "maintenance_records" edges to the MaintenanceRecord entity.
+func (cc *CarCreate) AddMaintenanceRecords(m ...*MaintenanceRecord) *CarCreate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cc.AddMaintenanceRecordIDs(ids...)
+}
+
+// AddInsurancePolicyIDs adds the "insurance_policies" edge to the InsurancePolicy entity by IDs.
+func (cc *CarCreate) AddInsurancePolicyIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddInsurancePolicyIDs(ids...)
+	return cc
+}
+
+// AddInsurancePolicies adds the "insurance_policies" edges to the InsurancePolicy entity.
+func (cc *CarCreate) AddInsurancePolicies(i ...*InsurancePolicy) *CarCreate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cc.AddInsurancePolicyIDs(ids...)
+}
+
+// AddTransferIDs adds the "transfers" edge to the Transfer entity by IDs.
+func (cc *CarCreate) AddTransferIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddTransferIDs(ids...)
+	return cc
+}
+
+// AddTransfers adds the "transfers" edges to the Transfer entity.
+func (cc *CarCreate) AddTransfers(t ...*Transfer) *CarCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cc.AddTransferIDs(ids...)
+}
+
+// AddOdometerReadingIDs adds the "odometer_readings" edge to the OdometerReading entity by IDs.
+func (cc *CarCreate) AddOdometerReadingIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddOdometerReadingIDs(ids...)
+	return cc
+}
+
+// AddOdometerReadings adds the "odometer_readings" edges to the OdometerReading entity.
+func (cc *CarCreate) AddOdometerReadings(o ...*OdometerReading) *CarCreate {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return cc.AddOdometerReadingIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (cc *CarCreate) AddAttachmentIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddAttachmentIDs(ids...)
+	return cc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (cc *CarCreate) AddAttachments(a ...*Attachment) *CarCreate {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cc.AddAttachmentIDs(ids...)
+}
+
+// AddFleetIDs adds the "fleets" edge to the Fleet entity by IDs.
+func (cc *CarCreate) AddFleetIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddFleetIDs(ids...)
+	return cc
+}
+
+// AddFleets adds the "fleets" edges to the Fleet entity.
+func (cc *CarCreate) AddFleets(f ...*Fleet) *CarCreate {
+	ids := make([]int64, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cc.AddFleetIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (cc *CarCreate) AddTagIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddTagIDs(ids...)
+	return cc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (cc *CarCreate) AddTags(t ...*Tag) *CarCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cc.AddTagIDs(ids...)
+}
+
+// AddAttributeIDs adds the "attributes" edge to the CarAttribute entity by IDs.
+func (cc *CarCreate) AddAttributeIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddAttributeIDs(ids...)
+	return cc
+}
+
+// AddAttributes adds the "attributes" edges to the CarAttribute entity.
+func (cc *CarCreate) AddAttributes(c ...*CarAttribute) *CarCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddAttributeIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (cc *CarCreate) AddReservationIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddReservationIDs(ids...)
+	return cc
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (cc *CarCreate) AddReservations(r ...*Reservation) *CarCreate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cc.AddReservationIDs(ids...)
+}
+
+// AddTripIDs adds the "trips" edge to the Trip entity by IDs.
+func (cc *CarCreate) AddTripIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddTripIDs(ids...)
+	return cc
+}
+
+// AddTrips adds the "trips" edges to the Trip entity.
+func (cc *CarCreate) AddTrips(t ...*Trip) *CarCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cc.AddTripIDs(ids...)
+}
+
+// AddRefuelIDs adds the "refuels" edge to the Refuel entity by IDs.
+func (cc *CarCreate) AddRefuelIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddRefuelIDs(ids...)
+	return cc
+}
+
+// AddRefuels adds the "refuels" edges to the Refuel entity.
+func (cc *CarCreate) AddRefuels(r ...*Refuel) *CarCreate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cc.AddRefuelIDs(ids...)
+}
+
+// AddRecallIDs adds the "recalls" edge to the CarRecall entity by IDs.
+func (cc *CarCreate) AddRecallIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddRecallIDs(ids...)
+	return cc
+}
+
+// AddRecalls adds the "recalls" edges to the CarRecall entity.
+func (cc *CarCreate) AddRecalls(c ...*CarRecall) *CarCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddRecallIDs(ids...)
+}
+
+// AddOwnerHistoryIDs adds the "owner_histories" edge to the OwnerHistory entity by IDs.
+func (cc *CarCreate) AddOwnerHistoryIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddOwnerHistoryIDs(ids...)
+	return cc
+}
+
+// AddOwnerHistories adds the "owner_histories" edges to the OwnerHistory entity.
+func (cc *CarCreate) AddOwnerHistories(o ...*OwnerHistory) *CarCreate {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return cc.AddOwnerHistoryIDs(ids...)
+}
+
+// AddViolationIDs adds the "violations" edge to the Violation entity by IDs.
+func (cc *CarCreate) AddViolationIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddViolationIDs(ids...)
+	return cc
+}
+
+// AddViolations adds the "violations" edges to the Violation entity.
+func (cc *CarCreate) AddViolations(v ...*Violation) *CarCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return cc.AddViolationIDs(ids...)
+}
+
+// AddTradeRecordIDs adds the "trade_records" edge to the TradeRecord entity by IDs.
+func (cc *CarCreate) AddTradeRecordIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddTradeRecordIDs(ids...)
+	return cc
+}
+
+// AddTradeRecords adds the "trade_records" edges to the TradeRecord entity.
+func (cc *CarCreate) AddTradeRecords(t ...*TradeRecord) *CarCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cc.AddTradeRecordIDs(ids...)
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (cc *CarCreate) AddListingIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddListingIDs(ids...)
+	return cc
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (cc *CarCreate) AddListings(l ...*Listing) *CarCreate {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cc.AddListingIDs(ids...)
+}
+
+// AddWarrantyIDs adds the "warranties" edge to the CarWarranty entity by IDs.
+func (cc *CarCreate) AddWarrantyIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddWarrantyIDs(ids...)
+	return cc
+}
+
+// AddWarranties adds the "warranties" edges to the CarWarranty entity.
+func (cc *CarCreate) AddWarranties(c ...*CarWarranty) *CarCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddWarrantyIDs(ids...)
+}
+
+// AddIncidentIDs adds the "incidents" edge to the Incident entity by IDs.
+func (cc *CarCreate) AddIncidentIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddIncidentIDs(ids...)
+	return cc
+}
+
+// AddIncidents adds the "incidents" edges to the Incident entity.
+func (cc *CarCreate) AddIncidents(i ...*Incident) *CarCreate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cc.AddIncidentIDs(ids...)
+}
+
+// AddLeaseContractIDs adds the "lease_contracts" edge to the LeaseContract entity by IDs.
+func (cc *CarCreate) AddLeaseContractIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddLeaseContractIDs(ids...)
+	return cc
+}
+
+// AddLeaseContracts adds the "lease_contracts" edges to the LeaseContract entity.
+func (cc *CarCreate) AddLeaseContracts(l ...*LeaseContract) *CarCreate {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cc.AddLeaseContractIDs(ids...)
+}
+
+// AddChargingSessionIDs adds the "charging_sessions" edge to the ChargingSession entity by IDs.
+func (cc *CarCreate) AddChargingSessionIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddChargingSessionIDs(ids...)
+	return cc
+}
+
+// AddChargingSessions adds the "charging_sessions" edges to the ChargingSession entity.
+func (cc *CarCreate) AddChargingSessions(c ...*ChargingSession) *CarCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddChargingSessionIDs(ids...)
+}
+
+// AddGeofenceIDs adds the "geofences" edge to the Geofence entity by IDs.
+func (cc *CarCreate) AddGeofenceIDs(ids ...int64) *CarCreate {
+	cc.mutation.AddGeofenceIDs(ids...)
+	return cc
+}
+
+// AddGeofences adds the "geofences" edges to the Geofence entity.
+func (cc *CarCreate) AddGeofences(g ...*Geofence) *CarCreate {
+	ids := make([]int64, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return cc.AddGeofenceIDs(ids...)
+}
+
 // Mutation returns the CarMutation object of the builder.
 func (cc *CarCreate) Mutation() *CarMutation {
 	return cc.mutation
@@ -79,7 +533,9 @@ func (cc *CarCreate) Save(ctx context.Context) (*Car, error) {
 		err  error
 		node *Car
 	)
-	cc.defaults()
+	if err := cc.defaults(); err != nil {
+		return nil, err
+	}
 	if len(cc.hooks) == 0 {
 		if err = cc.check(); err != nil {
 			return nil, err
@@ -144,11 +600,15 @@ func (cc *CarCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cc *CarCreate) defaults() {
+func (cc *CarCreate) defaults() error {
 	if _, ok := cc.mutation.RegisteredAt(); !ok {
+		if car.DefaultRegisteredAt == nil {
+			return fmt.Errorf("ent: uninitialized car.DefaultRegisteredAt (forgotten import ent/runtime?)")
+		}
 		v := car.DefaultRegisteredAt()
 		cc.mutation.SetRegisteredAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -189,6 +649,14 @@ func (cc *CarCreate) createSpec() (*Car, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := cc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: car.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
 	if value, ok := cc.mutation.UserID(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt64,
@@ -205,6 +673,30 @@ func (cc *CarCreate) createSpec() (*Car, *sqlgraph.CreateSpec) {
 		})
 		_node.Model = value
 	}
+	if value, ok := cc.mutation.Vin(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldVin,
+		})
+		_node.Vin = value
+	}
+	if value, ok := cc.mutation.Plate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldPlate,
+		})
+		_node.Plate = value
+	}
+	if value, ok := cc.mutation.OwnerName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldOwnerName,
+		})
+		_node.OwnerName = value
+	}
 	if value, ok := cc.mutation.RegisteredAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -213,6 +705,441 @@ func (cc *CarCreate) createSpec() (*Car, *sqlgraph.CreateSpec) {
 		})
 		_node.RegisteredAt = value
 	}
+	if value, ok := cc.mutation.BatteryCapacity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatteryCapacity,
+		})
+		_node.BatteryCapacity = value
+	}
+	if value, ok := cc.mutation.BatterySoh(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatterySoh,
+		})
+		_node.BatterySoh = value
+	}
+	if nodes := cc.mutation.VehicleModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.VehicleModelTable,
+			Columns: []string{car.VehicleModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ModelID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.MaintenanceRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceRecordsTable,
+			Columns: []string{car.MaintenanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: maintenancerecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.InsurancePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.InsurancePoliciesTable,
+			Columns: []string{car.InsurancePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.TransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TransfersTable,
+			Columns: []string{car.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: transfer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.OdometerReadingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OdometerReadingsTable,
+			Columns: []string{car.OdometerReadingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: odometerreading.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttachmentsTable,
+			Columns: []string{car.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.FleetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.FleetsTable,
+			Columns: car.FleetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: fleet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.TagsTable,
+			Columns: car.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttributesTable,
+			Columns: []string{car.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ReservationsTable,
+			Columns: []string{car.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.TripsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TripsTable,
+			Columns: []string{car.TripsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: trip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.RefuelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RefuelsTable,
+			Columns: []string{car.RefuelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: refuel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.RecallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RecallsTable,
+			Columns: []string{car.RecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.OwnerHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OwnerHistoriesTable,
+			Columns: []string{car.OwnerHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: ownerhistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ViolationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ViolationsTable,
+			Columns: []string{car.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: violation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.TradeRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TradeRecordsTable,
+			Columns: []string{car.TradeRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: traderecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ListingsTable,
+			Columns: []string{car.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: listing.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.WarrantiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.WarrantiesTable,
+			Columns: []string{car.WarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.IncidentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.IncidentsTable,
+			Columns: []string{car.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.LeaseContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.LeaseContractsTable,
+			Columns: []string{car.LeaseContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: leasecontract.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ChargingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ChargingSessionsTable,
+			Columns: []string{car.ChargingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: chargingsession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.GeofencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.GeofencesTable,
+			Columns: car.GeofencesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: geofence.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -3,12 +3,37 @@
 package ent
 
 import (
+	"car-service/internal/data/ent/attachment"
 	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/ownerhistory"
 	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/refuel"
+	"car-service/internal/data/ent/reservation"
+	"car-service/internal/data/ent/tag"
+	"car-service/internal/data/ent/traderecord"
+	"car-service/internal/data/ent/transfer"
+	"car-service/internal/data/ent/trip"
+	"car-service/internal/data/ent/vehiclemodel"
+	"car-service/internal/data/ent/violation"
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -23,7 +48,30 @@ type CarQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Car
-	modifiers  []func(*sql.Selector)
+	// eager-loading edges.
+	withVehicleModel       *VehicleModelQuery
+	withMaintenanceRecords *MaintenanceRecordQuery
+	withInsurancePolicies  *InsurancePolicyQuery
+	withTransfers          *TransferQuery
+	withOdometerReadings   *OdometerReadingQuery
+	withAttachments        *AttachmentQuery
+	withFleets             *FleetQuery
+	withTags               *TagQuery
+	withAttributes         *CarAttributeQuery
+	withReservations       *ReservationQuery
+	withTrips              *TripQuery
+	withRefuels            *RefuelQuery
+	withRecalls            *CarRecallQuery
+	withOwnerHistories     *OwnerHistoryQuery
+	withViolations         *ViolationQuery
+	withTradeRecords       *TradeRecordQuery
+	withListings           *ListingQuery
+	withWarranties         *CarWarrantyQuery
+	withIncidents          *IncidentQuery
+	withLeaseContracts     *LeaseContractQuery
+	withChargingSessions   *ChargingSessionQuery
+	withGeofences          *GeofenceQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,6 +108,490 @@ func (cq *CarQuery) Order(o ...OrderFunc) *CarQuery {
 	return cq
 }
 
+// QueryVehicleModel chains the current query on the "vehicle_model" edge.
+func (cq *CarQuery) QueryVehicleModel() *VehicleModelQuery {
+	query := &VehicleModelQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(vehiclemodel.Table, vehiclemodel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, car.VehicleModelTable, car.VehicleModelColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMaintenanceRecords chains the current query on the "maintenance_records" edge.
+func (cq *CarQuery) QueryMaintenanceRecords() *MaintenanceRecordQuery {
+	query := &MaintenanceRecordQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(maintenancerecord.Table, maintenancerecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.MaintenanceRecordsTable, car.MaintenanceRecordsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInsurancePolicies chains the current query on the "insurance_policies" edge.
+func (cq *CarQuery) QueryInsurancePolicies() *InsurancePolicyQuery {
+	query := &InsurancePolicyQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(insurancepolicy.Table, insurancepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.InsurancePoliciesTable, car.InsurancePoliciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransfers chains the current query on the "transfers" edge.
+func (cq *CarQuery) QueryTransfers() *TransferQuery {
+	query := &TransferQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(transfer.Table, transfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.TransfersTable, car.TransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOdometerReadings chains the current query on the "odometer_readings" edge.
+func (cq *CarQuery) QueryOdometerReadings() *OdometerReadingQuery {
+	query := &OdometerReadingQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(odometerreading.Table, odometerreading.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.OdometerReadingsTable, car.OdometerReadingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (cq *CarQuery) QueryAttachments() *AttachmentQuery {
+	query := &AttachmentQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.AttachmentsTable, car.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFleets chains the current query on the "fleets" edge.
+func (cq *CarQuery) QueryFleets() *FleetQuery {
+	query := &FleetQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(fleet.Table, fleet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, car.FleetsTable, car.FleetsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (cq *CarQuery) QueryTags() *TagQuery {
+	query := &TagQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, car.TagsTable, car.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttributes chains the current query on the "attributes" edge.
+func (cq *CarQuery) QueryAttributes() *CarAttributeQuery {
+	query := &CarAttributeQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(carattribute.Table, carattribute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.AttributesTable, car.AttributesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReservations chains the current query on the "reservations" edge.
+func (cq *CarQuery) QueryReservations() *ReservationQuery {
+	query := &ReservationQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(reservation.Table, reservation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.ReservationsTable, car.ReservationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTrips chains the current query on the "trips" edge.
+func (cq *CarQuery) QueryTrips() *TripQuery {
+	query := &TripQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(trip.Table, trip.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.TripsTable, car.TripsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefuels chains the current query on the "refuels" edge.
+func (cq *CarQuery) QueryRefuels() *RefuelQuery {
+	query := &RefuelQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(refuel.Table, refuel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.RefuelsTable, car.RefuelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecalls chains the current query on the "recalls" edge.
+func (cq *CarQuery) QueryRecalls() *CarRecallQuery {
+	query := &CarRecallQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(carrecall.Table, carrecall.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.RecallsTable, car.RecallsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOwnerHistories chains the current query on the "owner_histories" edge.
+func (cq *CarQuery) QueryOwnerHistories() *OwnerHistoryQuery {
+	query := &OwnerHistoryQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(ownerhistory.Table, ownerhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.OwnerHistoriesTable, car.OwnerHistoriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryViolations chains the current query on the "violations" edge.
+func (cq *CarQuery) QueryViolations() *ViolationQuery {
+	query := &ViolationQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(violation.Table, violation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.ViolationsTable, car.ViolationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTradeRecords chains the current query on the "trade_records" edge.
+func (cq *CarQuery) QueryTradeRecords() *TradeRecordQuery {
+	query := &TradeRecordQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(traderecord.Table, traderecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.TradeRecordsTable, car.TradeRecordsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryListings chains the current query on the "listings" edge.
+func (cq *CarQuery) QueryListings() *ListingQuery {
+	query := &ListingQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.ListingsTable, car.ListingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWarranties chains the current query on the "warranties" edge.
+func (cq *CarQuery) QueryWarranties() *CarWarrantyQuery {
+	query := &CarWarrantyQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(carwarranty.Table, carwarranty.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.WarrantiesTable, car.WarrantiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIncidents chains the current query on the "incidents" edge.
+func (cq *CarQuery) QueryIncidents() *IncidentQuery {
+	query := &IncidentQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(incident.Table, incident.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.IncidentsTable, car.IncidentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeaseContracts chains the current query on the "lease_contracts" edge.
+func (cq *CarQuery) QueryLeaseContracts() *LeaseContractQuery {
+	query := &LeaseContractQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(leasecontract.Table, leasecontract.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.LeaseContractsTable, car.LeaseContractsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChargingSessions chains the current query on the "charging_sessions" edge.
+func (cq *CarQuery) QueryChargingSessions() *ChargingSessionQuery {
+	query := &ChargingSessionQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(chargingsession.Table, chargingsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.ChargingSessionsTable, car.ChargingSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGeofences chains the current query on the "geofences" edge.
+func (cq *CarQuery) QueryGeofences() *GeofenceQuery {
+	query := &GeofenceQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(geofence.Table, geofence.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, car.GeofencesTable, car.GeofencesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Car entity from the query.
 // Returns a *NotFoundError when no Car was found.
 func (cq *CarQuery) First(ctx context.Context) (*Car, error) {
@@ -236,11 +768,33 @@ func (cq *CarQuery) Clone() *CarQuery {
 		return nil
 	}
 	return &CarQuery{
-		config:     cq.config,
-		limit:      cq.limit,
-		offset:     cq.offset,
-		order:      append([]OrderFunc{}, cq.order...),
-		predicates: append([]predicate.Car{}, cq.predicates...),
+		config:                 cq.config,
+		limit:                  cq.limit,
+		offset:                 cq.offset,
+		order:                  append([]OrderFunc{}, cq.order...),
+		predicates:             append([]predicate.Car{}, cq.predicates...),
+		withVehicleModel:       cq.withVehicleModel.Clone(),
+		withMaintenanceRecords: cq.withMaintenanceRecords.Clone(),
+		withInsurancePolicies:  cq.withInsurancePolicies.Clone(),
+		withTransfers:          cq.withTransfers.Clone(),
+		withOdometerReadings:   cq.withOdometerReadings.Clone(),
+		withAttachments:        cq.withAttachments.Clone(),
+		withFleets:             cq.withFleets.Clone(),
+		withTags:               cq.withTags.Clone(),
+		withAttributes:         cq.withAttributes.Clone(),
+		withReservations:       cq.withReservations.Clone(),
+		withTrips:              cq.withTrips.Clone(),
+		withRefuels:            cq.withRefuels.Clone(),
+		withRecalls:            cq.withRecalls.Clone(),
+		withOwnerHistories:     cq.withOwnerHistories.Clone(),
+		withViolations:         cq.withViolations.Clone(),
+		withTradeRecords:       cq.withTradeRecords.Clone(),
+		withListings:           cq.withListings.Clone(),
+		withWarranties:         cq.withWarranties.Clone(),
+		withIncidents:          cq.withIncidents.Clone(),
+		withLeaseContracts:     cq.withLeaseContracts.Clone(),
+		withChargingSessions:   cq.withChargingSessions.Clone(),
+		withGeofences:          cq.withGeofences.Clone(),
 		// clone intermediate query.
 		sql:    cq.sql.Clone(),
 		path:   cq.path,
@@ -248,18 +802,260 @@ func (cq *CarQuery) Clone() *CarQuery {
 	}
 }
 
+// WithVehicleModel tells the query-builder to eager-load the nodes that are connected to
+// the "vehicle_model" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithVehicleModel(opts ...func(*VehicleModelQuery)) *CarQuery {
+	query := &VehicleModelQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withVehicleModel = query
+	return cq
+}
+
+// WithMaintenanceRecords tells the query-builder to eager-load the nodes that are connected to
+// the "maintenance_records" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithMaintenanceRecords(opts ...func(*MaintenanceRecordQuery)) *CarQuery {
+	query := &MaintenanceRecordQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withMaintenanceRecords = query
+	return cq
+}
+
+// WithInsurancePolicies tells the query-builder to eager-load the nodes that are connected to
+// the "insurance_policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithInsurancePolicies(opts ...func(*InsurancePolicyQuery)) *CarQuery {
+	query := &InsurancePolicyQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withInsurancePolicies = query
+	return cq
+}
+
+// WithTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithTransfers(opts ...func(*TransferQuery)) *CarQuery {
+	query := &TransferQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withTransfers = query
+	return cq
+}
+
+// WithOdometerReadings tells the query-builder to eager-load the nodes that are connected to
+// the "odometer_readings" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithOdometerReadings(opts ...func(*OdometerReadingQuery)) *CarQuery {
+	query := &OdometerReadingQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withOdometerReadings = query
+	return cq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithAttachments(opts ...func(*AttachmentQuery)) *CarQuery {
+	query := &AttachmentQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withAttachments = query
+	return cq
+}
+
+// WithFleets tells the query-builder to eager-load the nodes that are connected to
+// the "fleets" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithFleets(opts ...func(*FleetQuery)) *CarQuery {
+	query := &FleetQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withFleets = query
+	return cq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithTags(opts ...func(*TagQuery)) *CarQuery {
+	query := &TagQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withTags = query
+	return cq
+}
+
+// WithAttributes tells the query-builder to eager-load the nodes that are connected to
+// the "attributes" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithAttributes(opts ...func(*CarAttributeQuery)) *CarQuery {
+	query := &CarAttributeQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withAttributes = query
+	return cq
+}
+
+// WithReservations tells the query-builder to eager-load the nodes that are connected to
+// the "reservations" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithReservations(opts ...func(*ReservationQuery)) *CarQuery {
+	query := &ReservationQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withReservations = query
+	return cq
+}
+
+// WithTrips tells the query-builder to eager-load the nodes that are connected to
+// the "trips" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithTrips(opts ...func(*TripQuery)) *CarQuery {
+	query := &TripQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withTrips = query
+	return cq
+}
+
+// WithRefuels tells the query-builder to eager-load the nodes that are connected to
+// the "refuels" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithRefuels(opts ...func(*RefuelQuery)) *CarQuery {
+	query := &RefuelQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withRefuels = query
+	return cq
+}
+
+// WithRecalls tells the query-builder to eager-load the nodes that are connected to
+// the "recalls" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithRecalls(opts ...func(*CarRecallQuery)) *CarQuery {
+	query := &CarRecallQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withRecalls = query
+	return cq
+}
+
+// WithOwnerHistories tells the query-builder to eager-load the nodes that are connected to
+// the "owner_histories" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithOwnerHistories(opts ...func(*OwnerHistoryQuery)) *CarQuery {
+	query := &OwnerHistoryQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withOwnerHistories = query
+	return cq
+}
+
+// WithViolations tells the query-builder to eager-load the nodes that are connected to
+// the "violations" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithViolations(opts ...func(*ViolationQuery)) *CarQuery {
+	query := &ViolationQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withViolations = query
+	return cq
+}
+
+// WithTradeRecords tells the query-builder to eager-load the nodes that are connected to
+// the "trade_records" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithTradeRecords(opts ...func(*TradeRecordQuery)) *CarQuery {
+	query := &TradeRecordQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withTradeRecords = query
+	return cq
+}
+
+// WithListings tells the query-builder to eager-load the nodes that are connected to
+// the "listings" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithListings(opts ...func(*ListingQuery)) *CarQuery {
+	query := &ListingQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withListings = query
+	return cq
+}
+
+// WithWarranties tells the query-builder to eager-load the nodes that are connected to
+// the "warranties" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithWarranties(opts ...func(*CarWarrantyQuery)) *CarQuery {
+	query := &CarWarrantyQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withWarranties = query
+	return cq
+}
+
+// WithIncidents tells the query-builder to eager-load the nodes that are connected to
+// the "incidents" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithIncidents(opts ...func(*IncidentQuery)) *CarQuery {
+	query := &IncidentQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withIncidents = query
+	return cq
+}
+
+// WithLeaseContracts tells the query-builder to eager-load the nodes that are connected to
+// the "lease_contracts" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithLeaseContracts(opts ...func(*LeaseContractQuery)) *CarQuery {
+	query := &LeaseContractQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withLeaseContracts = query
+	return cq
+}
+
+// WithChargingSessions tells the query-builder to eager-load the nodes that are connected to
+// the "charging_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithChargingSessions(opts ...func(*ChargingSessionQuery)) *CarQuery {
+	query := &ChargingSessionQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withChargingSessions = query
+	return cq
+}
+
+// WithGeofences tells the query-builder to eager-load the nodes that are connected to
+// the "geofences" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CarQuery) WithGeofences(opts ...func(*GeofenceQuery)) *CarQuery {
+	query := &GeofenceQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withGeofences = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		UserID int64 `json:"user_id,omitempty"`
+//		TenantID int64 `json:"tenant_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Car.Query().
-//		GroupBy(car.FieldUserID).
+//		GroupBy(car.FieldTenantID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 //
@@ -283,11 +1079,11 @@ func (cq *CarQuery) GroupBy(field string, fields ...string) *CarGroupBy {
 // Example:
 //
 //	var v []struct {
-//		UserID int64 `json:"user_id,omitempty"`
+//		TenantID int64 `json:"tenant_id,omitempty"`
 //	}
 //
 //	client.Car.Query().
-//		Select(car.FieldUserID).
+//		Select(car.FieldTenantID).
 //		Scan(ctx, &v)
 //
 func (cq *CarQuery) Select(fields ...string) *CarSelect {
@@ -311,13 +1107,43 @@ func (cq *CarQuery) prepareQuery(ctx context.Context) error {
 		}
 		cq.sql = prev
 	}
+	if car.Policy == nil {
+		return errors.New("ent: uninitialized car.Policy (forgotten import ent/runtime?)")
+	}
+	if err := car.Policy.EvalQuery(ctx, cq); err != nil {
+		return err
+	}
 	return nil
 }
 
 func (cq *CarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Car, error) {
 	var (
-		nodes = []*Car{}
-		_spec = cq.querySpec()
+		nodes       = []*Car{}
+		_spec       = cq.querySpec()
+		loadedTypes = [22]bool{
+			cq.withVehicleModel != nil,
+			cq.withMaintenanceRecords != nil,
+			cq.withInsurancePolicies != nil,
+			cq.withTransfers != nil,
+			cq.withOdometerReadings != nil,
+			cq.withAttachments != nil,
+			cq.withFleets != nil,
+			cq.withTags != nil,
+			cq.withAttributes != nil,
+			cq.withReservations != nil,
+			cq.withTrips != nil,
+			cq.withRefuels != nil,
+			cq.withRecalls != nil,
+			cq.withOwnerHistories != nil,
+			cq.withViolations != nil,
+			cq.withTradeRecords != nil,
+			cq.withListings != nil,
+			cq.withWarranties != nil,
+			cq.withIncidents != nil,
+			cq.withLeaseContracts != nil,
+			cq.withChargingSessions != nil,
+			cq.withGeofences != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Car).scanValues(nil, columns)
@@ -325,6 +1151,7 @@ func (cq *CarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Car, err
 	_spec.Assign = func(columns []string, values []interface{}) error {
 		node := &Car{config: cq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if len(cq.modifiers) > 0 {
@@ -339,6 +1166,642 @@ func (cq *CarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Car, err
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+
+	if query := cq.withVehicleModel; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*Car)
+		for i := range nodes {
+			fk := nodes[i].ModelID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(vehiclemodel.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "model_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.VehicleModel = n
+			}
+		}
+	}
+
+	if query := cq.withMaintenanceRecords; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.MaintenanceRecords = []*MaintenanceRecord{}
+		}
+		query.Where(predicate.MaintenanceRecord(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.MaintenanceRecordsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.MaintenanceRecords = append(node.Edges.MaintenanceRecords, n)
+		}
+	}
+
+	if query := cq.withInsurancePolicies; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.InsurancePolicies = []*InsurancePolicy{}
+		}
+		query.Where(predicate.InsurancePolicy(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.InsurancePoliciesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.InsurancePolicies = append(node.Edges.InsurancePolicies, n)
+		}
+	}
+
+	if query := cq.withTransfers; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Transfers = []*Transfer{}
+		}
+		query.Where(predicate.Transfer(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.TransfersColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Transfers = append(node.Edges.Transfers, n)
+		}
+	}
+
+	if query := cq.withOdometerReadings; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.OdometerReadings = []*OdometerReading{}
+		}
+		query.Where(predicate.OdometerReading(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.OdometerReadingsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.OdometerReadings = append(node.Edges.OdometerReadings, n)
+		}
+	}
+
+	if query := cq.withAttachments; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Attachments = []*Attachment{}
+		}
+		query.Where(predicate.Attachment(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.AttachmentsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Attachments = append(node.Edges.Attachments, n)
+		}
+	}
+
+	if query := cq.withFleets; query != nil {
+		edgeids := make([]driver.Value, len(nodes))
+		byid := make(map[int64]*Car)
+		nids := make(map[int64]map[*Car]struct{})
+		for i, node := range nodes {
+			edgeids[i] = node.ID
+			byid[node.ID] = node
+			node.Edges.Fleets = []*Fleet{}
+		}
+		query.Where(func(s *sql.Selector) {
+			joinT := sql.Table(car.FleetsTable)
+			s.Join(joinT).On(s.C(fleet.FieldID), joinT.C(car.FleetsPrimaryKey[0]))
+			s.Where(sql.InValues(joinT.C(car.FleetsPrimaryKey[1]), edgeids...))
+			columns := s.SelectedColumns()
+			s.Select(joinT.C(car.FleetsPrimaryKey[1]))
+			s.AppendSelect(columns...)
+			s.SetDistinct(false)
+		})
+		neighbors, err := query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]interface{}, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]interface{}{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []interface{}) error {
+				outValue := values[0].(*sql.NullInt64).Int64
+				inValue := values[1].(*sql.NullInt64).Int64
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Car]struct{}{byid[outValue]: struct{}{}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byid[outValue]] = struct{}{}
+				return nil
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "fleets" node returned %v`, n.ID)
+			}
+			for kn := range nodes {
+				kn.Edges.Fleets = append(kn.Edges.Fleets, n)
+			}
+		}
+	}
+
+	if query := cq.withTags; query != nil {
+		edgeids := make([]driver.Value, len(nodes))
+		byid := make(map[int64]*Car)
+		nids := make(map[int64]map[*Car]struct{})
+		for i, node := range nodes {
+			edgeids[i] = node.ID
+			byid[node.ID] = node
+			node.Edges.Tags = []*Tag{}
+		}
+		query.Where(func(s *sql.Selector) {
+			joinT := sql.Table(car.TagsTable)
+			s.Join(joinT).On(s.C(tag.FieldID), joinT.C(car.TagsPrimaryKey[0]))
+			s.Where(sql.InValues(joinT.C(car.TagsPrimaryKey[1]), edgeids...))
+			columns := s.SelectedColumns()
+			s.Select(joinT.C(car.TagsPrimaryKey[1]))
+			s.AppendSelect(columns...)
+			s.SetDistinct(false)
+		})
+		neighbors, err := query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]interface{}, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]interface{}{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []interface{}) error {
+				outValue := values[0].(*sql.NullInt64).Int64
+				inValue := values[1].(*sql.NullInt64).Int64
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Car]struct{}{byid[outValue]: struct{}{}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byid[outValue]] = struct{}{}
+				return nil
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+			}
+			for kn := range nodes {
+				kn.Edges.Tags = append(kn.Edges.Tags, n)
+			}
+		}
+	}
+
+	if query := cq.withAttributes; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Attributes = []*CarAttribute{}
+		}
+		query.Where(predicate.CarAttribute(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.AttributesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Attributes = append(node.Edges.Attributes, n)
+		}
+	}
+
+	if query := cq.withReservations; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Reservations = []*Reservation{}
+		}
+		query.Where(predicate.Reservation(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.ReservationsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Reservations = append(node.Edges.Reservations, n)
+		}
+	}
+
+	if query := cq.withTrips; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Trips = []*Trip{}
+		}
+		query.Where(predicate.Trip(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.TripsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Trips = append(node.Edges.Trips, n)
+		}
+	}
+
+	if query := cq.withRefuels; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Refuels = []*Refuel{}
+		}
+		query.Where(predicate.Refuel(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.RefuelsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Refuels = append(node.Edges.Refuels, n)
+		}
+	}
+
+	if query := cq.withRecalls; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Recalls = []*CarRecall{}
+		}
+		query.Where(predicate.CarRecall(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.RecallsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Recalls = append(node.Edges.Recalls, n)
+		}
+	}
+
+	if query := cq.withOwnerHistories; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.OwnerHistories = []*OwnerHistory{}
+		}
+		query.Where(predicate.OwnerHistory(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.OwnerHistoriesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.OwnerHistories = append(node.Edges.OwnerHistories, n)
+		}
+	}
+
+	if query := cq.withViolations; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Violations = []*Violation{}
+		}
+		query.Where(predicate.Violation(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.ViolationsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Violations = append(node.Edges.Violations, n)
+		}
+	}
+
+	if query := cq.withTradeRecords; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.TradeRecords = []*TradeRecord{}
+		}
+		query.Where(predicate.TradeRecord(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.TradeRecordsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.TradeRecords = append(node.Edges.TradeRecords, n)
+		}
+	}
+
+	if query := cq.withListings; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Listings = []*Listing{}
+		}
+		query.Where(predicate.Listing(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.ListingsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Listings = append(node.Edges.Listings, n)
+		}
+	}
+
+	if query := cq.withWarranties; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Warranties = []*CarWarranty{}
+		}
+		query.Where(predicate.CarWarranty(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.WarrantiesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Warranties = append(node.Edges.Warranties, n)
+		}
+	}
+
+	if query := cq.withIncidents; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Incidents = []*Incident{}
+		}
+		query.Where(predicate.Incident(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.IncidentsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Incidents = append(node.Edges.Incidents, n)
+		}
+	}
+
+	if query := cq.withLeaseContracts; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.LeaseContracts = []*LeaseContract{}
+		}
+		query.Where(predicate.LeaseContract(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.LeaseContractsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.LeaseContracts = append(node.Edges.LeaseContracts, n)
+		}
+	}
+
+	if query := cq.withChargingSessions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*Car)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.ChargingSessions = []*ChargingSession{}
+		}
+		query.Where(predicate.ChargingSession(func(s *sql.Selector) {
+			s.Where(sql.InValues(car.ChargingSessionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CarID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.ChargingSessions = append(node.Edges.ChargingSessions, n)
+		}
+	}
+
+	if query := cq.withGeofences; query != nil {
+		edgeids := make([]driver.Value, len(nodes))
+		byid := make(map[int64]*Car)
+		nids := make(map[int64]map[*Car]struct{})
+		for i, node := range nodes {
+			edgeids[i] = node.ID
+			byid[node.ID] = node
+			node.Edges.Geofences = []*Geofence{}
+		}
+		query.Where(func(s *sql.Selector) {
+			joinT := sql.Table(car.GeofencesTable)
+			s.Join(joinT).On(s.C(geofence.FieldID), joinT.C(car.GeofencesPrimaryKey[0]))
+			s.Where(sql.InValues(joinT.C(car.GeofencesPrimaryKey[1]), edgeids...))
+			columns := s.SelectedColumns()
+			s.Select(joinT.C(car.GeofencesPrimaryKey[1]))
+			s.AppendSelect(columns...)
+			s.SetDistinct(false)
+		})
+		neighbors, err := query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]interface{}, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]interface{}{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []interface{}) error {
+				outValue := values[0].(*sql.NullInt64).Int64
+				inValue := values[1].(*sql.NullInt64).Int64
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Car]struct{}{byid[outValue]: struct{}{}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byid[outValue]] = struct{}{}
+				return nil
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "geofences" node returned %v`, n.ID)
+			}
+			for kn := range nodes {
+				kn.Edges.Geofences = append(kn.Edges.Geofences, n)
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -445,6 +1908,32 @@ func (cq *CarQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cq *CarQuery) ForUpdate(opts ...sql.LockOption) *CarQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cq *CarQuery) ForShare(opts ...sql.LockOption) *CarQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cq
+}
+
 // Modify adds a query modifier for attaching custom logic to queries.
 func (cq *CarQuery) Modify(modifiers ...func(s *sql.Selector)) *CarSelect {
 	cq.modifiers = append(cq.modifiers, modifiers...)
@@ -3,8 +3,30 @@
 package ent
 
 import (
+	"car-service/internal/data/ent/attachment"
 	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/ownerhistory"
 	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/refuel"
+	"car-service/internal/data/ent/reservation"
+	"car-service/internal/data/ent/tag"
+	"car-service/internal/data/ent/traderecord"
+	"car-service/internal/data/ent/transfer"
+	"car-service/internal/data/ent/trip"
+	"car-service/internal/data/ent/vehiclemodel"
+	"car-service/internal/data/ent/violation"
 	"context"
 	"errors"
 	"fmt"
@@ -28,6 +50,33 @@ func (cu *CarUpdate) Where(ps ...predicate.Car) *CarUpdate {
 	return cu
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (cu *CarUpdate) SetTenantID(i int64) *CarUpdate {
+	cu.mutation.ResetTenantID()
+	cu.mutation.SetTenantID(i)
+	return cu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cu *CarUpdate) SetNillableTenantID(i *int64) *CarUpdate {
+	if i != nil {
+		cu.SetTenantID(*i)
+	}
+	return cu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (cu *CarUpdate) AddTenantID(i int64) *CarUpdate {
+	cu.mutation.AddTenantID(i)
+	return cu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (cu *CarUpdate) ClearTenantID() *CarUpdate {
+	cu.mutation.ClearTenantID()
+	return cu
+}
+
 // SetUserID sets the "user_id" field.
 func (cu *CarUpdate) SetUserID(i int64) *CarUpdate {
 	cu.mutation.ResetUserID()
@@ -75,6 +124,86 @@ func (cu *CarUpdate) ClearModel() *CarUpdate {
 	return cu
 }
 
+// SetModelID sets the "model_id" field.
+func (cu *CarUpdate) SetModelID(i int64) *CarUpdate {
+	cu.mutation.SetModelID(i)
+	return cu
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (cu *CarUpdate) SetNillableModelID(i *int64) *CarUpdate {
+	if i != nil {
+		cu.SetModelID(*i)
+	}
+	return cu
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (cu *CarUpdate) ClearModelID() *CarUpdate {
+	cu.mutation.ClearModelID()
+	return cu
+}
+
+// SetVin sets the "vin" field.
+func (cu *CarUpdate) SetVin(s string) *CarUpdate {
+	cu.mutation.SetVin(s)
+	return cu
+}
+
+// SetNillableVin sets the "vin" field if the given value is not nil.
+func (cu *CarUpdate) SetNillableVin(s *string) *CarUpdate {
+	if s != nil {
+		cu.SetVin(*s)
+	}
+	return cu
+}
+
+// ClearVin clears the value of the "vin" field.
+func (cu *CarUpdate) ClearVin() *CarUpdate {
+	cu.mutation.ClearVin()
+	return cu
+}
+
+// SetPlate sets the "plate" field.
+func (cu *CarUpdate) SetPlate(s string) *CarUpdate {
+	cu.mutation.SetPlate(s)
+	return cu
+}
+
+// SetNillablePlate sets the "plate" field if the given value is not nil.
+func (cu *CarUpdate) SetNillablePlate(s *string) *CarUpdate {
+	if s != nil {
+		cu.SetPlate(*s)
+	}
+	return cu
+}
+
+// ClearPlate clears the value of the "plate" field.
+func (cu *CarUpdate) ClearPlate() *CarUpdate {
+	cu.mutation.ClearPlate()
+	return cu
+}
+
+// SetOwnerName sets the "owner_name" field.
+func (cu *CarUpdate) SetOwnerName(s string) *CarUpdate {
+	cu.mutation.SetOwnerName(s)
+	return cu
+}
+
+// SetNillableOwnerName sets the "owner_name" field if the given value is not nil.
+func (cu *CarUpdate) SetNillableOwnerName(s *string) *CarUpdate {
+	if s != nil {
+		cu.SetOwnerName(*s)
+	}
+	return cu
+}
+
+// ClearOwnerName clears the value of the "owner_name" field.
+func (cu *CarUpdate) ClearOwnerName() *CarUpdate {
+	cu.mutation.ClearOwnerName()
+	return cu
+}
+
 // SetRegisteredAt sets the "registered_at" field.
 func (cu *CarUpdate) SetRegisteredAt(t time.Time) *CarUpdate {
 	cu.mutation.SetRegisteredAt(t)
@@ -89,349 +218,4662 @@ func (cu *CarUpdate) SetNillableRegisteredAt(t *time.Time) *CarUpdate {
 	return cu
 }
 
-// Mutation returns the CarMutation object of the builder.
-func (cu *CarUpdate) Mutation() *CarMutation {
-	return cu.mutation
+// SetBatteryCapacity sets the "battery_capacity" field.
+func (cu *CarUpdate) SetBatteryCapacity(f float64) *CarUpdate {
+	cu.mutation.ResetBatteryCapacity()
+	cu.mutation.SetBatteryCapacity(f)
+	return cu
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (cu *CarUpdate) Save(ctx context.Context) (int, error) {
-	var (
-		err      error
-		affected int
-	)
-	if len(cu.hooks) == 0 {
-		affected, err = cu.sqlSave(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*CarMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			cu.mutation = mutation
-			affected, err = cu.sqlSave(ctx)
-			mutation.done = true
-			return affected, err
-		})
-		for i := len(cu.hooks) - 1; i >= 0; i-- {
-			if cu.hooks[i] == nil {
-				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = cu.hooks[i](mut)
-		}
-		if _, err := mut.Mutate(ctx, cu.mutation); err != nil {
-			return 0, err
-		}
+// SetNillableBatteryCapacity sets the "battery_capacity" field if the given value is not nil.
+func (cu *CarUpdate) SetNillableBatteryCapacity(f *float64) *CarUpdate {
+	if f != nil {
+		cu.SetBatteryCapacity(*f)
 	}
-	return affected, err
+	return cu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (cu *CarUpdate) SaveX(ctx context.Context) int {
-	affected, err := cu.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// AddBatteryCapacity adds f to the "battery_capacity" field.
+func (cu *CarUpdate) AddBatteryCapacity(f float64) *CarUpdate {
+	cu.mutation.AddBatteryCapacity(f)
+	return cu
 }
 
-// Exec executes the query.
-func (cu *CarUpdate) Exec(ctx context.Context) error {
-	_, err := cu.Save(ctx)
-	return err
+// ClearBatteryCapacity clears the value of the "battery_capacity" field.
+func (cu *CarUpdate) ClearBatteryCapacity() *CarUpdate {
+	cu.mutation.ClearBatteryCapacity()
+	return cu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (cu *CarUpdate) ExecX(ctx context.Context) {
-	if err := cu.Exec(ctx); err != nil {
-		panic(err)
-	}
+// SetBatterySoh sets the "battery_soh" field.
+func (cu *CarUpdate) SetBatterySoh(f float64) *CarUpdate {
+	cu.mutation.ResetBatterySoh()
+	cu.mutation.SetBatterySoh(f)
+	return cu
 }
 
-func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := &sqlgraph.UpdateSpec{
-		Node: &sqlgraph.NodeSpec{
-			Table:   car.Table,
-			Columns: car.Columns,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt64,
-				Column: car.FieldID,
-			},
-		},
-	}
-	if ps := cu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+// SetNillableBatterySoh sets the "battery_soh" field if the given value is not nil.
+func (cu *CarUpdate) SetNillableBatterySoh(f *float64) *CarUpdate {
+	if f != nil {
+		cu.SetBatterySoh(*f)
 	}
-	if value, ok := cu.mutation.UserID(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Value:  value,
-			Column: car.FieldUserID,
-		})
-	}
-	if value, ok := cu.mutation.AddedUserID(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Value:  value,
-			Column: car.FieldUserID,
-		})
-	}
-	if cu.mutation.UserIDCleared() {
-		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Column: car.FieldUserID,
-		})
-	}
-	if value, ok := cu.mutation.Model(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: car.FieldModel,
-		})
-	}
-	if cu.mutation.ModelCleared() {
-		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Column: car.FieldModel,
-		})
-	}
-	if value, ok := cu.mutation.RegisteredAt(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: car.FieldRegisteredAt,
-		})
-	}
-	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{car.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return cu
+}
+
+// AddBatterySoh adds f to the "battery_soh" field.
+func (cu *CarUpdate) AddBatterySoh(f float64) *CarUpdate {
+	cu.mutation.AddBatterySoh(f)
+	return cu
+}
+
+// ClearBatterySoh clears the value of the "battery_soh" field.
+func (cu *CarUpdate) ClearBatterySoh() *CarUpdate {
+	cu.mutation.ClearBatterySoh()
+	return cu
+}
+
+// SetVehicleModelID sets the "vehicle_model" edge to the VehicleModel entity by ID.
+func (cu *CarUpdate) SetVehicleModelID(id int64) *CarUpdate {
+	cu.mutation.SetVehicleModelID(id)
+	return cu
+}
+
+// SetNillableVehicleModelID sets the "vehicle_model" edge to the VehicleModel entity by ID if the given value is not nil.
+func (cu *CarUpdate) SetNillableVehicleModelID(id *int64) *CarUpdate {
+	if id != nil {
+		cu = cu.SetVehicleModelID(*id)
 	}
-	return n, nil
+	return cu
 }
 
-// CarUpdateOne is the builder for updating a single Car entity.
-type CarUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *CarMutation
+// SetVehicleModel sets the "vehicle_model" edge to the VehicleModel entity.
+func (cu *CarUpdate) SetVehicleModel(v *VehicleModel) *CarUpdate {
+	return cu.SetVehicleModelID(v.ID)
 }
 
-// SetUserID sets the "user_id" field.
-func (cuo *CarUpdateOne) SetUserID(i int64) *CarUpdateOne {
-	cuo.mutation.ResetUserID()
-	cuo.mutation.SetUserID(i)
-	return cuo
+// AddMaintenanceRecordIDs adds the "maintenance_records" edge to the MaintenanceRecord entity by IDs.
+func (cu *CarUpdate) AddMaintenanceRecordIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddMaintenanceRecordIDs(ids...)
+	return cu
 }
 
-// SetNillableUserID sets the "user_id" field if the given value is not nil.
-func (cuo *CarUpdateOne) SetNillableUserID(i *int64) *CarUpdateOne {
-	if i != nil {
-		cuo.SetUserID(*i)
+// AddMaintenanceRecords adds the "maintenance_records" edges to the MaintenanceRecord entity.
+func (cu *CarUpdate) AddMaintenanceRecords(m ...*MaintenanceRecord) *CarUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return cuo
+	return cu.AddMaintenanceRecordIDs(ids...)
 }
 
-// AddUserID adds i to the "user_id" field.
-func (cuo *CarUpdateOne) AddUserID(i int64) *CarUpdateOne {
-	cuo.mutation.AddUserID(i)
-	return cuo
+// AddInsurancePolicyIDs adds the "insurance_policies" edge to the InsurancePolicy entity by IDs.
+func (cu *CarUpdate) AddInsurancePolicyIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddInsurancePolicyIDs(ids...)
+	return cu
 }
 
-// ClearUserID clears the value of the "user_id" field.
-func (cuo *CarUpdateOne) ClearUserID() *CarUpdateOne {
-	cuo.mutation.ClearUserID()
-	return cuo
+// AddInsurancePolicies adds the "insurance_policies" edges to the InsurancePolicy entity.
+func (cu *CarUpdate) AddInsurancePolicies(i ...*InsurancePolicy) *CarUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cu.AddInsurancePolicyIDs(ids...)
 }
 
-// SetModel sets the "model" field.
-func (cuo *CarUpdateOne) SetModel(s string) *CarUpdateOne {
-	cuo.mutation.SetModel(s)
-	return cuo
+// AddTransferIDs adds the "transfers" edge to the Transfer entity by IDs.
+func (cu *CarUpdate) AddTransferIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddTransferIDs(ids...)
+	return cu
 }
 
-// SetNillableModel sets the "model" field if the given value is not nil.
-func (cuo *CarUpdateOne) SetNillableModel(s *string) *CarUpdateOne {
-	if s != nil {
-		cuo.SetModel(*s)
+// AddTransfers adds the "transfers" edges to the Transfer entity.
+func (cu *CarUpdate) AddTransfers(t ...*Transfer) *CarUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	return cuo
+	return cu.AddTransferIDs(ids...)
 }
 
-// ClearModel clears the value of the "model" field.
-func (cuo *CarUpdateOne) ClearModel() *CarUpdateOne {
-	cuo.mutation.ClearModel()
-	return cuo
+// AddOdometerReadingIDs adds the "odometer_readings" edge to the OdometerReading entity by IDs.
+func (cu *CarUpdate) AddOdometerReadingIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddOdometerReadingIDs(ids...)
+	return cu
 }
 
-// SetRegisteredAt sets the "registered_at" field.
-func (cuo *CarUpdateOne) SetRegisteredAt(t time.Time) *CarUpdateOne {
-	cuo.mutation.SetRegisteredAt(t)
-	return cuo
+// AddOdometerReadings adds the "odometer_readings" edges to the OdometerReading entity.
+func (cu *CarUpdate) AddOdometerReadings(o ...*OdometerReading) *CarUpdate {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return cu.AddOdometerReadingIDs(ids...)
 }
 
-// SetNillableRegisteredAt sets the "registered_at" field if the given value is not nil.
-func (cuo *CarUpdateOne) SetNillableRegisteredAt(t *time.Time) *CarUpdateOne {
-	if t != nil {
-		cuo.SetRegisteredAt(*t)
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (cu *CarUpdate) AddAttachmentIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddAttachmentIDs(ids...)
+	return cu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (cu *CarUpdate) AddAttachments(a ...*Attachment) *CarUpdate {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return cuo
+	return cu.AddAttachmentIDs(ids...)
 }
 
-// Mutation returns the CarMutation object of the builder.
-func (cuo *CarUpdateOne) Mutation() *CarMutation {
-	return cuo.mutation
+// AddFleetIDs adds the "fleets" edge to the Fleet entity by IDs.
+func (cu *CarUpdate) AddFleetIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddFleetIDs(ids...)
+	return cu
 }
 
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (cuo *CarUpdateOne) Select(field string, fields ...string) *CarUpdateOne {
-	cuo.fields = append([]string{field}, fields...)
-	return cuo
+// AddFleets adds the "fleets" edges to the Fleet entity.
+func (cu *CarUpdate) AddFleets(f ...*Fleet) *CarUpdate {
+	ids := make([]int64, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cu.AddFleetIDs(ids...)
 }
 
-// Save executes the query and returns the updated Car entity.
-func (cuo *CarUpdateOne) Save(ctx context.Context) (*Car, error) {
-	var (
-		err  error
-		node *Car
-	)
-	if len(cuo.hooks) == 0 {
-		node, err = cuo.sqlSave(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*CarMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			cuo.mutation = mutation
-			node, err = cuo.sqlSave(ctx)
-			mutation.done = true
-			return node, err
-		})
-		for i := len(cuo.hooks) - 1; i >= 0; i-- {
-			if cuo.hooks[i] == nil {
-				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = cuo.hooks[i](mut)
-		}
-		v, err := mut.Mutate(ctx, cuo.mutation)
-		if err != nil {
-			return nil, err
-		}
-		nv, ok := v.(*Car)
-		if !ok {
-			return nil, fmt.Errorf("unexpected node type %T returned from CarMutation", v)
-		}
-		node = nv
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (cu *CarUpdate) AddTagIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddTagIDs(ids...)
+	return cu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (cu *CarUpdate) AddTags(t ...*Tag) *CarUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	return node, err
+	return cu.AddTagIDs(ids...)
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (cuo *CarUpdateOne) SaveX(ctx context.Context) *Car {
-	node, err := cuo.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddAttributeIDs adds the "attributes" edge to the CarAttribute entity by IDs.
+func (cu *CarUpdate) AddAttributeIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddAttributeIDs(ids...)
+	return cu
+}
+
+// AddAttributes adds the "attributes" edges to the CarAttribute entity.
+func (cu *CarUpdate) AddAttributes(c ...*CarAttribute) *CarUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	return node
+	return cu.AddAttributeIDs(ids...)
 }
 
-// Exec executes the query on the entity.
-func (cuo *CarUpdateOne) Exec(ctx context.Context) error {
-	_, err := cuo.Save(ctx)
-	return err
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (cu *CarUpdate) AddReservationIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddReservationIDs(ids...)
+	return cu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (cuo *CarUpdateOne) ExecX(ctx context.Context) {
-	if err := cuo.Exec(ctx); err != nil {
-		panic(err)
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (cu *CarUpdate) AddReservations(r ...*Reservation) *CarUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
+	return cu.AddReservationIDs(ids...)
 }
 
-func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
-	_spec := &sqlgraph.UpdateSpec{
-		Node: &sqlgraph.NodeSpec{
-			Table:   car.Table,
-			Columns: car.Columns,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt64,
-				Column: car.FieldID,
-			},
-		},
+// AddTripIDs adds the "trips" edge to the Trip entity by IDs.
+func (cu *CarUpdate) AddTripIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddTripIDs(ids...)
+	return cu
+}
+
+// AddTrips adds the "trips" edges to the Trip entity.
+func (cu *CarUpdate) AddTrips(t ...*Trip) *CarUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	id, ok := cuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Car.id" for update`)}
+	return cu.AddTripIDs(ids...)
+}
+
+// AddRefuelIDs adds the "refuels" edge to the Refuel entity by IDs.
+func (cu *CarUpdate) AddRefuelIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddRefuelIDs(ids...)
+	return cu
+}
+
+// AddRefuels adds the "refuels" edges to the Refuel entity.
+func (cu *CarUpdate) AddRefuels(r ...*Refuel) *CarUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
-	_spec.Node.ID.Value = id
-	if fields := cuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, car.FieldID)
-		for _, f := range fields {
-			if !car.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != car.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
-		}
+	return cu.AddRefuelIDs(ids...)
+}
+
+// AddRecallIDs adds the "recalls" edge to the CarRecall entity by IDs.
+func (cu *CarUpdate) AddRecallIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddRecallIDs(ids...)
+	return cu
+}
+
+// AddRecalls adds the "recalls" edges to the CarRecall entity.
+func (cu *CarUpdate) AddRecalls(c ...*CarRecall) *CarUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	if ps := cuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return cu.AddRecallIDs(ids...)
+}
+
+// AddOwnerHistoryIDs adds the "owner_histories" edge to the OwnerHistory entity by IDs.
+func (cu *CarUpdate) AddOwnerHistoryIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddOwnerHistoryIDs(ids...)
+	return cu
+}
+
+// AddOwnerHistories adds the "owner_histories" edges to the OwnerHistory entity.
+func (cu *CarUpdate) AddOwnerHistories(o ...*OwnerHistory) *CarUpdate {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	if value, ok := cuo.mutation.UserID(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Value:  value,
-			Column: car.FieldUserID,
-		})
+	return cu.AddOwnerHistoryIDs(ids...)
+}
+
+// AddViolationIDs adds the "violations" edge to the Violation entity by IDs.
+func (cu *CarUpdate) AddViolationIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddViolationIDs(ids...)
+	return cu
+}
+
+// AddViolations adds the "violations" edges to the Violation entity.
+func (cu *CarUpdate) AddViolations(v ...*Violation) *CarUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := cuo.mutation.AddedUserID(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Value:  value,
-			Column: car.FieldUserID,
-		})
+	return cu.AddViolationIDs(ids...)
+}
+
+// AddTradeRecordIDs adds the "trade_records" edge to the TradeRecord entity by IDs.
+func (cu *CarUpdate) AddTradeRecordIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddTradeRecordIDs(ids...)
+	return cu
+}
+
+// AddTradeRecords adds the "trade_records" edges to the TradeRecord entity.
+func (cu *CarUpdate) AddTradeRecords(t ...*TradeRecord) *CarUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	if cuo.mutation.UserIDCleared() {
-		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Column: car.FieldUserID,
-		})
+	return cu.AddTradeRecordIDs(ids...)
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (cu *CarUpdate) AddListingIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddListingIDs(ids...)
+	return cu
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (cu *CarUpdate) AddListings(l ...*Listing) *CarUpdate {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
-	if value, ok := cuo.mutation.Model(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: car.FieldModel,
-		})
+	return cu.AddListingIDs(ids...)
+}
+
+// AddWarrantyIDs adds the "warranties" edge to the CarWarranty entity by IDs.
+func (cu *CarUpdate) AddWarrantyIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddWarrantyIDs(ids...)
+	return cu
+}
+
+// AddWarranties adds the "warranties" edges to the CarWarranty entity.
+func (cu *CarUpdate) AddWarranties(c ...*CarWarranty) *CarUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	if cuo.mutation.ModelCleared() {
-		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Column: car.FieldModel,
-		})
+	return cu.AddWarrantyIDs(ids...)
+}
+
+// AddIncidentIDs adds the "incidents" edge to the Incident entity by IDs.
+func (cu *CarUpdate) AddIncidentIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddIncidentIDs(ids...)
+	return cu
+}
+
+// AddIncidents adds the "incidents" edges to the Incident entity.
+func (cu *CarUpdate) AddIncidents(i ...*Incident) *CarUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
 	}
-	if value, ok := cuo.mutation.RegisteredAt(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: car.FieldRegisteredAt,
-		})
+	return cu.AddIncidentIDs(ids...)
+}
+
+// AddLeaseContractIDs adds the "lease_contracts" edge to the LeaseContract entity by IDs.
+func (cu *CarUpdate) AddLeaseContractIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddLeaseContractIDs(ids...)
+	return cu
+}
+
+// AddLeaseContracts adds the "lease_contracts" edges to the LeaseContract entity.
+func (cu *CarUpdate) AddLeaseContracts(l ...*LeaseContract) *CarUpdate {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cu.AddLeaseContractIDs(ids...)
+}
+
+// AddChargingSessionIDs adds the "charging_sessions" edge to the ChargingSession entity by IDs.
+func (cu *CarUpdate) AddChargingSessionIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddChargingSessionIDs(ids...)
+	return cu
+}
+
+// AddChargingSessions adds the "charging_sessions" edges to the ChargingSession entity.
+func (cu *CarUpdate) AddChargingSessions(c ...*ChargingSession) *CarUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddChargingSessionIDs(ids...)
+}
+
+// AddGeofenceIDs adds the "geofences" edge to the Geofence entity by IDs.
+func (cu *CarUpdate) AddGeofenceIDs(ids ...int64) *CarUpdate {
+	cu.mutation.AddGeofenceIDs(ids...)
+	return cu
+}
+
+// AddGeofences adds the "geofences" edges to the Geofence entity.
+func (cu *CarUpdate) AddGeofences(g ...*Geofence) *CarUpdate {
+	ids := make([]int64, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return cu.AddGeofenceIDs(ids...)
+}
+
+// Mutation returns the CarMutation object of the builder.
+func (cu *CarUpdate) Mutation() *CarMutation {
+	return cu.mutation
+}
+
+// ClearVehicleModel clears the "vehicle_model" edge to the VehicleModel entity.
+func (cu *CarUpdate) ClearVehicleModel() *CarUpdate {
+	cu.mutation.ClearVehicleModel()
+	return cu
+}
+
+// ClearMaintenanceRecords clears all "maintenance_records" edges to the MaintenanceRecord entity.
+func (cu *CarUpdate) ClearMaintenanceRecords() *CarUpdate {
+	cu.mutation.ClearMaintenanceRecords()
+	return cu
+}
+
+// RemoveMaintenanceRecordIDs removes the "maintenance_records" edge to MaintenanceRecord entities by IDs.
+func (cu *CarUpdate) RemoveMaintenanceRecordIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveMaintenanceRecordIDs(ids...)
+	return cu
+}
+
+// RemoveMaintenanceRecords removes "maintenance_records" edges to MaintenanceRecord entities.
+func (cu *CarUpdate) RemoveMaintenanceRecords(m ...*MaintenanceRecord) *CarUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cu.RemoveMaintenanceRecordIDs(ids...)
+}
+
+// ClearInsurancePolicies clears all "insurance_policies" edges to the InsurancePolicy entity.
+func (cu *CarUpdate) ClearInsurancePolicies() *CarUpdate {
+	cu.mutation.ClearInsurancePolicies()
+	return cu
+}
+
+// RemoveInsurancePolicyIDs removes the "insurance_policies" edge to InsurancePolicy entities by IDs.
+func (cu *CarUpdate) RemoveInsurancePolicyIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveInsurancePolicyIDs(ids...)
+	return cu
+}
+
+// RemoveInsurancePolicies removes "insurance_policies" edges to InsurancePolicy entities.
+func (cu *CarUpdate) RemoveInsurancePolicies(i ...*InsurancePolicy) *CarUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cu.RemoveInsurancePolicyIDs(ids...)
+}
+
+// ClearTransfers clears all "transfers" edges to the Transfer entity.
+func (cu *CarUpdate) ClearTransfers() *CarUpdate {
+	cu.mutation.ClearTransfers()
+	return cu
+}
+
+// RemoveTransferIDs removes the "transfers" edge to Transfer entities by IDs.
+func (cu *CarUpdate) RemoveTransferIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveTransferIDs(ids...)
+	return cu
+}
+
+// RemoveTransfers removes "transfers" edges to Transfer entities.
+func (cu *CarUpdate) RemoveTransfers(t ...*Transfer) *CarUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cu.RemoveTransferIDs(ids...)
+}
+
+// ClearOdometerReadings clears all "odometer_readings" edges to the OdometerReading entity.
+func (cu *CarUpdate) ClearOdometerReadings() *CarUpdate {
+	cu.mutation.ClearOdometerReadings()
+	return cu
+}
+
+// RemoveOdometerReadingIDs removes the "odometer_readings" edge to OdometerReading entities by IDs.
+func (cu *CarUpdate) RemoveOdometerReadingIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveOdometerReadingIDs(ids...)
+	return cu
+}
+
+// RemoveOdometerReadings removes "odometer_readings" edges to OdometerReading entities.
+func (cu *CarUpdate) RemoveOdometerReadings(o ...*OdometerReading) *CarUpdate {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return cu.RemoveOdometerReadingIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (cu *CarUpdate) ClearAttachments() *CarUpdate {
+	cu.mutation.ClearAttachments()
+	return cu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (cu *CarUpdate) RemoveAttachmentIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveAttachmentIDs(ids...)
+	return cu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (cu *CarUpdate) RemoveAttachments(a ...*Attachment) *CarUpdate {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearFleets clears all "fleets" edges to the Fleet entity.
+func (cu *CarUpdate) ClearFleets() *CarUpdate {
+	cu.mutation.ClearFleets()
+	return cu
+}
+
+// RemoveFleetIDs removes the "fleets" edge to Fleet entities by IDs.
+func (cu *CarUpdate) RemoveFleetIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveFleetIDs(ids...)
+	return cu
+}
+
+// RemoveFleets removes "fleets" edges to Fleet entities.
+func (cu *CarUpdate) RemoveFleets(f ...*Fleet) *CarUpdate {
+	ids := make([]int64, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cu.RemoveFleetIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (cu *CarUpdate) ClearTags() *CarUpdate {
+	cu.mutation.ClearTags()
+	return cu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (cu *CarUpdate) RemoveTagIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveTagIDs(ids...)
+	return cu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (cu *CarUpdate) RemoveTags(t ...*Tag) *CarUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cu.RemoveTagIDs(ids...)
+}
+
+// ClearAttributes clears all "attributes" edges to the CarAttribute entity.
+func (cu *CarUpdate) ClearAttributes() *CarUpdate {
+	cu.mutation.ClearAttributes()
+	return cu
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to CarAttribute entities by IDs.
+func (cu *CarUpdate) RemoveAttributeIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveAttributeIDs(ids...)
+	return cu
+}
+
+// RemoveAttributes removes "attributes" edges to CarAttribute entities.
+func (cu *CarUpdate) RemoveAttributes(c ...*CarAttribute) *CarUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveAttributeIDs(ids...)
+}
+
+// ClearReservations clears all "reservations" edges to the Reservation entity.
+func (cu *CarUpdate) ClearReservations() *CarUpdate {
+	cu.mutation.ClearReservations()
+	return cu
+}
+
+// RemoveReservationIDs removes the "reservations" edge to Reservation entities by IDs.
+func (cu *CarUpdate) RemoveReservationIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveReservationIDs(ids...)
+	return cu
+}
+
+// RemoveReservations removes "reservations" edges to Reservation entities.
+func (cu *CarUpdate) RemoveReservations(r ...*Reservation) *CarUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cu.RemoveReservationIDs(ids...)
+}
+
+// ClearTrips clears all "trips" edges to the Trip entity.
+func (cu *CarUpdate) ClearTrips() *CarUpdate {
+	cu.mutation.ClearTrips()
+	return cu
+}
+
+// RemoveTripIDs removes the "trips" edge to Trip entities by IDs.
+func (cu *CarUpdate) RemoveTripIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveTripIDs(ids...)
+	return cu
+}
+
+// RemoveTrips removes "trips" edges to Trip entities.
+func (cu *CarUpdate) RemoveTrips(t ...*Trip) *CarUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cu.RemoveTripIDs(ids...)
+}
+
+// ClearRefuels clears all "refuels" edges to the Refuel entity.
+func (cu *CarUpdate) ClearRefuels() *CarUpdate {
+	cu.mutation.ClearRefuels()
+	return cu
+}
+
+// RemoveRefuelIDs removes the "refuels" edge to Refuel entities by IDs.
+func (cu *CarUpdate) RemoveRefuelIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveRefuelIDs(ids...)
+	return cu
+}
+
+// RemoveRefuels removes "refuels" edges to Refuel entities.
+func (cu *CarUpdate) RemoveRefuels(r ...*Refuel) *CarUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cu.RemoveRefuelIDs(ids...)
+}
+
+// ClearRecalls clears all "recalls" edges to the CarRecall entity.
+func (cu *CarUpdate) ClearRecalls() *CarUpdate {
+	cu.mutation.ClearRecalls()
+	return cu
+}
+
+// RemoveRecallIDs removes the "recalls" edge to CarRecall entities by IDs.
+func (cu *CarUpdate) RemoveRecallIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveRecallIDs(ids...)
+	return cu
+}
+
+// RemoveRecalls removes "recalls" edges to CarRecall entities.
+func (cu *CarUpdate) RemoveRecalls(c ...*CarRecall) *CarUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveRecallIDs(ids...)
+}
+
+// ClearOwnerHistories clears all "owner_histories" edges to the OwnerHistory entity.
+func (cu *CarUpdate) ClearOwnerHistories() *CarUpdate {
+	cu.mutation.ClearOwnerHistories()
+	return cu
+}
+
+// RemoveOwnerHistoryIDs removes the "owner_histories" edge to OwnerHistory entities by IDs.
+func (cu *CarUpdate) RemoveOwnerHistoryIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveOwnerHistoryIDs(ids...)
+	return cu
+}
+
+// RemoveOwnerHistories removes "owner_histories" edges to OwnerHistory entities.
+func (cu *CarUpdate) RemoveOwnerHistories(o ...*OwnerHistory) *CarUpdate {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return cu.RemoveOwnerHistoryIDs(ids...)
+}
+
+// ClearViolations clears all "violations" edges to the Violation entity.
+func (cu *CarUpdate) ClearViolations() *CarUpdate {
+	cu.mutation.ClearViolations()
+	return cu
+}
+
+// RemoveViolationIDs removes the "violations" edge to Violation entities by IDs.
+func (cu *CarUpdate) RemoveViolationIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveViolationIDs(ids...)
+	return cu
+}
+
+// RemoveViolations removes "violations" edges to Violation entities.
+func (cu *CarUpdate) RemoveViolations(v ...*Violation) *CarUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return cu.RemoveViolationIDs(ids...)
+}
+
+// ClearTradeRecords clears all "trade_records" edges to the TradeRecord entity.
+func (cu *CarUpdate) ClearTradeRecords() *CarUpdate {
+	cu.mutation.ClearTradeRecords()
+	return cu
+}
+
+// RemoveTradeRecordIDs removes the "trade_records" edge to TradeRecord entities by IDs.
+func (cu *CarUpdate) RemoveTradeRecordIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveTradeRecordIDs(ids...)
+	return cu
+}
+
+// RemoveTradeRecords removes "trade_records" edges to TradeRecord entities.
+func (cu *CarUpdate) RemoveTradeRecords(t ...*TradeRecord) *CarUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cu.RemoveTradeRecordIDs(ids...)
+}
+
+// ClearListings clears all "listings" edges to the Listing entity.
+func (cu *CarUpdate) ClearListings() *CarUpdate {
+	cu.mutation.ClearListings()
+	return cu
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (cu *CarUpdate) RemoveListingIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveListingIDs(ids...)
+	return cu
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (cu *CarUpdate) RemoveListings(l ...*Listing) *CarUpdate {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cu.RemoveListingIDs(ids...)
+}
+
+// ClearWarranties clears all "warranties" edges to the CarWarranty entity.
+func (cu *CarUpdate) ClearWarranties() *CarUpdate {
+	cu.mutation.ClearWarranties()
+	return cu
+}
+
+// RemoveWarrantyIDs removes the "warranties" edge to CarWarranty entities by IDs.
+func (cu *CarUpdate) RemoveWarrantyIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveWarrantyIDs(ids...)
+	return cu
+}
+
+// RemoveWarranties removes "warranties" edges to CarWarranty entities.
+func (cu *CarUpdate) RemoveWarranties(c ...*CarWarranty) *CarUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveWarrantyIDs(ids...)
+}
+
+// ClearIncidents clears all "incidents" edges to the Incident entity.
+func (cu *CarUpdate) ClearIncidents() *CarUpdate {
+	cu.mutation.ClearIncidents()
+	return cu
+}
+
+// RemoveIncidentIDs removes the "incidents" edge to Incident entities by IDs.
+func (cu *CarUpdate) RemoveIncidentIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveIncidentIDs(ids...)
+	return cu
+}
+
+// RemoveIncidents removes "incidents" edges to Incident entities.
+func (cu *CarUpdate) RemoveIncidents(i ...*Incident) *CarUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cu.RemoveIncidentIDs(ids...)
+}
+
+// ClearLeaseContracts clears all "lease_contracts" edges to the LeaseContract entity.
+func (cu *CarUpdate) ClearLeaseContracts() *CarUpdate {
+	cu.mutation.ClearLeaseContracts()
+	return cu
+}
+
+// RemoveLeaseContractIDs removes the "lease_contracts" edge to LeaseContract entities by IDs.
+func (cu *CarUpdate) RemoveLeaseContractIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveLeaseContractIDs(ids...)
+	return cu
+}
+
+// RemoveLeaseContracts removes "lease_contracts" edges to LeaseContract entities.
+func (cu *CarUpdate) RemoveLeaseContracts(l ...*LeaseContract) *CarUpdate {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cu.RemoveLeaseContractIDs(ids...)
+}
+
+// ClearChargingSessions clears all "charging_sessions" edges to the ChargingSession entity.
+func (cu *CarUpdate) ClearChargingSessions() *CarUpdate {
+	cu.mutation.ClearChargingSessions()
+	return cu
+}
+
+// RemoveChargingSessionIDs removes the "charging_sessions" edge to ChargingSession entities by IDs.
+func (cu *CarUpdate) RemoveChargingSessionIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveChargingSessionIDs(ids...)
+	return cu
+}
+
+// RemoveChargingSessions removes "charging_sessions" edges to ChargingSession entities.
+func (cu *CarUpdate) RemoveChargingSessions(c ...*ChargingSession) *CarUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveChargingSessionIDs(ids...)
+}
+
+// ClearGeofences clears all "geofences" edges to the Geofence entity.
+func (cu *CarUpdate) ClearGeofences() *CarUpdate {
+	cu.mutation.ClearGeofences()
+	return cu
+}
+
+// RemoveGeofenceIDs removes the "geofences" edge to Geofence entities by IDs.
+func (cu *CarUpdate) RemoveGeofenceIDs(ids ...int64) *CarUpdate {
+	cu.mutation.RemoveGeofenceIDs(ids...)
+	return cu
+}
+
+// RemoveGeofences removes "geofences" edges to Geofence entities.
+func (cu *CarUpdate) RemoveGeofences(g ...*Geofence) *CarUpdate {
+	ids := make([]int64, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return cu.RemoveGeofenceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CarUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cu.hooks) == 0 {
+		affected, err = cu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cu.mutation = mutation
+			affected, err = cu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cu.hooks) - 1; i >= 0; i-- {
+			if cu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CarUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CarUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CarUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   car.Table,
+			Columns: car.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: car.FieldID,
+			},
+		},
+	}
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: car.FieldTenantID,
+		})
+	}
+	if value, ok := cu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: car.FieldTenantID,
+		})
+	}
+	if cu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: car.FieldTenantID,
+		})
+	}
+	if value, ok := cu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: car.FieldUserID,
+		})
+	}
+	if value, ok := cu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: car.FieldUserID,
+		})
+	}
+	if cu.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: car.FieldUserID,
+		})
+	}
+	if value, ok := cu.mutation.Model(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldModel,
+		})
+	}
+	if cu.mutation.ModelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: car.FieldModel,
+		})
+	}
+	if value, ok := cu.mutation.Vin(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldVin,
+		})
+	}
+	if cu.mutation.VinCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: car.FieldVin,
+		})
+	}
+	if value, ok := cu.mutation.Plate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldPlate,
+		})
+	}
+	if cu.mutation.PlateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: car.FieldPlate,
+		})
+	}
+	if value, ok := cu.mutation.OwnerName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldOwnerName,
+		})
+	}
+	if cu.mutation.OwnerNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: car.FieldOwnerName,
+		})
+	}
+	if value, ok := cu.mutation.RegisteredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: car.FieldRegisteredAt,
+		})
+	}
+	if value, ok := cu.mutation.BatteryCapacity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatteryCapacity,
+		})
+	}
+	if value, ok := cu.mutation.AddedBatteryCapacity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatteryCapacity,
+		})
+	}
+	if cu.mutation.BatteryCapacityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: car.FieldBatteryCapacity,
+		})
+	}
+	if value, ok := cu.mutation.BatterySoh(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatterySoh,
+		})
+	}
+	if value, ok := cu.mutation.AddedBatterySoh(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatterySoh,
+		})
+	}
+	if cu.mutation.BatterySohCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: car.FieldBatterySoh,
+		})
+	}
+	if cu.mutation.VehicleModelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.VehicleModelTable,
+			Columns: []string{car.VehicleModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.VehicleModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.VehicleModelTable,
+			Columns: []string{car.VehicleModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.MaintenanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceRecordsTable,
+			Columns: []string{car.MaintenanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: maintenancerecord.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedMaintenanceRecordsIDs(); len(nodes) > 0 && !cu.mutation.MaintenanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceRecordsTable,
+			Columns: []string{car.MaintenanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: maintenancerecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.MaintenanceRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceRecordsTable,
+			Columns: []string{car.MaintenanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: maintenancerecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.InsurancePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.InsurancePoliciesTable,
+			Columns: []string{car.InsurancePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedInsurancePoliciesIDs(); len(nodes) > 0 && !cu.mutation.InsurancePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.InsurancePoliciesTable,
+			Columns: []string{car.InsurancePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.InsurancePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.InsurancePoliciesTable,
+			Columns: []string{car.InsurancePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TransfersTable,
+			Columns: []string{car.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: transfer.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedTransfersIDs(); len(nodes) > 0 && !cu.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TransfersTable,
+			Columns: []string{car.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: transfer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.TransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TransfersTable,
+			Columns: []string{car.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: transfer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.OdometerReadingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OdometerReadingsTable,
+			Columns: []string{car.OdometerReadingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: odometerreading.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedOdometerReadingsIDs(); len(nodes) > 0 && !cu.mutation.OdometerReadingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OdometerReadingsTable,
+			Columns: []string{car.OdometerReadingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: odometerreading.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.OdometerReadingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OdometerReadingsTable,
+			Columns: []string{car.OdometerReadingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: odometerreading.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttachmentsTable,
+			Columns: []string{car.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !cu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttachmentsTable,
+			Columns: []string{car.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttachmentsTable,
+			Columns: []string{car.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.FleetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.FleetsTable,
+			Columns: car.FleetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: fleet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedFleetsIDs(); len(nodes) > 0 && !cu.mutation.FleetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.FleetsTable,
+			Columns: car.FleetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: fleet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.FleetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.FleetsTable,
+			Columns: car.FleetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: fleet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.TagsTable,
+			Columns: car.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !cu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.TagsTable,
+			Columns: car.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.TagsTable,
+			Columns: car.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttributesTable,
+			Columns: []string{car.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedAttributesIDs(); len(nodes) > 0 && !cu.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttributesTable,
+			Columns: []string{car.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttributesTable,
+			Columns: []string{car.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ReservationsTable,
+			Columns: []string{car.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !cu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ReservationsTable,
+			Columns: []string{car.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ReservationsTable,
+			Columns: []string{car.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.TripsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TripsTable,
+			Columns: []string{car.TripsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: trip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedTripsIDs(); len(nodes) > 0 && !cu.mutation.TripsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TripsTable,
+			Columns: []string{car.TripsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: trip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.TripsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TripsTable,
+			Columns: []string{car.TripsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: trip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.RefuelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RefuelsTable,
+			Columns: []string{car.RefuelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: refuel.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedRefuelsIDs(); len(nodes) > 0 && !cu.mutation.RefuelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RefuelsTable,
+			Columns: []string{car.RefuelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: refuel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RefuelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RefuelsTable,
+			Columns: []string{car.RefuelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: refuel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.RecallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RecallsTable,
+			Columns: []string{car.RecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedRecallsIDs(); len(nodes) > 0 && !cu.mutation.RecallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RecallsTable,
+			Columns: []string{car.RecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RecallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RecallsTable,
+			Columns: []string{car.RecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.OwnerHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OwnerHistoriesTable,
+			Columns: []string{car.OwnerHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: ownerhistory.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedOwnerHistoriesIDs(); len(nodes) > 0 && !cu.mutation.OwnerHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OwnerHistoriesTable,
+			Columns: []string{car.OwnerHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: ownerhistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.OwnerHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OwnerHistoriesTable,
+			Columns: []string{car.OwnerHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: ownerhistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ViolationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ViolationsTable,
+			Columns: []string{car.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: violation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedViolationsIDs(); len(nodes) > 0 && !cu.mutation.ViolationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ViolationsTable,
+			Columns: []string{car.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: violation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ViolationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ViolationsTable,
+			Columns: []string{car.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: violation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.TradeRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TradeRecordsTable,
+			Columns: []string{car.TradeRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: traderecord.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedTradeRecordsIDs(); len(nodes) > 0 && !cu.mutation.TradeRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TradeRecordsTable,
+			Columns: []string{car.TradeRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: traderecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.TradeRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TradeRecordsTable,
+			Columns: []string{car.TradeRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: traderecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ListingsTable,
+			Columns: []string{car.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: listing.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedListingsIDs(); len(nodes) > 0 && !cu.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ListingsTable,
+			Columns: []string{car.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: listing.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ListingsTable,
+			Columns: []string{car.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: listing.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.WarrantiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.WarrantiesTable,
+			Columns: []string{car.WarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedWarrantiesIDs(); len(nodes) > 0 && !cu.mutation.WarrantiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.WarrantiesTable,
+			Columns: []string{car.WarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.WarrantiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.WarrantiesTable,
+			Columns: []string{car.WarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.IncidentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.IncidentsTable,
+			Columns: []string{car.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedIncidentsIDs(); len(nodes) > 0 && !cu.mutation.IncidentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.IncidentsTable,
+			Columns: []string{car.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.IncidentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.IncidentsTable,
+			Columns: []string{car.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.LeaseContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.LeaseContractsTable,
+			Columns: []string{car.LeaseContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: leasecontract.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedLeaseContractsIDs(); len(nodes) > 0 && !cu.mutation.LeaseContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.LeaseContractsTable,
+			Columns: []string{car.LeaseContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: leasecontract.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.LeaseContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.LeaseContractsTable,
+			Columns: []string{car.LeaseContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: leasecontract.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ChargingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ChargingSessionsTable,
+			Columns: []string{car.ChargingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: chargingsession.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedChargingSessionsIDs(); len(nodes) > 0 && !cu.mutation.ChargingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ChargingSessionsTable,
+			Columns: []string{car.ChargingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: chargingsession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ChargingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ChargingSessionsTable,
+			Columns: []string{car.ChargingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: chargingsession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.GeofencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.GeofencesTable,
+			Columns: car.GeofencesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: geofence.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedGeofencesIDs(); len(nodes) > 0 && !cu.mutation.GeofencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.GeofencesTable,
+			Columns: car.GeofencesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: geofence.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.GeofencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.GeofencesTable,
+			Columns: car.GeofencesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: geofence.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{car.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CarUpdateOne is the builder for updating a single Car entity.
+type CarUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CarMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cuo *CarUpdateOne) SetTenantID(i int64) *CarUpdateOne {
+	cuo.mutation.ResetTenantID()
+	cuo.mutation.SetTenantID(i)
+	return cuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableTenantID(i *int64) *CarUpdateOne {
+	if i != nil {
+		cuo.SetTenantID(*i)
+	}
+	return cuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (cuo *CarUpdateOne) AddTenantID(i int64) *CarUpdateOne {
+	cuo.mutation.AddTenantID(i)
+	return cuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (cuo *CarUpdateOne) ClearTenantID() *CarUpdateOne {
+	cuo.mutation.ClearTenantID()
+	return cuo
+}
+
+// SetUserID sets the "user_id" field.
+func (cuo *CarUpdateOne) SetUserID(i int64) *CarUpdateOne {
+	cuo.mutation.ResetUserID()
+	cuo.mutation.SetUserID(i)
+	return cuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableUserID(i *int64) *CarUpdateOne {
+	if i != nil {
+		cuo.SetUserID(*i)
+	}
+	return cuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (cuo *CarUpdateOne) AddUserID(i int64) *CarUpdateOne {
+	cuo.mutation.AddUserID(i)
+	return cuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (cuo *CarUpdateOne) ClearUserID() *CarUpdateOne {
+	cuo.mutation.ClearUserID()
+	return cuo
+}
+
+// SetModel sets the "model" field.
+func (cuo *CarUpdateOne) SetModel(s string) *CarUpdateOne {
+	cuo.mutation.SetModel(s)
+	return cuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableModel(s *string) *CarUpdateOne {
+	if s != nil {
+		cuo.SetModel(*s)
+	}
+	return cuo
+}
+
+// ClearModel clears the value of the "model" field.
+func (cuo *CarUpdateOne) ClearModel() *CarUpdateOne {
+	cuo.mutation.ClearModel()
+	return cuo
+}
+
+// SetModelID sets the "model_id" field.
+func (cuo *CarUpdateOne) SetModelID(i int64) *CarUpdateOne {
+	cuo.mutation.SetModelID(i)
+	return cuo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableModelID(i *int64) *CarUpdateOne {
+	if i != nil {
+		cuo.SetModelID(*i)
+	}
+	return cuo
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (cuo *CarUpdateOne) ClearModelID() *CarUpdateOne {
+	cuo.mutation.ClearModelID()
+	return cuo
+}
+
+// SetVin sets the "vin" field.
+func (cuo *CarUpdateOne) SetVin(s string) *CarUpdateOne {
+	cuo.mutation.SetVin(s)
+	return cuo
+}
+
+// SetNillableVin sets the "vin" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableVin(s *string) *CarUpdateOne {
+	if s != nil {
+		cuo.SetVin(*s)
+	}
+	return cuo
+}
+
+// ClearVin clears the value of the "vin" field.
+func (cuo *CarUpdateOne) ClearVin() *CarUpdateOne {
+	cuo.mutation.ClearVin()
+	return cuo
+}
+
+// SetPlate sets the "plate" field.
+func (cuo *CarUpdateOne) SetPlate(s string) *CarUpdateOne {
+	cuo.mutation.SetPlate(s)
+	return cuo
+}
+
+// SetNillablePlate sets the "plate" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillablePlate(s *string) *CarUpdateOne {
+	if s != nil {
+		cuo.SetPlate(*s)
+	}
+	return cuo
+}
+
+// ClearPlate clears the value of the "plate" field.
+func (cuo *CarUpdateOne) ClearPlate() *CarUpdateOne {
+	cuo.mutation.ClearPlate()
+	return cuo
+}
+
+// SetOwnerName sets the "owner_name" field.
+func (cuo *CarUpdateOne) SetOwnerName(s string) *CarUpdateOne {
+	cuo.mutation.SetOwnerName(s)
+	return cuo
+}
+
+// SetNillableOwnerName sets the "owner_name" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableOwnerName(s *string) *CarUpdateOne {
+	if s != nil {
+		cuo.SetOwnerName(*s)
+	}
+	return cuo
+}
+
+// ClearOwnerName clears the value of the "owner_name" field.
+func (cuo *CarUpdateOne) ClearOwnerName() *CarUpdateOne {
+	cuo.mutation.ClearOwnerName()
+	return cuo
+}
+
+// SetRegisteredAt sets the "registered_at" field.
+func (cuo *CarUpdateOne) SetRegisteredAt(t time.Time) *CarUpdateOne {
+	cuo.mutation.SetRegisteredAt(t)
+	return cuo
+}
+
+// SetNillableRegisteredAt sets the "registered_at" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableRegisteredAt(t *time.Time) *CarUpdateOne {
+	if t != nil {
+		cuo.SetRegisteredAt(*t)
+	}
+	return cuo
+}
+
+// SetBatteryCapacity sets the "battery_capacity" field.
+func (cuo *CarUpdateOne) SetBatteryCapacity(f float64) *CarUpdateOne {
+	cuo.mutation.ResetBatteryCapacity()
+	cuo.mutation.SetBatteryCapacity(f)
+	return cuo
+}
+
+// SetNillableBatteryCapacity sets the "battery_capacity" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableBatteryCapacity(f *float64) *CarUpdateOne {
+	if f != nil {
+		cuo.SetBatteryCapacity(*f)
+	}
+	return cuo
+}
+
+// AddBatteryCapacity adds f to the "battery_capacity" field.
+func (cuo *CarUpdateOne) AddBatteryCapacity(f float64) *CarUpdateOne {
+	cuo.mutation.AddBatteryCapacity(f)
+	return cuo
+}
+
+// ClearBatteryCapacity clears the value of the "battery_capacity" field.
+func (cuo *CarUpdateOne) ClearBatteryCapacity() *CarUpdateOne {
+	cuo.mutation.ClearBatteryCapacity()
+	return cuo
+}
+
+// SetBatterySoh sets the "battery_soh" field.
+func (cuo *CarUpdateOne) SetBatterySoh(f float64) *CarUpdateOne {
+	cuo.mutation.ResetBatterySoh()
+	cuo.mutation.SetBatterySoh(f)
+	return cuo
+}
+
+// SetNillableBatterySoh sets the "battery_soh" field if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableBatterySoh(f *float64) *CarUpdateOne {
+	if f != nil {
+		cuo.SetBatterySoh(*f)
+	}
+	return cuo
+}
+
+// AddBatterySoh adds f to the "battery_soh" field.
+func (cuo *CarUpdateOne) AddBatterySoh(f float64) *CarUpdateOne {
+	cuo.mutation.AddBatterySoh(f)
+	return cuo
+}
+
+// ClearBatterySoh clears the value of the "battery_soh" field.
+func (cuo *CarUpdateOne) ClearBatterySoh() *CarUpdateOne {
+	cuo.mutation.ClearBatterySoh()
+	return cuo
+}
+
+// SetVehicleModelID sets the "vehicle_model" edge to the VehicleModel entity by ID.
+func (cuo *CarUpdateOne) SetVehicleModelID(id int64) *CarUpdateOne {
+	cuo.mutation.SetVehicleModelID(id)
+	return cuo
+}
+
+// SetNillableVehicleModelID sets the "vehicle_model" edge to the VehicleModel entity by ID if the given value is not nil.
+func (cuo *CarUpdateOne) SetNillableVehicleModelID(id *int64) *CarUpdateOne {
+	if id != nil {
+		cuo = cuo.SetVehicleModelID(*id)
+	}
+	return cuo
+}
+
+// SetVehicleModel sets the "vehicle_model" edge to the VehicleModel entity.
+func (cuo *CarUpdateOne) SetVehicleModel(v *VehicleModel) *CarUpdateOne {
+	return cuo.SetVehicleModelID(v.ID)
+}
+
+// AddMaintenanceRecordIDs adds the "maintenance_records" edge to the MaintenanceRecord entity by IDs.
+func (cuo *CarUpdateOne) AddMaintenanceRecordIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddMaintenanceRecordIDs(ids...)
+	return cuo
+}
+
+// AddMaintenanceRecords adds the "maintenance_records" edges to the MaintenanceRecord entity.
+func (cuo *CarUpdateOne) AddMaintenanceRecords(m ...*MaintenanceRecord) *CarUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cuo.AddMaintenanceRecordIDs(ids...)
+}
+
+// AddInsurancePolicyIDs adds the "insurance_policies" edge to the InsurancePolicy entity by IDs.
+func (cuo *CarUpdateOne) AddInsurancePolicyIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddInsurancePolicyIDs(ids...)
+	return cuo
+}
+
+// AddInsurancePolicies adds the "insurance_policies" edges to the InsurancePolicy entity.
+func (cuo *CarUpdateOne) AddInsurancePolicies(i ...*InsurancePolicy) *CarUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cuo.AddInsurancePolicyIDs(ids...)
+}
+
+// AddTransferIDs adds the "transfers" edge to the Transfer entity by IDs.
+func (cuo *CarUpdateOne) AddTransferIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddTransferIDs(ids...)
+	return cuo
+}
+
+// AddTransfers adds the "transfers" edges to the Transfer entity.
+func (cuo *CarUpdateOne) AddTransfers(t ...*Transfer) *CarUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.AddTransferIDs(ids...)
+}
+
+// AddOdometerReadingIDs adds the "odometer_readings" edge to the OdometerReading entity by IDs.
+func (cuo *CarUpdateOne) AddOdometerReadingIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddOdometerReadingIDs(ids...)
+	return cuo
+}
+
+// AddOdometerReadings adds the "odometer_readings" edges to the OdometerReading entity.
+func (cuo *CarUpdateOne) AddOdometerReadings(o ...*OdometerReading) *CarUpdateOne {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return cuo.AddOdometerReadingIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (cuo *CarUpdateOne) AddAttachmentIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddAttachmentIDs(ids...)
+	return cuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (cuo *CarUpdateOne) AddAttachments(a ...*Attachment) *CarUpdateOne {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cuo.AddAttachmentIDs(ids...)
+}
+
+// AddFleetIDs adds the "fleets" edge to the Fleet entity by IDs.
+func (cuo *CarUpdateOne) AddFleetIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddFleetIDs(ids...)
+	return cuo
+}
+
+// AddFleets adds the "fleets" edges to the Fleet entity.
+func (cuo *CarUpdateOne) AddFleets(f ...*Fleet) *CarUpdateOne {
+	ids := make([]int64, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cuo.AddFleetIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (cuo *CarUpdateOne) AddTagIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddTagIDs(ids...)
+	return cuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (cuo *CarUpdateOne) AddTags(t ...*Tag) *CarUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.AddTagIDs(ids...)
+}
+
+// AddAttributeIDs adds the "attributes" edge to the CarAttribute entity by IDs.
+func (cuo *CarUpdateOne) AddAttributeIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddAttributeIDs(ids...)
+	return cuo
+}
+
+// AddAttributes adds the "attributes" edges to the CarAttribute entity.
+func (cuo *CarUpdateOne) AddAttributes(c ...*CarAttribute) *CarUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddAttributeIDs(ids...)
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (cuo *CarUpdateOne) AddReservationIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddReservationIDs(ids...)
+	return cuo
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (cuo *CarUpdateOne) AddReservations(r ...*Reservation) *CarUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cuo.AddReservationIDs(ids...)
+}
+
+// AddTripIDs adds the "trips" edge to the Trip entity by IDs.
+func (cuo *CarUpdateOne) AddTripIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddTripIDs(ids...)
+	return cuo
+}
+
+// AddTrips adds the "trips" edges to the Trip entity.
+func (cuo *CarUpdateOne) AddTrips(t ...*Trip) *CarUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.AddTripIDs(ids...)
+}
+
+// AddRefuelIDs adds the "refuels" edge to the Refuel entity by IDs.
+func (cuo *CarUpdateOne) AddRefuelIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddRefuelIDs(ids...)
+	return cuo
+}
+
+// AddRefuels adds the "refuels" edges to the Refuel entity.
+func (cuo *CarUpdateOne) AddRefuels(r ...*Refuel) *CarUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cuo.AddRefuelIDs(ids...)
+}
+
+// AddRecallIDs adds the "recalls" edge to the CarRecall entity by IDs.
+func (cuo *CarUpdateOne) AddRecallIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddRecallIDs(ids...)
+	return cuo
+}
+
+// AddRecalls adds the "recalls" edges to the CarRecall entity.
+func (cuo *CarUpdateOne) AddRecalls(c ...*CarRecall) *CarUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddRecallIDs(ids...)
+}
+
+// AddOwnerHistoryIDs adds the "owner_histories" edge to the OwnerHistory entity by IDs.
+func (cuo *CarUpdateOne) AddOwnerHistoryIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddOwnerHistoryIDs(ids...)
+	return cuo
+}
+
+// AddOwnerHistories adds the "owner_histories" edges to the OwnerHistory entity.
+func (cuo *CarUpdateOne) AddOwnerHistories(o ...*OwnerHistory) *CarUpdateOne {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return cuo.AddOwnerHistoryIDs(ids...)
+}
+
+// AddViolationIDs adds the "violations" edge to the Violation entity by IDs.
+func (cuo *CarUpdateOne) AddViolationIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddViolationIDs(ids...)
+	return cuo
+}
+
+// AddViolations adds the "violations" edges to the Violation entity.
+func (cuo *CarUpdateOne) AddViolations(v ...*Violation) *CarUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return cuo.AddViolationIDs(ids...)
+}
+
+// AddTradeRecordIDs adds the "trade_records" edge to the TradeRecord entity by IDs.
+func (cuo *CarUpdateOne) AddTradeRecordIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddTradeRecordIDs(ids...)
+	return cuo
+}
+
+// AddTradeRecords adds the "trade_records" edges to the TradeRecord entity.
+func (cuo *CarUpdateOne) AddTradeRecords(t ...*TradeRecord) *CarUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.AddTradeRecordIDs(ids...)
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (cuo *CarUpdateOne) AddListingIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddListingIDs(ids...)
+	return cuo
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (cuo *CarUpdateOne) AddListings(l ...*Listing) *CarUpdateOne {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cuo.AddListingIDs(ids...)
+}
+
+// AddWarrantyIDs adds the "warranties" edge to the CarWarranty entity by IDs.
+func (cuo *CarUpdateOne) AddWarrantyIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddWarrantyIDs(ids...)
+	return cuo
+}
+
+// AddWarranties adds the "warranties" edges to the CarWarranty entity.
+func (cuo *CarUpdateOne) AddWarranties(c ...*CarWarranty) *CarUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddWarrantyIDs(ids...)
+}
+
+// AddIncidentIDs adds the "incidents" edge to the Incident entity by IDs.
+func (cuo *CarUpdateOne) AddIncidentIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddIncidentIDs(ids...)
+	return cuo
+}
+
+// AddIncidents adds the "incidents" edges to the Incident entity.
+func (cuo *CarUpdateOne) AddIncidents(i ...*Incident) *CarUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cuo.AddIncidentIDs(ids...)
+}
+
+// AddLeaseContractIDs adds the "lease_contracts" edge to the LeaseContract entity by IDs.
+func (cuo *CarUpdateOne) AddLeaseContractIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddLeaseContractIDs(ids...)
+	return cuo
+}
+
+// AddLeaseContracts adds the "lease_contracts" edges to the LeaseContract entity.
+func (cuo *CarUpdateOne) AddLeaseContracts(l ...*LeaseContract) *CarUpdateOne {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cuo.AddLeaseContractIDs(ids...)
+}
+
+// AddChargingSessionIDs adds the "charging_sessions" edge to the ChargingSession entity by IDs.
+func (cuo *CarUpdateOne) AddChargingSessionIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddChargingSessionIDs(ids...)
+	return cuo
+}
+
+// AddChargingSessions adds the "charging_sessions" edges to the ChargingSession entity.
+func (cuo *CarUpdateOne) AddChargingSessions(c ...*ChargingSession) *CarUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddChargingSessionIDs(ids...)
+}
+
+// AddGeofenceIDs adds the "geofences" edge to the Geofence entity by IDs.
+func (cuo *CarUpdateOne) AddGeofenceIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.AddGeofenceIDs(ids...)
+	return cuo
+}
+
+// AddGeofences adds the "geofences" edges to the Geofence entity.
+func (cuo *CarUpdateOne) AddGeofences(g ...*Geofence) *CarUpdateOne {
+	ids := make([]int64, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return cuo.AddGeofenceIDs(ids...)
+}
+
+// Mutation returns the CarMutation object of the builder.
+func (cuo *CarUpdateOne) Mutation() *CarMutation {
+	return cuo.mutation
+}
+
+// ClearVehicleModel clears the "vehicle_model" edge to the VehicleModel entity.
+func (cuo *CarUpdateOne) ClearVehicleModel() *CarUpdateOne {
+	cuo.mutation.ClearVehicleModel()
+	return cuo
+}
+
+// ClearMaintenanceRecords clears all "maintenance_records" edges to the MaintenanceRecord entity.
+func (cuo *CarUpdateOne) ClearMaintenanceRecords() *CarUpdateOne {
+	cuo.mutation.ClearMaintenanceRecords()
+	return cuo
+}
+
+// RemoveMaintenanceRecordIDs removes the "maintenance_records" edge to MaintenanceRecord entities by IDs.
+func (cuo *CarUpdateOne) RemoveMaintenanceRecordIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveMaintenanceRecordIDs(ids...)
+	return cuo
+}
+
+// RemoveMaintenanceRecords removes "maintenance_records" edges to MaintenanceRecord entities.
+func (cuo *CarUpdateOne) RemoveMaintenanceRecords(m ...*MaintenanceRecord) *CarUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cuo.RemoveMaintenanceRecordIDs(ids...)
+}
+
+// ClearInsurancePolicies clears all "insurance_policies" edges to the InsurancePolicy entity.
+func (cuo *CarUpdateOne) ClearInsurancePolicies() *CarUpdateOne {
+	cuo.mutation.ClearInsurancePolicies()
+	return cuo
+}
+
+// RemoveInsurancePolicyIDs removes the "insurance_policies" edge to InsurancePolicy entities by IDs.
+func (cuo *CarUpdateOne) RemoveInsurancePolicyIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveInsurancePolicyIDs(ids...)
+	return cuo
+}
+
+// RemoveInsurancePolicies removes "insurance_policies" edges to InsurancePolicy entities.
+func (cuo *CarUpdateOne) RemoveInsurancePolicies(i ...*InsurancePolicy) *CarUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cuo.RemoveInsurancePolicyIDs(ids...)
+}
+
+// ClearTransfers clears all "transfers" edges to the Transfer entity.
+func (cuo *CarUpdateOne) ClearTransfers() *CarUpdateOne {
+	cuo.mutation.ClearTransfers()
+	return cuo
+}
+
+// RemoveTransferIDs removes the "transfers" edge to Transfer entities by IDs.
+func (cuo *CarUpdateOne) RemoveTransferIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveTransferIDs(ids...)
+	return cuo
+}
+
+// RemoveTransfers removes "transfers" edges to Transfer entities.
+func (cuo *CarUpdateOne) RemoveTransfers(t ...*Transfer) *CarUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.RemoveTransferIDs(ids...)
+}
+
+// ClearOdometerReadings clears all "odometer_readings" edges to the OdometerReading entity.
+func (cuo *CarUpdateOne) ClearOdometerReadings() *CarUpdateOne {
+	cuo.mutation.ClearOdometerReadings()
+	return cuo
+}
+
+// RemoveOdometerReadingIDs removes the "odometer_readings" edge to OdometerReading entities by IDs.
+func (cuo *CarUpdateOne) RemoveOdometerReadingIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveOdometerReadingIDs(ids...)
+	return cuo
+}
+
+// RemoveOdometerReadings removes "odometer_readings" edges to OdometerReading entities.
+func (cuo *CarUpdateOne) RemoveOdometerReadings(o ...*OdometerReading) *CarUpdateOne {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return cuo.RemoveOdometerReadingIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (cuo *CarUpdateOne) ClearAttachments() *CarUpdateOne {
+	cuo.mutation.ClearAttachments()
+	return cuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (cuo *CarUpdateOne) RemoveAttachmentIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveAttachmentIDs(ids...)
+	return cuo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (cuo *CarUpdateOne) RemoveAttachments(a ...*Attachment) *CarUpdateOne {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cuo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearFleets clears all "fleets" edges to the Fleet entity.
+func (cuo *CarUpdateOne) ClearFleets() *CarUpdateOne {
+	cuo.mutation.ClearFleets()
+	return cuo
+}
+
+// RemoveFleetIDs removes the "fleets" edge to Fleet entities by IDs.
+func (cuo *CarUpdateOne) RemoveFleetIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveFleetIDs(ids...)
+	return cuo
+}
+
+// RemoveFleets removes "fleets" edges to Fleet entities.
+func (cuo *CarUpdateOne) RemoveFleets(f ...*Fleet) *CarUpdateOne {
+	ids := make([]int64, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cuo.RemoveFleetIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (cuo *CarUpdateOne) ClearTags() *CarUpdateOne {
+	cuo.mutation.ClearTags()
+	return cuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (cuo *CarUpdateOne) RemoveTagIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveTagIDs(ids...)
+	return cuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (cuo *CarUpdateOne) RemoveTags(t ...*Tag) *CarUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.RemoveTagIDs(ids...)
+}
+
+// ClearAttributes clears all "attributes" edges to the CarAttribute entity.
+func (cuo *CarUpdateOne) ClearAttributes() *CarUpdateOne {
+	cuo.mutation.ClearAttributes()
+	return cuo
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to CarAttribute entities by IDs.
+func (cuo *CarUpdateOne) RemoveAttributeIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveAttributeIDs(ids...)
+	return cuo
+}
+
+// RemoveAttributes removes "attributes" edges to CarAttribute entities.
+func (cuo *CarUpdateOne) RemoveAttributes(c ...*CarAttribute) *CarUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveAttributeIDs(ids...)
+}
+
+// ClearReservations clears all "reservations" edges to the Reservation entity.
+func (cuo *CarUpdateOne) ClearReservations() *CarUpdateOne {
+	cuo.mutation.ClearReservations()
+	return cuo
+}
+
+// RemoveReservationIDs removes the "reservations" edge to Reservation entities by IDs.
+func (cuo *CarUpdateOne) RemoveReservationIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveReservationIDs(ids...)
+	return cuo
+}
+
+// RemoveReservations removes "reservations" edges to Reservation entities.
+func (cuo *CarUpdateOne) RemoveReservations(r ...*Reservation) *CarUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cuo.RemoveReservationIDs(ids...)
+}
+
+// ClearTrips clears all "trips" edges to the Trip entity.
+func (cuo *CarUpdateOne) ClearTrips() *CarUpdateOne {
+	cuo.mutation.ClearTrips()
+	return cuo
+}
+
+// RemoveTripIDs removes the "trips" edge to Trip entities by IDs.
+func (cuo *CarUpdateOne) RemoveTripIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveTripIDs(ids...)
+	return cuo
+}
+
+// RemoveTrips removes "trips" edges to Trip entities.
+func (cuo *CarUpdateOne) RemoveTrips(t ...*Trip) *CarUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.RemoveTripIDs(ids...)
+}
+
+// ClearRefuels clears all "refuels" edges to the Refuel entity.
+func (cuo *CarUpdateOne) ClearRefuels() *CarUpdateOne {
+	cuo.mutation.ClearRefuels()
+	return cuo
+}
+
+// RemoveRefuelIDs removes the "refuels" edge to Refuel entities by IDs.
+func (cuo *CarUpdateOne) RemoveRefuelIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveRefuelIDs(ids...)
+	return cuo
+}
+
+// RemoveRefuels removes "refuels" edges to Refuel entities.
+func (cuo *CarUpdateOne) RemoveRefuels(r ...*Refuel) *CarUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cuo.RemoveRefuelIDs(ids...)
+}
+
+// ClearRecalls clears all "recalls" edges to the CarRecall entity.
+func (cuo *CarUpdateOne) ClearRecalls() *CarUpdateOne {
+	cuo.mutation.ClearRecalls()
+	return cuo
+}
+
+// RemoveRecallIDs removes the "recalls" edge to CarRecall entities by IDs.
+func (cuo *CarUpdateOne) RemoveRecallIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveRecallIDs(ids...)
+	return cuo
+}
+
+// RemoveRecalls removes "recalls" edges to CarRecall entities.
+func (cuo *CarUpdateOne) RemoveRecalls(c ...*CarRecall) *CarUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveRecallIDs(ids...)
+}
+
+// ClearOwnerHistories clears all "owner_histories" edges to the OwnerHistory entity.
+func (cuo *CarUpdateOne) ClearOwnerHistories() *CarUpdateOne {
+	cuo.mutation.ClearOwnerHistories()
+	return cuo
+}
+
+// RemoveOwnerHistoryIDs removes the "owner_histories" edge to OwnerHistory entities by IDs.
+func (cuo *CarUpdateOne) RemoveOwnerHistoryIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveOwnerHistoryIDs(ids...)
+	return cuo
+}
+
+// RemoveOwnerHistories removes "owner_histories" edges to OwnerHistory entities.
+func (cuo *CarUpdateOne) RemoveOwnerHistories(o ...*OwnerHistory) *CarUpdateOne {
+	ids := make([]int64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return cuo.RemoveOwnerHistoryIDs(ids...)
+}
+
+// ClearViolations clears all "violations" edges to the Violation entity.
+func (cuo *CarUpdateOne) ClearViolations() *CarUpdateOne {
+	cuo.mutation.ClearViolations()
+	return cuo
+}
+
+// RemoveViolationIDs removes the "violations" edge to Violation entities by IDs.
+func (cuo *CarUpdateOne) RemoveViolationIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveViolationIDs(ids...)
+	return cuo
+}
+
+// RemoveViolations removes "violations" edges to Violation entities.
+func (cuo *CarUpdateOne) RemoveViolations(v ...*Violation) *CarUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return cuo.RemoveViolationIDs(ids...)
+}
+
+// ClearTradeRecords clears all "trade_records" edges to the TradeRecord entity.
+func (cuo *CarUpdateOne) ClearTradeRecords() *CarUpdateOne {
+	cuo.mutation.ClearTradeRecords()
+	return cuo
+}
+
+// RemoveTradeRecordIDs removes the "trade_records" edge to TradeRecord entities by IDs.
+func (cuo *CarUpdateOne) RemoveTradeRecordIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveTradeRecordIDs(ids...)
+	return cuo
+}
+
+// RemoveTradeRecords removes "trade_records" edges to TradeRecord entities.
+func (cuo *CarUpdateOne) RemoveTradeRecords(t ...*TradeRecord) *CarUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.RemoveTradeRecordIDs(ids...)
+}
+
+// ClearListings clears all "listings" edges to the Listing entity.
+func (cuo *CarUpdateOne) ClearListings() *CarUpdateOne {
+	cuo.mutation.ClearListings()
+	return cuo
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (cuo *CarUpdateOne) RemoveListingIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveListingIDs(ids...)
+	return cuo
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (cuo *CarUpdateOne) RemoveListings(l ...*Listing) *CarUpdateOne {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cuo.RemoveListingIDs(ids...)
+}
+
+// ClearWarranties clears all "warranties" edges to the CarWarranty entity.
+func (cuo *CarUpdateOne) ClearWarranties() *CarUpdateOne {
+	cuo.mutation.ClearWarranties()
+	return cuo
+}
+
+// RemoveWarrantyIDs removes the "warranties" edge to CarWarranty entities by IDs.
+func (cuo *CarUpdateOne) RemoveWarrantyIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveWarrantyIDs(ids...)
+	return cuo
+}
+
+// RemoveWarranties removes "warranties" edges to CarWarranty entities.
+func (cuo *CarUpdateOne) RemoveWarranties(c ...*CarWarranty) *CarUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveWarrantyIDs(ids...)
+}
+
+// ClearIncidents clears all "incidents" edges to the Incident entity.
+func (cuo *CarUpdateOne) ClearIncidents() *CarUpdateOne {
+	cuo.mutation.ClearIncidents()
+	return cuo
+}
+
+// RemoveIncidentIDs removes the "incidents" edge to Incident entities by IDs.
+func (cuo *CarUpdateOne) RemoveIncidentIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveIncidentIDs(ids...)
+	return cuo
+}
+
+// RemoveIncidents removes "incidents" edges to Incident entities.
+func (cuo *CarUpdateOne) RemoveIncidents(i ...*Incident) *CarUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cuo.RemoveIncidentIDs(ids...)
+}
+
+// ClearLeaseContracts clears all "lease_contracts" edges to the LeaseContract entity.
+func (cuo *CarUpdateOne) ClearLeaseContracts() *CarUpdateOne {
+	cuo.mutation.ClearLeaseContracts()
+	return cuo
+}
+
+// RemoveLeaseContractIDs removes the "lease_contracts" edge to LeaseContract entities by IDs.
+func (cuo *CarUpdateOne) RemoveLeaseContractIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveLeaseContractIDs(ids...)
+	return cuo
+}
+
+// RemoveLeaseContracts removes "lease_contracts" edges to LeaseContract entities.
+func (cuo *CarUpdateOne) RemoveLeaseContracts(l ...*LeaseContract) *CarUpdateOne {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cuo.RemoveLeaseContractIDs(ids...)
+}
+
+// ClearChargingSessions clears all "charging_sessions" edges to the ChargingSession entity.
+func (cuo *CarUpdateOne) ClearChargingSessions() *CarUpdateOne {
+	cuo.mutation.ClearChargingSessions()
+	return cuo
+}
+
+// RemoveChargingSessionIDs removes the "charging_sessions" edge to ChargingSession entities by IDs.
+func (cuo *CarUpdateOne) RemoveChargingSessionIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveChargingSessionIDs(ids...)
+	return cuo
+}
+
+// RemoveChargingSessions removes "charging_sessions" edges to ChargingSession entities.
+func (cuo *CarUpdateOne) RemoveChargingSessions(c ...*ChargingSession) *CarUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveChargingSessionIDs(ids...)
+}
+
+// ClearGeofences clears all "geofences" edges to the Geofence entity.
+func (cuo *CarUpdateOne) ClearGeofences() *CarUpdateOne {
+	cuo.mutation.ClearGeofences()
+	return cuo
+}
+
+// RemoveGeofenceIDs removes the "geofences" edge to Geofence entities by IDs.
+func (cuo *CarUpdateOne) RemoveGeofenceIDs(ids ...int64) *CarUpdateOne {
+	cuo.mutation.RemoveGeofenceIDs(ids...)
+	return cuo
+}
+
+// RemoveGeofences removes "geofences" edges to Geofence entities.
+func (cuo *CarUpdateOne) RemoveGeofences(g ...*Geofence) *CarUpdateOne {
+	ids := make([]int64, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return cuo.RemoveGeofenceIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CarUpdateOne) Select(field string, fields ...string) *CarUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Car entity.
+func (cuo *CarUpdateOne) Save(ctx context.Context) (*Car, error) {
+	var (
+		err  error
+		node *Car
+	)
+	if len(cuo.hooks) == 0 {
+		node, err = cuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cuo.mutation = mutation
+			node, err = cuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cuo.hooks) - 1; i >= 0; i-- {
+			if cuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, cuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Car)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CarMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CarUpdateOne) SaveX(ctx context.Context) *Car {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CarUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CarUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   car.Table,
+			Columns: car.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: car.FieldID,
+			},
+		},
+	}
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Car.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, car.FieldID)
+		for _, f := range fields {
+			if !car.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != car.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: car.FieldTenantID,
+		})
+	}
+	if value, ok := cuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: car.FieldTenantID,
+		})
+	}
+	if cuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: car.FieldTenantID,
+		})
+	}
+	if value, ok := cuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: car.FieldUserID,
+		})
+	}
+	if value, ok := cuo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: car.FieldUserID,
+		})
+	}
+	if cuo.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: car.FieldUserID,
+		})
+	}
+	if value, ok := cuo.mutation.Model(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldModel,
+		})
+	}
+	if cuo.mutation.ModelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: car.FieldModel,
+		})
+	}
+	if value, ok := cuo.mutation.Vin(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldVin,
+		})
+	}
+	if cuo.mutation.VinCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: car.FieldVin,
+		})
+	}
+	if value, ok := cuo.mutation.Plate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldPlate,
+		})
+	}
+	if cuo.mutation.PlateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: car.FieldPlate,
+		})
+	}
+	if value, ok := cuo.mutation.OwnerName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: car.FieldOwnerName,
+		})
+	}
+	if cuo.mutation.OwnerNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: car.FieldOwnerName,
+		})
+	}
+	if value, ok := cuo.mutation.RegisteredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: car.FieldRegisteredAt,
+		})
+	}
+	if value, ok := cuo.mutation.BatteryCapacity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatteryCapacity,
+		})
+	}
+	if value, ok := cuo.mutation.AddedBatteryCapacity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatteryCapacity,
+		})
+	}
+	if cuo.mutation.BatteryCapacityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: car.FieldBatteryCapacity,
+		})
+	}
+	if value, ok := cuo.mutation.BatterySoh(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatterySoh,
+		})
+	}
+	if value, ok := cuo.mutation.AddedBatterySoh(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: car.FieldBatterySoh,
+		})
+	}
+	if cuo.mutation.BatterySohCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: car.FieldBatterySoh,
+		})
+	}
+	if cuo.mutation.VehicleModelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.VehicleModelTable,
+			Columns: []string{car.VehicleModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.VehicleModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.VehicleModelTable,
+			Columns: []string{car.VehicleModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: vehiclemodel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.MaintenanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceRecordsTable,
+			Columns: []string{car.MaintenanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: maintenancerecord.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedMaintenanceRecordsIDs(); len(nodes) > 0 && !cuo.mutation.MaintenanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceRecordsTable,
+			Columns: []string{car.MaintenanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: maintenancerecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.MaintenanceRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceRecordsTable,
+			Columns: []string{car.MaintenanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: maintenancerecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.InsurancePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.InsurancePoliciesTable,
+			Columns: []string{car.InsurancePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedInsurancePoliciesIDs(); len(nodes) > 0 && !cuo.mutation.InsurancePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.InsurancePoliciesTable,
+			Columns: []string{car.InsurancePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.InsurancePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.InsurancePoliciesTable,
+			Columns: []string{car.InsurancePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TransfersTable,
+			Columns: []string{car.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: transfer.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedTransfersIDs(); len(nodes) > 0 && !cuo.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TransfersTable,
+			Columns: []string{car.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: transfer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.TransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TransfersTable,
+			Columns: []string{car.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: transfer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.OdometerReadingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OdometerReadingsTable,
+			Columns: []string{car.OdometerReadingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: odometerreading.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedOdometerReadingsIDs(); len(nodes) > 0 && !cuo.mutation.OdometerReadingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OdometerReadingsTable,
+			Columns: []string{car.OdometerReadingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: odometerreading.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.OdometerReadingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OdometerReadingsTable,
+			Columns: []string{car.OdometerReadingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: odometerreading.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttachmentsTable,
+			Columns: []string{car.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !cuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttachmentsTable,
+			Columns: []string{car.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttachmentsTable,
+			Columns: []string{car.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.FleetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.FleetsTable,
+			Columns: car.FleetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: fleet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedFleetsIDs(); len(nodes) > 0 && !cuo.mutation.FleetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.FleetsTable,
+			Columns: car.FleetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: fleet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.FleetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.FleetsTable,
+			Columns: car.FleetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: fleet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.TagsTable,
+			Columns: car.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !cuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.TagsTable,
+			Columns: car.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.TagsTable,
+			Columns: car.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttributesTable,
+			Columns: []string{car.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedAttributesIDs(); len(nodes) > 0 && !cuo.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttributesTable,
+			Columns: []string{car.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttributesTable,
+			Columns: []string{car.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carattribute.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ReservationsTable,
+			Columns: []string{car.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !cuo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ReservationsTable,
+			Columns: []string{car.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ReservationsTable,
+			Columns: []string{car.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.TripsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TripsTable,
+			Columns: []string{car.TripsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: trip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedTripsIDs(); len(nodes) > 0 && !cuo.mutation.TripsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TripsTable,
+			Columns: []string{car.TripsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: trip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.TripsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TripsTable,
+			Columns: []string{car.TripsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: trip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.RefuelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RefuelsTable,
+			Columns: []string{car.RefuelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: refuel.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedRefuelsIDs(); len(nodes) > 0 && !cuo.mutation.RefuelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RefuelsTable,
+			Columns: []string{car.RefuelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: refuel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RefuelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RefuelsTable,
+			Columns: []string{car.RefuelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: refuel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.RecallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RecallsTable,
+			Columns: []string{car.RecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedRecallsIDs(); len(nodes) > 0 && !cuo.mutation.RecallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RecallsTable,
+			Columns: []string{car.RecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RecallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RecallsTable,
+			Columns: []string{car.RecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.OwnerHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OwnerHistoriesTable,
+			Columns: []string{car.OwnerHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: ownerhistory.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedOwnerHistoriesIDs(); len(nodes) > 0 && !cuo.mutation.OwnerHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OwnerHistoriesTable,
+			Columns: []string{car.OwnerHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: ownerhistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.OwnerHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OwnerHistoriesTable,
+			Columns: []string{car.OwnerHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: ownerhistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ViolationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ViolationsTable,
+			Columns: []string{car.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: violation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedViolationsIDs(); len(nodes) > 0 && !cuo.mutation.ViolationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ViolationsTable,
+			Columns: []string{car.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: violation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ViolationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ViolationsTable,
+			Columns: []string{car.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: violation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.TradeRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TradeRecordsTable,
+			Columns: []string{car.TradeRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: traderecord.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedTradeRecordsIDs(); len(nodes) > 0 && !cuo.mutation.TradeRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TradeRecordsTable,
+			Columns: []string{car.TradeRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: traderecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.TradeRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TradeRecordsTable,
+			Columns: []string{car.TradeRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: traderecord.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ListingsTable,
+			Columns: []string{car.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: listing.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedListingsIDs(); len(nodes) > 0 && !cuo.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ListingsTable,
+			Columns: []string{car.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: listing.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ListingsTable,
+			Columns: []string{car.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: listing.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.WarrantiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.WarrantiesTable,
+			Columns: []string{car.WarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedWarrantiesIDs(); len(nodes) > 0 && !cuo.mutation.WarrantiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.WarrantiesTable,
+			Columns: []string{car.WarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.WarrantiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.WarrantiesTable,
+			Columns: []string{car.WarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.IncidentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.IncidentsTable,
+			Columns: []string{car.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedIncidentsIDs(); len(nodes) > 0 && !cuo.mutation.IncidentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.IncidentsTable,
+			Columns: []string{car.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.IncidentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.IncidentsTable,
+			Columns: []string{car.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.LeaseContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.LeaseContractsTable,
+			Columns: []string{car.LeaseContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: leasecontract.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedLeaseContractsIDs(); len(nodes) > 0 && !cuo.mutation.LeaseContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.LeaseContractsTable,
+			Columns: []string{car.LeaseContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: leasecontract.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.LeaseContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.LeaseContractsTable,
+			Columns: []string{car.LeaseContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: leasecontract.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ChargingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ChargingSessionsTable,
+			Columns: []string{car.ChargingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: chargingsession.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedChargingSessionsIDs(); len(nodes) > 0 && !cuo.mutation.ChargingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ChargingSessionsTable,
+			Columns: []string{car.ChargingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: chargingsession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ChargingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ChargingSessionsTable,
+			Columns: []string{car.ChargingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: chargingsession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.GeofencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.GeofencesTable,
+			Columns: car.GeofencesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: geofence.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedGeofencesIDs(); len(nodes) > 0 && !cuo.mutation.GeofencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.GeofencesTable,
+			Columns: car.GeofencesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: geofence.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.GeofencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.GeofencesTable,
+			Columns: car.GeofencesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: geofence.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &Car{config: cuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// CarAttribute is the model entity for the CarAttribute schema.
+type CarAttribute struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// DefinitionID holds the value of the "definition_id" field.
+	DefinitionID int64 `json:"definition_id,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CarAttributeQuery when eager-loading is set.
+	Edges CarAttributeEdges `json:"edges"`
+}
+
+// CarAttributeEdges holds the relations/edges for other nodes in the graph.
+type CarAttributeEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// Definition holds the value of the definition edge.
+	Definition *AttributeDefinition `json:"definition,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarAttributeEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// DefinitionOrErr returns the Definition value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarAttributeEdges) DefinitionOrErr() (*AttributeDefinition, error) {
+	if e.loadedTypes[1] {
+		if e.Definition == nil {
+			// The edge definition was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: attributedefinition.Label}
+		}
+		return e.Definition, nil
+	}
+	return nil, &NotLoadedError{edge: "definition"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CarAttribute) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case carattribute.FieldID, carattribute.FieldCarID, carattribute.FieldDefinitionID:
+			values[i] = new(sql.NullInt64)
+		case carattribute.FieldValue:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CarAttribute", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CarAttribute fields.
+func (ca *CarAttribute) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case carattribute.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ca.ID = int64(value.Int64)
+		case carattribute.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				ca.CarID = value.Int64
+			}
+		case carattribute.FieldDefinitionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field definition_id", values[i])
+			} else if value.Valid {
+				ca.DefinitionID = value.Int64
+			}
+		case carattribute.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				ca.Value = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the CarAttribute entity.
+func (ca *CarAttribute) QueryCar() *CarQuery {
+	return (&CarAttributeClient{config: ca.config}).QueryCar(ca)
+}
+
+// QueryDefinition queries the "definition" edge of the CarAttribute entity.
+func (ca *CarAttribute) QueryDefinition() *AttributeDefinitionQuery {
+	return (&CarAttributeClient{config: ca.config}).QueryDefinition(ca)
+}
+
+// Update returns a builder for updating this CarAttribute.
+// Note that you need to call CarAttribute.Unwrap() before calling this method if this CarAttribute
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ca *CarAttribute) Update() *CarAttributeUpdateOne {
+	return (&CarAttributeClient{config: ca.config}).UpdateOne(ca)
+}
+
+// Unwrap unwraps the CarAttribute entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ca *CarAttribute) Unwrap() *CarAttribute {
+	_tx, ok := ca.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CarAttribute is not a transactional entity")
+	}
+	ca.config.driver = _tx.drv
+	return ca
+}
+
+// String implements the fmt.Stringer.
+func (ca *CarAttribute) String() string {
+	var builder strings.Builder
+	builder.WriteString("CarAttribute(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ca.ID))
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", ca.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("definition_id=")
+	builder.WriteString(fmt.Sprintf("%v", ca.DefinitionID))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(ca.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CarAttributes is a parsable slice of CarAttribute.
+type CarAttributes []*CarAttribute
+
+func (ca CarAttributes) config(cfg config) {
+	for _i := range ca {
+		ca[_i].config = cfg
+	}
+}
@@ -0,0 +1,54 @@
+// Code generated by ent, DO NOT EDIT.
+
+package carattribute
+
+const (
+	// Label holds the string label denoting the carattribute type in the database.
+	Label = "car_attribute"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldDefinitionID holds the string denoting the definition_id field in the database.
+	FieldDefinitionID = "definition_id"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// EdgeDefinition holds the string denoting the definition edge name in mutations.
+	EdgeDefinition = "definition"
+	// Table holds the table name of the carattribute in the database.
+	Table = "car_attribute"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "car_attribute"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+	// DefinitionTable is the table that holds the definition relation/edge.
+	DefinitionTable = "car_attribute"
+	// DefinitionInverseTable is the table name for the AttributeDefinition entity.
+	// It exists in this package in order to avoid circular dependency with the "attributedefinition" package.
+	DefinitionInverseTable = "attribute_definition"
+	// DefinitionColumn is the table column denoting the definition relation/edge.
+	DefinitionColumn = "definition_id"
+)
+
+// Columns holds all SQL columns for carattribute fields.
+var Columns = []string{
+	FieldID,
+	FieldCarID,
+	FieldDefinitionID,
+	FieldValue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
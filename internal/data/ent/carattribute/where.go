@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package carattribute
+
+import (
+	"car-service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// DefinitionID applies equality check predicate on the "definition_id" field. It's identical to DefinitionIDEQ.
+func DefinitionID(v int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDefinitionID), v))
+	})
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValue), v))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.CarAttribute {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.CarAttribute {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// DefinitionIDEQ applies the EQ predicate on the "definition_id" field.
+func DefinitionIDEQ(v int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDefinitionID), v))
+	})
+}
+
+// DefinitionIDNEQ applies the NEQ predicate on the "definition_id" field.
+func DefinitionIDNEQ(v int64) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDefinitionID), v))
+	})
+}
+
+// DefinitionIDIn applies the In predicate on the "definition_id" field.
+func DefinitionIDIn(vs ...int64) predicate.CarAttribute {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDefinitionID), v...))
+	})
+}
+
+// DefinitionIDNotIn applies the NotIn predicate on the "definition_id" field.
+func DefinitionIDNotIn(vs ...int64) predicate.CarAttribute {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDefinitionID), v...))
+	})
+}
+
+// DefinitionIDIsNil applies the IsNil predicate on the "definition_id" field.
+func DefinitionIDIsNil() predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDefinitionID)))
+	})
+}
+
+// DefinitionIDNotNil applies the NotNil predicate on the "definition_id" field.
+func DefinitionIDNotNil() predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDefinitionID)))
+	})
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValue), v))
+	})
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldValue), v))
+	})
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.CarAttribute {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldValue), v...))
+	})
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.CarAttribute {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldValue), v...))
+	})
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldValue), v))
+	})
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldValue), v))
+	})
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldValue), v))
+	})
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldValue), v))
+	})
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldValue), v))
+	})
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldValue), v))
+	})
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldValue), v))
+	})
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldValue), v))
+	})
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldValue), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDefinition applies the HasEdge predicate on the "definition" edge.
+func HasDefinition() predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DefinitionTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DefinitionTable, DefinitionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDefinitionWith applies the HasEdge predicate on the "definition" edge with a given conditions (other predicates).
+func HasDefinitionWith(preds ...predicate.AttributeDefinition) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DefinitionInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DefinitionTable, DefinitionColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CarAttribute) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CarAttribute) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CarAttribute) predicate.CarAttribute {
+	return predicate.CarAttribute(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
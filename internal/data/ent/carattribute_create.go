@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarAttributeCreate is the builder for creating a CarAttribute entity.
+type CarAttributeCreate struct {
+	config
+	mutation *CarAttributeMutation
+	hooks    []Hook
+}
+
+// SetCarID sets the "car_id" field.
+func (cac *CarAttributeCreate) SetCarID(i int64) *CarAttributeCreate {
+	cac.mutation.SetCarID(i)
+	return cac
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (cac *CarAttributeCreate) SetNillableCarID(i *int64) *CarAttributeCreate {
+	if i != nil {
+		cac.SetCarID(*i)
+	}
+	return cac
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (cac *CarAttributeCreate) SetDefinitionID(i int64) *CarAttributeCreate {
+	cac.mutation.SetDefinitionID(i)
+	return cac
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (cac *CarAttributeCreate) SetNillableDefinitionID(i *int64) *CarAttributeCreate {
+	if i != nil {
+		cac.SetDefinitionID(*i)
+	}
+	return cac
+}
+
+// SetValue sets the "value" field.
+func (cac *CarAttributeCreate) SetValue(s string) *CarAttributeCreate {
+	cac.mutation.SetValue(s)
+	return cac
+}
+
+// SetID sets the "id" field.
+func (cac *CarAttributeCreate) SetID(i int64) *CarAttributeCreate {
+	cac.mutation.SetID(i)
+	return cac
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (cac *CarAttributeCreate) SetCar(c *Car) *CarAttributeCreate {
+	return cac.SetCarID(c.ID)
+}
+
+// SetDefinition sets the "definition" edge to the AttributeDefinition entity.
+func (cac *CarAttributeCreate) SetDefinition(a *AttributeDefinition) *CarAttributeCreate {
+	return cac.SetDefinitionID(a.ID)
+}
+
+// Mutation returns the CarAttributeMutation object of the builder.
+func (cac *CarAttributeCreate) Mutation() *CarAttributeMutation {
+	return cac.mutation
+}
+
+// Save creates the CarAttribute in the database.
+func (cac *CarAttributeCreate) Save(ctx context.Context) (*CarAttribute, error) {
+	var (
+		err  error
+		node *CarAttribute
+	)
+	if len(cac.hooks) == 0 {
+		if err = cac.check(); err != nil {
+			return nil, err
+		}
+		node, err = cac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarAttributeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cac.check(); err != nil {
+				return nil, err
+			}
+			cac.mutation = mutation
+			if node, err = cac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cac.hooks) - 1; i >= 0; i-- {
+			if cac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cac.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, cac.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*CarAttribute)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CarAttributeMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cac *CarAttributeCreate) SaveX(ctx context.Context) *CarAttribute {
+	v, err := cac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cac *CarAttributeCreate) Exec(ctx context.Context) error {
+	_, err := cac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cac *CarAttributeCreate) ExecX(ctx context.Context) {
+	if err := cac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cac *CarAttributeCreate) check() error {
+	if _, ok := cac.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "CarAttribute.value"`)}
+	}
+	return nil
+}
+
+func (cac *CarAttributeCreate) sqlSave(ctx context.Context) (*CarAttribute, error) {
+	_node, _spec := cac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (cac *CarAttributeCreate) createSpec() (*CarAttribute, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CarAttribute{config: cac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: carattribute.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carattribute.FieldID,
+			},
+		}
+	)
+	if id, ok := cac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cac.mutation.Value(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carattribute.FieldValue,
+		})
+		_node.Value = value
+	}
+	if nodes := cac.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.CarTable,
+			Columns: []string{carattribute.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cac.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.DefinitionTable,
+			Columns: []string{carattribute.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attributedefinition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DefinitionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CarAttributeCreateBulk is the builder for creating many CarAttribute entities in bulk.
+type CarAttributeCreateBulk struct {
+	config
+	builders []*CarAttributeCreate
+}
+
+// Save creates the CarAttribute entities in the database.
+func (cacb *CarAttributeCreateBulk) Save(ctx context.Context) ([]*CarAttribute, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cacb.builders))
+	nodes := make([]*CarAttribute, len(cacb.builders))
+	mutators := make([]Mutator, len(cacb.builders))
+	for i := range cacb.builders {
+		func(i int, root context.Context) {
+			builder := cacb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CarAttributeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cacb *CarAttributeCreateBulk) SaveX(ctx context.Context) []*CarAttribute {
+	v, err := cacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cacb *CarAttributeCreateBulk) Exec(ctx context.Context) error {
+	_, err := cacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacb *CarAttributeCreateBulk) ExecX(ctx context.Context) {
+	if err := cacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
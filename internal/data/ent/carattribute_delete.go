@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarAttributeDelete is the builder for deleting a CarAttribute entity.
+type CarAttributeDelete struct {
+	config
+	hooks    []Hook
+	mutation *CarAttributeMutation
+}
+
+// Where appends a list predicates to the CarAttributeDelete builder.
+func (cad *CarAttributeDelete) Where(ps ...predicate.CarAttribute) *CarAttributeDelete {
+	cad.mutation.Where(ps...)
+	return cad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cad *CarAttributeDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cad.hooks) == 0 {
+		affected, err = cad.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarAttributeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cad.mutation = mutation
+			affected, err = cad.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cad.hooks) - 1; i >= 0; i-- {
+			if cad.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cad.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cad.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cad *CarAttributeDelete) ExecX(ctx context.Context) int {
+	n, err := cad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cad *CarAttributeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: carattribute.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carattribute.FieldID,
+			},
+		},
+	}
+	if ps := cad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// CarAttributeDeleteOne is the builder for deleting a single CarAttribute entity.
+type CarAttributeDeleteOne struct {
+	cad *CarAttributeDelete
+}
+
+// Exec executes the deletion query.
+func (cado *CarAttributeDeleteOne) Exec(ctx context.Context) error {
+	n, err := cado.cad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{carattribute.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cado *CarAttributeDeleteOne) ExecX(ctx context.Context) {
+	cado.cad.ExecX(ctx)
+}
@@ -0,0 +1,708 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarAttributeQuery is the builder for querying CarAttribute entities.
+type CarAttributeQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CarAttribute
+	// eager-loading edges.
+	withCar        *CarQuery
+	withDefinition *AttributeDefinitionQuery
+	modifiers      []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CarAttributeQuery builder.
+func (caq *CarAttributeQuery) Where(ps ...predicate.CarAttribute) *CarAttributeQuery {
+	caq.predicates = append(caq.predicates, ps...)
+	return caq
+}
+
+// Limit adds a limit step to the query.
+func (caq *CarAttributeQuery) Limit(limit int) *CarAttributeQuery {
+	caq.limit = &limit
+	return caq
+}
+
+// Offset adds an offset step to the query.
+func (caq *CarAttributeQuery) Offset(offset int) *CarAttributeQuery {
+	caq.offset = &offset
+	return caq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (caq *CarAttributeQuery) Unique(unique bool) *CarAttributeQuery {
+	caq.unique = &unique
+	return caq
+}
+
+// Order adds an order step to the query.
+func (caq *CarAttributeQuery) Order(o ...OrderFunc) *CarAttributeQuery {
+	caq.order = append(caq.order, o...)
+	return caq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (caq *CarAttributeQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: caq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := caq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := caq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carattribute.Table, carattribute.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carattribute.CarTable, carattribute.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(caq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDefinition chains the current query on the "definition" edge.
+func (caq *CarAttributeQuery) QueryDefinition() *AttributeDefinitionQuery {
+	query := &AttributeDefinitionQuery{config: caq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := caq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := caq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carattribute.Table, carattribute.FieldID, selector),
+			sqlgraph.To(attributedefinition.Table, attributedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carattribute.DefinitionTable, carattribute.DefinitionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(caq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CarAttribute entity from the query.
+// Returns a *NotFoundError when no CarAttribute was found.
+func (caq *CarAttributeQuery) First(ctx context.Context) (*CarAttribute, error) {
+	nodes, err := caq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{carattribute.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (caq *CarAttributeQuery) FirstX(ctx context.Context) *CarAttribute {
+	node, err := caq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CarAttribute ID from the query.
+// Returns a *NotFoundError when no CarAttribute ID was found.
+func (caq *CarAttributeQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = caq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{carattribute.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (caq *CarAttributeQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := caq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CarAttribute entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CarAttribute entity is found.
+// Returns a *NotFoundError when no CarAttribute entities are found.
+func (caq *CarAttributeQuery) Only(ctx context.Context) (*CarAttribute, error) {
+	nodes, err := caq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{carattribute.Label}
+	default:
+		return nil, &NotSingularError{carattribute.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (caq *CarAttributeQuery) OnlyX(ctx context.Context) *CarAttribute {
+	node, err := caq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CarAttribute ID in the query.
+// Returns a *NotSingularError when more than one CarAttribute ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (caq *CarAttributeQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = caq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{carattribute.Label}
+	default:
+		err = &NotSingularError{carattribute.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (caq *CarAttributeQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := caq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CarAttributes.
+func (caq *CarAttributeQuery) All(ctx context.Context) ([]*CarAttribute, error) {
+	if err := caq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return caq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (caq *CarAttributeQuery) AllX(ctx context.Context) []*CarAttribute {
+	nodes, err := caq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CarAttribute IDs.
+func (caq *CarAttributeQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := caq.Select(carattribute.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (caq *CarAttributeQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := caq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (caq *CarAttributeQuery) Count(ctx context.Context) (int, error) {
+	if err := caq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return caq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (caq *CarAttributeQuery) CountX(ctx context.Context) int {
+	count, err := caq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (caq *CarAttributeQuery) Exist(ctx context.Context) (bool, error) {
+	if err := caq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return caq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (caq *CarAttributeQuery) ExistX(ctx context.Context) bool {
+	exist, err := caq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CarAttributeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (caq *CarAttributeQuery) Clone() *CarAttributeQuery {
+	if caq == nil {
+		return nil
+	}
+	return &CarAttributeQuery{
+		config:         caq.config,
+		limit:          caq.limit,
+		offset:         caq.offset,
+		order:          append([]OrderFunc{}, caq.order...),
+		predicates:     append([]predicate.CarAttribute{}, caq.predicates...),
+		withCar:        caq.withCar.Clone(),
+		withDefinition: caq.withDefinition.Clone(),
+		// clone intermediate query.
+		sql:    caq.sql.Clone(),
+		path:   caq.path,
+		unique: caq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (caq *CarAttributeQuery) WithCar(opts ...func(*CarQuery)) *CarAttributeQuery {
+	query := &CarQuery{config: caq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	caq.withCar = query
+	return caq
+}
+
+// WithDefinition tells the query-builder to eager-load the nodes that are connected to
+// the "definition" edge. The optional arguments are used to configure the query builder of the edge.
+func (caq *CarAttributeQuery) WithDefinition(opts ...func(*AttributeDefinitionQuery)) *CarAttributeQuery {
+	query := &AttributeDefinitionQuery{config: caq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	caq.withDefinition = query
+	return caq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CarID int64 `json:"car_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CarAttribute.Query().
+//		GroupBy(carattribute.FieldCarID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (caq *CarAttributeQuery) GroupBy(field string, fields ...string) *CarAttributeGroupBy {
+	grbuild := &CarAttributeGroupBy{config: caq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := caq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return caq.sqlQuery(ctx), nil
+	}
+	grbuild.label = carattribute.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CarID int64 `json:"car_id,omitempty"`
+//	}
+//
+//	client.CarAttribute.Query().
+//		Select(carattribute.FieldCarID).
+//		Scan(ctx, &v)
+//
+func (caq *CarAttributeQuery) Select(fields ...string) *CarAttributeSelect {
+	caq.fields = append(caq.fields, fields...)
+	selbuild := &CarAttributeSelect{CarAttributeQuery: caq}
+	selbuild.label = carattribute.Label
+	selbuild.flds, selbuild.scan = &caq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (caq *CarAttributeQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range caq.fields {
+		if !carattribute.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if caq.path != nil {
+		prev, err := caq.path(ctx)
+		if err != nil {
+			return err
+		}
+		caq.sql = prev
+	}
+	return nil
+}
+
+func (caq *CarAttributeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CarAttribute, error) {
+	var (
+		nodes       = []*CarAttribute{}
+		_spec       = caq.querySpec()
+		loadedTypes = [2]bool{
+			caq.withCar != nil,
+			caq.withDefinition != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*CarAttribute).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &CarAttribute{config: caq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(caq.modifiers) > 0 {
+		_spec.Modifiers = caq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, caq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := caq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*CarAttribute)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	if query := caq.withDefinition; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*CarAttribute)
+		for i := range nodes {
+			fk := nodes[i].DefinitionID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(attributedefinition.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "definition_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Definition = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (caq *CarAttributeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := caq.querySpec()
+	if len(caq.modifiers) > 0 {
+		_spec.Modifiers = caq.modifiers
+	}
+	_spec.Node.Columns = caq.fields
+	if len(caq.fields) > 0 {
+		_spec.Unique = caq.unique != nil && *caq.unique
+	}
+	return sqlgraph.CountNodes(ctx, caq.driver, _spec)
+}
+
+func (caq *CarAttributeQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := caq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (caq *CarAttributeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   carattribute.Table,
+			Columns: carattribute.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carattribute.FieldID,
+			},
+		},
+		From:   caq.sql,
+		Unique: true,
+	}
+	if unique := caq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := caq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, carattribute.FieldID)
+		for i := range fields {
+			if fields[i] != carattribute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := caq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := caq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := caq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := caq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (caq *CarAttributeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(caq.driver.Dialect())
+	t1 := builder.Table(carattribute.Table)
+	columns := caq.fields
+	if len(columns) == 0 {
+		columns = carattribute.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if caq.sql != nil {
+		selector = caq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if caq.unique != nil && *caq.unique {
+		selector.Distinct()
+	}
+	for _, m := range caq.modifiers {
+		m(selector)
+	}
+	for _, p := range caq.predicates {
+		p(selector)
+	}
+	for _, p := range caq.order {
+		p(selector)
+	}
+	if offset := caq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := caq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (caq *CarAttributeQuery) ForUpdate(opts ...sql.LockOption) *CarAttributeQuery {
+	if caq.driver.Dialect() == dialect.Postgres {
+		caq.Unique(false)
+	}
+	caq.modifiers = append(caq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return caq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (caq *CarAttributeQuery) ForShare(opts ...sql.LockOption) *CarAttributeQuery {
+	if caq.driver.Dialect() == dialect.Postgres {
+		caq.Unique(false)
+	}
+	caq.modifiers = append(caq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return caq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (caq *CarAttributeQuery) Modify(modifiers ...func(s *sql.Selector)) *CarAttributeSelect {
+	caq.modifiers = append(caq.modifiers, modifiers...)
+	return caq.Select()
+}
+
+// CarAttributeGroupBy is the group-by builder for CarAttribute entities.
+type CarAttributeGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cagb *CarAttributeGroupBy) Aggregate(fns ...AggregateFunc) *CarAttributeGroupBy {
+	cagb.fns = append(cagb.fns, fns...)
+	return cagb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cagb *CarAttributeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cagb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cagb.sql = query
+	return cagb.sqlScan(ctx, v)
+}
+
+func (cagb *CarAttributeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cagb.fields {
+		if !carattribute.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cagb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cagb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cagb *CarAttributeGroupBy) sqlQuery() *sql.Selector {
+	selector := cagb.sql.Select()
+	aggregation := make([]string, 0, len(cagb.fns))
+	for _, fn := range cagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(cagb.fields)+len(cagb.fns))
+		for _, f := range cagb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(cagb.fields...)...)
+}
+
+// CarAttributeSelect is the builder for selecting fields of CarAttribute entities.
+type CarAttributeSelect struct {
+	*CarAttributeQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cas *CarAttributeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cas.sql = cas.CarAttributeQuery.sqlQuery(ctx)
+	return cas.sqlScan(ctx, v)
+}
+
+func (cas *CarAttributeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cas.sql.Query()
+	if err := cas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cas *CarAttributeSelect) Modify(modifiers ...func(s *sql.Selector)) *CarAttributeSelect {
+	cas.modifiers = append(cas.modifiers, modifiers...)
+	return cas
+}
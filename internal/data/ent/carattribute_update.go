@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarAttributeUpdate is the builder for updating CarAttribute entities.
+type CarAttributeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CarAttributeMutation
+}
+
+// Where appends a list predicates to the CarAttributeUpdate builder.
+func (cau *CarAttributeUpdate) Where(ps ...predicate.CarAttribute) *CarAttributeUpdate {
+	cau.mutation.Where(ps...)
+	return cau
+}
+
+// SetCarID sets the "car_id" field.
+func (cau *CarAttributeUpdate) SetCarID(i int64) *CarAttributeUpdate {
+	cau.mutation.SetCarID(i)
+	return cau
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (cau *CarAttributeUpdate) SetNillableCarID(i *int64) *CarAttributeUpdate {
+	if i != nil {
+		cau.SetCarID(*i)
+	}
+	return cau
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (cau *CarAttributeUpdate) ClearCarID() *CarAttributeUpdate {
+	cau.mutation.ClearCarID()
+	return cau
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (cau *CarAttributeUpdate) SetDefinitionID(i int64) *CarAttributeUpdate {
+	cau.mutation.SetDefinitionID(i)
+	return cau
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (cau *CarAttributeUpdate) SetNillableDefinitionID(i *int64) *CarAttributeUpdate {
+	if i != nil {
+		cau.SetDefinitionID(*i)
+	}
+	return cau
+}
+
+// ClearDefinitionID clears the value of the "definition_id" field.
+func (cau *CarAttributeUpdate) ClearDefinitionID() *CarAttributeUpdate {
+	cau.mutation.ClearDefinitionID()
+	return cau
+}
+
+// SetValue sets the "value" field.
+func (cau *CarAttributeUpdate) SetValue(s string) *CarAttributeUpdate {
+	cau.mutation.SetValue(s)
+	return cau
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (cau *CarAttributeUpdate) SetCar(c *Car) *CarAttributeUpdate {
+	return cau.SetCarID(c.ID)
+}
+
+// SetDefinition sets the "definition" edge to the AttributeDefinition entity.
+func (cau *CarAttributeUpdate) SetDefinition(a *AttributeDefinition) *CarAttributeUpdate {
+	return cau.SetDefinitionID(a.ID)
+}
+
+// Mutation returns the CarAttributeMutation object of the builder.
+func (cau *CarAttributeUpdate) Mutation() *CarAttributeMutation {
+	return cau.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (cau *CarAttributeUpdate) ClearCar() *CarAttributeUpdate {
+	cau.mutation.ClearCar()
+	return cau
+}
+
+// ClearDefinition clears the "definition" edge to the AttributeDefinition entity.
+func (cau *CarAttributeUpdate) ClearDefinition() *CarAttributeUpdate {
+	cau.mutation.ClearDefinition()
+	return cau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cau *CarAttributeUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cau.hooks) == 0 {
+		affected, err = cau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarAttributeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cau.mutation = mutation
+			affected, err = cau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cau.hooks) - 1; i >= 0; i-- {
+			if cau.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cau *CarAttributeUpdate) SaveX(ctx context.Context) int {
+	affected, err := cau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cau *CarAttributeUpdate) Exec(ctx context.Context) error {
+	_, err := cau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cau *CarAttributeUpdate) ExecX(ctx context.Context) {
+	if err := cau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cau *CarAttributeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   carattribute.Table,
+			Columns: carattribute.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carattribute.FieldID,
+			},
+		},
+	}
+	if ps := cau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cau.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carattribute.FieldValue,
+		})
+	}
+	if cau.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.CarTable,
+			Columns: []string{carattribute.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cau.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.CarTable,
+			Columns: []string{carattribute.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cau.mutation.DefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.DefinitionTable,
+			Columns: []string{carattribute.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attributedefinition.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cau.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.DefinitionTable,
+			Columns: []string{carattribute.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attributedefinition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carattribute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CarAttributeUpdateOne is the builder for updating a single CarAttribute entity.
+type CarAttributeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CarAttributeMutation
+}
+
+// SetCarID sets the "car_id" field.
+func (cauo *CarAttributeUpdateOne) SetCarID(i int64) *CarAttributeUpdateOne {
+	cauo.mutation.SetCarID(i)
+	return cauo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (cauo *CarAttributeUpdateOne) SetNillableCarID(i *int64) *CarAttributeUpdateOne {
+	if i != nil {
+		cauo.SetCarID(*i)
+	}
+	return cauo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (cauo *CarAttributeUpdateOne) ClearCarID() *CarAttributeUpdateOne {
+	cauo.mutation.ClearCarID()
+	return cauo
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (cauo *CarAttributeUpdateOne) SetDefinitionID(i int64) *CarAttributeUpdateOne {
+	cauo.mutation.SetDefinitionID(i)
+	return cauo
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (cauo *CarAttributeUpdateOne) SetNillableDefinitionID(i *int64) *CarAttributeUpdateOne {
+	if i != nil {
+		cauo.SetDefinitionID(*i)
+	}
+	return cauo
+}
+
+// ClearDefinitionID clears the value of the "definition_id" field.
+func (cauo *CarAttributeUpdateOne) ClearDefinitionID() *CarAttributeUpdateOne {
+	cauo.mutation.ClearDefinitionID()
+	return cauo
+}
+
+// SetValue sets the "value" field.
+func (cauo *CarAttributeUpdateOne) SetValue(s string) *CarAttributeUpdateOne {
+	cauo.mutation.SetValue(s)
+	return cauo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (cauo *CarAttributeUpdateOne) SetCar(c *Car) *CarAttributeUpdateOne {
+	return cauo.SetCarID(c.ID)
+}
+
+// SetDefinition sets the "definition" edge to the AttributeDefinition entity.
+func (cauo *CarAttributeUpdateOne) SetDefinition(a *AttributeDefinition) *CarAttributeUpdateOne {
+	return cauo.SetDefinitionID(a.ID)
+}
+
+// Mutation returns the CarAttributeMutation object of the builder.
+func (cauo *CarAttributeUpdateOne) Mutation() *CarAttributeMutation {
+	return cauo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (cauo *CarAttributeUpdateOne) ClearCar() *CarAttributeUpdateOne {
+	cauo.mutation.ClearCar()
+	return cauo
+}
+
+// ClearDefinition clears the "definition" edge to the AttributeDefinition entity.
+func (cauo *CarAttributeUpdateOne) ClearDefinition() *CarAttributeUpdateOne {
+	cauo.mutation.ClearDefinition()
+	return cauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cauo *CarAttributeUpdateOne) Select(field string, fields ...string) *CarAttributeUpdateOne {
+	cauo.fields = append([]string{field}, fields...)
+	return cauo
+}
+
+// Save executes the query and returns the updated CarAttribute entity.
+func (cauo *CarAttributeUpdateOne) Save(ctx context.Context) (*CarAttribute, error) {
+	var (
+		err  error
+		node *CarAttribute
+	)
+	if len(cauo.hooks) == 0 {
+		node, err = cauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarAttributeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cauo.mutation = mutation
+			node, err = cauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cauo.hooks) - 1; i >= 0; i-- {
+			if cauo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cauo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, cauo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*CarAttribute)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CarAttributeMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cauo *CarAttributeUpdateOne) SaveX(ctx context.Context) *CarAttribute {
+	node, err := cauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cauo *CarAttributeUpdateOne) Exec(ctx context.Context) error {
+	_, err := cauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cauo *CarAttributeUpdateOne) ExecX(ctx context.Context) {
+	if err := cauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cauo *CarAttributeUpdateOne) sqlSave(ctx context.Context) (_node *CarAttribute, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   carattribute.Table,
+			Columns: carattribute.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carattribute.FieldID,
+			},
+		},
+	}
+	id, ok := cauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CarAttribute.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, carattribute.FieldID)
+		for _, f := range fields {
+			if !carattribute.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != carattribute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cauo.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carattribute.FieldValue,
+		})
+	}
+	if cauo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.CarTable,
+			Columns: []string{carattribute.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cauo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.CarTable,
+			Columns: []string{carattribute.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cauo.mutation.DefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.DefinitionTable,
+			Columns: []string{carattribute.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attributedefinition.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cauo.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.DefinitionTable,
+			Columns: []string{carattribute.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: attributedefinition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CarAttribute{config: cauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carattribute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
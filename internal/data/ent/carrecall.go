@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/recall"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// CarRecall is the model entity for the CarRecall schema.
+type CarRecall struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// RecallID holds the value of the "recall_id" field.
+	RecallID int64 `json:"recall_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// RemediedAt holds the value of the "remedied_at" field.
+	RemediedAt *time.Time `json:"remedied_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CarRecallQuery when eager-loading is set.
+	Edges CarRecallEdges `json:"edges"`
+}
+
+// CarRecallEdges holds the relations/edges for other nodes in the graph.
+type CarRecallEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// Recall holds the value of the recall edge.
+	Recall *Recall `json:"recall,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarRecallEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// RecallOrErr returns the Recall value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarRecallEdges) RecallOrErr() (*Recall, error) {
+	if e.loadedTypes[1] {
+		if e.Recall == nil {
+			// The edge recall was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: recall.Label}
+		}
+		return e.Recall, nil
+	}
+	return nil, &NotLoadedError{edge: "recall"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CarRecall) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case carrecall.FieldID, carrecall.FieldTenantID, carrecall.FieldCarID, carrecall.FieldRecallID:
+			values[i] = new(sql.NullInt64)
+		case carrecall.FieldStatus, carrecall.FieldNotes:
+			values[i] = new(sql.NullString)
+		case carrecall.FieldRemediedAt, carrecall.FieldCreatedAt, carrecall.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CarRecall", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CarRecall fields.
+func (cr *CarRecall) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case carrecall.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cr.ID = int64(value.Int64)
+		case carrecall.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				cr.TenantID = value.Int64
+			}
+		case carrecall.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				cr.CarID = value.Int64
+			}
+		case carrecall.FieldRecallID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recall_id", values[i])
+			} else if value.Valid {
+				cr.RecallID = value.Int64
+			}
+		case carrecall.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				cr.Status = value.String
+			}
+		case carrecall.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				cr.Notes = value.String
+			}
+		case carrecall.FieldRemediedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field remedied_at", values[i])
+			} else if value.Valid {
+				cr.RemediedAt = new(time.Time)
+				*cr.RemediedAt = value.Time
+			}
+		case carrecall.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cr.CreatedAt = value.Time
+			}
+		case carrecall.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cr.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the CarRecall entity.
+func (cr *CarRecall) QueryCar() *CarQuery {
+	return (&CarRecallClient{config: cr.config}).QueryCar(cr)
+}
+
+// QueryRecall queries the "recall" edge of the CarRecall entity.
+func (cr *CarRecall) QueryRecall() *RecallQuery {
+	return (&CarRecallClient{config: cr.config}).QueryRecall(cr)
+}
+
+// Update returns a builder for updating this CarRecall.
+// Note that you need to call CarRecall.Unwrap() before calling this method if this CarRecall
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *CarRecall) Update() *CarRecallUpdateOne {
+	return (&CarRecallClient{config: cr.config}).UpdateOne(cr)
+}
+
+// Unwrap unwraps the CarRecall entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *CarRecall) Unwrap() *CarRecall {
+	_tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CarRecall is not a transactional entity")
+	}
+	cr.config.driver = _tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *CarRecall) String() string {
+	var builder strings.Builder
+	builder.WriteString("CarRecall(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cr.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("recall_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.RecallID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(cr.Status)
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(cr.Notes)
+	builder.WriteString(", ")
+	if v := cr.RemediedAt; v != nil {
+		builder.WriteString("remedied_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CarRecalls is a parsable slice of CarRecall.
+type CarRecalls []*CarRecall
+
+func (cr CarRecalls) config(cfg config) {
+	for _i := range cr {
+		cr[_i].config = cfg
+	}
+}
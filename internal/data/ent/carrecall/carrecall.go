@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package carrecall
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the carrecall type in the database.
+	Label = "car_recall"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldRecallID holds the string denoting the recall_id field in the database.
+	FieldRecallID = "recall_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldRemediedAt holds the string denoting the remedied_at field in the database.
+	FieldRemediedAt = "remedied_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// EdgeRecall holds the string denoting the recall edge name in mutations.
+	EdgeRecall = "recall"
+	// Table holds the table name of the carrecall in the database.
+	Table = "car_recall"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "car_recall"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+	// RecallTable is the table that holds the recall relation/edge.
+	RecallTable = "car_recall"
+	// RecallInverseTable is the table name for the Recall entity.
+	// It exists in this package in order to avoid circular dependency with the "recall" package.
+	RecallInverseTable = "recall"
+	// RecallColumn is the table column denoting the recall relation/edge.
+	RecallColumn = "recall_id"
+)
+
+// Columns holds all SQL columns for carrecall fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldRecallID,
+	FieldStatus,
+	FieldNotes,
+	FieldRemediedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
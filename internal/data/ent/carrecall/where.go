@@ -0,0 +1,918 @@
+// Code generated by ent, DO NOT EDIT.
+
+package carrecall
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// RecallID applies equality check predicate on the "recall_id" field. It's identical to RecallIDEQ.
+func RecallID(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecallID), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// RemediedAt applies equality check predicate on the "remedied_at" field. It's identical to RemediedAtEQ.
+func RemediedAt(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemediedAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// RecallIDEQ applies the EQ predicate on the "recall_id" field.
+func RecallIDEQ(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecallID), v))
+	})
+}
+
+// RecallIDNEQ applies the NEQ predicate on the "recall_id" field.
+func RecallIDNEQ(v int64) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRecallID), v))
+	})
+}
+
+// RecallIDIn applies the In predicate on the "recall_id" field.
+func RecallIDIn(vs ...int64) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRecallID), v...))
+	})
+}
+
+// RecallIDNotIn applies the NotIn predicate on the "recall_id" field.
+func RecallIDNotIn(vs ...int64) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRecallID), v...))
+	})
+}
+
+// RecallIDIsNil applies the IsNil predicate on the "recall_id" field.
+func RecallIDIsNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRecallID)))
+	})
+}
+
+// RecallIDNotNil applies the NotNil predicate on the "recall_id" field.
+func RecallIDNotNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRecallID)))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNotes), v))
+	})
+}
+
+// RemediedAtEQ applies the EQ predicate on the "remedied_at" field.
+func RemediedAtEQ(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemediedAt), v))
+	})
+}
+
+// RemediedAtNEQ applies the NEQ predicate on the "remedied_at" field.
+func RemediedAtNEQ(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRemediedAt), v))
+	})
+}
+
+// RemediedAtIn applies the In predicate on the "remedied_at" field.
+func RemediedAtIn(vs ...time.Time) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRemediedAt), v...))
+	})
+}
+
+// RemediedAtNotIn applies the NotIn predicate on the "remedied_at" field.
+func RemediedAtNotIn(vs ...time.Time) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRemediedAt), v...))
+	})
+}
+
+// RemediedAtGT applies the GT predicate on the "remedied_at" field.
+func RemediedAtGT(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRemediedAt), v))
+	})
+}
+
+// RemediedAtGTE applies the GTE predicate on the "remedied_at" field.
+func RemediedAtGTE(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRemediedAt), v))
+	})
+}
+
+// RemediedAtLT applies the LT predicate on the "remedied_at" field.
+func RemediedAtLT(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRemediedAt), v))
+	})
+}
+
+// RemediedAtLTE applies the LTE predicate on the "remedied_at" field.
+func RemediedAtLTE(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRemediedAt), v))
+	})
+}
+
+// RemediedAtIsNil applies the IsNil predicate on the "remedied_at" field.
+func RemediedAtIsNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRemediedAt)))
+	})
+}
+
+// RemediedAtNotNil applies the NotNil predicate on the "remedied_at" field.
+func RemediedAtNotNil() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRemediedAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CarRecall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarRecall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecall applies the HasEdge predicate on the "recall" edge.
+func HasRecall() predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RecallTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RecallTable, RecallColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecallWith applies the HasEdge predicate on the "recall" edge with a given conditions (other predicates).
+func HasRecallWith(preds ...predicate.Recall) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RecallInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RecallTable, RecallColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CarRecall) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CarRecall) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CarRecall) predicate.CarRecall {
+	return predicate.CarRecall(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
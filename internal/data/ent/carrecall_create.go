@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/recall"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarRecallCreate is the builder for creating a CarRecall entity.
+type CarRecallCreate struct {
+	config
+	mutation *CarRecallMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (crc *CarRecallCreate) SetTenantID(i int64) *CarRecallCreate {
+	crc.mutation.SetTenantID(i)
+	return crc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (crc *CarRecallCreate) SetNillableTenantID(i *int64) *CarRecallCreate {
+	if i != nil {
+		crc.SetTenantID(*i)
+	}
+	return crc
+}
+
+// SetCarID sets the "car_id" field.
+func (crc *CarRecallCreate) SetCarID(i int64) *CarRecallCreate {
+	crc.mutation.SetCarID(i)
+	return crc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (crc *CarRecallCreate) SetNillableCarID(i *int64) *CarRecallCreate {
+	if i != nil {
+		crc.SetCarID(*i)
+	}
+	return crc
+}
+
+// SetRecallID sets the "recall_id" field.
+func (crc *CarRecallCreate) SetRecallID(i int64) *CarRecallCreate {
+	crc.mutation.SetRecallID(i)
+	return crc
+}
+
+// SetNillableRecallID sets the "recall_id" field if the given value is not nil.
+func (crc *CarRecallCreate) SetNillableRecallID(i *int64) *CarRecallCreate {
+	if i != nil {
+		crc.SetRecallID(*i)
+	}
+	return crc
+}
+
+// SetStatus sets the "status" field.
+func (crc *CarRecallCreate) SetStatus(s string) *CarRecallCreate {
+	crc.mutation.SetStatus(s)
+	return crc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (crc *CarRecallCreate) SetNillableStatus(s *string) *CarRecallCreate {
+	if s != nil {
+		crc.SetStatus(*s)
+	}
+	return crc
+}
+
+// SetNotes sets the "notes" field.
+func (crc *CarRecallCreate) SetNotes(s string) *CarRecallCreate {
+	crc.mutation.SetNotes(s)
+	return crc
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (crc *CarRecallCreate) SetNillableNotes(s *string) *CarRecallCreate {
+	if s != nil {
+		crc.SetNotes(*s)
+	}
+	return crc
+}
+
+// SetRemediedAt sets the "remedied_at" field.
+func (crc *CarRecallCreate) SetRemediedAt(t time.Time) *CarRecallCreate {
+	crc.mutation.SetRemediedAt(t)
+	return crc
+}
+
+// SetNillableRemediedAt sets the "remedied_at" field if the given value is not nil.
+func (crc *CarRecallCreate) SetNillableRemediedAt(t *time.Time) *CarRecallCreate {
+	if t != nil {
+		crc.SetRemediedAt(*t)
+	}
+	return crc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (crc *CarRecallCreate) SetCreatedAt(t time.Time) *CarRecallCreate {
+	crc.mutation.SetCreatedAt(t)
+	return crc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (crc *CarRecallCreate) SetNillableCreatedAt(t *time.Time) *CarRecallCreate {
+	if t != nil {
+		crc.SetCreatedAt(*t)
+	}
+	return crc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (crc *CarRecallCreate) SetUpdatedAt(t time.Time) *CarRecallCreate {
+	crc.mutation.SetUpdatedAt(t)
+	return crc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (crc *CarRecallCreate) SetNillableUpdatedAt(t *time.Time) *CarRecallCreate {
+	if t != nil {
+		crc.SetUpdatedAt(*t)
+	}
+	return crc
+}
+
+// SetID sets the "id" field.
+func (crc *CarRecallCreate) SetID(i int64) *CarRecallCreate {
+	crc.mutation.SetID(i)
+	return crc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (crc *CarRecallCreate) SetCar(c *Car) *CarRecallCreate {
+	return crc.SetCarID(c.ID)
+}
+
+// SetRecall sets the "recall" edge to the Recall entity.
+func (crc *CarRecallCreate) SetRecall(r *Recall) *CarRecallCreate {
+	return crc.SetRecallID(r.ID)
+}
+
+// Mutation returns the CarRecallMutation object of the builder.
+func (crc *CarRecallCreate) Mutation() *CarRecallMutation {
+	return crc.mutation
+}
+
+// Save creates the CarRecall in the database.
+func (crc *CarRecallCreate) Save(ctx context.Context) (*CarRecall, error) {
+	var (
+		err  error
+		node *CarRecall
+	)
+	if err := crc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(crc.hooks) == 0 {
+		if err = crc.check(); err != nil {
+			return nil, err
+		}
+		node, err = crc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarRecallMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = crc.check(); err != nil {
+				return nil, err
+			}
+			crc.mutation = mutation
+			if node, err = crc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(crc.hooks) - 1; i >= 0; i-- {
+			if crc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = crc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, crc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*CarRecall)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CarRecallMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *CarRecallCreate) SaveX(ctx context.Context) *CarRecall {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *CarRecallCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *CarRecallCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (crc *CarRecallCreate) defaults() error {
+	if _, ok := crc.mutation.Status(); !ok {
+		v := carrecall.DefaultStatus
+		crc.mutation.SetStatus(v)
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		if carrecall.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized carrecall.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := carrecall.DefaultCreatedAt()
+		crc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		if carrecall.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized carrecall.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := carrecall.DefaultUpdatedAt()
+		crc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *CarRecallCreate) check() error {
+	if _, ok := crc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CarRecall.status"`)}
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CarRecall.created_at"`)}
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CarRecall.updated_at"`)}
+	}
+	return nil
+}
+
+func (crc *CarRecallCreate) sqlSave(ctx context.Context) (*CarRecall, error) {
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (crc *CarRecallCreate) createSpec() (*CarRecall, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CarRecall{config: crc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: carrecall.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carrecall.FieldID,
+			},
+		}
+	)
+	if id, ok := crc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := crc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carrecall.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := crc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carrecall.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := crc.mutation.Notes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carrecall.FieldNotes,
+		})
+		_node.Notes = value
+	}
+	if value, ok := crc.mutation.RemediedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carrecall.FieldRemediedAt,
+		})
+		_node.RemediedAt = &value
+	}
+	if value, ok := crc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carrecall.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := crc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carrecall.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := crc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.CarTable,
+			Columns: []string{carrecall.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := crc.mutation.RecallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.RecallTable,
+			Columns: []string{carrecall.RecallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: recall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RecallID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CarRecallCreateBulk is the builder for creating many CarRecall entities in bulk.
+type CarRecallCreateBulk struct {
+	config
+	builders []*CarRecallCreate
+}
+
+// Save creates the CarRecall entities in the database.
+func (crcb *CarRecallCreateBulk) Save(ctx context.Context) ([]*CarRecall, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*CarRecall, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CarRecallMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *CarRecallCreateBulk) SaveX(ctx context.Context) []*CarRecall {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *CarRecallCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *CarRecallCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
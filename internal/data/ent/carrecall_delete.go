@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarRecallDelete is the builder for deleting a CarRecall entity.
+type CarRecallDelete struct {
+	config
+	hooks    []Hook
+	mutation *CarRecallMutation
+}
+
+// Where appends a list predicates to the CarRecallDelete builder.
+func (crd *CarRecallDelete) Where(ps ...predicate.CarRecall) *CarRecallDelete {
+	crd.mutation.Where(ps...)
+	return crd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (crd *CarRecallDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(crd.hooks) == 0 {
+		affected, err = crd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarRecallMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			crd.mutation = mutation
+			affected, err = crd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(crd.hooks) - 1; i >= 0; i-- {
+			if crd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = crd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, crd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crd *CarRecallDelete) ExecX(ctx context.Context) int {
+	n, err := crd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (crd *CarRecallDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: carrecall.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carrecall.FieldID,
+			},
+		},
+	}
+	if ps := crd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, crd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// CarRecallDeleteOne is the builder for deleting a single CarRecall entity.
+type CarRecallDeleteOne struct {
+	crd *CarRecallDelete
+}
+
+// Exec executes the deletion query.
+func (crdo *CarRecallDeleteOne) Exec(ctx context.Context) error {
+	n, err := crdo.crd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{carrecall.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crdo *CarRecallDeleteOne) ExecX(ctx context.Context) {
+	crdo.crd.ExecX(ctx)
+}
@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/recall"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarRecallQuery is the builder for querying CarRecall entities.
+type CarRecallQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CarRecall
+	// eager-loading edges.
+	withCar    *CarQuery
+	withRecall *RecallQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CarRecallQuery builder.
+func (crq *CarRecallQuery) Where(ps ...predicate.CarRecall) *CarRecallQuery {
+	crq.predicates = append(crq.predicates, ps...)
+	return crq
+}
+
+// Limit adds a limit step to the query.
+func (crq *CarRecallQuery) Limit(limit int) *CarRecallQuery {
+	crq.limit = &limit
+	return crq
+}
+
+// Offset adds an offset step to the query.
+func (crq *CarRecallQuery) Offset(offset int) *CarRecallQuery {
+	crq.offset = &offset
+	return crq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (crq *CarRecallQuery) Unique(unique bool) *CarRecallQuery {
+	crq.unique = &unique
+	return crq
+}
+
+// Order adds an order step to the query.
+func (crq *CarRecallQuery) Order(o ...OrderFunc) *CarRecallQuery {
+	crq.order = append(crq.order, o...)
+	return crq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (crq *CarRecallQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: crq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carrecall.Table, carrecall.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carrecall.CarTable, carrecall.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecall chains the current query on the "recall" edge.
+func (crq *CarRecallQuery) QueryRecall() *RecallQuery {
+	query := &RecallQuery{config: crq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carrecall.Table, carrecall.FieldID, selector),
+			sqlgraph.To(recall.Table, recall.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carrecall.RecallTable, carrecall.RecallColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CarRecall entity from the query.
+// Returns a *NotFoundError when no CarRecall was found.
+func (crq *CarRecallQuery) First(ctx context.Context) (*CarRecall, error) {
+	nodes, err := crq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{carrecall.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (crq *CarRecallQuery) FirstX(ctx context.Context) *CarRecall {
+	node, err := crq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CarRecall ID from the query.
+// Returns a *NotFoundError when no CarRecall ID was found.
+func (crq *CarRecallQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = crq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{carrecall.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (crq *CarRecallQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := crq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CarRecall entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CarRecall entity is found.
+// Returns a *NotFoundError when no CarRecall entities are found.
+func (crq *CarRecallQuery) Only(ctx context.Context) (*CarRecall, error) {
+	nodes, err := crq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{carrecall.Label}
+	default:
+		return nil, &NotSingularError{carrecall.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (crq *CarRecallQuery) OnlyX(ctx context.Context) *CarRecall {
+	node, err := crq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CarRecall ID in the query.
+// Returns a *NotSingularError when more than one CarRecall ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (crq *CarRecallQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = crq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{carrecall.Label}
+	default:
+		err = &NotSingularError{carrecall.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (crq *CarRecallQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := crq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CarRecalls.
+func (crq *CarRecallQuery) All(ctx context.Context) ([]*CarRecall, error) {
+	if err := crq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return crq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (crq *CarRecallQuery) AllX(ctx context.Context) []*CarRecall {
+	nodes, err := crq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CarRecall IDs.
+func (crq *CarRecallQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := crq.Select(carrecall.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (crq *CarRecallQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := crq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (crq *CarRecallQuery) Count(ctx context.Context) (int, error) {
+	if err := crq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return crq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (crq *CarRecallQuery) CountX(ctx context.Context) int {
+	count, err := crq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (crq *CarRecallQuery) Exist(ctx context.Context) (bool, error) {
+	if err := crq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return crq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (crq *CarRecallQuery) ExistX(ctx context.Context) bool {
+	exist, err := crq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CarRecallQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (crq *CarRecallQuery) Clone() *CarRecallQuery {
+	if crq == nil {
+		return nil
+	}
+	return &CarRecallQuery{
+		config:     crq.config,
+		limit:      crq.limit,
+		offset:     crq.offset,
+		order:      append([]OrderFunc{}, crq.order...),
+		predicates: append([]predicate.CarRecall{}, crq.predicates...),
+		withCar:    crq.withCar.Clone(),
+		withRecall: crq.withRecall.Clone(),
+		// clone intermediate query.
+		sql:    crq.sql.Clone(),
+		path:   crq.path,
+		unique: crq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *CarRecallQuery) WithCar(opts ...func(*CarQuery)) *CarRecallQuery {
+	query := &CarQuery{config: crq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withCar = query
+	return crq
+}
+
+// WithRecall tells the query-builder to eager-load the nodes that are connected to
+// the "recall" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *CarRecallQuery) WithRecall(opts ...func(*RecallQuery)) *CarRecallQuery {
+	query := &RecallQuery{config: crq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withRecall = query
+	return crq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CarRecall.Query().
+//		GroupBy(carrecall.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (crq *CarRecallQuery) GroupBy(field string, fields ...string) *CarRecallGroupBy {
+	grbuild := &CarRecallGroupBy{config: crq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return crq.sqlQuery(ctx), nil
+	}
+	grbuild.label = carrecall.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.CarRecall.Query().
+//		Select(carrecall.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (crq *CarRecallQuery) Select(fields ...string) *CarRecallSelect {
+	crq.fields = append(crq.fields, fields...)
+	selbuild := &CarRecallSelect{CarRecallQuery: crq}
+	selbuild.label = carrecall.Label
+	selbuild.flds, selbuild.scan = &crq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (crq *CarRecallQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range crq.fields {
+		if !carrecall.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if crq.path != nil {
+		prev, err := crq.path(ctx)
+		if err != nil {
+			return err
+		}
+		crq.sql = prev
+	}
+	if carrecall.Policy == nil {
+		return errors.New("ent: uninitialized carrecall.Policy (forgotten import ent/runtime?)")
+	}
+	if err := carrecall.Policy.EvalQuery(ctx, crq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (crq *CarRecallQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CarRecall, error) {
+	var (
+		nodes       = []*CarRecall{}
+		_spec       = crq.querySpec()
+		loadedTypes = [2]bool{
+			crq.withCar != nil,
+			crq.withRecall != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*CarRecall).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &CarRecall{config: crq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, crq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := crq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*CarRecall)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	if query := crq.withRecall; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*CarRecall)
+		for i := range nodes {
+			fk := nodes[i].RecallID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(recall.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "recall_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Recall = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (crq *CarRecallQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := crq.querySpec()
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	_spec.Node.Columns = crq.fields
+	if len(crq.fields) > 0 {
+		_spec.Unique = crq.unique != nil && *crq.unique
+	}
+	return sqlgraph.CountNodes(ctx, crq.driver, _spec)
+}
+
+func (crq *CarRecallQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := crq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (crq *CarRecallQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   carrecall.Table,
+			Columns: carrecall.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carrecall.FieldID,
+			},
+		},
+		From:   crq.sql,
+		Unique: true,
+	}
+	if unique := crq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := crq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, carrecall.FieldID)
+		for i := range fields {
+			if fields[i] != carrecall.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := crq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := crq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := crq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := crq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (crq *CarRecallQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(crq.driver.Dialect())
+	t1 := builder.Table(carrecall.Table)
+	columns := crq.fields
+	if len(columns) == 0 {
+		columns = carrecall.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if crq.sql != nil {
+		selector = crq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if crq.unique != nil && *crq.unique {
+		selector.Distinct()
+	}
+	for _, m := range crq.modifiers {
+		m(selector)
+	}
+	for _, p := range crq.predicates {
+		p(selector)
+	}
+	for _, p := range crq.order {
+		p(selector)
+	}
+	if offset := crq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := crq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (crq *CarRecallQuery) ForUpdate(opts ...sql.LockOption) *CarRecallQuery {
+	if crq.driver.Dialect() == dialect.Postgres {
+		crq.Unique(false)
+	}
+	crq.modifiers = append(crq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return crq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (crq *CarRecallQuery) ForShare(opts ...sql.LockOption) *CarRecallQuery {
+	if crq.driver.Dialect() == dialect.Postgres {
+		crq.Unique(false)
+	}
+	crq.modifiers = append(crq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return crq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (crq *CarRecallQuery) Modify(modifiers ...func(s *sql.Selector)) *CarRecallSelect {
+	crq.modifiers = append(crq.modifiers, modifiers...)
+	return crq.Select()
+}
+
+// CarRecallGroupBy is the group-by builder for CarRecall entities.
+type CarRecallGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (crgb *CarRecallGroupBy) Aggregate(fns ...AggregateFunc) *CarRecallGroupBy {
+	crgb.fns = append(crgb.fns, fns...)
+	return crgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (crgb *CarRecallGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := crgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	crgb.sql = query
+	return crgb.sqlScan(ctx, v)
+}
+
+func (crgb *CarRecallGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range crgb.fields {
+		if !carrecall.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := crgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (crgb *CarRecallGroupBy) sqlQuery() *sql.Selector {
+	selector := crgb.sql.Select()
+	aggregation := make([]string, 0, len(crgb.fns))
+	for _, fn := range crgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(crgb.fields)+len(crgb.fns))
+		for _, f := range crgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(crgb.fields...)...)
+}
+
+// CarRecallSelect is the builder for selecting fields of CarRecall entities.
+type CarRecallSelect struct {
+	*CarRecallQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crs *CarRecallSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := crs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	crs.sql = crs.CarRecallQuery.sqlQuery(ctx)
+	return crs.sqlScan(ctx, v)
+}
+
+func (crs *CarRecallSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := crs.sql.Query()
+	if err := crs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (crs *CarRecallSelect) Modify(modifiers ...func(s *sql.Selector)) *CarRecallSelect {
+	crs.modifiers = append(crs.modifiers, modifiers...)
+	return crs
+}
@@ -0,0 +1,864 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/recall"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarRecallUpdate is the builder for updating CarRecall entities.
+type CarRecallUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CarRecallMutation
+}
+
+// Where appends a list predicates to the CarRecallUpdate builder.
+func (cru *CarRecallUpdate) Where(ps ...predicate.CarRecall) *CarRecallUpdate {
+	cru.mutation.Where(ps...)
+	return cru
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cru *CarRecallUpdate) SetTenantID(i int64) *CarRecallUpdate {
+	cru.mutation.ResetTenantID()
+	cru.mutation.SetTenantID(i)
+	return cru
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cru *CarRecallUpdate) SetNillableTenantID(i *int64) *CarRecallUpdate {
+	if i != nil {
+		cru.SetTenantID(*i)
+	}
+	return cru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (cru *CarRecallUpdate) AddTenantID(i int64) *CarRecallUpdate {
+	cru.mutation.AddTenantID(i)
+	return cru
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (cru *CarRecallUpdate) ClearTenantID() *CarRecallUpdate {
+	cru.mutation.ClearTenantID()
+	return cru
+}
+
+// SetCarID sets the "car_id" field.
+func (cru *CarRecallUpdate) SetCarID(i int64) *CarRecallUpdate {
+	cru.mutation.SetCarID(i)
+	return cru
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (cru *CarRecallUpdate) SetNillableCarID(i *int64) *CarRecallUpdate {
+	if i != nil {
+		cru.SetCarID(*i)
+	}
+	return cru
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (cru *CarRecallUpdate) ClearCarID() *CarRecallUpdate {
+	cru.mutation.ClearCarID()
+	return cru
+}
+
+// SetRecallID sets the "recall_id" field.
+func (cru *CarRecallUpdate) SetRecallID(i int64) *CarRecallUpdate {
+	cru.mutation.SetRecallID(i)
+	return cru
+}
+
+// SetNillableRecallID sets the "recall_id" field if the given value is not nil.
+func (cru *CarRecallUpdate) SetNillableRecallID(i *int64) *CarRecallUpdate {
+	if i != nil {
+		cru.SetRecallID(*i)
+	}
+	return cru
+}
+
+// ClearRecallID clears the value of the "recall_id" field.
+func (cru *CarRecallUpdate) ClearRecallID() *CarRecallUpdate {
+	cru.mutation.ClearRecallID()
+	return cru
+}
+
+// SetStatus sets the "status" field.
+func (cru *CarRecallUpdate) SetStatus(s string) *CarRecallUpdate {
+	cru.mutation.SetStatus(s)
+	return cru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cru *CarRecallUpdate) SetNillableStatus(s *string) *CarRecallUpdate {
+	if s != nil {
+		cru.SetStatus(*s)
+	}
+	return cru
+}
+
+// SetNotes sets the "notes" field.
+func (cru *CarRecallUpdate) SetNotes(s string) *CarRecallUpdate {
+	cru.mutation.SetNotes(s)
+	return cru
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (cru *CarRecallUpdate) SetNillableNotes(s *string) *CarRecallUpdate {
+	if s != nil {
+		cru.SetNotes(*s)
+	}
+	return cru
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (cru *CarRecallUpdate) ClearNotes() *CarRecallUpdate {
+	cru.mutation.ClearNotes()
+	return cru
+}
+
+// SetRemediedAt sets the "remedied_at" field.
+func (cru *CarRecallUpdate) SetRemediedAt(t time.Time) *CarRecallUpdate {
+	cru.mutation.SetRemediedAt(t)
+	return cru
+}
+
+// SetNillableRemediedAt sets the "remedied_at" field if the given value is not nil.
+func (cru *CarRecallUpdate) SetNillableRemediedAt(t *time.Time) *CarRecallUpdate {
+	if t != nil {
+		cru.SetRemediedAt(*t)
+	}
+	return cru
+}
+
+// ClearRemediedAt clears the value of the "remedied_at" field.
+func (cru *CarRecallUpdate) ClearRemediedAt() *CarRecallUpdate {
+	cru.mutation.ClearRemediedAt()
+	return cru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cru *CarRecallUpdate) SetCreatedAt(t time.Time) *CarRecallUpdate {
+	cru.mutation.SetCreatedAt(t)
+	return cru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cru *CarRecallUpdate) SetNillableCreatedAt(t *time.Time) *CarRecallUpdate {
+	if t != nil {
+		cru.SetCreatedAt(*t)
+	}
+	return cru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cru *CarRecallUpdate) SetUpdatedAt(t time.Time) *CarRecallUpdate {
+	cru.mutation.SetUpdatedAt(t)
+	return cru
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cru *CarRecallUpdate) SetNillableUpdatedAt(t *time.Time) *CarRecallUpdate {
+	if t != nil {
+		cru.SetUpdatedAt(*t)
+	}
+	return cru
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (cru *CarRecallUpdate) SetCar(c *Car) *CarRecallUpdate {
+	return cru.SetCarID(c.ID)
+}
+
+// SetRecall sets the "recall" edge to the Recall entity.
+func (cru *CarRecallUpdate) SetRecall(r *Recall) *CarRecallUpdate {
+	return cru.SetRecallID(r.ID)
+}
+
+// Mutation returns the CarRecallMutation object of the builder.
+func (cru *CarRecallUpdate) Mutation() *CarRecallMutation {
+	return cru.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (cru *CarRecallUpdate) ClearCar() *CarRecallUpdate {
+	cru.mutation.ClearCar()
+	return cru
+}
+
+// ClearRecall clears the "recall" edge to the Recall entity.
+func (cru *CarRecallUpdate) ClearRecall() *CarRecallUpdate {
+	cru.mutation.ClearRecall()
+	return cru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cru *CarRecallUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cru.hooks) == 0 {
+		affected, err = cru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarRecallMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cru.mutation = mutation
+			affected, err = cru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cru.hooks) - 1; i >= 0; i-- {
+			if cru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *CarRecallUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *CarRecallUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *CarRecallUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cru *CarRecallUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   carrecall.Table,
+			Columns: carrecall.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carrecall.FieldID,
+			},
+		},
+	}
+	if ps := cru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cru.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carrecall.FieldTenantID,
+		})
+	}
+	if value, ok := cru.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carrecall.FieldTenantID,
+		})
+	}
+	if cru.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: carrecall.FieldTenantID,
+		})
+	}
+	if value, ok := cru.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carrecall.FieldStatus,
+		})
+	}
+	if value, ok := cru.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carrecall.FieldNotes,
+		})
+	}
+	if cru.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: carrecall.FieldNotes,
+		})
+	}
+	if value, ok := cru.mutation.RemediedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carrecall.FieldRemediedAt,
+		})
+	}
+	if cru.mutation.RemediedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: carrecall.FieldRemediedAt,
+		})
+	}
+	if value, ok := cru.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carrecall.FieldCreatedAt,
+		})
+	}
+	if value, ok := cru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carrecall.FieldUpdatedAt,
+		})
+	}
+	if cru.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.CarTable,
+			Columns: []string{carrecall.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.CarTable,
+			Columns: []string{carrecall.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cru.mutation.RecallCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.RecallTable,
+			Columns: []string{carrecall.RecallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: recall.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.RecallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.RecallTable,
+			Columns: []string{carrecall.RecallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: recall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carrecall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CarRecallUpdateOne is the builder for updating a single CarRecall entity.
+type CarRecallUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CarRecallMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cruo *CarRecallUpdateOne) SetTenantID(i int64) *CarRecallUpdateOne {
+	cruo.mutation.ResetTenantID()
+	cruo.mutation.SetTenantID(i)
+	return cruo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cruo *CarRecallUpdateOne) SetNillableTenantID(i *int64) *CarRecallUpdateOne {
+	if i != nil {
+		cruo.SetTenantID(*i)
+	}
+	return cruo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (cruo *CarRecallUpdateOne) AddTenantID(i int64) *CarRecallUpdateOne {
+	cruo.mutation.AddTenantID(i)
+	return cruo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (cruo *CarRecallUpdateOne) ClearTenantID() *CarRecallUpdateOne {
+	cruo.mutation.ClearTenantID()
+	return cruo
+}
+
+// SetCarID sets the "car_id" field.
+func (cruo *CarRecallUpdateOne) SetCarID(i int64) *CarRecallUpdateOne {
+	cruo.mutation.SetCarID(i)
+	return cruo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (cruo *CarRecallUpdateOne) SetNillableCarID(i *int64) *CarRecallUpdateOne {
+	if i != nil {
+		cruo.SetCarID(*i)
+	}
+	return cruo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (cruo *CarRecallUpdateOne) ClearCarID() *CarRecallUpdateOne {
+	cruo.mutation.ClearCarID()
+	return cruo
+}
+
+// SetRecallID sets the "recall_id" field.
+func (cruo *CarRecallUpdateOne) SetRecallID(i int64) *CarRecallUpdateOne {
+	cruo.mutation.SetRecallID(i)
+	return cruo
+}
+
+// SetNillableRecallID sets the "recall_id" field if the given value is not nil.
+func (cruo *CarRecallUpdateOne) SetNillableRecallID(i *int64) *CarRecallUpdateOne {
+	if i != nil {
+		cruo.SetRecallID(*i)
+	}
+	return cruo
+}
+
+// ClearRecallID clears the value of the "recall_id" field.
+func (cruo *CarRecallUpdateOne) ClearRecallID() *CarRecallUpdateOne {
+	cruo.mutation.ClearRecallID()
+	return cruo
+}
+
+// SetStatus sets the "status" field.
+func (cruo *CarRecallUpdateOne) SetStatus(s string) *CarRecallUpdateOne {
+	cruo.mutation.SetStatus(s)
+	return cruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cruo *CarRecallUpdateOne) SetNillableStatus(s *string) *CarRecallUpdateOne {
+	if s != nil {
+		cruo.SetStatus(*s)
+	}
+	return cruo
+}
+
+// SetNotes sets the "notes" field.
+func (cruo *CarRecallUpdateOne) SetNotes(s string) *CarRecallUpdateOne {
+	cruo.mutation.SetNotes(s)
+	return cruo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (cruo *CarRecallUpdateOne) SetNillableNotes(s *string) *CarRecallUpdateOne {
+	if s != nil {
+		cruo.SetNotes(*s)
+	}
+	return cruo
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (cruo *CarRecallUpdateOne) ClearNotes() *CarRecallUpdateOne {
+	cruo.mutation.ClearNotes()
+	return cruo
+}
+
+// SetRemediedAt sets the "remedied_at" field.
+func (cruo *CarRecallUpdateOne) SetRemediedAt(t time.Time) *CarRecallUpdateOne {
+	cruo.mutation.SetRemediedAt(t)
+	return cruo
+}
+
+// SetNillableRemediedAt sets the "remedied_at" field if the given value is not nil.
+func (cruo *CarRecallUpdateOne) SetNillableRemediedAt(t *time.Time) *CarRecallUpdateOne {
+	if t != nil {
+		cruo.SetRemediedAt(*t)
+	}
+	return cruo
+}
+
+// ClearRemediedAt clears the value of the "remedied_at" field.
+func (cruo *CarRecallUpdateOne) ClearRemediedAt() *CarRecallUpdateOne {
+	cruo.mutation.ClearRemediedAt()
+	return cruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cruo *CarRecallUpdateOne) SetCreatedAt(t time.Time) *CarRecallUpdateOne {
+	cruo.mutation.SetCreatedAt(t)
+	return cruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cruo *CarRecallUpdateOne) SetNillableCreatedAt(t *time.Time) *CarRecallUpdateOne {
+	if t != nil {
+		cruo.SetCreatedAt(*t)
+	}
+	return cruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cruo *CarRecallUpdateOne) SetUpdatedAt(t time.Time) *CarRecallUpdateOne {
+	cruo.mutation.SetUpdatedAt(t)
+	return cruo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cruo *CarRecallUpdateOne) SetNillableUpdatedAt(t *time.Time) *CarRecallUpdateOne {
+	if t != nil {
+		cruo.SetUpdatedAt(*t)
+	}
+	return cruo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (cruo *CarRecallUpdateOne) SetCar(c *Car) *CarRecallUpdateOne {
+	return cruo.SetCarID(c.ID)
+}
+
+// SetRecall sets the "recall" edge to the Recall entity.
+func (cruo *CarRecallUpdateOne) SetRecall(r *Recall) *CarRecallUpdateOne {
+	return cruo.SetRecallID(r.ID)
+}
+
+// Mutation returns the CarRecallMutation object of the builder.
+func (cruo *CarRecallUpdateOne) Mutation() *CarRecallMutation {
+	return cruo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (cruo *CarRecallUpdateOne) ClearCar() *CarRecallUpdateOne {
+	cruo.mutation.ClearCar()
+	return cruo
+}
+
+// ClearRecall clears the "recall" edge to the Recall entity.
+func (cruo *CarRecallUpdateOne) ClearRecall() *CarRecallUpdateOne {
+	cruo.mutation.ClearRecall()
+	return cruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cruo *CarRecallUpdateOne) Select(field string, fields ...string) *CarRecallUpdateOne {
+	cruo.fields = append([]string{field}, fields...)
+	return cruo
+}
+
+// Save executes the query and returns the updated CarRecall entity.
+func (cruo *CarRecallUpdateOne) Save(ctx context.Context) (*CarRecall, error) {
+	var (
+		err  error
+		node *CarRecall
+	)
+	if len(cruo.hooks) == 0 {
+		node, err = cruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarRecallMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cruo.mutation = mutation
+			node, err = cruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cruo.hooks) - 1; i >= 0; i-- {
+			if cruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, cruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*CarRecall)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CarRecallMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *CarRecallUpdateOne) SaveX(ctx context.Context) *CarRecall {
+	node, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cruo *CarRecallUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *CarRecallUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cruo *CarRecallUpdateOne) sqlSave(ctx context.Context) (_node *CarRecall, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   carrecall.Table,
+			Columns: carrecall.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carrecall.FieldID,
+			},
+		},
+	}
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CarRecall.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, carrecall.FieldID)
+		for _, f := range fields {
+			if !carrecall.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != carrecall.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cruo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carrecall.FieldTenantID,
+		})
+	}
+	if value, ok := cruo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carrecall.FieldTenantID,
+		})
+	}
+	if cruo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: carrecall.FieldTenantID,
+		})
+	}
+	if value, ok := cruo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carrecall.FieldStatus,
+		})
+	}
+	if value, ok := cruo.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carrecall.FieldNotes,
+		})
+	}
+	if cruo.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: carrecall.FieldNotes,
+		})
+	}
+	if value, ok := cruo.mutation.RemediedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carrecall.FieldRemediedAt,
+		})
+	}
+	if cruo.mutation.RemediedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: carrecall.FieldRemediedAt,
+		})
+	}
+	if value, ok := cruo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carrecall.FieldCreatedAt,
+		})
+	}
+	if value, ok := cruo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carrecall.FieldUpdatedAt,
+		})
+	}
+	if cruo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.CarTable,
+			Columns: []string{carrecall.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.CarTable,
+			Columns: []string{carrecall.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cruo.mutation.RecallCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.RecallTable,
+			Columns: []string{carrecall.RecallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: recall.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.RecallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.RecallTable,
+			Columns: []string{carrecall.RecallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: recall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CarRecall{config: cruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carrecall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/warrantydefinition"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// CarWarranty is the model entity for the CarWarranty schema.
+type CarWarranty struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// DefinitionID holds the value of the "definition_id" field.
+	DefinitionID int64 `json:"definition_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Components holds the value of the "components" field.
+	Components string `json:"components,omitempty"`
+	// StartAt holds the value of the "start_at" field.
+	StartAt time.Time `json:"start_at,omitempty"`
+	// EndAt holds the value of the "end_at" field.
+	EndAt time.Time `json:"end_at,omitempty"`
+	// MileageLimit holds the value of the "mileage_limit" field.
+	MileageLimit int64 `json:"mileage_limit,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CarWarrantyQuery when eager-loading is set.
+	Edges CarWarrantyEdges `json:"edges"`
+}
+
+// CarWarrantyEdges holds the relations/edges for other nodes in the graph.
+type CarWarrantyEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// Definition holds the value of the definition edge.
+	Definition *WarrantyDefinition `json:"definition,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarWarrantyEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// DefinitionOrErr returns the Definition value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarWarrantyEdges) DefinitionOrErr() (*WarrantyDefinition, error) {
+	if e.loadedTypes[1] {
+		if e.Definition == nil {
+			// The edge definition was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: warrantydefinition.Label}
+		}
+		return e.Definition, nil
+	}
+	return nil, &NotLoadedError{edge: "definition"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CarWarranty) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case carwarranty.FieldID, carwarranty.FieldTenantID, carwarranty.FieldCarID, carwarranty.FieldDefinitionID, carwarranty.FieldMileageLimit:
+			values[i] = new(sql.NullInt64)
+		case carwarranty.FieldName, carwarranty.FieldComponents:
+			values[i] = new(sql.NullString)
+		case carwarranty.FieldStartAt, carwarranty.FieldEndAt, carwarranty.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CarWarranty", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CarWarranty fields.
+func (cw *CarWarranty) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case carwarranty.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cw.ID = int64(value.Int64)
+		case carwarranty.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				cw.TenantID = value.Int64
+			}
+		case carwarranty.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				cw.CarID = value.Int64
+			}
+		case carwarranty.FieldDefinitionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field definition_id", values[i])
+			} else if value.Valid {
+				cw.DefinitionID = value.Int64
+			}
+		case carwarranty.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cw.Name = value.String
+			}
+		case carwarranty.FieldComponents:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field components", values[i])
+			} else if value.Valid {
+				cw.Components = value.String
+			}
+		case carwarranty.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				cw.StartAt = value.Time
+			}
+		case carwarranty.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				cw.EndAt = value.Time
+			}
+		case carwarranty.FieldMileageLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mileage_limit", values[i])
+			} else if value.Valid {
+				cw.MileageLimit = value.Int64
+			}
+		case carwarranty.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cw.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the CarWarranty entity.
+func (cw *CarWarranty) QueryCar() *CarQuery {
+	return (&CarWarrantyClient{config: cw.config}).QueryCar(cw)
+}
+
+// QueryDefinition queries the "definition" edge of the CarWarranty entity.
+func (cw *CarWarranty) QueryDefinition() *WarrantyDefinitionQuery {
+	return (&CarWarrantyClient{config: cw.config}).QueryDefinition(cw)
+}
+
+// Update returns a builder for updating this CarWarranty.
+// Note that you need to call CarWarranty.Unwrap() before calling this method if this CarWarranty
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cw *CarWarranty) Update() *CarWarrantyUpdateOne {
+	return (&CarWarrantyClient{config: cw.config}).UpdateOne(cw)
+}
+
+// Unwrap unwraps the CarWarranty entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cw *CarWarranty) Unwrap() *CarWarranty {
+	_tx, ok := cw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CarWarranty is not a transactional entity")
+	}
+	cw.config.driver = _tx.drv
+	return cw
+}
+
+// String implements the fmt.Stringer.
+func (cw *CarWarranty) String() string {
+	var builder strings.Builder
+	builder.WriteString("CarWarranty(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cw.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", cw.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", cw.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("definition_id=")
+	builder.WriteString(fmt.Sprintf("%v", cw.DefinitionID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(cw.Name)
+	builder.WriteString(", ")
+	builder.WriteString("components=")
+	builder.WriteString(cw.Components)
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(cw.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(cw.EndAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("mileage_limit=")
+	builder.WriteString(fmt.Sprintf("%v", cw.MileageLimit))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cw.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CarWarranties is a parsable slice of CarWarranty.
+type CarWarranties []*CarWarranty
+
+func (cw CarWarranties) config(cfg config) {
+	for _i := range cw {
+		cw[_i].config = cfg
+	}
+}
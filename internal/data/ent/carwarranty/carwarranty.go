@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package carwarranty
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the carwarranty type in the database.
+	Label = "car_warranty"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldDefinitionID holds the string denoting the definition_id field in the database.
+	FieldDefinitionID = "definition_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldComponents holds the string denoting the components field in the database.
+	FieldComponents = "components"
+	// FieldStartAt holds the string denoting the start_at field in the database.
+	FieldStartAt = "start_at"
+	// FieldEndAt holds the string denoting the end_at field in the database.
+	FieldEndAt = "end_at"
+	// FieldMileageLimit holds the string denoting the mileage_limit field in the database.
+	FieldMileageLimit = "mileage_limit"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// EdgeDefinition holds the string denoting the definition edge name in mutations.
+	EdgeDefinition = "definition"
+	// Table holds the table name of the carwarranty in the database.
+	Table = "car_warranty"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "car_warranty"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+	// DefinitionTable is the table that holds the definition relation/edge.
+	DefinitionTable = "car_warranty"
+	// DefinitionInverseTable is the table name for the WarrantyDefinition entity.
+	// It exists in this package in order to avoid circular dependency with the "warrantydefinition" package.
+	DefinitionInverseTable = "warranty_definition"
+	// DefinitionColumn is the table column denoting the definition relation/edge.
+	DefinitionColumn = "definition_id"
+)
+
+// Columns holds all SQL columns for carwarranty fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldDefinitionID,
+	FieldName,
+	FieldComponents,
+	FieldStartAt,
+	FieldEndAt,
+	FieldMileageLimit,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
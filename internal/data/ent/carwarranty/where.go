@@ -0,0 +1,1043 @@
+// Code generated by ent, DO NOT EDIT.
+
+package carwarranty
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// DefinitionID applies equality check predicate on the "definition_id" field. It's identical to DefinitionIDEQ.
+func DefinitionID(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDefinitionID), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Components applies equality check predicate on the "components" field. It's identical to ComponentsEQ.
+func Components(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldComponents), v))
+	})
+}
+
+// StartAt applies equality check predicate on the "start_at" field. It's identical to StartAtEQ.
+func StartAt(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartAt), v))
+	})
+}
+
+// EndAt applies equality check predicate on the "end_at" field. It's identical to EndAtEQ.
+func EndAt(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndAt), v))
+	})
+}
+
+// MileageLimit applies equality check predicate on the "mileage_limit" field. It's identical to MileageLimitEQ.
+func MileageLimit(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileageLimit), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// DefinitionIDEQ applies the EQ predicate on the "definition_id" field.
+func DefinitionIDEQ(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDefinitionID), v))
+	})
+}
+
+// DefinitionIDNEQ applies the NEQ predicate on the "definition_id" field.
+func DefinitionIDNEQ(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDefinitionID), v))
+	})
+}
+
+// DefinitionIDIn applies the In predicate on the "definition_id" field.
+func DefinitionIDIn(vs ...int64) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDefinitionID), v...))
+	})
+}
+
+// DefinitionIDNotIn applies the NotIn predicate on the "definition_id" field.
+func DefinitionIDNotIn(vs ...int64) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDefinitionID), v...))
+	})
+}
+
+// DefinitionIDIsNil applies the IsNil predicate on the "definition_id" field.
+func DefinitionIDIsNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDefinitionID)))
+	})
+}
+
+// DefinitionIDNotNil applies the NotNil predicate on the "definition_id" field.
+func DefinitionIDNotNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDefinitionID)))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldName)))
+	})
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldName)))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// ComponentsEQ applies the EQ predicate on the "components" field.
+func ComponentsEQ(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsNEQ applies the NEQ predicate on the "components" field.
+func ComponentsNEQ(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsIn applies the In predicate on the "components" field.
+func ComponentsIn(vs ...string) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldComponents), v...))
+	})
+}
+
+// ComponentsNotIn applies the NotIn predicate on the "components" field.
+func ComponentsNotIn(vs ...string) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldComponents), v...))
+	})
+}
+
+// ComponentsGT applies the GT predicate on the "components" field.
+func ComponentsGT(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsGTE applies the GTE predicate on the "components" field.
+func ComponentsGTE(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsLT applies the LT predicate on the "components" field.
+func ComponentsLT(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsLTE applies the LTE predicate on the "components" field.
+func ComponentsLTE(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsContains applies the Contains predicate on the "components" field.
+func ComponentsContains(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsHasPrefix applies the HasPrefix predicate on the "components" field.
+func ComponentsHasPrefix(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsHasSuffix applies the HasSuffix predicate on the "components" field.
+func ComponentsHasSuffix(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsIsNil applies the IsNil predicate on the "components" field.
+func ComponentsIsNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldComponents)))
+	})
+}
+
+// ComponentsNotNil applies the NotNil predicate on the "components" field.
+func ComponentsNotNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldComponents)))
+	})
+}
+
+// ComponentsEqualFold applies the EqualFold predicate on the "components" field.
+func ComponentsEqualFold(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsContainsFold applies the ContainsFold predicate on the "components" field.
+func ComponentsContainsFold(v string) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldComponents), v))
+	})
+}
+
+// StartAtEQ applies the EQ predicate on the "start_at" field.
+func StartAtEQ(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartAt), v))
+	})
+}
+
+// StartAtNEQ applies the NEQ predicate on the "start_at" field.
+func StartAtNEQ(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartAt), v))
+	})
+}
+
+// StartAtIn applies the In predicate on the "start_at" field.
+func StartAtIn(vs ...time.Time) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartAt), v...))
+	})
+}
+
+// StartAtNotIn applies the NotIn predicate on the "start_at" field.
+func StartAtNotIn(vs ...time.Time) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartAt), v...))
+	})
+}
+
+// StartAtGT applies the GT predicate on the "start_at" field.
+func StartAtGT(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartAt), v))
+	})
+}
+
+// StartAtGTE applies the GTE predicate on the "start_at" field.
+func StartAtGTE(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartAt), v))
+	})
+}
+
+// StartAtLT applies the LT predicate on the "start_at" field.
+func StartAtLT(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartAt), v))
+	})
+}
+
+// StartAtLTE applies the LTE predicate on the "start_at" field.
+func StartAtLTE(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartAt), v))
+	})
+}
+
+// StartAtIsNil applies the IsNil predicate on the "start_at" field.
+func StartAtIsNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartAt)))
+	})
+}
+
+// StartAtNotNil applies the NotNil predicate on the "start_at" field.
+func StartAtNotNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartAt)))
+	})
+}
+
+// EndAtEQ applies the EQ predicate on the "end_at" field.
+func EndAtEQ(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndAt), v))
+	})
+}
+
+// EndAtNEQ applies the NEQ predicate on the "end_at" field.
+func EndAtNEQ(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndAt), v))
+	})
+}
+
+// EndAtIn applies the In predicate on the "end_at" field.
+func EndAtIn(vs ...time.Time) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndAt), v...))
+	})
+}
+
+// EndAtNotIn applies the NotIn predicate on the "end_at" field.
+func EndAtNotIn(vs ...time.Time) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndAt), v...))
+	})
+}
+
+// EndAtGT applies the GT predicate on the "end_at" field.
+func EndAtGT(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndAt), v))
+	})
+}
+
+// EndAtGTE applies the GTE predicate on the "end_at" field.
+func EndAtGTE(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndAt), v))
+	})
+}
+
+// EndAtLT applies the LT predicate on the "end_at" field.
+func EndAtLT(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndAt), v))
+	})
+}
+
+// EndAtLTE applies the LTE predicate on the "end_at" field.
+func EndAtLTE(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndAt), v))
+	})
+}
+
+// EndAtIsNil applies the IsNil predicate on the "end_at" field.
+func EndAtIsNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndAt)))
+	})
+}
+
+// EndAtNotNil applies the NotNil predicate on the "end_at" field.
+func EndAtNotNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndAt)))
+	})
+}
+
+// MileageLimitEQ applies the EQ predicate on the "mileage_limit" field.
+func MileageLimitEQ(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitNEQ applies the NEQ predicate on the "mileage_limit" field.
+func MileageLimitNEQ(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitIn applies the In predicate on the "mileage_limit" field.
+func MileageLimitIn(vs ...int64) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMileageLimit), v...))
+	})
+}
+
+// MileageLimitNotIn applies the NotIn predicate on the "mileage_limit" field.
+func MileageLimitNotIn(vs ...int64) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMileageLimit), v...))
+	})
+}
+
+// MileageLimitGT applies the GT predicate on the "mileage_limit" field.
+func MileageLimitGT(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitGTE applies the GTE predicate on the "mileage_limit" field.
+func MileageLimitGTE(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitLT applies the LT predicate on the "mileage_limit" field.
+func MileageLimitLT(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitLTE applies the LTE predicate on the "mileage_limit" field.
+func MileageLimitLTE(v int64) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitIsNil applies the IsNil predicate on the "mileage_limit" field.
+func MileageLimitIsNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMileageLimit)))
+	})
+}
+
+// MileageLimitNotNil applies the NotNil predicate on the "mileage_limit" field.
+func MileageLimitNotNil() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMileageLimit)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CarWarranty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDefinition applies the HasEdge predicate on the "definition" edge.
+func HasDefinition() predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DefinitionTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DefinitionTable, DefinitionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDefinitionWith applies the HasEdge predicate on the "definition" edge with a given conditions (other predicates).
+func HasDefinitionWith(preds ...predicate.WarrantyDefinition) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DefinitionInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DefinitionTable, DefinitionColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CarWarranty) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CarWarranty) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CarWarranty) predicate.CarWarranty {
+	return predicate.CarWarranty(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/warrantydefinition"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarWarrantyCreate is the builder for creating a CarWarranty entity.
+type CarWarrantyCreate struct {
+	config
+	mutation *CarWarrantyMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cwc *CarWarrantyCreate) SetTenantID(i int64) *CarWarrantyCreate {
+	cwc.mutation.SetTenantID(i)
+	return cwc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cwc *CarWarrantyCreate) SetNillableTenantID(i *int64) *CarWarrantyCreate {
+	if i != nil {
+		cwc.SetTenantID(*i)
+	}
+	return cwc
+}
+
+// SetCarID sets the "car_id" field.
+func (cwc *CarWarrantyCreate) SetCarID(i int64) *CarWarrantyCreate {
+	cwc.mutation.SetCarID(i)
+	return cwc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (cwc *CarWarrantyCreate) SetNillableCarID(i *int64) *CarWarrantyCreate {
+	if i != nil {
+		cwc.SetCarID(*i)
+	}
+	return cwc
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (cwc *CarWarrantyCreate) SetDefinitionID(i int64) *CarWarrantyCreate {
+	cwc.mutation.SetDefinitionID(i)
+	return cwc
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (cwc *CarWarrantyCreate) SetNillableDefinitionID(i *int64) *CarWarrantyCreate {
+	if i != nil {
+		cwc.SetDefinitionID(*i)
+	}
+	return cwc
+}
+
+// SetName sets the "name" field.
+func (cwc *CarWarrantyCreate) SetName(s string) *CarWarrantyCreate {
+	cwc.mutation.SetName(s)
+	return cwc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cwc *CarWarrantyCreate) SetNillableName(s *string) *CarWarrantyCreate {
+	if s != nil {
+		cwc.SetName(*s)
+	}
+	return cwc
+}
+
+// SetComponents sets the "components" field.
+func (cwc *CarWarrantyCreate) SetComponents(s string) *CarWarrantyCreate {
+	cwc.mutation.SetComponents(s)
+	return cwc
+}
+
+// SetNillableComponents sets the "components" field if the given value is not nil.
+func (cwc *CarWarrantyCreate) SetNillableComponents(s *string) *CarWarrantyCreate {
+	if s != nil {
+		cwc.SetComponents(*s)
+	}
+	return cwc
+}
+
+// SetStartAt sets the "start_at" field.
+func (cwc *CarWarrantyCreate) SetStartAt(t time.Time) *CarWarrantyCreate {
+	cwc.mutation.SetStartAt(t)
+	return cwc
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (cwc *CarWarrantyCreate) SetNillableStartAt(t *time.Time) *CarWarrantyCreate {
+	if t != nil {
+		cwc.SetStartAt(*t)
+	}
+	return cwc
+}
+
+// SetEndAt sets the "end_at" field.
+func (cwc *CarWarrantyCreate) SetEndAt(t time.Time) *CarWarrantyCreate {
+	cwc.mutation.SetEndAt(t)
+	return cwc
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (cwc *CarWarrantyCreate) SetNillableEndAt(t *time.Time) *CarWarrantyCreate {
+	if t != nil {
+		cwc.SetEndAt(*t)
+	}
+	return cwc
+}
+
+// SetMileageLimit sets the "mileage_limit" field.
+func (cwc *CarWarrantyCreate) SetMileageLimit(i int64) *CarWarrantyCreate {
+	cwc.mutation.SetMileageLimit(i)
+	return cwc
+}
+
+// SetNillableMileageLimit sets the "mileage_limit" field if the given value is not nil.
+func (cwc *CarWarrantyCreate) SetNillableMileageLimit(i *int64) *CarWarrantyCreate {
+	if i != nil {
+		cwc.SetMileageLimit(*i)
+	}
+	return cwc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cwc *CarWarrantyCreate) SetCreatedAt(t time.Time) *CarWarrantyCreate {
+	cwc.mutation.SetCreatedAt(t)
+	return cwc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cwc *CarWarrantyCreate) SetNillableCreatedAt(t *time.Time) *CarWarrantyCreate {
+	if t != nil {
+		cwc.SetCreatedAt(*t)
+	}
+	return cwc
+}
+
+// SetID sets the "id" field.
+func (cwc *CarWarrantyCreate) SetID(i int64) *CarWarrantyCreate {
+	cwc.mutation.SetID(i)
+	return cwc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (cwc *CarWarrantyCreate) SetCar(c *Car) *CarWarrantyCreate {
+	return cwc.SetCarID(c.ID)
+}
+
+// SetDefinition sets the "definition" edge to the WarrantyDefinition entity.
+func (cwc *CarWarrantyCreate) SetDefinition(w *WarrantyDefinition) *CarWarrantyCreate {
+	return cwc.SetDefinitionID(w.ID)
+}
+
+// Mutation returns the CarWarrantyMutation object of the builder.
+func (cwc *CarWarrantyCreate) Mutation() *CarWarrantyMutation {
+	return cwc.mutation
+}
+
+// Save creates the CarWarranty in the database.
+func (cwc *CarWarrantyCreate) Save(ctx context.Context) (*CarWarranty, error) {
+	var (
+		err  error
+		node *CarWarranty
+	)
+	if err := cwc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(cwc.hooks) == 0 {
+		if err = cwc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cwc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarWarrantyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cwc.check(); err != nil {
+				return nil, err
+			}
+			cwc.mutation = mutation
+			if node, err = cwc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cwc.hooks) - 1; i >= 0; i-- {
+			if cwc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cwc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, cwc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*CarWarranty)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CarWarrantyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cwc *CarWarrantyCreate) SaveX(ctx context.Context) *CarWarranty {
+	v, err := cwc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cwc *CarWarrantyCreate) Exec(ctx context.Context) error {
+	_, err := cwc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cwc *CarWarrantyCreate) ExecX(ctx context.Context) {
+	if err := cwc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cwc *CarWarrantyCreate) defaults() error {
+	if _, ok := cwc.mutation.CreatedAt(); !ok {
+		if carwarranty.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized carwarranty.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := carwarranty.DefaultCreatedAt()
+		cwc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cwc *CarWarrantyCreate) check() error {
+	if _, ok := cwc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CarWarranty.created_at"`)}
+	}
+	return nil
+}
+
+func (cwc *CarWarrantyCreate) sqlSave(ctx context.Context) (*CarWarranty, error) {
+	_node, _spec := cwc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cwc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (cwc *CarWarrantyCreate) createSpec() (*CarWarranty, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CarWarranty{config: cwc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: carwarranty.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carwarranty.FieldID,
+			},
+		}
+	)
+	if id, ok := cwc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cwc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := cwc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carwarranty.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := cwc.mutation.Components(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carwarranty.FieldComponents,
+		})
+		_node.Components = value
+	}
+	if value, ok := cwc.mutation.StartAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carwarranty.FieldStartAt,
+		})
+		_node.StartAt = value
+	}
+	if value, ok := cwc.mutation.EndAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carwarranty.FieldEndAt,
+		})
+		_node.EndAt = value
+	}
+	if value, ok := cwc.mutation.MileageLimit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldMileageLimit,
+		})
+		_node.MileageLimit = value
+	}
+	if value, ok := cwc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carwarranty.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := cwc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.CarTable,
+			Columns: []string{carwarranty.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cwc.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.DefinitionTable,
+			Columns: []string{carwarranty.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: warrantydefinition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DefinitionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CarWarrantyCreateBulk is the builder for creating many CarWarranty entities in bulk.
+type CarWarrantyCreateBulk struct {
+	config
+	builders []*CarWarrantyCreate
+}
+
+// Save creates the CarWarranty entities in the database.
+func (cwcb *CarWarrantyCreateBulk) Save(ctx context.Context) ([]*CarWarranty, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cwcb.builders))
+	nodes := make([]*CarWarranty, len(cwcb.builders))
+	mutators := make([]Mutator, len(cwcb.builders))
+	for i := range cwcb.builders {
+		func(i int, root context.Context) {
+			builder := cwcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CarWarrantyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cwcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cwcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cwcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cwcb *CarWarrantyCreateBulk) SaveX(ctx context.Context) []*CarWarranty {
+	v, err := cwcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cwcb *CarWarrantyCreateBulk) Exec(ctx context.Context) error {
+	_, err := cwcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cwcb *CarWarrantyCreateBulk) ExecX(ctx context.Context) {
+	if err := cwcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
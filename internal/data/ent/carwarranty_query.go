@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/warrantydefinition"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarWarrantyQuery is the builder for querying CarWarranty entities.
+type CarWarrantyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CarWarranty
+	// eager-loading edges.
+	withCar        *CarQuery
+	withDefinition *WarrantyDefinitionQuery
+	modifiers      []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CarWarrantyQuery builder.
+func (cwq *CarWarrantyQuery) Where(ps ...predicate.CarWarranty) *CarWarrantyQuery {
+	cwq.predicates = append(cwq.predicates, ps...)
+	return cwq
+}
+
+// Limit adds a limit step to the query.
+func (cwq *CarWarrantyQuery) Limit(limit int) *CarWarrantyQuery {
+	cwq.limit = &limit
+	return cwq
+}
+
+// Offset adds an offset step to the query.
+func (cwq *CarWarrantyQuery) Offset(offset int) *CarWarrantyQuery {
+	cwq.offset = &offset
+	return cwq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cwq *CarWarrantyQuery) Unique(unique bool) *CarWarrantyQuery {
+	cwq.unique = &unique
+	return cwq
+}
+
+// Order adds an order step to the query.
+func (cwq *CarWarrantyQuery) Order(o ...OrderFunc) *CarWarrantyQuery {
+	cwq.order = append(cwq.order, o...)
+	return cwq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (cwq *CarWarrantyQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: cwq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cwq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cwq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carwarranty.Table, carwarranty.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carwarranty.CarTable, carwarranty.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cwq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDefinition chains the current query on the "definition" edge.
+func (cwq *CarWarrantyQuery) QueryDefinition() *WarrantyDefinitionQuery {
+	query := &WarrantyDefinitionQuery{config: cwq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cwq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cwq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carwarranty.Table, carwarranty.FieldID, selector),
+			sqlgraph.To(warrantydefinition.Table, warrantydefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carwarranty.DefinitionTable, carwarranty.DefinitionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cwq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CarWarranty entity from the query.
+// Returns a *NotFoundError when no CarWarranty was found.
+func (cwq *CarWarrantyQuery) First(ctx context.Context) (*CarWarranty, error) {
+	nodes, err := cwq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{carwarranty.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cwq *CarWarrantyQuery) FirstX(ctx context.Context) *CarWarranty {
+	node, err := cwq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CarWarranty ID from the query.
+// Returns a *NotFoundError when no CarWarranty ID was found.
+func (cwq *CarWarrantyQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = cwq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{carwarranty.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cwq *CarWarrantyQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := cwq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CarWarranty entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CarWarranty entity is found.
+// Returns a *NotFoundError when no CarWarranty entities are found.
+func (cwq *CarWarrantyQuery) Only(ctx context.Context) (*CarWarranty, error) {
+	nodes, err := cwq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{carwarranty.Label}
+	default:
+		return nil, &NotSingularError{carwarranty.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cwq *CarWarrantyQuery) OnlyX(ctx context.Context) *CarWarranty {
+	node, err := cwq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CarWarranty ID in the query.
+// Returns a *NotSingularError when more than one CarWarranty ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cwq *CarWarrantyQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = cwq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{carwarranty.Label}
+	default:
+		err = &NotSingularError{carwarranty.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cwq *CarWarrantyQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := cwq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CarWarranties.
+func (cwq *CarWarrantyQuery) All(ctx context.Context) ([]*CarWarranty, error) {
+	if err := cwq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return cwq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cwq *CarWarrantyQuery) AllX(ctx context.Context) []*CarWarranty {
+	nodes, err := cwq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CarWarranty IDs.
+func (cwq *CarWarrantyQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := cwq.Select(carwarranty.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cwq *CarWarrantyQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := cwq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cwq *CarWarrantyQuery) Count(ctx context.Context) (int, error) {
+	if err := cwq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return cwq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cwq *CarWarrantyQuery) CountX(ctx context.Context) int {
+	count, err := cwq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cwq *CarWarrantyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := cwq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return cwq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cwq *CarWarrantyQuery) ExistX(ctx context.Context) bool {
+	exist, err := cwq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CarWarrantyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cwq *CarWarrantyQuery) Clone() *CarWarrantyQuery {
+	if cwq == nil {
+		return nil
+	}
+	return &CarWarrantyQuery{
+		config:         cwq.config,
+		limit:          cwq.limit,
+		offset:         cwq.offset,
+		order:          append([]OrderFunc{}, cwq.order...),
+		predicates:     append([]predicate.CarWarranty{}, cwq.predicates...),
+		withCar:        cwq.withCar.Clone(),
+		withDefinition: cwq.withDefinition.Clone(),
+		// clone intermediate query.
+		sql:    cwq.sql.Clone(),
+		path:   cwq.path,
+		unique: cwq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (cwq *CarWarrantyQuery) WithCar(opts ...func(*CarQuery)) *CarWarrantyQuery {
+	query := &CarQuery{config: cwq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cwq.withCar = query
+	return cwq
+}
+
+// WithDefinition tells the query-builder to eager-load the nodes that are connected to
+// the "definition" edge. The optional arguments are used to configure the query builder of the edge.
+func (cwq *CarWarrantyQuery) WithDefinition(opts ...func(*WarrantyDefinitionQuery)) *CarWarrantyQuery {
+	query := &WarrantyDefinitionQuery{config: cwq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cwq.withDefinition = query
+	return cwq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CarWarranty.Query().
+//		GroupBy(carwarranty.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (cwq *CarWarrantyQuery) GroupBy(field string, fields ...string) *CarWarrantyGroupBy {
+	grbuild := &CarWarrantyGroupBy{config: cwq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cwq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cwq.sqlQuery(ctx), nil
+	}
+	grbuild.label = carwarranty.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.CarWarranty.Query().
+//		Select(carwarranty.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (cwq *CarWarrantyQuery) Select(fields ...string) *CarWarrantySelect {
+	cwq.fields = append(cwq.fields, fields...)
+	selbuild := &CarWarrantySelect{CarWarrantyQuery: cwq}
+	selbuild.label = carwarranty.Label
+	selbuild.flds, selbuild.scan = &cwq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (cwq *CarWarrantyQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range cwq.fields {
+		if !carwarranty.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cwq.path != nil {
+		prev, err := cwq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cwq.sql = prev
+	}
+	if carwarranty.Policy == nil {
+		return errors.New("ent: uninitialized carwarranty.Policy (forgotten import ent/runtime?)")
+	}
+	if err := carwarranty.Policy.EvalQuery(ctx, cwq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cwq *CarWarrantyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CarWarranty, error) {
+	var (
+		nodes       = []*CarWarranty{}
+		_spec       = cwq.querySpec()
+		loadedTypes = [2]bool{
+			cwq.withCar != nil,
+			cwq.withDefinition != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*CarWarranty).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &CarWarranty{config: cwq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(cwq.modifiers) > 0 {
+		_spec.Modifiers = cwq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cwq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := cwq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*CarWarranty)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	if query := cwq.withDefinition; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*CarWarranty)
+		for i := range nodes {
+			fk := nodes[i].DefinitionID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(warrantydefinition.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "definition_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Definition = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (cwq *CarWarrantyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cwq.querySpec()
+	if len(cwq.modifiers) > 0 {
+		_spec.Modifiers = cwq.modifiers
+	}
+	_spec.Node.Columns = cwq.fields
+	if len(cwq.fields) > 0 {
+		_spec.Unique = cwq.unique != nil && *cwq.unique
+	}
+	return sqlgraph.CountNodes(ctx, cwq.driver, _spec)
+}
+
+func (cwq *CarWarrantyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := cwq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (cwq *CarWarrantyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   carwarranty.Table,
+			Columns: carwarranty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carwarranty.FieldID,
+			},
+		},
+		From:   cwq.sql,
+		Unique: true,
+	}
+	if unique := cwq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := cwq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, carwarranty.FieldID)
+		for i := range fields {
+			if fields[i] != carwarranty.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cwq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cwq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cwq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cwq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cwq *CarWarrantyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cwq.driver.Dialect())
+	t1 := builder.Table(carwarranty.Table)
+	columns := cwq.fields
+	if len(columns) == 0 {
+		columns = carwarranty.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cwq.sql != nil {
+		selector = cwq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cwq.unique != nil && *cwq.unique {
+		selector.Distinct()
+	}
+	for _, m := range cwq.modifiers {
+		m(selector)
+	}
+	for _, p := range cwq.predicates {
+		p(selector)
+	}
+	for _, p := range cwq.order {
+		p(selector)
+	}
+	if offset := cwq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cwq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cwq *CarWarrantyQuery) ForUpdate(opts ...sql.LockOption) *CarWarrantyQuery {
+	if cwq.driver.Dialect() == dialect.Postgres {
+		cwq.Unique(false)
+	}
+	cwq.modifiers = append(cwq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cwq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cwq *CarWarrantyQuery) ForShare(opts ...sql.LockOption) *CarWarrantyQuery {
+	if cwq.driver.Dialect() == dialect.Postgres {
+		cwq.Unique(false)
+	}
+	cwq.modifiers = append(cwq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cwq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cwq *CarWarrantyQuery) Modify(modifiers ...func(s *sql.Selector)) *CarWarrantySelect {
+	cwq.modifiers = append(cwq.modifiers, modifiers...)
+	return cwq.Select()
+}
+
+// CarWarrantyGroupBy is the group-by builder for CarWarranty entities.
+type CarWarrantyGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cwgb *CarWarrantyGroupBy) Aggregate(fns ...AggregateFunc) *CarWarrantyGroupBy {
+	cwgb.fns = append(cwgb.fns, fns...)
+	return cwgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cwgb *CarWarrantyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cwgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cwgb.sql = query
+	return cwgb.sqlScan(ctx, v)
+}
+
+func (cwgb *CarWarrantyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cwgb.fields {
+		if !carwarranty.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cwgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cwgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cwgb *CarWarrantyGroupBy) sqlQuery() *sql.Selector {
+	selector := cwgb.sql.Select()
+	aggregation := make([]string, 0, len(cwgb.fns))
+	for _, fn := range cwgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(cwgb.fields)+len(cwgb.fns))
+		for _, f := range cwgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(cwgb.fields...)...)
+}
+
+// CarWarrantySelect is the builder for selecting fields of CarWarranty entities.
+type CarWarrantySelect struct {
+	*CarWarrantyQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cws *CarWarrantySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cws.sql = cws.CarWarrantyQuery.sqlQuery(ctx)
+	return cws.sqlScan(ctx, v)
+}
+
+func (cws *CarWarrantySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cws.sql.Query()
+	if err := cws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cws *CarWarrantySelect) Modify(modifiers ...func(s *sql.Selector)) *CarWarrantySelect {
+	cws.modifiers = append(cws.modifiers, modifiers...)
+	return cws
+}
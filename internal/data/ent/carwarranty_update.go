@@ -0,0 +1,1006 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/warrantydefinition"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CarWarrantyUpdate is the builder for updating CarWarranty entities.
+type CarWarrantyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CarWarrantyMutation
+}
+
+// Where appends a list predicates to the CarWarrantyUpdate builder.
+func (cwu *CarWarrantyUpdate) Where(ps ...predicate.CarWarranty) *CarWarrantyUpdate {
+	cwu.mutation.Where(ps...)
+	return cwu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cwu *CarWarrantyUpdate) SetTenantID(i int64) *CarWarrantyUpdate {
+	cwu.mutation.ResetTenantID()
+	cwu.mutation.SetTenantID(i)
+	return cwu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cwu *CarWarrantyUpdate) SetNillableTenantID(i *int64) *CarWarrantyUpdate {
+	if i != nil {
+		cwu.SetTenantID(*i)
+	}
+	return cwu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (cwu *CarWarrantyUpdate) AddTenantID(i int64) *CarWarrantyUpdate {
+	cwu.mutation.AddTenantID(i)
+	return cwu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (cwu *CarWarrantyUpdate) ClearTenantID() *CarWarrantyUpdate {
+	cwu.mutation.ClearTenantID()
+	return cwu
+}
+
+// SetCarID sets the "car_id" field.
+func (cwu *CarWarrantyUpdate) SetCarID(i int64) *CarWarrantyUpdate {
+	cwu.mutation.SetCarID(i)
+	return cwu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (cwu *CarWarrantyUpdate) SetNillableCarID(i *int64) *CarWarrantyUpdate {
+	if i != nil {
+		cwu.SetCarID(*i)
+	}
+	return cwu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (cwu *CarWarrantyUpdate) ClearCarID() *CarWarrantyUpdate {
+	cwu.mutation.ClearCarID()
+	return cwu
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (cwu *CarWarrantyUpdate) SetDefinitionID(i int64) *CarWarrantyUpdate {
+	cwu.mutation.SetDefinitionID(i)
+	return cwu
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (cwu *CarWarrantyUpdate) SetNillableDefinitionID(i *int64) *CarWarrantyUpdate {
+	if i != nil {
+		cwu.SetDefinitionID(*i)
+	}
+	return cwu
+}
+
+// ClearDefinitionID clears the value of the "definition_id" field.
+func (cwu *CarWarrantyUpdate) ClearDefinitionID() *CarWarrantyUpdate {
+	cwu.mutation.ClearDefinitionID()
+	return cwu
+}
+
+// SetName sets the "name" field.
+func (cwu *CarWarrantyUpdate) SetName(s string) *CarWarrantyUpdate {
+	cwu.mutation.SetName(s)
+	return cwu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cwu *CarWarrantyUpdate) SetNillableName(s *string) *CarWarrantyUpdate {
+	if s != nil {
+		cwu.SetName(*s)
+	}
+	return cwu
+}
+
+// ClearName clears the value of the "name" field.
+func (cwu *CarWarrantyUpdate) ClearName() *CarWarrantyUpdate {
+	cwu.mutation.ClearName()
+	return cwu
+}
+
+// SetComponents sets the "components" field.
+func (cwu *CarWarrantyUpdate) SetComponents(s string) *CarWarrantyUpdate {
+	cwu.mutation.SetComponents(s)
+	return cwu
+}
+
+// SetNillableComponents sets the "components" field if the given value is not nil.
+func (cwu *CarWarrantyUpdate) SetNillableComponents(s *string) *CarWarrantyUpdate {
+	if s != nil {
+		cwu.SetComponents(*s)
+	}
+	return cwu
+}
+
+// ClearComponents clears the value of the "components" field.
+func (cwu *CarWarrantyUpdate) ClearComponents() *CarWarrantyUpdate {
+	cwu.mutation.ClearComponents()
+	return cwu
+}
+
+// SetStartAt sets the "start_at" field.
+func (cwu *CarWarrantyUpdate) SetStartAt(t time.Time) *CarWarrantyUpdate {
+	cwu.mutation.SetStartAt(t)
+	return cwu
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (cwu *CarWarrantyUpdate) SetNillableStartAt(t *time.Time) *CarWarrantyUpdate {
+	if t != nil {
+		cwu.SetStartAt(*t)
+	}
+	return cwu
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (cwu *CarWarrantyUpdate) ClearStartAt() *CarWarrantyUpdate {
+	cwu.mutation.ClearStartAt()
+	return cwu
+}
+
+// SetEndAt sets the "end_at" field.
+func (cwu *CarWarrantyUpdate) SetEndAt(t time.Time) *CarWarrantyUpdate {
+	cwu.mutation.SetEndAt(t)
+	return cwu
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (cwu *CarWarrantyUpdate) SetNillableEndAt(t *time.Time) *CarWarrantyUpdate {
+	if t != nil {
+		cwu.SetEndAt(*t)
+	}
+	return cwu
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (cwu *CarWarrantyUpdate) ClearEndAt() *CarWarrantyUpdate {
+	cwu.mutation.ClearEndAt()
+	return cwu
+}
+
+// SetMileageLimit sets the "mileage_limit" field.
+func (cwu *CarWarrantyUpdate) SetMileageLimit(i int64) *CarWarrantyUpdate {
+	cwu.mutation.ResetMileageLimit()
+	cwu.mutation.SetMileageLimit(i)
+	return cwu
+}
+
+// SetNillableMileageLimit sets the "mileage_limit" field if the given value is not nil.
+func (cwu *CarWarrantyUpdate) SetNillableMileageLimit(i *int64) *CarWarrantyUpdate {
+	if i != nil {
+		cwu.SetMileageLimit(*i)
+	}
+	return cwu
+}
+
+// AddMileageLimit adds i to the "mileage_limit" field.
+func (cwu *CarWarrantyUpdate) AddMileageLimit(i int64) *CarWarrantyUpdate {
+	cwu.mutation.AddMileageLimit(i)
+	return cwu
+}
+
+// ClearMileageLimit clears the value of the "mileage_limit" field.
+func (cwu *CarWarrantyUpdate) ClearMileageLimit() *CarWarrantyUpdate {
+	cwu.mutation.ClearMileageLimit()
+	return cwu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cwu *CarWarrantyUpdate) SetCreatedAt(t time.Time) *CarWarrantyUpdate {
+	cwu.mutation.SetCreatedAt(t)
+	return cwu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cwu *CarWarrantyUpdate) SetNillableCreatedAt(t *time.Time) *CarWarrantyUpdate {
+	if t != nil {
+		cwu.SetCreatedAt(*t)
+	}
+	return cwu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (cwu *CarWarrantyUpdate) SetCar(c *Car) *CarWarrantyUpdate {
+	return cwu.SetCarID(c.ID)
+}
+
+// SetDefinition sets the "definition" edge to the WarrantyDefinition entity.
+func (cwu *CarWarrantyUpdate) SetDefinition(w *WarrantyDefinition) *CarWarrantyUpdate {
+	return cwu.SetDefinitionID(w.ID)
+}
+
+// Mutation returns the CarWarrantyMutation object of the builder.
+func (cwu *CarWarrantyUpdate) Mutation() *CarWarrantyMutation {
+	return cwu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (cwu *CarWarrantyUpdate) ClearCar() *CarWarrantyUpdate {
+	cwu.mutation.ClearCar()
+	return cwu
+}
+
+// ClearDefinition clears the "definition" edge to the WarrantyDefinition entity.
+func (cwu *CarWarrantyUpdate) ClearDefinition() *CarWarrantyUpdate {
+	cwu.mutation.ClearDefinition()
+	return cwu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cwu *CarWarrantyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cwu.hooks) == 0 {
+		affected, err = cwu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarWarrantyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cwu.mutation = mutation
+			affected, err = cwu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cwu.hooks) - 1; i >= 0; i-- {
+			if cwu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cwu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cwu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cwu *CarWarrantyUpdate) SaveX(ctx context.Context) int {
+	affected, err := cwu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cwu *CarWarrantyUpdate) Exec(ctx context.Context) error {
+	_, err := cwu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cwu *CarWarrantyUpdate) ExecX(ctx context.Context) {
+	if err := cwu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cwu *CarWarrantyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   carwarranty.Table,
+			Columns: carwarranty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carwarranty.FieldID,
+			},
+		},
+	}
+	if ps := cwu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cwu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldTenantID,
+		})
+	}
+	if value, ok := cwu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldTenantID,
+		})
+	}
+	if cwu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: carwarranty.FieldTenantID,
+		})
+	}
+	if value, ok := cwu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carwarranty.FieldName,
+		})
+	}
+	if cwu.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: carwarranty.FieldName,
+		})
+	}
+	if value, ok := cwu.mutation.Components(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carwarranty.FieldComponents,
+		})
+	}
+	if cwu.mutation.ComponentsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: carwarranty.FieldComponents,
+		})
+	}
+	if value, ok := cwu.mutation.StartAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carwarranty.FieldStartAt,
+		})
+	}
+	if cwu.mutation.StartAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: carwarranty.FieldStartAt,
+		})
+	}
+	if value, ok := cwu.mutation.EndAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carwarranty.FieldEndAt,
+		})
+	}
+	if cwu.mutation.EndAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: carwarranty.FieldEndAt,
+		})
+	}
+	if value, ok := cwu.mutation.MileageLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldMileageLimit,
+		})
+	}
+	if value, ok := cwu.mutation.AddedMileageLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldMileageLimit,
+		})
+	}
+	if cwu.mutation.MileageLimitCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: carwarranty.FieldMileageLimit,
+		})
+	}
+	if value, ok := cwu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carwarranty.FieldCreatedAt,
+		})
+	}
+	if cwu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.CarTable,
+			Columns: []string{carwarranty.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cwu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.CarTable,
+			Columns: []string{carwarranty.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cwu.mutation.DefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.DefinitionTable,
+			Columns: []string{carwarranty.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: warrantydefinition.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cwu.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.DefinitionTable,
+			Columns: []string{carwarranty.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: warrantydefinition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cwu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carwarranty.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CarWarrantyUpdateOne is the builder for updating a single CarWarranty entity.
+type CarWarrantyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CarWarrantyMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cwuo *CarWarrantyUpdateOne) SetTenantID(i int64) *CarWarrantyUpdateOne {
+	cwuo.mutation.ResetTenantID()
+	cwuo.mutation.SetTenantID(i)
+	return cwuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cwuo *CarWarrantyUpdateOne) SetNillableTenantID(i *int64) *CarWarrantyUpdateOne {
+	if i != nil {
+		cwuo.SetTenantID(*i)
+	}
+	return cwuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (cwuo *CarWarrantyUpdateOne) AddTenantID(i int64) *CarWarrantyUpdateOne {
+	cwuo.mutation.AddTenantID(i)
+	return cwuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (cwuo *CarWarrantyUpdateOne) ClearTenantID() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearTenantID()
+	return cwuo
+}
+
+// SetCarID sets the "car_id" field.
+func (cwuo *CarWarrantyUpdateOne) SetCarID(i int64) *CarWarrantyUpdateOne {
+	cwuo.mutation.SetCarID(i)
+	return cwuo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (cwuo *CarWarrantyUpdateOne) SetNillableCarID(i *int64) *CarWarrantyUpdateOne {
+	if i != nil {
+		cwuo.SetCarID(*i)
+	}
+	return cwuo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (cwuo *CarWarrantyUpdateOne) ClearCarID() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearCarID()
+	return cwuo
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (cwuo *CarWarrantyUpdateOne) SetDefinitionID(i int64) *CarWarrantyUpdateOne {
+	cwuo.mutation.SetDefinitionID(i)
+	return cwuo
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (cwuo *CarWarrantyUpdateOne) SetNillableDefinitionID(i *int64) *CarWarrantyUpdateOne {
+	if i != nil {
+		cwuo.SetDefinitionID(*i)
+	}
+	return cwuo
+}
+
+// ClearDefinitionID clears the value of the "definition_id" field.
+func (cwuo *CarWarrantyUpdateOne) ClearDefinitionID() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearDefinitionID()
+	return cwuo
+}
+
+// SetName sets the "name" field.
+func (cwuo *CarWarrantyUpdateOne) SetName(s string) *CarWarrantyUpdateOne {
+	cwuo.mutation.SetName(s)
+	return cwuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cwuo *CarWarrantyUpdateOne) SetNillableName(s *string) *CarWarrantyUpdateOne {
+	if s != nil {
+		cwuo.SetName(*s)
+	}
+	return cwuo
+}
+
+// ClearName clears the value of the "name" field.
+func (cwuo *CarWarrantyUpdateOne) ClearName() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearName()
+	return cwuo
+}
+
+// SetComponents sets the "components" field.
+func (cwuo *CarWarrantyUpdateOne) SetComponents(s string) *CarWarrantyUpdateOne {
+	cwuo.mutation.SetComponents(s)
+	return cwuo
+}
+
+// SetNillableComponents sets the "components" field if the given value is not nil.
+func (cwuo *CarWarrantyUpdateOne) SetNillableComponents(s *string) *CarWarrantyUpdateOne {
+	if s != nil {
+		cwuo.SetComponents(*s)
+	}
+	return cwuo
+}
+
+// ClearComponents clears the value of the "components" field.
+func (cwuo *CarWarrantyUpdateOne) ClearComponents() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearComponents()
+	return cwuo
+}
+
+// SetStartAt sets the "start_at" field.
+func (cwuo *CarWarrantyUpdateOne) SetStartAt(t time.Time) *CarWarrantyUpdateOne {
+	cwuo.mutation.SetStartAt(t)
+	return cwuo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (cwuo *CarWarrantyUpdateOne) SetNillableStartAt(t *time.Time) *CarWarrantyUpdateOne {
+	if t != nil {
+		cwuo.SetStartAt(*t)
+	}
+	return cwuo
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (cwuo *CarWarrantyUpdateOne) ClearStartAt() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearStartAt()
+	return cwuo
+}
+
+// SetEndAt sets the "end_at" field.
+func (cwuo *CarWarrantyUpdateOne) SetEndAt(t time.Time) *CarWarrantyUpdateOne {
+	cwuo.mutation.SetEndAt(t)
+	return cwuo
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (cwuo *CarWarrantyUpdateOne) SetNillableEndAt(t *time.Time) *CarWarrantyUpdateOne {
+	if t != nil {
+		cwuo.SetEndAt(*t)
+	}
+	return cwuo
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (cwuo *CarWarrantyUpdateOne) ClearEndAt() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearEndAt()
+	return cwuo
+}
+
+// SetMileageLimit sets the "mileage_limit" field.
+func (cwuo *CarWarrantyUpdateOne) SetMileageLimit(i int64) *CarWarrantyUpdateOne {
+	cwuo.mutation.ResetMileageLimit()
+	cwuo.mutation.SetMileageLimit(i)
+	return cwuo
+}
+
+// SetNillableMileageLimit sets the "mileage_limit" field if the given value is not nil.
+func (cwuo *CarWarrantyUpdateOne) SetNillableMileageLimit(i *int64) *CarWarrantyUpdateOne {
+	if i != nil {
+		cwuo.SetMileageLimit(*i)
+	}
+	return cwuo
+}
+
+// AddMileageLimit adds i to the "mileage_limit" field.
+func (cwuo *CarWarrantyUpdateOne) AddMileageLimit(i int64) *CarWarrantyUpdateOne {
+	cwuo.mutation.AddMileageLimit(i)
+	return cwuo
+}
+
+// ClearMileageLimit clears the value of the "mileage_limit" field.
+func (cwuo *CarWarrantyUpdateOne) ClearMileageLimit() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearMileageLimit()
+	return cwuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cwuo *CarWarrantyUpdateOne) SetCreatedAt(t time.Time) *CarWarrantyUpdateOne {
+	cwuo.mutation.SetCreatedAt(t)
+	return cwuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cwuo *CarWarrantyUpdateOne) SetNillableCreatedAt(t *time.Time) *CarWarrantyUpdateOne {
+	if t != nil {
+		cwuo.SetCreatedAt(*t)
+	}
+	return cwuo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (cwuo *CarWarrantyUpdateOne) SetCar(c *Car) *CarWarrantyUpdateOne {
+	return cwuo.SetCarID(c.ID)
+}
+
+// SetDefinition sets the "definition" edge to the WarrantyDefinition entity.
+func (cwuo *CarWarrantyUpdateOne) SetDefinition(w *WarrantyDefinition) *CarWarrantyUpdateOne {
+	return cwuo.SetDefinitionID(w.ID)
+}
+
+// Mutation returns the CarWarrantyMutation object of the builder.
+func (cwuo *CarWarrantyUpdateOne) Mutation() *CarWarrantyMutation {
+	return cwuo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (cwuo *CarWarrantyUpdateOne) ClearCar() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearCar()
+	return cwuo
+}
+
+// ClearDefinition clears the "definition" edge to the WarrantyDefinition entity.
+func (cwuo *CarWarrantyUpdateOne) ClearDefinition() *CarWarrantyUpdateOne {
+	cwuo.mutation.ClearDefinition()
+	return cwuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cwuo *CarWarrantyUpdateOne) Select(field string, fields ...string) *CarWarrantyUpdateOne {
+	cwuo.fields = append([]string{field}, fields...)
+	return cwuo
+}
+
+// Save executes the query and returns the updated CarWarranty entity.
+func (cwuo *CarWarrantyUpdateOne) Save(ctx context.Context) (*CarWarranty, error) {
+	var (
+		err  error
+		node *CarWarranty
+	)
+	if len(cwuo.hooks) == 0 {
+		node, err = cwuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CarWarrantyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cwuo.mutation = mutation
+			node, err = cwuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cwuo.hooks) - 1; i >= 0; i-- {
+			if cwuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cwuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, cwuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*CarWarranty)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CarWarrantyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cwuo *CarWarrantyUpdateOne) SaveX(ctx context.Context) *CarWarranty {
+	node, err := cwuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cwuo *CarWarrantyUpdateOne) Exec(ctx context.Context) error {
+	_, err := cwuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cwuo *CarWarrantyUpdateOne) ExecX(ctx context.Context) {
+	if err := cwuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cwuo *CarWarrantyUpdateOne) sqlSave(ctx context.Context) (_node *CarWarranty, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   carwarranty.Table,
+			Columns: carwarranty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carwarranty.FieldID,
+			},
+		},
+	}
+	id, ok := cwuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CarWarranty.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cwuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, carwarranty.FieldID)
+		for _, f := range fields {
+			if !carwarranty.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != carwarranty.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cwuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cwuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldTenantID,
+		})
+	}
+	if value, ok := cwuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldTenantID,
+		})
+	}
+	if cwuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: carwarranty.FieldTenantID,
+		})
+	}
+	if value, ok := cwuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carwarranty.FieldName,
+		})
+	}
+	if cwuo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: carwarranty.FieldName,
+		})
+	}
+	if value, ok := cwuo.mutation.Components(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: carwarranty.FieldComponents,
+		})
+	}
+	if cwuo.mutation.ComponentsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: carwarranty.FieldComponents,
+		})
+	}
+	if value, ok := cwuo.mutation.StartAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carwarranty.FieldStartAt,
+		})
+	}
+	if cwuo.mutation.StartAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: carwarranty.FieldStartAt,
+		})
+	}
+	if value, ok := cwuo.mutation.EndAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carwarranty.FieldEndAt,
+		})
+	}
+	if cwuo.mutation.EndAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: carwarranty.FieldEndAt,
+		})
+	}
+	if value, ok := cwuo.mutation.MileageLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldMileageLimit,
+		})
+	}
+	if value, ok := cwuo.mutation.AddedMileageLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: carwarranty.FieldMileageLimit,
+		})
+	}
+	if cwuo.mutation.MileageLimitCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: carwarranty.FieldMileageLimit,
+		})
+	}
+	if value, ok := cwuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: carwarranty.FieldCreatedAt,
+		})
+	}
+	if cwuo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.CarTable,
+			Columns: []string{carwarranty.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cwuo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.CarTable,
+			Columns: []string{carwarranty.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cwuo.mutation.DefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.DefinitionTable,
+			Columns: []string{carwarranty.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: warrantydefinition.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cwuo.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.DefinitionTable,
+			Columns: []string{carwarranty.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: warrantydefinition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CarWarranty{config: cwuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cwuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carwarranty.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
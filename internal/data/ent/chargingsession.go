@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/chargingsession"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ChargingSession is the model entity for the ChargingSession schema.
+type ChargingSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt time.Time `json:"ended_at,omitempty"`
+	// Energy holds the value of the "energy" field.
+	Energy float64 `json:"energy,omitempty"`
+	// ChargerType holds the value of the "charger_type" field.
+	ChargerType string `json:"charger_type,omitempty"`
+	// Cost holds the value of the "cost" field.
+	Cost float64 `json:"cost,omitempty"`
+	// Station holds the value of the "station" field.
+	Station string `json:"station,omitempty"`
+	// StartSoc holds the value of the "start_soc" field.
+	StartSoc float64 `json:"start_soc,omitempty"`
+	// EndSoc holds the value of the "end_soc" field.
+	EndSoc float64 `json:"end_soc,omitempty"`
+	// Soh holds the value of the "soh" field.
+	Soh float64 `json:"soh,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChargingSessionQuery when eager-loading is set.
+	Edges ChargingSessionEdges `json:"edges"`
+}
+
+// ChargingSessionEdges holds the relations/edges for other nodes in the graph.
+type ChargingSessionEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChargingSessionEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChargingSession) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chargingsession.FieldEnergy, chargingsession.FieldCost, chargingsession.FieldStartSoc, chargingsession.FieldEndSoc, chargingsession.FieldSoh:
+			values[i] = new(sql.NullFloat64)
+		case chargingsession.FieldID, chargingsession.FieldTenantID, chargingsession.FieldCarID:
+			values[i] = new(sql.NullInt64)
+		case chargingsession.FieldChargerType, chargingsession.FieldStation:
+			values[i] = new(sql.NullString)
+		case chargingsession.FieldStartedAt, chargingsession.FieldEndedAt, chargingsession.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ChargingSession", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChargingSession fields.
+func (cs *ChargingSession) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chargingsession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cs.ID = int64(value.Int64)
+		case chargingsession.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				cs.TenantID = value.Int64
+			}
+		case chargingsession.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				cs.CarID = value.Int64
+			}
+		case chargingsession.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				cs.StartedAt = value.Time
+			}
+		case chargingsession.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				cs.EndedAt = value.Time
+			}
+		case chargingsession.FieldEnergy:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field energy", values[i])
+			} else if value.Valid {
+				cs.Energy = value.Float64
+			}
+		case chargingsession.FieldChargerType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field charger_type", values[i])
+			} else if value.Valid {
+				cs.ChargerType = value.String
+			}
+		case chargingsession.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				cs.Cost = value.Float64
+			}
+		case chargingsession.FieldStation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field station", values[i])
+			} else if value.Valid {
+				cs.Station = value.String
+			}
+		case chargingsession.FieldStartSoc:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_soc", values[i])
+			} else if value.Valid {
+				cs.StartSoc = value.Float64
+			}
+		case chargingsession.FieldEndSoc:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_soc", values[i])
+			} else if value.Valid {
+				cs.EndSoc = value.Float64
+			}
+		case chargingsession.FieldSoh:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field soh", values[i])
+			} else if value.Valid {
+				cs.Soh = value.Float64
+			}
+		case chargingsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cs.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the ChargingSession entity.
+func (cs *ChargingSession) QueryCar() *CarQuery {
+	return (&ChargingSessionClient{config: cs.config}).QueryCar(cs)
+}
+
+// Update returns a builder for updating this ChargingSession.
+// Note that you need to call ChargingSession.Unwrap() before calling this method if this ChargingSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *ChargingSession) Update() *ChargingSessionUpdateOne {
+	return (&ChargingSessionClient{config: cs.config}).UpdateOne(cs)
+}
+
+// Unwrap unwraps the ChargingSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *ChargingSession) Unwrap() *ChargingSession {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChargingSession is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *ChargingSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChargingSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", cs.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", cs.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(cs.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ended_at=")
+	builder.WriteString(cs.EndedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("energy=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Energy))
+	builder.WriteString(", ")
+	builder.WriteString("charger_type=")
+	builder.WriteString(cs.ChargerType)
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Cost))
+	builder.WriteString(", ")
+	builder.WriteString("station=")
+	builder.WriteString(cs.Station)
+	builder.WriteString(", ")
+	builder.WriteString("start_soc=")
+	builder.WriteString(fmt.Sprintf("%v", cs.StartSoc))
+	builder.WriteString(", ")
+	builder.WriteString("end_soc=")
+	builder.WriteString(fmt.Sprintf("%v", cs.EndSoc))
+	builder.WriteString(", ")
+	builder.WriteString("soh=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Soh))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cs.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChargingSessions is a parsable slice of ChargingSession.
+type ChargingSessions []*ChargingSession
+
+func (cs ChargingSessions) config(cfg config) {
+	for _i := range cs {
+		cs[_i].config = cfg
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chargingsession
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the chargingsession type in the database.
+	Label = "charging_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldEnergy holds the string denoting the energy field in the database.
+	FieldEnergy = "energy"
+	// FieldChargerType holds the string denoting the charger_type field in the database.
+	FieldChargerType = "charger_type"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldStation holds the string denoting the station field in the database.
+	FieldStation = "station"
+	// FieldStartSoc holds the string denoting the start_soc field in the database.
+	FieldStartSoc = "start_soc"
+	// FieldEndSoc holds the string denoting the end_soc field in the database.
+	FieldEndSoc = "end_soc"
+	// FieldSoh holds the string denoting the soh field in the database.
+	FieldSoh = "soh"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// Table holds the table name of the chargingsession in the database.
+	Table = "charging_session"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "charging_session"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+)
+
+// Columns holds all SQL columns for chargingsession fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldStartedAt,
+	FieldEndedAt,
+	FieldEnergy,
+	FieldChargerType,
+	FieldCost,
+	FieldStation,
+	FieldStartSoc,
+	FieldEndSoc,
+	FieldSoh,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,1334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chargingsession
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartedAt), v))
+	})
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndedAt), v))
+	})
+}
+
+// Energy applies equality check predicate on the "energy" field. It's identical to EnergyEQ.
+func Energy(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEnergy), v))
+	})
+}
+
+// ChargerType applies equality check predicate on the "charger_type" field. It's identical to ChargerTypeEQ.
+func ChargerType(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldChargerType), v))
+	})
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCost), v))
+	})
+}
+
+// Station applies equality check predicate on the "station" field. It's identical to StationEQ.
+func Station(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStation), v))
+	})
+}
+
+// StartSoc applies equality check predicate on the "start_soc" field. It's identical to StartSocEQ.
+func StartSoc(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartSoc), v))
+	})
+}
+
+// EndSoc applies equality check predicate on the "end_soc" field. It's identical to EndSocEQ.
+func EndSoc(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndSoc), v))
+	})
+}
+
+// Soh applies equality check predicate on the "soh" field. It's identical to SohEQ.
+func Soh(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSoh), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartedAt), v...))
+	})
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartedAt), v...))
+	})
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartedAt)))
+	})
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartedAt)))
+	})
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndedAt), v...))
+	})
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndedAt), v...))
+	})
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndedAt)))
+	})
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndedAt)))
+	})
+}
+
+// EnergyEQ applies the EQ predicate on the "energy" field.
+func EnergyEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEnergy), v))
+	})
+}
+
+// EnergyNEQ applies the NEQ predicate on the "energy" field.
+func EnergyNEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEnergy), v))
+	})
+}
+
+// EnergyIn applies the In predicate on the "energy" field.
+func EnergyIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEnergy), v...))
+	})
+}
+
+// EnergyNotIn applies the NotIn predicate on the "energy" field.
+func EnergyNotIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEnergy), v...))
+	})
+}
+
+// EnergyGT applies the GT predicate on the "energy" field.
+func EnergyGT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEnergy), v))
+	})
+}
+
+// EnergyGTE applies the GTE predicate on the "energy" field.
+func EnergyGTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEnergy), v))
+	})
+}
+
+// EnergyLT applies the LT predicate on the "energy" field.
+func EnergyLT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEnergy), v))
+	})
+}
+
+// EnergyLTE applies the LTE predicate on the "energy" field.
+func EnergyLTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEnergy), v))
+	})
+}
+
+// EnergyIsNil applies the IsNil predicate on the "energy" field.
+func EnergyIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEnergy)))
+	})
+}
+
+// EnergyNotNil applies the NotNil predicate on the "energy" field.
+func EnergyNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEnergy)))
+	})
+}
+
+// ChargerTypeEQ applies the EQ predicate on the "charger_type" field.
+func ChargerTypeEQ(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeNEQ applies the NEQ predicate on the "charger_type" field.
+func ChargerTypeNEQ(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeIn applies the In predicate on the "charger_type" field.
+func ChargerTypeIn(vs ...string) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldChargerType), v...))
+	})
+}
+
+// ChargerTypeNotIn applies the NotIn predicate on the "charger_type" field.
+func ChargerTypeNotIn(vs ...string) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldChargerType), v...))
+	})
+}
+
+// ChargerTypeGT applies the GT predicate on the "charger_type" field.
+func ChargerTypeGT(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeGTE applies the GTE predicate on the "charger_type" field.
+func ChargerTypeGTE(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeLT applies the LT predicate on the "charger_type" field.
+func ChargerTypeLT(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeLTE applies the LTE predicate on the "charger_type" field.
+func ChargerTypeLTE(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeContains applies the Contains predicate on the "charger_type" field.
+func ChargerTypeContains(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeHasPrefix applies the HasPrefix predicate on the "charger_type" field.
+func ChargerTypeHasPrefix(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeHasSuffix applies the HasSuffix predicate on the "charger_type" field.
+func ChargerTypeHasSuffix(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeIsNil applies the IsNil predicate on the "charger_type" field.
+func ChargerTypeIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldChargerType)))
+	})
+}
+
+// ChargerTypeNotNil applies the NotNil predicate on the "charger_type" field.
+func ChargerTypeNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldChargerType)))
+	})
+}
+
+// ChargerTypeEqualFold applies the EqualFold predicate on the "charger_type" field.
+func ChargerTypeEqualFold(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldChargerType), v))
+	})
+}
+
+// ChargerTypeContainsFold applies the ContainsFold predicate on the "charger_type" field.
+func ChargerTypeContainsFold(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldChargerType), v))
+	})
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCost), v))
+	})
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCost), v))
+	})
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCost), v...))
+	})
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCost), v...))
+	})
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCost), v))
+	})
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCost), v))
+	})
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCost), v))
+	})
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCost), v))
+	})
+}
+
+// CostIsNil applies the IsNil predicate on the "cost" field.
+func CostIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCost)))
+	})
+}
+
+// CostNotNil applies the NotNil predicate on the "cost" field.
+func CostNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCost)))
+	})
+}
+
+// StationEQ applies the EQ predicate on the "station" field.
+func StationEQ(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStation), v))
+	})
+}
+
+// StationNEQ applies the NEQ predicate on the "station" field.
+func StationNEQ(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStation), v))
+	})
+}
+
+// StationIn applies the In predicate on the "station" field.
+func StationIn(vs ...string) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStation), v...))
+	})
+}
+
+// StationNotIn applies the NotIn predicate on the "station" field.
+func StationNotIn(vs ...string) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStation), v...))
+	})
+}
+
+// StationGT applies the GT predicate on the "station" field.
+func StationGT(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStation), v))
+	})
+}
+
+// StationGTE applies the GTE predicate on the "station" field.
+func StationGTE(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStation), v))
+	})
+}
+
+// StationLT applies the LT predicate on the "station" field.
+func StationLT(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStation), v))
+	})
+}
+
+// StationLTE applies the LTE predicate on the "station" field.
+func StationLTE(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStation), v))
+	})
+}
+
+// StationContains applies the Contains predicate on the "station" field.
+func StationContains(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStation), v))
+	})
+}
+
+// StationHasPrefix applies the HasPrefix predicate on the "station" field.
+func StationHasPrefix(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStation), v))
+	})
+}
+
+// StationHasSuffix applies the HasSuffix predicate on the "station" field.
+func StationHasSuffix(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStation), v))
+	})
+}
+
+// StationIsNil applies the IsNil predicate on the "station" field.
+func StationIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStation)))
+	})
+}
+
+// StationNotNil applies the NotNil predicate on the "station" field.
+func StationNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStation)))
+	})
+}
+
+// StationEqualFold applies the EqualFold predicate on the "station" field.
+func StationEqualFold(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStation), v))
+	})
+}
+
+// StationContainsFold applies the ContainsFold predicate on the "station" field.
+func StationContainsFold(v string) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStation), v))
+	})
+}
+
+// StartSocEQ applies the EQ predicate on the "start_soc" field.
+func StartSocEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartSoc), v))
+	})
+}
+
+// StartSocNEQ applies the NEQ predicate on the "start_soc" field.
+func StartSocNEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartSoc), v))
+	})
+}
+
+// StartSocIn applies the In predicate on the "start_soc" field.
+func StartSocIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartSoc), v...))
+	})
+}
+
+// StartSocNotIn applies the NotIn predicate on the "start_soc" field.
+func StartSocNotIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartSoc), v...))
+	})
+}
+
+// StartSocGT applies the GT predicate on the "start_soc" field.
+func StartSocGT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartSoc), v))
+	})
+}
+
+// StartSocGTE applies the GTE predicate on the "start_soc" field.
+func StartSocGTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartSoc), v))
+	})
+}
+
+// StartSocLT applies the LT predicate on the "start_soc" field.
+func StartSocLT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartSoc), v))
+	})
+}
+
+// StartSocLTE applies the LTE predicate on the "start_soc" field.
+func StartSocLTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartSoc), v))
+	})
+}
+
+// StartSocIsNil applies the IsNil predicate on the "start_soc" field.
+func StartSocIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartSoc)))
+	})
+}
+
+// StartSocNotNil applies the NotNil predicate on the "start_soc" field.
+func StartSocNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartSoc)))
+	})
+}
+
+// EndSocEQ applies the EQ predicate on the "end_soc" field.
+func EndSocEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndSoc), v))
+	})
+}
+
+// EndSocNEQ applies the NEQ predicate on the "end_soc" field.
+func EndSocNEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndSoc), v))
+	})
+}
+
+// EndSocIn applies the In predicate on the "end_soc" field.
+func EndSocIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndSoc), v...))
+	})
+}
+
+// EndSocNotIn applies the NotIn predicate on the "end_soc" field.
+func EndSocNotIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndSoc), v...))
+	})
+}
+
+// EndSocGT applies the GT predicate on the "end_soc" field.
+func EndSocGT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndSoc), v))
+	})
+}
+
+// EndSocGTE applies the GTE predicate on the "end_soc" field.
+func EndSocGTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndSoc), v))
+	})
+}
+
+// EndSocLT applies the LT predicate on the "end_soc" field.
+func EndSocLT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndSoc), v))
+	})
+}
+
+// EndSocLTE applies the LTE predicate on the "end_soc" field.
+func EndSocLTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndSoc), v))
+	})
+}
+
+// EndSocIsNil applies the IsNil predicate on the "end_soc" field.
+func EndSocIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndSoc)))
+	})
+}
+
+// EndSocNotNil applies the NotNil predicate on the "end_soc" field.
+func EndSocNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndSoc)))
+	})
+}
+
+// SohEQ applies the EQ predicate on the "soh" field.
+func SohEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSoh), v))
+	})
+}
+
+// SohNEQ applies the NEQ predicate on the "soh" field.
+func SohNEQ(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSoh), v))
+	})
+}
+
+// SohIn applies the In predicate on the "soh" field.
+func SohIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSoh), v...))
+	})
+}
+
+// SohNotIn applies the NotIn predicate on the "soh" field.
+func SohNotIn(vs ...float64) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSoh), v...))
+	})
+}
+
+// SohGT applies the GT predicate on the "soh" field.
+func SohGT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSoh), v))
+	})
+}
+
+// SohGTE applies the GTE predicate on the "soh" field.
+func SohGTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSoh), v))
+	})
+}
+
+// SohLT applies the LT predicate on the "soh" field.
+func SohLT(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSoh), v))
+	})
+}
+
+// SohLTE applies the LTE predicate on the "soh" field.
+func SohLTE(v float64) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSoh), v))
+	})
+}
+
+// SohIsNil applies the IsNil predicate on the "soh" field.
+func SohIsNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSoh)))
+	})
+}
+
+// SohNotNil applies the NotNil predicate on the "soh" field.
+func SohNotNil() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSoh)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ChargingSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChargingSession) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChargingSession) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChargingSession) predicate.ChargingSession {
+	return predicate.ChargingSession(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,523 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/chargingsession"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChargingSessionCreate is the builder for creating a ChargingSession entity.
+type ChargingSessionCreate struct {
+	config
+	mutation *ChargingSessionMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (csc *ChargingSessionCreate) SetTenantID(i int64) *ChargingSessionCreate {
+	csc.mutation.SetTenantID(i)
+	return csc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableTenantID(i *int64) *ChargingSessionCreate {
+	if i != nil {
+		csc.SetTenantID(*i)
+	}
+	return csc
+}
+
+// SetCarID sets the "car_id" field.
+func (csc *ChargingSessionCreate) SetCarID(i int64) *ChargingSessionCreate {
+	csc.mutation.SetCarID(i)
+	return csc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableCarID(i *int64) *ChargingSessionCreate {
+	if i != nil {
+		csc.SetCarID(*i)
+	}
+	return csc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (csc *ChargingSessionCreate) SetStartedAt(t time.Time) *ChargingSessionCreate {
+	csc.mutation.SetStartedAt(t)
+	return csc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableStartedAt(t *time.Time) *ChargingSessionCreate {
+	if t != nil {
+		csc.SetStartedAt(*t)
+	}
+	return csc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (csc *ChargingSessionCreate) SetEndedAt(t time.Time) *ChargingSessionCreate {
+	csc.mutation.SetEndedAt(t)
+	return csc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableEndedAt(t *time.Time) *ChargingSessionCreate {
+	if t != nil {
+		csc.SetEndedAt(*t)
+	}
+	return csc
+}
+
+// SetEnergy sets the "energy" field.
+func (csc *ChargingSessionCreate) SetEnergy(f float64) *ChargingSessionCreate {
+	csc.mutation.SetEnergy(f)
+	return csc
+}
+
+// SetNillableEnergy sets the "energy" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableEnergy(f *float64) *ChargingSessionCreate {
+	if f != nil {
+		csc.SetEnergy(*f)
+	}
+	return csc
+}
+
+// SetChargerType sets the "charger_type" field.
+func (csc *ChargingSessionCreate) SetChargerType(s string) *ChargingSessionCreate {
+	csc.mutation.SetChargerType(s)
+	return csc
+}
+
+// SetNillableChargerType sets the "charger_type" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableChargerType(s *string) *ChargingSessionCreate {
+	if s != nil {
+		csc.SetChargerType(*s)
+	}
+	return csc
+}
+
+// SetCost sets the "cost" field.
+func (csc *ChargingSessionCreate) SetCost(f float64) *ChargingSessionCreate {
+	csc.mutation.SetCost(f)
+	return csc
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableCost(f *float64) *ChargingSessionCreate {
+	if f != nil {
+		csc.SetCost(*f)
+	}
+	return csc
+}
+
+// SetStation sets the "station" field.
+func (csc *ChargingSessionCreate) SetStation(s string) *ChargingSessionCreate {
+	csc.mutation.SetStation(s)
+	return csc
+}
+
+// SetNillableStation sets the "station" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableStation(s *string) *ChargingSessionCreate {
+	if s != nil {
+		csc.SetStation(*s)
+	}
+	return csc
+}
+
+// SetStartSoc sets the "start_soc" field.
+func (csc *ChargingSessionCreate) SetStartSoc(f float64) *ChargingSessionCreate {
+	csc.mutation.SetStartSoc(f)
+	return csc
+}
+
+// SetNillableStartSoc sets the "start_soc" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableStartSoc(f *float64) *ChargingSessionCreate {
+	if f != nil {
+		csc.SetStartSoc(*f)
+	}
+	return csc
+}
+
+// SetEndSoc sets the "end_soc" field.
+func (csc *ChargingSessionCreate) SetEndSoc(f float64) *ChargingSessionCreate {
+	csc.mutation.SetEndSoc(f)
+	return csc
+}
+
+// SetNillableEndSoc sets the "end_soc" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableEndSoc(f *float64) *ChargingSessionCreate {
+	if f != nil {
+		csc.SetEndSoc(*f)
+	}
+	return csc
+}
+
+// SetSoh sets the "soh" field.
+func (csc *ChargingSessionCreate) SetSoh(f float64) *ChargingSessionCreate {
+	csc.mutation.SetSoh(f)
+	return csc
+}
+
+// SetNillableSoh sets the "soh" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableSoh(f *float64) *ChargingSessionCreate {
+	if f != nil {
+		csc.SetSoh(*f)
+	}
+	return csc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csc *ChargingSessionCreate) SetCreatedAt(t time.Time) *ChargingSessionCreate {
+	csc.mutation.SetCreatedAt(t)
+	return csc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csc *ChargingSessionCreate) SetNillableCreatedAt(t *time.Time) *ChargingSessionCreate {
+	if t != nil {
+		csc.SetCreatedAt(*t)
+	}
+	return csc
+}
+
+// SetID sets the "id" field.
+func (csc *ChargingSessionCreate) SetID(i int64) *ChargingSessionCreate {
+	csc.mutation.SetID(i)
+	return csc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (csc *ChargingSessionCreate) SetCar(c *Car) *ChargingSessionCreate {
+	return csc.SetCarID(c.ID)
+}
+
+// Mutation returns the ChargingSessionMutation object of the builder.
+func (csc *ChargingSessionCreate) Mutation() *ChargingSessionMutation {
+	return csc.mutation
+}
+
+// Save creates the ChargingSession in the database.
+func (csc *ChargingSessionCreate) Save(ctx context.Context) (*ChargingSession, error) {
+	var (
+		err  error
+		node *ChargingSession
+	)
+	if err := csc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(csc.hooks) == 0 {
+		if err = csc.check(); err != nil {
+			return nil, err
+		}
+		node, err = csc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChargingSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = csc.check(); err != nil {
+				return nil, err
+			}
+			csc.mutation = mutation
+			if node, err = csc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(csc.hooks) - 1; i >= 0; i-- {
+			if csc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = csc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, csc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ChargingSession)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ChargingSessionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *ChargingSessionCreate) SaveX(ctx context.Context) *ChargingSession {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *ChargingSessionCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *ChargingSessionCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *ChargingSessionCreate) defaults() error {
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		if chargingsession.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized chargingsession.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := chargingsession.DefaultCreatedAt()
+		csc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *ChargingSessionCreate) check() error {
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChargingSession.created_at"`)}
+	}
+	return nil
+}
+
+func (csc *ChargingSessionCreate) sqlSave(ctx context.Context) (*ChargingSession, error) {
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (csc *ChargingSessionCreate) createSpec() (*ChargingSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChargingSession{config: csc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: chargingsession.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: chargingsession.FieldID,
+			},
+		}
+	)
+	if id, ok := csc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := csc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: chargingsession.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := csc.mutation.StartedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: chargingsession.FieldStartedAt,
+		})
+		_node.StartedAt = value
+	}
+	if value, ok := csc.mutation.EndedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: chargingsession.FieldEndedAt,
+		})
+		_node.EndedAt = value
+	}
+	if value, ok := csc.mutation.Energy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEnergy,
+		})
+		_node.Energy = value
+	}
+	if value, ok := csc.mutation.ChargerType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: chargingsession.FieldChargerType,
+		})
+		_node.ChargerType = value
+	}
+	if value, ok := csc.mutation.Cost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldCost,
+		})
+		_node.Cost = value
+	}
+	if value, ok := csc.mutation.Station(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: chargingsession.FieldStation,
+		})
+		_node.Station = value
+	}
+	if value, ok := csc.mutation.StartSoc(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldStartSoc,
+		})
+		_node.StartSoc = value
+	}
+	if value, ok := csc.mutation.EndSoc(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEndSoc,
+		})
+		_node.EndSoc = value
+	}
+	if value, ok := csc.mutation.Soh(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldSoh,
+		})
+		_node.Soh = value
+	}
+	if value, ok := csc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: chargingsession.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := csc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargingsession.CarTable,
+			Columns: []string{chargingsession.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ChargingSessionCreateBulk is the builder for creating many ChargingSession entities in bulk.
+type ChargingSessionCreateBulk struct {
+	config
+	builders []*ChargingSessionCreate
+}
+
+// Save creates the ChargingSession entities in the database.
+func (cscb *ChargingSessionCreateBulk) Save(ctx context.Context) ([]*ChargingSession, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*ChargingSession, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChargingSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *ChargingSessionCreateBulk) SaveX(ctx context.Context) []*ChargingSession {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *ChargingSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *ChargingSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChargingSessionQuery is the builder for querying ChargingSession entities.
+type ChargingSessionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ChargingSession
+	// eager-loading edges.
+	withCar   *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ChargingSessionQuery builder.
+func (csq *ChargingSessionQuery) Where(ps ...predicate.ChargingSession) *ChargingSessionQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit adds a limit step to the query.
+func (csq *ChargingSessionQuery) Limit(limit int) *ChargingSessionQuery {
+	csq.limit = &limit
+	return csq
+}
+
+// Offset adds an offset step to the query.
+func (csq *ChargingSessionQuery) Offset(offset int) *ChargingSessionQuery {
+	csq.offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *ChargingSessionQuery) Unique(unique bool) *ChargingSessionQuery {
+	csq.unique = &unique
+	return csq
+}
+
+// Order adds an order step to the query.
+func (csq *ChargingSessionQuery) Order(o ...OrderFunc) *ChargingSessionQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (csq *ChargingSessionQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: csq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chargingsession.Table, chargingsession.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chargingsession.CarTable, chargingsession.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ChargingSession entity from the query.
+// Returns a *NotFoundError when no ChargingSession was found.
+func (csq *ChargingSessionQuery) First(ctx context.Context) (*ChargingSession, error) {
+	nodes, err := csq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{chargingsession.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *ChargingSessionQuery) FirstX(ctx context.Context) *ChargingSession {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ChargingSession ID from the query.
+// Returns a *NotFoundError when no ChargingSession ID was found.
+func (csq *ChargingSessionQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = csq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{chargingsession.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *ChargingSessionQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ChargingSession entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ChargingSession entity is found.
+// Returns a *NotFoundError when no ChargingSession entities are found.
+func (csq *ChargingSessionQuery) Only(ctx context.Context) (*ChargingSession, error) {
+	nodes, err := csq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{chargingsession.Label}
+	default:
+		return nil, &NotSingularError{chargingsession.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *ChargingSessionQuery) OnlyX(ctx context.Context) *ChargingSession {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ChargingSession ID in the query.
+// Returns a *NotSingularError when more than one ChargingSession ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *ChargingSessionQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = csq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{chargingsession.Label}
+	default:
+		err = &NotSingularError{chargingsession.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *ChargingSessionQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ChargingSessions.
+func (csq *ChargingSessionQuery) All(ctx context.Context) ([]*ChargingSession, error) {
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return csq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *ChargingSessionQuery) AllX(ctx context.Context) []*ChargingSession {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ChargingSession IDs.
+func (csq *ChargingSessionQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := csq.Select(chargingsession.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *ChargingSessionQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *ChargingSessionQuery) Count(ctx context.Context) (int, error) {
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return csq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *ChargingSessionQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *ChargingSessionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := csq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return csq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *ChargingSessionQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ChargingSessionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *ChargingSessionQuery) Clone() *ChargingSessionQuery {
+	if csq == nil {
+		return nil
+	}
+	return &ChargingSessionQuery{
+		config:     csq.config,
+		limit:      csq.limit,
+		offset:     csq.offset,
+		order:      append([]OrderFunc{}, csq.order...),
+		predicates: append([]predicate.ChargingSession{}, csq.predicates...),
+		withCar:    csq.withCar.Clone(),
+		// clone intermediate query.
+		sql:    csq.sql.Clone(),
+		path:   csq.path,
+		unique: csq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *ChargingSessionQuery) WithCar(opts ...func(*CarQuery)) *ChargingSessionQuery {
+	query := &CarQuery{config: csq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withCar = query
+	return csq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ChargingSession.Query().
+//		GroupBy(chargingsession.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (csq *ChargingSessionQuery) GroupBy(field string, fields ...string) *ChargingSessionGroupBy {
+	grbuild := &ChargingSessionGroupBy{config: csq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return csq.sqlQuery(ctx), nil
+	}
+	grbuild.label = chargingsession.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.ChargingSession.Query().
+//		Select(chargingsession.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (csq *ChargingSessionQuery) Select(fields ...string) *ChargingSessionSelect {
+	csq.fields = append(csq.fields, fields...)
+	selbuild := &ChargingSessionSelect{ChargingSessionQuery: csq}
+	selbuild.label = chargingsession.Label
+	selbuild.flds, selbuild.scan = &csq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (csq *ChargingSessionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range csq.fields {
+		if !chargingsession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	if chargingsession.Policy == nil {
+		return errors.New("ent: uninitialized chargingsession.Policy (forgotten import ent/runtime?)")
+	}
+	if err := chargingsession.Policy.EvalQuery(ctx, csq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (csq *ChargingSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ChargingSession, error) {
+	var (
+		nodes       = []*ChargingSession{}
+		_spec       = csq.querySpec()
+		loadedTypes = [1]bool{
+			csq.withCar != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ChargingSession).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ChargingSession{config: csq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := csq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*ChargingSession)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (csq *ChargingSessionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
+	_spec.Node.Columns = csq.fields
+	if len(csq.fields) > 0 {
+		_spec.Unique = csq.unique != nil && *csq.unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *ChargingSessionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := csq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (csq *ChargingSessionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   chargingsession.Table,
+			Columns: chargingsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: chargingsession.FieldID,
+			},
+		},
+		From:   csq.sql,
+		Unique: true,
+	}
+	if unique := csq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := csq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chargingsession.FieldID)
+		for i := range fields {
+			if fields[i] != chargingsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *ChargingSessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(chargingsession.Table)
+	columns := csq.fields
+	if len(columns) == 0 {
+		columns = chargingsession.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.unique != nil && *csq.unique {
+		selector.Distinct()
+	}
+	for _, m := range csq.modifiers {
+		m(selector)
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (csq *ChargingSessionQuery) ForUpdate(opts ...sql.LockOption) *ChargingSessionQuery {
+	if csq.driver.Dialect() == dialect.Postgres {
+		csq.Unique(false)
+	}
+	csq.modifiers = append(csq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return csq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (csq *ChargingSessionQuery) ForShare(opts ...sql.LockOption) *ChargingSessionQuery {
+	if csq.driver.Dialect() == dialect.Postgres {
+		csq.Unique(false)
+	}
+	csq.modifiers = append(csq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return csq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (csq *ChargingSessionQuery) Modify(modifiers ...func(s *sql.Selector)) *ChargingSessionSelect {
+	csq.modifiers = append(csq.modifiers, modifiers...)
+	return csq.Select()
+}
+
+// ChargingSessionGroupBy is the group-by builder for ChargingSession entities.
+type ChargingSessionGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *ChargingSessionGroupBy) Aggregate(fns ...AggregateFunc) *ChargingSessionGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (csgb *ChargingSessionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := csgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	csgb.sql = query
+	return csgb.sqlScan(ctx, v)
+}
+
+func (csgb *ChargingSessionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range csgb.fields {
+		if !chargingsession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := csgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (csgb *ChargingSessionGroupBy) sqlQuery() *sql.Selector {
+	selector := csgb.sql.Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(csgb.fields)+len(csgb.fns))
+		for _, f := range csgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(csgb.fields...)...)
+}
+
+// ChargingSessionSelect is the builder for selecting fields of ChargingSession entities.
+type ChargingSessionSelect struct {
+	*ChargingSessionQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *ChargingSessionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	css.sql = css.ChargingSessionQuery.sqlQuery(ctx)
+	return css.sqlScan(ctx, v)
+}
+
+func (css *ChargingSessionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := css.sql.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (css *ChargingSessionSelect) Modify(modifiers ...func(s *sql.Selector)) *ChargingSessionSelect {
+	css.modifiers = append(css.modifiers, modifiers...)
+	return css
+}
@@ -0,0 +1,1249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChargingSessionUpdate is the builder for updating ChargingSession entities.
+type ChargingSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChargingSessionMutation
+}
+
+// Where appends a list predicates to the ChargingSessionUpdate builder.
+func (csu *ChargingSessionUpdate) Where(ps ...predicate.ChargingSession) *ChargingSessionUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (csu *ChargingSessionUpdate) SetTenantID(i int64) *ChargingSessionUpdate {
+	csu.mutation.ResetTenantID()
+	csu.mutation.SetTenantID(i)
+	return csu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableTenantID(i *int64) *ChargingSessionUpdate {
+	if i != nil {
+		csu.SetTenantID(*i)
+	}
+	return csu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (csu *ChargingSessionUpdate) AddTenantID(i int64) *ChargingSessionUpdate {
+	csu.mutation.AddTenantID(i)
+	return csu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (csu *ChargingSessionUpdate) ClearTenantID() *ChargingSessionUpdate {
+	csu.mutation.ClearTenantID()
+	return csu
+}
+
+// SetCarID sets the "car_id" field.
+func (csu *ChargingSessionUpdate) SetCarID(i int64) *ChargingSessionUpdate {
+	csu.mutation.SetCarID(i)
+	return csu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableCarID(i *int64) *ChargingSessionUpdate {
+	if i != nil {
+		csu.SetCarID(*i)
+	}
+	return csu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (csu *ChargingSessionUpdate) ClearCarID() *ChargingSessionUpdate {
+	csu.mutation.ClearCarID()
+	return csu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (csu *ChargingSessionUpdate) SetStartedAt(t time.Time) *ChargingSessionUpdate {
+	csu.mutation.SetStartedAt(t)
+	return csu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableStartedAt(t *time.Time) *ChargingSessionUpdate {
+	if t != nil {
+		csu.SetStartedAt(*t)
+	}
+	return csu
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (csu *ChargingSessionUpdate) ClearStartedAt() *ChargingSessionUpdate {
+	csu.mutation.ClearStartedAt()
+	return csu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (csu *ChargingSessionUpdate) SetEndedAt(t time.Time) *ChargingSessionUpdate {
+	csu.mutation.SetEndedAt(t)
+	return csu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableEndedAt(t *time.Time) *ChargingSessionUpdate {
+	if t != nil {
+		csu.SetEndedAt(*t)
+	}
+	return csu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (csu *ChargingSessionUpdate) ClearEndedAt() *ChargingSessionUpdate {
+	csu.mutation.ClearEndedAt()
+	return csu
+}
+
+// SetEnergy sets the "energy" field.
+func (csu *ChargingSessionUpdate) SetEnergy(f float64) *ChargingSessionUpdate {
+	csu.mutation.ResetEnergy()
+	csu.mutation.SetEnergy(f)
+	return csu
+}
+
+// SetNillableEnergy sets the "energy" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableEnergy(f *float64) *ChargingSessionUpdate {
+	if f != nil {
+		csu.SetEnergy(*f)
+	}
+	return csu
+}
+
+// AddEnergy adds f to the "energy" field.
+func (csu *ChargingSessionUpdate) AddEnergy(f float64) *ChargingSessionUpdate {
+	csu.mutation.AddEnergy(f)
+	return csu
+}
+
+// ClearEnergy clears the value of the "energy" field.
+func (csu *ChargingSessionUpdate) ClearEnergy() *ChargingSessionUpdate {
+	csu.mutation.ClearEnergy()
+	return csu
+}
+
+// SetChargerType sets the "charger_type" field.
+func (csu *ChargingSessionUpdate) SetChargerType(s string) *ChargingSessionUpdate {
+	csu.mutation.SetChargerType(s)
+	return csu
+}
+
+// SetNillableChargerType sets the "charger_type" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableChargerType(s *string) *ChargingSessionUpdate {
+	if s != nil {
+		csu.SetChargerType(*s)
+	}
+	return csu
+}
+
+// ClearChargerType clears the value of the "charger_type" field.
+func (csu *ChargingSessionUpdate) ClearChargerType() *ChargingSessionUpdate {
+	csu.mutation.ClearChargerType()
+	return csu
+}
+
+// SetCost sets the "cost" field.
+func (csu *ChargingSessionUpdate) SetCost(f float64) *ChargingSessionUpdate {
+	csu.mutation.ResetCost()
+	csu.mutation.SetCost(f)
+	return csu
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableCost(f *float64) *ChargingSessionUpdate {
+	if f != nil {
+		csu.SetCost(*f)
+	}
+	return csu
+}
+
+// AddCost adds f to the "cost" field.
+func (csu *ChargingSessionUpdate) AddCost(f float64) *ChargingSessionUpdate {
+	csu.mutation.AddCost(f)
+	return csu
+}
+
+// ClearCost clears the value of the "cost" field.
+func (csu *ChargingSessionUpdate) ClearCost() *ChargingSessionUpdate {
+	csu.mutation.ClearCost()
+	return csu
+}
+
+// SetStation sets the "station" field.
+func (csu *ChargingSessionUpdate) SetStation(s string) *ChargingSessionUpdate {
+	csu.mutation.SetStation(s)
+	return csu
+}
+
+// SetNillableStation sets the "station" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableStation(s *string) *ChargingSessionUpdate {
+	if s != nil {
+		csu.SetStation(*s)
+	}
+	return csu
+}
+
+// ClearStation clears the value of the "station" field.
+func (csu *ChargingSessionUpdate) ClearStation() *ChargingSessionUpdate {
+	csu.mutation.ClearStation()
+	return csu
+}
+
+// SetStartSoc sets the "start_soc" field.
+func (csu *ChargingSessionUpdate) SetStartSoc(f float64) *ChargingSessionUpdate {
+	csu.mutation.ResetStartSoc()
+	csu.mutation.SetStartSoc(f)
+	return csu
+}
+
+// SetNillableStartSoc sets the "start_soc" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableStartSoc(f *float64) *ChargingSessionUpdate {
+	if f != nil {
+		csu.SetStartSoc(*f)
+	}
+	return csu
+}
+
+// AddStartSoc adds f to the "start_soc" field.
+func (csu *ChargingSessionUpdate) AddStartSoc(f float64) *ChargingSessionUpdate {
+	csu.mutation.AddStartSoc(f)
+	return csu
+}
+
+// ClearStartSoc clears the value of the "start_soc" field.
+func (csu *ChargingSessionUpdate) ClearStartSoc() *ChargingSessionUpdate {
+	csu.mutation.ClearStartSoc()
+	return csu
+}
+
+// SetEndSoc sets the "end_soc" field.
+func (csu *ChargingSessionUpdate) SetEndSoc(f float64) *ChargingSessionUpdate {
+	csu.mutation.ResetEndSoc()
+	csu.mutation.SetEndSoc(f)
+	return csu
+}
+
+// SetNillableEndSoc sets the "end_soc" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableEndSoc(f *float64) *ChargingSessionUpdate {
+	if f != nil {
+		csu.SetEndSoc(*f)
+	}
+	return csu
+}
+
+// AddEndSoc adds f to the "end_soc" field.
+func (csu *ChargingSessionUpdate) AddEndSoc(f float64) *ChargingSessionUpdate {
+	csu.mutation.AddEndSoc(f)
+	return csu
+}
+
+// ClearEndSoc clears the value of the "end_soc" field.
+func (csu *ChargingSessionUpdate) ClearEndSoc() *ChargingSessionUpdate {
+	csu.mutation.ClearEndSoc()
+	return csu
+}
+
+// SetSoh sets the "soh" field.
+func (csu *ChargingSessionUpdate) SetSoh(f float64) *ChargingSessionUpdate {
+	csu.mutation.ResetSoh()
+	csu.mutation.SetSoh(f)
+	return csu
+}
+
+// SetNillableSoh sets the "soh" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableSoh(f *float64) *ChargingSessionUpdate {
+	if f != nil {
+		csu.SetSoh(*f)
+	}
+	return csu
+}
+
+// AddSoh adds f to the "soh" field.
+func (csu *ChargingSessionUpdate) AddSoh(f float64) *ChargingSessionUpdate {
+	csu.mutation.AddSoh(f)
+	return csu
+}
+
+// ClearSoh clears the value of the "soh" field.
+func (csu *ChargingSessionUpdate) ClearSoh() *ChargingSessionUpdate {
+	csu.mutation.ClearSoh()
+	return csu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csu *ChargingSessionUpdate) SetCreatedAt(t time.Time) *ChargingSessionUpdate {
+	csu.mutation.SetCreatedAt(t)
+	return csu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csu *ChargingSessionUpdate) SetNillableCreatedAt(t *time.Time) *ChargingSessionUpdate {
+	if t != nil {
+		csu.SetCreatedAt(*t)
+	}
+	return csu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (csu *ChargingSessionUpdate) SetCar(c *Car) *ChargingSessionUpdate {
+	return csu.SetCarID(c.ID)
+}
+
+// Mutation returns the ChargingSessionMutation object of the builder.
+func (csu *ChargingSessionUpdate) Mutation() *ChargingSessionMutation {
+	return csu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (csu *ChargingSessionUpdate) ClearCar() *ChargingSessionUpdate {
+	csu.mutation.ClearCar()
+	return csu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *ChargingSessionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(csu.hooks) == 0 {
+		affected, err = csu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChargingSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			csu.mutation = mutation
+			affected, err = csu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(csu.hooks) - 1; i >= 0; i-- {
+			if csu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = csu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, csu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *ChargingSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *ChargingSessionUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *ChargingSessionUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (csu *ChargingSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   chargingsession.Table,
+			Columns: chargingsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: chargingsession.FieldID,
+			},
+		},
+	}
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: chargingsession.FieldTenantID,
+		})
+	}
+	if value, ok := csu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: chargingsession.FieldTenantID,
+		})
+	}
+	if csu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: chargingsession.FieldTenantID,
+		})
+	}
+	if value, ok := csu.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: chargingsession.FieldStartedAt,
+		})
+	}
+	if csu.mutation.StartedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: chargingsession.FieldStartedAt,
+		})
+	}
+	if value, ok := csu.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: chargingsession.FieldEndedAt,
+		})
+	}
+	if csu.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: chargingsession.FieldEndedAt,
+		})
+	}
+	if value, ok := csu.mutation.Energy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEnergy,
+		})
+	}
+	if value, ok := csu.mutation.AddedEnergy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEnergy,
+		})
+	}
+	if csu.mutation.EnergyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldEnergy,
+		})
+	}
+	if value, ok := csu.mutation.ChargerType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: chargingsession.FieldChargerType,
+		})
+	}
+	if csu.mutation.ChargerTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: chargingsession.FieldChargerType,
+		})
+	}
+	if value, ok := csu.mutation.Cost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldCost,
+		})
+	}
+	if value, ok := csu.mutation.AddedCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldCost,
+		})
+	}
+	if csu.mutation.CostCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldCost,
+		})
+	}
+	if value, ok := csu.mutation.Station(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: chargingsession.FieldStation,
+		})
+	}
+	if csu.mutation.StationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: chargingsession.FieldStation,
+		})
+	}
+	if value, ok := csu.mutation.StartSoc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldStartSoc,
+		})
+	}
+	if value, ok := csu.mutation.AddedStartSoc(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldStartSoc,
+		})
+	}
+	if csu.mutation.StartSocCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldStartSoc,
+		})
+	}
+	if value, ok := csu.mutation.EndSoc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEndSoc,
+		})
+	}
+	if value, ok := csu.mutation.AddedEndSoc(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEndSoc,
+		})
+	}
+	if csu.mutation.EndSocCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldEndSoc,
+		})
+	}
+	if value, ok := csu.mutation.Soh(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldSoh,
+		})
+	}
+	if value, ok := csu.mutation.AddedSoh(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldSoh,
+		})
+	}
+	if csu.mutation.SohCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldSoh,
+		})
+	}
+	if value, ok := csu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: chargingsession.FieldCreatedAt,
+		})
+	}
+	if csu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargingsession.CarTable,
+			Columns: []string{chargingsession.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargingsession.CarTable,
+			Columns: []string{chargingsession.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chargingsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ChargingSessionUpdateOne is the builder for updating a single ChargingSession entity.
+type ChargingSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChargingSessionMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (csuo *ChargingSessionUpdateOne) SetTenantID(i int64) *ChargingSessionUpdateOne {
+	csuo.mutation.ResetTenantID()
+	csuo.mutation.SetTenantID(i)
+	return csuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableTenantID(i *int64) *ChargingSessionUpdateOne {
+	if i != nil {
+		csuo.SetTenantID(*i)
+	}
+	return csuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (csuo *ChargingSessionUpdateOne) AddTenantID(i int64) *ChargingSessionUpdateOne {
+	csuo.mutation.AddTenantID(i)
+	return csuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (csuo *ChargingSessionUpdateOne) ClearTenantID() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearTenantID()
+	return csuo
+}
+
+// SetCarID sets the "car_id" field.
+func (csuo *ChargingSessionUpdateOne) SetCarID(i int64) *ChargingSessionUpdateOne {
+	csuo.mutation.SetCarID(i)
+	return csuo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableCarID(i *int64) *ChargingSessionUpdateOne {
+	if i != nil {
+		csuo.SetCarID(*i)
+	}
+	return csuo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (csuo *ChargingSessionUpdateOne) ClearCarID() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearCarID()
+	return csuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (csuo *ChargingSessionUpdateOne) SetStartedAt(t time.Time) *ChargingSessionUpdateOne {
+	csuo.mutation.SetStartedAt(t)
+	return csuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableStartedAt(t *time.Time) *ChargingSessionUpdateOne {
+	if t != nil {
+		csuo.SetStartedAt(*t)
+	}
+	return csuo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (csuo *ChargingSessionUpdateOne) ClearStartedAt() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearStartedAt()
+	return csuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (csuo *ChargingSessionUpdateOne) SetEndedAt(t time.Time) *ChargingSessionUpdateOne {
+	csuo.mutation.SetEndedAt(t)
+	return csuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableEndedAt(t *time.Time) *ChargingSessionUpdateOne {
+	if t != nil {
+		csuo.SetEndedAt(*t)
+	}
+	return csuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (csuo *ChargingSessionUpdateOne) ClearEndedAt() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearEndedAt()
+	return csuo
+}
+
+// SetEnergy sets the "energy" field.
+func (csuo *ChargingSessionUpdateOne) SetEnergy(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.ResetEnergy()
+	csuo.mutation.SetEnergy(f)
+	return csuo
+}
+
+// SetNillableEnergy sets the "energy" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableEnergy(f *float64) *ChargingSessionUpdateOne {
+	if f != nil {
+		csuo.SetEnergy(*f)
+	}
+	return csuo
+}
+
+// AddEnergy adds f to the "energy" field.
+func (csuo *ChargingSessionUpdateOne) AddEnergy(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.AddEnergy(f)
+	return csuo
+}
+
+// ClearEnergy clears the value of the "energy" field.
+func (csuo *ChargingSessionUpdateOne) ClearEnergy() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearEnergy()
+	return csuo
+}
+
+// SetChargerType sets the "charger_type" field.
+func (csuo *ChargingSessionUpdateOne) SetChargerType(s string) *ChargingSessionUpdateOne {
+	csuo.mutation.SetChargerType(s)
+	return csuo
+}
+
+// SetNillableChargerType sets the "charger_type" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableChargerType(s *string) *ChargingSessionUpdateOne {
+	if s != nil {
+		csuo.SetChargerType(*s)
+	}
+	return csuo
+}
+
+// ClearChargerType clears the value of the "charger_type" field.
+func (csuo *ChargingSessionUpdateOne) ClearChargerType() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearChargerType()
+	return csuo
+}
+
+// SetCost sets the "cost" field.
+func (csuo *ChargingSessionUpdateOne) SetCost(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.ResetCost()
+	csuo.mutation.SetCost(f)
+	return csuo
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableCost(f *float64) *ChargingSessionUpdateOne {
+	if f != nil {
+		csuo.SetCost(*f)
+	}
+	return csuo
+}
+
+// AddCost adds f to the "cost" field.
+func (csuo *ChargingSessionUpdateOne) AddCost(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.AddCost(f)
+	return csuo
+}
+
+// ClearCost clears the value of the "cost" field.
+func (csuo *ChargingSessionUpdateOne) ClearCost() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearCost()
+	return csuo
+}
+
+// SetStation sets the "station" field.
+func (csuo *ChargingSessionUpdateOne) SetStation(s string) *ChargingSessionUpdateOne {
+	csuo.mutation.SetStation(s)
+	return csuo
+}
+
+// SetNillableStation sets the "station" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableStation(s *string) *ChargingSessionUpdateOne {
+	if s != nil {
+		csuo.SetStation(*s)
+	}
+	return csuo
+}
+
+// ClearStation clears the value of the "station" field.
+func (csuo *ChargingSessionUpdateOne) ClearStation() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearStation()
+	return csuo
+}
+
+// SetStartSoc sets the "start_soc" field.
+func (csuo *ChargingSessionUpdateOne) SetStartSoc(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.ResetStartSoc()
+	csuo.mutation.SetStartSoc(f)
+	return csuo
+}
+
+// SetNillableStartSoc sets the "start_soc" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableStartSoc(f *float64) *ChargingSessionUpdateOne {
+	if f != nil {
+		csuo.SetStartSoc(*f)
+	}
+	return csuo
+}
+
+// AddStartSoc adds f to the "start_soc" field.
+func (csuo *ChargingSessionUpdateOne) AddStartSoc(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.AddStartSoc(f)
+	return csuo
+}
+
+// ClearStartSoc clears the value of the "start_soc" field.
+func (csuo *ChargingSessionUpdateOne) ClearStartSoc() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearStartSoc()
+	return csuo
+}
+
+// SetEndSoc sets the "end_soc" field.
+func (csuo *ChargingSessionUpdateOne) SetEndSoc(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.ResetEndSoc()
+	csuo.mutation.SetEndSoc(f)
+	return csuo
+}
+
+// SetNillableEndSoc sets the "end_soc" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableEndSoc(f *float64) *ChargingSessionUpdateOne {
+	if f != nil {
+		csuo.SetEndSoc(*f)
+	}
+	return csuo
+}
+
+// AddEndSoc adds f to the "end_soc" field.
+func (csuo *ChargingSessionUpdateOne) AddEndSoc(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.AddEndSoc(f)
+	return csuo
+}
+
+// ClearEndSoc clears the value of the "end_soc" field.
+func (csuo *ChargingSessionUpdateOne) ClearEndSoc() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearEndSoc()
+	return csuo
+}
+
+// SetSoh sets the "soh" field.
+func (csuo *ChargingSessionUpdateOne) SetSoh(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.ResetSoh()
+	csuo.mutation.SetSoh(f)
+	return csuo
+}
+
+// SetNillableSoh sets the "soh" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableSoh(f *float64) *ChargingSessionUpdateOne {
+	if f != nil {
+		csuo.SetSoh(*f)
+	}
+	return csuo
+}
+
+// AddSoh adds f to the "soh" field.
+func (csuo *ChargingSessionUpdateOne) AddSoh(f float64) *ChargingSessionUpdateOne {
+	csuo.mutation.AddSoh(f)
+	return csuo
+}
+
+// ClearSoh clears the value of the "soh" field.
+func (csuo *ChargingSessionUpdateOne) ClearSoh() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearSoh()
+	return csuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csuo *ChargingSessionUpdateOne) SetCreatedAt(t time.Time) *ChargingSessionUpdateOne {
+	csuo.mutation.SetCreatedAt(t)
+	return csuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csuo *ChargingSessionUpdateOne) SetNillableCreatedAt(t *time.Time) *ChargingSessionUpdateOne {
+	if t != nil {
+		csuo.SetCreatedAt(*t)
+	}
+	return csuo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (csuo *ChargingSessionUpdateOne) SetCar(c *Car) *ChargingSessionUpdateOne {
+	return csuo.SetCarID(c.ID)
+}
+
+// Mutation returns the ChargingSessionMutation object of the builder.
+func (csuo *ChargingSessionUpdateOne) Mutation() *ChargingSessionMutation {
+	return csuo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (csuo *ChargingSessionUpdateOne) ClearCar() *ChargingSessionUpdateOne {
+	csuo.mutation.ClearCar()
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *ChargingSessionUpdateOne) Select(field string, fields ...string) *ChargingSessionUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated ChargingSession entity.
+func (csuo *ChargingSessionUpdateOne) Save(ctx context.Context) (*ChargingSession, error) {
+	var (
+		err  error
+		node *ChargingSession
+	)
+	if len(csuo.hooks) == 0 {
+		node, err = csuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChargingSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			csuo.mutation = mutation
+			node, err = csuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(csuo.hooks) - 1; i >= 0; i-- {
+			if csuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = csuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, csuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ChargingSession)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ChargingSessionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *ChargingSessionUpdateOne) SaveX(ctx context.Context) *ChargingSession {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *ChargingSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *ChargingSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (csuo *ChargingSessionUpdateOne) sqlSave(ctx context.Context) (_node *ChargingSession, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   chargingsession.Table,
+			Columns: chargingsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: chargingsession.FieldID,
+			},
+		},
+	}
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChargingSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chargingsession.FieldID)
+		for _, f := range fields {
+			if !chargingsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chargingsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: chargingsession.FieldTenantID,
+		})
+	}
+	if value, ok := csuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: chargingsession.FieldTenantID,
+		})
+	}
+	if csuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: chargingsession.FieldTenantID,
+		})
+	}
+	if value, ok := csuo.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: chargingsession.FieldStartedAt,
+		})
+	}
+	if csuo.mutation.StartedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: chargingsession.FieldStartedAt,
+		})
+	}
+	if value, ok := csuo.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: chargingsession.FieldEndedAt,
+		})
+	}
+	if csuo.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: chargingsession.FieldEndedAt,
+		})
+	}
+	if value, ok := csuo.mutation.Energy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEnergy,
+		})
+	}
+	if value, ok := csuo.mutation.AddedEnergy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEnergy,
+		})
+	}
+	if csuo.mutation.EnergyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldEnergy,
+		})
+	}
+	if value, ok := csuo.mutation.ChargerType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: chargingsession.FieldChargerType,
+		})
+	}
+	if csuo.mutation.ChargerTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: chargingsession.FieldChargerType,
+		})
+	}
+	if value, ok := csuo.mutation.Cost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldCost,
+		})
+	}
+	if value, ok := csuo.mutation.AddedCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldCost,
+		})
+	}
+	if csuo.mutation.CostCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldCost,
+		})
+	}
+	if value, ok := csuo.mutation.Station(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: chargingsession.FieldStation,
+		})
+	}
+	if csuo.mutation.StationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: chargingsession.FieldStation,
+		})
+	}
+	if value, ok := csuo.mutation.StartSoc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldStartSoc,
+		})
+	}
+	if value, ok := csuo.mutation.AddedStartSoc(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldStartSoc,
+		})
+	}
+	if csuo.mutation.StartSocCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldStartSoc,
+		})
+	}
+	if value, ok := csuo.mutation.EndSoc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEndSoc,
+		})
+	}
+	if value, ok := csuo.mutation.AddedEndSoc(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldEndSoc,
+		})
+	}
+	if csuo.mutation.EndSocCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldEndSoc,
+		})
+	}
+	if value, ok := csuo.mutation.Soh(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldSoh,
+		})
+	}
+	if value, ok := csuo.mutation.AddedSoh(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: chargingsession.FieldSoh,
+		})
+	}
+	if csuo.mutation.SohCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: chargingsession.FieldSoh,
+		})
+	}
+	if value, ok := csuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: chargingsession.FieldCreatedAt,
+		})
+	}
+	if csuo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargingsession.CarTable,
+			Columns: []string{chargingsession.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargingsession.CarTable,
+			Columns: []string{chargingsession.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ChargingSession{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chargingsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -9,10 +9,39 @@ import (
 
 	"car-service/internal/data/ent/migrate"
 
+	"car-service/internal/data/ent/attachment"
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/auditlog"
+	"car-service/internal/data/ent/brand"
 	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/jobrun"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/ownerhistory"
+	"car-service/internal/data/ent/recall"
+	"car-service/internal/data/ent/refuel"
+	"car-service/internal/data/ent/reservation"
+	"car-service/internal/data/ent/tag"
+	"car-service/internal/data/ent/traderecord"
+	"car-service/internal/data/ent/transfer"
+	"car-service/internal/data/ent/trip"
+	"car-service/internal/data/ent/vehiclemodel"
+	"car-service/internal/data/ent/violation"
+	"car-service/internal/data/ent/warrantydefinition"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -20,8 +49,64 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// AttributeDefinition is the client for interacting with the AttributeDefinition builders.
+	AttributeDefinition *AttributeDefinitionClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Brand is the client for interacting with the Brand builders.
+	Brand *BrandClient
 	// Car is the client for interacting with the Car builders.
 	Car *CarClient
+	// CarAttribute is the client for interacting with the CarAttribute builders.
+	CarAttribute *CarAttributeClient
+	// CarRecall is the client for interacting with the CarRecall builders.
+	CarRecall *CarRecallClient
+	// CarWarranty is the client for interacting with the CarWarranty builders.
+	CarWarranty *CarWarrantyClient
+	// ChargingSession is the client for interacting with the ChargingSession builders.
+	ChargingSession *ChargingSessionClient
+	// Fleet is the client for interacting with the Fleet builders.
+	Fleet *FleetClient
+	// Geofence is the client for interacting with the Geofence builders.
+	Geofence *GeofenceClient
+	// Incident is the client for interacting with the Incident builders.
+	Incident *IncidentClient
+	// InsurancePolicy is the client for interacting with the InsurancePolicy builders.
+	InsurancePolicy *InsurancePolicyClient
+	// JobRun is the client for interacting with the JobRun builders.
+	JobRun *JobRunClient
+	// LeaseContract is the client for interacting with the LeaseContract builders.
+	LeaseContract *LeaseContractClient
+	// Listing is the client for interacting with the Listing builders.
+	Listing *ListingClient
+	// MaintenanceRecord is the client for interacting with the MaintenanceRecord builders.
+	MaintenanceRecord *MaintenanceRecordClient
+	// OdometerReading is the client for interacting with the OdometerReading builders.
+	OdometerReading *OdometerReadingClient
+	// OwnerHistory is the client for interacting with the OwnerHistory builders.
+	OwnerHistory *OwnerHistoryClient
+	// Recall is the client for interacting with the Recall builders.
+	Recall *RecallClient
+	// Refuel is the client for interacting with the Refuel builders.
+	Refuel *RefuelClient
+	// Reservation is the client for interacting with the Reservation builders.
+	Reservation *ReservationClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TradeRecord is the client for interacting with the TradeRecord builders.
+	TradeRecord *TradeRecordClient
+	// Transfer is the client for interacting with the Transfer builders.
+	Transfer *TransferClient
+	// Trip is the client for interacting with the Trip builders.
+	Trip *TripClient
+	// VehicleModel is the client for interacting with the VehicleModel builders.
+	VehicleModel *VehicleModelClient
+	// Violation is the client for interacting with the Violation builders.
+	Violation *ViolationClient
+	// WarrantyDefinition is the client for interacting with the WarrantyDefinition builders.
+	WarrantyDefinition *WarrantyDefinitionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -35,7 +120,35 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Attachment = NewAttachmentClient(c.config)
+	c.AttributeDefinition = NewAttributeDefinitionClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.Brand = NewBrandClient(c.config)
 	c.Car = NewCarClient(c.config)
+	c.CarAttribute = NewCarAttributeClient(c.config)
+	c.CarRecall = NewCarRecallClient(c.config)
+	c.CarWarranty = NewCarWarrantyClient(c.config)
+	c.ChargingSession = NewChargingSessionClient(c.config)
+	c.Fleet = NewFleetClient(c.config)
+	c.Geofence = NewGeofenceClient(c.config)
+	c.Incident = NewIncidentClient(c.config)
+	c.InsurancePolicy = NewInsurancePolicyClient(c.config)
+	c.JobRun = NewJobRunClient(c.config)
+	c.LeaseContract = NewLeaseContractClient(c.config)
+	c.Listing = NewListingClient(c.config)
+	c.MaintenanceRecord = NewMaintenanceRecordClient(c.config)
+	c.OdometerReading = NewOdometerReadingClient(c.config)
+	c.OwnerHistory = NewOwnerHistoryClient(c.config)
+	c.Recall = NewRecallClient(c.config)
+	c.Refuel = NewRefuelClient(c.config)
+	c.Reservation = NewReservationClient(c.config)
+	c.Tag = NewTagClient(c.config)
+	c.TradeRecord = NewTradeRecordClient(c.config)
+	c.Transfer = NewTransferClient(c.config)
+	c.Trip = NewTripClient(c.config)
+	c.VehicleModel = NewVehicleModelClient(c.config)
+	c.Violation = NewViolationClient(c.config)
+	c.WarrantyDefinition = NewWarrantyDefinitionClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -67,9 +180,37 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Car:    NewCarClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		Attachment:          NewAttachmentClient(cfg),
+		AttributeDefinition: NewAttributeDefinitionClient(cfg),
+		AuditLog:            NewAuditLogClient(cfg),
+		Brand:               NewBrandClient(cfg),
+		Car:                 NewCarClient(cfg),
+		CarAttribute:        NewCarAttributeClient(cfg),
+		CarRecall:           NewCarRecallClient(cfg),
+		CarWarranty:         NewCarWarrantyClient(cfg),
+		ChargingSession:     NewChargingSessionClient(cfg),
+		Fleet:               NewFleetClient(cfg),
+		Geofence:            NewGeofenceClient(cfg),
+		Incident:            NewIncidentClient(cfg),
+		InsurancePolicy:     NewInsurancePolicyClient(cfg),
+		JobRun:              NewJobRunClient(cfg),
+		LeaseContract:       NewLeaseContractClient(cfg),
+		Listing:             NewListingClient(cfg),
+		MaintenanceRecord:   NewMaintenanceRecordClient(cfg),
+		OdometerReading:     NewOdometerReadingClient(cfg),
+		OwnerHistory:        NewOwnerHistoryClient(cfg),
+		Recall:              NewRecallClient(cfg),
+		Refuel:              NewRefuelClient(cfg),
+		Reservation:         NewReservationClient(cfg),
+		Tag:                 NewTagClient(cfg),
+		TradeRecord:         NewTradeRecordClient(cfg),
+		Transfer:            NewTransferClient(cfg),
+		Trip:                NewTripClient(cfg),
+		VehicleModel:        NewVehicleModelClient(cfg),
+		Violation:           NewViolationClient(cfg),
+		WarrantyDefinition:  NewWarrantyDefinitionClient(cfg),
 	}, nil
 }
 
@@ -87,16 +228,44 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Car:    NewCarClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		Attachment:          NewAttachmentClient(cfg),
+		AttributeDefinition: NewAttributeDefinitionClient(cfg),
+		AuditLog:            NewAuditLogClient(cfg),
+		Brand:               NewBrandClient(cfg),
+		Car:                 NewCarClient(cfg),
+		CarAttribute:        NewCarAttributeClient(cfg),
+		CarRecall:           NewCarRecallClient(cfg),
+		CarWarranty:         NewCarWarrantyClient(cfg),
+		ChargingSession:     NewChargingSessionClient(cfg),
+		Fleet:               NewFleetClient(cfg),
+		Geofence:            NewGeofenceClient(cfg),
+		Incident:            NewIncidentClient(cfg),
+		InsurancePolicy:     NewInsurancePolicyClient(cfg),
+		JobRun:              NewJobRunClient(cfg),
+		LeaseContract:       NewLeaseContractClient(cfg),
+		Listing:             NewListingClient(cfg),
+		MaintenanceRecord:   NewMaintenanceRecordClient(cfg),
+		OdometerReading:     NewOdometerReadingClient(cfg),
+		OwnerHistory:        NewOwnerHistoryClient(cfg),
+		Recall:              NewRecallClient(cfg),
+		Refuel:              NewRefuelClient(cfg),
+		Reservation:         NewReservationClient(cfg),
+		Tag:                 NewTagClient(cfg),
+		TradeRecord:         NewTradeRecordClient(cfg),
+		Transfer:            NewTransferClient(cfg),
+		Trip:                NewTripClient(cfg),
+		VehicleModel:        NewVehicleModelClient(cfg),
+		Violation:           NewViolationClient(cfg),
+		WarrantyDefinition:  NewWarrantyDefinitionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Car.
+//		Attachment.
 //		Query().
 //		Count(ctx)
 //
@@ -119,7 +288,446 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Attachment.Use(hooks...)
+	c.AttributeDefinition.Use(hooks...)
+	c.AuditLog.Use(hooks...)
+	c.Brand.Use(hooks...)
 	c.Car.Use(hooks...)
+	c.CarAttribute.Use(hooks...)
+	c.CarRecall.Use(hooks...)
+	c.CarWarranty.Use(hooks...)
+	c.ChargingSession.Use(hooks...)
+	c.Fleet.Use(hooks...)
+	c.Geofence.Use(hooks...)
+	c.Incident.Use(hooks...)
+	c.InsurancePolicy.Use(hooks...)
+	c.JobRun.Use(hooks...)
+	c.LeaseContract.Use(hooks...)
+	c.Listing.Use(hooks...)
+	c.MaintenanceRecord.Use(hooks...)
+	c.OdometerReading.Use(hooks...)
+	c.OwnerHistory.Use(hooks...)
+	c.Recall.Use(hooks...)
+	c.Refuel.Use(hooks...)
+	c.Reservation.Use(hooks...)
+	c.Tag.Use(hooks...)
+	c.TradeRecord.Use(hooks...)
+	c.Transfer.Use(hooks...)
+	c.Trip.Use(hooks...)
+	c.VehicleModel.Use(hooks...)
+	c.Violation.Use(hooks...)
+	c.WarrantyDefinition.Use(hooks...)
+}
+
+// AttachmentClient is a client for the Attachment schema.
+type AttachmentClient struct {
+	config
+}
+
+// NewAttachmentClient returns a client for the Attachment from the given config.
+func NewAttachmentClient(c config) *AttachmentClient {
+	return &AttachmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attachment.Hooks(f(g(h())))`.
+func (c *AttachmentClient) Use(hooks ...Hook) {
+	c.hooks.Attachment = append(c.hooks.Attachment, hooks...)
+}
+
+// Create returns a builder for creating a Attachment entity.
+func (c *AttachmentClient) Create() *AttachmentCreate {
+	mutation := newAttachmentMutation(c.config, OpCreate)
+	return &AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Attachment entities.
+func (c *AttachmentClient) CreateBulk(builders ...*AttachmentCreate) *AttachmentCreateBulk {
+	return &AttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Attachment.
+func (c *AttachmentClient) Update() *AttachmentUpdate {
+	mutation := newAttachmentMutation(c.config, OpUpdate)
+	return &AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttachmentClient) UpdateOne(a *Attachment) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachment(a))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttachmentClient) UpdateOneID(id int64) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachmentID(id))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Attachment.
+func (c *AttachmentClient) Delete() *AttachmentDelete {
+	mutation := newAttachmentMutation(c.config, OpDelete)
+	return &AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttachmentClient) DeleteOne(a *Attachment) *AttachmentDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *AttachmentClient) DeleteOneID(id int64) *AttachmentDeleteOne {
+	builder := c.Delete().Where(attachment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttachmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Attachment.
+func (c *AttachmentClient) Query() *AttachmentQuery {
+	return &AttachmentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Attachment entity by its id.
+func (c *AttachmentClient) Get(ctx context.Context, id int64) (*Attachment, error) {
+	return c.Query().Where(attachment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttachmentClient) GetX(ctx context.Context, id int64) *Attachment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a Attachment.
+func (c *AttachmentClient) QueryCar(a *Attachment) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attachment.Table, attachment.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attachment.CarTable, attachment.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttachmentClient) Hooks() []Hook {
+	hooks := c.hooks.Attachment
+	return append(hooks[:len(hooks):len(hooks)], attachment.Hooks[:]...)
+}
+
+// AttributeDefinitionClient is a client for the AttributeDefinition schema.
+type AttributeDefinitionClient struct {
+	config
+}
+
+// NewAttributeDefinitionClient returns a client for the AttributeDefinition from the given config.
+func NewAttributeDefinitionClient(c config) *AttributeDefinitionClient {
+	return &AttributeDefinitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attributedefinition.Hooks(f(g(h())))`.
+func (c *AttributeDefinitionClient) Use(hooks ...Hook) {
+	c.hooks.AttributeDefinition = append(c.hooks.AttributeDefinition, hooks...)
+}
+
+// Create returns a builder for creating a AttributeDefinition entity.
+func (c *AttributeDefinitionClient) Create() *AttributeDefinitionCreate {
+	mutation := newAttributeDefinitionMutation(c.config, OpCreate)
+	return &AttributeDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AttributeDefinition entities.
+func (c *AttributeDefinitionClient) CreateBulk(builders ...*AttributeDefinitionCreate) *AttributeDefinitionCreateBulk {
+	return &AttributeDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AttributeDefinition.
+func (c *AttributeDefinitionClient) Update() *AttributeDefinitionUpdate {
+	mutation := newAttributeDefinitionMutation(c.config, OpUpdate)
+	return &AttributeDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttributeDefinitionClient) UpdateOne(ad *AttributeDefinition) *AttributeDefinitionUpdateOne {
+	mutation := newAttributeDefinitionMutation(c.config, OpUpdateOne, withAttributeDefinition(ad))
+	return &AttributeDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttributeDefinitionClient) UpdateOneID(id int64) *AttributeDefinitionUpdateOne {
+	mutation := newAttributeDefinitionMutation(c.config, OpUpdateOne, withAttributeDefinitionID(id))
+	return &AttributeDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AttributeDefinition.
+func (c *AttributeDefinitionClient) Delete() *AttributeDefinitionDelete {
+	mutation := newAttributeDefinitionMutation(c.config, OpDelete)
+	return &AttributeDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttributeDefinitionClient) DeleteOne(ad *AttributeDefinition) *AttributeDefinitionDeleteOne {
+	return c.DeleteOneID(ad.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *AttributeDefinitionClient) DeleteOneID(id int64) *AttributeDefinitionDeleteOne {
+	builder := c.Delete().Where(attributedefinition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttributeDefinitionDeleteOne{builder}
+}
+
+// Query returns a query builder for AttributeDefinition.
+func (c *AttributeDefinitionClient) Query() *AttributeDefinitionQuery {
+	return &AttributeDefinitionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AttributeDefinition entity by its id.
+func (c *AttributeDefinitionClient) Get(ctx context.Context, id int64) (*AttributeDefinition, error) {
+	return c.Query().Where(attributedefinition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttributeDefinitionClient) GetX(ctx context.Context, id int64) *AttributeDefinition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryValues queries the values edge of a AttributeDefinition.
+func (c *AttributeDefinitionClient) QueryValues(ad *AttributeDefinition) *CarAttributeQuery {
+	query := &CarAttributeQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ad.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attributedefinition.Table, attributedefinition.FieldID, id),
+			sqlgraph.To(carattribute.Table, carattribute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attributedefinition.ValuesTable, attributedefinition.ValuesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ad.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttributeDefinitionClient) Hooks() []Hook {
+	hooks := c.hooks.AttributeDefinition
+	return append(hooks[:len(hooks):len(hooks)], attributedefinition.Hooks[:]...)
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int64) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int64) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int64) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int64) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLog
+	return append(hooks[:len(hooks):len(hooks)], auditlog.Hooks[:]...)
+}
+
+// BrandClient is a client for the Brand schema.
+type BrandClient struct {
+	config
+}
+
+// NewBrandClient returns a client for the Brand from the given config.
+func NewBrandClient(c config) *BrandClient {
+	return &BrandClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `brand.Hooks(f(g(h())))`.
+func (c *BrandClient) Use(hooks ...Hook) {
+	c.hooks.Brand = append(c.hooks.Brand, hooks...)
+}
+
+// Create returns a builder for creating a Brand entity.
+func (c *BrandClient) Create() *BrandCreate {
+	mutation := newBrandMutation(c.config, OpCreate)
+	return &BrandCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Brand entities.
+func (c *BrandClient) CreateBulk(builders ...*BrandCreate) *BrandCreateBulk {
+	return &BrandCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Brand.
+func (c *BrandClient) Update() *BrandUpdate {
+	mutation := newBrandMutation(c.config, OpUpdate)
+	return &BrandUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BrandClient) UpdateOne(b *Brand) *BrandUpdateOne {
+	mutation := newBrandMutation(c.config, OpUpdateOne, withBrand(b))
+	return &BrandUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BrandClient) UpdateOneID(id int64) *BrandUpdateOne {
+	mutation := newBrandMutation(c.config, OpUpdateOne, withBrandID(id))
+	return &BrandUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Brand.
+func (c *BrandClient) Delete() *BrandDelete {
+	mutation := newBrandMutation(c.config, OpDelete)
+	return &BrandDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BrandClient) DeleteOne(b *Brand) *BrandDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *BrandClient) DeleteOneID(id int64) *BrandDeleteOne {
+	builder := c.Delete().Where(brand.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BrandDeleteOne{builder}
+}
+
+// Query returns a query builder for Brand.
+func (c *BrandClient) Query() *BrandQuery {
+	return &BrandQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Brand entity by its id.
+func (c *BrandClient) Get(ctx context.Context, id int64) (*Brand, error) {
+	return c.Query().Where(brand.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BrandClient) GetX(ctx context.Context, id int64) *Brand {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVehicleModels queries the vehicle_models edge of a Brand.
+func (c *BrandClient) QueryVehicleModels(b *Brand) *VehicleModelQuery {
+	query := &VehicleModelQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(brand.Table, brand.FieldID, id),
+			sqlgraph.To(vehiclemodel.Table, vehiclemodel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, brand.VehicleModelsTable, brand.VehicleModelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BrandClient) Hooks() []Hook {
+	return c.hooks.Brand
 }
 
 // CarClient is a client for the Car schema.
@@ -207,7 +815,3003 @@ func (c *CarClient) GetX(ctx context.Context, id int64) *Car {
 	return obj
 }
 
-// Hooks returns the client hooks.
-func (c *CarClient) Hooks() []Hook {
-	return c.hooks.Car
+// QueryVehicleModel queries the vehicle_model edge of a Car.
+func (c *CarClient) QueryVehicleModel(ca *Car) *VehicleModelQuery {
+	query := &VehicleModelQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(vehiclemodel.Table, vehiclemodel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, car.VehicleModelTable, car.VehicleModelColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMaintenanceRecords queries the maintenance_records edge of a Car.
+func (c *CarClient) QueryMaintenanceRecords(ca *Car) *MaintenanceRecordQuery {
+	query := &MaintenanceRecordQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(maintenancerecord.Table, maintenancerecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.MaintenanceRecordsTable, car.MaintenanceRecordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInsurancePolicies queries the insurance_policies edge of a Car.
+func (c *CarClient) QueryInsurancePolicies(ca *Car) *InsurancePolicyQuery {
+	query := &InsurancePolicyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(insurancepolicy.Table, insurancepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.InsurancePoliciesTable, car.InsurancePoliciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransfers queries the transfers edge of a Car.
+func (c *CarClient) QueryTransfers(ca *Car) *TransferQuery {
+	query := &TransferQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(transfer.Table, transfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.TransfersTable, car.TransfersColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOdometerReadings queries the odometer_readings edge of a Car.
+func (c *CarClient) QueryOdometerReadings(ca *Car) *OdometerReadingQuery {
+	query := &OdometerReadingQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(odometerreading.Table, odometerreading.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.OdometerReadingsTable, car.OdometerReadingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a Car.
+func (c *CarClient) QueryAttachments(ca *Car) *AttachmentQuery {
+	query := &AttachmentQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.AttachmentsTable, car.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFleets queries the fleets edge of a Car.
+func (c *CarClient) QueryFleets(ca *Car) *FleetQuery {
+	query := &FleetQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(fleet.Table, fleet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, car.FleetsTable, car.FleetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Car.
+func (c *CarClient) QueryTags(ca *Car) *TagQuery {
+	query := &TagQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, car.TagsTable, car.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttributes queries the attributes edge of a Car.
+func (c *CarClient) QueryAttributes(ca *Car) *CarAttributeQuery {
+	query := &CarAttributeQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(carattribute.Table, carattribute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.AttributesTable, car.AttributesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReservations queries the reservations edge of a Car.
+func (c *CarClient) QueryReservations(ca *Car) *ReservationQuery {
+	query := &ReservationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(reservation.Table, reservation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.ReservationsTable, car.ReservationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTrips queries the trips edge of a Car.
+func (c *CarClient) QueryTrips(ca *Car) *TripQuery {
+	query := &TripQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(trip.Table, trip.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.TripsTable, car.TripsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefuels queries the refuels edge of a Car.
+func (c *CarClient) QueryRefuels(ca *Car) *RefuelQuery {
+	query := &RefuelQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(refuel.Table, refuel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.RefuelsTable, car.RefuelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecalls queries the recalls edge of a Car.
+func (c *CarClient) QueryRecalls(ca *Car) *CarRecallQuery {
+	query := &CarRecallQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(carrecall.Table, carrecall.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.RecallsTable, car.RecallsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOwnerHistories queries the owner_histories edge of a Car.
+func (c *CarClient) QueryOwnerHistories(ca *Car) *OwnerHistoryQuery {
+	query := &OwnerHistoryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(ownerhistory.Table, ownerhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.OwnerHistoriesTable, car.OwnerHistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryViolations queries the violations edge of a Car.
+func (c *CarClient) QueryViolations(ca *Car) *ViolationQuery {
+	query := &ViolationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(violation.Table, violation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.ViolationsTable, car.ViolationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTradeRecords queries the trade_records edge of a Car.
+func (c *CarClient) QueryTradeRecords(ca *Car) *TradeRecordQuery {
+	query := &TradeRecordQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(traderecord.Table, traderecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.TradeRecordsTable, car.TradeRecordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryListings queries the listings edge of a Car.
+func (c *CarClient) QueryListings(ca *Car) *ListingQuery {
+	query := &ListingQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.ListingsTable, car.ListingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWarranties queries the warranties edge of a Car.
+func (c *CarClient) QueryWarranties(ca *Car) *CarWarrantyQuery {
+	query := &CarWarrantyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(carwarranty.Table, carwarranty.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.WarrantiesTable, car.WarrantiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIncidents queries the incidents edge of a Car.
+func (c *CarClient) QueryIncidents(ca *Car) *IncidentQuery {
+	query := &IncidentQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(incident.Table, incident.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.IncidentsTable, car.IncidentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaseContracts queries the lease_contracts edge of a Car.
+func (c *CarClient) QueryLeaseContracts(ca *Car) *LeaseContractQuery {
+	query := &LeaseContractQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(leasecontract.Table, leasecontract.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.LeaseContractsTable, car.LeaseContractsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChargingSessions queries the charging_sessions edge of a Car.
+func (c *CarClient) QueryChargingSessions(ca *Car) *ChargingSessionQuery {
+	query := &ChargingSessionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(chargingsession.Table, chargingsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.ChargingSessionsTable, car.ChargingSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGeofences queries the geofences edge of a Car.
+func (c *CarClient) QueryGeofences(ca *Car) *GeofenceQuery {
+	query := &GeofenceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(geofence.Table, geofence.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, car.GeofencesTable, car.GeofencesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CarClient) Hooks() []Hook {
+	hooks := c.hooks.Car
+	return append(hooks[:len(hooks):len(hooks)], car.Hooks[:]...)
+}
+
+// CarAttributeClient is a client for the CarAttribute schema.
+type CarAttributeClient struct {
+	config
+}
+
+// NewCarAttributeClient returns a client for the CarAttribute from the given config.
+func NewCarAttributeClient(c config) *CarAttributeClient {
+	return &CarAttributeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `carattribute.Hooks(f(g(h())))`.
+func (c *CarAttributeClient) Use(hooks ...Hook) {
+	c.hooks.CarAttribute = append(c.hooks.CarAttribute, hooks...)
+}
+
+// Create returns a builder for creating a CarAttribute entity.
+func (c *CarAttributeClient) Create() *CarAttributeCreate {
+	mutation := newCarAttributeMutation(c.config, OpCreate)
+	return &CarAttributeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CarAttribute entities.
+func (c *CarAttributeClient) CreateBulk(builders ...*CarAttributeCreate) *CarAttributeCreateBulk {
+	return &CarAttributeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CarAttribute.
+func (c *CarAttributeClient) Update() *CarAttributeUpdate {
+	mutation := newCarAttributeMutation(c.config, OpUpdate)
+	return &CarAttributeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CarAttributeClient) UpdateOne(ca *CarAttribute) *CarAttributeUpdateOne {
+	mutation := newCarAttributeMutation(c.config, OpUpdateOne, withCarAttribute(ca))
+	return &CarAttributeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CarAttributeClient) UpdateOneID(id int64) *CarAttributeUpdateOne {
+	mutation := newCarAttributeMutation(c.config, OpUpdateOne, withCarAttributeID(id))
+	return &CarAttributeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CarAttribute.
+func (c *CarAttributeClient) Delete() *CarAttributeDelete {
+	mutation := newCarAttributeMutation(c.config, OpDelete)
+	return &CarAttributeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CarAttributeClient) DeleteOne(ca *CarAttribute) *CarAttributeDeleteOne {
+	return c.DeleteOneID(ca.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *CarAttributeClient) DeleteOneID(id int64) *CarAttributeDeleteOne {
+	builder := c.Delete().Where(carattribute.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CarAttributeDeleteOne{builder}
+}
+
+// Query returns a query builder for CarAttribute.
+func (c *CarAttributeClient) Query() *CarAttributeQuery {
+	return &CarAttributeQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a CarAttribute entity by its id.
+func (c *CarAttributeClient) Get(ctx context.Context, id int64) (*CarAttribute, error) {
+	return c.Query().Where(carattribute.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CarAttributeClient) GetX(ctx context.Context, id int64) *CarAttribute {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a CarAttribute.
+func (c *CarAttributeClient) QueryCar(ca *CarAttribute) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carattribute.Table, carattribute.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carattribute.CarTable, carattribute.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDefinition queries the definition edge of a CarAttribute.
+func (c *CarAttributeClient) QueryDefinition(ca *CarAttribute) *AttributeDefinitionQuery {
+	query := &AttributeDefinitionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carattribute.Table, carattribute.FieldID, id),
+			sqlgraph.To(attributedefinition.Table, attributedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carattribute.DefinitionTable, carattribute.DefinitionColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CarAttributeClient) Hooks() []Hook {
+	return c.hooks.CarAttribute
+}
+
+// CarRecallClient is a client for the CarRecall schema.
+type CarRecallClient struct {
+	config
+}
+
+// NewCarRecallClient returns a client for the CarRecall from the given config.
+func NewCarRecallClient(c config) *CarRecallClient {
+	return &CarRecallClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `carrecall.Hooks(f(g(h())))`.
+func (c *CarRecallClient) Use(hooks ...Hook) {
+	c.hooks.CarRecall = append(c.hooks.CarRecall, hooks...)
+}
+
+// Create returns a builder for creating a CarRecall entity.
+func (c *CarRecallClient) Create() *CarRecallCreate {
+	mutation := newCarRecallMutation(c.config, OpCreate)
+	return &CarRecallCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CarRecall entities.
+func (c *CarRecallClient) CreateBulk(builders ...*CarRecallCreate) *CarRecallCreateBulk {
+	return &CarRecallCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CarRecall.
+func (c *CarRecallClient) Update() *CarRecallUpdate {
+	mutation := newCarRecallMutation(c.config, OpUpdate)
+	return &CarRecallUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CarRecallClient) UpdateOne(cr *CarRecall) *CarRecallUpdateOne {
+	mutation := newCarRecallMutation(c.config, OpUpdateOne, withCarRecall(cr))
+	return &CarRecallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CarRecallClient) UpdateOneID(id int64) *CarRecallUpdateOne {
+	mutation := newCarRecallMutation(c.config, OpUpdateOne, withCarRecallID(id))
+	return &CarRecallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CarRecall.
+func (c *CarRecallClient) Delete() *CarRecallDelete {
+	mutation := newCarRecallMutation(c.config, OpDelete)
+	return &CarRecallDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CarRecallClient) DeleteOne(cr *CarRecall) *CarRecallDeleteOne {
+	return c.DeleteOneID(cr.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *CarRecallClient) DeleteOneID(id int64) *CarRecallDeleteOne {
+	builder := c.Delete().Where(carrecall.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CarRecallDeleteOne{builder}
+}
+
+// Query returns a query builder for CarRecall.
+func (c *CarRecallClient) Query() *CarRecallQuery {
+	return &CarRecallQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a CarRecall entity by its id.
+func (c *CarRecallClient) Get(ctx context.Context, id int64) (*CarRecall, error) {
+	return c.Query().Where(carrecall.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CarRecallClient) GetX(ctx context.Context, id int64) *CarRecall {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a CarRecall.
+func (c *CarRecallClient) QueryCar(cr *CarRecall) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carrecall.Table, carrecall.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carrecall.CarTable, carrecall.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecall queries the recall edge of a CarRecall.
+func (c *CarRecallClient) QueryRecall(cr *CarRecall) *RecallQuery {
+	query := &RecallQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carrecall.Table, carrecall.FieldID, id),
+			sqlgraph.To(recall.Table, recall.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carrecall.RecallTable, carrecall.RecallColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CarRecallClient) Hooks() []Hook {
+	hooks := c.hooks.CarRecall
+	return append(hooks[:len(hooks):len(hooks)], carrecall.Hooks[:]...)
+}
+
+// CarWarrantyClient is a client for the CarWarranty schema.
+type CarWarrantyClient struct {
+	config
+}
+
+// NewCarWarrantyClient returns a client for the CarWarranty from the given config.
+func NewCarWarrantyClient(c config) *CarWarrantyClient {
+	return &CarWarrantyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `carwarranty.Hooks(f(g(h())))`.
+func (c *CarWarrantyClient) Use(hooks ...Hook) {
+	c.hooks.CarWarranty = append(c.hooks.CarWarranty, hooks...)
+}
+
+// Create returns a builder for creating a CarWarranty entity.
+func (c *CarWarrantyClient) Create() *CarWarrantyCreate {
+	mutation := newCarWarrantyMutation(c.config, OpCreate)
+	return &CarWarrantyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CarWarranty entities.
+func (c *CarWarrantyClient) CreateBulk(builders ...*CarWarrantyCreate) *CarWarrantyCreateBulk {
+	return &CarWarrantyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CarWarranty.
+func (c *CarWarrantyClient) Update() *CarWarrantyUpdate {
+	mutation := newCarWarrantyMutation(c.config, OpUpdate)
+	return &CarWarrantyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CarWarrantyClient) UpdateOne(cw *CarWarranty) *CarWarrantyUpdateOne {
+	mutation := newCarWarrantyMutation(c.config, OpUpdateOne, withCarWarranty(cw))
+	return &CarWarrantyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CarWarrantyClient) UpdateOneID(id int64) *CarWarrantyUpdateOne {
+	mutation := newCarWarrantyMutation(c.config, OpUpdateOne, withCarWarrantyID(id))
+	return &CarWarrantyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CarWarranty.
+func (c *CarWarrantyClient) Delete() *CarWarrantyDelete {
+	mutation := newCarWarrantyMutation(c.config, OpDelete)
+	return &CarWarrantyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CarWarrantyClient) DeleteOne(cw *CarWarranty) *CarWarrantyDeleteOne {
+	return c.DeleteOneID(cw.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *CarWarrantyClient) DeleteOneID(id int64) *CarWarrantyDeleteOne {
+	builder := c.Delete().Where(carwarranty.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CarWarrantyDeleteOne{builder}
+}
+
+// Query returns a query builder for CarWarranty.
+func (c *CarWarrantyClient) Query() *CarWarrantyQuery {
+	return &CarWarrantyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a CarWarranty entity by its id.
+func (c *CarWarrantyClient) Get(ctx context.Context, id int64) (*CarWarranty, error) {
+	return c.Query().Where(carwarranty.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CarWarrantyClient) GetX(ctx context.Context, id int64) *CarWarranty {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a CarWarranty.
+func (c *CarWarrantyClient) QueryCar(cw *CarWarranty) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carwarranty.Table, carwarranty.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carwarranty.CarTable, carwarranty.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(cw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDefinition queries the definition edge of a CarWarranty.
+func (c *CarWarrantyClient) QueryDefinition(cw *CarWarranty) *WarrantyDefinitionQuery {
+	query := &WarrantyDefinitionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carwarranty.Table, carwarranty.FieldID, id),
+			sqlgraph.To(warrantydefinition.Table, warrantydefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carwarranty.DefinitionTable, carwarranty.DefinitionColumn),
+		)
+		fromV = sqlgraph.Neighbors(cw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CarWarrantyClient) Hooks() []Hook {
+	hooks := c.hooks.CarWarranty
+	return append(hooks[:len(hooks):len(hooks)], carwarranty.Hooks[:]...)
+}
+
+// ChargingSessionClient is a client for the ChargingSession schema.
+type ChargingSessionClient struct {
+	config
+}
+
+// NewChargingSessionClient returns a client for the ChargingSession from the given config.
+func NewChargingSessionClient(c config) *ChargingSessionClient {
+	return &ChargingSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chargingsession.Hooks(f(g(h())))`.
+func (c *ChargingSessionClient) Use(hooks ...Hook) {
+	c.hooks.ChargingSession = append(c.hooks.ChargingSession, hooks...)
+}
+
+// Create returns a builder for creating a ChargingSession entity.
+func (c *ChargingSessionClient) Create() *ChargingSessionCreate {
+	mutation := newChargingSessionMutation(c.config, OpCreate)
+	return &ChargingSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChargingSession entities.
+func (c *ChargingSessionClient) CreateBulk(builders ...*ChargingSessionCreate) *ChargingSessionCreateBulk {
+	return &ChargingSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChargingSession.
+func (c *ChargingSessionClient) Update() *ChargingSessionUpdate {
+	mutation := newChargingSessionMutation(c.config, OpUpdate)
+	return &ChargingSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChargingSessionClient) UpdateOne(cs *ChargingSession) *ChargingSessionUpdateOne {
+	mutation := newChargingSessionMutation(c.config, OpUpdateOne, withChargingSession(cs))
+	return &ChargingSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChargingSessionClient) UpdateOneID(id int64) *ChargingSessionUpdateOne {
+	mutation := newChargingSessionMutation(c.config, OpUpdateOne, withChargingSessionID(id))
+	return &ChargingSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChargingSession.
+func (c *ChargingSessionClient) Delete() *ChargingSessionDelete {
+	mutation := newChargingSessionMutation(c.config, OpDelete)
+	return &ChargingSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChargingSessionClient) DeleteOne(cs *ChargingSession) *ChargingSessionDeleteOne {
+	return c.DeleteOneID(cs.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ChargingSessionClient) DeleteOneID(id int64) *ChargingSessionDeleteOne {
+	builder := c.Delete().Where(chargingsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChargingSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for ChargingSession.
+func (c *ChargingSessionClient) Query() *ChargingSessionQuery {
+	return &ChargingSessionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ChargingSession entity by its id.
+func (c *ChargingSessionClient) Get(ctx context.Context, id int64) (*ChargingSession, error) {
+	return c.Query().Where(chargingsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChargingSessionClient) GetX(ctx context.Context, id int64) *ChargingSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a ChargingSession.
+func (c *ChargingSessionClient) QueryCar(cs *ChargingSession) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chargingsession.Table, chargingsession.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chargingsession.CarTable, chargingsession.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChargingSessionClient) Hooks() []Hook {
+	hooks := c.hooks.ChargingSession
+	return append(hooks[:len(hooks):len(hooks)], chargingsession.Hooks[:]...)
+}
+
+// FleetClient is a client for the Fleet schema.
+type FleetClient struct {
+	config
+}
+
+// NewFleetClient returns a client for the Fleet from the given config.
+func NewFleetClient(c config) *FleetClient {
+	return &FleetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fleet.Hooks(f(g(h())))`.
+func (c *FleetClient) Use(hooks ...Hook) {
+	c.hooks.Fleet = append(c.hooks.Fleet, hooks...)
+}
+
+// Create returns a builder for creating a Fleet entity.
+func (c *FleetClient) Create() *FleetCreate {
+	mutation := newFleetMutation(c.config, OpCreate)
+	return &FleetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Fleet entities.
+func (c *FleetClient) CreateBulk(builders ...*FleetCreate) *FleetCreateBulk {
+	return &FleetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Fleet.
+func (c *FleetClient) Update() *FleetUpdate {
+	mutation := newFleetMutation(c.config, OpUpdate)
+	return &FleetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FleetClient) UpdateOne(f *Fleet) *FleetUpdateOne {
+	mutation := newFleetMutation(c.config, OpUpdateOne, withFleet(f))
+	return &FleetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FleetClient) UpdateOneID(id int64) *FleetUpdateOne {
+	mutation := newFleetMutation(c.config, OpUpdateOne, withFleetID(id))
+	return &FleetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Fleet.
+func (c *FleetClient) Delete() *FleetDelete {
+	mutation := newFleetMutation(c.config, OpDelete)
+	return &FleetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FleetClient) DeleteOne(f *Fleet) *FleetDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *FleetClient) DeleteOneID(id int64) *FleetDeleteOne {
+	builder := c.Delete().Where(fleet.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FleetDeleteOne{builder}
+}
+
+// Query returns a query builder for Fleet.
+func (c *FleetClient) Query() *FleetQuery {
+	return &FleetQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Fleet entity by its id.
+func (c *FleetClient) Get(ctx context.Context, id int64) (*Fleet, error) {
+	return c.Query().Where(fleet.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FleetClient) GetX(ctx context.Context, id int64) *Fleet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCars queries the cars edge of a Fleet.
+func (c *FleetClient) QueryCars(f *Fleet) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fleet.Table, fleet.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, fleet.CarsTable, fleet.CarsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FleetClient) Hooks() []Hook {
+	hooks := c.hooks.Fleet
+	return append(hooks[:len(hooks):len(hooks)], fleet.Hooks[:]...)
+}
+
+// GeofenceClient is a client for the Geofence schema.
+type GeofenceClient struct {
+	config
+}
+
+// NewGeofenceClient returns a client for the Geofence from the given config.
+func NewGeofenceClient(c config) *GeofenceClient {
+	return &GeofenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `geofence.Hooks(f(g(h())))`.
+func (c *GeofenceClient) Use(hooks ...Hook) {
+	c.hooks.Geofence = append(c.hooks.Geofence, hooks...)
+}
+
+// Create returns a builder for creating a Geofence entity.
+func (c *GeofenceClient) Create() *GeofenceCreate {
+	mutation := newGeofenceMutation(c.config, OpCreate)
+	return &GeofenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Geofence entities.
+func (c *GeofenceClient) CreateBulk(builders ...*GeofenceCreate) *GeofenceCreateBulk {
+	return &GeofenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Geofence.
+func (c *GeofenceClient) Update() *GeofenceUpdate {
+	mutation := newGeofenceMutation(c.config, OpUpdate)
+	return &GeofenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GeofenceClient) UpdateOne(ge *Geofence) *GeofenceUpdateOne {
+	mutation := newGeofenceMutation(c.config, OpUpdateOne, withGeofence(ge))
+	return &GeofenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GeofenceClient) UpdateOneID(id int64) *GeofenceUpdateOne {
+	mutation := newGeofenceMutation(c.config, OpUpdateOne, withGeofenceID(id))
+	return &GeofenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Geofence.
+func (c *GeofenceClient) Delete() *GeofenceDelete {
+	mutation := newGeofenceMutation(c.config, OpDelete)
+	return &GeofenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GeofenceClient) DeleteOne(ge *Geofence) *GeofenceDeleteOne {
+	return c.DeleteOneID(ge.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *GeofenceClient) DeleteOneID(id int64) *GeofenceDeleteOne {
+	builder := c.Delete().Where(geofence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GeofenceDeleteOne{builder}
+}
+
+// Query returns a query builder for Geofence.
+func (c *GeofenceClient) Query() *GeofenceQuery {
+	return &GeofenceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Geofence entity by its id.
+func (c *GeofenceClient) Get(ctx context.Context, id int64) (*Geofence, error) {
+	return c.Query().Where(geofence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GeofenceClient) GetX(ctx context.Context, id int64) *Geofence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCars queries the cars edge of a Geofence.
+func (c *GeofenceClient) QueryCars(ge *Geofence) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ge.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(geofence.Table, geofence.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, geofence.CarsTable, geofence.CarsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ge.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GeofenceClient) Hooks() []Hook {
+	hooks := c.hooks.Geofence
+	return append(hooks[:len(hooks):len(hooks)], geofence.Hooks[:]...)
+}
+
+// IncidentClient is a client for the Incident schema.
+type IncidentClient struct {
+	config
+}
+
+// NewIncidentClient returns a client for the Incident from the given config.
+func NewIncidentClient(c config) *IncidentClient {
+	return &IncidentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `incident.Hooks(f(g(h())))`.
+func (c *IncidentClient) Use(hooks ...Hook) {
+	c.hooks.Incident = append(c.hooks.Incident, hooks...)
+}
+
+// Create returns a builder for creating a Incident entity.
+func (c *IncidentClient) Create() *IncidentCreate {
+	mutation := newIncidentMutation(c.config, OpCreate)
+	return &IncidentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Incident entities.
+func (c *IncidentClient) CreateBulk(builders ...*IncidentCreate) *IncidentCreateBulk {
+	return &IncidentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Incident.
+func (c *IncidentClient) Update() *IncidentUpdate {
+	mutation := newIncidentMutation(c.config, OpUpdate)
+	return &IncidentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IncidentClient) UpdateOne(i *Incident) *IncidentUpdateOne {
+	mutation := newIncidentMutation(c.config, OpUpdateOne, withIncident(i))
+	return &IncidentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IncidentClient) UpdateOneID(id int64) *IncidentUpdateOne {
+	mutation := newIncidentMutation(c.config, OpUpdateOne, withIncidentID(id))
+	return &IncidentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Incident.
+func (c *IncidentClient) Delete() *IncidentDelete {
+	mutation := newIncidentMutation(c.config, OpDelete)
+	return &IncidentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IncidentClient) DeleteOne(i *Incident) *IncidentDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *IncidentClient) DeleteOneID(id int64) *IncidentDeleteOne {
+	builder := c.Delete().Where(incident.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IncidentDeleteOne{builder}
+}
+
+// Query returns a query builder for Incident.
+func (c *IncidentClient) Query() *IncidentQuery {
+	return &IncidentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Incident entity by its id.
+func (c *IncidentClient) Get(ctx context.Context, id int64) (*Incident, error) {
+	return c.Query().Where(incident.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IncidentClient) GetX(ctx context.Context, id int64) *Incident {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a Incident.
+func (c *IncidentClient) QueryCar(i *Incident) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(incident.Table, incident.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, incident.CarTable, incident.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPolicy queries the policy edge of a Incident.
+func (c *IncidentClient) QueryPolicy(i *Incident) *InsurancePolicyQuery {
+	query := &InsurancePolicyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(incident.Table, incident.FieldID, id),
+			sqlgraph.To(insurancepolicy.Table, insurancepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, incident.PolicyTable, incident.PolicyColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IncidentClient) Hooks() []Hook {
+	hooks := c.hooks.Incident
+	return append(hooks[:len(hooks):len(hooks)], incident.Hooks[:]...)
+}
+
+// InsurancePolicyClient is a client for the InsurancePolicy schema.
+type InsurancePolicyClient struct {
+	config
+}
+
+// NewInsurancePolicyClient returns a client for the InsurancePolicy from the given config.
+func NewInsurancePolicyClient(c config) *InsurancePolicyClient {
+	return &InsurancePolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `insurancepolicy.Hooks(f(g(h())))`.
+func (c *InsurancePolicyClient) Use(hooks ...Hook) {
+	c.hooks.InsurancePolicy = append(c.hooks.InsurancePolicy, hooks...)
+}
+
+// Create returns a builder for creating a InsurancePolicy entity.
+func (c *InsurancePolicyClient) Create() *InsurancePolicyCreate {
+	mutation := newInsurancePolicyMutation(c.config, OpCreate)
+	return &InsurancePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InsurancePolicy entities.
+func (c *InsurancePolicyClient) CreateBulk(builders ...*InsurancePolicyCreate) *InsurancePolicyCreateBulk {
+	return &InsurancePolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InsurancePolicy.
+func (c *InsurancePolicyClient) Update() *InsurancePolicyUpdate {
+	mutation := newInsurancePolicyMutation(c.config, OpUpdate)
+	return &InsurancePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InsurancePolicyClient) UpdateOne(ip *InsurancePolicy) *InsurancePolicyUpdateOne {
+	mutation := newInsurancePolicyMutation(c.config, OpUpdateOne, withInsurancePolicy(ip))
+	return &InsurancePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InsurancePolicyClient) UpdateOneID(id int64) *InsurancePolicyUpdateOne {
+	mutation := newInsurancePolicyMutation(c.config, OpUpdateOne, withInsurancePolicyID(id))
+	return &InsurancePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InsurancePolicy.
+func (c *InsurancePolicyClient) Delete() *InsurancePolicyDelete {
+	mutation := newInsurancePolicyMutation(c.config, OpDelete)
+	return &InsurancePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InsurancePolicyClient) DeleteOne(ip *InsurancePolicy) *InsurancePolicyDeleteOne {
+	return c.DeleteOneID(ip.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InsurancePolicyClient) DeleteOneID(id int64) *InsurancePolicyDeleteOne {
+	builder := c.Delete().Where(insurancepolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InsurancePolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for InsurancePolicy.
+func (c *InsurancePolicyClient) Query() *InsurancePolicyQuery {
+	return &InsurancePolicyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InsurancePolicy entity by its id.
+func (c *InsurancePolicyClient) Get(ctx context.Context, id int64) (*InsurancePolicy, error) {
+	return c.Query().Where(insurancepolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InsurancePolicyClient) GetX(ctx context.Context, id int64) *InsurancePolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a InsurancePolicy.
+func (c *InsurancePolicyClient) QueryCar(ip *InsurancePolicy) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ip.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(insurancepolicy.Table, insurancepolicy.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, insurancepolicy.CarTable, insurancepolicy.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(ip.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIncidents queries the incidents edge of a InsurancePolicy.
+func (c *InsurancePolicyClient) QueryIncidents(ip *InsurancePolicy) *IncidentQuery {
+	query := &IncidentQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ip.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(insurancepolicy.Table, insurancepolicy.FieldID, id),
+			sqlgraph.To(incident.Table, incident.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, insurancepolicy.IncidentsTable, insurancepolicy.IncidentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ip.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InsurancePolicyClient) Hooks() []Hook {
+	hooks := c.hooks.InsurancePolicy
+	return append(hooks[:len(hooks):len(hooks)], insurancepolicy.Hooks[:]...)
+}
+
+// JobRunClient is a client for the JobRun schema.
+type JobRunClient struct {
+	config
+}
+
+// NewJobRunClient returns a client for the JobRun from the given config.
+func NewJobRunClient(c config) *JobRunClient {
+	return &JobRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobrun.Hooks(f(g(h())))`.
+func (c *JobRunClient) Use(hooks ...Hook) {
+	c.hooks.JobRun = append(c.hooks.JobRun, hooks...)
+}
+
+// Create returns a builder for creating a JobRun entity.
+func (c *JobRunClient) Create() *JobRunCreate {
+	mutation := newJobRunMutation(c.config, OpCreate)
+	return &JobRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobRun entities.
+func (c *JobRunClient) CreateBulk(builders ...*JobRunCreate) *JobRunCreateBulk {
+	return &JobRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobRun.
+func (c *JobRunClient) Update() *JobRunUpdate {
+	mutation := newJobRunMutation(c.config, OpUpdate)
+	return &JobRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobRunClient) UpdateOne(jr *JobRun) *JobRunUpdateOne {
+	mutation := newJobRunMutation(c.config, OpUpdateOne, withJobRun(jr))
+	return &JobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobRunClient) UpdateOneID(id int64) *JobRunUpdateOne {
+	mutation := newJobRunMutation(c.config, OpUpdateOne, withJobRunID(id))
+	return &JobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobRun.
+func (c *JobRunClient) Delete() *JobRunDelete {
+	mutation := newJobRunMutation(c.config, OpDelete)
+	return &JobRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobRunClient) DeleteOne(jr *JobRun) *JobRunDeleteOne {
+	return c.DeleteOneID(jr.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *JobRunClient) DeleteOneID(id int64) *JobRunDeleteOne {
+	builder := c.Delete().Where(jobrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobRunDeleteOne{builder}
+}
+
+// Query returns a query builder for JobRun.
+func (c *JobRunClient) Query() *JobRunQuery {
+	return &JobRunQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a JobRun entity by its id.
+func (c *JobRunClient) Get(ctx context.Context, id int64) (*JobRun, error) {
+	return c.Query().Where(jobrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobRunClient) GetX(ctx context.Context, id int64) *JobRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobRunClient) Hooks() []Hook {
+	return c.hooks.JobRun
+}
+
+// LeaseContractClient is a client for the LeaseContract schema.
+type LeaseContractClient struct {
+	config
+}
+
+// NewLeaseContractClient returns a client for the LeaseContract from the given config.
+func NewLeaseContractClient(c config) *LeaseContractClient {
+	return &LeaseContractClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leasecontract.Hooks(f(g(h())))`.
+func (c *LeaseContractClient) Use(hooks ...Hook) {
+	c.hooks.LeaseContract = append(c.hooks.LeaseContract, hooks...)
+}
+
+// Create returns a builder for creating a LeaseContract entity.
+func (c *LeaseContractClient) Create() *LeaseContractCreate {
+	mutation := newLeaseContractMutation(c.config, OpCreate)
+	return &LeaseContractCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaseContract entities.
+func (c *LeaseContractClient) CreateBulk(builders ...*LeaseContractCreate) *LeaseContractCreateBulk {
+	return &LeaseContractCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaseContract.
+func (c *LeaseContractClient) Update() *LeaseContractUpdate {
+	mutation := newLeaseContractMutation(c.config, OpUpdate)
+	return &LeaseContractUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaseContractClient) UpdateOne(lc *LeaseContract) *LeaseContractUpdateOne {
+	mutation := newLeaseContractMutation(c.config, OpUpdateOne, withLeaseContract(lc))
+	return &LeaseContractUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaseContractClient) UpdateOneID(id int64) *LeaseContractUpdateOne {
+	mutation := newLeaseContractMutation(c.config, OpUpdateOne, withLeaseContractID(id))
+	return &LeaseContractUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaseContract.
+func (c *LeaseContractClient) Delete() *LeaseContractDelete {
+	mutation := newLeaseContractMutation(c.config, OpDelete)
+	return &LeaseContractDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaseContractClient) DeleteOne(lc *LeaseContract) *LeaseContractDeleteOne {
+	return c.DeleteOneID(lc.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *LeaseContractClient) DeleteOneID(id int64) *LeaseContractDeleteOne {
+	builder := c.Delete().Where(leasecontract.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaseContractDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaseContract.
+func (c *LeaseContractClient) Query() *LeaseContractQuery {
+	return &LeaseContractQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a LeaseContract entity by its id.
+func (c *LeaseContractClient) Get(ctx context.Context, id int64) (*LeaseContract, error) {
+	return c.Query().Where(leasecontract.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaseContractClient) GetX(ctx context.Context, id int64) *LeaseContract {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a LeaseContract.
+func (c *LeaseContractClient) QueryCar(lc *LeaseContract) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := lc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leasecontract.Table, leasecontract.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leasecontract.CarTable, leasecontract.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(lc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeaseContractClient) Hooks() []Hook {
+	hooks := c.hooks.LeaseContract
+	return append(hooks[:len(hooks):len(hooks)], leasecontract.Hooks[:]...)
+}
+
+// ListingClient is a client for the Listing schema.
+type ListingClient struct {
+	config
+}
+
+// NewListingClient returns a client for the Listing from the given config.
+func NewListingClient(c config) *ListingClient {
+	return &ListingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `listing.Hooks(f(g(h())))`.
+func (c *ListingClient) Use(hooks ...Hook) {
+	c.hooks.Listing = append(c.hooks.Listing, hooks...)
+}
+
+// Create returns a builder for creating a Listing entity.
+func (c *ListingClient) Create() *ListingCreate {
+	mutation := newListingMutation(c.config, OpCreate)
+	return &ListingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Listing entities.
+func (c *ListingClient) CreateBulk(builders ...*ListingCreate) *ListingCreateBulk {
+	return &ListingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Listing.
+func (c *ListingClient) Update() *ListingUpdate {
+	mutation := newListingMutation(c.config, OpUpdate)
+	return &ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ListingClient) UpdateOne(l *Listing) *ListingUpdateOne {
+	mutation := newListingMutation(c.config, OpUpdateOne, withListing(l))
+	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ListingClient) UpdateOneID(id int64) *ListingUpdateOne {
+	mutation := newListingMutation(c.config, OpUpdateOne, withListingID(id))
+	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Listing.
+func (c *ListingClient) Delete() *ListingDelete {
+	mutation := newListingMutation(c.config, OpDelete)
+	return &ListingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ListingClient) DeleteOne(l *Listing) *ListingDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ListingClient) DeleteOneID(id int64) *ListingDeleteOne {
+	builder := c.Delete().Where(listing.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ListingDeleteOne{builder}
+}
+
+// Query returns a query builder for Listing.
+func (c *ListingClient) Query() *ListingQuery {
+	return &ListingQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Listing entity by its id.
+func (c *ListingClient) Get(ctx context.Context, id int64) (*Listing, error) {
+	return c.Query().Where(listing.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ListingClient) GetX(ctx context.Context, id int64) *Listing {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a Listing.
+func (c *ListingClient) QueryCar(l *Listing) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listing.CarTable, listing.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ListingClient) Hooks() []Hook {
+	hooks := c.hooks.Listing
+	return append(hooks[:len(hooks):len(hooks)], listing.Hooks[:]...)
+}
+
+// MaintenanceRecordClient is a client for the MaintenanceRecord schema.
+type MaintenanceRecordClient struct {
+	config
+}
+
+// NewMaintenanceRecordClient returns a client for the MaintenanceRecord from the given config.
+func NewMaintenanceRecordClient(c config) *MaintenanceRecordClient {
+	return &MaintenanceRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `maintenancerecord.Hooks(f(g(h())))`.
+func (c *MaintenanceRecordClient) Use(hooks ...Hook) {
+	c.hooks.MaintenanceRecord = append(c.hooks.MaintenanceRecord, hooks...)
+}
+
+// Create returns a builder for creating a MaintenanceRecord entity.
+func (c *MaintenanceRecordClient) Create() *MaintenanceRecordCreate {
+	mutation := newMaintenanceRecordMutation(c.config, OpCreate)
+	return &MaintenanceRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MaintenanceRecord entities.
+func (c *MaintenanceRecordClient) CreateBulk(builders ...*MaintenanceRecordCreate) *MaintenanceRecordCreateBulk {
+	return &MaintenanceRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MaintenanceRecord.
+func (c *MaintenanceRecordClient) Update() *MaintenanceRecordUpdate {
+	mutation := newMaintenanceRecordMutation(c.config, OpUpdate)
+	return &MaintenanceRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MaintenanceRecordClient) UpdateOne(mr *MaintenanceRecord) *MaintenanceRecordUpdateOne {
+	mutation := newMaintenanceRecordMutation(c.config, OpUpdateOne, withMaintenanceRecord(mr))
+	return &MaintenanceRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MaintenanceRecordClient) UpdateOneID(id int64) *MaintenanceRecordUpdateOne {
+	mutation := newMaintenanceRecordMutation(c.config, OpUpdateOne, withMaintenanceRecordID(id))
+	return &MaintenanceRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MaintenanceRecord.
+func (c *MaintenanceRecordClient) Delete() *MaintenanceRecordDelete {
+	mutation := newMaintenanceRecordMutation(c.config, OpDelete)
+	return &MaintenanceRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MaintenanceRecordClient) DeleteOne(mr *MaintenanceRecord) *MaintenanceRecordDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *MaintenanceRecordClient) DeleteOneID(id int64) *MaintenanceRecordDeleteOne {
+	builder := c.Delete().Where(maintenancerecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MaintenanceRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for MaintenanceRecord.
+func (c *MaintenanceRecordClient) Query() *MaintenanceRecordQuery {
+	return &MaintenanceRecordQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a MaintenanceRecord entity by its id.
+func (c *MaintenanceRecordClient) Get(ctx context.Context, id int64) (*MaintenanceRecord, error) {
+	return c.Query().Where(maintenancerecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MaintenanceRecordClient) GetX(ctx context.Context, id int64) *MaintenanceRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a MaintenanceRecord.
+func (c *MaintenanceRecordClient) QueryCar(mr *MaintenanceRecord) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenancerecord.Table, maintenancerecord.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, maintenancerecord.CarTable, maintenancerecord.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MaintenanceRecordClient) Hooks() []Hook {
+	hooks := c.hooks.MaintenanceRecord
+	return append(hooks[:len(hooks):len(hooks)], maintenancerecord.Hooks[:]...)
+}
+
+// OdometerReadingClient is a client for the OdometerReading schema.
+type OdometerReadingClient struct {
+	config
+}
+
+// NewOdometerReadingClient returns a client for the OdometerReading from the given config.
+func NewOdometerReadingClient(c config) *OdometerReadingClient {
+	return &OdometerReadingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `odometerreading.Hooks(f(g(h())))`.
+func (c *OdometerReadingClient) Use(hooks ...Hook) {
+	c.hooks.OdometerReading = append(c.hooks.OdometerReading, hooks...)
+}
+
+// Create returns a builder for creating a OdometerReading entity.
+func (c *OdometerReadingClient) Create() *OdometerReadingCreate {
+	mutation := newOdometerReadingMutation(c.config, OpCreate)
+	return &OdometerReadingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OdometerReading entities.
+func (c *OdometerReadingClient) CreateBulk(builders ...*OdometerReadingCreate) *OdometerReadingCreateBulk {
+	return &OdometerReadingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OdometerReading.
+func (c *OdometerReadingClient) Update() *OdometerReadingUpdate {
+	mutation := newOdometerReadingMutation(c.config, OpUpdate)
+	return &OdometerReadingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OdometerReadingClient) UpdateOne(or *OdometerReading) *OdometerReadingUpdateOne {
+	mutation := newOdometerReadingMutation(c.config, OpUpdateOne, withOdometerReading(or))
+	return &OdometerReadingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OdometerReadingClient) UpdateOneID(id int64) *OdometerReadingUpdateOne {
+	mutation := newOdometerReadingMutation(c.config, OpUpdateOne, withOdometerReadingID(id))
+	return &OdometerReadingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OdometerReading.
+func (c *OdometerReadingClient) Delete() *OdometerReadingDelete {
+	mutation := newOdometerReadingMutation(c.config, OpDelete)
+	return &OdometerReadingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OdometerReadingClient) DeleteOne(or *OdometerReading) *OdometerReadingDeleteOne {
+	return c.DeleteOneID(or.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *OdometerReadingClient) DeleteOneID(id int64) *OdometerReadingDeleteOne {
+	builder := c.Delete().Where(odometerreading.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OdometerReadingDeleteOne{builder}
+}
+
+// Query returns a query builder for OdometerReading.
+func (c *OdometerReadingClient) Query() *OdometerReadingQuery {
+	return &OdometerReadingQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a OdometerReading entity by its id.
+func (c *OdometerReadingClient) Get(ctx context.Context, id int64) (*OdometerReading, error) {
+	return c.Query().Where(odometerreading.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OdometerReadingClient) GetX(ctx context.Context, id int64) *OdometerReading {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a OdometerReading.
+func (c *OdometerReadingClient) QueryCar(or *OdometerReading) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := or.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(odometerreading.Table, odometerreading.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, odometerreading.CarTable, odometerreading.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(or.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OdometerReadingClient) Hooks() []Hook {
+	hooks := c.hooks.OdometerReading
+	return append(hooks[:len(hooks):len(hooks)], odometerreading.Hooks[:]...)
+}
+
+// OwnerHistoryClient is a client for the OwnerHistory schema.
+type OwnerHistoryClient struct {
+	config
+}
+
+// NewOwnerHistoryClient returns a client for the OwnerHistory from the given config.
+func NewOwnerHistoryClient(c config) *OwnerHistoryClient {
+	return &OwnerHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ownerhistory.Hooks(f(g(h())))`.
+func (c *OwnerHistoryClient) Use(hooks ...Hook) {
+	c.hooks.OwnerHistory = append(c.hooks.OwnerHistory, hooks...)
+}
+
+// Create returns a builder for creating a OwnerHistory entity.
+func (c *OwnerHistoryClient) Create() *OwnerHistoryCreate {
+	mutation := newOwnerHistoryMutation(c.config, OpCreate)
+	return &OwnerHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OwnerHistory entities.
+func (c *OwnerHistoryClient) CreateBulk(builders ...*OwnerHistoryCreate) *OwnerHistoryCreateBulk {
+	return &OwnerHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OwnerHistory.
+func (c *OwnerHistoryClient) Update() *OwnerHistoryUpdate {
+	mutation := newOwnerHistoryMutation(c.config, OpUpdate)
+	return &OwnerHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OwnerHistoryClient) UpdateOne(oh *OwnerHistory) *OwnerHistoryUpdateOne {
+	mutation := newOwnerHistoryMutation(c.config, OpUpdateOne, withOwnerHistory(oh))
+	return &OwnerHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OwnerHistoryClient) UpdateOneID(id int64) *OwnerHistoryUpdateOne {
+	mutation := newOwnerHistoryMutation(c.config, OpUpdateOne, withOwnerHistoryID(id))
+	return &OwnerHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OwnerHistory.
+func (c *OwnerHistoryClient) Delete() *OwnerHistoryDelete {
+	mutation := newOwnerHistoryMutation(c.config, OpDelete)
+	return &OwnerHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OwnerHistoryClient) DeleteOne(oh *OwnerHistory) *OwnerHistoryDeleteOne {
+	return c.DeleteOneID(oh.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *OwnerHistoryClient) DeleteOneID(id int64) *OwnerHistoryDeleteOne {
+	builder := c.Delete().Where(ownerhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OwnerHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for OwnerHistory.
+func (c *OwnerHistoryClient) Query() *OwnerHistoryQuery {
+	return &OwnerHistoryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a OwnerHistory entity by its id.
+func (c *OwnerHistoryClient) Get(ctx context.Context, id int64) (*OwnerHistory, error) {
+	return c.Query().Where(ownerhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OwnerHistoryClient) GetX(ctx context.Context, id int64) *OwnerHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a OwnerHistory.
+func (c *OwnerHistoryClient) QueryCar(oh *OwnerHistory) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := oh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownerhistory.Table, ownerhistory.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownerhistory.CarTable, ownerhistory.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(oh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OwnerHistoryClient) Hooks() []Hook {
+	hooks := c.hooks.OwnerHistory
+	return append(hooks[:len(hooks):len(hooks)], ownerhistory.Hooks[:]...)
+}
+
+// RecallClient is a client for the Recall schema.
+type RecallClient struct {
+	config
+}
+
+// NewRecallClient returns a client for the Recall from the given config.
+func NewRecallClient(c config) *RecallClient {
+	return &RecallClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recall.Hooks(f(g(h())))`.
+func (c *RecallClient) Use(hooks ...Hook) {
+	c.hooks.Recall = append(c.hooks.Recall, hooks...)
+}
+
+// Create returns a builder for creating a Recall entity.
+func (c *RecallClient) Create() *RecallCreate {
+	mutation := newRecallMutation(c.config, OpCreate)
+	return &RecallCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Recall entities.
+func (c *RecallClient) CreateBulk(builders ...*RecallCreate) *RecallCreateBulk {
+	return &RecallCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Recall.
+func (c *RecallClient) Update() *RecallUpdate {
+	mutation := newRecallMutation(c.config, OpUpdate)
+	return &RecallUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecallClient) UpdateOne(r *Recall) *RecallUpdateOne {
+	mutation := newRecallMutation(c.config, OpUpdateOne, withRecall(r))
+	return &RecallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecallClient) UpdateOneID(id int64) *RecallUpdateOne {
+	mutation := newRecallMutation(c.config, OpUpdateOne, withRecallID(id))
+	return &RecallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Recall.
+func (c *RecallClient) Delete() *RecallDelete {
+	mutation := newRecallMutation(c.config, OpDelete)
+	return &RecallDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecallClient) DeleteOne(r *Recall) *RecallDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *RecallClient) DeleteOneID(id int64) *RecallDeleteOne {
+	builder := c.Delete().Where(recall.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecallDeleteOne{builder}
+}
+
+// Query returns a query builder for Recall.
+func (c *RecallClient) Query() *RecallQuery {
+	return &RecallQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Recall entity by its id.
+func (c *RecallClient) Get(ctx context.Context, id int64) (*Recall, error) {
+	return c.Query().Where(recall.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecallClient) GetX(ctx context.Context, id int64) *Recall {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCarRecalls queries the car_recalls edge of a Recall.
+func (c *RecallClient) QueryCarRecalls(r *Recall) *CarRecallQuery {
+	query := &CarRecallQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recall.Table, recall.FieldID, id),
+			sqlgraph.To(carrecall.Table, carrecall.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, recall.CarRecallsTable, recall.CarRecallsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecallClient) Hooks() []Hook {
+	return c.hooks.Recall
+}
+
+// RefuelClient is a client for the Refuel schema.
+type RefuelClient struct {
+	config
+}
+
+// NewRefuelClient returns a client for the Refuel from the given config.
+func NewRefuelClient(c config) *RefuelClient {
+	return &RefuelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refuel.Hooks(f(g(h())))`.
+func (c *RefuelClient) Use(hooks ...Hook) {
+	c.hooks.Refuel = append(c.hooks.Refuel, hooks...)
+}
+
+// Create returns a builder for creating a Refuel entity.
+func (c *RefuelClient) Create() *RefuelCreate {
+	mutation := newRefuelMutation(c.config, OpCreate)
+	return &RefuelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Refuel entities.
+func (c *RefuelClient) CreateBulk(builders ...*RefuelCreate) *RefuelCreateBulk {
+	return &RefuelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Refuel.
+func (c *RefuelClient) Update() *RefuelUpdate {
+	mutation := newRefuelMutation(c.config, OpUpdate)
+	return &RefuelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefuelClient) UpdateOne(r *Refuel) *RefuelUpdateOne {
+	mutation := newRefuelMutation(c.config, OpUpdateOne, withRefuel(r))
+	return &RefuelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefuelClient) UpdateOneID(id int64) *RefuelUpdateOne {
+	mutation := newRefuelMutation(c.config, OpUpdateOne, withRefuelID(id))
+	return &RefuelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Refuel.
+func (c *RefuelClient) Delete() *RefuelDelete {
+	mutation := newRefuelMutation(c.config, OpDelete)
+	return &RefuelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefuelClient) DeleteOne(r *Refuel) *RefuelDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *RefuelClient) DeleteOneID(id int64) *RefuelDeleteOne {
+	builder := c.Delete().Where(refuel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefuelDeleteOne{builder}
+}
+
+// Query returns a query builder for Refuel.
+func (c *RefuelClient) Query() *RefuelQuery {
+	return &RefuelQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Refuel entity by its id.
+func (c *RefuelClient) Get(ctx context.Context, id int64) (*Refuel, error) {
+	return c.Query().Where(refuel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefuelClient) GetX(ctx context.Context, id int64) *Refuel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a Refuel.
+func (c *RefuelClient) QueryCar(r *Refuel) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refuel.Table, refuel.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refuel.CarTable, refuel.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RefuelClient) Hooks() []Hook {
+	hooks := c.hooks.Refuel
+	return append(hooks[:len(hooks):len(hooks)], refuel.Hooks[:]...)
+}
+
+// ReservationClient is a client for the Reservation schema.
+type ReservationClient struct {
+	config
+}
+
+// NewReservationClient returns a client for the Reservation from the given config.
+func NewReservationClient(c config) *ReservationClient {
+	return &ReservationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reservation.Hooks(f(g(h())))`.
+func (c *ReservationClient) Use(hooks ...Hook) {
+	c.hooks.Reservation = append(c.hooks.Reservation, hooks...)
+}
+
+// Create returns a builder for creating a Reservation entity.
+func (c *ReservationClient) Create() *ReservationCreate {
+	mutation := newReservationMutation(c.config, OpCreate)
+	return &ReservationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reservation entities.
+func (c *ReservationClient) CreateBulk(builders ...*ReservationCreate) *ReservationCreateBulk {
+	return &ReservationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Reservation.
+func (c *ReservationClient) Update() *ReservationUpdate {
+	mutation := newReservationMutation(c.config, OpUpdate)
+	return &ReservationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReservationClient) UpdateOne(r *Reservation) *ReservationUpdateOne {
+	mutation := newReservationMutation(c.config, OpUpdateOne, withReservation(r))
+	return &ReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReservationClient) UpdateOneID(id int64) *ReservationUpdateOne {
+	mutation := newReservationMutation(c.config, OpUpdateOne, withReservationID(id))
+	return &ReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Reservation.
+func (c *ReservationClient) Delete() *ReservationDelete {
+	mutation := newReservationMutation(c.config, OpDelete)
+	return &ReservationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReservationClient) DeleteOne(r *Reservation) *ReservationDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ReservationClient) DeleteOneID(id int64) *ReservationDeleteOne {
+	builder := c.Delete().Where(reservation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReservationDeleteOne{builder}
+}
+
+// Query returns a query builder for Reservation.
+func (c *ReservationClient) Query() *ReservationQuery {
+	return &ReservationQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Reservation entity by its id.
+func (c *ReservationClient) Get(ctx context.Context, id int64) (*Reservation, error) {
+	return c.Query().Where(reservation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReservationClient) GetX(ctx context.Context, id int64) *Reservation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a Reservation.
+func (c *ReservationClient) QueryCar(r *Reservation) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reservation.Table, reservation.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reservation.CarTable, reservation.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReservationClient) Hooks() []Hook {
+	hooks := c.hooks.Reservation
+	return append(hooks[:len(hooks):len(hooks)], reservation.Hooks[:]...)
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(t *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(t))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagClient) UpdateOneID(id int64) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagClient) DeleteOne(t *Tag) *TagDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *TagClient) DeleteOneID(id int64) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDeleteOne{builder}
+}
+
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id int64) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagClient) GetX(ctx context.Context, id int64) *Tag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCars queries the cars edge of a Tag.
+func (c *TagClient) QueryCars(t *Tag) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.CarsTable, tag.CarsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagClient) Hooks() []Hook {
+	hooks := c.hooks.Tag
+	return append(hooks[:len(hooks):len(hooks)], tag.Hooks[:]...)
+}
+
+// TradeRecordClient is a client for the TradeRecord schema.
+type TradeRecordClient struct {
+	config
+}
+
+// NewTradeRecordClient returns a client for the TradeRecord from the given config.
+func NewTradeRecordClient(c config) *TradeRecordClient {
+	return &TradeRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `traderecord.Hooks(f(g(h())))`.
+func (c *TradeRecordClient) Use(hooks ...Hook) {
+	c.hooks.TradeRecord = append(c.hooks.TradeRecord, hooks...)
+}
+
+// Create returns a builder for creating a TradeRecord entity.
+func (c *TradeRecordClient) Create() *TradeRecordCreate {
+	mutation := newTradeRecordMutation(c.config, OpCreate)
+	return &TradeRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TradeRecord entities.
+func (c *TradeRecordClient) CreateBulk(builders ...*TradeRecordCreate) *TradeRecordCreateBulk {
+	return &TradeRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TradeRecord.
+func (c *TradeRecordClient) Update() *TradeRecordUpdate {
+	mutation := newTradeRecordMutation(c.config, OpUpdate)
+	return &TradeRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TradeRecordClient) UpdateOne(tr *TradeRecord) *TradeRecordUpdateOne {
+	mutation := newTradeRecordMutation(c.config, OpUpdateOne, withTradeRecord(tr))
+	return &TradeRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TradeRecordClient) UpdateOneID(id int64) *TradeRecordUpdateOne {
+	mutation := newTradeRecordMutation(c.config, OpUpdateOne, withTradeRecordID(id))
+	return &TradeRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TradeRecord.
+func (c *TradeRecordClient) Delete() *TradeRecordDelete {
+	mutation := newTradeRecordMutation(c.config, OpDelete)
+	return &TradeRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TradeRecordClient) DeleteOne(tr *TradeRecord) *TradeRecordDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *TradeRecordClient) DeleteOneID(id int64) *TradeRecordDeleteOne {
+	builder := c.Delete().Where(traderecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TradeRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for TradeRecord.
+func (c *TradeRecordClient) Query() *TradeRecordQuery {
+	return &TradeRecordQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a TradeRecord entity by its id.
+func (c *TradeRecordClient) Get(ctx context.Context, id int64) (*TradeRecord, error) {
+	return c.Query().Where(traderecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TradeRecordClient) GetX(ctx context.Context, id int64) *TradeRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a TradeRecord.
+func (c *TradeRecordClient) QueryCar(tr *TradeRecord) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(traderecord.Table, traderecord.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, traderecord.CarTable, traderecord.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TradeRecordClient) Hooks() []Hook {
+	hooks := c.hooks.TradeRecord
+	return append(hooks[:len(hooks):len(hooks)], traderecord.Hooks[:]...)
+}
+
+// TransferClient is a client for the Transfer schema.
+type TransferClient struct {
+	config
+}
+
+// NewTransferClient returns a client for the Transfer from the given config.
+func NewTransferClient(c config) *TransferClient {
+	return &TransferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transfer.Hooks(f(g(h())))`.
+func (c *TransferClient) Use(hooks ...Hook) {
+	c.hooks.Transfer = append(c.hooks.Transfer, hooks...)
+}
+
+// Create returns a builder for creating a Transfer entity.
+func (c *TransferClient) Create() *TransferCreate {
+	mutation := newTransferMutation(c.config, OpCreate)
+	return &TransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Transfer entities.
+func (c *TransferClient) CreateBulk(builders ...*TransferCreate) *TransferCreateBulk {
+	return &TransferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Transfer.
+func (c *TransferClient) Update() *TransferUpdate {
+	mutation := newTransferMutation(c.config, OpUpdate)
+	return &TransferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TransferClient) UpdateOne(t *Transfer) *TransferUpdateOne {
+	mutation := newTransferMutation(c.config, OpUpdateOne, withTransfer(t))
+	return &TransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TransferClient) UpdateOneID(id int64) *TransferUpdateOne {
+	mutation := newTransferMutation(c.config, OpUpdateOne, withTransferID(id))
+	return &TransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Transfer.
+func (c *TransferClient) Delete() *TransferDelete {
+	mutation := newTransferMutation(c.config, OpDelete)
+	return &TransferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TransferClient) DeleteOne(t *Transfer) *TransferDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *TransferClient) DeleteOneID(id int64) *TransferDeleteOne {
+	builder := c.Delete().Where(transfer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TransferDeleteOne{builder}
+}
+
+// Query returns a query builder for Transfer.
+func (c *TransferClient) Query() *TransferQuery {
+	return &TransferQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Transfer entity by its id.
+func (c *TransferClient) Get(ctx context.Context, id int64) (*Transfer, error) {
+	return c.Query().Where(transfer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TransferClient) GetX(ctx context.Context, id int64) *Transfer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a Transfer.
+func (c *TransferClient) QueryCar(t *Transfer) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transfer.Table, transfer.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transfer.CarTable, transfer.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TransferClient) Hooks() []Hook {
+	hooks := c.hooks.Transfer
+	return append(hooks[:len(hooks):len(hooks)], transfer.Hooks[:]...)
+}
+
+// TripClient is a client for the Trip schema.
+type TripClient struct {
+	config
+}
+
+// NewTripClient returns a client for the Trip from the given config.
+func NewTripClient(c config) *TripClient {
+	return &TripClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `trip.Hooks(f(g(h())))`.
+func (c *TripClient) Use(hooks ...Hook) {
+	c.hooks.Trip = append(c.hooks.Trip, hooks...)
+}
+
+// Create returns a builder for creating a Trip entity.
+func (c *TripClient) Create() *TripCreate {
+	mutation := newTripMutation(c.config, OpCreate)
+	return &TripCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Trip entities.
+func (c *TripClient) CreateBulk(builders ...*TripCreate) *TripCreateBulk {
+	return &TripCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Trip.
+func (c *TripClient) Update() *TripUpdate {
+	mutation := newTripMutation(c.config, OpUpdate)
+	return &TripUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TripClient) UpdateOne(t *Trip) *TripUpdateOne {
+	mutation := newTripMutation(c.config, OpUpdateOne, withTrip(t))
+	return &TripUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TripClient) UpdateOneID(id int64) *TripUpdateOne {
+	mutation := newTripMutation(c.config, OpUpdateOne, withTripID(id))
+	return &TripUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Trip.
+func (c *TripClient) Delete() *TripDelete {
+	mutation := newTripMutation(c.config, OpDelete)
+	return &TripDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TripClient) DeleteOne(t *Trip) *TripDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *TripClient) DeleteOneID(id int64) *TripDeleteOne {
+	builder := c.Delete().Where(trip.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TripDeleteOne{builder}
+}
+
+// Query returns a query builder for Trip.
+func (c *TripClient) Query() *TripQuery {
+	return &TripQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Trip entity by its id.
+func (c *TripClient) Get(ctx context.Context, id int64) (*Trip, error) {
+	return c.Query().Where(trip.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TripClient) GetX(ctx context.Context, id int64) *Trip {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a Trip.
+func (c *TripClient) QueryCar(t *Trip) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(trip.Table, trip.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, trip.CarTable, trip.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TripClient) Hooks() []Hook {
+	hooks := c.hooks.Trip
+	return append(hooks[:len(hooks):len(hooks)], trip.Hooks[:]...)
+}
+
+// VehicleModelClient is a client for the VehicleModel schema.
+type VehicleModelClient struct {
+	config
+}
+
+// NewVehicleModelClient returns a client for the VehicleModel from the given config.
+func NewVehicleModelClient(c config) *VehicleModelClient {
+	return &VehicleModelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vehiclemodel.Hooks(f(g(h())))`.
+func (c *VehicleModelClient) Use(hooks ...Hook) {
+	c.hooks.VehicleModel = append(c.hooks.VehicleModel, hooks...)
+}
+
+// Create returns a builder for creating a VehicleModel entity.
+func (c *VehicleModelClient) Create() *VehicleModelCreate {
+	mutation := newVehicleModelMutation(c.config, OpCreate)
+	return &VehicleModelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VehicleModel entities.
+func (c *VehicleModelClient) CreateBulk(builders ...*VehicleModelCreate) *VehicleModelCreateBulk {
+	return &VehicleModelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VehicleModel.
+func (c *VehicleModelClient) Update() *VehicleModelUpdate {
+	mutation := newVehicleModelMutation(c.config, OpUpdate)
+	return &VehicleModelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VehicleModelClient) UpdateOne(vm *VehicleModel) *VehicleModelUpdateOne {
+	mutation := newVehicleModelMutation(c.config, OpUpdateOne, withVehicleModel(vm))
+	return &VehicleModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VehicleModelClient) UpdateOneID(id int64) *VehicleModelUpdateOne {
+	mutation := newVehicleModelMutation(c.config, OpUpdateOne, withVehicleModelID(id))
+	return &VehicleModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VehicleModel.
+func (c *VehicleModelClient) Delete() *VehicleModelDelete {
+	mutation := newVehicleModelMutation(c.config, OpDelete)
+	return &VehicleModelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VehicleModelClient) DeleteOne(vm *VehicleModel) *VehicleModelDeleteOne {
+	return c.DeleteOneID(vm.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *VehicleModelClient) DeleteOneID(id int64) *VehicleModelDeleteOne {
+	builder := c.Delete().Where(vehiclemodel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VehicleModelDeleteOne{builder}
+}
+
+// Query returns a query builder for VehicleModel.
+func (c *VehicleModelClient) Query() *VehicleModelQuery {
+	return &VehicleModelQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a VehicleModel entity by its id.
+func (c *VehicleModelClient) Get(ctx context.Context, id int64) (*VehicleModel, error) {
+	return c.Query().Where(vehiclemodel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VehicleModelClient) GetX(ctx context.Context, id int64) *VehicleModel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBrand queries the brand edge of a VehicleModel.
+func (c *VehicleModelClient) QueryBrand(vm *VehicleModel) *BrandQuery {
+	query := &BrandQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := vm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehiclemodel.Table, vehiclemodel.FieldID, id),
+			sqlgraph.To(brand.Table, brand.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vehiclemodel.BrandTable, vehiclemodel.BrandColumn),
+		)
+		fromV = sqlgraph.Neighbors(vm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCars queries the cars edge of a VehicleModel.
+func (c *VehicleModelClient) QueryCars(vm *VehicleModel) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := vm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehiclemodel.Table, vehiclemodel.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vehiclemodel.CarsTable, vehiclemodel.CarsColumn),
+		)
+		fromV = sqlgraph.Neighbors(vm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VehicleModelClient) Hooks() []Hook {
+	return c.hooks.VehicleModel
+}
+
+// ViolationClient is a client for the Violation schema.
+type ViolationClient struct {
+	config
+}
+
+// NewViolationClient returns a client for the Violation from the given config.
+func NewViolationClient(c config) *ViolationClient {
+	return &ViolationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `violation.Hooks(f(g(h())))`.
+func (c *ViolationClient) Use(hooks ...Hook) {
+	c.hooks.Violation = append(c.hooks.Violation, hooks...)
+}
+
+// Create returns a builder for creating a Violation entity.
+func (c *ViolationClient) Create() *ViolationCreate {
+	mutation := newViolationMutation(c.config, OpCreate)
+	return &ViolationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Violation entities.
+func (c *ViolationClient) CreateBulk(builders ...*ViolationCreate) *ViolationCreateBulk {
+	return &ViolationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Violation.
+func (c *ViolationClient) Update() *ViolationUpdate {
+	mutation := newViolationMutation(c.config, OpUpdate)
+	return &ViolationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ViolationClient) UpdateOne(v *Violation) *ViolationUpdateOne {
+	mutation := newViolationMutation(c.config, OpUpdateOne, withViolation(v))
+	return &ViolationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ViolationClient) UpdateOneID(id int64) *ViolationUpdateOne {
+	mutation := newViolationMutation(c.config, OpUpdateOne, withViolationID(id))
+	return &ViolationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Violation.
+func (c *ViolationClient) Delete() *ViolationDelete {
+	mutation := newViolationMutation(c.config, OpDelete)
+	return &ViolationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ViolationClient) DeleteOne(v *Violation) *ViolationDeleteOne {
+	return c.DeleteOneID(v.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ViolationClient) DeleteOneID(id int64) *ViolationDeleteOne {
+	builder := c.Delete().Where(violation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ViolationDeleteOne{builder}
+}
+
+// Query returns a query builder for Violation.
+func (c *ViolationClient) Query() *ViolationQuery {
+	return &ViolationQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Violation entity by its id.
+func (c *ViolationClient) Get(ctx context.Context, id int64) (*Violation, error) {
+	return c.Query().Where(violation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ViolationClient) GetX(ctx context.Context, id int64) *Violation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCar queries the car edge of a Violation.
+func (c *ViolationClient) QueryCar(v *Violation) *CarQuery {
+	query := &CarQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := v.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(violation.Table, violation.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, violation.CarTable, violation.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(v.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ViolationClient) Hooks() []Hook {
+	hooks := c.hooks.Violation
+	return append(hooks[:len(hooks):len(hooks)], violation.Hooks[:]...)
+}
+
+// WarrantyDefinitionClient is a client for the WarrantyDefinition schema.
+type WarrantyDefinitionClient struct {
+	config
+}
+
+// NewWarrantyDefinitionClient returns a client for the WarrantyDefinition from the given config.
+func NewWarrantyDefinitionClient(c config) *WarrantyDefinitionClient {
+	return &WarrantyDefinitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `warrantydefinition.Hooks(f(g(h())))`.
+func (c *WarrantyDefinitionClient) Use(hooks ...Hook) {
+	c.hooks.WarrantyDefinition = append(c.hooks.WarrantyDefinition, hooks...)
+}
+
+// Create returns a builder for creating a WarrantyDefinition entity.
+func (c *WarrantyDefinitionClient) Create() *WarrantyDefinitionCreate {
+	mutation := newWarrantyDefinitionMutation(c.config, OpCreate)
+	return &WarrantyDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WarrantyDefinition entities.
+func (c *WarrantyDefinitionClient) CreateBulk(builders ...*WarrantyDefinitionCreate) *WarrantyDefinitionCreateBulk {
+	return &WarrantyDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WarrantyDefinition.
+func (c *WarrantyDefinitionClient) Update() *WarrantyDefinitionUpdate {
+	mutation := newWarrantyDefinitionMutation(c.config, OpUpdate)
+	return &WarrantyDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WarrantyDefinitionClient) UpdateOne(wd *WarrantyDefinition) *WarrantyDefinitionUpdateOne {
+	mutation := newWarrantyDefinitionMutation(c.config, OpUpdateOne, withWarrantyDefinition(wd))
+	return &WarrantyDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WarrantyDefinitionClient) UpdateOneID(id int64) *WarrantyDefinitionUpdateOne {
+	mutation := newWarrantyDefinitionMutation(c.config, OpUpdateOne, withWarrantyDefinitionID(id))
+	return &WarrantyDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WarrantyDefinition.
+func (c *WarrantyDefinitionClient) Delete() *WarrantyDefinitionDelete {
+	mutation := newWarrantyDefinitionMutation(c.config, OpDelete)
+	return &WarrantyDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WarrantyDefinitionClient) DeleteOne(wd *WarrantyDefinition) *WarrantyDefinitionDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WarrantyDefinitionClient) DeleteOneID(id int64) *WarrantyDefinitionDeleteOne {
+	builder := c.Delete().Where(warrantydefinition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WarrantyDefinitionDeleteOne{builder}
+}
+
+// Query returns a query builder for WarrantyDefinition.
+func (c *WarrantyDefinitionClient) Query() *WarrantyDefinitionQuery {
+	return &WarrantyDefinitionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WarrantyDefinition entity by its id.
+func (c *WarrantyDefinitionClient) Get(ctx context.Context, id int64) (*WarrantyDefinition, error) {
+	return c.Query().Where(warrantydefinition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WarrantyDefinitionClient) GetX(ctx context.Context, id int64) *WarrantyDefinition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCarWarranties queries the car_warranties edge of a WarrantyDefinition.
+func (c *WarrantyDefinitionClient) QueryCarWarranties(wd *WarrantyDefinition) *CarWarrantyQuery {
+	query := &CarWarrantyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warrantydefinition.Table, warrantydefinition.FieldID, id),
+			sqlgraph.To(carwarranty.Table, carwarranty.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warrantydefinition.CarWarrantiesTable, warrantydefinition.CarWarrantiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WarrantyDefinitionClient) Hooks() []Hook {
+	return c.hooks.WarrantyDefinition
 }
@@ -28,7 +28,35 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Car []ent.Hook
+	Attachment          []ent.Hook
+	AttributeDefinition []ent.Hook
+	AuditLog            []ent.Hook
+	Brand               []ent.Hook
+	Car                 []ent.Hook
+	CarAttribute        []ent.Hook
+	CarRecall           []ent.Hook
+	CarWarranty         []ent.Hook
+	ChargingSession     []ent.Hook
+	Fleet               []ent.Hook
+	Geofence            []ent.Hook
+	Incident            []ent.Hook
+	InsurancePolicy     []ent.Hook
+	JobRun              []ent.Hook
+	LeaseContract       []ent.Hook
+	Listing             []ent.Hook
+	MaintenanceRecord   []ent.Hook
+	OdometerReading     []ent.Hook
+	OwnerHistory        []ent.Hook
+	Recall              []ent.Hook
+	Refuel              []ent.Hook
+	Reservation         []ent.Hook
+	Tag                 []ent.Hook
+	TradeRecord         []ent.Hook
+	Transfer            []ent.Hook
+	Trip                []ent.Hook
+	VehicleModel        []ent.Hook
+	Violation           []ent.Hook
+	WarrantyDefinition  []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -3,7 +3,35 @@
 package ent
 
 import (
+	"car-service/internal/data/ent/attachment"
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/auditlog"
+	"car-service/internal/data/ent/brand"
 	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/jobrun"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/ownerhistory"
+	"car-service/internal/data/ent/recall"
+	"car-service/internal/data/ent/refuel"
+	"car-service/internal/data/ent/reservation"
+	"car-service/internal/data/ent/tag"
+	"car-service/internal/data/ent/traderecord"
+	"car-service/internal/data/ent/transfer"
+	"car-service/internal/data/ent/trip"
+	"car-service/internal/data/ent/vehiclemodel"
+	"car-service/internal/data/ent/violation"
+	"car-service/internal/data/ent/warrantydefinition"
 	"context"
 	"errors"
 	"fmt"
@@ -31,7 +59,35 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		car.Table: car.ValidColumn,
+		attachment.Table:          attachment.ValidColumn,
+		attributedefinition.Table: attributedefinition.ValidColumn,
+		auditlog.Table:            auditlog.ValidColumn,
+		brand.Table:               brand.ValidColumn,
+		car.Table:                 car.ValidColumn,
+		carattribute.Table:        carattribute.ValidColumn,
+		carrecall.Table:           carrecall.ValidColumn,
+		carwarranty.Table:         carwarranty.ValidColumn,
+		chargingsession.Table:     chargingsession.ValidColumn,
+		fleet.Table:               fleet.ValidColumn,
+		geofence.Table:            geofence.ValidColumn,
+		incident.Table:            incident.ValidColumn,
+		insurancepolicy.Table:     insurancepolicy.ValidColumn,
+		jobrun.Table:              jobrun.ValidColumn,
+		leasecontract.Table:       leasecontract.ValidColumn,
+		listing.Table:             listing.ValidColumn,
+		maintenancerecord.Table:   maintenancerecord.ValidColumn,
+		odometerreading.Table:     odometerreading.ValidColumn,
+		ownerhistory.Table:        ownerhistory.ValidColumn,
+		recall.Table:              recall.ValidColumn,
+		refuel.Table:              refuel.ValidColumn,
+		reservation.Table:         reservation.ValidColumn,
+		tag.Table:                 tag.ValidColumn,
+		traderecord.Table:         traderecord.ValidColumn,
+		transfer.Table:            transfer.ValidColumn,
+		trip.Table:                trip.ValidColumn,
+		vehiclemodel.Table:        vehiclemodel.ValidColumn,
+		violation.Table:           violation.ValidColumn,
+		warrantydefinition.Table:  warrantydefinition.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
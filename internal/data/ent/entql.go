@@ -0,0 +1,4452 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/attachment"
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/auditlog"
+	"car-service/internal/data/ent/brand"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/jobrun"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/ownerhistory"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/recall"
+	"car-service/internal/data/ent/refuel"
+	"car-service/internal/data/ent/reservation"
+	"car-service/internal/data/ent/tag"
+	"car-service/internal/data/ent/traderecord"
+	"car-service/internal/data/ent/transfer"
+	"car-service/internal/data/ent/trip"
+	"car-service/internal/data/ent/vehiclemodel"
+	"car-service/internal/data/ent/violation"
+	"car-service/internal/data/ent/warrantydefinition"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
+)
+
+// schemaGraph holds a representation of ent/schema at runtime.
+var schemaGraph = func() *sqlgraph.Schema {
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 29)}
+	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   attachment.Table,
+			Columns: attachment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: attachment.FieldID,
+			},
+		},
+		Type: "Attachment",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			attachment.FieldTenantID:    {Type: field.TypeInt64, Column: attachment.FieldTenantID},
+			attachment.FieldCarID:       {Type: field.TypeInt64, Column: attachment.FieldCarID},
+			attachment.FieldKind:        {Type: field.TypeString, Column: attachment.FieldKind},
+			attachment.FieldFileName:    {Type: field.TypeString, Column: attachment.FieldFileName},
+			attachment.FieldContentType: {Type: field.TypeString, Column: attachment.FieldContentType},
+			attachment.FieldSize:        {Type: field.TypeInt64, Column: attachment.FieldSize},
+			attachment.FieldChecksum:    {Type: field.TypeString, Column: attachment.FieldChecksum},
+			attachment.FieldStorageKey:  {Type: field.TypeString, Column: attachment.FieldStorageKey},
+			attachment.FieldCreatedAt:   {Type: field.TypeTime, Column: attachment.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   attributedefinition.Table,
+			Columns: attributedefinition.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: attributedefinition.FieldID,
+			},
+		},
+		Type: "AttributeDefinition",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			attributedefinition.FieldTenantID:   {Type: field.TypeInt64, Column: attributedefinition.FieldTenantID},
+			attributedefinition.FieldName:       {Type: field.TypeString, Column: attributedefinition.FieldName},
+			attributedefinition.FieldValueType:  {Type: field.TypeString, Column: attributedefinition.FieldValueType},
+			attributedefinition.FieldRequired:   {Type: field.TypeBool, Column: attributedefinition.FieldRequired},
+			attributedefinition.FieldEnumValues: {Type: field.TypeString, Column: attributedefinition.FieldEnumValues},
+			attributedefinition.FieldCreatedAt:  {Type: field.TypeTime, Column: attributedefinition.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: auditlog.FieldID,
+			},
+		},
+		Type: "AuditLog",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			auditlog.FieldTenantID:  {Type: field.TypeInt64, Column: auditlog.FieldTenantID},
+			auditlog.FieldCarID:     {Type: field.TypeInt64, Column: auditlog.FieldCarID},
+			auditlog.FieldOp:        {Type: field.TypeString, Column: auditlog.FieldOp},
+			auditlog.FieldActor:     {Type: field.TypeInt64, Column: auditlog.FieldActor},
+			auditlog.FieldDiff:      {Type: field.TypeString, Column: auditlog.FieldDiff},
+			auditlog.FieldTraceID:   {Type: field.TypeString, Column: auditlog.FieldTraceID},
+			auditlog.FieldCreatedAt: {Type: field.TypeTime, Column: auditlog.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   brand.Table,
+			Columns: brand.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: brand.FieldID,
+			},
+		},
+		Type: "Brand",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			brand.FieldName: {Type: field.TypeString, Column: brand.FieldName},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   car.Table,
+			Columns: car.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: car.FieldID,
+			},
+		},
+		Type: "Car",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			car.FieldTenantID:        {Type: field.TypeInt64, Column: car.FieldTenantID},
+			car.FieldUserID:          {Type: field.TypeInt64, Column: car.FieldUserID},
+			car.FieldModel:           {Type: field.TypeString, Column: car.FieldModel},
+			car.FieldModelID:         {Type: field.TypeInt64, Column: car.FieldModelID},
+			car.FieldVin:             {Type: field.TypeString, Column: car.FieldVin},
+			car.FieldPlate:           {Type: field.TypeString, Column: car.FieldPlate},
+			car.FieldOwnerName:       {Type: field.TypeString, Column: car.FieldOwnerName},
+			car.FieldRegisteredAt:    {Type: field.TypeTime, Column: car.FieldRegisteredAt},
+			car.FieldBatteryCapacity: {Type: field.TypeFloat64, Column: car.FieldBatteryCapacity},
+			car.FieldBatterySoh:      {Type: field.TypeFloat64, Column: car.FieldBatterySoh},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   carattribute.Table,
+			Columns: carattribute.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carattribute.FieldID,
+			},
+		},
+		Type: "CarAttribute",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			carattribute.FieldCarID:        {Type: field.TypeInt64, Column: carattribute.FieldCarID},
+			carattribute.FieldDefinitionID: {Type: field.TypeInt64, Column: carattribute.FieldDefinitionID},
+			carattribute.FieldValue:        {Type: field.TypeString, Column: carattribute.FieldValue},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   carrecall.Table,
+			Columns: carrecall.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carrecall.FieldID,
+			},
+		},
+		Type: "CarRecall",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			carrecall.FieldTenantID:   {Type: field.TypeInt64, Column: carrecall.FieldTenantID},
+			carrecall.FieldCarID:      {Type: field.TypeInt64, Column: carrecall.FieldCarID},
+			carrecall.FieldRecallID:   {Type: field.TypeInt64, Column: carrecall.FieldRecallID},
+			carrecall.FieldStatus:     {Type: field.TypeString, Column: carrecall.FieldStatus},
+			carrecall.FieldNotes:      {Type: field.TypeString, Column: carrecall.FieldNotes},
+			carrecall.FieldRemediedAt: {Type: field.TypeTime, Column: carrecall.FieldRemediedAt},
+			carrecall.FieldCreatedAt:  {Type: field.TypeTime, Column: carrecall.FieldCreatedAt},
+			carrecall.FieldUpdatedAt:  {Type: field.TypeTime, Column: carrecall.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   carwarranty.Table,
+			Columns: carwarranty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: carwarranty.FieldID,
+			},
+		},
+		Type: "CarWarranty",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			carwarranty.FieldTenantID:     {Type: field.TypeInt64, Column: carwarranty.FieldTenantID},
+			carwarranty.FieldCarID:        {Type: field.TypeInt64, Column: carwarranty.FieldCarID},
+			carwarranty.FieldDefinitionID: {Type: field.TypeInt64, Column: carwarranty.FieldDefinitionID},
+			carwarranty.FieldName:         {Type: field.TypeString, Column: carwarranty.FieldName},
+			carwarranty.FieldComponents:   {Type: field.TypeString, Column: carwarranty.FieldComponents},
+			carwarranty.FieldStartAt:      {Type: field.TypeTime, Column: carwarranty.FieldStartAt},
+			carwarranty.FieldEndAt:        {Type: field.TypeTime, Column: carwarranty.FieldEndAt},
+			carwarranty.FieldMileageLimit: {Type: field.TypeInt64, Column: carwarranty.FieldMileageLimit},
+			carwarranty.FieldCreatedAt:    {Type: field.TypeTime, Column: carwarranty.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   chargingsession.Table,
+			Columns: chargingsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: chargingsession.FieldID,
+			},
+		},
+		Type: "ChargingSession",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			chargingsession.FieldTenantID:    {Type: field.TypeInt64, Column: chargingsession.FieldTenantID},
+			chargingsession.FieldCarID:       {Type: field.TypeInt64, Column: chargingsession.FieldCarID},
+			chargingsession.FieldStartedAt:   {Type: field.TypeTime, Column: chargingsession.FieldStartedAt},
+			chargingsession.FieldEndedAt:     {Type: field.TypeTime, Column: chargingsession.FieldEndedAt},
+			chargingsession.FieldEnergy:      {Type: field.TypeFloat64, Column: chargingsession.FieldEnergy},
+			chargingsession.FieldChargerType: {Type: field.TypeString, Column: chargingsession.FieldChargerType},
+			chargingsession.FieldCost:        {Type: field.TypeFloat64, Column: chargingsession.FieldCost},
+			chargingsession.FieldStation:     {Type: field.TypeString, Column: chargingsession.FieldStation},
+			chargingsession.FieldStartSoc:    {Type: field.TypeFloat64, Column: chargingsession.FieldStartSoc},
+			chargingsession.FieldEndSoc:      {Type: field.TypeFloat64, Column: chargingsession.FieldEndSoc},
+			chargingsession.FieldSoh:         {Type: field.TypeFloat64, Column: chargingsession.FieldSoh},
+			chargingsession.FieldCreatedAt:   {Type: field.TypeTime, Column: chargingsession.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   fleet.Table,
+			Columns: fleet.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: fleet.FieldID,
+			},
+		},
+		Type: "Fleet",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			fleet.FieldTenantID:    {Type: field.TypeInt64, Column: fleet.FieldTenantID},
+			fleet.FieldName:        {Type: field.TypeString, Column: fleet.FieldName},
+			fleet.FieldDescription: {Type: field.TypeString, Column: fleet.FieldDescription},
+			fleet.FieldCreatedAt:   {Type: field.TypeTime, Column: fleet.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   geofence.Table,
+			Columns: geofence.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: geofence.FieldID,
+			},
+		},
+		Type: "Geofence",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			geofence.FieldTenantID:  {Type: field.TypeInt64, Column: geofence.FieldTenantID},
+			geofence.FieldName:      {Type: field.TypeString, Column: geofence.FieldName},
+			geofence.FieldPolygon:   {Type: field.TypeString, Column: geofence.FieldPolygon},
+			geofence.FieldDays:      {Type: field.TypeString, Column: geofence.FieldDays},
+			geofence.FieldStartTime: {Type: field.TypeString, Column: geofence.FieldStartTime},
+			geofence.FieldEndTime:   {Type: field.TypeString, Column: geofence.FieldEndTime},
+			geofence.FieldTimeZone:  {Type: field.TypeString, Column: geofence.FieldTimeZone},
+			geofence.FieldAlertOn:   {Type: field.TypeString, Column: geofence.FieldAlertOn},
+			geofence.FieldEnabled:   {Type: field.TypeBool, Column: geofence.FieldEnabled},
+			geofence.FieldCreatedAt: {Type: field.TypeTime, Column: geofence.FieldCreatedAt},
+			geofence.FieldUpdatedAt: {Type: field.TypeTime, Column: geofence.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   incident.Table,
+			Columns: incident.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: incident.FieldID,
+			},
+		},
+		Type: "Incident",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			incident.FieldTenantID:      {Type: field.TypeInt64, Column: incident.FieldTenantID},
+			incident.FieldCarID:         {Type: field.TypeInt64, Column: incident.FieldCarID},
+			incident.FieldReporterID:    {Type: field.TypeInt64, Column: incident.FieldReporterID},
+			incident.FieldOccurredAt:    {Type: field.TypeTime, Column: incident.FieldOccurredAt},
+			incident.FieldLocation:      {Type: field.TypeString, Column: incident.FieldLocation},
+			incident.FieldSeverity:      {Type: field.TypeString, Column: incident.FieldSeverity},
+			incident.FieldDescription:   {Type: field.TypeString, Column: incident.FieldDescription},
+			incident.FieldPhotoIds:      {Type: field.TypeString, Column: incident.FieldPhotoIds},
+			incident.FieldThirdParties:  {Type: field.TypeString, Column: incident.FieldThirdParties},
+			incident.FieldStatus:        {Type: field.TypeString, Column: incident.FieldStatus},
+			incident.FieldPolicyID:      {Type: field.TypeInt64, Column: incident.FieldPolicyID},
+			incident.FieldClaimNo:       {Type: field.TypeString, Column: incident.FieldClaimNo},
+			incident.FieldClaimAmount:   {Type: field.TypeFloat64, Column: incident.FieldClaimAmount},
+			incident.FieldSettledAmount: {Type: field.TypeFloat64, Column: incident.FieldSettledAmount},
+			incident.FieldSettledAt:     {Type: field.TypeTime, Column: incident.FieldSettledAt},
+			incident.FieldCreatedAt:     {Type: field.TypeTime, Column: incident.FieldCreatedAt},
+			incident.FieldUpdatedAt:     {Type: field.TypeTime, Column: incident.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[12] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   insurancepolicy.Table,
+			Columns: insurancepolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: insurancepolicy.FieldID,
+			},
+		},
+		Type: "InsurancePolicy",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			insurancepolicy.FieldTenantID:     {Type: field.TypeInt64, Column: insurancepolicy.FieldTenantID},
+			insurancepolicy.FieldCarID:        {Type: field.TypeInt64, Column: insurancepolicy.FieldCarID},
+			insurancepolicy.FieldProvider:     {Type: field.TypeString, Column: insurancepolicy.FieldProvider},
+			insurancepolicy.FieldPolicyNumber: {Type: field.TypeString, Column: insurancepolicy.FieldPolicyNumber},
+			insurancepolicy.FieldCoverage:     {Type: field.TypeString, Column: insurancepolicy.FieldCoverage},
+			insurancepolicy.FieldStartDate:    {Type: field.TypeTime, Column: insurancepolicy.FieldStartDate},
+			insurancepolicy.FieldEndDate:      {Type: field.TypeTime, Column: insurancepolicy.FieldEndDate},
+			insurancepolicy.FieldPremium:      {Type: field.TypeFloat64, Column: insurancepolicy.FieldPremium},
+			insurancepolicy.FieldRemindedAt:   {Type: field.TypeTime, Column: insurancepolicy.FieldRemindedAt},
+		},
+	}
+	graph.Nodes[13] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   jobrun.Table,
+			Columns: jobrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: jobrun.FieldID,
+			},
+		},
+		Type: "JobRun",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			jobrun.FieldName:        {Type: field.TypeString, Column: jobrun.FieldName},
+			jobrun.FieldScheduledAt: {Type: field.TypeTime, Column: jobrun.FieldScheduledAt},
+			jobrun.FieldStartedAt:   {Type: field.TypeTime, Column: jobrun.FieldStartedAt},
+			jobrun.FieldFinishedAt:  {Type: field.TypeTime, Column: jobrun.FieldFinishedAt},
+			jobrun.FieldStatus:      {Type: field.TypeString, Column: jobrun.FieldStatus},
+			jobrun.FieldError:       {Type: field.TypeString, Column: jobrun.FieldError},
+			jobrun.FieldInstance:    {Type: field.TypeString, Column: jobrun.FieldInstance},
+			jobrun.FieldCreatedAt:   {Type: field.TypeTime, Column: jobrun.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[14] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   leasecontract.Table,
+			Columns: leasecontract.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: leasecontract.FieldID,
+			},
+		},
+		Type: "LeaseContract",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			leasecontract.FieldTenantID:         {Type: field.TypeInt64, Column: leasecontract.FieldTenantID},
+			leasecontract.FieldCarID:            {Type: field.TypeInt64, Column: leasecontract.FieldCarID},
+			leasecontract.FieldLessor:           {Type: field.TypeString, Column: leasecontract.FieldLessor},
+			leasecontract.FieldContractNo:       {Type: field.TypeString, Column: leasecontract.FieldContractNo},
+			leasecontract.FieldLesseeID:         {Type: field.TypeInt64, Column: leasecontract.FieldLesseeID},
+			leasecontract.FieldStartDate:        {Type: field.TypeTime, Column: leasecontract.FieldStartDate},
+			leasecontract.FieldEndDate:          {Type: field.TypeTime, Column: leasecontract.FieldEndDate},
+			leasecontract.FieldMonthlyPayment:   {Type: field.TypeFloat64, Column: leasecontract.FieldMonthlyPayment},
+			leasecontract.FieldMileageAllowance: {Type: field.TypeInt64, Column: leasecontract.FieldMileageAllowance},
+			leasecontract.FieldExcessRate:       {Type: field.TypeFloat64, Column: leasecontract.FieldExcessRate},
+			leasecontract.FieldStartMileage:     {Type: field.TypeInt64, Column: leasecontract.FieldStartMileage},
+			leasecontract.FieldTransferAllowed:  {Type: field.TypeBool, Column: leasecontract.FieldTransferAllowed},
+			leasecontract.FieldStatus:           {Type: field.TypeString, Column: leasecontract.FieldStatus},
+			leasecontract.FieldTerminatedAt:     {Type: field.TypeTime, Column: leasecontract.FieldTerminatedAt},
+			leasecontract.FieldCreatedAt:        {Type: field.TypeTime, Column: leasecontract.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[15] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   listing.Table,
+			Columns: listing.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: listing.FieldID,
+			},
+		},
+		Type: "Listing",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			listing.FieldTenantID:    {Type: field.TypeInt64, Column: listing.FieldTenantID},
+			listing.FieldCarID:       {Type: field.TypeInt64, Column: listing.FieldCarID},
+			listing.FieldSellerID:    {Type: field.TypeInt64, Column: listing.FieldSellerID},
+			listing.FieldPrice:       {Type: field.TypeFloat64, Column: listing.FieldPrice},
+			listing.FieldDescription: {Type: field.TypeString, Column: listing.FieldDescription},
+			listing.FieldStatus:      {Type: field.TypeString, Column: listing.FieldStatus},
+			listing.FieldExpiresAt:   {Type: field.TypeTime, Column: listing.FieldExpiresAt},
+			listing.FieldBuyerID:     {Type: field.TypeInt64, Column: listing.FieldBuyerID},
+			listing.FieldClosedAt:    {Type: field.TypeTime, Column: listing.FieldClosedAt},
+			listing.FieldCreatedAt:   {Type: field.TypeTime, Column: listing.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[16] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   maintenancerecord.Table,
+			Columns: maintenancerecord.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: maintenancerecord.FieldID,
+			},
+		},
+		Type: "MaintenanceRecord",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			maintenancerecord.FieldTenantID:   {Type: field.TypeInt64, Column: maintenancerecord.FieldTenantID},
+			maintenancerecord.FieldCarID:      {Type: field.TypeInt64, Column: maintenancerecord.FieldCarID},
+			maintenancerecord.FieldServicedAt: {Type: field.TypeTime, Column: maintenancerecord.FieldServicedAt},
+			maintenancerecord.FieldMileage:    {Type: field.TypeInt64, Column: maintenancerecord.FieldMileage},
+			maintenancerecord.FieldType:       {Type: field.TypeString, Column: maintenancerecord.FieldType},
+			maintenancerecord.FieldCost:       {Type: field.TypeFloat64, Column: maintenancerecord.FieldCost},
+			maintenancerecord.FieldShop:       {Type: field.TypeString, Column: maintenancerecord.FieldShop},
+			maintenancerecord.FieldNotes:      {Type: field.TypeString, Column: maintenancerecord.FieldNotes},
+		},
+	}
+	graph.Nodes[17] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   odometerreading.Table,
+			Columns: odometerreading.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: odometerreading.FieldID,
+			},
+		},
+		Type: "OdometerReading",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			odometerreading.FieldTenantID:   {Type: field.TypeInt64, Column: odometerreading.FieldTenantID},
+			odometerreading.FieldCarID:      {Type: field.TypeInt64, Column: odometerreading.FieldCarID},
+			odometerreading.FieldMileage:    {Type: field.TypeInt64, Column: odometerreading.FieldMileage},
+			odometerreading.FieldSource:     {Type: field.TypeString, Column: odometerreading.FieldSource},
+			odometerreading.FieldSuspicious: {Type: field.TypeBool, Column: odometerreading.FieldSuspicious},
+			odometerreading.FieldRecordedAt: {Type: field.TypeTime, Column: odometerreading.FieldRecordedAt},
+		},
+	}
+	graph.Nodes[18] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   ownerhistory.Table,
+			Columns: ownerhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: ownerhistory.FieldID,
+			},
+		},
+		Type: "OwnerHistory",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			ownerhistory.FieldTenantID:  {Type: field.TypeInt64, Column: ownerhistory.FieldTenantID},
+			ownerhistory.FieldCarID:     {Type: field.TypeInt64, Column: ownerhistory.FieldCarID},
+			ownerhistory.FieldUserID:    {Type: field.TypeInt64, Column: ownerhistory.FieldUserID},
+			ownerhistory.FieldStartedAt: {Type: field.TypeTime, Column: ownerhistory.FieldStartedAt},
+			ownerhistory.FieldEndedAt:   {Type: field.TypeTime, Column: ownerhistory.FieldEndedAt},
+		},
+	}
+	graph.Nodes[19] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   recall.Table,
+			Columns: recall.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: recall.FieldID,
+			},
+		},
+		Type: "Recall",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			recall.FieldCampaignNo:   {Type: field.TypeString, Column: recall.FieldCampaignNo},
+			recall.FieldManufacturer: {Type: field.TypeString, Column: recall.FieldManufacturer},
+			recall.FieldModelID:      {Type: field.TypeInt64, Column: recall.FieldModelID},
+			recall.FieldModel:        {Type: field.TypeString, Column: recall.FieldModel},
+			recall.FieldYearFrom:     {Type: field.TypeInt, Column: recall.FieldYearFrom},
+			recall.FieldYearTo:       {Type: field.TypeInt, Column: recall.FieldYearTo},
+			recall.FieldVinFrom:      {Type: field.TypeString, Column: recall.FieldVinFrom},
+			recall.FieldVinTo:        {Type: field.TypeString, Column: recall.FieldVinTo},
+			recall.FieldComponent:    {Type: field.TypeString, Column: recall.FieldComponent},
+			recall.FieldDescription:  {Type: field.TypeString, Column: recall.FieldDescription},
+			recall.FieldRemedy:       {Type: field.TypeString, Column: recall.FieldRemedy},
+			recall.FieldIssuedAt:     {Type: field.TypeTime, Column: recall.FieldIssuedAt},
+			recall.FieldCreatedAt:    {Type: field.TypeTime, Column: recall.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[20] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   refuel.Table,
+			Columns: refuel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: refuel.FieldID,
+			},
+		},
+		Type: "Refuel",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			refuel.FieldTenantID:   {Type: field.TypeInt64, Column: refuel.FieldTenantID},
+			refuel.FieldCarID:      {Type: field.TypeInt64, Column: refuel.FieldCarID},
+			refuel.FieldAmount:     {Type: field.TypeFloat64, Column: refuel.FieldAmount},
+			refuel.FieldUnit:       {Type: field.TypeString, Column: refuel.FieldUnit},
+			refuel.FieldCost:       {Type: field.TypeFloat64, Column: refuel.FieldCost},
+			refuel.FieldStation:    {Type: field.TypeString, Column: refuel.FieldStation},
+			refuel.FieldOdometer:   {Type: field.TypeInt64, Column: refuel.FieldOdometer},
+			refuel.FieldRefueledAt: {Type: field.TypeTime, Column: refuel.FieldRefueledAt},
+		},
+	}
+	graph.Nodes[21] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   reservation.Table,
+			Columns: reservation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: reservation.FieldID,
+			},
+		},
+		Type: "Reservation",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			reservation.FieldTenantID:    {Type: field.TypeInt64, Column: reservation.FieldTenantID},
+			reservation.FieldCarID:       {Type: field.TypeInt64, Column: reservation.FieldCarID},
+			reservation.FieldUserID:      {Type: field.TypeInt64, Column: reservation.FieldUserID},
+			reservation.FieldStartAt:     {Type: field.TypeTime, Column: reservation.FieldStartAt},
+			reservation.FieldEndAt:       {Type: field.TypeTime, Column: reservation.FieldEndAt},
+			reservation.FieldPurpose:     {Type: field.TypeString, Column: reservation.FieldPurpose},
+			reservation.FieldStatus:      {Type: field.TypeString, Column: reservation.FieldStatus},
+			reservation.FieldCancelledAt: {Type: field.TypeTime, Column: reservation.FieldCancelledAt},
+			reservation.FieldCreatedAt:   {Type: field.TypeTime, Column: reservation.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[22] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   tag.Table,
+			Columns: tag.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: tag.FieldID,
+			},
+		},
+		Type: "Tag",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			tag.FieldTenantID: {Type: field.TypeInt64, Column: tag.FieldTenantID},
+			tag.FieldName:     {Type: field.TypeString, Column: tag.FieldName},
+		},
+	}
+	graph.Nodes[23] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   traderecord.Table,
+			Columns: traderecord.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: traderecord.FieldID,
+			},
+		},
+		Type: "TradeRecord",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			traderecord.FieldTenantID:       {Type: field.TypeInt64, Column: traderecord.FieldTenantID},
+			traderecord.FieldCarID:          {Type: field.TypeInt64, Column: traderecord.FieldCarID},
+			traderecord.FieldModelID:        {Type: field.TypeInt64, Column: traderecord.FieldModelID},
+			traderecord.FieldSellerID:       {Type: field.TypeInt64, Column: traderecord.FieldSellerID},
+			traderecord.FieldBuyerID:        {Type: field.TypeInt64, Column: traderecord.FieldBuyerID},
+			traderecord.FieldPrice:          {Type: field.TypeFloat64, Column: traderecord.FieldPrice},
+			traderecord.FieldEstimatedValue: {Type: field.TypeFloat64, Column: traderecord.FieldEstimatedValue},
+			traderecord.FieldMileage:        {Type: field.TypeInt64, Column: traderecord.FieldMileage},
+			traderecord.FieldAgeMonths:      {Type: field.TypeInt, Column: traderecord.FieldAgeMonths},
+			traderecord.FieldTradedAt:       {Type: field.TypeTime, Column: traderecord.FieldTradedAt},
+		},
+	}
+	graph.Nodes[24] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   transfer.Table,
+			Columns: transfer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: transfer.FieldID,
+			},
+		},
+		Type: "Transfer",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			transfer.FieldTenantID:        {Type: field.TypeInt64, Column: transfer.FieldTenantID},
+			transfer.FieldCarID:           {Type: field.TypeInt64, Column: transfer.FieldCarID},
+			transfer.FieldSellerID:        {Type: field.TypeInt64, Column: transfer.FieldSellerID},
+			transfer.FieldBuyerID:         {Type: field.TypeInt64, Column: transfer.FieldBuyerID},
+			transfer.FieldStatus:          {Type: field.TypeString, Column: transfer.FieldStatus},
+			transfer.FieldRequireApproval: {Type: field.TypeBool, Column: transfer.FieldRequireApproval},
+			transfer.FieldApprovedBy:      {Type: field.TypeInt64, Column: transfer.FieldApprovedBy},
+			transfer.FieldExpiresAt:       {Type: field.TypeTime, Column: transfer.FieldExpiresAt},
+			transfer.FieldAcceptedAt:      {Type: field.TypeTime, Column: transfer.FieldAcceptedAt},
+			transfer.FieldCompletedAt:     {Type: field.TypeTime, Column: transfer.FieldCompletedAt},
+			transfer.FieldCreatedAt:       {Type: field.TypeTime, Column: transfer.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[25] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   trip.Table,
+			Columns: trip.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: trip.FieldID,
+			},
+		},
+		Type: "Trip",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			trip.FieldTenantID:      {Type: field.TypeInt64, Column: trip.FieldTenantID},
+			trip.FieldCarID:         {Type: field.TypeInt64, Column: trip.FieldCarID},
+			trip.FieldDriverID:      {Type: field.TypeInt64, Column: trip.FieldDriverID},
+			trip.FieldPurpose:       {Type: field.TypeString, Column: trip.FieldPurpose},
+			trip.FieldStartOdometer: {Type: field.TypeInt64, Column: trip.FieldStartOdometer},
+			trip.FieldEndOdometer:   {Type: field.TypeInt64, Column: trip.FieldEndOdometer},
+			trip.FieldStartedAt:     {Type: field.TypeTime, Column: trip.FieldStartedAt},
+			trip.FieldEndedAt:       {Type: field.TypeTime, Column: trip.FieldEndedAt},
+			trip.FieldCreatedAt:     {Type: field.TypeTime, Column: trip.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[26] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   vehiclemodel.Table,
+			Columns: vehiclemodel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: vehiclemodel.FieldID,
+			},
+		},
+		Type: "VehicleModel",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			vehiclemodel.FieldBrandID:  {Type: field.TypeInt64, Column: vehiclemodel.FieldBrandID},
+			vehiclemodel.FieldName:     {Type: field.TypeString, Column: vehiclemodel.FieldName},
+			vehiclemodel.FieldFuelType: {Type: field.TypeString, Column: vehiclemodel.FieldFuelType},
+			vehiclemodel.FieldSeats:    {Type: field.TypeInt32, Column: vehiclemodel.FieldSeats},
+			vehiclemodel.FieldBodyType: {Type: field.TypeString, Column: vehiclemodel.FieldBodyType},
+		},
+	}
+	graph.Nodes[27] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   violation.Table,
+			Columns: violation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: violation.FieldID,
+			},
+		},
+		Type: "Violation",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			violation.FieldTenantID:    {Type: field.TypeInt64, Column: violation.FieldTenantID},
+			violation.FieldCarID:       {Type: field.TypeInt64, Column: violation.FieldCarID},
+			violation.FieldUserID:      {Type: field.TypeInt64, Column: violation.FieldUserID},
+			violation.FieldReferenceNo: {Type: field.TypeString, Column: violation.FieldReferenceNo},
+			violation.FieldOccurredAt:  {Type: field.TypeTime, Column: violation.FieldOccurredAt},
+			violation.FieldLocation:    {Type: field.TypeString, Column: violation.FieldLocation},
+			violation.FieldAmount:      {Type: field.TypeFloat64, Column: violation.FieldAmount},
+			violation.FieldDescription: {Type: field.TypeString, Column: violation.FieldDescription},
+			violation.FieldStatus:      {Type: field.TypeString, Column: violation.FieldStatus},
+			violation.FieldResolvedAt:  {Type: field.TypeTime, Column: violation.FieldResolvedAt},
+			violation.FieldResolvedBy:  {Type: field.TypeInt64, Column: violation.FieldResolvedBy},
+			violation.FieldCreatedAt:   {Type: field.TypeTime, Column: violation.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[28] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   warrantydefinition.Table,
+			Columns: warrantydefinition.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: warrantydefinition.FieldID,
+			},
+		},
+		Type: "WarrantyDefinition",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			warrantydefinition.FieldModelID:        {Type: field.TypeInt64, Column: warrantydefinition.FieldModelID},
+			warrantydefinition.FieldName:           {Type: field.TypeString, Column: warrantydefinition.FieldName},
+			warrantydefinition.FieldComponents:     {Type: field.TypeString, Column: warrantydefinition.FieldComponents},
+			warrantydefinition.FieldDurationMonths: {Type: field.TypeInt, Column: warrantydefinition.FieldDurationMonths},
+			warrantydefinition.FieldMileageLimit:   {Type: field.TypeInt64, Column: warrantydefinition.FieldMileageLimit},
+			warrantydefinition.FieldCreatedAt:      {Type: field.TypeTime, Column: warrantydefinition.FieldCreatedAt},
+		},
+	}
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.CarTable,
+			Columns: []string{attachment.CarColumn},
+			Bidi:    false,
+		},
+		"Attachment",
+		"Car",
+	)
+	graph.MustAddE(
+		"values",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attributedefinition.ValuesTable,
+			Columns: []string{attributedefinition.ValuesColumn},
+			Bidi:    false,
+		},
+		"AttributeDefinition",
+		"CarAttribute",
+	)
+	graph.MustAddE(
+		"vehicle_models",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brand.VehicleModelsTable,
+			Columns: []string{brand.VehicleModelsColumn},
+			Bidi:    false,
+		},
+		"Brand",
+		"VehicleModel",
+	)
+	graph.MustAddE(
+		"vehicle_model",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.VehicleModelTable,
+			Columns: []string{car.VehicleModelColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"VehicleModel",
+	)
+	graph.MustAddE(
+		"maintenance_records",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceRecordsTable,
+			Columns: []string{car.MaintenanceRecordsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"MaintenanceRecord",
+	)
+	graph.MustAddE(
+		"insurance_policies",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.InsurancePoliciesTable,
+			Columns: []string{car.InsurancePoliciesColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"InsurancePolicy",
+	)
+	graph.MustAddE(
+		"transfers",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TransfersTable,
+			Columns: []string{car.TransfersColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"Transfer",
+	)
+	graph.MustAddE(
+		"odometer_readings",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OdometerReadingsTable,
+			Columns: []string{car.OdometerReadingsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"OdometerReading",
+	)
+	graph.MustAddE(
+		"attachments",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttachmentsTable,
+			Columns: []string{car.AttachmentsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"Attachment",
+	)
+	graph.MustAddE(
+		"fleets",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.FleetsTable,
+			Columns: car.FleetsPrimaryKey,
+			Bidi:    false,
+		},
+		"Car",
+		"Fleet",
+	)
+	graph.MustAddE(
+		"tags",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.TagsTable,
+			Columns: car.TagsPrimaryKey,
+			Bidi:    false,
+		},
+		"Car",
+		"Tag",
+	)
+	graph.MustAddE(
+		"attributes",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.AttributesTable,
+			Columns: []string{car.AttributesColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"CarAttribute",
+	)
+	graph.MustAddE(
+		"reservations",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ReservationsTable,
+			Columns: []string{car.ReservationsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"Reservation",
+	)
+	graph.MustAddE(
+		"trips",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TripsTable,
+			Columns: []string{car.TripsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"Trip",
+	)
+	graph.MustAddE(
+		"refuels",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RefuelsTable,
+			Columns: []string{car.RefuelsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"Refuel",
+	)
+	graph.MustAddE(
+		"recalls",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.RecallsTable,
+			Columns: []string{car.RecallsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"CarRecall",
+	)
+	graph.MustAddE(
+		"owner_histories",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.OwnerHistoriesTable,
+			Columns: []string{car.OwnerHistoriesColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"OwnerHistory",
+	)
+	graph.MustAddE(
+		"violations",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ViolationsTable,
+			Columns: []string{car.ViolationsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"Violation",
+	)
+	graph.MustAddE(
+		"trade_records",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.TradeRecordsTable,
+			Columns: []string{car.TradeRecordsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"TradeRecord",
+	)
+	graph.MustAddE(
+		"listings",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ListingsTable,
+			Columns: []string{car.ListingsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"Listing",
+	)
+	graph.MustAddE(
+		"warranties",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.WarrantiesTable,
+			Columns: []string{car.WarrantiesColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"CarWarranty",
+	)
+	graph.MustAddE(
+		"incidents",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.IncidentsTable,
+			Columns: []string{car.IncidentsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"Incident",
+	)
+	graph.MustAddE(
+		"lease_contracts",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.LeaseContractsTable,
+			Columns: []string{car.LeaseContractsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"LeaseContract",
+	)
+	graph.MustAddE(
+		"charging_sessions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.ChargingSessionsTable,
+			Columns: []string{car.ChargingSessionsColumn},
+			Bidi:    false,
+		},
+		"Car",
+		"ChargingSession",
+	)
+	graph.MustAddE(
+		"geofences",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   car.GeofencesTable,
+			Columns: car.GeofencesPrimaryKey,
+			Bidi:    false,
+		},
+		"Car",
+		"Geofence",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.CarTable,
+			Columns: []string{carattribute.CarColumn},
+			Bidi:    false,
+		},
+		"CarAttribute",
+		"Car",
+	)
+	graph.MustAddE(
+		"definition",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carattribute.DefinitionTable,
+			Columns: []string{carattribute.DefinitionColumn},
+			Bidi:    false,
+		},
+		"CarAttribute",
+		"AttributeDefinition",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.CarTable,
+			Columns: []string{carrecall.CarColumn},
+			Bidi:    false,
+		},
+		"CarRecall",
+		"Car",
+	)
+	graph.MustAddE(
+		"recall",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carrecall.RecallTable,
+			Columns: []string{carrecall.RecallColumn},
+			Bidi:    false,
+		},
+		"CarRecall",
+		"Recall",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.CarTable,
+			Columns: []string{carwarranty.CarColumn},
+			Bidi:    false,
+		},
+		"CarWarranty",
+		"Car",
+	)
+	graph.MustAddE(
+		"definition",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carwarranty.DefinitionTable,
+			Columns: []string{carwarranty.DefinitionColumn},
+			Bidi:    false,
+		},
+		"CarWarranty",
+		"WarrantyDefinition",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargingsession.CarTable,
+			Columns: []string{chargingsession.CarColumn},
+			Bidi:    false,
+		},
+		"ChargingSession",
+		"Car",
+	)
+	graph.MustAddE(
+		"cars",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   fleet.CarsTable,
+			Columns: fleet.CarsPrimaryKey,
+			Bidi:    false,
+		},
+		"Fleet",
+		"Car",
+	)
+	graph.MustAddE(
+		"cars",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   geofence.CarsTable,
+			Columns: geofence.CarsPrimaryKey,
+			Bidi:    false,
+		},
+		"Geofence",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.CarTable,
+			Columns: []string{incident.CarColumn},
+			Bidi:    false,
+		},
+		"Incident",
+		"Car",
+	)
+	graph.MustAddE(
+		"policy",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.PolicyTable,
+			Columns: []string{incident.PolicyColumn},
+			Bidi:    false,
+		},
+		"Incident",
+		"InsurancePolicy",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   insurancepolicy.CarTable,
+			Columns: []string{insurancepolicy.CarColumn},
+			Bidi:    false,
+		},
+		"InsurancePolicy",
+		"Car",
+	)
+	graph.MustAddE(
+		"incidents",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   insurancepolicy.IncidentsTable,
+			Columns: []string{insurancepolicy.IncidentsColumn},
+			Bidi:    false,
+		},
+		"InsurancePolicy",
+		"Incident",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leasecontract.CarTable,
+			Columns: []string{leasecontract.CarColumn},
+			Bidi:    false,
+		},
+		"LeaseContract",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.CarTable,
+			Columns: []string{listing.CarColumn},
+			Bidi:    false,
+		},
+		"Listing",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancerecord.CarTable,
+			Columns: []string{maintenancerecord.CarColumn},
+			Bidi:    false,
+		},
+		"MaintenanceRecord",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   odometerreading.CarTable,
+			Columns: []string{odometerreading.CarColumn},
+			Bidi:    false,
+		},
+		"OdometerReading",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownerhistory.CarTable,
+			Columns: []string{ownerhistory.CarColumn},
+			Bidi:    false,
+		},
+		"OwnerHistory",
+		"Car",
+	)
+	graph.MustAddE(
+		"car_recalls",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recall.CarRecallsTable,
+			Columns: []string{recall.CarRecallsColumn},
+			Bidi:    false,
+		},
+		"Recall",
+		"CarRecall",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refuel.CarTable,
+			Columns: []string{refuel.CarColumn},
+			Bidi:    false,
+		},
+		"Refuel",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.CarTable,
+			Columns: []string{reservation.CarColumn},
+			Bidi:    false,
+		},
+		"Reservation",
+		"Car",
+	)
+	graph.MustAddE(
+		"cars",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.CarsTable,
+			Columns: tag.CarsPrimaryKey,
+			Bidi:    false,
+		},
+		"Tag",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   traderecord.CarTable,
+			Columns: []string{traderecord.CarColumn},
+			Bidi:    false,
+		},
+		"TradeRecord",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.CarTable,
+			Columns: []string{transfer.CarColumn},
+			Bidi:    false,
+		},
+		"Transfer",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trip.CarTable,
+			Columns: []string{trip.CarColumn},
+			Bidi:    false,
+		},
+		"Trip",
+		"Car",
+	)
+	graph.MustAddE(
+		"brand",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vehiclemodel.BrandTable,
+			Columns: []string{vehiclemodel.BrandColumn},
+			Bidi:    false,
+		},
+		"VehicleModel",
+		"Brand",
+	)
+	graph.MustAddE(
+		"cars",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehiclemodel.CarsTable,
+			Columns: []string{vehiclemodel.CarsColumn},
+			Bidi:    false,
+		},
+		"VehicleModel",
+		"Car",
+	)
+	graph.MustAddE(
+		"car",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   violation.CarTable,
+			Columns: []string{violation.CarColumn},
+			Bidi:    false,
+		},
+		"Violation",
+		"Car",
+	)
+	graph.MustAddE(
+		"car_warranties",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantydefinition.CarWarrantiesTable,
+			Columns: []string{warrantydefinition.CarWarrantiesColumn},
+			Bidi:    false,
+		},
+		"WarrantyDefinition",
+		"CarWarranty",
+	)
+	return graph
+}()
+
+// predicateAdder wraps the addPredicate method.
+// All update, update-one and query builders implement this interface.
+type predicateAdder interface {
+	addPredicate(func(s *sql.Selector))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (aq *AttachmentQuery) addPredicate(pred func(s *sql.Selector)) {
+	aq.predicates = append(aq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AttachmentQuery builder.
+func (aq *AttachmentQuery) Filter() *AttachmentFilter {
+	return &AttachmentFilter{config: aq.config, predicateAdder: aq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AttachmentMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AttachmentMutation builder.
+func (m *AttachmentMutation) Filter() *AttachmentFilter {
+	return &AttachmentFilter{config: m.config, predicateAdder: m}
+}
+
+// AttachmentFilter provides a generic filtering capability at runtime for AttachmentQuery.
+type AttachmentFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AttachmentFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *AttachmentFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(attachment.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *AttachmentFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(attachment.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *AttachmentFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(attachment.FieldCarID))
+}
+
+// WhereKind applies the entql string predicate on the kind field.
+func (f *AttachmentFilter) WhereKind(p entql.StringP) {
+	f.Where(p.Field(attachment.FieldKind))
+}
+
+// WhereFileName applies the entql string predicate on the file_name field.
+func (f *AttachmentFilter) WhereFileName(p entql.StringP) {
+	f.Where(p.Field(attachment.FieldFileName))
+}
+
+// WhereContentType applies the entql string predicate on the content_type field.
+func (f *AttachmentFilter) WhereContentType(p entql.StringP) {
+	f.Where(p.Field(attachment.FieldContentType))
+}
+
+// WhereSize applies the entql int64 predicate on the size field.
+func (f *AttachmentFilter) WhereSize(p entql.Int64P) {
+	f.Where(p.Field(attachment.FieldSize))
+}
+
+// WhereChecksum applies the entql string predicate on the checksum field.
+func (f *AttachmentFilter) WhereChecksum(p entql.StringP) {
+	f.Where(p.Field(attachment.FieldChecksum))
+}
+
+// WhereStorageKey applies the entql string predicate on the storage_key field.
+func (f *AttachmentFilter) WhereStorageKey(p entql.StringP) {
+	f.Where(p.Field(attachment.FieldStorageKey))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AttachmentFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(attachment.FieldCreatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *AttachmentFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *AttachmentFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (adq *AttributeDefinitionQuery) addPredicate(pred func(s *sql.Selector)) {
+	adq.predicates = append(adq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AttributeDefinitionQuery builder.
+func (adq *AttributeDefinitionQuery) Filter() *AttributeDefinitionFilter {
+	return &AttributeDefinitionFilter{config: adq.config, predicateAdder: adq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AttributeDefinitionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AttributeDefinitionMutation builder.
+func (m *AttributeDefinitionMutation) Filter() *AttributeDefinitionFilter {
+	return &AttributeDefinitionFilter{config: m.config, predicateAdder: m}
+}
+
+// AttributeDefinitionFilter provides a generic filtering capability at runtime for AttributeDefinitionQuery.
+type AttributeDefinitionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AttributeDefinitionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *AttributeDefinitionFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(attributedefinition.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *AttributeDefinitionFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(attributedefinition.FieldTenantID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *AttributeDefinitionFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(attributedefinition.FieldName))
+}
+
+// WhereValueType applies the entql string predicate on the value_type field.
+func (f *AttributeDefinitionFilter) WhereValueType(p entql.StringP) {
+	f.Where(p.Field(attributedefinition.FieldValueType))
+}
+
+// WhereRequired applies the entql bool predicate on the required field.
+func (f *AttributeDefinitionFilter) WhereRequired(p entql.BoolP) {
+	f.Where(p.Field(attributedefinition.FieldRequired))
+}
+
+// WhereEnumValues applies the entql string predicate on the enum_values field.
+func (f *AttributeDefinitionFilter) WhereEnumValues(p entql.StringP) {
+	f.Where(p.Field(attributedefinition.FieldEnumValues))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AttributeDefinitionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(attributedefinition.FieldCreatedAt))
+}
+
+// WhereHasValues applies a predicate to check if query has an edge values.
+func (f *AttributeDefinitionFilter) WhereHasValues() {
+	f.Where(entql.HasEdge("values"))
+}
+
+// WhereHasValuesWith applies a predicate to check if query has an edge values with a given conditions (other predicates).
+func (f *AttributeDefinitionFilter) WhereHasValuesWith(preds ...predicate.CarAttribute) {
+	f.Where(entql.HasEdgeWith("values", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (alq *AuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	alq.predicates = append(alq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AuditLogQuery builder.
+func (alq *AuditLogQuery) Filter() *AuditLogFilter {
+	return &AuditLogFilter{config: alq.config, predicateAdder: alq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AuditLogMutation builder.
+func (m *AuditLogMutation) Filter() *AuditLogFilter {
+	return &AuditLogFilter{config: m.config, predicateAdder: m}
+}
+
+// AuditLogFilter provides a generic filtering capability at runtime for AuditLogQuery.
+type AuditLogFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AuditLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *AuditLogFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(auditlog.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *AuditLogFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(auditlog.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *AuditLogFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(auditlog.FieldCarID))
+}
+
+// WhereOp applies the entql string predicate on the op field.
+func (f *AuditLogFilter) WhereOp(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldOp))
+}
+
+// WhereActor applies the entql int64 predicate on the actor field.
+func (f *AuditLogFilter) WhereActor(p entql.Int64P) {
+	f.Where(p.Field(auditlog.FieldActor))
+}
+
+// WhereDiff applies the entql string predicate on the diff field.
+func (f *AuditLogFilter) WhereDiff(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldDiff))
+}
+
+// WhereTraceID applies the entql string predicate on the trace_id field.
+func (f *AuditLogFilter) WhereTraceID(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldTraceID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlog.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (bq *BrandQuery) addPredicate(pred func(s *sql.Selector)) {
+	bq.predicates = append(bq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the BrandQuery builder.
+func (bq *BrandQuery) Filter() *BrandFilter {
+	return &BrandFilter{config: bq.config, predicateAdder: bq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *BrandMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the BrandMutation builder.
+func (m *BrandMutation) Filter() *BrandFilter {
+	return &BrandFilter{config: m.config, predicateAdder: m}
+}
+
+// BrandFilter provides a generic filtering capability at runtime for BrandQuery.
+type BrandFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *BrandFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *BrandFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(brand.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *BrandFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(brand.FieldName))
+}
+
+// WhereHasVehicleModels applies a predicate to check if query has an edge vehicle_models.
+func (f *BrandFilter) WhereHasVehicleModels() {
+	f.Where(entql.HasEdge("vehicle_models"))
+}
+
+// WhereHasVehicleModelsWith applies a predicate to check if query has an edge vehicle_models with a given conditions (other predicates).
+func (f *BrandFilter) WhereHasVehicleModelsWith(preds ...predicate.VehicleModel) {
+	f.Where(entql.HasEdgeWith("vehicle_models", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (cq *CarQuery) addPredicate(pred func(s *sql.Selector)) {
+	cq.predicates = append(cq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CarQuery builder.
+func (cq *CarQuery) Filter() *CarFilter {
+	return &CarFilter{config: cq.config, predicateAdder: cq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CarMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CarMutation builder.
+func (m *CarMutation) Filter() *CarFilter {
+	return &CarFilter{config: m.config, predicateAdder: m}
+}
+
+// CarFilter provides a generic filtering capability at runtime for CarQuery.
+type CarFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CarFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *CarFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(car.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *CarFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(car.FieldTenantID))
+}
+
+// WhereUserID applies the entql int64 predicate on the user_id field.
+func (f *CarFilter) WhereUserID(p entql.Int64P) {
+	f.Where(p.Field(car.FieldUserID))
+}
+
+// WhereModel applies the entql string predicate on the model field.
+func (f *CarFilter) WhereModel(p entql.StringP) {
+	f.Where(p.Field(car.FieldModel))
+}
+
+// WhereModelID applies the entql int64 predicate on the model_id field.
+func (f *CarFilter) WhereModelID(p entql.Int64P) {
+	f.Where(p.Field(car.FieldModelID))
+}
+
+// WhereVin applies the entql string predicate on the vin field.
+func (f *CarFilter) WhereVin(p entql.StringP) {
+	f.Where(p.Field(car.FieldVin))
+}
+
+// WherePlate applies the entql string predicate on the plate field.
+func (f *CarFilter) WherePlate(p entql.StringP) {
+	f.Where(p.Field(car.FieldPlate))
+}
+
+// WhereOwnerName applies the entql string predicate on the owner_name field.
+func (f *CarFilter) WhereOwnerName(p entql.StringP) {
+	f.Where(p.Field(car.FieldOwnerName))
+}
+
+// WhereRegisteredAt applies the entql time.Time predicate on the registered_at field.
+func (f *CarFilter) WhereRegisteredAt(p entql.TimeP) {
+	f.Where(p.Field(car.FieldRegisteredAt))
+}
+
+// WhereBatteryCapacity applies the entql float64 predicate on the battery_capacity field.
+func (f *CarFilter) WhereBatteryCapacity(p entql.Float64P) {
+	f.Where(p.Field(car.FieldBatteryCapacity))
+}
+
+// WhereBatterySoh applies the entql float64 predicate on the battery_soh field.
+func (f *CarFilter) WhereBatterySoh(p entql.Float64P) {
+	f.Where(p.Field(car.FieldBatterySoh))
+}
+
+// WhereHasVehicleModel applies a predicate to check if query has an edge vehicle_model.
+func (f *CarFilter) WhereHasVehicleModel() {
+	f.Where(entql.HasEdge("vehicle_model"))
+}
+
+// WhereHasVehicleModelWith applies a predicate to check if query has an edge vehicle_model with a given conditions (other predicates).
+func (f *CarFilter) WhereHasVehicleModelWith(preds ...predicate.VehicleModel) {
+	f.Where(entql.HasEdgeWith("vehicle_model", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasMaintenanceRecords applies a predicate to check if query has an edge maintenance_records.
+func (f *CarFilter) WhereHasMaintenanceRecords() {
+	f.Where(entql.HasEdge("maintenance_records"))
+}
+
+// WhereHasMaintenanceRecordsWith applies a predicate to check if query has an edge maintenance_records with a given conditions (other predicates).
+func (f *CarFilter) WhereHasMaintenanceRecordsWith(preds ...predicate.MaintenanceRecord) {
+	f.Where(entql.HasEdgeWith("maintenance_records", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInsurancePolicies applies a predicate to check if query has an edge insurance_policies.
+func (f *CarFilter) WhereHasInsurancePolicies() {
+	f.Where(entql.HasEdge("insurance_policies"))
+}
+
+// WhereHasInsurancePoliciesWith applies a predicate to check if query has an edge insurance_policies with a given conditions (other predicates).
+func (f *CarFilter) WhereHasInsurancePoliciesWith(preds ...predicate.InsurancePolicy) {
+	f.Where(entql.HasEdgeWith("insurance_policies", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransfers applies a predicate to check if query has an edge transfers.
+func (f *CarFilter) WhereHasTransfers() {
+	f.Where(entql.HasEdge("transfers"))
+}
+
+// WhereHasTransfersWith applies a predicate to check if query has an edge transfers with a given conditions (other predicates).
+func (f *CarFilter) WhereHasTransfersWith(preds ...predicate.Transfer) {
+	f.Where(entql.HasEdgeWith("transfers", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasOdometerReadings applies a predicate to check if query has an edge odometer_readings.
+func (f *CarFilter) WhereHasOdometerReadings() {
+	f.Where(entql.HasEdge("odometer_readings"))
+}
+
+// WhereHasOdometerReadingsWith applies a predicate to check if query has an edge odometer_readings with a given conditions (other predicates).
+func (f *CarFilter) WhereHasOdometerReadingsWith(preds ...predicate.OdometerReading) {
+	f.Where(entql.HasEdgeWith("odometer_readings", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAttachments applies a predicate to check if query has an edge attachments.
+func (f *CarFilter) WhereHasAttachments() {
+	f.Where(entql.HasEdge("attachments"))
+}
+
+// WhereHasAttachmentsWith applies a predicate to check if query has an edge attachments with a given conditions (other predicates).
+func (f *CarFilter) WhereHasAttachmentsWith(preds ...predicate.Attachment) {
+	f.Where(entql.HasEdgeWith("attachments", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasFleets applies a predicate to check if query has an edge fleets.
+func (f *CarFilter) WhereHasFleets() {
+	f.Where(entql.HasEdge("fleets"))
+}
+
+// WhereHasFleetsWith applies a predicate to check if query has an edge fleets with a given conditions (other predicates).
+func (f *CarFilter) WhereHasFleetsWith(preds ...predicate.Fleet) {
+	f.Where(entql.HasEdgeWith("fleets", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTags applies a predicate to check if query has an edge tags.
+func (f *CarFilter) WhereHasTags() {
+	f.Where(entql.HasEdge("tags"))
+}
+
+// WhereHasTagsWith applies a predicate to check if query has an edge tags with a given conditions (other predicates).
+func (f *CarFilter) WhereHasTagsWith(preds ...predicate.Tag) {
+	f.Where(entql.HasEdgeWith("tags", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAttributes applies a predicate to check if query has an edge attributes.
+func (f *CarFilter) WhereHasAttributes() {
+	f.Where(entql.HasEdge("attributes"))
+}
+
+// WhereHasAttributesWith applies a predicate to check if query has an edge attributes with a given conditions (other predicates).
+func (f *CarFilter) WhereHasAttributesWith(preds ...predicate.CarAttribute) {
+	f.Where(entql.HasEdgeWith("attributes", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasReservations applies a predicate to check if query has an edge reservations.
+func (f *CarFilter) WhereHasReservations() {
+	f.Where(entql.HasEdge("reservations"))
+}
+
+// WhereHasReservationsWith applies a predicate to check if query has an edge reservations with a given conditions (other predicates).
+func (f *CarFilter) WhereHasReservationsWith(preds ...predicate.Reservation) {
+	f.Where(entql.HasEdgeWith("reservations", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTrips applies a predicate to check if query has an edge trips.
+func (f *CarFilter) WhereHasTrips() {
+	f.Where(entql.HasEdge("trips"))
+}
+
+// WhereHasTripsWith applies a predicate to check if query has an edge trips with a given conditions (other predicates).
+func (f *CarFilter) WhereHasTripsWith(preds ...predicate.Trip) {
+	f.Where(entql.HasEdgeWith("trips", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRefuels applies a predicate to check if query has an edge refuels.
+func (f *CarFilter) WhereHasRefuels() {
+	f.Where(entql.HasEdge("refuels"))
+}
+
+// WhereHasRefuelsWith applies a predicate to check if query has an edge refuels with a given conditions (other predicates).
+func (f *CarFilter) WhereHasRefuelsWith(preds ...predicate.Refuel) {
+	f.Where(entql.HasEdgeWith("refuels", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecalls applies a predicate to check if query has an edge recalls.
+func (f *CarFilter) WhereHasRecalls() {
+	f.Where(entql.HasEdge("recalls"))
+}
+
+// WhereHasRecallsWith applies a predicate to check if query has an edge recalls with a given conditions (other predicates).
+func (f *CarFilter) WhereHasRecallsWith(preds ...predicate.CarRecall) {
+	f.Where(entql.HasEdgeWith("recalls", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasOwnerHistories applies a predicate to check if query has an edge owner_histories.
+func (f *CarFilter) WhereHasOwnerHistories() {
+	f.Where(entql.HasEdge("owner_histories"))
+}
+
+// WhereHasOwnerHistoriesWith applies a predicate to check if query has an edge owner_histories with a given conditions (other predicates).
+func (f *CarFilter) WhereHasOwnerHistoriesWith(preds ...predicate.OwnerHistory) {
+	f.Where(entql.HasEdgeWith("owner_histories", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasViolations applies a predicate to check if query has an edge violations.
+func (f *CarFilter) WhereHasViolations() {
+	f.Where(entql.HasEdge("violations"))
+}
+
+// WhereHasViolationsWith applies a predicate to check if query has an edge violations with a given conditions (other predicates).
+func (f *CarFilter) WhereHasViolationsWith(preds ...predicate.Violation) {
+	f.Where(entql.HasEdgeWith("violations", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTradeRecords applies a predicate to check if query has an edge trade_records.
+func (f *CarFilter) WhereHasTradeRecords() {
+	f.Where(entql.HasEdge("trade_records"))
+}
+
+// WhereHasTradeRecordsWith applies a predicate to check if query has an edge trade_records with a given conditions (other predicates).
+func (f *CarFilter) WhereHasTradeRecordsWith(preds ...predicate.TradeRecord) {
+	f.Where(entql.HasEdgeWith("trade_records", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasListings applies a predicate to check if query has an edge listings.
+func (f *CarFilter) WhereHasListings() {
+	f.Where(entql.HasEdge("listings"))
+}
+
+// WhereHasListingsWith applies a predicate to check if query has an edge listings with a given conditions (other predicates).
+func (f *CarFilter) WhereHasListingsWith(preds ...predicate.Listing) {
+	f.Where(entql.HasEdgeWith("listings", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWarranties applies a predicate to check if query has an edge warranties.
+func (f *CarFilter) WhereHasWarranties() {
+	f.Where(entql.HasEdge("warranties"))
+}
+
+// WhereHasWarrantiesWith applies a predicate to check if query has an edge warranties with a given conditions (other predicates).
+func (f *CarFilter) WhereHasWarrantiesWith(preds ...predicate.CarWarranty) {
+	f.Where(entql.HasEdgeWith("warranties", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasIncidents applies a predicate to check if query has an edge incidents.
+func (f *CarFilter) WhereHasIncidents() {
+	f.Where(entql.HasEdge("incidents"))
+}
+
+// WhereHasIncidentsWith applies a predicate to check if query has an edge incidents with a given conditions (other predicates).
+func (f *CarFilter) WhereHasIncidentsWith(preds ...predicate.Incident) {
+	f.Where(entql.HasEdgeWith("incidents", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasLeaseContracts applies a predicate to check if query has an edge lease_contracts.
+func (f *CarFilter) WhereHasLeaseContracts() {
+	f.Where(entql.HasEdge("lease_contracts"))
+}
+
+// WhereHasLeaseContractsWith applies a predicate to check if query has an edge lease_contracts with a given conditions (other predicates).
+func (f *CarFilter) WhereHasLeaseContractsWith(preds ...predicate.LeaseContract) {
+	f.Where(entql.HasEdgeWith("lease_contracts", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasChargingSessions applies a predicate to check if query has an edge charging_sessions.
+func (f *CarFilter) WhereHasChargingSessions() {
+	f.Where(entql.HasEdge("charging_sessions"))
+}
+
+// WhereHasChargingSessionsWith applies a predicate to check if query has an edge charging_sessions with a given conditions (other predicates).
+func (f *CarFilter) WhereHasChargingSessionsWith(preds ...predicate.ChargingSession) {
+	f.Where(entql.HasEdgeWith("charging_sessions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasGeofences applies a predicate to check if query has an edge geofences.
+func (f *CarFilter) WhereHasGeofences() {
+	f.Where(entql.HasEdge("geofences"))
+}
+
+// WhereHasGeofencesWith applies a predicate to check if query has an edge geofences with a given conditions (other predicates).
+func (f *CarFilter) WhereHasGeofencesWith(preds ...predicate.Geofence) {
+	f.Where(entql.HasEdgeWith("geofences", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (caq *CarAttributeQuery) addPredicate(pred func(s *sql.Selector)) {
+	caq.predicates = append(caq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CarAttributeQuery builder.
+func (caq *CarAttributeQuery) Filter() *CarAttributeFilter {
+	return &CarAttributeFilter{config: caq.config, predicateAdder: caq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CarAttributeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CarAttributeMutation builder.
+func (m *CarAttributeMutation) Filter() *CarAttributeFilter {
+	return &CarAttributeFilter{config: m.config, predicateAdder: m}
+}
+
+// CarAttributeFilter provides a generic filtering capability at runtime for CarAttributeQuery.
+type CarAttributeFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CarAttributeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *CarAttributeFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(carattribute.FieldID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *CarAttributeFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(carattribute.FieldCarID))
+}
+
+// WhereDefinitionID applies the entql int64 predicate on the definition_id field.
+func (f *CarAttributeFilter) WhereDefinitionID(p entql.Int64P) {
+	f.Where(p.Field(carattribute.FieldDefinitionID))
+}
+
+// WhereValue applies the entql string predicate on the value field.
+func (f *CarAttributeFilter) WhereValue(p entql.StringP) {
+	f.Where(p.Field(carattribute.FieldValue))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *CarAttributeFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *CarAttributeFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDefinition applies a predicate to check if query has an edge definition.
+func (f *CarAttributeFilter) WhereHasDefinition() {
+	f.Where(entql.HasEdge("definition"))
+}
+
+// WhereHasDefinitionWith applies a predicate to check if query has an edge definition with a given conditions (other predicates).
+func (f *CarAttributeFilter) WhereHasDefinitionWith(preds ...predicate.AttributeDefinition) {
+	f.Where(entql.HasEdgeWith("definition", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (crq *CarRecallQuery) addPredicate(pred func(s *sql.Selector)) {
+	crq.predicates = append(crq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CarRecallQuery builder.
+func (crq *CarRecallQuery) Filter() *CarRecallFilter {
+	return &CarRecallFilter{config: crq.config, predicateAdder: crq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CarRecallMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CarRecallMutation builder.
+func (m *CarRecallMutation) Filter() *CarRecallFilter {
+	return &CarRecallFilter{config: m.config, predicateAdder: m}
+}
+
+// CarRecallFilter provides a generic filtering capability at runtime for CarRecallQuery.
+type CarRecallFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CarRecallFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *CarRecallFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(carrecall.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *CarRecallFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(carrecall.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *CarRecallFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(carrecall.FieldCarID))
+}
+
+// WhereRecallID applies the entql int64 predicate on the recall_id field.
+func (f *CarRecallFilter) WhereRecallID(p entql.Int64P) {
+	f.Where(p.Field(carrecall.FieldRecallID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *CarRecallFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(carrecall.FieldStatus))
+}
+
+// WhereNotes applies the entql string predicate on the notes field.
+func (f *CarRecallFilter) WhereNotes(p entql.StringP) {
+	f.Where(p.Field(carrecall.FieldNotes))
+}
+
+// WhereRemediedAt applies the entql time.Time predicate on the remedied_at field.
+func (f *CarRecallFilter) WhereRemediedAt(p entql.TimeP) {
+	f.Where(p.Field(carrecall.FieldRemediedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *CarRecallFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(carrecall.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *CarRecallFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(carrecall.FieldUpdatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *CarRecallFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *CarRecallFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecall applies a predicate to check if query has an edge recall.
+func (f *CarRecallFilter) WhereHasRecall() {
+	f.Where(entql.HasEdge("recall"))
+}
+
+// WhereHasRecallWith applies a predicate to check if query has an edge recall with a given conditions (other predicates).
+func (f *CarRecallFilter) WhereHasRecallWith(preds ...predicate.Recall) {
+	f.Where(entql.HasEdgeWith("recall", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (cwq *CarWarrantyQuery) addPredicate(pred func(s *sql.Selector)) {
+	cwq.predicates = append(cwq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CarWarrantyQuery builder.
+func (cwq *CarWarrantyQuery) Filter() *CarWarrantyFilter {
+	return &CarWarrantyFilter{config: cwq.config, predicateAdder: cwq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CarWarrantyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CarWarrantyMutation builder.
+func (m *CarWarrantyMutation) Filter() *CarWarrantyFilter {
+	return &CarWarrantyFilter{config: m.config, predicateAdder: m}
+}
+
+// CarWarrantyFilter provides a generic filtering capability at runtime for CarWarrantyQuery.
+type CarWarrantyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CarWarrantyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *CarWarrantyFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(carwarranty.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *CarWarrantyFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(carwarranty.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *CarWarrantyFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(carwarranty.FieldCarID))
+}
+
+// WhereDefinitionID applies the entql int64 predicate on the definition_id field.
+func (f *CarWarrantyFilter) WhereDefinitionID(p entql.Int64P) {
+	f.Where(p.Field(carwarranty.FieldDefinitionID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *CarWarrantyFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(carwarranty.FieldName))
+}
+
+// WhereComponents applies the entql string predicate on the components field.
+func (f *CarWarrantyFilter) WhereComponents(p entql.StringP) {
+	f.Where(p.Field(carwarranty.FieldComponents))
+}
+
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *CarWarrantyFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(carwarranty.FieldStartAt))
+}
+
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *CarWarrantyFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(carwarranty.FieldEndAt))
+}
+
+// WhereMileageLimit applies the entql int64 predicate on the mileage_limit field.
+func (f *CarWarrantyFilter) WhereMileageLimit(p entql.Int64P) {
+	f.Where(p.Field(carwarranty.FieldMileageLimit))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *CarWarrantyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(carwarranty.FieldCreatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *CarWarrantyFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *CarWarrantyFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDefinition applies a predicate to check if query has an edge definition.
+func (f *CarWarrantyFilter) WhereHasDefinition() {
+	f.Where(entql.HasEdge("definition"))
+}
+
+// WhereHasDefinitionWith applies a predicate to check if query has an edge definition with a given conditions (other predicates).
+func (f *CarWarrantyFilter) WhereHasDefinitionWith(preds ...predicate.WarrantyDefinition) {
+	f.Where(entql.HasEdgeWith("definition", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (csq *ChargingSessionQuery) addPredicate(pred func(s *sql.Selector)) {
+	csq.predicates = append(csq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ChargingSessionQuery builder.
+func (csq *ChargingSessionQuery) Filter() *ChargingSessionFilter {
+	return &ChargingSessionFilter{config: csq.config, predicateAdder: csq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ChargingSessionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ChargingSessionMutation builder.
+func (m *ChargingSessionMutation) Filter() *ChargingSessionFilter {
+	return &ChargingSessionFilter{config: m.config, predicateAdder: m}
+}
+
+// ChargingSessionFilter provides a generic filtering capability at runtime for ChargingSessionQuery.
+type ChargingSessionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ChargingSessionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *ChargingSessionFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(chargingsession.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *ChargingSessionFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(chargingsession.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *ChargingSessionFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(chargingsession.FieldCarID))
+}
+
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *ChargingSessionFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(chargingsession.FieldStartedAt))
+}
+
+// WhereEndedAt applies the entql time.Time predicate on the ended_at field.
+func (f *ChargingSessionFilter) WhereEndedAt(p entql.TimeP) {
+	f.Where(p.Field(chargingsession.FieldEndedAt))
+}
+
+// WhereEnergy applies the entql float64 predicate on the energy field.
+func (f *ChargingSessionFilter) WhereEnergy(p entql.Float64P) {
+	f.Where(p.Field(chargingsession.FieldEnergy))
+}
+
+// WhereChargerType applies the entql string predicate on the charger_type field.
+func (f *ChargingSessionFilter) WhereChargerType(p entql.StringP) {
+	f.Where(p.Field(chargingsession.FieldChargerType))
+}
+
+// WhereCost applies the entql float64 predicate on the cost field.
+func (f *ChargingSessionFilter) WhereCost(p entql.Float64P) {
+	f.Where(p.Field(chargingsession.FieldCost))
+}
+
+// WhereStation applies the entql string predicate on the station field.
+func (f *ChargingSessionFilter) WhereStation(p entql.StringP) {
+	f.Where(p.Field(chargingsession.FieldStation))
+}
+
+// WhereStartSoc applies the entql float64 predicate on the start_soc field.
+func (f *ChargingSessionFilter) WhereStartSoc(p entql.Float64P) {
+	f.Where(p.Field(chargingsession.FieldStartSoc))
+}
+
+// WhereEndSoc applies the entql float64 predicate on the end_soc field.
+func (f *ChargingSessionFilter) WhereEndSoc(p entql.Float64P) {
+	f.Where(p.Field(chargingsession.FieldEndSoc))
+}
+
+// WhereSoh applies the entql float64 predicate on the soh field.
+func (f *ChargingSessionFilter) WhereSoh(p entql.Float64P) {
+	f.Where(p.Field(chargingsession.FieldSoh))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ChargingSessionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(chargingsession.FieldCreatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *ChargingSessionFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *ChargingSessionFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (fq *FleetQuery) addPredicate(pred func(s *sql.Selector)) {
+	fq.predicates = append(fq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the FleetQuery builder.
+func (fq *FleetQuery) Filter() *FleetFilter {
+	return &FleetFilter{config: fq.config, predicateAdder: fq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *FleetMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the FleetMutation builder.
+func (m *FleetMutation) Filter() *FleetFilter {
+	return &FleetFilter{config: m.config, predicateAdder: m}
+}
+
+// FleetFilter provides a generic filtering capability at runtime for FleetQuery.
+type FleetFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *FleetFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *FleetFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(fleet.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *FleetFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(fleet.FieldTenantID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *FleetFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(fleet.FieldName))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *FleetFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(fleet.FieldDescription))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *FleetFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(fleet.FieldCreatedAt))
+}
+
+// WhereHasCars applies a predicate to check if query has an edge cars.
+func (f *FleetFilter) WhereHasCars() {
+	f.Where(entql.HasEdge("cars"))
+}
+
+// WhereHasCarsWith applies a predicate to check if query has an edge cars with a given conditions (other predicates).
+func (f *FleetFilter) WhereHasCarsWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("cars", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (gq *GeofenceQuery) addPredicate(pred func(s *sql.Selector)) {
+	gq.predicates = append(gq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the GeofenceQuery builder.
+func (gq *GeofenceQuery) Filter() *GeofenceFilter {
+	return &GeofenceFilter{config: gq.config, predicateAdder: gq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *GeofenceMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the GeofenceMutation builder.
+func (m *GeofenceMutation) Filter() *GeofenceFilter {
+	return &GeofenceFilter{config: m.config, predicateAdder: m}
+}
+
+// GeofenceFilter provides a generic filtering capability at runtime for GeofenceQuery.
+type GeofenceFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *GeofenceFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *GeofenceFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(geofence.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *GeofenceFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(geofence.FieldTenantID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *GeofenceFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(geofence.FieldName))
+}
+
+// WherePolygon applies the entql string predicate on the polygon field.
+func (f *GeofenceFilter) WherePolygon(p entql.StringP) {
+	f.Where(p.Field(geofence.FieldPolygon))
+}
+
+// WhereDays applies the entql string predicate on the days field.
+func (f *GeofenceFilter) WhereDays(p entql.StringP) {
+	f.Where(p.Field(geofence.FieldDays))
+}
+
+// WhereStartTime applies the entql string predicate on the start_time field.
+func (f *GeofenceFilter) WhereStartTime(p entql.StringP) {
+	f.Where(p.Field(geofence.FieldStartTime))
+}
+
+// WhereEndTime applies the entql string predicate on the end_time field.
+func (f *GeofenceFilter) WhereEndTime(p entql.StringP) {
+	f.Where(p.Field(geofence.FieldEndTime))
+}
+
+// WhereTimeZone applies the entql string predicate on the time_zone field.
+func (f *GeofenceFilter) WhereTimeZone(p entql.StringP) {
+	f.Where(p.Field(geofence.FieldTimeZone))
+}
+
+// WhereAlertOn applies the entql string predicate on the alert_on field.
+func (f *GeofenceFilter) WhereAlertOn(p entql.StringP) {
+	f.Where(p.Field(geofence.FieldAlertOn))
+}
+
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *GeofenceFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(geofence.FieldEnabled))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *GeofenceFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(geofence.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *GeofenceFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(geofence.FieldUpdatedAt))
+}
+
+// WhereHasCars applies a predicate to check if query has an edge cars.
+func (f *GeofenceFilter) WhereHasCars() {
+	f.Where(entql.HasEdge("cars"))
+}
+
+// WhereHasCarsWith applies a predicate to check if query has an edge cars with a given conditions (other predicates).
+func (f *GeofenceFilter) WhereHasCarsWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("cars", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (iq *IncidentQuery) addPredicate(pred func(s *sql.Selector)) {
+	iq.predicates = append(iq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the IncidentQuery builder.
+func (iq *IncidentQuery) Filter() *IncidentFilter {
+	return &IncidentFilter{config: iq.config, predicateAdder: iq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *IncidentMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the IncidentMutation builder.
+func (m *IncidentMutation) Filter() *IncidentFilter {
+	return &IncidentFilter{config: m.config, predicateAdder: m}
+}
+
+// IncidentFilter provides a generic filtering capability at runtime for IncidentQuery.
+type IncidentFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *IncidentFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *IncidentFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(incident.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *IncidentFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(incident.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *IncidentFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(incident.FieldCarID))
+}
+
+// WhereReporterID applies the entql int64 predicate on the reporter_id field.
+func (f *IncidentFilter) WhereReporterID(p entql.Int64P) {
+	f.Where(p.Field(incident.FieldReporterID))
+}
+
+// WhereOccurredAt applies the entql time.Time predicate on the occurred_at field.
+func (f *IncidentFilter) WhereOccurredAt(p entql.TimeP) {
+	f.Where(p.Field(incident.FieldOccurredAt))
+}
+
+// WhereLocation applies the entql string predicate on the location field.
+func (f *IncidentFilter) WhereLocation(p entql.StringP) {
+	f.Where(p.Field(incident.FieldLocation))
+}
+
+// WhereSeverity applies the entql string predicate on the severity field.
+func (f *IncidentFilter) WhereSeverity(p entql.StringP) {
+	f.Where(p.Field(incident.FieldSeverity))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *IncidentFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(incident.FieldDescription))
+}
+
+// WherePhotoIds applies the entql string predicate on the photo_ids field.
+func (f *IncidentFilter) WherePhotoIds(p entql.StringP) {
+	f.Where(p.Field(incident.FieldPhotoIds))
+}
+
+// WhereThirdParties applies the entql string predicate on the third_parties field.
+func (f *IncidentFilter) WhereThirdParties(p entql.StringP) {
+	f.Where(p.Field(incident.FieldThirdParties))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *IncidentFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(incident.FieldStatus))
+}
+
+// WherePolicyID applies the entql int64 predicate on the policy_id field.
+func (f *IncidentFilter) WherePolicyID(p entql.Int64P) {
+	f.Where(p.Field(incident.FieldPolicyID))
+}
+
+// WhereClaimNo applies the entql string predicate on the claim_no field.
+func (f *IncidentFilter) WhereClaimNo(p entql.StringP) {
+	f.Where(p.Field(incident.FieldClaimNo))
+}
+
+// WhereClaimAmount applies the entql float64 predicate on the claim_amount field.
+func (f *IncidentFilter) WhereClaimAmount(p entql.Float64P) {
+	f.Where(p.Field(incident.FieldClaimAmount))
+}
+
+// WhereSettledAmount applies the entql float64 predicate on the settled_amount field.
+func (f *IncidentFilter) WhereSettledAmount(p entql.Float64P) {
+	f.Where(p.Field(incident.FieldSettledAmount))
+}
+
+// WhereSettledAt applies the entql time.Time predicate on the settled_at field.
+func (f *IncidentFilter) WhereSettledAt(p entql.TimeP) {
+	f.Where(p.Field(incident.FieldSettledAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *IncidentFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(incident.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *IncidentFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(incident.FieldUpdatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *IncidentFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *IncidentFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPolicy applies a predicate to check if query has an edge policy.
+func (f *IncidentFilter) WhereHasPolicy() {
+	f.Where(entql.HasEdge("policy"))
+}
+
+// WhereHasPolicyWith applies a predicate to check if query has an edge policy with a given conditions (other predicates).
+func (f *IncidentFilter) WhereHasPolicyWith(preds ...predicate.InsurancePolicy) {
+	f.Where(entql.HasEdgeWith("policy", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (ipq *InsurancePolicyQuery) addPredicate(pred func(s *sql.Selector)) {
+	ipq.predicates = append(ipq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the InsurancePolicyQuery builder.
+func (ipq *InsurancePolicyQuery) Filter() *InsurancePolicyFilter {
+	return &InsurancePolicyFilter{config: ipq.config, predicateAdder: ipq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *InsurancePolicyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the InsurancePolicyMutation builder.
+func (m *InsurancePolicyMutation) Filter() *InsurancePolicyFilter {
+	return &InsurancePolicyFilter{config: m.config, predicateAdder: m}
+}
+
+// InsurancePolicyFilter provides a generic filtering capability at runtime for InsurancePolicyQuery.
+type InsurancePolicyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *InsurancePolicyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *InsurancePolicyFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(insurancepolicy.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *InsurancePolicyFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(insurancepolicy.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *InsurancePolicyFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(insurancepolicy.FieldCarID))
+}
+
+// WhereProvider applies the entql string predicate on the provider field.
+func (f *InsurancePolicyFilter) WhereProvider(p entql.StringP) {
+	f.Where(p.Field(insurancepolicy.FieldProvider))
+}
+
+// WherePolicyNumber applies the entql string predicate on the policy_number field.
+func (f *InsurancePolicyFilter) WherePolicyNumber(p entql.StringP) {
+	f.Where(p.Field(insurancepolicy.FieldPolicyNumber))
+}
+
+// WhereCoverage applies the entql string predicate on the coverage field.
+func (f *InsurancePolicyFilter) WhereCoverage(p entql.StringP) {
+	f.Where(p.Field(insurancepolicy.FieldCoverage))
+}
+
+// WhereStartDate applies the entql time.Time predicate on the start_date field.
+func (f *InsurancePolicyFilter) WhereStartDate(p entql.TimeP) {
+	f.Where(p.Field(insurancepolicy.FieldStartDate))
+}
+
+// WhereEndDate applies the entql time.Time predicate on the end_date field.
+func (f *InsurancePolicyFilter) WhereEndDate(p entql.TimeP) {
+	f.Where(p.Field(insurancepolicy.FieldEndDate))
+}
+
+// WherePremium applies the entql float64 predicate on the premium field.
+func (f *InsurancePolicyFilter) WherePremium(p entql.Float64P) {
+	f.Where(p.Field(insurancepolicy.FieldPremium))
+}
+
+// WhereRemindedAt applies the entql time.Time predicate on the reminded_at field.
+func (f *InsurancePolicyFilter) WhereRemindedAt(p entql.TimeP) {
+	f.Where(p.Field(insurancepolicy.FieldRemindedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *InsurancePolicyFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *InsurancePolicyFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasIncidents applies a predicate to check if query has an edge incidents.
+func (f *InsurancePolicyFilter) WhereHasIncidents() {
+	f.Where(entql.HasEdge("incidents"))
+}
+
+// WhereHasIncidentsWith applies a predicate to check if query has an edge incidents with a given conditions (other predicates).
+func (f *InsurancePolicyFilter) WhereHasIncidentsWith(preds ...predicate.Incident) {
+	f.Where(entql.HasEdgeWith("incidents", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (jrq *JobRunQuery) addPredicate(pred func(s *sql.Selector)) {
+	jrq.predicates = append(jrq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the JobRunQuery builder.
+func (jrq *JobRunQuery) Filter() *JobRunFilter {
+	return &JobRunFilter{config: jrq.config, predicateAdder: jrq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *JobRunMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the JobRunMutation builder.
+func (m *JobRunMutation) Filter() *JobRunFilter {
+	return &JobRunFilter{config: m.config, predicateAdder: m}
+}
+
+// JobRunFilter provides a generic filtering capability at runtime for JobRunQuery.
+type JobRunFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *JobRunFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *JobRunFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(jobrun.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *JobRunFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(jobrun.FieldName))
+}
+
+// WhereScheduledAt applies the entql time.Time predicate on the scheduled_at field.
+func (f *JobRunFilter) WhereScheduledAt(p entql.TimeP) {
+	f.Where(p.Field(jobrun.FieldScheduledAt))
+}
+
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *JobRunFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(jobrun.FieldStartedAt))
+}
+
+// WhereFinishedAt applies the entql time.Time predicate on the finished_at field.
+func (f *JobRunFilter) WhereFinishedAt(p entql.TimeP) {
+	f.Where(p.Field(jobrun.FieldFinishedAt))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *JobRunFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(jobrun.FieldStatus))
+}
+
+// WhereError applies the entql string predicate on the error field.
+func (f *JobRunFilter) WhereError(p entql.StringP) {
+	f.Where(p.Field(jobrun.FieldError))
+}
+
+// WhereInstance applies the entql string predicate on the instance field.
+func (f *JobRunFilter) WhereInstance(p entql.StringP) {
+	f.Where(p.Field(jobrun.FieldInstance))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *JobRunFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(jobrun.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (lcq *LeaseContractQuery) addPredicate(pred func(s *sql.Selector)) {
+	lcq.predicates = append(lcq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LeaseContractQuery builder.
+func (lcq *LeaseContractQuery) Filter() *LeaseContractFilter {
+	return &LeaseContractFilter{config: lcq.config, predicateAdder: lcq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LeaseContractMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LeaseContractMutation builder.
+func (m *LeaseContractMutation) Filter() *LeaseContractFilter {
+	return &LeaseContractFilter{config: m.config, predicateAdder: m}
+}
+
+// LeaseContractFilter provides a generic filtering capability at runtime for LeaseContractQuery.
+type LeaseContractFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LeaseContractFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *LeaseContractFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(leasecontract.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *LeaseContractFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(leasecontract.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *LeaseContractFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(leasecontract.FieldCarID))
+}
+
+// WhereLessor applies the entql string predicate on the lessor field.
+func (f *LeaseContractFilter) WhereLessor(p entql.StringP) {
+	f.Where(p.Field(leasecontract.FieldLessor))
+}
+
+// WhereContractNo applies the entql string predicate on the contract_no field.
+func (f *LeaseContractFilter) WhereContractNo(p entql.StringP) {
+	f.Where(p.Field(leasecontract.FieldContractNo))
+}
+
+// WhereLesseeID applies the entql int64 predicate on the lessee_id field.
+func (f *LeaseContractFilter) WhereLesseeID(p entql.Int64P) {
+	f.Where(p.Field(leasecontract.FieldLesseeID))
+}
+
+// WhereStartDate applies the entql time.Time predicate on the start_date field.
+func (f *LeaseContractFilter) WhereStartDate(p entql.TimeP) {
+	f.Where(p.Field(leasecontract.FieldStartDate))
+}
+
+// WhereEndDate applies the entql time.Time predicate on the end_date field.
+func (f *LeaseContractFilter) WhereEndDate(p entql.TimeP) {
+	f.Where(p.Field(leasecontract.FieldEndDate))
+}
+
+// WhereMonthlyPayment applies the entql float64 predicate on the monthly_payment field.
+func (f *LeaseContractFilter) WhereMonthlyPayment(p entql.Float64P) {
+	f.Where(p.Field(leasecontract.FieldMonthlyPayment))
+}
+
+// WhereMileageAllowance applies the entql int64 predicate on the mileage_allowance field.
+func (f *LeaseContractFilter) WhereMileageAllowance(p entql.Int64P) {
+	f.Where(p.Field(leasecontract.FieldMileageAllowance))
+}
+
+// WhereExcessRate applies the entql float64 predicate on the excess_rate field.
+func (f *LeaseContractFilter) WhereExcessRate(p entql.Float64P) {
+	f.Where(p.Field(leasecontract.FieldExcessRate))
+}
+
+// WhereStartMileage applies the entql int64 predicate on the start_mileage field.
+func (f *LeaseContractFilter) WhereStartMileage(p entql.Int64P) {
+	f.Where(p.Field(leasecontract.FieldStartMileage))
+}
+
+// WhereTransferAllowed applies the entql bool predicate on the transfer_allowed field.
+func (f *LeaseContractFilter) WhereTransferAllowed(p entql.BoolP) {
+	f.Where(p.Field(leasecontract.FieldTransferAllowed))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *LeaseContractFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(leasecontract.FieldStatus))
+}
+
+// WhereTerminatedAt applies the entql time.Time predicate on the terminated_at field.
+func (f *LeaseContractFilter) WhereTerminatedAt(p entql.TimeP) {
+	f.Where(p.Field(leasecontract.FieldTerminatedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LeaseContractFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(leasecontract.FieldCreatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *LeaseContractFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *LeaseContractFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (lq *ListingQuery) addPredicate(pred func(s *sql.Selector)) {
+	lq.predicates = append(lq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ListingQuery builder.
+func (lq *ListingQuery) Filter() *ListingFilter {
+	return &ListingFilter{config: lq.config, predicateAdder: lq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ListingMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ListingMutation builder.
+func (m *ListingMutation) Filter() *ListingFilter {
+	return &ListingFilter{config: m.config, predicateAdder: m}
+}
+
+// ListingFilter provides a generic filtering capability at runtime for ListingQuery.
+type ListingFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ListingFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *ListingFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(listing.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *ListingFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(listing.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *ListingFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(listing.FieldCarID))
+}
+
+// WhereSellerID applies the entql int64 predicate on the seller_id field.
+func (f *ListingFilter) WhereSellerID(p entql.Int64P) {
+	f.Where(p.Field(listing.FieldSellerID))
+}
+
+// WherePrice applies the entql float64 predicate on the price field.
+func (f *ListingFilter) WherePrice(p entql.Float64P) {
+	f.Where(p.Field(listing.FieldPrice))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *ListingFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(listing.FieldDescription))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *ListingFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(listing.FieldStatus))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *ListingFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(listing.FieldExpiresAt))
+}
+
+// WhereBuyerID applies the entql int64 predicate on the buyer_id field.
+func (f *ListingFilter) WhereBuyerID(p entql.Int64P) {
+	f.Where(p.Field(listing.FieldBuyerID))
+}
+
+// WhereClosedAt applies the entql time.Time predicate on the closed_at field.
+func (f *ListingFilter) WhereClosedAt(p entql.TimeP) {
+	f.Where(p.Field(listing.FieldClosedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ListingFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(listing.FieldCreatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *ListingFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *ListingFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (mrq *MaintenanceRecordQuery) addPredicate(pred func(s *sql.Selector)) {
+	mrq.predicates = append(mrq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MaintenanceRecordQuery builder.
+func (mrq *MaintenanceRecordQuery) Filter() *MaintenanceRecordFilter {
+	return &MaintenanceRecordFilter{config: mrq.config, predicateAdder: mrq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MaintenanceRecordMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MaintenanceRecordMutation builder.
+func (m *MaintenanceRecordMutation) Filter() *MaintenanceRecordFilter {
+	return &MaintenanceRecordFilter{config: m.config, predicateAdder: m}
+}
+
+// MaintenanceRecordFilter provides a generic filtering capability at runtime for MaintenanceRecordQuery.
+type MaintenanceRecordFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MaintenanceRecordFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *MaintenanceRecordFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(maintenancerecord.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *MaintenanceRecordFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(maintenancerecord.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *MaintenanceRecordFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(maintenancerecord.FieldCarID))
+}
+
+// WhereServicedAt applies the entql time.Time predicate on the serviced_at field.
+func (f *MaintenanceRecordFilter) WhereServicedAt(p entql.TimeP) {
+	f.Where(p.Field(maintenancerecord.FieldServicedAt))
+}
+
+// WhereMileage applies the entql int64 predicate on the mileage field.
+func (f *MaintenanceRecordFilter) WhereMileage(p entql.Int64P) {
+	f.Where(p.Field(maintenancerecord.FieldMileage))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *MaintenanceRecordFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(maintenancerecord.FieldType))
+}
+
+// WhereCost applies the entql float64 predicate on the cost field.
+func (f *MaintenanceRecordFilter) WhereCost(p entql.Float64P) {
+	f.Where(p.Field(maintenancerecord.FieldCost))
+}
+
+// WhereShop applies the entql string predicate on the shop field.
+func (f *MaintenanceRecordFilter) WhereShop(p entql.StringP) {
+	f.Where(p.Field(maintenancerecord.FieldShop))
+}
+
+// WhereNotes applies the entql string predicate on the notes field.
+func (f *MaintenanceRecordFilter) WhereNotes(p entql.StringP) {
+	f.Where(p.Field(maintenancerecord.FieldNotes))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *MaintenanceRecordFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *MaintenanceRecordFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (orq *OdometerReadingQuery) addPredicate(pred func(s *sql.Selector)) {
+	orq.predicates = append(orq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OdometerReadingQuery builder.
+func (orq *OdometerReadingQuery) Filter() *OdometerReadingFilter {
+	return &OdometerReadingFilter{config: orq.config, predicateAdder: orq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OdometerReadingMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OdometerReadingMutation builder.
+func (m *OdometerReadingMutation) Filter() *OdometerReadingFilter {
+	return &OdometerReadingFilter{config: m.config, predicateAdder: m}
+}
+
+// OdometerReadingFilter provides a generic filtering capability at runtime for OdometerReadingQuery.
+type OdometerReadingFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OdometerReadingFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *OdometerReadingFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(odometerreading.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *OdometerReadingFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(odometerreading.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *OdometerReadingFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(odometerreading.FieldCarID))
+}
+
+// WhereMileage applies the entql int64 predicate on the mileage field.
+func (f *OdometerReadingFilter) WhereMileage(p entql.Int64P) {
+	f.Where(p.Field(odometerreading.FieldMileage))
+}
+
+// WhereSource applies the entql string predicate on the source field.
+func (f *OdometerReadingFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(odometerreading.FieldSource))
+}
+
+// WhereSuspicious applies the entql bool predicate on the suspicious field.
+func (f *OdometerReadingFilter) WhereSuspicious(p entql.BoolP) {
+	f.Where(p.Field(odometerreading.FieldSuspicious))
+}
+
+// WhereRecordedAt applies the entql time.Time predicate on the recorded_at field.
+func (f *OdometerReadingFilter) WhereRecordedAt(p entql.TimeP) {
+	f.Where(p.Field(odometerreading.FieldRecordedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *OdometerReadingFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *OdometerReadingFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (ohq *OwnerHistoryQuery) addPredicate(pred func(s *sql.Selector)) {
+	ohq.predicates = append(ohq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OwnerHistoryQuery builder.
+func (ohq *OwnerHistoryQuery) Filter() *OwnerHistoryFilter {
+	return &OwnerHistoryFilter{config: ohq.config, predicateAdder: ohq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OwnerHistoryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OwnerHistoryMutation builder.
+func (m *OwnerHistoryMutation) Filter() *OwnerHistoryFilter {
+	return &OwnerHistoryFilter{config: m.config, predicateAdder: m}
+}
+
+// OwnerHistoryFilter provides a generic filtering capability at runtime for OwnerHistoryQuery.
+type OwnerHistoryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OwnerHistoryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *OwnerHistoryFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(ownerhistory.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *OwnerHistoryFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(ownerhistory.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *OwnerHistoryFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(ownerhistory.FieldCarID))
+}
+
+// WhereUserID applies the entql int64 predicate on the user_id field.
+func (f *OwnerHistoryFilter) WhereUserID(p entql.Int64P) {
+	f.Where(p.Field(ownerhistory.FieldUserID))
+}
+
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *OwnerHistoryFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(ownerhistory.FieldStartedAt))
+}
+
+// WhereEndedAt applies the entql time.Time predicate on the ended_at field.
+func (f *OwnerHistoryFilter) WhereEndedAt(p entql.TimeP) {
+	f.Where(p.Field(ownerhistory.FieldEndedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *OwnerHistoryFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *OwnerHistoryFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rq *RecallQuery) addPredicate(pred func(s *sql.Selector)) {
+	rq.predicates = append(rq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RecallQuery builder.
+func (rq *RecallQuery) Filter() *RecallFilter {
+	return &RecallFilter{config: rq.config, predicateAdder: rq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RecallMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RecallMutation builder.
+func (m *RecallMutation) Filter() *RecallFilter {
+	return &RecallFilter{config: m.config, predicateAdder: m}
+}
+
+// RecallFilter provides a generic filtering capability at runtime for RecallQuery.
+type RecallFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RecallFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *RecallFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(recall.FieldID))
+}
+
+// WhereCampaignNo applies the entql string predicate on the campaign_no field.
+func (f *RecallFilter) WhereCampaignNo(p entql.StringP) {
+	f.Where(p.Field(recall.FieldCampaignNo))
+}
+
+// WhereManufacturer applies the entql string predicate on the manufacturer field.
+func (f *RecallFilter) WhereManufacturer(p entql.StringP) {
+	f.Where(p.Field(recall.FieldManufacturer))
+}
+
+// WhereModelID applies the entql int64 predicate on the model_id field.
+func (f *RecallFilter) WhereModelID(p entql.Int64P) {
+	f.Where(p.Field(recall.FieldModelID))
+}
+
+// WhereModel applies the entql string predicate on the model field.
+func (f *RecallFilter) WhereModel(p entql.StringP) {
+	f.Where(p.Field(recall.FieldModel))
+}
+
+// WhereYearFrom applies the entql int predicate on the year_from field.
+func (f *RecallFilter) WhereYearFrom(p entql.IntP) {
+	f.Where(p.Field(recall.FieldYearFrom))
+}
+
+// WhereYearTo applies the entql int predicate on the year_to field.
+func (f *RecallFilter) WhereYearTo(p entql.IntP) {
+	f.Where(p.Field(recall.FieldYearTo))
+}
+
+// WhereVinFrom applies the entql string predicate on the vin_from field.
+func (f *RecallFilter) WhereVinFrom(p entql.StringP) {
+	f.Where(p.Field(recall.FieldVinFrom))
+}
+
+// WhereVinTo applies the entql string predicate on the vin_to field.
+func (f *RecallFilter) WhereVinTo(p entql.StringP) {
+	f.Where(p.Field(recall.FieldVinTo))
+}
+
+// WhereComponent applies the entql string predicate on the component field.
+func (f *RecallFilter) WhereComponent(p entql.StringP) {
+	f.Where(p.Field(recall.FieldComponent))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *RecallFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(recall.FieldDescription))
+}
+
+// WhereRemedy applies the entql string predicate on the remedy field.
+func (f *RecallFilter) WhereRemedy(p entql.StringP) {
+	f.Where(p.Field(recall.FieldRemedy))
+}
+
+// WhereIssuedAt applies the entql time.Time predicate on the issued_at field.
+func (f *RecallFilter) WhereIssuedAt(p entql.TimeP) {
+	f.Where(p.Field(recall.FieldIssuedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RecallFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(recall.FieldCreatedAt))
+}
+
+// WhereHasCarRecalls applies a predicate to check if query has an edge car_recalls.
+func (f *RecallFilter) WhereHasCarRecalls() {
+	f.Where(entql.HasEdge("car_recalls"))
+}
+
+// WhereHasCarRecallsWith applies a predicate to check if query has an edge car_recalls with a given conditions (other predicates).
+func (f *RecallFilter) WhereHasCarRecallsWith(preds ...predicate.CarRecall) {
+	f.Where(entql.HasEdgeWith("car_recalls", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rq *RefuelQuery) addPredicate(pred func(s *sql.Selector)) {
+	rq.predicates = append(rq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RefuelQuery builder.
+func (rq *RefuelQuery) Filter() *RefuelFilter {
+	return &RefuelFilter{config: rq.config, predicateAdder: rq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RefuelMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RefuelMutation builder.
+func (m *RefuelMutation) Filter() *RefuelFilter {
+	return &RefuelFilter{config: m.config, predicateAdder: m}
+}
+
+// RefuelFilter provides a generic filtering capability at runtime for RefuelQuery.
+type RefuelFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RefuelFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *RefuelFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(refuel.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *RefuelFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(refuel.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *RefuelFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(refuel.FieldCarID))
+}
+
+// WhereAmount applies the entql float64 predicate on the amount field.
+func (f *RefuelFilter) WhereAmount(p entql.Float64P) {
+	f.Where(p.Field(refuel.FieldAmount))
+}
+
+// WhereUnit applies the entql string predicate on the unit field.
+func (f *RefuelFilter) WhereUnit(p entql.StringP) {
+	f.Where(p.Field(refuel.FieldUnit))
+}
+
+// WhereCost applies the entql float64 predicate on the cost field.
+func (f *RefuelFilter) WhereCost(p entql.Float64P) {
+	f.Where(p.Field(refuel.FieldCost))
+}
+
+// WhereStation applies the entql string predicate on the station field.
+func (f *RefuelFilter) WhereStation(p entql.StringP) {
+	f.Where(p.Field(refuel.FieldStation))
+}
+
+// WhereOdometer applies the entql int64 predicate on the odometer field.
+func (f *RefuelFilter) WhereOdometer(p entql.Int64P) {
+	f.Where(p.Field(refuel.FieldOdometer))
+}
+
+// WhereRefueledAt applies the entql time.Time predicate on the refueled_at field.
+func (f *RefuelFilter) WhereRefueledAt(p entql.TimeP) {
+	f.Where(p.Field(refuel.FieldRefueledAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *RefuelFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *RefuelFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rq *ReservationQuery) addPredicate(pred func(s *sql.Selector)) {
+	rq.predicates = append(rq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ReservationQuery builder.
+func (rq *ReservationQuery) Filter() *ReservationFilter {
+	return &ReservationFilter{config: rq.config, predicateAdder: rq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ReservationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ReservationMutation builder.
+func (m *ReservationMutation) Filter() *ReservationFilter {
+	return &ReservationFilter{config: m.config, predicateAdder: m}
+}
+
+// ReservationFilter provides a generic filtering capability at runtime for ReservationQuery.
+type ReservationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ReservationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *ReservationFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(reservation.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *ReservationFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(reservation.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *ReservationFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(reservation.FieldCarID))
+}
+
+// WhereUserID applies the entql int64 predicate on the user_id field.
+func (f *ReservationFilter) WhereUserID(p entql.Int64P) {
+	f.Where(p.Field(reservation.FieldUserID))
+}
+
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *ReservationFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(reservation.FieldStartAt))
+}
+
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *ReservationFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(reservation.FieldEndAt))
+}
+
+// WherePurpose applies the entql string predicate on the purpose field.
+func (f *ReservationFilter) WherePurpose(p entql.StringP) {
+	f.Where(p.Field(reservation.FieldPurpose))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *ReservationFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(reservation.FieldStatus))
+}
+
+// WhereCancelledAt applies the entql time.Time predicate on the cancelled_at field.
+func (f *ReservationFilter) WhereCancelledAt(p entql.TimeP) {
+	f.Where(p.Field(reservation.FieldCancelledAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ReservationFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(reservation.FieldCreatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *ReservationFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *ReservationFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (tq *TagQuery) addPredicate(pred func(s *sql.Selector)) {
+	tq.predicates = append(tq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TagQuery builder.
+func (tq *TagQuery) Filter() *TagFilter {
+	return &TagFilter{config: tq.config, predicateAdder: tq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TagMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TagMutation builder.
+func (m *TagMutation) Filter() *TagFilter {
+	return &TagFilter{config: m.config, predicateAdder: m}
+}
+
+// TagFilter provides a generic filtering capability at runtime for TagQuery.
+type TagFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TagFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *TagFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(tag.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *TagFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(tag.FieldTenantID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *TagFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(tag.FieldName))
+}
+
+// WhereHasCars applies a predicate to check if query has an edge cars.
+func (f *TagFilter) WhereHasCars() {
+	f.Where(entql.HasEdge("cars"))
+}
+
+// WhereHasCarsWith applies a predicate to check if query has an edge cars with a given conditions (other predicates).
+func (f *TagFilter) WhereHasCarsWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("cars", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (trq *TradeRecordQuery) addPredicate(pred func(s *sql.Selector)) {
+	trq.predicates = append(trq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TradeRecordQuery builder.
+func (trq *TradeRecordQuery) Filter() *TradeRecordFilter {
+	return &TradeRecordFilter{config: trq.config, predicateAdder: trq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TradeRecordMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TradeRecordMutation builder.
+func (m *TradeRecordMutation) Filter() *TradeRecordFilter {
+	return &TradeRecordFilter{config: m.config, predicateAdder: m}
+}
+
+// TradeRecordFilter provides a generic filtering capability at runtime for TradeRecordQuery.
+type TradeRecordFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TradeRecordFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *TradeRecordFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(traderecord.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *TradeRecordFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(traderecord.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *TradeRecordFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(traderecord.FieldCarID))
+}
+
+// WhereModelID applies the entql int64 predicate on the model_id field.
+func (f *TradeRecordFilter) WhereModelID(p entql.Int64P) {
+	f.Where(p.Field(traderecord.FieldModelID))
+}
+
+// WhereSellerID applies the entql int64 predicate on the seller_id field.
+func (f *TradeRecordFilter) WhereSellerID(p entql.Int64P) {
+	f.Where(p.Field(traderecord.FieldSellerID))
+}
+
+// WhereBuyerID applies the entql int64 predicate on the buyer_id field.
+func (f *TradeRecordFilter) WhereBuyerID(p entql.Int64P) {
+	f.Where(p.Field(traderecord.FieldBuyerID))
+}
+
+// WherePrice applies the entql float64 predicate on the price field.
+func (f *TradeRecordFilter) WherePrice(p entql.Float64P) {
+	f.Where(p.Field(traderecord.FieldPrice))
+}
+
+// WhereEstimatedValue applies the entql float64 predicate on the estimated_value field.
+func (f *TradeRecordFilter) WhereEstimatedValue(p entql.Float64P) {
+	f.Where(p.Field(traderecord.FieldEstimatedValue))
+}
+
+// WhereMileage applies the entql int64 predicate on the mileage field.
+func (f *TradeRecordFilter) WhereMileage(p entql.Int64P) {
+	f.Where(p.Field(traderecord.FieldMileage))
+}
+
+// WhereAgeMonths applies the entql int predicate on the age_months field.
+func (f *TradeRecordFilter) WhereAgeMonths(p entql.IntP) {
+	f.Where(p.Field(traderecord.FieldAgeMonths))
+}
+
+// WhereTradedAt applies the entql time.Time predicate on the traded_at field.
+func (f *TradeRecordFilter) WhereTradedAt(p entql.TimeP) {
+	f.Where(p.Field(traderecord.FieldTradedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *TradeRecordFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *TradeRecordFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (tq *TransferQuery) addPredicate(pred func(s *sql.Selector)) {
+	tq.predicates = append(tq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TransferQuery builder.
+func (tq *TransferQuery) Filter() *TransferFilter {
+	return &TransferFilter{config: tq.config, predicateAdder: tq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TransferMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TransferMutation builder.
+func (m *TransferMutation) Filter() *TransferFilter {
+	return &TransferFilter{config: m.config, predicateAdder: m}
+}
+
+// TransferFilter provides a generic filtering capability at runtime for TransferQuery.
+type TransferFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TransferFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *TransferFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(transfer.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *TransferFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(transfer.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *TransferFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(transfer.FieldCarID))
+}
+
+// WhereSellerID applies the entql int64 predicate on the seller_id field.
+func (f *TransferFilter) WhereSellerID(p entql.Int64P) {
+	f.Where(p.Field(transfer.FieldSellerID))
+}
+
+// WhereBuyerID applies the entql int64 predicate on the buyer_id field.
+func (f *TransferFilter) WhereBuyerID(p entql.Int64P) {
+	f.Where(p.Field(transfer.FieldBuyerID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *TransferFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(transfer.FieldStatus))
+}
+
+// WhereRequireApproval applies the entql bool predicate on the require_approval field.
+func (f *TransferFilter) WhereRequireApproval(p entql.BoolP) {
+	f.Where(p.Field(transfer.FieldRequireApproval))
+}
+
+// WhereApprovedBy applies the entql int64 predicate on the approved_by field.
+func (f *TransferFilter) WhereApprovedBy(p entql.Int64P) {
+	f.Where(p.Field(transfer.FieldApprovedBy))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *TransferFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(transfer.FieldExpiresAt))
+}
+
+// WhereAcceptedAt applies the entql time.Time predicate on the accepted_at field.
+func (f *TransferFilter) WhereAcceptedAt(p entql.TimeP) {
+	f.Where(p.Field(transfer.FieldAcceptedAt))
+}
+
+// WhereCompletedAt applies the entql time.Time predicate on the completed_at field.
+func (f *TransferFilter) WhereCompletedAt(p entql.TimeP) {
+	f.Where(p.Field(transfer.FieldCompletedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TransferFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(transfer.FieldCreatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *TransferFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *TransferFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (tq *TripQuery) addPredicate(pred func(s *sql.Selector)) {
+	tq.predicates = append(tq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TripQuery builder.
+func (tq *TripQuery) Filter() *TripFilter {
+	return &TripFilter{config: tq.config, predicateAdder: tq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TripMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TripMutation builder.
+func (m *TripMutation) Filter() *TripFilter {
+	return &TripFilter{config: m.config, predicateAdder: m}
+}
+
+// TripFilter provides a generic filtering capability at runtime for TripQuery.
+type TripFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TripFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *TripFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(trip.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *TripFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(trip.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *TripFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(trip.FieldCarID))
+}
+
+// WhereDriverID applies the entql int64 predicate on the driver_id field.
+func (f *TripFilter) WhereDriverID(p entql.Int64P) {
+	f.Where(p.Field(trip.FieldDriverID))
+}
+
+// WherePurpose applies the entql string predicate on the purpose field.
+func (f *TripFilter) WherePurpose(p entql.StringP) {
+	f.Where(p.Field(trip.FieldPurpose))
+}
+
+// WhereStartOdometer applies the entql int64 predicate on the start_odometer field.
+func (f *TripFilter) WhereStartOdometer(p entql.Int64P) {
+	f.Where(p.Field(trip.FieldStartOdometer))
+}
+
+// WhereEndOdometer applies the entql int64 predicate on the end_odometer field.
+func (f *TripFilter) WhereEndOdometer(p entql.Int64P) {
+	f.Where(p.Field(trip.FieldEndOdometer))
+}
+
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *TripFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(trip.FieldStartedAt))
+}
+
+// WhereEndedAt applies the entql time.Time predicate on the ended_at field.
+func (f *TripFilter) WhereEndedAt(p entql.TimeP) {
+	f.Where(p.Field(trip.FieldEndedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TripFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(trip.FieldCreatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *TripFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *TripFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (vmq *VehicleModelQuery) addPredicate(pred func(s *sql.Selector)) {
+	vmq.predicates = append(vmq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the VehicleModelQuery builder.
+func (vmq *VehicleModelQuery) Filter() *VehicleModelFilter {
+	return &VehicleModelFilter{config: vmq.config, predicateAdder: vmq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *VehicleModelMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the VehicleModelMutation builder.
+func (m *VehicleModelMutation) Filter() *VehicleModelFilter {
+	return &VehicleModelFilter{config: m.config, predicateAdder: m}
+}
+
+// VehicleModelFilter provides a generic filtering capability at runtime for VehicleModelQuery.
+type VehicleModelFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *VehicleModelFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *VehicleModelFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(vehiclemodel.FieldID))
+}
+
+// WhereBrandID applies the entql int64 predicate on the brand_id field.
+func (f *VehicleModelFilter) WhereBrandID(p entql.Int64P) {
+	f.Where(p.Field(vehiclemodel.FieldBrandID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *VehicleModelFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(vehiclemodel.FieldName))
+}
+
+// WhereFuelType applies the entql string predicate on the fuel_type field.
+func (f *VehicleModelFilter) WhereFuelType(p entql.StringP) {
+	f.Where(p.Field(vehiclemodel.FieldFuelType))
+}
+
+// WhereSeats applies the entql int32 predicate on the seats field.
+func (f *VehicleModelFilter) WhereSeats(p entql.Int32P) {
+	f.Where(p.Field(vehiclemodel.FieldSeats))
+}
+
+// WhereBodyType applies the entql string predicate on the body_type field.
+func (f *VehicleModelFilter) WhereBodyType(p entql.StringP) {
+	f.Where(p.Field(vehiclemodel.FieldBodyType))
+}
+
+// WhereHasBrand applies a predicate to check if query has an edge brand.
+func (f *VehicleModelFilter) WhereHasBrand() {
+	f.Where(entql.HasEdge("brand"))
+}
+
+// WhereHasBrandWith applies a predicate to check if query has an edge brand with a given conditions (other predicates).
+func (f *VehicleModelFilter) WhereHasBrandWith(preds ...predicate.Brand) {
+	f.Where(entql.HasEdgeWith("brand", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasCars applies a predicate to check if query has an edge cars.
+func (f *VehicleModelFilter) WhereHasCars() {
+	f.Where(entql.HasEdge("cars"))
+}
+
+// WhereHasCarsWith applies a predicate to check if query has an edge cars with a given conditions (other predicates).
+func (f *VehicleModelFilter) WhereHasCarsWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("cars", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (vq *ViolationQuery) addPredicate(pred func(s *sql.Selector)) {
+	vq.predicates = append(vq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ViolationQuery builder.
+func (vq *ViolationQuery) Filter() *ViolationFilter {
+	return &ViolationFilter{config: vq.config, predicateAdder: vq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ViolationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ViolationMutation builder.
+func (m *ViolationMutation) Filter() *ViolationFilter {
+	return &ViolationFilter{config: m.config, predicateAdder: m}
+}
+
+// ViolationFilter provides a generic filtering capability at runtime for ViolationQuery.
+type ViolationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ViolationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[27].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *ViolationFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(violation.FieldID))
+}
+
+// WhereTenantID applies the entql int64 predicate on the tenant_id field.
+func (f *ViolationFilter) WhereTenantID(p entql.Int64P) {
+	f.Where(p.Field(violation.FieldTenantID))
+}
+
+// WhereCarID applies the entql int64 predicate on the car_id field.
+func (f *ViolationFilter) WhereCarID(p entql.Int64P) {
+	f.Where(p.Field(violation.FieldCarID))
+}
+
+// WhereUserID applies the entql int64 predicate on the user_id field.
+func (f *ViolationFilter) WhereUserID(p entql.Int64P) {
+	f.Where(p.Field(violation.FieldUserID))
+}
+
+// WhereReferenceNo applies the entql string predicate on the reference_no field.
+func (f *ViolationFilter) WhereReferenceNo(p entql.StringP) {
+	f.Where(p.Field(violation.FieldReferenceNo))
+}
+
+// WhereOccurredAt applies the entql time.Time predicate on the occurred_at field.
+func (f *ViolationFilter) WhereOccurredAt(p entql.TimeP) {
+	f.Where(p.Field(violation.FieldOccurredAt))
+}
+
+// WhereLocation applies the entql string predicate on the location field.
+func (f *ViolationFilter) WhereLocation(p entql.StringP) {
+	f.Where(p.Field(violation.FieldLocation))
+}
+
+// WhereAmount applies the entql float64 predicate on the amount field.
+func (f *ViolationFilter) WhereAmount(p entql.Float64P) {
+	f.Where(p.Field(violation.FieldAmount))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *ViolationFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(violation.FieldDescription))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *ViolationFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(violation.FieldStatus))
+}
+
+// WhereResolvedAt applies the entql time.Time predicate on the resolved_at field.
+func (f *ViolationFilter) WhereResolvedAt(p entql.TimeP) {
+	f.Where(p.Field(violation.FieldResolvedAt))
+}
+
+// WhereResolvedBy applies the entql int64 predicate on the resolved_by field.
+func (f *ViolationFilter) WhereResolvedBy(p entql.Int64P) {
+	f.Where(p.Field(violation.FieldResolvedBy))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ViolationFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(violation.FieldCreatedAt))
+}
+
+// WhereHasCar applies a predicate to check if query has an edge car.
+func (f *ViolationFilter) WhereHasCar() {
+	f.Where(entql.HasEdge("car"))
+}
+
+// WhereHasCarWith applies a predicate to check if query has an edge car with a given conditions (other predicates).
+func (f *ViolationFilter) WhereHasCarWith(preds ...predicate.Car) {
+	f.Where(entql.HasEdgeWith("car", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (wdq *WarrantyDefinitionQuery) addPredicate(pred func(s *sql.Selector)) {
+	wdq.predicates = append(wdq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WarrantyDefinitionQuery builder.
+func (wdq *WarrantyDefinitionQuery) Filter() *WarrantyDefinitionFilter {
+	return &WarrantyDefinitionFilter{config: wdq.config, predicateAdder: wdq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WarrantyDefinitionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WarrantyDefinitionMutation builder.
+func (m *WarrantyDefinitionMutation) Filter() *WarrantyDefinitionFilter {
+	return &WarrantyDefinitionFilter{config: m.config, predicateAdder: m}
+}
+
+// WarrantyDefinitionFilter provides a generic filtering capability at runtime for WarrantyDefinitionQuery.
+type WarrantyDefinitionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WarrantyDefinitionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[28].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int64 predicate on the id field.
+func (f *WarrantyDefinitionFilter) WhereID(p entql.Int64P) {
+	f.Where(p.Field(warrantydefinition.FieldID))
+}
+
+// WhereModelID applies the entql int64 predicate on the model_id field.
+func (f *WarrantyDefinitionFilter) WhereModelID(p entql.Int64P) {
+	f.Where(p.Field(warrantydefinition.FieldModelID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *WarrantyDefinitionFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(warrantydefinition.FieldName))
+}
+
+// WhereComponents applies the entql string predicate on the components field.
+func (f *WarrantyDefinitionFilter) WhereComponents(p entql.StringP) {
+	f.Where(p.Field(warrantydefinition.FieldComponents))
+}
+
+// WhereDurationMonths applies the entql int predicate on the duration_months field.
+func (f *WarrantyDefinitionFilter) WhereDurationMonths(p entql.IntP) {
+	f.Where(p.Field(warrantydefinition.FieldDurationMonths))
+}
+
+// WhereMileageLimit applies the entql int64 predicate on the mileage_limit field.
+func (f *WarrantyDefinitionFilter) WhereMileageLimit(p entql.Int64P) {
+	f.Where(p.Field(warrantydefinition.FieldMileageLimit))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WarrantyDefinitionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(warrantydefinition.FieldCreatedAt))
+}
+
+// WhereHasCarWarranties applies a predicate to check if query has an edge car_warranties.
+func (f *WarrantyDefinitionFilter) WhereHasCarWarranties() {
+	f.Where(entql.HasEdge("car_warranties"))
+}
+
+// WhereHasCarWarrantiesWith applies a predicate to check if query has an edge car_warranties with a given conditions (other predicates).
+func (f *WarrantyDefinitionFilter) WhereHasCarWarrantiesWith(preds ...predicate.CarWarranty) {
+	f.Where(entql.HasEdgeWith("car_warranties", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
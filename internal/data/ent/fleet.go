@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/fleet"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Fleet is the model entity for the Fleet schema.
+type Fleet struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FleetQuery when eager-loading is set.
+	Edges FleetEdges `json:"edges"`
+}
+
+// FleetEdges holds the relations/edges for other nodes in the graph.
+type FleetEdges struct {
+	// Cars holds the value of the cars edge.
+	Cars []*Car `json:"cars,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarsOrErr returns the Cars value or an error if the edge
+// was not loaded in eager-loading.
+func (e FleetEdges) CarsOrErr() ([]*Car, error) {
+	if e.loadedTypes[0] {
+		return e.Cars, nil
+	}
+	return nil, &NotLoadedError{edge: "cars"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Fleet) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fleet.FieldID, fleet.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case fleet.FieldName, fleet.FieldDescription:
+			values[i] = new(sql.NullString)
+		case fleet.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Fleet", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Fleet fields.
+func (f *Fleet) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fleet.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			f.ID = int64(value.Int64)
+		case fleet.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				f.TenantID = value.Int64
+			}
+		case fleet.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				f.Name = value.String
+			}
+		case fleet.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				f.Description = value.String
+			}
+		case fleet.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				f.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCars queries the "cars" edge of the Fleet entity.
+func (f *Fleet) QueryCars() *CarQuery {
+	return (&FleetClient{config: f.config}).QueryCars(f)
+}
+
+// Update returns a builder for updating this Fleet.
+// Note that you need to call Fleet.Unwrap() before calling this method if this Fleet
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (f *Fleet) Update() *FleetUpdateOne {
+	return (&FleetClient{config: f.config}).UpdateOne(f)
+}
+
+// Unwrap unwraps the Fleet entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (f *Fleet) Unwrap() *Fleet {
+	_tx, ok := f.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Fleet is not a transactional entity")
+	}
+	f.config.driver = _tx.drv
+	return f
+}
+
+// String implements the fmt.Stringer.
+func (f *Fleet) String() string {
+	var builder strings.Builder
+	builder.WriteString("Fleet(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", f.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", f.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(f.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(f.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Fleets is a parsable slice of Fleet.
+type Fleets []*Fleet
+
+func (f Fleets) config(cfg config) {
+	for _i := range f {
+		f[_i].config = cfg
+	}
+}
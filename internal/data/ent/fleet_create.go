@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/fleet"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FleetCreate is the builder for creating a Fleet entity.
+type FleetCreate struct {
+	config
+	mutation *FleetMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (fc *FleetCreate) SetTenantID(i int64) *FleetCreate {
+	fc.mutation.SetTenantID(i)
+	return fc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (fc *FleetCreate) SetNillableTenantID(i *int64) *FleetCreate {
+	if i != nil {
+		fc.SetTenantID(*i)
+	}
+	return fc
+}
+
+// SetName sets the "name" field.
+func (fc *FleetCreate) SetName(s string) *FleetCreate {
+	fc.mutation.SetName(s)
+	return fc
+}
+
+// SetDescription sets the "description" field.
+func (fc *FleetCreate) SetDescription(s string) *FleetCreate {
+	fc.mutation.SetDescription(s)
+	return fc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (fc *FleetCreate) SetNillableDescription(s *string) *FleetCreate {
+	if s != nil {
+		fc.SetDescription(*s)
+	}
+	return fc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fc *FleetCreate) SetCreatedAt(t time.Time) *FleetCreate {
+	fc.mutation.SetCreatedAt(t)
+	return fc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fc *FleetCreate) SetNillableCreatedAt(t *time.Time) *FleetCreate {
+	if t != nil {
+		fc.SetCreatedAt(*t)
+	}
+	return fc
+}
+
+// SetID sets the "id" field.
+func (fc *FleetCreate) SetID(i int64) *FleetCreate {
+	fc.mutation.SetID(i)
+	return fc
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (fc *FleetCreate) AddCarIDs(ids ...int64) *FleetCreate {
+	fc.mutation.AddCarIDs(ids...)
+	return fc
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (fc *FleetCreate) AddCars(c ...*Car) *FleetCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return fc.AddCarIDs(ids...)
+}
+
+// Mutation returns the FleetMutation object of the builder.
+func (fc *FleetCreate) Mutation() *FleetMutation {
+	return fc.mutation
+}
+
+// Save creates the Fleet in the database.
+func (fc *FleetCreate) Save(ctx context.Context) (*Fleet, error) {
+	var (
+		err  error
+		node *Fleet
+	)
+	if err := fc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(fc.hooks) == 0 {
+		if err = fc.check(); err != nil {
+			return nil, err
+		}
+		node, err = fc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FleetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = fc.check(); err != nil {
+				return nil, err
+			}
+			fc.mutation = mutation
+			if node, err = fc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(fc.hooks) - 1; i >= 0; i-- {
+			if fc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = fc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, fc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Fleet)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from FleetMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fc *FleetCreate) SaveX(ctx context.Context) *Fleet {
+	v, err := fc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fc *FleetCreate) Exec(ctx context.Context) error {
+	_, err := fc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fc *FleetCreate) ExecX(ctx context.Context) {
+	if err := fc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fc *FleetCreate) defaults() error {
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		if fleet.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized fleet.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := fleet.DefaultCreatedAt()
+		fc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fc *FleetCreate) check() error {
+	if _, ok := fc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Fleet.name"`)}
+	}
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Fleet.created_at"`)}
+	}
+	return nil
+}
+
+func (fc *FleetCreate) sqlSave(ctx context.Context) (*Fleet, error) {
+	_node, _spec := fc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (fc *FleetCreate) createSpec() (*Fleet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Fleet{config: fc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: fleet.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: fleet.FieldID,
+			},
+		}
+	)
+	if id, ok := fc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := fc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fleet.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := fc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fleet.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := fc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fleet.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := fc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fleet.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := fc.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   fleet.CarsTable,
+			Columns: fleet.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FleetCreateBulk is the builder for creating many Fleet entities in bulk.
+type FleetCreateBulk struct {
+	config
+	builders []*FleetCreate
+}
+
+// Save creates the Fleet entities in the database.
+func (fcb *FleetCreateBulk) Save(ctx context.Context) ([]*Fleet, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
+	nodes := make([]*Fleet, len(fcb.builders))
+	mutators := make([]Mutator, len(fcb.builders))
+	for i := range fcb.builders {
+		func(i int, root context.Context) {
+			builder := fcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FleetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcb *FleetCreateBulk) SaveX(ctx context.Context) []*Fleet {
+	v, err := fcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fcb *FleetCreateBulk) Exec(ctx context.Context) error {
+	_, err := fcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcb *FleetCreateBulk) ExecX(ctx context.Context) {
+	if err := fcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FleetDelete is the builder for deleting a Fleet entity.
+type FleetDelete struct {
+	config
+	hooks    []Hook
+	mutation *FleetMutation
+}
+
+// Where appends a list predicates to the FleetDelete builder.
+func (fd *FleetDelete) Where(ps ...predicate.Fleet) *FleetDelete {
+	fd.mutation.Where(ps...)
+	return fd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fd *FleetDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(fd.hooks) == 0 {
+		affected, err = fd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FleetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fd.mutation = mutation
+			affected, err = fd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(fd.hooks) - 1; i >= 0; i-- {
+			if fd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = fd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fd *FleetDelete) ExecX(ctx context.Context) int {
+	n, err := fd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fd *FleetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: fleet.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: fleet.FieldID,
+			},
+		},
+	}
+	if ps := fd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// FleetDeleteOne is the builder for deleting a single Fleet entity.
+type FleetDeleteOne struct {
+	fd *FleetDelete
+}
+
+// Exec executes the deletion query.
+func (fdo *FleetDeleteOne) Exec(ctx context.Context) error {
+	n, err := fdo.fd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fleet.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fdo *FleetDeleteOne) ExecX(ctx context.Context) {
+	fdo.fd.ExecX(ctx)
+}
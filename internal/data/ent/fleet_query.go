@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FleetQuery is the builder for querying Fleet entities.
+type FleetQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Fleet
+	// eager-loading edges.
+	withCars  *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FleetQuery builder.
+func (fq *FleetQuery) Where(ps ...predicate.Fleet) *FleetQuery {
+	fq.predicates = append(fq.predicates, ps...)
+	return fq
+}
+
+// Limit adds a limit step to the query.
+func (fq *FleetQuery) Limit(limit int) *FleetQuery {
+	fq.limit = &limit
+	return fq
+}
+
+// Offset adds an offset step to the query.
+func (fq *FleetQuery) Offset(offset int) *FleetQuery {
+	fq.offset = &offset
+	return fq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fq *FleetQuery) Unique(unique bool) *FleetQuery {
+	fq.unique = &unique
+	return fq
+}
+
+// Order adds an order step to the query.
+func (fq *FleetQuery) Order(o ...OrderFunc) *FleetQuery {
+	fq.order = append(fq.order, o...)
+	return fq
+}
+
+// QueryCars chains the current query on the "cars" edge.
+func (fq *FleetQuery) QueryCars() *CarQuery {
+	query := &CarQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fleet.Table, fleet.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, fleet.CarsTable, fleet.CarsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Fleet entity from the query.
+// Returns a *NotFoundError when no Fleet was found.
+func (fq *FleetQuery) First(ctx context.Context) (*Fleet, error) {
+	nodes, err := fq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{fleet.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fq *FleetQuery) FirstX(ctx context.Context) *Fleet {
+	node, err := fq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Fleet ID from the query.
+// Returns a *NotFoundError when no Fleet ID was found.
+func (fq *FleetQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = fq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{fleet.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fq *FleetQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := fq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Fleet entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Fleet entity is found.
+// Returns a *NotFoundError when no Fleet entities are found.
+func (fq *FleetQuery) Only(ctx context.Context) (*Fleet, error) {
+	nodes, err := fq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{fleet.Label}
+	default:
+		return nil, &NotSingularError{fleet.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fq *FleetQuery) OnlyX(ctx context.Context) *Fleet {
+	node, err := fq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Fleet ID in the query.
+// Returns a *NotSingularError when more than one Fleet ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fq *FleetQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = fq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{fleet.Label}
+	default:
+		err = &NotSingularError{fleet.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fq *FleetQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := fq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Fleets.
+func (fq *FleetQuery) All(ctx context.Context) ([]*Fleet, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return fq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fq *FleetQuery) AllX(ctx context.Context) []*Fleet {
+	nodes, err := fq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Fleet IDs.
+func (fq *FleetQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := fq.Select(fleet.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fq *FleetQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := fq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fq *FleetQuery) Count(ctx context.Context) (int, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return fq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fq *FleetQuery) CountX(ctx context.Context) int {
+	count, err := fq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fq *FleetQuery) Exist(ctx context.Context) (bool, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return fq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fq *FleetQuery) ExistX(ctx context.Context) bool {
+	exist, err := fq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FleetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fq *FleetQuery) Clone() *FleetQuery {
+	if fq == nil {
+		return nil
+	}
+	return &FleetQuery{
+		config:     fq.config,
+		limit:      fq.limit,
+		offset:     fq.offset,
+		order:      append([]OrderFunc{}, fq.order...),
+		predicates: append([]predicate.Fleet{}, fq.predicates...),
+		withCars:   fq.withCars.Clone(),
+		// clone intermediate query.
+		sql:    fq.sql.Clone(),
+		path:   fq.path,
+		unique: fq.unique,
+	}
+}
+
+// WithCars tells the query-builder to eager-load the nodes that are connected to
+// the "cars" edge. The optional arguments are used to configure the query builder of the edge.
+func (fq *FleetQuery) WithCars(opts ...func(*CarQuery)) *FleetQuery {
+	query := &CarQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withCars = query
+	return fq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Fleet.Query().
+//		GroupBy(fleet.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (fq *FleetQuery) GroupBy(field string, fields ...string) *FleetGroupBy {
+	grbuild := &FleetGroupBy{config: fq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fq.sqlQuery(ctx), nil
+	}
+	grbuild.label = fleet.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.Fleet.Query().
+//		Select(fleet.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (fq *FleetQuery) Select(fields ...string) *FleetSelect {
+	fq.fields = append(fq.fields, fields...)
+	selbuild := &FleetSelect{FleetQuery: fq}
+	selbuild.label = fleet.Label
+	selbuild.flds, selbuild.scan = &fq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (fq *FleetQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range fq.fields {
+		if !fleet.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fq.path != nil {
+		prev, err := fq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fq.sql = prev
+	}
+	if fleet.Policy == nil {
+		return errors.New("ent: uninitialized fleet.Policy (forgotten import ent/runtime?)")
+	}
+	if err := fleet.Policy.EvalQuery(ctx, fq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (fq *FleetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fleet, error) {
+	var (
+		nodes       = []*Fleet{}
+		_spec       = fq.querySpec()
+		loadedTypes = [1]bool{
+			fq.withCars != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Fleet).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Fleet{config: fq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(fq.modifiers) > 0 {
+		_spec.Modifiers = fq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := fq.withCars; query != nil {
+		edgeids := make([]driver.Value, len(nodes))
+		byid := make(map[int64]*Fleet)
+		nids := make(map[int64]map[*Fleet]struct{})
+		for i, node := range nodes {
+			edgeids[i] = node.ID
+			byid[node.ID] = node
+			node.Edges.Cars = []*Car{}
+		}
+		query.Where(func(s *sql.Selector) {
+			joinT := sql.Table(fleet.CarsTable)
+			s.Join(joinT).On(s.C(car.FieldID), joinT.C(fleet.CarsPrimaryKey[1]))
+			s.Where(sql.InValues(joinT.C(fleet.CarsPrimaryKey[0]), edgeids...))
+			columns := s.SelectedColumns()
+			s.Select(joinT.C(fleet.CarsPrimaryKey[0]))
+			s.AppendSelect(columns...)
+			s.SetDistinct(false)
+		})
+		neighbors, err := query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]interface{}, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]interface{}{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []interface{}) error {
+				outValue := values[0].(*sql.NullInt64).Int64
+				inValue := values[1].(*sql.NullInt64).Int64
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Fleet]struct{}{byid[outValue]: struct{}{}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byid[outValue]] = struct{}{}
+				return nil
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "cars" node returned %v`, n.ID)
+			}
+			for kn := range nodes {
+				kn.Edges.Cars = append(kn.Edges.Cars, n)
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (fq *FleetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fq.querySpec()
+	if len(fq.modifiers) > 0 {
+		_spec.Modifiers = fq.modifiers
+	}
+	_spec.Node.Columns = fq.fields
+	if len(fq.fields) > 0 {
+		_spec.Unique = fq.unique != nil && *fq.unique
+	}
+	return sqlgraph.CountNodes(ctx, fq.driver, _spec)
+}
+
+func (fq *FleetQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := fq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (fq *FleetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   fleet.Table,
+			Columns: fleet.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: fleet.FieldID,
+			},
+		},
+		From:   fq.sql,
+		Unique: true,
+	}
+	if unique := fq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := fq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fleet.FieldID)
+		for i := range fields {
+			if fields[i] != fleet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := fq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fq *FleetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fq.driver.Dialect())
+	t1 := builder.Table(fleet.Table)
+	columns := fq.fields
+	if len(columns) == 0 {
+		columns = fleet.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fq.sql != nil {
+		selector = fq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fq.unique != nil && *fq.unique {
+		selector.Distinct()
+	}
+	for _, m := range fq.modifiers {
+		m(selector)
+	}
+	for _, p := range fq.predicates {
+		p(selector)
+	}
+	for _, p := range fq.order {
+		p(selector)
+	}
+	if offset := fq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (fq *FleetQuery) ForUpdate(opts ...sql.LockOption) *FleetQuery {
+	if fq.driver.Dialect() == dialect.Postgres {
+		fq.Unique(false)
+	}
+	fq.modifiers = append(fq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return fq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (fq *FleetQuery) ForShare(opts ...sql.LockOption) *FleetQuery {
+	if fq.driver.Dialect() == dialect.Postgres {
+		fq.Unique(false)
+	}
+	fq.modifiers = append(fq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return fq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (fq *FleetQuery) Modify(modifiers ...func(s *sql.Selector)) *FleetSelect {
+	fq.modifiers = append(fq.modifiers, modifiers...)
+	return fq.Select()
+}
+
+// FleetGroupBy is the group-by builder for Fleet entities.
+type FleetGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fgb *FleetGroupBy) Aggregate(fns ...AggregateFunc) *FleetGroupBy {
+	fgb.fns = append(fgb.fns, fns...)
+	return fgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (fgb *FleetGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := fgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	fgb.sql = query
+	return fgb.sqlScan(ctx, v)
+}
+
+func (fgb *FleetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range fgb.fields {
+		if !fleet.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := fgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fgb *FleetGroupBy) sqlQuery() *sql.Selector {
+	selector := fgb.sql.Select()
+	aggregation := make([]string, 0, len(fgb.fns))
+	for _, fn := range fgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(fgb.fields)+len(fgb.fns))
+		for _, f := range fgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(fgb.fields...)...)
+}
+
+// FleetSelect is the builder for selecting fields of Fleet entities.
+type FleetSelect struct {
+	*FleetQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fs *FleetSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := fs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	fs.sql = fs.FleetQuery.sqlQuery(ctx)
+	return fs.sqlScan(ctx, v)
+}
+
+func (fs *FleetSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fs.sql.Query()
+	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (fs *FleetSelect) Modify(modifiers ...func(s *sql.Selector)) *FleetSelect {
+	fs.modifiers = append(fs.modifiers, modifiers...)
+	return fs
+}
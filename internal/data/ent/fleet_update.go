@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FleetUpdate is the builder for updating Fleet entities.
+type FleetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FleetMutation
+}
+
+// Where appends a list predicates to the FleetUpdate builder.
+func (fu *FleetUpdate) Where(ps ...predicate.Fleet) *FleetUpdate {
+	fu.mutation.Where(ps...)
+	return fu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (fu *FleetUpdate) SetTenantID(i int64) *FleetUpdate {
+	fu.mutation.ResetTenantID()
+	fu.mutation.SetTenantID(i)
+	return fu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (fu *FleetUpdate) SetNillableTenantID(i *int64) *FleetUpdate {
+	if i != nil {
+		fu.SetTenantID(*i)
+	}
+	return fu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (fu *FleetUpdate) AddTenantID(i int64) *FleetUpdate {
+	fu.mutation.AddTenantID(i)
+	return fu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (fu *FleetUpdate) ClearTenantID() *FleetUpdate {
+	fu.mutation.ClearTenantID()
+	return fu
+}
+
+// SetName sets the "name" field.
+func (fu *FleetUpdate) SetName(s string) *FleetUpdate {
+	fu.mutation.SetName(s)
+	return fu
+}
+
+// SetDescription sets the "description" field.
+func (fu *FleetUpdate) SetDescription(s string) *FleetUpdate {
+	fu.mutation.SetDescription(s)
+	return fu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (fu *FleetUpdate) SetNillableDescription(s *string) *FleetUpdate {
+	if s != nil {
+		fu.SetDescription(*s)
+	}
+	return fu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (fu *FleetUpdate) ClearDescription() *FleetUpdate {
+	fu.mutation.ClearDescription()
+	return fu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fu *FleetUpdate) SetCreatedAt(t time.Time) *FleetUpdate {
+	fu.mutation.SetCreatedAt(t)
+	return fu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fu *FleetUpdate) SetNillableCreatedAt(t *time.Time) *FleetUpdate {
+	if t != nil {
+		fu.SetCreatedAt(*t)
+	}
+	return fu
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (fu *FleetUpdate) AddCarIDs(ids ...int64) *FleetUpdate {
+	fu.mutation.AddCarIDs(ids...)
+	return fu
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (fu *FleetUpdate) AddCars(c ...*Car) *FleetUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return fu.AddCarIDs(ids...)
+}
+
+// Mutation returns the FleetMutation object of the builder.
+func (fu *FleetUpdate) Mutation() *FleetMutation {
+	return fu.mutation
+}
+
+// ClearCars clears all "cars" edges to the Car entity.
+func (fu *FleetUpdate) ClearCars() *FleetUpdate {
+	fu.mutation.ClearCars()
+	return fu
+}
+
+// RemoveCarIDs removes the "cars" edge to Car entities by IDs.
+func (fu *FleetUpdate) RemoveCarIDs(ids ...int64) *FleetUpdate {
+	fu.mutation.RemoveCarIDs(ids...)
+	return fu
+}
+
+// RemoveCars removes "cars" edges to Car entities.
+func (fu *FleetUpdate) RemoveCars(c ...*Car) *FleetUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return fu.RemoveCarIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fu *FleetUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(fu.hooks) == 0 {
+		affected, err = fu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FleetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fu.mutation = mutation
+			affected, err = fu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(fu.hooks) - 1; i >= 0; i-- {
+			if fu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = fu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fu *FleetUpdate) SaveX(ctx context.Context) int {
+	affected, err := fu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fu *FleetUpdate) Exec(ctx context.Context) error {
+	_, err := fu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fu *FleetUpdate) ExecX(ctx context.Context) {
+	if err := fu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fu *FleetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   fleet.Table,
+			Columns: fleet.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: fleet.FieldID,
+			},
+		},
+	}
+	if ps := fu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fleet.FieldTenantID,
+		})
+	}
+	if value, ok := fu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fleet.FieldTenantID,
+		})
+	}
+	if fu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: fleet.FieldTenantID,
+		})
+	}
+	if value, ok := fu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fleet.FieldName,
+		})
+	}
+	if value, ok := fu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fleet.FieldDescription,
+		})
+	}
+	if fu.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fleet.FieldDescription,
+		})
+	}
+	if value, ok := fu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fleet.FieldCreatedAt,
+		})
+	}
+	if fu.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   fleet.CarsTable,
+			Columns: fleet.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedCarsIDs(); len(nodes) > 0 && !fu.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   fleet.CarsTable,
+			Columns: fleet.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   fleet.CarsTable,
+			Columns: fleet.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fleet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// FleetUpdateOne is the builder for updating a single Fleet entity.
+type FleetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FleetMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (fuo *FleetUpdateOne) SetTenantID(i int64) *FleetUpdateOne {
+	fuo.mutation.ResetTenantID()
+	fuo.mutation.SetTenantID(i)
+	return fuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (fuo *FleetUpdateOne) SetNillableTenantID(i *int64) *FleetUpdateOne {
+	if i != nil {
+		fuo.SetTenantID(*i)
+	}
+	return fuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (fuo *FleetUpdateOne) AddTenantID(i int64) *FleetUpdateOne {
+	fuo.mutation.AddTenantID(i)
+	return fuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (fuo *FleetUpdateOne) ClearTenantID() *FleetUpdateOne {
+	fuo.mutation.ClearTenantID()
+	return fuo
+}
+
+// SetName sets the "name" field.
+func (fuo *FleetUpdateOne) SetName(s string) *FleetUpdateOne {
+	fuo.mutation.SetName(s)
+	return fuo
+}
+
+// SetDescription sets the "description" field.
+func (fuo *FleetUpdateOne) SetDescription(s string) *FleetUpdateOne {
+	fuo.mutation.SetDescription(s)
+	return fuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (fuo *FleetUpdateOne) SetNillableDescription(s *string) *FleetUpdateOne {
+	if s != nil {
+		fuo.SetDescription(*s)
+	}
+	return fuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (fuo *FleetUpdateOne) ClearDescription() *FleetUpdateOne {
+	fuo.mutation.ClearDescription()
+	return fuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fuo *FleetUpdateOne) SetCreatedAt(t time.Time) *FleetUpdateOne {
+	fuo.mutation.SetCreatedAt(t)
+	return fuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fuo *FleetUpdateOne) SetNillableCreatedAt(t *time.Time) *FleetUpdateOne {
+	if t != nil {
+		fuo.SetCreatedAt(*t)
+	}
+	return fuo
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (fuo *FleetUpdateOne) AddCarIDs(ids ...int64) *FleetUpdateOne {
+	fuo.mutation.AddCarIDs(ids...)
+	return fuo
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (fuo *FleetUpdateOne) AddCars(c ...*Car) *FleetUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return fuo.AddCarIDs(ids...)
+}
+
+// Mutation returns the FleetMutation object of the builder.
+func (fuo *FleetUpdateOne) Mutation() *FleetMutation {
+	return fuo.mutation
+}
+
+// ClearCars clears all "cars" edges to the Car entity.
+func (fuo *FleetUpdateOne) ClearCars() *FleetUpdateOne {
+	fuo.mutation.ClearCars()
+	return fuo
+}
+
+// RemoveCarIDs removes the "cars" edge to Car entities by IDs.
+func (fuo *FleetUpdateOne) RemoveCarIDs(ids ...int64) *FleetUpdateOne {
+	fuo.mutation.RemoveCarIDs(ids...)
+	return fuo
+}
+
+// RemoveCars removes "cars" edges to Car entities.
+func (fuo *FleetUpdateOne) RemoveCars(c ...*Car) *FleetUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return fuo.RemoveCarIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fuo *FleetUpdateOne) Select(field string, fields ...string) *FleetUpdateOne {
+	fuo.fields = append([]string{field}, fields...)
+	return fuo
+}
+
+// Save executes the query and returns the updated Fleet entity.
+func (fuo *FleetUpdateOne) Save(ctx context.Context) (*Fleet, error) {
+	var (
+		err  error
+		node *Fleet
+	)
+	if len(fuo.hooks) == 0 {
+		node, err = fuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FleetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fuo.mutation = mutation
+			node, err = fuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(fuo.hooks) - 1; i >= 0; i-- {
+			if fuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = fuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, fuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Fleet)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from FleetMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fuo *FleetUpdateOne) SaveX(ctx context.Context) *Fleet {
+	node, err := fuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fuo *FleetUpdateOne) Exec(ctx context.Context) error {
+	_, err := fuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fuo *FleetUpdateOne) ExecX(ctx context.Context) {
+	if err := fuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fuo *FleetUpdateOne) sqlSave(ctx context.Context) (_node *Fleet, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   fleet.Table,
+			Columns: fleet.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: fleet.FieldID,
+			},
+		},
+	}
+	id, ok := fuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Fleet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fleet.FieldID)
+		for _, f := range fields {
+			if !fleet.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fleet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fleet.FieldTenantID,
+		})
+	}
+	if value, ok := fuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fleet.FieldTenantID,
+		})
+	}
+	if fuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: fleet.FieldTenantID,
+		})
+	}
+	if value, ok := fuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fleet.FieldName,
+		})
+	}
+	if value, ok := fuo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fleet.FieldDescription,
+		})
+	}
+	if fuo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fleet.FieldDescription,
+		})
+	}
+	if value, ok := fuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fleet.FieldCreatedAt,
+		})
+	}
+	if fuo.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   fleet.CarsTable,
+			Columns: fleet.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedCarsIDs(); len(nodes) > 0 && !fuo.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   fleet.CarsTable,
+			Columns: fleet.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   fleet.CarsTable,
+			Columns: fleet.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Fleet{config: fuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fleet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/execquery,sql/modifier --template "./template/template.go.tmpl" ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature privacy,entql,sql/execquery,sql/modifier,sql/lock --template "./template/template.go.tmpl" ./schema
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/geofence"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Geofence is the model entity for the Geofence schema.
+type Geofence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Polygon holds the value of the "polygon" field.
+	Polygon string `json:"polygon,omitempty"`
+	// Days holds the value of the "days" field.
+	Days string `json:"days,omitempty"`
+	// StartTime holds the value of the "start_time" field.
+	StartTime string `json:"start_time,omitempty"`
+	// EndTime holds the value of the "end_time" field.
+	EndTime string `json:"end_time,omitempty"`
+	// TimeZone holds the value of the "time_zone" field.
+	TimeZone string `json:"time_zone,omitempty"`
+	// AlertOn holds the value of the "alert_on" field.
+	AlertOn string `json:"alert_on,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GeofenceQuery when eager-loading is set.
+	Edges GeofenceEdges `json:"edges"`
+}
+
+// GeofenceEdges holds the relations/edges for other nodes in the graph.
+type GeofenceEdges struct {
+	// Cars holds the value of the cars edge.
+	Cars []*Car `json:"cars,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarsOrErr returns the Cars value or an error if the edge
+// was not loaded in eager-loading.
+func (e GeofenceEdges) CarsOrErr() ([]*Car, error) {
+	if e.loadedTypes[0] {
+		return e.Cars, nil
+	}
+	return nil, &NotLoadedError{edge: "cars"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Geofence) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case geofence.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case geofence.FieldID, geofence.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case geofence.FieldName, geofence.FieldPolygon, geofence.FieldDays, geofence.FieldStartTime, geofence.FieldEndTime, geofence.FieldTimeZone, geofence.FieldAlertOn:
+			values[i] = new(sql.NullString)
+		case geofence.FieldCreatedAt, geofence.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Geofence", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Geofence fields.
+func (ge *Geofence) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case geofence.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ge.ID = int64(value.Int64)
+		case geofence.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ge.TenantID = value.Int64
+			}
+		case geofence.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ge.Name = value.String
+			}
+		case geofence.FieldPolygon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field polygon", values[i])
+			} else if value.Valid {
+				ge.Polygon = value.String
+			}
+		case geofence.FieldDays:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field days", values[i])
+			} else if value.Valid {
+				ge.Days = value.String
+			}
+		case geofence.FieldStartTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				ge.StartTime = value.String
+			}
+		case geofence.FieldEndTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				ge.EndTime = value.String
+			}
+		case geofence.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				ge.TimeZone = value.String
+			}
+		case geofence.FieldAlertOn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_on", values[i])
+			} else if value.Valid {
+				ge.AlertOn = value.String
+			}
+		case geofence.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ge.Enabled = value.Bool
+			}
+		case geofence.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ge.CreatedAt = value.Time
+			}
+		case geofence.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ge.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCars queries the "cars" edge of the Geofence entity.
+func (ge *Geofence) QueryCars() *CarQuery {
+	return (&GeofenceClient{config: ge.config}).QueryCars(ge)
+}
+
+// Update returns a builder for updating this Geofence.
+// Note that you need to call Geofence.Unwrap() before calling this method if this Geofence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ge *Geofence) Update() *GeofenceUpdateOne {
+	return (&GeofenceClient{config: ge.config}).UpdateOne(ge)
+}
+
+// Unwrap unwraps the Geofence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ge *Geofence) Unwrap() *Geofence {
+	_tx, ok := ge.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Geofence is not a transactional entity")
+	}
+	ge.config.driver = _tx.drv
+	return ge
+}
+
+// String implements the fmt.Stringer.
+func (ge *Geofence) String() string {
+	var builder strings.Builder
+	builder.WriteString("Geofence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ge.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ge.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ge.Name)
+	builder.WriteString(", ")
+	builder.WriteString("polygon=")
+	builder.WriteString(ge.Polygon)
+	builder.WriteString(", ")
+	builder.WriteString("days=")
+	builder.WriteString(ge.Days)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(ge.StartTime)
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(ge.EndTime)
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(ge.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("alert_on=")
+	builder.WriteString(ge.AlertOn)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ge.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ge.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ge.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Geofences is a parsable slice of Geofence.
+type Geofences []*Geofence
+
+func (ge Geofences) config(cfg config) {
+	for _i := range ge {
+		ge[_i].config = cfg
+	}
+}
@@ -0,0 +1,98 @@
+// Code generated by ent, DO NOT EDIT.
+
+package geofence
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the geofence type in the database.
+	Label = "geofence"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPolygon holds the string denoting the polygon field in the database.
+	FieldPolygon = "polygon"
+	// FieldDays holds the string denoting the days field in the database.
+	FieldDays = "days"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldTimeZone holds the string denoting the time_zone field in the database.
+	FieldTimeZone = "time_zone"
+	// FieldAlertOn holds the string denoting the alert_on field in the database.
+	FieldAlertOn = "alert_on"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeCars holds the string denoting the cars edge name in mutations.
+	EdgeCars = "cars"
+	// Table holds the table name of the geofence in the database.
+	Table = "geofence"
+	// CarsTable is the table that holds the cars relation/edge. The primary key declared below.
+	CarsTable = "geofence_cars"
+	// CarsInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarsInverseTable = "car"
+)
+
+// Columns holds all SQL columns for geofence fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldName,
+	FieldPolygon,
+	FieldDays,
+	FieldStartTime,
+	FieldEndTime,
+	FieldTimeZone,
+	FieldAlertOn,
+	FieldEnabled,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+var (
+	// CarsPrimaryKey and CarsColumn2 are the table columns denoting the
+	// primary key for the cars relation (M2M).
+	CarsPrimaryKey = []string{"geofence_id", "car_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultAlertOn holds the default value on creation for the "alert_on" field.
+	DefaultAlertOn string
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,1322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package geofence
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Polygon applies equality check predicate on the "polygon" field. It's identical to PolygonEQ.
+func Polygon(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPolygon), v))
+	})
+}
+
+// Days applies equality check predicate on the "days" field. It's identical to DaysEQ.
+func Days(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDays), v))
+	})
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartTime), v))
+	})
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndTime), v))
+	})
+}
+
+// TimeZone applies equality check predicate on the "time_zone" field. It's identical to TimeZoneEQ.
+func TimeZone(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimeZone), v))
+	})
+}
+
+// AlertOn applies equality check predicate on the "alert_on" field. It's identical to AlertOnEQ.
+func AlertOn(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAlertOn), v))
+	})
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEnabled), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// PolygonEQ applies the EQ predicate on the "polygon" field.
+func PolygonEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonNEQ applies the NEQ predicate on the "polygon" field.
+func PolygonNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonIn applies the In predicate on the "polygon" field.
+func PolygonIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPolygon), v...))
+	})
+}
+
+// PolygonNotIn applies the NotIn predicate on the "polygon" field.
+func PolygonNotIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPolygon), v...))
+	})
+}
+
+// PolygonGT applies the GT predicate on the "polygon" field.
+func PolygonGT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonGTE applies the GTE predicate on the "polygon" field.
+func PolygonGTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonLT applies the LT predicate on the "polygon" field.
+func PolygonLT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonLTE applies the LTE predicate on the "polygon" field.
+func PolygonLTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonContains applies the Contains predicate on the "polygon" field.
+func PolygonContains(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonHasPrefix applies the HasPrefix predicate on the "polygon" field.
+func PolygonHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonHasSuffix applies the HasSuffix predicate on the "polygon" field.
+func PolygonHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonIsNil applies the IsNil predicate on the "polygon" field.
+func PolygonIsNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPolygon)))
+	})
+}
+
+// PolygonNotNil applies the NotNil predicate on the "polygon" field.
+func PolygonNotNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPolygon)))
+	})
+}
+
+// PolygonEqualFold applies the EqualFold predicate on the "polygon" field.
+func PolygonEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPolygon), v))
+	})
+}
+
+// PolygonContainsFold applies the ContainsFold predicate on the "polygon" field.
+func PolygonContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPolygon), v))
+	})
+}
+
+// DaysEQ applies the EQ predicate on the "days" field.
+func DaysEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDays), v))
+	})
+}
+
+// DaysNEQ applies the NEQ predicate on the "days" field.
+func DaysNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDays), v))
+	})
+}
+
+// DaysIn applies the In predicate on the "days" field.
+func DaysIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDays), v...))
+	})
+}
+
+// DaysNotIn applies the NotIn predicate on the "days" field.
+func DaysNotIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDays), v...))
+	})
+}
+
+// DaysGT applies the GT predicate on the "days" field.
+func DaysGT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDays), v))
+	})
+}
+
+// DaysGTE applies the GTE predicate on the "days" field.
+func DaysGTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDays), v))
+	})
+}
+
+// DaysLT applies the LT predicate on the "days" field.
+func DaysLT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDays), v))
+	})
+}
+
+// DaysLTE applies the LTE predicate on the "days" field.
+func DaysLTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDays), v))
+	})
+}
+
+// DaysContains applies the Contains predicate on the "days" field.
+func DaysContains(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDays), v))
+	})
+}
+
+// DaysHasPrefix applies the HasPrefix predicate on the "days" field.
+func DaysHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDays), v))
+	})
+}
+
+// DaysHasSuffix applies the HasSuffix predicate on the "days" field.
+func DaysHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDays), v))
+	})
+}
+
+// DaysIsNil applies the IsNil predicate on the "days" field.
+func DaysIsNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDays)))
+	})
+}
+
+// DaysNotNil applies the NotNil predicate on the "days" field.
+func DaysNotNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDays)))
+	})
+}
+
+// DaysEqualFold applies the EqualFold predicate on the "days" field.
+func DaysEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDays), v))
+	})
+}
+
+// DaysContainsFold applies the ContainsFold predicate on the "days" field.
+func DaysContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDays), v))
+	})
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartTime), v...))
+	})
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartTime), v...))
+	})
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeContains applies the Contains predicate on the "start_time" field.
+func StartTimeContains(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeHasPrefix applies the HasPrefix predicate on the "start_time" field.
+func StartTimeHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeHasSuffix applies the HasSuffix predicate on the "start_time" field.
+func StartTimeHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeIsNil applies the IsNil predicate on the "start_time" field.
+func StartTimeIsNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartTime)))
+	})
+}
+
+// StartTimeNotNil applies the NotNil predicate on the "start_time" field.
+func StartTimeNotNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartTime)))
+	})
+}
+
+// StartTimeEqualFold applies the EqualFold predicate on the "start_time" field.
+func StartTimeEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeContainsFold applies the ContainsFold predicate on the "start_time" field.
+func StartTimeContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStartTime), v))
+	})
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndTime), v...))
+	})
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndTime), v...))
+	})
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeContains applies the Contains predicate on the "end_time" field.
+func EndTimeContains(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeHasPrefix applies the HasPrefix predicate on the "end_time" field.
+func EndTimeHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeHasSuffix applies the HasSuffix predicate on the "end_time" field.
+func EndTimeHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeIsNil applies the IsNil predicate on the "end_time" field.
+func EndTimeIsNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndTime)))
+	})
+}
+
+// EndTimeNotNil applies the NotNil predicate on the "end_time" field.
+func EndTimeNotNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndTime)))
+	})
+}
+
+// EndTimeEqualFold applies the EqualFold predicate on the "end_time" field.
+func EndTimeEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeContainsFold applies the ContainsFold predicate on the "end_time" field.
+func EndTimeContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEndTime), v))
+	})
+}
+
+// TimeZoneEQ applies the EQ predicate on the "time_zone" field.
+func TimeZoneEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneNEQ applies the NEQ predicate on the "time_zone" field.
+func TimeZoneNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneIn applies the In predicate on the "time_zone" field.
+func TimeZoneIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTimeZone), v...))
+	})
+}
+
+// TimeZoneNotIn applies the NotIn predicate on the "time_zone" field.
+func TimeZoneNotIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTimeZone), v...))
+	})
+}
+
+// TimeZoneGT applies the GT predicate on the "time_zone" field.
+func TimeZoneGT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneGTE applies the GTE predicate on the "time_zone" field.
+func TimeZoneGTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneLT applies the LT predicate on the "time_zone" field.
+func TimeZoneLT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneLTE applies the LTE predicate on the "time_zone" field.
+func TimeZoneLTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneContains applies the Contains predicate on the "time_zone" field.
+func TimeZoneContains(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneHasPrefix applies the HasPrefix predicate on the "time_zone" field.
+func TimeZoneHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneHasSuffix applies the HasSuffix predicate on the "time_zone" field.
+func TimeZoneHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneIsNil applies the IsNil predicate on the "time_zone" field.
+func TimeZoneIsNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTimeZone)))
+	})
+}
+
+// TimeZoneNotNil applies the NotNil predicate on the "time_zone" field.
+func TimeZoneNotNil() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTimeZone)))
+	})
+}
+
+// TimeZoneEqualFold applies the EqualFold predicate on the "time_zone" field.
+func TimeZoneEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTimeZone), v))
+	})
+}
+
+// TimeZoneContainsFold applies the ContainsFold predicate on the "time_zone" field.
+func TimeZoneContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTimeZone), v))
+	})
+}
+
+// AlertOnEQ applies the EQ predicate on the "alert_on" field.
+func AlertOnEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnNEQ applies the NEQ predicate on the "alert_on" field.
+func AlertOnNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnIn applies the In predicate on the "alert_on" field.
+func AlertOnIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAlertOn), v...))
+	})
+}
+
+// AlertOnNotIn applies the NotIn predicate on the "alert_on" field.
+func AlertOnNotIn(vs ...string) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAlertOn), v...))
+	})
+}
+
+// AlertOnGT applies the GT predicate on the "alert_on" field.
+func AlertOnGT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnGTE applies the GTE predicate on the "alert_on" field.
+func AlertOnGTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnLT applies the LT predicate on the "alert_on" field.
+func AlertOnLT(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnLTE applies the LTE predicate on the "alert_on" field.
+func AlertOnLTE(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnContains applies the Contains predicate on the "alert_on" field.
+func AlertOnContains(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnHasPrefix applies the HasPrefix predicate on the "alert_on" field.
+func AlertOnHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnHasSuffix applies the HasSuffix predicate on the "alert_on" field.
+func AlertOnHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnEqualFold applies the EqualFold predicate on the "alert_on" field.
+func AlertOnEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAlertOn), v))
+	})
+}
+
+// AlertOnContainsFold applies the ContainsFold predicate on the "alert_on" field.
+func AlertOnContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAlertOn), v))
+	})
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEnabled), v))
+	})
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEnabled), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Geofence {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Geofence(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// HasCars applies the HasEdge predicate on the "cars" edge.
+func HasCars() predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, CarsTable, CarsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarsWith applies the HasEdge predicate on the "cars" edge with a given conditions (other predicates).
+func HasCarsWith(preds ...predicate.Car) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, CarsTable, CarsPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Geofence) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Geofence) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Geofence) predicate.Geofence {
+	return predicate.Geofence(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
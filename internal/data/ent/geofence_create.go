@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/geofence"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GeofenceCreate is the builder for creating a Geofence entity.
+type GeofenceCreate struct {
+	config
+	mutation *GeofenceMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (gc *GeofenceCreate) SetTenantID(i int64) *GeofenceCreate {
+	gc.mutation.SetTenantID(i)
+	return gc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillableTenantID(i *int64) *GeofenceCreate {
+	if i != nil {
+		gc.SetTenantID(*i)
+	}
+	return gc
+}
+
+// SetName sets the "name" field.
+func (gc *GeofenceCreate) SetName(s string) *GeofenceCreate {
+	gc.mutation.SetName(s)
+	return gc
+}
+
+// SetPolygon sets the "polygon" field.
+func (gc *GeofenceCreate) SetPolygon(s string) *GeofenceCreate {
+	gc.mutation.SetPolygon(s)
+	return gc
+}
+
+// SetNillablePolygon sets the "polygon" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillablePolygon(s *string) *GeofenceCreate {
+	if s != nil {
+		gc.SetPolygon(*s)
+	}
+	return gc
+}
+
+// SetDays sets the "days" field.
+func (gc *GeofenceCreate) SetDays(s string) *GeofenceCreate {
+	gc.mutation.SetDays(s)
+	return gc
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillableDays(s *string) *GeofenceCreate {
+	if s != nil {
+		gc.SetDays(*s)
+	}
+	return gc
+}
+
+// SetStartTime sets the "start_time" field.
+func (gc *GeofenceCreate) SetStartTime(s string) *GeofenceCreate {
+	gc.mutation.SetStartTime(s)
+	return gc
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillableStartTime(s *string) *GeofenceCreate {
+	if s != nil {
+		gc.SetStartTime(*s)
+	}
+	return gc
+}
+
+// SetEndTime sets the "end_time" field.
+func (gc *GeofenceCreate) SetEndTime(s string) *GeofenceCreate {
+	gc.mutation.SetEndTime(s)
+	return gc
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillableEndTime(s *string) *GeofenceCreate {
+	if s != nil {
+		gc.SetEndTime(*s)
+	}
+	return gc
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (gc *GeofenceCreate) SetTimeZone(s string) *GeofenceCreate {
+	gc.mutation.SetTimeZone(s)
+	return gc
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillableTimeZone(s *string) *GeofenceCreate {
+	if s != nil {
+		gc.SetTimeZone(*s)
+	}
+	return gc
+}
+
+// SetAlertOn sets the "alert_on" field.
+func (gc *GeofenceCreate) SetAlertOn(s string) *GeofenceCreate {
+	gc.mutation.SetAlertOn(s)
+	return gc
+}
+
+// SetNillableAlertOn sets the "alert_on" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillableAlertOn(s *string) *GeofenceCreate {
+	if s != nil {
+		gc.SetAlertOn(*s)
+	}
+	return gc
+}
+
+// SetEnabled sets the "enabled" field.
+func (gc *GeofenceCreate) SetEnabled(b bool) *GeofenceCreate {
+	gc.mutation.SetEnabled(b)
+	return gc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillableEnabled(b *bool) *GeofenceCreate {
+	if b != nil {
+		gc.SetEnabled(*b)
+	}
+	return gc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gc *GeofenceCreate) SetCreatedAt(t time.Time) *GeofenceCreate {
+	gc.mutation.SetCreatedAt(t)
+	return gc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillableCreatedAt(t *time.Time) *GeofenceCreate {
+	if t != nil {
+		gc.SetCreatedAt(*t)
+	}
+	return gc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gc *GeofenceCreate) SetUpdatedAt(t time.Time) *GeofenceCreate {
+	gc.mutation.SetUpdatedAt(t)
+	return gc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gc *GeofenceCreate) SetNillableUpdatedAt(t *time.Time) *GeofenceCreate {
+	if t != nil {
+		gc.SetUpdatedAt(*t)
+	}
+	return gc
+}
+
+// SetID sets the "id" field.
+func (gc *GeofenceCreate) SetID(i int64) *GeofenceCreate {
+	gc.mutation.SetID(i)
+	return gc
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (gc *GeofenceCreate) AddCarIDs(ids ...int64) *GeofenceCreate {
+	gc.mutation.AddCarIDs(ids...)
+	return gc
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (gc *GeofenceCreate) AddCars(c ...*Car) *GeofenceCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return gc.AddCarIDs(ids...)
+}
+
+// Mutation returns the GeofenceMutation object of the builder.
+func (gc *GeofenceCreate) Mutation() *GeofenceMutation {
+	return gc.mutation
+}
+
+// Save creates the Geofence in the database.
+func (gc *GeofenceCreate) Save(ctx context.Context) (*Geofence, error) {
+	var (
+		err  error
+		node *Geofence
+	)
+	if err := gc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(gc.hooks) == 0 {
+		if err = gc.check(); err != nil {
+			return nil, err
+		}
+		node, err = gc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GeofenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = gc.check(); err != nil {
+				return nil, err
+			}
+			gc.mutation = mutation
+			if node, err = gc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(gc.hooks) - 1; i >= 0; i-- {
+			if gc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = gc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, gc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Geofence)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from GeofenceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gc *GeofenceCreate) SaveX(ctx context.Context) *Geofence {
+	v, err := gc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gc *GeofenceCreate) Exec(ctx context.Context) error {
+	_, err := gc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gc *GeofenceCreate) ExecX(ctx context.Context) {
+	if err := gc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gc *GeofenceCreate) defaults() error {
+	if _, ok := gc.mutation.AlertOn(); !ok {
+		v := geofence.DefaultAlertOn
+		gc.mutation.SetAlertOn(v)
+	}
+	if _, ok := gc.mutation.Enabled(); !ok {
+		v := geofence.DefaultEnabled
+		gc.mutation.SetEnabled(v)
+	}
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		if geofence.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized geofence.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := geofence.DefaultCreatedAt()
+		gc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := gc.mutation.UpdatedAt(); !ok {
+		if geofence.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized geofence.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := geofence.DefaultUpdatedAt()
+		gc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gc *GeofenceCreate) check() error {
+	if _, ok := gc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Geofence.name"`)}
+	}
+	if _, ok := gc.mutation.AlertOn(); !ok {
+		return &ValidationError{Name: "alert_on", err: errors.New(`ent: missing required field "Geofence.alert_on"`)}
+	}
+	if _, ok := gc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Geofence.enabled"`)}
+	}
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Geofence.created_at"`)}
+	}
+	if _, ok := gc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Geofence.updated_at"`)}
+	}
+	return nil
+}
+
+func (gc *GeofenceCreate) sqlSave(ctx context.Context) (*Geofence, error) {
+	_node, _spec := gc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (gc *GeofenceCreate) createSpec() (*Geofence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Geofence{config: gc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: geofence.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: geofence.FieldID,
+			},
+		}
+	)
+	if id, ok := gc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := gc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: geofence.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := gc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := gc.mutation.Polygon(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldPolygon,
+		})
+		_node.Polygon = value
+	}
+	if value, ok := gc.mutation.Days(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldDays,
+		})
+		_node.Days = value
+	}
+	if value, ok := gc.mutation.StartTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldStartTime,
+		})
+		_node.StartTime = value
+	}
+	if value, ok := gc.mutation.EndTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldEndTime,
+		})
+		_node.EndTime = value
+	}
+	if value, ok := gc.mutation.TimeZone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldTimeZone,
+		})
+		_node.TimeZone = value
+	}
+	if value, ok := gc.mutation.AlertOn(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldAlertOn,
+		})
+		_node.AlertOn = value
+	}
+	if value, ok := gc.mutation.Enabled(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: geofence.FieldEnabled,
+		})
+		_node.Enabled = value
+	}
+	if value, ok := gc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: geofence.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := gc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: geofence.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := gc.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   geofence.CarsTable,
+			Columns: geofence.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GeofenceCreateBulk is the builder for creating many Geofence entities in bulk.
+type GeofenceCreateBulk struct {
+	config
+	builders []*GeofenceCreate
+}
+
+// Save creates the Geofence entities in the database.
+func (gcb *GeofenceCreateBulk) Save(ctx context.Context) ([]*Geofence, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
+	nodes := make([]*Geofence, len(gcb.builders))
+	mutators := make([]Mutator, len(gcb.builders))
+	for i := range gcb.builders {
+		func(i int, root context.Context) {
+			builder := gcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GeofenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcb *GeofenceCreateBulk) SaveX(ctx context.Context) []*Geofence {
+	v, err := gcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gcb *GeofenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := gcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcb *GeofenceCreateBulk) ExecX(ctx context.Context) {
+	if err := gcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GeofenceQuery is the builder for querying Geofence entities.
+type GeofenceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Geofence
+	// eager-loading edges.
+	withCars  *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GeofenceQuery builder.
+func (gq *GeofenceQuery) Where(ps ...predicate.Geofence) *GeofenceQuery {
+	gq.predicates = append(gq.predicates, ps...)
+	return gq
+}
+
+// Limit adds a limit step to the query.
+func (gq *GeofenceQuery) Limit(limit int) *GeofenceQuery {
+	gq.limit = &limit
+	return gq
+}
+
+// Offset adds an offset step to the query.
+func (gq *GeofenceQuery) Offset(offset int) *GeofenceQuery {
+	gq.offset = &offset
+	return gq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gq *GeofenceQuery) Unique(unique bool) *GeofenceQuery {
+	gq.unique = &unique
+	return gq
+}
+
+// Order adds an order step to the query.
+func (gq *GeofenceQuery) Order(o ...OrderFunc) *GeofenceQuery {
+	gq.order = append(gq.order, o...)
+	return gq
+}
+
+// QueryCars chains the current query on the "cars" edge.
+func (gq *GeofenceQuery) QueryCars() *CarQuery {
+	query := &CarQuery{config: gq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(geofence.Table, geofence.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, geofence.CarsTable, geofence.CarsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Geofence entity from the query.
+// Returns a *NotFoundError when no Geofence was found.
+func (gq *GeofenceQuery) First(ctx context.Context) (*Geofence, error) {
+	nodes, err := gq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{geofence.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gq *GeofenceQuery) FirstX(ctx context.Context) *Geofence {
+	node, err := gq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Geofence ID from the query.
+// Returns a *NotFoundError when no Geofence ID was found.
+func (gq *GeofenceQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = gq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{geofence.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gq *GeofenceQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := gq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Geofence entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Geofence entity is found.
+// Returns a *NotFoundError when no Geofence entities are found.
+func (gq *GeofenceQuery) Only(ctx context.Context) (*Geofence, error) {
+	nodes, err := gq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{geofence.Label}
+	default:
+		return nil, &NotSingularError{geofence.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gq *GeofenceQuery) OnlyX(ctx context.Context) *Geofence {
+	node, err := gq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Geofence ID in the query.
+// Returns a *NotSingularError when more than one Geofence ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gq *GeofenceQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = gq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{geofence.Label}
+	default:
+		err = &NotSingularError{geofence.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gq *GeofenceQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := gq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Geofences.
+func (gq *GeofenceQuery) All(ctx context.Context) ([]*Geofence, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return gq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gq *GeofenceQuery) AllX(ctx context.Context) []*Geofence {
+	nodes, err := gq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Geofence IDs.
+func (gq *GeofenceQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := gq.Select(geofence.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gq *GeofenceQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := gq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gq *GeofenceQuery) Count(ctx context.Context) (int, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return gq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gq *GeofenceQuery) CountX(ctx context.Context) int {
+	count, err := gq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gq *GeofenceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return gq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gq *GeofenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := gq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GeofenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gq *GeofenceQuery) Clone() *GeofenceQuery {
+	if gq == nil {
+		return nil
+	}
+	return &GeofenceQuery{
+		config:     gq.config,
+		limit:      gq.limit,
+		offset:     gq.offset,
+		order:      append([]OrderFunc{}, gq.order...),
+		predicates: append([]predicate.Geofence{}, gq.predicates...),
+		withCars:   gq.withCars.Clone(),
+		// clone intermediate query.
+		sql:    gq.sql.Clone(),
+		path:   gq.path,
+		unique: gq.unique,
+	}
+}
+
+// WithCars tells the query-builder to eager-load the nodes that are connected to
+// the "cars" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GeofenceQuery) WithCars(opts ...func(*CarQuery)) *GeofenceQuery {
+	query := &CarQuery{config: gq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withCars = query
+	return gq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Geofence.Query().
+//		GroupBy(geofence.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (gq *GeofenceQuery) GroupBy(field string, fields ...string) *GeofenceGroupBy {
+	grbuild := &GeofenceGroupBy{config: gq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return gq.sqlQuery(ctx), nil
+	}
+	grbuild.label = geofence.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.Geofence.Query().
+//		Select(geofence.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (gq *GeofenceQuery) Select(fields ...string) *GeofenceSelect {
+	gq.fields = append(gq.fields, fields...)
+	selbuild := &GeofenceSelect{GeofenceQuery: gq}
+	selbuild.label = geofence.Label
+	selbuild.flds, selbuild.scan = &gq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (gq *GeofenceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range gq.fields {
+		if !geofence.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gq.path != nil {
+		prev, err := gq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gq.sql = prev
+	}
+	if geofence.Policy == nil {
+		return errors.New("ent: uninitialized geofence.Policy (forgotten import ent/runtime?)")
+	}
+	if err := geofence.Policy.EvalQuery(ctx, gq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (gq *GeofenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Geofence, error) {
+	var (
+		nodes       = []*Geofence{}
+		_spec       = gq.querySpec()
+		loadedTypes = [1]bool{
+			gq.withCars != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Geofence).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Geofence{config: gq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := gq.withCars; query != nil {
+		edgeids := make([]driver.Value, len(nodes))
+		byid := make(map[int64]*Geofence)
+		nids := make(map[int64]map[*Geofence]struct{})
+		for i, node := range nodes {
+			edgeids[i] = node.ID
+			byid[node.ID] = node
+			node.Edges.Cars = []*Car{}
+		}
+		query.Where(func(s *sql.Selector) {
+			joinT := sql.Table(geofence.CarsTable)
+			s.Join(joinT).On(s.C(car.FieldID), joinT.C(geofence.CarsPrimaryKey[1]))
+			s.Where(sql.InValues(joinT.C(geofence.CarsPrimaryKey[0]), edgeids...))
+			columns := s.SelectedColumns()
+			s.Select(joinT.C(geofence.CarsPrimaryKey[0]))
+			s.AppendSelect(columns...)
+			s.SetDistinct(false)
+		})
+		neighbors, err := query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]interface{}, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]interface{}{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []interface{}) error {
+				outValue := values[0].(*sql.NullInt64).Int64
+				inValue := values[1].(*sql.NullInt64).Int64
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Geofence]struct{}{byid[outValue]: struct{}{}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byid[outValue]] = struct{}{}
+				return nil
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "cars" node returned %v`, n.ID)
+			}
+			for kn := range nodes {
+				kn.Edges.Cars = append(kn.Edges.Cars, n)
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (gq *GeofenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gq.querySpec()
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
+	_spec.Node.Columns = gq.fields
+	if len(gq.fields) > 0 {
+		_spec.Unique = gq.unique != nil && *gq.unique
+	}
+	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
+}
+
+func (gq *GeofenceQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := gq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (gq *GeofenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   geofence.Table,
+			Columns: geofence.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: geofence.FieldID,
+			},
+		},
+		From:   gq.sql,
+		Unique: true,
+	}
+	if unique := gq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := gq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, geofence.FieldID)
+		for i := range fields {
+			if fields[i] != geofence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gq *GeofenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gq.driver.Dialect())
+	t1 := builder.Table(geofence.Table)
+	columns := gq.fields
+	if len(columns) == 0 {
+		columns = geofence.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gq.sql != nil {
+		selector = gq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gq.unique != nil && *gq.unique {
+		selector.Distinct()
+	}
+	for _, m := range gq.modifiers {
+		m(selector)
+	}
+	for _, p := range gq.predicates {
+		p(selector)
+	}
+	for _, p := range gq.order {
+		p(selector)
+	}
+	if offset := gq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (gq *GeofenceQuery) ForUpdate(opts ...sql.LockOption) *GeofenceQuery {
+	if gq.driver.Dialect() == dialect.Postgres {
+		gq.Unique(false)
+	}
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return gq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (gq *GeofenceQuery) ForShare(opts ...sql.LockOption) *GeofenceQuery {
+	if gq.driver.Dialect() == dialect.Postgres {
+		gq.Unique(false)
+	}
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return gq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gq *GeofenceQuery) Modify(modifiers ...func(s *sql.Selector)) *GeofenceSelect {
+	gq.modifiers = append(gq.modifiers, modifiers...)
+	return gq.Select()
+}
+
+// GeofenceGroupBy is the group-by builder for Geofence entities.
+type GeofenceGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ggb *GeofenceGroupBy) Aggregate(fns ...AggregateFunc) *GeofenceGroupBy {
+	ggb.fns = append(ggb.fns, fns...)
+	return ggb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ggb *GeofenceGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ggb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ggb.sql = query
+	return ggb.sqlScan(ctx, v)
+}
+
+func (ggb *GeofenceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ggb.fields {
+		if !geofence.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ggb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ggb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ggb *GeofenceGroupBy) sqlQuery() *sql.Selector {
+	selector := ggb.sql.Select()
+	aggregation := make([]string, 0, len(ggb.fns))
+	for _, fn := range ggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ggb.fields)+len(ggb.fns))
+		for _, f := range ggb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ggb.fields...)...)
+}
+
+// GeofenceSelect is the builder for selecting fields of Geofence entities.
+type GeofenceSelect struct {
+	*GeofenceQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gs *GeofenceSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := gs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	gs.sql = gs.GeofenceQuery.sqlQuery(ctx)
+	return gs.sqlScan(ctx, v)
+}
+
+func (gs *GeofenceSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := gs.sql.Query()
+	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gs *GeofenceSelect) Modify(modifiers ...func(s *sql.Selector)) *GeofenceSelect {
+	gs.modifiers = append(gs.modifiers, modifiers...)
+	return gs
+}
@@ -0,0 +1,1045 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GeofenceUpdate is the builder for updating Geofence entities.
+type GeofenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GeofenceMutation
+}
+
+// Where appends a list predicates to the GeofenceUpdate builder.
+func (gu *GeofenceUpdate) Where(ps ...predicate.Geofence) *GeofenceUpdate {
+	gu.mutation.Where(ps...)
+	return gu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (gu *GeofenceUpdate) SetTenantID(i int64) *GeofenceUpdate {
+	gu.mutation.ResetTenantID()
+	gu.mutation.SetTenantID(i)
+	return gu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillableTenantID(i *int64) *GeofenceUpdate {
+	if i != nil {
+		gu.SetTenantID(*i)
+	}
+	return gu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (gu *GeofenceUpdate) AddTenantID(i int64) *GeofenceUpdate {
+	gu.mutation.AddTenantID(i)
+	return gu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (gu *GeofenceUpdate) ClearTenantID() *GeofenceUpdate {
+	gu.mutation.ClearTenantID()
+	return gu
+}
+
+// SetName sets the "name" field.
+func (gu *GeofenceUpdate) SetName(s string) *GeofenceUpdate {
+	gu.mutation.SetName(s)
+	return gu
+}
+
+// SetPolygon sets the "polygon" field.
+func (gu *GeofenceUpdate) SetPolygon(s string) *GeofenceUpdate {
+	gu.mutation.SetPolygon(s)
+	return gu
+}
+
+// SetNillablePolygon sets the "polygon" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillablePolygon(s *string) *GeofenceUpdate {
+	if s != nil {
+		gu.SetPolygon(*s)
+	}
+	return gu
+}
+
+// ClearPolygon clears the value of the "polygon" field.
+func (gu *GeofenceUpdate) ClearPolygon() *GeofenceUpdate {
+	gu.mutation.ClearPolygon()
+	return gu
+}
+
+// SetDays sets the "days" field.
+func (gu *GeofenceUpdate) SetDays(s string) *GeofenceUpdate {
+	gu.mutation.SetDays(s)
+	return gu
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillableDays(s *string) *GeofenceUpdate {
+	if s != nil {
+		gu.SetDays(*s)
+	}
+	return gu
+}
+
+// ClearDays clears the value of the "days" field.
+func (gu *GeofenceUpdate) ClearDays() *GeofenceUpdate {
+	gu.mutation.ClearDays()
+	return gu
+}
+
+// SetStartTime sets the "start_time" field.
+func (gu *GeofenceUpdate) SetStartTime(s string) *GeofenceUpdate {
+	gu.mutation.SetStartTime(s)
+	return gu
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillableStartTime(s *string) *GeofenceUpdate {
+	if s != nil {
+		gu.SetStartTime(*s)
+	}
+	return gu
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (gu *GeofenceUpdate) ClearStartTime() *GeofenceUpdate {
+	gu.mutation.ClearStartTime()
+	return gu
+}
+
+// SetEndTime sets the "end_time" field.
+func (gu *GeofenceUpdate) SetEndTime(s string) *GeofenceUpdate {
+	gu.mutation.SetEndTime(s)
+	return gu
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillableEndTime(s *string) *GeofenceUpdate {
+	if s != nil {
+		gu.SetEndTime(*s)
+	}
+	return gu
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (gu *GeofenceUpdate) ClearEndTime() *GeofenceUpdate {
+	gu.mutation.ClearEndTime()
+	return gu
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (gu *GeofenceUpdate) SetTimeZone(s string) *GeofenceUpdate {
+	gu.mutation.SetTimeZone(s)
+	return gu
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillableTimeZone(s *string) *GeofenceUpdate {
+	if s != nil {
+		gu.SetTimeZone(*s)
+	}
+	return gu
+}
+
+// ClearTimeZone clears the value of the "time_zone" field.
+func (gu *GeofenceUpdate) ClearTimeZone() *GeofenceUpdate {
+	gu.mutation.ClearTimeZone()
+	return gu
+}
+
+// SetAlertOn sets the "alert_on" field.
+func (gu *GeofenceUpdate) SetAlertOn(s string) *GeofenceUpdate {
+	gu.mutation.SetAlertOn(s)
+	return gu
+}
+
+// SetNillableAlertOn sets the "alert_on" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillableAlertOn(s *string) *GeofenceUpdate {
+	if s != nil {
+		gu.SetAlertOn(*s)
+	}
+	return gu
+}
+
+// SetEnabled sets the "enabled" field.
+func (gu *GeofenceUpdate) SetEnabled(b bool) *GeofenceUpdate {
+	gu.mutation.SetEnabled(b)
+	return gu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillableEnabled(b *bool) *GeofenceUpdate {
+	if b != nil {
+		gu.SetEnabled(*b)
+	}
+	return gu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gu *GeofenceUpdate) SetCreatedAt(t time.Time) *GeofenceUpdate {
+	gu.mutation.SetCreatedAt(t)
+	return gu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillableCreatedAt(t *time.Time) *GeofenceUpdate {
+	if t != nil {
+		gu.SetCreatedAt(*t)
+	}
+	return gu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gu *GeofenceUpdate) SetUpdatedAt(t time.Time) *GeofenceUpdate {
+	gu.mutation.SetUpdatedAt(t)
+	return gu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gu *GeofenceUpdate) SetNillableUpdatedAt(t *time.Time) *GeofenceUpdate {
+	if t != nil {
+		gu.SetUpdatedAt(*t)
+	}
+	return gu
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (gu *GeofenceUpdate) AddCarIDs(ids ...int64) *GeofenceUpdate {
+	gu.mutation.AddCarIDs(ids...)
+	return gu
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (gu *GeofenceUpdate) AddCars(c ...*Car) *GeofenceUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return gu.AddCarIDs(ids...)
+}
+
+// Mutation returns the GeofenceMutation object of the builder.
+func (gu *GeofenceUpdate) Mutation() *GeofenceMutation {
+	return gu.mutation
+}
+
+// ClearCars clears all "cars" edges to the Car entity.
+func (gu *GeofenceUpdate) ClearCars() *GeofenceUpdate {
+	gu.mutation.ClearCars()
+	return gu
+}
+
+// RemoveCarIDs removes the "cars" edge to Car entities by IDs.
+func (gu *GeofenceUpdate) RemoveCarIDs(ids ...int64) *GeofenceUpdate {
+	gu.mutation.RemoveCarIDs(ids...)
+	return gu
+}
+
+// RemoveCars removes "cars" edges to Car entities.
+func (gu *GeofenceUpdate) RemoveCars(c ...*Car) *GeofenceUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return gu.RemoveCarIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gu *GeofenceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(gu.hooks) == 0 {
+		affected, err = gu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GeofenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			gu.mutation = mutation
+			affected, err = gu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(gu.hooks) - 1; i >= 0; i-- {
+			if gu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = gu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, gu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gu *GeofenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := gu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gu *GeofenceUpdate) Exec(ctx context.Context) error {
+	_, err := gu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gu *GeofenceUpdate) ExecX(ctx context.Context) {
+	if err := gu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (gu *GeofenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   geofence.Table,
+			Columns: geofence.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: geofence.FieldID,
+			},
+		},
+	}
+	if ps := gu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: geofence.FieldTenantID,
+		})
+	}
+	if value, ok := gu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: geofence.FieldTenantID,
+		})
+	}
+	if gu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: geofence.FieldTenantID,
+		})
+	}
+	if value, ok := gu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldName,
+		})
+	}
+	if value, ok := gu.mutation.Polygon(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldPolygon,
+		})
+	}
+	if gu.mutation.PolygonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldPolygon,
+		})
+	}
+	if value, ok := gu.mutation.Days(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldDays,
+		})
+	}
+	if gu.mutation.DaysCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldDays,
+		})
+	}
+	if value, ok := gu.mutation.StartTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldStartTime,
+		})
+	}
+	if gu.mutation.StartTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldStartTime,
+		})
+	}
+	if value, ok := gu.mutation.EndTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldEndTime,
+		})
+	}
+	if gu.mutation.EndTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldEndTime,
+		})
+	}
+	if value, ok := gu.mutation.TimeZone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldTimeZone,
+		})
+	}
+	if gu.mutation.TimeZoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldTimeZone,
+		})
+	}
+	if value, ok := gu.mutation.AlertOn(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldAlertOn,
+		})
+	}
+	if value, ok := gu.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: geofence.FieldEnabled,
+		})
+	}
+	if value, ok := gu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: geofence.FieldCreatedAt,
+		})
+	}
+	if value, ok := gu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: geofence.FieldUpdatedAt,
+		})
+	}
+	if gu.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   geofence.CarsTable,
+			Columns: geofence.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedCarsIDs(); len(nodes) > 0 && !gu.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   geofence.CarsTable,
+			Columns: geofence.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   geofence.CarsTable,
+			Columns: geofence.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{geofence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// GeofenceUpdateOne is the builder for updating a single Geofence entity.
+type GeofenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GeofenceMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (guo *GeofenceUpdateOne) SetTenantID(i int64) *GeofenceUpdateOne {
+	guo.mutation.ResetTenantID()
+	guo.mutation.SetTenantID(i)
+	return guo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillableTenantID(i *int64) *GeofenceUpdateOne {
+	if i != nil {
+		guo.SetTenantID(*i)
+	}
+	return guo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (guo *GeofenceUpdateOne) AddTenantID(i int64) *GeofenceUpdateOne {
+	guo.mutation.AddTenantID(i)
+	return guo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (guo *GeofenceUpdateOne) ClearTenantID() *GeofenceUpdateOne {
+	guo.mutation.ClearTenantID()
+	return guo
+}
+
+// SetName sets the "name" field.
+func (guo *GeofenceUpdateOne) SetName(s string) *GeofenceUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetPolygon sets the "polygon" field.
+func (guo *GeofenceUpdateOne) SetPolygon(s string) *GeofenceUpdateOne {
+	guo.mutation.SetPolygon(s)
+	return guo
+}
+
+// SetNillablePolygon sets the "polygon" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillablePolygon(s *string) *GeofenceUpdateOne {
+	if s != nil {
+		guo.SetPolygon(*s)
+	}
+	return guo
+}
+
+// ClearPolygon clears the value of the "polygon" field.
+func (guo *GeofenceUpdateOne) ClearPolygon() *GeofenceUpdateOne {
+	guo.mutation.ClearPolygon()
+	return guo
+}
+
+// SetDays sets the "days" field.
+func (guo *GeofenceUpdateOne) SetDays(s string) *GeofenceUpdateOne {
+	guo.mutation.SetDays(s)
+	return guo
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillableDays(s *string) *GeofenceUpdateOne {
+	if s != nil {
+		guo.SetDays(*s)
+	}
+	return guo
+}
+
+// ClearDays clears the value of the "days" field.
+func (guo *GeofenceUpdateOne) ClearDays() *GeofenceUpdateOne {
+	guo.mutation.ClearDays()
+	return guo
+}
+
+// SetStartTime sets the "start_time" field.
+func (guo *GeofenceUpdateOne) SetStartTime(s string) *GeofenceUpdateOne {
+	guo.mutation.SetStartTime(s)
+	return guo
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillableStartTime(s *string) *GeofenceUpdateOne {
+	if s != nil {
+		guo.SetStartTime(*s)
+	}
+	return guo
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (guo *GeofenceUpdateOne) ClearStartTime() *GeofenceUpdateOne {
+	guo.mutation.ClearStartTime()
+	return guo
+}
+
+// SetEndTime sets the "end_time" field.
+func (guo *GeofenceUpdateOne) SetEndTime(s string) *GeofenceUpdateOne {
+	guo.mutation.SetEndTime(s)
+	return guo
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillableEndTime(s *string) *GeofenceUpdateOne {
+	if s != nil {
+		guo.SetEndTime(*s)
+	}
+	return guo
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (guo *GeofenceUpdateOne) ClearEndTime() *GeofenceUpdateOne {
+	guo.mutation.ClearEndTime()
+	return guo
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (guo *GeofenceUpdateOne) SetTimeZone(s string) *GeofenceUpdateOne {
+	guo.mutation.SetTimeZone(s)
+	return guo
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillableTimeZone(s *string) *GeofenceUpdateOne {
+	if s != nil {
+		guo.SetTimeZone(*s)
+	}
+	return guo
+}
+
+// ClearTimeZone clears the value of the "time_zone" field.
+func (guo *GeofenceUpdateOne) ClearTimeZone() *GeofenceUpdateOne {
+	guo.mutation.ClearTimeZone()
+	return guo
+}
+
+// SetAlertOn sets the "alert_on" field.
+func (guo *GeofenceUpdateOne) SetAlertOn(s string) *GeofenceUpdateOne {
+	guo.mutation.SetAlertOn(s)
+	return guo
+}
+
+// SetNillableAlertOn sets the "alert_on" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillableAlertOn(s *string) *GeofenceUpdateOne {
+	if s != nil {
+		guo.SetAlertOn(*s)
+	}
+	return guo
+}
+
+// SetEnabled sets the "enabled" field.
+func (guo *GeofenceUpdateOne) SetEnabled(b bool) *GeofenceUpdateOne {
+	guo.mutation.SetEnabled(b)
+	return guo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillableEnabled(b *bool) *GeofenceUpdateOne {
+	if b != nil {
+		guo.SetEnabled(*b)
+	}
+	return guo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (guo *GeofenceUpdateOne) SetCreatedAt(t time.Time) *GeofenceUpdateOne {
+	guo.mutation.SetCreatedAt(t)
+	return guo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillableCreatedAt(t *time.Time) *GeofenceUpdateOne {
+	if t != nil {
+		guo.SetCreatedAt(*t)
+	}
+	return guo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (guo *GeofenceUpdateOne) SetUpdatedAt(t time.Time) *GeofenceUpdateOne {
+	guo.mutation.SetUpdatedAt(t)
+	return guo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (guo *GeofenceUpdateOne) SetNillableUpdatedAt(t *time.Time) *GeofenceUpdateOne {
+	if t != nil {
+		guo.SetUpdatedAt(*t)
+	}
+	return guo
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (guo *GeofenceUpdateOne) AddCarIDs(ids ...int64) *GeofenceUpdateOne {
+	guo.mutation.AddCarIDs(ids...)
+	return guo
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (guo *GeofenceUpdateOne) AddCars(c ...*Car) *GeofenceUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return guo.AddCarIDs(ids...)
+}
+
+// Mutation returns the GeofenceMutation object of the builder.
+func (guo *GeofenceUpdateOne) Mutation() *GeofenceMutation {
+	return guo.mutation
+}
+
+// ClearCars clears all "cars" edges to the Car entity.
+func (guo *GeofenceUpdateOne) ClearCars() *GeofenceUpdateOne {
+	guo.mutation.ClearCars()
+	return guo
+}
+
+// RemoveCarIDs removes the "cars" edge to Car entities by IDs.
+func (guo *GeofenceUpdateOne) RemoveCarIDs(ids ...int64) *GeofenceUpdateOne {
+	guo.mutation.RemoveCarIDs(ids...)
+	return guo
+}
+
+// RemoveCars removes "cars" edges to Car entities.
+func (guo *GeofenceUpdateOne) RemoveCars(c ...*Car) *GeofenceUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return guo.RemoveCarIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (guo *GeofenceUpdateOne) Select(field string, fields ...string) *GeofenceUpdateOne {
+	guo.fields = append([]string{field}, fields...)
+	return guo
+}
+
+// Save executes the query and returns the updated Geofence entity.
+func (guo *GeofenceUpdateOne) Save(ctx context.Context) (*Geofence, error) {
+	var (
+		err  error
+		node *Geofence
+	)
+	if len(guo.hooks) == 0 {
+		node, err = guo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GeofenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			guo.mutation = mutation
+			node, err = guo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(guo.hooks) - 1; i >= 0; i-- {
+			if guo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = guo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, guo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Geofence)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from GeofenceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (guo *GeofenceUpdateOne) SaveX(ctx context.Context) *Geofence {
+	node, err := guo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (guo *GeofenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := guo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (guo *GeofenceUpdateOne) ExecX(ctx context.Context) {
+	if err := guo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (guo *GeofenceUpdateOne) sqlSave(ctx context.Context) (_node *Geofence, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   geofence.Table,
+			Columns: geofence.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: geofence.FieldID,
+			},
+		},
+	}
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Geofence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := guo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, geofence.FieldID)
+		for _, f := range fields {
+			if !geofence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != geofence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := guo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := guo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: geofence.FieldTenantID,
+		})
+	}
+	if value, ok := guo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: geofence.FieldTenantID,
+		})
+	}
+	if guo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: geofence.FieldTenantID,
+		})
+	}
+	if value, ok := guo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldName,
+		})
+	}
+	if value, ok := guo.mutation.Polygon(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldPolygon,
+		})
+	}
+	if guo.mutation.PolygonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldPolygon,
+		})
+	}
+	if value, ok := guo.mutation.Days(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldDays,
+		})
+	}
+	if guo.mutation.DaysCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldDays,
+		})
+	}
+	if value, ok := guo.mutation.StartTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldStartTime,
+		})
+	}
+	if guo.mutation.StartTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldStartTime,
+		})
+	}
+	if value, ok := guo.mutation.EndTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldEndTime,
+		})
+	}
+	if guo.mutation.EndTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldEndTime,
+		})
+	}
+	if value, ok := guo.mutation.TimeZone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldTimeZone,
+		})
+	}
+	if guo.mutation.TimeZoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: geofence.FieldTimeZone,
+		})
+	}
+	if value, ok := guo.mutation.AlertOn(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: geofence.FieldAlertOn,
+		})
+	}
+	if value, ok := guo.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: geofence.FieldEnabled,
+		})
+	}
+	if value, ok := guo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: geofence.FieldCreatedAt,
+		})
+	}
+	if value, ok := guo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: geofence.FieldUpdatedAt,
+		})
+	}
+	if guo.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   geofence.CarsTable,
+			Columns: geofence.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedCarsIDs(); len(nodes) > 0 && !guo.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   geofence.CarsTable,
+			Columns: geofence.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   geofence.CarsTable,
+			Columns: geofence.CarsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Geofence{config: guo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{geofence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
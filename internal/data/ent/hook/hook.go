@@ -8,6 +8,58 @@ import (
 	"fmt"
 )
 
+// The AttachmentFunc type is an adapter to allow the use of ordinary
+// function as Attachment mutator.
+type AttachmentFunc func(context.Context, *ent.AttachmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttachmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AttachmentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttachmentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The AttributeDefinitionFunc type is an adapter to allow the use of ordinary
+// function as AttributeDefinition mutator.
+type AttributeDefinitionFunc func(context.Context, *ent.AttributeDefinitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttributeDefinitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AttributeDefinitionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttributeDefinitionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AuditLogMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The BrandFunc type is an adapter to allow the use of ordinary
+// function as Brand mutator.
+type BrandFunc func(context.Context, *ent.BrandMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BrandFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BrandMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BrandMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The CarFunc type is an adapter to allow the use of ordinary
 // function as Car mutator.
 type CarFunc func(context.Context, *ent.CarMutation) (ent.Value, error)
@@ -21,6 +73,318 @@ func (f CarFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return f(ctx, mv)
 }
 
+// The CarAttributeFunc type is an adapter to allow the use of ordinary
+// function as CarAttribute mutator.
+type CarAttributeFunc func(context.Context, *ent.CarAttributeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CarAttributeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.CarAttributeMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CarAttributeMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The CarRecallFunc type is an adapter to allow the use of ordinary
+// function as CarRecall mutator.
+type CarRecallFunc func(context.Context, *ent.CarRecallMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CarRecallFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.CarRecallMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CarRecallMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The CarWarrantyFunc type is an adapter to allow the use of ordinary
+// function as CarWarranty mutator.
+type CarWarrantyFunc func(context.Context, *ent.CarWarrantyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CarWarrantyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.CarWarrantyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CarWarrantyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ChargingSessionFunc type is an adapter to allow the use of ordinary
+// function as ChargingSession mutator.
+type ChargingSessionFunc func(context.Context, *ent.ChargingSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChargingSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ChargingSessionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChargingSessionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The FleetFunc type is an adapter to allow the use of ordinary
+// function as Fleet mutator.
+type FleetFunc func(context.Context, *ent.FleetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FleetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.FleetMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FleetMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The GeofenceFunc type is an adapter to allow the use of ordinary
+// function as Geofence mutator.
+type GeofenceFunc func(context.Context, *ent.GeofenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GeofenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.GeofenceMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GeofenceMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The IncidentFunc type is an adapter to allow the use of ordinary
+// function as Incident mutator.
+type IncidentFunc func(context.Context, *ent.IncidentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IncidentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.IncidentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IncidentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InsurancePolicyFunc type is an adapter to allow the use of ordinary
+// function as InsurancePolicy mutator.
+type InsurancePolicyFunc func(context.Context, *ent.InsurancePolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InsurancePolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InsurancePolicyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InsurancePolicyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The JobRunFunc type is an adapter to allow the use of ordinary
+// function as JobRun mutator.
+type JobRunFunc func(context.Context, *ent.JobRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.JobRunMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobRunMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The LeaseContractFunc type is an adapter to allow the use of ordinary
+// function as LeaseContract mutator.
+type LeaseContractFunc func(context.Context, *ent.LeaseContractMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaseContractFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.LeaseContractMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaseContractMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ListingFunc type is an adapter to allow the use of ordinary
+// function as Listing mutator.
+type ListingFunc func(context.Context, *ent.ListingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ListingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ListingMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ListingMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The MaintenanceRecordFunc type is an adapter to allow the use of ordinary
+// function as MaintenanceRecord mutator.
+type MaintenanceRecordFunc func(context.Context, *ent.MaintenanceRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MaintenanceRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.MaintenanceRecordMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MaintenanceRecordMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The OdometerReadingFunc type is an adapter to allow the use of ordinary
+// function as OdometerReading mutator.
+type OdometerReadingFunc func(context.Context, *ent.OdometerReadingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OdometerReadingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.OdometerReadingMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OdometerReadingMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The OwnerHistoryFunc type is an adapter to allow the use of ordinary
+// function as OwnerHistory mutator.
+type OwnerHistoryFunc func(context.Context, *ent.OwnerHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OwnerHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.OwnerHistoryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OwnerHistoryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The RecallFunc type is an adapter to allow the use of ordinary
+// function as Recall mutator.
+type RecallFunc func(context.Context, *ent.RecallMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecallFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.RecallMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecallMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The RefuelFunc type is an adapter to allow the use of ordinary
+// function as Refuel mutator.
+type RefuelFunc func(context.Context, *ent.RefuelMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefuelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.RefuelMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefuelMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ReservationFunc type is an adapter to allow the use of ordinary
+// function as Reservation mutator.
+type ReservationFunc func(context.Context, *ent.ReservationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReservationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ReservationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReservationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary
+// function as Tag mutator.
+type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TagMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TradeRecordFunc type is an adapter to allow the use of ordinary
+// function as TradeRecord mutator.
+type TradeRecordFunc func(context.Context, *ent.TradeRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TradeRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TradeRecordMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TradeRecordMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TransferFunc type is an adapter to allow the use of ordinary
+// function as Transfer mutator.
+type TransferFunc func(context.Context, *ent.TransferMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TransferFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TransferMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TransferMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TripFunc type is an adapter to allow the use of ordinary
+// function as Trip mutator.
+type TripFunc func(context.Context, *ent.TripMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TripFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TripMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TripMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The VehicleModelFunc type is an adapter to allow the use of ordinary
+// function as VehicleModel mutator.
+type VehicleModelFunc func(context.Context, *ent.VehicleModelMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VehicleModelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.VehicleModelMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VehicleModelMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ViolationFunc type is an adapter to allow the use of ordinary
+// function as Violation mutator.
+type ViolationFunc func(context.Context, *ent.ViolationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ViolationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ViolationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ViolationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WarrantyDefinitionFunc type is an adapter to allow the use of ordinary
+// function as WarrantyDefinition mutator.
+type WarrantyDefinitionFunc func(context.Context, *ent.WarrantyDefinitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WarrantyDefinitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WarrantyDefinitionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WarrantyDefinitionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
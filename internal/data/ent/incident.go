@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Incident is the model entity for the Incident schema.
+type Incident struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// ReporterID holds the value of the "reporter_id" field.
+	ReporterID int64 `json:"reporter_id,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// Severity holds the value of the "severity" field.
+	Severity string `json:"severity,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// PhotoIds holds the value of the "photo_ids" field.
+	PhotoIds string `json:"photo_ids,omitempty"`
+	// ThirdParties holds the value of the "third_parties" field.
+	ThirdParties string `json:"third_parties,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// PolicyID holds the value of the "policy_id" field.
+	PolicyID int64 `json:"policy_id,omitempty"`
+	// ClaimNo holds the value of the "claim_no" field.
+	ClaimNo string `json:"claim_no,omitempty"`
+	// ClaimAmount holds the value of the "claim_amount" field.
+	ClaimAmount float64 `json:"claim_amount,omitempty"`
+	// SettledAmount holds the value of the "settled_amount" field.
+	SettledAmount float64 `json:"settled_amount,omitempty"`
+	// SettledAt holds the value of the "settled_at" field.
+	SettledAt *time.Time `json:"settled_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IncidentQuery when eager-loading is set.
+	Edges IncidentEdges `json:"edges"`
+}
+
+// IncidentEdges holds the relations/edges for other nodes in the graph.
+type IncidentEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// Policy holds the value of the policy edge.
+	Policy *InsurancePolicy `json:"policy,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IncidentEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// PolicyOrErr returns the Policy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IncidentEdges) PolicyOrErr() (*InsurancePolicy, error) {
+	if e.loadedTypes[1] {
+		if e.Policy == nil {
+			// The edge policy was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: insurancepolicy.Label}
+		}
+		return e.Policy, nil
+	}
+	return nil, &NotLoadedError{edge: "policy"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Incident) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case incident.FieldClaimAmount, incident.FieldSettledAmount:
+			values[i] = new(sql.NullFloat64)
+		case incident.FieldID, incident.FieldTenantID, incident.FieldCarID, incident.FieldReporterID, incident.FieldPolicyID:
+			values[i] = new(sql.NullInt64)
+		case incident.FieldLocation, incident.FieldSeverity, incident.FieldDescription, incident.FieldPhotoIds, incident.FieldThirdParties, incident.FieldStatus, incident.FieldClaimNo:
+			values[i] = new(sql.NullString)
+		case incident.FieldOccurredAt, incident.FieldSettledAt, incident.FieldCreatedAt, incident.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Incident", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Incident fields.
+func (i *Incident) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case incident.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int64(value.Int64)
+		case incident.FieldTenantID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[j])
+			} else if value.Valid {
+				i.TenantID = value.Int64
+			}
+		case incident.FieldCarID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[j])
+			} else if value.Valid {
+				i.CarID = value.Int64
+			}
+		case incident.FieldReporterID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reporter_id", values[j])
+			} else if value.Valid {
+				i.ReporterID = value.Int64
+			}
+		case incident.FieldOccurredAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[j])
+			} else if value.Valid {
+				i.OccurredAt = value.Time
+			}
+		case incident.FieldLocation:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[j])
+			} else if value.Valid {
+				i.Location = value.String
+			}
+		case incident.FieldSeverity:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[j])
+			} else if value.Valid {
+				i.Severity = value.String
+			}
+		case incident.FieldDescription:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[j])
+			} else if value.Valid {
+				i.Description = value.String
+			}
+		case incident.FieldPhotoIds:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field photo_ids", values[j])
+			} else if value.Valid {
+				i.PhotoIds = value.String
+			}
+		case incident.FieldThirdParties:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field third_parties", values[j])
+			} else if value.Valid {
+				i.ThirdParties = value.String
+			}
+		case incident.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = value.String
+			}
+		case incident.FieldPolicyID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_id", values[j])
+			} else if value.Valid {
+				i.PolicyID = value.Int64
+			}
+		case incident.FieldClaimNo:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_no", values[j])
+			} else if value.Valid {
+				i.ClaimNo = value.String
+			}
+		case incident.FieldClaimAmount:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_amount", values[j])
+			} else if value.Valid {
+				i.ClaimAmount = value.Float64
+			}
+		case incident.FieldSettledAmount:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field settled_amount", values[j])
+			} else if value.Valid {
+				i.SettledAmount = value.Float64
+			}
+		case incident.FieldSettledAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field settled_at", values[j])
+			} else if value.Valid {
+				i.SettledAt = new(time.Time)
+				*i.SettledAt = value.Time
+			}
+		case incident.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		case incident.FieldUpdatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[j])
+			} else if value.Valid {
+				i.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the Incident entity.
+func (i *Incident) QueryCar() *CarQuery {
+	return (&IncidentClient{config: i.config}).QueryCar(i)
+}
+
+// QueryPolicy queries the "policy" edge of the Incident entity.
+func (i *Incident) QueryPolicy() *InsurancePolicyQuery {
+	return (&IncidentClient{config: i.config}).QueryPolicy(i)
+}
+
+// Update returns a builder for updating this Incident.
+// Note that you need to call Incident.Unwrap() before calling this method if this Incident
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Incident) Update() *IncidentUpdateOne {
+	return (&IncidentClient{config: i.config}).UpdateOne(i)
+}
+
+// Unwrap unwraps the Incident entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Incident) Unwrap() *Incident {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Incident is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Incident) String() string {
+	var builder strings.Builder
+	builder.WriteString("Incident(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("reporter_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.ReporterID))
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(i.OccurredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(i.Location)
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(i.Severity)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(i.Description)
+	builder.WriteString(", ")
+	builder.WriteString("photo_ids=")
+	builder.WriteString(i.PhotoIds)
+	builder.WriteString(", ")
+	builder.WriteString("third_parties=")
+	builder.WriteString(i.ThirdParties)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(i.Status)
+	builder.WriteString(", ")
+	builder.WriteString("policy_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.PolicyID))
+	builder.WriteString(", ")
+	builder.WriteString("claim_no=")
+	builder.WriteString(i.ClaimNo)
+	builder.WriteString(", ")
+	builder.WriteString("claim_amount=")
+	builder.WriteString(fmt.Sprintf("%v", i.ClaimAmount))
+	builder.WriteString(", ")
+	builder.WriteString("settled_amount=")
+	builder.WriteString(fmt.Sprintf("%v", i.SettledAmount))
+	builder.WriteString(", ")
+	if v := i.SettledAt; v != nil {
+		builder.WriteString("settled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(i.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Incidents is a parsable slice of Incident.
+type Incidents []*Incident
+
+func (i Incidents) config(cfg config) {
+	for _i := range i {
+		i[_i].config = cfg
+	}
+}
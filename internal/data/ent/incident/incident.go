@@ -0,0 +1,119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package incident
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the incident type in the database.
+	Label = "incident"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldReporterID holds the string denoting the reporter_id field in the database.
+	FieldReporterID = "reporter_id"
+	// FieldOccurredAt holds the string denoting the occurred_at field in the database.
+	FieldOccurredAt = "occurred_at"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldPhotoIds holds the string denoting the photo_ids field in the database.
+	FieldPhotoIds = "photo_ids"
+	// FieldThirdParties holds the string denoting the third_parties field in the database.
+	FieldThirdParties = "third_parties"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPolicyID holds the string denoting the policy_id field in the database.
+	FieldPolicyID = "policy_id"
+	// FieldClaimNo holds the string denoting the claim_no field in the database.
+	FieldClaimNo = "claim_no"
+	// FieldClaimAmount holds the string denoting the claim_amount field in the database.
+	FieldClaimAmount = "claim_amount"
+	// FieldSettledAmount holds the string denoting the settled_amount field in the database.
+	FieldSettledAmount = "settled_amount"
+	// FieldSettledAt holds the string denoting the settled_at field in the database.
+	FieldSettledAt = "settled_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// EdgePolicy holds the string denoting the policy edge name in mutations.
+	EdgePolicy = "policy"
+	// Table holds the table name of the incident in the database.
+	Table = "incident"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "incident"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+	// PolicyTable is the table that holds the policy relation/edge.
+	PolicyTable = "incident"
+	// PolicyInverseTable is the table name for the InsurancePolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "insurancepolicy" package.
+	PolicyInverseTable = "insurance_policy"
+	// PolicyColumn is the table column denoting the policy relation/edge.
+	PolicyColumn = "policy_id"
+)
+
+// Columns holds all SQL columns for incident fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldReporterID,
+	FieldOccurredAt,
+	FieldLocation,
+	FieldSeverity,
+	FieldDescription,
+	FieldPhotoIds,
+	FieldThirdParties,
+	FieldStatus,
+	FieldPolicyID,
+	FieldClaimNo,
+	FieldClaimAmount,
+	FieldSettledAmount,
+	FieldSettledAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,1966 @@
+// Code generated by ent, DO NOT EDIT.
+
+package incident
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// ReporterID applies equality check predicate on the "reporter_id" field. It's identical to ReporterIDEQ.
+func ReporterID(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReporterID), v))
+	})
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOccurredAt), v))
+	})
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLocation), v))
+	})
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeverity), v))
+	})
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// PhotoIds applies equality check predicate on the "photo_ids" field. It's identical to PhotoIdsEQ.
+func PhotoIds(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPhotoIds), v))
+	})
+}
+
+// ThirdParties applies equality check predicate on the "third_parties" field. It's identical to ThirdPartiesEQ.
+func ThirdParties(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldThirdParties), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// PolicyID applies equality check predicate on the "policy_id" field. It's identical to PolicyIDEQ.
+func PolicyID(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPolicyID), v))
+	})
+}
+
+// ClaimNo applies equality check predicate on the "claim_no" field. It's identical to ClaimNoEQ.
+func ClaimNo(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimAmount applies equality check predicate on the "claim_amount" field. It's identical to ClaimAmountEQ.
+func ClaimAmount(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimAmount), v))
+	})
+}
+
+// SettledAmount applies equality check predicate on the "settled_amount" field. It's identical to SettledAmountEQ.
+func SettledAmount(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSettledAmount), v))
+	})
+}
+
+// SettledAt applies equality check predicate on the "settled_at" field. It's identical to SettledAtEQ.
+func SettledAt(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSettledAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// ReporterIDEQ applies the EQ predicate on the "reporter_id" field.
+func ReporterIDEQ(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReporterID), v))
+	})
+}
+
+// ReporterIDNEQ applies the NEQ predicate on the "reporter_id" field.
+func ReporterIDNEQ(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReporterID), v))
+	})
+}
+
+// ReporterIDIn applies the In predicate on the "reporter_id" field.
+func ReporterIDIn(vs ...int64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReporterID), v...))
+	})
+}
+
+// ReporterIDNotIn applies the NotIn predicate on the "reporter_id" field.
+func ReporterIDNotIn(vs ...int64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReporterID), v...))
+	})
+}
+
+// ReporterIDGT applies the GT predicate on the "reporter_id" field.
+func ReporterIDGT(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReporterID), v))
+	})
+}
+
+// ReporterIDGTE applies the GTE predicate on the "reporter_id" field.
+func ReporterIDGTE(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReporterID), v))
+	})
+}
+
+// ReporterIDLT applies the LT predicate on the "reporter_id" field.
+func ReporterIDLT(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReporterID), v))
+	})
+}
+
+// ReporterIDLTE applies the LTE predicate on the "reporter_id" field.
+func ReporterIDLTE(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReporterID), v))
+	})
+}
+
+// ReporterIDIsNil applies the IsNil predicate on the "reporter_id" field.
+func ReporterIDIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReporterID)))
+	})
+}
+
+// ReporterIDNotNil applies the NotNil predicate on the "reporter_id" field.
+func ReporterIDNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReporterID)))
+	})
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOccurredAt), v...))
+	})
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOccurredAt), v...))
+	})
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtIsNil applies the IsNil predicate on the "occurred_at" field.
+func OccurredAtIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOccurredAt)))
+	})
+}
+
+// OccurredAtNotNil applies the NotNil predicate on the "occurred_at" field.
+func OccurredAtNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOccurredAt)))
+	})
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLocation), v))
+	})
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLocation), v))
+	})
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLocation), v...))
+	})
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLocation), v...))
+	})
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLocation), v))
+	})
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLocation), v))
+	})
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLocation), v))
+	})
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLocation), v))
+	})
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLocation), v))
+	})
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLocation), v))
+	})
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLocation), v))
+	})
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLocation)))
+	})
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLocation)))
+	})
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLocation), v))
+	})
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLocation), v))
+	})
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSeverity), v...))
+	})
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSeverity), v...))
+	})
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityIsNil applies the IsNil predicate on the "severity" field.
+func SeverityIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSeverity)))
+	})
+}
+
+// SeverityNotNil applies the NotNil predicate on the "severity" field.
+func SeverityNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSeverity)))
+	})
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSeverity), v))
+	})
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDescription)))
+	})
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDescription)))
+	})
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDescription), v))
+	})
+}
+
+// PhotoIdsEQ applies the EQ predicate on the "photo_ids" field.
+func PhotoIdsEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsNEQ applies the NEQ predicate on the "photo_ids" field.
+func PhotoIdsNEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsIn applies the In predicate on the "photo_ids" field.
+func PhotoIdsIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPhotoIds), v...))
+	})
+}
+
+// PhotoIdsNotIn applies the NotIn predicate on the "photo_ids" field.
+func PhotoIdsNotIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPhotoIds), v...))
+	})
+}
+
+// PhotoIdsGT applies the GT predicate on the "photo_ids" field.
+func PhotoIdsGT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsGTE applies the GTE predicate on the "photo_ids" field.
+func PhotoIdsGTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsLT applies the LT predicate on the "photo_ids" field.
+func PhotoIdsLT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsLTE applies the LTE predicate on the "photo_ids" field.
+func PhotoIdsLTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsContains applies the Contains predicate on the "photo_ids" field.
+func PhotoIdsContains(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsHasPrefix applies the HasPrefix predicate on the "photo_ids" field.
+func PhotoIdsHasPrefix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsHasSuffix applies the HasSuffix predicate on the "photo_ids" field.
+func PhotoIdsHasSuffix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsIsNil applies the IsNil predicate on the "photo_ids" field.
+func PhotoIdsIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPhotoIds)))
+	})
+}
+
+// PhotoIdsNotNil applies the NotNil predicate on the "photo_ids" field.
+func PhotoIdsNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPhotoIds)))
+	})
+}
+
+// PhotoIdsEqualFold applies the EqualFold predicate on the "photo_ids" field.
+func PhotoIdsEqualFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPhotoIds), v))
+	})
+}
+
+// PhotoIdsContainsFold applies the ContainsFold predicate on the "photo_ids" field.
+func PhotoIdsContainsFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPhotoIds), v))
+	})
+}
+
+// ThirdPartiesEQ applies the EQ predicate on the "third_parties" field.
+func ThirdPartiesEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesNEQ applies the NEQ predicate on the "third_parties" field.
+func ThirdPartiesNEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesIn applies the In predicate on the "third_parties" field.
+func ThirdPartiesIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldThirdParties), v...))
+	})
+}
+
+// ThirdPartiesNotIn applies the NotIn predicate on the "third_parties" field.
+func ThirdPartiesNotIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldThirdParties), v...))
+	})
+}
+
+// ThirdPartiesGT applies the GT predicate on the "third_parties" field.
+func ThirdPartiesGT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesGTE applies the GTE predicate on the "third_parties" field.
+func ThirdPartiesGTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesLT applies the LT predicate on the "third_parties" field.
+func ThirdPartiesLT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesLTE applies the LTE predicate on the "third_parties" field.
+func ThirdPartiesLTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesContains applies the Contains predicate on the "third_parties" field.
+func ThirdPartiesContains(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesHasPrefix applies the HasPrefix predicate on the "third_parties" field.
+func ThirdPartiesHasPrefix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesHasSuffix applies the HasSuffix predicate on the "third_parties" field.
+func ThirdPartiesHasSuffix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesIsNil applies the IsNil predicate on the "third_parties" field.
+func ThirdPartiesIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldThirdParties)))
+	})
+}
+
+// ThirdPartiesNotNil applies the NotNil predicate on the "third_parties" field.
+func ThirdPartiesNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldThirdParties)))
+	})
+}
+
+// ThirdPartiesEqualFold applies the EqualFold predicate on the "third_parties" field.
+func ThirdPartiesEqualFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldThirdParties), v))
+	})
+}
+
+// ThirdPartiesContainsFold applies the ContainsFold predicate on the "third_parties" field.
+func ThirdPartiesContainsFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldThirdParties), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// PolicyIDEQ applies the EQ predicate on the "policy_id" field.
+func PolicyIDEQ(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPolicyID), v))
+	})
+}
+
+// PolicyIDNEQ applies the NEQ predicate on the "policy_id" field.
+func PolicyIDNEQ(v int64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPolicyID), v))
+	})
+}
+
+// PolicyIDIn applies the In predicate on the "policy_id" field.
+func PolicyIDIn(vs ...int64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPolicyID), v...))
+	})
+}
+
+// PolicyIDNotIn applies the NotIn predicate on the "policy_id" field.
+func PolicyIDNotIn(vs ...int64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPolicyID), v...))
+	})
+}
+
+// PolicyIDIsNil applies the IsNil predicate on the "policy_id" field.
+func PolicyIDIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPolicyID)))
+	})
+}
+
+// PolicyIDNotNil applies the NotNil predicate on the "policy_id" field.
+func PolicyIDNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPolicyID)))
+	})
+}
+
+// ClaimNoEQ applies the EQ predicate on the "claim_no" field.
+func ClaimNoEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoNEQ applies the NEQ predicate on the "claim_no" field.
+func ClaimNoNEQ(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoIn applies the In predicate on the "claim_no" field.
+func ClaimNoIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClaimNo), v...))
+	})
+}
+
+// ClaimNoNotIn applies the NotIn predicate on the "claim_no" field.
+func ClaimNoNotIn(vs ...string) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClaimNo), v...))
+	})
+}
+
+// ClaimNoGT applies the GT predicate on the "claim_no" field.
+func ClaimNoGT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoGTE applies the GTE predicate on the "claim_no" field.
+func ClaimNoGTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoLT applies the LT predicate on the "claim_no" field.
+func ClaimNoLT(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoLTE applies the LTE predicate on the "claim_no" field.
+func ClaimNoLTE(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoContains applies the Contains predicate on the "claim_no" field.
+func ClaimNoContains(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoHasPrefix applies the HasPrefix predicate on the "claim_no" field.
+func ClaimNoHasPrefix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoHasSuffix applies the HasSuffix predicate on the "claim_no" field.
+func ClaimNoHasSuffix(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoIsNil applies the IsNil predicate on the "claim_no" field.
+func ClaimNoIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldClaimNo)))
+	})
+}
+
+// ClaimNoNotNil applies the NotNil predicate on the "claim_no" field.
+func ClaimNoNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldClaimNo)))
+	})
+}
+
+// ClaimNoEqualFold applies the EqualFold predicate on the "claim_no" field.
+func ClaimNoEqualFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimNoContainsFold applies the ContainsFold predicate on the "claim_no" field.
+func ClaimNoContainsFold(v string) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldClaimNo), v))
+	})
+}
+
+// ClaimAmountEQ applies the EQ predicate on the "claim_amount" field.
+func ClaimAmountEQ(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimAmount), v))
+	})
+}
+
+// ClaimAmountNEQ applies the NEQ predicate on the "claim_amount" field.
+func ClaimAmountNEQ(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClaimAmount), v))
+	})
+}
+
+// ClaimAmountIn applies the In predicate on the "claim_amount" field.
+func ClaimAmountIn(vs ...float64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClaimAmount), v...))
+	})
+}
+
+// ClaimAmountNotIn applies the NotIn predicate on the "claim_amount" field.
+func ClaimAmountNotIn(vs ...float64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClaimAmount), v...))
+	})
+}
+
+// ClaimAmountGT applies the GT predicate on the "claim_amount" field.
+func ClaimAmountGT(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClaimAmount), v))
+	})
+}
+
+// ClaimAmountGTE applies the GTE predicate on the "claim_amount" field.
+func ClaimAmountGTE(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClaimAmount), v))
+	})
+}
+
+// ClaimAmountLT applies the LT predicate on the "claim_amount" field.
+func ClaimAmountLT(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClaimAmount), v))
+	})
+}
+
+// ClaimAmountLTE applies the LTE predicate on the "claim_amount" field.
+func ClaimAmountLTE(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClaimAmount), v))
+	})
+}
+
+// ClaimAmountIsNil applies the IsNil predicate on the "claim_amount" field.
+func ClaimAmountIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldClaimAmount)))
+	})
+}
+
+// ClaimAmountNotNil applies the NotNil predicate on the "claim_amount" field.
+func ClaimAmountNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldClaimAmount)))
+	})
+}
+
+// SettledAmountEQ applies the EQ predicate on the "settled_amount" field.
+func SettledAmountEQ(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSettledAmount), v))
+	})
+}
+
+// SettledAmountNEQ applies the NEQ predicate on the "settled_amount" field.
+func SettledAmountNEQ(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSettledAmount), v))
+	})
+}
+
+// SettledAmountIn applies the In predicate on the "settled_amount" field.
+func SettledAmountIn(vs ...float64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSettledAmount), v...))
+	})
+}
+
+// SettledAmountNotIn applies the NotIn predicate on the "settled_amount" field.
+func SettledAmountNotIn(vs ...float64) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSettledAmount), v...))
+	})
+}
+
+// SettledAmountGT applies the GT predicate on the "settled_amount" field.
+func SettledAmountGT(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSettledAmount), v))
+	})
+}
+
+// SettledAmountGTE applies the GTE predicate on the "settled_amount" field.
+func SettledAmountGTE(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSettledAmount), v))
+	})
+}
+
+// SettledAmountLT applies the LT predicate on the "settled_amount" field.
+func SettledAmountLT(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSettledAmount), v))
+	})
+}
+
+// SettledAmountLTE applies the LTE predicate on the "settled_amount" field.
+func SettledAmountLTE(v float64) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSettledAmount), v))
+	})
+}
+
+// SettledAmountIsNil applies the IsNil predicate on the "settled_amount" field.
+func SettledAmountIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSettledAmount)))
+	})
+}
+
+// SettledAmountNotNil applies the NotNil predicate on the "settled_amount" field.
+func SettledAmountNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSettledAmount)))
+	})
+}
+
+// SettledAtEQ applies the EQ predicate on the "settled_at" field.
+func SettledAtEQ(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtNEQ applies the NEQ predicate on the "settled_at" field.
+func SettledAtNEQ(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtIn applies the In predicate on the "settled_at" field.
+func SettledAtIn(vs ...time.Time) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSettledAt), v...))
+	})
+}
+
+// SettledAtNotIn applies the NotIn predicate on the "settled_at" field.
+func SettledAtNotIn(vs ...time.Time) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSettledAt), v...))
+	})
+}
+
+// SettledAtGT applies the GT predicate on the "settled_at" field.
+func SettledAtGT(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtGTE applies the GTE predicate on the "settled_at" field.
+func SettledAtGTE(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtLT applies the LT predicate on the "settled_at" field.
+func SettledAtLT(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtLTE applies the LTE predicate on the "settled_at" field.
+func SettledAtLTE(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtIsNil applies the IsNil predicate on the "settled_at" field.
+func SettledAtIsNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSettledAt)))
+	})
+}
+
+// SettledAtNotNil applies the NotNil predicate on the "settled_at" field.
+func SettledAtNotNil() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSettledAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Incident {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Incident(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPolicy applies the HasEdge predicate on the "policy" edge.
+func HasPolicy() predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PolicyTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PolicyTable, PolicyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPolicyWith applies the HasEdge predicate on the "policy" edge with a given conditions (other predicates).
+func HasPolicyWith(preds ...predicate.InsurancePolicy) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PolicyInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PolicyTable, PolicyColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Incident) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Incident) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Incident) predicate.Incident {
+	return predicate.Incident(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,668 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IncidentCreate is the builder for creating a Incident entity.
+type IncidentCreate struct {
+	config
+	mutation *IncidentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ic *IncidentCreate) SetTenantID(i int64) *IncidentCreate {
+	ic.mutation.SetTenantID(i)
+	return ic
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableTenantID(i *int64) *IncidentCreate {
+	if i != nil {
+		ic.SetTenantID(*i)
+	}
+	return ic
+}
+
+// SetCarID sets the "car_id" field.
+func (ic *IncidentCreate) SetCarID(i int64) *IncidentCreate {
+	ic.mutation.SetCarID(i)
+	return ic
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableCarID(i *int64) *IncidentCreate {
+	if i != nil {
+		ic.SetCarID(*i)
+	}
+	return ic
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (ic *IncidentCreate) SetReporterID(i int64) *IncidentCreate {
+	ic.mutation.SetReporterID(i)
+	return ic
+}
+
+// SetNillableReporterID sets the "reporter_id" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableReporterID(i *int64) *IncidentCreate {
+	if i != nil {
+		ic.SetReporterID(*i)
+	}
+	return ic
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (ic *IncidentCreate) SetOccurredAt(t time.Time) *IncidentCreate {
+	ic.mutation.SetOccurredAt(t)
+	return ic
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableOccurredAt(t *time.Time) *IncidentCreate {
+	if t != nil {
+		ic.SetOccurredAt(*t)
+	}
+	return ic
+}
+
+// SetLocation sets the "location" field.
+func (ic *IncidentCreate) SetLocation(s string) *IncidentCreate {
+	ic.mutation.SetLocation(s)
+	return ic
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableLocation(s *string) *IncidentCreate {
+	if s != nil {
+		ic.SetLocation(*s)
+	}
+	return ic
+}
+
+// SetSeverity sets the "severity" field.
+func (ic *IncidentCreate) SetSeverity(s string) *IncidentCreate {
+	ic.mutation.SetSeverity(s)
+	return ic
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableSeverity(s *string) *IncidentCreate {
+	if s != nil {
+		ic.SetSeverity(*s)
+	}
+	return ic
+}
+
+// SetDescription sets the "description" field.
+func (ic *IncidentCreate) SetDescription(s string) *IncidentCreate {
+	ic.mutation.SetDescription(s)
+	return ic
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableDescription(s *string) *IncidentCreate {
+	if s != nil {
+		ic.SetDescription(*s)
+	}
+	return ic
+}
+
+// SetPhotoIds sets the "photo_ids" field.
+func (ic *IncidentCreate) SetPhotoIds(s string) *IncidentCreate {
+	ic.mutation.SetPhotoIds(s)
+	return ic
+}
+
+// SetNillablePhotoIds sets the "photo_ids" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillablePhotoIds(s *string) *IncidentCreate {
+	if s != nil {
+		ic.SetPhotoIds(*s)
+	}
+	return ic
+}
+
+// SetThirdParties sets the "third_parties" field.
+func (ic *IncidentCreate) SetThirdParties(s string) *IncidentCreate {
+	ic.mutation.SetThirdParties(s)
+	return ic
+}
+
+// SetNillableThirdParties sets the "third_parties" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableThirdParties(s *string) *IncidentCreate {
+	if s != nil {
+		ic.SetThirdParties(*s)
+	}
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *IncidentCreate) SetStatus(s string) *IncidentCreate {
+	ic.mutation.SetStatus(s)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableStatus(s *string) *IncidentCreate {
+	if s != nil {
+		ic.SetStatus(*s)
+	}
+	return ic
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (ic *IncidentCreate) SetPolicyID(i int64) *IncidentCreate {
+	ic.mutation.SetPolicyID(i)
+	return ic
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillablePolicyID(i *int64) *IncidentCreate {
+	if i != nil {
+		ic.SetPolicyID(*i)
+	}
+	return ic
+}
+
+// SetClaimNo sets the "claim_no" field.
+func (ic *IncidentCreate) SetClaimNo(s string) *IncidentCreate {
+	ic.mutation.SetClaimNo(s)
+	return ic
+}
+
+// SetNillableClaimNo sets the "claim_no" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableClaimNo(s *string) *IncidentCreate {
+	if s != nil {
+		ic.SetClaimNo(*s)
+	}
+	return ic
+}
+
+// SetClaimAmount sets the "claim_amount" field.
+func (ic *IncidentCreate) SetClaimAmount(f float64) *IncidentCreate {
+	ic.mutation.SetClaimAmount(f)
+	return ic
+}
+
+// SetNillableClaimAmount sets the "claim_amount" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableClaimAmount(f *float64) *IncidentCreate {
+	if f != nil {
+		ic.SetClaimAmount(*f)
+	}
+	return ic
+}
+
+// SetSettledAmount sets the "settled_amount" field.
+func (ic *IncidentCreate) SetSettledAmount(f float64) *IncidentCreate {
+	ic.mutation.SetSettledAmount(f)
+	return ic
+}
+
+// SetNillableSettledAmount sets the "settled_amount" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableSettledAmount(f *float64) *IncidentCreate {
+	if f != nil {
+		ic.SetSettledAmount(*f)
+	}
+	return ic
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (ic *IncidentCreate) SetSettledAt(t time.Time) *IncidentCreate {
+	ic.mutation.SetSettledAt(t)
+	return ic
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableSettledAt(t *time.Time) *IncidentCreate {
+	if t != nil {
+		ic.SetSettledAt(*t)
+	}
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *IncidentCreate) SetCreatedAt(t time.Time) *IncidentCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableCreatedAt(t *time.Time) *IncidentCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ic *IncidentCreate) SetUpdatedAt(t time.Time) *IncidentCreate {
+	ic.mutation.SetUpdatedAt(t)
+	return ic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ic *IncidentCreate) SetNillableUpdatedAt(t *time.Time) *IncidentCreate {
+	if t != nil {
+		ic.SetUpdatedAt(*t)
+	}
+	return ic
+}
+
+// SetID sets the "id" field.
+func (ic *IncidentCreate) SetID(i int64) *IncidentCreate {
+	ic.mutation.SetID(i)
+	return ic
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ic *IncidentCreate) SetCar(c *Car) *IncidentCreate {
+	return ic.SetCarID(c.ID)
+}
+
+// SetPolicy sets the "policy" edge to the InsurancePolicy entity.
+func (ic *IncidentCreate) SetPolicy(i *InsurancePolicy) *IncidentCreate {
+	return ic.SetPolicyID(i.ID)
+}
+
+// Mutation returns the IncidentMutation object of the builder.
+func (ic *IncidentCreate) Mutation() *IncidentMutation {
+	return ic.mutation
+}
+
+// Save creates the Incident in the database.
+func (ic *IncidentCreate) Save(ctx context.Context) (*Incident, error) {
+	var (
+		err  error
+		node *Incident
+	)
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
+	if len(ic.hooks) == 0 {
+		if err = ic.check(); err != nil {
+			return nil, err
+		}
+		node, err = ic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IncidentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ic.check(); err != nil {
+				return nil, err
+			}
+			ic.mutation = mutation
+			if node, err = ic.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ic.hooks) - 1; i >= 0; i-- {
+			if ic.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ic.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ic.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Incident)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from IncidentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *IncidentCreate) SaveX(ctx context.Context) *Incident {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *IncidentCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *IncidentCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *IncidentCreate) defaults() error {
+	if _, ok := ic.mutation.Status(); !ok {
+		v := incident.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		if incident.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized incident.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := incident.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		if incident.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized incident.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := incident.DefaultUpdatedAt()
+		ic.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *IncidentCreate) check() error {
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Incident.status"`)}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Incident.created_at"`)}
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Incident.updated_at"`)}
+	}
+	return nil
+}
+
+func (ic *IncidentCreate) sqlSave(ctx context.Context) (*Incident, error) {
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (ic *IncidentCreate) createSpec() (*Incident, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Incident{config: ic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: incident.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: incident.FieldID,
+			},
+		}
+	)
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ic.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := ic.mutation.ReporterID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldReporterID,
+		})
+		_node.ReporterID = value
+	}
+	if value, ok := ic.mutation.OccurredAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldOccurredAt,
+		})
+		_node.OccurredAt = value
+	}
+	if value, ok := ic.mutation.Location(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldLocation,
+		})
+		_node.Location = value
+	}
+	if value, ok := ic.mutation.Severity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldSeverity,
+		})
+		_node.Severity = value
+	}
+	if value, ok := ic.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := ic.mutation.PhotoIds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldPhotoIds,
+		})
+		_node.PhotoIds = value
+	}
+	if value, ok := ic.mutation.ThirdParties(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldThirdParties,
+		})
+		_node.ThirdParties = value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := ic.mutation.ClaimNo(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldClaimNo,
+		})
+		_node.ClaimNo = value
+	}
+	if value, ok := ic.mutation.ClaimAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldClaimAmount,
+		})
+		_node.ClaimAmount = value
+	}
+	if value, ok := ic.mutation.SettledAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldSettledAmount,
+		})
+		_node.SettledAmount = value
+	}
+	if value, ok := ic.mutation.SettledAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldSettledAt,
+		})
+		_node.SettledAt = &value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ic.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := ic.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.CarTable,
+			Columns: []string{incident.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.PolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.PolicyTable,
+			Columns: []string{incident.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PolicyID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IncidentCreateBulk is the builder for creating many Incident entities in bulk.
+type IncidentCreateBulk struct {
+	config
+	builders []*IncidentCreate
+}
+
+// Save creates the Incident entities in the database.
+func (icb *IncidentCreateBulk) Save(ctx context.Context) ([]*Incident, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Incident, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IncidentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *IncidentCreateBulk) SaveX(ctx context.Context) []*Incident {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *IncidentCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *IncidentCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
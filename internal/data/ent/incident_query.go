@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IncidentQuery is the builder for querying Incident entities.
+type IncidentQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Incident
+	// eager-loading edges.
+	withCar    *CarQuery
+	withPolicy *InsurancePolicyQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IncidentQuery builder.
+func (iq *IncidentQuery) Where(ps ...predicate.Incident) *IncidentQuery {
+	iq.predicates = append(iq.predicates, ps...)
+	return iq
+}
+
+// Limit adds a limit step to the query.
+func (iq *IncidentQuery) Limit(limit int) *IncidentQuery {
+	iq.limit = &limit
+	return iq
+}
+
+// Offset adds an offset step to the query.
+func (iq *IncidentQuery) Offset(offset int) *IncidentQuery {
+	iq.offset = &offset
+	return iq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iq *IncidentQuery) Unique(unique bool) *IncidentQuery {
+	iq.unique = &unique
+	return iq
+}
+
+// Order adds an order step to the query.
+func (iq *IncidentQuery) Order(o ...OrderFunc) *IncidentQuery {
+	iq.order = append(iq.order, o...)
+	return iq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (iq *IncidentQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: iq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(incident.Table, incident.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, incident.CarTable, incident.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPolicy chains the current query on the "policy" edge.
+func (iq *IncidentQuery) QueryPolicy() *InsurancePolicyQuery {
+	query := &InsurancePolicyQuery{config: iq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(incident.Table, incident.FieldID, selector),
+			sqlgraph.To(insurancepolicy.Table, insurancepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, incident.PolicyTable, incident.PolicyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Incident entity from the query.
+// Returns a *NotFoundError when no Incident was found.
+func (iq *IncidentQuery) First(ctx context.Context) (*Incident, error) {
+	nodes, err := iq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{incident.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iq *IncidentQuery) FirstX(ctx context.Context) *Incident {
+	node, err := iq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Incident ID from the query.
+// Returns a *NotFoundError when no Incident ID was found.
+func (iq *IncidentQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = iq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{incident.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iq *IncidentQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := iq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Incident entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Incident entity is found.
+// Returns a *NotFoundError when no Incident entities are found.
+func (iq *IncidentQuery) Only(ctx context.Context) (*Incident, error) {
+	nodes, err := iq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{incident.Label}
+	default:
+		return nil, &NotSingularError{incident.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iq *IncidentQuery) OnlyX(ctx context.Context) *Incident {
+	node, err := iq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Incident ID in the query.
+// Returns a *NotSingularError when more than one Incident ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iq *IncidentQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = iq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{incident.Label}
+	default:
+		err = &NotSingularError{incident.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iq *IncidentQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := iq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Incidents.
+func (iq *IncidentQuery) All(ctx context.Context) ([]*Incident, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return iq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iq *IncidentQuery) AllX(ctx context.Context) []*Incident {
+	nodes, err := iq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Incident IDs.
+func (iq *IncidentQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := iq.Select(incident.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iq *IncidentQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := iq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iq *IncidentQuery) Count(ctx context.Context) (int, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return iq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iq *IncidentQuery) CountX(ctx context.Context) int {
+	count, err := iq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iq *IncidentQuery) Exist(ctx context.Context) (bool, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return iq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iq *IncidentQuery) ExistX(ctx context.Context) bool {
+	exist, err := iq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IncidentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iq *IncidentQuery) Clone() *IncidentQuery {
+	if iq == nil {
+		return nil
+	}
+	return &IncidentQuery{
+		config:     iq.config,
+		limit:      iq.limit,
+		offset:     iq.offset,
+		order:      append([]OrderFunc{}, iq.order...),
+		predicates: append([]predicate.Incident{}, iq.predicates...),
+		withCar:    iq.withCar.Clone(),
+		withPolicy: iq.withPolicy.Clone(),
+		// clone intermediate query.
+		sql:    iq.sql.Clone(),
+		path:   iq.path,
+		unique: iq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *IncidentQuery) WithCar(opts ...func(*CarQuery)) *IncidentQuery {
+	query := &CarQuery{config: iq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withCar = query
+	return iq
+}
+
+// WithPolicy tells the query-builder to eager-load the nodes that are connected to
+// the "policy" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *IncidentQuery) WithPolicy(opts ...func(*InsurancePolicyQuery)) *IncidentQuery {
+	query := &InsurancePolicyQuery{config: iq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withPolicy = query
+	return iq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Incident.Query().
+//		GroupBy(incident.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (iq *IncidentQuery) GroupBy(field string, fields ...string) *IncidentGroupBy {
+	grbuild := &IncidentGroupBy{config: iq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iq.sqlQuery(ctx), nil
+	}
+	grbuild.label = incident.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.Incident.Query().
+//		Select(incident.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (iq *IncidentQuery) Select(fields ...string) *IncidentSelect {
+	iq.fields = append(iq.fields, fields...)
+	selbuild := &IncidentSelect{IncidentQuery: iq}
+	selbuild.label = incident.Label
+	selbuild.flds, selbuild.scan = &iq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (iq *IncidentQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range iq.fields {
+		if !incident.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iq.path != nil {
+		prev, err := iq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iq.sql = prev
+	}
+	if incident.Policy == nil {
+		return errors.New("ent: uninitialized incident.Policy (forgotten import ent/runtime?)")
+	}
+	if err := incident.Policy.EvalQuery(ctx, iq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (iq *IncidentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Incident, error) {
+	var (
+		nodes       = []*Incident{}
+		_spec       = iq.querySpec()
+		loadedTypes = [2]bool{
+			iq.withCar != nil,
+			iq.withPolicy != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Incident).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Incident{config: iq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := iq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*Incident)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	if query := iq.withPolicy; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*Incident)
+		for i := range nodes {
+			fk := nodes[i].PolicyID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(insurancepolicy.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "policy_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Policy = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (iq *IncidentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iq.querySpec()
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
+	_spec.Node.Columns = iq.fields
+	if len(iq.fields) > 0 {
+		_spec.Unique = iq.unique != nil && *iq.unique
+	}
+	return sqlgraph.CountNodes(ctx, iq.driver, _spec)
+}
+
+func (iq *IncidentQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := iq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (iq *IncidentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   incident.Table,
+			Columns: incident.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: incident.FieldID,
+			},
+		},
+		From:   iq.sql,
+		Unique: true,
+	}
+	if unique := iq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := iq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, incident.FieldID)
+		for i := range fields {
+			if fields[i] != incident.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iq *IncidentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iq.driver.Dialect())
+	t1 := builder.Table(incident.Table)
+	columns := iq.fields
+	if len(columns) == 0 {
+		columns = incident.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iq.sql != nil {
+		selector = iq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iq.unique != nil && *iq.unique {
+		selector.Distinct()
+	}
+	for _, m := range iq.modifiers {
+		m(selector)
+	}
+	for _, p := range iq.predicates {
+		p(selector)
+	}
+	for _, p := range iq.order {
+		p(selector)
+	}
+	if offset := iq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iq *IncidentQuery) ForUpdate(opts ...sql.LockOption) *IncidentQuery {
+	if iq.driver.Dialect() == dialect.Postgres {
+		iq.Unique(false)
+	}
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iq *IncidentQuery) ForShare(opts ...sql.LockOption) *IncidentQuery {
+	if iq.driver.Dialect() == dialect.Postgres {
+		iq.Unique(false)
+	}
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iq *IncidentQuery) Modify(modifiers ...func(s *sql.Selector)) *IncidentSelect {
+	iq.modifiers = append(iq.modifiers, modifiers...)
+	return iq.Select()
+}
+
+// IncidentGroupBy is the group-by builder for Incident entities.
+type IncidentGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (igb *IncidentGroupBy) Aggregate(fns ...AggregateFunc) *IncidentGroupBy {
+	igb.fns = append(igb.fns, fns...)
+	return igb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (igb *IncidentGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := igb.path(ctx)
+	if err != nil {
+		return err
+	}
+	igb.sql = query
+	return igb.sqlScan(ctx, v)
+}
+
+func (igb *IncidentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range igb.fields {
+		if !incident.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := igb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := igb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (igb *IncidentGroupBy) sqlQuery() *sql.Selector {
+	selector := igb.sql.Select()
+	aggregation := make([]string, 0, len(igb.fns))
+	for _, fn := range igb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(igb.fields)+len(igb.fns))
+		for _, f := range igb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(igb.fields...)...)
+}
+
+// IncidentSelect is the builder for selecting fields of Incident entities.
+type IncidentSelect struct {
+	*IncidentQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (is *IncidentSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := is.prepareQuery(ctx); err != nil {
+		return err
+	}
+	is.sql = is.IncidentQuery.sqlQuery(ctx)
+	return is.sqlScan(ctx, v)
+}
+
+func (is *IncidentSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := is.sql.Query()
+	if err := is.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (is *IncidentSelect) Modify(modifiers ...func(s *sql.Selector)) *IncidentSelect {
+	is.modifiers = append(is.modifiers, modifiers...)
+	return is
+}
@@ -0,0 +1,1542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IncidentUpdate is the builder for updating Incident entities.
+type IncidentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IncidentMutation
+}
+
+// Where appends a list predicates to the IncidentUpdate builder.
+func (iu *IncidentUpdate) Where(ps ...predicate.Incident) *IncidentUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (iu *IncidentUpdate) SetTenantID(i int64) *IncidentUpdate {
+	iu.mutation.ResetTenantID()
+	iu.mutation.SetTenantID(i)
+	return iu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableTenantID(i *int64) *IncidentUpdate {
+	if i != nil {
+		iu.SetTenantID(*i)
+	}
+	return iu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (iu *IncidentUpdate) AddTenantID(i int64) *IncidentUpdate {
+	iu.mutation.AddTenantID(i)
+	return iu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (iu *IncidentUpdate) ClearTenantID() *IncidentUpdate {
+	iu.mutation.ClearTenantID()
+	return iu
+}
+
+// SetCarID sets the "car_id" field.
+func (iu *IncidentUpdate) SetCarID(i int64) *IncidentUpdate {
+	iu.mutation.SetCarID(i)
+	return iu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableCarID(i *int64) *IncidentUpdate {
+	if i != nil {
+		iu.SetCarID(*i)
+	}
+	return iu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (iu *IncidentUpdate) ClearCarID() *IncidentUpdate {
+	iu.mutation.ClearCarID()
+	return iu
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (iu *IncidentUpdate) SetReporterID(i int64) *IncidentUpdate {
+	iu.mutation.ResetReporterID()
+	iu.mutation.SetReporterID(i)
+	return iu
+}
+
+// SetNillableReporterID sets the "reporter_id" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableReporterID(i *int64) *IncidentUpdate {
+	if i != nil {
+		iu.SetReporterID(*i)
+	}
+	return iu
+}
+
+// AddReporterID adds i to the "reporter_id" field.
+func (iu *IncidentUpdate) AddReporterID(i int64) *IncidentUpdate {
+	iu.mutation.AddReporterID(i)
+	return iu
+}
+
+// ClearReporterID clears the value of the "reporter_id" field.
+func (iu *IncidentUpdate) ClearReporterID() *IncidentUpdate {
+	iu.mutation.ClearReporterID()
+	return iu
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (iu *IncidentUpdate) SetOccurredAt(t time.Time) *IncidentUpdate {
+	iu.mutation.SetOccurredAt(t)
+	return iu
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableOccurredAt(t *time.Time) *IncidentUpdate {
+	if t != nil {
+		iu.SetOccurredAt(*t)
+	}
+	return iu
+}
+
+// ClearOccurredAt clears the value of the "occurred_at" field.
+func (iu *IncidentUpdate) ClearOccurredAt() *IncidentUpdate {
+	iu.mutation.ClearOccurredAt()
+	return iu
+}
+
+// SetLocation sets the "location" field.
+func (iu *IncidentUpdate) SetLocation(s string) *IncidentUpdate {
+	iu.mutation.SetLocation(s)
+	return iu
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableLocation(s *string) *IncidentUpdate {
+	if s != nil {
+		iu.SetLocation(*s)
+	}
+	return iu
+}
+
+// ClearLocation clears the value of the "location" field.
+func (iu *IncidentUpdate) ClearLocation() *IncidentUpdate {
+	iu.mutation.ClearLocation()
+	return iu
+}
+
+// SetSeverity sets the "severity" field.
+func (iu *IncidentUpdate) SetSeverity(s string) *IncidentUpdate {
+	iu.mutation.SetSeverity(s)
+	return iu
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableSeverity(s *string) *IncidentUpdate {
+	if s != nil {
+		iu.SetSeverity(*s)
+	}
+	return iu
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (iu *IncidentUpdate) ClearSeverity() *IncidentUpdate {
+	iu.mutation.ClearSeverity()
+	return iu
+}
+
+// SetDescription sets the "description" field.
+func (iu *IncidentUpdate) SetDescription(s string) *IncidentUpdate {
+	iu.mutation.SetDescription(s)
+	return iu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableDescription(s *string) *IncidentUpdate {
+	if s != nil {
+		iu.SetDescription(*s)
+	}
+	return iu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (iu *IncidentUpdate) ClearDescription() *IncidentUpdate {
+	iu.mutation.ClearDescription()
+	return iu
+}
+
+// SetPhotoIds sets the "photo_ids" field.
+func (iu *IncidentUpdate) SetPhotoIds(s string) *IncidentUpdate {
+	iu.mutation.SetPhotoIds(s)
+	return iu
+}
+
+// SetNillablePhotoIds sets the "photo_ids" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillablePhotoIds(s *string) *IncidentUpdate {
+	if s != nil {
+		iu.SetPhotoIds(*s)
+	}
+	return iu
+}
+
+// ClearPhotoIds clears the value of the "photo_ids" field.
+func (iu *IncidentUpdate) ClearPhotoIds() *IncidentUpdate {
+	iu.mutation.ClearPhotoIds()
+	return iu
+}
+
+// SetThirdParties sets the "third_parties" field.
+func (iu *IncidentUpdate) SetThirdParties(s string) *IncidentUpdate {
+	iu.mutation.SetThirdParties(s)
+	return iu
+}
+
+// SetNillableThirdParties sets the "third_parties" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableThirdParties(s *string) *IncidentUpdate {
+	if s != nil {
+		iu.SetThirdParties(*s)
+	}
+	return iu
+}
+
+// ClearThirdParties clears the value of the "third_parties" field.
+func (iu *IncidentUpdate) ClearThirdParties() *IncidentUpdate {
+	iu.mutation.ClearThirdParties()
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *IncidentUpdate) SetStatus(s string) *IncidentUpdate {
+	iu.mutation.SetStatus(s)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableStatus(s *string) *IncidentUpdate {
+	if s != nil {
+		iu.SetStatus(*s)
+	}
+	return iu
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (iu *IncidentUpdate) SetPolicyID(i int64) *IncidentUpdate {
+	iu.mutation.SetPolicyID(i)
+	return iu
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillablePolicyID(i *int64) *IncidentUpdate {
+	if i != nil {
+		iu.SetPolicyID(*i)
+	}
+	return iu
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (iu *IncidentUpdate) ClearPolicyID() *IncidentUpdate {
+	iu.mutation.ClearPolicyID()
+	return iu
+}
+
+// SetClaimNo sets the "claim_no" field.
+func (iu *IncidentUpdate) SetClaimNo(s string) *IncidentUpdate {
+	iu.mutation.SetClaimNo(s)
+	return iu
+}
+
+// SetNillableClaimNo sets the "claim_no" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableClaimNo(s *string) *IncidentUpdate {
+	if s != nil {
+		iu.SetClaimNo(*s)
+	}
+	return iu
+}
+
+// ClearClaimNo clears the value of the "claim_no" field.
+func (iu *IncidentUpdate) ClearClaimNo() *IncidentUpdate {
+	iu.mutation.ClearClaimNo()
+	return iu
+}
+
+// SetClaimAmount sets the "claim_amount" field.
+func (iu *IncidentUpdate) SetClaimAmount(f float64) *IncidentUpdate {
+	iu.mutation.ResetClaimAmount()
+	iu.mutation.SetClaimAmount(f)
+	return iu
+}
+
+// SetNillableClaimAmount sets the "claim_amount" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableClaimAmount(f *float64) *IncidentUpdate {
+	if f != nil {
+		iu.SetClaimAmount(*f)
+	}
+	return iu
+}
+
+// AddClaimAmount adds f to the "claim_amount" field.
+func (iu *IncidentUpdate) AddClaimAmount(f float64) *IncidentUpdate {
+	iu.mutation.AddClaimAmount(f)
+	return iu
+}
+
+// ClearClaimAmount clears the value of the "claim_amount" field.
+func (iu *IncidentUpdate) ClearClaimAmount() *IncidentUpdate {
+	iu.mutation.ClearClaimAmount()
+	return iu
+}
+
+// SetSettledAmount sets the "settled_amount" field.
+func (iu *IncidentUpdate) SetSettledAmount(f float64) *IncidentUpdate {
+	iu.mutation.ResetSettledAmount()
+	iu.mutation.SetSettledAmount(f)
+	return iu
+}
+
+// SetNillableSettledAmount sets the "settled_amount" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableSettledAmount(f *float64) *IncidentUpdate {
+	if f != nil {
+		iu.SetSettledAmount(*f)
+	}
+	return iu
+}
+
+// AddSettledAmount adds f to the "settled_amount" field.
+func (iu *IncidentUpdate) AddSettledAmount(f float64) *IncidentUpdate {
+	iu.mutation.AddSettledAmount(f)
+	return iu
+}
+
+// ClearSettledAmount clears the value of the "settled_amount" field.
+func (iu *IncidentUpdate) ClearSettledAmount() *IncidentUpdate {
+	iu.mutation.ClearSettledAmount()
+	return iu
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (iu *IncidentUpdate) SetSettledAt(t time.Time) *IncidentUpdate {
+	iu.mutation.SetSettledAt(t)
+	return iu
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableSettledAt(t *time.Time) *IncidentUpdate {
+	if t != nil {
+		iu.SetSettledAt(*t)
+	}
+	return iu
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (iu *IncidentUpdate) ClearSettledAt() *IncidentUpdate {
+	iu.mutation.ClearSettledAt()
+	return iu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iu *IncidentUpdate) SetCreatedAt(t time.Time) *IncidentUpdate {
+	iu.mutation.SetCreatedAt(t)
+	return iu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableCreatedAt(t *time.Time) *IncidentUpdate {
+	if t != nil {
+		iu.SetCreatedAt(*t)
+	}
+	return iu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iu *IncidentUpdate) SetUpdatedAt(t time.Time) *IncidentUpdate {
+	iu.mutation.SetUpdatedAt(t)
+	return iu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iu *IncidentUpdate) SetNillableUpdatedAt(t *time.Time) *IncidentUpdate {
+	if t != nil {
+		iu.SetUpdatedAt(*t)
+	}
+	return iu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (iu *IncidentUpdate) SetCar(c *Car) *IncidentUpdate {
+	return iu.SetCarID(c.ID)
+}
+
+// SetPolicy sets the "policy" edge to the InsurancePolicy entity.
+func (iu *IncidentUpdate) SetPolicy(i *InsurancePolicy) *IncidentUpdate {
+	return iu.SetPolicyID(i.ID)
+}
+
+// Mutation returns the IncidentMutation object of the builder.
+func (iu *IncidentUpdate) Mutation() *IncidentMutation {
+	return iu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (iu *IncidentUpdate) ClearCar() *IncidentUpdate {
+	iu.mutation.ClearCar()
+	return iu
+}
+
+// ClearPolicy clears the "policy" edge to the InsurancePolicy entity.
+func (iu *IncidentUpdate) ClearPolicy() *IncidentUpdate {
+	iu.mutation.ClearPolicy()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *IncidentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iu.hooks) == 0 {
+		affected, err = iu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IncidentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iu.mutation = mutation
+			affected, err = iu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iu.hooks) - 1; i >= 0; i-- {
+			if iu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *IncidentUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *IncidentUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *IncidentUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iu *IncidentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   incident.Table,
+			Columns: incident.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: incident.FieldID,
+			},
+		},
+	}
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldTenantID,
+		})
+	}
+	if value, ok := iu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldTenantID,
+		})
+	}
+	if iu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: incident.FieldTenantID,
+		})
+	}
+	if value, ok := iu.mutation.ReporterID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldReporterID,
+		})
+	}
+	if value, ok := iu.mutation.AddedReporterID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldReporterID,
+		})
+	}
+	if iu.mutation.ReporterIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: incident.FieldReporterID,
+		})
+	}
+	if value, ok := iu.mutation.OccurredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldOccurredAt,
+		})
+	}
+	if iu.mutation.OccurredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: incident.FieldOccurredAt,
+		})
+	}
+	if value, ok := iu.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldLocation,
+		})
+	}
+	if iu.mutation.LocationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldLocation,
+		})
+	}
+	if value, ok := iu.mutation.Severity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldSeverity,
+		})
+	}
+	if iu.mutation.SeverityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldSeverity,
+		})
+	}
+	if value, ok := iu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldDescription,
+		})
+	}
+	if iu.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldDescription,
+		})
+	}
+	if value, ok := iu.mutation.PhotoIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldPhotoIds,
+		})
+	}
+	if iu.mutation.PhotoIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldPhotoIds,
+		})
+	}
+	if value, ok := iu.mutation.ThirdParties(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldThirdParties,
+		})
+	}
+	if iu.mutation.ThirdPartiesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldThirdParties,
+		})
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldStatus,
+		})
+	}
+	if value, ok := iu.mutation.ClaimNo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldClaimNo,
+		})
+	}
+	if iu.mutation.ClaimNoCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldClaimNo,
+		})
+	}
+	if value, ok := iu.mutation.ClaimAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldClaimAmount,
+		})
+	}
+	if value, ok := iu.mutation.AddedClaimAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldClaimAmount,
+		})
+	}
+	if iu.mutation.ClaimAmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: incident.FieldClaimAmount,
+		})
+	}
+	if value, ok := iu.mutation.SettledAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldSettledAmount,
+		})
+	}
+	if value, ok := iu.mutation.AddedSettledAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldSettledAmount,
+		})
+	}
+	if iu.mutation.SettledAmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: incident.FieldSettledAmount,
+		})
+	}
+	if value, ok := iu.mutation.SettledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldSettledAt,
+		})
+	}
+	if iu.mutation.SettledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: incident.FieldSettledAt,
+		})
+	}
+	if value, ok := iu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldCreatedAt,
+		})
+	}
+	if value, ok := iu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldUpdatedAt,
+		})
+	}
+	if iu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.CarTable,
+			Columns: []string{incident.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.CarTable,
+			Columns: []string{incident.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.PolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.PolicyTable,
+			Columns: []string{incident.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.PolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.PolicyTable,
+			Columns: []string{incident.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{incident.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// IncidentUpdateOne is the builder for updating a single Incident entity.
+type IncidentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IncidentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (iuo *IncidentUpdateOne) SetTenantID(i int64) *IncidentUpdateOne {
+	iuo.mutation.ResetTenantID()
+	iuo.mutation.SetTenantID(i)
+	return iuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableTenantID(i *int64) *IncidentUpdateOne {
+	if i != nil {
+		iuo.SetTenantID(*i)
+	}
+	return iuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (iuo *IncidentUpdateOne) AddTenantID(i int64) *IncidentUpdateOne {
+	iuo.mutation.AddTenantID(i)
+	return iuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (iuo *IncidentUpdateOne) ClearTenantID() *IncidentUpdateOne {
+	iuo.mutation.ClearTenantID()
+	return iuo
+}
+
+// SetCarID sets the "car_id" field.
+func (iuo *IncidentUpdateOne) SetCarID(i int64) *IncidentUpdateOne {
+	iuo.mutation.SetCarID(i)
+	return iuo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableCarID(i *int64) *IncidentUpdateOne {
+	if i != nil {
+		iuo.SetCarID(*i)
+	}
+	return iuo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (iuo *IncidentUpdateOne) ClearCarID() *IncidentUpdateOne {
+	iuo.mutation.ClearCarID()
+	return iuo
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (iuo *IncidentUpdateOne) SetReporterID(i int64) *IncidentUpdateOne {
+	iuo.mutation.ResetReporterID()
+	iuo.mutation.SetReporterID(i)
+	return iuo
+}
+
+// SetNillableReporterID sets the "reporter_id" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableReporterID(i *int64) *IncidentUpdateOne {
+	if i != nil {
+		iuo.SetReporterID(*i)
+	}
+	return iuo
+}
+
+// AddReporterID adds i to the "reporter_id" field.
+func (iuo *IncidentUpdateOne) AddReporterID(i int64) *IncidentUpdateOne {
+	iuo.mutation.AddReporterID(i)
+	return iuo
+}
+
+// ClearReporterID clears the value of the "reporter_id" field.
+func (iuo *IncidentUpdateOne) ClearReporterID() *IncidentUpdateOne {
+	iuo.mutation.ClearReporterID()
+	return iuo
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (iuo *IncidentUpdateOne) SetOccurredAt(t time.Time) *IncidentUpdateOne {
+	iuo.mutation.SetOccurredAt(t)
+	return iuo
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableOccurredAt(t *time.Time) *IncidentUpdateOne {
+	if t != nil {
+		iuo.SetOccurredAt(*t)
+	}
+	return iuo
+}
+
+// ClearOccurredAt clears the value of the "occurred_at" field.
+func (iuo *IncidentUpdateOne) ClearOccurredAt() *IncidentUpdateOne {
+	iuo.mutation.ClearOccurredAt()
+	return iuo
+}
+
+// SetLocation sets the "location" field.
+func (iuo *IncidentUpdateOne) SetLocation(s string) *IncidentUpdateOne {
+	iuo.mutation.SetLocation(s)
+	return iuo
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableLocation(s *string) *IncidentUpdateOne {
+	if s != nil {
+		iuo.SetLocation(*s)
+	}
+	return iuo
+}
+
+// ClearLocation clears the value of the "location" field.
+func (iuo *IncidentUpdateOne) ClearLocation() *IncidentUpdateOne {
+	iuo.mutation.ClearLocation()
+	return iuo
+}
+
+// SetSeverity sets the "severity" field.
+func (iuo *IncidentUpdateOne) SetSeverity(s string) *IncidentUpdateOne {
+	iuo.mutation.SetSeverity(s)
+	return iuo
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableSeverity(s *string) *IncidentUpdateOne {
+	if s != nil {
+		iuo.SetSeverity(*s)
+	}
+	return iuo
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (iuo *IncidentUpdateOne) ClearSeverity() *IncidentUpdateOne {
+	iuo.mutation.ClearSeverity()
+	return iuo
+}
+
+// SetDescription sets the "description" field.
+func (iuo *IncidentUpdateOne) SetDescription(s string) *IncidentUpdateOne {
+	iuo.mutation.SetDescription(s)
+	return iuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableDescription(s *string) *IncidentUpdateOne {
+	if s != nil {
+		iuo.SetDescription(*s)
+	}
+	return iuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (iuo *IncidentUpdateOne) ClearDescription() *IncidentUpdateOne {
+	iuo.mutation.ClearDescription()
+	return iuo
+}
+
+// SetPhotoIds sets the "photo_ids" field.
+func (iuo *IncidentUpdateOne) SetPhotoIds(s string) *IncidentUpdateOne {
+	iuo.mutation.SetPhotoIds(s)
+	return iuo
+}
+
+// SetNillablePhotoIds sets the "photo_ids" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillablePhotoIds(s *string) *IncidentUpdateOne {
+	if s != nil {
+		iuo.SetPhotoIds(*s)
+	}
+	return iuo
+}
+
+// ClearPhotoIds clears the value of the "photo_ids" field.
+func (iuo *IncidentUpdateOne) ClearPhotoIds() *IncidentUpdateOne {
+	iuo.mutation.ClearPhotoIds()
+	return iuo
+}
+
+// SetThirdParties sets the "third_parties" field.
+func (iuo *IncidentUpdateOne) SetThirdParties(s string) *IncidentUpdateOne {
+	iuo.mutation.SetThirdParties(s)
+	return iuo
+}
+
+// SetNillableThirdParties sets the "third_parties" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableThirdParties(s *string) *IncidentUpdateOne {
+	if s != nil {
+		iuo.SetThirdParties(*s)
+	}
+	return iuo
+}
+
+// ClearThirdParties clears the value of the "third_parties" field.
+func (iuo *IncidentUpdateOne) ClearThirdParties() *IncidentUpdateOne {
+	iuo.mutation.ClearThirdParties()
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *IncidentUpdateOne) SetStatus(s string) *IncidentUpdateOne {
+	iuo.mutation.SetStatus(s)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableStatus(s *string) *IncidentUpdateOne {
+	if s != nil {
+		iuo.SetStatus(*s)
+	}
+	return iuo
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (iuo *IncidentUpdateOne) SetPolicyID(i int64) *IncidentUpdateOne {
+	iuo.mutation.SetPolicyID(i)
+	return iuo
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillablePolicyID(i *int64) *IncidentUpdateOne {
+	if i != nil {
+		iuo.SetPolicyID(*i)
+	}
+	return iuo
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (iuo *IncidentUpdateOne) ClearPolicyID() *IncidentUpdateOne {
+	iuo.mutation.ClearPolicyID()
+	return iuo
+}
+
+// SetClaimNo sets the "claim_no" field.
+func (iuo *IncidentUpdateOne) SetClaimNo(s string) *IncidentUpdateOne {
+	iuo.mutation.SetClaimNo(s)
+	return iuo
+}
+
+// SetNillableClaimNo sets the "claim_no" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableClaimNo(s *string) *IncidentUpdateOne {
+	if s != nil {
+		iuo.SetClaimNo(*s)
+	}
+	return iuo
+}
+
+// ClearClaimNo clears the value of the "claim_no" field.
+func (iuo *IncidentUpdateOne) ClearClaimNo() *IncidentUpdateOne {
+	iuo.mutation.ClearClaimNo()
+	return iuo
+}
+
+// SetClaimAmount sets the "claim_amount" field.
+func (iuo *IncidentUpdateOne) SetClaimAmount(f float64) *IncidentUpdateOne {
+	iuo.mutation.ResetClaimAmount()
+	iuo.mutation.SetClaimAmount(f)
+	return iuo
+}
+
+// SetNillableClaimAmount sets the "claim_amount" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableClaimAmount(f *float64) *IncidentUpdateOne {
+	if f != nil {
+		iuo.SetClaimAmount(*f)
+	}
+	return iuo
+}
+
+// AddClaimAmount adds f to the "claim_amount" field.
+func (iuo *IncidentUpdateOne) AddClaimAmount(f float64) *IncidentUpdateOne {
+	iuo.mutation.AddClaimAmount(f)
+	return iuo
+}
+
+// ClearClaimAmount clears the value of the "claim_amount" field.
+func (iuo *IncidentUpdateOne) ClearClaimAmount() *IncidentUpdateOne {
+	iuo.mutation.ClearClaimAmount()
+	return iuo
+}
+
+// SetSettledAmount sets the "settled_amount" field.
+func (iuo *IncidentUpdateOne) SetSettledAmount(f float64) *IncidentUpdateOne {
+	iuo.mutation.ResetSettledAmount()
+	iuo.mutation.SetSettledAmount(f)
+	return iuo
+}
+
+// SetNillableSettledAmount sets the "settled_amount" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableSettledAmount(f *float64) *IncidentUpdateOne {
+	if f != nil {
+		iuo.SetSettledAmount(*f)
+	}
+	return iuo
+}
+
+// AddSettledAmount adds f to the "settled_amount" field.
+func (iuo *IncidentUpdateOne) AddSettledAmount(f float64) *IncidentUpdateOne {
+	iuo.mutation.AddSettledAmount(f)
+	return iuo
+}
+
+// ClearSettledAmount clears the value of the "settled_amount" field.
+func (iuo *IncidentUpdateOne) ClearSettledAmount() *IncidentUpdateOne {
+	iuo.mutation.ClearSettledAmount()
+	return iuo
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (iuo *IncidentUpdateOne) SetSettledAt(t time.Time) *IncidentUpdateOne {
+	iuo.mutation.SetSettledAt(t)
+	return iuo
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableSettledAt(t *time.Time) *IncidentUpdateOne {
+	if t != nil {
+		iuo.SetSettledAt(*t)
+	}
+	return iuo
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (iuo *IncidentUpdateOne) ClearSettledAt() *IncidentUpdateOne {
+	iuo.mutation.ClearSettledAt()
+	return iuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iuo *IncidentUpdateOne) SetCreatedAt(t time.Time) *IncidentUpdateOne {
+	iuo.mutation.SetCreatedAt(t)
+	return iuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableCreatedAt(t *time.Time) *IncidentUpdateOne {
+	if t != nil {
+		iuo.SetCreatedAt(*t)
+	}
+	return iuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iuo *IncidentUpdateOne) SetUpdatedAt(t time.Time) *IncidentUpdateOne {
+	iuo.mutation.SetUpdatedAt(t)
+	return iuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iuo *IncidentUpdateOne) SetNillableUpdatedAt(t *time.Time) *IncidentUpdateOne {
+	if t != nil {
+		iuo.SetUpdatedAt(*t)
+	}
+	return iuo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (iuo *IncidentUpdateOne) SetCar(c *Car) *IncidentUpdateOne {
+	return iuo.SetCarID(c.ID)
+}
+
+// SetPolicy sets the "policy" edge to the InsurancePolicy entity.
+func (iuo *IncidentUpdateOne) SetPolicy(i *InsurancePolicy) *IncidentUpdateOne {
+	return iuo.SetPolicyID(i.ID)
+}
+
+// Mutation returns the IncidentMutation object of the builder.
+func (iuo *IncidentUpdateOne) Mutation() *IncidentMutation {
+	return iuo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (iuo *IncidentUpdateOne) ClearCar() *IncidentUpdateOne {
+	iuo.mutation.ClearCar()
+	return iuo
+}
+
+// ClearPolicy clears the "policy" edge to the InsurancePolicy entity.
+func (iuo *IncidentUpdateOne) ClearPolicy() *IncidentUpdateOne {
+	iuo.mutation.ClearPolicy()
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *IncidentUpdateOne) Select(field string, fields ...string) *IncidentUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Incident entity.
+func (iuo *IncidentUpdateOne) Save(ctx context.Context) (*Incident, error) {
+	var (
+		err  error
+		node *Incident
+	)
+	if len(iuo.hooks) == 0 {
+		node, err = iuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IncidentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iuo.mutation = mutation
+			node, err = iuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iuo.hooks) - 1; i >= 0; i-- {
+			if iuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, iuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Incident)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from IncidentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *IncidentUpdateOne) SaveX(ctx context.Context) *Incident {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *IncidentUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *IncidentUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iuo *IncidentUpdateOne) sqlSave(ctx context.Context) (_node *Incident, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   incident.Table,
+			Columns: incident.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: incident.FieldID,
+			},
+		},
+	}
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Incident.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, incident.FieldID)
+		for _, f := range fields {
+			if !incident.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != incident.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldTenantID,
+		})
+	}
+	if value, ok := iuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldTenantID,
+		})
+	}
+	if iuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: incident.FieldTenantID,
+		})
+	}
+	if value, ok := iuo.mutation.ReporterID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldReporterID,
+		})
+	}
+	if value, ok := iuo.mutation.AddedReporterID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: incident.FieldReporterID,
+		})
+	}
+	if iuo.mutation.ReporterIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: incident.FieldReporterID,
+		})
+	}
+	if value, ok := iuo.mutation.OccurredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldOccurredAt,
+		})
+	}
+	if iuo.mutation.OccurredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: incident.FieldOccurredAt,
+		})
+	}
+	if value, ok := iuo.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldLocation,
+		})
+	}
+	if iuo.mutation.LocationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldLocation,
+		})
+	}
+	if value, ok := iuo.mutation.Severity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldSeverity,
+		})
+	}
+	if iuo.mutation.SeverityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldSeverity,
+		})
+	}
+	if value, ok := iuo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldDescription,
+		})
+	}
+	if iuo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldDescription,
+		})
+	}
+	if value, ok := iuo.mutation.PhotoIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldPhotoIds,
+		})
+	}
+	if iuo.mutation.PhotoIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldPhotoIds,
+		})
+	}
+	if value, ok := iuo.mutation.ThirdParties(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldThirdParties,
+		})
+	}
+	if iuo.mutation.ThirdPartiesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldThirdParties,
+		})
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldStatus,
+		})
+	}
+	if value, ok := iuo.mutation.ClaimNo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: incident.FieldClaimNo,
+		})
+	}
+	if iuo.mutation.ClaimNoCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: incident.FieldClaimNo,
+		})
+	}
+	if value, ok := iuo.mutation.ClaimAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldClaimAmount,
+		})
+	}
+	if value, ok := iuo.mutation.AddedClaimAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldClaimAmount,
+		})
+	}
+	if iuo.mutation.ClaimAmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: incident.FieldClaimAmount,
+		})
+	}
+	if value, ok := iuo.mutation.SettledAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldSettledAmount,
+		})
+	}
+	if value, ok := iuo.mutation.AddedSettledAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: incident.FieldSettledAmount,
+		})
+	}
+	if iuo.mutation.SettledAmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: incident.FieldSettledAmount,
+		})
+	}
+	if value, ok := iuo.mutation.SettledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldSettledAt,
+		})
+	}
+	if iuo.mutation.SettledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: incident.FieldSettledAt,
+		})
+	}
+	if value, ok := iuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldCreatedAt,
+		})
+	}
+	if value, ok := iuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: incident.FieldUpdatedAt,
+		})
+	}
+	if iuo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.CarTable,
+			Columns: []string{incident.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.CarTable,
+			Columns: []string{incident.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.PolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.PolicyTable,
+			Columns: []string{incident.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.PolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incident.PolicyTable,
+			Columns: []string{incident.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: insurancepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Incident{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{incident.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/insurancepolicy"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// InsurancePolicy is the model entity for the InsurancePolicy schema.
+type InsurancePolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// PolicyNumber holds the value of the "policy_number" field.
+	PolicyNumber string `json:"policy_number,omitempty"`
+	// Coverage holds the value of the "coverage" field.
+	Coverage string `json:"coverage,omitempty"`
+	// StartDate holds the value of the "start_date" field.
+	StartDate time.Time `json:"start_date,omitempty"`
+	// EndDate holds the value of the "end_date" field.
+	EndDate time.Time `json:"end_date,omitempty"`
+	// Premium holds the value of the "premium" field.
+	Premium float64 `json:"premium,omitempty"`
+	// RemindedAt holds the value of the "reminded_at" field.
+	RemindedAt *time.Time `json:"reminded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InsurancePolicyQuery when eager-loading is set.
+	Edges InsurancePolicyEdges `json:"edges"`
+}
+
+// InsurancePolicyEdges holds the relations/edges for other nodes in the graph.
+type InsurancePolicyEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// Incidents holds the value of the incidents edge.
+	Incidents []*Incident `json:"incidents,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InsurancePolicyEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// IncidentsOrErr returns the Incidents value or an error if the edge
+// was not loaded in eager-loading.
+func (e InsurancePolicyEdges) IncidentsOrErr() ([]*Incident, error) {
+	if e.loadedTypes[1] {
+		return e.Incidents, nil
+	}
+	return nil, &NotLoadedError{edge: "incidents"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InsurancePolicy) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case insurancepolicy.FieldPremium:
+			values[i] = new(sql.NullFloat64)
+		case insurancepolicy.FieldID, insurancepolicy.FieldTenantID, insurancepolicy.FieldCarID:
+			values[i] = new(sql.NullInt64)
+		case insurancepolicy.FieldProvider, insurancepolicy.FieldPolicyNumber, insurancepolicy.FieldCoverage:
+			values[i] = new(sql.NullString)
+		case insurancepolicy.FieldStartDate, insurancepolicy.FieldEndDate, insurancepolicy.FieldRemindedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InsurancePolicy", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InsurancePolicy fields.
+func (ip *InsurancePolicy) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case insurancepolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ip.ID = int64(value.Int64)
+		case insurancepolicy.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ip.TenantID = value.Int64
+			}
+		case insurancepolicy.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				ip.CarID = value.Int64
+			}
+		case insurancepolicy.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				ip.Provider = value.String
+			}
+		case insurancepolicy.FieldPolicyNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_number", values[i])
+			} else if value.Valid {
+				ip.PolicyNumber = value.String
+			}
+		case insurancepolicy.FieldCoverage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coverage", values[i])
+			} else if value.Valid {
+				ip.Coverage = value.String
+			}
+		case insurancepolicy.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				ip.StartDate = value.Time
+			}
+		case insurancepolicy.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				ip.EndDate = value.Time
+			}
+		case insurancepolicy.FieldPremium:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field premium", values[i])
+			} else if value.Valid {
+				ip.Premium = value.Float64
+			}
+		case insurancepolicy.FieldRemindedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reminded_at", values[i])
+			} else if value.Valid {
+				ip.RemindedAt = new(time.Time)
+				*ip.RemindedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the InsurancePolicy entity.
+func (ip *InsurancePolicy) QueryCar() *CarQuery {
+	return (&InsurancePolicyClient{config: ip.config}).QueryCar(ip)
+}
+
+// QueryIncidents queries the "incidents" edge of the InsurancePolicy entity.
+func (ip *InsurancePolicy) QueryIncidents() *IncidentQuery {
+	return (&InsurancePolicyClient{config: ip.config}).QueryIncidents(ip)
+}
+
+// Update returns a builder for updating this InsurancePolicy.
+// Note that you need to call InsurancePolicy.Unwrap() before calling this method if this InsurancePolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ip *InsurancePolicy) Update() *InsurancePolicyUpdateOne {
+	return (&InsurancePolicyClient{config: ip.config}).UpdateOne(ip)
+}
+
+// Unwrap unwraps the InsurancePolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ip *InsurancePolicy) Unwrap() *InsurancePolicy {
+	_tx, ok := ip.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InsurancePolicy is not a transactional entity")
+	}
+	ip.config.driver = _tx.drv
+	return ip
+}
+
+// String implements the fmt.Stringer.
+func (ip *InsurancePolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("InsurancePolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ip.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ip.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", ip.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(ip.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("policy_number=")
+	builder.WriteString(ip.PolicyNumber)
+	builder.WriteString(", ")
+	builder.WriteString("coverage=")
+	builder.WriteString(ip.Coverage)
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(ip.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(ip.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("premium=")
+	builder.WriteString(fmt.Sprintf("%v", ip.Premium))
+	builder.WriteString(", ")
+	if v := ip.RemindedAt; v != nil {
+		builder.WriteString("reminded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InsurancePolicies is a parsable slice of InsurancePolicy.
+type InsurancePolicies []*InsurancePolicy
+
+func (ip InsurancePolicies) config(cfg config) {
+	for _i := range ip {
+		ip[_i].config = cfg
+	}
+}
@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package insurancepolicy
+
+import (
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the insurancepolicy type in the database.
+	Label = "insurance_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldPolicyNumber holds the string denoting the policy_number field in the database.
+	FieldPolicyNumber = "policy_number"
+	// FieldCoverage holds the string denoting the coverage field in the database.
+	FieldCoverage = "coverage"
+	// FieldStartDate holds the string denoting the start_date field in the database.
+	FieldStartDate = "start_date"
+	// FieldEndDate holds the string denoting the end_date field in the database.
+	FieldEndDate = "end_date"
+	// FieldPremium holds the string denoting the premium field in the database.
+	FieldPremium = "premium"
+	// FieldRemindedAt holds the string denoting the reminded_at field in the database.
+	FieldRemindedAt = "reminded_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// EdgeIncidents holds the string denoting the incidents edge name in mutations.
+	EdgeIncidents = "incidents"
+	// Table holds the table name of the insurancepolicy in the database.
+	Table = "insurance_policy"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "insurance_policy"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+	// IncidentsTable is the table that holds the incidents relation/edge.
+	IncidentsTable = "incident"
+	// IncidentsInverseTable is the table name for the Incident entity.
+	// It exists in this package in order to avoid circular dependency with the "incident" package.
+	IncidentsInverseTable = "incident"
+	// IncidentsColumn is the table column denoting the incidents relation/edge.
+	IncidentsColumn = "policy_id"
+)
+
+// Columns holds all SQL columns for insurancepolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldProvider,
+	FieldPolicyNumber,
+	FieldCoverage,
+	FieldStartDate,
+	FieldEndDate,
+	FieldPremium,
+	FieldRemindedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+)
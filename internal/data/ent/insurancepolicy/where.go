@@ -0,0 +1,1120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package insurancepolicy
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProvider), v))
+	})
+}
+
+// PolicyNumber applies equality check predicate on the "policy_number" field. It's identical to PolicyNumberEQ.
+func PolicyNumber(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// Coverage applies equality check predicate on the "coverage" field. It's identical to CoverageEQ.
+func Coverage(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCoverage), v))
+	})
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartDate), v))
+	})
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndDate), v))
+	})
+}
+
+// Premium applies equality check predicate on the "premium" field. It's identical to PremiumEQ.
+func Premium(v float64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPremium), v))
+	})
+}
+
+// RemindedAt applies equality check predicate on the "reminded_at" field. It's identical to RemindedAtEQ.
+func RemindedAt(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemindedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProvider), v...))
+	})
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProvider), v...))
+	})
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderIsNil applies the IsNil predicate on the "provider" field.
+func ProviderIsNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldProvider)))
+	})
+}
+
+// ProviderNotNil applies the NotNil predicate on the "provider" field.
+func ProviderNotNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldProvider)))
+	})
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldProvider), v))
+	})
+}
+
+// PolicyNumberEQ applies the EQ predicate on the "policy_number" field.
+func PolicyNumberEQ(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberNEQ applies the NEQ predicate on the "policy_number" field.
+func PolicyNumberNEQ(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberIn applies the In predicate on the "policy_number" field.
+func PolicyNumberIn(vs ...string) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPolicyNumber), v...))
+	})
+}
+
+// PolicyNumberNotIn applies the NotIn predicate on the "policy_number" field.
+func PolicyNumberNotIn(vs ...string) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPolicyNumber), v...))
+	})
+}
+
+// PolicyNumberGT applies the GT predicate on the "policy_number" field.
+func PolicyNumberGT(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberGTE applies the GTE predicate on the "policy_number" field.
+func PolicyNumberGTE(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberLT applies the LT predicate on the "policy_number" field.
+func PolicyNumberLT(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberLTE applies the LTE predicate on the "policy_number" field.
+func PolicyNumberLTE(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberContains applies the Contains predicate on the "policy_number" field.
+func PolicyNumberContains(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberHasPrefix applies the HasPrefix predicate on the "policy_number" field.
+func PolicyNumberHasPrefix(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberHasSuffix applies the HasSuffix predicate on the "policy_number" field.
+func PolicyNumberHasSuffix(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberIsNil applies the IsNil predicate on the "policy_number" field.
+func PolicyNumberIsNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPolicyNumber)))
+	})
+}
+
+// PolicyNumberNotNil applies the NotNil predicate on the "policy_number" field.
+func PolicyNumberNotNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPolicyNumber)))
+	})
+}
+
+// PolicyNumberEqualFold applies the EqualFold predicate on the "policy_number" field.
+func PolicyNumberEqualFold(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// PolicyNumberContainsFold applies the ContainsFold predicate on the "policy_number" field.
+func PolicyNumberContainsFold(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPolicyNumber), v))
+	})
+}
+
+// CoverageEQ applies the EQ predicate on the "coverage" field.
+func CoverageEQ(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageNEQ applies the NEQ predicate on the "coverage" field.
+func CoverageNEQ(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageIn applies the In predicate on the "coverage" field.
+func CoverageIn(vs ...string) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCoverage), v...))
+	})
+}
+
+// CoverageNotIn applies the NotIn predicate on the "coverage" field.
+func CoverageNotIn(vs ...string) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCoverage), v...))
+	})
+}
+
+// CoverageGT applies the GT predicate on the "coverage" field.
+func CoverageGT(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageGTE applies the GTE predicate on the "coverage" field.
+func CoverageGTE(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageLT applies the LT predicate on the "coverage" field.
+func CoverageLT(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageLTE applies the LTE predicate on the "coverage" field.
+func CoverageLTE(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageContains applies the Contains predicate on the "coverage" field.
+func CoverageContains(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageHasPrefix applies the HasPrefix predicate on the "coverage" field.
+func CoverageHasPrefix(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageHasSuffix applies the HasSuffix predicate on the "coverage" field.
+func CoverageHasSuffix(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageIsNil applies the IsNil predicate on the "coverage" field.
+func CoverageIsNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCoverage)))
+	})
+}
+
+// CoverageNotNil applies the NotNil predicate on the "coverage" field.
+func CoverageNotNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCoverage)))
+	})
+}
+
+// CoverageEqualFold applies the EqualFold predicate on the "coverage" field.
+func CoverageEqualFold(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageContainsFold applies the ContainsFold predicate on the "coverage" field.
+func CoverageContainsFold(v string) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCoverage), v))
+	})
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartDate), v...))
+	})
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartDate), v...))
+	})
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateIsNil applies the IsNil predicate on the "start_date" field.
+func StartDateIsNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartDate)))
+	})
+}
+
+// StartDateNotNil applies the NotNil predicate on the "start_date" field.
+func StartDateNotNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartDate)))
+	})
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndDate), v...))
+	})
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndDate), v...))
+	})
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateIsNil applies the IsNil predicate on the "end_date" field.
+func EndDateIsNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndDate)))
+	})
+}
+
+// EndDateNotNil applies the NotNil predicate on the "end_date" field.
+func EndDateNotNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndDate)))
+	})
+}
+
+// PremiumEQ applies the EQ predicate on the "premium" field.
+func PremiumEQ(v float64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPremium), v))
+	})
+}
+
+// PremiumNEQ applies the NEQ predicate on the "premium" field.
+func PremiumNEQ(v float64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPremium), v))
+	})
+}
+
+// PremiumIn applies the In predicate on the "premium" field.
+func PremiumIn(vs ...float64) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPremium), v...))
+	})
+}
+
+// PremiumNotIn applies the NotIn predicate on the "premium" field.
+func PremiumNotIn(vs ...float64) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPremium), v...))
+	})
+}
+
+// PremiumGT applies the GT predicate on the "premium" field.
+func PremiumGT(v float64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPremium), v))
+	})
+}
+
+// PremiumGTE applies the GTE predicate on the "premium" field.
+func PremiumGTE(v float64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPremium), v))
+	})
+}
+
+// PremiumLT applies the LT predicate on the "premium" field.
+func PremiumLT(v float64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPremium), v))
+	})
+}
+
+// PremiumLTE applies the LTE predicate on the "premium" field.
+func PremiumLTE(v float64) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPremium), v))
+	})
+}
+
+// PremiumIsNil applies the IsNil predicate on the "premium" field.
+func PremiumIsNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPremium)))
+	})
+}
+
+// PremiumNotNil applies the NotNil predicate on the "premium" field.
+func PremiumNotNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPremium)))
+	})
+}
+
+// RemindedAtEQ applies the EQ predicate on the "reminded_at" field.
+func RemindedAtEQ(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemindedAt), v))
+	})
+}
+
+// RemindedAtNEQ applies the NEQ predicate on the "reminded_at" field.
+func RemindedAtNEQ(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRemindedAt), v))
+	})
+}
+
+// RemindedAtIn applies the In predicate on the "reminded_at" field.
+func RemindedAtIn(vs ...time.Time) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRemindedAt), v...))
+	})
+}
+
+// RemindedAtNotIn applies the NotIn predicate on the "reminded_at" field.
+func RemindedAtNotIn(vs ...time.Time) predicate.InsurancePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRemindedAt), v...))
+	})
+}
+
+// RemindedAtGT applies the GT predicate on the "reminded_at" field.
+func RemindedAtGT(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRemindedAt), v))
+	})
+}
+
+// RemindedAtGTE applies the GTE predicate on the "reminded_at" field.
+func RemindedAtGTE(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRemindedAt), v))
+	})
+}
+
+// RemindedAtLT applies the LT predicate on the "reminded_at" field.
+func RemindedAtLT(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRemindedAt), v))
+	})
+}
+
+// RemindedAtLTE applies the LTE predicate on the "reminded_at" field.
+func RemindedAtLTE(v time.Time) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRemindedAt), v))
+	})
+}
+
+// RemindedAtIsNil applies the IsNil predicate on the "reminded_at" field.
+func RemindedAtIsNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRemindedAt)))
+	})
+}
+
+// RemindedAtNotNil applies the NotNil predicate on the "reminded_at" field.
+func RemindedAtNotNil() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRemindedAt)))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIncidents applies the HasEdge predicate on the "incidents" edge.
+func HasIncidents() predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(IncidentsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IncidentsTable, IncidentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIncidentsWith applies the HasEdge predicate on the "incidents" edge with a given conditions (other predicates).
+func HasIncidentsWith(preds ...predicate.Incident) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(IncidentsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IncidentsTable, IncidentsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InsurancePolicy) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InsurancePolicy) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InsurancePolicy) predicate.InsurancePolicy {
+	return predicate.InsurancePolicy(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InsurancePolicyCreate is the builder for creating a InsurancePolicy entity.
+type InsurancePolicyCreate struct {
+	config
+	mutation *InsurancePolicyMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ipc *InsurancePolicyCreate) SetTenantID(i int64) *InsurancePolicyCreate {
+	ipc.mutation.SetTenantID(i)
+	return ipc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ipc *InsurancePolicyCreate) SetNillableTenantID(i *int64) *InsurancePolicyCreate {
+	if i != nil {
+		ipc.SetTenantID(*i)
+	}
+	return ipc
+}
+
+// SetCarID sets the "car_id" field.
+func (ipc *InsurancePolicyCreate) SetCarID(i int64) *InsurancePolicyCreate {
+	ipc.mutation.SetCarID(i)
+	return ipc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ipc *InsurancePolicyCreate) SetNillableCarID(i *int64) *InsurancePolicyCreate {
+	if i != nil {
+		ipc.SetCarID(*i)
+	}
+	return ipc
+}
+
+// SetProvider sets the "provider" field.
+func (ipc *InsurancePolicyCreate) SetProvider(s string) *InsurancePolicyCreate {
+	ipc.mutation.SetProvider(s)
+	return ipc
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (ipc *InsurancePolicyCreate) SetNillableProvider(s *string) *InsurancePolicyCreate {
+	if s != nil {
+		ipc.SetProvider(*s)
+	}
+	return ipc
+}
+
+// SetPolicyNumber sets the "policy_number" field.
+func (ipc *InsurancePolicyCreate) SetPolicyNumber(s string) *InsurancePolicyCreate {
+	ipc.mutation.SetPolicyNumber(s)
+	return ipc
+}
+
+// SetNillablePolicyNumber sets the "policy_number" field if the given value is not nil.
+func (ipc *InsurancePolicyCreate) SetNillablePolicyNumber(s *string) *InsurancePolicyCreate {
+	if s != nil {
+		ipc.SetPolicyNumber(*s)
+	}
+	return ipc
+}
+
+// SetCoverage sets the "coverage" field.
+func (ipc *InsurancePolicyCreate) SetCoverage(s string) *InsurancePolicyCreate {
+	ipc.mutation.SetCoverage(s)
+	return ipc
+}
+
+// SetNillableCoverage sets the "coverage" field if the given value is not nil.
+func (ipc *InsurancePolicyCreate) SetNillableCoverage(s *string) *InsurancePolicyCreate {
+	if s != nil {
+		ipc.SetCoverage(*s)
+	}
+	return ipc
+}
+
+// SetStartDate sets the "start_date" field.
+func (ipc *InsurancePolicyCreate) SetStartDate(t time.Time) *InsurancePolicyCreate {
+	ipc.mutation.SetStartDate(t)
+	return ipc
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ipc *InsurancePolicyCreate) SetNillableStartDate(t *time.Time) *InsurancePolicyCreate {
+	if t != nil {
+		ipc.SetStartDate(*t)
+	}
+	return ipc
+}
+
+// SetEndDate sets the "end_date" field.
+func (ipc *InsurancePolicyCreate) SetEndDate(t time.Time) *InsurancePolicyCreate {
+	ipc.mutation.SetEndDate(t)
+	return ipc
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ipc *InsurancePolicyCreate) SetNillableEndDate(t *time.Time) *InsurancePolicyCreate {
+	if t != nil {
+		ipc.SetEndDate(*t)
+	}
+	return ipc
+}
+
+// SetPremium sets the "premium" field.
+func (ipc *InsurancePolicyCreate) SetPremium(f float64) *InsurancePolicyCreate {
+	ipc.mutation.SetPremium(f)
+	return ipc
+}
+
+// SetNillablePremium sets the "premium" field if the given value is not nil.
+func (ipc *InsurancePolicyCreate) SetNillablePremium(f *float64) *InsurancePolicyCreate {
+	if f != nil {
+		ipc.SetPremium(*f)
+	}
+	return ipc
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (ipc *InsurancePolicyCreate) SetRemindedAt(t time.Time) *InsurancePolicyCreate {
+	ipc.mutation.SetRemindedAt(t)
+	return ipc
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (ipc *InsurancePolicyCreate) SetNillableRemindedAt(t *time.Time) *InsurancePolicyCreate {
+	if t != nil {
+		ipc.SetRemindedAt(*t)
+	}
+	return ipc
+}
+
+// SetID sets the "id" field.
+func (ipc *InsurancePolicyCreate) SetID(i int64) *InsurancePolicyCreate {
+	ipc.mutation.SetID(i)
+	return ipc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ipc *InsurancePolicyCreate) SetCar(c *Car) *InsurancePolicyCreate {
+	return ipc.SetCarID(c.ID)
+}
+
+// AddIncidentIDs adds the "incidents" edge to the Incident entity by IDs.
+func (ipc *InsurancePolicyCreate) AddIncidentIDs(ids ...int64) *InsurancePolicyCreate {
+	ipc.mutation.AddIncidentIDs(ids...)
+	return ipc
+}
+
+// AddIncidents adds the "incidents" edges to the Incident entity.
+func (ipc *InsurancePolicyCreate) AddIncidents(i ...*Incident) *InsurancePolicyCreate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ipc.AddIncidentIDs(ids...)
+}
+
+// Mutation returns the InsurancePolicyMutation object of the builder.
+func (ipc *InsurancePolicyCreate) Mutation() *InsurancePolicyMutation {
+	return ipc.mutation
+}
+
+// Save creates the InsurancePolicy in the database.
+func (ipc *InsurancePolicyCreate) Save(ctx context.Context) (*InsurancePolicy, error) {
+	var (
+		err  error
+		node *InsurancePolicy
+	)
+	if len(ipc.hooks) == 0 {
+		if err = ipc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ipc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InsurancePolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ipc.check(); err != nil {
+				return nil, err
+			}
+			ipc.mutation = mutation
+			if node, err = ipc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ipc.hooks) - 1; i >= 0; i-- {
+			if ipc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ipc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ipc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InsurancePolicy)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InsurancePolicyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ipc *InsurancePolicyCreate) SaveX(ctx context.Context) *InsurancePolicy {
+	v, err := ipc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ipc *InsurancePolicyCreate) Exec(ctx context.Context) error {
+	_, err := ipc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipc *InsurancePolicyCreate) ExecX(ctx context.Context) {
+	if err := ipc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ipc *InsurancePolicyCreate) check() error {
+	return nil
+}
+
+func (ipc *InsurancePolicyCreate) sqlSave(ctx context.Context) (*InsurancePolicy, error) {
+	_node, _spec := ipc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ipc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (ipc *InsurancePolicyCreate) createSpec() (*InsurancePolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InsurancePolicy{config: ipc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: insurancepolicy.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: insurancepolicy.FieldID,
+			},
+		}
+	)
+	if id, ok := ipc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ipc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: insurancepolicy.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := ipc.mutation.Provider(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: insurancepolicy.FieldProvider,
+		})
+		_node.Provider = value
+	}
+	if value, ok := ipc.mutation.PolicyNumber(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: insurancepolicy.FieldPolicyNumber,
+		})
+		_node.PolicyNumber = value
+	}
+	if value, ok := ipc.mutation.Coverage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: insurancepolicy.FieldCoverage,
+		})
+		_node.Coverage = value
+	}
+	if value, ok := ipc.mutation.StartDate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: insurancepolicy.FieldStartDate,
+		})
+		_node.StartDate = value
+	}
+	if value, ok := ipc.mutation.EndDate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: insurancepolicy.FieldEndDate,
+		})
+		_node.EndDate = value
+	}
+	if value, ok := ipc.mutation.Premium(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: insurancepolicy.FieldPremium,
+		})
+		_node.Premium = value
+	}
+	if value, ok := ipc.mutation.RemindedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: insurancepolicy.FieldRemindedAt,
+		})
+		_node.RemindedAt = &value
+	}
+	if nodes := ipc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   insurancepolicy.CarTable,
+			Columns: []string{insurancepolicy.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ipc.mutation.IncidentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   insurancepolicy.IncidentsTable,
+			Columns: []string{insurancepolicy.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InsurancePolicyCreateBulk is the builder for creating many InsurancePolicy entities in bulk.
+type InsurancePolicyCreateBulk struct {
+	config
+	builders []*InsurancePolicyCreate
+}
+
+// Save creates the InsurancePolicy entities in the database.
+func (ipcb *InsurancePolicyCreateBulk) Save(ctx context.Context) ([]*InsurancePolicy, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ipcb.builders))
+	nodes := make([]*InsurancePolicy, len(ipcb.builders))
+	mutators := make([]Mutator, len(ipcb.builders))
+	for i := range ipcb.builders {
+		func(i int, root context.Context) {
+			builder := ipcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InsurancePolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ipcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ipcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ipcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipcb *InsurancePolicyCreateBulk) SaveX(ctx context.Context) []*InsurancePolicy {
+	v, err := ipcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ipcb *InsurancePolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := ipcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipcb *InsurancePolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := ipcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InsurancePolicyDelete is the builder for deleting a InsurancePolicy entity.
+type InsurancePolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *InsurancePolicyMutation
+}
+
+// Where appends a list predicates to the InsurancePolicyDelete builder.
+func (ipd *InsurancePolicyDelete) Where(ps ...predicate.InsurancePolicy) *InsurancePolicyDelete {
+	ipd.mutation.Where(ps...)
+	return ipd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ipd *InsurancePolicyDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ipd.hooks) == 0 {
+		affected, err = ipd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InsurancePolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ipd.mutation = mutation
+			affected, err = ipd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ipd.hooks) - 1; i >= 0; i-- {
+			if ipd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ipd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ipd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipd *InsurancePolicyDelete) ExecX(ctx context.Context) int {
+	n, err := ipd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ipd *InsurancePolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: insurancepolicy.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: insurancepolicy.FieldID,
+			},
+		},
+	}
+	if ps := ipd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ipd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// InsurancePolicyDeleteOne is the builder for deleting a single InsurancePolicy entity.
+type InsurancePolicyDeleteOne struct {
+	ipd *InsurancePolicyDelete
+}
+
+// Exec executes the deletion query.
+func (ipdo *InsurancePolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := ipdo.ipd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{insurancepolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipdo *InsurancePolicyDeleteOne) ExecX(ctx context.Context) {
+	ipdo.ipd.ExecX(ctx)
+}
@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InsurancePolicyQuery is the builder for querying InsurancePolicy entities.
+type InsurancePolicyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InsurancePolicy
+	// eager-loading edges.
+	withCar       *CarQuery
+	withIncidents *IncidentQuery
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InsurancePolicyQuery builder.
+func (ipq *InsurancePolicyQuery) Where(ps ...predicate.InsurancePolicy) *InsurancePolicyQuery {
+	ipq.predicates = append(ipq.predicates, ps...)
+	return ipq
+}
+
+// Limit adds a limit step to the query.
+func (ipq *InsurancePolicyQuery) Limit(limit int) *InsurancePolicyQuery {
+	ipq.limit = &limit
+	return ipq
+}
+
+// Offset adds an offset step to the query.
+func (ipq *InsurancePolicyQuery) Offset(offset int) *InsurancePolicyQuery {
+	ipq.offset = &offset
+	return ipq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ipq *InsurancePolicyQuery) Unique(unique bool) *InsurancePolicyQuery {
+	ipq.unique = &unique
+	return ipq
+}
+
+// Order adds an order step to the query.
+func (ipq *InsurancePolicyQuery) Order(o ...OrderFunc) *InsurancePolicyQuery {
+	ipq.order = append(ipq.order, o...)
+	return ipq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (ipq *InsurancePolicyQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: ipq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ipq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ipq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(insurancepolicy.Table, insurancepolicy.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, insurancepolicy.CarTable, insurancepolicy.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ipq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIncidents chains the current query on the "incidents" edge.
+func (ipq *InsurancePolicyQuery) QueryIncidents() *IncidentQuery {
+	query := &IncidentQuery{config: ipq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ipq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ipq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(insurancepolicy.Table, insurancepolicy.FieldID, selector),
+			sqlgraph.To(incident.Table, incident.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, insurancepolicy.IncidentsTable, insurancepolicy.IncidentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ipq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first InsurancePolicy entity from the query.
+// Returns a *NotFoundError when no InsurancePolicy was found.
+func (ipq *InsurancePolicyQuery) First(ctx context.Context) (*InsurancePolicy, error) {
+	nodes, err := ipq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{insurancepolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ipq *InsurancePolicyQuery) FirstX(ctx context.Context) *InsurancePolicy {
+	node, err := ipq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InsurancePolicy ID from the query.
+// Returns a *NotFoundError when no InsurancePolicy ID was found.
+func (ipq *InsurancePolicyQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = ipq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{insurancepolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ipq *InsurancePolicyQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := ipq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InsurancePolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InsurancePolicy entity is found.
+// Returns a *NotFoundError when no InsurancePolicy entities are found.
+func (ipq *InsurancePolicyQuery) Only(ctx context.Context) (*InsurancePolicy, error) {
+	nodes, err := ipq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{insurancepolicy.Label}
+	default:
+		return nil, &NotSingularError{insurancepolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ipq *InsurancePolicyQuery) OnlyX(ctx context.Context) *InsurancePolicy {
+	node, err := ipq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InsurancePolicy ID in the query.
+// Returns a *NotSingularError when more than one InsurancePolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ipq *InsurancePolicyQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = ipq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{insurancepolicy.Label}
+	default:
+		err = &NotSingularError{insurancepolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ipq *InsurancePolicyQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := ipq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InsurancePolicies.
+func (ipq *InsurancePolicyQuery) All(ctx context.Context) ([]*InsurancePolicy, error) {
+	if err := ipq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ipq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ipq *InsurancePolicyQuery) AllX(ctx context.Context) []*InsurancePolicy {
+	nodes, err := ipq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InsurancePolicy IDs.
+func (ipq *InsurancePolicyQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := ipq.Select(insurancepolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ipq *InsurancePolicyQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := ipq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ipq *InsurancePolicyQuery) Count(ctx context.Context) (int, error) {
+	if err := ipq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ipq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ipq *InsurancePolicyQuery) CountX(ctx context.Context) int {
+	count, err := ipq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ipq *InsurancePolicyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ipq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ipq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ipq *InsurancePolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := ipq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InsurancePolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ipq *InsurancePolicyQuery) Clone() *InsurancePolicyQuery {
+	if ipq == nil {
+		return nil
+	}
+	return &InsurancePolicyQuery{
+		config:        ipq.config,
+		limit:         ipq.limit,
+		offset:        ipq.offset,
+		order:         append([]OrderFunc{}, ipq.order...),
+		predicates:    append([]predicate.InsurancePolicy{}, ipq.predicates...),
+		withCar:       ipq.withCar.Clone(),
+		withIncidents: ipq.withIncidents.Clone(),
+		// clone intermediate query.
+		sql:    ipq.sql.Clone(),
+		path:   ipq.path,
+		unique: ipq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (ipq *InsurancePolicyQuery) WithCar(opts ...func(*CarQuery)) *InsurancePolicyQuery {
+	query := &CarQuery{config: ipq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ipq.withCar = query
+	return ipq
+}
+
+// WithIncidents tells the query-builder to eager-load the nodes that are connected to
+// the "incidents" edge. The optional arguments are used to configure the query builder of the edge.
+func (ipq *InsurancePolicyQuery) WithIncidents(opts ...func(*IncidentQuery)) *InsurancePolicyQuery {
+	query := &IncidentQuery{config: ipq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ipq.withIncidents = query
+	return ipq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InsurancePolicy.Query().
+//		GroupBy(insurancepolicy.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (ipq *InsurancePolicyQuery) GroupBy(field string, fields ...string) *InsurancePolicyGroupBy {
+	grbuild := &InsurancePolicyGroupBy{config: ipq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ipq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ipq.sqlQuery(ctx), nil
+	}
+	grbuild.label = insurancepolicy.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.InsurancePolicy.Query().
+//		Select(insurancepolicy.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (ipq *InsurancePolicyQuery) Select(fields ...string) *InsurancePolicySelect {
+	ipq.fields = append(ipq.fields, fields...)
+	selbuild := &InsurancePolicySelect{InsurancePolicyQuery: ipq}
+	selbuild.label = insurancepolicy.Label
+	selbuild.flds, selbuild.scan = &ipq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ipq *InsurancePolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ipq.fields {
+		if !insurancepolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ipq.path != nil {
+		prev, err := ipq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ipq.sql = prev
+	}
+	if insurancepolicy.Policy == nil {
+		return errors.New("ent: uninitialized insurancepolicy.Policy (forgotten import ent/runtime?)")
+	}
+	if err := insurancepolicy.Policy.EvalQuery(ctx, ipq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ipq *InsurancePolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InsurancePolicy, error) {
+	var (
+		nodes       = []*InsurancePolicy{}
+		_spec       = ipq.querySpec()
+		loadedTypes = [2]bool{
+			ipq.withCar != nil,
+			ipq.withIncidents != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*InsurancePolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &InsurancePolicy{config: ipq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ipq.modifiers) > 0 {
+		_spec.Modifiers = ipq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ipq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ipq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*InsurancePolicy)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	if query := ipq.withIncidents; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*InsurancePolicy)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Incidents = []*Incident{}
+		}
+		query.Where(predicate.Incident(func(s *sql.Selector) {
+			s.Where(sql.InValues(insurancepolicy.IncidentsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.PolicyID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "policy_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Incidents = append(node.Edges.Incidents, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ipq *InsurancePolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ipq.querySpec()
+	if len(ipq.modifiers) > 0 {
+		_spec.Modifiers = ipq.modifiers
+	}
+	_spec.Node.Columns = ipq.fields
+	if len(ipq.fields) > 0 {
+		_spec.Unique = ipq.unique != nil && *ipq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ipq.driver, _spec)
+}
+
+func (ipq *InsurancePolicyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ipq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ipq *InsurancePolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   insurancepolicy.Table,
+			Columns: insurancepolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: insurancepolicy.FieldID,
+			},
+		},
+		From:   ipq.sql,
+		Unique: true,
+	}
+	if unique := ipq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ipq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, insurancepolicy.FieldID)
+		for i := range fields {
+			if fields[i] != insurancepolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ipq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ipq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ipq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ipq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ipq *InsurancePolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ipq.driver.Dialect())
+	t1 := builder.Table(insurancepolicy.Table)
+	columns := ipq.fields
+	if len(columns) == 0 {
+		columns = insurancepolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ipq.sql != nil {
+		selector = ipq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ipq.unique != nil && *ipq.unique {
+		selector.Distinct()
+	}
+	for _, m := range ipq.modifiers {
+		m(selector)
+	}
+	for _, p := range ipq.predicates {
+		p(selector)
+	}
+	for _, p := range ipq.order {
+		p(selector)
+	}
+	if offset := ipq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ipq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ipq *InsurancePolicyQuery) ForUpdate(opts ...sql.LockOption) *InsurancePolicyQuery {
+	if ipq.driver.Dialect() == dialect.Postgres {
+		ipq.Unique(false)
+	}
+	ipq.modifiers = append(ipq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ipq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ipq *InsurancePolicyQuery) ForShare(opts ...sql.LockOption) *InsurancePolicyQuery {
+	if ipq.driver.Dialect() == dialect.Postgres {
+		ipq.Unique(false)
+	}
+	ipq.modifiers = append(ipq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ipq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ipq *InsurancePolicyQuery) Modify(modifiers ...func(s *sql.Selector)) *InsurancePolicySelect {
+	ipq.modifiers = append(ipq.modifiers, modifiers...)
+	return ipq.Select()
+}
+
+// InsurancePolicyGroupBy is the group-by builder for InsurancePolicy entities.
+type InsurancePolicyGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ipgb *InsurancePolicyGroupBy) Aggregate(fns ...AggregateFunc) *InsurancePolicyGroupBy {
+	ipgb.fns = append(ipgb.fns, fns...)
+	return ipgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ipgb *InsurancePolicyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ipgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ipgb.sql = query
+	return ipgb.sqlScan(ctx, v)
+}
+
+func (ipgb *InsurancePolicyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ipgb.fields {
+		if !insurancepolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ipgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ipgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ipgb *InsurancePolicyGroupBy) sqlQuery() *sql.Selector {
+	selector := ipgb.sql.Select()
+	aggregation := make([]string, 0, len(ipgb.fns))
+	for _, fn := range ipgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ipgb.fields)+len(ipgb.fns))
+		for _, f := range ipgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ipgb.fields...)...)
+}
+
+// InsurancePolicySelect is the builder for selecting fields of InsurancePolicy entities.
+type InsurancePolicySelect struct {
+	*InsurancePolicyQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ips *InsurancePolicySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ips.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ips.sql = ips.InsurancePolicyQuery.sqlQuery(ctx)
+	return ips.sqlScan(ctx, v)
+}
+
+func (ips *InsurancePolicySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ips.sql.Query()
+	if err := ips.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ips *InsurancePolicySelect) Modify(modifiers ...func(s *sql.Selector)) *InsurancePolicySelect {
+	ips.modifiers = append(ips.modifiers, modifiers...)
+	return ips
+}
@@ -0,0 +1,1144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InsurancePolicyUpdate is the builder for updating InsurancePolicy entities.
+type InsurancePolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InsurancePolicyMutation
+}
+
+// Where appends a list predicates to the InsurancePolicyUpdate builder.
+func (ipu *InsurancePolicyUpdate) Where(ps ...predicate.InsurancePolicy) *InsurancePolicyUpdate {
+	ipu.mutation.Where(ps...)
+	return ipu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ipu *InsurancePolicyUpdate) SetTenantID(i int64) *InsurancePolicyUpdate {
+	ipu.mutation.ResetTenantID()
+	ipu.mutation.SetTenantID(i)
+	return ipu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ipu *InsurancePolicyUpdate) SetNillableTenantID(i *int64) *InsurancePolicyUpdate {
+	if i != nil {
+		ipu.SetTenantID(*i)
+	}
+	return ipu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ipu *InsurancePolicyUpdate) AddTenantID(i int64) *InsurancePolicyUpdate {
+	ipu.mutation.AddTenantID(i)
+	return ipu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (ipu *InsurancePolicyUpdate) ClearTenantID() *InsurancePolicyUpdate {
+	ipu.mutation.ClearTenantID()
+	return ipu
+}
+
+// SetCarID sets the "car_id" field.
+func (ipu *InsurancePolicyUpdate) SetCarID(i int64) *InsurancePolicyUpdate {
+	ipu.mutation.SetCarID(i)
+	return ipu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ipu *InsurancePolicyUpdate) SetNillableCarID(i *int64) *InsurancePolicyUpdate {
+	if i != nil {
+		ipu.SetCarID(*i)
+	}
+	return ipu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (ipu *InsurancePolicyUpdate) ClearCarID() *InsurancePolicyUpdate {
+	ipu.mutation.ClearCarID()
+	return ipu
+}
+
+// SetProvider sets the "provider" field.
+func (ipu *InsurancePolicyUpdate) SetProvider(s string) *InsurancePolicyUpdate {
+	ipu.mutation.SetProvider(s)
+	return ipu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (ipu *InsurancePolicyUpdate) SetNillableProvider(s *string) *InsurancePolicyUpdate {
+	if s != nil {
+		ipu.SetProvider(*s)
+	}
+	return ipu
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (ipu *InsurancePolicyUpdate) ClearProvider() *InsurancePolicyUpdate {
+	ipu.mutation.ClearProvider()
+	return ipu
+}
+
+// SetPolicyNumber sets the "policy_number" field.
+func (ipu *InsurancePolicyUpdate) SetPolicyNumber(s string) *InsurancePolicyUpdate {
+	ipu.mutation.SetPolicyNumber(s)
+	return ipu
+}
+
+// SetNillablePolicyNumber sets the "policy_number" field if the given value is not nil.
+func (ipu *InsurancePolicyUpdate) SetNillablePolicyNumber(s *string) *InsurancePolicyUpdate {
+	if s != nil {
+		ipu.SetPolicyNumber(*s)
+	}
+	return ipu
+}
+
+// ClearPolicyNumber clears the value of the "policy_number" field.
+func (ipu *InsurancePolicyUpdate) ClearPolicyNumber() *InsurancePolicyUpdate {
+	ipu.mutation.ClearPolicyNumber()
+	return ipu
+}
+
+// SetCoverage sets the "coverage" field.
+func (ipu *InsurancePolicyUpdate) SetCoverage(s string) *InsurancePolicyUpdate {
+	ipu.mutation.SetCoverage(s)
+	return ipu
+}
+
+// SetNillableCoverage sets the "coverage" field if the given value is not nil.
+func (ipu *InsurancePolicyUpdate) SetNillableCoverage(s *string) *InsurancePolicyUpdate {
+	if s != nil {
+		ipu.SetCoverage(*s)
+	}
+	return ipu
+}
+
+// ClearCoverage clears the value of the "coverage" field.
+func (ipu *InsurancePolicyUpdate) ClearCoverage() *InsurancePolicyUpdate {
+	ipu.mutation.ClearCoverage()
+	return ipu
+}
+
+// SetStartDate sets the "start_date" field.
+func (ipu *InsurancePolicyUpdate) SetStartDate(t time.Time) *InsurancePolicyUpdate {
+	ipu.mutation.SetStartDate(t)
+	return ipu
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ipu *InsurancePolicyUpdate) SetNillableStartDate(t *time.Time) *InsurancePolicyUpdate {
+	if t != nil {
+		ipu.SetStartDate(*t)
+	}
+	return ipu
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (ipu *InsurancePolicyUpdate) ClearStartDate() *InsurancePolicyUpdate {
+	ipu.mutation.ClearStartDate()
+	return ipu
+}
+
+// SetEndDate sets the "end_date" field.
+func (ipu *InsurancePolicyUpdate) SetEndDate(t time.Time) *InsurancePolicyUpdate {
+	ipu.mutation.SetEndDate(t)
+	return ipu
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ipu *InsurancePolicyUpdate) SetNillableEndDate(t *time.Time) *InsurancePolicyUpdate {
+	if t != nil {
+		ipu.SetEndDate(*t)
+	}
+	return ipu
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (ipu *InsurancePolicyUpdate) ClearEndDate() *InsurancePolicyUpdate {
+	ipu.mutation.ClearEndDate()
+	return ipu
+}
+
+// SetPremium sets the "premium" field.
+func (ipu *InsurancePolicyUpdate) SetPremium(f float64) *InsurancePolicyUpdate {
+	ipu.mutation.ResetPremium()
+	ipu.mutation.SetPremium(f)
+	return ipu
+}
+
+// SetNillablePremium sets the "premium" field if the given value is not nil.
+func (ipu *InsurancePolicyUpdate) SetNillablePremium(f *float64) *InsurancePolicyUpdate {
+	if f != nil {
+		ipu.SetPremium(*f)
+	}
+	return ipu
+}
+
+// AddPremium adds f to the "premium" field.
+func (ipu *InsurancePolicyUpdate) AddPremium(f float64) *InsurancePolicyUpdate {
+	ipu.mutation.AddPremium(f)
+	return ipu
+}
+
+// ClearPremium clears the value of the "premium" field.
+func (ipu *InsurancePolicyUpdate) ClearPremium() *InsurancePolicyUpdate {
+	ipu.mutation.ClearPremium()
+	return ipu
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (ipu *InsurancePolicyUpdate) SetRemindedAt(t time.Time) *InsurancePolicyUpdate {
+	ipu.mutation.SetRemindedAt(t)
+	return ipu
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (ipu *InsurancePolicyUpdate) SetNillableRemindedAt(t *time.Time) *InsurancePolicyUpdate {
+	if t != nil {
+		ipu.SetRemindedAt(*t)
+	}
+	return ipu
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (ipu *InsurancePolicyUpdate) ClearRemindedAt() *InsurancePolicyUpdate {
+	ipu.mutation.ClearRemindedAt()
+	return ipu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ipu *InsurancePolicyUpdate) SetCar(c *Car) *InsurancePolicyUpdate {
+	return ipu.SetCarID(c.ID)
+}
+
+// AddIncidentIDs adds the "incidents" edge to the Incident entity by IDs.
+func (ipu *InsurancePolicyUpdate) AddIncidentIDs(ids ...int64) *InsurancePolicyUpdate {
+	ipu.mutation.AddIncidentIDs(ids...)
+	return ipu
+}
+
+// AddIncidents adds the "incidents" edges to the Incident entity.
+func (ipu *InsurancePolicyUpdate) AddIncidents(i ...*Incident) *InsurancePolicyUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ipu.AddIncidentIDs(ids...)
+}
+
+// Mutation returns the InsurancePolicyMutation object of the builder.
+func (ipu *InsurancePolicyUpdate) Mutation() *InsurancePolicyMutation {
+	return ipu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (ipu *InsurancePolicyUpdate) ClearCar() *InsurancePolicyUpdate {
+	ipu.mutation.ClearCar()
+	return ipu
+}
+
+// ClearIncidents clears all "incidents" edges to the Incident entity.
+func (ipu *InsurancePolicyUpdate) ClearIncidents() *InsurancePolicyUpdate {
+	ipu.mutation.ClearIncidents()
+	return ipu
+}
+
+// RemoveIncidentIDs removes the "incidents" edge to Incident entities by IDs.
+func (ipu *InsurancePolicyUpdate) RemoveIncidentIDs(ids ...int64) *InsurancePolicyUpdate {
+	ipu.mutation.RemoveIncidentIDs(ids...)
+	return ipu
+}
+
+// RemoveIncidents removes "incidents" edges to Incident entities.
+func (ipu *InsurancePolicyUpdate) RemoveIncidents(i ...*Incident) *InsurancePolicyUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ipu.RemoveIncidentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ipu *InsurancePolicyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ipu.hooks) == 0 {
+		affected, err = ipu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InsurancePolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ipu.mutation = mutation
+			affected, err = ipu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ipu.hooks) - 1; i >= 0; i-- {
+			if ipu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ipu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ipu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipu *InsurancePolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := ipu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ipu *InsurancePolicyUpdate) Exec(ctx context.Context) error {
+	_, err := ipu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipu *InsurancePolicyUpdate) ExecX(ctx context.Context) {
+	if err := ipu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ipu *InsurancePolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   insurancepolicy.Table,
+			Columns: insurancepolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: insurancepolicy.FieldID,
+			},
+		},
+	}
+	if ps := ipu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ipu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: insurancepolicy.FieldTenantID,
+		})
+	}
+	if value, ok := ipu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: insurancepolicy.FieldTenantID,
+		})
+	}
+	if ipu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: insurancepolicy.FieldTenantID,
+		})
+	}
+	if value, ok := ipu.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: insurancepolicy.FieldProvider,
+		})
+	}
+	if ipu.mutation.ProviderCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: insurancepolicy.FieldProvider,
+		})
+	}
+	if value, ok := ipu.mutation.PolicyNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: insurancepolicy.FieldPolicyNumber,
+		})
+	}
+	if ipu.mutation.PolicyNumberCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: insurancepolicy.FieldPolicyNumber,
+		})
+	}
+	if value, ok := ipu.mutation.Coverage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: insurancepolicy.FieldCoverage,
+		})
+	}
+	if ipu.mutation.CoverageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: insurancepolicy.FieldCoverage,
+		})
+	}
+	if value, ok := ipu.mutation.StartDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: insurancepolicy.FieldStartDate,
+		})
+	}
+	if ipu.mutation.StartDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: insurancepolicy.FieldStartDate,
+		})
+	}
+	if value, ok := ipu.mutation.EndDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: insurancepolicy.FieldEndDate,
+		})
+	}
+	if ipu.mutation.EndDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: insurancepolicy.FieldEndDate,
+		})
+	}
+	if value, ok := ipu.mutation.Premium(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: insurancepolicy.FieldPremium,
+		})
+	}
+	if value, ok := ipu.mutation.AddedPremium(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: insurancepolicy.FieldPremium,
+		})
+	}
+	if ipu.mutation.PremiumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: insurancepolicy.FieldPremium,
+		})
+	}
+	if value, ok := ipu.mutation.RemindedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: insurancepolicy.FieldRemindedAt,
+		})
+	}
+	if ipu.mutation.RemindedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: insurancepolicy.FieldRemindedAt,
+		})
+	}
+	if ipu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   insurancepolicy.CarTable,
+			Columns: []string{insurancepolicy.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   insurancepolicy.CarTable,
+			Columns: []string{insurancepolicy.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ipu.mutation.IncidentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   insurancepolicy.IncidentsTable,
+			Columns: []string{insurancepolicy.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipu.mutation.RemovedIncidentsIDs(); len(nodes) > 0 && !ipu.mutation.IncidentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   insurancepolicy.IncidentsTable,
+			Columns: []string{insurancepolicy.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipu.mutation.IncidentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   insurancepolicy.IncidentsTable,
+			Columns: []string{insurancepolicy.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ipu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{insurancepolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InsurancePolicyUpdateOne is the builder for updating a single InsurancePolicy entity.
+type InsurancePolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InsurancePolicyMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ipuo *InsurancePolicyUpdateOne) SetTenantID(i int64) *InsurancePolicyUpdateOne {
+	ipuo.mutation.ResetTenantID()
+	ipuo.mutation.SetTenantID(i)
+	return ipuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ipuo *InsurancePolicyUpdateOne) SetNillableTenantID(i *int64) *InsurancePolicyUpdateOne {
+	if i != nil {
+		ipuo.SetTenantID(*i)
+	}
+	return ipuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ipuo *InsurancePolicyUpdateOne) AddTenantID(i int64) *InsurancePolicyUpdateOne {
+	ipuo.mutation.AddTenantID(i)
+	return ipuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (ipuo *InsurancePolicyUpdateOne) ClearTenantID() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearTenantID()
+	return ipuo
+}
+
+// SetCarID sets the "car_id" field.
+func (ipuo *InsurancePolicyUpdateOne) SetCarID(i int64) *InsurancePolicyUpdateOne {
+	ipuo.mutation.SetCarID(i)
+	return ipuo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ipuo *InsurancePolicyUpdateOne) SetNillableCarID(i *int64) *InsurancePolicyUpdateOne {
+	if i != nil {
+		ipuo.SetCarID(*i)
+	}
+	return ipuo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (ipuo *InsurancePolicyUpdateOne) ClearCarID() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearCarID()
+	return ipuo
+}
+
+// SetProvider sets the "provider" field.
+func (ipuo *InsurancePolicyUpdateOne) SetProvider(s string) *InsurancePolicyUpdateOne {
+	ipuo.mutation.SetProvider(s)
+	return ipuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (ipuo *InsurancePolicyUpdateOne) SetNillableProvider(s *string) *InsurancePolicyUpdateOne {
+	if s != nil {
+		ipuo.SetProvider(*s)
+	}
+	return ipuo
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (ipuo *InsurancePolicyUpdateOne) ClearProvider() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearProvider()
+	return ipuo
+}
+
+// SetPolicyNumber sets the "policy_number" field.
+func (ipuo *InsurancePolicyUpdateOne) SetPolicyNumber(s string) *InsurancePolicyUpdateOne {
+	ipuo.mutation.SetPolicyNumber(s)
+	return ipuo
+}
+
+// SetNillablePolicyNumber sets the "policy_number" field if the given value is not nil.
+func (ipuo *InsurancePolicyUpdateOne) SetNillablePolicyNumber(s *string) *InsurancePolicyUpdateOne {
+	if s != nil {
+		ipuo.SetPolicyNumber(*s)
+	}
+	return ipuo
+}
+
+// ClearPolicyNumber clears the value of the "policy_number" field.
+func (ipuo *InsurancePolicyUpdateOne) ClearPolicyNumber() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearPolicyNumber()
+	return ipuo
+}
+
+// SetCoverage sets the "coverage" field.
+func (ipuo *InsurancePolicyUpdateOne) SetCoverage(s string) *InsurancePolicyUpdateOne {
+	ipuo.mutation.SetCoverage(s)
+	return ipuo
+}
+
+// SetNillableCoverage sets the "coverage" field if the given value is not nil.
+func (ipuo *InsurancePolicyUpdateOne) SetNillableCoverage(s *string) *InsurancePolicyUpdateOne {
+	if s != nil {
+		ipuo.SetCoverage(*s)
+	}
+	return ipuo
+}
+
+// ClearCoverage clears the value of the "coverage" field.
+func (ipuo *InsurancePolicyUpdateOne) ClearCoverage() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearCoverage()
+	return ipuo
+}
+
+// SetStartDate sets the "start_date" field.
+func (ipuo *InsurancePolicyUpdateOne) SetStartDate(t time.Time) *InsurancePolicyUpdateOne {
+	ipuo.mutation.SetStartDate(t)
+	return ipuo
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ipuo *InsurancePolicyUpdateOne) SetNillableStartDate(t *time.Time) *InsurancePolicyUpdateOne {
+	if t != nil {
+		ipuo.SetStartDate(*t)
+	}
+	return ipuo
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (ipuo *InsurancePolicyUpdateOne) ClearStartDate() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearStartDate()
+	return ipuo
+}
+
+// SetEndDate sets the "end_date" field.
+func (ipuo *InsurancePolicyUpdateOne) SetEndDate(t time.Time) *InsurancePolicyUpdateOne {
+	ipuo.mutation.SetEndDate(t)
+	return ipuo
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ipuo *InsurancePolicyUpdateOne) SetNillableEndDate(t *time.Time) *InsurancePolicyUpdateOne {
+	if t != nil {
+		ipuo.SetEndDate(*t)
+	}
+	return ipuo
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (ipuo *InsurancePolicyUpdateOne) ClearEndDate() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearEndDate()
+	return ipuo
+}
+
+// SetPremium sets the "premium" field.
+func (ipuo *InsurancePolicyUpdateOne) SetPremium(f float64) *InsurancePolicyUpdateOne {
+	ipuo.mutation.ResetPremium()
+	ipuo.mutation.SetPremium(f)
+	return ipuo
+}
+
+// SetNillablePremium sets the "premium" field if the given value is not nil.
+func (ipuo *InsurancePolicyUpdateOne) SetNillablePremium(f *float64) *InsurancePolicyUpdateOne {
+	if f != nil {
+		ipuo.SetPremium(*f)
+	}
+	return ipuo
+}
+
+// AddPremium adds f to the "premium" field.
+func (ipuo *InsurancePolicyUpdateOne) AddPremium(f float64) *InsurancePolicyUpdateOne {
+	ipuo.mutation.AddPremium(f)
+	return ipuo
+}
+
+// ClearPremium clears the value of the "premium" field.
+func (ipuo *InsurancePolicyUpdateOne) ClearPremium() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearPremium()
+	return ipuo
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (ipuo *InsurancePolicyUpdateOne) SetRemindedAt(t time.Time) *InsurancePolicyUpdateOne {
+	ipuo.mutation.SetRemindedAt(t)
+	return ipuo
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (ipuo *InsurancePolicyUpdateOne) SetNillableRemindedAt(t *time.Time) *InsurancePolicyUpdateOne {
+	if t != nil {
+		ipuo.SetRemindedAt(*t)
+	}
+	return ipuo
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (ipuo *InsurancePolicyUpdateOne) ClearRemindedAt() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearRemindedAt()
+	return ipuo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ipuo *InsurancePolicyUpdateOne) SetCar(c *Car) *InsurancePolicyUpdateOne {
+	return ipuo.SetCarID(c.ID)
+}
+
+// AddIncidentIDs adds the "incidents" edge to the Incident entity by IDs.
+func (ipuo *InsurancePolicyUpdateOne) AddIncidentIDs(ids ...int64) *InsurancePolicyUpdateOne {
+	ipuo.mutation.AddIncidentIDs(ids...)
+	return ipuo
+}
+
+// AddIncidents adds the "incidents" edges to the Incident entity.
+func (ipuo *InsurancePolicyUpdateOne) AddIncidents(i ...*Incident) *InsurancePolicyUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ipuo.AddIncidentIDs(ids...)
+}
+
+// Mutation returns the InsurancePolicyMutation object of the builder.
+func (ipuo *InsurancePolicyUpdateOne) Mutation() *InsurancePolicyMutation {
+	return ipuo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (ipuo *InsurancePolicyUpdateOne) ClearCar() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearCar()
+	return ipuo
+}
+
+// ClearIncidents clears all "incidents" edges to the Incident entity.
+func (ipuo *InsurancePolicyUpdateOne) ClearIncidents() *InsurancePolicyUpdateOne {
+	ipuo.mutation.ClearIncidents()
+	return ipuo
+}
+
+// RemoveIncidentIDs removes the "incidents" edge to Incident entities by IDs.
+func (ipuo *InsurancePolicyUpdateOne) RemoveIncidentIDs(ids ...int64) *InsurancePolicyUpdateOne {
+	ipuo.mutation.RemoveIncidentIDs(ids...)
+	return ipuo
+}
+
+// RemoveIncidents removes "incidents" edges to Incident entities.
+func (ipuo *InsurancePolicyUpdateOne) RemoveIncidents(i ...*Incident) *InsurancePolicyUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ipuo.RemoveIncidentIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ipuo *InsurancePolicyUpdateOne) Select(field string, fields ...string) *InsurancePolicyUpdateOne {
+	ipuo.fields = append([]string{field}, fields...)
+	return ipuo
+}
+
+// Save executes the query and returns the updated InsurancePolicy entity.
+func (ipuo *InsurancePolicyUpdateOne) Save(ctx context.Context) (*InsurancePolicy, error) {
+	var (
+		err  error
+		node *InsurancePolicy
+	)
+	if len(ipuo.hooks) == 0 {
+		node, err = ipuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InsurancePolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ipuo.mutation = mutation
+			node, err = ipuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ipuo.hooks) - 1; i >= 0; i-- {
+			if ipuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ipuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ipuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InsurancePolicy)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InsurancePolicyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipuo *InsurancePolicyUpdateOne) SaveX(ctx context.Context) *InsurancePolicy {
+	node, err := ipuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ipuo *InsurancePolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ipuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipuo *InsurancePolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := ipuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ipuo *InsurancePolicyUpdateOne) sqlSave(ctx context.Context) (_node *InsurancePolicy, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   insurancepolicy.Table,
+			Columns: insurancepolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: insurancepolicy.FieldID,
+			},
+		},
+	}
+	id, ok := ipuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InsurancePolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ipuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, insurancepolicy.FieldID)
+		for _, f := range fields {
+			if !insurancepolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != insurancepolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ipuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ipuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: insurancepolicy.FieldTenantID,
+		})
+	}
+	if value, ok := ipuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: insurancepolicy.FieldTenantID,
+		})
+	}
+	if ipuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: insurancepolicy.FieldTenantID,
+		})
+	}
+	if value, ok := ipuo.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: insurancepolicy.FieldProvider,
+		})
+	}
+	if ipuo.mutation.ProviderCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: insurancepolicy.FieldProvider,
+		})
+	}
+	if value, ok := ipuo.mutation.PolicyNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: insurancepolicy.FieldPolicyNumber,
+		})
+	}
+	if ipuo.mutation.PolicyNumberCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: insurancepolicy.FieldPolicyNumber,
+		})
+	}
+	if value, ok := ipuo.mutation.Coverage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: insurancepolicy.FieldCoverage,
+		})
+	}
+	if ipuo.mutation.CoverageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: insurancepolicy.FieldCoverage,
+		})
+	}
+	if value, ok := ipuo.mutation.StartDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: insurancepolicy.FieldStartDate,
+		})
+	}
+	if ipuo.mutation.StartDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: insurancepolicy.FieldStartDate,
+		})
+	}
+	if value, ok := ipuo.mutation.EndDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: insurancepolicy.FieldEndDate,
+		})
+	}
+	if ipuo.mutation.EndDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: insurancepolicy.FieldEndDate,
+		})
+	}
+	if value, ok := ipuo.mutation.Premium(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: insurancepolicy.FieldPremium,
+		})
+	}
+	if value, ok := ipuo.mutation.AddedPremium(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: insurancepolicy.FieldPremium,
+		})
+	}
+	if ipuo.mutation.PremiumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: insurancepolicy.FieldPremium,
+		})
+	}
+	if value, ok := ipuo.mutation.RemindedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: insurancepolicy.FieldRemindedAt,
+		})
+	}
+	if ipuo.mutation.RemindedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: insurancepolicy.FieldRemindedAt,
+		})
+	}
+	if ipuo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   insurancepolicy.CarTable,
+			Columns: []string{insurancepolicy.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipuo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   insurancepolicy.CarTable,
+			Columns: []string{insurancepolicy.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ipuo.mutation.IncidentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   insurancepolicy.IncidentsTable,
+			Columns: []string{insurancepolicy.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipuo.mutation.RemovedIncidentsIDs(); len(nodes) > 0 && !ipuo.mutation.IncidentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   insurancepolicy.IncidentsTable,
+			Columns: []string{insurancepolicy.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipuo.mutation.IncidentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   insurancepolicy.IncidentsTable,
+			Columns: []string{insurancepolicy.IncidentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: incident.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &InsurancePolicy{config: ipuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ipuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{insurancepolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
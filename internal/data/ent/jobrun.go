@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/jobrun"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// JobRun is the model entity for the JobRun schema.
+type JobRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ScheduledAt holds the value of the "scheduled_at" field.
+	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// Instance holds the value of the "instance" field.
+	Instance string `json:"instance,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobRun) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobrun.FieldID:
+			values[i] = new(sql.NullInt64)
+		case jobrun.FieldName, jobrun.FieldStatus, jobrun.FieldError, jobrun.FieldInstance:
+			values[i] = new(sql.NullString)
+		case jobrun.FieldScheduledAt, jobrun.FieldStartedAt, jobrun.FieldFinishedAt, jobrun.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type JobRun", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobRun fields.
+func (jr *JobRun) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			jr.ID = int64(value.Int64)
+		case jobrun.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				jr.Name = value.String
+			}
+		case jobrun.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				jr.ScheduledAt = value.Time
+			}
+		case jobrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				jr.StartedAt = value.Time
+			}
+		case jobrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				jr.FinishedAt = new(time.Time)
+				*jr.FinishedAt = value.Time
+			}
+		case jobrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				jr.Status = value.String
+			}
+		case jobrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				jr.Error = value.String
+			}
+		case jobrun.FieldInstance:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance", values[i])
+			} else if value.Valid {
+				jr.Instance = value.String
+			}
+		case jobrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				jr.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this JobRun.
+// Note that you need to call JobRun.Unwrap() before calling this method if this JobRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (jr *JobRun) Update() *JobRunUpdateOne {
+	return (&JobRunClient{config: jr.config}).UpdateOne(jr)
+}
+
+// Unwrap unwraps the JobRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (jr *JobRun) Unwrap() *JobRun {
+	_tx, ok := jr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JobRun is not a transactional entity")
+	}
+	jr.config.driver = _tx.drv
+	return jr
+}
+
+// String implements the fmt.Stringer.
+func (jr *JobRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", jr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(jr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_at=")
+	builder.WriteString(jr.ScheduledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(jr.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := jr.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(jr.Status)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(jr.Error)
+	builder.WriteString(", ")
+	builder.WriteString("instance=")
+	builder.WriteString(jr.Instance)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(jr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobRuns is a parsable slice of JobRun.
+type JobRuns []*JobRun
+
+func (jr JobRuns) config(cfg config) {
+	for _i := range jr {
+		jr[_i].config = cfg
+	}
+}
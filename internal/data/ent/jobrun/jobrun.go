@@ -0,0 +1,62 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobrun
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the jobrun type in the database.
+	Label = "job_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldScheduledAt holds the string denoting the scheduled_at field in the database.
+	FieldScheduledAt = "scheduled_at"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldInstance holds the string denoting the instance field in the database.
+	FieldInstance = "instance"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the jobrun in the database.
+	Table = "job_run"
+)
+
+// Columns holds all SQL columns for jobrun fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldScheduledAt,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldStatus,
+	FieldError,
+	FieldInstance,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/jobrun"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobRunCreate is the builder for creating a JobRun entity.
+type JobRunCreate struct {
+	config
+	mutation *JobRunMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (jrc *JobRunCreate) SetName(s string) *JobRunCreate {
+	jrc.mutation.SetName(s)
+	return jrc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (jrc *JobRunCreate) SetScheduledAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetScheduledAt(t)
+	return jrc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jrc *JobRunCreate) SetStartedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetStartedAt(t)
+	return jrc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jrc *JobRunCreate) SetFinishedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetFinishedAt(t)
+	return jrc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableFinishedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetFinishedAt(*t)
+	}
+	return jrc
+}
+
+// SetStatus sets the "status" field.
+func (jrc *JobRunCreate) SetStatus(s string) *JobRunCreate {
+	jrc.mutation.SetStatus(s)
+	return jrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableStatus(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetStatus(*s)
+	}
+	return jrc
+}
+
+// SetError sets the "error" field.
+func (jrc *JobRunCreate) SetError(s string) *JobRunCreate {
+	jrc.mutation.SetError(s)
+	return jrc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableError(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetError(*s)
+	}
+	return jrc
+}
+
+// SetInstance sets the "instance" field.
+func (jrc *JobRunCreate) SetInstance(s string) *JobRunCreate {
+	jrc.mutation.SetInstance(s)
+	return jrc
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableInstance(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetInstance(*s)
+	}
+	return jrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jrc *JobRunCreate) SetCreatedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetCreatedAt(t)
+	return jrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableCreatedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetCreatedAt(*t)
+	}
+	return jrc
+}
+
+// SetID sets the "id" field.
+func (jrc *JobRunCreate) SetID(i int64) *JobRunCreate {
+	jrc.mutation.SetID(i)
+	return jrc
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jrc *JobRunCreate) Mutation() *JobRunMutation {
+	return jrc.mutation
+}
+
+// Save creates the JobRun in the database.
+func (jrc *JobRunCreate) Save(ctx context.Context) (*JobRun, error) {
+	var (
+		err  error
+		node *JobRun
+	)
+	jrc.defaults()
+	if len(jrc.hooks) == 0 {
+		if err = jrc.check(); err != nil {
+			return nil, err
+		}
+		node, err = jrc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobRunMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = jrc.check(); err != nil {
+				return nil, err
+			}
+			jrc.mutation = mutation
+			if node, err = jrc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(jrc.hooks) - 1; i >= 0; i-- {
+			if jrc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = jrc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, jrc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*JobRun)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from JobRunMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jrc *JobRunCreate) SaveX(ctx context.Context) *JobRun {
+	v, err := jrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrc *JobRunCreate) Exec(ctx context.Context) error {
+	_, err := jrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrc *JobRunCreate) ExecX(ctx context.Context) {
+	if err := jrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jrc *JobRunCreate) defaults() {
+	if _, ok := jrc.mutation.Status(); !ok {
+		v := jobrun.DefaultStatus
+		jrc.mutation.SetStatus(v)
+	}
+	if _, ok := jrc.mutation.CreatedAt(); !ok {
+		v := jobrun.DefaultCreatedAt()
+		jrc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jrc *JobRunCreate) check() error {
+	if _, ok := jrc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "JobRun.name"`)}
+	}
+	if _, ok := jrc.mutation.ScheduledAt(); !ok {
+		return &ValidationError{Name: "scheduled_at", err: errors.New(`ent: missing required field "JobRun.scheduled_at"`)}
+	}
+	if _, ok := jrc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "JobRun.started_at"`)}
+	}
+	if _, ok := jrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "JobRun.status"`)}
+	}
+	if _, ok := jrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JobRun.created_at"`)}
+	}
+	return nil
+}
+
+func (jrc *JobRunCreate) sqlSave(ctx context.Context) (*JobRun, error) {
+	_node, _spec := jrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (jrc *JobRunCreate) createSpec() (*JobRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobRun{config: jrc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: jobrun.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: jobrun.FieldID,
+			},
+		}
+	)
+	if id, ok := jrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jrc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := jrc.mutation.ScheduledAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldScheduledAt,
+		})
+		_node.ScheduledAt = value
+	}
+	if value, ok := jrc.mutation.StartedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldStartedAt,
+		})
+		_node.StartedAt = value
+	}
+	if value, ok := jrc.mutation.FinishedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldFinishedAt,
+		})
+		_node.FinishedAt = &value
+	}
+	if value, ok := jrc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := jrc.mutation.Error(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldError,
+		})
+		_node.Error = value
+	}
+	if value, ok := jrc.mutation.Instance(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldInstance,
+		})
+		_node.Instance = value
+	}
+	if value, ok := jrc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// JobRunCreateBulk is the builder for creating many JobRun entities in bulk.
+type JobRunCreateBulk struct {
+	config
+	builders []*JobRunCreate
+}
+
+// Save creates the JobRun entities in the database.
+func (jrcb *JobRunCreateBulk) Save(ctx context.Context) ([]*JobRun, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(jrcb.builders))
+	nodes := make([]*JobRun, len(jrcb.builders))
+	mutators := make([]Mutator, len(jrcb.builders))
+	for i := range jrcb.builders {
+		func(i int, root context.Context) {
+			builder := jrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) SaveX(ctx context.Context) []*JobRun {
+	v, err := jrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrcb *JobRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := jrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) ExecX(ctx context.Context) {
+	if err := jrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
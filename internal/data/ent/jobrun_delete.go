@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/jobrun"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobRunDelete is the builder for deleting a JobRun entity.
+type JobRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// Where appends a list predicates to the JobRunDelete builder.
+func (jrd *JobRunDelete) Where(ps ...predicate.JobRun) *JobRunDelete {
+	jrd.mutation.Where(ps...)
+	return jrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (jrd *JobRunDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(jrd.hooks) == 0 {
+		affected, err = jrd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobRunMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			jrd.mutation = mutation
+			affected, err = jrd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(jrd.hooks) - 1; i >= 0; i-- {
+			if jrd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = jrd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, jrd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrd *JobRunDelete) ExecX(ctx context.Context) int {
+	n, err := jrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (jrd *JobRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: jobrun.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: jobrun.FieldID,
+			},
+		},
+	}
+	if ps := jrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, jrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// JobRunDeleteOne is the builder for deleting a single JobRun entity.
+type JobRunDeleteOne struct {
+	jrd *JobRunDelete
+}
+
+// Exec executes the deletion query.
+func (jrdo *JobRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := jrdo.jrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{jobrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrdo *JobRunDeleteOne) ExecX(ctx context.Context) {
+	jrdo.jrd.ExecX(ctx)
+}
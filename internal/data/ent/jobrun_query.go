@@ -0,0 +1,577 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/jobrun"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobRunQuery is the builder for querying JobRun entities.
+type JobRunQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.JobRun
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the JobRunQuery builder.
+func (jrq *JobRunQuery) Where(ps ...predicate.JobRun) *JobRunQuery {
+	jrq.predicates = append(jrq.predicates, ps...)
+	return jrq
+}
+
+// Limit adds a limit step to the query.
+func (jrq *JobRunQuery) Limit(limit int) *JobRunQuery {
+	jrq.limit = &limit
+	return jrq
+}
+
+// Offset adds an offset step to the query.
+func (jrq *JobRunQuery) Offset(offset int) *JobRunQuery {
+	jrq.offset = &offset
+	return jrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (jrq *JobRunQuery) Unique(unique bool) *JobRunQuery {
+	jrq.unique = &unique
+	return jrq
+}
+
+// Order adds an order step to the query.
+func (jrq *JobRunQuery) Order(o ...OrderFunc) *JobRunQuery {
+	jrq.order = append(jrq.order, o...)
+	return jrq
+}
+
+// First returns the first JobRun entity from the query.
+// Returns a *NotFoundError when no JobRun was found.
+func (jrq *JobRunQuery) First(ctx context.Context) (*JobRun, error) {
+	nodes, err := jrq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{jobrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (jrq *JobRunQuery) FirstX(ctx context.Context) *JobRun {
+	node, err := jrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first JobRun ID from the query.
+// Returns a *NotFoundError when no JobRun ID was found.
+func (jrq *JobRunQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = jrq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{jobrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (jrq *JobRunQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := jrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single JobRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one JobRun entity is found.
+// Returns a *NotFoundError when no JobRun entities are found.
+func (jrq *JobRunQuery) Only(ctx context.Context) (*JobRun, error) {
+	nodes, err := jrq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{jobrun.Label}
+	default:
+		return nil, &NotSingularError{jobrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (jrq *JobRunQuery) OnlyX(ctx context.Context) *JobRun {
+	node, err := jrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only JobRun ID in the query.
+// Returns a *NotSingularError when more than one JobRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (jrq *JobRunQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = jrq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{jobrun.Label}
+	default:
+		err = &NotSingularError{jobrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (jrq *JobRunQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := jrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of JobRuns.
+func (jrq *JobRunQuery) All(ctx context.Context) ([]*JobRun, error) {
+	if err := jrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return jrq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (jrq *JobRunQuery) AllX(ctx context.Context) []*JobRun {
+	nodes, err := jrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of JobRun IDs.
+func (jrq *JobRunQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := jrq.Select(jobrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (jrq *JobRunQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := jrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (jrq *JobRunQuery) Count(ctx context.Context) (int, error) {
+	if err := jrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return jrq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (jrq *JobRunQuery) CountX(ctx context.Context) int {
+	count, err := jrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (jrq *JobRunQuery) Exist(ctx context.Context) (bool, error) {
+	if err := jrq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return jrq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (jrq *JobRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := jrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the JobRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (jrq *JobRunQuery) Clone() *JobRunQuery {
+	if jrq == nil {
+		return nil
+	}
+	return &JobRunQuery{
+		config:     jrq.config,
+		limit:      jrq.limit,
+		offset:     jrq.offset,
+		order:      append([]OrderFunc{}, jrq.order...),
+		predicates: append([]predicate.JobRun{}, jrq.predicates...),
+		// clone intermediate query.
+		sql:    jrq.sql.Clone(),
+		path:   jrq.path,
+		unique: jrq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.JobRun.Query().
+//		GroupBy(jobrun.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (jrq *JobRunQuery) GroupBy(field string, fields ...string) *JobRunGroupBy {
+	grbuild := &JobRunGroupBy{config: jrq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := jrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return jrq.sqlQuery(ctx), nil
+	}
+	grbuild.label = jobrun.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.JobRun.Query().
+//		Select(jobrun.FieldName).
+//		Scan(ctx, &v)
+//
+func (jrq *JobRunQuery) Select(fields ...string) *JobRunSelect {
+	jrq.fields = append(jrq.fields, fields...)
+	selbuild := &JobRunSelect{JobRunQuery: jrq}
+	selbuild.label = jobrun.Label
+	selbuild.flds, selbuild.scan = &jrq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (jrq *JobRunQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range jrq.fields {
+		if !jobrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if jrq.path != nil {
+		prev, err := jrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		jrq.sql = prev
+	}
+	return nil
+}
+
+func (jrq *JobRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*JobRun, error) {
+	var (
+		nodes = []*JobRun{}
+		_spec = jrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*JobRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &JobRun{config: jrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(jrq.modifiers) > 0 {
+		_spec.Modifiers = jrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, jrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (jrq *JobRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := jrq.querySpec()
+	if len(jrq.modifiers) > 0 {
+		_spec.Modifiers = jrq.modifiers
+	}
+	_spec.Node.Columns = jrq.fields
+	if len(jrq.fields) > 0 {
+		_spec.Unique = jrq.unique != nil && *jrq.unique
+	}
+	return sqlgraph.CountNodes(ctx, jrq.driver, _spec)
+}
+
+func (jrq *JobRunQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := jrq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (jrq *JobRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   jobrun.Table,
+			Columns: jobrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: jobrun.FieldID,
+			},
+		},
+		From:   jrq.sql,
+		Unique: true,
+	}
+	if unique := jrq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := jrq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobrun.FieldID)
+		for i := range fields {
+			if fields[i] != jobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := jrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := jrq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := jrq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := jrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (jrq *JobRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(jrq.driver.Dialect())
+	t1 := builder.Table(jobrun.Table)
+	columns := jrq.fields
+	if len(columns) == 0 {
+		columns = jobrun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if jrq.sql != nil {
+		selector = jrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if jrq.unique != nil && *jrq.unique {
+		selector.Distinct()
+	}
+	for _, m := range jrq.modifiers {
+		m(selector)
+	}
+	for _, p := range jrq.predicates {
+		p(selector)
+	}
+	for _, p := range jrq.order {
+		p(selector)
+	}
+	if offset := jrq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := jrq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (jrq *JobRunQuery) ForUpdate(opts ...sql.LockOption) *JobRunQuery {
+	if jrq.driver.Dialect() == dialect.Postgres {
+		jrq.Unique(false)
+	}
+	jrq.modifiers = append(jrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return jrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (jrq *JobRunQuery) ForShare(opts ...sql.LockOption) *JobRunQuery {
+	if jrq.driver.Dialect() == dialect.Postgres {
+		jrq.Unique(false)
+	}
+	jrq.modifiers = append(jrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return jrq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (jrq *JobRunQuery) Modify(modifiers ...func(s *sql.Selector)) *JobRunSelect {
+	jrq.modifiers = append(jrq.modifiers, modifiers...)
+	return jrq.Select()
+}
+
+// JobRunGroupBy is the group-by builder for JobRun entities.
+type JobRunGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (jrgb *JobRunGroupBy) Aggregate(fns ...AggregateFunc) *JobRunGroupBy {
+	jrgb.fns = append(jrgb.fns, fns...)
+	return jrgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (jrgb *JobRunGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := jrgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	jrgb.sql = query
+	return jrgb.sqlScan(ctx, v)
+}
+
+func (jrgb *JobRunGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range jrgb.fields {
+		if !jobrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := jrgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jrgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (jrgb *JobRunGroupBy) sqlQuery() *sql.Selector {
+	selector := jrgb.sql.Select()
+	aggregation := make([]string, 0, len(jrgb.fns))
+	for _, fn := range jrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(jrgb.fields)+len(jrgb.fns))
+		for _, f := range jrgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(jrgb.fields...)...)
+}
+
+// JobRunSelect is the builder for selecting fields of JobRun entities.
+type JobRunSelect struct {
+	*JobRunQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jrs *JobRunSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := jrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	jrs.sql = jrs.JobRunQuery.sqlQuery(ctx)
+	return jrs.sqlScan(ctx, v)
+}
+
+func (jrs *JobRunSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := jrs.sql.Query()
+	if err := jrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (jrs *JobRunSelect) Modify(modifiers ...func(s *sql.Selector)) *JobRunSelect {
+	jrs.modifiers = append(jrs.modifiers, modifiers...)
+	return jrs
+}
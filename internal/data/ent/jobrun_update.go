@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/jobrun"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobRunUpdate is the builder for updating JobRun entities.
+type JobRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (jru *JobRunUpdate) Where(ps ...predicate.JobRun) *JobRunUpdate {
+	jru.mutation.Where(ps...)
+	return jru
+}
+
+// SetName sets the "name" field.
+func (jru *JobRunUpdate) SetName(s string) *JobRunUpdate {
+	jru.mutation.SetName(s)
+	return jru
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (jru *JobRunUpdate) SetScheduledAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetScheduledAt(t)
+	return jru
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jru *JobRunUpdate) SetStartedAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetStartedAt(t)
+	return jru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jru *JobRunUpdate) SetFinishedAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetFinishedAt(t)
+	return jru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableFinishedAt(t *time.Time) *JobRunUpdate {
+	if t != nil {
+		jru.SetFinishedAt(*t)
+	}
+	return jru
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (jru *JobRunUpdate) ClearFinishedAt() *JobRunUpdate {
+	jru.mutation.ClearFinishedAt()
+	return jru
+}
+
+// SetStatus sets the "status" field.
+func (jru *JobRunUpdate) SetStatus(s string) *JobRunUpdate {
+	jru.mutation.SetStatus(s)
+	return jru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableStatus(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetStatus(*s)
+	}
+	return jru
+}
+
+// SetError sets the "error" field.
+func (jru *JobRunUpdate) SetError(s string) *JobRunUpdate {
+	jru.mutation.SetError(s)
+	return jru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableError(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetError(*s)
+	}
+	return jru
+}
+
+// ClearError clears the value of the "error" field.
+func (jru *JobRunUpdate) ClearError() *JobRunUpdate {
+	jru.mutation.ClearError()
+	return jru
+}
+
+// SetInstance sets the "instance" field.
+func (jru *JobRunUpdate) SetInstance(s string) *JobRunUpdate {
+	jru.mutation.SetInstance(s)
+	return jru
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableInstance(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetInstance(*s)
+	}
+	return jru
+}
+
+// ClearInstance clears the value of the "instance" field.
+func (jru *JobRunUpdate) ClearInstance() *JobRunUpdate {
+	jru.mutation.ClearInstance()
+	return jru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jru *JobRunUpdate) SetCreatedAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetCreatedAt(t)
+	return jru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableCreatedAt(t *time.Time) *JobRunUpdate {
+	if t != nil {
+		jru.SetCreatedAt(*t)
+	}
+	return jru
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jru *JobRunUpdate) Mutation() *JobRunMutation {
+	return jru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jru *JobRunUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(jru.hooks) == 0 {
+		affected, err = jru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobRunMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			jru.mutation = mutation
+			affected, err = jru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(jru.hooks) - 1; i >= 0; i-- {
+			if jru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = jru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, jru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jru *JobRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := jru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jru *JobRunUpdate) Exec(ctx context.Context) error {
+	_, err := jru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jru *JobRunUpdate) ExecX(ctx context.Context) {
+	if err := jru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jru *JobRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   jobrun.Table,
+			Columns: jobrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: jobrun.FieldID,
+			},
+		},
+	}
+	if ps := jru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jru.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldName,
+		})
+	}
+	if value, ok := jru.mutation.ScheduledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldScheduledAt,
+		})
+	}
+	if value, ok := jru.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldStartedAt,
+		})
+	}
+	if value, ok := jru.mutation.FinishedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldFinishedAt,
+		})
+	}
+	if jru.mutation.FinishedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: jobrun.FieldFinishedAt,
+		})
+	}
+	if value, ok := jru.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldStatus,
+		})
+	}
+	if value, ok := jru.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldError,
+		})
+	}
+	if jru.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: jobrun.FieldError,
+		})
+	}
+	if value, ok := jru.mutation.Instance(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldInstance,
+		})
+	}
+	if jru.mutation.InstanceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: jobrun.FieldInstance,
+		})
+	}
+	if value, ok := jru.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldCreatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// JobRunUpdateOne is the builder for updating a single JobRun entity.
+type JobRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// SetName sets the "name" field.
+func (jruo *JobRunUpdateOne) SetName(s string) *JobRunUpdateOne {
+	jruo.mutation.SetName(s)
+	return jruo
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (jruo *JobRunUpdateOne) SetScheduledAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetScheduledAt(t)
+	return jruo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jruo *JobRunUpdateOne) SetStartedAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetStartedAt(t)
+	return jruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jruo *JobRunUpdateOne) SetFinishedAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetFinishedAt(t)
+	return jruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableFinishedAt(t *time.Time) *JobRunUpdateOne {
+	if t != nil {
+		jruo.SetFinishedAt(*t)
+	}
+	return jruo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (jruo *JobRunUpdateOne) ClearFinishedAt() *JobRunUpdateOne {
+	jruo.mutation.ClearFinishedAt()
+	return jruo
+}
+
+// SetStatus sets the "status" field.
+func (jruo *JobRunUpdateOne) SetStatus(s string) *JobRunUpdateOne {
+	jruo.mutation.SetStatus(s)
+	return jruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableStatus(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetStatus(*s)
+	}
+	return jruo
+}
+
+// SetError sets the "error" field.
+func (jruo *JobRunUpdateOne) SetError(s string) *JobRunUpdateOne {
+	jruo.mutation.SetError(s)
+	return jruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableError(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetError(*s)
+	}
+	return jruo
+}
+
+// ClearError clears the value of the "error" field.
+func (jruo *JobRunUpdateOne) ClearError() *JobRunUpdateOne {
+	jruo.mutation.ClearError()
+	return jruo
+}
+
+// SetInstance sets the "instance" field.
+func (jruo *JobRunUpdateOne) SetInstance(s string) *JobRunUpdateOne {
+	jruo.mutation.SetInstance(s)
+	return jruo
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableInstance(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetInstance(*s)
+	}
+	return jruo
+}
+
+// ClearInstance clears the value of the "instance" field.
+func (jruo *JobRunUpdateOne) ClearInstance() *JobRunUpdateOne {
+	jruo.mutation.ClearInstance()
+	return jruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jruo *JobRunUpdateOne) SetCreatedAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetCreatedAt(t)
+	return jruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableCreatedAt(t *time.Time) *JobRunUpdateOne {
+	if t != nil {
+		jruo.SetCreatedAt(*t)
+	}
+	return jruo
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jruo *JobRunUpdateOne) Mutation() *JobRunMutation {
+	return jruo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jruo *JobRunUpdateOne) Select(field string, fields ...string) *JobRunUpdateOne {
+	jruo.fields = append([]string{field}, fields...)
+	return jruo
+}
+
+// Save executes the query and returns the updated JobRun entity.
+func (jruo *JobRunUpdateOne) Save(ctx context.Context) (*JobRun, error) {
+	var (
+		err  error
+		node *JobRun
+	)
+	if len(jruo.hooks) == 0 {
+		node, err = jruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobRunMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			jruo.mutation = mutation
+			node, err = jruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(jruo.hooks) - 1; i >= 0; i-- {
+			if jruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = jruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, jruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*JobRun)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from JobRunMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) SaveX(ctx context.Context) *JobRun {
+	node, err := jruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jruo *JobRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := jruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) ExecX(ctx context.Context) {
+	if err := jruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jruo *JobRunUpdateOne) sqlSave(ctx context.Context) (_node *JobRun, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   jobrun.Table,
+			Columns: jobrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: jobrun.FieldID,
+			},
+		},
+	}
+	id, ok := jruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobrun.FieldID)
+		for _, f := range fields {
+			if !jobrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jruo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldName,
+		})
+	}
+	if value, ok := jruo.mutation.ScheduledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldScheduledAt,
+		})
+	}
+	if value, ok := jruo.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldStartedAt,
+		})
+	}
+	if value, ok := jruo.mutation.FinishedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldFinishedAt,
+		})
+	}
+	if jruo.mutation.FinishedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: jobrun.FieldFinishedAt,
+		})
+	}
+	if value, ok := jruo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldStatus,
+		})
+	}
+	if value, ok := jruo.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldError,
+		})
+	}
+	if jruo.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: jobrun.FieldError,
+		})
+	}
+	if value, ok := jruo.mutation.Instance(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jobrun.FieldInstance,
+		})
+	}
+	if jruo.mutation.InstanceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: jobrun.FieldInstance,
+		})
+	}
+	if value, ok := jruo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jobrun.FieldCreatedAt,
+		})
+	}
+	_node = &JobRun{config: jruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
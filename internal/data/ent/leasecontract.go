@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/leasecontract"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// LeaseContract is the model entity for the LeaseContract schema.
+type LeaseContract struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// Lessor holds the value of the "lessor" field.
+	Lessor string `json:"lessor,omitempty"`
+	// ContractNo holds the value of the "contract_no" field.
+	ContractNo string `json:"contract_no,omitempty"`
+	// LesseeID holds the value of the "lessee_id" field.
+	LesseeID int64 `json:"lessee_id,omitempty"`
+	// StartDate holds the value of the "start_date" field.
+	StartDate time.Time `json:"start_date,omitempty"`
+	// EndDate holds the value of the "end_date" field.
+	EndDate time.Time `json:"end_date,omitempty"`
+	// MonthlyPayment holds the value of the "monthly_payment" field.
+	MonthlyPayment float64 `json:"monthly_payment,omitempty"`
+	// MileageAllowance holds the value of the "mileage_allowance" field.
+	MileageAllowance int64 `json:"mileage_allowance,omitempty"`
+	// ExcessRate holds the value of the "excess_rate" field.
+	ExcessRate float64 `json:"excess_rate,omitempty"`
+	// StartMileage holds the value of the "start_mileage" field.
+	StartMileage *int64 `json:"start_mileage,omitempty"`
+	// TransferAllowed holds the value of the "transfer_allowed" field.
+	TransferAllowed bool `json:"transfer_allowed,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// TerminatedAt holds the value of the "terminated_at" field.
+	TerminatedAt *time.Time `json:"terminated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeaseContractQuery when eager-loading is set.
+	Edges LeaseContractEdges `json:"edges"`
+}
+
+// LeaseContractEdges holds the relations/edges for other nodes in the graph.
+type LeaseContractEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeaseContractEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeaseContract) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leasecontract.FieldTransferAllowed:
+			values[i] = new(sql.NullBool)
+		case leasecontract.FieldMonthlyPayment, leasecontract.FieldExcessRate:
+			values[i] = new(sql.NullFloat64)
+		case leasecontract.FieldID, leasecontract.FieldTenantID, leasecontract.FieldCarID, leasecontract.FieldLesseeID, leasecontract.FieldMileageAllowance, leasecontract.FieldStartMileage:
+			values[i] = new(sql.NullInt64)
+		case leasecontract.FieldLessor, leasecontract.FieldContractNo, leasecontract.FieldStatus:
+			values[i] = new(sql.NullString)
+		case leasecontract.FieldStartDate, leasecontract.FieldEndDate, leasecontract.FieldTerminatedAt, leasecontract.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type LeaseContract", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeaseContract fields.
+func (lc *LeaseContract) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leasecontract.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lc.ID = int64(value.Int64)
+		case leasecontract.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				lc.TenantID = value.Int64
+			}
+		case leasecontract.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				lc.CarID = value.Int64
+			}
+		case leasecontract.FieldLessor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lessor", values[i])
+			} else if value.Valid {
+				lc.Lessor = value.String
+			}
+		case leasecontract.FieldContractNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contract_no", values[i])
+			} else if value.Valid {
+				lc.ContractNo = value.String
+			}
+		case leasecontract.FieldLesseeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lessee_id", values[i])
+			} else if value.Valid {
+				lc.LesseeID = value.Int64
+			}
+		case leasecontract.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				lc.StartDate = value.Time
+			}
+		case leasecontract.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				lc.EndDate = value.Time
+			}
+		case leasecontract.FieldMonthlyPayment:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_payment", values[i])
+			} else if value.Valid {
+				lc.MonthlyPayment = value.Float64
+			}
+		case leasecontract.FieldMileageAllowance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mileage_allowance", values[i])
+			} else if value.Valid {
+				lc.MileageAllowance = value.Int64
+			}
+		case leasecontract.FieldExcessRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field excess_rate", values[i])
+			} else if value.Valid {
+				lc.ExcessRate = value.Float64
+			}
+		case leasecontract.FieldStartMileage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_mileage", values[i])
+			} else if value.Valid {
+				lc.StartMileage = new(int64)
+				*lc.StartMileage = value.Int64
+			}
+		case leasecontract.FieldTransferAllowed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_allowed", values[i])
+			} else if value.Valid {
+				lc.TransferAllowed = value.Bool
+			}
+		case leasecontract.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				lc.Status = value.String
+			}
+		case leasecontract.FieldTerminatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field terminated_at", values[i])
+			} else if value.Valid {
+				lc.TerminatedAt = new(time.Time)
+				*lc.TerminatedAt = value.Time
+			}
+		case leasecontract.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lc.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the LeaseContract entity.
+func (lc *LeaseContract) QueryCar() *CarQuery {
+	return (&LeaseContractClient{config: lc.config}).QueryCar(lc)
+}
+
+// Update returns a builder for updating this LeaseContract.
+// Note that you need to call LeaseContract.Unwrap() before calling this method if this LeaseContract
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lc *LeaseContract) Update() *LeaseContractUpdateOne {
+	return (&LeaseContractClient{config: lc.config}).UpdateOne(lc)
+}
+
+// Unwrap unwraps the LeaseContract entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lc *LeaseContract) Unwrap() *LeaseContract {
+	_tx, ok := lc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LeaseContract is not a transactional entity")
+	}
+	lc.config.driver = _tx.drv
+	return lc
+}
+
+// String implements the fmt.Stringer.
+func (lc *LeaseContract) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeaseContract(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", lc.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", lc.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("lessor=")
+	builder.WriteString(lc.Lessor)
+	builder.WriteString(", ")
+	builder.WriteString("contract_no=")
+	builder.WriteString(lc.ContractNo)
+	builder.WriteString(", ")
+	builder.WriteString("lessee_id=")
+	builder.WriteString(fmt.Sprintf("%v", lc.LesseeID))
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(lc.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(lc.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("monthly_payment=")
+	builder.WriteString(fmt.Sprintf("%v", lc.MonthlyPayment))
+	builder.WriteString(", ")
+	builder.WriteString("mileage_allowance=")
+	builder.WriteString(fmt.Sprintf("%v", lc.MileageAllowance))
+	builder.WriteString(", ")
+	builder.WriteString("excess_rate=")
+	builder.WriteString(fmt.Sprintf("%v", lc.ExcessRate))
+	builder.WriteString(", ")
+	if v := lc.StartMileage; v != nil {
+		builder.WriteString("start_mileage=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("transfer_allowed=")
+	builder.WriteString(fmt.Sprintf("%v", lc.TransferAllowed))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(lc.Status)
+	builder.WriteString(", ")
+	if v := lc.TerminatedAt; v != nil {
+		builder.WriteString("terminated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(lc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeaseContracts is a parsable slice of LeaseContract.
+type LeaseContracts []*LeaseContract
+
+func (lc LeaseContracts) config(cfg config) {
+	for _i := range lc {
+		lc[_i].config = cfg
+	}
+}
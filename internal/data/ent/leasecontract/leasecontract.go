@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leasecontract
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the leasecontract type in the database.
+	Label = "lease_contract"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldLessor holds the string denoting the lessor field in the database.
+	FieldLessor = "lessor"
+	// FieldContractNo holds the string denoting the contract_no field in the database.
+	FieldContractNo = "contract_no"
+	// FieldLesseeID holds the string denoting the lessee_id field in the database.
+	FieldLesseeID = "lessee_id"
+	// FieldStartDate holds the string denoting the start_date field in the database.
+	FieldStartDate = "start_date"
+	// FieldEndDate holds the string denoting the end_date field in the database.
+	FieldEndDate = "end_date"
+	// FieldMonthlyPayment holds the string denoting the monthly_payment field in the database.
+	FieldMonthlyPayment = "monthly_payment"
+	// FieldMileageAllowance holds the string denoting the mileage_allowance field in the database.
+	FieldMileageAllowance = "mileage_allowance"
+	// FieldExcessRate holds the string denoting the excess_rate field in the database.
+	FieldExcessRate = "excess_rate"
+	// FieldStartMileage holds the string denoting the start_mileage field in the database.
+	FieldStartMileage = "start_mileage"
+	// FieldTransferAllowed holds the string denoting the transfer_allowed field in the database.
+	FieldTransferAllowed = "transfer_allowed"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTerminatedAt holds the string denoting the terminated_at field in the database.
+	FieldTerminatedAt = "terminated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// Table holds the table name of the leasecontract in the database.
+	Table = "lease_contract"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "lease_contract"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+)
+
+// Columns holds all SQL columns for leasecontract fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldLessor,
+	FieldContractNo,
+	FieldLesseeID,
+	FieldStartDate,
+	FieldEndDate,
+	FieldMonthlyPayment,
+	FieldMileageAllowance,
+	FieldExcessRate,
+	FieldStartMileage,
+	FieldTransferAllowed,
+	FieldStatus,
+	FieldTerminatedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultTransferAllowed holds the default value on creation for the "transfer_allowed" field.
+	DefaultTransferAllowed bool
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,1570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leasecontract
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// Lessor applies equality check predicate on the "lessor" field. It's identical to LessorEQ.
+func Lessor(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLessor), v))
+	})
+}
+
+// ContractNo applies equality check predicate on the "contract_no" field. It's identical to ContractNoEQ.
+func ContractNo(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldContractNo), v))
+	})
+}
+
+// LesseeID applies equality check predicate on the "lessee_id" field. It's identical to LesseeIDEQ.
+func LesseeID(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLesseeID), v))
+	})
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartDate), v))
+	})
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndDate), v))
+	})
+}
+
+// MonthlyPayment applies equality check predicate on the "monthly_payment" field. It's identical to MonthlyPaymentEQ.
+func MonthlyPayment(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMonthlyPayment), v))
+	})
+}
+
+// MileageAllowance applies equality check predicate on the "mileage_allowance" field. It's identical to MileageAllowanceEQ.
+func MileageAllowance(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileageAllowance), v))
+	})
+}
+
+// ExcessRate applies equality check predicate on the "excess_rate" field. It's identical to ExcessRateEQ.
+func ExcessRate(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExcessRate), v))
+	})
+}
+
+// StartMileage applies equality check predicate on the "start_mileage" field. It's identical to StartMileageEQ.
+func StartMileage(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartMileage), v))
+	})
+}
+
+// TransferAllowed applies equality check predicate on the "transfer_allowed" field. It's identical to TransferAllowedEQ.
+func TransferAllowed(v bool) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTransferAllowed), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// TerminatedAt applies equality check predicate on the "terminated_at" field. It's identical to TerminatedAtEQ.
+func TerminatedAt(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTerminatedAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// LessorEQ applies the EQ predicate on the "lessor" field.
+func LessorEQ(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLessor), v))
+	})
+}
+
+// LessorNEQ applies the NEQ predicate on the "lessor" field.
+func LessorNEQ(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLessor), v))
+	})
+}
+
+// LessorIn applies the In predicate on the "lessor" field.
+func LessorIn(vs ...string) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLessor), v...))
+	})
+}
+
+// LessorNotIn applies the NotIn predicate on the "lessor" field.
+func LessorNotIn(vs ...string) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLessor), v...))
+	})
+}
+
+// LessorGT applies the GT predicate on the "lessor" field.
+func LessorGT(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLessor), v))
+	})
+}
+
+// LessorGTE applies the GTE predicate on the "lessor" field.
+func LessorGTE(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLessor), v))
+	})
+}
+
+// LessorLT applies the LT predicate on the "lessor" field.
+func LessorLT(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLessor), v))
+	})
+}
+
+// LessorLTE applies the LTE predicate on the "lessor" field.
+func LessorLTE(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLessor), v))
+	})
+}
+
+// LessorContains applies the Contains predicate on the "lessor" field.
+func LessorContains(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLessor), v))
+	})
+}
+
+// LessorHasPrefix applies the HasPrefix predicate on the "lessor" field.
+func LessorHasPrefix(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLessor), v))
+	})
+}
+
+// LessorHasSuffix applies the HasSuffix predicate on the "lessor" field.
+func LessorHasSuffix(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLessor), v))
+	})
+}
+
+// LessorIsNil applies the IsNil predicate on the "lessor" field.
+func LessorIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLessor)))
+	})
+}
+
+// LessorNotNil applies the NotNil predicate on the "lessor" field.
+func LessorNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLessor)))
+	})
+}
+
+// LessorEqualFold applies the EqualFold predicate on the "lessor" field.
+func LessorEqualFold(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLessor), v))
+	})
+}
+
+// LessorContainsFold applies the ContainsFold predicate on the "lessor" field.
+func LessorContainsFold(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLessor), v))
+	})
+}
+
+// ContractNoEQ applies the EQ predicate on the "contract_no" field.
+func ContractNoEQ(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoNEQ applies the NEQ predicate on the "contract_no" field.
+func ContractNoNEQ(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoIn applies the In predicate on the "contract_no" field.
+func ContractNoIn(vs ...string) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldContractNo), v...))
+	})
+}
+
+// ContractNoNotIn applies the NotIn predicate on the "contract_no" field.
+func ContractNoNotIn(vs ...string) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldContractNo), v...))
+	})
+}
+
+// ContractNoGT applies the GT predicate on the "contract_no" field.
+func ContractNoGT(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoGTE applies the GTE predicate on the "contract_no" field.
+func ContractNoGTE(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoLT applies the LT predicate on the "contract_no" field.
+func ContractNoLT(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoLTE applies the LTE predicate on the "contract_no" field.
+func ContractNoLTE(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoContains applies the Contains predicate on the "contract_no" field.
+func ContractNoContains(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoHasPrefix applies the HasPrefix predicate on the "contract_no" field.
+func ContractNoHasPrefix(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoHasSuffix applies the HasSuffix predicate on the "contract_no" field.
+func ContractNoHasSuffix(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoIsNil applies the IsNil predicate on the "contract_no" field.
+func ContractNoIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldContractNo)))
+	})
+}
+
+// ContractNoNotNil applies the NotNil predicate on the "contract_no" field.
+func ContractNoNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldContractNo)))
+	})
+}
+
+// ContractNoEqualFold applies the EqualFold predicate on the "contract_no" field.
+func ContractNoEqualFold(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldContractNo), v))
+	})
+}
+
+// ContractNoContainsFold applies the ContainsFold predicate on the "contract_no" field.
+func ContractNoContainsFold(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldContractNo), v))
+	})
+}
+
+// LesseeIDEQ applies the EQ predicate on the "lessee_id" field.
+func LesseeIDEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLesseeID), v))
+	})
+}
+
+// LesseeIDNEQ applies the NEQ predicate on the "lessee_id" field.
+func LesseeIDNEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLesseeID), v))
+	})
+}
+
+// LesseeIDIn applies the In predicate on the "lessee_id" field.
+func LesseeIDIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLesseeID), v...))
+	})
+}
+
+// LesseeIDNotIn applies the NotIn predicate on the "lessee_id" field.
+func LesseeIDNotIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLesseeID), v...))
+	})
+}
+
+// LesseeIDGT applies the GT predicate on the "lessee_id" field.
+func LesseeIDGT(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLesseeID), v))
+	})
+}
+
+// LesseeIDGTE applies the GTE predicate on the "lessee_id" field.
+func LesseeIDGTE(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLesseeID), v))
+	})
+}
+
+// LesseeIDLT applies the LT predicate on the "lessee_id" field.
+func LesseeIDLT(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLesseeID), v))
+	})
+}
+
+// LesseeIDLTE applies the LTE predicate on the "lessee_id" field.
+func LesseeIDLTE(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLesseeID), v))
+	})
+}
+
+// LesseeIDIsNil applies the IsNil predicate on the "lessee_id" field.
+func LesseeIDIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLesseeID)))
+	})
+}
+
+// LesseeIDNotNil applies the NotNil predicate on the "lessee_id" field.
+func LesseeIDNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLesseeID)))
+	})
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartDate), v...))
+	})
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartDate), v...))
+	})
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartDate), v))
+	})
+}
+
+// StartDateIsNil applies the IsNil predicate on the "start_date" field.
+func StartDateIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartDate)))
+	})
+}
+
+// StartDateNotNil applies the NotNil predicate on the "start_date" field.
+func StartDateNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartDate)))
+	})
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndDate), v...))
+	})
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndDate), v...))
+	})
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndDate), v))
+	})
+}
+
+// EndDateIsNil applies the IsNil predicate on the "end_date" field.
+func EndDateIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndDate)))
+	})
+}
+
+// EndDateNotNil applies the NotNil predicate on the "end_date" field.
+func EndDateNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndDate)))
+	})
+}
+
+// MonthlyPaymentEQ applies the EQ predicate on the "monthly_payment" field.
+func MonthlyPaymentEQ(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMonthlyPayment), v))
+	})
+}
+
+// MonthlyPaymentNEQ applies the NEQ predicate on the "monthly_payment" field.
+func MonthlyPaymentNEQ(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMonthlyPayment), v))
+	})
+}
+
+// MonthlyPaymentIn applies the In predicate on the "monthly_payment" field.
+func MonthlyPaymentIn(vs ...float64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMonthlyPayment), v...))
+	})
+}
+
+// MonthlyPaymentNotIn applies the NotIn predicate on the "monthly_payment" field.
+func MonthlyPaymentNotIn(vs ...float64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMonthlyPayment), v...))
+	})
+}
+
+// MonthlyPaymentGT applies the GT predicate on the "monthly_payment" field.
+func MonthlyPaymentGT(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMonthlyPayment), v))
+	})
+}
+
+// MonthlyPaymentGTE applies the GTE predicate on the "monthly_payment" field.
+func MonthlyPaymentGTE(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMonthlyPayment), v))
+	})
+}
+
+// MonthlyPaymentLT applies the LT predicate on the "monthly_payment" field.
+func MonthlyPaymentLT(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMonthlyPayment), v))
+	})
+}
+
+// MonthlyPaymentLTE applies the LTE predicate on the "monthly_payment" field.
+func MonthlyPaymentLTE(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMonthlyPayment), v))
+	})
+}
+
+// MonthlyPaymentIsNil applies the IsNil predicate on the "monthly_payment" field.
+func MonthlyPaymentIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMonthlyPayment)))
+	})
+}
+
+// MonthlyPaymentNotNil applies the NotNil predicate on the "monthly_payment" field.
+func MonthlyPaymentNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMonthlyPayment)))
+	})
+}
+
+// MileageAllowanceEQ applies the EQ predicate on the "mileage_allowance" field.
+func MileageAllowanceEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileageAllowance), v))
+	})
+}
+
+// MileageAllowanceNEQ applies the NEQ predicate on the "mileage_allowance" field.
+func MileageAllowanceNEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMileageAllowance), v))
+	})
+}
+
+// MileageAllowanceIn applies the In predicate on the "mileage_allowance" field.
+func MileageAllowanceIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMileageAllowance), v...))
+	})
+}
+
+// MileageAllowanceNotIn applies the NotIn predicate on the "mileage_allowance" field.
+func MileageAllowanceNotIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMileageAllowance), v...))
+	})
+}
+
+// MileageAllowanceGT applies the GT predicate on the "mileage_allowance" field.
+func MileageAllowanceGT(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMileageAllowance), v))
+	})
+}
+
+// MileageAllowanceGTE applies the GTE predicate on the "mileage_allowance" field.
+func MileageAllowanceGTE(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMileageAllowance), v))
+	})
+}
+
+// MileageAllowanceLT applies the LT predicate on the "mileage_allowance" field.
+func MileageAllowanceLT(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMileageAllowance), v))
+	})
+}
+
+// MileageAllowanceLTE applies the LTE predicate on the "mileage_allowance" field.
+func MileageAllowanceLTE(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMileageAllowance), v))
+	})
+}
+
+// MileageAllowanceIsNil applies the IsNil predicate on the "mileage_allowance" field.
+func MileageAllowanceIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMileageAllowance)))
+	})
+}
+
+// MileageAllowanceNotNil applies the NotNil predicate on the "mileage_allowance" field.
+func MileageAllowanceNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMileageAllowance)))
+	})
+}
+
+// ExcessRateEQ applies the EQ predicate on the "excess_rate" field.
+func ExcessRateEQ(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExcessRate), v))
+	})
+}
+
+// ExcessRateNEQ applies the NEQ predicate on the "excess_rate" field.
+func ExcessRateNEQ(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExcessRate), v))
+	})
+}
+
+// ExcessRateIn applies the In predicate on the "excess_rate" field.
+func ExcessRateIn(vs ...float64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExcessRate), v...))
+	})
+}
+
+// ExcessRateNotIn applies the NotIn predicate on the "excess_rate" field.
+func ExcessRateNotIn(vs ...float64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExcessRate), v...))
+	})
+}
+
+// ExcessRateGT applies the GT predicate on the "excess_rate" field.
+func ExcessRateGT(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExcessRate), v))
+	})
+}
+
+// ExcessRateGTE applies the GTE predicate on the "excess_rate" field.
+func ExcessRateGTE(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExcessRate), v))
+	})
+}
+
+// ExcessRateLT applies the LT predicate on the "excess_rate" field.
+func ExcessRateLT(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExcessRate), v))
+	})
+}
+
+// ExcessRateLTE applies the LTE predicate on the "excess_rate" field.
+func ExcessRateLTE(v float64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExcessRate), v))
+	})
+}
+
+// ExcessRateIsNil applies the IsNil predicate on the "excess_rate" field.
+func ExcessRateIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExcessRate)))
+	})
+}
+
+// ExcessRateNotNil applies the NotNil predicate on the "excess_rate" field.
+func ExcessRateNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExcessRate)))
+	})
+}
+
+// StartMileageEQ applies the EQ predicate on the "start_mileage" field.
+func StartMileageEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartMileage), v))
+	})
+}
+
+// StartMileageNEQ applies the NEQ predicate on the "start_mileage" field.
+func StartMileageNEQ(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartMileage), v))
+	})
+}
+
+// StartMileageIn applies the In predicate on the "start_mileage" field.
+func StartMileageIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartMileage), v...))
+	})
+}
+
+// StartMileageNotIn applies the NotIn predicate on the "start_mileage" field.
+func StartMileageNotIn(vs ...int64) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartMileage), v...))
+	})
+}
+
+// StartMileageGT applies the GT predicate on the "start_mileage" field.
+func StartMileageGT(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartMileage), v))
+	})
+}
+
+// StartMileageGTE applies the GTE predicate on the "start_mileage" field.
+func StartMileageGTE(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartMileage), v))
+	})
+}
+
+// StartMileageLT applies the LT predicate on the "start_mileage" field.
+func StartMileageLT(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartMileage), v))
+	})
+}
+
+// StartMileageLTE applies the LTE predicate on the "start_mileage" field.
+func StartMileageLTE(v int64) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartMileage), v))
+	})
+}
+
+// StartMileageIsNil applies the IsNil predicate on the "start_mileage" field.
+func StartMileageIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartMileage)))
+	})
+}
+
+// StartMileageNotNil applies the NotNil predicate on the "start_mileage" field.
+func StartMileageNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartMileage)))
+	})
+}
+
+// TransferAllowedEQ applies the EQ predicate on the "transfer_allowed" field.
+func TransferAllowedEQ(v bool) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTransferAllowed), v))
+	})
+}
+
+// TransferAllowedNEQ applies the NEQ predicate on the "transfer_allowed" field.
+func TransferAllowedNEQ(v bool) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTransferAllowed), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// TerminatedAtEQ applies the EQ predicate on the "terminated_at" field.
+func TerminatedAtEQ(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTerminatedAt), v))
+	})
+}
+
+// TerminatedAtNEQ applies the NEQ predicate on the "terminated_at" field.
+func TerminatedAtNEQ(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTerminatedAt), v))
+	})
+}
+
+// TerminatedAtIn applies the In predicate on the "terminated_at" field.
+func TerminatedAtIn(vs ...time.Time) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTerminatedAt), v...))
+	})
+}
+
+// TerminatedAtNotIn applies the NotIn predicate on the "terminated_at" field.
+func TerminatedAtNotIn(vs ...time.Time) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTerminatedAt), v...))
+	})
+}
+
+// TerminatedAtGT applies the GT predicate on the "terminated_at" field.
+func TerminatedAtGT(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTerminatedAt), v))
+	})
+}
+
+// TerminatedAtGTE applies the GTE predicate on the "terminated_at" field.
+func TerminatedAtGTE(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTerminatedAt), v))
+	})
+}
+
+// TerminatedAtLT applies the LT predicate on the "terminated_at" field.
+func TerminatedAtLT(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTerminatedAt), v))
+	})
+}
+
+// TerminatedAtLTE applies the LTE predicate on the "terminated_at" field.
+func TerminatedAtLTE(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTerminatedAt), v))
+	})
+}
+
+// TerminatedAtIsNil applies the IsNil predicate on the "terminated_at" field.
+func TerminatedAtIsNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTerminatedAt)))
+	})
+}
+
+// TerminatedAtNotNil applies the NotNil predicate on the "terminated_at" field.
+func TerminatedAtNotNil() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTerminatedAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LeaseContract {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeaseContract) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeaseContract) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeaseContract) predicate.LeaseContract {
+	return predicate.LeaseContract(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
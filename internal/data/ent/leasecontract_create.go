@@ -0,0 +1,603 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/leasecontract"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaseContractCreate is the builder for creating a LeaseContract entity.
+type LeaseContractCreate struct {
+	config
+	mutation *LeaseContractMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (lcc *LeaseContractCreate) SetTenantID(i int64) *LeaseContractCreate {
+	lcc.mutation.SetTenantID(i)
+	return lcc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableTenantID(i *int64) *LeaseContractCreate {
+	if i != nil {
+		lcc.SetTenantID(*i)
+	}
+	return lcc
+}
+
+// SetCarID sets the "car_id" field.
+func (lcc *LeaseContractCreate) SetCarID(i int64) *LeaseContractCreate {
+	lcc.mutation.SetCarID(i)
+	return lcc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableCarID(i *int64) *LeaseContractCreate {
+	if i != nil {
+		lcc.SetCarID(*i)
+	}
+	return lcc
+}
+
+// SetLessor sets the "lessor" field.
+func (lcc *LeaseContractCreate) SetLessor(s string) *LeaseContractCreate {
+	lcc.mutation.SetLessor(s)
+	return lcc
+}
+
+// SetNillableLessor sets the "lessor" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableLessor(s *string) *LeaseContractCreate {
+	if s != nil {
+		lcc.SetLessor(*s)
+	}
+	return lcc
+}
+
+// SetContractNo sets the "contract_no" field.
+func (lcc *LeaseContractCreate) SetContractNo(s string) *LeaseContractCreate {
+	lcc.mutation.SetContractNo(s)
+	return lcc
+}
+
+// SetNillableContractNo sets the "contract_no" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableContractNo(s *string) *LeaseContractCreate {
+	if s != nil {
+		lcc.SetContractNo(*s)
+	}
+	return lcc
+}
+
+// SetLesseeID sets the "lessee_id" field.
+func (lcc *LeaseContractCreate) SetLesseeID(i int64) *LeaseContractCreate {
+	lcc.mutation.SetLesseeID(i)
+	return lcc
+}
+
+// SetNillableLesseeID sets the "lessee_id" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableLesseeID(i *int64) *LeaseContractCreate {
+	if i != nil {
+		lcc.SetLesseeID(*i)
+	}
+	return lcc
+}
+
+// SetStartDate sets the "start_date" field.
+func (lcc *LeaseContractCreate) SetStartDate(t time.Time) *LeaseContractCreate {
+	lcc.mutation.SetStartDate(t)
+	return lcc
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableStartDate(t *time.Time) *LeaseContractCreate {
+	if t != nil {
+		lcc.SetStartDate(*t)
+	}
+	return lcc
+}
+
+// SetEndDate sets the "end_date" field.
+func (lcc *LeaseContractCreate) SetEndDate(t time.Time) *LeaseContractCreate {
+	lcc.mutation.SetEndDate(t)
+	return lcc
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableEndDate(t *time.Time) *LeaseContractCreate {
+	if t != nil {
+		lcc.SetEndDate(*t)
+	}
+	return lcc
+}
+
+// SetMonthlyPayment sets the "monthly_payment" field.
+func (lcc *LeaseContractCreate) SetMonthlyPayment(f float64) *LeaseContractCreate {
+	lcc.mutation.SetMonthlyPayment(f)
+	return lcc
+}
+
+// SetNillableMonthlyPayment sets the "monthly_payment" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableMonthlyPayment(f *float64) *LeaseContractCreate {
+	if f != nil {
+		lcc.SetMonthlyPayment(*f)
+	}
+	return lcc
+}
+
+// SetMileageAllowance sets the "mileage_allowance" field.
+func (lcc *LeaseContractCreate) SetMileageAllowance(i int64) *LeaseContractCreate {
+	lcc.mutation.SetMileageAllowance(i)
+	return lcc
+}
+
+// SetNillableMileageAllowance sets the "mileage_allowance" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableMileageAllowance(i *int64) *LeaseContractCreate {
+	if i != nil {
+		lcc.SetMileageAllowance(*i)
+	}
+	return lcc
+}
+
+// SetExcessRate sets the "excess_rate" field.
+func (lcc *LeaseContractCreate) SetExcessRate(f float64) *LeaseContractCreate {
+	lcc.mutation.SetExcessRate(f)
+	return lcc
+}
+
+// SetNillableExcessRate sets the "excess_rate" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableExcessRate(f *float64) *LeaseContractCreate {
+	if f != nil {
+		lcc.SetExcessRate(*f)
+	}
+	return lcc
+}
+
+// SetStartMileage sets the "start_mileage" field.
+func (lcc *LeaseContractCreate) SetStartMileage(i int64) *LeaseContractCreate {
+	lcc.mutation.SetStartMileage(i)
+	return lcc
+}
+
+// SetNillableStartMileage sets the "start_mileage" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableStartMileage(i *int64) *LeaseContractCreate {
+	if i != nil {
+		lcc.SetStartMileage(*i)
+	}
+	return lcc
+}
+
+// SetTransferAllowed sets the "transfer_allowed" field.
+func (lcc *LeaseContractCreate) SetTransferAllowed(b bool) *LeaseContractCreate {
+	lcc.mutation.SetTransferAllowed(b)
+	return lcc
+}
+
+// SetNillableTransferAllowed sets the "transfer_allowed" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableTransferAllowed(b *bool) *LeaseContractCreate {
+	if b != nil {
+		lcc.SetTransferAllowed(*b)
+	}
+	return lcc
+}
+
+// SetStatus sets the "status" field.
+func (lcc *LeaseContractCreate) SetStatus(s string) *LeaseContractCreate {
+	lcc.mutation.SetStatus(s)
+	return lcc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableStatus(s *string) *LeaseContractCreate {
+	if s != nil {
+		lcc.SetStatus(*s)
+	}
+	return lcc
+}
+
+// SetTerminatedAt sets the "terminated_at" field.
+func (lcc *LeaseContractCreate) SetTerminatedAt(t time.Time) *LeaseContractCreate {
+	lcc.mutation.SetTerminatedAt(t)
+	return lcc
+}
+
+// SetNillableTerminatedAt sets the "terminated_at" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableTerminatedAt(t *time.Time) *LeaseContractCreate {
+	if t != nil {
+		lcc.SetTerminatedAt(*t)
+	}
+	return lcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lcc *LeaseContractCreate) SetCreatedAt(t time.Time) *LeaseContractCreate {
+	lcc.mutation.SetCreatedAt(t)
+	return lcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lcc *LeaseContractCreate) SetNillableCreatedAt(t *time.Time) *LeaseContractCreate {
+	if t != nil {
+		lcc.SetCreatedAt(*t)
+	}
+	return lcc
+}
+
+// SetID sets the "id" field.
+func (lcc *LeaseContractCreate) SetID(i int64) *LeaseContractCreate {
+	lcc.mutation.SetID(i)
+	return lcc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (lcc *LeaseContractCreate) SetCar(c *Car) *LeaseContractCreate {
+	return lcc.SetCarID(c.ID)
+}
+
+// Mutation returns the LeaseContractMutation object of the builder.
+func (lcc *LeaseContractCreate) Mutation() *LeaseContractMutation {
+	return lcc.mutation
+}
+
+// Save creates the LeaseContract in the database.
+func (lcc *LeaseContractCreate) Save(ctx context.Context) (*LeaseContract, error) {
+	var (
+		err  error
+		node *LeaseContract
+	)
+	if err := lcc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(lcc.hooks) == 0 {
+		if err = lcc.check(); err != nil {
+			return nil, err
+		}
+		node, err = lcc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeaseContractMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lcc.check(); err != nil {
+				return nil, err
+			}
+			lcc.mutation = mutation
+			if node, err = lcc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lcc.hooks) - 1; i >= 0; i-- {
+			if lcc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lcc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, lcc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*LeaseContract)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from LeaseContractMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lcc *LeaseContractCreate) SaveX(ctx context.Context) *LeaseContract {
+	v, err := lcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcc *LeaseContractCreate) Exec(ctx context.Context) error {
+	_, err := lcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcc *LeaseContractCreate) ExecX(ctx context.Context) {
+	if err := lcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lcc *LeaseContractCreate) defaults() error {
+	if _, ok := lcc.mutation.TransferAllowed(); !ok {
+		v := leasecontract.DefaultTransferAllowed
+		lcc.mutation.SetTransferAllowed(v)
+	}
+	if _, ok := lcc.mutation.Status(); !ok {
+		v := leasecontract.DefaultStatus
+		lcc.mutation.SetStatus(v)
+	}
+	if _, ok := lcc.mutation.CreatedAt(); !ok {
+		if leasecontract.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized leasecontract.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := leasecontract.DefaultCreatedAt()
+		lcc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lcc *LeaseContractCreate) check() error {
+	if _, ok := lcc.mutation.TransferAllowed(); !ok {
+		return &ValidationError{Name: "transfer_allowed", err: errors.New(`ent: missing required field "LeaseContract.transfer_allowed"`)}
+	}
+	if _, ok := lcc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LeaseContract.status"`)}
+	}
+	if _, ok := lcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeaseContract.created_at"`)}
+	}
+	return nil
+}
+
+func (lcc *LeaseContractCreate) sqlSave(ctx context.Context) (*LeaseContract, error) {
+	_node, _spec := lcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (lcc *LeaseContractCreate) createSpec() (*LeaseContract, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaseContract{config: lcc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: leasecontract.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: leasecontract.FieldID,
+			},
+		}
+	)
+	if id, ok := lcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lcc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := lcc.mutation.Lessor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: leasecontract.FieldLessor,
+		})
+		_node.Lessor = value
+	}
+	if value, ok := lcc.mutation.ContractNo(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: leasecontract.FieldContractNo,
+		})
+		_node.ContractNo = value
+	}
+	if value, ok := lcc.mutation.LesseeID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldLesseeID,
+		})
+		_node.LesseeID = value
+	}
+	if value, ok := lcc.mutation.StartDate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldStartDate,
+		})
+		_node.StartDate = value
+	}
+	if value, ok := lcc.mutation.EndDate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldEndDate,
+		})
+		_node.EndDate = value
+	}
+	if value, ok := lcc.mutation.MonthlyPayment(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldMonthlyPayment,
+		})
+		_node.MonthlyPayment = value
+	}
+	if value, ok := lcc.mutation.MileageAllowance(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldMileageAllowance,
+		})
+		_node.MileageAllowance = value
+	}
+	if value, ok := lcc.mutation.ExcessRate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldExcessRate,
+		})
+		_node.ExcessRate = value
+	}
+	if value, ok := lcc.mutation.StartMileage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldStartMileage,
+		})
+		_node.StartMileage = &value
+	}
+	if value, ok := lcc.mutation.TransferAllowed(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: leasecontract.FieldTransferAllowed,
+		})
+		_node.TransferAllowed = value
+	}
+	if value, ok := lcc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: leasecontract.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := lcc.mutation.TerminatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldTerminatedAt,
+		})
+		_node.TerminatedAt = &value
+	}
+	if value, ok := lcc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := lcc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leasecontract.CarTable,
+			Columns: []string{leasecontract.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeaseContractCreateBulk is the builder for creating many LeaseContract entities in bulk.
+type LeaseContractCreateBulk struct {
+	config
+	builders []*LeaseContractCreate
+}
+
+// Save creates the LeaseContract entities in the database.
+func (lccb *LeaseContractCreateBulk) Save(ctx context.Context) ([]*LeaseContract, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(lccb.builders))
+	nodes := make([]*LeaseContract, len(lccb.builders))
+	mutators := make([]Mutator, len(lccb.builders))
+	for i := range lccb.builders {
+		func(i int, root context.Context) {
+			builder := lccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaseContractMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lccb *LeaseContractCreateBulk) SaveX(ctx context.Context) []*LeaseContract {
+	v, err := lccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lccb *LeaseContractCreateBulk) Exec(ctx context.Context) error {
+	_, err := lccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lccb *LeaseContractCreateBulk) ExecX(ctx context.Context) {
+	if err := lccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
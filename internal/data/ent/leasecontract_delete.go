@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaseContractDelete is the builder for deleting a LeaseContract entity.
+type LeaseContractDelete struct {
+	config
+	hooks    []Hook
+	mutation *LeaseContractMutation
+}
+
+// Where appends a list predicates to the LeaseContractDelete builder.
+func (lcd *LeaseContractDelete) Where(ps ...predicate.LeaseContract) *LeaseContractDelete {
+	lcd.mutation.Where(ps...)
+	return lcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lcd *LeaseContractDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(lcd.hooks) == 0 {
+		affected, err = lcd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeaseContractMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lcd.mutation = mutation
+			affected, err = lcd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lcd.hooks) - 1; i >= 0; i-- {
+			if lcd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lcd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lcd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcd *LeaseContractDelete) ExecX(ctx context.Context) int {
+	n, err := lcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lcd *LeaseContractDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: leasecontract.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: leasecontract.FieldID,
+			},
+		},
+	}
+	if ps := lcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// LeaseContractDeleteOne is the builder for deleting a single LeaseContract entity.
+type LeaseContractDeleteOne struct {
+	lcd *LeaseContractDelete
+}
+
+// Exec executes the deletion query.
+func (lcdo *LeaseContractDeleteOne) Exec(ctx context.Context) error {
+	n, err := lcdo.lcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{leasecontract.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcdo *LeaseContractDeleteOne) ExecX(ctx context.Context) {
+	lcdo.lcd.ExecX(ctx)
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaseContractQuery is the builder for querying LeaseContract entities.
+type LeaseContractQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.LeaseContract
+	// eager-loading edges.
+	withCar   *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LeaseContractQuery builder.
+func (lcq *LeaseContractQuery) Where(ps ...predicate.LeaseContract) *LeaseContractQuery {
+	lcq.predicates = append(lcq.predicates, ps...)
+	return lcq
+}
+
+// Limit adds a limit step to the query.
+func (lcq *LeaseContractQuery) Limit(limit int) *LeaseContractQuery {
+	lcq.limit = &limit
+	return lcq
+}
+
+// Offset adds an offset step to the query.
+func (lcq *LeaseContractQuery) Offset(offset int) *LeaseContractQuery {
+	lcq.offset = &offset
+	return lcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lcq *LeaseContractQuery) Unique(unique bool) *LeaseContractQuery {
+	lcq.unique = &unique
+	return lcq
+}
+
+// Order adds an order step to the query.
+func (lcq *LeaseContractQuery) Order(o ...OrderFunc) *LeaseContractQuery {
+	lcq.order = append(lcq.order, o...)
+	return lcq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (lcq *LeaseContractQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: lcq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leasecontract.Table, leasecontract.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leasecontract.CarTable, leasecontract.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LeaseContract entity from the query.
+// Returns a *NotFoundError when no LeaseContract was found.
+func (lcq *LeaseContractQuery) First(ctx context.Context) (*LeaseContract, error) {
+	nodes, err := lcq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{leasecontract.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lcq *LeaseContractQuery) FirstX(ctx context.Context) *LeaseContract {
+	node, err := lcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LeaseContract ID from the query.
+// Returns a *NotFoundError when no LeaseContract ID was found.
+func (lcq *LeaseContractQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = lcq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{leasecontract.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lcq *LeaseContractQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := lcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LeaseContract entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LeaseContract entity is found.
+// Returns a *NotFoundError when no LeaseContract entities are found.
+func (lcq *LeaseContractQuery) Only(ctx context.Context) (*LeaseContract, error) {
+	nodes, err := lcq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{leasecontract.Label}
+	default:
+		return nil, &NotSingularError{leasecontract.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lcq *LeaseContractQuery) OnlyX(ctx context.Context) *LeaseContract {
+	node, err := lcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LeaseContract ID in the query.
+// Returns a *NotSingularError when more than one LeaseContract ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lcq *LeaseContractQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = lcq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{leasecontract.Label}
+	default:
+		err = &NotSingularError{leasecontract.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lcq *LeaseContractQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := lcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LeaseContracts.
+func (lcq *LeaseContractQuery) All(ctx context.Context) ([]*LeaseContract, error) {
+	if err := lcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return lcq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lcq *LeaseContractQuery) AllX(ctx context.Context) []*LeaseContract {
+	nodes, err := lcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LeaseContract IDs.
+func (lcq *LeaseContractQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := lcq.Select(leasecontract.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lcq *LeaseContractQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := lcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lcq *LeaseContractQuery) Count(ctx context.Context) (int, error) {
+	if err := lcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return lcq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lcq *LeaseContractQuery) CountX(ctx context.Context) int {
+	count, err := lcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lcq *LeaseContractQuery) Exist(ctx context.Context) (bool, error) {
+	if err := lcq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return lcq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lcq *LeaseContractQuery) ExistX(ctx context.Context) bool {
+	exist, err := lcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LeaseContractQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lcq *LeaseContractQuery) Clone() *LeaseContractQuery {
+	if lcq == nil {
+		return nil
+	}
+	return &LeaseContractQuery{
+		config:     lcq.config,
+		limit:      lcq.limit,
+		offset:     lcq.offset,
+		order:      append([]OrderFunc{}, lcq.order...),
+		predicates: append([]predicate.LeaseContract{}, lcq.predicates...),
+		withCar:    lcq.withCar.Clone(),
+		// clone intermediate query.
+		sql:    lcq.sql.Clone(),
+		path:   lcq.path,
+		unique: lcq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (lcq *LeaseContractQuery) WithCar(opts ...func(*CarQuery)) *LeaseContractQuery {
+	query := &CarQuery{config: lcq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	lcq.withCar = query
+	return lcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LeaseContract.Query().
+//		GroupBy(leasecontract.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (lcq *LeaseContractQuery) GroupBy(field string, fields ...string) *LeaseContractGroupBy {
+	grbuild := &LeaseContractGroupBy{config: lcq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := lcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return lcq.sqlQuery(ctx), nil
+	}
+	grbuild.label = leasecontract.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.LeaseContract.Query().
+//		Select(leasecontract.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (lcq *LeaseContractQuery) Select(fields ...string) *LeaseContractSelect {
+	lcq.fields = append(lcq.fields, fields...)
+	selbuild := &LeaseContractSelect{LeaseContractQuery: lcq}
+	selbuild.label = leasecontract.Label
+	selbuild.flds, selbuild.scan = &lcq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (lcq *LeaseContractQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range lcq.fields {
+		if !leasecontract.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lcq.path != nil {
+		prev, err := lcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lcq.sql = prev
+	}
+	if leasecontract.Policy == nil {
+		return errors.New("ent: uninitialized leasecontract.Policy (forgotten import ent/runtime?)")
+	}
+	if err := leasecontract.Policy.EvalQuery(ctx, lcq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (lcq *LeaseContractQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LeaseContract, error) {
+	var (
+		nodes       = []*LeaseContract{}
+		_spec       = lcq.querySpec()
+		loadedTypes = [1]bool{
+			lcq.withCar != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*LeaseContract).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &LeaseContract{config: lcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(lcq.modifiers) > 0 {
+		_spec.Modifiers = lcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := lcq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*LeaseContract)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (lcq *LeaseContractQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lcq.querySpec()
+	if len(lcq.modifiers) > 0 {
+		_spec.Modifiers = lcq.modifiers
+	}
+	_spec.Node.Columns = lcq.fields
+	if len(lcq.fields) > 0 {
+		_spec.Unique = lcq.unique != nil && *lcq.unique
+	}
+	return sqlgraph.CountNodes(ctx, lcq.driver, _spec)
+}
+
+func (lcq *LeaseContractQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := lcq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (lcq *LeaseContractQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   leasecontract.Table,
+			Columns: leasecontract.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: leasecontract.FieldID,
+			},
+		},
+		From:   lcq.sql,
+		Unique: true,
+	}
+	if unique := lcq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := lcq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leasecontract.FieldID)
+		for i := range fields {
+			if fields[i] != leasecontract.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lcq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lcq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lcq *LeaseContractQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lcq.driver.Dialect())
+	t1 := builder.Table(leasecontract.Table)
+	columns := lcq.fields
+	if len(columns) == 0 {
+		columns = leasecontract.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lcq.sql != nil {
+		selector = lcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lcq.unique != nil && *lcq.unique {
+		selector.Distinct()
+	}
+	for _, m := range lcq.modifiers {
+		m(selector)
+	}
+	for _, p := range lcq.predicates {
+		p(selector)
+	}
+	for _, p := range lcq.order {
+		p(selector)
+	}
+	if offset := lcq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lcq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (lcq *LeaseContractQuery) ForUpdate(opts ...sql.LockOption) *LeaseContractQuery {
+	if lcq.driver.Dialect() == dialect.Postgres {
+		lcq.Unique(false)
+	}
+	lcq.modifiers = append(lcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return lcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (lcq *LeaseContractQuery) ForShare(opts ...sql.LockOption) *LeaseContractQuery {
+	if lcq.driver.Dialect() == dialect.Postgres {
+		lcq.Unique(false)
+	}
+	lcq.modifiers = append(lcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return lcq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lcq *LeaseContractQuery) Modify(modifiers ...func(s *sql.Selector)) *LeaseContractSelect {
+	lcq.modifiers = append(lcq.modifiers, modifiers...)
+	return lcq.Select()
+}
+
+// LeaseContractGroupBy is the group-by builder for LeaseContract entities.
+type LeaseContractGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lcgb *LeaseContractGroupBy) Aggregate(fns ...AggregateFunc) *LeaseContractGroupBy {
+	lcgb.fns = append(lcgb.fns, fns...)
+	return lcgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (lcgb *LeaseContractGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := lcgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	lcgb.sql = query
+	return lcgb.sqlScan(ctx, v)
+}
+
+func (lcgb *LeaseContractGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range lcgb.fields {
+		if !leasecontract.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := lcgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lcgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (lcgb *LeaseContractGroupBy) sqlQuery() *sql.Selector {
+	selector := lcgb.sql.Select()
+	aggregation := make([]string, 0, len(lcgb.fns))
+	for _, fn := range lcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(lcgb.fields)+len(lcgb.fns))
+		for _, f := range lcgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(lcgb.fields...)...)
+}
+
+// LeaseContractSelect is the builder for selecting fields of LeaseContract entities.
+type LeaseContractSelect struct {
+	*LeaseContractQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lcs *LeaseContractSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := lcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	lcs.sql = lcs.LeaseContractQuery.sqlQuery(ctx)
+	return lcs.sqlScan(ctx, v)
+}
+
+func (lcs *LeaseContractSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := lcs.sql.Query()
+	if err := lcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lcs *LeaseContractSelect) Modify(modifiers ...func(s *sql.Selector)) *LeaseContractSelect {
+	lcs.modifiers = append(lcs.modifiers, modifiers...)
+	return lcs
+}
@@ -0,0 +1,1399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaseContractUpdate is the builder for updating LeaseContract entities.
+type LeaseContractUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LeaseContractMutation
+}
+
+// Where appends a list predicates to the LeaseContractUpdate builder.
+func (lcu *LeaseContractUpdate) Where(ps ...predicate.LeaseContract) *LeaseContractUpdate {
+	lcu.mutation.Where(ps...)
+	return lcu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (lcu *LeaseContractUpdate) SetTenantID(i int64) *LeaseContractUpdate {
+	lcu.mutation.ResetTenantID()
+	lcu.mutation.SetTenantID(i)
+	return lcu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableTenantID(i *int64) *LeaseContractUpdate {
+	if i != nil {
+		lcu.SetTenantID(*i)
+	}
+	return lcu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (lcu *LeaseContractUpdate) AddTenantID(i int64) *LeaseContractUpdate {
+	lcu.mutation.AddTenantID(i)
+	return lcu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (lcu *LeaseContractUpdate) ClearTenantID() *LeaseContractUpdate {
+	lcu.mutation.ClearTenantID()
+	return lcu
+}
+
+// SetCarID sets the "car_id" field.
+func (lcu *LeaseContractUpdate) SetCarID(i int64) *LeaseContractUpdate {
+	lcu.mutation.SetCarID(i)
+	return lcu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableCarID(i *int64) *LeaseContractUpdate {
+	if i != nil {
+		lcu.SetCarID(*i)
+	}
+	return lcu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (lcu *LeaseContractUpdate) ClearCarID() *LeaseContractUpdate {
+	lcu.mutation.ClearCarID()
+	return lcu
+}
+
+// SetLessor sets the "lessor" field.
+func (lcu *LeaseContractUpdate) SetLessor(s string) *LeaseContractUpdate {
+	lcu.mutation.SetLessor(s)
+	return lcu
+}
+
+// SetNillableLessor sets the "lessor" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableLessor(s *string) *LeaseContractUpdate {
+	if s != nil {
+		lcu.SetLessor(*s)
+	}
+	return lcu
+}
+
+// ClearLessor clears the value of the "lessor" field.
+func (lcu *LeaseContractUpdate) ClearLessor() *LeaseContractUpdate {
+	lcu.mutation.ClearLessor()
+	return lcu
+}
+
+// SetContractNo sets the "contract_no" field.
+func (lcu *LeaseContractUpdate) SetContractNo(s string) *LeaseContractUpdate {
+	lcu.mutation.SetContractNo(s)
+	return lcu
+}
+
+// SetNillableContractNo sets the "contract_no" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableContractNo(s *string) *LeaseContractUpdate {
+	if s != nil {
+		lcu.SetContractNo(*s)
+	}
+	return lcu
+}
+
+// ClearContractNo clears the value of the "contract_no" field.
+func (lcu *LeaseContractUpdate) ClearContractNo() *LeaseContractUpdate {
+	lcu.mutation.ClearContractNo()
+	return lcu
+}
+
+// SetLesseeID sets the "lessee_id" field.
+func (lcu *LeaseContractUpdate) SetLesseeID(i int64) *LeaseContractUpdate {
+	lcu.mutation.ResetLesseeID()
+	lcu.mutation.SetLesseeID(i)
+	return lcu
+}
+
+// SetNillableLesseeID sets the "lessee_id" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableLesseeID(i *int64) *LeaseContractUpdate {
+	if i != nil {
+		lcu.SetLesseeID(*i)
+	}
+	return lcu
+}
+
+// AddLesseeID adds i to the "lessee_id" field.
+func (lcu *LeaseContractUpdate) AddLesseeID(i int64) *LeaseContractUpdate {
+	lcu.mutation.AddLesseeID(i)
+	return lcu
+}
+
+// ClearLesseeID clears the value of the "lessee_id" field.
+func (lcu *LeaseContractUpdate) ClearLesseeID() *LeaseContractUpdate {
+	lcu.mutation.ClearLesseeID()
+	return lcu
+}
+
+// SetStartDate sets the "start_date" field.
+func (lcu *LeaseContractUpdate) SetStartDate(t time.Time) *LeaseContractUpdate {
+	lcu.mutation.SetStartDate(t)
+	return lcu
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableStartDate(t *time.Time) *LeaseContractUpdate {
+	if t != nil {
+		lcu.SetStartDate(*t)
+	}
+	return lcu
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (lcu *LeaseContractUpdate) ClearStartDate() *LeaseContractUpdate {
+	lcu.mutation.ClearStartDate()
+	return lcu
+}
+
+// SetEndDate sets the "end_date" field.
+func (lcu *LeaseContractUpdate) SetEndDate(t time.Time) *LeaseContractUpdate {
+	lcu.mutation.SetEndDate(t)
+	return lcu
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableEndDate(t *time.Time) *LeaseContractUpdate {
+	if t != nil {
+		lcu.SetEndDate(*t)
+	}
+	return lcu
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (lcu *LeaseContractUpdate) ClearEndDate() *LeaseContractUpdate {
+	lcu.mutation.ClearEndDate()
+	return lcu
+}
+
+// SetMonthlyPayment sets the "monthly_payment" field.
+func (lcu *LeaseContractUpdate) SetMonthlyPayment(f float64) *LeaseContractUpdate {
+	lcu.mutation.ResetMonthlyPayment()
+	lcu.mutation.SetMonthlyPayment(f)
+	return lcu
+}
+
+// SetNillableMonthlyPayment sets the "monthly_payment" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableMonthlyPayment(f *float64) *LeaseContractUpdate {
+	if f != nil {
+		lcu.SetMonthlyPayment(*f)
+	}
+	return lcu
+}
+
+// AddMonthlyPayment adds f to the "monthly_payment" field.
+func (lcu *LeaseContractUpdate) AddMonthlyPayment(f float64) *LeaseContractUpdate {
+	lcu.mutation.AddMonthlyPayment(f)
+	return lcu
+}
+
+// ClearMonthlyPayment clears the value of the "monthly_payment" field.
+func (lcu *LeaseContractUpdate) ClearMonthlyPayment() *LeaseContractUpdate {
+	lcu.mutation.ClearMonthlyPayment()
+	return lcu
+}
+
+// SetMileageAllowance sets the "mileage_allowance" field.
+func (lcu *LeaseContractUpdate) SetMileageAllowance(i int64) *LeaseContractUpdate {
+	lcu.mutation.ResetMileageAllowance()
+	lcu.mutation.SetMileageAllowance(i)
+	return lcu
+}
+
+// SetNillableMileageAllowance sets the "mileage_allowance" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableMileageAllowance(i *int64) *LeaseContractUpdate {
+	if i != nil {
+		lcu.SetMileageAllowance(*i)
+	}
+	return lcu
+}
+
+// AddMileageAllowance adds i to the "mileage_allowance" field.
+func (lcu *LeaseContractUpdate) AddMileageAllowance(i int64) *LeaseContractUpdate {
+	lcu.mutation.AddMileageAllowance(i)
+	return lcu
+}
+
+// ClearMileageAllowance clears the value of the "mileage_allowance" field.
+func (lcu *LeaseContractUpdate) ClearMileageAllowance() *LeaseContractUpdate {
+	lcu.mutation.ClearMileageAllowance()
+	return lcu
+}
+
+// SetExcessRate sets the "excess_rate" field.
+func (lcu *LeaseContractUpdate) SetExcessRate(f float64) *LeaseContractUpdate {
+	lcu.mutation.ResetExcessRate()
+	lcu.mutation.SetExcessRate(f)
+	return lcu
+}
+
+// SetNillableExcessRate sets the "excess_rate" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableExcessRate(f *float64) *LeaseContractUpdate {
+	if f != nil {
+		lcu.SetExcessRate(*f)
+	}
+	return lcu
+}
+
+// AddExcessRate adds f to the "excess_rate" field.
+func (lcu *LeaseContractUpdate) AddExcessRate(f float64) *LeaseContractUpdate {
+	lcu.mutation.AddExcessRate(f)
+	return lcu
+}
+
+// ClearExcessRate clears the value of the "excess_rate" field.
+func (lcu *LeaseContractUpdate) ClearExcessRate() *LeaseContractUpdate {
+	lcu.mutation.ClearExcessRate()
+	return lcu
+}
+
+// SetStartMileage sets the "start_mileage" field.
+func (lcu *LeaseContractUpdate) SetStartMileage(i int64) *LeaseContractUpdate {
+	lcu.mutation.ResetStartMileage()
+	lcu.mutation.SetStartMileage(i)
+	return lcu
+}
+
+// SetNillableStartMileage sets the "start_mileage" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableStartMileage(i *int64) *LeaseContractUpdate {
+	if i != nil {
+		lcu.SetStartMileage(*i)
+	}
+	return lcu
+}
+
+// AddStartMileage adds i to the "start_mileage" field.
+func (lcu *LeaseContractUpdate) AddStartMileage(i int64) *LeaseContractUpdate {
+	lcu.mutation.AddStartMileage(i)
+	return lcu
+}
+
+// ClearStartMileage clears the value of the "start_mileage" field.
+func (lcu *LeaseContractUpdate) ClearStartMileage() *LeaseContractUpdate {
+	lcu.mutation.ClearStartMileage()
+	return lcu
+}
+
+// SetTransferAllowed sets the "transfer_allowed" field.
+func (lcu *LeaseContractUpdate) SetTransferAllowed(b bool) *LeaseContractUpdate {
+	lcu.mutation.SetTransferAllowed(b)
+	return lcu
+}
+
+// SetNillableTransferAllowed sets the "transfer_allowed" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableTransferAllowed(b *bool) *LeaseContractUpdate {
+	if b != nil {
+		lcu.SetTransferAllowed(*b)
+	}
+	return lcu
+}
+
+// SetStatus sets the "status" field.
+func (lcu *LeaseContractUpdate) SetStatus(s string) *LeaseContractUpdate {
+	lcu.mutation.SetStatus(s)
+	return lcu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableStatus(s *string) *LeaseContractUpdate {
+	if s != nil {
+		lcu.SetStatus(*s)
+	}
+	return lcu
+}
+
+// SetTerminatedAt sets the "terminated_at" field.
+func (lcu *LeaseContractUpdate) SetTerminatedAt(t time.Time) *LeaseContractUpdate {
+	lcu.mutation.SetTerminatedAt(t)
+	return lcu
+}
+
+// SetNillableTerminatedAt sets the "terminated_at" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableTerminatedAt(t *time.Time) *LeaseContractUpdate {
+	if t != nil {
+		lcu.SetTerminatedAt(*t)
+	}
+	return lcu
+}
+
+// ClearTerminatedAt clears the value of the "terminated_at" field.
+func (lcu *LeaseContractUpdate) ClearTerminatedAt() *LeaseContractUpdate {
+	lcu.mutation.ClearTerminatedAt()
+	return lcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lcu *LeaseContractUpdate) SetCreatedAt(t time.Time) *LeaseContractUpdate {
+	lcu.mutation.SetCreatedAt(t)
+	return lcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lcu *LeaseContractUpdate) SetNillableCreatedAt(t *time.Time) *LeaseContractUpdate {
+	if t != nil {
+		lcu.SetCreatedAt(*t)
+	}
+	return lcu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (lcu *LeaseContractUpdate) SetCar(c *Car) *LeaseContractUpdate {
+	return lcu.SetCarID(c.ID)
+}
+
+// Mutation returns the LeaseContractMutation object of the builder.
+func (lcu *LeaseContractUpdate) Mutation() *LeaseContractMutation {
+	return lcu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (lcu *LeaseContractUpdate) ClearCar() *LeaseContractUpdate {
+	lcu.mutation.ClearCar()
+	return lcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lcu *LeaseContractUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(lcu.hooks) == 0 {
+		affected, err = lcu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeaseContractMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lcu.mutation = mutation
+			affected, err = lcu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lcu.hooks) - 1; i >= 0; i-- {
+			if lcu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lcu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lcu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcu *LeaseContractUpdate) SaveX(ctx context.Context) int {
+	affected, err := lcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lcu *LeaseContractUpdate) Exec(ctx context.Context) error {
+	_, err := lcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcu *LeaseContractUpdate) ExecX(ctx context.Context) {
+	if err := lcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lcu *LeaseContractUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   leasecontract.Table,
+			Columns: leasecontract.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: leasecontract.FieldID,
+			},
+		},
+	}
+	if ps := lcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lcu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldTenantID,
+		})
+	}
+	if value, ok := lcu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldTenantID,
+		})
+	}
+	if lcu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: leasecontract.FieldTenantID,
+		})
+	}
+	if value, ok := lcu.mutation.Lessor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: leasecontract.FieldLessor,
+		})
+	}
+	if lcu.mutation.LessorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: leasecontract.FieldLessor,
+		})
+	}
+	if value, ok := lcu.mutation.ContractNo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: leasecontract.FieldContractNo,
+		})
+	}
+	if lcu.mutation.ContractNoCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: leasecontract.FieldContractNo,
+		})
+	}
+	if value, ok := lcu.mutation.LesseeID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldLesseeID,
+		})
+	}
+	if value, ok := lcu.mutation.AddedLesseeID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldLesseeID,
+		})
+	}
+	if lcu.mutation.LesseeIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: leasecontract.FieldLesseeID,
+		})
+	}
+	if value, ok := lcu.mutation.StartDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldStartDate,
+		})
+	}
+	if lcu.mutation.StartDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: leasecontract.FieldStartDate,
+		})
+	}
+	if value, ok := lcu.mutation.EndDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldEndDate,
+		})
+	}
+	if lcu.mutation.EndDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: leasecontract.FieldEndDate,
+		})
+	}
+	if value, ok := lcu.mutation.MonthlyPayment(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldMonthlyPayment,
+		})
+	}
+	if value, ok := lcu.mutation.AddedMonthlyPayment(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldMonthlyPayment,
+		})
+	}
+	if lcu.mutation.MonthlyPaymentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: leasecontract.FieldMonthlyPayment,
+		})
+	}
+	if value, ok := lcu.mutation.MileageAllowance(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldMileageAllowance,
+		})
+	}
+	if value, ok := lcu.mutation.AddedMileageAllowance(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldMileageAllowance,
+		})
+	}
+	if lcu.mutation.MileageAllowanceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: leasecontract.FieldMileageAllowance,
+		})
+	}
+	if value, ok := lcu.mutation.ExcessRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldExcessRate,
+		})
+	}
+	if value, ok := lcu.mutation.AddedExcessRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldExcessRate,
+		})
+	}
+	if lcu.mutation.ExcessRateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: leasecontract.FieldExcessRate,
+		})
+	}
+	if value, ok := lcu.mutation.StartMileage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldStartMileage,
+		})
+	}
+	if value, ok := lcu.mutation.AddedStartMileage(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldStartMileage,
+		})
+	}
+	if lcu.mutation.StartMileageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: leasecontract.FieldStartMileage,
+		})
+	}
+	if value, ok := lcu.mutation.TransferAllowed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: leasecontract.FieldTransferAllowed,
+		})
+	}
+	if value, ok := lcu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: leasecontract.FieldStatus,
+		})
+	}
+	if value, ok := lcu.mutation.TerminatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldTerminatedAt,
+		})
+	}
+	if lcu.mutation.TerminatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: leasecontract.FieldTerminatedAt,
+		})
+	}
+	if value, ok := lcu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldCreatedAt,
+		})
+	}
+	if lcu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leasecontract.CarTable,
+			Columns: []string{leasecontract.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lcu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leasecontract.CarTable,
+			Columns: []string{leasecontract.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leasecontract.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// LeaseContractUpdateOne is the builder for updating a single LeaseContract entity.
+type LeaseContractUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LeaseContractMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (lcuo *LeaseContractUpdateOne) SetTenantID(i int64) *LeaseContractUpdateOne {
+	lcuo.mutation.ResetTenantID()
+	lcuo.mutation.SetTenantID(i)
+	return lcuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableTenantID(i *int64) *LeaseContractUpdateOne {
+	if i != nil {
+		lcuo.SetTenantID(*i)
+	}
+	return lcuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (lcuo *LeaseContractUpdateOne) AddTenantID(i int64) *LeaseContractUpdateOne {
+	lcuo.mutation.AddTenantID(i)
+	return lcuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (lcuo *LeaseContractUpdateOne) ClearTenantID() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearTenantID()
+	return lcuo
+}
+
+// SetCarID sets the "car_id" field.
+func (lcuo *LeaseContractUpdateOne) SetCarID(i int64) *LeaseContractUpdateOne {
+	lcuo.mutation.SetCarID(i)
+	return lcuo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableCarID(i *int64) *LeaseContractUpdateOne {
+	if i != nil {
+		lcuo.SetCarID(*i)
+	}
+	return lcuo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (lcuo *LeaseContractUpdateOne) ClearCarID() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearCarID()
+	return lcuo
+}
+
+// SetLessor sets the "lessor" field.
+func (lcuo *LeaseContractUpdateOne) SetLessor(s string) *LeaseContractUpdateOne {
+	lcuo.mutation.SetLessor(s)
+	return lcuo
+}
+
+// SetNillableLessor sets the "lessor" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableLessor(s *string) *LeaseContractUpdateOne {
+	if s != nil {
+		lcuo.SetLessor(*s)
+	}
+	return lcuo
+}
+
+// ClearLessor clears the value of the "lessor" field.
+func (lcuo *LeaseContractUpdateOne) ClearLessor() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearLessor()
+	return lcuo
+}
+
+// SetContractNo sets the "contract_no" field.
+func (lcuo *LeaseContractUpdateOne) SetContractNo(s string) *LeaseContractUpdateOne {
+	lcuo.mutation.SetContractNo(s)
+	return lcuo
+}
+
+// SetNillableContractNo sets the "contract_no" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableContractNo(s *string) *LeaseContractUpdateOne {
+	if s != nil {
+		lcuo.SetContractNo(*s)
+	}
+	return lcuo
+}
+
+// ClearContractNo clears the value of the "contract_no" field.
+func (lcuo *LeaseContractUpdateOne) ClearContractNo() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearContractNo()
+	return lcuo
+}
+
+// SetLesseeID sets the "lessee_id" field.
+func (lcuo *LeaseContractUpdateOne) SetLesseeID(i int64) *LeaseContractUpdateOne {
+	lcuo.mutation.ResetLesseeID()
+	lcuo.mutation.SetLesseeID(i)
+	return lcuo
+}
+
+// SetNillableLesseeID sets the "lessee_id" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableLesseeID(i *int64) *LeaseContractUpdateOne {
+	if i != nil {
+		lcuo.SetLesseeID(*i)
+	}
+	return lcuo
+}
+
+// AddLesseeID adds i to the "lessee_id" field.
+func (lcuo *LeaseContractUpdateOne) AddLesseeID(i int64) *LeaseContractUpdateOne {
+	lcuo.mutation.AddLesseeID(i)
+	return lcuo
+}
+
+// ClearLesseeID clears the value of the "lessee_id" field.
+func (lcuo *LeaseContractUpdateOne) ClearLesseeID() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearLesseeID()
+	return lcuo
+}
+
+// SetStartDate sets the "start_date" field.
+func (lcuo *LeaseContractUpdateOne) SetStartDate(t time.Time) *LeaseContractUpdateOne {
+	lcuo.mutation.SetStartDate(t)
+	return lcuo
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableStartDate(t *time.Time) *LeaseContractUpdateOne {
+	if t != nil {
+		lcuo.SetStartDate(*t)
+	}
+	return lcuo
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (lcuo *LeaseContractUpdateOne) ClearStartDate() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearStartDate()
+	return lcuo
+}
+
+// SetEndDate sets the "end_date" field.
+func (lcuo *LeaseContractUpdateOne) SetEndDate(t time.Time) *LeaseContractUpdateOne {
+	lcuo.mutation.SetEndDate(t)
+	return lcuo
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableEndDate(t *time.Time) *LeaseContractUpdateOne {
+	if t != nil {
+		lcuo.SetEndDate(*t)
+	}
+	return lcuo
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (lcuo *LeaseContractUpdateOne) ClearEndDate() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearEndDate()
+	return lcuo
+}
+
+// SetMonthlyPayment sets the "monthly_payment" field.
+func (lcuo *LeaseContractUpdateOne) SetMonthlyPayment(f float64) *LeaseContractUpdateOne {
+	lcuo.mutation.ResetMonthlyPayment()
+	lcuo.mutation.SetMonthlyPayment(f)
+	return lcuo
+}
+
+// SetNillableMonthlyPayment sets the "monthly_payment" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableMonthlyPayment(f *float64) *LeaseContractUpdateOne {
+	if f != nil {
+		lcuo.SetMonthlyPayment(*f)
+	}
+	return lcuo
+}
+
+// AddMonthlyPayment adds f to the "monthly_payment" field.
+func (lcuo *LeaseContractUpdateOne) AddMonthlyPayment(f float64) *LeaseContractUpdateOne {
+	lcuo.mutation.AddMonthlyPayment(f)
+	return lcuo
+}
+
+// ClearMonthlyPayment clears the value of the "monthly_payment" field.
+func (lcuo *LeaseContractUpdateOne) ClearMonthlyPayment() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearMonthlyPayment()
+	return lcuo
+}
+
+// SetMileageAllowance sets the "mileage_allowance" field.
+func (lcuo *LeaseContractUpdateOne) SetMileageAllowance(i int64) *LeaseContractUpdateOne {
+	lcuo.mutation.ResetMileageAllowance()
+	lcuo.mutation.SetMileageAllowance(i)
+	return lcuo
+}
+
+// SetNillableMileageAllowance sets the "mileage_allowance" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableMileageAllowance(i *int64) *LeaseContractUpdateOne {
+	if i != nil {
+		lcuo.SetMileageAllowance(*i)
+	}
+	return lcuo
+}
+
+// AddMileageAllowance adds i to the "mileage_allowance" field.
+func (lcuo *LeaseContractUpdateOne) AddMileageAllowance(i int64) *LeaseContractUpdateOne {
+	lcuo.mutation.AddMileageAllowance(i)
+	return lcuo
+}
+
+// ClearMileageAllowance clears the value of the "mileage_allowance" field.
+func (lcuo *LeaseContractUpdateOne) ClearMileageAllowance() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearMileageAllowance()
+	return lcuo
+}
+
+// SetExcessRate sets the "excess_rate" field.
+func (lcuo *LeaseContractUpdateOne) SetExcessRate(f float64) *LeaseContractUpdateOne {
+	lcuo.mutation.ResetExcessRate()
+	lcuo.mutation.SetExcessRate(f)
+	return lcuo
+}
+
+// SetNillableExcessRate sets the "excess_rate" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableExcessRate(f *float64) *LeaseContractUpdateOne {
+	if f != nil {
+		lcuo.SetExcessRate(*f)
+	}
+	return lcuo
+}
+
+// AddExcessRate adds f to the "excess_rate" field.
+func (lcuo *LeaseContractUpdateOne) AddExcessRate(f float64) *LeaseContractUpdateOne {
+	lcuo.mutation.AddExcessRate(f)
+	return lcuo
+}
+
+// ClearExcessRate clears the value of the "excess_rate" field.
+func (lcuo *LeaseContractUpdateOne) ClearExcessRate() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearExcessRate()
+	return lcuo
+}
+
+// SetStartMileage sets the "start_mileage" field.
+func (lcuo *LeaseContractUpdateOne) SetStartMileage(i int64) *LeaseContractUpdateOne {
+	lcuo.mutation.ResetStartMileage()
+	lcuo.mutation.SetStartMileage(i)
+	return lcuo
+}
+
+// SetNillableStartMileage sets the "start_mileage" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableStartMileage(i *int64) *LeaseContractUpdateOne {
+	if i != nil {
+		lcuo.SetStartMileage(*i)
+	}
+	return lcuo
+}
+
+// AddStartMileage adds i to the "start_mileage" field.
+func (lcuo *LeaseContractUpdateOne) AddStartMileage(i int64) *LeaseContractUpdateOne {
+	lcuo.mutation.AddStartMileage(i)
+	return lcuo
+}
+
+// ClearStartMileage clears the value of the "start_mileage" field.
+func (lcuo *LeaseContractUpdateOne) ClearStartMileage() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearStartMileage()
+	return lcuo
+}
+
+// SetTransferAllowed sets the "transfer_allowed" field.
+func (lcuo *LeaseContractUpdateOne) SetTransferAllowed(b bool) *LeaseContractUpdateOne {
+	lcuo.mutation.SetTransferAllowed(b)
+	return lcuo
+}
+
+// SetNillableTransferAllowed sets the "transfer_allowed" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableTransferAllowed(b *bool) *LeaseContractUpdateOne {
+	if b != nil {
+		lcuo.SetTransferAllowed(*b)
+	}
+	return lcuo
+}
+
+// SetStatus sets the "status" field.
+func (lcuo *LeaseContractUpdateOne) SetStatus(s string) *LeaseContractUpdateOne {
+	lcuo.mutation.SetStatus(s)
+	return lcuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableStatus(s *string) *LeaseContractUpdateOne {
+	if s != nil {
+		lcuo.SetStatus(*s)
+	}
+	return lcuo
+}
+
+// SetTerminatedAt sets the "terminated_at" field.
+func (lcuo *LeaseContractUpdateOne) SetTerminatedAt(t time.Time) *LeaseContractUpdateOne {
+	lcuo.mutation.SetTerminatedAt(t)
+	return lcuo
+}
+
+// SetNillableTerminatedAt sets the "terminated_at" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableTerminatedAt(t *time.Time) *LeaseContractUpdateOne {
+	if t != nil {
+		lcuo.SetTerminatedAt(*t)
+	}
+	return lcuo
+}
+
+// ClearTerminatedAt clears the value of the "terminated_at" field.
+func (lcuo *LeaseContractUpdateOne) ClearTerminatedAt() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearTerminatedAt()
+	return lcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lcuo *LeaseContractUpdateOne) SetCreatedAt(t time.Time) *LeaseContractUpdateOne {
+	lcuo.mutation.SetCreatedAt(t)
+	return lcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lcuo *LeaseContractUpdateOne) SetNillableCreatedAt(t *time.Time) *LeaseContractUpdateOne {
+	if t != nil {
+		lcuo.SetCreatedAt(*t)
+	}
+	return lcuo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (lcuo *LeaseContractUpdateOne) SetCar(c *Car) *LeaseContractUpdateOne {
+	return lcuo.SetCarID(c.ID)
+}
+
+// Mutation returns the LeaseContractMutation object of the builder.
+func (lcuo *LeaseContractUpdateOne) Mutation() *LeaseContractMutation {
+	return lcuo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (lcuo *LeaseContractUpdateOne) ClearCar() *LeaseContractUpdateOne {
+	lcuo.mutation.ClearCar()
+	return lcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lcuo *LeaseContractUpdateOne) Select(field string, fields ...string) *LeaseContractUpdateOne {
+	lcuo.fields = append([]string{field}, fields...)
+	return lcuo
+}
+
+// Save executes the query and returns the updated LeaseContract entity.
+func (lcuo *LeaseContractUpdateOne) Save(ctx context.Context) (*LeaseContract, error) {
+	var (
+		err  error
+		node *LeaseContract
+	)
+	if len(lcuo.hooks) == 0 {
+		node, err = lcuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeaseContractMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lcuo.mutation = mutation
+			node, err = lcuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lcuo.hooks) - 1; i >= 0; i-- {
+			if lcuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lcuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, lcuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*LeaseContract)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from LeaseContractMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcuo *LeaseContractUpdateOne) SaveX(ctx context.Context) *LeaseContract {
+	node, err := lcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lcuo *LeaseContractUpdateOne) Exec(ctx context.Context) error {
+	_, err := lcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcuo *LeaseContractUpdateOne) ExecX(ctx context.Context) {
+	if err := lcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lcuo *LeaseContractUpdateOne) sqlSave(ctx context.Context) (_node *LeaseContract, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   leasecontract.Table,
+			Columns: leasecontract.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: leasecontract.FieldID,
+			},
+		},
+	}
+	id, ok := lcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeaseContract.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leasecontract.FieldID)
+		for _, f := range fields {
+			if !leasecontract.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leasecontract.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lcuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldTenantID,
+		})
+	}
+	if value, ok := lcuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldTenantID,
+		})
+	}
+	if lcuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: leasecontract.FieldTenantID,
+		})
+	}
+	if value, ok := lcuo.mutation.Lessor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: leasecontract.FieldLessor,
+		})
+	}
+	if lcuo.mutation.LessorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: leasecontract.FieldLessor,
+		})
+	}
+	if value, ok := lcuo.mutation.ContractNo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: leasecontract.FieldContractNo,
+		})
+	}
+	if lcuo.mutation.ContractNoCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: leasecontract.FieldContractNo,
+		})
+	}
+	if value, ok := lcuo.mutation.LesseeID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldLesseeID,
+		})
+	}
+	if value, ok := lcuo.mutation.AddedLesseeID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldLesseeID,
+		})
+	}
+	if lcuo.mutation.LesseeIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: leasecontract.FieldLesseeID,
+		})
+	}
+	if value, ok := lcuo.mutation.StartDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldStartDate,
+		})
+	}
+	if lcuo.mutation.StartDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: leasecontract.FieldStartDate,
+		})
+	}
+	if value, ok := lcuo.mutation.EndDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldEndDate,
+		})
+	}
+	if lcuo.mutation.EndDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: leasecontract.FieldEndDate,
+		})
+	}
+	if value, ok := lcuo.mutation.MonthlyPayment(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldMonthlyPayment,
+		})
+	}
+	if value, ok := lcuo.mutation.AddedMonthlyPayment(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldMonthlyPayment,
+		})
+	}
+	if lcuo.mutation.MonthlyPaymentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: leasecontract.FieldMonthlyPayment,
+		})
+	}
+	if value, ok := lcuo.mutation.MileageAllowance(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldMileageAllowance,
+		})
+	}
+	if value, ok := lcuo.mutation.AddedMileageAllowance(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldMileageAllowance,
+		})
+	}
+	if lcuo.mutation.MileageAllowanceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: leasecontract.FieldMileageAllowance,
+		})
+	}
+	if value, ok := lcuo.mutation.ExcessRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldExcessRate,
+		})
+	}
+	if value, ok := lcuo.mutation.AddedExcessRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: leasecontract.FieldExcessRate,
+		})
+	}
+	if lcuo.mutation.ExcessRateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: leasecontract.FieldExcessRate,
+		})
+	}
+	if value, ok := lcuo.mutation.StartMileage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldStartMileage,
+		})
+	}
+	if value, ok := lcuo.mutation.AddedStartMileage(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: leasecontract.FieldStartMileage,
+		})
+	}
+	if lcuo.mutation.StartMileageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: leasecontract.FieldStartMileage,
+		})
+	}
+	if value, ok := lcuo.mutation.TransferAllowed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: leasecontract.FieldTransferAllowed,
+		})
+	}
+	if value, ok := lcuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: leasecontract.FieldStatus,
+		})
+	}
+	if value, ok := lcuo.mutation.TerminatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldTerminatedAt,
+		})
+	}
+	if lcuo.mutation.TerminatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: leasecontract.FieldTerminatedAt,
+		})
+	}
+	if value, ok := lcuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leasecontract.FieldCreatedAt,
+		})
+	}
+	if lcuo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leasecontract.CarTable,
+			Columns: []string{leasecontract.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lcuo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leasecontract.CarTable,
+			Columns: []string{leasecontract.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LeaseContract{config: lcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leasecontract.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
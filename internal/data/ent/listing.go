@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/listing"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Listing is the model entity for the Listing schema.
+type Listing struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// SellerID holds the value of the "seller_id" field.
+	SellerID int64 `json:"seller_id,omitempty"`
+	// Price holds the value of the "price" field.
+	Price float64 `json:"price,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// BuyerID holds the value of the "buyer_id" field.
+	BuyerID int64 `json:"buyer_id,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ListingQuery when eager-loading is set.
+	Edges ListingEdges `json:"edges"`
+}
+
+// ListingEdges holds the relations/edges for other nodes in the graph.
+type ListingEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Listing) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case listing.FieldPrice:
+			values[i] = new(sql.NullFloat64)
+		case listing.FieldID, listing.FieldTenantID, listing.FieldCarID, listing.FieldSellerID, listing.FieldBuyerID:
+			values[i] = new(sql.NullInt64)
+		case listing.FieldDescription, listing.FieldStatus:
+			values[i] = new(sql.NullString)
+		case listing.FieldExpiresAt, listing.FieldClosedAt, listing.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Listing", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Listing fields.
+func (l *Listing) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case listing.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			l.ID = int64(value.Int64)
+		case listing.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				l.TenantID = value.Int64
+			}
+		case listing.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				l.CarID = value.Int64
+			}
+		case listing.FieldSellerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_id", values[i])
+			} else if value.Valid {
+				l.SellerID = value.Int64
+			}
+		case listing.FieldPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				l.Price = value.Float64
+			}
+		case listing.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				l.Description = value.String
+			}
+		case listing.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				l.Status = value.String
+			}
+		case listing.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				l.ExpiresAt = value.Time
+			}
+		case listing.FieldBuyerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_id", values[i])
+			} else if value.Valid {
+				l.BuyerID = value.Int64
+			}
+		case listing.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				l.ClosedAt = new(time.Time)
+				*l.ClosedAt = value.Time
+			}
+		case listing.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				l.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the Listing entity.
+func (l *Listing) QueryCar() *CarQuery {
+	return (&ListingClient{config: l.config}).QueryCar(l)
+}
+
+// Update returns a builder for updating this Listing.
+// Note that you need to call Listing.Unwrap() before calling this method if this Listing
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Listing) Update() *ListingUpdateOne {
+	return (&ListingClient{config: l.config}).UpdateOne(l)
+}
+
+// Unwrap unwraps the Listing entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Listing) Unwrap() *Listing {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Listing is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Listing) String() string {
+	var builder strings.Builder
+	builder.WriteString("Listing(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", l.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", l.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("seller_id=")
+	builder.WriteString(fmt.Sprintf("%v", l.SellerID))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", l.Price))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(l.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(l.Status)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(l.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("buyer_id=")
+	builder.WriteString(fmt.Sprintf("%v", l.BuyerID))
+	builder.WriteString(", ")
+	if v := l.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(l.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Listings is a parsable slice of Listing.
+type Listings []*Listing
+
+func (l Listings) config(cfg config) {
+	for _i := range l {
+		l[_i].config = cfg
+	}
+}
@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/listing"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ListingCreate is the builder for creating a Listing entity.
+type ListingCreate struct {
+	config
+	mutation *ListingMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (lc *ListingCreate) SetTenantID(i int64) *ListingCreate {
+	lc.mutation.SetTenantID(i)
+	return lc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableTenantID(i *int64) *ListingCreate {
+	if i != nil {
+		lc.SetTenantID(*i)
+	}
+	return lc
+}
+
+// SetCarID sets the "car_id" field.
+func (lc *ListingCreate) SetCarID(i int64) *ListingCreate {
+	lc.mutation.SetCarID(i)
+	return lc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableCarID(i *int64) *ListingCreate {
+	if i != nil {
+		lc.SetCarID(*i)
+	}
+	return lc
+}
+
+// SetSellerID sets the "seller_id" field.
+func (lc *ListingCreate) SetSellerID(i int64) *ListingCreate {
+	lc.mutation.SetSellerID(i)
+	return lc
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableSellerID(i *int64) *ListingCreate {
+	if i != nil {
+		lc.SetSellerID(*i)
+	}
+	return lc
+}
+
+// SetPrice sets the "price" field.
+func (lc *ListingCreate) SetPrice(f float64) *ListingCreate {
+	lc.mutation.SetPrice(f)
+	return lc
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (lc *ListingCreate) SetNillablePrice(f *float64) *ListingCreate {
+	if f != nil {
+		lc.SetPrice(*f)
+	}
+	return lc
+}
+
+// SetDescription sets the "description" field.
+func (lc *ListingCreate) SetDescription(s string) *ListingCreate {
+	lc.mutation.SetDescription(s)
+	return lc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableDescription(s *string) *ListingCreate {
+	if s != nil {
+		lc.SetDescription(*s)
+	}
+	return lc
+}
+
+// SetStatus sets the "status" field.
+func (lc *ListingCreate) SetStatus(s string) *ListingCreate {
+	lc.mutation.SetStatus(s)
+	return lc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableStatus(s *string) *ListingCreate {
+	if s != nil {
+		lc.SetStatus(*s)
+	}
+	return lc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (lc *ListingCreate) SetExpiresAt(t time.Time) *ListingCreate {
+	lc.mutation.SetExpiresAt(t)
+	return lc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableExpiresAt(t *time.Time) *ListingCreate {
+	if t != nil {
+		lc.SetExpiresAt(*t)
+	}
+	return lc
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (lc *ListingCreate) SetBuyerID(i int64) *ListingCreate {
+	lc.mutation.SetBuyerID(i)
+	return lc
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableBuyerID(i *int64) *ListingCreate {
+	if i != nil {
+		lc.SetBuyerID(*i)
+	}
+	return lc
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (lc *ListingCreate) SetClosedAt(t time.Time) *ListingCreate {
+	lc.mutation.SetClosedAt(t)
+	return lc
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableClosedAt(t *time.Time) *ListingCreate {
+	if t != nil {
+		lc.SetClosedAt(*t)
+	}
+	return lc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lc *ListingCreate) SetCreatedAt(t time.Time) *ListingCreate {
+	lc.mutation.SetCreatedAt(t)
+	return lc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableCreatedAt(t *time.Time) *ListingCreate {
+	if t != nil {
+		lc.SetCreatedAt(*t)
+	}
+	return lc
+}
+
+// SetID sets the "id" field.
+func (lc *ListingCreate) SetID(i int64) *ListingCreate {
+	lc.mutation.SetID(i)
+	return lc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (lc *ListingCreate) SetCar(c *Car) *ListingCreate {
+	return lc.SetCarID(c.ID)
+}
+
+// Mutation returns the ListingMutation object of the builder.
+func (lc *ListingCreate) Mutation() *ListingMutation {
+	return lc.mutation
+}
+
+// Save creates the Listing in the database.
+func (lc *ListingCreate) Save(ctx context.Context) (*Listing, error) {
+	var (
+		err  error
+		node *Listing
+	)
+	if err := lc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(lc.hooks) == 0 {
+		if err = lc.check(); err != nil {
+			return nil, err
+		}
+		node, err = lc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ListingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lc.check(); err != nil {
+				return nil, err
+			}
+			lc.mutation = mutation
+			if node, err = lc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lc.hooks) - 1; i >= 0; i-- {
+			if lc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, lc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Listing)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ListingMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *ListingCreate) SaveX(ctx context.Context) *Listing {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lc *ListingCreate) Exec(ctx context.Context) error {
+	_, err := lc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lc *ListingCreate) ExecX(ctx context.Context) {
+	if err := lc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lc *ListingCreate) defaults() error {
+	if _, ok := lc.mutation.Status(); !ok {
+		v := listing.DefaultStatus
+		lc.mutation.SetStatus(v)
+	}
+	if _, ok := lc.mutation.CreatedAt(); !ok {
+		if listing.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized listing.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := listing.DefaultCreatedAt()
+		lc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *ListingCreate) check() error {
+	if _, ok := lc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Listing.status"`)}
+	}
+	if _, ok := lc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Listing.created_at"`)}
+	}
+	return nil
+}
+
+func (lc *ListingCreate) sqlSave(ctx context.Context) (*Listing, error) {
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (lc *ListingCreate) createSpec() (*Listing, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Listing{config: lc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: listing.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: listing.FieldID,
+			},
+		}
+	)
+	if id, ok := lc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := lc.mutation.SellerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldSellerID,
+		})
+		_node.SellerID = value
+	}
+	if value, ok := lc.mutation.Price(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: listing.FieldPrice,
+		})
+		_node.Price = value
+	}
+	if value, ok := lc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: listing.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := lc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: listing.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := lc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: listing.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := lc.mutation.BuyerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldBuyerID,
+		})
+		_node.BuyerID = value
+	}
+	if value, ok := lc.mutation.ClosedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: listing.FieldClosedAt,
+		})
+		_node.ClosedAt = &value
+	}
+	if value, ok := lc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: listing.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := lc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.CarTable,
+			Columns: []string{listing.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ListingCreateBulk is the builder for creating many Listing entities in bulk.
+type ListingCreateBulk struct {
+	config
+	builders []*ListingCreate
+}
+
+// Save creates the Listing entities in the database.
+func (lcb *ListingCreateBulk) Save(ctx context.Context) ([]*Listing, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*Listing, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ListingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *ListingCreateBulk) SaveX(ctx context.Context) []*Listing {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcb *ListingCreateBulk) Exec(ctx context.Context) error {
+	_, err := lcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcb *ListingCreateBulk) ExecX(ctx context.Context) {
+	if err := lcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
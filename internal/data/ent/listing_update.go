@@ -0,0 +1,1037 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ListingUpdate is the builder for updating Listing entities.
+type ListingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ListingMutation
+}
+
+// Where appends a list predicates to the ListingUpdate builder.
+func (lu *ListingUpdate) Where(ps ...predicate.Listing) *ListingUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (lu *ListingUpdate) SetTenantID(i int64) *ListingUpdate {
+	lu.mutation.ResetTenantID()
+	lu.mutation.SetTenantID(i)
+	return lu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableTenantID(i *int64) *ListingUpdate {
+	if i != nil {
+		lu.SetTenantID(*i)
+	}
+	return lu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (lu *ListingUpdate) AddTenantID(i int64) *ListingUpdate {
+	lu.mutation.AddTenantID(i)
+	return lu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (lu *ListingUpdate) ClearTenantID() *ListingUpdate {
+	lu.mutation.ClearTenantID()
+	return lu
+}
+
+// SetCarID sets the "car_id" field.
+func (lu *ListingUpdate) SetCarID(i int64) *ListingUpdate {
+	lu.mutation.SetCarID(i)
+	return lu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableCarID(i *int64) *ListingUpdate {
+	if i != nil {
+		lu.SetCarID(*i)
+	}
+	return lu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (lu *ListingUpdate) ClearCarID() *ListingUpdate {
+	lu.mutation.ClearCarID()
+	return lu
+}
+
+// SetSellerID sets the "seller_id" field.
+func (lu *ListingUpdate) SetSellerID(i int64) *ListingUpdate {
+	lu.mutation.ResetSellerID()
+	lu.mutation.SetSellerID(i)
+	return lu
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableSellerID(i *int64) *ListingUpdate {
+	if i != nil {
+		lu.SetSellerID(*i)
+	}
+	return lu
+}
+
+// AddSellerID adds i to the "seller_id" field.
+func (lu *ListingUpdate) AddSellerID(i int64) *ListingUpdate {
+	lu.mutation.AddSellerID(i)
+	return lu
+}
+
+// ClearSellerID clears the value of the "seller_id" field.
+func (lu *ListingUpdate) ClearSellerID() *ListingUpdate {
+	lu.mutation.ClearSellerID()
+	return lu
+}
+
+// SetPrice sets the "price" field.
+func (lu *ListingUpdate) SetPrice(f float64) *ListingUpdate {
+	lu.mutation.ResetPrice()
+	lu.mutation.SetPrice(f)
+	return lu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillablePrice(f *float64) *ListingUpdate {
+	if f != nil {
+		lu.SetPrice(*f)
+	}
+	return lu
+}
+
+// AddPrice adds f to the "price" field.
+func (lu *ListingUpdate) AddPrice(f float64) *ListingUpdate {
+	lu.mutation.AddPrice(f)
+	return lu
+}
+
+// ClearPrice clears the value of the "price" field.
+func (lu *ListingUpdate) ClearPrice() *ListingUpdate {
+	lu.mutation.ClearPrice()
+	return lu
+}
+
+// SetDescription sets the "description" field.
+func (lu *ListingUpdate) SetDescription(s string) *ListingUpdate {
+	lu.mutation.SetDescription(s)
+	return lu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableDescription(s *string) *ListingUpdate {
+	if s != nil {
+		lu.SetDescription(*s)
+	}
+	return lu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (lu *ListingUpdate) ClearDescription() *ListingUpdate {
+	lu.mutation.ClearDescription()
+	return lu
+}
+
+// SetStatus sets the "status" field.
+func (lu *ListingUpdate) SetStatus(s string) *ListingUpdate {
+	lu.mutation.SetStatus(s)
+	return lu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableStatus(s *string) *ListingUpdate {
+	if s != nil {
+		lu.SetStatus(*s)
+	}
+	return lu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (lu *ListingUpdate) SetExpiresAt(t time.Time) *ListingUpdate {
+	lu.mutation.SetExpiresAt(t)
+	return lu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableExpiresAt(t *time.Time) *ListingUpdate {
+	if t != nil {
+		lu.SetExpiresAt(*t)
+	}
+	return lu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (lu *ListingUpdate) ClearExpiresAt() *ListingUpdate {
+	lu.mutation.ClearExpiresAt()
+	return lu
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (lu *ListingUpdate) SetBuyerID(i int64) *ListingUpdate {
+	lu.mutation.ResetBuyerID()
+	lu.mutation.SetBuyerID(i)
+	return lu
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableBuyerID(i *int64) *ListingUpdate {
+	if i != nil {
+		lu.SetBuyerID(*i)
+	}
+	return lu
+}
+
+// AddBuyerID adds i to the "buyer_id" field.
+func (lu *ListingUpdate) AddBuyerID(i int64) *ListingUpdate {
+	lu.mutation.AddBuyerID(i)
+	return lu
+}
+
+// ClearBuyerID clears the value of the "buyer_id" field.
+func (lu *ListingUpdate) ClearBuyerID() *ListingUpdate {
+	lu.mutation.ClearBuyerID()
+	return lu
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (lu *ListingUpdate) SetClosedAt(t time.Time) *ListingUpdate {
+	lu.mutation.SetClosedAt(t)
+	return lu
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableClosedAt(t *time.Time) *ListingUpdate {
+	if t != nil {
+		lu.SetClosedAt(*t)
+	}
+	return lu
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (lu *ListingUpdate) ClearClosedAt() *ListingUpdate {
+	lu.mutation.ClearClosedAt()
+	return lu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lu *ListingUpdate) SetCreatedAt(t time.Time) *ListingUpdate {
+	lu.mutation.SetCreatedAt(t)
+	return lu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableCreatedAt(t *time.Time) *ListingUpdate {
+	if t != nil {
+		lu.SetCreatedAt(*t)
+	}
+	return lu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (lu *ListingUpdate) SetCar(c *Car) *ListingUpdate {
+	return lu.SetCarID(c.ID)
+}
+
+// Mutation returns the ListingMutation object of the builder.
+func (lu *ListingUpdate) Mutation() *ListingMutation {
+	return lu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (lu *ListingUpdate) ClearCar() *ListingUpdate {
+	lu.mutation.ClearCar()
+	return lu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *ListingUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(lu.hooks) == 0 {
+		affected, err = lu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ListingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lu.mutation = mutation
+			affected, err = lu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lu.hooks) - 1; i >= 0; i-- {
+			if lu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *ListingUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *ListingUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *ListingUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lu *ListingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   listing.Table,
+			Columns: listing.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: listing.FieldID,
+			},
+		},
+	}
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldTenantID,
+		})
+	}
+	if value, ok := lu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldTenantID,
+		})
+	}
+	if lu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: listing.FieldTenantID,
+		})
+	}
+	if value, ok := lu.mutation.SellerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldSellerID,
+		})
+	}
+	if value, ok := lu.mutation.AddedSellerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldSellerID,
+		})
+	}
+	if lu.mutation.SellerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: listing.FieldSellerID,
+		})
+	}
+	if value, ok := lu.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: listing.FieldPrice,
+		})
+	}
+	if value, ok := lu.mutation.AddedPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: listing.FieldPrice,
+		})
+	}
+	if lu.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: listing.FieldPrice,
+		})
+	}
+	if value, ok := lu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: listing.FieldDescription,
+		})
+	}
+	if lu.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: listing.FieldDescription,
+		})
+	}
+	if value, ok := lu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: listing.FieldStatus,
+		})
+	}
+	if value, ok := lu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: listing.FieldExpiresAt,
+		})
+	}
+	if lu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: listing.FieldExpiresAt,
+		})
+	}
+	if value, ok := lu.mutation.BuyerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldBuyerID,
+		})
+	}
+	if value, ok := lu.mutation.AddedBuyerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldBuyerID,
+		})
+	}
+	if lu.mutation.BuyerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: listing.FieldBuyerID,
+		})
+	}
+	if value, ok := lu.mutation.ClosedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: listing.FieldClosedAt,
+		})
+	}
+	if lu.mutation.ClosedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: listing.FieldClosedAt,
+		})
+	}
+	if value, ok := lu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: listing.FieldCreatedAt,
+		})
+	}
+	if lu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.CarTable,
+			Columns: []string{listing.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.CarTable,
+			Columns: []string{listing.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listing.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ListingUpdateOne is the builder for updating a single Listing entity.
+type ListingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ListingMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (luo *ListingUpdateOne) SetTenantID(i int64) *ListingUpdateOne {
+	luo.mutation.ResetTenantID()
+	luo.mutation.SetTenantID(i)
+	return luo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableTenantID(i *int64) *ListingUpdateOne {
+	if i != nil {
+		luo.SetTenantID(*i)
+	}
+	return luo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (luo *ListingUpdateOne) AddTenantID(i int64) *ListingUpdateOne {
+	luo.mutation.AddTenantID(i)
+	return luo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (luo *ListingUpdateOne) ClearTenantID() *ListingUpdateOne {
+	luo.mutation.ClearTenantID()
+	return luo
+}
+
+// SetCarID sets the "car_id" field.
+func (luo *ListingUpdateOne) SetCarID(i int64) *ListingUpdateOne {
+	luo.mutation.SetCarID(i)
+	return luo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableCarID(i *int64) *ListingUpdateOne {
+	if i != nil {
+		luo.SetCarID(*i)
+	}
+	return luo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (luo *ListingUpdateOne) ClearCarID() *ListingUpdateOne {
+	luo.mutation.ClearCarID()
+	return luo
+}
+
+// SetSellerID sets the "seller_id" field.
+func (luo *ListingUpdateOne) SetSellerID(i int64) *ListingUpdateOne {
+	luo.mutation.ResetSellerID()
+	luo.mutation.SetSellerID(i)
+	return luo
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableSellerID(i *int64) *ListingUpdateOne {
+	if i != nil {
+		luo.SetSellerID(*i)
+	}
+	return luo
+}
+
+// AddSellerID adds i to the "seller_id" field.
+func (luo *ListingUpdateOne) AddSellerID(i int64) *ListingUpdateOne {
+	luo.mutation.AddSellerID(i)
+	return luo
+}
+
+// ClearSellerID clears the value of the "seller_id" field.
+func (luo *ListingUpdateOne) ClearSellerID() *ListingUpdateOne {
+	luo.mutation.ClearSellerID()
+	return luo
+}
+
+// SetPrice sets the "price" field.
+func (luo *ListingUpdateOne) SetPrice(f float64) *ListingUpdateOne {
+	luo.mutation.ResetPrice()
+	luo.mutation.SetPrice(f)
+	return luo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillablePrice(f *float64) *ListingUpdateOne {
+	if f != nil {
+		luo.SetPrice(*f)
+	}
+	return luo
+}
+
+// AddPrice adds f to the "price" field.
+func (luo *ListingUpdateOne) AddPrice(f float64) *ListingUpdateOne {
+	luo.mutation.AddPrice(f)
+	return luo
+}
+
+// ClearPrice clears the value of the "price" field.
+func (luo *ListingUpdateOne) ClearPrice() *ListingUpdateOne {
+	luo.mutation.ClearPrice()
+	return luo
+}
+
+// SetDescription sets the "description" field.
+func (luo *ListingUpdateOne) SetDescription(s string) *ListingUpdateOne {
+	luo.mutation.SetDescription(s)
+	return luo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableDescription(s *string) *ListingUpdateOne {
+	if s != nil {
+		luo.SetDescription(*s)
+	}
+	return luo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (luo *ListingUpdateOne) ClearDescription() *ListingUpdateOne {
+	luo.mutation.ClearDescription()
+	return luo
+}
+
+// SetStatus sets the "status" field.
+func (luo *ListingUpdateOne) SetStatus(s string) *ListingUpdateOne {
+	luo.mutation.SetStatus(s)
+	return luo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableStatus(s *string) *ListingUpdateOne {
+	if s != nil {
+		luo.SetStatus(*s)
+	}
+	return luo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (luo *ListingUpdateOne) SetExpiresAt(t time.Time) *ListingUpdateOne {
+	luo.mutation.SetExpiresAt(t)
+	return luo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableExpiresAt(t *time.Time) *ListingUpdateOne {
+	if t != nil {
+		luo.SetExpiresAt(*t)
+	}
+	return luo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (luo *ListingUpdateOne) ClearExpiresAt() *ListingUpdateOne {
+	luo.mutation.ClearExpiresAt()
+	return luo
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (luo *ListingUpdateOne) SetBuyerID(i int64) *ListingUpdateOne {
+	luo.mutation.ResetBuyerID()
+	luo.mutation.SetBuyerID(i)
+	return luo
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableBuyerID(i *int64) *ListingUpdateOne {
+	if i != nil {
+		luo.SetBuyerID(*i)
+	}
+	return luo
+}
+
+// AddBuyerID adds i to the "buyer_id" field.
+func (luo *ListingUpdateOne) AddBuyerID(i int64) *ListingUpdateOne {
+	luo.mutation.AddBuyerID(i)
+	return luo
+}
+
+// ClearBuyerID clears the value of the "buyer_id" field.
+func (luo *ListingUpdateOne) ClearBuyerID() *ListingUpdateOne {
+	luo.mutation.ClearBuyerID()
+	return luo
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (luo *ListingUpdateOne) SetClosedAt(t time.Time) *ListingUpdateOne {
+	luo.mutation.SetClosedAt(t)
+	return luo
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableClosedAt(t *time.Time) *ListingUpdateOne {
+	if t != nil {
+		luo.SetClosedAt(*t)
+	}
+	return luo
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (luo *ListingUpdateOne) ClearClosedAt() *ListingUpdateOne {
+	luo.mutation.ClearClosedAt()
+	return luo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (luo *ListingUpdateOne) SetCreatedAt(t time.Time) *ListingUpdateOne {
+	luo.mutation.SetCreatedAt(t)
+	return luo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableCreatedAt(t *time.Time) *ListingUpdateOne {
+	if t != nil {
+		luo.SetCreatedAt(*t)
+	}
+	return luo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (luo *ListingUpdateOne) SetCar(c *Car) *ListingUpdateOne {
+	return luo.SetCarID(c.ID)
+}
+
+// Mutation returns the ListingMutation object of the builder.
+func (luo *ListingUpdateOne) Mutation() *ListingMutation {
+	return luo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (luo *ListingUpdateOne) ClearCar() *ListingUpdateOne {
+	luo.mutation.ClearCar()
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *ListingUpdateOne) Select(field string, fields ...string) *ListingUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Listing entity.
+func (luo *ListingUpdateOne) Save(ctx context.Context) (*Listing, error) {
+	var (
+		err  error
+		node *Listing
+	)
+	if len(luo.hooks) == 0 {
+		node, err = luo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ListingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			luo.mutation = mutation
+			node, err = luo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(luo.hooks) - 1; i >= 0; i-- {
+			if luo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = luo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, luo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Listing)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ListingMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *ListingUpdateOne) SaveX(ctx context.Context) *Listing {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *ListingUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *ListingUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (luo *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   listing.Table,
+			Columns: listing.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: listing.FieldID,
+			},
+		},
+	}
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Listing.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, listing.FieldID)
+		for _, f := range fields {
+			if !listing.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != listing.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldTenantID,
+		})
+	}
+	if value, ok := luo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldTenantID,
+		})
+	}
+	if luo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: listing.FieldTenantID,
+		})
+	}
+	if value, ok := luo.mutation.SellerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldSellerID,
+		})
+	}
+	if value, ok := luo.mutation.AddedSellerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldSellerID,
+		})
+	}
+	if luo.mutation.SellerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: listing.FieldSellerID,
+		})
+	}
+	if value, ok := luo.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: listing.FieldPrice,
+		})
+	}
+	if value, ok := luo.mutation.AddedPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: listing.FieldPrice,
+		})
+	}
+	if luo.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: listing.FieldPrice,
+		})
+	}
+	if value, ok := luo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: listing.FieldDescription,
+		})
+	}
+	if luo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: listing.FieldDescription,
+		})
+	}
+	if value, ok := luo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: listing.FieldStatus,
+		})
+	}
+	if value, ok := luo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: listing.FieldExpiresAt,
+		})
+	}
+	if luo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: listing.FieldExpiresAt,
+		})
+	}
+	if value, ok := luo.mutation.BuyerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldBuyerID,
+		})
+	}
+	if value, ok := luo.mutation.AddedBuyerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: listing.FieldBuyerID,
+		})
+	}
+	if luo.mutation.BuyerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: listing.FieldBuyerID,
+		})
+	}
+	if value, ok := luo.mutation.ClosedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: listing.FieldClosedAt,
+		})
+	}
+	if luo.mutation.ClosedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: listing.FieldClosedAt,
+		})
+	}
+	if value, ok := luo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: listing.FieldCreatedAt,
+		})
+	}
+	if luo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.CarTable,
+			Columns: []string{listing.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.CarTable,
+			Columns: []string{listing.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Listing{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listing.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/maintenancerecord"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// MaintenanceRecord is the model entity for the MaintenanceRecord schema.
+type MaintenanceRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// ServicedAt holds the value of the "serviced_at" field.
+	ServicedAt time.Time `json:"serviced_at,omitempty"`
+	// Mileage holds the value of the "mileage" field.
+	Mileage int64 `json:"mileage,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Cost holds the value of the "cost" field.
+	Cost float64 `json:"cost,omitempty"`
+	// Shop holds the value of the "shop" field.
+	Shop string `json:"shop,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MaintenanceRecordQuery when eager-loading is set.
+	Edges MaintenanceRecordEdges `json:"edges"`
+}
+
+// MaintenanceRecordEdges holds the relations/edges for other nodes in the graph.
+type MaintenanceRecordEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MaintenanceRecordEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MaintenanceRecord) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case maintenancerecord.FieldCost:
+			values[i] = new(sql.NullFloat64)
+		case maintenancerecord.FieldID, maintenancerecord.FieldTenantID, maintenancerecord.FieldCarID, maintenancerecord.FieldMileage:
+			values[i] = new(sql.NullInt64)
+		case maintenancerecord.FieldType, maintenancerecord.FieldShop, maintenancerecord.FieldNotes:
+			values[i] = new(sql.NullString)
+		case maintenancerecord.FieldServicedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MaintenanceRecord", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MaintenanceRecord fields.
+func (mr *MaintenanceRecord) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case maintenancerecord.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mr.ID = int64(value.Int64)
+		case maintenancerecord.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				mr.TenantID = value.Int64
+			}
+		case maintenancerecord.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				mr.CarID = value.Int64
+			}
+		case maintenancerecord.FieldServicedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field serviced_at", values[i])
+			} else if value.Valid {
+				mr.ServicedAt = value.Time
+			}
+		case maintenancerecord.FieldMileage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mileage", values[i])
+			} else if value.Valid {
+				mr.Mileage = value.Int64
+			}
+		case maintenancerecord.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				mr.Type = value.String
+			}
+		case maintenancerecord.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				mr.Cost = value.Float64
+			}
+		case maintenancerecord.FieldShop:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shop", values[i])
+			} else if value.Valid {
+				mr.Shop = value.String
+			}
+		case maintenancerecord.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				mr.Notes = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the MaintenanceRecord entity.
+func (mr *MaintenanceRecord) QueryCar() *CarQuery {
+	return (&MaintenanceRecordClient{config: mr.config}).QueryCar(mr)
+}
+
+// Update returns a builder for updating this MaintenanceRecord.
+// Note that you need to call MaintenanceRecord.Unwrap() before calling this method if this MaintenanceRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mr *MaintenanceRecord) Update() *MaintenanceRecordUpdateOne {
+	return (&MaintenanceRecordClient{config: mr.config}).UpdateOne(mr)
+}
+
+// Unwrap unwraps the MaintenanceRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mr *MaintenanceRecord) Unwrap() *MaintenanceRecord {
+	_tx, ok := mr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MaintenanceRecord is not a transactional entity")
+	}
+	mr.config.driver = _tx.drv
+	return mr
+}
+
+// String implements the fmt.Stringer.
+func (mr *MaintenanceRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("MaintenanceRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mr.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", mr.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", mr.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("serviced_at=")
+	builder.WriteString(mr.ServicedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("mileage=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Mileage))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(mr.Type)
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Cost))
+	builder.WriteString(", ")
+	builder.WriteString("shop=")
+	builder.WriteString(mr.Shop)
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(mr.Notes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MaintenanceRecords is a parsable slice of MaintenanceRecord.
+type MaintenanceRecords []*MaintenanceRecord
+
+func (mr MaintenanceRecords) config(cfg config) {
+	for _i := range mr {
+		mr[_i].config = cfg
+	}
+}
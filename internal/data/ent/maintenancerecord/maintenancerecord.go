@@ -0,0 +1,79 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maintenancerecord
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the maintenancerecord type in the database.
+	Label = "maintenance_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldServicedAt holds the string denoting the serviced_at field in the database.
+	FieldServicedAt = "serviced_at"
+	// FieldMileage holds the string denoting the mileage field in the database.
+	FieldMileage = "mileage"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldShop holds the string denoting the shop field in the database.
+	FieldShop = "shop"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// Table holds the table name of the maintenancerecord in the database.
+	Table = "maintenance_record"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "maintenance_record"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+)
+
+// Columns holds all SQL columns for maintenancerecord fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldServicedAt,
+	FieldMileage,
+	FieldType,
+	FieldCost,
+	FieldShop,
+	FieldNotes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultServicedAt holds the default value on creation for the "serviced_at" field.
+	DefaultServicedAt func() time.Time
+)
@@ -0,0 +1,981 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maintenancerecord
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// ServicedAt applies equality check predicate on the "serviced_at" field. It's identical to ServicedAtEQ.
+func ServicedAt(v time.Time) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldServicedAt), v))
+	})
+}
+
+// Mileage applies equality check predicate on the "mileage" field. It's identical to MileageEQ.
+func Mileage(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileage), v))
+	})
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCost), v))
+	})
+}
+
+// Shop applies equality check predicate on the "shop" field. It's identical to ShopEQ.
+func Shop(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldShop), v))
+	})
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// ServicedAtEQ applies the EQ predicate on the "serviced_at" field.
+func ServicedAtEQ(v time.Time) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldServicedAt), v))
+	})
+}
+
+// ServicedAtNEQ applies the NEQ predicate on the "serviced_at" field.
+func ServicedAtNEQ(v time.Time) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldServicedAt), v))
+	})
+}
+
+// ServicedAtIn applies the In predicate on the "serviced_at" field.
+func ServicedAtIn(vs ...time.Time) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldServicedAt), v...))
+	})
+}
+
+// ServicedAtNotIn applies the NotIn predicate on the "serviced_at" field.
+func ServicedAtNotIn(vs ...time.Time) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldServicedAt), v...))
+	})
+}
+
+// ServicedAtGT applies the GT predicate on the "serviced_at" field.
+func ServicedAtGT(v time.Time) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldServicedAt), v))
+	})
+}
+
+// ServicedAtGTE applies the GTE predicate on the "serviced_at" field.
+func ServicedAtGTE(v time.Time) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldServicedAt), v))
+	})
+}
+
+// ServicedAtLT applies the LT predicate on the "serviced_at" field.
+func ServicedAtLT(v time.Time) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldServicedAt), v))
+	})
+}
+
+// ServicedAtLTE applies the LTE predicate on the "serviced_at" field.
+func ServicedAtLTE(v time.Time) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldServicedAt), v))
+	})
+}
+
+// MileageEQ applies the EQ predicate on the "mileage" field.
+func MileageEQ(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileage), v))
+	})
+}
+
+// MileageNEQ applies the NEQ predicate on the "mileage" field.
+func MileageNEQ(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMileage), v))
+	})
+}
+
+// MileageIn applies the In predicate on the "mileage" field.
+func MileageIn(vs ...int64) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMileage), v...))
+	})
+}
+
+// MileageNotIn applies the NotIn predicate on the "mileage" field.
+func MileageNotIn(vs ...int64) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMileage), v...))
+	})
+}
+
+// MileageGT applies the GT predicate on the "mileage" field.
+func MileageGT(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMileage), v))
+	})
+}
+
+// MileageGTE applies the GTE predicate on the "mileage" field.
+func MileageGTE(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMileage), v))
+	})
+}
+
+// MileageLT applies the LT predicate on the "mileage" field.
+func MileageLT(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMileage), v))
+	})
+}
+
+// MileageLTE applies the LTE predicate on the "mileage" field.
+func MileageLTE(v int64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMileage), v))
+	})
+}
+
+// MileageIsNil applies the IsNil predicate on the "mileage" field.
+func MileageIsNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMileage)))
+	})
+}
+
+// MileageNotNil applies the NotNil predicate on the "mileage" field.
+func MileageNotNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMileage)))
+	})
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldType), v))
+	})
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldType), v...))
+	})
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldType), v...))
+	})
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldType), v))
+	})
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldType), v))
+	})
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldType), v))
+	})
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldType), v))
+	})
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldType), v))
+	})
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldType), v))
+	})
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldType), v))
+	})
+}
+
+// TypeIsNil applies the IsNil predicate on the "type" field.
+func TypeIsNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldType)))
+	})
+}
+
+// TypeNotNil applies the NotNil predicate on the "type" field.
+func TypeNotNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldType)))
+	})
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldType), v))
+	})
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldType), v))
+	})
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCost), v))
+	})
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCost), v))
+	})
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCost), v...))
+	})
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCost), v...))
+	})
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCost), v))
+	})
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCost), v))
+	})
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCost), v))
+	})
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCost), v))
+	})
+}
+
+// CostIsNil applies the IsNil predicate on the "cost" field.
+func CostIsNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCost)))
+	})
+}
+
+// CostNotNil applies the NotNil predicate on the "cost" field.
+func CostNotNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCost)))
+	})
+}
+
+// ShopEQ applies the EQ predicate on the "shop" field.
+func ShopEQ(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldShop), v))
+	})
+}
+
+// ShopNEQ applies the NEQ predicate on the "shop" field.
+func ShopNEQ(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldShop), v))
+	})
+}
+
+// ShopIn applies the In predicate on the "shop" field.
+func ShopIn(vs ...string) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldShop), v...))
+	})
+}
+
+// ShopNotIn applies the NotIn predicate on the "shop" field.
+func ShopNotIn(vs ...string) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldShop), v...))
+	})
+}
+
+// ShopGT applies the GT predicate on the "shop" field.
+func ShopGT(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldShop), v))
+	})
+}
+
+// ShopGTE applies the GTE predicate on the "shop" field.
+func ShopGTE(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldShop), v))
+	})
+}
+
+// ShopLT applies the LT predicate on the "shop" field.
+func ShopLT(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldShop), v))
+	})
+}
+
+// ShopLTE applies the LTE predicate on the "shop" field.
+func ShopLTE(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldShop), v))
+	})
+}
+
+// ShopContains applies the Contains predicate on the "shop" field.
+func ShopContains(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldShop), v))
+	})
+}
+
+// ShopHasPrefix applies the HasPrefix predicate on the "shop" field.
+func ShopHasPrefix(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldShop), v))
+	})
+}
+
+// ShopHasSuffix applies the HasSuffix predicate on the "shop" field.
+func ShopHasSuffix(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldShop), v))
+	})
+}
+
+// ShopIsNil applies the IsNil predicate on the "shop" field.
+func ShopIsNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldShop)))
+	})
+}
+
+// ShopNotNil applies the NotNil predicate on the "shop" field.
+func ShopNotNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldShop)))
+	})
+}
+
+// ShopEqualFold applies the EqualFold predicate on the "shop" field.
+func ShopEqualFold(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldShop), v))
+	})
+}
+
+// ShopContainsFold applies the ContainsFold predicate on the "shop" field.
+func ShopContainsFold(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldShop), v))
+	})
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.MaintenanceRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNotes), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MaintenanceRecord) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MaintenanceRecord) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MaintenanceRecord) predicate.MaintenanceRecord {
+	return predicate.MaintenanceRecord(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/maintenancerecord"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceRecordCreate is the builder for creating a MaintenanceRecord entity.
+type MaintenanceRecordCreate struct {
+	config
+	mutation *MaintenanceRecordMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (mrc *MaintenanceRecordCreate) SetTenantID(i int64) *MaintenanceRecordCreate {
+	mrc.mutation.SetTenantID(i)
+	return mrc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (mrc *MaintenanceRecordCreate) SetNillableTenantID(i *int64) *MaintenanceRecordCreate {
+	if i != nil {
+		mrc.SetTenantID(*i)
+	}
+	return mrc
+}
+
+// SetCarID sets the "car_id" field.
+func (mrc *MaintenanceRecordCreate) SetCarID(i int64) *MaintenanceRecordCreate {
+	mrc.mutation.SetCarID(i)
+	return mrc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (mrc *MaintenanceRecordCreate) SetNillableCarID(i *int64) *MaintenanceRecordCreate {
+	if i != nil {
+		mrc.SetCarID(*i)
+	}
+	return mrc
+}
+
+// SetServicedAt sets the "serviced_at" field.
+func (mrc *MaintenanceRecordCreate) SetServicedAt(t time.Time) *MaintenanceRecordCreate {
+	mrc.mutation.SetServicedAt(t)
+	return mrc
+}
+
+// SetNillableServicedAt sets the "serviced_at" field if the given value is not nil.
+func (mrc *MaintenanceRecordCreate) SetNillableServicedAt(t *time.Time) *MaintenanceRecordCreate {
+	if t != nil {
+		mrc.SetServicedAt(*t)
+	}
+	return mrc
+}
+
+// SetMileage sets the "mileage" field.
+func (mrc *MaintenanceRecordCreate) SetMileage(i int64) *MaintenanceRecordCreate {
+	mrc.mutation.SetMileage(i)
+	return mrc
+}
+
+// SetNillableMileage sets the "mileage" field if the given value is not nil.
+func (mrc *MaintenanceRecordCreate) SetNillableMileage(i *int64) *MaintenanceRecordCreate {
+	if i != nil {
+		mrc.SetMileage(*i)
+	}
+	return mrc
+}
+
+// SetType sets the "type" field.
+func (mrc *MaintenanceRecordCreate) SetType(s string) *MaintenanceRecordCreate {
+	mrc.mutation.SetType(s)
+	return mrc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (mrc *MaintenanceRecordCreate) SetNillableType(s *string) *MaintenanceRecordCreate {
+	if s != nil {
+		mrc.SetType(*s)
+	}
+	return mrc
+}
+
+// SetCost sets the "cost" field.
+func (mrc *MaintenanceRecordCreate) SetCost(f float64) *MaintenanceRecordCreate {
+	mrc.mutation.SetCost(f)
+	return mrc
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (mrc *MaintenanceRecordCreate) SetNillableCost(f *float64) *MaintenanceRecordCreate {
+	if f != nil {
+		mrc.SetCost(*f)
+	}
+	return mrc
+}
+
+// SetShop sets the "shop" field.
+func (mrc *MaintenanceRecordCreate) SetShop(s string) *MaintenanceRecordCreate {
+	mrc.mutation.SetShop(s)
+	return mrc
+}
+
+// SetNillableShop sets the "shop" field if the given value is not nil.
+func (mrc *MaintenanceRecordCreate) SetNillableShop(s *string) *MaintenanceRecordCreate {
+	if s != nil {
+		mrc.SetShop(*s)
+	}
+	return mrc
+}
+
+// SetNotes sets the "notes" field.
+func (mrc *MaintenanceRecordCreate) SetNotes(s string) *MaintenanceRecordCreate {
+	mrc.mutation.SetNotes(s)
+	return mrc
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (mrc *MaintenanceRecordCreate) SetNillableNotes(s *string) *MaintenanceRecordCreate {
+	if s != nil {
+		mrc.SetNotes(*s)
+	}
+	return mrc
+}
+
+// SetID sets the "id" field.
+func (mrc *MaintenanceRecordCreate) SetID(i int64) *MaintenanceRecordCreate {
+	mrc.mutation.SetID(i)
+	return mrc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (mrc *MaintenanceRecordCreate) SetCar(c *Car) *MaintenanceRecordCreate {
+	return mrc.SetCarID(c.ID)
+}
+
+// Mutation returns the MaintenanceRecordMutation object of the builder.
+func (mrc *MaintenanceRecordCreate) Mutation() *MaintenanceRecordMutation {
+	return mrc.mutation
+}
+
+// Save creates the MaintenanceRecord in the database.
+func (mrc *MaintenanceRecordCreate) Save(ctx context.Context) (*MaintenanceRecord, error) {
+	var (
+		err  error
+		node *MaintenanceRecord
+	)
+	if err := mrc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(mrc.hooks) == 0 {
+		if err = mrc.check(); err != nil {
+			return nil, err
+		}
+		node, err = mrc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MaintenanceRecordMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mrc.check(); err != nil {
+				return nil, err
+			}
+			mrc.mutation = mutation
+			if node, err = mrc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mrc.hooks) - 1; i >= 0; i-- {
+			if mrc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mrc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mrc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MaintenanceRecord)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MaintenanceRecordMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrc *MaintenanceRecordCreate) SaveX(ctx context.Context) *MaintenanceRecord {
+	v, err := mrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrc *MaintenanceRecordCreate) Exec(ctx context.Context) error {
+	_, err := mrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrc *MaintenanceRecordCreate) ExecX(ctx context.Context) {
+	if err := mrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrc *MaintenanceRecordCreate) defaults() error {
+	if _, ok := mrc.mutation.ServicedAt(); !ok {
+		if maintenancerecord.DefaultServicedAt == nil {
+			return fmt.Errorf("ent: uninitialized maintenancerecord.DefaultServicedAt (forgotten import ent/runtime?)")
+		}
+		v := maintenancerecord.DefaultServicedAt()
+		mrc.mutation.SetServicedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrc *MaintenanceRecordCreate) check() error {
+	if _, ok := mrc.mutation.ServicedAt(); !ok {
+		return &ValidationError{Name: "serviced_at", err: errors.New(`ent: missing required field "MaintenanceRecord.serviced_at"`)}
+	}
+	return nil
+}
+
+func (mrc *MaintenanceRecordCreate) sqlSave(ctx context.Context) (*MaintenanceRecord, error) {
+	_node, _spec := mrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (mrc *MaintenanceRecordCreate) createSpec() (*MaintenanceRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MaintenanceRecord{config: mrc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: maintenancerecord.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: maintenancerecord.FieldID,
+			},
+		}
+	)
+	if id, ok := mrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mrc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := mrc.mutation.ServicedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: maintenancerecord.FieldServicedAt,
+		})
+		_node.ServicedAt = value
+	}
+	if value, ok := mrc.mutation.Mileage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldMileage,
+		})
+		_node.Mileage = value
+	}
+	if value, ok := mrc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: maintenancerecord.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := mrc.mutation.Cost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: maintenancerecord.FieldCost,
+		})
+		_node.Cost = value
+	}
+	if value, ok := mrc.mutation.Shop(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: maintenancerecord.FieldShop,
+		})
+		_node.Shop = value
+	}
+	if value, ok := mrc.mutation.Notes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: maintenancerecord.FieldNotes,
+		})
+		_node.Notes = value
+	}
+	if nodes := mrc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancerecord.CarTable,
+			Columns: []string{maintenancerecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MaintenanceRecordCreateBulk is the builder for creating many MaintenanceRecord entities in bulk.
+type MaintenanceRecordCreateBulk struct {
+	config
+	builders []*MaintenanceRecordCreate
+}
+
+// Save creates the MaintenanceRecord entities in the database.
+func (mrcb *MaintenanceRecordCreateBulk) Save(ctx context.Context) ([]*MaintenanceRecord, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mrcb.builders))
+	nodes := make([]*MaintenanceRecord, len(mrcb.builders))
+	mutators := make([]Mutator, len(mrcb.builders))
+	for i := range mrcb.builders {
+		func(i int, root context.Context) {
+			builder := mrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MaintenanceRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcb *MaintenanceRecordCreateBulk) SaveX(ctx context.Context) []*MaintenanceRecord {
+	v, err := mrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcb *MaintenanceRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcb *MaintenanceRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := mrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceRecordDelete is the builder for deleting a MaintenanceRecord entity.
+type MaintenanceRecordDelete struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceRecordMutation
+}
+
+// Where appends a list predicates to the MaintenanceRecordDelete builder.
+func (mrd *MaintenanceRecordDelete) Where(ps ...predicate.MaintenanceRecord) *MaintenanceRecordDelete {
+	mrd.mutation.Where(ps...)
+	return mrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mrd *MaintenanceRecordDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mrd.hooks) == 0 {
+		affected, err = mrd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MaintenanceRecordMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mrd.mutation = mutation
+			affected, err = mrd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mrd.hooks) - 1; i >= 0; i-- {
+			if mrd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mrd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mrd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrd *MaintenanceRecordDelete) ExecX(ctx context.Context) int {
+	n, err := mrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mrd *MaintenanceRecordDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: maintenancerecord.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: maintenancerecord.FieldID,
+			},
+		},
+	}
+	if ps := mrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// MaintenanceRecordDeleteOne is the builder for deleting a single MaintenanceRecord entity.
+type MaintenanceRecordDeleteOne struct {
+	mrd *MaintenanceRecordDelete
+}
+
+// Exec executes the deletion query.
+func (mrdo *MaintenanceRecordDeleteOne) Exec(ctx context.Context) error {
+	n, err := mrdo.mrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{maintenancerecord.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrdo *MaintenanceRecordDeleteOne) ExecX(ctx context.Context) {
+	mrdo.mrd.ExecX(ctx)
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceRecordQuery is the builder for querying MaintenanceRecord entities.
+type MaintenanceRecordQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MaintenanceRecord
+	// eager-loading edges.
+	withCar   *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MaintenanceRecordQuery builder.
+func (mrq *MaintenanceRecordQuery) Where(ps ...predicate.MaintenanceRecord) *MaintenanceRecordQuery {
+	mrq.predicates = append(mrq.predicates, ps...)
+	return mrq
+}
+
+// Limit adds a limit step to the query.
+func (mrq *MaintenanceRecordQuery) Limit(limit int) *MaintenanceRecordQuery {
+	mrq.limit = &limit
+	return mrq
+}
+
+// Offset adds an offset step to the query.
+func (mrq *MaintenanceRecordQuery) Offset(offset int) *MaintenanceRecordQuery {
+	mrq.offset = &offset
+	return mrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mrq *MaintenanceRecordQuery) Unique(unique bool) *MaintenanceRecordQuery {
+	mrq.unique = &unique
+	return mrq
+}
+
+// Order adds an order step to the query.
+func (mrq *MaintenanceRecordQuery) Order(o ...OrderFunc) *MaintenanceRecordQuery {
+	mrq.order = append(mrq.order, o...)
+	return mrq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (mrq *MaintenanceRecordQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: mrq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenancerecord.Table, maintenancerecord.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, maintenancerecord.CarTable, maintenancerecord.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MaintenanceRecord entity from the query.
+// Returns a *NotFoundError when no MaintenanceRecord was found.
+func (mrq *MaintenanceRecordQuery) First(ctx context.Context) (*MaintenanceRecord, error) {
+	nodes, err := mrq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{maintenancerecord.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mrq *MaintenanceRecordQuery) FirstX(ctx context.Context) *MaintenanceRecord {
+	node, err := mrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MaintenanceRecord ID from the query.
+// Returns a *NotFoundError when no MaintenanceRecord ID was found.
+func (mrq *MaintenanceRecordQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = mrq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{maintenancerecord.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mrq *MaintenanceRecordQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := mrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MaintenanceRecord entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MaintenanceRecord entity is found.
+// Returns a *NotFoundError when no MaintenanceRecord entities are found.
+func (mrq *MaintenanceRecordQuery) Only(ctx context.Context) (*MaintenanceRecord, error) {
+	nodes, err := mrq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{maintenancerecord.Label}
+	default:
+		return nil, &NotSingularError{maintenancerecord.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mrq *MaintenanceRecordQuery) OnlyX(ctx context.Context) *MaintenanceRecord {
+	node, err := mrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MaintenanceRecord ID in the query.
+// Returns a *NotSingularError when more than one MaintenanceRecord ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mrq *MaintenanceRecordQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = mrq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{maintenancerecord.Label}
+	default:
+		err = &NotSingularError{maintenancerecord.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mrq *MaintenanceRecordQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := mrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MaintenanceRecords.
+func (mrq *MaintenanceRecordQuery) All(ctx context.Context) ([]*MaintenanceRecord, error) {
+	if err := mrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return mrq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mrq *MaintenanceRecordQuery) AllX(ctx context.Context) []*MaintenanceRecord {
+	nodes, err := mrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MaintenanceRecord IDs.
+func (mrq *MaintenanceRecordQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := mrq.Select(maintenancerecord.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mrq *MaintenanceRecordQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := mrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mrq *MaintenanceRecordQuery) Count(ctx context.Context) (int, error) {
+	if err := mrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return mrq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mrq *MaintenanceRecordQuery) CountX(ctx context.Context) int {
+	count, err := mrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mrq *MaintenanceRecordQuery) Exist(ctx context.Context) (bool, error) {
+	if err := mrq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return mrq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mrq *MaintenanceRecordQuery) ExistX(ctx context.Context) bool {
+	exist, err := mrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MaintenanceRecordQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mrq *MaintenanceRecordQuery) Clone() *MaintenanceRecordQuery {
+	if mrq == nil {
+		return nil
+	}
+	return &MaintenanceRecordQuery{
+		config:     mrq.config,
+		limit:      mrq.limit,
+		offset:     mrq.offset,
+		order:      append([]OrderFunc{}, mrq.order...),
+		predicates: append([]predicate.MaintenanceRecord{}, mrq.predicates...),
+		withCar:    mrq.withCar.Clone(),
+		// clone intermediate query.
+		sql:    mrq.sql.Clone(),
+		path:   mrq.path,
+		unique: mrq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (mrq *MaintenanceRecordQuery) WithCar(opts ...func(*CarQuery)) *MaintenanceRecordQuery {
+	query := &CarQuery{config: mrq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	mrq.withCar = query
+	return mrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MaintenanceRecord.Query().
+//		GroupBy(maintenancerecord.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (mrq *MaintenanceRecordQuery) GroupBy(field string, fields ...string) *MaintenanceRecordGroupBy {
+	grbuild := &MaintenanceRecordGroupBy{config: mrq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := mrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return mrq.sqlQuery(ctx), nil
+	}
+	grbuild.label = maintenancerecord.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.MaintenanceRecord.Query().
+//		Select(maintenancerecord.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (mrq *MaintenanceRecordQuery) Select(fields ...string) *MaintenanceRecordSelect {
+	mrq.fields = append(mrq.fields, fields...)
+	selbuild := &MaintenanceRecordSelect{MaintenanceRecordQuery: mrq}
+	selbuild.label = maintenancerecord.Label
+	selbuild.flds, selbuild.scan = &mrq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (mrq *MaintenanceRecordQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range mrq.fields {
+		if !maintenancerecord.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mrq.path != nil {
+		prev, err := mrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mrq.sql = prev
+	}
+	if maintenancerecord.Policy == nil {
+		return errors.New("ent: uninitialized maintenancerecord.Policy (forgotten import ent/runtime?)")
+	}
+	if err := maintenancerecord.Policy.EvalQuery(ctx, mrq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (mrq *MaintenanceRecordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MaintenanceRecord, error) {
+	var (
+		nodes       = []*MaintenanceRecord{}
+		_spec       = mrq.querySpec()
+		loadedTypes = [1]bool{
+			mrq.withCar != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*MaintenanceRecord).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &MaintenanceRecord{config: mrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(mrq.modifiers) > 0 {
+		_spec.Modifiers = mrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := mrq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*MaintenanceRecord)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (mrq *MaintenanceRecordQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mrq.querySpec()
+	if len(mrq.modifiers) > 0 {
+		_spec.Modifiers = mrq.modifiers
+	}
+	_spec.Node.Columns = mrq.fields
+	if len(mrq.fields) > 0 {
+		_spec.Unique = mrq.unique != nil && *mrq.unique
+	}
+	return sqlgraph.CountNodes(ctx, mrq.driver, _spec)
+}
+
+func (mrq *MaintenanceRecordQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := mrq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (mrq *MaintenanceRecordQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   maintenancerecord.Table,
+			Columns: maintenancerecord.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: maintenancerecord.FieldID,
+			},
+		},
+		From:   mrq.sql,
+		Unique: true,
+	}
+	if unique := mrq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := mrq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenancerecord.FieldID)
+		for i := range fields {
+			if fields[i] != maintenancerecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mrq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mrq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mrq *MaintenanceRecordQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mrq.driver.Dialect())
+	t1 := builder.Table(maintenancerecord.Table)
+	columns := mrq.fields
+	if len(columns) == 0 {
+		columns = maintenancerecord.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mrq.sql != nil {
+		selector = mrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mrq.unique != nil && *mrq.unique {
+		selector.Distinct()
+	}
+	for _, m := range mrq.modifiers {
+		m(selector)
+	}
+	for _, p := range mrq.predicates {
+		p(selector)
+	}
+	for _, p := range mrq.order {
+		p(selector)
+	}
+	if offset := mrq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mrq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mrq *MaintenanceRecordQuery) ForUpdate(opts ...sql.LockOption) *MaintenanceRecordQuery {
+	if mrq.driver.Dialect() == dialect.Postgres {
+		mrq.Unique(false)
+	}
+	mrq.modifiers = append(mrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mrq *MaintenanceRecordQuery) ForShare(opts ...sql.LockOption) *MaintenanceRecordQuery {
+	if mrq.driver.Dialect() == dialect.Postgres {
+		mrq.Unique(false)
+	}
+	mrq.modifiers = append(mrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mrq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mrq *MaintenanceRecordQuery) Modify(modifiers ...func(s *sql.Selector)) *MaintenanceRecordSelect {
+	mrq.modifiers = append(mrq.modifiers, modifiers...)
+	return mrq.Select()
+}
+
+// MaintenanceRecordGroupBy is the group-by builder for MaintenanceRecord entities.
+type MaintenanceRecordGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mrgb *MaintenanceRecordGroupBy) Aggregate(fns ...AggregateFunc) *MaintenanceRecordGroupBy {
+	mrgb.fns = append(mrgb.fns, fns...)
+	return mrgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (mrgb *MaintenanceRecordGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := mrgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	mrgb.sql = query
+	return mrgb.sqlScan(ctx, v)
+}
+
+func (mrgb *MaintenanceRecordGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range mrgb.fields {
+		if !maintenancerecord.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := mrgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mrgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (mrgb *MaintenanceRecordGroupBy) sqlQuery() *sql.Selector {
+	selector := mrgb.sql.Select()
+	aggregation := make([]string, 0, len(mrgb.fns))
+	for _, fn := range mrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(mrgb.fields)+len(mrgb.fns))
+		for _, f := range mrgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(mrgb.fields...)...)
+}
+
+// MaintenanceRecordSelect is the builder for selecting fields of MaintenanceRecord entities.
+type MaintenanceRecordSelect struct {
+	*MaintenanceRecordQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mrs *MaintenanceRecordSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := mrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	mrs.sql = mrs.MaintenanceRecordQuery.sqlQuery(ctx)
+	return mrs.sqlScan(ctx, v)
+}
+
+func (mrs *MaintenanceRecordSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := mrs.sql.Query()
+	if err := mrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mrs *MaintenanceRecordSelect) Modify(modifiers ...func(s *sql.Selector)) *MaintenanceRecordSelect {
+	mrs.modifiers = append(mrs.modifiers, modifiers...)
+	return mrs
+}
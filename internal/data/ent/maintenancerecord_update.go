@@ -0,0 +1,901 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceRecordUpdate is the builder for updating MaintenanceRecord entities.
+type MaintenanceRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceRecordMutation
+}
+
+// Where appends a list predicates to the MaintenanceRecordUpdate builder.
+func (mru *MaintenanceRecordUpdate) Where(ps ...predicate.MaintenanceRecord) *MaintenanceRecordUpdate {
+	mru.mutation.Where(ps...)
+	return mru
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (mru *MaintenanceRecordUpdate) SetTenantID(i int64) *MaintenanceRecordUpdate {
+	mru.mutation.ResetTenantID()
+	mru.mutation.SetTenantID(i)
+	return mru
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (mru *MaintenanceRecordUpdate) SetNillableTenantID(i *int64) *MaintenanceRecordUpdate {
+	if i != nil {
+		mru.SetTenantID(*i)
+	}
+	return mru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (mru *MaintenanceRecordUpdate) AddTenantID(i int64) *MaintenanceRecordUpdate {
+	mru.mutation.AddTenantID(i)
+	return mru
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (mru *MaintenanceRecordUpdate) ClearTenantID() *MaintenanceRecordUpdate {
+	mru.mutation.ClearTenantID()
+	return mru
+}
+
+// SetCarID sets the "car_id" field.
+func (mru *MaintenanceRecordUpdate) SetCarID(i int64) *MaintenanceRecordUpdate {
+	mru.mutation.SetCarID(i)
+	return mru
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (mru *MaintenanceRecordUpdate) SetNillableCarID(i *int64) *MaintenanceRecordUpdate {
+	if i != nil {
+		mru.SetCarID(*i)
+	}
+	return mru
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (mru *MaintenanceRecordUpdate) ClearCarID() *MaintenanceRecordUpdate {
+	mru.mutation.ClearCarID()
+	return mru
+}
+
+// SetServicedAt sets the "serviced_at" field.
+func (mru *MaintenanceRecordUpdate) SetServicedAt(t time.Time) *MaintenanceRecordUpdate {
+	mru.mutation.SetServicedAt(t)
+	return mru
+}
+
+// SetNillableServicedAt sets the "serviced_at" field if the given value is not nil.
+func (mru *MaintenanceRecordUpdate) SetNillableServicedAt(t *time.Time) *MaintenanceRecordUpdate {
+	if t != nil {
+		mru.SetServicedAt(*t)
+	}
+	return mru
+}
+
+// SetMileage sets the "mileage" field.
+func (mru *MaintenanceRecordUpdate) SetMileage(i int64) *MaintenanceRecordUpdate {
+	mru.mutation.ResetMileage()
+	mru.mutation.SetMileage(i)
+	return mru
+}
+
+// SetNillableMileage sets the "mileage" field if the given value is not nil.
+func (mru *MaintenanceRecordUpdate) SetNillableMileage(i *int64) *MaintenanceRecordUpdate {
+	if i != nil {
+		mru.SetMileage(*i)
+	}
+	return mru
+}
+
+// AddMileage adds i to the "mileage" field.
+func (mru *MaintenanceRecordUpdate) AddMileage(i int64) *MaintenanceRecordUpdate {
+	mru.mutation.AddMileage(i)
+	return mru
+}
+
+// ClearMileage clears the value of the "mileage" field.
+func (mru *MaintenanceRecordUpdate) ClearMileage() *MaintenanceRecordUpdate {
+	mru.mutation.ClearMileage()
+	return mru
+}
+
+// SetType sets the "type" field.
+func (mru *MaintenanceRecordUpdate) SetType(s string) *MaintenanceRecordUpdate {
+	mru.mutation.SetType(s)
+	return mru
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (mru *MaintenanceRecordUpdate) SetNillableType(s *string) *MaintenanceRecordUpdate {
+	if s != nil {
+		mru.SetType(*s)
+	}
+	return mru
+}
+
+// ClearType clears the value of the "type" field.
+func (mru *MaintenanceRecordUpdate) ClearType() *MaintenanceRecordUpdate {
+	mru.mutation.ClearType()
+	return mru
+}
+
+// SetCost sets the "cost" field.
+func (mru *MaintenanceRecordUpdate) SetCost(f float64) *MaintenanceRecordUpdate {
+	mru.mutation.ResetCost()
+	mru.mutation.SetCost(f)
+	return mru
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (mru *MaintenanceRecordUpdate) SetNillableCost(f *float64) *MaintenanceRecordUpdate {
+	if f != nil {
+		mru.SetCost(*f)
+	}
+	return mru
+}
+
+// AddCost adds f to the "cost" field.
+func (mru *MaintenanceRecordUpdate) AddCost(f float64) *MaintenanceRecordUpdate {
+	mru.mutation.AddCost(f)
+	return mru
+}
+
+// ClearCost clears the value of the "cost" field.
+func (mru *MaintenanceRecordUpdate) ClearCost() *MaintenanceRecordUpdate {
+	mru.mutation.ClearCost()
+	return mru
+}
+
+// SetShop sets the "shop" field.
+func (mru *MaintenanceRecordUpdate) SetShop(s string) *MaintenanceRecordUpdate {
+	mru.mutation.SetShop(s)
+	return mru
+}
+
+// SetNillableShop sets the "shop" field if the given value is not nil.
+func (mru *MaintenanceRecordUpdate) SetNillableShop(s *string) *MaintenanceRecordUpdate {
+	if s != nil {
+		mru.SetShop(*s)
+	}
+	return mru
+}
+
+// ClearShop clears the value of the "shop" field.
+func (mru *MaintenanceRecordUpdate) ClearShop() *MaintenanceRecordUpdate {
+	mru.mutation.ClearShop()
+	return mru
+}
+
+// SetNotes sets the "notes" field.
+func (mru *MaintenanceRecordUpdate) SetNotes(s string) *MaintenanceRecordUpdate {
+	mru.mutation.SetNotes(s)
+	return mru
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (mru *MaintenanceRecordUpdate) SetNillableNotes(s *string) *MaintenanceRecordUpdate {
+	if s != nil {
+		mru.SetNotes(*s)
+	}
+	return mru
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (mru *MaintenanceRecordUpdate) ClearNotes() *MaintenanceRecordUpdate {
+	mru.mutation.ClearNotes()
+	return mru
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (mru *MaintenanceRecordUpdate) SetCar(c *Car) *MaintenanceRecordUpdate {
+	return mru.SetCarID(c.ID)
+}
+
+// Mutation returns the MaintenanceRecordMutation object of the builder.
+func (mru *MaintenanceRecordUpdate) Mutation() *MaintenanceRecordMutation {
+	return mru.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (mru *MaintenanceRecordUpdate) ClearCar() *MaintenanceRecordUpdate {
+	mru.mutation.ClearCar()
+	return mru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mru *MaintenanceRecordUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mru.hooks) == 0 {
+		affected, err = mru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MaintenanceRecordMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mru.mutation = mutation
+			affected, err = mru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mru.hooks) - 1; i >= 0; i-- {
+			if mru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mru *MaintenanceRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := mru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mru *MaintenanceRecordUpdate) Exec(ctx context.Context) error {
+	_, err := mru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mru *MaintenanceRecordUpdate) ExecX(ctx context.Context) {
+	if err := mru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mru *MaintenanceRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   maintenancerecord.Table,
+			Columns: maintenancerecord.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: maintenancerecord.FieldID,
+			},
+		},
+	}
+	if ps := mru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mru.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldTenantID,
+		})
+	}
+	if value, ok := mru.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldTenantID,
+		})
+	}
+	if mru.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: maintenancerecord.FieldTenantID,
+		})
+	}
+	if value, ok := mru.mutation.ServicedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: maintenancerecord.FieldServicedAt,
+		})
+	}
+	if value, ok := mru.mutation.Mileage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldMileage,
+		})
+	}
+	if value, ok := mru.mutation.AddedMileage(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldMileage,
+		})
+	}
+	if mru.mutation.MileageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: maintenancerecord.FieldMileage,
+		})
+	}
+	if value, ok := mru.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: maintenancerecord.FieldType,
+		})
+	}
+	if mru.mutation.TypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: maintenancerecord.FieldType,
+		})
+	}
+	if value, ok := mru.mutation.Cost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: maintenancerecord.FieldCost,
+		})
+	}
+	if value, ok := mru.mutation.AddedCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: maintenancerecord.FieldCost,
+		})
+	}
+	if mru.mutation.CostCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: maintenancerecord.FieldCost,
+		})
+	}
+	if value, ok := mru.mutation.Shop(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: maintenancerecord.FieldShop,
+		})
+	}
+	if mru.mutation.ShopCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: maintenancerecord.FieldShop,
+		})
+	}
+	if value, ok := mru.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: maintenancerecord.FieldNotes,
+		})
+	}
+	if mru.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: maintenancerecord.FieldNotes,
+		})
+	}
+	if mru.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancerecord.CarTable,
+			Columns: []string{maintenancerecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mru.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancerecord.CarTable,
+			Columns: []string{maintenancerecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MaintenanceRecordUpdateOne is the builder for updating a single MaintenanceRecord entity.
+type MaintenanceRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MaintenanceRecordMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (mruo *MaintenanceRecordUpdateOne) SetTenantID(i int64) *MaintenanceRecordUpdateOne {
+	mruo.mutation.ResetTenantID()
+	mruo.mutation.SetTenantID(i)
+	return mruo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (mruo *MaintenanceRecordUpdateOne) SetNillableTenantID(i *int64) *MaintenanceRecordUpdateOne {
+	if i != nil {
+		mruo.SetTenantID(*i)
+	}
+	return mruo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (mruo *MaintenanceRecordUpdateOne) AddTenantID(i int64) *MaintenanceRecordUpdateOne {
+	mruo.mutation.AddTenantID(i)
+	return mruo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (mruo *MaintenanceRecordUpdateOne) ClearTenantID() *MaintenanceRecordUpdateOne {
+	mruo.mutation.ClearTenantID()
+	return mruo
+}
+
+// SetCarID sets the "car_id" field.
+func (mruo *MaintenanceRecordUpdateOne) SetCarID(i int64) *MaintenanceRecordUpdateOne {
+	mruo.mutation.SetCarID(i)
+	return mruo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (mruo *MaintenanceRecordUpdateOne) SetNillableCarID(i *int64) *MaintenanceRecordUpdateOne {
+	if i != nil {
+		mruo.SetCarID(*i)
+	}
+	return mruo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (mruo *MaintenanceRecordUpdateOne) ClearCarID() *MaintenanceRecordUpdateOne {
+	mruo.mutation.ClearCarID()
+	return mruo
+}
+
+// SetServicedAt sets the "serviced_at" field.
+func (mruo *MaintenanceRecordUpdateOne) SetServicedAt(t time.Time) *MaintenanceRecordUpdateOne {
+	mruo.mutation.SetServicedAt(t)
+	return mruo
+}
+
+// SetNillableServicedAt sets the "serviced_at" field if the given value is not nil.
+func (mruo *MaintenanceRecordUpdateOne) SetNillableServicedAt(t *time.Time) *MaintenanceRecordUpdateOne {
+	if t != nil {
+		mruo.SetServicedAt(*t)
+	}
+	return mruo
+}
+
+// SetMileage sets the "mileage" field.
+func (mruo *MaintenanceRecordUpdateOne) SetMileage(i int64) *MaintenanceRecordUpdateOne {
+	mruo.mutation.ResetMileage()
+	mruo.mutation.SetMileage(i)
+	return mruo
+}
+
+// SetNillableMileage sets the "mileage" field if the given value is not nil.
+func (mruo *MaintenanceRecordUpdateOne) SetNillableMileage(i *int64) *MaintenanceRecordUpdateOne {
+	if i != nil {
+		mruo.SetMileage(*i)
+	}
+	return mruo
+}
+
+// AddMileage adds i to the "mileage" field.
+func (mruo *MaintenanceRecordUpdateOne) AddMileage(i int64) *MaintenanceRecordUpdateOne {
+	mruo.mutation.AddMileage(i)
+	return mruo
+}
+
+// ClearMileage clears the value of the "mileage" field.
+func (mruo *MaintenanceRecordUpdateOne) ClearMileage() *MaintenanceRecordUpdateOne {
+	mruo.mutation.ClearMileage()
+	return mruo
+}
+
+// SetType sets the "type" field.
+func (mruo *MaintenanceRecordUpdateOne) SetType(s string) *MaintenanceRecordUpdateOne {
+	mruo.mutation.SetType(s)
+	return mruo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (mruo *MaintenanceRecordUpdateOne) SetNillableType(s *string) *MaintenanceRecordUpdateOne {
+	if s != nil {
+		mruo.SetType(*s)
+	}
+	return mruo
+}
+
+// ClearType clears the value of the "type" field.
+func (mruo *MaintenanceRecordUpdateOne) ClearType() *MaintenanceRecordUpdateOne {
+	mruo.mutation.ClearType()
+	return mruo
+}
+
+// SetCost sets the "cost" field.
+func (mruo *MaintenanceRecordUpdateOne) SetCost(f float64) *MaintenanceRecordUpdateOne {
+	mruo.mutation.ResetCost()
+	mruo.mutation.SetCost(f)
+	return mruo
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (mruo *MaintenanceRecordUpdateOne) SetNillableCost(f *float64) *MaintenanceRecordUpdateOne {
+	if f != nil {
+		mruo.SetCost(*f)
+	}
+	return mruo
+}
+
+// AddCost adds f to the "cost" field.
+func (mruo *MaintenanceRecordUpdateOne) AddCost(f float64) *MaintenanceRecordUpdateOne {
+	mruo.mutation.AddCost(f)
+	return mruo
+}
+
+// ClearCost clears the value of the "cost" field.
+func (mruo *MaintenanceRecordUpdateOne) ClearCost() *MaintenanceRecordUpdateOne {
+	mruo.mutation.ClearCost()
+	return mruo
+}
+
+// SetShop sets the "shop" field.
+func (mruo *MaintenanceRecordUpdateOne) SetShop(s string) *MaintenanceRecordUpdateOne {
+	mruo.mutation.SetShop(s)
+	return mruo
+}
+
+// SetNillableShop sets the "shop" field if the given value is not nil.
+func (mruo *MaintenanceRecordUpdateOne) SetNillableShop(s *string) *MaintenanceRecordUpdateOne {
+	if s != nil {
+		mruo.SetShop(*s)
+	}
+	return mruo
+}
+
+// ClearShop clears the value of the "shop" field.
+func (mruo *MaintenanceRecordUpdateOne) ClearShop() *MaintenanceRecordUpdateOne {
+	mruo.mutation.ClearShop()
+	return mruo
+}
+
+// SetNotes sets the "notes" field.
+func (mruo *MaintenanceRecordUpdateOne) SetNotes(s string) *MaintenanceRecordUpdateOne {
+	mruo.mutation.SetNotes(s)
+	return mruo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (mruo *MaintenanceRecordUpdateOne) SetNillableNotes(s *string) *MaintenanceRecordUpdateOne {
+	if s != nil {
+		mruo.SetNotes(*s)
+	}
+	return mruo
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (mruo *MaintenanceRecordUpdateOne) ClearNotes() *MaintenanceRecordUpdateOne {
+	mruo.mutation.ClearNotes()
+	return mruo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (mruo *MaintenanceRecordUpdateOne) SetCar(c *Car) *MaintenanceRecordUpdateOne {
+	return mruo.SetCarID(c.ID)
+}
+
+// Mutation returns the MaintenanceRecordMutation object of the builder.
+func (mruo *MaintenanceRecordUpdateOne) Mutation() *MaintenanceRecordMutation {
+	return mruo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (mruo *MaintenanceRecordUpdateOne) ClearCar() *MaintenanceRecordUpdateOne {
+	mruo.mutation.ClearCar()
+	return mruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mruo *MaintenanceRecordUpdateOne) Select(field string, fields ...string) *MaintenanceRecordUpdateOne {
+	mruo.fields = append([]string{field}, fields...)
+	return mruo
+}
+
+// Save executes the query and returns the updated MaintenanceRecord entity.
+func (mruo *MaintenanceRecordUpdateOne) Save(ctx context.Context) (*MaintenanceRecord, error) {
+	var (
+		err  error
+		node *MaintenanceRecord
+	)
+	if len(mruo.hooks) == 0 {
+		node, err = mruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MaintenanceRecordMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mruo.mutation = mutation
+			node, err = mruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mruo.hooks) - 1; i >= 0; i-- {
+			if mruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, mruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*MaintenanceRecord)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from MaintenanceRecordMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mruo *MaintenanceRecordUpdateOne) SaveX(ctx context.Context) *MaintenanceRecord {
+	node, err := mruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mruo *MaintenanceRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := mruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mruo *MaintenanceRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := mruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mruo *MaintenanceRecordUpdateOne) sqlSave(ctx context.Context) (_node *MaintenanceRecord, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   maintenancerecord.Table,
+			Columns: maintenancerecord.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: maintenancerecord.FieldID,
+			},
+		},
+	}
+	id, ok := mruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MaintenanceRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenancerecord.FieldID)
+		for _, f := range fields {
+			if !maintenancerecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != maintenancerecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mruo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldTenantID,
+		})
+	}
+	if value, ok := mruo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldTenantID,
+		})
+	}
+	if mruo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: maintenancerecord.FieldTenantID,
+		})
+	}
+	if value, ok := mruo.mutation.ServicedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: maintenancerecord.FieldServicedAt,
+		})
+	}
+	if value, ok := mruo.mutation.Mileage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldMileage,
+		})
+	}
+	if value, ok := mruo.mutation.AddedMileage(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: maintenancerecord.FieldMileage,
+		})
+	}
+	if mruo.mutation.MileageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: maintenancerecord.FieldMileage,
+		})
+	}
+	if value, ok := mruo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: maintenancerecord.FieldType,
+		})
+	}
+	if mruo.mutation.TypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: maintenancerecord.FieldType,
+		})
+	}
+	if value, ok := mruo.mutation.Cost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: maintenancerecord.FieldCost,
+		})
+	}
+	if value, ok := mruo.mutation.AddedCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: maintenancerecord.FieldCost,
+		})
+	}
+	if mruo.mutation.CostCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: maintenancerecord.FieldCost,
+		})
+	}
+	if value, ok := mruo.mutation.Shop(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: maintenancerecord.FieldShop,
+		})
+	}
+	if mruo.mutation.ShopCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: maintenancerecord.FieldShop,
+		})
+	}
+	if value, ok := mruo.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: maintenancerecord.FieldNotes,
+		})
+	}
+	if mruo.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: maintenancerecord.FieldNotes,
+		})
+	}
+	if mruo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancerecord.CarTable,
+			Columns: []string{maintenancerecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mruo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancerecord.CarTable,
+			Columns: []string{maintenancerecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MaintenanceRecord{config: mruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -9,27 +9,1345 @@ import (
 )
 
 var (
+	// AttachmentColumns holds the columns for the "attachment" table.
+	AttachmentColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "kind", Type: field.TypeString, Nullable: true},
+		{Name: "file_name", Type: field.TypeString, Nullable: true},
+		{Name: "content_type", Type: field.TypeString, Nullable: true},
+		{Name: "size", Type: field.TypeInt64, Nullable: true},
+		{Name: "checksum", Type: field.TypeString, Nullable: true},
+		{Name: "storage_key", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// AttachmentTable holds the schema information for the "attachment" table.
+	AttachmentTable = &schema.Table{
+		Name:       "attachment",
+		Columns:    AttachmentColumns,
+		PrimaryKey: []*schema.Column{AttachmentColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachment_car_attachments",
+				Columns:    []*schema.Column{AttachmentColumns[9]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentColumns[1]},
+			},
+			{
+				Name:    "attachment_car_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentColumns[9]},
+			},
+		},
+	}
+	// AttributeDefinitionColumns holds the columns for the "attribute_definition" table.
+	AttributeDefinitionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "value_type", Type: field.TypeString, Nullable: true},
+		{Name: "required", Type: field.TypeBool, Default: false},
+		{Name: "enum_values", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+	}
+	// AttributeDefinitionTable holds the schema information for the "attribute_definition" table.
+	AttributeDefinitionTable = &schema.Table{
+		Name:       "attribute_definition",
+		Columns:    AttributeDefinitionColumns,
+		PrimaryKey: []*schema.Column{AttributeDefinitionColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attributedefinition_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttributeDefinitionColumns[1]},
+			},
+			{
+				Name:    "attributedefinition_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{AttributeDefinitionColumns[1], AttributeDefinitionColumns[2]},
+			},
+		},
+	}
+	// AuditLogColumns holds the columns for the "audit_log" table.
+	AuditLogColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "car_id", Type: field.TypeInt64},
+		{Name: "op", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeInt64, Nullable: true},
+		{Name: "diff", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "trace_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+	}
+	// AuditLogTable holds the schema information for the "audit_log" table.
+	AuditLogTable = &schema.Table{
+		Name:       "audit_log",
+		Columns:    AuditLogColumns,
+		PrimaryKey: []*schema.Column{AuditLogColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogColumns[1]},
+			},
+			{
+				Name:    "auditlog_car_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogColumns[2], AuditLogColumns[7]},
+			},
+			{
+				Name:    "auditlog_actor_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogColumns[4], AuditLogColumns[7]},
+			},
+		},
+	}
+	// BrandColumns holds the columns for the "brand" table.
+	BrandColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+	}
+	// BrandTable holds the schema information for the "brand" table.
+	BrandTable = &schema.Table{
+		Name:       "brand",
+		Columns:    BrandColumns,
+		PrimaryKey: []*schema.Column{BrandColumns[0]},
+	}
 	// CarColumns holds the columns for the "car" table.
 	CarColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt64, Nullable: true},
 		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "vin", Type: field.TypeString, Nullable: true},
+		{Name: "plate", Type: field.TypeString, Nullable: true},
+		{Name: "owner_name", Type: field.TypeString, Nullable: true},
 		{Name: "registered_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "battery_capacity", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(8,2)"}},
+		{Name: "battery_soh", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(5,2)"}},
+		{Name: "model_id", Type: field.TypeInt64, Nullable: true},
 	}
 	// CarTable holds the schema information for the "car" table.
 	CarTable = &schema.Table{
 		Name:       "car",
 		Columns:    CarColumns,
 		PrimaryKey: []*schema.Column{CarColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "car_vehicle_model_cars",
+				Columns:    []*schema.Column{CarColumns[10]},
+				RefColumns: []*schema.Column{VehicleModelColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "car_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{CarColumns[1]},
+			},
+			{
+				Name:    "car_model_vin_plate_owner_name",
+				Unique:  false,
+				Columns: []*schema.Column{CarColumns[3], CarColumns[4], CarColumns[5], CarColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "FULLTEXT",
+				},
+			},
+		},
+	}
+	// CarAttributeColumns holds the columns for the "car_attribute" table.
+	CarAttributeColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "value", Type: field.TypeString},
+		{Name: "definition_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// CarAttributeTable holds the schema information for the "car_attribute" table.
+	CarAttributeTable = &schema.Table{
+		Name:       "car_attribute",
+		Columns:    CarAttributeColumns,
+		PrimaryKey: []*schema.Column{CarAttributeColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "car_attribute_attribute_definition_values",
+				Columns:    []*schema.Column{CarAttributeColumns[2]},
+				RefColumns: []*schema.Column{AttributeDefinitionColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "car_attribute_car_attributes",
+				Columns:    []*schema.Column{CarAttributeColumns[3]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "carattribute_car_id_definition_id",
+				Unique:  true,
+				Columns: []*schema.Column{CarAttributeColumns[3], CarAttributeColumns[2]},
+			},
+			{
+				Name:    "carattribute_definition_id_value",
+				Unique:  false,
+				Columns: []*schema.Column{CarAttributeColumns[2], CarAttributeColumns[1]},
+			},
+		},
+	}
+	// CarRecallColumns holds the columns for the "car_recall" table.
+	CarRecallColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "remedied_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "recall_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// CarRecallTable holds the schema information for the "car_recall" table.
+	CarRecallTable = &schema.Table{
+		Name:       "car_recall",
+		Columns:    CarRecallColumns,
+		PrimaryKey: []*schema.Column{CarRecallColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "car_recall_car_recalls",
+				Columns:    []*schema.Column{CarRecallColumns[7]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "car_recall_recall_car_recalls",
+				Columns:    []*schema.Column{CarRecallColumns[8]},
+				RefColumns: []*schema.Column{RecallColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "carrecall_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{CarRecallColumns[1]},
+			},
+			{
+				Name:    "carrecall_car_id_recall_id",
+				Unique:  true,
+				Columns: []*schema.Column{CarRecallColumns[7], CarRecallColumns[8]},
+			},
+			{
+				Name:    "carrecall_recall_id",
+				Unique:  false,
+				Columns: []*schema.Column{CarRecallColumns[8]},
+			},
+		},
+	}
+	// CarWarrantyColumns holds the columns for the "car_warranty" table.
+	CarWarrantyColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "components", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "start_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "mileage_limit", Type: field.TypeInt64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "definition_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// CarWarrantyTable holds the schema information for the "car_warranty" table.
+	CarWarrantyTable = &schema.Table{
+		Name:       "car_warranty",
+		Columns:    CarWarrantyColumns,
+		PrimaryKey: []*schema.Column{CarWarrantyColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "car_warranty_car_warranties",
+				Columns:    []*schema.Column{CarWarrantyColumns[8]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "car_warranty_warranty_definition_car_warranties",
+				Columns:    []*schema.Column{CarWarrantyColumns[9]},
+				RefColumns: []*schema.Column{WarrantyDefinitionColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "carwarranty_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{CarWarrantyColumns[1]},
+			},
+			{
+				Name:    "carwarranty_car_id_definition_id",
+				Unique:  true,
+				Columns: []*schema.Column{CarWarrantyColumns[8], CarWarrantyColumns[9]},
+			},
+		},
+	}
+	// ChargingSessionColumns holds the columns for the "charging_session" table.
+	ChargingSessionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "energy", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(8,2)"}},
+		{Name: "charger_type", Type: field.TypeString, Nullable: true},
+		{Name: "cost", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(10,2)"}},
+		{Name: "station", Type: field.TypeString, Nullable: true},
+		{Name: "start_soc", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(5,2)"}},
+		{Name: "end_soc", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(5,2)"}},
+		{Name: "soh", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(5,2)"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// ChargingSessionTable holds the schema information for the "charging_session" table.
+	ChargingSessionTable = &schema.Table{
+		Name:       "charging_session",
+		Columns:    ChargingSessionColumns,
+		PrimaryKey: []*schema.Column{ChargingSessionColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "charging_session_car_charging_sessions",
+				Columns:    []*schema.Column{ChargingSessionColumns[12]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chargingsession_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ChargingSessionColumns[1]},
+			},
+			{
+				Name:    "chargingsession_car_id_ended_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChargingSessionColumns[12], ChargingSessionColumns[3]},
+			},
+		},
+	}
+	// FleetColumns holds the columns for the "fleet" table.
+	FleetColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+	}
+	// FleetTable holds the schema information for the "fleet" table.
+	FleetTable = &schema.Table{
+		Name:       "fleet",
+		Columns:    FleetColumns,
+		PrimaryKey: []*schema.Column{FleetColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fleet_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{FleetColumns[1]},
+			},
+			{
+				Name:    "fleet_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{FleetColumns[1], FleetColumns[2]},
+			},
+		},
+	}
+	// GeofenceColumns holds the columns for the "geofence" table.
+	GeofenceColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "polygon", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "days", Type: field.TypeString, Nullable: true},
+		{Name: "start_time", Type: field.TypeString, Nullable: true},
+		{Name: "end_time", Type: field.TypeString, Nullable: true},
+		{Name: "time_zone", Type: field.TypeString, Nullable: true},
+		{Name: "alert_on", Type: field.TypeString, Default: "both"},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+	}
+	// GeofenceTable holds the schema information for the "geofence" table.
+	GeofenceTable = &schema.Table{
+		Name:       "geofence",
+		Columns:    GeofenceColumns,
+		PrimaryKey: []*schema.Column{GeofenceColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "geofence_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{GeofenceColumns[1]},
+			},
+			{
+				Name:    "geofence_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{GeofenceColumns[1], GeofenceColumns[2]},
+			},
+		},
+	}
+	// IncidentColumns holds the columns for the "incident" table.
+	IncidentColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "reporter_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "occurred_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "severity", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "photo_ids", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "third_parties", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "reported"},
+		{Name: "claim_no", Type: field.TypeString, Nullable: true},
+		{Name: "claim_amount", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(12,2)"}},
+		{Name: "settled_amount", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(12,2)"}},
+		{Name: "settled_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "policy_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// IncidentTable holds the schema information for the "incident" table.
+	IncidentTable = &schema.Table{
+		Name:       "incident",
+		Columns:    IncidentColumns,
+		PrimaryKey: []*schema.Column{IncidentColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "incident_car_incidents",
+				Columns:    []*schema.Column{IncidentColumns[16]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "incident_insurance_policy_incidents",
+				Columns:    []*schema.Column{IncidentColumns[17]},
+				RefColumns: []*schema.Column{InsurancePolicyColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "incident_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{IncidentColumns[1]},
+			},
+			{
+				Name:    "incident_car_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{IncidentColumns[16], IncidentColumns[3]},
+			},
+			{
+				Name:    "incident_status",
+				Unique:  false,
+				Columns: []*schema.Column{IncidentColumns[9]},
+			},
+		},
+	}
+	// InsurancePolicyColumns holds the columns for the "insurance_policy" table.
+	InsurancePolicyColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "provider", Type: field.TypeString, Nullable: true},
+		{Name: "policy_number", Type: field.TypeString, Nullable: true},
+		{Name: "coverage", Type: field.TypeString, Nullable: true},
+		{Name: "start_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "end_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "premium", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(10,2)"}},
+		{Name: "reminded_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// InsurancePolicyTable holds the schema information for the "insurance_policy" table.
+	InsurancePolicyTable = &schema.Table{
+		Name:       "insurance_policy",
+		Columns:    InsurancePolicyColumns,
+		PrimaryKey: []*schema.Column{InsurancePolicyColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "insurance_policy_car_insurance_policies",
+				Columns:    []*schema.Column{InsurancePolicyColumns[9]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "insurancepolicy_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InsurancePolicyColumns[1]},
+			},
+			{
+				Name:    "insurancepolicy_car_id",
+				Unique:  false,
+				Columns: []*schema.Column{InsurancePolicyColumns[9]},
+			},
+			{
+				Name:    "insurancepolicy_end_date",
+				Unique:  false,
+				Columns: []*schema.Column{InsurancePolicyColumns[6]},
+			},
+		},
+	}
+	// JobRunColumns holds the columns for the "job_run" table.
+	JobRunColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "scheduled_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "started_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "status", Type: field.TypeString, Default: "running"},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "instance", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+	}
+	// JobRunTable holds the schema information for the "job_run" table.
+	JobRunTable = &schema.Table{
+		Name:       "job_run",
+		Columns:    JobRunColumns,
+		PrimaryKey: []*schema.Column{JobRunColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobrun_name_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobRunColumns[1], JobRunColumns[2]},
+			},
+			{
+				Name:    "jobrun_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobRunColumns[3]},
+			},
+		},
+	}
+	// LeaseContractColumns holds the columns for the "lease_contract" table.
+	LeaseContractColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "lessor", Type: field.TypeString, Nullable: true},
+		{Name: "contract_no", Type: field.TypeString, Nullable: true},
+		{Name: "lessee_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "start_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "end_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "monthly_payment", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(12,2)"}},
+		{Name: "mileage_allowance", Type: field.TypeInt64, Nullable: true},
+		{Name: "excess_rate", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(10,2)"}},
+		{Name: "start_mileage", Type: field.TypeInt64, Nullable: true},
+		{Name: "transfer_allowed", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "terminated_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// LeaseContractTable holds the schema information for the "lease_contract" table.
+	LeaseContractTable = &schema.Table{
+		Name:       "lease_contract",
+		Columns:    LeaseContractColumns,
+		PrimaryKey: []*schema.Column{LeaseContractColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lease_contract_car_lease_contracts",
+				Columns:    []*schema.Column{LeaseContractColumns[15]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leasecontract_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{LeaseContractColumns[1]},
+			},
+			{
+				Name:    "leasecontract_car_id_status_end_date",
+				Unique:  false,
+				Columns: []*schema.Column{LeaseContractColumns[15], LeaseContractColumns[12], LeaseContractColumns[6]},
+			},
+		},
+	}
+	// ListingColumns holds the columns for the "listing" table.
+	ListingColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "seller_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "price", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(12,2)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "buyer_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// ListingTable holds the schema information for the "listing" table.
+	ListingTable = &schema.Table{
+		Name:       "listing",
+		Columns:    ListingColumns,
+		PrimaryKey: []*schema.Column{ListingColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "listing_car_listings",
+				Columns:    []*schema.Column{ListingColumns[10]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "listing_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListingColumns[1]},
+			},
+			{
+				Name:    "listing_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ListingColumns[5], ListingColumns[6]},
+			},
+			{
+				Name:    "listing_car_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListingColumns[10]},
+			},
+			{
+				Name:    "listing_seller_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListingColumns[2]},
+			},
+		},
+	}
+	// MaintenanceRecordColumns holds the columns for the "maintenance_record" table.
+	MaintenanceRecordColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "serviced_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "mileage", Type: field.TypeInt64, Nullable: true},
+		{Name: "type", Type: field.TypeString, Nullable: true},
+		{Name: "cost", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(10,2)"}},
+		{Name: "shop", Type: field.TypeString, Nullable: true},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// MaintenanceRecordTable holds the schema information for the "maintenance_record" table.
+	MaintenanceRecordTable = &schema.Table{
+		Name:       "maintenance_record",
+		Columns:    MaintenanceRecordColumns,
+		PrimaryKey: []*schema.Column{MaintenanceRecordColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "maintenance_record_car_maintenance_records",
+				Columns:    []*schema.Column{MaintenanceRecordColumns[8]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "maintenancerecord_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{MaintenanceRecordColumns[1]},
+			},
+			{
+				Name:    "maintenancerecord_car_id_serviced_at",
+				Unique:  false,
+				Columns: []*schema.Column{MaintenanceRecordColumns[8], MaintenanceRecordColumns[2]},
+			},
+		},
+	}
+	// OdometerReadingColumns holds the columns for the "odometer_reading" table.
+	OdometerReadingColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "mileage", Type: field.TypeInt64, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "suspicious", Type: field.TypeBool, Default: false},
+		{Name: "recorded_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// OdometerReadingTable holds the schema information for the "odometer_reading" table.
+	OdometerReadingTable = &schema.Table{
+		Name:       "odometer_reading",
+		Columns:    OdometerReadingColumns,
+		PrimaryKey: []*schema.Column{OdometerReadingColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "odometer_reading_car_odometer_readings",
+				Columns:    []*schema.Column{OdometerReadingColumns[6]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "odometerreading_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{OdometerReadingColumns[1]},
+			},
+			{
+				Name:    "odometerreading_car_id_recorded_at",
+				Unique:  false,
+				Columns: []*schema.Column{OdometerReadingColumns[6], OdometerReadingColumns[5]},
+			},
+		},
+	}
+	// OwnerHistoryColumns holds the columns for the "owner_history" table.
+	OwnerHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// OwnerHistoryTable holds the schema information for the "owner_history" table.
+	OwnerHistoryTable = &schema.Table{
+		Name:       "owner_history",
+		Columns:    OwnerHistoryColumns,
+		PrimaryKey: []*schema.Column{OwnerHistoryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "owner_history_car_owner_histories",
+				Columns:    []*schema.Column{OwnerHistoryColumns[5]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ownerhistory_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{OwnerHistoryColumns[1]},
+			},
+			{
+				Name:    "ownerhistory_car_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{OwnerHistoryColumns[5], OwnerHistoryColumns[3]},
+			},
+			{
+				Name:    "ownerhistory_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OwnerHistoryColumns[2]},
+			},
+		},
+	}
+	// RecallColumns holds the columns for the "recall" table.
+	RecallColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "campaign_no", Type: field.TypeString},
+		{Name: "manufacturer", Type: field.TypeString, Nullable: true},
+		{Name: "model_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "year_from", Type: field.TypeInt, Nullable: true},
+		{Name: "year_to", Type: field.TypeInt, Nullable: true},
+		{Name: "vin_from", Type: field.TypeString, Nullable: true},
+		{Name: "vin_to", Type: field.TypeString, Nullable: true},
+		{Name: "component", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "remedy", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "issued_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+	}
+	// RecallTable holds the schema information for the "recall" table.
+	RecallTable = &schema.Table{
+		Name:       "recall",
+		Columns:    RecallColumns,
+		PrimaryKey: []*schema.Column{RecallColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recall_campaign_no",
+				Unique:  true,
+				Columns: []*schema.Column{RecallColumns[1]},
+			},
+			{
+				Name:    "recall_model_id",
+				Unique:  false,
+				Columns: []*schema.Column{RecallColumns[3]},
+			},
+		},
+	}
+	// RefuelColumns holds the columns for the "refuel" table.
+	RefuelColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(10,2)"}},
+		{Name: "unit", Type: field.TypeString, Nullable: true},
+		{Name: "cost", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(10,2)"}},
+		{Name: "station", Type: field.TypeString, Nullable: true},
+		{Name: "odometer", Type: field.TypeInt64, Nullable: true},
+		{Name: "refueled_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// RefuelTable holds the schema information for the "refuel" table.
+	RefuelTable = &schema.Table{
+		Name:       "refuel",
+		Columns:    RefuelColumns,
+		PrimaryKey: []*schema.Column{RefuelColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refuel_car_refuels",
+				Columns:    []*schema.Column{RefuelColumns[8]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refuel_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefuelColumns[1]},
+			},
+			{
+				Name:    "refuel_car_id_refueled_at",
+				Unique:  false,
+				Columns: []*schema.Column{RefuelColumns[8], RefuelColumns[7]},
+			},
+		},
+	}
+	// ReservationColumns holds the columns for the "reservation" table.
+	ReservationColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "start_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "purpose", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "booked"},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// ReservationTable holds the schema information for the "reservation" table.
+	ReservationTable = &schema.Table{
+		Name:       "reservation",
+		Columns:    ReservationColumns,
+		PrimaryKey: []*schema.Column{ReservationColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reservation_car_reservations",
+				Columns:    []*schema.Column{ReservationColumns[9]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reservation_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationColumns[1]},
+			},
+			{
+				Name:    "reservation_car_id_start_at_end_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationColumns[9], ReservationColumns[3], ReservationColumns[4]},
+			},
+			{
+				Name:    "reservation_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationColumns[2]},
+			},
+		},
+	}
+	// TagColumns holds the columns for the "tag" table.
+	TagColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+	}
+	// TagTable holds the schema information for the "tag" table.
+	TagTable = &schema.Table{
+		Name:       "tag",
+		Columns:    TagColumns,
+		PrimaryKey: []*schema.Column{TagColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TagColumns[1]},
+			},
+			{
+				Name:    "tag_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TagColumns[1], TagColumns[2]},
+			},
+		},
+	}
+	// TradeRecordColumns holds the columns for the "trade_record" table.
+	TradeRecordColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "model_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "seller_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "buyer_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "price", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(12,2)"}},
+		{Name: "estimated_value", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(12,2)"}},
+		{Name: "mileage", Type: field.TypeInt64, Nullable: true},
+		{Name: "age_months", Type: field.TypeInt, Nullable: true},
+		{Name: "traded_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// TradeRecordTable holds the schema information for the "trade_record" table.
+	TradeRecordTable = &schema.Table{
+		Name:       "trade_record",
+		Columns:    TradeRecordColumns,
+		PrimaryKey: []*schema.Column{TradeRecordColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "trade_record_car_trade_records",
+				Columns:    []*schema.Column{TradeRecordColumns[10]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "traderecord_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TradeRecordColumns[1]},
+			},
+			{
+				Name:    "traderecord_model_id_traded_at",
+				Unique:  false,
+				Columns: []*schema.Column{TradeRecordColumns[2], TradeRecordColumns[9]},
+			},
+			{
+				Name:    "traderecord_car_id",
+				Unique:  false,
+				Columns: []*schema.Column{TradeRecordColumns[10]},
+			},
+		},
+	}
+	// TransferColumns holds the columns for the "transfer" table.
+	TransferColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "seller_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "buyer_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "require_approval", Type: field.TypeBool, Default: false},
+		{Name: "approved_by", Type: field.TypeInt64, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// TransferTable holds the schema information for the "transfer" table.
+	TransferTable = &schema.Table{
+		Name:       "transfer",
+		Columns:    TransferColumns,
+		PrimaryKey: []*schema.Column{TransferColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transfer_car_transfers",
+				Columns:    []*schema.Column{TransferColumns[11]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transfer_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransferColumns[1]},
+			},
+			{
+				Name:    "transfer_car_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{TransferColumns[11], TransferColumns[4]},
+			},
+			{
+				Name:    "transfer_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{TransferColumns[4], TransferColumns[7]},
+			},
+		},
+	}
+	// TripColumns holds the columns for the "trip" table.
+	TripColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "driver_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "purpose", Type: field.TypeString, Nullable: true},
+		{Name: "start_odometer", Type: field.TypeInt64, Nullable: true},
+		{Name: "end_odometer", Type: field.TypeInt64, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// TripTable holds the schema information for the "trip" table.
+	TripTable = &schema.Table{
+		Name:       "trip",
+		Columns:    TripColumns,
+		PrimaryKey: []*schema.Column{TripColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "trip_car_trips",
+				Columns:    []*schema.Column{TripColumns[9]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trip_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TripColumns[1]},
+			},
+			{
+				Name:    "trip_car_id_ended_at",
+				Unique:  false,
+				Columns: []*schema.Column{TripColumns[9], TripColumns[7]},
+			},
+			{
+				Name:    "trip_driver_id",
+				Unique:  false,
+				Columns: []*schema.Column{TripColumns[2]},
+			},
+		},
+	}
+	// VehicleModelColumns holds the columns for the "vehicle_model" table.
+	VehicleModelColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "fuel_type", Type: field.TypeString, Nullable: true},
+		{Name: "seats", Type: field.TypeInt32, Nullable: true},
+		{Name: "body_type", Type: field.TypeString, Nullable: true},
+		{Name: "brand_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// VehicleModelTable holds the schema information for the "vehicle_model" table.
+	VehicleModelTable = &schema.Table{
+		Name:       "vehicle_model",
+		Columns:    VehicleModelColumns,
+		PrimaryKey: []*schema.Column{VehicleModelColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vehicle_model_brand_vehicle_models",
+				Columns:    []*schema.Column{VehicleModelColumns[5]},
+				RefColumns: []*schema.Column{BrandColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vehiclemodel_brand_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{VehicleModelColumns[5], VehicleModelColumns[1]},
+			},
+		},
+	}
+	// ViolationColumns holds the columns for the "violation" table.
+	ViolationColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "reference_no", Type: field.TypeString, Nullable: true},
+		{Name: "occurred_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(10,2)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "unpaid"},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "resolved_by", Type: field.TypeInt64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "car_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// ViolationTable holds the schema information for the "violation" table.
+	ViolationTable = &schema.Table{
+		Name:       "violation",
+		Columns:    ViolationColumns,
+		PrimaryKey: []*schema.Column{ViolationColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "violation_car_violations",
+				Columns:    []*schema.Column{ViolationColumns[12]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "violation_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ViolationColumns[1]},
+			},
+			{
+				Name:    "violation_tenant_id_reference_no",
+				Unique:  true,
+				Columns: []*schema.Column{ViolationColumns[1], ViolationColumns[3]},
+			},
+			{
+				Name:    "violation_car_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{ViolationColumns[12], ViolationColumns[4]},
+			},
+			{
+				Name:    "violation_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ViolationColumns[2], ViolationColumns[8]},
+			},
+		},
+	}
+	// WarrantyDefinitionColumns holds the columns for the "warranty_definition" table.
+	WarrantyDefinitionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "model_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "components", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "duration_months", Type: field.TypeInt, Nullable: true},
+		{Name: "mileage_limit", Type: field.TypeInt64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+	}
+	// WarrantyDefinitionTable holds the schema information for the "warranty_definition" table.
+	WarrantyDefinitionTable = &schema.Table{
+		Name:       "warranty_definition",
+		Columns:    WarrantyDefinitionColumns,
+		PrimaryKey: []*schema.Column{WarrantyDefinitionColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warrantydefinition_model_id",
+				Unique:  false,
+				Columns: []*schema.Column{WarrantyDefinitionColumns[1]},
+			},
+		},
+	}
+	// FleetCarsColumns holds the columns for the "fleet_cars" table.
+	FleetCarsColumns = []*schema.Column{
+		{Name: "fleet_id", Type: field.TypeInt64},
+		{Name: "car_id", Type: field.TypeInt64},
+	}
+	// FleetCarsTable holds the schema information for the "fleet_cars" table.
+	FleetCarsTable = &schema.Table{
+		Name:       "fleet_cars",
+		Columns:    FleetCarsColumns,
+		PrimaryKey: []*schema.Column{FleetCarsColumns[0], FleetCarsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "fleet_cars_fleet_id",
+				Columns:    []*schema.Column{FleetCarsColumns[0]},
+				RefColumns: []*schema.Column{FleetColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "fleet_cars_car_id",
+				Columns:    []*schema.Column{FleetCarsColumns[1]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// GeofenceCarsColumns holds the columns for the "geofence_cars" table.
+	GeofenceCarsColumns = []*schema.Column{
+		{Name: "geofence_id", Type: field.TypeInt64},
+		{Name: "car_id", Type: field.TypeInt64},
+	}
+	// GeofenceCarsTable holds the schema information for the "geofence_cars" table.
+	GeofenceCarsTable = &schema.Table{
+		Name:       "geofence_cars",
+		Columns:    GeofenceCarsColumns,
+		PrimaryKey: []*schema.Column{GeofenceCarsColumns[0], GeofenceCarsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "geofence_cars_geofence_id",
+				Columns:    []*schema.Column{GeofenceCarsColumns[0]},
+				RefColumns: []*schema.Column{GeofenceColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "geofence_cars_car_id",
+				Columns:    []*schema.Column{GeofenceCarsColumns[1]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagCarsColumns holds the columns for the "tag_cars" table.
+	TagCarsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeInt64},
+		{Name: "car_id", Type: field.TypeInt64},
+	}
+	// TagCarsTable holds the schema information for the "tag_cars" table.
+	TagCarsTable = &schema.Table{
+		Name:       "tag_cars",
+		Columns:    TagCarsColumns,
+		PrimaryKey: []*schema.Column{TagCarsColumns[0], TagCarsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_cars_tag_id",
+				Columns:    []*schema.Column{TagCarsColumns[0]},
+				RefColumns: []*schema.Column{TagColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_cars_car_id",
+				Columns:    []*schema.Column{TagCarsColumns[1]},
+				RefColumns: []*schema.Column{CarColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AttachmentTable,
+		AttributeDefinitionTable,
+		AuditLogTable,
+		BrandTable,
 		CarTable,
+		CarAttributeTable,
+		CarRecallTable,
+		CarWarrantyTable,
+		ChargingSessionTable,
+		FleetTable,
+		GeofenceTable,
+		IncidentTable,
+		InsurancePolicyTable,
+		JobRunTable,
+		LeaseContractTable,
+		ListingTable,
+		MaintenanceRecordTable,
+		OdometerReadingTable,
+		OwnerHistoryTable,
+		RecallTable,
+		RefuelTable,
+		ReservationTable,
+		TagTable,
+		TradeRecordTable,
+		TransferTable,
+		TripTable,
+		VehicleModelTable,
+		ViolationTable,
+		WarrantyDefinitionTable,
+		FleetCarsTable,
+		GeofenceCarsTable,
+		TagCarsTable,
 	}
 )
 
 func init() {
+	AttachmentTable.ForeignKeys[0].RefTable = CarTable
+	AttachmentTable.Annotation = &entsql.Annotation{
+		Table: "attachment",
+	}
+	AttributeDefinitionTable.Annotation = &entsql.Annotation{
+		Table: "attribute_definition",
+	}
+	AuditLogTable.Annotation = &entsql.Annotation{
+		Table: "audit_log",
+	}
+	BrandTable.Annotation = &entsql.Annotation{
+		Table: "brand",
+	}
+	CarTable.ForeignKeys[0].RefTable = VehicleModelTable
 	CarTable.Annotation = &entsql.Annotation{
 		Table: "car",
 	}
+	CarAttributeTable.ForeignKeys[0].RefTable = AttributeDefinitionTable
+	CarAttributeTable.ForeignKeys[1].RefTable = CarTable
+	CarAttributeTable.Annotation = &entsql.Annotation{
+		Table: "car_attribute",
+	}
+	CarRecallTable.ForeignKeys[0].RefTable = CarTable
+	CarRecallTable.ForeignKeys[1].RefTable = RecallTable
+	CarRecallTable.Annotation = &entsql.Annotation{
+		Table: "car_recall",
+	}
+	CarWarrantyTable.ForeignKeys[0].RefTable = CarTable
+	CarWarrantyTable.ForeignKeys[1].RefTable = WarrantyDefinitionTable
+	CarWarrantyTable.Annotation = &entsql.Annotation{
+		Table: "car_warranty",
+	}
+	ChargingSessionTable.ForeignKeys[0].RefTable = CarTable
+	ChargingSessionTable.Annotation = &entsql.Annotation{
+		Table: "charging_session",
+	}
+	FleetTable.Annotation = &entsql.Annotation{
+		Table: "fleet",
+	}
+	GeofenceTable.Annotation = &entsql.Annotation{
+		Table: "geofence",
+	}
+	IncidentTable.ForeignKeys[0].RefTable = CarTable
+	IncidentTable.ForeignKeys[1].RefTable = InsurancePolicyTable
+	IncidentTable.Annotation = &entsql.Annotation{
+		Table: "incident",
+	}
+	InsurancePolicyTable.ForeignKeys[0].RefTable = CarTable
+	InsurancePolicyTable.Annotation = &entsql.Annotation{
+		Table: "insurance_policy",
+	}
+	JobRunTable.Annotation = &entsql.Annotation{
+		Table: "job_run",
+	}
+	LeaseContractTable.ForeignKeys[0].RefTable = CarTable
+	LeaseContractTable.Annotation = &entsql.Annotation{
+		Table: "lease_contract",
+	}
+	ListingTable.ForeignKeys[0].RefTable = CarTable
+	ListingTable.Annotation = &entsql.Annotation{
+		Table: "listing",
+	}
+	MaintenanceRecordTable.ForeignKeys[0].RefTable = CarTable
+	MaintenanceRecordTable.Annotation = &entsql.Annotation{
+		Table: "maintenance_record",
+	}
+	OdometerReadingTable.ForeignKeys[0].RefTable = CarTable
+	OdometerReadingTable.Annotation = &entsql.Annotation{
+		Table: "odometer_reading",
+	}
+	OwnerHistoryTable.ForeignKeys[0].RefTable = CarTable
+	OwnerHistoryTable.Annotation = &entsql.Annotation{
+		Table: "owner_history",
+	}
+	RecallTable.Annotation = &entsql.Annotation{
+		Table: "recall",
+	}
+	RefuelTable.ForeignKeys[0].RefTable = CarTable
+	RefuelTable.Annotation = &entsql.Annotation{
+		Table: "refuel",
+	}
+	ReservationTable.ForeignKeys[0].RefTable = CarTable
+	ReservationTable.Annotation = &entsql.Annotation{
+		Table: "reservation",
+	}
+	TagTable.Annotation = &entsql.Annotation{
+		Table: "tag",
+	}
+	TradeRecordTable.ForeignKeys[0].RefTable = CarTable
+	TradeRecordTable.Annotation = &entsql.Annotation{
+		Table: "trade_record",
+	}
+	TransferTable.ForeignKeys[0].RefTable = CarTable
+	TransferTable.Annotation = &entsql.Annotation{
+		Table: "transfer",
+	}
+	TripTable.ForeignKeys[0].RefTable = CarTable
+	TripTable.Annotation = &entsql.Annotation{
+		Table: "trip",
+	}
+	VehicleModelTable.ForeignKeys[0].RefTable = BrandTable
+	VehicleModelTable.Annotation = &entsql.Annotation{
+		Table: "vehicle_model",
+	}
+	ViolationTable.ForeignKeys[0].RefTable = CarTable
+	ViolationTable.Annotation = &entsql.Annotation{
+		Table: "violation",
+	}
+	WarrantyDefinitionTable.Annotation = &entsql.Annotation{
+		Table: "warranty_definition",
+	}
+	FleetCarsTable.ForeignKeys[0].RefTable = FleetTable
+	FleetCarsTable.ForeignKeys[1].RefTable = CarTable
+	GeofenceCarsTable.ForeignKeys[0].RefTable = GeofenceTable
+	GeofenceCarsTable.ForeignKeys[1].RefTable = CarTable
+	TagCarsTable.ForeignKeys[0].RefTable = TagTable
+	TagCarsTable.ForeignKeys[1].RefTable = CarTable
 }
@@ -3,8 +3,36 @@
 package ent
 
 import (
+	"car-service/internal/data/ent/attachment"
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/auditlog"
+	"car-service/internal/data/ent/brand"
 	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carattribute"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/jobrun"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/ownerhistory"
 	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/recall"
+	"car-service/internal/data/ent/refuel"
+	"car-service/internal/data/ent/reservation"
+	"car-service/internal/data/ent/tag"
+	"car-service/internal/data/ent/traderecord"
+	"car-service/internal/data/ent/transfer"
+	"car-service/internal/data/ent/trip"
+	"car-service/internal/data/ent/vehiclemodel"
+	"car-service/internal/data/ent/violation"
+	"car-service/internal/data/ent/warrantydefinition"
 	"context"
 	"errors"
 	"fmt"
@@ -23,36 +51,72 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCar = "Car"
+	TypeAttachment          = "Attachment"
+	TypeAttributeDefinition = "AttributeDefinition"
+	TypeAuditLog            = "AuditLog"
+	TypeBrand               = "Brand"
+	TypeCar                 = "Car"
+	TypeCarAttribute        = "CarAttribute"
+	TypeCarRecall           = "CarRecall"
+	TypeCarWarranty         = "CarWarranty"
+	TypeChargingSession     = "ChargingSession"
+	TypeFleet               = "Fleet"
+	TypeGeofence            = "Geofence"
+	TypeIncident            = "Incident"
+	TypeInsurancePolicy     = "InsurancePolicy"
+	TypeJobRun              = "JobRun"
+	TypeLeaseContract       = "LeaseContract"
+	TypeListing             = "Listing"
+	TypeMaintenanceRecord   = "MaintenanceRecord"
+	TypeOdometerReading     = "OdometerReading"
+	TypeOwnerHistory        = "OwnerHistory"
+	TypeRecall              = "Recall"
+	TypeRefuel              = "Refuel"
+	TypeReservation         = "Reservation"
+	TypeTag                 = "Tag"
+	TypeTradeRecord         = "TradeRecord"
+	TypeTransfer            = "Transfer"
+	TypeTrip                = "Trip"
+	TypeVehicleModel        = "VehicleModel"
+	TypeViolation           = "Violation"
+	TypeWarrantyDefinition  = "WarrantyDefinition"
 )
 
-// CarMutation represents an operation that mutates the Car nodes in the graph.
-type CarMutation struct {
+// AttachmentMutation represents an operation that mutates the Attachment nodes in the graph.
+type AttachmentMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int64
-	user_id       *int64
-	adduser_id    *int64
-	model         *string
-	registered_at *time.Time
+	tenant_id     *int64
+	addtenant_id  *int64
+	kind          *string
+	file_name     *string
+	content_type  *string
+	size          *int64
+	addsize       *int64
+	checksum      *string
+	storage_key   *string
+	created_at    *time.Time
 	clearedFields map[string]struct{}
+	car           *int64
+	clearedcar    bool
 	done          bool
-	oldValue      func(context.Context) (*Car, error)
-	predicates    []predicate.Car
+	oldValue      func(context.Context) (*Attachment, error)
+	predicates    []predicate.Attachment
 }
 
-var _ ent.Mutation = (*CarMutation)(nil)
+var _ ent.Mutation = (*AttachmentMutation)(nil)
 
-// carOption allows management of the mutation configuration using functional options.
-type carOption func(*CarMutation)
+// attachmentOption allows management of the mutation configuration using functional options.
+type attachmentOption func(*AttachmentMutation)
 
-// newCarMutation creates new mutation for the Car entity.
-func newCarMutation(c config, op Op, opts ...carOption) *CarMutation {
-	m := &CarMutation{
+// newAttachmentMutation creates new mutation for the Attachment entity.
+func newAttachmentMutation(c config, op Op, opts ...attachmentOption) *AttachmentMutation {
+	m := &AttachmentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCar,
+		typ:           TypeAttachment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -61,20 +125,20 @@ func newCarMutation(c config, op Op, opts ...carOption) *CarMutation {
 	return m
 }
 
-// withCarID sets the ID field of the mutation.
-func withCarID(id int64) carOption {
-	return func(m *CarMutation) {
+// withAttachmentID sets the ID field of the mutation.
+func withAttachmentID(id int64) attachmentOption {
+	return func(m *AttachmentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Car
+			value *Attachment
 		)
-		m.oldValue = func(ctx context.Context) (*Car, error) {
+		m.oldValue = func(ctx context.Context) (*Attachment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Car.Get(ctx, id)
+					value, err = m.Client().Attachment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -83,10 +147,10 @@ func withCarID(id int64) carOption {
 	}
 }
 
-// withCar sets the old Car of the mutation.
-func withCar(node *Car) carOption {
-	return func(m *CarMutation) {
-		m.oldValue = func(context.Context) (*Car, error) {
+// withAttachment sets the old Attachment of the mutation.
+func withAttachment(node *Attachment) attachmentOption {
+	return func(m *AttachmentMutation) {
+		m.oldValue = func(context.Context) (*Attachment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -95,7 +159,7 @@ func withCar(node *Car) carOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CarMutation) Client() *Client {
+func (m AttachmentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -103,7 +167,7 @@ func (m CarMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CarMutation) Tx() (*Tx, error) {
+func (m AttachmentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -113,222 +177,30606 @@ func (m CarMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Car entities.
-func (m *CarMutation) SetID(id int64) {
+// operation is only accepted on creation of Attachment entities.
+func (m *AttachmentMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CarMutation) ID() (id int64, exists bool) {
+func (m *AttachmentMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *CarMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Car.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AttachmentMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Attachment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *AttachmentMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *AttachmentMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *AttachmentMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *AttachmentMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *AttachmentMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[attachment.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *AttachmentMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *AttachmentMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, attachment.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *AttachmentMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *AttachmentMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *AttachmentMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[attachment.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *AttachmentMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *AttachmentMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, attachment.FieldCarID)
+}
+
+// SetKind sets the "kind" field.
+func (m *AttachmentMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *AttachmentMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ClearKind clears the value of the "kind" field.
+func (m *AttachmentMutation) ClearKind() {
+	m.kind = nil
+	m.clearedFields[attachment.FieldKind] = struct{}{}
+}
+
+// KindCleared returns if the "kind" field was cleared in this mutation.
+func (m *AttachmentMutation) KindCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldKind]
+	return ok
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *AttachmentMutation) ResetKind() {
+	m.kind = nil
+	delete(m.clearedFields, attachment.FieldKind)
+}
+
+// SetFileName sets the "file_name" field.
+func (m *AttachmentMutation) SetFileName(s string) {
+	m.file_name = &s
+}
+
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *AttachmentMutation) FileName() (r string, exists bool) {
+	v := m.file_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileName returns the old "file_name" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldFileName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
+	}
+	return oldValue.FileName, nil
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (m *AttachmentMutation) ClearFileName() {
+	m.file_name = nil
+	m.clearedFields[attachment.FieldFileName] = struct{}{}
+}
+
+// FileNameCleared returns if the "file_name" field was cleared in this mutation.
+func (m *AttachmentMutation) FileNameCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldFileName]
+	return ok
+}
+
+// ResetFileName resets all changes to the "file_name" field.
+func (m *AttachmentMutation) ResetFileName() {
+	m.file_name = nil
+	delete(m.clearedFields, attachment.FieldFileName)
+}
+
+// SetContentType sets the "content_type" field.
+func (m *AttachmentMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *AttachmentMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (m *AttachmentMutation) ClearContentType() {
+	m.content_type = nil
+	m.clearedFields[attachment.FieldContentType] = struct{}{}
+}
+
+// ContentTypeCleared returns if the "content_type" field was cleared in this mutation.
+func (m *AttachmentMutation) ContentTypeCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldContentType]
+	return ok
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *AttachmentMutation) ResetContentType() {
+	m.content_type = nil
+	delete(m.clearedFields, attachment.FieldContentType)
+}
+
+// SetSize sets the "size" field.
+func (m *AttachmentMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *AttachmentMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *AttachmentMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *AttachmentMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSize clears the value of the "size" field.
+func (m *AttachmentMutation) ClearSize() {
+	m.size = nil
+	m.addsize = nil
+	m.clearedFields[attachment.FieldSize] = struct{}{}
+}
+
+// SizeCleared returns if the "size" field was cleared in this mutation.
+func (m *AttachmentMutation) SizeCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldSize]
+	return ok
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *AttachmentMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+	delete(m.clearedFields, attachment.FieldSize)
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *AttachmentMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *AttachmentMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (m *AttachmentMutation) ClearChecksum() {
+	m.checksum = nil
+	m.clearedFields[attachment.FieldChecksum] = struct{}{}
+}
+
+// ChecksumCleared returns if the "checksum" field was cleared in this mutation.
+func (m *AttachmentMutation) ChecksumCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldChecksum]
+	return ok
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *AttachmentMutation) ResetChecksum() {
+	m.checksum = nil
+	delete(m.clearedFields, attachment.FieldChecksum)
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (m *AttachmentMutation) SetStorageKey(s string) {
+	m.storage_key = &s
+}
+
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *AttachmentMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageKey returns the old "storage_key" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+	}
+	return oldValue.StorageKey, nil
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (m *AttachmentMutation) ClearStorageKey() {
+	m.storage_key = nil
+	m.clearedFields[attachment.FieldStorageKey] = struct{}{}
+}
+
+// StorageKeyCleared returns if the "storage_key" field was cleared in this mutation.
+func (m *AttachmentMutation) StorageKeyCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldStorageKey]
+	return ok
+}
+
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *AttachmentMutation) ResetStorageKey() {
+	m.storage_key = nil
+	delete(m.clearedFields, attachment.FieldStorageKey)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AttachmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AttachmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AttachmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *AttachmentMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *AttachmentMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *AttachmentMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *AttachmentMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the AttachmentMutation builder.
+func (m *AttachmentMutation) Where(ps ...predicate.Attachment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *AttachmentMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Attachment).
+func (m *AttachmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AttachmentMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.tenant_id != nil {
+		fields = append(fields, attachment.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, attachment.FieldCarID)
+	}
+	if m.kind != nil {
+		fields = append(fields, attachment.FieldKind)
+	}
+	if m.file_name != nil {
+		fields = append(fields, attachment.FieldFileName)
+	}
+	if m.content_type != nil {
+		fields = append(fields, attachment.FieldContentType)
+	}
+	if m.size != nil {
+		fields = append(fields, attachment.FieldSize)
+	}
+	if m.checksum != nil {
+		fields = append(fields, attachment.FieldChecksum)
+	}
+	if m.storage_key != nil {
+		fields = append(fields, attachment.FieldStorageKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, attachment.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AttachmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case attachment.FieldTenantID:
+		return m.TenantID()
+	case attachment.FieldCarID:
+		return m.CarID()
+	case attachment.FieldKind:
+		return m.Kind()
+	case attachment.FieldFileName:
+		return m.FileName()
+	case attachment.FieldContentType:
+		return m.ContentType()
+	case attachment.FieldSize:
+		return m.Size()
+	case attachment.FieldChecksum:
+		return m.Checksum()
+	case attachment.FieldStorageKey:
+		return m.StorageKey()
+	case attachment.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AttachmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case attachment.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case attachment.FieldCarID:
+		return m.OldCarID(ctx)
+	case attachment.FieldKind:
+		return m.OldKind(ctx)
+	case attachment.FieldFileName:
+		return m.OldFileName(ctx)
+	case attachment.FieldContentType:
+		return m.OldContentType(ctx)
+	case attachment.FieldSize:
+		return m.OldSize(ctx)
+	case attachment.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case attachment.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case attachment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Attachment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttachmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case attachment.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case attachment.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case attachment.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case attachment.FieldFileName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileName(v)
+		return nil
+	case attachment.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case attachment.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case attachment.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case attachment.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case attachment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AttachmentMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, attachment.FieldTenantID)
+	}
+	if m.addsize != nil {
+		fields = append(fields, attachment.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AttachmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case attachment.FieldTenantID:
+		return m.AddedTenantID()
+	case attachment.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttachmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case attachment.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case attachment.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AttachmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(attachment.FieldTenantID) {
+		fields = append(fields, attachment.FieldTenantID)
+	}
+	if m.FieldCleared(attachment.FieldCarID) {
+		fields = append(fields, attachment.FieldCarID)
+	}
+	if m.FieldCleared(attachment.FieldKind) {
+		fields = append(fields, attachment.FieldKind)
+	}
+	if m.FieldCleared(attachment.FieldFileName) {
+		fields = append(fields, attachment.FieldFileName)
+	}
+	if m.FieldCleared(attachment.FieldContentType) {
+		fields = append(fields, attachment.FieldContentType)
+	}
+	if m.FieldCleared(attachment.FieldSize) {
+		fields = append(fields, attachment.FieldSize)
+	}
+	if m.FieldCleared(attachment.FieldChecksum) {
+		fields = append(fields, attachment.FieldChecksum)
+	}
+	if m.FieldCleared(attachment.FieldStorageKey) {
+		fields = append(fields, attachment.FieldStorageKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AttachmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AttachmentMutation) ClearField(name string) error {
+	switch name {
+	case attachment.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case attachment.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case attachment.FieldKind:
+		m.ClearKind()
+		return nil
+	case attachment.FieldFileName:
+		m.ClearFileName()
+		return nil
+	case attachment.FieldContentType:
+		m.ClearContentType()
+		return nil
+	case attachment.FieldSize:
+		m.ClearSize()
+		return nil
+	case attachment.FieldChecksum:
+		m.ClearChecksum()
+		return nil
+	case attachment.FieldStorageKey:
+		m.ClearStorageKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AttachmentMutation) ResetField(name string) error {
+	switch name {
+	case attachment.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case attachment.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case attachment.FieldKind:
+		m.ResetKind()
+		return nil
+	case attachment.FieldFileName:
+		m.ResetFileName()
+		return nil
+	case attachment.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case attachment.FieldSize:
+		m.ResetSize()
+		return nil
+	case attachment.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case attachment.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case attachment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AttachmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, attachment.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AttachmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case attachment.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AttachmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AttachmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AttachmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, attachment.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AttachmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case attachment.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AttachmentMutation) ClearEdge(name string) error {
+	switch name {
+	case attachment.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AttachmentMutation) ResetEdge(name string) error {
+	switch name {
+	case attachment.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment edge %s", name)
+}
+
+// AttributeDefinitionMutation represents an operation that mutates the AttributeDefinition nodes in the graph.
+type AttributeDefinitionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	name          *string
+	value_type    *string
+	required      *bool
+	enum_values   *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	values        map[int64]struct{}
+	removedvalues map[int64]struct{}
+	clearedvalues bool
+	done          bool
+	oldValue      func(context.Context) (*AttributeDefinition, error)
+	predicates    []predicate.AttributeDefinition
+}
+
+var _ ent.Mutation = (*AttributeDefinitionMutation)(nil)
+
+// attributedefinitionOption allows management of the mutation configuration using functional options.
+type attributedefinitionOption func(*AttributeDefinitionMutation)
+
+// newAttributeDefinitionMutation creates new mutation for the AttributeDefinition entity.
+func newAttributeDefinitionMutation(c config, op Op, opts ...attributedefinitionOption) *AttributeDefinitionMutation {
+	m := &AttributeDefinitionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAttributeDefinition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAttributeDefinitionID sets the ID field of the mutation.
+func withAttributeDefinitionID(id int64) attributedefinitionOption {
+	return func(m *AttributeDefinitionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AttributeDefinition
+		)
+		m.oldValue = func(ctx context.Context) (*AttributeDefinition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AttributeDefinition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAttributeDefinition sets the old AttributeDefinition of the mutation.
+func withAttributeDefinition(node *AttributeDefinition) attributedefinitionOption {
+	return func(m *AttributeDefinitionMutation) {
+		m.oldValue = func(context.Context) (*AttributeDefinition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AttributeDefinitionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AttributeDefinitionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AttributeDefinition entities.
+func (m *AttributeDefinitionMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AttributeDefinitionMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AttributeDefinitionMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AttributeDefinition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *AttributeDefinitionMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *AttributeDefinitionMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the AttributeDefinition entity.
+// If the AttributeDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttributeDefinitionMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *AttributeDefinitionMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *AttributeDefinitionMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *AttributeDefinitionMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[attributedefinition.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *AttributeDefinitionMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[attributedefinition.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *AttributeDefinitionMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, attributedefinition.FieldTenantID)
+}
+
+// SetName sets the "name" field.
+func (m *AttributeDefinitionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *AttributeDefinitionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the AttributeDefinition entity.
+// If the AttributeDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttributeDefinitionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *AttributeDefinitionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetValueType sets the "value_type" field.
+func (m *AttributeDefinitionMutation) SetValueType(s string) {
+	m.value_type = &s
+}
+
+// ValueType returns the value of the "value_type" field in the mutation.
+func (m *AttributeDefinitionMutation) ValueType() (r string, exists bool) {
+	v := m.value_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValueType returns the old "value_type" field's value of the AttributeDefinition entity.
+// If the AttributeDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttributeDefinitionMutation) OldValueType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValueType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValueType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValueType: %w", err)
+	}
+	return oldValue.ValueType, nil
+}
+
+// ClearValueType clears the value of the "value_type" field.
+func (m *AttributeDefinitionMutation) ClearValueType() {
+	m.value_type = nil
+	m.clearedFields[attributedefinition.FieldValueType] = struct{}{}
+}
+
+// ValueTypeCleared returns if the "value_type" field was cleared in this mutation.
+func (m *AttributeDefinitionMutation) ValueTypeCleared() bool {
+	_, ok := m.clearedFields[attributedefinition.FieldValueType]
+	return ok
+}
+
+// ResetValueType resets all changes to the "value_type" field.
+func (m *AttributeDefinitionMutation) ResetValueType() {
+	m.value_type = nil
+	delete(m.clearedFields, attributedefinition.FieldValueType)
+}
+
+// SetRequired sets the "required" field.
+func (m *AttributeDefinitionMutation) SetRequired(b bool) {
+	m.required = &b
+}
+
+// Required returns the value of the "required" field in the mutation.
+func (m *AttributeDefinitionMutation) Required() (r bool, exists bool) {
+	v := m.required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequired returns the old "required" field's value of the AttributeDefinition entity.
+// If the AttributeDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttributeDefinitionMutation) OldRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequired: %w", err)
+	}
+	return oldValue.Required, nil
+}
+
+// ResetRequired resets all changes to the "required" field.
+func (m *AttributeDefinitionMutation) ResetRequired() {
+	m.required = nil
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (m *AttributeDefinitionMutation) SetEnumValues(s string) {
+	m.enum_values = &s
+}
+
+// EnumValues returns the value of the "enum_values" field in the mutation.
+func (m *AttributeDefinitionMutation) EnumValues() (r string, exists bool) {
+	v := m.enum_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnumValues returns the old "enum_values" field's value of the AttributeDefinition entity.
+// If the AttributeDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttributeDefinitionMutation) OldEnumValues(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnumValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnumValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnumValues: %w", err)
+	}
+	return oldValue.EnumValues, nil
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (m *AttributeDefinitionMutation) ClearEnumValues() {
+	m.enum_values = nil
+	m.clearedFields[attributedefinition.FieldEnumValues] = struct{}{}
+}
+
+// EnumValuesCleared returns if the "enum_values" field was cleared in this mutation.
+func (m *AttributeDefinitionMutation) EnumValuesCleared() bool {
+	_, ok := m.clearedFields[attributedefinition.FieldEnumValues]
+	return ok
+}
+
+// ResetEnumValues resets all changes to the "enum_values" field.
+func (m *AttributeDefinitionMutation) ResetEnumValues() {
+	m.enum_values = nil
+	delete(m.clearedFields, attributedefinition.FieldEnumValues)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AttributeDefinitionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AttributeDefinitionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AttributeDefinition entity.
+// If the AttributeDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttributeDefinitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AttributeDefinitionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddValueIDs adds the "values" edge to the CarAttribute entity by ids.
+func (m *AttributeDefinitionMutation) AddValueIDs(ids ...int64) {
+	if m.values == nil {
+		m.values = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.values[ids[i]] = struct{}{}
+	}
+}
+
+// ClearValues clears the "values" edge to the CarAttribute entity.
+func (m *AttributeDefinitionMutation) ClearValues() {
+	m.clearedvalues = true
+}
+
+// ValuesCleared reports if the "values" edge to the CarAttribute entity was cleared.
+func (m *AttributeDefinitionMutation) ValuesCleared() bool {
+	return m.clearedvalues
+}
+
+// RemoveValueIDs removes the "values" edge to the CarAttribute entity by IDs.
+func (m *AttributeDefinitionMutation) RemoveValueIDs(ids ...int64) {
+	if m.removedvalues == nil {
+		m.removedvalues = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.values, ids[i])
+		m.removedvalues[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedValues returns the removed IDs of the "values" edge to the CarAttribute entity.
+func (m *AttributeDefinitionMutation) RemovedValuesIDs() (ids []int64) {
+	for id := range m.removedvalues {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ValuesIDs returns the "values" edge IDs in the mutation.
+func (m *AttributeDefinitionMutation) ValuesIDs() (ids []int64) {
+	for id := range m.values {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetValues resets all changes to the "values" edge.
+func (m *AttributeDefinitionMutation) ResetValues() {
+	m.values = nil
+	m.clearedvalues = false
+	m.removedvalues = nil
+}
+
+// Where appends a list predicates to the AttributeDefinitionMutation builder.
+func (m *AttributeDefinitionMutation) Where(ps ...predicate.AttributeDefinition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *AttributeDefinitionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (AttributeDefinition).
+func (m *AttributeDefinitionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AttributeDefinitionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.tenant_id != nil {
+		fields = append(fields, attributedefinition.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, attributedefinition.FieldName)
+	}
+	if m.value_type != nil {
+		fields = append(fields, attributedefinition.FieldValueType)
+	}
+	if m.required != nil {
+		fields = append(fields, attributedefinition.FieldRequired)
+	}
+	if m.enum_values != nil {
+		fields = append(fields, attributedefinition.FieldEnumValues)
+	}
+	if m.created_at != nil {
+		fields = append(fields, attributedefinition.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AttributeDefinitionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case attributedefinition.FieldTenantID:
+		return m.TenantID()
+	case attributedefinition.FieldName:
+		return m.Name()
+	case attributedefinition.FieldValueType:
+		return m.ValueType()
+	case attributedefinition.FieldRequired:
+		return m.Required()
+	case attributedefinition.FieldEnumValues:
+		return m.EnumValues()
+	case attributedefinition.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AttributeDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case attributedefinition.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case attributedefinition.FieldName:
+		return m.OldName(ctx)
+	case attributedefinition.FieldValueType:
+		return m.OldValueType(ctx)
+	case attributedefinition.FieldRequired:
+		return m.OldRequired(ctx)
+	case attributedefinition.FieldEnumValues:
+		return m.OldEnumValues(ctx)
+	case attributedefinition.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AttributeDefinition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttributeDefinitionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case attributedefinition.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case attributedefinition.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case attributedefinition.FieldValueType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValueType(v)
+		return nil
+	case attributedefinition.FieldRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequired(v)
+		return nil
+	case attributedefinition.FieldEnumValues:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnumValues(v)
+		return nil
+	case attributedefinition.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AttributeDefinition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AttributeDefinitionMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, attributedefinition.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AttributeDefinitionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case attributedefinition.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttributeDefinitionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case attributedefinition.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AttributeDefinition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AttributeDefinitionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(attributedefinition.FieldTenantID) {
+		fields = append(fields, attributedefinition.FieldTenantID)
+	}
+	if m.FieldCleared(attributedefinition.FieldValueType) {
+		fields = append(fields, attributedefinition.FieldValueType)
+	}
+	if m.FieldCleared(attributedefinition.FieldEnumValues) {
+		fields = append(fields, attributedefinition.FieldEnumValues)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AttributeDefinitionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AttributeDefinitionMutation) ClearField(name string) error {
+	switch name {
+	case attributedefinition.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case attributedefinition.FieldValueType:
+		m.ClearValueType()
+		return nil
+	case attributedefinition.FieldEnumValues:
+		m.ClearEnumValues()
+		return nil
+	}
+	return fmt.Errorf("unknown AttributeDefinition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AttributeDefinitionMutation) ResetField(name string) error {
+	switch name {
+	case attributedefinition.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case attributedefinition.FieldName:
+		m.ResetName()
+		return nil
+	case attributedefinition.FieldValueType:
+		m.ResetValueType()
+		return nil
+	case attributedefinition.FieldRequired:
+		m.ResetRequired()
+		return nil
+	case attributedefinition.FieldEnumValues:
+		m.ResetEnumValues()
+		return nil
+	case attributedefinition.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AttributeDefinition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AttributeDefinitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.values != nil {
+		edges = append(edges, attributedefinition.EdgeValues)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AttributeDefinitionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case attributedefinition.EdgeValues:
+		ids := make([]ent.Value, 0, len(m.values))
+		for id := range m.values {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AttributeDefinitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedvalues != nil {
+		edges = append(edges, attributedefinition.EdgeValues)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AttributeDefinitionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case attributedefinition.EdgeValues:
+		ids := make([]ent.Value, 0, len(m.removedvalues))
+		for id := range m.removedvalues {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AttributeDefinitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedvalues {
+		edges = append(edges, attributedefinition.EdgeValues)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AttributeDefinitionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case attributedefinition.EdgeValues:
+		return m.clearedvalues
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AttributeDefinitionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AttributeDefinition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AttributeDefinitionMutation) ResetEdge(name string) error {
+	switch name {
+	case attributedefinition.EdgeValues:
+		m.ResetValues()
+		return nil
+	}
+	return fmt.Errorf("unknown AttributeDefinition edge %s", name)
+}
+
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	car_id        *int64
+	addcar_id     *int64
+	_op           *string
+	actor         *int64
+	addactor      *int64
+	diff          *string
+	trace_id      *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditLog, error)
+	predicates    []predicate.AuditLog
+}
+
+var _ ent.Mutation = (*AuditLogMutation)(nil)
+
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
+
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id int64) auditlogOption {
+	return func(m *AuditLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditLog
+		)
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuditLog entities.
+func (m *AuditLogMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditLogMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *AuditLogMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *AuditLogMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *AuditLogMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *AuditLogMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *AuditLogMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[auditlog.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *AuditLogMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, auditlog.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *AuditLogMutation) SetCarID(i int64) {
+	m.car_id = &i
+	m.addcar_id = nil
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *AuditLogMutation) CarID() (r int64, exists bool) {
+	v := m.car_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// AddCarID adds i to the "car_id" field.
+func (m *AuditLogMutation) AddCarID(i int64) {
+	if m.addcar_id != nil {
+		*m.addcar_id += i
+	} else {
+		m.addcar_id = &i
+	}
+}
+
+// AddedCarID returns the value that was added to the "car_id" field in this mutation.
+func (m *AuditLogMutation) AddedCarID() (r int64, exists bool) {
+	v := m.addcar_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *AuditLogMutation) ResetCarID() {
+	m.car_id = nil
+	m.addcar_id = nil
+}
+
+// SetOp sets the "op" field.
+func (m *AuditLogMutation) SetOp(s string) {
+	m._op = &s
+}
+
+// GetOp returns the value of the "op" field in the mutation.
+func (m *AuditLogMutation) GetOp() (r string, exists bool) {
+	v := m._op
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOp returns the old "op" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldOp(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOp: %w", err)
+	}
+	return oldValue.Op, nil
+}
+
+// ResetOp resets all changes to the "op" field.
+func (m *AuditLogMutation) ResetOp() {
+	m._op = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *AuditLogMutation) SetActor(i int64) {
+	m.actor = &i
+	m.addactor = nil
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditLogMutation) Actor() (r int64, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldActor(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// AddActor adds i to the "actor" field.
+func (m *AuditLogMutation) AddActor(i int64) {
+	if m.addactor != nil {
+		*m.addactor += i
+	} else {
+		m.addactor = &i
+	}
+}
+
+// AddedActor returns the value that was added to the "actor" field in this mutation.
+func (m *AuditLogMutation) AddedActor() (r int64, exists bool) {
+	v := m.addactor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearActor clears the value of the "actor" field.
+func (m *AuditLogMutation) ClearActor() {
+	m.actor = nil
+	m.addactor = nil
+	m.clearedFields[auditlog.FieldActor] = struct{}{}
+}
+
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *AuditLogMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldActor]
+	return ok
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditLogMutation) ResetActor() {
+	m.actor = nil
+	m.addactor = nil
+	delete(m.clearedFields, auditlog.FieldActor)
+}
+
+// SetDiff sets the "diff" field.
+func (m *AuditLogMutation) SetDiff(s string) {
+	m.diff = &s
+}
+
+// Diff returns the value of the "diff" field in the mutation.
+func (m *AuditLogMutation) Diff() (r string, exists bool) {
+	v := m.diff
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiff returns the old "diff" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldDiff(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiff is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiff requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiff: %w", err)
+	}
+	return oldValue.Diff, nil
+}
+
+// ClearDiff clears the value of the "diff" field.
+func (m *AuditLogMutation) ClearDiff() {
+	m.diff = nil
+	m.clearedFields[auditlog.FieldDiff] = struct{}{}
+}
+
+// DiffCleared returns if the "diff" field was cleared in this mutation.
+func (m *AuditLogMutation) DiffCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldDiff]
+	return ok
+}
+
+// ResetDiff resets all changes to the "diff" field.
+func (m *AuditLogMutation) ResetDiff() {
+	m.diff = nil
+	delete(m.clearedFields, auditlog.FieldDiff)
+}
+
+// SetTraceID sets the "trace_id" field.
+func (m *AuditLogMutation) SetTraceID(s string) {
+	m.trace_id = &s
+}
+
+// TraceID returns the value of the "trace_id" field in the mutation.
+func (m *AuditLogMutation) TraceID() (r string, exists bool) {
+	v := m.trace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTraceID returns the old "trace_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTraceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTraceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTraceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTraceID: %w", err)
+	}
+	return oldValue.TraceID, nil
+}
+
+// ClearTraceID clears the value of the "trace_id" field.
+func (m *AuditLogMutation) ClearTraceID() {
+	m.trace_id = nil
+	m.clearedFields[auditlog.FieldTraceID] = struct{}{}
+}
+
+// TraceIDCleared returns if the "trace_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TraceIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTraceID]
+	return ok
+}
+
+// ResetTraceID resets all changes to the "trace_id" field.
+func (m *AuditLogMutation) ResetTraceID() {
+	m.trace_id = nil
+	delete(m.clearedFields, auditlog.FieldTraceID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *AuditLogMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.tenant_id != nil {
+		fields = append(fields, auditlog.FieldTenantID)
+	}
+	if m.car_id != nil {
+		fields = append(fields, auditlog.FieldCarID)
+	}
+	if m._op != nil {
+		fields = append(fields, auditlog.FieldOp)
+	}
+	if m.actor != nil {
+		fields = append(fields, auditlog.FieldActor)
+	}
+	if m.diff != nil {
+		fields = append(fields, auditlog.FieldDiff)
+	}
+	if m.trace_id != nil {
+		fields = append(fields, auditlog.FieldTraceID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldTenantID:
+		return m.TenantID()
+	case auditlog.FieldCarID:
+		return m.CarID()
+	case auditlog.FieldOp:
+		return m.GetOp()
+	case auditlog.FieldActor:
+		return m.Actor()
+	case auditlog.FieldDiff:
+		return m.Diff()
+	case auditlog.FieldTraceID:
+		return m.TraceID()
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditlog.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case auditlog.FieldCarID:
+		return m.OldCarID(ctx)
+	case auditlog.FieldOp:
+		return m.OldOp(ctx)
+	case auditlog.FieldActor:
+		return m.OldActor(ctx)
+	case auditlog.FieldDiff:
+		return m.OldDiff(ctx)
+	case auditlog.FieldTraceID:
+		return m.OldTraceID(ctx)
+	case auditlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case auditlog.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case auditlog.FieldOp:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOp(v)
+		return nil
+	case auditlog.FieldActor:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case auditlog.FieldDiff:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiff(v)
+		return nil
+	case auditlog.FieldTraceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTraceID(v)
+		return nil
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, auditlog.FieldTenantID)
+	}
+	if m.addcar_id != nil {
+		fields = append(fields, auditlog.FieldCarID)
+	}
+	if m.addactor != nil {
+		fields = append(fields, auditlog.FieldActor)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldTenantID:
+		return m.AddedTenantID()
+	case auditlog.FieldCarID:
+		return m.AddedCarID()
+	case auditlog.FieldActor:
+		return m.AddedActor()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case auditlog.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCarID(v)
+		return nil
+	case auditlog.FieldActor:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActor(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldTenantID) {
+		fields = append(fields, auditlog.FieldTenantID)
+	}
+	if m.FieldCleared(auditlog.FieldActor) {
+		fields = append(fields, auditlog.FieldActor)
+	}
+	if m.FieldCleared(auditlog.FieldDiff) {
+		fields = append(fields, auditlog.FieldDiff)
+	}
+	if m.FieldCleared(auditlog.FieldTraceID) {
+		fields = append(fields, auditlog.FieldTraceID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case auditlog.FieldActor:
+		m.ClearActor()
+		return nil
+	case auditlog.FieldDiff:
+		m.ClearDiff()
+		return nil
+	case auditlog.FieldTraceID:
+		m.ClearTraceID()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case auditlog.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case auditlog.FieldOp:
+		m.ResetOp()
+		return nil
+	case auditlog.FieldActor:
+		m.ResetActor()
+		return nil
+	case auditlog.FieldDiff:
+		m.ResetDiff()
+		return nil
+	case auditlog.FieldTraceID:
+		m.ResetTraceID()
+		return nil
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// BrandMutation represents an operation that mutates the Brand nodes in the graph.
+type BrandMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int64
+	name                  *string
+	clearedFields         map[string]struct{}
+	vehicle_models        map[int64]struct{}
+	removedvehicle_models map[int64]struct{}
+	clearedvehicle_models bool
+	done                  bool
+	oldValue              func(context.Context) (*Brand, error)
+	predicates            []predicate.Brand
+}
+
+var _ ent.Mutation = (*BrandMutation)(nil)
+
+// brandOption allows management of the mutation configuration using functional options.
+type brandOption func(*BrandMutation)
+
+// newBrandMutation creates new mutation for the Brand entity.
+func newBrandMutation(c config, op Op, opts ...brandOption) *BrandMutation {
+	m := &BrandMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBrand,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBrandID sets the ID field of the mutation.
+func withBrandID(id int64) brandOption {
+	return func(m *BrandMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Brand
+		)
+		m.oldValue = func(ctx context.Context) (*Brand, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Brand.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBrand sets the old Brand of the mutation.
+func withBrand(node *Brand) brandOption {
+	return func(m *BrandMutation) {
+		m.oldValue = func(context.Context) (*Brand, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BrandMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BrandMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Brand entities.
+func (m *BrandMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BrandMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BrandMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Brand.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *BrandMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BrandMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Brand entity.
+// If the Brand object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrandMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BrandMutation) ResetName() {
+	m.name = nil
+}
+
+// AddVehicleModelIDs adds the "vehicle_models" edge to the VehicleModel entity by ids.
+func (m *BrandMutation) AddVehicleModelIDs(ids ...int64) {
+	if m.vehicle_models == nil {
+		m.vehicle_models = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.vehicle_models[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVehicleModels clears the "vehicle_models" edge to the VehicleModel entity.
+func (m *BrandMutation) ClearVehicleModels() {
+	m.clearedvehicle_models = true
+}
+
+// VehicleModelsCleared reports if the "vehicle_models" edge to the VehicleModel entity was cleared.
+func (m *BrandMutation) VehicleModelsCleared() bool {
+	return m.clearedvehicle_models
+}
+
+// RemoveVehicleModelIDs removes the "vehicle_models" edge to the VehicleModel entity by IDs.
+func (m *BrandMutation) RemoveVehicleModelIDs(ids ...int64) {
+	if m.removedvehicle_models == nil {
+		m.removedvehicle_models = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.vehicle_models, ids[i])
+		m.removedvehicle_models[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVehicleModels returns the removed IDs of the "vehicle_models" edge to the VehicleModel entity.
+func (m *BrandMutation) RemovedVehicleModelsIDs() (ids []int64) {
+	for id := range m.removedvehicle_models {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VehicleModelsIDs returns the "vehicle_models" edge IDs in the mutation.
+func (m *BrandMutation) VehicleModelsIDs() (ids []int64) {
+	for id := range m.vehicle_models {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVehicleModels resets all changes to the "vehicle_models" edge.
+func (m *BrandMutation) ResetVehicleModels() {
+	m.vehicle_models = nil
+	m.clearedvehicle_models = false
+	m.removedvehicle_models = nil
+}
+
+// Where appends a list predicates to the BrandMutation builder.
+func (m *BrandMutation) Where(ps ...predicate.Brand) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *BrandMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Brand).
+func (m *BrandMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BrandMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, brand.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BrandMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case brand.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BrandMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case brand.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Brand field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BrandMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case brand.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Brand field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BrandMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BrandMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BrandMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Brand numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BrandMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BrandMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BrandMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Brand nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BrandMutation) ResetField(name string) error {
+	switch name {
+	case brand.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Brand field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BrandMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.vehicle_models != nil {
+		edges = append(edges, brand.EdgeVehicleModels)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BrandMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case brand.EdgeVehicleModels:
+		ids := make([]ent.Value, 0, len(m.vehicle_models))
+		for id := range m.vehicle_models {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BrandMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedvehicle_models != nil {
+		edges = append(edges, brand.EdgeVehicleModels)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BrandMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case brand.EdgeVehicleModels:
+		ids := make([]ent.Value, 0, len(m.removedvehicle_models))
+		for id := range m.removedvehicle_models {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BrandMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedvehicle_models {
+		edges = append(edges, brand.EdgeVehicleModels)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BrandMutation) EdgeCleared(name string) bool {
+	switch name {
+	case brand.EdgeVehicleModels:
+		return m.clearedvehicle_models
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BrandMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Brand unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BrandMutation) ResetEdge(name string) error {
+	switch name {
+	case brand.EdgeVehicleModels:
+		m.ResetVehicleModels()
+		return nil
+	}
+	return fmt.Errorf("unknown Brand edge %s", name)
+}
+
+// CarMutation represents an operation that mutates the Car nodes in the graph.
+type CarMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int64
+	tenant_id                  *int64
+	addtenant_id               *int64
+	user_id                    *int64
+	adduser_id                 *int64
+	model                      *string
+	vin                        *string
+	plate                      *string
+	owner_name                 *string
+	registered_at              *time.Time
+	battery_capacity           *float64
+	addbattery_capacity        *float64
+	battery_soh                *float64
+	addbattery_soh             *float64
+	clearedFields              map[string]struct{}
+	vehicle_model              *int64
+	clearedvehicle_model       bool
+	maintenance_records        map[int64]struct{}
+	removedmaintenance_records map[int64]struct{}
+	clearedmaintenance_records bool
+	insurance_policies         map[int64]struct{}
+	removedinsurance_policies  map[int64]struct{}
+	clearedinsurance_policies  bool
+	transfers                  map[int64]struct{}
+	removedtransfers           map[int64]struct{}
+	clearedtransfers           bool
+	odometer_readings          map[int64]struct{}
+	removedodometer_readings   map[int64]struct{}
+	clearedodometer_readings   bool
+	attachments                map[int64]struct{}
+	removedattachments         map[int64]struct{}
+	clearedattachments         bool
+	fleets                     map[int64]struct{}
+	removedfleets              map[int64]struct{}
+	clearedfleets              bool
+	tags                       map[int64]struct{}
+	removedtags                map[int64]struct{}
+	clearedtags                bool
+	attributes                 map[int64]struct{}
+	removedattributes          map[int64]struct{}
+	clearedattributes          bool
+	reservations               map[int64]struct{}
+	removedreservations        map[int64]struct{}
+	clearedreservations        bool
+	trips                      map[int64]struct{}
+	removedtrips               map[int64]struct{}
+	clearedtrips               bool
+	refuels                    map[int64]struct{}
+	removedrefuels             map[int64]struct{}
+	clearedrefuels             bool
+	recalls                    map[int64]struct{}
+	removedrecalls             map[int64]struct{}
+	clearedrecalls             bool
+	owner_histories            map[int64]struct{}
+	removedowner_histories     map[int64]struct{}
+	clearedowner_histories     bool
+	violations                 map[int64]struct{}
+	removedviolations          map[int64]struct{}
+	clearedviolations          bool
+	trade_records              map[int64]struct{}
+	removedtrade_records       map[int64]struct{}
+	clearedtrade_records       bool
+	listings                   map[int64]struct{}
+	removedlistings            map[int64]struct{}
+	clearedlistings            bool
+	warranties                 map[int64]struct{}
+	removedwarranties          map[int64]struct{}
+	clearedwarranties          bool
+	incidents                  map[int64]struct{}
+	removedincidents           map[int64]struct{}
+	clearedincidents           bool
+	lease_contracts            map[int64]struct{}
+	removedlease_contracts     map[int64]struct{}
+	clearedlease_contracts     bool
+	charging_sessions          map[int64]struct{}
+	removedcharging_sessions   map[int64]struct{}
+	clearedcharging_sessions   bool
+	geofences                  map[int64]struct{}
+	removedgeofences           map[int64]struct{}
+	clearedgeofences           bool
+	done                       bool
+	oldValue                   func(context.Context) (*Car, error)
+	predicates                 []predicate.Car
+}
+
+var _ ent.Mutation = (*CarMutation)(nil)
+
+// carOption allows management of the mutation configuration using functional options.
+type carOption func(*CarMutation)
+
+// newCarMutation creates new mutation for the Car entity.
+func newCarMutation(c config, op Op, opts ...carOption) *CarMutation {
+	m := &CarMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCar,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCarID sets the ID field of the mutation.
+func withCarID(id int64) carOption {
+	return func(m *CarMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Car
+		)
+		m.oldValue = func(ctx context.Context) (*Car, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Car.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCar sets the old Car of the mutation.
+func withCar(node *Car) carOption {
+	return func(m *CarMutation) {
+		m.oldValue = func(context.Context) (*Car, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CarMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CarMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Car entities.
+func (m *CarMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CarMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CarMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Car.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *CarMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *CarMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *CarMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *CarMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *CarMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[car.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *CarMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[car.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *CarMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, car.FieldTenantID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *CarMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CarMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *CarMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *CarMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *CarMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[car.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *CarMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[car.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CarMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, car.FieldUserID)
+}
+
+// SetModel sets the "model" field.
+func (m *CarMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *CarMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ClearModel clears the value of the "model" field.
+func (m *CarMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[car.FieldModel] = struct{}{}
+}
+
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *CarMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[car.FieldModel]
+	return ok
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *CarMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, car.FieldModel)
+}
+
+// SetModelID sets the "model_id" field.
+func (m *CarMutation) SetModelID(i int64) {
+	m.vehicle_model = &i
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *CarMutation) ModelID() (r int64, exists bool) {
+	v := m.vehicle_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldModelID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (m *CarMutation) ClearModelID() {
+	m.vehicle_model = nil
+	m.clearedFields[car.FieldModelID] = struct{}{}
+}
+
+// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
+func (m *CarMutation) ModelIDCleared() bool {
+	_, ok := m.clearedFields[car.FieldModelID]
+	return ok
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *CarMutation) ResetModelID() {
+	m.vehicle_model = nil
+	delete(m.clearedFields, car.FieldModelID)
+}
+
+// SetVin sets the "vin" field.
+func (m *CarMutation) SetVin(s string) {
+	m.vin = &s
+}
+
+// Vin returns the value of the "vin" field in the mutation.
+func (m *CarMutation) Vin() (r string, exists bool) {
+	v := m.vin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVin returns the old "vin" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldVin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVin: %w", err)
+	}
+	return oldValue.Vin, nil
+}
+
+// ClearVin clears the value of the "vin" field.
+func (m *CarMutation) ClearVin() {
+	m.vin = nil
+	m.clearedFields[car.FieldVin] = struct{}{}
+}
+
+// VinCleared returns if the "vin" field was cleared in this mutation.
+func (m *CarMutation) VinCleared() bool {
+	_, ok := m.clearedFields[car.FieldVin]
+	return ok
+}
+
+// ResetVin resets all changes to the "vin" field.
+func (m *CarMutation) ResetVin() {
+	m.vin = nil
+	delete(m.clearedFields, car.FieldVin)
+}
+
+// SetPlate sets the "plate" field.
+func (m *CarMutation) SetPlate(s string) {
+	m.plate = &s
+}
+
+// Plate returns the value of the "plate" field in the mutation.
+func (m *CarMutation) Plate() (r string, exists bool) {
+	v := m.plate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlate returns the old "plate" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldPlate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlate: %w", err)
+	}
+	return oldValue.Plate, nil
+}
+
+// ClearPlate clears the value of the "plate" field.
+func (m *CarMutation) ClearPlate() {
+	m.plate = nil
+	m.clearedFields[car.FieldPlate] = struct{}{}
+}
+
+// PlateCleared returns if the "plate" field was cleared in this mutation.
+func (m *CarMutation) PlateCleared() bool {
+	_, ok := m.clearedFields[car.FieldPlate]
+	return ok
+}
+
+// ResetPlate resets all changes to the "plate" field.
+func (m *CarMutation) ResetPlate() {
+	m.plate = nil
+	delete(m.clearedFields, car.FieldPlate)
+}
+
+// SetOwnerName sets the "owner_name" field.
+func (m *CarMutation) SetOwnerName(s string) {
+	m.owner_name = &s
+}
+
+// OwnerName returns the value of the "owner_name" field in the mutation.
+func (m *CarMutation) OwnerName() (r string, exists bool) {
+	v := m.owner_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerName returns the old "owner_name" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldOwnerName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerName: %w", err)
+	}
+	return oldValue.OwnerName, nil
+}
+
+// ClearOwnerName clears the value of the "owner_name" field.
+func (m *CarMutation) ClearOwnerName() {
+	m.owner_name = nil
+	m.clearedFields[car.FieldOwnerName] = struct{}{}
+}
+
+// OwnerNameCleared returns if the "owner_name" field was cleared in this mutation.
+func (m *CarMutation) OwnerNameCleared() bool {
+	_, ok := m.clearedFields[car.FieldOwnerName]
+	return ok
+}
+
+// ResetOwnerName resets all changes to the "owner_name" field.
+func (m *CarMutation) ResetOwnerName() {
+	m.owner_name = nil
+	delete(m.clearedFields, car.FieldOwnerName)
+}
+
+// SetRegisteredAt sets the "registered_at" field.
+func (m *CarMutation) SetRegisteredAt(t time.Time) {
+	m.registered_at = &t
+}
+
+// RegisteredAt returns the value of the "registered_at" field in the mutation.
+func (m *CarMutation) RegisteredAt() (r time.Time, exists bool) {
+	v := m.registered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegisteredAt returns the old "registered_at" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldRegisteredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegisteredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegisteredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegisteredAt: %w", err)
+	}
+	return oldValue.RegisteredAt, nil
+}
+
+// ResetRegisteredAt resets all changes to the "registered_at" field.
+func (m *CarMutation) ResetRegisteredAt() {
+	m.registered_at = nil
+}
+
+// SetBatteryCapacity sets the "battery_capacity" field.
+func (m *CarMutation) SetBatteryCapacity(f float64) {
+	m.battery_capacity = &f
+	m.addbattery_capacity = nil
+}
+
+// BatteryCapacity returns the value of the "battery_capacity" field in the mutation.
+func (m *CarMutation) BatteryCapacity() (r float64, exists bool) {
+	v := m.battery_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBatteryCapacity returns the old "battery_capacity" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldBatteryCapacity(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBatteryCapacity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBatteryCapacity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBatteryCapacity: %w", err)
+	}
+	return oldValue.BatteryCapacity, nil
+}
+
+// AddBatteryCapacity adds f to the "battery_capacity" field.
+func (m *CarMutation) AddBatteryCapacity(f float64) {
+	if m.addbattery_capacity != nil {
+		*m.addbattery_capacity += f
+	} else {
+		m.addbattery_capacity = &f
+	}
+}
+
+// AddedBatteryCapacity returns the value that was added to the "battery_capacity" field in this mutation.
+func (m *CarMutation) AddedBatteryCapacity() (r float64, exists bool) {
+	v := m.addbattery_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBatteryCapacity clears the value of the "battery_capacity" field.
+func (m *CarMutation) ClearBatteryCapacity() {
+	m.battery_capacity = nil
+	m.addbattery_capacity = nil
+	m.clearedFields[car.FieldBatteryCapacity] = struct{}{}
+}
+
+// BatteryCapacityCleared returns if the "battery_capacity" field was cleared in this mutation.
+func (m *CarMutation) BatteryCapacityCleared() bool {
+	_, ok := m.clearedFields[car.FieldBatteryCapacity]
+	return ok
+}
+
+// ResetBatteryCapacity resets all changes to the "battery_capacity" field.
+func (m *CarMutation) ResetBatteryCapacity() {
+	m.battery_capacity = nil
+	m.addbattery_capacity = nil
+	delete(m.clearedFields, car.FieldBatteryCapacity)
+}
+
+// SetBatterySoh sets the "battery_soh" field.
+func (m *CarMutation) SetBatterySoh(f float64) {
+	m.battery_soh = &f
+	m.addbattery_soh = nil
+}
+
+// BatterySoh returns the value of the "battery_soh" field in the mutation.
+func (m *CarMutation) BatterySoh() (r float64, exists bool) {
+	v := m.battery_soh
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBatterySoh returns the old "battery_soh" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldBatterySoh(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBatterySoh is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBatterySoh requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBatterySoh: %w", err)
+	}
+	return oldValue.BatterySoh, nil
+}
+
+// AddBatterySoh adds f to the "battery_soh" field.
+func (m *CarMutation) AddBatterySoh(f float64) {
+	if m.addbattery_soh != nil {
+		*m.addbattery_soh += f
+	} else {
+		m.addbattery_soh = &f
+	}
+}
+
+// AddedBatterySoh returns the value that was added to the "battery_soh" field in this mutation.
+func (m *CarMutation) AddedBatterySoh() (r float64, exists bool) {
+	v := m.addbattery_soh
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBatterySoh clears the value of the "battery_soh" field.
+func (m *CarMutation) ClearBatterySoh() {
+	m.battery_soh = nil
+	m.addbattery_soh = nil
+	m.clearedFields[car.FieldBatterySoh] = struct{}{}
+}
+
+// BatterySohCleared returns if the "battery_soh" field was cleared in this mutation.
+func (m *CarMutation) BatterySohCleared() bool {
+	_, ok := m.clearedFields[car.FieldBatterySoh]
+	return ok
+}
+
+// ResetBatterySoh resets all changes to the "battery_soh" field.
+func (m *CarMutation) ResetBatterySoh() {
+	m.battery_soh = nil
+	m.addbattery_soh = nil
+	delete(m.clearedFields, car.FieldBatterySoh)
+}
+
+// SetVehicleModelID sets the "vehicle_model" edge to the VehicleModel entity by id.
+func (m *CarMutation) SetVehicleModelID(id int64) {
+	m.vehicle_model = &id
+}
+
+// ClearVehicleModel clears the "vehicle_model" edge to the VehicleModel entity.
+func (m *CarMutation) ClearVehicleModel() {
+	m.clearedvehicle_model = true
+}
+
+// VehicleModelCleared reports if the "vehicle_model" edge to the VehicleModel entity was cleared.
+func (m *CarMutation) VehicleModelCleared() bool {
+	return m.ModelIDCleared() || m.clearedvehicle_model
+}
+
+// VehicleModelID returns the "vehicle_model" edge ID in the mutation.
+func (m *CarMutation) VehicleModelID() (id int64, exists bool) {
+	if m.vehicle_model != nil {
+		return *m.vehicle_model, true
+	}
+	return
+}
+
+// VehicleModelIDs returns the "vehicle_model" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VehicleModelID instead. It exists only for internal usage by the builders.
+func (m *CarMutation) VehicleModelIDs() (ids []int64) {
+	if id := m.vehicle_model; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVehicleModel resets all changes to the "vehicle_model" edge.
+func (m *CarMutation) ResetVehicleModel() {
+	m.vehicle_model = nil
+	m.clearedvehicle_model = false
+}
+
+// AddMaintenanceRecordIDs adds the "maintenance_records" edge to the MaintenanceRecord entity by ids.
+func (m *CarMutation) AddMaintenanceRecordIDs(ids ...int64) {
+	if m.maintenance_records == nil {
+		m.maintenance_records = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.maintenance_records[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMaintenanceRecords clears the "maintenance_records" edge to the MaintenanceRecord entity.
+func (m *CarMutation) ClearMaintenanceRecords() {
+	m.clearedmaintenance_records = true
+}
+
+// MaintenanceRecordsCleared reports if the "maintenance_records" edge to the MaintenanceRecord entity was cleared.
+func (m *CarMutation) MaintenanceRecordsCleared() bool {
+	return m.clearedmaintenance_records
+}
+
+// RemoveMaintenanceRecordIDs removes the "maintenance_records" edge to the MaintenanceRecord entity by IDs.
+func (m *CarMutation) RemoveMaintenanceRecordIDs(ids ...int64) {
+	if m.removedmaintenance_records == nil {
+		m.removedmaintenance_records = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.maintenance_records, ids[i])
+		m.removedmaintenance_records[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMaintenanceRecords returns the removed IDs of the "maintenance_records" edge to the MaintenanceRecord entity.
+func (m *CarMutation) RemovedMaintenanceRecordsIDs() (ids []int64) {
+	for id := range m.removedmaintenance_records {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MaintenanceRecordsIDs returns the "maintenance_records" edge IDs in the mutation.
+func (m *CarMutation) MaintenanceRecordsIDs() (ids []int64) {
+	for id := range m.maintenance_records {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMaintenanceRecords resets all changes to the "maintenance_records" edge.
+func (m *CarMutation) ResetMaintenanceRecords() {
+	m.maintenance_records = nil
+	m.clearedmaintenance_records = false
+	m.removedmaintenance_records = nil
+}
+
+// AddInsurancePolicyIDs adds the "insurance_policies" edge to the InsurancePolicy entity by ids.
+func (m *CarMutation) AddInsurancePolicyIDs(ids ...int64) {
+	if m.insurance_policies == nil {
+		m.insurance_policies = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.insurance_policies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInsurancePolicies clears the "insurance_policies" edge to the InsurancePolicy entity.
+func (m *CarMutation) ClearInsurancePolicies() {
+	m.clearedinsurance_policies = true
+}
+
+// InsurancePoliciesCleared reports if the "insurance_policies" edge to the InsurancePolicy entity was cleared.
+func (m *CarMutation) InsurancePoliciesCleared() bool {
+	return m.clearedinsurance_policies
+}
+
+// RemoveInsurancePolicyIDs removes the "insurance_policies" edge to the InsurancePolicy entity by IDs.
+func (m *CarMutation) RemoveInsurancePolicyIDs(ids ...int64) {
+	if m.removedinsurance_policies == nil {
+		m.removedinsurance_policies = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.insurance_policies, ids[i])
+		m.removedinsurance_policies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInsurancePolicies returns the removed IDs of the "insurance_policies" edge to the InsurancePolicy entity.
+func (m *CarMutation) RemovedInsurancePoliciesIDs() (ids []int64) {
+	for id := range m.removedinsurance_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InsurancePoliciesIDs returns the "insurance_policies" edge IDs in the mutation.
+func (m *CarMutation) InsurancePoliciesIDs() (ids []int64) {
+	for id := range m.insurance_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInsurancePolicies resets all changes to the "insurance_policies" edge.
+func (m *CarMutation) ResetInsurancePolicies() {
+	m.insurance_policies = nil
+	m.clearedinsurance_policies = false
+	m.removedinsurance_policies = nil
+}
+
+// AddTransferIDs adds the "transfers" edge to the Transfer entity by ids.
+func (m *CarMutation) AddTransferIDs(ids ...int64) {
+	if m.transfers == nil {
+		m.transfers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.transfers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransfers clears the "transfers" edge to the Transfer entity.
+func (m *CarMutation) ClearTransfers() {
+	m.clearedtransfers = true
+}
+
+// TransfersCleared reports if the "transfers" edge to the Transfer entity was cleared.
+func (m *CarMutation) TransfersCleared() bool {
+	return m.clearedtransfers
+}
+
+// RemoveTransferIDs removes the "transfers" edge to the Transfer entity by IDs.
+func (m *CarMutation) RemoveTransferIDs(ids ...int64) {
+	if m.removedtransfers == nil {
+		m.removedtransfers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.transfers, ids[i])
+		m.removedtransfers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransfers returns the removed IDs of the "transfers" edge to the Transfer entity.
+func (m *CarMutation) RemovedTransfersIDs() (ids []int64) {
+	for id := range m.removedtransfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransfersIDs returns the "transfers" edge IDs in the mutation.
+func (m *CarMutation) TransfersIDs() (ids []int64) {
+	for id := range m.transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransfers resets all changes to the "transfers" edge.
+func (m *CarMutation) ResetTransfers() {
+	m.transfers = nil
+	m.clearedtransfers = false
+	m.removedtransfers = nil
+}
+
+// AddOdometerReadingIDs adds the "odometer_readings" edge to the OdometerReading entity by ids.
+func (m *CarMutation) AddOdometerReadingIDs(ids ...int64) {
+	if m.odometer_readings == nil {
+		m.odometer_readings = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.odometer_readings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOdometerReadings clears the "odometer_readings" edge to the OdometerReading entity.
+func (m *CarMutation) ClearOdometerReadings() {
+	m.clearedodometer_readings = true
+}
+
+// OdometerReadingsCleared reports if the "odometer_readings" edge to the OdometerReading entity was cleared.
+func (m *CarMutation) OdometerReadingsCleared() bool {
+	return m.clearedodometer_readings
+}
+
+// RemoveOdometerReadingIDs removes the "odometer_readings" edge to the OdometerReading entity by IDs.
+func (m *CarMutation) RemoveOdometerReadingIDs(ids ...int64) {
+	if m.removedodometer_readings == nil {
+		m.removedodometer_readings = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.odometer_readings, ids[i])
+		m.removedodometer_readings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOdometerReadings returns the removed IDs of the "odometer_readings" edge to the OdometerReading entity.
+func (m *CarMutation) RemovedOdometerReadingsIDs() (ids []int64) {
+	for id := range m.removedodometer_readings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OdometerReadingsIDs returns the "odometer_readings" edge IDs in the mutation.
+func (m *CarMutation) OdometerReadingsIDs() (ids []int64) {
+	for id := range m.odometer_readings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOdometerReadings resets all changes to the "odometer_readings" edge.
+func (m *CarMutation) ResetOdometerReadings() {
+	m.odometer_readings = nil
+	m.clearedodometer_readings = false
+	m.removedodometer_readings = nil
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *CarMutation) AddAttachmentIDs(ids ...int64) {
+	if m.attachments == nil {
+		m.attachments = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *CarMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *CarMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *CarMutation) RemoveAttachmentIDs(ids ...int64) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *CarMutation) RemovedAttachmentsIDs() (ids []int64) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *CarMutation) AttachmentsIDs() (ids []int64) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *CarMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// AddFleetIDs adds the "fleets" edge to the Fleet entity by ids.
+func (m *CarMutation) AddFleetIDs(ids ...int64) {
+	if m.fleets == nil {
+		m.fleets = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.fleets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFleets clears the "fleets" edge to the Fleet entity.
+func (m *CarMutation) ClearFleets() {
+	m.clearedfleets = true
+}
+
+// FleetsCleared reports if the "fleets" edge to the Fleet entity was cleared.
+func (m *CarMutation) FleetsCleared() bool {
+	return m.clearedfleets
+}
+
+// RemoveFleetIDs removes the "fleets" edge to the Fleet entity by IDs.
+func (m *CarMutation) RemoveFleetIDs(ids ...int64) {
+	if m.removedfleets == nil {
+		m.removedfleets = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.fleets, ids[i])
+		m.removedfleets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFleets returns the removed IDs of the "fleets" edge to the Fleet entity.
+func (m *CarMutation) RemovedFleetsIDs() (ids []int64) {
+	for id := range m.removedfleets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FleetsIDs returns the "fleets" edge IDs in the mutation.
+func (m *CarMutation) FleetsIDs() (ids []int64) {
+	for id := range m.fleets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFleets resets all changes to the "fleets" edge.
+func (m *CarMutation) ResetFleets() {
+	m.fleets = nil
+	m.clearedfleets = false
+	m.removedfleets = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *CarMutation) AddTagIDs(ids ...int64) {
+	if m.tags == nil {
+		m.tags = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *CarMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *CarMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *CarMutation) RemoveTagIDs(ids ...int64) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *CarMutation) RemovedTagsIDs() (ids []int64) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *CarMutation) TagsIDs() (ids []int64) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *CarMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddAttributeIDs adds the "attributes" edge to the CarAttribute entity by ids.
+func (m *CarMutation) AddAttributeIDs(ids ...int64) {
+	if m.attributes == nil {
+		m.attributes = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.attributes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttributes clears the "attributes" edge to the CarAttribute entity.
+func (m *CarMutation) ClearAttributes() {
+	m.clearedattributes = true
+}
+
+// AttributesCleared reports if the "attributes" edge to the CarAttribute entity was cleared.
+func (m *CarMutation) AttributesCleared() bool {
+	return m.clearedattributes
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to the CarAttribute entity by IDs.
+func (m *CarMutation) RemoveAttributeIDs(ids ...int64) {
+	if m.removedattributes == nil {
+		m.removedattributes = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.attributes, ids[i])
+		m.removedattributes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttributes returns the removed IDs of the "attributes" edge to the CarAttribute entity.
+func (m *CarMutation) RemovedAttributesIDs() (ids []int64) {
+	for id := range m.removedattributes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttributesIDs returns the "attributes" edge IDs in the mutation.
+func (m *CarMutation) AttributesIDs() (ids []int64) {
+	for id := range m.attributes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttributes resets all changes to the "attributes" edge.
+func (m *CarMutation) ResetAttributes() {
+	m.attributes = nil
+	m.clearedattributes = false
+	m.removedattributes = nil
+}
+
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by ids.
+func (m *CarMutation) AddReservationIDs(ids ...int64) {
+	if m.reservations == nil {
+		m.reservations = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.reservations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReservations clears the "reservations" edge to the Reservation entity.
+func (m *CarMutation) ClearReservations() {
+	m.clearedreservations = true
+}
+
+// ReservationsCleared reports if the "reservations" edge to the Reservation entity was cleared.
+func (m *CarMutation) ReservationsCleared() bool {
+	return m.clearedreservations
+}
+
+// RemoveReservationIDs removes the "reservations" edge to the Reservation entity by IDs.
+func (m *CarMutation) RemoveReservationIDs(ids ...int64) {
+	if m.removedreservations == nil {
+		m.removedreservations = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.reservations, ids[i])
+		m.removedreservations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReservations returns the removed IDs of the "reservations" edge to the Reservation entity.
+func (m *CarMutation) RemovedReservationsIDs() (ids []int64) {
+	for id := range m.removedreservations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReservationsIDs returns the "reservations" edge IDs in the mutation.
+func (m *CarMutation) ReservationsIDs() (ids []int64) {
+	for id := range m.reservations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReservations resets all changes to the "reservations" edge.
+func (m *CarMutation) ResetReservations() {
+	m.reservations = nil
+	m.clearedreservations = false
+	m.removedreservations = nil
+}
+
+// AddTripIDs adds the "trips" edge to the Trip entity by ids.
+func (m *CarMutation) AddTripIDs(ids ...int64) {
+	if m.trips == nil {
+		m.trips = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.trips[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTrips clears the "trips" edge to the Trip entity.
+func (m *CarMutation) ClearTrips() {
+	m.clearedtrips = true
+}
+
+// TripsCleared reports if the "trips" edge to the Trip entity was cleared.
+func (m *CarMutation) TripsCleared() bool {
+	return m.clearedtrips
+}
+
+// RemoveTripIDs removes the "trips" edge to the Trip entity by IDs.
+func (m *CarMutation) RemoveTripIDs(ids ...int64) {
+	if m.removedtrips == nil {
+		m.removedtrips = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.trips, ids[i])
+		m.removedtrips[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTrips returns the removed IDs of the "trips" edge to the Trip entity.
+func (m *CarMutation) RemovedTripsIDs() (ids []int64) {
+	for id := range m.removedtrips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TripsIDs returns the "trips" edge IDs in the mutation.
+func (m *CarMutation) TripsIDs() (ids []int64) {
+	for id := range m.trips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTrips resets all changes to the "trips" edge.
+func (m *CarMutation) ResetTrips() {
+	m.trips = nil
+	m.clearedtrips = false
+	m.removedtrips = nil
+}
+
+// AddRefuelIDs adds the "refuels" edge to the Refuel entity by ids.
+func (m *CarMutation) AddRefuelIDs(ids ...int64) {
+	if m.refuels == nil {
+		m.refuels = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.refuels[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRefuels clears the "refuels" edge to the Refuel entity.
+func (m *CarMutation) ClearRefuels() {
+	m.clearedrefuels = true
+}
+
+// RefuelsCleared reports if the "refuels" edge to the Refuel entity was cleared.
+func (m *CarMutation) RefuelsCleared() bool {
+	return m.clearedrefuels
+}
+
+// RemoveRefuelIDs removes the "refuels" edge to the Refuel entity by IDs.
+func (m *CarMutation) RemoveRefuelIDs(ids ...int64) {
+	if m.removedrefuels == nil {
+		m.removedrefuels = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.refuels, ids[i])
+		m.removedrefuels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRefuels returns the removed IDs of the "refuels" edge to the Refuel entity.
+func (m *CarMutation) RemovedRefuelsIDs() (ids []int64) {
+	for id := range m.removedrefuels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RefuelsIDs returns the "refuels" edge IDs in the mutation.
+func (m *CarMutation) RefuelsIDs() (ids []int64) {
+	for id := range m.refuels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRefuels resets all changes to the "refuels" edge.
+func (m *CarMutation) ResetRefuels() {
+	m.refuels = nil
+	m.clearedrefuels = false
+	m.removedrefuels = nil
+}
+
+// AddRecallIDs adds the "recalls" edge to the CarRecall entity by ids.
+func (m *CarMutation) AddRecallIDs(ids ...int64) {
+	if m.recalls == nil {
+		m.recalls = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.recalls[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecalls clears the "recalls" edge to the CarRecall entity.
+func (m *CarMutation) ClearRecalls() {
+	m.clearedrecalls = true
+}
+
+// RecallsCleared reports if the "recalls" edge to the CarRecall entity was cleared.
+func (m *CarMutation) RecallsCleared() bool {
+	return m.clearedrecalls
+}
+
+// RemoveRecallIDs removes the "recalls" edge to the CarRecall entity by IDs.
+func (m *CarMutation) RemoveRecallIDs(ids ...int64) {
+	if m.removedrecalls == nil {
+		m.removedrecalls = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.recalls, ids[i])
+		m.removedrecalls[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecalls returns the removed IDs of the "recalls" edge to the CarRecall entity.
+func (m *CarMutation) RemovedRecallsIDs() (ids []int64) {
+	for id := range m.removedrecalls {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecallsIDs returns the "recalls" edge IDs in the mutation.
+func (m *CarMutation) RecallsIDs() (ids []int64) {
+	for id := range m.recalls {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecalls resets all changes to the "recalls" edge.
+func (m *CarMutation) ResetRecalls() {
+	m.recalls = nil
+	m.clearedrecalls = false
+	m.removedrecalls = nil
+}
+
+// AddOwnerHistoryIDs adds the "owner_histories" edge to the OwnerHistory entity by ids.
+func (m *CarMutation) AddOwnerHistoryIDs(ids ...int64) {
+	if m.owner_histories == nil {
+		m.owner_histories = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.owner_histories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOwnerHistories clears the "owner_histories" edge to the OwnerHistory entity.
+func (m *CarMutation) ClearOwnerHistories() {
+	m.clearedowner_histories = true
+}
+
+// OwnerHistoriesCleared reports if the "owner_histories" edge to the OwnerHistory entity was cleared.
+func (m *CarMutation) OwnerHistoriesCleared() bool {
+	return m.clearedowner_histories
+}
+
+// RemoveOwnerHistoryIDs removes the "owner_histories" edge to the OwnerHistory entity by IDs.
+func (m *CarMutation) RemoveOwnerHistoryIDs(ids ...int64) {
+	if m.removedowner_histories == nil {
+		m.removedowner_histories = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.owner_histories, ids[i])
+		m.removedowner_histories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOwnerHistories returns the removed IDs of the "owner_histories" edge to the OwnerHistory entity.
+func (m *CarMutation) RemovedOwnerHistoriesIDs() (ids []int64) {
+	for id := range m.removedowner_histories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OwnerHistoriesIDs returns the "owner_histories" edge IDs in the mutation.
+func (m *CarMutation) OwnerHistoriesIDs() (ids []int64) {
+	for id := range m.owner_histories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOwnerHistories resets all changes to the "owner_histories" edge.
+func (m *CarMutation) ResetOwnerHistories() {
+	m.owner_histories = nil
+	m.clearedowner_histories = false
+	m.removedowner_histories = nil
+}
+
+// AddViolationIDs adds the "violations" edge to the Violation entity by ids.
+func (m *CarMutation) AddViolationIDs(ids ...int64) {
+	if m.violations == nil {
+		m.violations = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.violations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearViolations clears the "violations" edge to the Violation entity.
+func (m *CarMutation) ClearViolations() {
+	m.clearedviolations = true
+}
+
+// ViolationsCleared reports if the "violations" edge to the Violation entity was cleared.
+func (m *CarMutation) ViolationsCleared() bool {
+	return m.clearedviolations
+}
+
+// RemoveViolationIDs removes the "violations" edge to the Violation entity by IDs.
+func (m *CarMutation) RemoveViolationIDs(ids ...int64) {
+	if m.removedviolations == nil {
+		m.removedviolations = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.violations, ids[i])
+		m.removedviolations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedViolations returns the removed IDs of the "violations" edge to the Violation entity.
+func (m *CarMutation) RemovedViolationsIDs() (ids []int64) {
+	for id := range m.removedviolations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ViolationsIDs returns the "violations" edge IDs in the mutation.
+func (m *CarMutation) ViolationsIDs() (ids []int64) {
+	for id := range m.violations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetViolations resets all changes to the "violations" edge.
+func (m *CarMutation) ResetViolations() {
+	m.violations = nil
+	m.clearedviolations = false
+	m.removedviolations = nil
+}
+
+// AddTradeRecordIDs adds the "trade_records" edge to the TradeRecord entity by ids.
+func (m *CarMutation) AddTradeRecordIDs(ids ...int64) {
+	if m.trade_records == nil {
+		m.trade_records = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.trade_records[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTradeRecords clears the "trade_records" edge to the TradeRecord entity.
+func (m *CarMutation) ClearTradeRecords() {
+	m.clearedtrade_records = true
+}
+
+// TradeRecordsCleared reports if the "trade_records" edge to the TradeRecord entity was cleared.
+func (m *CarMutation) TradeRecordsCleared() bool {
+	return m.clearedtrade_records
+}
+
+// RemoveTradeRecordIDs removes the "trade_records" edge to the TradeRecord entity by IDs.
+func (m *CarMutation) RemoveTradeRecordIDs(ids ...int64) {
+	if m.removedtrade_records == nil {
+		m.removedtrade_records = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.trade_records, ids[i])
+		m.removedtrade_records[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTradeRecords returns the removed IDs of the "trade_records" edge to the TradeRecord entity.
+func (m *CarMutation) RemovedTradeRecordsIDs() (ids []int64) {
+	for id := range m.removedtrade_records {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TradeRecordsIDs returns the "trade_records" edge IDs in the mutation.
+func (m *CarMutation) TradeRecordsIDs() (ids []int64) {
+	for id := range m.trade_records {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTradeRecords resets all changes to the "trade_records" edge.
+func (m *CarMutation) ResetTradeRecords() {
+	m.trade_records = nil
+	m.clearedtrade_records = false
+	m.removedtrade_records = nil
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by ids.
+func (m *CarMutation) AddListingIDs(ids ...int64) {
+	if m.listings == nil {
+		m.listings = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.listings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearListings clears the "listings" edge to the Listing entity.
+func (m *CarMutation) ClearListings() {
+	m.clearedlistings = true
+}
+
+// ListingsCleared reports if the "listings" edge to the Listing entity was cleared.
+func (m *CarMutation) ListingsCleared() bool {
+	return m.clearedlistings
+}
+
+// RemoveListingIDs removes the "listings" edge to the Listing entity by IDs.
+func (m *CarMutation) RemoveListingIDs(ids ...int64) {
+	if m.removedlistings == nil {
+		m.removedlistings = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.listings, ids[i])
+		m.removedlistings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedListings returns the removed IDs of the "listings" edge to the Listing entity.
+func (m *CarMutation) RemovedListingsIDs() (ids []int64) {
+	for id := range m.removedlistings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ListingsIDs returns the "listings" edge IDs in the mutation.
+func (m *CarMutation) ListingsIDs() (ids []int64) {
+	for id := range m.listings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetListings resets all changes to the "listings" edge.
+func (m *CarMutation) ResetListings() {
+	m.listings = nil
+	m.clearedlistings = false
+	m.removedlistings = nil
+}
+
+// AddWarrantyIDs adds the "warranties" edge to the CarWarranty entity by ids.
+func (m *CarMutation) AddWarrantyIDs(ids ...int64) {
+	if m.warranties == nil {
+		m.warranties = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.warranties[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWarranties clears the "warranties" edge to the CarWarranty entity.
+func (m *CarMutation) ClearWarranties() {
+	m.clearedwarranties = true
+}
+
+// WarrantiesCleared reports if the "warranties" edge to the CarWarranty entity was cleared.
+func (m *CarMutation) WarrantiesCleared() bool {
+	return m.clearedwarranties
+}
+
+// RemoveWarrantyIDs removes the "warranties" edge to the CarWarranty entity by IDs.
+func (m *CarMutation) RemoveWarrantyIDs(ids ...int64) {
+	if m.removedwarranties == nil {
+		m.removedwarranties = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.warranties, ids[i])
+		m.removedwarranties[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWarranties returns the removed IDs of the "warranties" edge to the CarWarranty entity.
+func (m *CarMutation) RemovedWarrantiesIDs() (ids []int64) {
+	for id := range m.removedwarranties {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WarrantiesIDs returns the "warranties" edge IDs in the mutation.
+func (m *CarMutation) WarrantiesIDs() (ids []int64) {
+	for id := range m.warranties {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWarranties resets all changes to the "warranties" edge.
+func (m *CarMutation) ResetWarranties() {
+	m.warranties = nil
+	m.clearedwarranties = false
+	m.removedwarranties = nil
+}
+
+// AddIncidentIDs adds the "incidents" edge to the Incident entity by ids.
+func (m *CarMutation) AddIncidentIDs(ids ...int64) {
+	if m.incidents == nil {
+		m.incidents = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.incidents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIncidents clears the "incidents" edge to the Incident entity.
+func (m *CarMutation) ClearIncidents() {
+	m.clearedincidents = true
+}
+
+// IncidentsCleared reports if the "incidents" edge to the Incident entity was cleared.
+func (m *CarMutation) IncidentsCleared() bool {
+	return m.clearedincidents
+}
+
+// RemoveIncidentIDs removes the "incidents" edge to the Incident entity by IDs.
+func (m *CarMutation) RemoveIncidentIDs(ids ...int64) {
+	if m.removedincidents == nil {
+		m.removedincidents = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.incidents, ids[i])
+		m.removedincidents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIncidents returns the removed IDs of the "incidents" edge to the Incident entity.
+func (m *CarMutation) RemovedIncidentsIDs() (ids []int64) {
+	for id := range m.removedincidents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IncidentsIDs returns the "incidents" edge IDs in the mutation.
+func (m *CarMutation) IncidentsIDs() (ids []int64) {
+	for id := range m.incidents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIncidents resets all changes to the "incidents" edge.
+func (m *CarMutation) ResetIncidents() {
+	m.incidents = nil
+	m.clearedincidents = false
+	m.removedincidents = nil
+}
+
+// AddLeaseContractIDs adds the "lease_contracts" edge to the LeaseContract entity by ids.
+func (m *CarMutation) AddLeaseContractIDs(ids ...int64) {
+	if m.lease_contracts == nil {
+		m.lease_contracts = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.lease_contracts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLeaseContracts clears the "lease_contracts" edge to the LeaseContract entity.
+func (m *CarMutation) ClearLeaseContracts() {
+	m.clearedlease_contracts = true
+}
+
+// LeaseContractsCleared reports if the "lease_contracts" edge to the LeaseContract entity was cleared.
+func (m *CarMutation) LeaseContractsCleared() bool {
+	return m.clearedlease_contracts
+}
+
+// RemoveLeaseContractIDs removes the "lease_contracts" edge to the LeaseContract entity by IDs.
+func (m *CarMutation) RemoveLeaseContractIDs(ids ...int64) {
+	if m.removedlease_contracts == nil {
+		m.removedlease_contracts = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.lease_contracts, ids[i])
+		m.removedlease_contracts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLeaseContracts returns the removed IDs of the "lease_contracts" edge to the LeaseContract entity.
+func (m *CarMutation) RemovedLeaseContractsIDs() (ids []int64) {
+	for id := range m.removedlease_contracts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LeaseContractsIDs returns the "lease_contracts" edge IDs in the mutation.
+func (m *CarMutation) LeaseContractsIDs() (ids []int64) {
+	for id := range m.lease_contracts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLeaseContracts resets all changes to the "lease_contracts" edge.
+func (m *CarMutation) ResetLeaseContracts() {
+	m.lease_contracts = nil
+	m.clearedlease_contracts = false
+	m.removedlease_contracts = nil
+}
+
+// AddChargingSessionIDs adds the "charging_sessions" edge to the ChargingSession entity by ids.
+func (m *CarMutation) AddChargingSessionIDs(ids ...int64) {
+	if m.charging_sessions == nil {
+		m.charging_sessions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.charging_sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChargingSessions clears the "charging_sessions" edge to the ChargingSession entity.
+func (m *CarMutation) ClearChargingSessions() {
+	m.clearedcharging_sessions = true
+}
+
+// ChargingSessionsCleared reports if the "charging_sessions" edge to the ChargingSession entity was cleared.
+func (m *CarMutation) ChargingSessionsCleared() bool {
+	return m.clearedcharging_sessions
+}
+
+// RemoveChargingSessionIDs removes the "charging_sessions" edge to the ChargingSession entity by IDs.
+func (m *CarMutation) RemoveChargingSessionIDs(ids ...int64) {
+	if m.removedcharging_sessions == nil {
+		m.removedcharging_sessions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.charging_sessions, ids[i])
+		m.removedcharging_sessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChargingSessions returns the removed IDs of the "charging_sessions" edge to the ChargingSession entity.
+func (m *CarMutation) RemovedChargingSessionsIDs() (ids []int64) {
+	for id := range m.removedcharging_sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChargingSessionsIDs returns the "charging_sessions" edge IDs in the mutation.
+func (m *CarMutation) ChargingSessionsIDs() (ids []int64) {
+	for id := range m.charging_sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChargingSessions resets all changes to the "charging_sessions" edge.
+func (m *CarMutation) ResetChargingSessions() {
+	m.charging_sessions = nil
+	m.clearedcharging_sessions = false
+	m.removedcharging_sessions = nil
+}
+
+// AddGeofenceIDs adds the "geofences" edge to the Geofence entity by ids.
+func (m *CarMutation) AddGeofenceIDs(ids ...int64) {
+	if m.geofences == nil {
+		m.geofences = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.geofences[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGeofences clears the "geofences" edge to the Geofence entity.
+func (m *CarMutation) ClearGeofences() {
+	m.clearedgeofences = true
+}
+
+// GeofencesCleared reports if the "geofences" edge to the Geofence entity was cleared.
+func (m *CarMutation) GeofencesCleared() bool {
+	return m.clearedgeofences
+}
+
+// RemoveGeofenceIDs removes the "geofences" edge to the Geofence entity by IDs.
+func (m *CarMutation) RemoveGeofenceIDs(ids ...int64) {
+	if m.removedgeofences == nil {
+		m.removedgeofences = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.geofences, ids[i])
+		m.removedgeofences[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGeofences returns the removed IDs of the "geofences" edge to the Geofence entity.
+func (m *CarMutation) RemovedGeofencesIDs() (ids []int64) {
+	for id := range m.removedgeofences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GeofencesIDs returns the "geofences" edge IDs in the mutation.
+func (m *CarMutation) GeofencesIDs() (ids []int64) {
+	for id := range m.geofences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGeofences resets all changes to the "geofences" edge.
+func (m *CarMutation) ResetGeofences() {
+	m.geofences = nil
+	m.clearedgeofences = false
+	m.removedgeofences = nil
+}
+
+// Where appends a list predicates to the CarMutation builder.
+func (m *CarMutation) Where(ps ...predicate.Car) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CarMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Car).
+func (m *CarMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CarMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.tenant_id != nil {
+		fields = append(fields, car.FieldTenantID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, car.FieldUserID)
+	}
+	if m.model != nil {
+		fields = append(fields, car.FieldModel)
+	}
+	if m.vehicle_model != nil {
+		fields = append(fields, car.FieldModelID)
+	}
+	if m.vin != nil {
+		fields = append(fields, car.FieldVin)
+	}
+	if m.plate != nil {
+		fields = append(fields, car.FieldPlate)
+	}
+	if m.owner_name != nil {
+		fields = append(fields, car.FieldOwnerName)
+	}
+	if m.registered_at != nil {
+		fields = append(fields, car.FieldRegisteredAt)
+	}
+	if m.battery_capacity != nil {
+		fields = append(fields, car.FieldBatteryCapacity)
+	}
+	if m.battery_soh != nil {
+		fields = append(fields, car.FieldBatterySoh)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CarMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case car.FieldTenantID:
+		return m.TenantID()
+	case car.FieldUserID:
+		return m.UserID()
+	case car.FieldModel:
+		return m.Model()
+	case car.FieldModelID:
+		return m.ModelID()
+	case car.FieldVin:
+		return m.Vin()
+	case car.FieldPlate:
+		return m.Plate()
+	case car.FieldOwnerName:
+		return m.OwnerName()
+	case car.FieldRegisteredAt:
+		return m.RegisteredAt()
+	case car.FieldBatteryCapacity:
+		return m.BatteryCapacity()
+	case car.FieldBatterySoh:
+		return m.BatterySoh()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CarMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case car.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case car.FieldUserID:
+		return m.OldUserID(ctx)
+	case car.FieldModel:
+		return m.OldModel(ctx)
+	case car.FieldModelID:
+		return m.OldModelID(ctx)
+	case car.FieldVin:
+		return m.OldVin(ctx)
+	case car.FieldPlate:
+		return m.OldPlate(ctx)
+	case car.FieldOwnerName:
+		return m.OldOwnerName(ctx)
+	case car.FieldRegisteredAt:
+		return m.OldRegisteredAt(ctx)
+	case car.FieldBatteryCapacity:
+		return m.OldBatteryCapacity(ctx)
+	case car.FieldBatterySoh:
+		return m.OldBatterySoh(ctx)
+	}
+	return nil, fmt.Errorf("unknown Car field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case car.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case car.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case car.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case car.FieldModelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case car.FieldVin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVin(v)
+		return nil
+	case car.FieldPlate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlate(v)
+		return nil
+	case car.FieldOwnerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerName(v)
+		return nil
+	case car.FieldRegisteredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegisteredAt(v)
+		return nil
+	case car.FieldBatteryCapacity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatteryCapacity(v)
+		return nil
+	case car.FieldBatterySoh:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatterySoh(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Car field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CarMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, car.FieldTenantID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, car.FieldUserID)
+	}
+	if m.addbattery_capacity != nil {
+		fields = append(fields, car.FieldBatteryCapacity)
+	}
+	if m.addbattery_soh != nil {
+		fields = append(fields, car.FieldBatterySoh)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CarMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case car.FieldTenantID:
+		return m.AddedTenantID()
+	case car.FieldUserID:
+		return m.AddedUserID()
+	case car.FieldBatteryCapacity:
+		return m.AddedBatteryCapacity()
+	case car.FieldBatterySoh:
+		return m.AddedBatterySoh()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case car.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case car.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case car.FieldBatteryCapacity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBatteryCapacity(v)
+		return nil
+	case car.FieldBatterySoh:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBatterySoh(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Car numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CarMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(car.FieldTenantID) {
+		fields = append(fields, car.FieldTenantID)
+	}
+	if m.FieldCleared(car.FieldUserID) {
+		fields = append(fields, car.FieldUserID)
+	}
+	if m.FieldCleared(car.FieldModel) {
+		fields = append(fields, car.FieldModel)
+	}
+	if m.FieldCleared(car.FieldModelID) {
+		fields = append(fields, car.FieldModelID)
+	}
+	if m.FieldCleared(car.FieldVin) {
+		fields = append(fields, car.FieldVin)
+	}
+	if m.FieldCleared(car.FieldPlate) {
+		fields = append(fields, car.FieldPlate)
+	}
+	if m.FieldCleared(car.FieldOwnerName) {
+		fields = append(fields, car.FieldOwnerName)
+	}
+	if m.FieldCleared(car.FieldBatteryCapacity) {
+		fields = append(fields, car.FieldBatteryCapacity)
+	}
+	if m.FieldCleared(car.FieldBatterySoh) {
+		fields = append(fields, car.FieldBatterySoh)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CarMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CarMutation) ClearField(name string) error {
+	switch name {
+	case car.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case car.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case car.FieldModel:
+		m.ClearModel()
+		return nil
+	case car.FieldModelID:
+		m.ClearModelID()
+		return nil
+	case car.FieldVin:
+		m.ClearVin()
+		return nil
+	case car.FieldPlate:
+		m.ClearPlate()
+		return nil
+	case car.FieldOwnerName:
+		m.ClearOwnerName()
+		return nil
+	case car.FieldBatteryCapacity:
+		m.ClearBatteryCapacity()
+		return nil
+	case car.FieldBatterySoh:
+		m.ClearBatterySoh()
+		return nil
+	}
+	return fmt.Errorf("unknown Car nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CarMutation) ResetField(name string) error {
+	switch name {
+	case car.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case car.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case car.FieldModel:
+		m.ResetModel()
+		return nil
+	case car.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case car.FieldVin:
+		m.ResetVin()
+		return nil
+	case car.FieldPlate:
+		m.ResetPlate()
+		return nil
+	case car.FieldOwnerName:
+		m.ResetOwnerName()
+		return nil
+	case car.FieldRegisteredAt:
+		m.ResetRegisteredAt()
+		return nil
+	case car.FieldBatteryCapacity:
+		m.ResetBatteryCapacity()
+		return nil
+	case car.FieldBatterySoh:
+		m.ResetBatterySoh()
+		return nil
+	}
+	return fmt.Errorf("unknown Car field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CarMutation) AddedEdges() []string {
+	edges := make([]string, 0, 22)
+	if m.vehicle_model != nil {
+		edges = append(edges, car.EdgeVehicleModel)
+	}
+	if m.maintenance_records != nil {
+		edges = append(edges, car.EdgeMaintenanceRecords)
+	}
+	if m.insurance_policies != nil {
+		edges = append(edges, car.EdgeInsurancePolicies)
+	}
+	if m.transfers != nil {
+		edges = append(edges, car.EdgeTransfers)
+	}
+	if m.odometer_readings != nil {
+		edges = append(edges, car.EdgeOdometerReadings)
+	}
+	if m.attachments != nil {
+		edges = append(edges, car.EdgeAttachments)
+	}
+	if m.fleets != nil {
+		edges = append(edges, car.EdgeFleets)
+	}
+	if m.tags != nil {
+		edges = append(edges, car.EdgeTags)
+	}
+	if m.attributes != nil {
+		edges = append(edges, car.EdgeAttributes)
+	}
+	if m.reservations != nil {
+		edges = append(edges, car.EdgeReservations)
+	}
+	if m.trips != nil {
+		edges = append(edges, car.EdgeTrips)
+	}
+	if m.refuels != nil {
+		edges = append(edges, car.EdgeRefuels)
+	}
+	if m.recalls != nil {
+		edges = append(edges, car.EdgeRecalls)
+	}
+	if m.owner_histories != nil {
+		edges = append(edges, car.EdgeOwnerHistories)
+	}
+	if m.violations != nil {
+		edges = append(edges, car.EdgeViolations)
+	}
+	if m.trade_records != nil {
+		edges = append(edges, car.EdgeTradeRecords)
+	}
+	if m.listings != nil {
+		edges = append(edges, car.EdgeListings)
+	}
+	if m.warranties != nil {
+		edges = append(edges, car.EdgeWarranties)
+	}
+	if m.incidents != nil {
+		edges = append(edges, car.EdgeIncidents)
+	}
+	if m.lease_contracts != nil {
+		edges = append(edges, car.EdgeLeaseContracts)
+	}
+	if m.charging_sessions != nil {
+		edges = append(edges, car.EdgeChargingSessions)
+	}
+	if m.geofences != nil {
+		edges = append(edges, car.EdgeGeofences)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CarMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case car.EdgeVehicleModel:
+		if id := m.vehicle_model; id != nil {
+			return []ent.Value{*id}
+		}
+	case car.EdgeMaintenanceRecords:
+		ids := make([]ent.Value, 0, len(m.maintenance_records))
+		for id := range m.maintenance_records {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeInsurancePolicies:
+		ids := make([]ent.Value, 0, len(m.insurance_policies))
+		for id := range m.insurance_policies {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeTransfers:
+		ids := make([]ent.Value, 0, len(m.transfers))
+		for id := range m.transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeOdometerReadings:
+		ids := make([]ent.Value, 0, len(m.odometer_readings))
+		for id := range m.odometer_readings {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeFleets:
+		ids := make([]ent.Value, 0, len(m.fleets))
+		for id := range m.fleets {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeAttributes:
+		ids := make([]ent.Value, 0, len(m.attributes))
+		for id := range m.attributes {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeReservations:
+		ids := make([]ent.Value, 0, len(m.reservations))
+		for id := range m.reservations {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeTrips:
+		ids := make([]ent.Value, 0, len(m.trips))
+		for id := range m.trips {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeRefuels:
+		ids := make([]ent.Value, 0, len(m.refuels))
+		for id := range m.refuels {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeRecalls:
+		ids := make([]ent.Value, 0, len(m.recalls))
+		for id := range m.recalls {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeOwnerHistories:
+		ids := make([]ent.Value, 0, len(m.owner_histories))
+		for id := range m.owner_histories {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeViolations:
+		ids := make([]ent.Value, 0, len(m.violations))
+		for id := range m.violations {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeTradeRecords:
+		ids := make([]ent.Value, 0, len(m.trade_records))
+		for id := range m.trade_records {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.listings))
+		for id := range m.listings {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeWarranties:
+		ids := make([]ent.Value, 0, len(m.warranties))
+		for id := range m.warranties {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeIncidents:
+		ids := make([]ent.Value, 0, len(m.incidents))
+		for id := range m.incidents {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeLeaseContracts:
+		ids := make([]ent.Value, 0, len(m.lease_contracts))
+		for id := range m.lease_contracts {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeChargingSessions:
+		ids := make([]ent.Value, 0, len(m.charging_sessions))
+		for id := range m.charging_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeGeofences:
+		ids := make([]ent.Value, 0, len(m.geofences))
+		for id := range m.geofences {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CarMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 22)
+	if m.removedmaintenance_records != nil {
+		edges = append(edges, car.EdgeMaintenanceRecords)
+	}
+	if m.removedinsurance_policies != nil {
+		edges = append(edges, car.EdgeInsurancePolicies)
+	}
+	if m.removedtransfers != nil {
+		edges = append(edges, car.EdgeTransfers)
+	}
+	if m.removedodometer_readings != nil {
+		edges = append(edges, car.EdgeOdometerReadings)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, car.EdgeAttachments)
+	}
+	if m.removedfleets != nil {
+		edges = append(edges, car.EdgeFleets)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, car.EdgeTags)
+	}
+	if m.removedattributes != nil {
+		edges = append(edges, car.EdgeAttributes)
+	}
+	if m.removedreservations != nil {
+		edges = append(edges, car.EdgeReservations)
+	}
+	if m.removedtrips != nil {
+		edges = append(edges, car.EdgeTrips)
+	}
+	if m.removedrefuels != nil {
+		edges = append(edges, car.EdgeRefuels)
+	}
+	if m.removedrecalls != nil {
+		edges = append(edges, car.EdgeRecalls)
+	}
+	if m.removedowner_histories != nil {
+		edges = append(edges, car.EdgeOwnerHistories)
+	}
+	if m.removedviolations != nil {
+		edges = append(edges, car.EdgeViolations)
+	}
+	if m.removedtrade_records != nil {
+		edges = append(edges, car.EdgeTradeRecords)
+	}
+	if m.removedlistings != nil {
+		edges = append(edges, car.EdgeListings)
+	}
+	if m.removedwarranties != nil {
+		edges = append(edges, car.EdgeWarranties)
+	}
+	if m.removedincidents != nil {
+		edges = append(edges, car.EdgeIncidents)
+	}
+	if m.removedlease_contracts != nil {
+		edges = append(edges, car.EdgeLeaseContracts)
+	}
+	if m.removedcharging_sessions != nil {
+		edges = append(edges, car.EdgeChargingSessions)
+	}
+	if m.removedgeofences != nil {
+		edges = append(edges, car.EdgeGeofences)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CarMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case car.EdgeMaintenanceRecords:
+		ids := make([]ent.Value, 0, len(m.removedmaintenance_records))
+		for id := range m.removedmaintenance_records {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeInsurancePolicies:
+		ids := make([]ent.Value, 0, len(m.removedinsurance_policies))
+		for id := range m.removedinsurance_policies {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeTransfers:
+		ids := make([]ent.Value, 0, len(m.removedtransfers))
+		for id := range m.removedtransfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeOdometerReadings:
+		ids := make([]ent.Value, 0, len(m.removedodometer_readings))
+		for id := range m.removedodometer_readings {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeFleets:
+		ids := make([]ent.Value, 0, len(m.removedfleets))
+		for id := range m.removedfleets {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeAttributes:
+		ids := make([]ent.Value, 0, len(m.removedattributes))
+		for id := range m.removedattributes {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeReservations:
+		ids := make([]ent.Value, 0, len(m.removedreservations))
+		for id := range m.removedreservations {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeTrips:
+		ids := make([]ent.Value, 0, len(m.removedtrips))
+		for id := range m.removedtrips {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeRefuels:
+		ids := make([]ent.Value, 0, len(m.removedrefuels))
+		for id := range m.removedrefuels {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeRecalls:
+		ids := make([]ent.Value, 0, len(m.removedrecalls))
+		for id := range m.removedrecalls {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeOwnerHistories:
+		ids := make([]ent.Value, 0, len(m.removedowner_histories))
+		for id := range m.removedowner_histories {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeViolations:
+		ids := make([]ent.Value, 0, len(m.removedviolations))
+		for id := range m.removedviolations {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeTradeRecords:
+		ids := make([]ent.Value, 0, len(m.removedtrade_records))
+		for id := range m.removedtrade_records {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.removedlistings))
+		for id := range m.removedlistings {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeWarranties:
+		ids := make([]ent.Value, 0, len(m.removedwarranties))
+		for id := range m.removedwarranties {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeIncidents:
+		ids := make([]ent.Value, 0, len(m.removedincidents))
+		for id := range m.removedincidents {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeLeaseContracts:
+		ids := make([]ent.Value, 0, len(m.removedlease_contracts))
+		for id := range m.removedlease_contracts {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeChargingSessions:
+		ids := make([]ent.Value, 0, len(m.removedcharging_sessions))
+		for id := range m.removedcharging_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeGeofences:
+		ids := make([]ent.Value, 0, len(m.removedgeofences))
+		for id := range m.removedgeofences {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CarMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 22)
+	if m.clearedvehicle_model {
+		edges = append(edges, car.EdgeVehicleModel)
+	}
+	if m.clearedmaintenance_records {
+		edges = append(edges, car.EdgeMaintenanceRecords)
+	}
+	if m.clearedinsurance_policies {
+		edges = append(edges, car.EdgeInsurancePolicies)
+	}
+	if m.clearedtransfers {
+		edges = append(edges, car.EdgeTransfers)
+	}
+	if m.clearedodometer_readings {
+		edges = append(edges, car.EdgeOdometerReadings)
+	}
+	if m.clearedattachments {
+		edges = append(edges, car.EdgeAttachments)
+	}
+	if m.clearedfleets {
+		edges = append(edges, car.EdgeFleets)
+	}
+	if m.clearedtags {
+		edges = append(edges, car.EdgeTags)
+	}
+	if m.clearedattributes {
+		edges = append(edges, car.EdgeAttributes)
+	}
+	if m.clearedreservations {
+		edges = append(edges, car.EdgeReservations)
+	}
+	if m.clearedtrips {
+		edges = append(edges, car.EdgeTrips)
+	}
+	if m.clearedrefuels {
+		edges = append(edges, car.EdgeRefuels)
+	}
+	if m.clearedrecalls {
+		edges = append(edges, car.EdgeRecalls)
+	}
+	if m.clearedowner_histories {
+		edges = append(edges, car.EdgeOwnerHistories)
+	}
+	if m.clearedviolations {
+		edges = append(edges, car.EdgeViolations)
+	}
+	if m.clearedtrade_records {
+		edges = append(edges, car.EdgeTradeRecords)
+	}
+	if m.clearedlistings {
+		edges = append(edges, car.EdgeListings)
+	}
+	if m.clearedwarranties {
+		edges = append(edges, car.EdgeWarranties)
+	}
+	if m.clearedincidents {
+		edges = append(edges, car.EdgeIncidents)
+	}
+	if m.clearedlease_contracts {
+		edges = append(edges, car.EdgeLeaseContracts)
+	}
+	if m.clearedcharging_sessions {
+		edges = append(edges, car.EdgeChargingSessions)
+	}
+	if m.clearedgeofences {
+		edges = append(edges, car.EdgeGeofences)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CarMutation) EdgeCleared(name string) bool {
+	switch name {
+	case car.EdgeVehicleModel:
+		return m.clearedvehicle_model
+	case car.EdgeMaintenanceRecords:
+		return m.clearedmaintenance_records
+	case car.EdgeInsurancePolicies:
+		return m.clearedinsurance_policies
+	case car.EdgeTransfers:
+		return m.clearedtransfers
+	case car.EdgeOdometerReadings:
+		return m.clearedodometer_readings
+	case car.EdgeAttachments:
+		return m.clearedattachments
+	case car.EdgeFleets:
+		return m.clearedfleets
+	case car.EdgeTags:
+		return m.clearedtags
+	case car.EdgeAttributes:
+		return m.clearedattributes
+	case car.EdgeReservations:
+		return m.clearedreservations
+	case car.EdgeTrips:
+		return m.clearedtrips
+	case car.EdgeRefuels:
+		return m.clearedrefuels
+	case car.EdgeRecalls:
+		return m.clearedrecalls
+	case car.EdgeOwnerHistories:
+		return m.clearedowner_histories
+	case car.EdgeViolations:
+		return m.clearedviolations
+	case car.EdgeTradeRecords:
+		return m.clearedtrade_records
+	case car.EdgeListings:
+		return m.clearedlistings
+	case car.EdgeWarranties:
+		return m.clearedwarranties
+	case car.EdgeIncidents:
+		return m.clearedincidents
+	case car.EdgeLeaseContracts:
+		return m.clearedlease_contracts
+	case car.EdgeChargingSessions:
+		return m.clearedcharging_sessions
+	case car.EdgeGeofences:
+		return m.clearedgeofences
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CarMutation) ClearEdge(name string) error {
+	switch name {
+	case car.EdgeVehicleModel:
+		m.ClearVehicleModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Car unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CarMutation) ResetEdge(name string) error {
+	switch name {
+	case car.EdgeVehicleModel:
+		m.ResetVehicleModel()
+		return nil
+	case car.EdgeMaintenanceRecords:
+		m.ResetMaintenanceRecords()
+		return nil
+	case car.EdgeInsurancePolicies:
+		m.ResetInsurancePolicies()
+		return nil
+	case car.EdgeTransfers:
+		m.ResetTransfers()
+		return nil
+	case car.EdgeOdometerReadings:
+		m.ResetOdometerReadings()
+		return nil
+	case car.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case car.EdgeFleets:
+		m.ResetFleets()
+		return nil
+	case car.EdgeTags:
+		m.ResetTags()
+		return nil
+	case car.EdgeAttributes:
+		m.ResetAttributes()
+		return nil
+	case car.EdgeReservations:
+		m.ResetReservations()
+		return nil
+	case car.EdgeTrips:
+		m.ResetTrips()
+		return nil
+	case car.EdgeRefuels:
+		m.ResetRefuels()
+		return nil
+	case car.EdgeRecalls:
+		m.ResetRecalls()
+		return nil
+	case car.EdgeOwnerHistories:
+		m.ResetOwnerHistories()
+		return nil
+	case car.EdgeViolations:
+		m.ResetViolations()
+		return nil
+	case car.EdgeTradeRecords:
+		m.ResetTradeRecords()
+		return nil
+	case car.EdgeListings:
+		m.ResetListings()
+		return nil
+	case car.EdgeWarranties:
+		m.ResetWarranties()
+		return nil
+	case car.EdgeIncidents:
+		m.ResetIncidents()
+		return nil
+	case car.EdgeLeaseContracts:
+		m.ResetLeaseContracts()
+		return nil
+	case car.EdgeChargingSessions:
+		m.ResetChargingSessions()
+		return nil
+	case car.EdgeGeofences:
+		m.ResetGeofences()
+		return nil
+	}
+	return fmt.Errorf("unknown Car edge %s", name)
+}
+
+// CarAttributeMutation represents an operation that mutates the CarAttribute nodes in the graph.
+type CarAttributeMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	value             *string
+	clearedFields     map[string]struct{}
+	car               *int64
+	clearedcar        bool
+	definition        *int64
+	cleareddefinition bool
+	done              bool
+	oldValue          func(context.Context) (*CarAttribute, error)
+	predicates        []predicate.CarAttribute
+}
+
+var _ ent.Mutation = (*CarAttributeMutation)(nil)
+
+// carattributeOption allows management of the mutation configuration using functional options.
+type carattributeOption func(*CarAttributeMutation)
+
+// newCarAttributeMutation creates new mutation for the CarAttribute entity.
+func newCarAttributeMutation(c config, op Op, opts ...carattributeOption) *CarAttributeMutation {
+	m := &CarAttributeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCarAttribute,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCarAttributeID sets the ID field of the mutation.
+func withCarAttributeID(id int64) carattributeOption {
+	return func(m *CarAttributeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CarAttribute
+		)
+		m.oldValue = func(ctx context.Context) (*CarAttribute, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CarAttribute.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCarAttribute sets the old CarAttribute of the mutation.
+func withCarAttribute(node *CarAttribute) carattributeOption {
+	return func(m *CarAttributeMutation) {
+		m.oldValue = func(context.Context) (*CarAttribute, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CarAttributeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CarAttributeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CarAttribute entities.
+func (m *CarAttributeMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CarAttributeMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CarAttributeMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CarAttribute.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCarID sets the "car_id" field.
+func (m *CarAttributeMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *CarAttributeMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the CarAttribute entity.
+// If the CarAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarAttributeMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *CarAttributeMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[carattribute.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *CarAttributeMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[carattribute.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *CarAttributeMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, carattribute.FieldCarID)
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (m *CarAttributeMutation) SetDefinitionID(i int64) {
+	m.definition = &i
+}
+
+// DefinitionID returns the value of the "definition_id" field in the mutation.
+func (m *CarAttributeMutation) DefinitionID() (r int64, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefinitionID returns the old "definition_id" field's value of the CarAttribute entity.
+// If the CarAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarAttributeMutation) OldDefinitionID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefinitionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefinitionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefinitionID: %w", err)
+	}
+	return oldValue.DefinitionID, nil
+}
+
+// ClearDefinitionID clears the value of the "definition_id" field.
+func (m *CarAttributeMutation) ClearDefinitionID() {
+	m.definition = nil
+	m.clearedFields[carattribute.FieldDefinitionID] = struct{}{}
+}
+
+// DefinitionIDCleared returns if the "definition_id" field was cleared in this mutation.
+func (m *CarAttributeMutation) DefinitionIDCleared() bool {
+	_, ok := m.clearedFields[carattribute.FieldDefinitionID]
+	return ok
+}
+
+// ResetDefinitionID resets all changes to the "definition_id" field.
+func (m *CarAttributeMutation) ResetDefinitionID() {
+	m.definition = nil
+	delete(m.clearedFields, carattribute.FieldDefinitionID)
+}
+
+// SetValue sets the "value" field.
+func (m *CarAttributeMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *CarAttributeMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the CarAttribute entity.
+// If the CarAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarAttributeMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *CarAttributeMutation) ResetValue() {
+	m.value = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *CarAttributeMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *CarAttributeMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *CarAttributeMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *CarAttributeMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// ClearDefinition clears the "definition" edge to the AttributeDefinition entity.
+func (m *CarAttributeMutation) ClearDefinition() {
+	m.cleareddefinition = true
+}
+
+// DefinitionCleared reports if the "definition" edge to the AttributeDefinition entity was cleared.
+func (m *CarAttributeMutation) DefinitionCleared() bool {
+	return m.DefinitionIDCleared() || m.cleareddefinition
+}
+
+// DefinitionIDs returns the "definition" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DefinitionID instead. It exists only for internal usage by the builders.
+func (m *CarAttributeMutation) DefinitionIDs() (ids []int64) {
+	if id := m.definition; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDefinition resets all changes to the "definition" edge.
+func (m *CarAttributeMutation) ResetDefinition() {
+	m.definition = nil
+	m.cleareddefinition = false
+}
+
+// Where appends a list predicates to the CarAttributeMutation builder.
+func (m *CarAttributeMutation) Where(ps ...predicate.CarAttribute) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CarAttributeMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (CarAttribute).
+func (m *CarAttributeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CarAttributeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.car != nil {
+		fields = append(fields, carattribute.FieldCarID)
+	}
+	if m.definition != nil {
+		fields = append(fields, carattribute.FieldDefinitionID)
+	}
+	if m.value != nil {
+		fields = append(fields, carattribute.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CarAttributeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case carattribute.FieldCarID:
+		return m.CarID()
+	case carattribute.FieldDefinitionID:
+		return m.DefinitionID()
+	case carattribute.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CarAttributeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case carattribute.FieldCarID:
+		return m.OldCarID(ctx)
+	case carattribute.FieldDefinitionID:
+		return m.OldDefinitionID(ctx)
+	case carattribute.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown CarAttribute field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarAttributeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case carattribute.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case carattribute.FieldDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefinitionID(v)
+		return nil
+	case carattribute.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CarAttribute field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CarAttributeMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CarAttributeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarAttributeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CarAttribute numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CarAttributeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(carattribute.FieldCarID) {
+		fields = append(fields, carattribute.FieldCarID)
+	}
+	if m.FieldCleared(carattribute.FieldDefinitionID) {
+		fields = append(fields, carattribute.FieldDefinitionID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CarAttributeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CarAttributeMutation) ClearField(name string) error {
+	switch name {
+	case carattribute.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case carattribute.FieldDefinitionID:
+		m.ClearDefinitionID()
+		return nil
+	}
+	return fmt.Errorf("unknown CarAttribute nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CarAttributeMutation) ResetField(name string) error {
+	switch name {
+	case carattribute.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case carattribute.FieldDefinitionID:
+		m.ResetDefinitionID()
+		return nil
+	case carattribute.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown CarAttribute field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CarAttributeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.car != nil {
+		edges = append(edges, carattribute.EdgeCar)
+	}
+	if m.definition != nil {
+		edges = append(edges, carattribute.EdgeDefinition)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CarAttributeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case carattribute.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	case carattribute.EdgeDefinition:
+		if id := m.definition; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CarAttributeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CarAttributeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CarAttributeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcar {
+		edges = append(edges, carattribute.EdgeCar)
+	}
+	if m.cleareddefinition {
+		edges = append(edges, carattribute.EdgeDefinition)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CarAttributeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case carattribute.EdgeCar:
+		return m.clearedcar
+	case carattribute.EdgeDefinition:
+		return m.cleareddefinition
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CarAttributeMutation) ClearEdge(name string) error {
+	switch name {
+	case carattribute.EdgeCar:
+		m.ClearCar()
+		return nil
+	case carattribute.EdgeDefinition:
+		m.ClearDefinition()
+		return nil
+	}
+	return fmt.Errorf("unknown CarAttribute unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CarAttributeMutation) ResetEdge(name string) error {
+	switch name {
+	case carattribute.EdgeCar:
+		m.ResetCar()
+		return nil
+	case carattribute.EdgeDefinition:
+		m.ResetDefinition()
+		return nil
+	}
+	return fmt.Errorf("unknown CarAttribute edge %s", name)
+}
+
+// CarRecallMutation represents an operation that mutates the CarRecall nodes in the graph.
+type CarRecallMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	status        *string
+	notes         *string
+	remedied_at   *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	car           *int64
+	clearedcar    bool
+	recall        *int64
+	clearedrecall bool
+	done          bool
+	oldValue      func(context.Context) (*CarRecall, error)
+	predicates    []predicate.CarRecall
+}
+
+var _ ent.Mutation = (*CarRecallMutation)(nil)
+
+// carrecallOption allows management of the mutation configuration using functional options.
+type carrecallOption func(*CarRecallMutation)
+
+// newCarRecallMutation creates new mutation for the CarRecall entity.
+func newCarRecallMutation(c config, op Op, opts ...carrecallOption) *CarRecallMutation {
+	m := &CarRecallMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCarRecall,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCarRecallID sets the ID field of the mutation.
+func withCarRecallID(id int64) carrecallOption {
+	return func(m *CarRecallMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CarRecall
+		)
+		m.oldValue = func(ctx context.Context) (*CarRecall, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CarRecall.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCarRecall sets the old CarRecall of the mutation.
+func withCarRecall(node *CarRecall) carrecallOption {
+	return func(m *CarRecallMutation) {
+		m.oldValue = func(context.Context) (*CarRecall, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CarRecallMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CarRecallMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CarRecall entities.
+func (m *CarRecallMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CarRecallMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CarRecallMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CarRecall.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *CarRecallMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *CarRecallMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the CarRecall entity.
+// If the CarRecall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarRecallMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *CarRecallMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *CarRecallMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *CarRecallMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[carrecall.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *CarRecallMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[carrecall.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *CarRecallMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, carrecall.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *CarRecallMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *CarRecallMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the CarRecall entity.
+// If the CarRecall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarRecallMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *CarRecallMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[carrecall.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *CarRecallMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[carrecall.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *CarRecallMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, carrecall.FieldCarID)
+}
+
+// SetRecallID sets the "recall_id" field.
+func (m *CarRecallMutation) SetRecallID(i int64) {
+	m.recall = &i
+}
+
+// RecallID returns the value of the "recall_id" field in the mutation.
+func (m *CarRecallMutation) RecallID() (r int64, exists bool) {
+	v := m.recall
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecallID returns the old "recall_id" field's value of the CarRecall entity.
+// If the CarRecall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarRecallMutation) OldRecallID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecallID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecallID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecallID: %w", err)
+	}
+	return oldValue.RecallID, nil
+}
+
+// ClearRecallID clears the value of the "recall_id" field.
+func (m *CarRecallMutation) ClearRecallID() {
+	m.recall = nil
+	m.clearedFields[carrecall.FieldRecallID] = struct{}{}
+}
+
+// RecallIDCleared returns if the "recall_id" field was cleared in this mutation.
+func (m *CarRecallMutation) RecallIDCleared() bool {
+	_, ok := m.clearedFields[carrecall.FieldRecallID]
+	return ok
+}
+
+// ResetRecallID resets all changes to the "recall_id" field.
+func (m *CarRecallMutation) ResetRecallID() {
+	m.recall = nil
+	delete(m.clearedFields, carrecall.FieldRecallID)
+}
+
+// SetStatus sets the "status" field.
+func (m *CarRecallMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CarRecallMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the CarRecall entity.
+// If the CarRecall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarRecallMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *CarRecallMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetNotes sets the "notes" field.
+func (m *CarRecallMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *CarRecallMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the CarRecall entity.
+// If the CarRecall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarRecallMutation) OldNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *CarRecallMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[carrecall.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *CarRecallMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[carrecall.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *CarRecallMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, carrecall.FieldNotes)
+}
+
+// SetRemediedAt sets the "remedied_at" field.
+func (m *CarRecallMutation) SetRemediedAt(t time.Time) {
+	m.remedied_at = &t
+}
+
+// RemediedAt returns the value of the "remedied_at" field in the mutation.
+func (m *CarRecallMutation) RemediedAt() (r time.Time, exists bool) {
+	v := m.remedied_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemediedAt returns the old "remedied_at" field's value of the CarRecall entity.
+// If the CarRecall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarRecallMutation) OldRemediedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemediedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemediedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemediedAt: %w", err)
+	}
+	return oldValue.RemediedAt, nil
+}
+
+// ClearRemediedAt clears the value of the "remedied_at" field.
+func (m *CarRecallMutation) ClearRemediedAt() {
+	m.remedied_at = nil
+	m.clearedFields[carrecall.FieldRemediedAt] = struct{}{}
+}
+
+// RemediedAtCleared returns if the "remedied_at" field was cleared in this mutation.
+func (m *CarRecallMutation) RemediedAtCleared() bool {
+	_, ok := m.clearedFields[carrecall.FieldRemediedAt]
+	return ok
+}
+
+// ResetRemediedAt resets all changes to the "remedied_at" field.
+func (m *CarRecallMutation) ResetRemediedAt() {
+	m.remedied_at = nil
+	delete(m.clearedFields, carrecall.FieldRemediedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CarRecallMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CarRecallMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CarRecall entity.
+// If the CarRecall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarRecallMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CarRecallMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CarRecallMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CarRecallMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CarRecall entity.
+// If the CarRecall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarRecallMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CarRecallMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *CarRecallMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *CarRecallMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *CarRecallMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *CarRecallMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// ClearRecall clears the "recall" edge to the Recall entity.
+func (m *CarRecallMutation) ClearRecall() {
+	m.clearedrecall = true
+}
+
+// RecallCleared reports if the "recall" edge to the Recall entity was cleared.
+func (m *CarRecallMutation) RecallCleared() bool {
+	return m.RecallIDCleared() || m.clearedrecall
+}
+
+// RecallIDs returns the "recall" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecallID instead. It exists only for internal usage by the builders.
+func (m *CarRecallMutation) RecallIDs() (ids []int64) {
+	if id := m.recall; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecall resets all changes to the "recall" edge.
+func (m *CarRecallMutation) ResetRecall() {
+	m.recall = nil
+	m.clearedrecall = false
+}
+
+// Where appends a list predicates to the CarRecallMutation builder.
+func (m *CarRecallMutation) Where(ps ...predicate.CarRecall) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CarRecallMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (CarRecall).
+func (m *CarRecallMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CarRecallMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.tenant_id != nil {
+		fields = append(fields, carrecall.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, carrecall.FieldCarID)
+	}
+	if m.recall != nil {
+		fields = append(fields, carrecall.FieldRecallID)
+	}
+	if m.status != nil {
+		fields = append(fields, carrecall.FieldStatus)
+	}
+	if m.notes != nil {
+		fields = append(fields, carrecall.FieldNotes)
+	}
+	if m.remedied_at != nil {
+		fields = append(fields, carrecall.FieldRemediedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, carrecall.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, carrecall.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CarRecallMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case carrecall.FieldTenantID:
+		return m.TenantID()
+	case carrecall.FieldCarID:
+		return m.CarID()
+	case carrecall.FieldRecallID:
+		return m.RecallID()
+	case carrecall.FieldStatus:
+		return m.Status()
+	case carrecall.FieldNotes:
+		return m.Notes()
+	case carrecall.FieldRemediedAt:
+		return m.RemediedAt()
+	case carrecall.FieldCreatedAt:
+		return m.CreatedAt()
+	case carrecall.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CarRecallMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case carrecall.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case carrecall.FieldCarID:
+		return m.OldCarID(ctx)
+	case carrecall.FieldRecallID:
+		return m.OldRecallID(ctx)
+	case carrecall.FieldStatus:
+		return m.OldStatus(ctx)
+	case carrecall.FieldNotes:
+		return m.OldNotes(ctx)
+	case carrecall.FieldRemediedAt:
+		return m.OldRemediedAt(ctx)
+	case carrecall.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case carrecall.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CarRecall field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarRecallMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case carrecall.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case carrecall.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case carrecall.FieldRecallID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecallID(v)
+		return nil
+	case carrecall.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case carrecall.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case carrecall.FieldRemediedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemediedAt(v)
+		return nil
+	case carrecall.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case carrecall.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CarRecall field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CarRecallMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, carrecall.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CarRecallMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case carrecall.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarRecallMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case carrecall.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CarRecall numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CarRecallMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(carrecall.FieldTenantID) {
+		fields = append(fields, carrecall.FieldTenantID)
+	}
+	if m.FieldCleared(carrecall.FieldCarID) {
+		fields = append(fields, carrecall.FieldCarID)
+	}
+	if m.FieldCleared(carrecall.FieldRecallID) {
+		fields = append(fields, carrecall.FieldRecallID)
+	}
+	if m.FieldCleared(carrecall.FieldNotes) {
+		fields = append(fields, carrecall.FieldNotes)
+	}
+	if m.FieldCleared(carrecall.FieldRemediedAt) {
+		fields = append(fields, carrecall.FieldRemediedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CarRecallMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CarRecallMutation) ClearField(name string) error {
+	switch name {
+	case carrecall.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case carrecall.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case carrecall.FieldRecallID:
+		m.ClearRecallID()
+		return nil
+	case carrecall.FieldNotes:
+		m.ClearNotes()
+		return nil
+	case carrecall.FieldRemediedAt:
+		m.ClearRemediedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CarRecall nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CarRecallMutation) ResetField(name string) error {
+	switch name {
+	case carrecall.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case carrecall.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case carrecall.FieldRecallID:
+		m.ResetRecallID()
+		return nil
+	case carrecall.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case carrecall.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case carrecall.FieldRemediedAt:
+		m.ResetRemediedAt()
+		return nil
+	case carrecall.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case carrecall.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CarRecall field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CarRecallMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.car != nil {
+		edges = append(edges, carrecall.EdgeCar)
+	}
+	if m.recall != nil {
+		edges = append(edges, carrecall.EdgeRecall)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CarRecallMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case carrecall.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	case carrecall.EdgeRecall:
+		if id := m.recall; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CarRecallMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CarRecallMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CarRecallMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcar {
+		edges = append(edges, carrecall.EdgeCar)
+	}
+	if m.clearedrecall {
+		edges = append(edges, carrecall.EdgeRecall)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CarRecallMutation) EdgeCleared(name string) bool {
+	switch name {
+	case carrecall.EdgeCar:
+		return m.clearedcar
+	case carrecall.EdgeRecall:
+		return m.clearedrecall
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CarRecallMutation) ClearEdge(name string) error {
+	switch name {
+	case carrecall.EdgeCar:
+		m.ClearCar()
+		return nil
+	case carrecall.EdgeRecall:
+		m.ClearRecall()
+		return nil
+	}
+	return fmt.Errorf("unknown CarRecall unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CarRecallMutation) ResetEdge(name string) error {
+	switch name {
+	case carrecall.EdgeCar:
+		m.ResetCar()
+		return nil
+	case carrecall.EdgeRecall:
+		m.ResetRecall()
+		return nil
+	}
+	return fmt.Errorf("unknown CarRecall edge %s", name)
+}
+
+// CarWarrantyMutation represents an operation that mutates the CarWarranty nodes in the graph.
+type CarWarrantyMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	tenant_id         *int64
+	addtenant_id      *int64
+	name              *string
+	components        *string
+	start_at          *time.Time
+	end_at            *time.Time
+	mileage_limit     *int64
+	addmileage_limit  *int64
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	car               *int64
+	clearedcar        bool
+	definition        *int64
+	cleareddefinition bool
+	done              bool
+	oldValue          func(context.Context) (*CarWarranty, error)
+	predicates        []predicate.CarWarranty
+}
+
+var _ ent.Mutation = (*CarWarrantyMutation)(nil)
+
+// carwarrantyOption allows management of the mutation configuration using functional options.
+type carwarrantyOption func(*CarWarrantyMutation)
+
+// newCarWarrantyMutation creates new mutation for the CarWarranty entity.
+func newCarWarrantyMutation(c config, op Op, opts ...carwarrantyOption) *CarWarrantyMutation {
+	m := &CarWarrantyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCarWarranty,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCarWarrantyID sets the ID field of the mutation.
+func withCarWarrantyID(id int64) carwarrantyOption {
+	return func(m *CarWarrantyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CarWarranty
+		)
+		m.oldValue = func(ctx context.Context) (*CarWarranty, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CarWarranty.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCarWarranty sets the old CarWarranty of the mutation.
+func withCarWarranty(node *CarWarranty) carwarrantyOption {
+	return func(m *CarWarrantyMutation) {
+		m.oldValue = func(context.Context) (*CarWarranty, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CarWarrantyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CarWarrantyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CarWarranty entities.
+func (m *CarWarrantyMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CarWarrantyMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CarWarrantyMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CarWarranty.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *CarWarrantyMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *CarWarrantyMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the CarWarranty entity.
+// If the CarWarranty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarWarrantyMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *CarWarrantyMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *CarWarrantyMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *CarWarrantyMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[carwarranty.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *CarWarrantyMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[carwarranty.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *CarWarrantyMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, carwarranty.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *CarWarrantyMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *CarWarrantyMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the CarWarranty entity.
+// If the CarWarranty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarWarrantyMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *CarWarrantyMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[carwarranty.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *CarWarrantyMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[carwarranty.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *CarWarrantyMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, carwarranty.FieldCarID)
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (m *CarWarrantyMutation) SetDefinitionID(i int64) {
+	m.definition = &i
+}
+
+// DefinitionID returns the value of the "definition_id" field in the mutation.
+func (m *CarWarrantyMutation) DefinitionID() (r int64, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefinitionID returns the old "definition_id" field's value of the CarWarranty entity.
+// If the CarWarranty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarWarrantyMutation) OldDefinitionID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefinitionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefinitionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefinitionID: %w", err)
+	}
+	return oldValue.DefinitionID, nil
+}
+
+// ClearDefinitionID clears the value of the "definition_id" field.
+func (m *CarWarrantyMutation) ClearDefinitionID() {
+	m.definition = nil
+	m.clearedFields[carwarranty.FieldDefinitionID] = struct{}{}
+}
+
+// DefinitionIDCleared returns if the "definition_id" field was cleared in this mutation.
+func (m *CarWarrantyMutation) DefinitionIDCleared() bool {
+	_, ok := m.clearedFields[carwarranty.FieldDefinitionID]
+	return ok
+}
+
+// ResetDefinitionID resets all changes to the "definition_id" field.
+func (m *CarWarrantyMutation) ResetDefinitionID() {
+	m.definition = nil
+	delete(m.clearedFields, carwarranty.FieldDefinitionID)
+}
+
+// SetName sets the "name" field.
+func (m *CarWarrantyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CarWarrantyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CarWarranty entity.
+// If the CarWarranty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarWarrantyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *CarWarrantyMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[carwarranty.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *CarWarrantyMutation) NameCleared() bool {
+	_, ok := m.clearedFields[carwarranty.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CarWarrantyMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, carwarranty.FieldName)
+}
+
+// SetComponents sets the "components" field.
+func (m *CarWarrantyMutation) SetComponents(s string) {
+	m.components = &s
+}
+
+// Components returns the value of the "components" field in the mutation.
+func (m *CarWarrantyMutation) Components() (r string, exists bool) {
+	v := m.components
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComponents returns the old "components" field's value of the CarWarranty entity.
+// If the CarWarranty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarWarrantyMutation) OldComponents(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComponents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComponents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComponents: %w", err)
+	}
+	return oldValue.Components, nil
+}
+
+// ClearComponents clears the value of the "components" field.
+func (m *CarWarrantyMutation) ClearComponents() {
+	m.components = nil
+	m.clearedFields[carwarranty.FieldComponents] = struct{}{}
+}
+
+// ComponentsCleared returns if the "components" field was cleared in this mutation.
+func (m *CarWarrantyMutation) ComponentsCleared() bool {
+	_, ok := m.clearedFields[carwarranty.FieldComponents]
+	return ok
+}
+
+// ResetComponents resets all changes to the "components" field.
+func (m *CarWarrantyMutation) ResetComponents() {
+	m.components = nil
+	delete(m.clearedFields, carwarranty.FieldComponents)
+}
+
+// SetStartAt sets the "start_at" field.
+func (m *CarWarrantyMutation) SetStartAt(t time.Time) {
+	m.start_at = &t
+}
+
+// StartAt returns the value of the "start_at" field in the mutation.
+func (m *CarWarrantyMutation) StartAt() (r time.Time, exists bool) {
+	v := m.start_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAt returns the old "start_at" field's value of the CarWarranty entity.
+// If the CarWarranty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarWarrantyMutation) OldStartAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+	}
+	return oldValue.StartAt, nil
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (m *CarWarrantyMutation) ClearStartAt() {
+	m.start_at = nil
+	m.clearedFields[carwarranty.FieldStartAt] = struct{}{}
+}
+
+// StartAtCleared returns if the "start_at" field was cleared in this mutation.
+func (m *CarWarrantyMutation) StartAtCleared() bool {
+	_, ok := m.clearedFields[carwarranty.FieldStartAt]
+	return ok
+}
+
+// ResetStartAt resets all changes to the "start_at" field.
+func (m *CarWarrantyMutation) ResetStartAt() {
+	m.start_at = nil
+	delete(m.clearedFields, carwarranty.FieldStartAt)
+}
+
+// SetEndAt sets the "end_at" field.
+func (m *CarWarrantyMutation) SetEndAt(t time.Time) {
+	m.end_at = &t
+}
+
+// EndAt returns the value of the "end_at" field in the mutation.
+func (m *CarWarrantyMutation) EndAt() (r time.Time, exists bool) {
+	v := m.end_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndAt returns the old "end_at" field's value of the CarWarranty entity.
+// If the CarWarranty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarWarrantyMutation) OldEndAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+	}
+	return oldValue.EndAt, nil
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (m *CarWarrantyMutation) ClearEndAt() {
+	m.end_at = nil
+	m.clearedFields[carwarranty.FieldEndAt] = struct{}{}
+}
+
+// EndAtCleared returns if the "end_at" field was cleared in this mutation.
+func (m *CarWarrantyMutation) EndAtCleared() bool {
+	_, ok := m.clearedFields[carwarranty.FieldEndAt]
+	return ok
+}
+
+// ResetEndAt resets all changes to the "end_at" field.
+func (m *CarWarrantyMutation) ResetEndAt() {
+	m.end_at = nil
+	delete(m.clearedFields, carwarranty.FieldEndAt)
+}
+
+// SetMileageLimit sets the "mileage_limit" field.
+func (m *CarWarrantyMutation) SetMileageLimit(i int64) {
+	m.mileage_limit = &i
+	m.addmileage_limit = nil
+}
+
+// MileageLimit returns the value of the "mileage_limit" field in the mutation.
+func (m *CarWarrantyMutation) MileageLimit() (r int64, exists bool) {
+	v := m.mileage_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMileageLimit returns the old "mileage_limit" field's value of the CarWarranty entity.
+// If the CarWarranty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarWarrantyMutation) OldMileageLimit(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMileageLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMileageLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMileageLimit: %w", err)
+	}
+	return oldValue.MileageLimit, nil
+}
+
+// AddMileageLimit adds i to the "mileage_limit" field.
+func (m *CarWarrantyMutation) AddMileageLimit(i int64) {
+	if m.addmileage_limit != nil {
+		*m.addmileage_limit += i
+	} else {
+		m.addmileage_limit = &i
+	}
+}
+
+// AddedMileageLimit returns the value that was added to the "mileage_limit" field in this mutation.
+func (m *CarWarrantyMutation) AddedMileageLimit() (r int64, exists bool) {
+	v := m.addmileage_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMileageLimit clears the value of the "mileage_limit" field.
+func (m *CarWarrantyMutation) ClearMileageLimit() {
+	m.mileage_limit = nil
+	m.addmileage_limit = nil
+	m.clearedFields[carwarranty.FieldMileageLimit] = struct{}{}
+}
+
+// MileageLimitCleared returns if the "mileage_limit" field was cleared in this mutation.
+func (m *CarWarrantyMutation) MileageLimitCleared() bool {
+	_, ok := m.clearedFields[carwarranty.FieldMileageLimit]
+	return ok
+}
+
+// ResetMileageLimit resets all changes to the "mileage_limit" field.
+func (m *CarWarrantyMutation) ResetMileageLimit() {
+	m.mileage_limit = nil
+	m.addmileage_limit = nil
+	delete(m.clearedFields, carwarranty.FieldMileageLimit)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CarWarrantyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CarWarrantyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CarWarranty entity.
+// If the CarWarranty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarWarrantyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CarWarrantyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *CarWarrantyMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *CarWarrantyMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *CarWarrantyMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *CarWarrantyMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// ClearDefinition clears the "definition" edge to the WarrantyDefinition entity.
+func (m *CarWarrantyMutation) ClearDefinition() {
+	m.cleareddefinition = true
+}
+
+// DefinitionCleared reports if the "definition" edge to the WarrantyDefinition entity was cleared.
+func (m *CarWarrantyMutation) DefinitionCleared() bool {
+	return m.DefinitionIDCleared() || m.cleareddefinition
+}
+
+// DefinitionIDs returns the "definition" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DefinitionID instead. It exists only for internal usage by the builders.
+func (m *CarWarrantyMutation) DefinitionIDs() (ids []int64) {
+	if id := m.definition; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDefinition resets all changes to the "definition" edge.
+func (m *CarWarrantyMutation) ResetDefinition() {
+	m.definition = nil
+	m.cleareddefinition = false
+}
+
+// Where appends a list predicates to the CarWarrantyMutation builder.
+func (m *CarWarrantyMutation) Where(ps ...predicate.CarWarranty) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CarWarrantyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (CarWarranty).
+func (m *CarWarrantyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CarWarrantyMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.tenant_id != nil {
+		fields = append(fields, carwarranty.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, carwarranty.FieldCarID)
+	}
+	if m.definition != nil {
+		fields = append(fields, carwarranty.FieldDefinitionID)
+	}
+	if m.name != nil {
+		fields = append(fields, carwarranty.FieldName)
+	}
+	if m.components != nil {
+		fields = append(fields, carwarranty.FieldComponents)
+	}
+	if m.start_at != nil {
+		fields = append(fields, carwarranty.FieldStartAt)
+	}
+	if m.end_at != nil {
+		fields = append(fields, carwarranty.FieldEndAt)
+	}
+	if m.mileage_limit != nil {
+		fields = append(fields, carwarranty.FieldMileageLimit)
+	}
+	if m.created_at != nil {
+		fields = append(fields, carwarranty.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CarWarrantyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case carwarranty.FieldTenantID:
+		return m.TenantID()
+	case carwarranty.FieldCarID:
+		return m.CarID()
+	case carwarranty.FieldDefinitionID:
+		return m.DefinitionID()
+	case carwarranty.FieldName:
+		return m.Name()
+	case carwarranty.FieldComponents:
+		return m.Components()
+	case carwarranty.FieldStartAt:
+		return m.StartAt()
+	case carwarranty.FieldEndAt:
+		return m.EndAt()
+	case carwarranty.FieldMileageLimit:
+		return m.MileageLimit()
+	case carwarranty.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CarWarrantyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case carwarranty.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case carwarranty.FieldCarID:
+		return m.OldCarID(ctx)
+	case carwarranty.FieldDefinitionID:
+		return m.OldDefinitionID(ctx)
+	case carwarranty.FieldName:
+		return m.OldName(ctx)
+	case carwarranty.FieldComponents:
+		return m.OldComponents(ctx)
+	case carwarranty.FieldStartAt:
+		return m.OldStartAt(ctx)
+	case carwarranty.FieldEndAt:
+		return m.OldEndAt(ctx)
+	case carwarranty.FieldMileageLimit:
+		return m.OldMileageLimit(ctx)
+	case carwarranty.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CarWarranty field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarWarrantyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case carwarranty.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case carwarranty.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case carwarranty.FieldDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefinitionID(v)
+		return nil
+	case carwarranty.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case carwarranty.FieldComponents:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComponents(v)
+		return nil
+	case carwarranty.FieldStartAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartAt(v)
+		return nil
+	case carwarranty.FieldEndAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAt(v)
+		return nil
+	case carwarranty.FieldMileageLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMileageLimit(v)
+		return nil
+	case carwarranty.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CarWarranty field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CarWarrantyMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, carwarranty.FieldTenantID)
+	}
+	if m.addmileage_limit != nil {
+		fields = append(fields, carwarranty.FieldMileageLimit)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CarWarrantyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case carwarranty.FieldTenantID:
+		return m.AddedTenantID()
+	case carwarranty.FieldMileageLimit:
+		return m.AddedMileageLimit()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarWarrantyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case carwarranty.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case carwarranty.FieldMileageLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMileageLimit(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CarWarranty numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CarWarrantyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(carwarranty.FieldTenantID) {
+		fields = append(fields, carwarranty.FieldTenantID)
+	}
+	if m.FieldCleared(carwarranty.FieldCarID) {
+		fields = append(fields, carwarranty.FieldCarID)
+	}
+	if m.FieldCleared(carwarranty.FieldDefinitionID) {
+		fields = append(fields, carwarranty.FieldDefinitionID)
+	}
+	if m.FieldCleared(carwarranty.FieldName) {
+		fields = append(fields, carwarranty.FieldName)
+	}
+	if m.FieldCleared(carwarranty.FieldComponents) {
+		fields = append(fields, carwarranty.FieldComponents)
+	}
+	if m.FieldCleared(carwarranty.FieldStartAt) {
+		fields = append(fields, carwarranty.FieldStartAt)
+	}
+	if m.FieldCleared(carwarranty.FieldEndAt) {
+		fields = append(fields, carwarranty.FieldEndAt)
+	}
+	if m.FieldCleared(carwarranty.FieldMileageLimit) {
+		fields = append(fields, carwarranty.FieldMileageLimit)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CarWarrantyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CarWarrantyMutation) ClearField(name string) error {
+	switch name {
+	case carwarranty.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case carwarranty.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case carwarranty.FieldDefinitionID:
+		m.ClearDefinitionID()
+		return nil
+	case carwarranty.FieldName:
+		m.ClearName()
+		return nil
+	case carwarranty.FieldComponents:
+		m.ClearComponents()
+		return nil
+	case carwarranty.FieldStartAt:
+		m.ClearStartAt()
+		return nil
+	case carwarranty.FieldEndAt:
+		m.ClearEndAt()
+		return nil
+	case carwarranty.FieldMileageLimit:
+		m.ClearMileageLimit()
+		return nil
+	}
+	return fmt.Errorf("unknown CarWarranty nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CarWarrantyMutation) ResetField(name string) error {
+	switch name {
+	case carwarranty.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case carwarranty.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case carwarranty.FieldDefinitionID:
+		m.ResetDefinitionID()
+		return nil
+	case carwarranty.FieldName:
+		m.ResetName()
+		return nil
+	case carwarranty.FieldComponents:
+		m.ResetComponents()
+		return nil
+	case carwarranty.FieldStartAt:
+		m.ResetStartAt()
+		return nil
+	case carwarranty.FieldEndAt:
+		m.ResetEndAt()
+		return nil
+	case carwarranty.FieldMileageLimit:
+		m.ResetMileageLimit()
+		return nil
+	case carwarranty.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CarWarranty field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CarWarrantyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.car != nil {
+		edges = append(edges, carwarranty.EdgeCar)
+	}
+	if m.definition != nil {
+		edges = append(edges, carwarranty.EdgeDefinition)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CarWarrantyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case carwarranty.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	case carwarranty.EdgeDefinition:
+		if id := m.definition; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CarWarrantyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CarWarrantyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CarWarrantyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcar {
+		edges = append(edges, carwarranty.EdgeCar)
+	}
+	if m.cleareddefinition {
+		edges = append(edges, carwarranty.EdgeDefinition)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CarWarrantyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case carwarranty.EdgeCar:
+		return m.clearedcar
+	case carwarranty.EdgeDefinition:
+		return m.cleareddefinition
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CarWarrantyMutation) ClearEdge(name string) error {
+	switch name {
+	case carwarranty.EdgeCar:
+		m.ClearCar()
+		return nil
+	case carwarranty.EdgeDefinition:
+		m.ClearDefinition()
+		return nil
+	}
+	return fmt.Errorf("unknown CarWarranty unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CarWarrantyMutation) ResetEdge(name string) error {
+	switch name {
+	case carwarranty.EdgeCar:
+		m.ResetCar()
+		return nil
+	case carwarranty.EdgeDefinition:
+		m.ResetDefinition()
+		return nil
+	}
+	return fmt.Errorf("unknown CarWarranty edge %s", name)
+}
+
+// ChargingSessionMutation represents an operation that mutates the ChargingSession nodes in the graph.
+type ChargingSessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	started_at    *time.Time
+	ended_at      *time.Time
+	energy        *float64
+	addenergy     *float64
+	charger_type  *string
+	cost          *float64
+	addcost       *float64
+	station       *string
+	start_soc     *float64
+	addstart_soc  *float64
+	end_soc       *float64
+	addend_soc    *float64
+	soh           *float64
+	addsoh        *float64
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	car           *int64
+	clearedcar    bool
+	done          bool
+	oldValue      func(context.Context) (*ChargingSession, error)
+	predicates    []predicate.ChargingSession
+}
+
+var _ ent.Mutation = (*ChargingSessionMutation)(nil)
+
+// chargingsessionOption allows management of the mutation configuration using functional options.
+type chargingsessionOption func(*ChargingSessionMutation)
+
+// newChargingSessionMutation creates new mutation for the ChargingSession entity.
+func newChargingSessionMutation(c config, op Op, opts ...chargingsessionOption) *ChargingSessionMutation {
+	m := &ChargingSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChargingSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChargingSessionID sets the ID field of the mutation.
+func withChargingSessionID(id int64) chargingsessionOption {
+	return func(m *ChargingSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChargingSession
+		)
+		m.oldValue = func(ctx context.Context) (*ChargingSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChargingSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChargingSession sets the old ChargingSession of the mutation.
+func withChargingSession(node *ChargingSession) chargingsessionOption {
+	return func(m *ChargingSessionMutation) {
+		m.oldValue = func(context.Context) (*ChargingSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChargingSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChargingSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChargingSession entities.
+func (m *ChargingSessionMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChargingSessionMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChargingSessionMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChargingSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ChargingSessionMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ChargingSessionMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *ChargingSessionMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *ChargingSessionMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *ChargingSessionMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[chargingsession.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *ChargingSessionMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ChargingSessionMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, chargingsession.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *ChargingSessionMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *ChargingSessionMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *ChargingSessionMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[chargingsession.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *ChargingSessionMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *ChargingSessionMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, chargingsession.FieldCarID)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ChargingSessionMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ChargingSessionMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *ChargingSessionMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[chargingsession.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *ChargingSessionMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ChargingSessionMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, chargingsession.FieldStartedAt)
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *ChargingSessionMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *ChargingSessionMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldEndedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *ChargingSessionMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[chargingsession.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *ChargingSessionMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *ChargingSessionMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, chargingsession.FieldEndedAt)
+}
+
+// SetEnergy sets the "energy" field.
+func (m *ChargingSessionMutation) SetEnergy(f float64) {
+	m.energy = &f
+	m.addenergy = nil
+}
+
+// Energy returns the value of the "energy" field in the mutation.
+func (m *ChargingSessionMutation) Energy() (r float64, exists bool) {
+	v := m.energy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnergy returns the old "energy" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldEnergy(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnergy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnergy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnergy: %w", err)
+	}
+	return oldValue.Energy, nil
+}
+
+// AddEnergy adds f to the "energy" field.
+func (m *ChargingSessionMutation) AddEnergy(f float64) {
+	if m.addenergy != nil {
+		*m.addenergy += f
+	} else {
+		m.addenergy = &f
+	}
+}
+
+// AddedEnergy returns the value that was added to the "energy" field in this mutation.
+func (m *ChargingSessionMutation) AddedEnergy() (r float64, exists bool) {
+	v := m.addenergy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEnergy clears the value of the "energy" field.
+func (m *ChargingSessionMutation) ClearEnergy() {
+	m.energy = nil
+	m.addenergy = nil
+	m.clearedFields[chargingsession.FieldEnergy] = struct{}{}
+}
+
+// EnergyCleared returns if the "energy" field was cleared in this mutation.
+func (m *ChargingSessionMutation) EnergyCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldEnergy]
+	return ok
+}
+
+// ResetEnergy resets all changes to the "energy" field.
+func (m *ChargingSessionMutation) ResetEnergy() {
+	m.energy = nil
+	m.addenergy = nil
+	delete(m.clearedFields, chargingsession.FieldEnergy)
+}
+
+// SetChargerType sets the "charger_type" field.
+func (m *ChargingSessionMutation) SetChargerType(s string) {
+	m.charger_type = &s
+}
+
+// ChargerType returns the value of the "charger_type" field in the mutation.
+func (m *ChargingSessionMutation) ChargerType() (r string, exists bool) {
+	v := m.charger_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChargerType returns the old "charger_type" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldChargerType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChargerType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChargerType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChargerType: %w", err)
+	}
+	return oldValue.ChargerType, nil
+}
+
+// ClearChargerType clears the value of the "charger_type" field.
+func (m *ChargingSessionMutation) ClearChargerType() {
+	m.charger_type = nil
+	m.clearedFields[chargingsession.FieldChargerType] = struct{}{}
+}
+
+// ChargerTypeCleared returns if the "charger_type" field was cleared in this mutation.
+func (m *ChargingSessionMutation) ChargerTypeCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldChargerType]
+	return ok
+}
+
+// ResetChargerType resets all changes to the "charger_type" field.
+func (m *ChargingSessionMutation) ResetChargerType() {
+	m.charger_type = nil
+	delete(m.clearedFields, chargingsession.FieldChargerType)
+}
+
+// SetCost sets the "cost" field.
+func (m *ChargingSessionMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *ChargingSessionMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *ChargingSessionMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *ChargingSessionMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCost clears the value of the "cost" field.
+func (m *ChargingSessionMutation) ClearCost() {
+	m.cost = nil
+	m.addcost = nil
+	m.clearedFields[chargingsession.FieldCost] = struct{}{}
+}
+
+// CostCleared returns if the "cost" field was cleared in this mutation.
+func (m *ChargingSessionMutation) CostCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldCost]
+	return ok
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *ChargingSessionMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+	delete(m.clearedFields, chargingsession.FieldCost)
+}
+
+// SetStation sets the "station" field.
+func (m *ChargingSessionMutation) SetStation(s string) {
+	m.station = &s
+}
+
+// Station returns the value of the "station" field in the mutation.
+func (m *ChargingSessionMutation) Station() (r string, exists bool) {
+	v := m.station
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStation returns the old "station" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldStation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStation: %w", err)
+	}
+	return oldValue.Station, nil
+}
+
+// ClearStation clears the value of the "station" field.
+func (m *ChargingSessionMutation) ClearStation() {
+	m.station = nil
+	m.clearedFields[chargingsession.FieldStation] = struct{}{}
+}
+
+// StationCleared returns if the "station" field was cleared in this mutation.
+func (m *ChargingSessionMutation) StationCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldStation]
+	return ok
+}
+
+// ResetStation resets all changes to the "station" field.
+func (m *ChargingSessionMutation) ResetStation() {
+	m.station = nil
+	delete(m.clearedFields, chargingsession.FieldStation)
+}
+
+// SetStartSoc sets the "start_soc" field.
+func (m *ChargingSessionMutation) SetStartSoc(f float64) {
+	m.start_soc = &f
+	m.addstart_soc = nil
+}
+
+// StartSoc returns the value of the "start_soc" field in the mutation.
+func (m *ChargingSessionMutation) StartSoc() (r float64, exists bool) {
+	v := m.start_soc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartSoc returns the old "start_soc" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldStartSoc(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartSoc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartSoc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartSoc: %w", err)
+	}
+	return oldValue.StartSoc, nil
+}
+
+// AddStartSoc adds f to the "start_soc" field.
+func (m *ChargingSessionMutation) AddStartSoc(f float64) {
+	if m.addstart_soc != nil {
+		*m.addstart_soc += f
+	} else {
+		m.addstart_soc = &f
+	}
+}
+
+// AddedStartSoc returns the value that was added to the "start_soc" field in this mutation.
+func (m *ChargingSessionMutation) AddedStartSoc() (r float64, exists bool) {
+	v := m.addstart_soc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartSoc clears the value of the "start_soc" field.
+func (m *ChargingSessionMutation) ClearStartSoc() {
+	m.start_soc = nil
+	m.addstart_soc = nil
+	m.clearedFields[chargingsession.FieldStartSoc] = struct{}{}
+}
+
+// StartSocCleared returns if the "start_soc" field was cleared in this mutation.
+func (m *ChargingSessionMutation) StartSocCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldStartSoc]
+	return ok
+}
+
+// ResetStartSoc resets all changes to the "start_soc" field.
+func (m *ChargingSessionMutation) ResetStartSoc() {
+	m.start_soc = nil
+	m.addstart_soc = nil
+	delete(m.clearedFields, chargingsession.FieldStartSoc)
+}
+
+// SetEndSoc sets the "end_soc" field.
+func (m *ChargingSessionMutation) SetEndSoc(f float64) {
+	m.end_soc = &f
+	m.addend_soc = nil
+}
+
+// EndSoc returns the value of the "end_soc" field in the mutation.
+func (m *ChargingSessionMutation) EndSoc() (r float64, exists bool) {
+	v := m.end_soc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndSoc returns the old "end_soc" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldEndSoc(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndSoc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndSoc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndSoc: %w", err)
+	}
+	return oldValue.EndSoc, nil
+}
+
+// AddEndSoc adds f to the "end_soc" field.
+func (m *ChargingSessionMutation) AddEndSoc(f float64) {
+	if m.addend_soc != nil {
+		*m.addend_soc += f
+	} else {
+		m.addend_soc = &f
+	}
+}
+
+// AddedEndSoc returns the value that was added to the "end_soc" field in this mutation.
+func (m *ChargingSessionMutation) AddedEndSoc() (r float64, exists bool) {
+	v := m.addend_soc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndSoc clears the value of the "end_soc" field.
+func (m *ChargingSessionMutation) ClearEndSoc() {
+	m.end_soc = nil
+	m.addend_soc = nil
+	m.clearedFields[chargingsession.FieldEndSoc] = struct{}{}
+}
+
+// EndSocCleared returns if the "end_soc" field was cleared in this mutation.
+func (m *ChargingSessionMutation) EndSocCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldEndSoc]
+	return ok
+}
+
+// ResetEndSoc resets all changes to the "end_soc" field.
+func (m *ChargingSessionMutation) ResetEndSoc() {
+	m.end_soc = nil
+	m.addend_soc = nil
+	delete(m.clearedFields, chargingsession.FieldEndSoc)
+}
+
+// SetSoh sets the "soh" field.
+func (m *ChargingSessionMutation) SetSoh(f float64) {
+	m.soh = &f
+	m.addsoh = nil
+}
+
+// Soh returns the value of the "soh" field in the mutation.
+func (m *ChargingSessionMutation) Soh() (r float64, exists bool) {
+	v := m.soh
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoh returns the old "soh" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldSoh(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoh is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoh requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoh: %w", err)
+	}
+	return oldValue.Soh, nil
+}
+
+// AddSoh adds f to the "soh" field.
+func (m *ChargingSessionMutation) AddSoh(f float64) {
+	if m.addsoh != nil {
+		*m.addsoh += f
+	} else {
+		m.addsoh = &f
+	}
+}
+
+// AddedSoh returns the value that was added to the "soh" field in this mutation.
+func (m *ChargingSessionMutation) AddedSoh() (r float64, exists bool) {
+	v := m.addsoh
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSoh clears the value of the "soh" field.
+func (m *ChargingSessionMutation) ClearSoh() {
+	m.soh = nil
+	m.addsoh = nil
+	m.clearedFields[chargingsession.FieldSoh] = struct{}{}
+}
+
+// SohCleared returns if the "soh" field was cleared in this mutation.
+func (m *ChargingSessionMutation) SohCleared() bool {
+	_, ok := m.clearedFields[chargingsession.FieldSoh]
+	return ok
+}
+
+// ResetSoh resets all changes to the "soh" field.
+func (m *ChargingSessionMutation) ResetSoh() {
+	m.soh = nil
+	m.addsoh = nil
+	delete(m.clearedFields, chargingsession.FieldSoh)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChargingSessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChargingSessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ChargingSession entity.
+// If the ChargingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChargingSessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChargingSessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *ChargingSessionMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *ChargingSessionMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *ChargingSessionMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *ChargingSessionMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the ChargingSessionMutation builder.
+func (m *ChargingSessionMutation) Where(ps ...predicate.ChargingSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ChargingSessionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ChargingSession).
+func (m *ChargingSessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChargingSessionMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.tenant_id != nil {
+		fields = append(fields, chargingsession.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, chargingsession.FieldCarID)
+	}
+	if m.started_at != nil {
+		fields = append(fields, chargingsession.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, chargingsession.FieldEndedAt)
+	}
+	if m.energy != nil {
+		fields = append(fields, chargingsession.FieldEnergy)
+	}
+	if m.charger_type != nil {
+		fields = append(fields, chargingsession.FieldChargerType)
+	}
+	if m.cost != nil {
+		fields = append(fields, chargingsession.FieldCost)
+	}
+	if m.station != nil {
+		fields = append(fields, chargingsession.FieldStation)
+	}
+	if m.start_soc != nil {
+		fields = append(fields, chargingsession.FieldStartSoc)
+	}
+	if m.end_soc != nil {
+		fields = append(fields, chargingsession.FieldEndSoc)
+	}
+	if m.soh != nil {
+		fields = append(fields, chargingsession.FieldSoh)
+	}
+	if m.created_at != nil {
+		fields = append(fields, chargingsession.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChargingSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chargingsession.FieldTenantID:
+		return m.TenantID()
+	case chargingsession.FieldCarID:
+		return m.CarID()
+	case chargingsession.FieldStartedAt:
+		return m.StartedAt()
+	case chargingsession.FieldEndedAt:
+		return m.EndedAt()
+	case chargingsession.FieldEnergy:
+		return m.Energy()
+	case chargingsession.FieldChargerType:
+		return m.ChargerType()
+	case chargingsession.FieldCost:
+		return m.Cost()
+	case chargingsession.FieldStation:
+		return m.Station()
+	case chargingsession.FieldStartSoc:
+		return m.StartSoc()
+	case chargingsession.FieldEndSoc:
+		return m.EndSoc()
+	case chargingsession.FieldSoh:
+		return m.Soh()
+	case chargingsession.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChargingSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chargingsession.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case chargingsession.FieldCarID:
+		return m.OldCarID(ctx)
+	case chargingsession.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case chargingsession.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case chargingsession.FieldEnergy:
+		return m.OldEnergy(ctx)
+	case chargingsession.FieldChargerType:
+		return m.OldChargerType(ctx)
+	case chargingsession.FieldCost:
+		return m.OldCost(ctx)
+	case chargingsession.FieldStation:
+		return m.OldStation(ctx)
+	case chargingsession.FieldStartSoc:
+		return m.OldStartSoc(ctx)
+	case chargingsession.FieldEndSoc:
+		return m.OldEndSoc(ctx)
+	case chargingsession.FieldSoh:
+		return m.OldSoh(ctx)
+	case chargingsession.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChargingSession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChargingSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chargingsession.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case chargingsession.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case chargingsession.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case chargingsession.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case chargingsession.FieldEnergy:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnergy(v)
+		return nil
+	case chargingsession.FieldChargerType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChargerType(v)
+		return nil
+	case chargingsession.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case chargingsession.FieldStation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStation(v)
+		return nil
+	case chargingsession.FieldStartSoc:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartSoc(v)
+		return nil
+	case chargingsession.FieldEndSoc:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndSoc(v)
+		return nil
+	case chargingsession.FieldSoh:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoh(v)
+		return nil
+	case chargingsession.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChargingSession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChargingSessionMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, chargingsession.FieldTenantID)
+	}
+	if m.addenergy != nil {
+		fields = append(fields, chargingsession.FieldEnergy)
+	}
+	if m.addcost != nil {
+		fields = append(fields, chargingsession.FieldCost)
+	}
+	if m.addstart_soc != nil {
+		fields = append(fields, chargingsession.FieldStartSoc)
+	}
+	if m.addend_soc != nil {
+		fields = append(fields, chargingsession.FieldEndSoc)
+	}
+	if m.addsoh != nil {
+		fields = append(fields, chargingsession.FieldSoh)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChargingSessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chargingsession.FieldTenantID:
+		return m.AddedTenantID()
+	case chargingsession.FieldEnergy:
+		return m.AddedEnergy()
+	case chargingsession.FieldCost:
+		return m.AddedCost()
+	case chargingsession.FieldStartSoc:
+		return m.AddedStartSoc()
+	case chargingsession.FieldEndSoc:
+		return m.AddedEndSoc()
+	case chargingsession.FieldSoh:
+		return m.AddedSoh()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChargingSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chargingsession.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case chargingsession.FieldEnergy:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnergy(v)
+		return nil
+	case chargingsession.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	case chargingsession.FieldStartSoc:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartSoc(v)
+		return nil
+	case chargingsession.FieldEndSoc:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndSoc(v)
+		return nil
+	case chargingsession.FieldSoh:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSoh(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChargingSession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChargingSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(chargingsession.FieldTenantID) {
+		fields = append(fields, chargingsession.FieldTenantID)
+	}
+	if m.FieldCleared(chargingsession.FieldCarID) {
+		fields = append(fields, chargingsession.FieldCarID)
+	}
+	if m.FieldCleared(chargingsession.FieldStartedAt) {
+		fields = append(fields, chargingsession.FieldStartedAt)
+	}
+	if m.FieldCleared(chargingsession.FieldEndedAt) {
+		fields = append(fields, chargingsession.FieldEndedAt)
+	}
+	if m.FieldCleared(chargingsession.FieldEnergy) {
+		fields = append(fields, chargingsession.FieldEnergy)
+	}
+	if m.FieldCleared(chargingsession.FieldChargerType) {
+		fields = append(fields, chargingsession.FieldChargerType)
+	}
+	if m.FieldCleared(chargingsession.FieldCost) {
+		fields = append(fields, chargingsession.FieldCost)
+	}
+	if m.FieldCleared(chargingsession.FieldStation) {
+		fields = append(fields, chargingsession.FieldStation)
+	}
+	if m.FieldCleared(chargingsession.FieldStartSoc) {
+		fields = append(fields, chargingsession.FieldStartSoc)
+	}
+	if m.FieldCleared(chargingsession.FieldEndSoc) {
+		fields = append(fields, chargingsession.FieldEndSoc)
+	}
+	if m.FieldCleared(chargingsession.FieldSoh) {
+		fields = append(fields, chargingsession.FieldSoh)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChargingSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChargingSessionMutation) ClearField(name string) error {
+	switch name {
+	case chargingsession.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case chargingsession.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case chargingsession.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case chargingsession.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	case chargingsession.FieldEnergy:
+		m.ClearEnergy()
+		return nil
+	case chargingsession.FieldChargerType:
+		m.ClearChargerType()
+		return nil
+	case chargingsession.FieldCost:
+		m.ClearCost()
+		return nil
+	case chargingsession.FieldStation:
+		m.ClearStation()
+		return nil
+	case chargingsession.FieldStartSoc:
+		m.ClearStartSoc()
+		return nil
+	case chargingsession.FieldEndSoc:
+		m.ClearEndSoc()
+		return nil
+	case chargingsession.FieldSoh:
+		m.ClearSoh()
+		return nil
+	}
+	return fmt.Errorf("unknown ChargingSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChargingSessionMutation) ResetField(name string) error {
+	switch name {
+	case chargingsession.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case chargingsession.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case chargingsession.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case chargingsession.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case chargingsession.FieldEnergy:
+		m.ResetEnergy()
+		return nil
+	case chargingsession.FieldChargerType:
+		m.ResetChargerType()
+		return nil
+	case chargingsession.FieldCost:
+		m.ResetCost()
+		return nil
+	case chargingsession.FieldStation:
+		m.ResetStation()
+		return nil
+	case chargingsession.FieldStartSoc:
+		m.ResetStartSoc()
+		return nil
+	case chargingsession.FieldEndSoc:
+		m.ResetEndSoc()
+		return nil
+	case chargingsession.FieldSoh:
+		m.ResetSoh()
+		return nil
+	case chargingsession.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ChargingSession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChargingSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, chargingsession.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChargingSessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case chargingsession.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChargingSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChargingSessionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChargingSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, chargingsession.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChargingSessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case chargingsession.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChargingSessionMutation) ClearEdge(name string) error {
+	switch name {
+	case chargingsession.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown ChargingSession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChargingSessionMutation) ResetEdge(name string) error {
+	switch name {
+	case chargingsession.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown ChargingSession edge %s", name)
+}
+
+// FleetMutation represents an operation that mutates the Fleet nodes in the graph.
+type FleetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	name          *string
+	description   *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	cars          map[int64]struct{}
+	removedcars   map[int64]struct{}
+	clearedcars   bool
+	done          bool
+	oldValue      func(context.Context) (*Fleet, error)
+	predicates    []predicate.Fleet
+}
+
+var _ ent.Mutation = (*FleetMutation)(nil)
+
+// fleetOption allows management of the mutation configuration using functional options.
+type fleetOption func(*FleetMutation)
+
+// newFleetMutation creates new mutation for the Fleet entity.
+func newFleetMutation(c config, op Op, opts ...fleetOption) *FleetMutation {
+	m := &FleetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFleet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFleetID sets the ID field of the mutation.
+func withFleetID(id int64) fleetOption {
+	return func(m *FleetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Fleet
+		)
+		m.oldValue = func(ctx context.Context) (*Fleet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Fleet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFleet sets the old Fleet of the mutation.
+func withFleet(node *Fleet) fleetOption {
+	return func(m *FleetMutation) {
+		m.oldValue = func(context.Context) (*Fleet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FleetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FleetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Fleet entities.
+func (m *FleetMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FleetMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FleetMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Fleet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *FleetMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *FleetMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Fleet entity.
+// If the Fleet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *FleetMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *FleetMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *FleetMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[fleet.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *FleetMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[fleet.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *FleetMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, fleet.FieldTenantID)
+}
+
+// SetName sets the "name" field.
+func (m *FleetMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FleetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Fleet entity.
+// If the Fleet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FleetMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *FleetMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *FleetMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Fleet entity.
+// If the Fleet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *FleetMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[fleet.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *FleetMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[fleet.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *FleetMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, fleet.FieldDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FleetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FleetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Fleet entity.
+// If the Fleet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FleetMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by ids.
+func (m *FleetMutation) AddCarIDs(ids ...int64) {
+	if m.cars == nil {
+		m.cars = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.cars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCars clears the "cars" edge to the Car entity.
+func (m *FleetMutation) ClearCars() {
+	m.clearedcars = true
+}
+
+// CarsCleared reports if the "cars" edge to the Car entity was cleared.
+func (m *FleetMutation) CarsCleared() bool {
+	return m.clearedcars
+}
+
+// RemoveCarIDs removes the "cars" edge to the Car entity by IDs.
+func (m *FleetMutation) RemoveCarIDs(ids ...int64) {
+	if m.removedcars == nil {
+		m.removedcars = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.cars, ids[i])
+		m.removedcars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCars returns the removed IDs of the "cars" edge to the Car entity.
+func (m *FleetMutation) RemovedCarsIDs() (ids []int64) {
+	for id := range m.removedcars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CarsIDs returns the "cars" edge IDs in the mutation.
+func (m *FleetMutation) CarsIDs() (ids []int64) {
+	for id := range m.cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCars resets all changes to the "cars" edge.
+func (m *FleetMutation) ResetCars() {
+	m.cars = nil
+	m.clearedcars = false
+	m.removedcars = nil
+}
+
+// Where appends a list predicates to the FleetMutation builder.
+func (m *FleetMutation) Where(ps ...predicate.Fleet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *FleetMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Fleet).
+func (m *FleetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FleetMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.tenant_id != nil {
+		fields = append(fields, fleet.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, fleet.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, fleet.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, fleet.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FleetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fleet.FieldTenantID:
+		return m.TenantID()
+	case fleet.FieldName:
+		return m.Name()
+	case fleet.FieldDescription:
+		return m.Description()
+	case fleet.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FleetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fleet.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case fleet.FieldName:
+		return m.OldName(ctx)
+	case fleet.FieldDescription:
+		return m.OldDescription(ctx)
+	case fleet.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Fleet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FleetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fleet.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case fleet.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case fleet.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case fleet.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Fleet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FleetMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, fleet.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FleetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case fleet.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FleetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case fleet.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Fleet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FleetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fleet.FieldTenantID) {
+		fields = append(fields, fleet.FieldTenantID)
+	}
+	if m.FieldCleared(fleet.FieldDescription) {
+		fields = append(fields, fleet.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FleetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FleetMutation) ClearField(name string) error {
+	switch name {
+	case fleet.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case fleet.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Fleet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FleetMutation) ResetField(name string) error {
+	switch name {
+	case fleet.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case fleet.FieldName:
+		m.ResetName()
+		return nil
+	case fleet.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case fleet.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Fleet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FleetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cars != nil {
+		edges = append(edges, fleet.EdgeCars)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FleetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case fleet.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.cars))
+		for id := range m.cars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FleetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcars != nil {
+		edges = append(edges, fleet.EdgeCars)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FleetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case fleet.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.removedcars))
+		for id := range m.removedcars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FleetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcars {
+		edges = append(edges, fleet.EdgeCars)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FleetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case fleet.EdgeCars:
+		return m.clearedcars
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FleetMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Fleet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FleetMutation) ResetEdge(name string) error {
+	switch name {
+	case fleet.EdgeCars:
+		m.ResetCars()
+		return nil
+	}
+	return fmt.Errorf("unknown Fleet edge %s", name)
+}
+
+// GeofenceMutation represents an operation that mutates the Geofence nodes in the graph.
+type GeofenceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	name          *string
+	polygon       *string
+	days          *string
+	start_time    *string
+	end_time      *string
+	time_zone     *string
+	alert_on      *string
+	enabled       *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	cars          map[int64]struct{}
+	removedcars   map[int64]struct{}
+	clearedcars   bool
+	done          bool
+	oldValue      func(context.Context) (*Geofence, error)
+	predicates    []predicate.Geofence
+}
+
+var _ ent.Mutation = (*GeofenceMutation)(nil)
+
+// geofenceOption allows management of the mutation configuration using functional options.
+type geofenceOption func(*GeofenceMutation)
+
+// newGeofenceMutation creates new mutation for the Geofence entity.
+func newGeofenceMutation(c config, op Op, opts ...geofenceOption) *GeofenceMutation {
+	m := &GeofenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGeofence,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGeofenceID sets the ID field of the mutation.
+func withGeofenceID(id int64) geofenceOption {
+	return func(m *GeofenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Geofence
+		)
+		m.oldValue = func(ctx context.Context) (*Geofence, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Geofence.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGeofence sets the old Geofence of the mutation.
+func withGeofence(node *Geofence) geofenceOption {
+	return func(m *GeofenceMutation) {
+		m.oldValue = func(context.Context) (*Geofence, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GeofenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GeofenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Geofence entities.
+func (m *GeofenceMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GeofenceMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GeofenceMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Geofence.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *GeofenceMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *GeofenceMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *GeofenceMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *GeofenceMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *GeofenceMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[geofence.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *GeofenceMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *GeofenceMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, geofence.FieldTenantID)
+}
+
+// SetName sets the "name" field.
+func (m *GeofenceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GeofenceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GeofenceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPolygon sets the "polygon" field.
+func (m *GeofenceMutation) SetPolygon(s string) {
+	m.polygon = &s
+}
+
+// Polygon returns the value of the "polygon" field in the mutation.
+func (m *GeofenceMutation) Polygon() (r string, exists bool) {
+	v := m.polygon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolygon returns the old "polygon" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldPolygon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolygon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolygon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolygon: %w", err)
+	}
+	return oldValue.Polygon, nil
+}
+
+// ClearPolygon clears the value of the "polygon" field.
+func (m *GeofenceMutation) ClearPolygon() {
+	m.polygon = nil
+	m.clearedFields[geofence.FieldPolygon] = struct{}{}
+}
+
+// PolygonCleared returns if the "polygon" field was cleared in this mutation.
+func (m *GeofenceMutation) PolygonCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldPolygon]
+	return ok
+}
+
+// ResetPolygon resets all changes to the "polygon" field.
+func (m *GeofenceMutation) ResetPolygon() {
+	m.polygon = nil
+	delete(m.clearedFields, geofence.FieldPolygon)
+}
+
+// SetDays sets the "days" field.
+func (m *GeofenceMutation) SetDays(s string) {
+	m.days = &s
+}
+
+// Days returns the value of the "days" field in the mutation.
+func (m *GeofenceMutation) Days() (r string, exists bool) {
+	v := m.days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDays returns the old "days" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldDays(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDays: %w", err)
+	}
+	return oldValue.Days, nil
+}
+
+// ClearDays clears the value of the "days" field.
+func (m *GeofenceMutation) ClearDays() {
+	m.days = nil
+	m.clearedFields[geofence.FieldDays] = struct{}{}
+}
+
+// DaysCleared returns if the "days" field was cleared in this mutation.
+func (m *GeofenceMutation) DaysCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldDays]
+	return ok
+}
+
+// ResetDays resets all changes to the "days" field.
+func (m *GeofenceMutation) ResetDays() {
+	m.days = nil
+	delete(m.clearedFields, geofence.FieldDays)
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *GeofenceMutation) SetStartTime(s string) {
+	m.start_time = &s
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *GeofenceMutation) StartTime() (r string, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldStartTime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (m *GeofenceMutation) ClearStartTime() {
+	m.start_time = nil
+	m.clearedFields[geofence.FieldStartTime] = struct{}{}
+}
+
+// StartTimeCleared returns if the "start_time" field was cleared in this mutation.
+func (m *GeofenceMutation) StartTimeCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldStartTime]
+	return ok
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *GeofenceMutation) ResetStartTime() {
+	m.start_time = nil
+	delete(m.clearedFields, geofence.FieldStartTime)
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *GeofenceMutation) SetEndTime(s string) {
+	m.end_time = &s
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *GeofenceMutation) EndTime() (r string, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldEndTime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (m *GeofenceMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[geofence.FieldEndTime] = struct{}{}
+}
+
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *GeofenceMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldEndTime]
+	return ok
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *GeofenceMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, geofence.FieldEndTime)
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (m *GeofenceMutation) SetTimeZone(s string) {
+	m.time_zone = &s
+}
+
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *GeofenceMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeZone returns the old "time_zone" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldTimeZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
+	}
+	return oldValue.TimeZone, nil
+}
+
+// ClearTimeZone clears the value of the "time_zone" field.
+func (m *GeofenceMutation) ClearTimeZone() {
+	m.time_zone = nil
+	m.clearedFields[geofence.FieldTimeZone] = struct{}{}
+}
+
+// TimeZoneCleared returns if the "time_zone" field was cleared in this mutation.
+func (m *GeofenceMutation) TimeZoneCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldTimeZone]
+	return ok
+}
+
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *GeofenceMutation) ResetTimeZone() {
+	m.time_zone = nil
+	delete(m.clearedFields, geofence.FieldTimeZone)
+}
+
+// SetAlertOn sets the "alert_on" field.
+func (m *GeofenceMutation) SetAlertOn(s string) {
+	m.alert_on = &s
+}
+
+// AlertOn returns the value of the "alert_on" field in the mutation.
+func (m *GeofenceMutation) AlertOn() (r string, exists bool) {
+	v := m.alert_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlertOn returns the old "alert_on" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldAlertOn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlertOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlertOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlertOn: %w", err)
+	}
+	return oldValue.AlertOn, nil
+}
+
+// ResetAlertOn resets all changes to the "alert_on" field.
+func (m *GeofenceMutation) ResetAlertOn() {
+	m.alert_on = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *GeofenceMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *GeofenceMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *GeofenceMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GeofenceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GeofenceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GeofenceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GeofenceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GeofenceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GeofenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GeofenceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by ids.
+func (m *GeofenceMutation) AddCarIDs(ids ...int64) {
+	if m.cars == nil {
+		m.cars = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.cars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCars clears the "cars" edge to the Car entity.
+func (m *GeofenceMutation) ClearCars() {
+	m.clearedcars = true
+}
+
+// CarsCleared reports if the "cars" edge to the Car entity was cleared.
+func (m *GeofenceMutation) CarsCleared() bool {
+	return m.clearedcars
+}
+
+// RemoveCarIDs removes the "cars" edge to the Car entity by IDs.
+func (m *GeofenceMutation) RemoveCarIDs(ids ...int64) {
+	if m.removedcars == nil {
+		m.removedcars = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.cars, ids[i])
+		m.removedcars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCars returns the removed IDs of the "cars" edge to the Car entity.
+func (m *GeofenceMutation) RemovedCarsIDs() (ids []int64) {
+	for id := range m.removedcars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CarsIDs returns the "cars" edge IDs in the mutation.
+func (m *GeofenceMutation) CarsIDs() (ids []int64) {
+	for id := range m.cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCars resets all changes to the "cars" edge.
+func (m *GeofenceMutation) ResetCars() {
+	m.cars = nil
+	m.clearedcars = false
+	m.removedcars = nil
+}
+
+// Where appends a list predicates to the GeofenceMutation builder.
+func (m *GeofenceMutation) Where(ps ...predicate.Geofence) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *GeofenceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Geofence).
+func (m *GeofenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GeofenceMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.tenant_id != nil {
+		fields = append(fields, geofence.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, geofence.FieldName)
+	}
+	if m.polygon != nil {
+		fields = append(fields, geofence.FieldPolygon)
+	}
+	if m.days != nil {
+		fields = append(fields, geofence.FieldDays)
+	}
+	if m.start_time != nil {
+		fields = append(fields, geofence.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, geofence.FieldEndTime)
+	}
+	if m.time_zone != nil {
+		fields = append(fields, geofence.FieldTimeZone)
+	}
+	if m.alert_on != nil {
+		fields = append(fields, geofence.FieldAlertOn)
+	}
+	if m.enabled != nil {
+		fields = append(fields, geofence.FieldEnabled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, geofence.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, geofence.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GeofenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case geofence.FieldTenantID:
+		return m.TenantID()
+	case geofence.FieldName:
+		return m.Name()
+	case geofence.FieldPolygon:
+		return m.Polygon()
+	case geofence.FieldDays:
+		return m.Days()
+	case geofence.FieldStartTime:
+		return m.StartTime()
+	case geofence.FieldEndTime:
+		return m.EndTime()
+	case geofence.FieldTimeZone:
+		return m.TimeZone()
+	case geofence.FieldAlertOn:
+		return m.AlertOn()
+	case geofence.FieldEnabled:
+		return m.Enabled()
+	case geofence.FieldCreatedAt:
+		return m.CreatedAt()
+	case geofence.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GeofenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case geofence.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case geofence.FieldName:
+		return m.OldName(ctx)
+	case geofence.FieldPolygon:
+		return m.OldPolygon(ctx)
+	case geofence.FieldDays:
+		return m.OldDays(ctx)
+	case geofence.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case geofence.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case geofence.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	case geofence.FieldAlertOn:
+		return m.OldAlertOn(ctx)
+	case geofence.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case geofence.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case geofence.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Geofence field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GeofenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case geofence.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case geofence.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case geofence.FieldPolygon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolygon(v)
+		return nil
+	case geofence.FieldDays:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDays(v)
+		return nil
+	case geofence.FieldStartTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case geofence.FieldEndTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case geofence.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	case geofence.FieldAlertOn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertOn(v)
+		return nil
+	case geofence.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case geofence.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case geofence.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Geofence field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GeofenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, geofence.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GeofenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case geofence.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GeofenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case geofence.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Geofence numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GeofenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(geofence.FieldTenantID) {
+		fields = append(fields, geofence.FieldTenantID)
+	}
+	if m.FieldCleared(geofence.FieldPolygon) {
+		fields = append(fields, geofence.FieldPolygon)
+	}
+	if m.FieldCleared(geofence.FieldDays) {
+		fields = append(fields, geofence.FieldDays)
+	}
+	if m.FieldCleared(geofence.FieldStartTime) {
+		fields = append(fields, geofence.FieldStartTime)
+	}
+	if m.FieldCleared(geofence.FieldEndTime) {
+		fields = append(fields, geofence.FieldEndTime)
+	}
+	if m.FieldCleared(geofence.FieldTimeZone) {
+		fields = append(fields, geofence.FieldTimeZone)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GeofenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GeofenceMutation) ClearField(name string) error {
+	switch name {
+	case geofence.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case geofence.FieldPolygon:
+		m.ClearPolygon()
+		return nil
+	case geofence.FieldDays:
+		m.ClearDays()
+		return nil
+	case geofence.FieldStartTime:
+		m.ClearStartTime()
+		return nil
+	case geofence.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	case geofence.FieldTimeZone:
+		m.ClearTimeZone()
+		return nil
+	}
+	return fmt.Errorf("unknown Geofence nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GeofenceMutation) ResetField(name string) error {
+	switch name {
+	case geofence.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case geofence.FieldName:
+		m.ResetName()
+		return nil
+	case geofence.FieldPolygon:
+		m.ResetPolygon()
+		return nil
+	case geofence.FieldDays:
+		m.ResetDays()
+		return nil
+	case geofence.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case geofence.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case geofence.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	case geofence.FieldAlertOn:
+		m.ResetAlertOn()
+		return nil
+	case geofence.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case geofence.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case geofence.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Geofence field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GeofenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cars != nil {
+		edges = append(edges, geofence.EdgeCars)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GeofenceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case geofence.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.cars))
+		for id := range m.cars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GeofenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcars != nil {
+		edges = append(edges, geofence.EdgeCars)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GeofenceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case geofence.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.removedcars))
+		for id := range m.removedcars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GeofenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcars {
+		edges = append(edges, geofence.EdgeCars)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GeofenceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case geofence.EdgeCars:
+		return m.clearedcars
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GeofenceMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Geofence unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GeofenceMutation) ResetEdge(name string) error {
+	switch name {
+	case geofence.EdgeCars:
+		m.ResetCars()
+		return nil
+	}
+	return fmt.Errorf("unknown Geofence edge %s", name)
+}
+
+// IncidentMutation represents an operation that mutates the Incident nodes in the graph.
+type IncidentMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	tenant_id         *int64
+	addtenant_id      *int64
+	reporter_id       *int64
+	addreporter_id    *int64
+	occurred_at       *time.Time
+	location          *string
+	severity          *string
+	description       *string
+	photo_ids         *string
+	third_parties     *string
+	status            *string
+	claim_no          *string
+	claim_amount      *float64
+	addclaim_amount   *float64
+	settled_amount    *float64
+	addsettled_amount *float64
+	settled_at        *time.Time
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	car               *int64
+	clearedcar        bool
+	policy            *int64
+	clearedpolicy     bool
+	done              bool
+	oldValue          func(context.Context) (*Incident, error)
+	predicates        []predicate.Incident
+}
+
+var _ ent.Mutation = (*IncidentMutation)(nil)
+
+// incidentOption allows management of the mutation configuration using functional options.
+type incidentOption func(*IncidentMutation)
+
+// newIncidentMutation creates new mutation for the Incident entity.
+func newIncidentMutation(c config, op Op, opts ...incidentOption) *IncidentMutation {
+	m := &IncidentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIncident,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIncidentID sets the ID field of the mutation.
+func withIncidentID(id int64) incidentOption {
+	return func(m *IncidentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Incident
+		)
+		m.oldValue = func(ctx context.Context) (*Incident, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Incident.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIncident sets the old Incident of the mutation.
+func withIncident(node *Incident) incidentOption {
+	return func(m *IncidentMutation) {
+		m.oldValue = func(context.Context) (*Incident, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IncidentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IncidentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Incident entities.
+func (m *IncidentMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IncidentMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IncidentMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Incident.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *IncidentMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *IncidentMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *IncidentMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *IncidentMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *IncidentMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[incident.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *IncidentMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[incident.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *IncidentMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, incident.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *IncidentMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *IncidentMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *IncidentMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[incident.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *IncidentMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[incident.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *IncidentMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, incident.FieldCarID)
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (m *IncidentMutation) SetReporterID(i int64) {
+	m.reporter_id = &i
+	m.addreporter_id = nil
+}
+
+// ReporterID returns the value of the "reporter_id" field in the mutation.
+func (m *IncidentMutation) ReporterID() (r int64, exists bool) {
+	v := m.reporter_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReporterID returns the old "reporter_id" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldReporterID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReporterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReporterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReporterID: %w", err)
+	}
+	return oldValue.ReporterID, nil
+}
+
+// AddReporterID adds i to the "reporter_id" field.
+func (m *IncidentMutation) AddReporterID(i int64) {
+	if m.addreporter_id != nil {
+		*m.addreporter_id += i
+	} else {
+		m.addreporter_id = &i
+	}
+}
+
+// AddedReporterID returns the value that was added to the "reporter_id" field in this mutation.
+func (m *IncidentMutation) AddedReporterID() (r int64, exists bool) {
+	v := m.addreporter_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearReporterID clears the value of the "reporter_id" field.
+func (m *IncidentMutation) ClearReporterID() {
+	m.reporter_id = nil
+	m.addreporter_id = nil
+	m.clearedFields[incident.FieldReporterID] = struct{}{}
+}
+
+// ReporterIDCleared returns if the "reporter_id" field was cleared in this mutation.
+func (m *IncidentMutation) ReporterIDCleared() bool {
+	_, ok := m.clearedFields[incident.FieldReporterID]
+	return ok
+}
+
+// ResetReporterID resets all changes to the "reporter_id" field.
+func (m *IncidentMutation) ResetReporterID() {
+	m.reporter_id = nil
+	m.addreporter_id = nil
+	delete(m.clearedFields, incident.FieldReporterID)
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (m *IncidentMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
+}
+
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *IncidentMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOccurredAt returns the old "occurred_at" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
+	}
+	return oldValue.OccurredAt, nil
+}
+
+// ClearOccurredAt clears the value of the "occurred_at" field.
+func (m *IncidentMutation) ClearOccurredAt() {
+	m.occurred_at = nil
+	m.clearedFields[incident.FieldOccurredAt] = struct{}{}
+}
+
+// OccurredAtCleared returns if the "occurred_at" field was cleared in this mutation.
+func (m *IncidentMutation) OccurredAtCleared() bool {
+	_, ok := m.clearedFields[incident.FieldOccurredAt]
+	return ok
+}
+
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *IncidentMutation) ResetOccurredAt() {
+	m.occurred_at = nil
+	delete(m.clearedFields, incident.FieldOccurredAt)
+}
+
+// SetLocation sets the "location" field.
+func (m *IncidentMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *IncidentMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *IncidentMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[incident.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *IncidentMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[incident.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *IncidentMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, incident.FieldLocation)
+}
+
+// SetSeverity sets the "severity" field.
+func (m *IncidentMutation) SetSeverity(s string) {
+	m.severity = &s
+}
+
+// Severity returns the value of the "severity" field in the mutation.
+func (m *IncidentMutation) Severity() (r string, exists bool) {
+	v := m.severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeverity returns the old "severity" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+	}
+	return oldValue.Severity, nil
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (m *IncidentMutation) ClearSeverity() {
+	m.severity = nil
+	m.clearedFields[incident.FieldSeverity] = struct{}{}
+}
+
+// SeverityCleared returns if the "severity" field was cleared in this mutation.
+func (m *IncidentMutation) SeverityCleared() bool {
+	_, ok := m.clearedFields[incident.FieldSeverity]
+	return ok
+}
+
+// ResetSeverity resets all changes to the "severity" field.
+func (m *IncidentMutation) ResetSeverity() {
+	m.severity = nil
+	delete(m.clearedFields, incident.FieldSeverity)
+}
+
+// SetDescription sets the "description" field.
+func (m *IncidentMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *IncidentMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *IncidentMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[incident.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *IncidentMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[incident.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *IncidentMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, incident.FieldDescription)
+}
+
+// SetPhotoIds sets the "photo_ids" field.
+func (m *IncidentMutation) SetPhotoIds(s string) {
+	m.photo_ids = &s
+}
+
+// PhotoIds returns the value of the "photo_ids" field in the mutation.
+func (m *IncidentMutation) PhotoIds() (r string, exists bool) {
+	v := m.photo_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhotoIds returns the old "photo_ids" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldPhotoIds(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhotoIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhotoIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhotoIds: %w", err)
+	}
+	return oldValue.PhotoIds, nil
+}
+
+// ClearPhotoIds clears the value of the "photo_ids" field.
+func (m *IncidentMutation) ClearPhotoIds() {
+	m.photo_ids = nil
+	m.clearedFields[incident.FieldPhotoIds] = struct{}{}
+}
+
+// PhotoIdsCleared returns if the "photo_ids" field was cleared in this mutation.
+func (m *IncidentMutation) PhotoIdsCleared() bool {
+	_, ok := m.clearedFields[incident.FieldPhotoIds]
+	return ok
+}
+
+// ResetPhotoIds resets all changes to the "photo_ids" field.
+func (m *IncidentMutation) ResetPhotoIds() {
+	m.photo_ids = nil
+	delete(m.clearedFields, incident.FieldPhotoIds)
+}
+
+// SetThirdParties sets the "third_parties" field.
+func (m *IncidentMutation) SetThirdParties(s string) {
+	m.third_parties = &s
+}
+
+// ThirdParties returns the value of the "third_parties" field in the mutation.
+func (m *IncidentMutation) ThirdParties() (r string, exists bool) {
+	v := m.third_parties
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThirdParties returns the old "third_parties" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldThirdParties(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThirdParties is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThirdParties requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThirdParties: %w", err)
+	}
+	return oldValue.ThirdParties, nil
+}
+
+// ClearThirdParties clears the value of the "third_parties" field.
+func (m *IncidentMutation) ClearThirdParties() {
+	m.third_parties = nil
+	m.clearedFields[incident.FieldThirdParties] = struct{}{}
+}
+
+// ThirdPartiesCleared returns if the "third_parties" field was cleared in this mutation.
+func (m *IncidentMutation) ThirdPartiesCleared() bool {
+	_, ok := m.clearedFields[incident.FieldThirdParties]
+	return ok
+}
+
+// ResetThirdParties resets all changes to the "third_parties" field.
+func (m *IncidentMutation) ResetThirdParties() {
+	m.third_parties = nil
+	delete(m.clearedFields, incident.FieldThirdParties)
+}
+
+// SetStatus sets the "status" field.
+func (m *IncidentMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *IncidentMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *IncidentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (m *IncidentMutation) SetPolicyID(i int64) {
+	m.policy = &i
+}
+
+// PolicyID returns the value of the "policy_id" field in the mutation.
+func (m *IncidentMutation) PolicyID() (r int64, exists bool) {
+	v := m.policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyID returns the old "policy_id" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldPolicyID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyID: %w", err)
+	}
+	return oldValue.PolicyID, nil
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (m *IncidentMutation) ClearPolicyID() {
+	m.policy = nil
+	m.clearedFields[incident.FieldPolicyID] = struct{}{}
+}
+
+// PolicyIDCleared returns if the "policy_id" field was cleared in this mutation.
+func (m *IncidentMutation) PolicyIDCleared() bool {
+	_, ok := m.clearedFields[incident.FieldPolicyID]
+	return ok
+}
+
+// ResetPolicyID resets all changes to the "policy_id" field.
+func (m *IncidentMutation) ResetPolicyID() {
+	m.policy = nil
+	delete(m.clearedFields, incident.FieldPolicyID)
+}
+
+// SetClaimNo sets the "claim_no" field.
+func (m *IncidentMutation) SetClaimNo(s string) {
+	m.claim_no = &s
+}
+
+// ClaimNo returns the value of the "claim_no" field in the mutation.
+func (m *IncidentMutation) ClaimNo() (r string, exists bool) {
+	v := m.claim_no
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimNo returns the old "claim_no" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldClaimNo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimNo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimNo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimNo: %w", err)
+	}
+	return oldValue.ClaimNo, nil
+}
+
+// ClearClaimNo clears the value of the "claim_no" field.
+func (m *IncidentMutation) ClearClaimNo() {
+	m.claim_no = nil
+	m.clearedFields[incident.FieldClaimNo] = struct{}{}
+}
+
+// ClaimNoCleared returns if the "claim_no" field was cleared in this mutation.
+func (m *IncidentMutation) ClaimNoCleared() bool {
+	_, ok := m.clearedFields[incident.FieldClaimNo]
+	return ok
+}
+
+// ResetClaimNo resets all changes to the "claim_no" field.
+func (m *IncidentMutation) ResetClaimNo() {
+	m.claim_no = nil
+	delete(m.clearedFields, incident.FieldClaimNo)
+}
+
+// SetClaimAmount sets the "claim_amount" field.
+func (m *IncidentMutation) SetClaimAmount(f float64) {
+	m.claim_amount = &f
+	m.addclaim_amount = nil
+}
+
+// ClaimAmount returns the value of the "claim_amount" field in the mutation.
+func (m *IncidentMutation) ClaimAmount() (r float64, exists bool) {
+	v := m.claim_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimAmount returns the old "claim_amount" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldClaimAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimAmount: %w", err)
+	}
+	return oldValue.ClaimAmount, nil
+}
+
+// AddClaimAmount adds f to the "claim_amount" field.
+func (m *IncidentMutation) AddClaimAmount(f float64) {
+	if m.addclaim_amount != nil {
+		*m.addclaim_amount += f
+	} else {
+		m.addclaim_amount = &f
+	}
+}
+
+// AddedClaimAmount returns the value that was added to the "claim_amount" field in this mutation.
+func (m *IncidentMutation) AddedClaimAmount() (r float64, exists bool) {
+	v := m.addclaim_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearClaimAmount clears the value of the "claim_amount" field.
+func (m *IncidentMutation) ClearClaimAmount() {
+	m.claim_amount = nil
+	m.addclaim_amount = nil
+	m.clearedFields[incident.FieldClaimAmount] = struct{}{}
+}
+
+// ClaimAmountCleared returns if the "claim_amount" field was cleared in this mutation.
+func (m *IncidentMutation) ClaimAmountCleared() bool {
+	_, ok := m.clearedFields[incident.FieldClaimAmount]
+	return ok
+}
+
+// ResetClaimAmount resets all changes to the "claim_amount" field.
+func (m *IncidentMutation) ResetClaimAmount() {
+	m.claim_amount = nil
+	m.addclaim_amount = nil
+	delete(m.clearedFields, incident.FieldClaimAmount)
+}
+
+// SetSettledAmount sets the "settled_amount" field.
+func (m *IncidentMutation) SetSettledAmount(f float64) {
+	m.settled_amount = &f
+	m.addsettled_amount = nil
+}
+
+// SettledAmount returns the value of the "settled_amount" field in the mutation.
+func (m *IncidentMutation) SettledAmount() (r float64, exists bool) {
+	v := m.settled_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettledAmount returns the old "settled_amount" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldSettledAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettledAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettledAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettledAmount: %w", err)
+	}
+	return oldValue.SettledAmount, nil
+}
+
+// AddSettledAmount adds f to the "settled_amount" field.
+func (m *IncidentMutation) AddSettledAmount(f float64) {
+	if m.addsettled_amount != nil {
+		*m.addsettled_amount += f
+	} else {
+		m.addsettled_amount = &f
+	}
+}
+
+// AddedSettledAmount returns the value that was added to the "settled_amount" field in this mutation.
+func (m *IncidentMutation) AddedSettledAmount() (r float64, exists bool) {
+	v := m.addsettled_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSettledAmount clears the value of the "settled_amount" field.
+func (m *IncidentMutation) ClearSettledAmount() {
+	m.settled_amount = nil
+	m.addsettled_amount = nil
+	m.clearedFields[incident.FieldSettledAmount] = struct{}{}
+}
+
+// SettledAmountCleared returns if the "settled_amount" field was cleared in this mutation.
+func (m *IncidentMutation) SettledAmountCleared() bool {
+	_, ok := m.clearedFields[incident.FieldSettledAmount]
+	return ok
+}
+
+// ResetSettledAmount resets all changes to the "settled_amount" field.
+func (m *IncidentMutation) ResetSettledAmount() {
+	m.settled_amount = nil
+	m.addsettled_amount = nil
+	delete(m.clearedFields, incident.FieldSettledAmount)
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (m *IncidentMutation) SetSettledAt(t time.Time) {
+	m.settled_at = &t
+}
+
+// SettledAt returns the value of the "settled_at" field in the mutation.
+func (m *IncidentMutation) SettledAt() (r time.Time, exists bool) {
+	v := m.settled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettledAt returns the old "settled_at" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldSettledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettledAt: %w", err)
+	}
+	return oldValue.SettledAt, nil
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (m *IncidentMutation) ClearSettledAt() {
+	m.settled_at = nil
+	m.clearedFields[incident.FieldSettledAt] = struct{}{}
+}
+
+// SettledAtCleared returns if the "settled_at" field was cleared in this mutation.
+func (m *IncidentMutation) SettledAtCleared() bool {
+	_, ok := m.clearedFields[incident.FieldSettledAt]
+	return ok
+}
+
+// ResetSettledAt resets all changes to the "settled_at" field.
+func (m *IncidentMutation) ResetSettledAt() {
+	m.settled_at = nil
+	delete(m.clearedFields, incident.FieldSettledAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IncidentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IncidentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IncidentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IncidentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IncidentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Incident entity.
+// If the Incident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncidentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IncidentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *IncidentMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *IncidentMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *IncidentMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *IncidentMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// ClearPolicy clears the "policy" edge to the InsurancePolicy entity.
+func (m *IncidentMutation) ClearPolicy() {
+	m.clearedpolicy = true
+}
+
+// PolicyCleared reports if the "policy" edge to the InsurancePolicy entity was cleared.
+func (m *IncidentMutation) PolicyCleared() bool {
+	return m.PolicyIDCleared() || m.clearedpolicy
+}
+
+// PolicyIDs returns the "policy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PolicyID instead. It exists only for internal usage by the builders.
+func (m *IncidentMutation) PolicyIDs() (ids []int64) {
+	if id := m.policy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPolicy resets all changes to the "policy" edge.
+func (m *IncidentMutation) ResetPolicy() {
+	m.policy = nil
+	m.clearedpolicy = false
+}
+
+// Where appends a list predicates to the IncidentMutation builder.
+func (m *IncidentMutation) Where(ps ...predicate.Incident) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *IncidentMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Incident).
+func (m *IncidentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IncidentMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.tenant_id != nil {
+		fields = append(fields, incident.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, incident.FieldCarID)
+	}
+	if m.reporter_id != nil {
+		fields = append(fields, incident.FieldReporterID)
+	}
+	if m.occurred_at != nil {
+		fields = append(fields, incident.FieldOccurredAt)
+	}
+	if m.location != nil {
+		fields = append(fields, incident.FieldLocation)
+	}
+	if m.severity != nil {
+		fields = append(fields, incident.FieldSeverity)
+	}
+	if m.description != nil {
+		fields = append(fields, incident.FieldDescription)
+	}
+	if m.photo_ids != nil {
+		fields = append(fields, incident.FieldPhotoIds)
+	}
+	if m.third_parties != nil {
+		fields = append(fields, incident.FieldThirdParties)
+	}
+	if m.status != nil {
+		fields = append(fields, incident.FieldStatus)
+	}
+	if m.policy != nil {
+		fields = append(fields, incident.FieldPolicyID)
+	}
+	if m.claim_no != nil {
+		fields = append(fields, incident.FieldClaimNo)
+	}
+	if m.claim_amount != nil {
+		fields = append(fields, incident.FieldClaimAmount)
+	}
+	if m.settled_amount != nil {
+		fields = append(fields, incident.FieldSettledAmount)
+	}
+	if m.settled_at != nil {
+		fields = append(fields, incident.FieldSettledAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, incident.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, incident.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IncidentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case incident.FieldTenantID:
+		return m.TenantID()
+	case incident.FieldCarID:
+		return m.CarID()
+	case incident.FieldReporterID:
+		return m.ReporterID()
+	case incident.FieldOccurredAt:
+		return m.OccurredAt()
+	case incident.FieldLocation:
+		return m.Location()
+	case incident.FieldSeverity:
+		return m.Severity()
+	case incident.FieldDescription:
+		return m.Description()
+	case incident.FieldPhotoIds:
+		return m.PhotoIds()
+	case incident.FieldThirdParties:
+		return m.ThirdParties()
+	case incident.FieldStatus:
+		return m.Status()
+	case incident.FieldPolicyID:
+		return m.PolicyID()
+	case incident.FieldClaimNo:
+		return m.ClaimNo()
+	case incident.FieldClaimAmount:
+		return m.ClaimAmount()
+	case incident.FieldSettledAmount:
+		return m.SettledAmount()
+	case incident.FieldSettledAt:
+		return m.SettledAt()
+	case incident.FieldCreatedAt:
+		return m.CreatedAt()
+	case incident.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IncidentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case incident.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case incident.FieldCarID:
+		return m.OldCarID(ctx)
+	case incident.FieldReporterID:
+		return m.OldReporterID(ctx)
+	case incident.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
+	case incident.FieldLocation:
+		return m.OldLocation(ctx)
+	case incident.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case incident.FieldDescription:
+		return m.OldDescription(ctx)
+	case incident.FieldPhotoIds:
+		return m.OldPhotoIds(ctx)
+	case incident.FieldThirdParties:
+		return m.OldThirdParties(ctx)
+	case incident.FieldStatus:
+		return m.OldStatus(ctx)
+	case incident.FieldPolicyID:
+		return m.OldPolicyID(ctx)
+	case incident.FieldClaimNo:
+		return m.OldClaimNo(ctx)
+	case incident.FieldClaimAmount:
+		return m.OldClaimAmount(ctx)
+	case incident.FieldSettledAmount:
+		return m.OldSettledAmount(ctx)
+	case incident.FieldSettledAt:
+		return m.OldSettledAt(ctx)
+	case incident.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case incident.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Incident field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IncidentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case incident.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case incident.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case incident.FieldReporterID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReporterID(v)
+		return nil
+	case incident.FieldOccurredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurredAt(v)
+		return nil
+	case incident.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case incident.FieldSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case incident.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case incident.FieldPhotoIds:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhotoIds(v)
+		return nil
+	case incident.FieldThirdParties:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThirdParties(v)
+		return nil
+	case incident.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case incident.FieldPolicyID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyID(v)
+		return nil
+	case incident.FieldClaimNo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimNo(v)
+		return nil
+	case incident.FieldClaimAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimAmount(v)
+		return nil
+	case incident.FieldSettledAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettledAmount(v)
+		return nil
+	case incident.FieldSettledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettledAt(v)
+		return nil
+	case incident.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case incident.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Incident field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IncidentMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, incident.FieldTenantID)
+	}
+	if m.addreporter_id != nil {
+		fields = append(fields, incident.FieldReporterID)
+	}
+	if m.addclaim_amount != nil {
+		fields = append(fields, incident.FieldClaimAmount)
+	}
+	if m.addsettled_amount != nil {
+		fields = append(fields, incident.FieldSettledAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IncidentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case incident.FieldTenantID:
+		return m.AddedTenantID()
+	case incident.FieldReporterID:
+		return m.AddedReporterID()
+	case incident.FieldClaimAmount:
+		return m.AddedClaimAmount()
+	case incident.FieldSettledAmount:
+		return m.AddedSettledAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IncidentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case incident.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case incident.FieldReporterID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReporterID(v)
+		return nil
+	case incident.FieldClaimAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClaimAmount(v)
+		return nil
+	case incident.FieldSettledAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSettledAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Incident numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IncidentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(incident.FieldTenantID) {
+		fields = append(fields, incident.FieldTenantID)
+	}
+	if m.FieldCleared(incident.FieldCarID) {
+		fields = append(fields, incident.FieldCarID)
+	}
+	if m.FieldCleared(incident.FieldReporterID) {
+		fields = append(fields, incident.FieldReporterID)
+	}
+	if m.FieldCleared(incident.FieldOccurredAt) {
+		fields = append(fields, incident.FieldOccurredAt)
+	}
+	if m.FieldCleared(incident.FieldLocation) {
+		fields = append(fields, incident.FieldLocation)
+	}
+	if m.FieldCleared(incident.FieldSeverity) {
+		fields = append(fields, incident.FieldSeverity)
+	}
+	if m.FieldCleared(incident.FieldDescription) {
+		fields = append(fields, incident.FieldDescription)
+	}
+	if m.FieldCleared(incident.FieldPhotoIds) {
+		fields = append(fields, incident.FieldPhotoIds)
+	}
+	if m.FieldCleared(incident.FieldThirdParties) {
+		fields = append(fields, incident.FieldThirdParties)
+	}
+	if m.FieldCleared(incident.FieldPolicyID) {
+		fields = append(fields, incident.FieldPolicyID)
+	}
+	if m.FieldCleared(incident.FieldClaimNo) {
+		fields = append(fields, incident.FieldClaimNo)
+	}
+	if m.FieldCleared(incident.FieldClaimAmount) {
+		fields = append(fields, incident.FieldClaimAmount)
+	}
+	if m.FieldCleared(incident.FieldSettledAmount) {
+		fields = append(fields, incident.FieldSettledAmount)
+	}
+	if m.FieldCleared(incident.FieldSettledAt) {
+		fields = append(fields, incident.FieldSettledAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IncidentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IncidentMutation) ClearField(name string) error {
+	switch name {
+	case incident.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case incident.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case incident.FieldReporterID:
+		m.ClearReporterID()
+		return nil
+	case incident.FieldOccurredAt:
+		m.ClearOccurredAt()
+		return nil
+	case incident.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case incident.FieldSeverity:
+		m.ClearSeverity()
+		return nil
+	case incident.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case incident.FieldPhotoIds:
+		m.ClearPhotoIds()
+		return nil
+	case incident.FieldThirdParties:
+		m.ClearThirdParties()
+		return nil
+	case incident.FieldPolicyID:
+		m.ClearPolicyID()
+		return nil
+	case incident.FieldClaimNo:
+		m.ClearClaimNo()
+		return nil
+	case incident.FieldClaimAmount:
+		m.ClearClaimAmount()
+		return nil
+	case incident.FieldSettledAmount:
+		m.ClearSettledAmount()
+		return nil
+	case incident.FieldSettledAt:
+		m.ClearSettledAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Incident nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IncidentMutation) ResetField(name string) error {
+	switch name {
+	case incident.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case incident.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case incident.FieldReporterID:
+		m.ResetReporterID()
+		return nil
+	case incident.FieldOccurredAt:
+		m.ResetOccurredAt()
+		return nil
+	case incident.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case incident.FieldSeverity:
+		m.ResetSeverity()
+		return nil
+	case incident.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case incident.FieldPhotoIds:
+		m.ResetPhotoIds()
+		return nil
+	case incident.FieldThirdParties:
+		m.ResetThirdParties()
+		return nil
+	case incident.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case incident.FieldPolicyID:
+		m.ResetPolicyID()
+		return nil
+	case incident.FieldClaimNo:
+		m.ResetClaimNo()
+		return nil
+	case incident.FieldClaimAmount:
+		m.ResetClaimAmount()
+		return nil
+	case incident.FieldSettledAmount:
+		m.ResetSettledAmount()
+		return nil
+	case incident.FieldSettledAt:
+		m.ResetSettledAt()
+		return nil
+	case incident.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case incident.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Incident field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IncidentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.car != nil {
+		edges = append(edges, incident.EdgeCar)
+	}
+	if m.policy != nil {
+		edges = append(edges, incident.EdgePolicy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IncidentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case incident.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	case incident.EdgePolicy:
+		if id := m.policy; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IncidentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IncidentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IncidentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcar {
+		edges = append(edges, incident.EdgeCar)
+	}
+	if m.clearedpolicy {
+		edges = append(edges, incident.EdgePolicy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IncidentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case incident.EdgeCar:
+		return m.clearedcar
+	case incident.EdgePolicy:
+		return m.clearedpolicy
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IncidentMutation) ClearEdge(name string) error {
+	switch name {
+	case incident.EdgeCar:
+		m.ClearCar()
+		return nil
+	case incident.EdgePolicy:
+		m.ClearPolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown Incident unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IncidentMutation) ResetEdge(name string) error {
+	switch name {
+	case incident.EdgeCar:
+		m.ResetCar()
+		return nil
+	case incident.EdgePolicy:
+		m.ResetPolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown Incident edge %s", name)
+}
+
+// InsurancePolicyMutation represents an operation that mutates the InsurancePolicy nodes in the graph.
+type InsurancePolicyMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int64
+	tenant_id        *int64
+	addtenant_id     *int64
+	provider         *string
+	policy_number    *string
+	coverage         *string
+	start_date       *time.Time
+	end_date         *time.Time
+	premium          *float64
+	addpremium       *float64
+	reminded_at      *time.Time
+	clearedFields    map[string]struct{}
+	car              *int64
+	clearedcar       bool
+	incidents        map[int64]struct{}
+	removedincidents map[int64]struct{}
+	clearedincidents bool
+	done             bool
+	oldValue         func(context.Context) (*InsurancePolicy, error)
+	predicates       []predicate.InsurancePolicy
+}
+
+var _ ent.Mutation = (*InsurancePolicyMutation)(nil)
+
+// insurancepolicyOption allows management of the mutation configuration using functional options.
+type insurancepolicyOption func(*InsurancePolicyMutation)
+
+// newInsurancePolicyMutation creates new mutation for the InsurancePolicy entity.
+func newInsurancePolicyMutation(c config, op Op, opts ...insurancepolicyOption) *InsurancePolicyMutation {
+	m := &InsurancePolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInsurancePolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInsurancePolicyID sets the ID field of the mutation.
+func withInsurancePolicyID(id int64) insurancepolicyOption {
+	return func(m *InsurancePolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InsurancePolicy
+		)
+		m.oldValue = func(ctx context.Context) (*InsurancePolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InsurancePolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInsurancePolicy sets the old InsurancePolicy of the mutation.
+func withInsurancePolicy(node *InsurancePolicy) insurancepolicyOption {
+	return func(m *InsurancePolicyMutation) {
+		m.oldValue = func(context.Context) (*InsurancePolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InsurancePolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InsurancePolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of InsurancePolicy entities.
+func (m *InsurancePolicyMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InsurancePolicyMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InsurancePolicyMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InsurancePolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *InsurancePolicyMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *InsurancePolicyMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the InsurancePolicy entity.
+// If the InsurancePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InsurancePolicyMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *InsurancePolicyMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *InsurancePolicyMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *InsurancePolicyMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[insurancepolicy.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *InsurancePolicyMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[insurancepolicy.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *InsurancePolicyMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, insurancepolicy.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *InsurancePolicyMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *InsurancePolicyMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the InsurancePolicy entity.
+// If the InsurancePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InsurancePolicyMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *InsurancePolicyMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[insurancepolicy.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *InsurancePolicyMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[insurancepolicy.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *InsurancePolicyMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, insurancepolicy.FieldCarID)
+}
+
+// SetProvider sets the "provider" field.
+func (m *InsurancePolicyMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *InsurancePolicyMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the InsurancePolicy entity.
+// If the InsurancePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InsurancePolicyMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (m *InsurancePolicyMutation) ClearProvider() {
+	m.provider = nil
+	m.clearedFields[insurancepolicy.FieldProvider] = struct{}{}
+}
+
+// ProviderCleared returns if the "provider" field was cleared in this mutation.
+func (m *InsurancePolicyMutation) ProviderCleared() bool {
+	_, ok := m.clearedFields[insurancepolicy.FieldProvider]
+	return ok
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *InsurancePolicyMutation) ResetProvider() {
+	m.provider = nil
+	delete(m.clearedFields, insurancepolicy.FieldProvider)
+}
+
+// SetPolicyNumber sets the "policy_number" field.
+func (m *InsurancePolicyMutation) SetPolicyNumber(s string) {
+	m.policy_number = &s
+}
+
+// PolicyNumber returns the value of the "policy_number" field in the mutation.
+func (m *InsurancePolicyMutation) PolicyNumber() (r string, exists bool) {
+	v := m.policy_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyNumber returns the old "policy_number" field's value of the InsurancePolicy entity.
+// If the InsurancePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InsurancePolicyMutation) OldPolicyNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyNumber: %w", err)
+	}
+	return oldValue.PolicyNumber, nil
+}
+
+// ClearPolicyNumber clears the value of the "policy_number" field.
+func (m *InsurancePolicyMutation) ClearPolicyNumber() {
+	m.policy_number = nil
+	m.clearedFields[insurancepolicy.FieldPolicyNumber] = struct{}{}
+}
+
+// PolicyNumberCleared returns if the "policy_number" field was cleared in this mutation.
+func (m *InsurancePolicyMutation) PolicyNumberCleared() bool {
+	_, ok := m.clearedFields[insurancepolicy.FieldPolicyNumber]
+	return ok
+}
+
+// ResetPolicyNumber resets all changes to the "policy_number" field.
+func (m *InsurancePolicyMutation) ResetPolicyNumber() {
+	m.policy_number = nil
+	delete(m.clearedFields, insurancepolicy.FieldPolicyNumber)
+}
+
+// SetCoverage sets the "coverage" field.
+func (m *InsurancePolicyMutation) SetCoverage(s string) {
+	m.coverage = &s
+}
+
+// Coverage returns the value of the "coverage" field in the mutation.
+func (m *InsurancePolicyMutation) Coverage() (r string, exists bool) {
+	v := m.coverage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoverage returns the old "coverage" field's value of the InsurancePolicy entity.
+// If the InsurancePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InsurancePolicyMutation) OldCoverage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoverage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoverage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoverage: %w", err)
+	}
+	return oldValue.Coverage, nil
+}
+
+// ClearCoverage clears the value of the "coverage" field.
+func (m *InsurancePolicyMutation) ClearCoverage() {
+	m.coverage = nil
+	m.clearedFields[insurancepolicy.FieldCoverage] = struct{}{}
+}
+
+// CoverageCleared returns if the "coverage" field was cleared in this mutation.
+func (m *InsurancePolicyMutation) CoverageCleared() bool {
+	_, ok := m.clearedFields[insurancepolicy.FieldCoverage]
+	return ok
+}
+
+// ResetCoverage resets all changes to the "coverage" field.
+func (m *InsurancePolicyMutation) ResetCoverage() {
+	m.coverage = nil
+	delete(m.clearedFields, insurancepolicy.FieldCoverage)
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *InsurancePolicyMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *InsurancePolicyMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the InsurancePolicy entity.
+// If the InsurancePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InsurancePolicyMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (m *InsurancePolicyMutation) ClearStartDate() {
+	m.start_date = nil
+	m.clearedFields[insurancepolicy.FieldStartDate] = struct{}{}
+}
+
+// StartDateCleared returns if the "start_date" field was cleared in this mutation.
+func (m *InsurancePolicyMutation) StartDateCleared() bool {
+	_, ok := m.clearedFields[insurancepolicy.FieldStartDate]
+	return ok
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *InsurancePolicyMutation) ResetStartDate() {
+	m.start_date = nil
+	delete(m.clearedFields, insurancepolicy.FieldStartDate)
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *InsurancePolicyMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *InsurancePolicyMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the InsurancePolicy entity.
+// If the InsurancePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InsurancePolicyMutation) OldEndDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (m *InsurancePolicyMutation) ClearEndDate() {
+	m.end_date = nil
+	m.clearedFields[insurancepolicy.FieldEndDate] = struct{}{}
+}
+
+// EndDateCleared returns if the "end_date" field was cleared in this mutation.
+func (m *InsurancePolicyMutation) EndDateCleared() bool {
+	_, ok := m.clearedFields[insurancepolicy.FieldEndDate]
+	return ok
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *InsurancePolicyMutation) ResetEndDate() {
+	m.end_date = nil
+	delete(m.clearedFields, insurancepolicy.FieldEndDate)
+}
+
+// SetPremium sets the "premium" field.
+func (m *InsurancePolicyMutation) SetPremium(f float64) {
+	m.premium = &f
+	m.addpremium = nil
+}
+
+// Premium returns the value of the "premium" field in the mutation.
+func (m *InsurancePolicyMutation) Premium() (r float64, exists bool) {
+	v := m.premium
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPremium returns the old "premium" field's value of the InsurancePolicy entity.
+// If the InsurancePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InsurancePolicyMutation) OldPremium(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPremium is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPremium requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPremium: %w", err)
+	}
+	return oldValue.Premium, nil
+}
+
+// AddPremium adds f to the "premium" field.
+func (m *InsurancePolicyMutation) AddPremium(f float64) {
+	if m.addpremium != nil {
+		*m.addpremium += f
+	} else {
+		m.addpremium = &f
+	}
+}
+
+// AddedPremium returns the value that was added to the "premium" field in this mutation.
+func (m *InsurancePolicyMutation) AddedPremium() (r float64, exists bool) {
+	v := m.addpremium
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPremium clears the value of the "premium" field.
+func (m *InsurancePolicyMutation) ClearPremium() {
+	m.premium = nil
+	m.addpremium = nil
+	m.clearedFields[insurancepolicy.FieldPremium] = struct{}{}
+}
+
+// PremiumCleared returns if the "premium" field was cleared in this mutation.
+func (m *InsurancePolicyMutation) PremiumCleared() bool {
+	_, ok := m.clearedFields[insurancepolicy.FieldPremium]
+	return ok
+}
+
+// ResetPremium resets all changes to the "premium" field.
+func (m *InsurancePolicyMutation) ResetPremium() {
+	m.premium = nil
+	m.addpremium = nil
+	delete(m.clearedFields, insurancepolicy.FieldPremium)
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (m *InsurancePolicyMutation) SetRemindedAt(t time.Time) {
+	m.reminded_at = &t
+}
+
+// RemindedAt returns the value of the "reminded_at" field in the mutation.
+func (m *InsurancePolicyMutation) RemindedAt() (r time.Time, exists bool) {
+	v := m.reminded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemindedAt returns the old "reminded_at" field's value of the InsurancePolicy entity.
+// If the InsurancePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InsurancePolicyMutation) OldRemindedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemindedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemindedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemindedAt: %w", err)
+	}
+	return oldValue.RemindedAt, nil
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (m *InsurancePolicyMutation) ClearRemindedAt() {
+	m.reminded_at = nil
+	m.clearedFields[insurancepolicy.FieldRemindedAt] = struct{}{}
+}
+
+// RemindedAtCleared returns if the "reminded_at" field was cleared in this mutation.
+func (m *InsurancePolicyMutation) RemindedAtCleared() bool {
+	_, ok := m.clearedFields[insurancepolicy.FieldRemindedAt]
+	return ok
+}
+
+// ResetRemindedAt resets all changes to the "reminded_at" field.
+func (m *InsurancePolicyMutation) ResetRemindedAt() {
+	m.reminded_at = nil
+	delete(m.clearedFields, insurancepolicy.FieldRemindedAt)
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *InsurancePolicyMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *InsurancePolicyMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *InsurancePolicyMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *InsurancePolicyMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// AddIncidentIDs adds the "incidents" edge to the Incident entity by ids.
+func (m *InsurancePolicyMutation) AddIncidentIDs(ids ...int64) {
+	if m.incidents == nil {
+		m.incidents = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.incidents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIncidents clears the "incidents" edge to the Incident entity.
+func (m *InsurancePolicyMutation) ClearIncidents() {
+	m.clearedincidents = true
+}
+
+// IncidentsCleared reports if the "incidents" edge to the Incident entity was cleared.
+func (m *InsurancePolicyMutation) IncidentsCleared() bool {
+	return m.clearedincidents
+}
+
+// RemoveIncidentIDs removes the "incidents" edge to the Incident entity by IDs.
+func (m *InsurancePolicyMutation) RemoveIncidentIDs(ids ...int64) {
+	if m.removedincidents == nil {
+		m.removedincidents = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.incidents, ids[i])
+		m.removedincidents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIncidents returns the removed IDs of the "incidents" edge to the Incident entity.
+func (m *InsurancePolicyMutation) RemovedIncidentsIDs() (ids []int64) {
+	for id := range m.removedincidents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IncidentsIDs returns the "incidents" edge IDs in the mutation.
+func (m *InsurancePolicyMutation) IncidentsIDs() (ids []int64) {
+	for id := range m.incidents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIncidents resets all changes to the "incidents" edge.
+func (m *InsurancePolicyMutation) ResetIncidents() {
+	m.incidents = nil
+	m.clearedincidents = false
+	m.removedincidents = nil
+}
+
+// Where appends a list predicates to the InsurancePolicyMutation builder.
+func (m *InsurancePolicyMutation) Where(ps ...predicate.InsurancePolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *InsurancePolicyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (InsurancePolicy).
+func (m *InsurancePolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InsurancePolicyMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.tenant_id != nil {
+		fields = append(fields, insurancepolicy.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, insurancepolicy.FieldCarID)
+	}
+	if m.provider != nil {
+		fields = append(fields, insurancepolicy.FieldProvider)
+	}
+	if m.policy_number != nil {
+		fields = append(fields, insurancepolicy.FieldPolicyNumber)
+	}
+	if m.coverage != nil {
+		fields = append(fields, insurancepolicy.FieldCoverage)
+	}
+	if m.start_date != nil {
+		fields = append(fields, insurancepolicy.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, insurancepolicy.FieldEndDate)
+	}
+	if m.premium != nil {
+		fields = append(fields, insurancepolicy.FieldPremium)
+	}
+	if m.reminded_at != nil {
+		fields = append(fields, insurancepolicy.FieldRemindedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InsurancePolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case insurancepolicy.FieldTenantID:
+		return m.TenantID()
+	case insurancepolicy.FieldCarID:
+		return m.CarID()
+	case insurancepolicy.FieldProvider:
+		return m.Provider()
+	case insurancepolicy.FieldPolicyNumber:
+		return m.PolicyNumber()
+	case insurancepolicy.FieldCoverage:
+		return m.Coverage()
+	case insurancepolicy.FieldStartDate:
+		return m.StartDate()
+	case insurancepolicy.FieldEndDate:
+		return m.EndDate()
+	case insurancepolicy.FieldPremium:
+		return m.Premium()
+	case insurancepolicy.FieldRemindedAt:
+		return m.RemindedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InsurancePolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case insurancepolicy.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case insurancepolicy.FieldCarID:
+		return m.OldCarID(ctx)
+	case insurancepolicy.FieldProvider:
+		return m.OldProvider(ctx)
+	case insurancepolicy.FieldPolicyNumber:
+		return m.OldPolicyNumber(ctx)
+	case insurancepolicy.FieldCoverage:
+		return m.OldCoverage(ctx)
+	case insurancepolicy.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case insurancepolicy.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case insurancepolicy.FieldPremium:
+		return m.OldPremium(ctx)
+	case insurancepolicy.FieldRemindedAt:
+		return m.OldRemindedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown InsurancePolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InsurancePolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case insurancepolicy.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case insurancepolicy.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case insurancepolicy.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case insurancepolicy.FieldPolicyNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyNumber(v)
+		return nil
+	case insurancepolicy.FieldCoverage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoverage(v)
+		return nil
+	case insurancepolicy.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case insurancepolicy.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case insurancepolicy.FieldPremium:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPremium(v)
+		return nil
+	case insurancepolicy.FieldRemindedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemindedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InsurancePolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InsurancePolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, insurancepolicy.FieldTenantID)
+	}
+	if m.addpremium != nil {
+		fields = append(fields, insurancepolicy.FieldPremium)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InsurancePolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case insurancepolicy.FieldTenantID:
+		return m.AddedTenantID()
+	case insurancepolicy.FieldPremium:
+		return m.AddedPremium()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InsurancePolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case insurancepolicy.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case insurancepolicy.FieldPremium:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPremium(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InsurancePolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InsurancePolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(insurancepolicy.FieldTenantID) {
+		fields = append(fields, insurancepolicy.FieldTenantID)
+	}
+	if m.FieldCleared(insurancepolicy.FieldCarID) {
+		fields = append(fields, insurancepolicy.FieldCarID)
+	}
+	if m.FieldCleared(insurancepolicy.FieldProvider) {
+		fields = append(fields, insurancepolicy.FieldProvider)
+	}
+	if m.FieldCleared(insurancepolicy.FieldPolicyNumber) {
+		fields = append(fields, insurancepolicy.FieldPolicyNumber)
+	}
+	if m.FieldCleared(insurancepolicy.FieldCoverage) {
+		fields = append(fields, insurancepolicy.FieldCoverage)
+	}
+	if m.FieldCleared(insurancepolicy.FieldStartDate) {
+		fields = append(fields, insurancepolicy.FieldStartDate)
+	}
+	if m.FieldCleared(insurancepolicy.FieldEndDate) {
+		fields = append(fields, insurancepolicy.FieldEndDate)
+	}
+	if m.FieldCleared(insurancepolicy.FieldPremium) {
+		fields = append(fields, insurancepolicy.FieldPremium)
+	}
+	if m.FieldCleared(insurancepolicy.FieldRemindedAt) {
+		fields = append(fields, insurancepolicy.FieldRemindedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InsurancePolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InsurancePolicyMutation) ClearField(name string) error {
+	switch name {
+	case insurancepolicy.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case insurancepolicy.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case insurancepolicy.FieldProvider:
+		m.ClearProvider()
+		return nil
+	case insurancepolicy.FieldPolicyNumber:
+		m.ClearPolicyNumber()
+		return nil
+	case insurancepolicy.FieldCoverage:
+		m.ClearCoverage()
+		return nil
+	case insurancepolicy.FieldStartDate:
+		m.ClearStartDate()
+		return nil
+	case insurancepolicy.FieldEndDate:
+		m.ClearEndDate()
+		return nil
+	case insurancepolicy.FieldPremium:
+		m.ClearPremium()
+		return nil
+	case insurancepolicy.FieldRemindedAt:
+		m.ClearRemindedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown InsurancePolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InsurancePolicyMutation) ResetField(name string) error {
+	switch name {
+	case insurancepolicy.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case insurancepolicy.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case insurancepolicy.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case insurancepolicy.FieldPolicyNumber:
+		m.ResetPolicyNumber()
+		return nil
+	case insurancepolicy.FieldCoverage:
+		m.ResetCoverage()
+		return nil
+	case insurancepolicy.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case insurancepolicy.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case insurancepolicy.FieldPremium:
+		m.ResetPremium()
+		return nil
+	case insurancepolicy.FieldRemindedAt:
+		m.ResetRemindedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown InsurancePolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InsurancePolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.car != nil {
+		edges = append(edges, insurancepolicy.EdgeCar)
+	}
+	if m.incidents != nil {
+		edges = append(edges, insurancepolicy.EdgeIncidents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InsurancePolicyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case insurancepolicy.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	case insurancepolicy.EdgeIncidents:
+		ids := make([]ent.Value, 0, len(m.incidents))
+		for id := range m.incidents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InsurancePolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedincidents != nil {
+		edges = append(edges, insurancepolicy.EdgeIncidents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InsurancePolicyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case insurancepolicy.EdgeIncidents:
+		ids := make([]ent.Value, 0, len(m.removedincidents))
+		for id := range m.removedincidents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InsurancePolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcar {
+		edges = append(edges, insurancepolicy.EdgeCar)
+	}
+	if m.clearedincidents {
+		edges = append(edges, insurancepolicy.EdgeIncidents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InsurancePolicyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case insurancepolicy.EdgeCar:
+		return m.clearedcar
+	case insurancepolicy.EdgeIncidents:
+		return m.clearedincidents
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InsurancePolicyMutation) ClearEdge(name string) error {
+	switch name {
+	case insurancepolicy.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown InsurancePolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InsurancePolicyMutation) ResetEdge(name string) error {
+	switch name {
+	case insurancepolicy.EdgeCar:
+		m.ResetCar()
+		return nil
+	case insurancepolicy.EdgeIncidents:
+		m.ResetIncidents()
+		return nil
+	}
+	return fmt.Errorf("unknown InsurancePolicy edge %s", name)
+}
+
+// JobRunMutation represents an operation that mutates the JobRun nodes in the graph.
+type JobRunMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	name          *string
+	scheduled_at  *time.Time
+	started_at    *time.Time
+	finished_at   *time.Time
+	status        *string
+	error         *string
+	instance      *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*JobRun, error)
+	predicates    []predicate.JobRun
+}
+
+var _ ent.Mutation = (*JobRunMutation)(nil)
+
+// jobrunOption allows management of the mutation configuration using functional options.
+type jobrunOption func(*JobRunMutation)
+
+// newJobRunMutation creates new mutation for the JobRun entity.
+func newJobRunMutation(c config, op Op, opts ...jobrunOption) *JobRunMutation {
+	m := &JobRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJobRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobRunID sets the ID field of the mutation.
+func withJobRunID(id int64) jobrunOption {
+	return func(m *JobRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JobRun
+		)
+		m.oldValue = func(ctx context.Context) (*JobRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JobRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJobRun sets the old JobRun of the mutation.
+func withJobRun(node *JobRun) jobrunOption {
+	return func(m *JobRunMutation) {
+		m.oldValue = func(context.Context) (*JobRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of JobRun entities.
+func (m *JobRunMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobRunMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobRunMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JobRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *JobRunMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *JobRunMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *JobRunMutation) ResetName() {
+	m.name = nil
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (m *JobRunMutation) SetScheduledAt(t time.Time) {
+	m.scheduled_at = &t
+}
+
+// ScheduledAt returns the value of the "scheduled_at" field in the mutation.
+func (m *JobRunMutation) ScheduledAt() (r time.Time, exists bool) {
+	v := m.scheduled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledAt returns the old "scheduled_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldScheduledAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledAt: %w", err)
+	}
+	return oldValue.ScheduledAt, nil
+}
+
+// ResetScheduledAt resets all changes to the "scheduled_at" field.
+func (m *JobRunMutation) ResetScheduledAt() {
+	m.scheduled_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *JobRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobRunMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *JobRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[jobrun.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *JobRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, jobrun.FieldFinishedAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *JobRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobRunMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *JobRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *JobRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[jobrun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, jobrun.FieldError)
+}
+
+// SetInstance sets the "instance" field.
+func (m *JobRunMutation) SetInstance(s string) {
+	m.instance = &s
+}
+
+// Instance returns the value of the "instance" field in the mutation.
+func (m *JobRunMutation) Instance() (r string, exists bool) {
+	v := m.instance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstance returns the old "instance" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldInstance(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstance: %w", err)
+	}
+	return oldValue.Instance, nil
+}
+
+// ClearInstance clears the value of the "instance" field.
+func (m *JobRunMutation) ClearInstance() {
+	m.instance = nil
+	m.clearedFields[jobrun.FieldInstance] = struct{}{}
+}
+
+// InstanceCleared returns if the "instance" field was cleared in this mutation.
+func (m *JobRunMutation) InstanceCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldInstance]
+	return ok
+}
+
+// ResetInstance resets all changes to the "instance" field.
+func (m *JobRunMutation) ResetInstance() {
+	m.instance = nil
+	delete(m.clearedFields, jobrun.FieldInstance)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobRunMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobRunMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobRunMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the JobRunMutation builder.
+func (m *JobRunMutation) Where(ps ...predicate.JobRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *JobRunMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (JobRun).
+func (m *JobRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobRunMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, jobrun.FieldName)
+	}
+	if m.scheduled_at != nil {
+		fields = append(fields, jobrun.FieldScheduledAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, jobrun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, jobrun.FieldFinishedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, jobrun.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, jobrun.FieldError)
+	}
+	if m.instance != nil {
+		fields = append(fields, jobrun.FieldInstance)
+	}
+	if m.created_at != nil {
+		fields = append(fields, jobrun.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jobrun.FieldName:
+		return m.Name()
+	case jobrun.FieldScheduledAt:
+		return m.ScheduledAt()
+	case jobrun.FieldStartedAt:
+		return m.StartedAt()
+	case jobrun.FieldFinishedAt:
+		return m.FinishedAt()
+	case jobrun.FieldStatus:
+		return m.Status()
+	case jobrun.FieldError:
+		return m.Error()
+	case jobrun.FieldInstance:
+		return m.Instance()
+	case jobrun.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jobrun.FieldName:
+		return m.OldName(ctx)
+	case jobrun.FieldScheduledAt:
+		return m.OldScheduledAt(ctx)
+	case jobrun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case jobrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case jobrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case jobrun.FieldError:
+		return m.OldError(ctx)
+	case jobrun.FieldInstance:
+		return m.OldInstance(ctx)
+	case jobrun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jobrun.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case jobrun.FieldScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledAt(v)
+		return nil
+	case jobrun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case jobrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case jobrun.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case jobrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case jobrun.FieldInstance:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstance(v)
+		return nil
+	case jobrun.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobRunMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobRunMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown JobRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(jobrun.FieldFinishedAt) {
+		fields = append(fields, jobrun.FieldFinishedAt)
+	}
+	if m.FieldCleared(jobrun.FieldError) {
+		fields = append(fields, jobrun.FieldError)
+	}
+	if m.FieldCleared(jobrun.FieldInstance) {
+		fields = append(fields, jobrun.FieldInstance)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobRunMutation) ClearField(name string) error {
+	switch name {
+	case jobrun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case jobrun.FieldError:
+		m.ClearError()
+		return nil
+	case jobrun.FieldInstance:
+		m.ClearInstance()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobRunMutation) ResetField(name string) error {
+	switch name {
+	case jobrun.FieldName:
+		m.ResetName()
+		return nil
+	case jobrun.FieldScheduledAt:
+		m.ResetScheduledAt()
+		return nil
+	case jobrun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case jobrun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case jobrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case jobrun.FieldError:
+		m.ResetError()
+		return nil
+	case jobrun.FieldInstance:
+		m.ResetInstance()
+		return nil
+	case jobrun.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JobRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JobRun edge %s", name)
+}
+
+// LeaseContractMutation represents an operation that mutates the LeaseContract nodes in the graph.
+type LeaseContractMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int64
+	tenant_id            *int64
+	addtenant_id         *int64
+	lessor               *string
+	contract_no          *string
+	lessee_id            *int64
+	addlessee_id         *int64
+	start_date           *time.Time
+	end_date             *time.Time
+	monthly_payment      *float64
+	addmonthly_payment   *float64
+	mileage_allowance    *int64
+	addmileage_allowance *int64
+	excess_rate          *float64
+	addexcess_rate       *float64
+	start_mileage        *int64
+	addstart_mileage     *int64
+	transfer_allowed     *bool
+	status               *string
+	terminated_at        *time.Time
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	car                  *int64
+	clearedcar           bool
+	done                 bool
+	oldValue             func(context.Context) (*LeaseContract, error)
+	predicates           []predicate.LeaseContract
+}
+
+var _ ent.Mutation = (*LeaseContractMutation)(nil)
+
+// leasecontractOption allows management of the mutation configuration using functional options.
+type leasecontractOption func(*LeaseContractMutation)
+
+// newLeaseContractMutation creates new mutation for the LeaseContract entity.
+func newLeaseContractMutation(c config, op Op, opts ...leasecontractOption) *LeaseContractMutation {
+	m := &LeaseContractMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLeaseContract,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLeaseContractID sets the ID field of the mutation.
+func withLeaseContractID(id int64) leasecontractOption {
+	return func(m *LeaseContractMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LeaseContract
+		)
+		m.oldValue = func(ctx context.Context) (*LeaseContract, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LeaseContract.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLeaseContract sets the old LeaseContract of the mutation.
+func withLeaseContract(node *LeaseContract) leasecontractOption {
+	return func(m *LeaseContractMutation) {
+		m.oldValue = func(context.Context) (*LeaseContract, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LeaseContractMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LeaseContractMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LeaseContract entities.
+func (m *LeaseContractMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LeaseContractMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LeaseContractMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LeaseContract.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *LeaseContractMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *LeaseContractMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *LeaseContractMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *LeaseContractMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *LeaseContractMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[leasecontract.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *LeaseContractMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *LeaseContractMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, leasecontract.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *LeaseContractMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *LeaseContractMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *LeaseContractMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[leasecontract.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *LeaseContractMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *LeaseContractMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, leasecontract.FieldCarID)
+}
+
+// SetLessor sets the "lessor" field.
+func (m *LeaseContractMutation) SetLessor(s string) {
+	m.lessor = &s
+}
+
+// Lessor returns the value of the "lessor" field in the mutation.
+func (m *LeaseContractMutation) Lessor() (r string, exists bool) {
+	v := m.lessor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLessor returns the old "lessor" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldLessor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLessor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLessor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLessor: %w", err)
+	}
+	return oldValue.Lessor, nil
+}
+
+// ClearLessor clears the value of the "lessor" field.
+func (m *LeaseContractMutation) ClearLessor() {
+	m.lessor = nil
+	m.clearedFields[leasecontract.FieldLessor] = struct{}{}
+}
+
+// LessorCleared returns if the "lessor" field was cleared in this mutation.
+func (m *LeaseContractMutation) LessorCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldLessor]
+	return ok
+}
+
+// ResetLessor resets all changes to the "lessor" field.
+func (m *LeaseContractMutation) ResetLessor() {
+	m.lessor = nil
+	delete(m.clearedFields, leasecontract.FieldLessor)
+}
+
+// SetContractNo sets the "contract_no" field.
+func (m *LeaseContractMutation) SetContractNo(s string) {
+	m.contract_no = &s
+}
+
+// ContractNo returns the value of the "contract_no" field in the mutation.
+func (m *LeaseContractMutation) ContractNo() (r string, exists bool) {
+	v := m.contract_no
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContractNo returns the old "contract_no" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldContractNo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContractNo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContractNo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContractNo: %w", err)
+	}
+	return oldValue.ContractNo, nil
+}
+
+// ClearContractNo clears the value of the "contract_no" field.
+func (m *LeaseContractMutation) ClearContractNo() {
+	m.contract_no = nil
+	m.clearedFields[leasecontract.FieldContractNo] = struct{}{}
+}
+
+// ContractNoCleared returns if the "contract_no" field was cleared in this mutation.
+func (m *LeaseContractMutation) ContractNoCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldContractNo]
+	return ok
+}
+
+// ResetContractNo resets all changes to the "contract_no" field.
+func (m *LeaseContractMutation) ResetContractNo() {
+	m.contract_no = nil
+	delete(m.clearedFields, leasecontract.FieldContractNo)
+}
+
+// SetLesseeID sets the "lessee_id" field.
+func (m *LeaseContractMutation) SetLesseeID(i int64) {
+	m.lessee_id = &i
+	m.addlessee_id = nil
+}
+
+// LesseeID returns the value of the "lessee_id" field in the mutation.
+func (m *LeaseContractMutation) LesseeID() (r int64, exists bool) {
+	v := m.lessee_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLesseeID returns the old "lessee_id" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldLesseeID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLesseeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLesseeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLesseeID: %w", err)
+	}
+	return oldValue.LesseeID, nil
+}
+
+// AddLesseeID adds i to the "lessee_id" field.
+func (m *LeaseContractMutation) AddLesseeID(i int64) {
+	if m.addlessee_id != nil {
+		*m.addlessee_id += i
+	} else {
+		m.addlessee_id = &i
+	}
+}
+
+// AddedLesseeID returns the value that was added to the "lessee_id" field in this mutation.
+func (m *LeaseContractMutation) AddedLesseeID() (r int64, exists bool) {
+	v := m.addlessee_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLesseeID clears the value of the "lessee_id" field.
+func (m *LeaseContractMutation) ClearLesseeID() {
+	m.lessee_id = nil
+	m.addlessee_id = nil
+	m.clearedFields[leasecontract.FieldLesseeID] = struct{}{}
+}
+
+// LesseeIDCleared returns if the "lessee_id" field was cleared in this mutation.
+func (m *LeaseContractMutation) LesseeIDCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldLesseeID]
+	return ok
+}
+
+// ResetLesseeID resets all changes to the "lessee_id" field.
+func (m *LeaseContractMutation) ResetLesseeID() {
+	m.lessee_id = nil
+	m.addlessee_id = nil
+	delete(m.clearedFields, leasecontract.FieldLesseeID)
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *LeaseContractMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *LeaseContractMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (m *LeaseContractMutation) ClearStartDate() {
+	m.start_date = nil
+	m.clearedFields[leasecontract.FieldStartDate] = struct{}{}
+}
+
+// StartDateCleared returns if the "start_date" field was cleared in this mutation.
+func (m *LeaseContractMutation) StartDateCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldStartDate]
+	return ok
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *LeaseContractMutation) ResetStartDate() {
+	m.start_date = nil
+	delete(m.clearedFields, leasecontract.FieldStartDate)
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *LeaseContractMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *LeaseContractMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldEndDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (m *LeaseContractMutation) ClearEndDate() {
+	m.end_date = nil
+	m.clearedFields[leasecontract.FieldEndDate] = struct{}{}
+}
+
+// EndDateCleared returns if the "end_date" field was cleared in this mutation.
+func (m *LeaseContractMutation) EndDateCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldEndDate]
+	return ok
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *LeaseContractMutation) ResetEndDate() {
+	m.end_date = nil
+	delete(m.clearedFields, leasecontract.FieldEndDate)
+}
+
+// SetMonthlyPayment sets the "monthly_payment" field.
+func (m *LeaseContractMutation) SetMonthlyPayment(f float64) {
+	m.monthly_payment = &f
+	m.addmonthly_payment = nil
+}
+
+// MonthlyPayment returns the value of the "monthly_payment" field in the mutation.
+func (m *LeaseContractMutation) MonthlyPayment() (r float64, exists bool) {
+	v := m.monthly_payment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyPayment returns the old "monthly_payment" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldMonthlyPayment(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyPayment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyPayment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyPayment: %w", err)
+	}
+	return oldValue.MonthlyPayment, nil
+}
+
+// AddMonthlyPayment adds f to the "monthly_payment" field.
+func (m *LeaseContractMutation) AddMonthlyPayment(f float64) {
+	if m.addmonthly_payment != nil {
+		*m.addmonthly_payment += f
+	} else {
+		m.addmonthly_payment = &f
+	}
+}
+
+// AddedMonthlyPayment returns the value that was added to the "monthly_payment" field in this mutation.
+func (m *LeaseContractMutation) AddedMonthlyPayment() (r float64, exists bool) {
+	v := m.addmonthly_payment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMonthlyPayment clears the value of the "monthly_payment" field.
+func (m *LeaseContractMutation) ClearMonthlyPayment() {
+	m.monthly_payment = nil
+	m.addmonthly_payment = nil
+	m.clearedFields[leasecontract.FieldMonthlyPayment] = struct{}{}
+}
+
+// MonthlyPaymentCleared returns if the "monthly_payment" field was cleared in this mutation.
+func (m *LeaseContractMutation) MonthlyPaymentCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldMonthlyPayment]
+	return ok
+}
+
+// ResetMonthlyPayment resets all changes to the "monthly_payment" field.
+func (m *LeaseContractMutation) ResetMonthlyPayment() {
+	m.monthly_payment = nil
+	m.addmonthly_payment = nil
+	delete(m.clearedFields, leasecontract.FieldMonthlyPayment)
+}
+
+// SetMileageAllowance sets the "mileage_allowance" field.
+func (m *LeaseContractMutation) SetMileageAllowance(i int64) {
+	m.mileage_allowance = &i
+	m.addmileage_allowance = nil
+}
+
+// MileageAllowance returns the value of the "mileage_allowance" field in the mutation.
+func (m *LeaseContractMutation) MileageAllowance() (r int64, exists bool) {
+	v := m.mileage_allowance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMileageAllowance returns the old "mileage_allowance" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldMileageAllowance(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMileageAllowance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMileageAllowance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMileageAllowance: %w", err)
+	}
+	return oldValue.MileageAllowance, nil
+}
+
+// AddMileageAllowance adds i to the "mileage_allowance" field.
+func (m *LeaseContractMutation) AddMileageAllowance(i int64) {
+	if m.addmileage_allowance != nil {
+		*m.addmileage_allowance += i
+	} else {
+		m.addmileage_allowance = &i
+	}
+}
+
+// AddedMileageAllowance returns the value that was added to the "mileage_allowance" field in this mutation.
+func (m *LeaseContractMutation) AddedMileageAllowance() (r int64, exists bool) {
+	v := m.addmileage_allowance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMileageAllowance clears the value of the "mileage_allowance" field.
+func (m *LeaseContractMutation) ClearMileageAllowance() {
+	m.mileage_allowance = nil
+	m.addmileage_allowance = nil
+	m.clearedFields[leasecontract.FieldMileageAllowance] = struct{}{}
+}
+
+// MileageAllowanceCleared returns if the "mileage_allowance" field was cleared in this mutation.
+func (m *LeaseContractMutation) MileageAllowanceCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldMileageAllowance]
+	return ok
+}
+
+// ResetMileageAllowance resets all changes to the "mileage_allowance" field.
+func (m *LeaseContractMutation) ResetMileageAllowance() {
+	m.mileage_allowance = nil
+	m.addmileage_allowance = nil
+	delete(m.clearedFields, leasecontract.FieldMileageAllowance)
+}
+
+// SetExcessRate sets the "excess_rate" field.
+func (m *LeaseContractMutation) SetExcessRate(f float64) {
+	m.excess_rate = &f
+	m.addexcess_rate = nil
+}
+
+// ExcessRate returns the value of the "excess_rate" field in the mutation.
+func (m *LeaseContractMutation) ExcessRate() (r float64, exists bool) {
+	v := m.excess_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExcessRate returns the old "excess_rate" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldExcessRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExcessRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExcessRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExcessRate: %w", err)
+	}
+	return oldValue.ExcessRate, nil
+}
+
+// AddExcessRate adds f to the "excess_rate" field.
+func (m *LeaseContractMutation) AddExcessRate(f float64) {
+	if m.addexcess_rate != nil {
+		*m.addexcess_rate += f
+	} else {
+		m.addexcess_rate = &f
+	}
+}
+
+// AddedExcessRate returns the value that was added to the "excess_rate" field in this mutation.
+func (m *LeaseContractMutation) AddedExcessRate() (r float64, exists bool) {
+	v := m.addexcess_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExcessRate clears the value of the "excess_rate" field.
+func (m *LeaseContractMutation) ClearExcessRate() {
+	m.excess_rate = nil
+	m.addexcess_rate = nil
+	m.clearedFields[leasecontract.FieldExcessRate] = struct{}{}
+}
+
+// ExcessRateCleared returns if the "excess_rate" field was cleared in this mutation.
+func (m *LeaseContractMutation) ExcessRateCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldExcessRate]
+	return ok
+}
+
+// ResetExcessRate resets all changes to the "excess_rate" field.
+func (m *LeaseContractMutation) ResetExcessRate() {
+	m.excess_rate = nil
+	m.addexcess_rate = nil
+	delete(m.clearedFields, leasecontract.FieldExcessRate)
+}
+
+// SetStartMileage sets the "start_mileage" field.
+func (m *LeaseContractMutation) SetStartMileage(i int64) {
+	m.start_mileage = &i
+	m.addstart_mileage = nil
+}
+
+// StartMileage returns the value of the "start_mileage" field in the mutation.
+func (m *LeaseContractMutation) StartMileage() (r int64, exists bool) {
+	v := m.start_mileage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartMileage returns the old "start_mileage" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldStartMileage(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartMileage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartMileage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartMileage: %w", err)
+	}
+	return oldValue.StartMileage, nil
+}
+
+// AddStartMileage adds i to the "start_mileage" field.
+func (m *LeaseContractMutation) AddStartMileage(i int64) {
+	if m.addstart_mileage != nil {
+		*m.addstart_mileage += i
+	} else {
+		m.addstart_mileage = &i
+	}
+}
+
+// AddedStartMileage returns the value that was added to the "start_mileage" field in this mutation.
+func (m *LeaseContractMutation) AddedStartMileage() (r int64, exists bool) {
+	v := m.addstart_mileage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartMileage clears the value of the "start_mileage" field.
+func (m *LeaseContractMutation) ClearStartMileage() {
+	m.start_mileage = nil
+	m.addstart_mileage = nil
+	m.clearedFields[leasecontract.FieldStartMileage] = struct{}{}
+}
+
+// StartMileageCleared returns if the "start_mileage" field was cleared in this mutation.
+func (m *LeaseContractMutation) StartMileageCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldStartMileage]
+	return ok
+}
+
+// ResetStartMileage resets all changes to the "start_mileage" field.
+func (m *LeaseContractMutation) ResetStartMileage() {
+	m.start_mileage = nil
+	m.addstart_mileage = nil
+	delete(m.clearedFields, leasecontract.FieldStartMileage)
+}
+
+// SetTransferAllowed sets the "transfer_allowed" field.
+func (m *LeaseContractMutation) SetTransferAllowed(b bool) {
+	m.transfer_allowed = &b
+}
+
+// TransferAllowed returns the value of the "transfer_allowed" field in the mutation.
+func (m *LeaseContractMutation) TransferAllowed() (r bool, exists bool) {
+	v := m.transfer_allowed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransferAllowed returns the old "transfer_allowed" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldTransferAllowed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransferAllowed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransferAllowed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransferAllowed: %w", err)
+	}
+	return oldValue.TransferAllowed, nil
+}
+
+// ResetTransferAllowed resets all changes to the "transfer_allowed" field.
+func (m *LeaseContractMutation) ResetTransferAllowed() {
+	m.transfer_allowed = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *LeaseContractMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *LeaseContractMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *LeaseContractMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTerminatedAt sets the "terminated_at" field.
+func (m *LeaseContractMutation) SetTerminatedAt(t time.Time) {
+	m.terminated_at = &t
+}
+
+// TerminatedAt returns the value of the "terminated_at" field in the mutation.
+func (m *LeaseContractMutation) TerminatedAt() (r time.Time, exists bool) {
+	v := m.terminated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTerminatedAt returns the old "terminated_at" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldTerminatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTerminatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTerminatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTerminatedAt: %w", err)
+	}
+	return oldValue.TerminatedAt, nil
+}
+
+// ClearTerminatedAt clears the value of the "terminated_at" field.
+func (m *LeaseContractMutation) ClearTerminatedAt() {
+	m.terminated_at = nil
+	m.clearedFields[leasecontract.FieldTerminatedAt] = struct{}{}
+}
+
+// TerminatedAtCleared returns if the "terminated_at" field was cleared in this mutation.
+func (m *LeaseContractMutation) TerminatedAtCleared() bool {
+	_, ok := m.clearedFields[leasecontract.FieldTerminatedAt]
+	return ok
+}
+
+// ResetTerminatedAt resets all changes to the "terminated_at" field.
+func (m *LeaseContractMutation) ResetTerminatedAt() {
+	m.terminated_at = nil
+	delete(m.clearedFields, leasecontract.FieldTerminatedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LeaseContractMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LeaseContractMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LeaseContract entity.
+// If the LeaseContract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseContractMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LeaseContractMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *LeaseContractMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *LeaseContractMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *LeaseContractMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *LeaseContractMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the LeaseContractMutation builder.
+func (m *LeaseContractMutation) Where(ps ...predicate.LeaseContract) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *LeaseContractMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (LeaseContract).
+func (m *LeaseContractMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LeaseContractMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.tenant_id != nil {
+		fields = append(fields, leasecontract.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, leasecontract.FieldCarID)
+	}
+	if m.lessor != nil {
+		fields = append(fields, leasecontract.FieldLessor)
+	}
+	if m.contract_no != nil {
+		fields = append(fields, leasecontract.FieldContractNo)
+	}
+	if m.lessee_id != nil {
+		fields = append(fields, leasecontract.FieldLesseeID)
+	}
+	if m.start_date != nil {
+		fields = append(fields, leasecontract.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, leasecontract.FieldEndDate)
+	}
+	if m.monthly_payment != nil {
+		fields = append(fields, leasecontract.FieldMonthlyPayment)
+	}
+	if m.mileage_allowance != nil {
+		fields = append(fields, leasecontract.FieldMileageAllowance)
+	}
+	if m.excess_rate != nil {
+		fields = append(fields, leasecontract.FieldExcessRate)
+	}
+	if m.start_mileage != nil {
+		fields = append(fields, leasecontract.FieldStartMileage)
+	}
+	if m.transfer_allowed != nil {
+		fields = append(fields, leasecontract.FieldTransferAllowed)
+	}
+	if m.status != nil {
+		fields = append(fields, leasecontract.FieldStatus)
+	}
+	if m.terminated_at != nil {
+		fields = append(fields, leasecontract.FieldTerminatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, leasecontract.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LeaseContractMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case leasecontract.FieldTenantID:
+		return m.TenantID()
+	case leasecontract.FieldCarID:
+		return m.CarID()
+	case leasecontract.FieldLessor:
+		return m.Lessor()
+	case leasecontract.FieldContractNo:
+		return m.ContractNo()
+	case leasecontract.FieldLesseeID:
+		return m.LesseeID()
+	case leasecontract.FieldStartDate:
+		return m.StartDate()
+	case leasecontract.FieldEndDate:
+		return m.EndDate()
+	case leasecontract.FieldMonthlyPayment:
+		return m.MonthlyPayment()
+	case leasecontract.FieldMileageAllowance:
+		return m.MileageAllowance()
+	case leasecontract.FieldExcessRate:
+		return m.ExcessRate()
+	case leasecontract.FieldStartMileage:
+		return m.StartMileage()
+	case leasecontract.FieldTransferAllowed:
+		return m.TransferAllowed()
+	case leasecontract.FieldStatus:
+		return m.Status()
+	case leasecontract.FieldTerminatedAt:
+		return m.TerminatedAt()
+	case leasecontract.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LeaseContractMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case leasecontract.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case leasecontract.FieldCarID:
+		return m.OldCarID(ctx)
+	case leasecontract.FieldLessor:
+		return m.OldLessor(ctx)
+	case leasecontract.FieldContractNo:
+		return m.OldContractNo(ctx)
+	case leasecontract.FieldLesseeID:
+		return m.OldLesseeID(ctx)
+	case leasecontract.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case leasecontract.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case leasecontract.FieldMonthlyPayment:
+		return m.OldMonthlyPayment(ctx)
+	case leasecontract.FieldMileageAllowance:
+		return m.OldMileageAllowance(ctx)
+	case leasecontract.FieldExcessRate:
+		return m.OldExcessRate(ctx)
+	case leasecontract.FieldStartMileage:
+		return m.OldStartMileage(ctx)
+	case leasecontract.FieldTransferAllowed:
+		return m.OldTransferAllowed(ctx)
+	case leasecontract.FieldStatus:
+		return m.OldStatus(ctx)
+	case leasecontract.FieldTerminatedAt:
+		return m.OldTerminatedAt(ctx)
+	case leasecontract.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LeaseContract field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseContractMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case leasecontract.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case leasecontract.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case leasecontract.FieldLessor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLessor(v)
+		return nil
+	case leasecontract.FieldContractNo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContractNo(v)
+		return nil
+	case leasecontract.FieldLesseeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLesseeID(v)
+		return nil
+	case leasecontract.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case leasecontract.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case leasecontract.FieldMonthlyPayment:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyPayment(v)
+		return nil
+	case leasecontract.FieldMileageAllowance:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMileageAllowance(v)
+		return nil
+	case leasecontract.FieldExcessRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcessRate(v)
+		return nil
+	case leasecontract.FieldStartMileage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartMileage(v)
+		return nil
+	case leasecontract.FieldTransferAllowed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransferAllowed(v)
+		return nil
+	case leasecontract.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case leasecontract.FieldTerminatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTerminatedAt(v)
+		return nil
+	case leasecontract.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LeaseContract field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LeaseContractMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, leasecontract.FieldTenantID)
+	}
+	if m.addlessee_id != nil {
+		fields = append(fields, leasecontract.FieldLesseeID)
+	}
+	if m.addmonthly_payment != nil {
+		fields = append(fields, leasecontract.FieldMonthlyPayment)
+	}
+	if m.addmileage_allowance != nil {
+		fields = append(fields, leasecontract.FieldMileageAllowance)
+	}
+	if m.addexcess_rate != nil {
+		fields = append(fields, leasecontract.FieldExcessRate)
+	}
+	if m.addstart_mileage != nil {
+		fields = append(fields, leasecontract.FieldStartMileage)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LeaseContractMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case leasecontract.FieldTenantID:
+		return m.AddedTenantID()
+	case leasecontract.FieldLesseeID:
+		return m.AddedLesseeID()
+	case leasecontract.FieldMonthlyPayment:
+		return m.AddedMonthlyPayment()
+	case leasecontract.FieldMileageAllowance:
+		return m.AddedMileageAllowance()
+	case leasecontract.FieldExcessRate:
+		return m.AddedExcessRate()
+	case leasecontract.FieldStartMileage:
+		return m.AddedStartMileage()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseContractMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case leasecontract.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case leasecontract.FieldLesseeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLesseeID(v)
+		return nil
+	case leasecontract.FieldMonthlyPayment:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyPayment(v)
+		return nil
+	case leasecontract.FieldMileageAllowance:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMileageAllowance(v)
+		return nil
+	case leasecontract.FieldExcessRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExcessRate(v)
+		return nil
+	case leasecontract.FieldStartMileage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartMileage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LeaseContract numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LeaseContractMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(leasecontract.FieldTenantID) {
+		fields = append(fields, leasecontract.FieldTenantID)
+	}
+	if m.FieldCleared(leasecontract.FieldCarID) {
+		fields = append(fields, leasecontract.FieldCarID)
+	}
+	if m.FieldCleared(leasecontract.FieldLessor) {
+		fields = append(fields, leasecontract.FieldLessor)
+	}
+	if m.FieldCleared(leasecontract.FieldContractNo) {
+		fields = append(fields, leasecontract.FieldContractNo)
+	}
+	if m.FieldCleared(leasecontract.FieldLesseeID) {
+		fields = append(fields, leasecontract.FieldLesseeID)
+	}
+	if m.FieldCleared(leasecontract.FieldStartDate) {
+		fields = append(fields, leasecontract.FieldStartDate)
+	}
+	if m.FieldCleared(leasecontract.FieldEndDate) {
+		fields = append(fields, leasecontract.FieldEndDate)
+	}
+	if m.FieldCleared(leasecontract.FieldMonthlyPayment) {
+		fields = append(fields, leasecontract.FieldMonthlyPayment)
+	}
+	if m.FieldCleared(leasecontract.FieldMileageAllowance) {
+		fields = append(fields, leasecontract.FieldMileageAllowance)
+	}
+	if m.FieldCleared(leasecontract.FieldExcessRate) {
+		fields = append(fields, leasecontract.FieldExcessRate)
+	}
+	if m.FieldCleared(leasecontract.FieldStartMileage) {
+		fields = append(fields, leasecontract.FieldStartMileage)
+	}
+	if m.FieldCleared(leasecontract.FieldTerminatedAt) {
+		fields = append(fields, leasecontract.FieldTerminatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LeaseContractMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LeaseContractMutation) ClearField(name string) error {
+	switch name {
+	case leasecontract.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case leasecontract.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case leasecontract.FieldLessor:
+		m.ClearLessor()
+		return nil
+	case leasecontract.FieldContractNo:
+		m.ClearContractNo()
+		return nil
+	case leasecontract.FieldLesseeID:
+		m.ClearLesseeID()
+		return nil
+	case leasecontract.FieldStartDate:
+		m.ClearStartDate()
+		return nil
+	case leasecontract.FieldEndDate:
+		m.ClearEndDate()
+		return nil
+	case leasecontract.FieldMonthlyPayment:
+		m.ClearMonthlyPayment()
+		return nil
+	case leasecontract.FieldMileageAllowance:
+		m.ClearMileageAllowance()
+		return nil
+	case leasecontract.FieldExcessRate:
+		m.ClearExcessRate()
+		return nil
+	case leasecontract.FieldStartMileage:
+		m.ClearStartMileage()
+		return nil
+	case leasecontract.FieldTerminatedAt:
+		m.ClearTerminatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LeaseContract nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LeaseContractMutation) ResetField(name string) error {
+	switch name {
+	case leasecontract.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case leasecontract.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case leasecontract.FieldLessor:
+		m.ResetLessor()
+		return nil
+	case leasecontract.FieldContractNo:
+		m.ResetContractNo()
+		return nil
+	case leasecontract.FieldLesseeID:
+		m.ResetLesseeID()
+		return nil
+	case leasecontract.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case leasecontract.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case leasecontract.FieldMonthlyPayment:
+		m.ResetMonthlyPayment()
+		return nil
+	case leasecontract.FieldMileageAllowance:
+		m.ResetMileageAllowance()
+		return nil
+	case leasecontract.FieldExcessRate:
+		m.ResetExcessRate()
+		return nil
+	case leasecontract.FieldStartMileage:
+		m.ResetStartMileage()
+		return nil
+	case leasecontract.FieldTransferAllowed:
+		m.ResetTransferAllowed()
+		return nil
+	case leasecontract.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case leasecontract.FieldTerminatedAt:
+		m.ResetTerminatedAt()
+		return nil
+	case leasecontract.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LeaseContract field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LeaseContractMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, leasecontract.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LeaseContractMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case leasecontract.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LeaseContractMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LeaseContractMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LeaseContractMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, leasecontract.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LeaseContractMutation) EdgeCleared(name string) bool {
+	switch name {
+	case leasecontract.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LeaseContractMutation) ClearEdge(name string) error {
+	switch name {
+	case leasecontract.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown LeaseContract unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LeaseContractMutation) ResetEdge(name string) error {
+	switch name {
+	case leasecontract.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown LeaseContract edge %s", name)
+}
+
+// ListingMutation represents an operation that mutates the Listing nodes in the graph.
+type ListingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	seller_id     *int64
+	addseller_id  *int64
+	price         *float64
+	addprice      *float64
+	description   *string
+	status        *string
+	expires_at    *time.Time
+	buyer_id      *int64
+	addbuyer_id   *int64
+	closed_at     *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	car           *int64
+	clearedcar    bool
+	done          bool
+	oldValue      func(context.Context) (*Listing, error)
+	predicates    []predicate.Listing
+}
+
+var _ ent.Mutation = (*ListingMutation)(nil)
+
+// listingOption allows management of the mutation configuration using functional options.
+type listingOption func(*ListingMutation)
+
+// newListingMutation creates new mutation for the Listing entity.
+func newListingMutation(c config, op Op, opts ...listingOption) *ListingMutation {
+	m := &ListingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeListing,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListingID sets the ID field of the mutation.
+func withListingID(id int64) listingOption {
+	return func(m *ListingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Listing
+		)
+		m.oldValue = func(ctx context.Context) (*Listing, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Listing.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withListing sets the old Listing of the mutation.
+func withListing(node *Listing) listingOption {
+	return func(m *ListingMutation) {
+		m.oldValue = func(context.Context) (*Listing, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Listing entities.
+func (m *ListingMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListingMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListingMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Listing.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ListingMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ListingMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *ListingMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *ListingMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *ListingMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[listing.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *ListingMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[listing.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ListingMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, listing.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *ListingMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *ListingMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *ListingMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[listing.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *ListingMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[listing.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *ListingMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, listing.FieldCarID)
+}
+
+// SetSellerID sets the "seller_id" field.
+func (m *ListingMutation) SetSellerID(i int64) {
+	m.seller_id = &i
+	m.addseller_id = nil
+}
+
+// SellerID returns the value of the "seller_id" field in the mutation.
+func (m *ListingMutation) SellerID() (r int64, exists bool) {
+	v := m.seller_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellerID returns the old "seller_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldSellerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellerID: %w", err)
+	}
+	return oldValue.SellerID, nil
+}
+
+// AddSellerID adds i to the "seller_id" field.
+func (m *ListingMutation) AddSellerID(i int64) {
+	if m.addseller_id != nil {
+		*m.addseller_id += i
+	} else {
+		m.addseller_id = &i
+	}
+}
+
+// AddedSellerID returns the value that was added to the "seller_id" field in this mutation.
+func (m *ListingMutation) AddedSellerID() (r int64, exists bool) {
+	v := m.addseller_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSellerID clears the value of the "seller_id" field.
+func (m *ListingMutation) ClearSellerID() {
+	m.seller_id = nil
+	m.addseller_id = nil
+	m.clearedFields[listing.FieldSellerID] = struct{}{}
+}
+
+// SellerIDCleared returns if the "seller_id" field was cleared in this mutation.
+func (m *ListingMutation) SellerIDCleared() bool {
+	_, ok := m.clearedFields[listing.FieldSellerID]
+	return ok
+}
+
+// ResetSellerID resets all changes to the "seller_id" field.
+func (m *ListingMutation) ResetSellerID() {
+	m.seller_id = nil
+	m.addseller_id = nil
+	delete(m.clearedFields, listing.FieldSellerID)
+}
+
+// SetPrice sets the "price" field.
+func (m *ListingMutation) SetPrice(f float64) {
+	m.price = &f
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *ListingMutation) Price() (r float64, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds f to the "price" field.
+func (m *ListingMutation) AddPrice(f float64) {
+	if m.addprice != nil {
+		*m.addprice += f
+	} else {
+		m.addprice = &f
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *ListingMutation) AddedPrice() (r float64, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPrice clears the value of the "price" field.
+func (m *ListingMutation) ClearPrice() {
+	m.price = nil
+	m.addprice = nil
+	m.clearedFields[listing.FieldPrice] = struct{}{}
+}
+
+// PriceCleared returns if the "price" field was cleared in this mutation.
+func (m *ListingMutation) PriceCleared() bool {
+	_, ok := m.clearedFields[listing.FieldPrice]
+	return ok
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *ListingMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+	delete(m.clearedFields, listing.FieldPrice)
+}
+
+// SetDescription sets the "description" field.
+func (m *ListingMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ListingMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ListingMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[listing.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ListingMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[listing.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ListingMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, listing.FieldDescription)
+}
+
+// SetStatus sets the "status" field.
+func (m *ListingMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ListingMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ListingMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ListingMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ListingMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ListingMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[listing.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ListingMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[listing.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ListingMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, listing.FieldExpiresAt)
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (m *ListingMutation) SetBuyerID(i int64) {
+	m.buyer_id = &i
+	m.addbuyer_id = nil
+}
+
+// BuyerID returns the value of the "buyer_id" field in the mutation.
+func (m *ListingMutation) BuyerID() (r int64, exists bool) {
+	v := m.buyer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerID returns the old "buyer_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldBuyerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerID: %w", err)
+	}
+	return oldValue.BuyerID, nil
+}
+
+// AddBuyerID adds i to the "buyer_id" field.
+func (m *ListingMutation) AddBuyerID(i int64) {
+	if m.addbuyer_id != nil {
+		*m.addbuyer_id += i
+	} else {
+		m.addbuyer_id = &i
+	}
+}
+
+// AddedBuyerID returns the value that was added to the "buyer_id" field in this mutation.
+func (m *ListingMutation) AddedBuyerID() (r int64, exists bool) {
+	v := m.addbuyer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBuyerID clears the value of the "buyer_id" field.
+func (m *ListingMutation) ClearBuyerID() {
+	m.buyer_id = nil
+	m.addbuyer_id = nil
+	m.clearedFields[listing.FieldBuyerID] = struct{}{}
+}
+
+// BuyerIDCleared returns if the "buyer_id" field was cleared in this mutation.
+func (m *ListingMutation) BuyerIDCleared() bool {
+	_, ok := m.clearedFields[listing.FieldBuyerID]
+	return ok
+}
+
+// ResetBuyerID resets all changes to the "buyer_id" field.
+func (m *ListingMutation) ResetBuyerID() {
+	m.buyer_id = nil
+	m.addbuyer_id = nil
+	delete(m.clearedFields, listing.FieldBuyerID)
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (m *ListingMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
+}
+
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *ListingMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedAt returns the old "closed_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *ListingMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[listing.FieldClosedAt] = struct{}{}
+}
+
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *ListingMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[listing.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *ListingMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, listing.FieldClosedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ListingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ListingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ListingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *ListingMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *ListingMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *ListingMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *ListingMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the ListingMutation builder.
+func (m *ListingMutation) Where(ps ...predicate.Listing) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ListingMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Listing).
+func (m *ListingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListingMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.tenant_id != nil {
+		fields = append(fields, listing.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, listing.FieldCarID)
+	}
+	if m.seller_id != nil {
+		fields = append(fields, listing.FieldSellerID)
+	}
+	if m.price != nil {
+		fields = append(fields, listing.FieldPrice)
+	}
+	if m.description != nil {
+		fields = append(fields, listing.FieldDescription)
+	}
+	if m.status != nil {
+		fields = append(fields, listing.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, listing.FieldExpiresAt)
+	}
+	if m.buyer_id != nil {
+		fields = append(fields, listing.FieldBuyerID)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, listing.FieldClosedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, listing.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case listing.FieldTenantID:
+		return m.TenantID()
+	case listing.FieldCarID:
+		return m.CarID()
+	case listing.FieldSellerID:
+		return m.SellerID()
+	case listing.FieldPrice:
+		return m.Price()
+	case listing.FieldDescription:
+		return m.Description()
+	case listing.FieldStatus:
+		return m.Status()
+	case listing.FieldExpiresAt:
+		return m.ExpiresAt()
+	case listing.FieldBuyerID:
+		return m.BuyerID()
+	case listing.FieldClosedAt:
+		return m.ClosedAt()
+	case listing.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case listing.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case listing.FieldCarID:
+		return m.OldCarID(ctx)
+	case listing.FieldSellerID:
+		return m.OldSellerID(ctx)
+	case listing.FieldPrice:
+		return m.OldPrice(ctx)
+	case listing.FieldDescription:
+		return m.OldDescription(ctx)
+	case listing.FieldStatus:
+		return m.OldStatus(ctx)
+	case listing.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case listing.FieldBuyerID:
+		return m.OldBuyerID(ctx)
+	case listing.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	case listing.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Listing field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case listing.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case listing.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case listing.FieldSellerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellerID(v)
+		return nil
+	case listing.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case listing.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case listing.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case listing.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case listing.FieldBuyerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerID(v)
+		return nil
+	case listing.FieldClosedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
+		return nil
+	case listing.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Listing field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListingMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, listing.FieldTenantID)
+	}
+	if m.addseller_id != nil {
+		fields = append(fields, listing.FieldSellerID)
+	}
+	if m.addprice != nil {
+		fields = append(fields, listing.FieldPrice)
+	}
+	if m.addbuyer_id != nil {
+		fields = append(fields, listing.FieldBuyerID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case listing.FieldTenantID:
+		return m.AddedTenantID()
+	case listing.FieldSellerID:
+		return m.AddedSellerID()
+	case listing.FieldPrice:
+		return m.AddedPrice()
+	case listing.FieldBuyerID:
+		return m.AddedBuyerID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case listing.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case listing.FieldSellerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSellerID(v)
+		return nil
+	case listing.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case listing.FieldBuyerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuyerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Listing numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(listing.FieldTenantID) {
+		fields = append(fields, listing.FieldTenantID)
+	}
+	if m.FieldCleared(listing.FieldCarID) {
+		fields = append(fields, listing.FieldCarID)
+	}
+	if m.FieldCleared(listing.FieldSellerID) {
+		fields = append(fields, listing.FieldSellerID)
+	}
+	if m.FieldCleared(listing.FieldPrice) {
+		fields = append(fields, listing.FieldPrice)
+	}
+	if m.FieldCleared(listing.FieldDescription) {
+		fields = append(fields, listing.FieldDescription)
+	}
+	if m.FieldCleared(listing.FieldExpiresAt) {
+		fields = append(fields, listing.FieldExpiresAt)
+	}
+	if m.FieldCleared(listing.FieldBuyerID) {
+		fields = append(fields, listing.FieldBuyerID)
+	}
+	if m.FieldCleared(listing.FieldClosedAt) {
+		fields = append(fields, listing.FieldClosedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListingMutation) ClearField(name string) error {
+	switch name {
+	case listing.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case listing.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case listing.FieldSellerID:
+		m.ClearSellerID()
+		return nil
+	case listing.FieldPrice:
+		m.ClearPrice()
+		return nil
+	case listing.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case listing.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case listing.FieldBuyerID:
+		m.ClearBuyerID()
+		return nil
+	case listing.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListingMutation) ResetField(name string) error {
+	switch name {
+	case listing.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case listing.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case listing.FieldSellerID:
+		m.ResetSellerID()
+		return nil
+	case listing.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case listing.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case listing.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case listing.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case listing.FieldBuyerID:
+		m.ResetBuyerID()
+		return nil
+	case listing.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	case listing.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, listing.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case listing.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListingMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, listing.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case listing.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListingMutation) ClearEdge(name string) error {
+	switch name {
+	case listing.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListingMutation) ResetEdge(name string) error {
+	switch name {
+	case listing.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing edge %s", name)
+}
+
+// MaintenanceRecordMutation represents an operation that mutates the MaintenanceRecord nodes in the graph.
+type MaintenanceRecordMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	serviced_at   *time.Time
+	mileage       *int64
+	addmileage    *int64
+	_type         *string
+	cost          *float64
+	addcost       *float64
+	shop          *string
+	notes         *string
+	clearedFields map[string]struct{}
+	car           *int64
+	clearedcar    bool
+	done          bool
+	oldValue      func(context.Context) (*MaintenanceRecord, error)
+	predicates    []predicate.MaintenanceRecord
+}
+
+var _ ent.Mutation = (*MaintenanceRecordMutation)(nil)
+
+// maintenancerecordOption allows management of the mutation configuration using functional options.
+type maintenancerecordOption func(*MaintenanceRecordMutation)
+
+// newMaintenanceRecordMutation creates new mutation for the MaintenanceRecord entity.
+func newMaintenanceRecordMutation(c config, op Op, opts ...maintenancerecordOption) *MaintenanceRecordMutation {
+	m := &MaintenanceRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMaintenanceRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMaintenanceRecordID sets the ID field of the mutation.
+func withMaintenanceRecordID(id int64) maintenancerecordOption {
+	return func(m *MaintenanceRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MaintenanceRecord
+		)
+		m.oldValue = func(ctx context.Context) (*MaintenanceRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MaintenanceRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMaintenanceRecord sets the old MaintenanceRecord of the mutation.
+func withMaintenanceRecord(node *MaintenanceRecord) maintenancerecordOption {
+	return func(m *MaintenanceRecordMutation) {
+		m.oldValue = func(context.Context) (*MaintenanceRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MaintenanceRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MaintenanceRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MaintenanceRecord entities.
+func (m *MaintenanceRecordMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MaintenanceRecordMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MaintenanceRecordMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MaintenanceRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *MaintenanceRecordMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *MaintenanceRecordMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the MaintenanceRecord entity.
+// If the MaintenanceRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceRecordMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *MaintenanceRecordMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *MaintenanceRecordMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *MaintenanceRecordMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[maintenancerecord.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *MaintenanceRecordMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[maintenancerecord.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *MaintenanceRecordMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, maintenancerecord.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *MaintenanceRecordMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *MaintenanceRecordMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the MaintenanceRecord entity.
+// If the MaintenanceRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceRecordMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *MaintenanceRecordMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[maintenancerecord.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *MaintenanceRecordMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[maintenancerecord.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *MaintenanceRecordMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, maintenancerecord.FieldCarID)
+}
+
+// SetServicedAt sets the "serviced_at" field.
+func (m *MaintenanceRecordMutation) SetServicedAt(t time.Time) {
+	m.serviced_at = &t
+}
+
+// ServicedAt returns the value of the "serviced_at" field in the mutation.
+func (m *MaintenanceRecordMutation) ServicedAt() (r time.Time, exists bool) {
+	v := m.serviced_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServicedAt returns the old "serviced_at" field's value of the MaintenanceRecord entity.
+// If the MaintenanceRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceRecordMutation) OldServicedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServicedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServicedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServicedAt: %w", err)
+	}
+	return oldValue.ServicedAt, nil
+}
+
+// ResetServicedAt resets all changes to the "serviced_at" field.
+func (m *MaintenanceRecordMutation) ResetServicedAt() {
+	m.serviced_at = nil
+}
+
+// SetMileage sets the "mileage" field.
+func (m *MaintenanceRecordMutation) SetMileage(i int64) {
+	m.mileage = &i
+	m.addmileage = nil
+}
+
+// Mileage returns the value of the "mileage" field in the mutation.
+func (m *MaintenanceRecordMutation) Mileage() (r int64, exists bool) {
+	v := m.mileage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMileage returns the old "mileage" field's value of the MaintenanceRecord entity.
+// If the MaintenanceRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceRecordMutation) OldMileage(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMileage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMileage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMileage: %w", err)
+	}
+	return oldValue.Mileage, nil
+}
+
+// AddMileage adds i to the "mileage" field.
+func (m *MaintenanceRecordMutation) AddMileage(i int64) {
+	if m.addmileage != nil {
+		*m.addmileage += i
+	} else {
+		m.addmileage = &i
+	}
+}
+
+// AddedMileage returns the value that was added to the "mileage" field in this mutation.
+func (m *MaintenanceRecordMutation) AddedMileage() (r int64, exists bool) {
+	v := m.addmileage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMileage clears the value of the "mileage" field.
+func (m *MaintenanceRecordMutation) ClearMileage() {
+	m.mileage = nil
+	m.addmileage = nil
+	m.clearedFields[maintenancerecord.FieldMileage] = struct{}{}
+}
+
+// MileageCleared returns if the "mileage" field was cleared in this mutation.
+func (m *MaintenanceRecordMutation) MileageCleared() bool {
+	_, ok := m.clearedFields[maintenancerecord.FieldMileage]
+	return ok
+}
+
+// ResetMileage resets all changes to the "mileage" field.
+func (m *MaintenanceRecordMutation) ResetMileage() {
+	m.mileage = nil
+	m.addmileage = nil
+	delete(m.clearedFields, maintenancerecord.FieldMileage)
+}
+
+// SetType sets the "type" field.
+func (m *MaintenanceRecordMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *MaintenanceRecordMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the MaintenanceRecord entity.
+// If the MaintenanceRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceRecordMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ClearType clears the value of the "type" field.
+func (m *MaintenanceRecordMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[maintenancerecord.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *MaintenanceRecordMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[maintenancerecord.FieldType]
+	return ok
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *MaintenanceRecordMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, maintenancerecord.FieldType)
+}
+
+// SetCost sets the "cost" field.
+func (m *MaintenanceRecordMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *MaintenanceRecordMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the MaintenanceRecord entity.
+// If the MaintenanceRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceRecordMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *MaintenanceRecordMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *MaintenanceRecordMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCost clears the value of the "cost" field.
+func (m *MaintenanceRecordMutation) ClearCost() {
+	m.cost = nil
+	m.addcost = nil
+	m.clearedFields[maintenancerecord.FieldCost] = struct{}{}
+}
+
+// CostCleared returns if the "cost" field was cleared in this mutation.
+func (m *MaintenanceRecordMutation) CostCleared() bool {
+	_, ok := m.clearedFields[maintenancerecord.FieldCost]
+	return ok
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *MaintenanceRecordMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+	delete(m.clearedFields, maintenancerecord.FieldCost)
+}
+
+// SetShop sets the "shop" field.
+func (m *MaintenanceRecordMutation) SetShop(s string) {
+	m.shop = &s
+}
+
+// Shop returns the value of the "shop" field in the mutation.
+func (m *MaintenanceRecordMutation) Shop() (r string, exists bool) {
+	v := m.shop
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShop returns the old "shop" field's value of the MaintenanceRecord entity.
+// If the MaintenanceRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceRecordMutation) OldShop(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShop is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShop requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShop: %w", err)
+	}
+	return oldValue.Shop, nil
+}
+
+// ClearShop clears the value of the "shop" field.
+func (m *MaintenanceRecordMutation) ClearShop() {
+	m.shop = nil
+	m.clearedFields[maintenancerecord.FieldShop] = struct{}{}
+}
+
+// ShopCleared returns if the "shop" field was cleared in this mutation.
+func (m *MaintenanceRecordMutation) ShopCleared() bool {
+	_, ok := m.clearedFields[maintenancerecord.FieldShop]
+	return ok
+}
+
+// ResetShop resets all changes to the "shop" field.
+func (m *MaintenanceRecordMutation) ResetShop() {
+	m.shop = nil
+	delete(m.clearedFields, maintenancerecord.FieldShop)
+}
+
+// SetNotes sets the "notes" field.
+func (m *MaintenanceRecordMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *MaintenanceRecordMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the MaintenanceRecord entity.
+// If the MaintenanceRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceRecordMutation) OldNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *MaintenanceRecordMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[maintenancerecord.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *MaintenanceRecordMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[maintenancerecord.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *MaintenanceRecordMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, maintenancerecord.FieldNotes)
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *MaintenanceRecordMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *MaintenanceRecordMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *MaintenanceRecordMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *MaintenanceRecordMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the MaintenanceRecordMutation builder.
+func (m *MaintenanceRecordMutation) Where(ps ...predicate.MaintenanceRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MaintenanceRecordMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MaintenanceRecord).
+func (m *MaintenanceRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MaintenanceRecordMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.tenant_id != nil {
+		fields = append(fields, maintenancerecord.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, maintenancerecord.FieldCarID)
+	}
+	if m.serviced_at != nil {
+		fields = append(fields, maintenancerecord.FieldServicedAt)
+	}
+	if m.mileage != nil {
+		fields = append(fields, maintenancerecord.FieldMileage)
+	}
+	if m._type != nil {
+		fields = append(fields, maintenancerecord.FieldType)
+	}
+	if m.cost != nil {
+		fields = append(fields, maintenancerecord.FieldCost)
+	}
+	if m.shop != nil {
+		fields = append(fields, maintenancerecord.FieldShop)
+	}
+	if m.notes != nil {
+		fields = append(fields, maintenancerecord.FieldNotes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MaintenanceRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancerecord.FieldTenantID:
+		return m.TenantID()
+	case maintenancerecord.FieldCarID:
+		return m.CarID()
+	case maintenancerecord.FieldServicedAt:
+		return m.ServicedAt()
+	case maintenancerecord.FieldMileage:
+		return m.Mileage()
+	case maintenancerecord.FieldType:
+		return m.GetType()
+	case maintenancerecord.FieldCost:
+		return m.Cost()
+	case maintenancerecord.FieldShop:
+		return m.Shop()
+	case maintenancerecord.FieldNotes:
+		return m.Notes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MaintenanceRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case maintenancerecord.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case maintenancerecord.FieldCarID:
+		return m.OldCarID(ctx)
+	case maintenancerecord.FieldServicedAt:
+		return m.OldServicedAt(ctx)
+	case maintenancerecord.FieldMileage:
+		return m.OldMileage(ctx)
+	case maintenancerecord.FieldType:
+		return m.OldType(ctx)
+	case maintenancerecord.FieldCost:
+		return m.OldCost(ctx)
+	case maintenancerecord.FieldShop:
+		return m.OldShop(ctx)
+	case maintenancerecord.FieldNotes:
+		return m.OldNotes(ctx)
+	}
+	return nil, fmt.Errorf("unknown MaintenanceRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case maintenancerecord.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case maintenancerecord.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case maintenancerecord.FieldServicedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServicedAt(v)
+		return nil
+	case maintenancerecord.FieldMileage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMileage(v)
+		return nil
+	case maintenancerecord.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case maintenancerecord.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case maintenancerecord.FieldShop:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShop(v)
+		return nil
+	case maintenancerecord.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MaintenanceRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, maintenancerecord.FieldTenantID)
+	}
+	if m.addmileage != nil {
+		fields = append(fields, maintenancerecord.FieldMileage)
+	}
+	if m.addcost != nil {
+		fields = append(fields, maintenancerecord.FieldCost)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MaintenanceRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancerecord.FieldTenantID:
+		return m.AddedTenantID()
+	case maintenancerecord.FieldMileage:
+		return m.AddedMileage()
+	case maintenancerecord.FieldCost:
+		return m.AddedCost()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case maintenancerecord.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case maintenancerecord.FieldMileage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMileage(v)
+		return nil
+	case maintenancerecord.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MaintenanceRecordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(maintenancerecord.FieldTenantID) {
+		fields = append(fields, maintenancerecord.FieldTenantID)
+	}
+	if m.FieldCleared(maintenancerecord.FieldCarID) {
+		fields = append(fields, maintenancerecord.FieldCarID)
+	}
+	if m.FieldCleared(maintenancerecord.FieldMileage) {
+		fields = append(fields, maintenancerecord.FieldMileage)
+	}
+	if m.FieldCleared(maintenancerecord.FieldType) {
+		fields = append(fields, maintenancerecord.FieldType)
+	}
+	if m.FieldCleared(maintenancerecord.FieldCost) {
+		fields = append(fields, maintenancerecord.FieldCost)
+	}
+	if m.FieldCleared(maintenancerecord.FieldShop) {
+		fields = append(fields, maintenancerecord.FieldShop)
+	}
+	if m.FieldCleared(maintenancerecord.FieldNotes) {
+		fields = append(fields, maintenancerecord.FieldNotes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MaintenanceRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MaintenanceRecordMutation) ClearField(name string) error {
+	switch name {
+	case maintenancerecord.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case maintenancerecord.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case maintenancerecord.FieldMileage:
+		m.ClearMileage()
+		return nil
+	case maintenancerecord.FieldType:
+		m.ClearType()
+		return nil
+	case maintenancerecord.FieldCost:
+		m.ClearCost()
+		return nil
+	case maintenancerecord.FieldShop:
+		m.ClearShop()
+		return nil
+	case maintenancerecord.FieldNotes:
+		m.ClearNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MaintenanceRecordMutation) ResetField(name string) error {
+	switch name {
+	case maintenancerecord.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case maintenancerecord.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case maintenancerecord.FieldServicedAt:
+		m.ResetServicedAt()
+		return nil
+	case maintenancerecord.FieldMileage:
+		m.ResetMileage()
+		return nil
+	case maintenancerecord.FieldType:
+		m.ResetType()
+		return nil
+	case maintenancerecord.FieldCost:
+		m.ResetCost()
+		return nil
+	case maintenancerecord.FieldShop:
+		m.ResetShop()
+		return nil
+	case maintenancerecord.FieldNotes:
+		m.ResetNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MaintenanceRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, maintenancerecord.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MaintenanceRecordMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case maintenancerecord.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MaintenanceRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MaintenanceRecordMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MaintenanceRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, maintenancerecord.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MaintenanceRecordMutation) EdgeCleared(name string) bool {
+	switch name {
+	case maintenancerecord.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MaintenanceRecordMutation) ClearEdge(name string) error {
+	switch name {
+	case maintenancerecord.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MaintenanceRecordMutation) ResetEdge(name string) error {
+	switch name {
+	case maintenancerecord.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceRecord edge %s", name)
+}
+
+// OdometerReadingMutation represents an operation that mutates the OdometerReading nodes in the graph.
+type OdometerReadingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	mileage       *int64
+	addmileage    *int64
+	source        *string
+	suspicious    *bool
+	recorded_at   *time.Time
+	clearedFields map[string]struct{}
+	car           *int64
+	clearedcar    bool
+	done          bool
+	oldValue      func(context.Context) (*OdometerReading, error)
+	predicates    []predicate.OdometerReading
+}
+
+var _ ent.Mutation = (*OdometerReadingMutation)(nil)
+
+// odometerreadingOption allows management of the mutation configuration using functional options.
+type odometerreadingOption func(*OdometerReadingMutation)
+
+// newOdometerReadingMutation creates new mutation for the OdometerReading entity.
+func newOdometerReadingMutation(c config, op Op, opts ...odometerreadingOption) *OdometerReadingMutation {
+	m := &OdometerReadingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOdometerReading,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOdometerReadingID sets the ID field of the mutation.
+func withOdometerReadingID(id int64) odometerreadingOption {
+	return func(m *OdometerReadingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OdometerReading
+		)
+		m.oldValue = func(ctx context.Context) (*OdometerReading, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OdometerReading.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOdometerReading sets the old OdometerReading of the mutation.
+func withOdometerReading(node *OdometerReading) odometerreadingOption {
+	return func(m *OdometerReadingMutation) {
+		m.oldValue = func(context.Context) (*OdometerReading, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OdometerReadingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OdometerReadingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OdometerReading entities.
+func (m *OdometerReadingMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OdometerReadingMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OdometerReadingMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OdometerReading.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *OdometerReadingMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *OdometerReadingMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the OdometerReading entity.
+// If the OdometerReading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OdometerReadingMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *OdometerReadingMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *OdometerReadingMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *OdometerReadingMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[odometerreading.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *OdometerReadingMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[odometerreading.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *OdometerReadingMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, odometerreading.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *OdometerReadingMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *OdometerReadingMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the OdometerReading entity.
+// If the OdometerReading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OdometerReadingMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *OdometerReadingMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[odometerreading.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *OdometerReadingMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[odometerreading.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *OdometerReadingMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, odometerreading.FieldCarID)
+}
+
+// SetMileage sets the "mileage" field.
+func (m *OdometerReadingMutation) SetMileage(i int64) {
+	m.mileage = &i
+	m.addmileage = nil
+}
+
+// Mileage returns the value of the "mileage" field in the mutation.
+func (m *OdometerReadingMutation) Mileage() (r int64, exists bool) {
+	v := m.mileage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMileage returns the old "mileage" field's value of the OdometerReading entity.
+// If the OdometerReading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OdometerReadingMutation) OldMileage(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMileage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMileage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMileage: %w", err)
+	}
+	return oldValue.Mileage, nil
+}
+
+// AddMileage adds i to the "mileage" field.
+func (m *OdometerReadingMutation) AddMileage(i int64) {
+	if m.addmileage != nil {
+		*m.addmileage += i
+	} else {
+		m.addmileage = &i
+	}
+}
+
+// AddedMileage returns the value that was added to the "mileage" field in this mutation.
+func (m *OdometerReadingMutation) AddedMileage() (r int64, exists bool) {
+	v := m.addmileage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMileage clears the value of the "mileage" field.
+func (m *OdometerReadingMutation) ClearMileage() {
+	m.mileage = nil
+	m.addmileage = nil
+	m.clearedFields[odometerreading.FieldMileage] = struct{}{}
+}
+
+// MileageCleared returns if the "mileage" field was cleared in this mutation.
+func (m *OdometerReadingMutation) MileageCleared() bool {
+	_, ok := m.clearedFields[odometerreading.FieldMileage]
+	return ok
+}
+
+// ResetMileage resets all changes to the "mileage" field.
+func (m *OdometerReadingMutation) ResetMileage() {
+	m.mileage = nil
+	m.addmileage = nil
+	delete(m.clearedFields, odometerreading.FieldMileage)
+}
+
+// SetSource sets the "source" field.
+func (m *OdometerReadingMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *OdometerReadingMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the OdometerReading entity.
+// If the OdometerReading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OdometerReadingMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ClearSource clears the value of the "source" field.
+func (m *OdometerReadingMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[odometerreading.FieldSource] = struct{}{}
+}
+
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *OdometerReadingMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[odometerreading.FieldSource]
+	return ok
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *OdometerReadingMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, odometerreading.FieldSource)
+}
+
+// SetSuspicious sets the "suspicious" field.
+func (m *OdometerReadingMutation) SetSuspicious(b bool) {
+	m.suspicious = &b
+}
+
+// Suspicious returns the value of the "suspicious" field in the mutation.
+func (m *OdometerReadingMutation) Suspicious() (r bool, exists bool) {
+	v := m.suspicious
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspicious returns the old "suspicious" field's value of the OdometerReading entity.
+// If the OdometerReading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OdometerReadingMutation) OldSuspicious(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspicious is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspicious requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspicious: %w", err)
+	}
+	return oldValue.Suspicious, nil
+}
+
+// ResetSuspicious resets all changes to the "suspicious" field.
+func (m *OdometerReadingMutation) ResetSuspicious() {
+	m.suspicious = nil
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (m *OdometerReadingMutation) SetRecordedAt(t time.Time) {
+	m.recorded_at = &t
+}
+
+// RecordedAt returns the value of the "recorded_at" field in the mutation.
+func (m *OdometerReadingMutation) RecordedAt() (r time.Time, exists bool) {
+	v := m.recorded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecordedAt returns the old "recorded_at" field's value of the OdometerReading entity.
+// If the OdometerReading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OdometerReadingMutation) OldRecordedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecordedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecordedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecordedAt: %w", err)
+	}
+	return oldValue.RecordedAt, nil
+}
+
+// ResetRecordedAt resets all changes to the "recorded_at" field.
+func (m *OdometerReadingMutation) ResetRecordedAt() {
+	m.recorded_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *OdometerReadingMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *OdometerReadingMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *OdometerReadingMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *OdometerReadingMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the OdometerReadingMutation builder.
+func (m *OdometerReadingMutation) Where(ps ...predicate.OdometerReading) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *OdometerReadingMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (OdometerReading).
+func (m *OdometerReadingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OdometerReadingMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.tenant_id != nil {
+		fields = append(fields, odometerreading.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, odometerreading.FieldCarID)
+	}
+	if m.mileage != nil {
+		fields = append(fields, odometerreading.FieldMileage)
+	}
+	if m.source != nil {
+		fields = append(fields, odometerreading.FieldSource)
+	}
+	if m.suspicious != nil {
+		fields = append(fields, odometerreading.FieldSuspicious)
+	}
+	if m.recorded_at != nil {
+		fields = append(fields, odometerreading.FieldRecordedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OdometerReadingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case odometerreading.FieldTenantID:
+		return m.TenantID()
+	case odometerreading.FieldCarID:
+		return m.CarID()
+	case odometerreading.FieldMileage:
+		return m.Mileage()
+	case odometerreading.FieldSource:
+		return m.Source()
+	case odometerreading.FieldSuspicious:
+		return m.Suspicious()
+	case odometerreading.FieldRecordedAt:
+		return m.RecordedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OdometerReadingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case odometerreading.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case odometerreading.FieldCarID:
+		return m.OldCarID(ctx)
+	case odometerreading.FieldMileage:
+		return m.OldMileage(ctx)
+	case odometerreading.FieldSource:
+		return m.OldSource(ctx)
+	case odometerreading.FieldSuspicious:
+		return m.OldSuspicious(ctx)
+	case odometerreading.FieldRecordedAt:
+		return m.OldRecordedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OdometerReading field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OdometerReadingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case odometerreading.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case odometerreading.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case odometerreading.FieldMileage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMileage(v)
+		return nil
+	case odometerreading.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case odometerreading.FieldSuspicious:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspicious(v)
+		return nil
+	case odometerreading.FieldRecordedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecordedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OdometerReading field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OdometerReadingMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, odometerreading.FieldTenantID)
+	}
+	if m.addmileage != nil {
+		fields = append(fields, odometerreading.FieldMileage)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OdometerReadingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case odometerreading.FieldTenantID:
+		return m.AddedTenantID()
+	case odometerreading.FieldMileage:
+		return m.AddedMileage()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OdometerReadingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case odometerreading.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case odometerreading.FieldMileage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMileage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OdometerReading numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OdometerReadingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(odometerreading.FieldTenantID) {
+		fields = append(fields, odometerreading.FieldTenantID)
+	}
+	if m.FieldCleared(odometerreading.FieldCarID) {
+		fields = append(fields, odometerreading.FieldCarID)
+	}
+	if m.FieldCleared(odometerreading.FieldMileage) {
+		fields = append(fields, odometerreading.FieldMileage)
+	}
+	if m.FieldCleared(odometerreading.FieldSource) {
+		fields = append(fields, odometerreading.FieldSource)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OdometerReadingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OdometerReadingMutation) ClearField(name string) error {
+	switch name {
+	case odometerreading.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case odometerreading.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case odometerreading.FieldMileage:
+		m.ClearMileage()
+		return nil
+	case odometerreading.FieldSource:
+		m.ClearSource()
+		return nil
+	}
+	return fmt.Errorf("unknown OdometerReading nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OdometerReadingMutation) ResetField(name string) error {
+	switch name {
+	case odometerreading.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case odometerreading.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case odometerreading.FieldMileage:
+		m.ResetMileage()
+		return nil
+	case odometerreading.FieldSource:
+		m.ResetSource()
+		return nil
+	case odometerreading.FieldSuspicious:
+		m.ResetSuspicious()
+		return nil
+	case odometerreading.FieldRecordedAt:
+		m.ResetRecordedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OdometerReading field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OdometerReadingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, odometerreading.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OdometerReadingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case odometerreading.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OdometerReadingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OdometerReadingMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OdometerReadingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, odometerreading.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OdometerReadingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case odometerreading.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OdometerReadingMutation) ClearEdge(name string) error {
+	switch name {
+	case odometerreading.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown OdometerReading unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OdometerReadingMutation) ResetEdge(name string) error {
+	switch name {
+	case odometerreading.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown OdometerReading edge %s", name)
+}
+
+// OwnerHistoryMutation represents an operation that mutates the OwnerHistory nodes in the graph.
+type OwnerHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	user_id       *int64
+	adduser_id    *int64
+	started_at    *time.Time
+	ended_at      *time.Time
+	clearedFields map[string]struct{}
+	car           *int64
+	clearedcar    bool
+	done          bool
+	oldValue      func(context.Context) (*OwnerHistory, error)
+	predicates    []predicate.OwnerHistory
+}
+
+var _ ent.Mutation = (*OwnerHistoryMutation)(nil)
+
+// ownerhistoryOption allows management of the mutation configuration using functional options.
+type ownerhistoryOption func(*OwnerHistoryMutation)
+
+// newOwnerHistoryMutation creates new mutation for the OwnerHistory entity.
+func newOwnerHistoryMutation(c config, op Op, opts ...ownerhistoryOption) *OwnerHistoryMutation {
+	m := &OwnerHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOwnerHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOwnerHistoryID sets the ID field of the mutation.
+func withOwnerHistoryID(id int64) ownerhistoryOption {
+	return func(m *OwnerHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OwnerHistory
+		)
+		m.oldValue = func(ctx context.Context) (*OwnerHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OwnerHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOwnerHistory sets the old OwnerHistory of the mutation.
+func withOwnerHistory(node *OwnerHistory) ownerhistoryOption {
+	return func(m *OwnerHistoryMutation) {
+		m.oldValue = func(context.Context) (*OwnerHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OwnerHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OwnerHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OwnerHistory entities.
+func (m *OwnerHistoryMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OwnerHistoryMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OwnerHistoryMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OwnerHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *OwnerHistoryMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *OwnerHistoryMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the OwnerHistory entity.
+// If the OwnerHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnerHistoryMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *OwnerHistoryMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *OwnerHistoryMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *OwnerHistoryMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[ownerhistory.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *OwnerHistoryMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[ownerhistory.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *OwnerHistoryMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, ownerhistory.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *OwnerHistoryMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *OwnerHistoryMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the OwnerHistory entity.
+// If the OwnerHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnerHistoryMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *OwnerHistoryMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[ownerhistory.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *OwnerHistoryMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[ownerhistory.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *OwnerHistoryMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, ownerhistory.FieldCarID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *OwnerHistoryMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OwnerHistoryMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the OwnerHistory entity.
+// If the OwnerHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnerHistoryMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *OwnerHistoryMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *OwnerHistoryMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *OwnerHistoryMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[ownerhistory.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *OwnerHistoryMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[ownerhistory.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OwnerHistoryMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, ownerhistory.FieldUserID)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *OwnerHistoryMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *OwnerHistoryMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the OwnerHistory entity.
+// If the OwnerHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnerHistoryMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *OwnerHistoryMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *OwnerHistoryMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *OwnerHistoryMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the OwnerHistory entity.
+// If the OwnerHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnerHistoryMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *OwnerHistoryMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[ownerhistory.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *OwnerHistoryMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[ownerhistory.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *OwnerHistoryMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, ownerhistory.FieldEndedAt)
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *OwnerHistoryMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *OwnerHistoryMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *OwnerHistoryMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *OwnerHistoryMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the OwnerHistoryMutation builder.
+func (m *OwnerHistoryMutation) Where(ps ...predicate.OwnerHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *OwnerHistoryMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (OwnerHistory).
+func (m *OwnerHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OwnerHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant_id != nil {
+		fields = append(fields, ownerhistory.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, ownerhistory.FieldCarID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, ownerhistory.FieldUserID)
+	}
+	if m.started_at != nil {
+		fields = append(fields, ownerhistory.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, ownerhistory.FieldEndedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OwnerHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ownerhistory.FieldTenantID:
+		return m.TenantID()
+	case ownerhistory.FieldCarID:
+		return m.CarID()
+	case ownerhistory.FieldUserID:
+		return m.UserID()
+	case ownerhistory.FieldStartedAt:
+		return m.StartedAt()
+	case ownerhistory.FieldEndedAt:
+		return m.EndedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OwnerHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ownerhistory.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case ownerhistory.FieldCarID:
+		return m.OldCarID(ctx)
+	case ownerhistory.FieldUserID:
+		return m.OldUserID(ctx)
+	case ownerhistory.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case ownerhistory.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OwnerHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnerHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ownerhistory.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case ownerhistory.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case ownerhistory.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case ownerhistory.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case ownerhistory.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OwnerHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, ownerhistory.FieldTenantID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, ownerhistory.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OwnerHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ownerhistory.FieldTenantID:
+		return m.AddedTenantID()
+	case ownerhistory.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnerHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ownerhistory.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case ownerhistory.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OwnerHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ownerhistory.FieldTenantID) {
+		fields = append(fields, ownerhistory.FieldTenantID)
+	}
+	if m.FieldCleared(ownerhistory.FieldCarID) {
+		fields = append(fields, ownerhistory.FieldCarID)
+	}
+	if m.FieldCleared(ownerhistory.FieldUserID) {
+		fields = append(fields, ownerhistory.FieldUserID)
+	}
+	if m.FieldCleared(ownerhistory.FieldEndedAt) {
+		fields = append(fields, ownerhistory.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OwnerHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OwnerHistoryMutation) ClearField(name string) error {
+	switch name {
+	case ownerhistory.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case ownerhistory.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case ownerhistory.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case ownerhistory.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OwnerHistoryMutation) ResetField(name string) error {
+	switch name {
+	case ownerhistory.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case ownerhistory.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case ownerhistory.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case ownerhistory.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case ownerhistory.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OwnerHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, ownerhistory.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OwnerHistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ownerhistory.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OwnerHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OwnerHistoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OwnerHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, ownerhistory.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OwnerHistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ownerhistory.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OwnerHistoryMutation) ClearEdge(name string) error {
+	switch name {
+	case ownerhistory.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OwnerHistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case ownerhistory.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerHistory edge %s", name)
+}
+
+// RecallMutation represents an operation that mutates the Recall nodes in the graph.
+type RecallMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int64
+	campaign_no        *string
+	manufacturer       *string
+	model_id           *int64
+	addmodel_id        *int64
+	model              *string
+	year_from          *int
+	addyear_from       *int
+	year_to            *int
+	addyear_to         *int
+	vin_from           *string
+	vin_to             *string
+	component          *string
+	description        *string
+	remedy             *string
+	issued_at          *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	car_recalls        map[int64]struct{}
+	removedcar_recalls map[int64]struct{}
+	clearedcar_recalls bool
+	done               bool
+	oldValue           func(context.Context) (*Recall, error)
+	predicates         []predicate.Recall
+}
+
+var _ ent.Mutation = (*RecallMutation)(nil)
+
+// recallOption allows management of the mutation configuration using functional options.
+type recallOption func(*RecallMutation)
+
+// newRecallMutation creates new mutation for the Recall entity.
+func newRecallMutation(c config, op Op, opts ...recallOption) *RecallMutation {
+	m := &RecallMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecall,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecallID sets the ID field of the mutation.
+func withRecallID(id int64) recallOption {
+	return func(m *RecallMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Recall
+		)
+		m.oldValue = func(ctx context.Context) (*Recall, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Recall.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecall sets the old Recall of the mutation.
+func withRecall(node *Recall) recallOption {
+	return func(m *RecallMutation) {
+		m.oldValue = func(context.Context) (*Recall, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecallMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecallMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Recall entities.
+func (m *RecallMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecallMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecallMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Recall.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCampaignNo sets the "campaign_no" field.
+func (m *RecallMutation) SetCampaignNo(s string) {
+	m.campaign_no = &s
+}
+
+// CampaignNo returns the value of the "campaign_no" field in the mutation.
+func (m *RecallMutation) CampaignNo() (r string, exists bool) {
+	v := m.campaign_no
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampaignNo returns the old "campaign_no" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldCampaignNo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaignNo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaignNo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaignNo: %w", err)
+	}
+	return oldValue.CampaignNo, nil
+}
+
+// ResetCampaignNo resets all changes to the "campaign_no" field.
+func (m *RecallMutation) ResetCampaignNo() {
+	m.campaign_no = nil
+}
+
+// SetManufacturer sets the "manufacturer" field.
+func (m *RecallMutation) SetManufacturer(s string) {
+	m.manufacturer = &s
+}
+
+// Manufacturer returns the value of the "manufacturer" field in the mutation.
+func (m *RecallMutation) Manufacturer() (r string, exists bool) {
+	v := m.manufacturer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManufacturer returns the old "manufacturer" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldManufacturer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManufacturer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManufacturer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManufacturer: %w", err)
+	}
+	return oldValue.Manufacturer, nil
+}
+
+// ClearManufacturer clears the value of the "manufacturer" field.
+func (m *RecallMutation) ClearManufacturer() {
+	m.manufacturer = nil
+	m.clearedFields[recall.FieldManufacturer] = struct{}{}
+}
+
+// ManufacturerCleared returns if the "manufacturer" field was cleared in this mutation.
+func (m *RecallMutation) ManufacturerCleared() bool {
+	_, ok := m.clearedFields[recall.FieldManufacturer]
+	return ok
+}
+
+// ResetManufacturer resets all changes to the "manufacturer" field.
+func (m *RecallMutation) ResetManufacturer() {
+	m.manufacturer = nil
+	delete(m.clearedFields, recall.FieldManufacturer)
+}
+
+// SetModelID sets the "model_id" field.
+func (m *RecallMutation) SetModelID(i int64) {
+	m.model_id = &i
+	m.addmodel_id = nil
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *RecallMutation) ModelID() (r int64, exists bool) {
+	v := m.model_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldModelID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
+}
+
+// AddModelID adds i to the "model_id" field.
+func (m *RecallMutation) AddModelID(i int64) {
+	if m.addmodel_id != nil {
+		*m.addmodel_id += i
+	} else {
+		m.addmodel_id = &i
+	}
+}
+
+// AddedModelID returns the value that was added to the "model_id" field in this mutation.
+func (m *RecallMutation) AddedModelID() (r int64, exists bool) {
+	v := m.addmodel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (m *RecallMutation) ClearModelID() {
+	m.model_id = nil
+	m.addmodel_id = nil
+	m.clearedFields[recall.FieldModelID] = struct{}{}
+}
+
+// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
+func (m *RecallMutation) ModelIDCleared() bool {
+	_, ok := m.clearedFields[recall.FieldModelID]
+	return ok
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *RecallMutation) ResetModelID() {
+	m.model_id = nil
+	m.addmodel_id = nil
+	delete(m.clearedFields, recall.FieldModelID)
+}
+
+// SetModel sets the "model" field.
+func (m *RecallMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *RecallMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ClearModel clears the value of the "model" field.
+func (m *RecallMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[recall.FieldModel] = struct{}{}
+}
+
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *RecallMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[recall.FieldModel]
+	return ok
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *RecallMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, recall.FieldModel)
+}
+
+// SetYearFrom sets the "year_from" field.
+func (m *RecallMutation) SetYearFrom(i int) {
+	m.year_from = &i
+	m.addyear_from = nil
+}
+
+// YearFrom returns the value of the "year_from" field in the mutation.
+func (m *RecallMutation) YearFrom() (r int, exists bool) {
+	v := m.year_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYearFrom returns the old "year_from" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldYearFrom(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYearFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYearFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYearFrom: %w", err)
+	}
+	return oldValue.YearFrom, nil
+}
+
+// AddYearFrom adds i to the "year_from" field.
+func (m *RecallMutation) AddYearFrom(i int) {
+	if m.addyear_from != nil {
+		*m.addyear_from += i
+	} else {
+		m.addyear_from = &i
+	}
+}
+
+// AddedYearFrom returns the value that was added to the "year_from" field in this mutation.
+func (m *RecallMutation) AddedYearFrom() (r int, exists bool) {
+	v := m.addyear_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearYearFrom clears the value of the "year_from" field.
+func (m *RecallMutation) ClearYearFrom() {
+	m.year_from = nil
+	m.addyear_from = nil
+	m.clearedFields[recall.FieldYearFrom] = struct{}{}
+}
+
+// YearFromCleared returns if the "year_from" field was cleared in this mutation.
+func (m *RecallMutation) YearFromCleared() bool {
+	_, ok := m.clearedFields[recall.FieldYearFrom]
+	return ok
+}
+
+// ResetYearFrom resets all changes to the "year_from" field.
+func (m *RecallMutation) ResetYearFrom() {
+	m.year_from = nil
+	m.addyear_from = nil
+	delete(m.clearedFields, recall.FieldYearFrom)
+}
+
+// SetYearTo sets the "year_to" field.
+func (m *RecallMutation) SetYearTo(i int) {
+	m.year_to = &i
+	m.addyear_to = nil
+}
+
+// YearTo returns the value of the "year_to" field in the mutation.
+func (m *RecallMutation) YearTo() (r int, exists bool) {
+	v := m.year_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYearTo returns the old "year_to" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldYearTo(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYearTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYearTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYearTo: %w", err)
+	}
+	return oldValue.YearTo, nil
+}
+
+// AddYearTo adds i to the "year_to" field.
+func (m *RecallMutation) AddYearTo(i int) {
+	if m.addyear_to != nil {
+		*m.addyear_to += i
+	} else {
+		m.addyear_to = &i
+	}
+}
+
+// AddedYearTo returns the value that was added to the "year_to" field in this mutation.
+func (m *RecallMutation) AddedYearTo() (r int, exists bool) {
+	v := m.addyear_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearYearTo clears the value of the "year_to" field.
+func (m *RecallMutation) ClearYearTo() {
+	m.year_to = nil
+	m.addyear_to = nil
+	m.clearedFields[recall.FieldYearTo] = struct{}{}
+}
+
+// YearToCleared returns if the "year_to" field was cleared in this mutation.
+func (m *RecallMutation) YearToCleared() bool {
+	_, ok := m.clearedFields[recall.FieldYearTo]
+	return ok
+}
+
+// ResetYearTo resets all changes to the "year_to" field.
+func (m *RecallMutation) ResetYearTo() {
+	m.year_to = nil
+	m.addyear_to = nil
+	delete(m.clearedFields, recall.FieldYearTo)
+}
+
+// SetVinFrom sets the "vin_from" field.
+func (m *RecallMutation) SetVinFrom(s string) {
+	m.vin_from = &s
+}
+
+// VinFrom returns the value of the "vin_from" field in the mutation.
+func (m *RecallMutation) VinFrom() (r string, exists bool) {
+	v := m.vin_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVinFrom returns the old "vin_from" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldVinFrom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVinFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVinFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVinFrom: %w", err)
+	}
+	return oldValue.VinFrom, nil
+}
+
+// ClearVinFrom clears the value of the "vin_from" field.
+func (m *RecallMutation) ClearVinFrom() {
+	m.vin_from = nil
+	m.clearedFields[recall.FieldVinFrom] = struct{}{}
+}
+
+// VinFromCleared returns if the "vin_from" field was cleared in this mutation.
+func (m *RecallMutation) VinFromCleared() bool {
+	_, ok := m.clearedFields[recall.FieldVinFrom]
+	return ok
+}
+
+// ResetVinFrom resets all changes to the "vin_from" field.
+func (m *RecallMutation) ResetVinFrom() {
+	m.vin_from = nil
+	delete(m.clearedFields, recall.FieldVinFrom)
+}
+
+// SetVinTo sets the "vin_to" field.
+func (m *RecallMutation) SetVinTo(s string) {
+	m.vin_to = &s
+}
+
+// VinTo returns the value of the "vin_to" field in the mutation.
+func (m *RecallMutation) VinTo() (r string, exists bool) {
+	v := m.vin_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVinTo returns the old "vin_to" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldVinTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVinTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVinTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVinTo: %w", err)
+	}
+	return oldValue.VinTo, nil
+}
+
+// ClearVinTo clears the value of the "vin_to" field.
+func (m *RecallMutation) ClearVinTo() {
+	m.vin_to = nil
+	m.clearedFields[recall.FieldVinTo] = struct{}{}
+}
+
+// VinToCleared returns if the "vin_to" field was cleared in this mutation.
+func (m *RecallMutation) VinToCleared() bool {
+	_, ok := m.clearedFields[recall.FieldVinTo]
+	return ok
+}
+
+// ResetVinTo resets all changes to the "vin_to" field.
+func (m *RecallMutation) ResetVinTo() {
+	m.vin_to = nil
+	delete(m.clearedFields, recall.FieldVinTo)
+}
+
+// SetComponent sets the "component" field.
+func (m *RecallMutation) SetComponent(s string) {
+	m.component = &s
+}
+
+// Component returns the value of the "component" field in the mutation.
+func (m *RecallMutation) Component() (r string, exists bool) {
+	v := m.component
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComponent returns the old "component" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldComponent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComponent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComponent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComponent: %w", err)
+	}
+	return oldValue.Component, nil
+}
+
+// ClearComponent clears the value of the "component" field.
+func (m *RecallMutation) ClearComponent() {
+	m.component = nil
+	m.clearedFields[recall.FieldComponent] = struct{}{}
+}
+
+// ComponentCleared returns if the "component" field was cleared in this mutation.
+func (m *RecallMutation) ComponentCleared() bool {
+	_, ok := m.clearedFields[recall.FieldComponent]
+	return ok
+}
+
+// ResetComponent resets all changes to the "component" field.
+func (m *RecallMutation) ResetComponent() {
+	m.component = nil
+	delete(m.clearedFields, recall.FieldComponent)
+}
+
+// SetDescription sets the "description" field.
+func (m *RecallMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RecallMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RecallMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[recall.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RecallMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[recall.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RecallMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, recall.FieldDescription)
+}
+
+// SetRemedy sets the "remedy" field.
+func (m *RecallMutation) SetRemedy(s string) {
+	m.remedy = &s
+}
+
+// Remedy returns the value of the "remedy" field in the mutation.
+func (m *RecallMutation) Remedy() (r string, exists bool) {
+	v := m.remedy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemedy returns the old "remedy" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldRemedy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemedy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemedy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemedy: %w", err)
+	}
+	return oldValue.Remedy, nil
+}
+
+// ClearRemedy clears the value of the "remedy" field.
+func (m *RecallMutation) ClearRemedy() {
+	m.remedy = nil
+	m.clearedFields[recall.FieldRemedy] = struct{}{}
+}
+
+// RemedyCleared returns if the "remedy" field was cleared in this mutation.
+func (m *RecallMutation) RemedyCleared() bool {
+	_, ok := m.clearedFields[recall.FieldRemedy]
+	return ok
+}
+
+// ResetRemedy resets all changes to the "remedy" field.
+func (m *RecallMutation) ResetRemedy() {
+	m.remedy = nil
+	delete(m.clearedFields, recall.FieldRemedy)
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (m *RecallMutation) SetIssuedAt(t time.Time) {
+	m.issued_at = &t
+}
+
+// IssuedAt returns the value of the "issued_at" field in the mutation.
+func (m *RecallMutation) IssuedAt() (r time.Time, exists bool) {
+	v := m.issued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuedAt returns the old "issued_at" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldIssuedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuedAt: %w", err)
+	}
+	return oldValue.IssuedAt, nil
+}
+
+// ClearIssuedAt clears the value of the "issued_at" field.
+func (m *RecallMutation) ClearIssuedAt() {
+	m.issued_at = nil
+	m.clearedFields[recall.FieldIssuedAt] = struct{}{}
+}
+
+// IssuedAtCleared returns if the "issued_at" field was cleared in this mutation.
+func (m *RecallMutation) IssuedAtCleared() bool {
+	_, ok := m.clearedFields[recall.FieldIssuedAt]
+	return ok
+}
+
+// ResetIssuedAt resets all changes to the "issued_at" field.
+func (m *RecallMutation) ResetIssuedAt() {
+	m.issued_at = nil
+	delete(m.clearedFields, recall.FieldIssuedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecallMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecallMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Recall entity.
+// If the Recall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecallMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecallMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddCarRecallIDs adds the "car_recalls" edge to the CarRecall entity by ids.
+func (m *RecallMutation) AddCarRecallIDs(ids ...int64) {
+	if m.car_recalls == nil {
+		m.car_recalls = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.car_recalls[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCarRecalls clears the "car_recalls" edge to the CarRecall entity.
+func (m *RecallMutation) ClearCarRecalls() {
+	m.clearedcar_recalls = true
+}
+
+// CarRecallsCleared reports if the "car_recalls" edge to the CarRecall entity was cleared.
+func (m *RecallMutation) CarRecallsCleared() bool {
+	return m.clearedcar_recalls
+}
+
+// RemoveCarRecallIDs removes the "car_recalls" edge to the CarRecall entity by IDs.
+func (m *RecallMutation) RemoveCarRecallIDs(ids ...int64) {
+	if m.removedcar_recalls == nil {
+		m.removedcar_recalls = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.car_recalls, ids[i])
+		m.removedcar_recalls[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCarRecalls returns the removed IDs of the "car_recalls" edge to the CarRecall entity.
+func (m *RecallMutation) RemovedCarRecallsIDs() (ids []int64) {
+	for id := range m.removedcar_recalls {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CarRecallsIDs returns the "car_recalls" edge IDs in the mutation.
+func (m *RecallMutation) CarRecallsIDs() (ids []int64) {
+	for id := range m.car_recalls {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCarRecalls resets all changes to the "car_recalls" edge.
+func (m *RecallMutation) ResetCarRecalls() {
+	m.car_recalls = nil
+	m.clearedcar_recalls = false
+	m.removedcar_recalls = nil
+}
+
+// Where appends a list predicates to the RecallMutation builder.
+func (m *RecallMutation) Where(ps ...predicate.Recall) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *RecallMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Recall).
+func (m *RecallMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecallMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.campaign_no != nil {
+		fields = append(fields, recall.FieldCampaignNo)
+	}
+	if m.manufacturer != nil {
+		fields = append(fields, recall.FieldManufacturer)
+	}
+	if m.model_id != nil {
+		fields = append(fields, recall.FieldModelID)
+	}
+	if m.model != nil {
+		fields = append(fields, recall.FieldModel)
+	}
+	if m.year_from != nil {
+		fields = append(fields, recall.FieldYearFrom)
+	}
+	if m.year_to != nil {
+		fields = append(fields, recall.FieldYearTo)
+	}
+	if m.vin_from != nil {
+		fields = append(fields, recall.FieldVinFrom)
+	}
+	if m.vin_to != nil {
+		fields = append(fields, recall.FieldVinTo)
+	}
+	if m.component != nil {
+		fields = append(fields, recall.FieldComponent)
+	}
+	if m.description != nil {
+		fields = append(fields, recall.FieldDescription)
+	}
+	if m.remedy != nil {
+		fields = append(fields, recall.FieldRemedy)
+	}
+	if m.issued_at != nil {
+		fields = append(fields, recall.FieldIssuedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recall.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecallMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recall.FieldCampaignNo:
+		return m.CampaignNo()
+	case recall.FieldManufacturer:
+		return m.Manufacturer()
+	case recall.FieldModelID:
+		return m.ModelID()
+	case recall.FieldModel:
+		return m.Model()
+	case recall.FieldYearFrom:
+		return m.YearFrom()
+	case recall.FieldYearTo:
+		return m.YearTo()
+	case recall.FieldVinFrom:
+		return m.VinFrom()
+	case recall.FieldVinTo:
+		return m.VinTo()
+	case recall.FieldComponent:
+		return m.Component()
+	case recall.FieldDescription:
+		return m.Description()
+	case recall.FieldRemedy:
+		return m.Remedy()
+	case recall.FieldIssuedAt:
+		return m.IssuedAt()
+	case recall.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecallMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recall.FieldCampaignNo:
+		return m.OldCampaignNo(ctx)
+	case recall.FieldManufacturer:
+		return m.OldManufacturer(ctx)
+	case recall.FieldModelID:
+		return m.OldModelID(ctx)
+	case recall.FieldModel:
+		return m.OldModel(ctx)
+	case recall.FieldYearFrom:
+		return m.OldYearFrom(ctx)
+	case recall.FieldYearTo:
+		return m.OldYearTo(ctx)
+	case recall.FieldVinFrom:
+		return m.OldVinFrom(ctx)
+	case recall.FieldVinTo:
+		return m.OldVinTo(ctx)
+	case recall.FieldComponent:
+		return m.OldComponent(ctx)
+	case recall.FieldDescription:
+		return m.OldDescription(ctx)
+	case recall.FieldRemedy:
+		return m.OldRemedy(ctx)
+	case recall.FieldIssuedAt:
+		return m.OldIssuedAt(ctx)
+	case recall.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Recall field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecallMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recall.FieldCampaignNo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCampaignNo(v)
+		return nil
+	case recall.FieldManufacturer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManufacturer(v)
+		return nil
+	case recall.FieldModelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case recall.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case recall.FieldYearFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYearFrom(v)
+		return nil
+	case recall.FieldYearTo:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYearTo(v)
+		return nil
+	case recall.FieldVinFrom:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVinFrom(v)
+		return nil
+	case recall.FieldVinTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVinTo(v)
+		return nil
+	case recall.FieldComponent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComponent(v)
+		return nil
+	case recall.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case recall.FieldRemedy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemedy(v)
+		return nil
+	case recall.FieldIssuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuedAt(v)
+		return nil
+	case recall.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Recall field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecallMutation) AddedFields() []string {
+	var fields []string
+	if m.addmodel_id != nil {
+		fields = append(fields, recall.FieldModelID)
+	}
+	if m.addyear_from != nil {
+		fields = append(fields, recall.FieldYearFrom)
+	}
+	if m.addyear_to != nil {
+		fields = append(fields, recall.FieldYearTo)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecallMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recall.FieldModelID:
+		return m.AddedModelID()
+	case recall.FieldYearFrom:
+		return m.AddedYearFrom()
+	case recall.FieldYearTo:
+		return m.AddedYearTo()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecallMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recall.FieldModelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModelID(v)
+		return nil
+	case recall.FieldYearFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYearFrom(v)
+		return nil
+	case recall.FieldYearTo:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYearTo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Recall numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecallMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recall.FieldManufacturer) {
+		fields = append(fields, recall.FieldManufacturer)
+	}
+	if m.FieldCleared(recall.FieldModelID) {
+		fields = append(fields, recall.FieldModelID)
+	}
+	if m.FieldCleared(recall.FieldModel) {
+		fields = append(fields, recall.FieldModel)
+	}
+	if m.FieldCleared(recall.FieldYearFrom) {
+		fields = append(fields, recall.FieldYearFrom)
+	}
+	if m.FieldCleared(recall.FieldYearTo) {
+		fields = append(fields, recall.FieldYearTo)
+	}
+	if m.FieldCleared(recall.FieldVinFrom) {
+		fields = append(fields, recall.FieldVinFrom)
+	}
+	if m.FieldCleared(recall.FieldVinTo) {
+		fields = append(fields, recall.FieldVinTo)
+	}
+	if m.FieldCleared(recall.FieldComponent) {
+		fields = append(fields, recall.FieldComponent)
+	}
+	if m.FieldCleared(recall.FieldDescription) {
+		fields = append(fields, recall.FieldDescription)
+	}
+	if m.FieldCleared(recall.FieldRemedy) {
+		fields = append(fields, recall.FieldRemedy)
+	}
+	if m.FieldCleared(recall.FieldIssuedAt) {
+		fields = append(fields, recall.FieldIssuedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecallMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecallMutation) ClearField(name string) error {
+	switch name {
+	case recall.FieldManufacturer:
+		m.ClearManufacturer()
+		return nil
+	case recall.FieldModelID:
+		m.ClearModelID()
+		return nil
+	case recall.FieldModel:
+		m.ClearModel()
+		return nil
+	case recall.FieldYearFrom:
+		m.ClearYearFrom()
+		return nil
+	case recall.FieldYearTo:
+		m.ClearYearTo()
+		return nil
+	case recall.FieldVinFrom:
+		m.ClearVinFrom()
+		return nil
+	case recall.FieldVinTo:
+		m.ClearVinTo()
+		return nil
+	case recall.FieldComponent:
+		m.ClearComponent()
+		return nil
+	case recall.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case recall.FieldRemedy:
+		m.ClearRemedy()
+		return nil
+	case recall.FieldIssuedAt:
+		m.ClearIssuedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Recall nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecallMutation) ResetField(name string) error {
+	switch name {
+	case recall.FieldCampaignNo:
+		m.ResetCampaignNo()
+		return nil
+	case recall.FieldManufacturer:
+		m.ResetManufacturer()
+		return nil
+	case recall.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case recall.FieldModel:
+		m.ResetModel()
+		return nil
+	case recall.FieldYearFrom:
+		m.ResetYearFrom()
+		return nil
+	case recall.FieldYearTo:
+		m.ResetYearTo()
+		return nil
+	case recall.FieldVinFrom:
+		m.ResetVinFrom()
+		return nil
+	case recall.FieldVinTo:
+		m.ResetVinTo()
+		return nil
+	case recall.FieldComponent:
+		m.ResetComponent()
+		return nil
+	case recall.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case recall.FieldRemedy:
+		m.ResetRemedy()
+		return nil
+	case recall.FieldIssuedAt:
+		m.ResetIssuedAt()
+		return nil
+	case recall.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Recall field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecallMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car_recalls != nil {
+		edges = append(edges, recall.EdgeCarRecalls)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecallMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recall.EdgeCarRecalls:
+		ids := make([]ent.Value, 0, len(m.car_recalls))
+		for id := range m.car_recalls {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecallMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcar_recalls != nil {
+		edges = append(edges, recall.EdgeCarRecalls)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecallMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case recall.EdgeCarRecalls:
+		ids := make([]ent.Value, 0, len(m.removedcar_recalls))
+		for id := range m.removedcar_recalls {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecallMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar_recalls {
+		edges = append(edges, recall.EdgeCarRecalls)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecallMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recall.EdgeCarRecalls:
+		return m.clearedcar_recalls
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecallMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Recall unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecallMutation) ResetEdge(name string) error {
+	switch name {
+	case recall.EdgeCarRecalls:
+		m.ResetCarRecalls()
+		return nil
+	}
+	return fmt.Errorf("unknown Recall edge %s", name)
+}
+
+// RefuelMutation represents an operation that mutates the Refuel nodes in the graph.
+type RefuelMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	amount        *float64
+	addamount     *float64
+	unit          *string
+	cost          *float64
+	addcost       *float64
+	station       *string
+	odometer      *int64
+	addodometer   *int64
+	refueled_at   *time.Time
+	clearedFields map[string]struct{}
+	car           *int64
+	clearedcar    bool
+	done          bool
+	oldValue      func(context.Context) (*Refuel, error)
+	predicates    []predicate.Refuel
+}
+
+var _ ent.Mutation = (*RefuelMutation)(nil)
+
+// refuelOption allows management of the mutation configuration using functional options.
+type refuelOption func(*RefuelMutation)
+
+// newRefuelMutation creates new mutation for the Refuel entity.
+func newRefuelMutation(c config, op Op, opts ...refuelOption) *RefuelMutation {
+	m := &RefuelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefuel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefuelID sets the ID field of the mutation.
+func withRefuelID(id int64) refuelOption {
+	return func(m *RefuelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Refuel
+		)
+		m.oldValue = func(ctx context.Context) (*Refuel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Refuel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefuel sets the old Refuel of the mutation.
+func withRefuel(node *Refuel) refuelOption {
+	return func(m *RefuelMutation) {
+		m.oldValue = func(context.Context) (*Refuel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefuelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefuelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Refuel entities.
+func (m *RefuelMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefuelMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefuelMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Refuel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *RefuelMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *RefuelMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Refuel entity.
+// If the Refuel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefuelMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *RefuelMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *RefuelMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *RefuelMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[refuel.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *RefuelMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[refuel.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *RefuelMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, refuel.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *RefuelMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *RefuelMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the Refuel entity.
+// If the Refuel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefuelMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *RefuelMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[refuel.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *RefuelMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[refuel.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *RefuelMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, refuel.FieldCarID)
+}
+
+// SetAmount sets the "amount" field.
+func (m *RefuelMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *RefuelMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Refuel entity.
+// If the Refuel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefuelMutation) OldAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *RefuelMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *RefuelMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (m *RefuelMutation) ClearAmount() {
+	m.amount = nil
+	m.addamount = nil
+	m.clearedFields[refuel.FieldAmount] = struct{}{}
+}
+
+// AmountCleared returns if the "amount" field was cleared in this mutation.
+func (m *RefuelMutation) AmountCleared() bool {
+	_, ok := m.clearedFields[refuel.FieldAmount]
+	return ok
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *RefuelMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+	delete(m.clearedFields, refuel.FieldAmount)
+}
+
+// SetUnit sets the "unit" field.
+func (m *RefuelMutation) SetUnit(s string) {
+	m.unit = &s
+}
+
+// Unit returns the value of the "unit" field in the mutation.
+func (m *RefuelMutation) Unit() (r string, exists bool) {
+	v := m.unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnit returns the old "unit" field's value of the Refuel entity.
+// If the Refuel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefuelMutation) OldUnit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnit: %w", err)
+	}
+	return oldValue.Unit, nil
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (m *RefuelMutation) ClearUnit() {
+	m.unit = nil
+	m.clearedFields[refuel.FieldUnit] = struct{}{}
+}
+
+// UnitCleared returns if the "unit" field was cleared in this mutation.
+func (m *RefuelMutation) UnitCleared() bool {
+	_, ok := m.clearedFields[refuel.FieldUnit]
+	return ok
+}
+
+// ResetUnit resets all changes to the "unit" field.
+func (m *RefuelMutation) ResetUnit() {
+	m.unit = nil
+	delete(m.clearedFields, refuel.FieldUnit)
+}
+
+// SetCost sets the "cost" field.
+func (m *RefuelMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *RefuelMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the Refuel entity.
+// If the Refuel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefuelMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *RefuelMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *RefuelMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCost clears the value of the "cost" field.
+func (m *RefuelMutation) ClearCost() {
+	m.cost = nil
+	m.addcost = nil
+	m.clearedFields[refuel.FieldCost] = struct{}{}
+}
+
+// CostCleared returns if the "cost" field was cleared in this mutation.
+func (m *RefuelMutation) CostCleared() bool {
+	_, ok := m.clearedFields[refuel.FieldCost]
+	return ok
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *RefuelMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+	delete(m.clearedFields, refuel.FieldCost)
+}
+
+// SetStation sets the "station" field.
+func (m *RefuelMutation) SetStation(s string) {
+	m.station = &s
+}
+
+// Station returns the value of the "station" field in the mutation.
+func (m *RefuelMutation) Station() (r string, exists bool) {
+	v := m.station
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStation returns the old "station" field's value of the Refuel entity.
+// If the Refuel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefuelMutation) OldStation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStation: %w", err)
+	}
+	return oldValue.Station, nil
+}
+
+// ClearStation clears the value of the "station" field.
+func (m *RefuelMutation) ClearStation() {
+	m.station = nil
+	m.clearedFields[refuel.FieldStation] = struct{}{}
+}
+
+// StationCleared returns if the "station" field was cleared in this mutation.
+func (m *RefuelMutation) StationCleared() bool {
+	_, ok := m.clearedFields[refuel.FieldStation]
+	return ok
+}
+
+// ResetStation resets all changes to the "station" field.
+func (m *RefuelMutation) ResetStation() {
+	m.station = nil
+	delete(m.clearedFields, refuel.FieldStation)
+}
+
+// SetOdometer sets the "odometer" field.
+func (m *RefuelMutation) SetOdometer(i int64) {
+	m.odometer = &i
+	m.addodometer = nil
+}
+
+// Odometer returns the value of the "odometer" field in the mutation.
+func (m *RefuelMutation) Odometer() (r int64, exists bool) {
+	v := m.odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOdometer returns the old "odometer" field's value of the Refuel entity.
+// If the Refuel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefuelMutation) OldOdometer(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOdometer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOdometer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOdometer: %w", err)
+	}
+	return oldValue.Odometer, nil
+}
+
+// AddOdometer adds i to the "odometer" field.
+func (m *RefuelMutation) AddOdometer(i int64) {
+	if m.addodometer != nil {
+		*m.addodometer += i
+	} else {
+		m.addodometer = &i
+	}
+}
+
+// AddedOdometer returns the value that was added to the "odometer" field in this mutation.
+func (m *RefuelMutation) AddedOdometer() (r int64, exists bool) {
+	v := m.addodometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOdometer clears the value of the "odometer" field.
+func (m *RefuelMutation) ClearOdometer() {
+	m.odometer = nil
+	m.addodometer = nil
+	m.clearedFields[refuel.FieldOdometer] = struct{}{}
+}
+
+// OdometerCleared returns if the "odometer" field was cleared in this mutation.
+func (m *RefuelMutation) OdometerCleared() bool {
+	_, ok := m.clearedFields[refuel.FieldOdometer]
+	return ok
+}
+
+// ResetOdometer resets all changes to the "odometer" field.
+func (m *RefuelMutation) ResetOdometer() {
+	m.odometer = nil
+	m.addodometer = nil
+	delete(m.clearedFields, refuel.FieldOdometer)
+}
+
+// SetRefueledAt sets the "refueled_at" field.
+func (m *RefuelMutation) SetRefueledAt(t time.Time) {
+	m.refueled_at = &t
+}
+
+// RefueledAt returns the value of the "refueled_at" field in the mutation.
+func (m *RefuelMutation) RefueledAt() (r time.Time, exists bool) {
+	v := m.refueled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefueledAt returns the old "refueled_at" field's value of the Refuel entity.
+// If the Refuel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefuelMutation) OldRefueledAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefueledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefueledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefueledAt: %w", err)
+	}
+	return oldValue.RefueledAt, nil
+}
+
+// ResetRefueledAt resets all changes to the "refueled_at" field.
+func (m *RefuelMutation) ResetRefueledAt() {
+	m.refueled_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *RefuelMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *RefuelMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *RefuelMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *RefuelMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the RefuelMutation builder.
+func (m *RefuelMutation) Where(ps ...predicate.Refuel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *RefuelMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Refuel).
+func (m *RefuelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefuelMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.tenant_id != nil {
+		fields = append(fields, refuel.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, refuel.FieldCarID)
+	}
+	if m.amount != nil {
+		fields = append(fields, refuel.FieldAmount)
+	}
+	if m.unit != nil {
+		fields = append(fields, refuel.FieldUnit)
+	}
+	if m.cost != nil {
+		fields = append(fields, refuel.FieldCost)
+	}
+	if m.station != nil {
+		fields = append(fields, refuel.FieldStation)
+	}
+	if m.odometer != nil {
+		fields = append(fields, refuel.FieldOdometer)
+	}
+	if m.refueled_at != nil {
+		fields = append(fields, refuel.FieldRefueledAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefuelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refuel.FieldTenantID:
+		return m.TenantID()
+	case refuel.FieldCarID:
+		return m.CarID()
+	case refuel.FieldAmount:
+		return m.Amount()
+	case refuel.FieldUnit:
+		return m.Unit()
+	case refuel.FieldCost:
+		return m.Cost()
+	case refuel.FieldStation:
+		return m.Station()
+	case refuel.FieldOdometer:
+		return m.Odometer()
+	case refuel.FieldRefueledAt:
+		return m.RefueledAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefuelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refuel.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case refuel.FieldCarID:
+		return m.OldCarID(ctx)
+	case refuel.FieldAmount:
+		return m.OldAmount(ctx)
+	case refuel.FieldUnit:
+		return m.OldUnit(ctx)
+	case refuel.FieldCost:
+		return m.OldCost(ctx)
+	case refuel.FieldStation:
+		return m.OldStation(ctx)
+	case refuel.FieldOdometer:
+		return m.OldOdometer(ctx)
+	case refuel.FieldRefueledAt:
+		return m.OldRefueledAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Refuel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefuelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refuel.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case refuel.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case refuel.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case refuel.FieldUnit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnit(v)
+		return nil
+	case refuel.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case refuel.FieldStation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStation(v)
+		return nil
+	case refuel.FieldOdometer:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOdometer(v)
+		return nil
+	case refuel.FieldRefueledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefueledAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Refuel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefuelMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, refuel.FieldTenantID)
+	}
+	if m.addamount != nil {
+		fields = append(fields, refuel.FieldAmount)
+	}
+	if m.addcost != nil {
+		fields = append(fields, refuel.FieldCost)
+	}
+	if m.addodometer != nil {
+		fields = append(fields, refuel.FieldOdometer)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefuelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case refuel.FieldTenantID:
+		return m.AddedTenantID()
+	case refuel.FieldAmount:
+		return m.AddedAmount()
+	case refuel.FieldCost:
+		return m.AddedCost()
+	case refuel.FieldOdometer:
+		return m.AddedOdometer()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefuelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case refuel.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case refuel.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case refuel.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	case refuel.FieldOdometer:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOdometer(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Refuel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefuelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refuel.FieldTenantID) {
+		fields = append(fields, refuel.FieldTenantID)
+	}
+	if m.FieldCleared(refuel.FieldCarID) {
+		fields = append(fields, refuel.FieldCarID)
+	}
+	if m.FieldCleared(refuel.FieldAmount) {
+		fields = append(fields, refuel.FieldAmount)
+	}
+	if m.FieldCleared(refuel.FieldUnit) {
+		fields = append(fields, refuel.FieldUnit)
+	}
+	if m.FieldCleared(refuel.FieldCost) {
+		fields = append(fields, refuel.FieldCost)
+	}
+	if m.FieldCleared(refuel.FieldStation) {
+		fields = append(fields, refuel.FieldStation)
+	}
+	if m.FieldCleared(refuel.FieldOdometer) {
+		fields = append(fields, refuel.FieldOdometer)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefuelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefuelMutation) ClearField(name string) error {
+	switch name {
+	case refuel.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case refuel.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case refuel.FieldAmount:
+		m.ClearAmount()
+		return nil
+	case refuel.FieldUnit:
+		m.ClearUnit()
+		return nil
+	case refuel.FieldCost:
+		m.ClearCost()
+		return nil
+	case refuel.FieldStation:
+		m.ClearStation()
+		return nil
+	case refuel.FieldOdometer:
+		m.ClearOdometer()
+		return nil
+	}
+	return fmt.Errorf("unknown Refuel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefuelMutation) ResetField(name string) error {
+	switch name {
+	case refuel.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case refuel.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case refuel.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case refuel.FieldUnit:
+		m.ResetUnit()
+		return nil
+	case refuel.FieldCost:
+		m.ResetCost()
+		return nil
+	case refuel.FieldStation:
+		m.ResetStation()
+		return nil
+	case refuel.FieldOdometer:
+		m.ResetOdometer()
+		return nil
+	case refuel.FieldRefueledAt:
+		m.ResetRefueledAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Refuel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefuelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, refuel.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefuelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case refuel.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefuelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefuelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefuelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, refuel.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefuelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case refuel.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefuelMutation) ClearEdge(name string) error {
+	switch name {
+	case refuel.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Refuel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefuelMutation) ResetEdge(name string) error {
+	switch name {
+	case refuel.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Refuel edge %s", name)
+}
+
+// ReservationMutation represents an operation that mutates the Reservation nodes in the graph.
+type ReservationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	user_id       *int64
+	adduser_id    *int64
+	start_at      *time.Time
+	end_at        *time.Time
+	purpose       *string
+	status        *string
+	cancelled_at  *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	car           *int64
+	clearedcar    bool
+	done          bool
+	oldValue      func(context.Context) (*Reservation, error)
+	predicates    []predicate.Reservation
+}
+
+var _ ent.Mutation = (*ReservationMutation)(nil)
+
+// reservationOption allows management of the mutation configuration using functional options.
+type reservationOption func(*ReservationMutation)
+
+// newReservationMutation creates new mutation for the Reservation entity.
+func newReservationMutation(c config, op Op, opts ...reservationOption) *ReservationMutation {
+	m := &ReservationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReservation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReservationID sets the ID field of the mutation.
+func withReservationID(id int64) reservationOption {
+	return func(m *ReservationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Reservation
+		)
+		m.oldValue = func(ctx context.Context) (*Reservation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Reservation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReservation sets the old Reservation of the mutation.
+func withReservation(node *Reservation) reservationOption {
+	return func(m *ReservationMutation) {
+		m.oldValue = func(context.Context) (*Reservation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReservationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReservationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Reservation entities.
+func (m *ReservationMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReservationMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReservationMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Reservation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ReservationMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ReservationMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *ReservationMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *ReservationMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *ReservationMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[reservation.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *ReservationMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[reservation.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ReservationMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, reservation.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *ReservationMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *ReservationMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *ReservationMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[reservation.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *ReservationMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[reservation.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *ReservationMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, reservation.FieldCarID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReservationMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReservationMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *ReservationMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *ReservationMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ReservationMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[reservation.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ReservationMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[reservation.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReservationMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, reservation.FieldUserID)
+}
+
+// SetStartAt sets the "start_at" field.
+func (m *ReservationMutation) SetStartAt(t time.Time) {
+	m.start_at = &t
+}
+
+// StartAt returns the value of the "start_at" field in the mutation.
+func (m *ReservationMutation) StartAt() (r time.Time, exists bool) {
+	v := m.start_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAt returns the old "start_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldStartAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+	}
+	return oldValue.StartAt, nil
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (m *ReservationMutation) ClearStartAt() {
+	m.start_at = nil
+	m.clearedFields[reservation.FieldStartAt] = struct{}{}
+}
+
+// StartAtCleared returns if the "start_at" field was cleared in this mutation.
+func (m *ReservationMutation) StartAtCleared() bool {
+	_, ok := m.clearedFields[reservation.FieldStartAt]
+	return ok
+}
+
+// ResetStartAt resets all changes to the "start_at" field.
+func (m *ReservationMutation) ResetStartAt() {
+	m.start_at = nil
+	delete(m.clearedFields, reservation.FieldStartAt)
+}
+
+// SetEndAt sets the "end_at" field.
+func (m *ReservationMutation) SetEndAt(t time.Time) {
+	m.end_at = &t
+}
+
+// EndAt returns the value of the "end_at" field in the mutation.
+func (m *ReservationMutation) EndAt() (r time.Time, exists bool) {
+	v := m.end_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndAt returns the old "end_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldEndAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+	}
+	return oldValue.EndAt, nil
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (m *ReservationMutation) ClearEndAt() {
+	m.end_at = nil
+	m.clearedFields[reservation.FieldEndAt] = struct{}{}
+}
+
+// EndAtCleared returns if the "end_at" field was cleared in this mutation.
+func (m *ReservationMutation) EndAtCleared() bool {
+	_, ok := m.clearedFields[reservation.FieldEndAt]
+	return ok
+}
+
+// ResetEndAt resets all changes to the "end_at" field.
+func (m *ReservationMutation) ResetEndAt() {
+	m.end_at = nil
+	delete(m.clearedFields, reservation.FieldEndAt)
+}
+
+// SetPurpose sets the "purpose" field.
+func (m *ReservationMutation) SetPurpose(s string) {
+	m.purpose = &s
+}
+
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *ReservationMutation) Purpose() (r string, exists bool) {
+	v := m.purpose
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurpose returns the old "purpose" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldPurpose(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+	}
+	return oldValue.Purpose, nil
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (m *ReservationMutation) ClearPurpose() {
+	m.purpose = nil
+	m.clearedFields[reservation.FieldPurpose] = struct{}{}
+}
+
+// PurposeCleared returns if the "purpose" field was cleared in this mutation.
+func (m *ReservationMutation) PurposeCleared() bool {
+	_, ok := m.clearedFields[reservation.FieldPurpose]
+	return ok
+}
+
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *ReservationMutation) ResetPurpose() {
+	m.purpose = nil
+	delete(m.clearedFields, reservation.FieldPurpose)
+}
+
+// SetStatus sets the "status" field.
+func (m *ReservationMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReservationMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReservationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (m *ReservationMutation) SetCancelledAt(t time.Time) {
+	m.cancelled_at = &t
+}
+
+// CancelledAt returns the value of the "cancelled_at" field in the mutation.
+func (m *ReservationMutation) CancelledAt() (r time.Time, exists bool) {
+	v := m.cancelled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelledAt returns the old "cancelled_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldCancelledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelledAt: %w", err)
+	}
+	return oldValue.CancelledAt, nil
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (m *ReservationMutation) ClearCancelledAt() {
+	m.cancelled_at = nil
+	m.clearedFields[reservation.FieldCancelledAt] = struct{}{}
+}
+
+// CancelledAtCleared returns if the "cancelled_at" field was cleared in this mutation.
+func (m *ReservationMutation) CancelledAtCleared() bool {
+	_, ok := m.clearedFields[reservation.FieldCancelledAt]
+	return ok
+}
+
+// ResetCancelledAt resets all changes to the "cancelled_at" field.
+func (m *ReservationMutation) ResetCancelledAt() {
+	m.cancelled_at = nil
+	delete(m.clearedFields, reservation.FieldCancelledAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReservationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReservationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReservationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *ReservationMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *ReservationMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *ReservationMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *ReservationMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the ReservationMutation builder.
+func (m *ReservationMutation) Where(ps ...predicate.Reservation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ReservationMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Reservation).
+func (m *ReservationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReservationMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.tenant_id != nil {
+		fields = append(fields, reservation.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, reservation.FieldCarID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, reservation.FieldUserID)
+	}
+	if m.start_at != nil {
+		fields = append(fields, reservation.FieldStartAt)
+	}
+	if m.end_at != nil {
+		fields = append(fields, reservation.FieldEndAt)
+	}
+	if m.purpose != nil {
+		fields = append(fields, reservation.FieldPurpose)
+	}
+	if m.status != nil {
+		fields = append(fields, reservation.FieldStatus)
+	}
+	if m.cancelled_at != nil {
+		fields = append(fields, reservation.FieldCancelledAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, reservation.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReservationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reservation.FieldTenantID:
+		return m.TenantID()
+	case reservation.FieldCarID:
+		return m.CarID()
+	case reservation.FieldUserID:
+		return m.UserID()
+	case reservation.FieldStartAt:
+		return m.StartAt()
+	case reservation.FieldEndAt:
+		return m.EndAt()
+	case reservation.FieldPurpose:
+		return m.Purpose()
+	case reservation.FieldStatus:
+		return m.Status()
+	case reservation.FieldCancelledAt:
+		return m.CancelledAt()
+	case reservation.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReservationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reservation.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case reservation.FieldCarID:
+		return m.OldCarID(ctx)
+	case reservation.FieldUserID:
+		return m.OldUserID(ctx)
+	case reservation.FieldStartAt:
+		return m.OldStartAt(ctx)
+	case reservation.FieldEndAt:
+		return m.OldEndAt(ctx)
+	case reservation.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case reservation.FieldStatus:
+		return m.OldStatus(ctx)
+	case reservation.FieldCancelledAt:
+		return m.OldCancelledAt(ctx)
+	case reservation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Reservation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReservationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reservation.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case reservation.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case reservation.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case reservation.FieldStartAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartAt(v)
+		return nil
+	case reservation.FieldEndAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAt(v)
+		return nil
+	case reservation.FieldPurpose:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurpose(v)
+		return nil
+	case reservation.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case reservation.FieldCancelledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelledAt(v)
+		return nil
+	case reservation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReservationMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, reservation.FieldTenantID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, reservation.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReservationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reservation.FieldTenantID:
+		return m.AddedTenantID()
+	case reservation.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReservationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reservation.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case reservation.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReservationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reservation.FieldTenantID) {
+		fields = append(fields, reservation.FieldTenantID)
+	}
+	if m.FieldCleared(reservation.FieldCarID) {
+		fields = append(fields, reservation.FieldCarID)
+	}
+	if m.FieldCleared(reservation.FieldUserID) {
+		fields = append(fields, reservation.FieldUserID)
+	}
+	if m.FieldCleared(reservation.FieldStartAt) {
+		fields = append(fields, reservation.FieldStartAt)
+	}
+	if m.FieldCleared(reservation.FieldEndAt) {
+		fields = append(fields, reservation.FieldEndAt)
+	}
+	if m.FieldCleared(reservation.FieldPurpose) {
+		fields = append(fields, reservation.FieldPurpose)
+	}
+	if m.FieldCleared(reservation.FieldCancelledAt) {
+		fields = append(fields, reservation.FieldCancelledAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReservationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReservationMutation) ClearField(name string) error {
+	switch name {
+	case reservation.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case reservation.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case reservation.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case reservation.FieldStartAt:
+		m.ClearStartAt()
+		return nil
+	case reservation.FieldEndAt:
+		m.ClearEndAt()
+		return nil
+	case reservation.FieldPurpose:
+		m.ClearPurpose()
+		return nil
+	case reservation.FieldCancelledAt:
+		m.ClearCancelledAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReservationMutation) ResetField(name string) error {
+	switch name {
+	case reservation.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case reservation.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case reservation.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case reservation.FieldStartAt:
+		m.ResetStartAt()
+		return nil
+	case reservation.FieldEndAt:
+		m.ResetEndAt()
+		return nil
+	case reservation.FieldPurpose:
+		m.ResetPurpose()
+		return nil
+	case reservation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case reservation.FieldCancelledAt:
+		m.ResetCancelledAt()
+		return nil
+	case reservation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReservationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, reservation.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReservationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reservation.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReservationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReservationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReservationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, reservation.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReservationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reservation.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReservationMutation) ClearEdge(name string) error {
+	switch name {
+	case reservation.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReservationMutation) ResetEdge(name string) error {
+	switch name {
+	case reservation.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Reservation edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	tenant_id     *int64
+	addtenant_id  *int64
+	name          *string
+	clearedFields map[string]struct{}
+	cars          map[int64]struct{}
+	removedcars   map[int64]struct{}
+	clearedcars   bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id int64) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *TagMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TagMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *TagMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *TagMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *TagMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[tag.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *TagMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[tag.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TagMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, tag.FieldTenantID)
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by ids.
+func (m *TagMutation) AddCarIDs(ids ...int64) {
+	if m.cars == nil {
+		m.cars = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.cars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCars clears the "cars" edge to the Car entity.
+func (m *TagMutation) ClearCars() {
+	m.clearedcars = true
+}
+
+// CarsCleared reports if the "cars" edge to the Car entity was cleared.
+func (m *TagMutation) CarsCleared() bool {
+	return m.clearedcars
+}
+
+// RemoveCarIDs removes the "cars" edge to the Car entity by IDs.
+func (m *TagMutation) RemoveCarIDs(ids ...int64) {
+	if m.removedcars == nil {
+		m.removedcars = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.cars, ids[i])
+		m.removedcars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCars returns the removed IDs of the "cars" edge to the Car entity.
+func (m *TagMutation) RemovedCarsIDs() (ids []int64) {
+	for id := range m.removedcars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CarsIDs returns the "cars" edge IDs in the mutation.
+func (m *TagMutation) CarsIDs() (ids []int64) {
+	for id := range m.cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCars resets all changes to the "cars" edge.
+func (m *TagMutation) ResetCars() {
+	m.cars = nil
+	m.clearedcars = false
+	m.removedcars = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.tenant_id != nil {
+		fields = append(fields, tag.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldTenantID:
+		return m.TenantID()
+	case tag.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case tag.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, tag.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tag.FieldTenantID) {
+		fields = append(fields, tag.FieldTenantID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	switch name {
+	case tag.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cars != nil {
+		edges = append(edges, tag.EdgeCars)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.cars))
+		for id := range m.cars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcars != nil {
+		edges = append(edges, tag.EdgeCars)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.removedcars))
+		for id := range m.removedcars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcars {
+		edges = append(edges, tag.EdgeCars)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeCars:
+		return m.clearedcars
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeCars:
+		m.ResetCars()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// TradeRecordMutation represents an operation that mutates the TradeRecord nodes in the graph.
+type TradeRecordMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int64
+	tenant_id          *int64
+	addtenant_id       *int64
+	model_id           *int64
+	addmodel_id        *int64
+	seller_id          *int64
+	addseller_id       *int64
+	buyer_id           *int64
+	addbuyer_id        *int64
+	price              *float64
+	addprice           *float64
+	estimated_value    *float64
+	addestimated_value *float64
+	mileage            *int64
+	addmileage         *int64
+	age_months         *int
+	addage_months      *int
+	traded_at          *time.Time
+	clearedFields      map[string]struct{}
+	car                *int64
+	clearedcar         bool
+	done               bool
+	oldValue           func(context.Context) (*TradeRecord, error)
+	predicates         []predicate.TradeRecord
+}
+
+var _ ent.Mutation = (*TradeRecordMutation)(nil)
+
+// traderecordOption allows management of the mutation configuration using functional options.
+type traderecordOption func(*TradeRecordMutation)
+
+// newTradeRecordMutation creates new mutation for the TradeRecord entity.
+func newTradeRecordMutation(c config, op Op, opts ...traderecordOption) *TradeRecordMutation {
+	m := &TradeRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTradeRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTradeRecordID sets the ID field of the mutation.
+func withTradeRecordID(id int64) traderecordOption {
+	return func(m *TradeRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TradeRecord
+		)
+		m.oldValue = func(ctx context.Context) (*TradeRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TradeRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTradeRecord sets the old TradeRecord of the mutation.
+func withTradeRecord(node *TradeRecord) traderecordOption {
+	return func(m *TradeRecordMutation) {
+		m.oldValue = func(context.Context) (*TradeRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TradeRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TradeRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TradeRecord entities.
+func (m *TradeRecordMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TradeRecordMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TradeRecordMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TradeRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *TradeRecordMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TradeRecordMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *TradeRecordMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *TradeRecordMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *TradeRecordMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[traderecord.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *TradeRecordMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[traderecord.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TradeRecordMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, traderecord.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *TradeRecordMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *TradeRecordMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *TradeRecordMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[traderecord.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *TradeRecordMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[traderecord.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *TradeRecordMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, traderecord.FieldCarID)
+}
+
+// SetModelID sets the "model_id" field.
+func (m *TradeRecordMutation) SetModelID(i int64) {
+	m.model_id = &i
+	m.addmodel_id = nil
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *TradeRecordMutation) ModelID() (r int64, exists bool) {
+	v := m.model_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldModelID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
+}
+
+// AddModelID adds i to the "model_id" field.
+func (m *TradeRecordMutation) AddModelID(i int64) {
+	if m.addmodel_id != nil {
+		*m.addmodel_id += i
+	} else {
+		m.addmodel_id = &i
+	}
+}
+
+// AddedModelID returns the value that was added to the "model_id" field in this mutation.
+func (m *TradeRecordMutation) AddedModelID() (r int64, exists bool) {
+	v := m.addmodel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (m *TradeRecordMutation) ClearModelID() {
+	m.model_id = nil
+	m.addmodel_id = nil
+	m.clearedFields[traderecord.FieldModelID] = struct{}{}
+}
+
+// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
+func (m *TradeRecordMutation) ModelIDCleared() bool {
+	_, ok := m.clearedFields[traderecord.FieldModelID]
+	return ok
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *TradeRecordMutation) ResetModelID() {
+	m.model_id = nil
+	m.addmodel_id = nil
+	delete(m.clearedFields, traderecord.FieldModelID)
+}
+
+// SetSellerID sets the "seller_id" field.
+func (m *TradeRecordMutation) SetSellerID(i int64) {
+	m.seller_id = &i
+	m.addseller_id = nil
+}
+
+// SellerID returns the value of the "seller_id" field in the mutation.
+func (m *TradeRecordMutation) SellerID() (r int64, exists bool) {
+	v := m.seller_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellerID returns the old "seller_id" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldSellerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellerID: %w", err)
+	}
+	return oldValue.SellerID, nil
+}
+
+// AddSellerID adds i to the "seller_id" field.
+func (m *TradeRecordMutation) AddSellerID(i int64) {
+	if m.addseller_id != nil {
+		*m.addseller_id += i
+	} else {
+		m.addseller_id = &i
+	}
+}
+
+// AddedSellerID returns the value that was added to the "seller_id" field in this mutation.
+func (m *TradeRecordMutation) AddedSellerID() (r int64, exists bool) {
+	v := m.addseller_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSellerID clears the value of the "seller_id" field.
+func (m *TradeRecordMutation) ClearSellerID() {
+	m.seller_id = nil
+	m.addseller_id = nil
+	m.clearedFields[traderecord.FieldSellerID] = struct{}{}
+}
+
+// SellerIDCleared returns if the "seller_id" field was cleared in this mutation.
+func (m *TradeRecordMutation) SellerIDCleared() bool {
+	_, ok := m.clearedFields[traderecord.FieldSellerID]
+	return ok
+}
+
+// ResetSellerID resets all changes to the "seller_id" field.
+func (m *TradeRecordMutation) ResetSellerID() {
+	m.seller_id = nil
+	m.addseller_id = nil
+	delete(m.clearedFields, traderecord.FieldSellerID)
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (m *TradeRecordMutation) SetBuyerID(i int64) {
+	m.buyer_id = &i
+	m.addbuyer_id = nil
+}
+
+// BuyerID returns the value of the "buyer_id" field in the mutation.
+func (m *TradeRecordMutation) BuyerID() (r int64, exists bool) {
+	v := m.buyer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerID returns the old "buyer_id" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldBuyerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerID: %w", err)
+	}
+	return oldValue.BuyerID, nil
+}
+
+// AddBuyerID adds i to the "buyer_id" field.
+func (m *TradeRecordMutation) AddBuyerID(i int64) {
+	if m.addbuyer_id != nil {
+		*m.addbuyer_id += i
+	} else {
+		m.addbuyer_id = &i
+	}
+}
+
+// AddedBuyerID returns the value that was added to the "buyer_id" field in this mutation.
+func (m *TradeRecordMutation) AddedBuyerID() (r int64, exists bool) {
+	v := m.addbuyer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBuyerID clears the value of the "buyer_id" field.
+func (m *TradeRecordMutation) ClearBuyerID() {
+	m.buyer_id = nil
+	m.addbuyer_id = nil
+	m.clearedFields[traderecord.FieldBuyerID] = struct{}{}
+}
+
+// BuyerIDCleared returns if the "buyer_id" field was cleared in this mutation.
+func (m *TradeRecordMutation) BuyerIDCleared() bool {
+	_, ok := m.clearedFields[traderecord.FieldBuyerID]
+	return ok
+}
+
+// ResetBuyerID resets all changes to the "buyer_id" field.
+func (m *TradeRecordMutation) ResetBuyerID() {
+	m.buyer_id = nil
+	m.addbuyer_id = nil
+	delete(m.clearedFields, traderecord.FieldBuyerID)
+}
+
+// SetPrice sets the "price" field.
+func (m *TradeRecordMutation) SetPrice(f float64) {
+	m.price = &f
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *TradeRecordMutation) Price() (r float64, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds f to the "price" field.
+func (m *TradeRecordMutation) AddPrice(f float64) {
+	if m.addprice != nil {
+		*m.addprice += f
+	} else {
+		m.addprice = &f
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *TradeRecordMutation) AddedPrice() (r float64, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPrice clears the value of the "price" field.
+func (m *TradeRecordMutation) ClearPrice() {
+	m.price = nil
+	m.addprice = nil
+	m.clearedFields[traderecord.FieldPrice] = struct{}{}
+}
+
+// PriceCleared returns if the "price" field was cleared in this mutation.
+func (m *TradeRecordMutation) PriceCleared() bool {
+	_, ok := m.clearedFields[traderecord.FieldPrice]
+	return ok
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *TradeRecordMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+	delete(m.clearedFields, traderecord.FieldPrice)
+}
+
+// SetEstimatedValue sets the "estimated_value" field.
+func (m *TradeRecordMutation) SetEstimatedValue(f float64) {
+	m.estimated_value = &f
+	m.addestimated_value = nil
+}
+
+// EstimatedValue returns the value of the "estimated_value" field in the mutation.
+func (m *TradeRecordMutation) EstimatedValue() (r float64, exists bool) {
+	v := m.estimated_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEstimatedValue returns the old "estimated_value" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldEstimatedValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEstimatedValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEstimatedValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEstimatedValue: %w", err)
+	}
+	return oldValue.EstimatedValue, nil
+}
+
+// AddEstimatedValue adds f to the "estimated_value" field.
+func (m *TradeRecordMutation) AddEstimatedValue(f float64) {
+	if m.addestimated_value != nil {
+		*m.addestimated_value += f
+	} else {
+		m.addestimated_value = &f
+	}
+}
+
+// AddedEstimatedValue returns the value that was added to the "estimated_value" field in this mutation.
+func (m *TradeRecordMutation) AddedEstimatedValue() (r float64, exists bool) {
+	v := m.addestimated_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEstimatedValue clears the value of the "estimated_value" field.
+func (m *TradeRecordMutation) ClearEstimatedValue() {
+	m.estimated_value = nil
+	m.addestimated_value = nil
+	m.clearedFields[traderecord.FieldEstimatedValue] = struct{}{}
+}
+
+// EstimatedValueCleared returns if the "estimated_value" field was cleared in this mutation.
+func (m *TradeRecordMutation) EstimatedValueCleared() bool {
+	_, ok := m.clearedFields[traderecord.FieldEstimatedValue]
+	return ok
+}
+
+// ResetEstimatedValue resets all changes to the "estimated_value" field.
+func (m *TradeRecordMutation) ResetEstimatedValue() {
+	m.estimated_value = nil
+	m.addestimated_value = nil
+	delete(m.clearedFields, traderecord.FieldEstimatedValue)
+}
+
+// SetMileage sets the "mileage" field.
+func (m *TradeRecordMutation) SetMileage(i int64) {
+	m.mileage = &i
+	m.addmileage = nil
+}
+
+// Mileage returns the value of the "mileage" field in the mutation.
+func (m *TradeRecordMutation) Mileage() (r int64, exists bool) {
+	v := m.mileage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMileage returns the old "mileage" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldMileage(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMileage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMileage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMileage: %w", err)
+	}
+	return oldValue.Mileage, nil
+}
+
+// AddMileage adds i to the "mileage" field.
+func (m *TradeRecordMutation) AddMileage(i int64) {
+	if m.addmileage != nil {
+		*m.addmileage += i
+	} else {
+		m.addmileage = &i
+	}
+}
+
+// AddedMileage returns the value that was added to the "mileage" field in this mutation.
+func (m *TradeRecordMutation) AddedMileage() (r int64, exists bool) {
+	v := m.addmileage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMileage clears the value of the "mileage" field.
+func (m *TradeRecordMutation) ClearMileage() {
+	m.mileage = nil
+	m.addmileage = nil
+	m.clearedFields[traderecord.FieldMileage] = struct{}{}
+}
+
+// MileageCleared returns if the "mileage" field was cleared in this mutation.
+func (m *TradeRecordMutation) MileageCleared() bool {
+	_, ok := m.clearedFields[traderecord.FieldMileage]
+	return ok
+}
+
+// ResetMileage resets all changes to the "mileage" field.
+func (m *TradeRecordMutation) ResetMileage() {
+	m.mileage = nil
+	m.addmileage = nil
+	delete(m.clearedFields, traderecord.FieldMileage)
+}
+
+// SetAgeMonths sets the "age_months" field.
+func (m *TradeRecordMutation) SetAgeMonths(i int) {
+	m.age_months = &i
+	m.addage_months = nil
+}
+
+// AgeMonths returns the value of the "age_months" field in the mutation.
+func (m *TradeRecordMutation) AgeMonths() (r int, exists bool) {
+	v := m.age_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgeMonths returns the old "age_months" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldAgeMonths(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgeMonths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgeMonths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgeMonths: %w", err)
+	}
+	return oldValue.AgeMonths, nil
+}
+
+// AddAgeMonths adds i to the "age_months" field.
+func (m *TradeRecordMutation) AddAgeMonths(i int) {
+	if m.addage_months != nil {
+		*m.addage_months += i
+	} else {
+		m.addage_months = &i
+	}
+}
+
+// AddedAgeMonths returns the value that was added to the "age_months" field in this mutation.
+func (m *TradeRecordMutation) AddedAgeMonths() (r int, exists bool) {
+	v := m.addage_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAgeMonths clears the value of the "age_months" field.
+func (m *TradeRecordMutation) ClearAgeMonths() {
+	m.age_months = nil
+	m.addage_months = nil
+	m.clearedFields[traderecord.FieldAgeMonths] = struct{}{}
+}
+
+// AgeMonthsCleared returns if the "age_months" field was cleared in this mutation.
+func (m *TradeRecordMutation) AgeMonthsCleared() bool {
+	_, ok := m.clearedFields[traderecord.FieldAgeMonths]
+	return ok
+}
+
+// ResetAgeMonths resets all changes to the "age_months" field.
+func (m *TradeRecordMutation) ResetAgeMonths() {
+	m.age_months = nil
+	m.addage_months = nil
+	delete(m.clearedFields, traderecord.FieldAgeMonths)
+}
+
+// SetTradedAt sets the "traded_at" field.
+func (m *TradeRecordMutation) SetTradedAt(t time.Time) {
+	m.traded_at = &t
+}
+
+// TradedAt returns the value of the "traded_at" field in the mutation.
+func (m *TradeRecordMutation) TradedAt() (r time.Time, exists bool) {
+	v := m.traded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTradedAt returns the old "traded_at" field's value of the TradeRecord entity.
+// If the TradeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeRecordMutation) OldTradedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTradedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTradedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTradedAt: %w", err)
+	}
+	return oldValue.TradedAt, nil
+}
+
+// ResetTradedAt resets all changes to the "traded_at" field.
+func (m *TradeRecordMutation) ResetTradedAt() {
+	m.traded_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *TradeRecordMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *TradeRecordMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *TradeRecordMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *TradeRecordMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the TradeRecordMutation builder.
+func (m *TradeRecordMutation) Where(ps ...predicate.TradeRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TradeRecordMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (TradeRecord).
+func (m *TradeRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TradeRecordMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.tenant_id != nil {
+		fields = append(fields, traderecord.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, traderecord.FieldCarID)
+	}
+	if m.model_id != nil {
+		fields = append(fields, traderecord.FieldModelID)
+	}
+	if m.seller_id != nil {
+		fields = append(fields, traderecord.FieldSellerID)
+	}
+	if m.buyer_id != nil {
+		fields = append(fields, traderecord.FieldBuyerID)
+	}
+	if m.price != nil {
+		fields = append(fields, traderecord.FieldPrice)
+	}
+	if m.estimated_value != nil {
+		fields = append(fields, traderecord.FieldEstimatedValue)
+	}
+	if m.mileage != nil {
+		fields = append(fields, traderecord.FieldMileage)
+	}
+	if m.age_months != nil {
+		fields = append(fields, traderecord.FieldAgeMonths)
+	}
+	if m.traded_at != nil {
+		fields = append(fields, traderecord.FieldTradedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TradeRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case traderecord.FieldTenantID:
+		return m.TenantID()
+	case traderecord.FieldCarID:
+		return m.CarID()
+	case traderecord.FieldModelID:
+		return m.ModelID()
+	case traderecord.FieldSellerID:
+		return m.SellerID()
+	case traderecord.FieldBuyerID:
+		return m.BuyerID()
+	case traderecord.FieldPrice:
+		return m.Price()
+	case traderecord.FieldEstimatedValue:
+		return m.EstimatedValue()
+	case traderecord.FieldMileage:
+		return m.Mileage()
+	case traderecord.FieldAgeMonths:
+		return m.AgeMonths()
+	case traderecord.FieldTradedAt:
+		return m.TradedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TradeRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case traderecord.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case traderecord.FieldCarID:
+		return m.OldCarID(ctx)
+	case traderecord.FieldModelID:
+		return m.OldModelID(ctx)
+	case traderecord.FieldSellerID:
+		return m.OldSellerID(ctx)
+	case traderecord.FieldBuyerID:
+		return m.OldBuyerID(ctx)
+	case traderecord.FieldPrice:
+		return m.OldPrice(ctx)
+	case traderecord.FieldEstimatedValue:
+		return m.OldEstimatedValue(ctx)
+	case traderecord.FieldMileage:
+		return m.OldMileage(ctx)
+	case traderecord.FieldAgeMonths:
+		return m.OldAgeMonths(ctx)
+	case traderecord.FieldTradedAt:
+		return m.OldTradedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TradeRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TradeRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case traderecord.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case traderecord.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case traderecord.FieldModelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case traderecord.FieldSellerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellerID(v)
+		return nil
+	case traderecord.FieldBuyerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerID(v)
+		return nil
+	case traderecord.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case traderecord.FieldEstimatedValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEstimatedValue(v)
+		return nil
+	case traderecord.FieldMileage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMileage(v)
+		return nil
+	case traderecord.FieldAgeMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgeMonths(v)
+		return nil
+	case traderecord.FieldTradedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTradedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TradeRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TradeRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, traderecord.FieldTenantID)
+	}
+	if m.addmodel_id != nil {
+		fields = append(fields, traderecord.FieldModelID)
+	}
+	if m.addseller_id != nil {
+		fields = append(fields, traderecord.FieldSellerID)
+	}
+	if m.addbuyer_id != nil {
+		fields = append(fields, traderecord.FieldBuyerID)
+	}
+	if m.addprice != nil {
+		fields = append(fields, traderecord.FieldPrice)
+	}
+	if m.addestimated_value != nil {
+		fields = append(fields, traderecord.FieldEstimatedValue)
+	}
+	if m.addmileage != nil {
+		fields = append(fields, traderecord.FieldMileage)
+	}
+	if m.addage_months != nil {
+		fields = append(fields, traderecord.FieldAgeMonths)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TradeRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case traderecord.FieldTenantID:
+		return m.AddedTenantID()
+	case traderecord.FieldModelID:
+		return m.AddedModelID()
+	case traderecord.FieldSellerID:
+		return m.AddedSellerID()
+	case traderecord.FieldBuyerID:
+		return m.AddedBuyerID()
+	case traderecord.FieldPrice:
+		return m.AddedPrice()
+	case traderecord.FieldEstimatedValue:
+		return m.AddedEstimatedValue()
+	case traderecord.FieldMileage:
+		return m.AddedMileage()
+	case traderecord.FieldAgeMonths:
+		return m.AddedAgeMonths()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TradeRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case traderecord.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case traderecord.FieldModelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModelID(v)
+		return nil
+	case traderecord.FieldSellerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSellerID(v)
+		return nil
+	case traderecord.FieldBuyerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuyerID(v)
+		return nil
+	case traderecord.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case traderecord.FieldEstimatedValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEstimatedValue(v)
+		return nil
+	case traderecord.FieldMileage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMileage(v)
+		return nil
+	case traderecord.FieldAgeMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAgeMonths(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TradeRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TradeRecordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(traderecord.FieldTenantID) {
+		fields = append(fields, traderecord.FieldTenantID)
+	}
+	if m.FieldCleared(traderecord.FieldCarID) {
+		fields = append(fields, traderecord.FieldCarID)
+	}
+	if m.FieldCleared(traderecord.FieldModelID) {
+		fields = append(fields, traderecord.FieldModelID)
+	}
+	if m.FieldCleared(traderecord.FieldSellerID) {
+		fields = append(fields, traderecord.FieldSellerID)
+	}
+	if m.FieldCleared(traderecord.FieldBuyerID) {
+		fields = append(fields, traderecord.FieldBuyerID)
+	}
+	if m.FieldCleared(traderecord.FieldPrice) {
+		fields = append(fields, traderecord.FieldPrice)
+	}
+	if m.FieldCleared(traderecord.FieldEstimatedValue) {
+		fields = append(fields, traderecord.FieldEstimatedValue)
+	}
+	if m.FieldCleared(traderecord.FieldMileage) {
+		fields = append(fields, traderecord.FieldMileage)
+	}
+	if m.FieldCleared(traderecord.FieldAgeMonths) {
+		fields = append(fields, traderecord.FieldAgeMonths)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TradeRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TradeRecordMutation) ClearField(name string) error {
+	switch name {
+	case traderecord.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case traderecord.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case traderecord.FieldModelID:
+		m.ClearModelID()
+		return nil
+	case traderecord.FieldSellerID:
+		m.ClearSellerID()
+		return nil
+	case traderecord.FieldBuyerID:
+		m.ClearBuyerID()
+		return nil
+	case traderecord.FieldPrice:
+		m.ClearPrice()
+		return nil
+	case traderecord.FieldEstimatedValue:
+		m.ClearEstimatedValue()
+		return nil
+	case traderecord.FieldMileage:
+		m.ClearMileage()
+		return nil
+	case traderecord.FieldAgeMonths:
+		m.ClearAgeMonths()
+		return nil
+	}
+	return fmt.Errorf("unknown TradeRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TradeRecordMutation) ResetField(name string) error {
+	switch name {
+	case traderecord.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case traderecord.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case traderecord.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case traderecord.FieldSellerID:
+		m.ResetSellerID()
+		return nil
+	case traderecord.FieldBuyerID:
+		m.ResetBuyerID()
+		return nil
+	case traderecord.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case traderecord.FieldEstimatedValue:
+		m.ResetEstimatedValue()
+		return nil
+	case traderecord.FieldMileage:
+		m.ResetMileage()
+		return nil
+	case traderecord.FieldAgeMonths:
+		m.ResetAgeMonths()
+		return nil
+	case traderecord.FieldTradedAt:
+		m.ResetTradedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TradeRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TradeRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, traderecord.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TradeRecordMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case traderecord.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TradeRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TradeRecordMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TradeRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, traderecord.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TradeRecordMutation) EdgeCleared(name string) bool {
+	switch name {
+	case traderecord.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TradeRecordMutation) ClearEdge(name string) error {
+	switch name {
+	case traderecord.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown TradeRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TradeRecordMutation) ResetEdge(name string) error {
+	switch name {
+	case traderecord.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown TradeRecord edge %s", name)
+}
+
+// TransferMutation represents an operation that mutates the Transfer nodes in the graph.
+type TransferMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int64
+	tenant_id        *int64
+	addtenant_id     *int64
+	seller_id        *int64
+	addseller_id     *int64
+	buyer_id         *int64
+	addbuyer_id      *int64
+	status           *string
+	require_approval *bool
+	approved_by      *int64
+	addapproved_by   *int64
+	expires_at       *time.Time
+	accepted_at      *time.Time
+	completed_at     *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	car              *int64
+	clearedcar       bool
+	done             bool
+	oldValue         func(context.Context) (*Transfer, error)
+	predicates       []predicate.Transfer
+}
+
+var _ ent.Mutation = (*TransferMutation)(nil)
+
+// transferOption allows management of the mutation configuration using functional options.
+type transferOption func(*TransferMutation)
+
+// newTransferMutation creates new mutation for the Transfer entity.
+func newTransferMutation(c config, op Op, opts ...transferOption) *TransferMutation {
+	m := &TransferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransfer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransferID sets the ID field of the mutation.
+func withTransferID(id int64) transferOption {
+	return func(m *TransferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transfer
+		)
+		m.oldValue = func(ctx context.Context) (*Transfer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transfer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransfer sets the old Transfer of the mutation.
+func withTransfer(node *Transfer) transferOption {
+	return func(m *TransferMutation) {
+		m.oldValue = func(context.Context) (*Transfer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Transfer entities.
+func (m *TransferMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransferMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransferMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *TransferMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TransferMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *TransferMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *TransferMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *TransferMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[transfer.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *TransferMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TransferMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, transfer.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *TransferMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *TransferMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *TransferMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[transfer.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *TransferMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *TransferMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, transfer.FieldCarID)
+}
+
+// SetSellerID sets the "seller_id" field.
+func (m *TransferMutation) SetSellerID(i int64) {
+	m.seller_id = &i
+	m.addseller_id = nil
+}
+
+// SellerID returns the value of the "seller_id" field in the mutation.
+func (m *TransferMutation) SellerID() (r int64, exists bool) {
+	v := m.seller_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellerID returns the old "seller_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldSellerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellerID: %w", err)
+	}
+	return oldValue.SellerID, nil
+}
+
+// AddSellerID adds i to the "seller_id" field.
+func (m *TransferMutation) AddSellerID(i int64) {
+	if m.addseller_id != nil {
+		*m.addseller_id += i
+	} else {
+		m.addseller_id = &i
+	}
+}
+
+// AddedSellerID returns the value that was added to the "seller_id" field in this mutation.
+func (m *TransferMutation) AddedSellerID() (r int64, exists bool) {
+	v := m.addseller_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSellerID clears the value of the "seller_id" field.
+func (m *TransferMutation) ClearSellerID() {
+	m.seller_id = nil
+	m.addseller_id = nil
+	m.clearedFields[transfer.FieldSellerID] = struct{}{}
+}
+
+// SellerIDCleared returns if the "seller_id" field was cleared in this mutation.
+func (m *TransferMutation) SellerIDCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldSellerID]
+	return ok
+}
+
+// ResetSellerID resets all changes to the "seller_id" field.
+func (m *TransferMutation) ResetSellerID() {
+	m.seller_id = nil
+	m.addseller_id = nil
+	delete(m.clearedFields, transfer.FieldSellerID)
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (m *TransferMutation) SetBuyerID(i int64) {
+	m.buyer_id = &i
+	m.addbuyer_id = nil
+}
+
+// BuyerID returns the value of the "buyer_id" field in the mutation.
+func (m *TransferMutation) BuyerID() (r int64, exists bool) {
+	v := m.buyer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerID returns the old "buyer_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldBuyerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerID: %w", err)
+	}
+	return oldValue.BuyerID, nil
+}
+
+// AddBuyerID adds i to the "buyer_id" field.
+func (m *TransferMutation) AddBuyerID(i int64) {
+	if m.addbuyer_id != nil {
+		*m.addbuyer_id += i
+	} else {
+		m.addbuyer_id = &i
+	}
+}
+
+// AddedBuyerID returns the value that was added to the "buyer_id" field in this mutation.
+func (m *TransferMutation) AddedBuyerID() (r int64, exists bool) {
+	v := m.addbuyer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBuyerID clears the value of the "buyer_id" field.
+func (m *TransferMutation) ClearBuyerID() {
+	m.buyer_id = nil
+	m.addbuyer_id = nil
+	m.clearedFields[transfer.FieldBuyerID] = struct{}{}
+}
+
+// BuyerIDCleared returns if the "buyer_id" field was cleared in this mutation.
+func (m *TransferMutation) BuyerIDCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldBuyerID]
+	return ok
+}
+
+// ResetBuyerID resets all changes to the "buyer_id" field.
+func (m *TransferMutation) ResetBuyerID() {
+	m.buyer_id = nil
+	m.addbuyer_id = nil
+	delete(m.clearedFields, transfer.FieldBuyerID)
+}
+
+// SetStatus sets the "status" field.
+func (m *TransferMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TransferMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TransferMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRequireApproval sets the "require_approval" field.
+func (m *TransferMutation) SetRequireApproval(b bool) {
+	m.require_approval = &b
+}
+
+// RequireApproval returns the value of the "require_approval" field in the mutation.
+func (m *TransferMutation) RequireApproval() (r bool, exists bool) {
+	v := m.require_approval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequireApproval returns the old "require_approval" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldRequireApproval(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequireApproval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequireApproval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequireApproval: %w", err)
+	}
+	return oldValue.RequireApproval, nil
+}
+
+// ResetRequireApproval resets all changes to the "require_approval" field.
+func (m *TransferMutation) ResetRequireApproval() {
+	m.require_approval = nil
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (m *TransferMutation) SetApprovedBy(i int64) {
+	m.approved_by = &i
+	m.addapproved_by = nil
+}
+
+// ApprovedBy returns the value of the "approved_by" field in the mutation.
+func (m *TransferMutation) ApprovedBy() (r int64, exists bool) {
+	v := m.approved_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedBy returns the old "approved_by" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldApprovedBy(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedBy: %w", err)
+	}
+	return oldValue.ApprovedBy, nil
+}
+
+// AddApprovedBy adds i to the "approved_by" field.
+func (m *TransferMutation) AddApprovedBy(i int64) {
+	if m.addapproved_by != nil {
+		*m.addapproved_by += i
+	} else {
+		m.addapproved_by = &i
+	}
+}
+
+// AddedApprovedBy returns the value that was added to the "approved_by" field in this mutation.
+func (m *TransferMutation) AddedApprovedBy() (r int64, exists bool) {
+	v := m.addapproved_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (m *TransferMutation) ClearApprovedBy() {
+	m.approved_by = nil
+	m.addapproved_by = nil
+	m.clearedFields[transfer.FieldApprovedBy] = struct{}{}
+}
+
+// ApprovedByCleared returns if the "approved_by" field was cleared in this mutation.
+func (m *TransferMutation) ApprovedByCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldApprovedBy]
+	return ok
+}
+
+// ResetApprovedBy resets all changes to the "approved_by" field.
+func (m *TransferMutation) ResetApprovedBy() {
+	m.approved_by = nil
+	m.addapproved_by = nil
+	delete(m.clearedFields, transfer.FieldApprovedBy)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *TransferMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TransferMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *TransferMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[transfer.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *TransferMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TransferMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, transfer.FieldExpiresAt)
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (m *TransferMutation) SetAcceptedAt(t time.Time) {
+	m.accepted_at = &t
+}
+
+// AcceptedAt returns the value of the "accepted_at" field in the mutation.
+func (m *TransferMutation) AcceptedAt() (r time.Time, exists bool) {
+	v := m.accepted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcceptedAt returns the old "accepted_at" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldAcceptedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcceptedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcceptedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcceptedAt: %w", err)
+	}
+	return oldValue.AcceptedAt, nil
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (m *TransferMutation) ClearAcceptedAt() {
+	m.accepted_at = nil
+	m.clearedFields[transfer.FieldAcceptedAt] = struct{}{}
+}
+
+// AcceptedAtCleared returns if the "accepted_at" field was cleared in this mutation.
+func (m *TransferMutation) AcceptedAtCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldAcceptedAt]
+	return ok
+}
+
+// ResetAcceptedAt resets all changes to the "accepted_at" field.
+func (m *TransferMutation) ResetAcceptedAt() {
+	m.accepted_at = nil
+	delete(m.clearedFields, transfer.FieldAcceptedAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *TransferMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *TransferMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *TransferMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[transfer.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *TransferMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *TransferMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, transfer.FieldCompletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TransferMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TransferMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TransferMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *TransferMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *TransferMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *TransferMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *TransferMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the TransferMutation builder.
+func (m *TransferMutation) Where(ps ...predicate.Transfer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TransferMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Transfer).
+func (m *TransferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransferMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.tenant_id != nil {
+		fields = append(fields, transfer.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, transfer.FieldCarID)
+	}
+	if m.seller_id != nil {
+		fields = append(fields, transfer.FieldSellerID)
+	}
+	if m.buyer_id != nil {
+		fields = append(fields, transfer.FieldBuyerID)
+	}
+	if m.status != nil {
+		fields = append(fields, transfer.FieldStatus)
+	}
+	if m.require_approval != nil {
+		fields = append(fields, transfer.FieldRequireApproval)
+	}
+	if m.approved_by != nil {
+		fields = append(fields, transfer.FieldApprovedBy)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, transfer.FieldExpiresAt)
+	}
+	if m.accepted_at != nil {
+		fields = append(fields, transfer.FieldAcceptedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, transfer.FieldCompletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, transfer.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transfer.FieldTenantID:
+		return m.TenantID()
+	case transfer.FieldCarID:
+		return m.CarID()
+	case transfer.FieldSellerID:
+		return m.SellerID()
+	case transfer.FieldBuyerID:
+		return m.BuyerID()
+	case transfer.FieldStatus:
+		return m.Status()
+	case transfer.FieldRequireApproval:
+		return m.RequireApproval()
+	case transfer.FieldApprovedBy:
+		return m.ApprovedBy()
+	case transfer.FieldExpiresAt:
+		return m.ExpiresAt()
+	case transfer.FieldAcceptedAt:
+		return m.AcceptedAt()
+	case transfer.FieldCompletedAt:
+		return m.CompletedAt()
+	case transfer.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transfer.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case transfer.FieldCarID:
+		return m.OldCarID(ctx)
+	case transfer.FieldSellerID:
+		return m.OldSellerID(ctx)
+	case transfer.FieldBuyerID:
+		return m.OldBuyerID(ctx)
+	case transfer.FieldStatus:
+		return m.OldStatus(ctx)
+	case transfer.FieldRequireApproval:
+		return m.OldRequireApproval(ctx)
+	case transfer.FieldApprovedBy:
+		return m.OldApprovedBy(ctx)
+	case transfer.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case transfer.FieldAcceptedAt:
+		return m.OldAcceptedAt(ctx)
+	case transfer.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case transfer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transfer.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case transfer.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case transfer.FieldSellerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellerID(v)
+		return nil
+	case transfer.FieldBuyerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerID(v)
+		return nil
+	case transfer.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case transfer.FieldRequireApproval:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequireApproval(v)
+		return nil
+	case transfer.FieldApprovedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovedBy(v)
+		return nil
+	case transfer.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case transfer.FieldAcceptedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcceptedAt(v)
+		return nil
+	case transfer.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case transfer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransferMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, transfer.FieldTenantID)
+	}
+	if m.addseller_id != nil {
+		fields = append(fields, transfer.FieldSellerID)
+	}
+	if m.addbuyer_id != nil {
+		fields = append(fields, transfer.FieldBuyerID)
+	}
+	if m.addapproved_by != nil {
+		fields = append(fields, transfer.FieldApprovedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransferMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case transfer.FieldTenantID:
+		return m.AddedTenantID()
+	case transfer.FieldSellerID:
+		return m.AddedSellerID()
+	case transfer.FieldBuyerID:
+		return m.AddedBuyerID()
+	case transfer.FieldApprovedBy:
+		return m.AddedApprovedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransferMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case transfer.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case transfer.FieldSellerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSellerID(v)
+		return nil
+	case transfer.FieldBuyerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuyerID(v)
+		return nil
+	case transfer.FieldApprovedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddApprovedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransferMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transfer.FieldTenantID) {
+		fields = append(fields, transfer.FieldTenantID)
+	}
+	if m.FieldCleared(transfer.FieldCarID) {
+		fields = append(fields, transfer.FieldCarID)
+	}
+	if m.FieldCleared(transfer.FieldSellerID) {
+		fields = append(fields, transfer.FieldSellerID)
+	}
+	if m.FieldCleared(transfer.FieldBuyerID) {
+		fields = append(fields, transfer.FieldBuyerID)
+	}
+	if m.FieldCleared(transfer.FieldApprovedBy) {
+		fields = append(fields, transfer.FieldApprovedBy)
+	}
+	if m.FieldCleared(transfer.FieldExpiresAt) {
+		fields = append(fields, transfer.FieldExpiresAt)
+	}
+	if m.FieldCleared(transfer.FieldAcceptedAt) {
+		fields = append(fields, transfer.FieldAcceptedAt)
+	}
+	if m.FieldCleared(transfer.FieldCompletedAt) {
+		fields = append(fields, transfer.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TransferMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TransferMutation) ClearField(name string) error {
+	switch name {
+	case transfer.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case transfer.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case transfer.FieldSellerID:
+		m.ClearSellerID()
+		return nil
+	case transfer.FieldBuyerID:
+		m.ClearBuyerID()
+		return nil
+	case transfer.FieldApprovedBy:
+		m.ClearApprovedBy()
+		return nil
+	case transfer.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case transfer.FieldAcceptedAt:
+		m.ClearAcceptedAt()
+		return nil
+	case transfer.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TransferMutation) ResetField(name string) error {
+	switch name {
+	case transfer.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case transfer.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case transfer.FieldSellerID:
+		m.ResetSellerID()
+		return nil
+	case transfer.FieldBuyerID:
+		m.ResetBuyerID()
+		return nil
+	case transfer.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case transfer.FieldRequireApproval:
+		m.ResetRequireApproval()
+		return nil
+	case transfer.FieldApprovedBy:
+		m.ResetApprovedBy()
+		return nil
+	case transfer.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case transfer.FieldAcceptedAt:
+		m.ResetAcceptedAt()
+		return nil
+	case transfer.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case transfer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, transfer.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TransferMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case transfer.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TransferMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, transfer.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TransferMutation) EdgeCleared(name string) bool {
+	switch name {
+	case transfer.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TransferMutation) ClearEdge(name string) error {
+	switch name {
+	case transfer.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TransferMutation) ResetEdge(name string) error {
+	switch name {
+	case transfer.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer edge %s", name)
+}
+
+// TripMutation represents an operation that mutates the Trip nodes in the graph.
+type TripMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	tenant_id         *int64
+	addtenant_id      *int64
+	driver_id         *int64
+	adddriver_id      *int64
+	purpose           *string
+	start_odometer    *int64
+	addstart_odometer *int64
+	end_odometer      *int64
+	addend_odometer   *int64
+	started_at        *time.Time
+	ended_at          *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	car               *int64
+	clearedcar        bool
+	done              bool
+	oldValue          func(context.Context) (*Trip, error)
+	predicates        []predicate.Trip
+}
+
+var _ ent.Mutation = (*TripMutation)(nil)
+
+// tripOption allows management of the mutation configuration using functional options.
+type tripOption func(*TripMutation)
+
+// newTripMutation creates new mutation for the Trip entity.
+func newTripMutation(c config, op Op, opts ...tripOption) *TripMutation {
+	m := &TripMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTrip,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTripID sets the ID field of the mutation.
+func withTripID(id int64) tripOption {
+	return func(m *TripMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Trip
+		)
+		m.oldValue = func(ctx context.Context) (*Trip, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Trip.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTrip sets the old Trip of the mutation.
+func withTrip(node *Trip) tripOption {
+	return func(m *TripMutation) {
+		m.oldValue = func(context.Context) (*Trip, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TripMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TripMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Trip entities.
+func (m *TripMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TripMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TripMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Trip.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *TripMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TripMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Trip entity.
+// If the Trip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TripMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *TripMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *TripMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *TripMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[trip.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *TripMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[trip.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TripMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, trip.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *TripMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *TripMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the Trip entity.
+// If the Trip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TripMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *TripMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[trip.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *TripMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[trip.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *TripMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, trip.FieldCarID)
+}
+
+// SetDriverID sets the "driver_id" field.
+func (m *TripMutation) SetDriverID(i int64) {
+	m.driver_id = &i
+	m.adddriver_id = nil
+}
+
+// DriverID returns the value of the "driver_id" field in the mutation.
+func (m *TripMutation) DriverID() (r int64, exists bool) {
+	v := m.driver_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDriverID returns the old "driver_id" field's value of the Trip entity.
+// If the Trip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TripMutation) OldDriverID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDriverID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDriverID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDriverID: %w", err)
+	}
+	return oldValue.DriverID, nil
+}
+
+// AddDriverID adds i to the "driver_id" field.
+func (m *TripMutation) AddDriverID(i int64) {
+	if m.adddriver_id != nil {
+		*m.adddriver_id += i
+	} else {
+		m.adddriver_id = &i
+	}
+}
+
+// AddedDriverID returns the value that was added to the "driver_id" field in this mutation.
+func (m *TripMutation) AddedDriverID() (r int64, exists bool) {
+	v := m.adddriver_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDriverID clears the value of the "driver_id" field.
+func (m *TripMutation) ClearDriverID() {
+	m.driver_id = nil
+	m.adddriver_id = nil
+	m.clearedFields[trip.FieldDriverID] = struct{}{}
+}
+
+// DriverIDCleared returns if the "driver_id" field was cleared in this mutation.
+func (m *TripMutation) DriverIDCleared() bool {
+	_, ok := m.clearedFields[trip.FieldDriverID]
+	return ok
+}
+
+// ResetDriverID resets all changes to the "driver_id" field.
+func (m *TripMutation) ResetDriverID() {
+	m.driver_id = nil
+	m.adddriver_id = nil
+	delete(m.clearedFields, trip.FieldDriverID)
+}
+
+// SetPurpose sets the "purpose" field.
+func (m *TripMutation) SetPurpose(s string) {
+	m.purpose = &s
+}
+
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *TripMutation) Purpose() (r string, exists bool) {
+	v := m.purpose
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurpose returns the old "purpose" field's value of the Trip entity.
+// If the Trip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TripMutation) OldPurpose(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+	}
+	return oldValue.Purpose, nil
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (m *TripMutation) ClearPurpose() {
+	m.purpose = nil
+	m.clearedFields[trip.FieldPurpose] = struct{}{}
+}
+
+// PurposeCleared returns if the "purpose" field was cleared in this mutation.
+func (m *TripMutation) PurposeCleared() bool {
+	_, ok := m.clearedFields[trip.FieldPurpose]
+	return ok
+}
+
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *TripMutation) ResetPurpose() {
+	m.purpose = nil
+	delete(m.clearedFields, trip.FieldPurpose)
+}
+
+// SetStartOdometer sets the "start_odometer" field.
+func (m *TripMutation) SetStartOdometer(i int64) {
+	m.start_odometer = &i
+	m.addstart_odometer = nil
+}
+
+// StartOdometer returns the value of the "start_odometer" field in the mutation.
+func (m *TripMutation) StartOdometer() (r int64, exists bool) {
+	v := m.start_odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartOdometer returns the old "start_odometer" field's value of the Trip entity.
+// If the Trip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TripMutation) OldStartOdometer(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartOdometer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartOdometer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartOdometer: %w", err)
+	}
+	return oldValue.StartOdometer, nil
+}
+
+// AddStartOdometer adds i to the "start_odometer" field.
+func (m *TripMutation) AddStartOdometer(i int64) {
+	if m.addstart_odometer != nil {
+		*m.addstart_odometer += i
+	} else {
+		m.addstart_odometer = &i
+	}
+}
+
+// AddedStartOdometer returns the value that was added to the "start_odometer" field in this mutation.
+func (m *TripMutation) AddedStartOdometer() (r int64, exists bool) {
+	v := m.addstart_odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartOdometer clears the value of the "start_odometer" field.
+func (m *TripMutation) ClearStartOdometer() {
+	m.start_odometer = nil
+	m.addstart_odometer = nil
+	m.clearedFields[trip.FieldStartOdometer] = struct{}{}
+}
+
+// StartOdometerCleared returns if the "start_odometer" field was cleared in this mutation.
+func (m *TripMutation) StartOdometerCleared() bool {
+	_, ok := m.clearedFields[trip.FieldStartOdometer]
+	return ok
+}
+
+// ResetStartOdometer resets all changes to the "start_odometer" field.
+func (m *TripMutation) ResetStartOdometer() {
+	m.start_odometer = nil
+	m.addstart_odometer = nil
+	delete(m.clearedFields, trip.FieldStartOdometer)
+}
+
+// SetEndOdometer sets the "end_odometer" field.
+func (m *TripMutation) SetEndOdometer(i int64) {
+	m.end_odometer = &i
+	m.addend_odometer = nil
+}
+
+// EndOdometer returns the value of the "end_odometer" field in the mutation.
+func (m *TripMutation) EndOdometer() (r int64, exists bool) {
+	v := m.end_odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndOdometer returns the old "end_odometer" field's value of the Trip entity.
+// If the Trip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TripMutation) OldEndOdometer(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndOdometer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndOdometer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndOdometer: %w", err)
+	}
+	return oldValue.EndOdometer, nil
+}
+
+// AddEndOdometer adds i to the "end_odometer" field.
+func (m *TripMutation) AddEndOdometer(i int64) {
+	if m.addend_odometer != nil {
+		*m.addend_odometer += i
+	} else {
+		m.addend_odometer = &i
+	}
+}
+
+// AddedEndOdometer returns the value that was added to the "end_odometer" field in this mutation.
+func (m *TripMutation) AddedEndOdometer() (r int64, exists bool) {
+	v := m.addend_odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndOdometer clears the value of the "end_odometer" field.
+func (m *TripMutation) ClearEndOdometer() {
+	m.end_odometer = nil
+	m.addend_odometer = nil
+	m.clearedFields[trip.FieldEndOdometer] = struct{}{}
+}
+
+// EndOdometerCleared returns if the "end_odometer" field was cleared in this mutation.
+func (m *TripMutation) EndOdometerCleared() bool {
+	_, ok := m.clearedFields[trip.FieldEndOdometer]
+	return ok
+}
+
+// ResetEndOdometer resets all changes to the "end_odometer" field.
+func (m *TripMutation) ResetEndOdometer() {
+	m.end_odometer = nil
+	m.addend_odometer = nil
+	delete(m.clearedFields, trip.FieldEndOdometer)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *TripMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *TripMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Trip entity.
+// If the Trip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TripMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *TripMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[trip.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *TripMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[trip.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *TripMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, trip.FieldStartedAt)
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *TripMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *TripMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the Trip entity.
+// If the Trip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TripMutation) OldEndedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *TripMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[trip.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *TripMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[trip.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *TripMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, trip.FieldEndedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TripMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TripMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Trip entity.
+// If the Trip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TripMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TripMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *TripMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *TripMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *TripMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *TripMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the TripMutation builder.
+func (m *TripMutation) Where(ps ...predicate.Trip) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TripMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Trip).
+func (m *TripMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TripMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.tenant_id != nil {
+		fields = append(fields, trip.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, trip.FieldCarID)
+	}
+	if m.driver_id != nil {
+		fields = append(fields, trip.FieldDriverID)
+	}
+	if m.purpose != nil {
+		fields = append(fields, trip.FieldPurpose)
+	}
+	if m.start_odometer != nil {
+		fields = append(fields, trip.FieldStartOdometer)
+	}
+	if m.end_odometer != nil {
+		fields = append(fields, trip.FieldEndOdometer)
+	}
+	if m.started_at != nil {
+		fields = append(fields, trip.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, trip.FieldEndedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, trip.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TripMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case trip.FieldTenantID:
+		return m.TenantID()
+	case trip.FieldCarID:
+		return m.CarID()
+	case trip.FieldDriverID:
+		return m.DriverID()
+	case trip.FieldPurpose:
+		return m.Purpose()
+	case trip.FieldStartOdometer:
+		return m.StartOdometer()
+	case trip.FieldEndOdometer:
+		return m.EndOdometer()
+	case trip.FieldStartedAt:
+		return m.StartedAt()
+	case trip.FieldEndedAt:
+		return m.EndedAt()
+	case trip.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TripMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case trip.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case trip.FieldCarID:
+		return m.OldCarID(ctx)
+	case trip.FieldDriverID:
+		return m.OldDriverID(ctx)
+	case trip.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case trip.FieldStartOdometer:
+		return m.OldStartOdometer(ctx)
+	case trip.FieldEndOdometer:
+		return m.OldEndOdometer(ctx)
+	case trip.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case trip.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case trip.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Trip field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TripMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case trip.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case trip.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case trip.FieldDriverID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDriverID(v)
+		return nil
+	case trip.FieldPurpose:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurpose(v)
+		return nil
+	case trip.FieldStartOdometer:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartOdometer(v)
+		return nil
+	case trip.FieldEndOdometer:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndOdometer(v)
+		return nil
+	case trip.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case trip.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case trip.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Trip field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TripMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, trip.FieldTenantID)
+	}
+	if m.adddriver_id != nil {
+		fields = append(fields, trip.FieldDriverID)
+	}
+	if m.addstart_odometer != nil {
+		fields = append(fields, trip.FieldStartOdometer)
+	}
+	if m.addend_odometer != nil {
+		fields = append(fields, trip.FieldEndOdometer)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TripMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case trip.FieldTenantID:
+		return m.AddedTenantID()
+	case trip.FieldDriverID:
+		return m.AddedDriverID()
+	case trip.FieldStartOdometer:
+		return m.AddedStartOdometer()
+	case trip.FieldEndOdometer:
+		return m.AddedEndOdometer()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TripMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case trip.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case trip.FieldDriverID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDriverID(v)
+		return nil
+	case trip.FieldStartOdometer:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartOdometer(v)
+		return nil
+	case trip.FieldEndOdometer:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndOdometer(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Trip numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TripMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(trip.FieldTenantID) {
+		fields = append(fields, trip.FieldTenantID)
+	}
+	if m.FieldCleared(trip.FieldCarID) {
+		fields = append(fields, trip.FieldCarID)
+	}
+	if m.FieldCleared(trip.FieldDriverID) {
+		fields = append(fields, trip.FieldDriverID)
+	}
+	if m.FieldCleared(trip.FieldPurpose) {
+		fields = append(fields, trip.FieldPurpose)
+	}
+	if m.FieldCleared(trip.FieldStartOdometer) {
+		fields = append(fields, trip.FieldStartOdometer)
+	}
+	if m.FieldCleared(trip.FieldEndOdometer) {
+		fields = append(fields, trip.FieldEndOdometer)
+	}
+	if m.FieldCleared(trip.FieldStartedAt) {
+		fields = append(fields, trip.FieldStartedAt)
+	}
+	if m.FieldCleared(trip.FieldEndedAt) {
+		fields = append(fields, trip.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TripMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TripMutation) ClearField(name string) error {
+	switch name {
+	case trip.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case trip.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case trip.FieldDriverID:
+		m.ClearDriverID()
+		return nil
+	case trip.FieldPurpose:
+		m.ClearPurpose()
+		return nil
+	case trip.FieldStartOdometer:
+		m.ClearStartOdometer()
+		return nil
+	case trip.FieldEndOdometer:
+		m.ClearEndOdometer()
+		return nil
+	case trip.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case trip.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Trip nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TripMutation) ResetField(name string) error {
+	switch name {
+	case trip.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case trip.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case trip.FieldDriverID:
+		m.ResetDriverID()
+		return nil
+	case trip.FieldPurpose:
+		m.ResetPurpose()
+		return nil
+	case trip.FieldStartOdometer:
+		m.ResetStartOdometer()
+		return nil
+	case trip.FieldEndOdometer:
+		m.ResetEndOdometer()
+		return nil
+	case trip.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case trip.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case trip.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Trip field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TripMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, trip.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TripMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case trip.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TripMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TripMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TripMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, trip.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TripMutation) EdgeCleared(name string) bool {
+	switch name {
+	case trip.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TripMutation) ClearEdge(name string) error {
+	switch name {
+	case trip.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Trip unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TripMutation) ResetEdge(name string) error {
+	switch name {
+	case trip.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Trip edge %s", name)
+}
+
+// VehicleModelMutation represents an operation that mutates the VehicleModel nodes in the graph.
+type VehicleModelMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	name          *string
+	fuel_type     *string
+	seats         *int32
+	addseats      *int32
+	body_type     *string
+	clearedFields map[string]struct{}
+	brand         *int64
+	clearedbrand  bool
+	cars          map[int64]struct{}
+	removedcars   map[int64]struct{}
+	clearedcars   bool
+	done          bool
+	oldValue      func(context.Context) (*VehicleModel, error)
+	predicates    []predicate.VehicleModel
+}
+
+var _ ent.Mutation = (*VehicleModelMutation)(nil)
+
+// vehiclemodelOption allows management of the mutation configuration using functional options.
+type vehiclemodelOption func(*VehicleModelMutation)
+
+// newVehicleModelMutation creates new mutation for the VehicleModel entity.
+func newVehicleModelMutation(c config, op Op, opts ...vehiclemodelOption) *VehicleModelMutation {
+	m := &VehicleModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVehicleModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVehicleModelID sets the ID field of the mutation.
+func withVehicleModelID(id int64) vehiclemodelOption {
+	return func(m *VehicleModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VehicleModel
+		)
+		m.oldValue = func(ctx context.Context) (*VehicleModel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VehicleModel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVehicleModel sets the old VehicleModel of the mutation.
+func withVehicleModel(node *VehicleModel) vehiclemodelOption {
+	return func(m *VehicleModelMutation) {
+		m.oldValue = func(context.Context) (*VehicleModel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VehicleModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VehicleModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of VehicleModel entities.
+func (m *VehicleModelMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VehicleModelMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VehicleModelMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VehicleModel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBrandID sets the "brand_id" field.
+func (m *VehicleModelMutation) SetBrandID(i int64) {
+	m.brand = &i
+}
+
+// BrandID returns the value of the "brand_id" field in the mutation.
+func (m *VehicleModelMutation) BrandID() (r int64, exists bool) {
+	v := m.brand
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBrandID returns the old "brand_id" field's value of the VehicleModel entity.
+// If the VehicleModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleModelMutation) OldBrandID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrandID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrandID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrandID: %w", err)
+	}
+	return oldValue.BrandID, nil
+}
+
+// ClearBrandID clears the value of the "brand_id" field.
+func (m *VehicleModelMutation) ClearBrandID() {
+	m.brand = nil
+	m.clearedFields[vehiclemodel.FieldBrandID] = struct{}{}
+}
+
+// BrandIDCleared returns if the "brand_id" field was cleared in this mutation.
+func (m *VehicleModelMutation) BrandIDCleared() bool {
+	_, ok := m.clearedFields[vehiclemodel.FieldBrandID]
+	return ok
+}
+
+// ResetBrandID resets all changes to the "brand_id" field.
+func (m *VehicleModelMutation) ResetBrandID() {
+	m.brand = nil
+	delete(m.clearedFields, vehiclemodel.FieldBrandID)
+}
+
+// SetName sets the "name" field.
+func (m *VehicleModelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *VehicleModelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the VehicleModel entity.
+// If the VehicleModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleModelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *VehicleModelMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[vehiclemodel.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *VehicleModelMutation) NameCleared() bool {
+	_, ok := m.clearedFields[vehiclemodel.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *VehicleModelMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, vehiclemodel.FieldName)
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (m *VehicleModelMutation) SetFuelType(s string) {
+	m.fuel_type = &s
+}
+
+// FuelType returns the value of the "fuel_type" field in the mutation.
+func (m *VehicleModelMutation) FuelType() (r string, exists bool) {
+	v := m.fuel_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFuelType returns the old "fuel_type" field's value of the VehicleModel entity.
+// If the VehicleModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleModelMutation) OldFuelType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFuelType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFuelType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFuelType: %w", err)
+	}
+	return oldValue.FuelType, nil
+}
+
+// ClearFuelType clears the value of the "fuel_type" field.
+func (m *VehicleModelMutation) ClearFuelType() {
+	m.fuel_type = nil
+	m.clearedFields[vehiclemodel.FieldFuelType] = struct{}{}
+}
+
+// FuelTypeCleared returns if the "fuel_type" field was cleared in this mutation.
+func (m *VehicleModelMutation) FuelTypeCleared() bool {
+	_, ok := m.clearedFields[vehiclemodel.FieldFuelType]
+	return ok
+}
+
+// ResetFuelType resets all changes to the "fuel_type" field.
+func (m *VehicleModelMutation) ResetFuelType() {
+	m.fuel_type = nil
+	delete(m.clearedFields, vehiclemodel.FieldFuelType)
+}
+
+// SetSeats sets the "seats" field.
+func (m *VehicleModelMutation) SetSeats(i int32) {
+	m.seats = &i
+	m.addseats = nil
+}
+
+// Seats returns the value of the "seats" field in the mutation.
+func (m *VehicleModelMutation) Seats() (r int32, exists bool) {
+	v := m.seats
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeats returns the old "seats" field's value of the VehicleModel entity.
+// If the VehicleModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleModelMutation) OldSeats(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeats is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeats requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeats: %w", err)
+	}
+	return oldValue.Seats, nil
+}
+
+// AddSeats adds i to the "seats" field.
+func (m *VehicleModelMutation) AddSeats(i int32) {
+	if m.addseats != nil {
+		*m.addseats += i
+	} else {
+		m.addseats = &i
+	}
+}
+
+// AddedSeats returns the value that was added to the "seats" field in this mutation.
+func (m *VehicleModelMutation) AddedSeats() (r int32, exists bool) {
+	v := m.addseats
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSeats clears the value of the "seats" field.
+func (m *VehicleModelMutation) ClearSeats() {
+	m.seats = nil
+	m.addseats = nil
+	m.clearedFields[vehiclemodel.FieldSeats] = struct{}{}
+}
+
+// SeatsCleared returns if the "seats" field was cleared in this mutation.
+func (m *VehicleModelMutation) SeatsCleared() bool {
+	_, ok := m.clearedFields[vehiclemodel.FieldSeats]
+	return ok
+}
+
+// ResetSeats resets all changes to the "seats" field.
+func (m *VehicleModelMutation) ResetSeats() {
+	m.seats = nil
+	m.addseats = nil
+	delete(m.clearedFields, vehiclemodel.FieldSeats)
+}
+
+// SetBodyType sets the "body_type" field.
+func (m *VehicleModelMutation) SetBodyType(s string) {
+	m.body_type = &s
+}
+
+// BodyType returns the value of the "body_type" field in the mutation.
+func (m *VehicleModelMutation) BodyType() (r string, exists bool) {
+	v := m.body_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBodyType returns the old "body_type" field's value of the VehicleModel entity.
+// If the VehicleModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleModelMutation) OldBodyType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodyType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodyType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodyType: %w", err)
+	}
+	return oldValue.BodyType, nil
+}
+
+// ClearBodyType clears the value of the "body_type" field.
+func (m *VehicleModelMutation) ClearBodyType() {
+	m.body_type = nil
+	m.clearedFields[vehiclemodel.FieldBodyType] = struct{}{}
+}
+
+// BodyTypeCleared returns if the "body_type" field was cleared in this mutation.
+func (m *VehicleModelMutation) BodyTypeCleared() bool {
+	_, ok := m.clearedFields[vehiclemodel.FieldBodyType]
+	return ok
+}
+
+// ResetBodyType resets all changes to the "body_type" field.
+func (m *VehicleModelMutation) ResetBodyType() {
+	m.body_type = nil
+	delete(m.clearedFields, vehiclemodel.FieldBodyType)
+}
+
+// ClearBrand clears the "brand" edge to the Brand entity.
+func (m *VehicleModelMutation) ClearBrand() {
+	m.clearedbrand = true
+}
+
+// BrandCleared reports if the "brand" edge to the Brand entity was cleared.
+func (m *VehicleModelMutation) BrandCleared() bool {
+	return m.BrandIDCleared() || m.clearedbrand
+}
+
+// BrandIDs returns the "brand" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BrandID instead. It exists only for internal usage by the builders.
+func (m *VehicleModelMutation) BrandIDs() (ids []int64) {
+	if id := m.brand; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBrand resets all changes to the "brand" edge.
+func (m *VehicleModelMutation) ResetBrand() {
+	m.brand = nil
+	m.clearedbrand = false
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by ids.
+func (m *VehicleModelMutation) AddCarIDs(ids ...int64) {
+	if m.cars == nil {
+		m.cars = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.cars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCars clears the "cars" edge to the Car entity.
+func (m *VehicleModelMutation) ClearCars() {
+	m.clearedcars = true
+}
+
+// CarsCleared reports if the "cars" edge to the Car entity was cleared.
+func (m *VehicleModelMutation) CarsCleared() bool {
+	return m.clearedcars
+}
+
+// RemoveCarIDs removes the "cars" edge to the Car entity by IDs.
+func (m *VehicleModelMutation) RemoveCarIDs(ids ...int64) {
+	if m.removedcars == nil {
+		m.removedcars = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.cars, ids[i])
+		m.removedcars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCars returns the removed IDs of the "cars" edge to the Car entity.
+func (m *VehicleModelMutation) RemovedCarsIDs() (ids []int64) {
+	for id := range m.removedcars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CarsIDs returns the "cars" edge IDs in the mutation.
+func (m *VehicleModelMutation) CarsIDs() (ids []int64) {
+	for id := range m.cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCars resets all changes to the "cars" edge.
+func (m *VehicleModelMutation) ResetCars() {
+	m.cars = nil
+	m.clearedcars = false
+	m.removedcars = nil
+}
+
+// Where appends a list predicates to the VehicleModelMutation builder.
+func (m *VehicleModelMutation) Where(ps ...predicate.VehicleModel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *VehicleModelMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (VehicleModel).
+func (m *VehicleModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VehicleModelMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.brand != nil {
+		fields = append(fields, vehiclemodel.FieldBrandID)
+	}
+	if m.name != nil {
+		fields = append(fields, vehiclemodel.FieldName)
+	}
+	if m.fuel_type != nil {
+		fields = append(fields, vehiclemodel.FieldFuelType)
+	}
+	if m.seats != nil {
+		fields = append(fields, vehiclemodel.FieldSeats)
+	}
+	if m.body_type != nil {
+		fields = append(fields, vehiclemodel.FieldBodyType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VehicleModelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vehiclemodel.FieldBrandID:
+		return m.BrandID()
+	case vehiclemodel.FieldName:
+		return m.Name()
+	case vehiclemodel.FieldFuelType:
+		return m.FuelType()
+	case vehiclemodel.FieldSeats:
+		return m.Seats()
+	case vehiclemodel.FieldBodyType:
+		return m.BodyType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VehicleModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vehiclemodel.FieldBrandID:
+		return m.OldBrandID(ctx)
+	case vehiclemodel.FieldName:
+		return m.OldName(ctx)
+	case vehiclemodel.FieldFuelType:
+		return m.OldFuelType(ctx)
+	case vehiclemodel.FieldSeats:
+		return m.OldSeats(ctx)
+	case vehiclemodel.FieldBodyType:
+		return m.OldBodyType(ctx)
+	}
+	return nil, fmt.Errorf("unknown VehicleModel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleModelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vehiclemodel.FieldBrandID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrandID(v)
+		return nil
+	case vehiclemodel.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case vehiclemodel.FieldFuelType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFuelType(v)
+		return nil
+	case vehiclemodel.FieldSeats:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeats(v)
+		return nil
+	case vehiclemodel.FieldBodyType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodyType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleModel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VehicleModelMutation) AddedFields() []string {
+	var fields []string
+	if m.addseats != nil {
+		fields = append(fields, vehiclemodel.FieldSeats)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VehicleModelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vehiclemodel.FieldSeats:
+		return m.AddedSeats()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleModelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case vehiclemodel.FieldSeats:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeats(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleModel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VehicleModelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(vehiclemodel.FieldBrandID) {
+		fields = append(fields, vehiclemodel.FieldBrandID)
+	}
+	if m.FieldCleared(vehiclemodel.FieldName) {
+		fields = append(fields, vehiclemodel.FieldName)
+	}
+	if m.FieldCleared(vehiclemodel.FieldFuelType) {
+		fields = append(fields, vehiclemodel.FieldFuelType)
+	}
+	if m.FieldCleared(vehiclemodel.FieldSeats) {
+		fields = append(fields, vehiclemodel.FieldSeats)
+	}
+	if m.FieldCleared(vehiclemodel.FieldBodyType) {
+		fields = append(fields, vehiclemodel.FieldBodyType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VehicleModelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VehicleModelMutation) ClearField(name string) error {
+	switch name {
+	case vehiclemodel.FieldBrandID:
+		m.ClearBrandID()
+		return nil
+	case vehiclemodel.FieldName:
+		m.ClearName()
+		return nil
+	case vehiclemodel.FieldFuelType:
+		m.ClearFuelType()
+		return nil
+	case vehiclemodel.FieldSeats:
+		m.ClearSeats()
+		return nil
+	case vehiclemodel.FieldBodyType:
+		m.ClearBodyType()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleModel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VehicleModelMutation) ResetField(name string) error {
+	switch name {
+	case vehiclemodel.FieldBrandID:
+		m.ResetBrandID()
+		return nil
+	case vehiclemodel.FieldName:
+		m.ResetName()
+		return nil
+	case vehiclemodel.FieldFuelType:
+		m.ResetFuelType()
+		return nil
+	case vehiclemodel.FieldSeats:
+		m.ResetSeats()
+		return nil
+	case vehiclemodel.FieldBodyType:
+		m.ResetBodyType()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleModel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VehicleModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.brand != nil {
+		edges = append(edges, vehiclemodel.EdgeBrand)
+	}
+	if m.cars != nil {
+		edges = append(edges, vehiclemodel.EdgeCars)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VehicleModelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case vehiclemodel.EdgeBrand:
+		if id := m.brand; id != nil {
+			return []ent.Value{*id}
+		}
+	case vehiclemodel.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.cars))
+		for id := range m.cars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VehicleModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcars != nil {
+		edges = append(edges, vehiclemodel.EdgeCars)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VehicleModelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case vehiclemodel.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.removedcars))
+		for id := range m.removedcars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VehicleModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedbrand {
+		edges = append(edges, vehiclemodel.EdgeBrand)
+	}
+	if m.clearedcars {
+		edges = append(edges, vehiclemodel.EdgeCars)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VehicleModelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case vehiclemodel.EdgeBrand:
+		return m.clearedbrand
+	case vehiclemodel.EdgeCars:
+		return m.clearedcars
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VehicleModelMutation) ClearEdge(name string) error {
+	switch name {
+	case vehiclemodel.EdgeBrand:
+		m.ClearBrand()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleModel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VehicleModelMutation) ResetEdge(name string) error {
+	switch name {
+	case vehiclemodel.EdgeBrand:
+		m.ResetBrand()
+		return nil
+	case vehiclemodel.EdgeCars:
+		m.ResetCars()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleModel edge %s", name)
+}
+
+// ViolationMutation represents an operation that mutates the Violation nodes in the graph.
+type ViolationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int64
+	tenant_id      *int64
+	addtenant_id   *int64
+	user_id        *int64
+	adduser_id     *int64
+	reference_no   *string
+	occurred_at    *time.Time
+	location       *string
+	amount         *float64
+	addamount      *float64
+	description    *string
+	status         *string
+	resolved_at    *time.Time
+	resolved_by    *int64
+	addresolved_by *int64
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	car            *int64
+	clearedcar     bool
+	done           bool
+	oldValue       func(context.Context) (*Violation, error)
+	predicates     []predicate.Violation
+}
+
+var _ ent.Mutation = (*ViolationMutation)(nil)
+
+// violationOption allows management of the mutation configuration using functional options.
+type violationOption func(*ViolationMutation)
+
+// newViolationMutation creates new mutation for the Violation entity.
+func newViolationMutation(c config, op Op, opts ...violationOption) *ViolationMutation {
+	m := &ViolationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeViolation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withViolationID sets the ID field of the mutation.
+func withViolationID(id int64) violationOption {
+	return func(m *ViolationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Violation
+		)
+		m.oldValue = func(ctx context.Context) (*Violation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Violation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withViolation sets the old Violation of the mutation.
+func withViolation(node *Violation) violationOption {
+	return func(m *ViolationMutation) {
+		m.oldValue = func(context.Context) (*Violation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ViolationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ViolationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Violation entities.
+func (m *ViolationMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ViolationMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ViolationMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Violation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ViolationMutation) SetTenantID(i int64) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ViolationMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *ViolationMutation) AddTenantID(i int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *ViolationMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *ViolationMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[violation.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *ViolationMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[violation.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ViolationMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, violation.FieldTenantID)
+}
+
+// SetCarID sets the "car_id" field.
+func (m *ViolationMutation) SetCarID(i int64) {
+	m.car = &i
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *ViolationMutation) CarID() (r int64, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldCarID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (m *ViolationMutation) ClearCarID() {
+	m.car = nil
+	m.clearedFields[violation.FieldCarID] = struct{}{}
+}
+
+// CarIDCleared returns if the "car_id" field was cleared in this mutation.
+func (m *ViolationMutation) CarIDCleared() bool {
+	_, ok := m.clearedFields[violation.FieldCarID]
+	return ok
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *ViolationMutation) ResetCarID() {
+	m.car = nil
+	delete(m.clearedFields, violation.FieldCarID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ViolationMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ViolationMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *ViolationMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *ViolationMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ViolationMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[violation.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ViolationMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[violation.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ViolationMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, violation.FieldUserID)
+}
+
+// SetReferenceNo sets the "reference_no" field.
+func (m *ViolationMutation) SetReferenceNo(s string) {
+	m.reference_no = &s
+}
+
+// ReferenceNo returns the value of the "reference_no" field in the mutation.
+func (m *ViolationMutation) ReferenceNo() (r string, exists bool) {
+	v := m.reference_no
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferenceNo returns the old "reference_no" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldReferenceNo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferenceNo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferenceNo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferenceNo: %w", err)
+	}
+	return oldValue.ReferenceNo, nil
+}
+
+// ClearReferenceNo clears the value of the "reference_no" field.
+func (m *ViolationMutation) ClearReferenceNo() {
+	m.reference_no = nil
+	m.clearedFields[violation.FieldReferenceNo] = struct{}{}
+}
+
+// ReferenceNoCleared returns if the "reference_no" field was cleared in this mutation.
+func (m *ViolationMutation) ReferenceNoCleared() bool {
+	_, ok := m.clearedFields[violation.FieldReferenceNo]
+	return ok
+}
+
+// ResetReferenceNo resets all changes to the "reference_no" field.
+func (m *ViolationMutation) ResetReferenceNo() {
+	m.reference_no = nil
+	delete(m.clearedFields, violation.FieldReferenceNo)
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (m *ViolationMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
+}
+
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *ViolationMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOccurredAt returns the old "occurred_at" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
+	}
+	return oldValue.OccurredAt, nil
+}
+
+// ClearOccurredAt clears the value of the "occurred_at" field.
+func (m *ViolationMutation) ClearOccurredAt() {
+	m.occurred_at = nil
+	m.clearedFields[violation.FieldOccurredAt] = struct{}{}
+}
+
+// OccurredAtCleared returns if the "occurred_at" field was cleared in this mutation.
+func (m *ViolationMutation) OccurredAtCleared() bool {
+	_, ok := m.clearedFields[violation.FieldOccurredAt]
+	return ok
+}
+
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *ViolationMutation) ResetOccurredAt() {
+	m.occurred_at = nil
+	delete(m.clearedFields, violation.FieldOccurredAt)
+}
+
+// SetLocation sets the "location" field.
+func (m *ViolationMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *ViolationMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *ViolationMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[violation.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *ViolationMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[violation.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *ViolationMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, violation.FieldLocation)
+}
+
+// SetAmount sets the "amount" field.
+func (m *ViolationMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *ViolationMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *ViolationMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *ViolationMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (m *ViolationMutation) ClearAmount() {
+	m.amount = nil
+	m.addamount = nil
+	m.clearedFields[violation.FieldAmount] = struct{}{}
+}
+
+// AmountCleared returns if the "amount" field was cleared in this mutation.
+func (m *ViolationMutation) AmountCleared() bool {
+	_, ok := m.clearedFields[violation.FieldAmount]
+	return ok
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *ViolationMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+	delete(m.clearedFields, violation.FieldAmount)
+}
+
+// SetDescription sets the "description" field.
+func (m *ViolationMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ViolationMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ViolationMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[violation.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ViolationMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[violation.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ViolationMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, violation.FieldDescription)
+}
+
+// SetStatus sets the "status" field.
+func (m *ViolationMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ViolationMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ViolationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *ViolationMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *ViolationMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *ViolationMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[violation.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *ViolationMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[violation.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *ViolationMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, violation.FieldResolvedAt)
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (m *ViolationMutation) SetResolvedBy(i int64) {
+	m.resolved_by = &i
+	m.addresolved_by = nil
+}
+
+// ResolvedBy returns the value of the "resolved_by" field in the mutation.
+func (m *ViolationMutation) ResolvedBy() (r int64, exists bool) {
+	v := m.resolved_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedBy returns the old "resolved_by" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldResolvedBy(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedBy: %w", err)
+	}
+	return oldValue.ResolvedBy, nil
+}
+
+// AddResolvedBy adds i to the "resolved_by" field.
+func (m *ViolationMutation) AddResolvedBy(i int64) {
+	if m.addresolved_by != nil {
+		*m.addresolved_by += i
+	} else {
+		m.addresolved_by = &i
+	}
+}
+
+// AddedResolvedBy returns the value that was added to the "resolved_by" field in this mutation.
+func (m *ViolationMutation) AddedResolvedBy() (r int64, exists bool) {
+	v := m.addresolved_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (m *ViolationMutation) ClearResolvedBy() {
+	m.resolved_by = nil
+	m.addresolved_by = nil
+	m.clearedFields[violation.FieldResolvedBy] = struct{}{}
+}
+
+// ResolvedByCleared returns if the "resolved_by" field was cleared in this mutation.
+func (m *ViolationMutation) ResolvedByCleared() bool {
+	_, ok := m.clearedFields[violation.FieldResolvedBy]
+	return ok
+}
+
+// ResetResolvedBy resets all changes to the "resolved_by" field.
+func (m *ViolationMutation) ResetResolvedBy() {
+	m.resolved_by = nil
+	m.addresolved_by = nil
+	delete(m.clearedFields, violation.FieldResolvedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ViolationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ViolationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Violation entity.
+// If the Violation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ViolationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ViolationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *ViolationMutation) ClearCar() {
+	m.clearedcar = true
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *ViolationMutation) CarCleared() bool {
+	return m.CarIDCleared() || m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *ViolationMutation) CarIDs() (ids []int64) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *ViolationMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the ViolationMutation builder.
+func (m *ViolationMutation) Where(ps ...predicate.Violation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ViolationMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Violation).
+func (m *ViolationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ViolationMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.tenant_id != nil {
+		fields = append(fields, violation.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, violation.FieldCarID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, violation.FieldUserID)
+	}
+	if m.reference_no != nil {
+		fields = append(fields, violation.FieldReferenceNo)
+	}
+	if m.occurred_at != nil {
+		fields = append(fields, violation.FieldOccurredAt)
+	}
+	if m.location != nil {
+		fields = append(fields, violation.FieldLocation)
+	}
+	if m.amount != nil {
+		fields = append(fields, violation.FieldAmount)
+	}
+	if m.description != nil {
+		fields = append(fields, violation.FieldDescription)
+	}
+	if m.status != nil {
+		fields = append(fields, violation.FieldStatus)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, violation.FieldResolvedAt)
+	}
+	if m.resolved_by != nil {
+		fields = append(fields, violation.FieldResolvedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, violation.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ViolationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case violation.FieldTenantID:
+		return m.TenantID()
+	case violation.FieldCarID:
+		return m.CarID()
+	case violation.FieldUserID:
+		return m.UserID()
+	case violation.FieldReferenceNo:
+		return m.ReferenceNo()
+	case violation.FieldOccurredAt:
+		return m.OccurredAt()
+	case violation.FieldLocation:
+		return m.Location()
+	case violation.FieldAmount:
+		return m.Amount()
+	case violation.FieldDescription:
+		return m.Description()
+	case violation.FieldStatus:
+		return m.Status()
+	case violation.FieldResolvedAt:
+		return m.ResolvedAt()
+	case violation.FieldResolvedBy:
+		return m.ResolvedBy()
+	case violation.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ViolationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case violation.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case violation.FieldCarID:
+		return m.OldCarID(ctx)
+	case violation.FieldUserID:
+		return m.OldUserID(ctx)
+	case violation.FieldReferenceNo:
+		return m.OldReferenceNo(ctx)
+	case violation.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
+	case violation.FieldLocation:
+		return m.OldLocation(ctx)
+	case violation.FieldAmount:
+		return m.OldAmount(ctx)
+	case violation.FieldDescription:
+		return m.OldDescription(ctx)
+	case violation.FieldStatus:
+		return m.OldStatus(ctx)
+	case violation.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case violation.FieldResolvedBy:
+		return m.OldResolvedBy(ctx)
+	case violation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Violation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ViolationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case violation.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case violation.FieldCarID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case violation.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case violation.FieldReferenceNo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferenceNo(v)
+		return nil
+	case violation.FieldOccurredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurredAt(v)
+		return nil
+	case violation.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case violation.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case violation.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case violation.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case violation.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	case violation.FieldResolvedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedBy(v)
+		return nil
+	case violation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Violation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ViolationMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, violation.FieldTenantID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, violation.FieldUserID)
+	}
+	if m.addamount != nil {
+		fields = append(fields, violation.FieldAmount)
+	}
+	if m.addresolved_by != nil {
+		fields = append(fields, violation.FieldResolvedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ViolationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case violation.FieldTenantID:
+		return m.AddedTenantID()
+	case violation.FieldUserID:
+		return m.AddedUserID()
+	case violation.FieldAmount:
+		return m.AddedAmount()
+	case violation.FieldResolvedBy:
+		return m.AddedResolvedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ViolationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case violation.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case violation.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case violation.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case violation.FieldResolvedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResolvedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Violation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ViolationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(violation.FieldTenantID) {
+		fields = append(fields, violation.FieldTenantID)
+	}
+	if m.FieldCleared(violation.FieldCarID) {
+		fields = append(fields, violation.FieldCarID)
+	}
+	if m.FieldCleared(violation.FieldUserID) {
+		fields = append(fields, violation.FieldUserID)
+	}
+	if m.FieldCleared(violation.FieldReferenceNo) {
+		fields = append(fields, violation.FieldReferenceNo)
+	}
+	if m.FieldCleared(violation.FieldOccurredAt) {
+		fields = append(fields, violation.FieldOccurredAt)
+	}
+	if m.FieldCleared(violation.FieldLocation) {
+		fields = append(fields, violation.FieldLocation)
+	}
+	if m.FieldCleared(violation.FieldAmount) {
+		fields = append(fields, violation.FieldAmount)
+	}
+	if m.FieldCleared(violation.FieldDescription) {
+		fields = append(fields, violation.FieldDescription)
+	}
+	if m.FieldCleared(violation.FieldResolvedAt) {
+		fields = append(fields, violation.FieldResolvedAt)
+	}
+	if m.FieldCleared(violation.FieldResolvedBy) {
+		fields = append(fields, violation.FieldResolvedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ViolationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ViolationMutation) ClearField(name string) error {
+	switch name {
+	case violation.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case violation.FieldCarID:
+		m.ClearCarID()
+		return nil
+	case violation.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case violation.FieldReferenceNo:
+		m.ClearReferenceNo()
+		return nil
+	case violation.FieldOccurredAt:
+		m.ClearOccurredAt()
+		return nil
+	case violation.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case violation.FieldAmount:
+		m.ClearAmount()
+		return nil
+	case violation.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case violation.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	case violation.FieldResolvedBy:
+		m.ClearResolvedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Violation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ViolationMutation) ResetField(name string) error {
+	switch name {
+	case violation.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case violation.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case violation.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case violation.FieldReferenceNo:
+		m.ResetReferenceNo()
+		return nil
+	case violation.FieldOccurredAt:
+		m.ResetOccurredAt()
+		return nil
+	case violation.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case violation.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case violation.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case violation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case violation.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	case violation.FieldResolvedBy:
+		m.ResetResolvedBy()
+		return nil
+	case violation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Violation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ViolationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car != nil {
+		edges = append(edges, violation.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ViolationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case violation.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ViolationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ViolationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ViolationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar {
+		edges = append(edges, violation.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ViolationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case violation.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ViolationMutation) ClearEdge(name string) error {
+	switch name {
+	case violation.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Violation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ViolationMutation) ResetEdge(name string) error {
+	switch name {
+	case violation.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown Violation edge %s", name)
+}
+
+// WarrantyDefinitionMutation represents an operation that mutates the WarrantyDefinition nodes in the graph.
+type WarrantyDefinitionMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int64
+	model_id              *int64
+	addmodel_id           *int64
+	name                  *string
+	components            *string
+	duration_months       *int
+	addduration_months    *int
+	mileage_limit         *int64
+	addmileage_limit      *int64
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	car_warranties        map[int64]struct{}
+	removedcar_warranties map[int64]struct{}
+	clearedcar_warranties bool
+	done                  bool
+	oldValue              func(context.Context) (*WarrantyDefinition, error)
+	predicates            []predicate.WarrantyDefinition
+}
+
+var _ ent.Mutation = (*WarrantyDefinitionMutation)(nil)
+
+// warrantydefinitionOption allows management of the mutation configuration using functional options.
+type warrantydefinitionOption func(*WarrantyDefinitionMutation)
+
+// newWarrantyDefinitionMutation creates new mutation for the WarrantyDefinition entity.
+func newWarrantyDefinitionMutation(c config, op Op, opts ...warrantydefinitionOption) *WarrantyDefinitionMutation {
+	m := &WarrantyDefinitionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWarrantyDefinition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWarrantyDefinitionID sets the ID field of the mutation.
+func withWarrantyDefinitionID(id int64) warrantydefinitionOption {
+	return func(m *WarrantyDefinitionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WarrantyDefinition
+		)
+		m.oldValue = func(ctx context.Context) (*WarrantyDefinition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WarrantyDefinition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWarrantyDefinition sets the old WarrantyDefinition of the mutation.
+func withWarrantyDefinition(node *WarrantyDefinition) warrantydefinitionOption {
+	return func(m *WarrantyDefinitionMutation) {
+		m.oldValue = func(context.Context) (*WarrantyDefinition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WarrantyDefinitionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WarrantyDefinitionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WarrantyDefinition entities.
+func (m *WarrantyDefinitionMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WarrantyDefinitionMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WarrantyDefinitionMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WarrantyDefinition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetModelID sets the "model_id" field.
+func (m *WarrantyDefinitionMutation) SetModelID(i int64) {
+	m.model_id = &i
+	m.addmodel_id = nil
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *WarrantyDefinitionMutation) ModelID() (r int64, exists bool) {
+	v := m.model_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the WarrantyDefinition entity.
+// If the WarrantyDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WarrantyDefinitionMutation) OldModelID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
+}
+
+// AddModelID adds i to the "model_id" field.
+func (m *WarrantyDefinitionMutation) AddModelID(i int64) {
+	if m.addmodel_id != nil {
+		*m.addmodel_id += i
+	} else {
+		m.addmodel_id = &i
+	}
+}
+
+// AddedModelID returns the value that was added to the "model_id" field in this mutation.
+func (m *WarrantyDefinitionMutation) AddedModelID() (r int64, exists bool) {
+	v := m.addmodel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (m *WarrantyDefinitionMutation) ClearModelID() {
+	m.model_id = nil
+	m.addmodel_id = nil
+	m.clearedFields[warrantydefinition.FieldModelID] = struct{}{}
+}
+
+// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
+func (m *WarrantyDefinitionMutation) ModelIDCleared() bool {
+	_, ok := m.clearedFields[warrantydefinition.FieldModelID]
+	return ok
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *WarrantyDefinitionMutation) ResetModelID() {
+	m.model_id = nil
+	m.addmodel_id = nil
+	delete(m.clearedFields, warrantydefinition.FieldModelID)
+}
+
+// SetName sets the "name" field.
+func (m *WarrantyDefinitionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WarrantyDefinitionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the WarrantyDefinition entity.
+// If the WarrantyDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WarrantyDefinitionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WarrantyDefinitionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetComponents sets the "components" field.
+func (m *WarrantyDefinitionMutation) SetComponents(s string) {
+	m.components = &s
+}
+
+// Components returns the value of the "components" field in the mutation.
+func (m *WarrantyDefinitionMutation) Components() (r string, exists bool) {
+	v := m.components
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComponents returns the old "components" field's value of the WarrantyDefinition entity.
+// If the WarrantyDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WarrantyDefinitionMutation) OldComponents(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComponents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComponents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComponents: %w", err)
+	}
+	return oldValue.Components, nil
+}
+
+// ClearComponents clears the value of the "components" field.
+func (m *WarrantyDefinitionMutation) ClearComponents() {
+	m.components = nil
+	m.clearedFields[warrantydefinition.FieldComponents] = struct{}{}
+}
+
+// ComponentsCleared returns if the "components" field was cleared in this mutation.
+func (m *WarrantyDefinitionMutation) ComponentsCleared() bool {
+	_, ok := m.clearedFields[warrantydefinition.FieldComponents]
+	return ok
 }
 
-// SetUserID sets the "user_id" field.
-func (m *CarMutation) SetUserID(i int64) {
-	m.user_id = &i
-	m.adduser_id = nil
+// ResetComponents resets all changes to the "components" field.
+func (m *WarrantyDefinitionMutation) ResetComponents() {
+	m.components = nil
+	delete(m.clearedFields, warrantydefinition.FieldComponents)
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *CarMutation) UserID() (r int64, exists bool) {
-	v := m.user_id
+// SetDurationMonths sets the "duration_months" field.
+func (m *WarrantyDefinitionMutation) SetDurationMonths(i int) {
+	m.duration_months = &i
+	m.addduration_months = nil
+}
+
+// DurationMonths returns the value of the "duration_months" field in the mutation.
+func (m *WarrantyDefinitionMutation) DurationMonths() (r int, exists bool) {
+	v := m.duration_months
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Car entity.
-// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMonths returns the old "duration_months" field's value of the WarrantyDefinition entity.
+// If the WarrantyDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarMutation) OldUserID(ctx context.Context) (v int64, err error) {
+func (m *WarrantyDefinitionMutation) OldDurationMonths(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMonths is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldDurationMonths requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMonths: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.DurationMonths, nil
 }
 
-// AddUserID adds i to the "user_id" field.
-func (m *CarMutation) AddUserID(i int64) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
+// AddDurationMonths adds i to the "duration_months" field.
+func (m *WarrantyDefinitionMutation) AddDurationMonths(i int) {
+	if m.addduration_months != nil {
+		*m.addduration_months += i
 	} else {
-		m.adduser_id = &i
+		m.addduration_months = &i
 	}
 }
 
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *CarMutation) AddedUserID() (r int64, exists bool) {
-	v := m.adduser_id
+// AddedDurationMonths returns the value that was added to the "duration_months" field in this mutation.
+func (m *WarrantyDefinitionMutation) AddedDurationMonths() (r int, exists bool) {
+	v := m.addduration_months
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearUserID clears the value of the "user_id" field.
-func (m *CarMutation) ClearUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
-	m.clearedFields[car.FieldUserID] = struct{}{}
+// ClearDurationMonths clears the value of the "duration_months" field.
+func (m *WarrantyDefinitionMutation) ClearDurationMonths() {
+	m.duration_months = nil
+	m.addduration_months = nil
+	m.clearedFields[warrantydefinition.FieldDurationMonths] = struct{}{}
 }
 
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *CarMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[car.FieldUserID]
+// DurationMonthsCleared returns if the "duration_months" field was cleared in this mutation.
+func (m *WarrantyDefinitionMutation) DurationMonthsCleared() bool {
+	_, ok := m.clearedFields[warrantydefinition.FieldDurationMonths]
 	return ok
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *CarMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
-	delete(m.clearedFields, car.FieldUserID)
+// ResetDurationMonths resets all changes to the "duration_months" field.
+func (m *WarrantyDefinitionMutation) ResetDurationMonths() {
+	m.duration_months = nil
+	m.addduration_months = nil
+	delete(m.clearedFields, warrantydefinition.FieldDurationMonths)
 }
 
-// SetModel sets the "model" field.
-func (m *CarMutation) SetModel(s string) {
-	m.model = &s
+// SetMileageLimit sets the "mileage_limit" field.
+func (m *WarrantyDefinitionMutation) SetMileageLimit(i int64) {
+	m.mileage_limit = &i
+	m.addmileage_limit = nil
 }
 
-// Model returns the value of the "model" field in the mutation.
-func (m *CarMutation) Model() (r string, exists bool) {
-	v := m.model
+// MileageLimit returns the value of the "mileage_limit" field in the mutation.
+func (m *WarrantyDefinitionMutation) MileageLimit() (r int64, exists bool) {
+	v := m.mileage_limit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModel returns the old "model" field's value of the Car entity.
-// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// OldMileageLimit returns the old "mileage_limit" field's value of the WarrantyDefinition entity.
+// If the WarrantyDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarMutation) OldModel(ctx context.Context) (v string, err error) {
+func (m *WarrantyDefinitionMutation) OldMileageLimit(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldMileageLimit is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
+		return v, errors.New("OldMileageLimit requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldMileageLimit: %w", err)
 	}
-	return oldValue.Model, nil
+	return oldValue.MileageLimit, nil
 }
 
-// ClearModel clears the value of the "model" field.
-func (m *CarMutation) ClearModel() {
-	m.model = nil
-	m.clearedFields[car.FieldModel] = struct{}{}
+// AddMileageLimit adds i to the "mileage_limit" field.
+func (m *WarrantyDefinitionMutation) AddMileageLimit(i int64) {
+	if m.addmileage_limit != nil {
+		*m.addmileage_limit += i
+	} else {
+		m.addmileage_limit = &i
+	}
 }
 
-// ModelCleared returns if the "model" field was cleared in this mutation.
-func (m *CarMutation) ModelCleared() bool {
-	_, ok := m.clearedFields[car.FieldModel]
+// AddedMileageLimit returns the value that was added to the "mileage_limit" field in this mutation.
+func (m *WarrantyDefinitionMutation) AddedMileageLimit() (r int64, exists bool) {
+	v := m.addmileage_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMileageLimit clears the value of the "mileage_limit" field.
+func (m *WarrantyDefinitionMutation) ClearMileageLimit() {
+	m.mileage_limit = nil
+	m.addmileage_limit = nil
+	m.clearedFields[warrantydefinition.FieldMileageLimit] = struct{}{}
+}
+
+// MileageLimitCleared returns if the "mileage_limit" field was cleared in this mutation.
+func (m *WarrantyDefinitionMutation) MileageLimitCleared() bool {
+	_, ok := m.clearedFields[warrantydefinition.FieldMileageLimit]
 	return ok
 }
 
-// ResetModel resets all changes to the "model" field.
-func (m *CarMutation) ResetModel() {
-	m.model = nil
-	delete(m.clearedFields, car.FieldModel)
+// ResetMileageLimit resets all changes to the "mileage_limit" field.
+func (m *WarrantyDefinitionMutation) ResetMileageLimit() {
+	m.mileage_limit = nil
+	m.addmileage_limit = nil
+	delete(m.clearedFields, warrantydefinition.FieldMileageLimit)
 }
 
-// SetRegisteredAt sets the "registered_at" field.
-func (m *CarMutation) SetRegisteredAt(t time.Time) {
-	m.registered_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *WarrantyDefinitionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RegisteredAt returns the value of the "registered_at" field in the mutation.
-func (m *CarMutation) RegisteredAt() (r time.Time, exists bool) {
-	v := m.registered_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WarrantyDefinitionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegisteredAt returns the old "registered_at" field's value of the Car entity.
-// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WarrantyDefinition entity.
+// If the WarrantyDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarMutation) OldRegisteredAt(ctx context.Context) (v time.Time, err error) {
+func (m *WarrantyDefinitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegisteredAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegisteredAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegisteredAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.RegisteredAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetRegisteredAt resets all changes to the "registered_at" field.
-func (m *CarMutation) ResetRegisteredAt() {
-	m.registered_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WarrantyDefinitionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the CarMutation builder.
-func (m *CarMutation) Where(ps ...predicate.Car) {
+// AddCarWarrantyIDs adds the "car_warranties" edge to the CarWarranty entity by ids.
+func (m *WarrantyDefinitionMutation) AddCarWarrantyIDs(ids ...int64) {
+	if m.car_warranties == nil {
+		m.car_warranties = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.car_warranties[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCarWarranties clears the "car_warranties" edge to the CarWarranty entity.
+func (m *WarrantyDefinitionMutation) ClearCarWarranties() {
+	m.clearedcar_warranties = true
+}
+
+// CarWarrantiesCleared reports if the "car_warranties" edge to the CarWarranty entity was cleared.
+func (m *WarrantyDefinitionMutation) CarWarrantiesCleared() bool {
+	return m.clearedcar_warranties
+}
+
+// RemoveCarWarrantyIDs removes the "car_warranties" edge to the CarWarranty entity by IDs.
+func (m *WarrantyDefinitionMutation) RemoveCarWarrantyIDs(ids ...int64) {
+	if m.removedcar_warranties == nil {
+		m.removedcar_warranties = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.car_warranties, ids[i])
+		m.removedcar_warranties[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCarWarranties returns the removed IDs of the "car_warranties" edge to the CarWarranty entity.
+func (m *WarrantyDefinitionMutation) RemovedCarWarrantiesIDs() (ids []int64) {
+	for id := range m.removedcar_warranties {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CarWarrantiesIDs returns the "car_warranties" edge IDs in the mutation.
+func (m *WarrantyDefinitionMutation) CarWarrantiesIDs() (ids []int64) {
+	for id := range m.car_warranties {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCarWarranties resets all changes to the "car_warranties" edge.
+func (m *WarrantyDefinitionMutation) ResetCarWarranties() {
+	m.car_warranties = nil
+	m.clearedcar_warranties = false
+	m.removedcar_warranties = nil
+}
+
+// Where appends a list predicates to the WarrantyDefinitionMutation builder.
+func (m *WarrantyDefinitionMutation) Where(ps ...predicate.WarrantyDefinition) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *CarMutation) Op() Op {
+func (m *WarrantyDefinitionMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Car).
-func (m *CarMutation) Type() string {
+// Type returns the node type of this mutation (WarrantyDefinition).
+func (m *WarrantyDefinitionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CarMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.user_id != nil {
-		fields = append(fields, car.FieldUserID)
+func (m *WarrantyDefinitionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.model_id != nil {
+		fields = append(fields, warrantydefinition.FieldModelID)
 	}
-	if m.model != nil {
-		fields = append(fields, car.FieldModel)
+	if m.name != nil {
+		fields = append(fields, warrantydefinition.FieldName)
 	}
-	if m.registered_at != nil {
-		fields = append(fields, car.FieldRegisteredAt)
+	if m.components != nil {
+		fields = append(fields, warrantydefinition.FieldComponents)
+	}
+	if m.duration_months != nil {
+		fields = append(fields, warrantydefinition.FieldDurationMonths)
+	}
+	if m.mileage_limit != nil {
+		fields = append(fields, warrantydefinition.FieldMileageLimit)
+	}
+	if m.created_at != nil {
+		fields = append(fields, warrantydefinition.FieldCreatedAt)
 	}
 	return fields
 }
@@ -336,14 +30784,20 @@ func (m *CarMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CarMutation) Field(name string) (ent.Value, bool) {
+func (m *WarrantyDefinitionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case car.FieldUserID:
-		return m.UserID()
-	case car.FieldModel:
-		return m.Model()
-	case car.FieldRegisteredAt:
-		return m.RegisteredAt()
+	case warrantydefinition.FieldModelID:
+		return m.ModelID()
+	case warrantydefinition.FieldName:
+		return m.Name()
+	case warrantydefinition.FieldComponents:
+		return m.Components()
+	case warrantydefinition.FieldDurationMonths:
+		return m.DurationMonths()
+	case warrantydefinition.FieldMileageLimit:
+		return m.MileageLimit()
+	case warrantydefinition.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -351,54 +30805,87 @@ func (m *CarMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CarMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WarrantyDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case car.FieldUserID:
-		return m.OldUserID(ctx)
-	case car.FieldModel:
-		return m.OldModel(ctx)
-	case car.FieldRegisteredAt:
-		return m.OldRegisteredAt(ctx)
+	case warrantydefinition.FieldModelID:
+		return m.OldModelID(ctx)
+	case warrantydefinition.FieldName:
+		return m.OldName(ctx)
+	case warrantydefinition.FieldComponents:
+		return m.OldComponents(ctx)
+	case warrantydefinition.FieldDurationMonths:
+		return m.OldDurationMonths(ctx)
+	case warrantydefinition.FieldMileageLimit:
+		return m.OldMileageLimit(ctx)
+	case warrantydefinition.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Car field %s", name)
+	return nil, fmt.Errorf("unknown WarrantyDefinition field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CarMutation) SetField(name string, value ent.Value) error {
+func (m *WarrantyDefinitionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case car.FieldUserID:
+	case warrantydefinition.FieldModelID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetModelID(v)
 		return nil
-	case car.FieldModel:
+	case warrantydefinition.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModel(v)
+		m.SetName(v)
 		return nil
-	case car.FieldRegisteredAt:
+	case warrantydefinition.FieldComponents:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComponents(v)
+		return nil
+	case warrantydefinition.FieldDurationMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMonths(v)
+		return nil
+	case warrantydefinition.FieldMileageLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMileageLimit(v)
+		return nil
+	case warrantydefinition.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegisteredAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Car field %s", name)
+	return fmt.Errorf("unknown WarrantyDefinition field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CarMutation) AddedFields() []string {
+func (m *WarrantyDefinitionMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, car.FieldUserID)
+	if m.addmodel_id != nil {
+		fields = append(fields, warrantydefinition.FieldModelID)
+	}
+	if m.addduration_months != nil {
+		fields = append(fields, warrantydefinition.FieldDurationMonths)
+	}
+	if m.addmileage_limit != nil {
+		fields = append(fields, warrantydefinition.FieldMileageLimit)
 	}
 	return fields
 }
@@ -406,10 +30893,14 @@ func (m *CarMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CarMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WarrantyDefinitionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case car.FieldUserID:
-		return m.AddedUserID()
+	case warrantydefinition.FieldModelID:
+		return m.AddedModelID()
+	case warrantydefinition.FieldDurationMonths:
+		return m.AddedDurationMonths()
+	case warrantydefinition.FieldMileageLimit:
+		return m.AddedMileageLimit()
 	}
 	return nil, false
 }
@@ -417,114 +30908,185 @@ func (m *CarMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CarMutation) AddField(name string, value ent.Value) error {
+func (m *WarrantyDefinitionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case car.FieldUserID:
+	case warrantydefinition.FieldModelID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUserID(v)
+		m.AddModelID(v)
+		return nil
+	case warrantydefinition.FieldDurationMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMonths(v)
+		return nil
+	case warrantydefinition.FieldMileageLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMileageLimit(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Car numeric field %s", name)
+	return fmt.Errorf("unknown WarrantyDefinition numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CarMutation) ClearedFields() []string {
+func (m *WarrantyDefinitionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(car.FieldUserID) {
-		fields = append(fields, car.FieldUserID)
+	if m.FieldCleared(warrantydefinition.FieldModelID) {
+		fields = append(fields, warrantydefinition.FieldModelID)
 	}
-	if m.FieldCleared(car.FieldModel) {
-		fields = append(fields, car.FieldModel)
+	if m.FieldCleared(warrantydefinition.FieldComponents) {
+		fields = append(fields, warrantydefinition.FieldComponents)
+	}
+	if m.FieldCleared(warrantydefinition.FieldDurationMonths) {
+		fields = append(fields, warrantydefinition.FieldDurationMonths)
+	}
+	if m.FieldCleared(warrantydefinition.FieldMileageLimit) {
+		fields = append(fields, warrantydefinition.FieldMileageLimit)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CarMutation) FieldCleared(name string) bool {
+func (m *WarrantyDefinitionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CarMutation) ClearField(name string) error {
+func (m *WarrantyDefinitionMutation) ClearField(name string) error {
 	switch name {
-	case car.FieldUserID:
-		m.ClearUserID()
+	case warrantydefinition.FieldModelID:
+		m.ClearModelID()
 		return nil
-	case car.FieldModel:
-		m.ClearModel()
+	case warrantydefinition.FieldComponents:
+		m.ClearComponents()
+		return nil
+	case warrantydefinition.FieldDurationMonths:
+		m.ClearDurationMonths()
+		return nil
+	case warrantydefinition.FieldMileageLimit:
+		m.ClearMileageLimit()
 		return nil
 	}
-	return fmt.Errorf("unknown Car nullable field %s", name)
+	return fmt.Errorf("unknown WarrantyDefinition nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CarMutation) ResetField(name string) error {
+func (m *WarrantyDefinitionMutation) ResetField(name string) error {
 	switch name {
-	case car.FieldUserID:
-		m.ResetUserID()
+	case warrantydefinition.FieldModelID:
+		m.ResetModelID()
 		return nil
-	case car.FieldModel:
-		m.ResetModel()
+	case warrantydefinition.FieldName:
+		m.ResetName()
 		return nil
-	case car.FieldRegisteredAt:
-		m.ResetRegisteredAt()
+	case warrantydefinition.FieldComponents:
+		m.ResetComponents()
+		return nil
+	case warrantydefinition.FieldDurationMonths:
+		m.ResetDurationMonths()
+		return nil
+	case warrantydefinition.FieldMileageLimit:
+		m.ResetMileageLimit()
+		return nil
+	case warrantydefinition.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Car field %s", name)
+	return fmt.Errorf("unknown WarrantyDefinition field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CarMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WarrantyDefinitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.car_warranties != nil {
+		edges = append(edges, warrantydefinition.EdgeCarWarranties)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CarMutation) AddedIDs(name string) []ent.Value {
+func (m *WarrantyDefinitionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case warrantydefinition.EdgeCarWarranties:
+		ids := make([]ent.Value, 0, len(m.car_warranties))
+		for id := range m.car_warranties {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CarMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WarrantyDefinitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcar_warranties != nil {
+		edges = append(edges, warrantydefinition.EdgeCarWarranties)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CarMutation) RemovedIDs(name string) []ent.Value {
+func (m *WarrantyDefinitionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case warrantydefinition.EdgeCarWarranties:
+		ids := make([]ent.Value, 0, len(m.removedcar_warranties))
+		for id := range m.removedcar_warranties {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CarMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WarrantyDefinitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcar_warranties {
+		edges = append(edges, warrantydefinition.EdgeCarWarranties)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CarMutation) EdgeCleared(name string) bool {
+func (m *WarrantyDefinitionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case warrantydefinition.EdgeCarWarranties:
+		return m.clearedcar_warranties
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CarMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Car unique edge %s", name)
+func (m *WarrantyDefinitionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WarrantyDefinition unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CarMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Car edge %s", name)
+func (m *WarrantyDefinitionMutation) ResetEdge(name string) error {
+	switch name {
+	case warrantydefinition.EdgeCarWarranties:
+		m.ResetCarWarranties()
+		return nil
+	}
+	return fmt.Errorf("unknown WarrantyDefinition edge %s", name)
 }
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/odometerreading"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// OdometerReading is the model entity for the OdometerReading schema.
+type OdometerReading struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// Mileage holds the value of the "mileage" field.
+	Mileage int64 `json:"mileage,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Suspicious holds the value of the "suspicious" field.
+	Suspicious bool `json:"suspicious,omitempty"`
+	// RecordedAt holds the value of the "recorded_at" field.
+	RecordedAt time.Time `json:"recorded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OdometerReadingQuery when eager-loading is set.
+	Edges OdometerReadingEdges `json:"edges"`
+}
+
+// OdometerReadingEdges holds the relations/edges for other nodes in the graph.
+type OdometerReadingEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OdometerReadingEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OdometerReading) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case odometerreading.FieldSuspicious:
+			values[i] = new(sql.NullBool)
+		case odometerreading.FieldID, odometerreading.FieldTenantID, odometerreading.FieldCarID, odometerreading.FieldMileage:
+			values[i] = new(sql.NullInt64)
+		case odometerreading.FieldSource:
+			values[i] = new(sql.NullString)
+		case odometerreading.FieldRecordedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type OdometerReading", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OdometerReading fields.
+func (or *OdometerReading) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case odometerreading.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			or.ID = int64(value.Int64)
+		case odometerreading.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				or.TenantID = value.Int64
+			}
+		case odometerreading.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				or.CarID = value.Int64
+			}
+		case odometerreading.FieldMileage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mileage", values[i])
+			} else if value.Valid {
+				or.Mileage = value.Int64
+			}
+		case odometerreading.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				or.Source = value.String
+			}
+		case odometerreading.FieldSuspicious:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field suspicious", values[i])
+			} else if value.Valid {
+				or.Suspicious = value.Bool
+			}
+		case odometerreading.FieldRecordedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recorded_at", values[i])
+			} else if value.Valid {
+				or.RecordedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the OdometerReading entity.
+func (or *OdometerReading) QueryCar() *CarQuery {
+	return (&OdometerReadingClient{config: or.config}).QueryCar(or)
+}
+
+// Update returns a builder for updating this OdometerReading.
+// Note that you need to call OdometerReading.Unwrap() before calling this method if this OdometerReading
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (or *OdometerReading) Update() *OdometerReadingUpdateOne {
+	return (&OdometerReadingClient{config: or.config}).UpdateOne(or)
+}
+
+// Unwrap unwraps the OdometerReading entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (or *OdometerReading) Unwrap() *OdometerReading {
+	_tx, ok := or.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OdometerReading is not a transactional entity")
+	}
+	or.config.driver = _tx.drv
+	return or
+}
+
+// String implements the fmt.Stringer.
+func (or *OdometerReading) String() string {
+	var builder strings.Builder
+	builder.WriteString("OdometerReading(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", or.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", or.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", or.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("mileage=")
+	builder.WriteString(fmt.Sprintf("%v", or.Mileage))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(or.Source)
+	builder.WriteString(", ")
+	builder.WriteString("suspicious=")
+	builder.WriteString(fmt.Sprintf("%v", or.Suspicious))
+	builder.WriteString(", ")
+	builder.WriteString("recorded_at=")
+	builder.WriteString(or.RecordedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OdometerReadings is a parsable slice of OdometerReading.
+type OdometerReadings []*OdometerReading
+
+func (or OdometerReadings) config(cfg config) {
+	for _i := range or {
+		or[_i].config = cfg
+	}
+}
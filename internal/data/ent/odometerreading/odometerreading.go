@@ -0,0 +1,75 @@
+// Code generated by ent, DO NOT EDIT.
+
+package odometerreading
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the odometerreading type in the database.
+	Label = "odometer_reading"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldMileage holds the string denoting the mileage field in the database.
+	FieldMileage = "mileage"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldSuspicious holds the string denoting the suspicious field in the database.
+	FieldSuspicious = "suspicious"
+	// FieldRecordedAt holds the string denoting the recorded_at field in the database.
+	FieldRecordedAt = "recorded_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// Table holds the table name of the odometerreading in the database.
+	Table = "odometer_reading"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "odometer_reading"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+)
+
+// Columns holds all SQL columns for odometerreading fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldMileage,
+	FieldSource,
+	FieldSuspicious,
+	FieldRecordedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultSuspicious holds the default value on creation for the "suspicious" field.
+	DefaultSuspicious bool
+	// DefaultRecordedAt holds the default value on creation for the "recorded_at" field.
+	DefaultRecordedAt func() time.Time
+)
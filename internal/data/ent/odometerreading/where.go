@@ -0,0 +1,641 @@
+// Code generated by ent, DO NOT EDIT.
+
+package odometerreading
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// Mileage applies equality check predicate on the "mileage" field. It's identical to MileageEQ.
+func Mileage(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileage), v))
+	})
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// Suspicious applies equality check predicate on the "suspicious" field. It's identical to SuspiciousEQ.
+func Suspicious(v bool) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSuspicious), v))
+	})
+}
+
+// RecordedAt applies equality check predicate on the "recorded_at" field. It's identical to RecordedAtEQ.
+func RecordedAt(v time.Time) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecordedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// MileageEQ applies the EQ predicate on the "mileage" field.
+func MileageEQ(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileage), v))
+	})
+}
+
+// MileageNEQ applies the NEQ predicate on the "mileage" field.
+func MileageNEQ(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMileage), v))
+	})
+}
+
+// MileageIn applies the In predicate on the "mileage" field.
+func MileageIn(vs ...int64) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMileage), v...))
+	})
+}
+
+// MileageNotIn applies the NotIn predicate on the "mileage" field.
+func MileageNotIn(vs ...int64) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMileage), v...))
+	})
+}
+
+// MileageGT applies the GT predicate on the "mileage" field.
+func MileageGT(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMileage), v))
+	})
+}
+
+// MileageGTE applies the GTE predicate on the "mileage" field.
+func MileageGTE(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMileage), v))
+	})
+}
+
+// MileageLT applies the LT predicate on the "mileage" field.
+func MileageLT(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMileage), v))
+	})
+}
+
+// MileageLTE applies the LTE predicate on the "mileage" field.
+func MileageLTE(v int64) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMileage), v))
+	})
+}
+
+// MileageIsNil applies the IsNil predicate on the "mileage" field.
+func MileageIsNil() predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMileage)))
+	})
+}
+
+// MileageNotNil applies the NotNil predicate on the "mileage" field.
+func MileageNotNil() predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMileage)))
+	})
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSource), v...))
+	})
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSource), v...))
+	})
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSource), v))
+	})
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSource), v))
+	})
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSource), v))
+	})
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSource)))
+	})
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSource)))
+	})
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSource), v))
+	})
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSource), v))
+	})
+}
+
+// SuspiciousEQ applies the EQ predicate on the "suspicious" field.
+func SuspiciousEQ(v bool) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSuspicious), v))
+	})
+}
+
+// SuspiciousNEQ applies the NEQ predicate on the "suspicious" field.
+func SuspiciousNEQ(v bool) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSuspicious), v))
+	})
+}
+
+// RecordedAtEQ applies the EQ predicate on the "recorded_at" field.
+func RecordedAtEQ(v time.Time) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecordedAt), v))
+	})
+}
+
+// RecordedAtNEQ applies the NEQ predicate on the "recorded_at" field.
+func RecordedAtNEQ(v time.Time) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRecordedAt), v))
+	})
+}
+
+// RecordedAtIn applies the In predicate on the "recorded_at" field.
+func RecordedAtIn(vs ...time.Time) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRecordedAt), v...))
+	})
+}
+
+// RecordedAtNotIn applies the NotIn predicate on the "recorded_at" field.
+func RecordedAtNotIn(vs ...time.Time) predicate.OdometerReading {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRecordedAt), v...))
+	})
+}
+
+// RecordedAtGT applies the GT predicate on the "recorded_at" field.
+func RecordedAtGT(v time.Time) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRecordedAt), v))
+	})
+}
+
+// RecordedAtGTE applies the GTE predicate on the "recorded_at" field.
+func RecordedAtGTE(v time.Time) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRecordedAt), v))
+	})
+}
+
+// RecordedAtLT applies the LT predicate on the "recorded_at" field.
+func RecordedAtLT(v time.Time) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRecordedAt), v))
+	})
+}
+
+// RecordedAtLTE applies the LTE predicate on the "recorded_at" field.
+func RecordedAtLTE(v time.Time) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRecordedAt), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OdometerReading) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OdometerReading) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OdometerReading) predicate.OdometerReading {
+	return predicate.OdometerReading(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
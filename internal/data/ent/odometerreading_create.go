@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/odometerreading"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OdometerReadingCreate is the builder for creating a OdometerReading entity.
+type OdometerReadingCreate struct {
+	config
+	mutation *OdometerReadingMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (orc *OdometerReadingCreate) SetTenantID(i int64) *OdometerReadingCreate {
+	orc.mutation.SetTenantID(i)
+	return orc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (orc *OdometerReadingCreate) SetNillableTenantID(i *int64) *OdometerReadingCreate {
+	if i != nil {
+		orc.SetTenantID(*i)
+	}
+	return orc
+}
+
+// SetCarID sets the "car_id" field.
+func (orc *OdometerReadingCreate) SetCarID(i int64) *OdometerReadingCreate {
+	orc.mutation.SetCarID(i)
+	return orc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (orc *OdometerReadingCreate) SetNillableCarID(i *int64) *OdometerReadingCreate {
+	if i != nil {
+		orc.SetCarID(*i)
+	}
+	return orc
+}
+
+// SetMileage sets the "mileage" field.
+func (orc *OdometerReadingCreate) SetMileage(i int64) *OdometerReadingCreate {
+	orc.mutation.SetMileage(i)
+	return orc
+}
+
+// SetNillableMileage sets the "mileage" field if the given value is not nil.
+func (orc *OdometerReadingCreate) SetNillableMileage(i *int64) *OdometerReadingCreate {
+	if i != nil {
+		orc.SetMileage(*i)
+	}
+	return orc
+}
+
+// SetSource sets the "source" field.
+func (orc *OdometerReadingCreate) SetSource(s string) *OdometerReadingCreate {
+	orc.mutation.SetSource(s)
+	return orc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (orc *OdometerReadingCreate) SetNillableSource(s *string) *OdometerReadingCreate {
+	if s != nil {
+		orc.SetSource(*s)
+	}
+	return orc
+}
+
+// SetSuspicious sets the "suspicious" field.
+func (orc *OdometerReadingCreate) SetSuspicious(b bool) *OdometerReadingCreate {
+	orc.mutation.SetSuspicious(b)
+	return orc
+}
+
+// SetNillableSuspicious sets the "suspicious" field if the given value is not nil.
+func (orc *OdometerReadingCreate) SetNillableSuspicious(b *bool) *OdometerReadingCreate {
+	if b != nil {
+		orc.SetSuspicious(*b)
+	}
+	return orc
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (orc *OdometerReadingCreate) SetRecordedAt(t time.Time) *OdometerReadingCreate {
+	orc.mutation.SetRecordedAt(t)
+	return orc
+}
+
+// SetNillableRecordedAt sets the "recorded_at" field if the given value is not nil.
+func (orc *OdometerReadingCreate) SetNillableRecordedAt(t *time.Time) *OdometerReadingCreate {
+	if t != nil {
+		orc.SetRecordedAt(*t)
+	}
+	return orc
+}
+
+// SetID sets the "id" field.
+func (orc *OdometerReadingCreate) SetID(i int64) *OdometerReadingCreate {
+	orc.mutation.SetID(i)
+	return orc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (orc *OdometerReadingCreate) SetCar(c *Car) *OdometerReadingCreate {
+	return orc.SetCarID(c.ID)
+}
+
+// Mutation returns the OdometerReadingMutation object of the builder.
+func (orc *OdometerReadingCreate) Mutation() *OdometerReadingMutation {
+	return orc.mutation
+}
+
+// Save creates the OdometerReading in the database.
+func (orc *OdometerReadingCreate) Save(ctx context.Context) (*OdometerReading, error) {
+	var (
+		err  error
+		node *OdometerReading
+	)
+	if err := orc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(orc.hooks) == 0 {
+		if err = orc.check(); err != nil {
+			return nil, err
+		}
+		node, err = orc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OdometerReadingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = orc.check(); err != nil {
+				return nil, err
+			}
+			orc.mutation = mutation
+			if node, err = orc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(orc.hooks) - 1; i >= 0; i-- {
+			if orc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = orc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, orc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*OdometerReading)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from OdometerReadingMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (orc *OdometerReadingCreate) SaveX(ctx context.Context) *OdometerReading {
+	v, err := orc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (orc *OdometerReadingCreate) Exec(ctx context.Context) error {
+	_, err := orc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (orc *OdometerReadingCreate) ExecX(ctx context.Context) {
+	if err := orc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (orc *OdometerReadingCreate) defaults() error {
+	if _, ok := orc.mutation.Suspicious(); !ok {
+		v := odometerreading.DefaultSuspicious
+		orc.mutation.SetSuspicious(v)
+	}
+	if _, ok := orc.mutation.RecordedAt(); !ok {
+		if odometerreading.DefaultRecordedAt == nil {
+			return fmt.Errorf("ent: uninitialized odometerreading.DefaultRecordedAt (forgotten import ent/runtime?)")
+		}
+		v := odometerreading.DefaultRecordedAt()
+		orc.mutation.SetRecordedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (orc *OdometerReadingCreate) check() error {
+	if _, ok := orc.mutation.Suspicious(); !ok {
+		return &ValidationError{Name: "suspicious", err: errors.New(`ent: missing required field "OdometerReading.suspicious"`)}
+	}
+	if _, ok := orc.mutation.RecordedAt(); !ok {
+		return &ValidationError{Name: "recorded_at", err: errors.New(`ent: missing required field "OdometerReading.recorded_at"`)}
+	}
+	return nil
+}
+
+func (orc *OdometerReadingCreate) sqlSave(ctx context.Context) (*OdometerReading, error) {
+	_node, _spec := orc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, orc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (orc *OdometerReadingCreate) createSpec() (*OdometerReading, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OdometerReading{config: orc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: odometerreading.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: odometerreading.FieldID,
+			},
+		}
+	)
+	if id, ok := orc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := orc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := orc.mutation.Mileage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldMileage,
+		})
+		_node.Mileage = value
+	}
+	if value, ok := orc.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: odometerreading.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := orc.mutation.Suspicious(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: odometerreading.FieldSuspicious,
+		})
+		_node.Suspicious = value
+	}
+	if value, ok := orc.mutation.RecordedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: odometerreading.FieldRecordedAt,
+		})
+		_node.RecordedAt = value
+	}
+	if nodes := orc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   odometerreading.CarTable,
+			Columns: []string{odometerreading.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OdometerReadingCreateBulk is the builder for creating many OdometerReading entities in bulk.
+type OdometerReadingCreateBulk struct {
+	config
+	builders []*OdometerReadingCreate
+}
+
+// Save creates the OdometerReading entities in the database.
+func (orcb *OdometerReadingCreateBulk) Save(ctx context.Context) ([]*OdometerReading, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(orcb.builders))
+	nodes := make([]*OdometerReading, len(orcb.builders))
+	mutators := make([]Mutator, len(orcb.builders))
+	for i := range orcb.builders {
+		func(i int, root context.Context) {
+			builder := orcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OdometerReadingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, orcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, orcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, orcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (orcb *OdometerReadingCreateBulk) SaveX(ctx context.Context) []*OdometerReading {
+	v, err := orcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (orcb *OdometerReadingCreateBulk) Exec(ctx context.Context) error {
+	_, err := orcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (orcb *OdometerReadingCreateBulk) ExecX(ctx context.Context) {
+	if err := orcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
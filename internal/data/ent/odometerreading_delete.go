@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OdometerReadingDelete is the builder for deleting a OdometerReading entity.
+type OdometerReadingDelete struct {
+	config
+	hooks    []Hook
+	mutation *OdometerReadingMutation
+}
+
+// Where appends a list predicates to the OdometerReadingDelete builder.
+func (ord *OdometerReadingDelete) Where(ps ...predicate.OdometerReading) *OdometerReadingDelete {
+	ord.mutation.Where(ps...)
+	return ord
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ord *OdometerReadingDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ord.hooks) == 0 {
+		affected, err = ord.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OdometerReadingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ord.mutation = mutation
+			affected, err = ord.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ord.hooks) - 1; i >= 0; i-- {
+			if ord.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ord.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ord.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ord *OdometerReadingDelete) ExecX(ctx context.Context) int {
+	n, err := ord.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ord *OdometerReadingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: odometerreading.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: odometerreading.FieldID,
+			},
+		},
+	}
+	if ps := ord.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ord.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// OdometerReadingDeleteOne is the builder for deleting a single OdometerReading entity.
+type OdometerReadingDeleteOne struct {
+	ord *OdometerReadingDelete
+}
+
+// Exec executes the deletion query.
+func (ordo *OdometerReadingDeleteOne) Exec(ctx context.Context) error {
+	n, err := ordo.ord.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{odometerreading.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ordo *OdometerReadingDeleteOne) ExecX(ctx context.Context) {
+	ordo.ord.ExecX(ctx)
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OdometerReadingQuery is the builder for querying OdometerReading entities.
+type OdometerReadingQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.OdometerReading
+	// eager-loading edges.
+	withCar   *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OdometerReadingQuery builder.
+func (orq *OdometerReadingQuery) Where(ps ...predicate.OdometerReading) *OdometerReadingQuery {
+	orq.predicates = append(orq.predicates, ps...)
+	return orq
+}
+
+// Limit adds a limit step to the query.
+func (orq *OdometerReadingQuery) Limit(limit int) *OdometerReadingQuery {
+	orq.limit = &limit
+	return orq
+}
+
+// Offset adds an offset step to the query.
+func (orq *OdometerReadingQuery) Offset(offset int) *OdometerReadingQuery {
+	orq.offset = &offset
+	return orq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (orq *OdometerReadingQuery) Unique(unique bool) *OdometerReadingQuery {
+	orq.unique = &unique
+	return orq
+}
+
+// Order adds an order step to the query.
+func (orq *OdometerReadingQuery) Order(o ...OrderFunc) *OdometerReadingQuery {
+	orq.order = append(orq.order, o...)
+	return orq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (orq *OdometerReadingQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: orq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := orq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := orq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(odometerreading.Table, odometerreading.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, odometerreading.CarTable, odometerreading.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(orq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OdometerReading entity from the query.
+// Returns a *NotFoundError when no OdometerReading was found.
+func (orq *OdometerReadingQuery) First(ctx context.Context) (*OdometerReading, error) {
+	nodes, err := orq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{odometerreading.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (orq *OdometerReadingQuery) FirstX(ctx context.Context) *OdometerReading {
+	node, err := orq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OdometerReading ID from the query.
+// Returns a *NotFoundError when no OdometerReading ID was found.
+func (orq *OdometerReadingQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = orq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{odometerreading.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (orq *OdometerReadingQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := orq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OdometerReading entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OdometerReading entity is found.
+// Returns a *NotFoundError when no OdometerReading entities are found.
+func (orq *OdometerReadingQuery) Only(ctx context.Context) (*OdometerReading, error) {
+	nodes, err := orq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{odometerreading.Label}
+	default:
+		return nil, &NotSingularError{odometerreading.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (orq *OdometerReadingQuery) OnlyX(ctx context.Context) *OdometerReading {
+	node, err := orq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OdometerReading ID in the query.
+// Returns a *NotSingularError when more than one OdometerReading ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (orq *OdometerReadingQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = orq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{odometerreading.Label}
+	default:
+		err = &NotSingularError{odometerreading.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (orq *OdometerReadingQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := orq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OdometerReadings.
+func (orq *OdometerReadingQuery) All(ctx context.Context) ([]*OdometerReading, error) {
+	if err := orq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return orq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (orq *OdometerReadingQuery) AllX(ctx context.Context) []*OdometerReading {
+	nodes, err := orq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OdometerReading IDs.
+func (orq *OdometerReadingQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := orq.Select(odometerreading.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (orq *OdometerReadingQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := orq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (orq *OdometerReadingQuery) Count(ctx context.Context) (int, error) {
+	if err := orq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return orq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (orq *OdometerReadingQuery) CountX(ctx context.Context) int {
+	count, err := orq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (orq *OdometerReadingQuery) Exist(ctx context.Context) (bool, error) {
+	if err := orq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return orq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (orq *OdometerReadingQuery) ExistX(ctx context.Context) bool {
+	exist, err := orq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OdometerReadingQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (orq *OdometerReadingQuery) Clone() *OdometerReadingQuery {
+	if orq == nil {
+		return nil
+	}
+	return &OdometerReadingQuery{
+		config:     orq.config,
+		limit:      orq.limit,
+		offset:     orq.offset,
+		order:      append([]OrderFunc{}, orq.order...),
+		predicates: append([]predicate.OdometerReading{}, orq.predicates...),
+		withCar:    orq.withCar.Clone(),
+		// clone intermediate query.
+		sql:    orq.sql.Clone(),
+		path:   orq.path,
+		unique: orq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (orq *OdometerReadingQuery) WithCar(opts ...func(*CarQuery)) *OdometerReadingQuery {
+	query := &CarQuery{config: orq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	orq.withCar = query
+	return orq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OdometerReading.Query().
+//		GroupBy(odometerreading.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (orq *OdometerReadingQuery) GroupBy(field string, fields ...string) *OdometerReadingGroupBy {
+	grbuild := &OdometerReadingGroupBy{config: orq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := orq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return orq.sqlQuery(ctx), nil
+	}
+	grbuild.label = odometerreading.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.OdometerReading.Query().
+//		Select(odometerreading.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (orq *OdometerReadingQuery) Select(fields ...string) *OdometerReadingSelect {
+	orq.fields = append(orq.fields, fields...)
+	selbuild := &OdometerReadingSelect{OdometerReadingQuery: orq}
+	selbuild.label = odometerreading.Label
+	selbuild.flds, selbuild.scan = &orq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (orq *OdometerReadingQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range orq.fields {
+		if !odometerreading.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if orq.path != nil {
+		prev, err := orq.path(ctx)
+		if err != nil {
+			return err
+		}
+		orq.sql = prev
+	}
+	if odometerreading.Policy == nil {
+		return errors.New("ent: uninitialized odometerreading.Policy (forgotten import ent/runtime?)")
+	}
+	if err := odometerreading.Policy.EvalQuery(ctx, orq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (orq *OdometerReadingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OdometerReading, error) {
+	var (
+		nodes       = []*OdometerReading{}
+		_spec       = orq.querySpec()
+		loadedTypes = [1]bool{
+			orq.withCar != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*OdometerReading).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &OdometerReading{config: orq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(orq.modifiers) > 0 {
+		_spec.Modifiers = orq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, orq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := orq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*OdometerReading)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (orq *OdometerReadingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := orq.querySpec()
+	if len(orq.modifiers) > 0 {
+		_spec.Modifiers = orq.modifiers
+	}
+	_spec.Node.Columns = orq.fields
+	if len(orq.fields) > 0 {
+		_spec.Unique = orq.unique != nil && *orq.unique
+	}
+	return sqlgraph.CountNodes(ctx, orq.driver, _spec)
+}
+
+func (orq *OdometerReadingQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := orq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (orq *OdometerReadingQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   odometerreading.Table,
+			Columns: odometerreading.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: odometerreading.FieldID,
+			},
+		},
+		From:   orq.sql,
+		Unique: true,
+	}
+	if unique := orq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := orq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, odometerreading.FieldID)
+		for i := range fields {
+			if fields[i] != odometerreading.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := orq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := orq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := orq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := orq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (orq *OdometerReadingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(orq.driver.Dialect())
+	t1 := builder.Table(odometerreading.Table)
+	columns := orq.fields
+	if len(columns) == 0 {
+		columns = odometerreading.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if orq.sql != nil {
+		selector = orq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if orq.unique != nil && *orq.unique {
+		selector.Distinct()
+	}
+	for _, m := range orq.modifiers {
+		m(selector)
+	}
+	for _, p := range orq.predicates {
+		p(selector)
+	}
+	for _, p := range orq.order {
+		p(selector)
+	}
+	if offset := orq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := orq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (orq *OdometerReadingQuery) ForUpdate(opts ...sql.LockOption) *OdometerReadingQuery {
+	if orq.driver.Dialect() == dialect.Postgres {
+		orq.Unique(false)
+	}
+	orq.modifiers = append(orq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return orq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (orq *OdometerReadingQuery) ForShare(opts ...sql.LockOption) *OdometerReadingQuery {
+	if orq.driver.Dialect() == dialect.Postgres {
+		orq.Unique(false)
+	}
+	orq.modifiers = append(orq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return orq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (orq *OdometerReadingQuery) Modify(modifiers ...func(s *sql.Selector)) *OdometerReadingSelect {
+	orq.modifiers = append(orq.modifiers, modifiers...)
+	return orq.Select()
+}
+
+// OdometerReadingGroupBy is the group-by builder for OdometerReading entities.
+type OdometerReadingGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (orgb *OdometerReadingGroupBy) Aggregate(fns ...AggregateFunc) *OdometerReadingGroupBy {
+	orgb.fns = append(orgb.fns, fns...)
+	return orgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (orgb *OdometerReadingGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := orgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	orgb.sql = query
+	return orgb.sqlScan(ctx, v)
+}
+
+func (orgb *OdometerReadingGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range orgb.fields {
+		if !odometerreading.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := orgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := orgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (orgb *OdometerReadingGroupBy) sqlQuery() *sql.Selector {
+	selector := orgb.sql.Select()
+	aggregation := make([]string, 0, len(orgb.fns))
+	for _, fn := range orgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(orgb.fields)+len(orgb.fns))
+		for _, f := range orgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(orgb.fields...)...)
+}
+
+// OdometerReadingSelect is the builder for selecting fields of OdometerReading entities.
+type OdometerReadingSelect struct {
+	*OdometerReadingQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ors *OdometerReadingSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ors.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ors.sql = ors.OdometerReadingQuery.sqlQuery(ctx)
+	return ors.sqlScan(ctx, v)
+}
+
+func (ors *OdometerReadingSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ors.sql.Query()
+	if err := ors.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ors *OdometerReadingSelect) Modify(modifiers ...func(s *sql.Selector)) *OdometerReadingSelect {
+	ors.modifiers = append(ors.modifiers, modifiers...)
+	return ors
+}
@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OdometerReadingUpdate is the builder for updating OdometerReading entities.
+type OdometerReadingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OdometerReadingMutation
+}
+
+// Where appends a list predicates to the OdometerReadingUpdate builder.
+func (oru *OdometerReadingUpdate) Where(ps ...predicate.OdometerReading) *OdometerReadingUpdate {
+	oru.mutation.Where(ps...)
+	return oru
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (oru *OdometerReadingUpdate) SetTenantID(i int64) *OdometerReadingUpdate {
+	oru.mutation.ResetTenantID()
+	oru.mutation.SetTenantID(i)
+	return oru
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (oru *OdometerReadingUpdate) SetNillableTenantID(i *int64) *OdometerReadingUpdate {
+	if i != nil {
+		oru.SetTenantID(*i)
+	}
+	return oru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (oru *OdometerReadingUpdate) AddTenantID(i int64) *OdometerReadingUpdate {
+	oru.mutation.AddTenantID(i)
+	return oru
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (oru *OdometerReadingUpdate) ClearTenantID() *OdometerReadingUpdate {
+	oru.mutation.ClearTenantID()
+	return oru
+}
+
+// SetCarID sets the "car_id" field.
+func (oru *OdometerReadingUpdate) SetCarID(i int64) *OdometerReadingUpdate {
+	oru.mutation.SetCarID(i)
+	return oru
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (oru *OdometerReadingUpdate) SetNillableCarID(i *int64) *OdometerReadingUpdate {
+	if i != nil {
+		oru.SetCarID(*i)
+	}
+	return oru
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (oru *OdometerReadingUpdate) ClearCarID() *OdometerReadingUpdate {
+	oru.mutation.ClearCarID()
+	return oru
+}
+
+// SetMileage sets the "mileage" field.
+func (oru *OdometerReadingUpdate) SetMileage(i int64) *OdometerReadingUpdate {
+	oru.mutation.ResetMileage()
+	oru.mutation.SetMileage(i)
+	return oru
+}
+
+// SetNillableMileage sets the "mileage" field if the given value is not nil.
+func (oru *OdometerReadingUpdate) SetNillableMileage(i *int64) *OdometerReadingUpdate {
+	if i != nil {
+		oru.SetMileage(*i)
+	}
+	return oru
+}
+
+// AddMileage adds i to the "mileage" field.
+func (oru *OdometerReadingUpdate) AddMileage(i int64) *OdometerReadingUpdate {
+	oru.mutation.AddMileage(i)
+	return oru
+}
+
+// ClearMileage clears the value of the "mileage" field.
+func (oru *OdometerReadingUpdate) ClearMileage() *OdometerReadingUpdate {
+	oru.mutation.ClearMileage()
+	return oru
+}
+
+// SetSource sets the "source" field.
+func (oru *OdometerReadingUpdate) SetSource(s string) *OdometerReadingUpdate {
+	oru.mutation.SetSource(s)
+	return oru
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (oru *OdometerReadingUpdate) SetNillableSource(s *string) *OdometerReadingUpdate {
+	if s != nil {
+		oru.SetSource(*s)
+	}
+	return oru
+}
+
+// ClearSource clears the value of the "source" field.
+func (oru *OdometerReadingUpdate) ClearSource() *OdometerReadingUpdate {
+	oru.mutation.ClearSource()
+	return oru
+}
+
+// SetSuspicious sets the "suspicious" field.
+func (oru *OdometerReadingUpdate) SetSuspicious(b bool) *OdometerReadingUpdate {
+	oru.mutation.SetSuspicious(b)
+	return oru
+}
+
+// SetNillableSuspicious sets the "suspicious" field if the given value is not nil.
+func (oru *OdometerReadingUpdate) SetNillableSuspicious(b *bool) *OdometerReadingUpdate {
+	if b != nil {
+		oru.SetSuspicious(*b)
+	}
+	return oru
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (oru *OdometerReadingUpdate) SetRecordedAt(t time.Time) *OdometerReadingUpdate {
+	oru.mutation.SetRecordedAt(t)
+	return oru
+}
+
+// SetNillableRecordedAt sets the "recorded_at" field if the given value is not nil.
+func (oru *OdometerReadingUpdate) SetNillableRecordedAt(t *time.Time) *OdometerReadingUpdate {
+	if t != nil {
+		oru.SetRecordedAt(*t)
+	}
+	return oru
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (oru *OdometerReadingUpdate) SetCar(c *Car) *OdometerReadingUpdate {
+	return oru.SetCarID(c.ID)
+}
+
+// Mutation returns the OdometerReadingMutation object of the builder.
+func (oru *OdometerReadingUpdate) Mutation() *OdometerReadingMutation {
+	return oru.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (oru *OdometerReadingUpdate) ClearCar() *OdometerReadingUpdate {
+	oru.mutation.ClearCar()
+	return oru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oru *OdometerReadingUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(oru.hooks) == 0 {
+		affected, err = oru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OdometerReadingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			oru.mutation = mutation
+			affected, err = oru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(oru.hooks) - 1; i >= 0; i-- {
+			if oru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = oru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, oru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oru *OdometerReadingUpdate) SaveX(ctx context.Context) int {
+	affected, err := oru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oru *OdometerReadingUpdate) Exec(ctx context.Context) error {
+	_, err := oru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oru *OdometerReadingUpdate) ExecX(ctx context.Context) {
+	if err := oru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (oru *OdometerReadingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   odometerreading.Table,
+			Columns: odometerreading.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: odometerreading.FieldID,
+			},
+		},
+	}
+	if ps := oru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oru.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldTenantID,
+		})
+	}
+	if value, ok := oru.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldTenantID,
+		})
+	}
+	if oru.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: odometerreading.FieldTenantID,
+		})
+	}
+	if value, ok := oru.mutation.Mileage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldMileage,
+		})
+	}
+	if value, ok := oru.mutation.AddedMileage(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldMileage,
+		})
+	}
+	if oru.mutation.MileageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: odometerreading.FieldMileage,
+		})
+	}
+	if value, ok := oru.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: odometerreading.FieldSource,
+		})
+	}
+	if oru.mutation.SourceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: odometerreading.FieldSource,
+		})
+	}
+	if value, ok := oru.mutation.Suspicious(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: odometerreading.FieldSuspicious,
+		})
+	}
+	if value, ok := oru.mutation.RecordedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: odometerreading.FieldRecordedAt,
+		})
+	}
+	if oru.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   odometerreading.CarTable,
+			Columns: []string{odometerreading.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oru.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   odometerreading.CarTable,
+			Columns: []string{odometerreading.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{odometerreading.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OdometerReadingUpdateOne is the builder for updating a single OdometerReading entity.
+type OdometerReadingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OdometerReadingMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (oruo *OdometerReadingUpdateOne) SetTenantID(i int64) *OdometerReadingUpdateOne {
+	oruo.mutation.ResetTenantID()
+	oruo.mutation.SetTenantID(i)
+	return oruo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (oruo *OdometerReadingUpdateOne) SetNillableTenantID(i *int64) *OdometerReadingUpdateOne {
+	if i != nil {
+		oruo.SetTenantID(*i)
+	}
+	return oruo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (oruo *OdometerReadingUpdateOne) AddTenantID(i int64) *OdometerReadingUpdateOne {
+	oruo.mutation.AddTenantID(i)
+	return oruo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (oruo *OdometerReadingUpdateOne) ClearTenantID() *OdometerReadingUpdateOne {
+	oruo.mutation.ClearTenantID()
+	return oruo
+}
+
+// SetCarID sets the "car_id" field.
+func (oruo *OdometerReadingUpdateOne) SetCarID(i int64) *OdometerReadingUpdateOne {
+	oruo.mutation.SetCarID(i)
+	return oruo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (oruo *OdometerReadingUpdateOne) SetNillableCarID(i *int64) *OdometerReadingUpdateOne {
+	if i != nil {
+		oruo.SetCarID(*i)
+	}
+	return oruo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (oruo *OdometerReadingUpdateOne) ClearCarID() *OdometerReadingUpdateOne {
+	oruo.mutation.ClearCarID()
+	return oruo
+}
+
+// SetMileage sets the "mileage" field.
+func (oruo *OdometerReadingUpdateOne) SetMileage(i int64) *OdometerReadingUpdateOne {
+	oruo.mutation.ResetMileage()
+	oruo.mutation.SetMileage(i)
+	return oruo
+}
+
+// SetNillableMileage sets the "mileage" field if the given value is not nil.
+func (oruo *OdometerReadingUpdateOne) SetNillableMileage(i *int64) *OdometerReadingUpdateOne {
+	if i != nil {
+		oruo.SetMileage(*i)
+	}
+	return oruo
+}
+
+// AddMileage adds i to the "mileage" field.
+func (oruo *OdometerReadingUpdateOne) AddMileage(i int64) *OdometerReadingUpdateOne {
+	oruo.mutation.AddMileage(i)
+	return oruo
+}
+
+// ClearMileage clears the value of the "mileage" field.
+func (oruo *OdometerReadingUpdateOne) ClearMileage() *OdometerReadingUpdateOne {
+	oruo.mutation.ClearMileage()
+	return oruo
+}
+
+// SetSource sets the "source" field.
+func (oruo *OdometerReadingUpdateOne) SetSource(s string) *OdometerReadingUpdateOne {
+	oruo.mutation.SetSource(s)
+	return oruo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (oruo *OdometerReadingUpdateOne) SetNillableSource(s *string) *OdometerReadingUpdateOne {
+	if s != nil {
+		oruo.SetSource(*s)
+	}
+	return oruo
+}
+
+// ClearSource clears the value of the "source" field.
+func (oruo *OdometerReadingUpdateOne) ClearSource() *OdometerReadingUpdateOne {
+	oruo.mutation.ClearSource()
+	return oruo
+}
+
+// SetSuspicious sets the "suspicious" field.
+func (oruo *OdometerReadingUpdateOne) SetSuspicious(b bool) *OdometerReadingUpdateOne {
+	oruo.mutation.SetSuspicious(b)
+	return oruo
+}
+
+// SetNillableSuspicious sets the "suspicious" field if the given value is not nil.
+func (oruo *OdometerReadingUpdateOne) SetNillableSuspicious(b *bool) *OdometerReadingUpdateOne {
+	if b != nil {
+		oruo.SetSuspicious(*b)
+	}
+	return oruo
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (oruo *OdometerReadingUpdateOne) SetRecordedAt(t time.Time) *OdometerReadingUpdateOne {
+	oruo.mutation.SetRecordedAt(t)
+	return oruo
+}
+
+// SetNillableRecordedAt sets the "recorded_at" field if the given value is not nil.
+func (oruo *OdometerReadingUpdateOne) SetNillableRecordedAt(t *time.Time) *OdometerReadingUpdateOne {
+	if t != nil {
+		oruo.SetRecordedAt(*t)
+	}
+	return oruo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (oruo *OdometerReadingUpdateOne) SetCar(c *Car) *OdometerReadingUpdateOne {
+	return oruo.SetCarID(c.ID)
+}
+
+// Mutation returns the OdometerReadingMutation object of the builder.
+func (oruo *OdometerReadingUpdateOne) Mutation() *OdometerReadingMutation {
+	return oruo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (oruo *OdometerReadingUpdateOne) ClearCar() *OdometerReadingUpdateOne {
+	oruo.mutation.ClearCar()
+	return oruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oruo *OdometerReadingUpdateOne) Select(field string, fields ...string) *OdometerReadingUpdateOne {
+	oruo.fields = append([]string{field}, fields...)
+	return oruo
+}
+
+// Save executes the query and returns the updated OdometerReading entity.
+func (oruo *OdometerReadingUpdateOne) Save(ctx context.Context) (*OdometerReading, error) {
+	var (
+		err  error
+		node *OdometerReading
+	)
+	if len(oruo.hooks) == 0 {
+		node, err = oruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OdometerReadingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			oruo.mutation = mutation
+			node, err = oruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(oruo.hooks) - 1; i >= 0; i-- {
+			if oruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = oruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, oruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*OdometerReading)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from OdometerReadingMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oruo *OdometerReadingUpdateOne) SaveX(ctx context.Context) *OdometerReading {
+	node, err := oruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oruo *OdometerReadingUpdateOne) Exec(ctx context.Context) error {
+	_, err := oruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oruo *OdometerReadingUpdateOne) ExecX(ctx context.Context) {
+	if err := oruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (oruo *OdometerReadingUpdateOne) sqlSave(ctx context.Context) (_node *OdometerReading, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   odometerreading.Table,
+			Columns: odometerreading.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: odometerreading.FieldID,
+			},
+		},
+	}
+	id, ok := oruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OdometerReading.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, odometerreading.FieldID)
+		for _, f := range fields {
+			if !odometerreading.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != odometerreading.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oruo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldTenantID,
+		})
+	}
+	if value, ok := oruo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldTenantID,
+		})
+	}
+	if oruo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: odometerreading.FieldTenantID,
+		})
+	}
+	if value, ok := oruo.mutation.Mileage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldMileage,
+		})
+	}
+	if value, ok := oruo.mutation.AddedMileage(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: odometerreading.FieldMileage,
+		})
+	}
+	if oruo.mutation.MileageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: odometerreading.FieldMileage,
+		})
+	}
+	if value, ok := oruo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: odometerreading.FieldSource,
+		})
+	}
+	if oruo.mutation.SourceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: odometerreading.FieldSource,
+		})
+	}
+	if value, ok := oruo.mutation.Suspicious(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: odometerreading.FieldSuspicious,
+		})
+	}
+	if value, ok := oruo.mutation.RecordedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: odometerreading.FieldRecordedAt,
+		})
+	}
+	if oruo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   odometerreading.CarTable,
+			Columns: []string{odometerreading.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oruo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   odometerreading.CarTable,
+			Columns: []string{odometerreading.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OdometerReading{config: oruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{odometerreading.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
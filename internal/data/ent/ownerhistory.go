@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/ownerhistory"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// OwnerHistory is the model entity for the OwnerHistory schema.
+type OwnerHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OwnerHistoryQuery when eager-loading is set.
+	Edges OwnerHistoryEdges `json:"edges"`
+}
+
+// OwnerHistoryEdges holds the relations/edges for other nodes in the graph.
+type OwnerHistoryEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OwnerHistoryEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OwnerHistory) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ownerhistory.FieldID, ownerhistory.FieldTenantID, ownerhistory.FieldCarID, ownerhistory.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case ownerhistory.FieldStartedAt, ownerhistory.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type OwnerHistory", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OwnerHistory fields.
+func (oh *OwnerHistory) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ownerhistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oh.ID = int64(value.Int64)
+		case ownerhistory.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				oh.TenantID = value.Int64
+			}
+		case ownerhistory.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				oh.CarID = value.Int64
+			}
+		case ownerhistory.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				oh.UserID = value.Int64
+			}
+		case ownerhistory.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				oh.StartedAt = value.Time
+			}
+		case ownerhistory.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				oh.EndedAt = new(time.Time)
+				*oh.EndedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the OwnerHistory entity.
+func (oh *OwnerHistory) QueryCar() *CarQuery {
+	return (&OwnerHistoryClient{config: oh.config}).QueryCar(oh)
+}
+
+// Update returns a builder for updating this OwnerHistory.
+// Note that you need to call OwnerHistory.Unwrap() before calling this method if this OwnerHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oh *OwnerHistory) Update() *OwnerHistoryUpdateOne {
+	return (&OwnerHistoryClient{config: oh.config}).UpdateOne(oh)
+}
+
+// Unwrap unwraps the OwnerHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oh *OwnerHistory) Unwrap() *OwnerHistory {
+	_tx, ok := oh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OwnerHistory is not a transactional entity")
+	}
+	oh.config.driver = _tx.drv
+	return oh
+}
+
+// String implements the fmt.Stringer.
+func (oh *OwnerHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("OwnerHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oh.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", oh.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", oh.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", oh.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(oh.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := oh.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OwnerHistories is a parsable slice of OwnerHistory.
+type OwnerHistories []*OwnerHistory
+
+func (oh OwnerHistories) config(cfg config) {
+	for _i := range oh {
+		oh[_i].config = cfg
+	}
+}
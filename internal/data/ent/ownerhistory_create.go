@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/ownerhistory"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OwnerHistoryCreate is the builder for creating a OwnerHistory entity.
+type OwnerHistoryCreate struct {
+	config
+	mutation *OwnerHistoryMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ohc *OwnerHistoryCreate) SetTenantID(i int64) *OwnerHistoryCreate {
+	ohc.mutation.SetTenantID(i)
+	return ohc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ohc *OwnerHistoryCreate) SetNillableTenantID(i *int64) *OwnerHistoryCreate {
+	if i != nil {
+		ohc.SetTenantID(*i)
+	}
+	return ohc
+}
+
+// SetCarID sets the "car_id" field.
+func (ohc *OwnerHistoryCreate) SetCarID(i int64) *OwnerHistoryCreate {
+	ohc.mutation.SetCarID(i)
+	return ohc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ohc *OwnerHistoryCreate) SetNillableCarID(i *int64) *OwnerHistoryCreate {
+	if i != nil {
+		ohc.SetCarID(*i)
+	}
+	return ohc
+}
+
+// SetUserID sets the "user_id" field.
+func (ohc *OwnerHistoryCreate) SetUserID(i int64) *OwnerHistoryCreate {
+	ohc.mutation.SetUserID(i)
+	return ohc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ohc *OwnerHistoryCreate) SetNillableUserID(i *int64) *OwnerHistoryCreate {
+	if i != nil {
+		ohc.SetUserID(*i)
+	}
+	return ohc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (ohc *OwnerHistoryCreate) SetStartedAt(t time.Time) *OwnerHistoryCreate {
+	ohc.mutation.SetStartedAt(t)
+	return ohc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (ohc *OwnerHistoryCreate) SetNillableStartedAt(t *time.Time) *OwnerHistoryCreate {
+	if t != nil {
+		ohc.SetStartedAt(*t)
+	}
+	return ohc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (ohc *OwnerHistoryCreate) SetEndedAt(t time.Time) *OwnerHistoryCreate {
+	ohc.mutation.SetEndedAt(t)
+	return ohc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (ohc *OwnerHistoryCreate) SetNillableEndedAt(t *time.Time) *OwnerHistoryCreate {
+	if t != nil {
+		ohc.SetEndedAt(*t)
+	}
+	return ohc
+}
+
+// SetID sets the "id" field.
+func (ohc *OwnerHistoryCreate) SetID(i int64) *OwnerHistoryCreate {
+	ohc.mutation.SetID(i)
+	return ohc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ohc *OwnerHistoryCreate) SetCar(c *Car) *OwnerHistoryCreate {
+	return ohc.SetCarID(c.ID)
+}
+
+// Mutation returns the OwnerHistoryMutation object of the builder.
+func (ohc *OwnerHistoryCreate) Mutation() *OwnerHistoryMutation {
+	return ohc.mutation
+}
+
+// Save creates the OwnerHistory in the database.
+func (ohc *OwnerHistoryCreate) Save(ctx context.Context) (*OwnerHistory, error) {
+	var (
+		err  error
+		node *OwnerHistory
+	)
+	if err := ohc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(ohc.hooks) == 0 {
+		if err = ohc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ohc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OwnerHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ohc.check(); err != nil {
+				return nil, err
+			}
+			ohc.mutation = mutation
+			if node, err = ohc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ohc.hooks) - 1; i >= 0; i-- {
+			if ohc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ohc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ohc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*OwnerHistory)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from OwnerHistoryMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ohc *OwnerHistoryCreate) SaveX(ctx context.Context) *OwnerHistory {
+	v, err := ohc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ohc *OwnerHistoryCreate) Exec(ctx context.Context) error {
+	_, err := ohc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohc *OwnerHistoryCreate) ExecX(ctx context.Context) {
+	if err := ohc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ohc *OwnerHistoryCreate) defaults() error {
+	if _, ok := ohc.mutation.StartedAt(); !ok {
+		if ownerhistory.DefaultStartedAt == nil {
+			return fmt.Errorf("ent: uninitialized ownerhistory.DefaultStartedAt (forgotten import ent/runtime?)")
+		}
+		v := ownerhistory.DefaultStartedAt()
+		ohc.mutation.SetStartedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ohc *OwnerHistoryCreate) check() error {
+	if _, ok := ohc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "OwnerHistory.started_at"`)}
+	}
+	return nil
+}
+
+func (ohc *OwnerHistoryCreate) sqlSave(ctx context.Context) (*OwnerHistory, error) {
+	_node, _spec := ohc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ohc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (ohc *OwnerHistoryCreate) createSpec() (*OwnerHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OwnerHistory{config: ohc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: ownerhistory.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: ownerhistory.FieldID,
+			},
+		}
+	)
+	if id, ok := ohc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ohc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := ohc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := ohc.mutation.StartedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ownerhistory.FieldStartedAt,
+		})
+		_node.StartedAt = value
+	}
+	if value, ok := ohc.mutation.EndedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ownerhistory.FieldEndedAt,
+		})
+		_node.EndedAt = &value
+	}
+	if nodes := ohc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownerhistory.CarTable,
+			Columns: []string{ownerhistory.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OwnerHistoryCreateBulk is the builder for creating many OwnerHistory entities in bulk.
+type OwnerHistoryCreateBulk struct {
+	config
+	builders []*OwnerHistoryCreate
+}
+
+// Save creates the OwnerHistory entities in the database.
+func (ohcb *OwnerHistoryCreateBulk) Save(ctx context.Context) ([]*OwnerHistory, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ohcb.builders))
+	nodes := make([]*OwnerHistory, len(ohcb.builders))
+	mutators := make([]Mutator, len(ohcb.builders))
+	for i := range ohcb.builders {
+		func(i int, root context.Context) {
+			builder := ohcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OwnerHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ohcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ohcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ohcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ohcb *OwnerHistoryCreateBulk) SaveX(ctx context.Context) []*OwnerHistory {
+	v, err := ohcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ohcb *OwnerHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := ohcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohcb *OwnerHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := ohcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/ownerhistory"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OwnerHistoryDelete is the builder for deleting a OwnerHistory entity.
+type OwnerHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *OwnerHistoryMutation
+}
+
+// Where appends a list predicates to the OwnerHistoryDelete builder.
+func (ohd *OwnerHistoryDelete) Where(ps ...predicate.OwnerHistory) *OwnerHistoryDelete {
+	ohd.mutation.Where(ps...)
+	return ohd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ohd *OwnerHistoryDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ohd.hooks) == 0 {
+		affected, err = ohd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OwnerHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ohd.mutation = mutation
+			affected, err = ohd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ohd.hooks) - 1; i >= 0; i-- {
+			if ohd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ohd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ohd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohd *OwnerHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := ohd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ohd *OwnerHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: ownerhistory.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: ownerhistory.FieldID,
+			},
+		},
+	}
+	if ps := ohd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ohd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// OwnerHistoryDeleteOne is the builder for deleting a single OwnerHistory entity.
+type OwnerHistoryDeleteOne struct {
+	ohd *OwnerHistoryDelete
+}
+
+// Exec executes the deletion query.
+func (ohdo *OwnerHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := ohdo.ohd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ownerhistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohdo *OwnerHistoryDeleteOne) ExecX(ctx context.Context) {
+	ohdo.ohd.ExecX(ctx)
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/ownerhistory"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OwnerHistoryQuery is the builder for querying OwnerHistory entities.
+type OwnerHistoryQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.OwnerHistory
+	// eager-loading edges.
+	withCar   *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OwnerHistoryQuery builder.
+func (ohq *OwnerHistoryQuery) Where(ps ...predicate.OwnerHistory) *OwnerHistoryQuery {
+	ohq.predicates = append(ohq.predicates, ps...)
+	return ohq
+}
+
+// Limit adds a limit step to the query.
+func (ohq *OwnerHistoryQuery) Limit(limit int) *OwnerHistoryQuery {
+	ohq.limit = &limit
+	return ohq
+}
+
+// Offset adds an offset step to the query.
+func (ohq *OwnerHistoryQuery) Offset(offset int) *OwnerHistoryQuery {
+	ohq.offset = &offset
+	return ohq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ohq *OwnerHistoryQuery) Unique(unique bool) *OwnerHistoryQuery {
+	ohq.unique = &unique
+	return ohq
+}
+
+// Order adds an order step to the query.
+func (ohq *OwnerHistoryQuery) Order(o ...OrderFunc) *OwnerHistoryQuery {
+	ohq.order = append(ohq.order, o...)
+	return ohq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (ohq *OwnerHistoryQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: ohq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ohq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ohq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownerhistory.Table, ownerhistory.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownerhistory.CarTable, ownerhistory.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ohq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OwnerHistory entity from the query.
+// Returns a *NotFoundError when no OwnerHistory was found.
+func (ohq *OwnerHistoryQuery) First(ctx context.Context) (*OwnerHistory, error) {
+	nodes, err := ohq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ownerhistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ohq *OwnerHistoryQuery) FirstX(ctx context.Context) *OwnerHistory {
+	node, err := ohq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OwnerHistory ID from the query.
+// Returns a *NotFoundError when no OwnerHistory ID was found.
+func (ohq *OwnerHistoryQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = ohq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ownerhistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ohq *OwnerHistoryQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := ohq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OwnerHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OwnerHistory entity is found.
+// Returns a *NotFoundError when no OwnerHistory entities are found.
+func (ohq *OwnerHistoryQuery) Only(ctx context.Context) (*OwnerHistory, error) {
+	nodes, err := ohq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ownerhistory.Label}
+	default:
+		return nil, &NotSingularError{ownerhistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ohq *OwnerHistoryQuery) OnlyX(ctx context.Context) *OwnerHistory {
+	node, err := ohq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OwnerHistory ID in the query.
+// Returns a *NotSingularError when more than one OwnerHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ohq *OwnerHistoryQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = ohq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ownerhistory.Label}
+	default:
+		err = &NotSingularError{ownerhistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ohq *OwnerHistoryQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := ohq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OwnerHistories.
+func (ohq *OwnerHistoryQuery) All(ctx context.Context) ([]*OwnerHistory, error) {
+	if err := ohq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ohq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ohq *OwnerHistoryQuery) AllX(ctx context.Context) []*OwnerHistory {
+	nodes, err := ohq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OwnerHistory IDs.
+func (ohq *OwnerHistoryQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := ohq.Select(ownerhistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ohq *OwnerHistoryQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := ohq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ohq *OwnerHistoryQuery) Count(ctx context.Context) (int, error) {
+	if err := ohq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ohq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ohq *OwnerHistoryQuery) CountX(ctx context.Context) int {
+	count, err := ohq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ohq *OwnerHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ohq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ohq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ohq *OwnerHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := ohq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OwnerHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ohq *OwnerHistoryQuery) Clone() *OwnerHistoryQuery {
+	if ohq == nil {
+		return nil
+	}
+	return &OwnerHistoryQuery{
+		config:     ohq.config,
+		limit:      ohq.limit,
+		offset:     ohq.offset,
+		order:      append([]OrderFunc{}, ohq.order...),
+		predicates: append([]predicate.OwnerHistory{}, ohq.predicates...),
+		withCar:    ohq.withCar.Clone(),
+		// clone intermediate query.
+		sql:    ohq.sql.Clone(),
+		path:   ohq.path,
+		unique: ohq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (ohq *OwnerHistoryQuery) WithCar(opts ...func(*CarQuery)) *OwnerHistoryQuery {
+	query := &CarQuery{config: ohq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ohq.withCar = query
+	return ohq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OwnerHistory.Query().
+//		GroupBy(ownerhistory.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (ohq *OwnerHistoryQuery) GroupBy(field string, fields ...string) *OwnerHistoryGroupBy {
+	grbuild := &OwnerHistoryGroupBy{config: ohq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ohq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ohq.sqlQuery(ctx), nil
+	}
+	grbuild.label = ownerhistory.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.OwnerHistory.Query().
+//		Select(ownerhistory.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (ohq *OwnerHistoryQuery) Select(fields ...string) *OwnerHistorySelect {
+	ohq.fields = append(ohq.fields, fields...)
+	selbuild := &OwnerHistorySelect{OwnerHistoryQuery: ohq}
+	selbuild.label = ownerhistory.Label
+	selbuild.flds, selbuild.scan = &ohq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ohq *OwnerHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ohq.fields {
+		if !ownerhistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ohq.path != nil {
+		prev, err := ohq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ohq.sql = prev
+	}
+	if ownerhistory.Policy == nil {
+		return errors.New("ent: uninitialized ownerhistory.Policy (forgotten import ent/runtime?)")
+	}
+	if err := ownerhistory.Policy.EvalQuery(ctx, ohq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ohq *OwnerHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OwnerHistory, error) {
+	var (
+		nodes       = []*OwnerHistory{}
+		_spec       = ohq.querySpec()
+		loadedTypes = [1]bool{
+			ohq.withCar != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*OwnerHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &OwnerHistory{config: ohq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ohq.modifiers) > 0 {
+		_spec.Modifiers = ohq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ohq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ohq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*OwnerHistory)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ohq *OwnerHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ohq.querySpec()
+	if len(ohq.modifiers) > 0 {
+		_spec.Modifiers = ohq.modifiers
+	}
+	_spec.Node.Columns = ohq.fields
+	if len(ohq.fields) > 0 {
+		_spec.Unique = ohq.unique != nil && *ohq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ohq.driver, _spec)
+}
+
+func (ohq *OwnerHistoryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ohq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ohq *OwnerHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ownerhistory.Table,
+			Columns: ownerhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: ownerhistory.FieldID,
+			},
+		},
+		From:   ohq.sql,
+		Unique: true,
+	}
+	if unique := ohq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ohq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ownerhistory.FieldID)
+		for i := range fields {
+			if fields[i] != ownerhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ohq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ohq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ohq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ohq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ohq *OwnerHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ohq.driver.Dialect())
+	t1 := builder.Table(ownerhistory.Table)
+	columns := ohq.fields
+	if len(columns) == 0 {
+		columns = ownerhistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ohq.sql != nil {
+		selector = ohq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ohq.unique != nil && *ohq.unique {
+		selector.Distinct()
+	}
+	for _, m := range ohq.modifiers {
+		m(selector)
+	}
+	for _, p := range ohq.predicates {
+		p(selector)
+	}
+	for _, p := range ohq.order {
+		p(selector)
+	}
+	if offset := ohq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ohq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ohq *OwnerHistoryQuery) ForUpdate(opts ...sql.LockOption) *OwnerHistoryQuery {
+	if ohq.driver.Dialect() == dialect.Postgres {
+		ohq.Unique(false)
+	}
+	ohq.modifiers = append(ohq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ohq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ohq *OwnerHistoryQuery) ForShare(opts ...sql.LockOption) *OwnerHistoryQuery {
+	if ohq.driver.Dialect() == dialect.Postgres {
+		ohq.Unique(false)
+	}
+	ohq.modifiers = append(ohq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ohq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ohq *OwnerHistoryQuery) Modify(modifiers ...func(s *sql.Selector)) *OwnerHistorySelect {
+	ohq.modifiers = append(ohq.modifiers, modifiers...)
+	return ohq.Select()
+}
+
+// OwnerHistoryGroupBy is the group-by builder for OwnerHistory entities.
+type OwnerHistoryGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ohgb *OwnerHistoryGroupBy) Aggregate(fns ...AggregateFunc) *OwnerHistoryGroupBy {
+	ohgb.fns = append(ohgb.fns, fns...)
+	return ohgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ohgb *OwnerHistoryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ohgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ohgb.sql = query
+	return ohgb.sqlScan(ctx, v)
+}
+
+func (ohgb *OwnerHistoryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ohgb.fields {
+		if !ownerhistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ohgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ohgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ohgb *OwnerHistoryGroupBy) sqlQuery() *sql.Selector {
+	selector := ohgb.sql.Select()
+	aggregation := make([]string, 0, len(ohgb.fns))
+	for _, fn := range ohgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ohgb.fields)+len(ohgb.fns))
+		for _, f := range ohgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ohgb.fields...)...)
+}
+
+// OwnerHistorySelect is the builder for selecting fields of OwnerHistory entities.
+type OwnerHistorySelect struct {
+	*OwnerHistoryQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ohs *OwnerHistorySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ohs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ohs.sql = ohs.OwnerHistoryQuery.sqlQuery(ctx)
+	return ohs.sqlScan(ctx, v)
+}
+
+func (ohs *OwnerHistorySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ohs.sql.Query()
+	if err := ohs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ohs *OwnerHistorySelect) Modify(modifiers ...func(s *sql.Selector)) *OwnerHistorySelect {
+	ohs.modifiers = append(ohs.modifiers, modifiers...)
+	return ohs
+}
@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/ownerhistory"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OwnerHistoryUpdate is the builder for updating OwnerHistory entities.
+type OwnerHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OwnerHistoryMutation
+}
+
+// Where appends a list predicates to the OwnerHistoryUpdate builder.
+func (ohu *OwnerHistoryUpdate) Where(ps ...predicate.OwnerHistory) *OwnerHistoryUpdate {
+	ohu.mutation.Where(ps...)
+	return ohu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ohu *OwnerHistoryUpdate) SetTenantID(i int64) *OwnerHistoryUpdate {
+	ohu.mutation.ResetTenantID()
+	ohu.mutation.SetTenantID(i)
+	return ohu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ohu *OwnerHistoryUpdate) SetNillableTenantID(i *int64) *OwnerHistoryUpdate {
+	if i != nil {
+		ohu.SetTenantID(*i)
+	}
+	return ohu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ohu *OwnerHistoryUpdate) AddTenantID(i int64) *OwnerHistoryUpdate {
+	ohu.mutation.AddTenantID(i)
+	return ohu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (ohu *OwnerHistoryUpdate) ClearTenantID() *OwnerHistoryUpdate {
+	ohu.mutation.ClearTenantID()
+	return ohu
+}
+
+// SetCarID sets the "car_id" field.
+func (ohu *OwnerHistoryUpdate) SetCarID(i int64) *OwnerHistoryUpdate {
+	ohu.mutation.SetCarID(i)
+	return ohu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ohu *OwnerHistoryUpdate) SetNillableCarID(i *int64) *OwnerHistoryUpdate {
+	if i != nil {
+		ohu.SetCarID(*i)
+	}
+	return ohu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (ohu *OwnerHistoryUpdate) ClearCarID() *OwnerHistoryUpdate {
+	ohu.mutation.ClearCarID()
+	return ohu
+}
+
+// SetUserID sets the "user_id" field.
+func (ohu *OwnerHistoryUpdate) SetUserID(i int64) *OwnerHistoryUpdate {
+	ohu.mutation.ResetUserID()
+	ohu.mutation.SetUserID(i)
+	return ohu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ohu *OwnerHistoryUpdate) SetNillableUserID(i *int64) *OwnerHistoryUpdate {
+	if i != nil {
+		ohu.SetUserID(*i)
+	}
+	return ohu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (ohu *OwnerHistoryUpdate) AddUserID(i int64) *OwnerHistoryUpdate {
+	ohu.mutation.AddUserID(i)
+	return ohu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ohu *OwnerHistoryUpdate) ClearUserID() *OwnerHistoryUpdate {
+	ohu.mutation.ClearUserID()
+	return ohu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (ohu *OwnerHistoryUpdate) SetStartedAt(t time.Time) *OwnerHistoryUpdate {
+	ohu.mutation.SetStartedAt(t)
+	return ohu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (ohu *OwnerHistoryUpdate) SetNillableStartedAt(t *time.Time) *OwnerHistoryUpdate {
+	if t != nil {
+		ohu.SetStartedAt(*t)
+	}
+	return ohu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (ohu *OwnerHistoryUpdate) SetEndedAt(t time.Time) *OwnerHistoryUpdate {
+	ohu.mutation.SetEndedAt(t)
+	return ohu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (ohu *OwnerHistoryUpdate) SetNillableEndedAt(t *time.Time) *OwnerHistoryUpdate {
+	if t != nil {
+		ohu.SetEndedAt(*t)
+	}
+	return ohu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (ohu *OwnerHistoryUpdate) ClearEndedAt() *OwnerHistoryUpdate {
+	ohu.mutation.ClearEndedAt()
+	return ohu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ohu *OwnerHistoryUpdate) SetCar(c *Car) *OwnerHistoryUpdate {
+	return ohu.SetCarID(c.ID)
+}
+
+// Mutation returns the OwnerHistoryMutation object of the builder.
+func (ohu *OwnerHistoryUpdate) Mutation() *OwnerHistoryMutation {
+	return ohu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (ohu *OwnerHistoryUpdate) ClearCar() *OwnerHistoryUpdate {
+	ohu.mutation.ClearCar()
+	return ohu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ohu *OwnerHistoryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ohu.hooks) == 0 {
+		affected, err = ohu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OwnerHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ohu.mutation = mutation
+			affected, err = ohu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ohu.hooks) - 1; i >= 0; i-- {
+			if ohu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ohu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ohu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ohu *OwnerHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ohu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ohu *OwnerHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := ohu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohu *OwnerHistoryUpdate) ExecX(ctx context.Context) {
+	if err := ohu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ohu *OwnerHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ownerhistory.Table,
+			Columns: ownerhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: ownerhistory.FieldID,
+			},
+		},
+	}
+	if ps := ohu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ohu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldTenantID,
+		})
+	}
+	if value, ok := ohu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldTenantID,
+		})
+	}
+	if ohu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: ownerhistory.FieldTenantID,
+		})
+	}
+	if value, ok := ohu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldUserID,
+		})
+	}
+	if value, ok := ohu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldUserID,
+		})
+	}
+	if ohu.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: ownerhistory.FieldUserID,
+		})
+	}
+	if value, ok := ohu.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ownerhistory.FieldStartedAt,
+		})
+	}
+	if value, ok := ohu.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ownerhistory.FieldEndedAt,
+		})
+	}
+	if ohu.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: ownerhistory.FieldEndedAt,
+		})
+	}
+	if ohu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownerhistory.CarTable,
+			Columns: []string{ownerhistory.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ohu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownerhistory.CarTable,
+			Columns: []string{ownerhistory.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ohu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownerhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OwnerHistoryUpdateOne is the builder for updating a single OwnerHistory entity.
+type OwnerHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OwnerHistoryMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ohuo *OwnerHistoryUpdateOne) SetTenantID(i int64) *OwnerHistoryUpdateOne {
+	ohuo.mutation.ResetTenantID()
+	ohuo.mutation.SetTenantID(i)
+	return ohuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ohuo *OwnerHistoryUpdateOne) SetNillableTenantID(i *int64) *OwnerHistoryUpdateOne {
+	if i != nil {
+		ohuo.SetTenantID(*i)
+	}
+	return ohuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ohuo *OwnerHistoryUpdateOne) AddTenantID(i int64) *OwnerHistoryUpdateOne {
+	ohuo.mutation.AddTenantID(i)
+	return ohuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (ohuo *OwnerHistoryUpdateOne) ClearTenantID() *OwnerHistoryUpdateOne {
+	ohuo.mutation.ClearTenantID()
+	return ohuo
+}
+
+// SetCarID sets the "car_id" field.
+func (ohuo *OwnerHistoryUpdateOne) SetCarID(i int64) *OwnerHistoryUpdateOne {
+	ohuo.mutation.SetCarID(i)
+	return ohuo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ohuo *OwnerHistoryUpdateOne) SetNillableCarID(i *int64) *OwnerHistoryUpdateOne {
+	if i != nil {
+		ohuo.SetCarID(*i)
+	}
+	return ohuo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (ohuo *OwnerHistoryUpdateOne) ClearCarID() *OwnerHistoryUpdateOne {
+	ohuo.mutation.ClearCarID()
+	return ohuo
+}
+
+// SetUserID sets the "user_id" field.
+func (ohuo *OwnerHistoryUpdateOne) SetUserID(i int64) *OwnerHistoryUpdateOne {
+	ohuo.mutation.ResetUserID()
+	ohuo.mutation.SetUserID(i)
+	return ohuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ohuo *OwnerHistoryUpdateOne) SetNillableUserID(i *int64) *OwnerHistoryUpdateOne {
+	if i != nil {
+		ohuo.SetUserID(*i)
+	}
+	return ohuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (ohuo *OwnerHistoryUpdateOne) AddUserID(i int64) *OwnerHistoryUpdateOne {
+	ohuo.mutation.AddUserID(i)
+	return ohuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ohuo *OwnerHistoryUpdateOne) ClearUserID() *OwnerHistoryUpdateOne {
+	ohuo.mutation.ClearUserID()
+	return ohuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (ohuo *OwnerHistoryUpdateOne) SetStartedAt(t time.Time) *OwnerHistoryUpdateOne {
+	ohuo.mutation.SetStartedAt(t)
+	return ohuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (ohuo *OwnerHistoryUpdateOne) SetNillableStartedAt(t *time.Time) *OwnerHistoryUpdateOne {
+	if t != nil {
+		ohuo.SetStartedAt(*t)
+	}
+	return ohuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (ohuo *OwnerHistoryUpdateOne) SetEndedAt(t time.Time) *OwnerHistoryUpdateOne {
+	ohuo.mutation.SetEndedAt(t)
+	return ohuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (ohuo *OwnerHistoryUpdateOne) SetNillableEndedAt(t *time.Time) *OwnerHistoryUpdateOne {
+	if t != nil {
+		ohuo.SetEndedAt(*t)
+	}
+	return ohuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (ohuo *OwnerHistoryUpdateOne) ClearEndedAt() *OwnerHistoryUpdateOne {
+	ohuo.mutation.ClearEndedAt()
+	return ohuo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ohuo *OwnerHistoryUpdateOne) SetCar(c *Car) *OwnerHistoryUpdateOne {
+	return ohuo.SetCarID(c.ID)
+}
+
+// Mutation returns the OwnerHistoryMutation object of the builder.
+func (ohuo *OwnerHistoryUpdateOne) Mutation() *OwnerHistoryMutation {
+	return ohuo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (ohuo *OwnerHistoryUpdateOne) ClearCar() *OwnerHistoryUpdateOne {
+	ohuo.mutation.ClearCar()
+	return ohuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ohuo *OwnerHistoryUpdateOne) Select(field string, fields ...string) *OwnerHistoryUpdateOne {
+	ohuo.fields = append([]string{field}, fields...)
+	return ohuo
+}
+
+// Save executes the query and returns the updated OwnerHistory entity.
+func (ohuo *OwnerHistoryUpdateOne) Save(ctx context.Context) (*OwnerHistory, error) {
+	var (
+		err  error
+		node *OwnerHistory
+	)
+	if len(ohuo.hooks) == 0 {
+		node, err = ohuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OwnerHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ohuo.mutation = mutation
+			node, err = ohuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ohuo.hooks) - 1; i >= 0; i-- {
+			if ohuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ohuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ohuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*OwnerHistory)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from OwnerHistoryMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ohuo *OwnerHistoryUpdateOne) SaveX(ctx context.Context) *OwnerHistory {
+	node, err := ohuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ohuo *OwnerHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := ohuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohuo *OwnerHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := ohuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ohuo *OwnerHistoryUpdateOne) sqlSave(ctx context.Context) (_node *OwnerHistory, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ownerhistory.Table,
+			Columns: ownerhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: ownerhistory.FieldID,
+			},
+		},
+	}
+	id, ok := ohuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OwnerHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ohuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ownerhistory.FieldID)
+		for _, f := range fields {
+			if !ownerhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ownerhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ohuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ohuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldTenantID,
+		})
+	}
+	if value, ok := ohuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldTenantID,
+		})
+	}
+	if ohuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: ownerhistory.FieldTenantID,
+		})
+	}
+	if value, ok := ohuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldUserID,
+		})
+	}
+	if value, ok := ohuo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: ownerhistory.FieldUserID,
+		})
+	}
+	if ohuo.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: ownerhistory.FieldUserID,
+		})
+	}
+	if value, ok := ohuo.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ownerhistory.FieldStartedAt,
+		})
+	}
+	if value, ok := ohuo.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ownerhistory.FieldEndedAt,
+		})
+	}
+	if ohuo.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: ownerhistory.FieldEndedAt,
+		})
+	}
+	if ohuo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownerhistory.CarTable,
+			Columns: []string{ownerhistory.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ohuo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownerhistory.CarTable,
+			Columns: []string{ownerhistory.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OwnerHistory{config: ohuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ohuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownerhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
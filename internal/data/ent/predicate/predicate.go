@@ -6,5 +6,89 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
+// AttributeDefinition is the predicate function for attributedefinition builders.
+type AttributeDefinition func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Brand is the predicate function for brand builders.
+type Brand func(*sql.Selector)
+
 // Car is the predicate function for car builders.
 type Car func(*sql.Selector)
+
+// CarAttribute is the predicate function for carattribute builders.
+type CarAttribute func(*sql.Selector)
+
+// CarRecall is the predicate function for carrecall builders.
+type CarRecall func(*sql.Selector)
+
+// CarWarranty is the predicate function for carwarranty builders.
+type CarWarranty func(*sql.Selector)
+
+// ChargingSession is the predicate function for chargingsession builders.
+type ChargingSession func(*sql.Selector)
+
+// Fleet is the predicate function for fleet builders.
+type Fleet func(*sql.Selector)
+
+// Geofence is the predicate function for geofence builders.
+type Geofence func(*sql.Selector)
+
+// Incident is the predicate function for incident builders.
+type Incident func(*sql.Selector)
+
+// InsurancePolicy is the predicate function for insurancepolicy builders.
+type InsurancePolicy func(*sql.Selector)
+
+// JobRun is the predicate function for jobrun builders.
+type JobRun func(*sql.Selector)
+
+// LeaseContract is the predicate function for leasecontract builders.
+type LeaseContract func(*sql.Selector)
+
+// Listing is the predicate function for listing builders.
+type Listing func(*sql.Selector)
+
+// MaintenanceRecord is the predicate function for maintenancerecord builders.
+type MaintenanceRecord func(*sql.Selector)
+
+// OdometerReading is the predicate function for odometerreading builders.
+type OdometerReading func(*sql.Selector)
+
+// OwnerHistory is the predicate function for ownerhistory builders.
+type OwnerHistory func(*sql.Selector)
+
+// Recall is the predicate function for recall builders.
+type Recall func(*sql.Selector)
+
+// Refuel is the predicate function for refuel builders.
+type Refuel func(*sql.Selector)
+
+// Reservation is the predicate function for reservation builders.
+type Reservation func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// TradeRecord is the predicate function for traderecord builders.
+type TradeRecord func(*sql.Selector)
+
+// Transfer is the predicate function for transfer builders.
+type Transfer func(*sql.Selector)
+
+// Trip is the predicate function for trip builders.
+type Trip func(*sql.Selector)
+
+// VehicleModel is the predicate function for vehiclemodel builders.
+type VehicleModel func(*sql.Selector)
+
+// Violation is the predicate function for violation builders.
+type Violation func(*sql.Selector)
+
+// WarrantyDefinition is the predicate function for warrantydefinition builders.
+type WarrantyDefinition func(*sql.Selector)
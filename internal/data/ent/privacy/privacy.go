@@ -0,0 +1,1009 @@
+// Code generated by ent, DO NOT EDIT.
+
+package privacy
+
+import (
+	"car-service/internal/data/ent"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/entql"
+	"entgo.io/ent/privacy"
+)
+
+var (
+	// Allow may be returned by rules to indicate that the policy
+	// evaluation should terminate with allow decision.
+	Allow = privacy.Allow
+
+	// Deny may be returned by rules to indicate that the policy
+	// evaluation should terminate with deny decision.
+	Deny = privacy.Deny
+
+	// Skip may be returned by rules to indicate that the policy
+	// evaluation should continue to the next rule.
+	Skip = privacy.Skip
+)
+
+// Allowf returns an formatted wrapped Allow decision.
+func Allowf(format string, a ...interface{}) error {
+	return fmt.Errorf(format+": %w", append(a, Allow)...)
+}
+
+// Denyf returns an formatted wrapped Deny decision.
+func Denyf(format string, a ...interface{}) error {
+	return fmt.Errorf(format+": %w", append(a, Deny)...)
+}
+
+// Skipf returns an formatted wrapped Skip decision.
+func Skipf(format string, a ...interface{}) error {
+	return fmt.Errorf(format+": %w", append(a, Skip)...)
+}
+
+// DecisionContext creates a new context from the given parent context with
+// a policy decision attach to it.
+func DecisionContext(parent context.Context, decision error) context.Context {
+	return privacy.DecisionContext(parent, decision)
+}
+
+// DecisionFromContext retrieves the policy decision from the context.
+func DecisionFromContext(ctx context.Context) (error, bool) {
+	return privacy.DecisionFromContext(ctx)
+}
+
+type (
+	// Policy groups query and mutation policies.
+	Policy = privacy.Policy
+
+	// QueryRule defines the interface deciding whether a
+	// query is allowed and optionally modify it.
+	QueryRule = privacy.QueryRule
+	// QueryPolicy combines multiple query rules into a single policy.
+	QueryPolicy = privacy.QueryPolicy
+
+	// MutationRule defines the interface which decides whether a
+	// mutation is allowed and optionally modifies it.
+	MutationRule = privacy.MutationRule
+	// MutationPolicy combines multiple mutation rules into a single policy.
+	MutationPolicy = privacy.MutationPolicy
+)
+
+// QueryRuleFunc type is an adapter to allow the use of
+// ordinary functions as query rules.
+type QueryRuleFunc func(context.Context, ent.Query) error
+
+// Eval returns f(ctx, q).
+func (f QueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	return f(ctx, q)
+}
+
+// MutationRuleFunc type is an adapter which allows the use of
+// ordinary functions as mutation rules.
+type MutationRuleFunc func(context.Context, ent.Mutation) error
+
+// EvalMutation returns f(ctx, m).
+func (f MutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	return f(ctx, m)
+}
+
+// QueryMutationRule is an interface which groups query and mutation rules.
+type QueryMutationRule interface {
+	QueryRule
+	MutationRule
+}
+
+// AlwaysAllowRule returns a rule that returns an allow decision.
+func AlwaysAllowRule() QueryMutationRule {
+	return fixedDecision{Allow}
+}
+
+// AlwaysDenyRule returns a rule that returns a deny decision.
+func AlwaysDenyRule() QueryMutationRule {
+	return fixedDecision{Deny}
+}
+
+type fixedDecision struct {
+	decision error
+}
+
+func (f fixedDecision) EvalQuery(context.Context, ent.Query) error {
+	return f.decision
+}
+
+func (f fixedDecision) EvalMutation(context.Context, ent.Mutation) error {
+	return f.decision
+}
+
+type contextDecision struct {
+	eval func(context.Context) error
+}
+
+// ContextQueryMutationRule creates a query/mutation rule from a context eval func.
+func ContextQueryMutationRule(eval func(context.Context) error) QueryMutationRule {
+	return contextDecision{eval}
+}
+
+func (c contextDecision) EvalQuery(ctx context.Context, _ ent.Query) error {
+	return c.eval(ctx)
+}
+
+func (c contextDecision) EvalMutation(ctx context.Context, _ ent.Mutation) error {
+	return c.eval(ctx)
+}
+
+// OnMutationOperation evaluates the given rule only on a given mutation operation.
+func OnMutationOperation(rule MutationRule, op ent.Op) MutationRule {
+	return MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if m.Op().Is(op) {
+			return rule.EvalMutation(ctx, m)
+		}
+		return Skip
+	})
+}
+
+// DenyMutationOperationRule returns a rule denying specified mutation operation.
+func DenyMutationOperationRule(op ent.Op) MutationRule {
+	rule := MutationRuleFunc(func(_ context.Context, m ent.Mutation) error {
+		return Denyf("ent/privacy: operation %s is not allowed", m.Op())
+	})
+	return OnMutationOperation(rule, op)
+}
+
+// The AttachmentQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AttachmentQueryRuleFunc func(context.Context, *ent.AttachmentQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AttachmentQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AttachmentQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AttachmentQuery", q)
+}
+
+// The AttachmentMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AttachmentMutationRuleFunc func(context.Context, *ent.AttachmentMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AttachmentMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AttachmentMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AttachmentMutation", m)
+}
+
+// The AttributeDefinitionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AttributeDefinitionQueryRuleFunc func(context.Context, *ent.AttributeDefinitionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AttributeDefinitionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AttributeDefinitionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AttributeDefinitionQuery", q)
+}
+
+// The AttributeDefinitionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AttributeDefinitionMutationRuleFunc func(context.Context, *ent.AttributeDefinitionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AttributeDefinitionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AttributeDefinitionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AttributeDefinitionMutation", m)
+}
+
+// The AuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditLogQueryRuleFunc func(context.Context, *ent.AuditLogQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditLogQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditLogQuery", q)
+}
+
+// The AuditLogMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditLogMutationRuleFunc func(context.Context, *ent.AuditLogMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditLogMutation", m)
+}
+
+// The BrandQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type BrandQueryRuleFunc func(context.Context, *ent.BrandQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f BrandQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BrandQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.BrandQuery", q)
+}
+
+// The BrandMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type BrandMutationRuleFunc func(context.Context, *ent.BrandMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f BrandMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.BrandMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.BrandMutation", m)
+}
+
+// The CarQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CarQueryRuleFunc func(context.Context, *ent.CarQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CarQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CarQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CarQuery", q)
+}
+
+// The CarMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CarMutationRuleFunc func(context.Context, *ent.CarMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CarMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CarMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CarMutation", m)
+}
+
+// The CarAttributeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CarAttributeQueryRuleFunc func(context.Context, *ent.CarAttributeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CarAttributeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CarAttributeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CarAttributeQuery", q)
+}
+
+// The CarAttributeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CarAttributeMutationRuleFunc func(context.Context, *ent.CarAttributeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CarAttributeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CarAttributeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CarAttributeMutation", m)
+}
+
+// The CarRecallQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CarRecallQueryRuleFunc func(context.Context, *ent.CarRecallQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CarRecallQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CarRecallQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CarRecallQuery", q)
+}
+
+// The CarRecallMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CarRecallMutationRuleFunc func(context.Context, *ent.CarRecallMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CarRecallMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CarRecallMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CarRecallMutation", m)
+}
+
+// The CarWarrantyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CarWarrantyQueryRuleFunc func(context.Context, *ent.CarWarrantyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CarWarrantyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CarWarrantyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CarWarrantyQuery", q)
+}
+
+// The CarWarrantyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CarWarrantyMutationRuleFunc func(context.Context, *ent.CarWarrantyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CarWarrantyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CarWarrantyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CarWarrantyMutation", m)
+}
+
+// The ChargingSessionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ChargingSessionQueryRuleFunc func(context.Context, *ent.ChargingSessionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ChargingSessionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ChargingSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ChargingSessionQuery", q)
+}
+
+// The ChargingSessionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ChargingSessionMutationRuleFunc func(context.Context, *ent.ChargingSessionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ChargingSessionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ChargingSessionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ChargingSessionMutation", m)
+}
+
+// The FleetQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FleetQueryRuleFunc func(context.Context, *ent.FleetQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FleetQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FleetQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FleetQuery", q)
+}
+
+// The FleetMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FleetMutationRuleFunc func(context.Context, *ent.FleetMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FleetMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FleetMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FleetMutation", m)
+}
+
+// The GeofenceQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type GeofenceQueryRuleFunc func(context.Context, *ent.GeofenceQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f GeofenceQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GeofenceQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.GeofenceQuery", q)
+}
+
+// The GeofenceMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type GeofenceMutationRuleFunc func(context.Context, *ent.GeofenceMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f GeofenceMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.GeofenceMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.GeofenceMutation", m)
+}
+
+// The IncidentQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type IncidentQueryRuleFunc func(context.Context, *ent.IncidentQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f IncidentQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.IncidentQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.IncidentQuery", q)
+}
+
+// The IncidentMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type IncidentMutationRuleFunc func(context.Context, *ent.IncidentMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f IncidentMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.IncidentMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.IncidentMutation", m)
+}
+
+// The InsurancePolicyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type InsurancePolicyQueryRuleFunc func(context.Context, *ent.InsurancePolicyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f InsurancePolicyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InsurancePolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.InsurancePolicyQuery", q)
+}
+
+// The InsurancePolicyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type InsurancePolicyMutationRuleFunc func(context.Context, *ent.InsurancePolicyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f InsurancePolicyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.InsurancePolicyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InsurancePolicyMutation", m)
+}
+
+// The JobRunQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type JobRunQueryRuleFunc func(context.Context, *ent.JobRunQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f JobRunQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.JobRunQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.JobRunQuery", q)
+}
+
+// The JobRunMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type JobRunMutationRuleFunc func(context.Context, *ent.JobRunMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f JobRunMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.JobRunMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.JobRunMutation", m)
+}
+
+// The LeaseContractQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LeaseContractQueryRuleFunc func(context.Context, *ent.LeaseContractQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LeaseContractQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LeaseContractQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LeaseContractQuery", q)
+}
+
+// The LeaseContractMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LeaseContractMutationRuleFunc func(context.Context, *ent.LeaseContractMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LeaseContractMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LeaseContractMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LeaseContractMutation", m)
+}
+
+// The ListingQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ListingQueryRuleFunc func(context.Context, *ent.ListingQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ListingQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ListingQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ListingQuery", q)
+}
+
+// The ListingMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ListingMutationRuleFunc func(context.Context, *ent.ListingMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ListingMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ListingMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ListingMutation", m)
+}
+
+// The MaintenanceRecordQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MaintenanceRecordQueryRuleFunc func(context.Context, *ent.MaintenanceRecordQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MaintenanceRecordQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MaintenanceRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MaintenanceRecordQuery", q)
+}
+
+// The MaintenanceRecordMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MaintenanceRecordMutationRuleFunc func(context.Context, *ent.MaintenanceRecordMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MaintenanceRecordMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MaintenanceRecordMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MaintenanceRecordMutation", m)
+}
+
+// The OdometerReadingQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OdometerReadingQueryRuleFunc func(context.Context, *ent.OdometerReadingQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OdometerReadingQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OdometerReadingQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OdometerReadingQuery", q)
+}
+
+// The OdometerReadingMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OdometerReadingMutationRuleFunc func(context.Context, *ent.OdometerReadingMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OdometerReadingMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OdometerReadingMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OdometerReadingMutation", m)
+}
+
+// The OwnerHistoryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OwnerHistoryQueryRuleFunc func(context.Context, *ent.OwnerHistoryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OwnerHistoryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OwnerHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OwnerHistoryQuery", q)
+}
+
+// The OwnerHistoryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OwnerHistoryMutationRuleFunc func(context.Context, *ent.OwnerHistoryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OwnerHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OwnerHistoryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OwnerHistoryMutation", m)
+}
+
+// The RecallQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RecallQueryRuleFunc func(context.Context, *ent.RecallQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RecallQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RecallQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RecallQuery", q)
+}
+
+// The RecallMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RecallMutationRuleFunc func(context.Context, *ent.RecallMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RecallMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RecallMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RecallMutation", m)
+}
+
+// The RefuelQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RefuelQueryRuleFunc func(context.Context, *ent.RefuelQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RefuelQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RefuelQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RefuelQuery", q)
+}
+
+// The RefuelMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RefuelMutationRuleFunc func(context.Context, *ent.RefuelMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RefuelMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RefuelMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RefuelMutation", m)
+}
+
+// The ReservationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ReservationQueryRuleFunc func(context.Context, *ent.ReservationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ReservationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReservationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ReservationQuery", q)
+}
+
+// The ReservationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ReservationMutationRuleFunc func(context.Context, *ent.ReservationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ReservationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ReservationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ReservationMutation", m)
+}
+
+// The TagQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TagQueryRuleFunc func(context.Context, *ent.TagQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TagQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TagQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TagQuery", q)
+}
+
+// The TagMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TagMutationRuleFunc func(context.Context, *ent.TagMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TagMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TagMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TagMutation", m)
+}
+
+// The TradeRecordQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TradeRecordQueryRuleFunc func(context.Context, *ent.TradeRecordQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TradeRecordQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TradeRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TradeRecordQuery", q)
+}
+
+// The TradeRecordMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TradeRecordMutationRuleFunc func(context.Context, *ent.TradeRecordMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TradeRecordMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TradeRecordMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TradeRecordMutation", m)
+}
+
+// The TransferQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TransferQueryRuleFunc func(context.Context, *ent.TransferQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TransferQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TransferQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TransferQuery", q)
+}
+
+// The TransferMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TransferMutationRuleFunc func(context.Context, *ent.TransferMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TransferMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TransferMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TransferMutation", m)
+}
+
+// The TripQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TripQueryRuleFunc func(context.Context, *ent.TripQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TripQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TripQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TripQuery", q)
+}
+
+// The TripMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TripMutationRuleFunc func(context.Context, *ent.TripMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TripMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TripMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TripMutation", m)
+}
+
+// The VehicleModelQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type VehicleModelQueryRuleFunc func(context.Context, *ent.VehicleModelQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f VehicleModelQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VehicleModelQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.VehicleModelQuery", q)
+}
+
+// The VehicleModelMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type VehicleModelMutationRuleFunc func(context.Context, *ent.VehicleModelMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f VehicleModelMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.VehicleModelMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.VehicleModelMutation", m)
+}
+
+// The ViolationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ViolationQueryRuleFunc func(context.Context, *ent.ViolationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ViolationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ViolationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ViolationQuery", q)
+}
+
+// The ViolationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ViolationMutationRuleFunc func(context.Context, *ent.ViolationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ViolationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ViolationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ViolationMutation", m)
+}
+
+// The WarrantyDefinitionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WarrantyDefinitionQueryRuleFunc func(context.Context, *ent.WarrantyDefinitionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WarrantyDefinitionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WarrantyDefinitionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.WarrantyDefinitionQuery", q)
+}
+
+// The WarrantyDefinitionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WarrantyDefinitionMutationRuleFunc func(context.Context, *ent.WarrantyDefinitionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WarrantyDefinitionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.WarrantyDefinitionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.WarrantyDefinitionMutation", m)
+}
+
+type (
+	// Filter is the interface that wraps the Where function
+	// for filtering nodes in queries and mutations.
+	Filter interface {
+		// Where applies a filter on the executed query/mutation.
+		Where(entql.P)
+	}
+
+	// The FilterFunc type is an adapter that allows the use of ordinary
+	// functions as filters for query and mutation types.
+	FilterFunc func(context.Context, Filter) error
+)
+
+// EvalQuery calls f(ctx, q) if the query implements the Filter interface, otherwise it is denied.
+func (f FilterFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	fr, err := queryFilter(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, fr)
+}
+
+// EvalMutation calls f(ctx, q) if the mutation implements the Filter interface, otherwise it is denied.
+func (f FilterFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	fr, err := mutationFilter(m)
+	if err != nil {
+		return err
+	}
+	return f(ctx, fr)
+}
+
+var _ QueryMutationRule = FilterFunc(nil)
+
+func queryFilter(q ent.Query) (Filter, error) {
+	switch q := q.(type) {
+	case *ent.AttachmentQuery:
+		return q.Filter(), nil
+	case *ent.AttributeDefinitionQuery:
+		return q.Filter(), nil
+	case *ent.AuditLogQuery:
+		return q.Filter(), nil
+	case *ent.BrandQuery:
+		return q.Filter(), nil
+	case *ent.CarQuery:
+		return q.Filter(), nil
+	case *ent.CarAttributeQuery:
+		return q.Filter(), nil
+	case *ent.CarRecallQuery:
+		return q.Filter(), nil
+	case *ent.CarWarrantyQuery:
+		return q.Filter(), nil
+	case *ent.ChargingSessionQuery:
+		return q.Filter(), nil
+	case *ent.FleetQuery:
+		return q.Filter(), nil
+	case *ent.GeofenceQuery:
+		return q.Filter(), nil
+	case *ent.IncidentQuery:
+		return q.Filter(), nil
+	case *ent.InsurancePolicyQuery:
+		return q.Filter(), nil
+	case *ent.JobRunQuery:
+		return q.Filter(), nil
+	case *ent.LeaseContractQuery:
+		return q.Filter(), nil
+	case *ent.ListingQuery:
+		return q.Filter(), nil
+	case *ent.MaintenanceRecordQuery:
+		return q.Filter(), nil
+	case *ent.OdometerReadingQuery:
+		return q.Filter(), nil
+	case *ent.OwnerHistoryQuery:
+		return q.Filter(), nil
+	case *ent.RecallQuery:
+		return q.Filter(), nil
+	case *ent.RefuelQuery:
+		return q.Filter(), nil
+	case *ent.ReservationQuery:
+		return q.Filter(), nil
+	case *ent.TagQuery:
+		return q.Filter(), nil
+	case *ent.TradeRecordQuery:
+		return q.Filter(), nil
+	case *ent.TransferQuery:
+		return q.Filter(), nil
+	case *ent.TripQuery:
+		return q.Filter(), nil
+	case *ent.VehicleModelQuery:
+		return q.Filter(), nil
+	case *ent.ViolationQuery:
+		return q.Filter(), nil
+	case *ent.WarrantyDefinitionQuery:
+		return q.Filter(), nil
+	default:
+		return nil, Denyf("ent/privacy: unexpected query type %T for query filter", q)
+	}
+}
+
+func mutationFilter(m ent.Mutation) (Filter, error) {
+	switch m := m.(type) {
+	case *ent.AttachmentMutation:
+		return m.Filter(), nil
+	case *ent.AttributeDefinitionMutation:
+		return m.Filter(), nil
+	case *ent.AuditLogMutation:
+		return m.Filter(), nil
+	case *ent.BrandMutation:
+		return m.Filter(), nil
+	case *ent.CarMutation:
+		return m.Filter(), nil
+	case *ent.CarAttributeMutation:
+		return m.Filter(), nil
+	case *ent.CarRecallMutation:
+		return m.Filter(), nil
+	case *ent.CarWarrantyMutation:
+		return m.Filter(), nil
+	case *ent.ChargingSessionMutation:
+		return m.Filter(), nil
+	case *ent.FleetMutation:
+		return m.Filter(), nil
+	case *ent.GeofenceMutation:
+		return m.Filter(), nil
+	case *ent.IncidentMutation:
+		return m.Filter(), nil
+	case *ent.InsurancePolicyMutation:
+		return m.Filter(), nil
+	case *ent.JobRunMutation:
+		return m.Filter(), nil
+	case *ent.LeaseContractMutation:
+		return m.Filter(), nil
+	case *ent.ListingMutation:
+		return m.Filter(), nil
+	case *ent.MaintenanceRecordMutation:
+		return m.Filter(), nil
+	case *ent.OdometerReadingMutation:
+		return m.Filter(), nil
+	case *ent.OwnerHistoryMutation:
+		return m.Filter(), nil
+	case *ent.RecallMutation:
+		return m.Filter(), nil
+	case *ent.RefuelMutation:
+		return m.Filter(), nil
+	case *ent.ReservationMutation:
+		return m.Filter(), nil
+	case *ent.TagMutation:
+		return m.Filter(), nil
+	case *ent.TradeRecordMutation:
+		return m.Filter(), nil
+	case *ent.TransferMutation:
+		return m.Filter(), nil
+	case *ent.TripMutation:
+		return m.Filter(), nil
+	case *ent.VehicleModelMutation:
+		return m.Filter(), nil
+	case *ent.ViolationMutation:
+		return m.Filter(), nil
+	case *ent.WarrantyDefinitionMutation:
+		return m.Filter(), nil
+	default:
+		return nil, Denyf("ent/privacy: unexpected mutation type %T for mutation filter", m)
+	}
+}
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/recall"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Recall is the model entity for the Recall schema.
+type Recall struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CampaignNo holds the value of the "campaign_no" field.
+	CampaignNo string `json:"campaign_no,omitempty"`
+	// Manufacturer holds the value of the "manufacturer" field.
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID int64 `json:"model_id,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// YearFrom holds the value of the "year_from" field.
+	YearFrom int `json:"year_from,omitempty"`
+	// YearTo holds the value of the "year_to" field.
+	YearTo int `json:"year_to,omitempty"`
+	// VinFrom holds the value of the "vin_from" field.
+	VinFrom string `json:"vin_from,omitempty"`
+	// VinTo holds the value of the "vin_to" field.
+	VinTo string `json:"vin_to,omitempty"`
+	// Component holds the value of the "component" field.
+	Component string `json:"component,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Remedy holds the value of the "remedy" field.
+	Remedy string `json:"remedy,omitempty"`
+	// IssuedAt holds the value of the "issued_at" field.
+	IssuedAt time.Time `json:"issued_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RecallQuery when eager-loading is set.
+	Edges RecallEdges `json:"edges"`
+}
+
+// RecallEdges holds the relations/edges for other nodes in the graph.
+type RecallEdges struct {
+	// CarRecalls holds the value of the car_recalls edge.
+	CarRecalls []*CarRecall `json:"car_recalls,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarRecallsOrErr returns the CarRecalls value or an error if the edge
+// was not loaded in eager-loading.
+func (e RecallEdges) CarRecallsOrErr() ([]*CarRecall, error) {
+	if e.loadedTypes[0] {
+		return e.CarRecalls, nil
+	}
+	return nil, &NotLoadedError{edge: "car_recalls"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Recall) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recall.FieldID, recall.FieldModelID, recall.FieldYearFrom, recall.FieldYearTo:
+			values[i] = new(sql.NullInt64)
+		case recall.FieldCampaignNo, recall.FieldManufacturer, recall.FieldModel, recall.FieldVinFrom, recall.FieldVinTo, recall.FieldComponent, recall.FieldDescription, recall.FieldRemedy:
+			values[i] = new(sql.NullString)
+		case recall.FieldIssuedAt, recall.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Recall", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Recall fields.
+func (r *Recall) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recall.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int64(value.Int64)
+		case recall.FieldCampaignNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign_no", values[i])
+			} else if value.Valid {
+				r.CampaignNo = value.String
+			}
+		case recall.FieldManufacturer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field manufacturer", values[i])
+			} else if value.Valid {
+				r.Manufacturer = value.String
+			}
+		case recall.FieldModelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				r.ModelID = value.Int64
+			}
+		case recall.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				r.Model = value.String
+			}
+		case recall.FieldYearFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year_from", values[i])
+			} else if value.Valid {
+				r.YearFrom = int(value.Int64)
+			}
+		case recall.FieldYearTo:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year_to", values[i])
+			} else if value.Valid {
+				r.YearTo = int(value.Int64)
+			}
+		case recall.FieldVinFrom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vin_from", values[i])
+			} else if value.Valid {
+				r.VinFrom = value.String
+			}
+		case recall.FieldVinTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vin_to", values[i])
+			} else if value.Valid {
+				r.VinTo = value.String
+			}
+		case recall.FieldComponent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field component", values[i])
+			} else if value.Valid {
+				r.Component = value.String
+			}
+		case recall.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				r.Description = value.String
+			}
+		case recall.FieldRemedy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remedy", values[i])
+			} else if value.Valid {
+				r.Remedy = value.String
+			}
+		case recall.FieldIssuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[i])
+			} else if value.Valid {
+				r.IssuedAt = value.Time
+			}
+		case recall.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCarRecalls queries the "car_recalls" edge of the Recall entity.
+func (r *Recall) QueryCarRecalls() *CarRecallQuery {
+	return (&RecallClient{config: r.config}).QueryCarRecalls(r)
+}
+
+// Update returns a builder for updating this Recall.
+// Note that you need to call Recall.Unwrap() before calling this method if this Recall
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Recall) Update() *RecallUpdateOne {
+	return (&RecallClient{config: r.config}).UpdateOne(r)
+}
+
+// Unwrap unwraps the Recall entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Recall) Unwrap() *Recall {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Recall is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Recall) String() string {
+	var builder strings.Builder
+	builder.WriteString("Recall(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("campaign_no=")
+	builder.WriteString(r.CampaignNo)
+	builder.WriteString(", ")
+	builder.WriteString("manufacturer=")
+	builder.WriteString(r.Manufacturer)
+	builder.WriteString(", ")
+	builder.WriteString("model_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.ModelID))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(r.Model)
+	builder.WriteString(", ")
+	builder.WriteString("year_from=")
+	builder.WriteString(fmt.Sprintf("%v", r.YearFrom))
+	builder.WriteString(", ")
+	builder.WriteString("year_to=")
+	builder.WriteString(fmt.Sprintf("%v", r.YearTo))
+	builder.WriteString(", ")
+	builder.WriteString("vin_from=")
+	builder.WriteString(r.VinFrom)
+	builder.WriteString(", ")
+	builder.WriteString("vin_to=")
+	builder.WriteString(r.VinTo)
+	builder.WriteString(", ")
+	builder.WriteString("component=")
+	builder.WriteString(r.Component)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(r.Description)
+	builder.WriteString(", ")
+	builder.WriteString("remedy=")
+	builder.WriteString(r.Remedy)
+	builder.WriteString(", ")
+	builder.WriteString("issued_at=")
+	builder.WriteString(r.IssuedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Recalls is a parsable slice of Recall.
+type Recalls []*Recall
+
+func (r Recalls) config(cfg config) {
+	for _i := range r {
+		r[_i].config = cfg
+	}
+}
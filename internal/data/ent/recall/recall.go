@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recall
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the recall type in the database.
+	Label = "recall"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCampaignNo holds the string denoting the campaign_no field in the database.
+	FieldCampaignNo = "campaign_no"
+	// FieldManufacturer holds the string denoting the manufacturer field in the database.
+	FieldManufacturer = "manufacturer"
+	// FieldModelID holds the string denoting the model_id field in the database.
+	FieldModelID = "model_id"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldYearFrom holds the string denoting the year_from field in the database.
+	FieldYearFrom = "year_from"
+	// FieldYearTo holds the string denoting the year_to field in the database.
+	FieldYearTo = "year_to"
+	// FieldVinFrom holds the string denoting the vin_from field in the database.
+	FieldVinFrom = "vin_from"
+	// FieldVinTo holds the string denoting the vin_to field in the database.
+	FieldVinTo = "vin_to"
+	// FieldComponent holds the string denoting the component field in the database.
+	FieldComponent = "component"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldRemedy holds the string denoting the remedy field in the database.
+	FieldRemedy = "remedy"
+	// FieldIssuedAt holds the string denoting the issued_at field in the database.
+	FieldIssuedAt = "issued_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCarRecalls holds the string denoting the car_recalls edge name in mutations.
+	EdgeCarRecalls = "car_recalls"
+	// Table holds the table name of the recall in the database.
+	Table = "recall"
+	// CarRecallsTable is the table that holds the car_recalls relation/edge.
+	CarRecallsTable = "car_recall"
+	// CarRecallsInverseTable is the table name for the CarRecall entity.
+	// It exists in this package in order to avoid circular dependency with the "carrecall" package.
+	CarRecallsInverseTable = "car_recall"
+	// CarRecallsColumn is the table column denoting the car_recalls relation/edge.
+	CarRecallsColumn = "recall_id"
+)
+
+// Columns holds all SQL columns for recall fields.
+var Columns = []string{
+	FieldID,
+	FieldCampaignNo,
+	FieldManufacturer,
+	FieldModelID,
+	FieldModel,
+	FieldYearFrom,
+	FieldYearTo,
+	FieldVinFrom,
+	FieldVinTo,
+	FieldComponent,
+	FieldDescription,
+	FieldRemedy,
+	FieldIssuedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,1655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recall
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CampaignNo applies equality check predicate on the "campaign_no" field. It's identical to CampaignNoEQ.
+func CampaignNo(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCampaignNo), v))
+	})
+}
+
+// Manufacturer applies equality check predicate on the "manufacturer" field. It's identical to ManufacturerEQ.
+func Manufacturer(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldManufacturer), v))
+	})
+}
+
+// ModelID applies equality check predicate on the "model_id" field. It's identical to ModelIDEQ.
+func ModelID(v int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModelID), v))
+	})
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModel), v))
+	})
+}
+
+// YearFrom applies equality check predicate on the "year_from" field. It's identical to YearFromEQ.
+func YearFrom(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldYearFrom), v))
+	})
+}
+
+// YearTo applies equality check predicate on the "year_to" field. It's identical to YearToEQ.
+func YearTo(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldYearTo), v))
+	})
+}
+
+// VinFrom applies equality check predicate on the "vin_from" field. It's identical to VinFromEQ.
+func VinFrom(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinTo applies equality check predicate on the "vin_to" field. It's identical to VinToEQ.
+func VinTo(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVinTo), v))
+	})
+}
+
+// Component applies equality check predicate on the "component" field. It's identical to ComponentEQ.
+func Component(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldComponent), v))
+	})
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// Remedy applies equality check predicate on the "remedy" field. It's identical to RemedyEQ.
+func Remedy(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemedy), v))
+	})
+}
+
+// IssuedAt applies equality check predicate on the "issued_at" field. It's identical to IssuedAtEQ.
+func IssuedAt(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuedAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CampaignNoEQ applies the EQ predicate on the "campaign_no" field.
+func CampaignNoEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoNEQ applies the NEQ predicate on the "campaign_no" field.
+func CampaignNoNEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoIn applies the In predicate on the "campaign_no" field.
+func CampaignNoIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCampaignNo), v...))
+	})
+}
+
+// CampaignNoNotIn applies the NotIn predicate on the "campaign_no" field.
+func CampaignNoNotIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCampaignNo), v...))
+	})
+}
+
+// CampaignNoGT applies the GT predicate on the "campaign_no" field.
+func CampaignNoGT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoGTE applies the GTE predicate on the "campaign_no" field.
+func CampaignNoGTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoLT applies the LT predicate on the "campaign_no" field.
+func CampaignNoLT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoLTE applies the LTE predicate on the "campaign_no" field.
+func CampaignNoLTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoContains applies the Contains predicate on the "campaign_no" field.
+func CampaignNoContains(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoHasPrefix applies the HasPrefix predicate on the "campaign_no" field.
+func CampaignNoHasPrefix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoHasSuffix applies the HasSuffix predicate on the "campaign_no" field.
+func CampaignNoHasSuffix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoEqualFold applies the EqualFold predicate on the "campaign_no" field.
+func CampaignNoEqualFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCampaignNo), v))
+	})
+}
+
+// CampaignNoContainsFold applies the ContainsFold predicate on the "campaign_no" field.
+func CampaignNoContainsFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCampaignNo), v))
+	})
+}
+
+// ManufacturerEQ applies the EQ predicate on the "manufacturer" field.
+func ManufacturerEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerNEQ applies the NEQ predicate on the "manufacturer" field.
+func ManufacturerNEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerIn applies the In predicate on the "manufacturer" field.
+func ManufacturerIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldManufacturer), v...))
+	})
+}
+
+// ManufacturerNotIn applies the NotIn predicate on the "manufacturer" field.
+func ManufacturerNotIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldManufacturer), v...))
+	})
+}
+
+// ManufacturerGT applies the GT predicate on the "manufacturer" field.
+func ManufacturerGT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerGTE applies the GTE predicate on the "manufacturer" field.
+func ManufacturerGTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerLT applies the LT predicate on the "manufacturer" field.
+func ManufacturerLT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerLTE applies the LTE predicate on the "manufacturer" field.
+func ManufacturerLTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerContains applies the Contains predicate on the "manufacturer" field.
+func ManufacturerContains(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerHasPrefix applies the HasPrefix predicate on the "manufacturer" field.
+func ManufacturerHasPrefix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerHasSuffix applies the HasSuffix predicate on the "manufacturer" field.
+func ManufacturerHasSuffix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerIsNil applies the IsNil predicate on the "manufacturer" field.
+func ManufacturerIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldManufacturer)))
+	})
+}
+
+// ManufacturerNotNil applies the NotNil predicate on the "manufacturer" field.
+func ManufacturerNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldManufacturer)))
+	})
+}
+
+// ManufacturerEqualFold applies the EqualFold predicate on the "manufacturer" field.
+func ManufacturerEqualFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldManufacturer), v))
+	})
+}
+
+// ManufacturerContainsFold applies the ContainsFold predicate on the "manufacturer" field.
+func ManufacturerContainsFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldManufacturer), v))
+	})
+}
+
+// ModelIDEQ applies the EQ predicate on the "model_id" field.
+func ModelIDEQ(v int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDNEQ applies the NEQ predicate on the "model_id" field.
+func ModelIDNEQ(v int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDIn applies the In predicate on the "model_id" field.
+func ModelIDIn(vs ...int64) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldModelID), v...))
+	})
+}
+
+// ModelIDNotIn applies the NotIn predicate on the "model_id" field.
+func ModelIDNotIn(vs ...int64) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldModelID), v...))
+	})
+}
+
+// ModelIDGT applies the GT predicate on the "model_id" field.
+func ModelIDGT(v int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDGTE applies the GTE predicate on the "model_id" field.
+func ModelIDGTE(v int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDLT applies the LT predicate on the "model_id" field.
+func ModelIDLT(v int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDLTE applies the LTE predicate on the "model_id" field.
+func ModelIDLTE(v int64) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDIsNil applies the IsNil predicate on the "model_id" field.
+func ModelIDIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldModelID)))
+	})
+}
+
+// ModelIDNotNil applies the NotNil predicate on the "model_id" field.
+func ModelIDNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldModelID)))
+	})
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModel), v))
+	})
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldModel), v))
+	})
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldModel), v...))
+	})
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldModel), v...))
+	})
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldModel), v))
+	})
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldModel), v))
+	})
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldModel), v))
+	})
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldModel), v))
+	})
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldModel), v))
+	})
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldModel), v))
+	})
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldModel), v))
+	})
+}
+
+// ModelIsNil applies the IsNil predicate on the "model" field.
+func ModelIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldModel)))
+	})
+}
+
+// ModelNotNil applies the NotNil predicate on the "model" field.
+func ModelNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldModel)))
+	})
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldModel), v))
+	})
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldModel), v))
+	})
+}
+
+// YearFromEQ applies the EQ predicate on the "year_from" field.
+func YearFromEQ(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldYearFrom), v))
+	})
+}
+
+// YearFromNEQ applies the NEQ predicate on the "year_from" field.
+func YearFromNEQ(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldYearFrom), v))
+	})
+}
+
+// YearFromIn applies the In predicate on the "year_from" field.
+func YearFromIn(vs ...int) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldYearFrom), v...))
+	})
+}
+
+// YearFromNotIn applies the NotIn predicate on the "year_from" field.
+func YearFromNotIn(vs ...int) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldYearFrom), v...))
+	})
+}
+
+// YearFromGT applies the GT predicate on the "year_from" field.
+func YearFromGT(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldYearFrom), v))
+	})
+}
+
+// YearFromGTE applies the GTE predicate on the "year_from" field.
+func YearFromGTE(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldYearFrom), v))
+	})
+}
+
+// YearFromLT applies the LT predicate on the "year_from" field.
+func YearFromLT(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldYearFrom), v))
+	})
+}
+
+// YearFromLTE applies the LTE predicate on the "year_from" field.
+func YearFromLTE(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldYearFrom), v))
+	})
+}
+
+// YearFromIsNil applies the IsNil predicate on the "year_from" field.
+func YearFromIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldYearFrom)))
+	})
+}
+
+// YearFromNotNil applies the NotNil predicate on the "year_from" field.
+func YearFromNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldYearFrom)))
+	})
+}
+
+// YearToEQ applies the EQ predicate on the "year_to" field.
+func YearToEQ(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldYearTo), v))
+	})
+}
+
+// YearToNEQ applies the NEQ predicate on the "year_to" field.
+func YearToNEQ(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldYearTo), v))
+	})
+}
+
+// YearToIn applies the In predicate on the "year_to" field.
+func YearToIn(vs ...int) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldYearTo), v...))
+	})
+}
+
+// YearToNotIn applies the NotIn predicate on the "year_to" field.
+func YearToNotIn(vs ...int) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldYearTo), v...))
+	})
+}
+
+// YearToGT applies the GT predicate on the "year_to" field.
+func YearToGT(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldYearTo), v))
+	})
+}
+
+// YearToGTE applies the GTE predicate on the "year_to" field.
+func YearToGTE(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldYearTo), v))
+	})
+}
+
+// YearToLT applies the LT predicate on the "year_to" field.
+func YearToLT(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldYearTo), v))
+	})
+}
+
+// YearToLTE applies the LTE predicate on the "year_to" field.
+func YearToLTE(v int) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldYearTo), v))
+	})
+}
+
+// YearToIsNil applies the IsNil predicate on the "year_to" field.
+func YearToIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldYearTo)))
+	})
+}
+
+// YearToNotNil applies the NotNil predicate on the "year_to" field.
+func YearToNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldYearTo)))
+	})
+}
+
+// VinFromEQ applies the EQ predicate on the "vin_from" field.
+func VinFromEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromNEQ applies the NEQ predicate on the "vin_from" field.
+func VinFromNEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromIn applies the In predicate on the "vin_from" field.
+func VinFromIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVinFrom), v...))
+	})
+}
+
+// VinFromNotIn applies the NotIn predicate on the "vin_from" field.
+func VinFromNotIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVinFrom), v...))
+	})
+}
+
+// VinFromGT applies the GT predicate on the "vin_from" field.
+func VinFromGT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromGTE applies the GTE predicate on the "vin_from" field.
+func VinFromGTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromLT applies the LT predicate on the "vin_from" field.
+func VinFromLT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromLTE applies the LTE predicate on the "vin_from" field.
+func VinFromLTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromContains applies the Contains predicate on the "vin_from" field.
+func VinFromContains(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromHasPrefix applies the HasPrefix predicate on the "vin_from" field.
+func VinFromHasPrefix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromHasSuffix applies the HasSuffix predicate on the "vin_from" field.
+func VinFromHasSuffix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromIsNil applies the IsNil predicate on the "vin_from" field.
+func VinFromIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldVinFrom)))
+	})
+}
+
+// VinFromNotNil applies the NotNil predicate on the "vin_from" field.
+func VinFromNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldVinFrom)))
+	})
+}
+
+// VinFromEqualFold applies the EqualFold predicate on the "vin_from" field.
+func VinFromEqualFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinFromContainsFold applies the ContainsFold predicate on the "vin_from" field.
+func VinFromContainsFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldVinFrom), v))
+	})
+}
+
+// VinToEQ applies the EQ predicate on the "vin_to" field.
+func VinToEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToNEQ applies the NEQ predicate on the "vin_to" field.
+func VinToNEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToIn applies the In predicate on the "vin_to" field.
+func VinToIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVinTo), v...))
+	})
+}
+
+// VinToNotIn applies the NotIn predicate on the "vin_to" field.
+func VinToNotIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVinTo), v...))
+	})
+}
+
+// VinToGT applies the GT predicate on the "vin_to" field.
+func VinToGT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToGTE applies the GTE predicate on the "vin_to" field.
+func VinToGTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToLT applies the LT predicate on the "vin_to" field.
+func VinToLT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToLTE applies the LTE predicate on the "vin_to" field.
+func VinToLTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToContains applies the Contains predicate on the "vin_to" field.
+func VinToContains(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToHasPrefix applies the HasPrefix predicate on the "vin_to" field.
+func VinToHasPrefix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToHasSuffix applies the HasSuffix predicate on the "vin_to" field.
+func VinToHasSuffix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToIsNil applies the IsNil predicate on the "vin_to" field.
+func VinToIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldVinTo)))
+	})
+}
+
+// VinToNotNil applies the NotNil predicate on the "vin_to" field.
+func VinToNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldVinTo)))
+	})
+}
+
+// VinToEqualFold applies the EqualFold predicate on the "vin_to" field.
+func VinToEqualFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldVinTo), v))
+	})
+}
+
+// VinToContainsFold applies the ContainsFold predicate on the "vin_to" field.
+func VinToContainsFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldVinTo), v))
+	})
+}
+
+// ComponentEQ applies the EQ predicate on the "component" field.
+func ComponentEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentNEQ applies the NEQ predicate on the "component" field.
+func ComponentNEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentIn applies the In predicate on the "component" field.
+func ComponentIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldComponent), v...))
+	})
+}
+
+// ComponentNotIn applies the NotIn predicate on the "component" field.
+func ComponentNotIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldComponent), v...))
+	})
+}
+
+// ComponentGT applies the GT predicate on the "component" field.
+func ComponentGT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentGTE applies the GTE predicate on the "component" field.
+func ComponentGTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentLT applies the LT predicate on the "component" field.
+func ComponentLT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentLTE applies the LTE predicate on the "component" field.
+func ComponentLTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentContains applies the Contains predicate on the "component" field.
+func ComponentContains(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentHasPrefix applies the HasPrefix predicate on the "component" field.
+func ComponentHasPrefix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentHasSuffix applies the HasSuffix predicate on the "component" field.
+func ComponentHasSuffix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentIsNil applies the IsNil predicate on the "component" field.
+func ComponentIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldComponent)))
+	})
+}
+
+// ComponentNotNil applies the NotNil predicate on the "component" field.
+func ComponentNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldComponent)))
+	})
+}
+
+// ComponentEqualFold applies the EqualFold predicate on the "component" field.
+func ComponentEqualFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldComponent), v))
+	})
+}
+
+// ComponentContainsFold applies the ContainsFold predicate on the "component" field.
+func ComponentContainsFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldComponent), v))
+	})
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDescription)))
+	})
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDescription)))
+	})
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDescription), v))
+	})
+}
+
+// RemedyEQ applies the EQ predicate on the "remedy" field.
+func RemedyEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyNEQ applies the NEQ predicate on the "remedy" field.
+func RemedyNEQ(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyIn applies the In predicate on the "remedy" field.
+func RemedyIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRemedy), v...))
+	})
+}
+
+// RemedyNotIn applies the NotIn predicate on the "remedy" field.
+func RemedyNotIn(vs ...string) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRemedy), v...))
+	})
+}
+
+// RemedyGT applies the GT predicate on the "remedy" field.
+func RemedyGT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyGTE applies the GTE predicate on the "remedy" field.
+func RemedyGTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyLT applies the LT predicate on the "remedy" field.
+func RemedyLT(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyLTE applies the LTE predicate on the "remedy" field.
+func RemedyLTE(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyContains applies the Contains predicate on the "remedy" field.
+func RemedyContains(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyHasPrefix applies the HasPrefix predicate on the "remedy" field.
+func RemedyHasPrefix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyHasSuffix applies the HasSuffix predicate on the "remedy" field.
+func RemedyHasSuffix(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyIsNil applies the IsNil predicate on the "remedy" field.
+func RemedyIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRemedy)))
+	})
+}
+
+// RemedyNotNil applies the NotNil predicate on the "remedy" field.
+func RemedyNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRemedy)))
+	})
+}
+
+// RemedyEqualFold applies the EqualFold predicate on the "remedy" field.
+func RemedyEqualFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRemedy), v))
+	})
+}
+
+// RemedyContainsFold applies the ContainsFold predicate on the "remedy" field.
+func RemedyContainsFold(v string) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRemedy), v))
+	})
+}
+
+// IssuedAtEQ applies the EQ predicate on the "issued_at" field.
+func IssuedAtEQ(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtNEQ applies the NEQ predicate on the "issued_at" field.
+func IssuedAtNEQ(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtIn applies the In predicate on the "issued_at" field.
+func IssuedAtIn(vs ...time.Time) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIssuedAt), v...))
+	})
+}
+
+// IssuedAtNotIn applies the NotIn predicate on the "issued_at" field.
+func IssuedAtNotIn(vs ...time.Time) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIssuedAt), v...))
+	})
+}
+
+// IssuedAtGT applies the GT predicate on the "issued_at" field.
+func IssuedAtGT(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtGTE applies the GTE predicate on the "issued_at" field.
+func IssuedAtGTE(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtLT applies the LT predicate on the "issued_at" field.
+func IssuedAtLT(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtLTE applies the LTE predicate on the "issued_at" field.
+func IssuedAtLTE(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtIsNil applies the IsNil predicate on the "issued_at" field.
+func IssuedAtIsNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIssuedAt)))
+	})
+}
+
+// IssuedAtNotNil applies the NotNil predicate on the "issued_at" field.
+func IssuedAtNotNil() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIssuedAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Recall {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Recall(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasCarRecalls applies the HasEdge predicate on the "car_recalls" edge.
+func HasCarRecalls() predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarRecallsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CarRecallsTable, CarRecallsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarRecallsWith applies the HasEdge predicate on the "car_recalls" edge with a given conditions (other predicates).
+func HasCarRecallsWith(preds ...predicate.CarRecall) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarRecallsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CarRecallsTable, CarRecallsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Recall) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Recall) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Recall) predicate.Recall {
+	return predicate.Recall(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/recall"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecallCreate is the builder for creating a Recall entity.
+type RecallCreate struct {
+	config
+	mutation *RecallMutation
+	hooks    []Hook
+}
+
+// SetCampaignNo sets the "campaign_no" field.
+func (rc *RecallCreate) SetCampaignNo(s string) *RecallCreate {
+	rc.mutation.SetCampaignNo(s)
+	return rc
+}
+
+// SetManufacturer sets the "manufacturer" field.
+func (rc *RecallCreate) SetManufacturer(s string) *RecallCreate {
+	rc.mutation.SetManufacturer(s)
+	return rc
+}
+
+// SetNillableManufacturer sets the "manufacturer" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableManufacturer(s *string) *RecallCreate {
+	if s != nil {
+		rc.SetManufacturer(*s)
+	}
+	return rc
+}
+
+// SetModelID sets the "model_id" field.
+func (rc *RecallCreate) SetModelID(i int64) *RecallCreate {
+	rc.mutation.SetModelID(i)
+	return rc
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableModelID(i *int64) *RecallCreate {
+	if i != nil {
+		rc.SetModelID(*i)
+	}
+	return rc
+}
+
+// SetModel sets the "model" field.
+func (rc *RecallCreate) SetModel(s string) *RecallCreate {
+	rc.mutation.SetModel(s)
+	return rc
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableModel(s *string) *RecallCreate {
+	if s != nil {
+		rc.SetModel(*s)
+	}
+	return rc
+}
+
+// SetYearFrom sets the "year_from" field.
+func (rc *RecallCreate) SetYearFrom(i int) *RecallCreate {
+	rc.mutation.SetYearFrom(i)
+	return rc
+}
+
+// SetNillableYearFrom sets the "year_from" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableYearFrom(i *int) *RecallCreate {
+	if i != nil {
+		rc.SetYearFrom(*i)
+	}
+	return rc
+}
+
+// SetYearTo sets the "year_to" field.
+func (rc *RecallCreate) SetYearTo(i int) *RecallCreate {
+	rc.mutation.SetYearTo(i)
+	return rc
+}
+
+// SetNillableYearTo sets the "year_to" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableYearTo(i *int) *RecallCreate {
+	if i != nil {
+		rc.SetYearTo(*i)
+	}
+	return rc
+}
+
+// SetVinFrom sets the "vin_from" field.
+func (rc *RecallCreate) SetVinFrom(s string) *RecallCreate {
+	rc.mutation.SetVinFrom(s)
+	return rc
+}
+
+// SetNillableVinFrom sets the "vin_from" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableVinFrom(s *string) *RecallCreate {
+	if s != nil {
+		rc.SetVinFrom(*s)
+	}
+	return rc
+}
+
+// SetVinTo sets the "vin_to" field.
+func (rc *RecallCreate) SetVinTo(s string) *RecallCreate {
+	rc.mutation.SetVinTo(s)
+	return rc
+}
+
+// SetNillableVinTo sets the "vin_to" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableVinTo(s *string) *RecallCreate {
+	if s != nil {
+		rc.SetVinTo(*s)
+	}
+	return rc
+}
+
+// SetComponent sets the "component" field.
+func (rc *RecallCreate) SetComponent(s string) *RecallCreate {
+	rc.mutation.SetComponent(s)
+	return rc
+}
+
+// SetNillableComponent sets the "component" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableComponent(s *string) *RecallCreate {
+	if s != nil {
+		rc.SetComponent(*s)
+	}
+	return rc
+}
+
+// SetDescription sets the "description" field.
+func (rc *RecallCreate) SetDescription(s string) *RecallCreate {
+	rc.mutation.SetDescription(s)
+	return rc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableDescription(s *string) *RecallCreate {
+	if s != nil {
+		rc.SetDescription(*s)
+	}
+	return rc
+}
+
+// SetRemedy sets the "remedy" field.
+func (rc *RecallCreate) SetRemedy(s string) *RecallCreate {
+	rc.mutation.SetRemedy(s)
+	return rc
+}
+
+// SetNillableRemedy sets the "remedy" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableRemedy(s *string) *RecallCreate {
+	if s != nil {
+		rc.SetRemedy(*s)
+	}
+	return rc
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (rc *RecallCreate) SetIssuedAt(t time.Time) *RecallCreate {
+	rc.mutation.SetIssuedAt(t)
+	return rc
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableIssuedAt(t *time.Time) *RecallCreate {
+	if t != nil {
+		rc.SetIssuedAt(*t)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *RecallCreate) SetCreatedAt(t time.Time) *RecallCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *RecallCreate) SetNillableCreatedAt(t *time.Time) *RecallCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *RecallCreate) SetID(i int64) *RecallCreate {
+	rc.mutation.SetID(i)
+	return rc
+}
+
+// AddCarRecallIDs adds the "car_recalls" edge to the CarRecall entity by IDs.
+func (rc *RecallCreate) AddCarRecallIDs(ids ...int64) *RecallCreate {
+	rc.mutation.AddCarRecallIDs(ids...)
+	return rc
+}
+
+// AddCarRecalls adds the "car_recalls" edges to the CarRecall entity.
+func (rc *RecallCreate) AddCarRecalls(c ...*CarRecall) *RecallCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rc.AddCarRecallIDs(ids...)
+}
+
+// Mutation returns the RecallMutation object of the builder.
+func (rc *RecallCreate) Mutation() *RecallMutation {
+	return rc.mutation
+}
+
+// Save creates the Recall in the database.
+func (rc *RecallCreate) Save(ctx context.Context) (*Recall, error) {
+	var (
+		err  error
+		node *Recall
+	)
+	rc.defaults()
+	if len(rc.hooks) == 0 {
+		if err = rc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RecallMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rc.check(); err != nil {
+				return nil, err
+			}
+			rc.mutation = mutation
+			if node, err = rc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rc.hooks) - 1; i >= 0; i-- {
+			if rc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Recall)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RecallMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RecallCreate) SaveX(ctx context.Context) *Recall {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RecallCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RecallCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RecallCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := recall.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RecallCreate) check() error {
+	if _, ok := rc.mutation.CampaignNo(); !ok {
+		return &ValidationError{Name: "campaign_no", err: errors.New(`ent: missing required field "Recall.campaign_no"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Recall.created_at"`)}
+	}
+	return nil
+}
+
+func (rc *RecallCreate) sqlSave(ctx context.Context) (*Recall, error) {
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (rc *RecallCreate) createSpec() (*Recall, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Recall{config: rc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: recall.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: recall.FieldID,
+			},
+		}
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.CampaignNo(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldCampaignNo,
+		})
+		_node.CampaignNo = value
+	}
+	if value, ok := rc.mutation.Manufacturer(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldManufacturer,
+		})
+		_node.Manufacturer = value
+	}
+	if value, ok := rc.mutation.ModelID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: recall.FieldModelID,
+		})
+		_node.ModelID = value
+	}
+	if value, ok := rc.mutation.Model(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldModel,
+		})
+		_node.Model = value
+	}
+	if value, ok := rc.mutation.YearFrom(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearFrom,
+		})
+		_node.YearFrom = value
+	}
+	if value, ok := rc.mutation.YearTo(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearTo,
+		})
+		_node.YearTo = value
+	}
+	if value, ok := rc.mutation.VinFrom(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldVinFrom,
+		})
+		_node.VinFrom = value
+	}
+	if value, ok := rc.mutation.VinTo(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldVinTo,
+		})
+		_node.VinTo = value
+	}
+	if value, ok := rc.mutation.Component(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldComponent,
+		})
+		_node.Component = value
+	}
+	if value, ok := rc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := rc.mutation.Remedy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldRemedy,
+		})
+		_node.Remedy = value
+	}
+	if value, ok := rc.mutation.IssuedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: recall.FieldIssuedAt,
+		})
+		_node.IssuedAt = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: recall.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := rc.mutation.CarRecallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recall.CarRecallsTable,
+			Columns: []string{recall.CarRecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RecallCreateBulk is the builder for creating many Recall entities in bulk.
+type RecallCreateBulk struct {
+	config
+	builders []*RecallCreate
+}
+
+// Save creates the Recall entities in the database.
+func (rcb *RecallCreateBulk) Save(ctx context.Context) ([]*Recall, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Recall, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecallMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RecallCreateBulk) SaveX(ctx context.Context) []*Recall {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RecallCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RecallCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
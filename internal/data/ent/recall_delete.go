@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/recall"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecallDelete is the builder for deleting a Recall entity.
+type RecallDelete struct {
+	config
+	hooks    []Hook
+	mutation *RecallMutation
+}
+
+// Where appends a list predicates to the RecallDelete builder.
+func (rd *RecallDelete) Where(ps ...predicate.Recall) *RecallDelete {
+	rd.mutation.Where(ps...)
+	return rd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rd *RecallDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rd.hooks) == 0 {
+		affected, err = rd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RecallMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rd.mutation = mutation
+			affected, err = rd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rd.hooks) - 1; i >= 0; i-- {
+			if rd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rd *RecallDelete) ExecX(ctx context.Context) int {
+	n, err := rd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rd *RecallDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: recall.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: recall.FieldID,
+			},
+		},
+	}
+	if ps := rd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// RecallDeleteOne is the builder for deleting a single Recall entity.
+type RecallDeleteOne struct {
+	rd *RecallDelete
+}
+
+// Exec executes the deletion query.
+func (rdo *RecallDeleteOne) Exec(ctx context.Context) error {
+	n, err := rdo.rd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{recall.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdo *RecallDeleteOne) ExecX(ctx context.Context) {
+	rdo.rd.ExecX(ctx)
+}
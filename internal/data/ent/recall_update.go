@@ -0,0 +1,1305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/recall"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecallUpdate is the builder for updating Recall entities.
+type RecallUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecallMutation
+}
+
+// Where appends a list predicates to the RecallUpdate builder.
+func (ru *RecallUpdate) Where(ps ...predicate.Recall) *RecallUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetCampaignNo sets the "campaign_no" field.
+func (ru *RecallUpdate) SetCampaignNo(s string) *RecallUpdate {
+	ru.mutation.SetCampaignNo(s)
+	return ru
+}
+
+// SetManufacturer sets the "manufacturer" field.
+func (ru *RecallUpdate) SetManufacturer(s string) *RecallUpdate {
+	ru.mutation.SetManufacturer(s)
+	return ru
+}
+
+// SetNillableManufacturer sets the "manufacturer" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableManufacturer(s *string) *RecallUpdate {
+	if s != nil {
+		ru.SetManufacturer(*s)
+	}
+	return ru
+}
+
+// ClearManufacturer clears the value of the "manufacturer" field.
+func (ru *RecallUpdate) ClearManufacturer() *RecallUpdate {
+	ru.mutation.ClearManufacturer()
+	return ru
+}
+
+// SetModelID sets the "model_id" field.
+func (ru *RecallUpdate) SetModelID(i int64) *RecallUpdate {
+	ru.mutation.ResetModelID()
+	ru.mutation.SetModelID(i)
+	return ru
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableModelID(i *int64) *RecallUpdate {
+	if i != nil {
+		ru.SetModelID(*i)
+	}
+	return ru
+}
+
+// AddModelID adds i to the "model_id" field.
+func (ru *RecallUpdate) AddModelID(i int64) *RecallUpdate {
+	ru.mutation.AddModelID(i)
+	return ru
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (ru *RecallUpdate) ClearModelID() *RecallUpdate {
+	ru.mutation.ClearModelID()
+	return ru
+}
+
+// SetModel sets the "model" field.
+func (ru *RecallUpdate) SetModel(s string) *RecallUpdate {
+	ru.mutation.SetModel(s)
+	return ru
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableModel(s *string) *RecallUpdate {
+	if s != nil {
+		ru.SetModel(*s)
+	}
+	return ru
+}
+
+// ClearModel clears the value of the "model" field.
+func (ru *RecallUpdate) ClearModel() *RecallUpdate {
+	ru.mutation.ClearModel()
+	return ru
+}
+
+// SetYearFrom sets the "year_from" field.
+func (ru *RecallUpdate) SetYearFrom(i int) *RecallUpdate {
+	ru.mutation.ResetYearFrom()
+	ru.mutation.SetYearFrom(i)
+	return ru
+}
+
+// SetNillableYearFrom sets the "year_from" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableYearFrom(i *int) *RecallUpdate {
+	if i != nil {
+		ru.SetYearFrom(*i)
+	}
+	return ru
+}
+
+// AddYearFrom adds i to the "year_from" field.
+func (ru *RecallUpdate) AddYearFrom(i int) *RecallUpdate {
+	ru.mutation.AddYearFrom(i)
+	return ru
+}
+
+// ClearYearFrom clears the value of the "year_from" field.
+func (ru *RecallUpdate) ClearYearFrom() *RecallUpdate {
+	ru.mutation.ClearYearFrom()
+	return ru
+}
+
+// SetYearTo sets the "year_to" field.
+func (ru *RecallUpdate) SetYearTo(i int) *RecallUpdate {
+	ru.mutation.ResetYearTo()
+	ru.mutation.SetYearTo(i)
+	return ru
+}
+
+// SetNillableYearTo sets the "year_to" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableYearTo(i *int) *RecallUpdate {
+	if i != nil {
+		ru.SetYearTo(*i)
+	}
+	return ru
+}
+
+// AddYearTo adds i to the "year_to" field.
+func (ru *RecallUpdate) AddYearTo(i int) *RecallUpdate {
+	ru.mutation.AddYearTo(i)
+	return ru
+}
+
+// ClearYearTo clears the value of the "year_to" field.
+func (ru *RecallUpdate) ClearYearTo() *RecallUpdate {
+	ru.mutation.ClearYearTo()
+	return ru
+}
+
+// SetVinFrom sets the "vin_from" field.
+func (ru *RecallUpdate) SetVinFrom(s string) *RecallUpdate {
+	ru.mutation.SetVinFrom(s)
+	return ru
+}
+
+// SetNillableVinFrom sets the "vin_from" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableVinFrom(s *string) *RecallUpdate {
+	if s != nil {
+		ru.SetVinFrom(*s)
+	}
+	return ru
+}
+
+// ClearVinFrom clears the value of the "vin_from" field.
+func (ru *RecallUpdate) ClearVinFrom() *RecallUpdate {
+	ru.mutation.ClearVinFrom()
+	return ru
+}
+
+// SetVinTo sets the "vin_to" field.
+func (ru *RecallUpdate) SetVinTo(s string) *RecallUpdate {
+	ru.mutation.SetVinTo(s)
+	return ru
+}
+
+// SetNillableVinTo sets the "vin_to" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableVinTo(s *string) *RecallUpdate {
+	if s != nil {
+		ru.SetVinTo(*s)
+	}
+	return ru
+}
+
+// ClearVinTo clears the value of the "vin_to" field.
+func (ru *RecallUpdate) ClearVinTo() *RecallUpdate {
+	ru.mutation.ClearVinTo()
+	return ru
+}
+
+// SetComponent sets the "component" field.
+func (ru *RecallUpdate) SetComponent(s string) *RecallUpdate {
+	ru.mutation.SetComponent(s)
+	return ru
+}
+
+// SetNillableComponent sets the "component" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableComponent(s *string) *RecallUpdate {
+	if s != nil {
+		ru.SetComponent(*s)
+	}
+	return ru
+}
+
+// ClearComponent clears the value of the "component" field.
+func (ru *RecallUpdate) ClearComponent() *RecallUpdate {
+	ru.mutation.ClearComponent()
+	return ru
+}
+
+// SetDescription sets the "description" field.
+func (ru *RecallUpdate) SetDescription(s string) *RecallUpdate {
+	ru.mutation.SetDescription(s)
+	return ru
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableDescription(s *string) *RecallUpdate {
+	if s != nil {
+		ru.SetDescription(*s)
+	}
+	return ru
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ru *RecallUpdate) ClearDescription() *RecallUpdate {
+	ru.mutation.ClearDescription()
+	return ru
+}
+
+// SetRemedy sets the "remedy" field.
+func (ru *RecallUpdate) SetRemedy(s string) *RecallUpdate {
+	ru.mutation.SetRemedy(s)
+	return ru
+}
+
+// SetNillableRemedy sets the "remedy" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableRemedy(s *string) *RecallUpdate {
+	if s != nil {
+		ru.SetRemedy(*s)
+	}
+	return ru
+}
+
+// ClearRemedy clears the value of the "remedy" field.
+func (ru *RecallUpdate) ClearRemedy() *RecallUpdate {
+	ru.mutation.ClearRemedy()
+	return ru
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (ru *RecallUpdate) SetIssuedAt(t time.Time) *RecallUpdate {
+	ru.mutation.SetIssuedAt(t)
+	return ru
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableIssuedAt(t *time.Time) *RecallUpdate {
+	if t != nil {
+		ru.SetIssuedAt(*t)
+	}
+	return ru
+}
+
+// ClearIssuedAt clears the value of the "issued_at" field.
+func (ru *RecallUpdate) ClearIssuedAt() *RecallUpdate {
+	ru.mutation.ClearIssuedAt()
+	return ru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ru *RecallUpdate) SetCreatedAt(t time.Time) *RecallUpdate {
+	ru.mutation.SetCreatedAt(t)
+	return ru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ru *RecallUpdate) SetNillableCreatedAt(t *time.Time) *RecallUpdate {
+	if t != nil {
+		ru.SetCreatedAt(*t)
+	}
+	return ru
+}
+
+// AddCarRecallIDs adds the "car_recalls" edge to the CarRecall entity by IDs.
+func (ru *RecallUpdate) AddCarRecallIDs(ids ...int64) *RecallUpdate {
+	ru.mutation.AddCarRecallIDs(ids...)
+	return ru
+}
+
+// AddCarRecalls adds the "car_recalls" edges to the CarRecall entity.
+func (ru *RecallUpdate) AddCarRecalls(c ...*CarRecall) *RecallUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ru.AddCarRecallIDs(ids...)
+}
+
+// Mutation returns the RecallMutation object of the builder.
+func (ru *RecallUpdate) Mutation() *RecallMutation {
+	return ru.mutation
+}
+
+// ClearCarRecalls clears all "car_recalls" edges to the CarRecall entity.
+func (ru *RecallUpdate) ClearCarRecalls() *RecallUpdate {
+	ru.mutation.ClearCarRecalls()
+	return ru
+}
+
+// RemoveCarRecallIDs removes the "car_recalls" edge to CarRecall entities by IDs.
+func (ru *RecallUpdate) RemoveCarRecallIDs(ids ...int64) *RecallUpdate {
+	ru.mutation.RemoveCarRecallIDs(ids...)
+	return ru
+}
+
+// RemoveCarRecalls removes "car_recalls" edges to CarRecall entities.
+func (ru *RecallUpdate) RemoveCarRecalls(c ...*CarRecall) *RecallUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ru.RemoveCarRecallIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RecallUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ru.hooks) == 0 {
+		affected, err = ru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RecallMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ru.mutation = mutation
+			affected, err = ru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ru.hooks) - 1; i >= 0; i-- {
+			if ru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RecallUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RecallUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RecallUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ru *RecallUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   recall.Table,
+			Columns: recall.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: recall.FieldID,
+			},
+		},
+	}
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.CampaignNo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldCampaignNo,
+		})
+	}
+	if value, ok := ru.mutation.Manufacturer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldManufacturer,
+		})
+	}
+	if ru.mutation.ManufacturerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldManufacturer,
+		})
+	}
+	if value, ok := ru.mutation.ModelID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: recall.FieldModelID,
+		})
+	}
+	if value, ok := ru.mutation.AddedModelID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: recall.FieldModelID,
+		})
+	}
+	if ru.mutation.ModelIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: recall.FieldModelID,
+		})
+	}
+	if value, ok := ru.mutation.Model(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldModel,
+		})
+	}
+	if ru.mutation.ModelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldModel,
+		})
+	}
+	if value, ok := ru.mutation.YearFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearFrom,
+		})
+	}
+	if value, ok := ru.mutation.AddedYearFrom(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearFrom,
+		})
+	}
+	if ru.mutation.YearFromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: recall.FieldYearFrom,
+		})
+	}
+	if value, ok := ru.mutation.YearTo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearTo,
+		})
+	}
+	if value, ok := ru.mutation.AddedYearTo(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearTo,
+		})
+	}
+	if ru.mutation.YearToCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: recall.FieldYearTo,
+		})
+	}
+	if value, ok := ru.mutation.VinFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldVinFrom,
+		})
+	}
+	if ru.mutation.VinFromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldVinFrom,
+		})
+	}
+	if value, ok := ru.mutation.VinTo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldVinTo,
+		})
+	}
+	if ru.mutation.VinToCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldVinTo,
+		})
+	}
+	if value, ok := ru.mutation.Component(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldComponent,
+		})
+	}
+	if ru.mutation.ComponentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldComponent,
+		})
+	}
+	if value, ok := ru.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldDescription,
+		})
+	}
+	if ru.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldDescription,
+		})
+	}
+	if value, ok := ru.mutation.Remedy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldRemedy,
+		})
+	}
+	if ru.mutation.RemedyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldRemedy,
+		})
+	}
+	if value, ok := ru.mutation.IssuedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: recall.FieldIssuedAt,
+		})
+	}
+	if ru.mutation.IssuedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: recall.FieldIssuedAt,
+		})
+	}
+	if value, ok := ru.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: recall.FieldCreatedAt,
+		})
+	}
+	if ru.mutation.CarRecallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recall.CarRecallsTable,
+			Columns: []string{recall.CarRecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedCarRecallsIDs(); len(nodes) > 0 && !ru.mutation.CarRecallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recall.CarRecallsTable,
+			Columns: []string{recall.CarRecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.CarRecallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recall.CarRecallsTable,
+			Columns: []string{recall.CarRecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RecallUpdateOne is the builder for updating a single Recall entity.
+type RecallUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecallMutation
+}
+
+// SetCampaignNo sets the "campaign_no" field.
+func (ruo *RecallUpdateOne) SetCampaignNo(s string) *RecallUpdateOne {
+	ruo.mutation.SetCampaignNo(s)
+	return ruo
+}
+
+// SetManufacturer sets the "manufacturer" field.
+func (ruo *RecallUpdateOne) SetManufacturer(s string) *RecallUpdateOne {
+	ruo.mutation.SetManufacturer(s)
+	return ruo
+}
+
+// SetNillableManufacturer sets the "manufacturer" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableManufacturer(s *string) *RecallUpdateOne {
+	if s != nil {
+		ruo.SetManufacturer(*s)
+	}
+	return ruo
+}
+
+// ClearManufacturer clears the value of the "manufacturer" field.
+func (ruo *RecallUpdateOne) ClearManufacturer() *RecallUpdateOne {
+	ruo.mutation.ClearManufacturer()
+	return ruo
+}
+
+// SetModelID sets the "model_id" field.
+func (ruo *RecallUpdateOne) SetModelID(i int64) *RecallUpdateOne {
+	ruo.mutation.ResetModelID()
+	ruo.mutation.SetModelID(i)
+	return ruo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableModelID(i *int64) *RecallUpdateOne {
+	if i != nil {
+		ruo.SetModelID(*i)
+	}
+	return ruo
+}
+
+// AddModelID adds i to the "model_id" field.
+func (ruo *RecallUpdateOne) AddModelID(i int64) *RecallUpdateOne {
+	ruo.mutation.AddModelID(i)
+	return ruo
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (ruo *RecallUpdateOne) ClearModelID() *RecallUpdateOne {
+	ruo.mutation.ClearModelID()
+	return ruo
+}
+
+// SetModel sets the "model" field.
+func (ruo *RecallUpdateOne) SetModel(s string) *RecallUpdateOne {
+	ruo.mutation.SetModel(s)
+	return ruo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableModel(s *string) *RecallUpdateOne {
+	if s != nil {
+		ruo.SetModel(*s)
+	}
+	return ruo
+}
+
+// ClearModel clears the value of the "model" field.
+func (ruo *RecallUpdateOne) ClearModel() *RecallUpdateOne {
+	ruo.mutation.ClearModel()
+	return ruo
+}
+
+// SetYearFrom sets the "year_from" field.
+func (ruo *RecallUpdateOne) SetYearFrom(i int) *RecallUpdateOne {
+	ruo.mutation.ResetYearFrom()
+	ruo.mutation.SetYearFrom(i)
+	return ruo
+}
+
+// SetNillableYearFrom sets the "year_from" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableYearFrom(i *int) *RecallUpdateOne {
+	if i != nil {
+		ruo.SetYearFrom(*i)
+	}
+	return ruo
+}
+
+// AddYearFrom adds i to the "year_from" field.
+func (ruo *RecallUpdateOne) AddYearFrom(i int) *RecallUpdateOne {
+	ruo.mutation.AddYearFrom(i)
+	return ruo
+}
+
+// ClearYearFrom clears the value of the "year_from" field.
+func (ruo *RecallUpdateOne) ClearYearFrom() *RecallUpdateOne {
+	ruo.mutation.ClearYearFrom()
+	return ruo
+}
+
+// SetYearTo sets the "year_to" field.
+func (ruo *RecallUpdateOne) SetYearTo(i int) *RecallUpdateOne {
+	ruo.mutation.ResetYearTo()
+	ruo.mutation.SetYearTo(i)
+	return ruo
+}
+
+// SetNillableYearTo sets the "year_to" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableYearTo(i *int) *RecallUpdateOne {
+	if i != nil {
+		ruo.SetYearTo(*i)
+	}
+	return ruo
+}
+
+// AddYearTo adds i to the "year_to" field.
+func (ruo *RecallUpdateOne) AddYearTo(i int) *RecallUpdateOne {
+	ruo.mutation.AddYearTo(i)
+	return ruo
+}
+
+// ClearYearTo clears the value of the "year_to" field.
+func (ruo *RecallUpdateOne) ClearYearTo() *RecallUpdateOne {
+	ruo.mutation.ClearYearTo()
+	return ruo
+}
+
+// SetVinFrom sets the "vin_from" field.
+func (ruo *RecallUpdateOne) SetVinFrom(s string) *RecallUpdateOne {
+	ruo.mutation.SetVinFrom(s)
+	return ruo
+}
+
+// SetNillableVinFrom sets the "vin_from" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableVinFrom(s *string) *RecallUpdateOne {
+	if s != nil {
+		ruo.SetVinFrom(*s)
+	}
+	return ruo
+}
+
+// ClearVinFrom clears the value of the "vin_from" field.
+func (ruo *RecallUpdateOne) ClearVinFrom() *RecallUpdateOne {
+	ruo.mutation.ClearVinFrom()
+	return ruo
+}
+
+// SetVinTo sets the "vin_to" field.
+func (ruo *RecallUpdateOne) SetVinTo(s string) *RecallUpdateOne {
+	ruo.mutation.SetVinTo(s)
+	return ruo
+}
+
+// SetNillableVinTo sets the "vin_to" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableVinTo(s *string) *RecallUpdateOne {
+	if s != nil {
+		ruo.SetVinTo(*s)
+	}
+	return ruo
+}
+
+// ClearVinTo clears the value of the "vin_to" field.
+func (ruo *RecallUpdateOne) ClearVinTo() *RecallUpdateOne {
+	ruo.mutation.ClearVinTo()
+	return ruo
+}
+
+// SetComponent sets the "component" field.
+func (ruo *RecallUpdateOne) SetComponent(s string) *RecallUpdateOne {
+	ruo.mutation.SetComponent(s)
+	return ruo
+}
+
+// SetNillableComponent sets the "component" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableComponent(s *string) *RecallUpdateOne {
+	if s != nil {
+		ruo.SetComponent(*s)
+	}
+	return ruo
+}
+
+// ClearComponent clears the value of the "component" field.
+func (ruo *RecallUpdateOne) ClearComponent() *RecallUpdateOne {
+	ruo.mutation.ClearComponent()
+	return ruo
+}
+
+// SetDescription sets the "description" field.
+func (ruo *RecallUpdateOne) SetDescription(s string) *RecallUpdateOne {
+	ruo.mutation.SetDescription(s)
+	return ruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableDescription(s *string) *RecallUpdateOne {
+	if s != nil {
+		ruo.SetDescription(*s)
+	}
+	return ruo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ruo *RecallUpdateOne) ClearDescription() *RecallUpdateOne {
+	ruo.mutation.ClearDescription()
+	return ruo
+}
+
+// SetRemedy sets the "remedy" field.
+func (ruo *RecallUpdateOne) SetRemedy(s string) *RecallUpdateOne {
+	ruo.mutation.SetRemedy(s)
+	return ruo
+}
+
+// SetNillableRemedy sets the "remedy" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableRemedy(s *string) *RecallUpdateOne {
+	if s != nil {
+		ruo.SetRemedy(*s)
+	}
+	return ruo
+}
+
+// ClearRemedy clears the value of the "remedy" field.
+func (ruo *RecallUpdateOne) ClearRemedy() *RecallUpdateOne {
+	ruo.mutation.ClearRemedy()
+	return ruo
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (ruo *RecallUpdateOne) SetIssuedAt(t time.Time) *RecallUpdateOne {
+	ruo.mutation.SetIssuedAt(t)
+	return ruo
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableIssuedAt(t *time.Time) *RecallUpdateOne {
+	if t != nil {
+		ruo.SetIssuedAt(*t)
+	}
+	return ruo
+}
+
+// ClearIssuedAt clears the value of the "issued_at" field.
+func (ruo *RecallUpdateOne) ClearIssuedAt() *RecallUpdateOne {
+	ruo.mutation.ClearIssuedAt()
+	return ruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ruo *RecallUpdateOne) SetCreatedAt(t time.Time) *RecallUpdateOne {
+	ruo.mutation.SetCreatedAt(t)
+	return ruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ruo *RecallUpdateOne) SetNillableCreatedAt(t *time.Time) *RecallUpdateOne {
+	if t != nil {
+		ruo.SetCreatedAt(*t)
+	}
+	return ruo
+}
+
+// AddCarRecallIDs adds the "car_recalls" edge to the CarRecall entity by IDs.
+func (ruo *RecallUpdateOne) AddCarRecallIDs(ids ...int64) *RecallUpdateOne {
+	ruo.mutation.AddCarRecallIDs(ids...)
+	return ruo
+}
+
+// AddCarRecalls adds the "car_recalls" edges to the CarRecall entity.
+func (ruo *RecallUpdateOne) AddCarRecalls(c ...*CarRecall) *RecallUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ruo.AddCarRecallIDs(ids...)
+}
+
+// Mutation returns the RecallMutation object of the builder.
+func (ruo *RecallUpdateOne) Mutation() *RecallMutation {
+	return ruo.mutation
+}
+
+// ClearCarRecalls clears all "car_recalls" edges to the CarRecall entity.
+func (ruo *RecallUpdateOne) ClearCarRecalls() *RecallUpdateOne {
+	ruo.mutation.ClearCarRecalls()
+	return ruo
+}
+
+// RemoveCarRecallIDs removes the "car_recalls" edge to CarRecall entities by IDs.
+func (ruo *RecallUpdateOne) RemoveCarRecallIDs(ids ...int64) *RecallUpdateOne {
+	ruo.mutation.RemoveCarRecallIDs(ids...)
+	return ruo
+}
+
+// RemoveCarRecalls removes "car_recalls" edges to CarRecall entities.
+func (ruo *RecallUpdateOne) RemoveCarRecalls(c ...*CarRecall) *RecallUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ruo.RemoveCarRecallIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RecallUpdateOne) Select(field string, fields ...string) *RecallUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Recall entity.
+func (ruo *RecallUpdateOne) Save(ctx context.Context) (*Recall, error) {
+	var (
+		err  error
+		node *Recall
+	)
+	if len(ruo.hooks) == 0 {
+		node, err = ruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RecallMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ruo.mutation = mutation
+			node, err = ruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ruo.hooks) - 1; i >= 0; i-- {
+			if ruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Recall)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RecallMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RecallUpdateOne) SaveX(ctx context.Context) *Recall {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RecallUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RecallUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ruo *RecallUpdateOne) sqlSave(ctx context.Context) (_node *Recall, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   recall.Table,
+			Columns: recall.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: recall.FieldID,
+			},
+		},
+	}
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Recall.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recall.FieldID)
+		for _, f := range fields {
+			if !recall.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recall.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.CampaignNo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldCampaignNo,
+		})
+	}
+	if value, ok := ruo.mutation.Manufacturer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldManufacturer,
+		})
+	}
+	if ruo.mutation.ManufacturerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldManufacturer,
+		})
+	}
+	if value, ok := ruo.mutation.ModelID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: recall.FieldModelID,
+		})
+	}
+	if value, ok := ruo.mutation.AddedModelID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: recall.FieldModelID,
+		})
+	}
+	if ruo.mutation.ModelIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: recall.FieldModelID,
+		})
+	}
+	if value, ok := ruo.mutation.Model(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldModel,
+		})
+	}
+	if ruo.mutation.ModelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldModel,
+		})
+	}
+	if value, ok := ruo.mutation.YearFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearFrom,
+		})
+	}
+	if value, ok := ruo.mutation.AddedYearFrom(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearFrom,
+		})
+	}
+	if ruo.mutation.YearFromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: recall.FieldYearFrom,
+		})
+	}
+	if value, ok := ruo.mutation.YearTo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearTo,
+		})
+	}
+	if value, ok := ruo.mutation.AddedYearTo(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: recall.FieldYearTo,
+		})
+	}
+	if ruo.mutation.YearToCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: recall.FieldYearTo,
+		})
+	}
+	if value, ok := ruo.mutation.VinFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldVinFrom,
+		})
+	}
+	if ruo.mutation.VinFromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldVinFrom,
+		})
+	}
+	if value, ok := ruo.mutation.VinTo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldVinTo,
+		})
+	}
+	if ruo.mutation.VinToCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldVinTo,
+		})
+	}
+	if value, ok := ruo.mutation.Component(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldComponent,
+		})
+	}
+	if ruo.mutation.ComponentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldComponent,
+		})
+	}
+	if value, ok := ruo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldDescription,
+		})
+	}
+	if ruo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldDescription,
+		})
+	}
+	if value, ok := ruo.mutation.Remedy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: recall.FieldRemedy,
+		})
+	}
+	if ruo.mutation.RemedyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: recall.FieldRemedy,
+		})
+	}
+	if value, ok := ruo.mutation.IssuedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: recall.FieldIssuedAt,
+		})
+	}
+	if ruo.mutation.IssuedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: recall.FieldIssuedAt,
+		})
+	}
+	if value, ok := ruo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: recall.FieldCreatedAt,
+		})
+	}
+	if ruo.mutation.CarRecallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recall.CarRecallsTable,
+			Columns: []string{recall.CarRecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedCarRecallsIDs(); len(nodes) > 0 && !ruo.mutation.CarRecallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recall.CarRecallsTable,
+			Columns: []string{recall.CarRecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.CarRecallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recall.CarRecallsTable,
+			Columns: []string{recall.CarRecallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carrecall.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Recall{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/refuel"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Refuel is the model entity for the Refuel schema.
+type Refuel struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// Unit holds the value of the "unit" field.
+	Unit string `json:"unit,omitempty"`
+	// Cost holds the value of the "cost" field.
+	Cost float64 `json:"cost,omitempty"`
+	// Station holds the value of the "station" field.
+	Station string `json:"station,omitempty"`
+	// Odometer holds the value of the "odometer" field.
+	Odometer int64 `json:"odometer,omitempty"`
+	// RefueledAt holds the value of the "refueled_at" field.
+	RefueledAt time.Time `json:"refueled_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RefuelQuery when eager-loading is set.
+	Edges RefuelEdges `json:"edges"`
+}
+
+// RefuelEdges holds the relations/edges for other nodes in the graph.
+type RefuelEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RefuelEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Refuel) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case refuel.FieldAmount, refuel.FieldCost:
+			values[i] = new(sql.NullFloat64)
+		case refuel.FieldID, refuel.FieldTenantID, refuel.FieldCarID, refuel.FieldOdometer:
+			values[i] = new(sql.NullInt64)
+		case refuel.FieldUnit, refuel.FieldStation:
+			values[i] = new(sql.NullString)
+		case refuel.FieldRefueledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Refuel", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Refuel fields.
+func (r *Refuel) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case refuel.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int64(value.Int64)
+		case refuel.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				r.TenantID = value.Int64
+			}
+		case refuel.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				r.CarID = value.Int64
+			}
+		case refuel.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				r.Amount = value.Float64
+			}
+		case refuel.FieldUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit", values[i])
+			} else if value.Valid {
+				r.Unit = value.String
+			}
+		case refuel.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				r.Cost = value.Float64
+			}
+		case refuel.FieldStation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field station", values[i])
+			} else if value.Valid {
+				r.Station = value.String
+			}
+		case refuel.FieldOdometer:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field odometer", values[i])
+			} else if value.Valid {
+				r.Odometer = value.Int64
+			}
+		case refuel.FieldRefueledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field refueled_at", values[i])
+			} else if value.Valid {
+				r.RefueledAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the Refuel entity.
+func (r *Refuel) QueryCar() *CarQuery {
+	return (&RefuelClient{config: r.config}).QueryCar(r)
+}
+
+// Update returns a builder for updating this Refuel.
+// Note that you need to call Refuel.Unwrap() before calling this method if this Refuel
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Refuel) Update() *RefuelUpdateOne {
+	return (&RefuelClient{config: r.config}).UpdateOne(r)
+}
+
+// Unwrap unwraps the Refuel entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Refuel) Unwrap() *Refuel {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Refuel is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Refuel) String() string {
+	var builder strings.Builder
+	builder.WriteString("Refuel(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", r.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("unit=")
+	builder.WriteString(r.Unit)
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", r.Cost))
+	builder.WriteString(", ")
+	builder.WriteString("station=")
+	builder.WriteString(r.Station)
+	builder.WriteString(", ")
+	builder.WriteString("odometer=")
+	builder.WriteString(fmt.Sprintf("%v", r.Odometer))
+	builder.WriteString(", ")
+	builder.WriteString("refueled_at=")
+	builder.WriteString(r.RefueledAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Refuels is a parsable slice of Refuel.
+type Refuels []*Refuel
+
+func (r Refuels) config(cfg config) {
+	for _i := range r {
+		r[_i].config = cfg
+	}
+}
@@ -0,0 +1,79 @@
+// Code generated by ent, DO NOT EDIT.
+
+package refuel
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the refuel type in the database.
+	Label = "refuel"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldUnit holds the string denoting the unit field in the database.
+	FieldUnit = "unit"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldStation holds the string denoting the station field in the database.
+	FieldStation = "station"
+	// FieldOdometer holds the string denoting the odometer field in the database.
+	FieldOdometer = "odometer"
+	// FieldRefueledAt holds the string denoting the refueled_at field in the database.
+	FieldRefueledAt = "refueled_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// Table holds the table name of the refuel in the database.
+	Table = "refuel"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "refuel"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+)
+
+// Columns holds all SQL columns for refuel fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldAmount,
+	FieldUnit,
+	FieldCost,
+	FieldStation,
+	FieldOdometer,
+	FieldRefueledAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultRefueledAt holds the default value on creation for the "refueled_at" field.
+	DefaultRefueledAt func() time.Time
+)
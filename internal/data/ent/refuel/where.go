@@ -0,0 +1,946 @@
+// Code generated by ent, DO NOT EDIT.
+
+package refuel
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// Unit applies equality check predicate on the "unit" field. It's identical to UnitEQ.
+func Unit(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnit), v))
+	})
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCost), v))
+	})
+}
+
+// Station applies equality check predicate on the "station" field. It's identical to StationEQ.
+func Station(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStation), v))
+	})
+}
+
+// Odometer applies equality check predicate on the "odometer" field. It's identical to OdometerEQ.
+func Odometer(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOdometer), v))
+	})
+}
+
+// RefueledAt applies equality check predicate on the "refueled_at" field. It's identical to RefueledAtEQ.
+func RefueledAt(v time.Time) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRefueledAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountIsNil applies the IsNil predicate on the "amount" field.
+func AmountIsNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAmount)))
+	})
+}
+
+// AmountNotNil applies the NotNil predicate on the "amount" field.
+func AmountNotNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAmount)))
+	})
+}
+
+// UnitEQ applies the EQ predicate on the "unit" field.
+func UnitEQ(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnit), v))
+	})
+}
+
+// UnitNEQ applies the NEQ predicate on the "unit" field.
+func UnitNEQ(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnit), v))
+	})
+}
+
+// UnitIn applies the In predicate on the "unit" field.
+func UnitIn(vs ...string) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnit), v...))
+	})
+}
+
+// UnitNotIn applies the NotIn predicate on the "unit" field.
+func UnitNotIn(vs ...string) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnit), v...))
+	})
+}
+
+// UnitGT applies the GT predicate on the "unit" field.
+func UnitGT(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnit), v))
+	})
+}
+
+// UnitGTE applies the GTE predicate on the "unit" field.
+func UnitGTE(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnit), v))
+	})
+}
+
+// UnitLT applies the LT predicate on the "unit" field.
+func UnitLT(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnit), v))
+	})
+}
+
+// UnitLTE applies the LTE predicate on the "unit" field.
+func UnitLTE(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnit), v))
+	})
+}
+
+// UnitContains applies the Contains predicate on the "unit" field.
+func UnitContains(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUnit), v))
+	})
+}
+
+// UnitHasPrefix applies the HasPrefix predicate on the "unit" field.
+func UnitHasPrefix(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUnit), v))
+	})
+}
+
+// UnitHasSuffix applies the HasSuffix predicate on the "unit" field.
+func UnitHasSuffix(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUnit), v))
+	})
+}
+
+// UnitIsNil applies the IsNil predicate on the "unit" field.
+func UnitIsNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUnit)))
+	})
+}
+
+// UnitNotNil applies the NotNil predicate on the "unit" field.
+func UnitNotNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUnit)))
+	})
+}
+
+// UnitEqualFold applies the EqualFold predicate on the "unit" field.
+func UnitEqualFold(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUnit), v))
+	})
+}
+
+// UnitContainsFold applies the ContainsFold predicate on the "unit" field.
+func UnitContainsFold(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUnit), v))
+	})
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCost), v))
+	})
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCost), v))
+	})
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCost), v...))
+	})
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCost), v...))
+	})
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCost), v))
+	})
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCost), v))
+	})
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCost), v))
+	})
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCost), v))
+	})
+}
+
+// CostIsNil applies the IsNil predicate on the "cost" field.
+func CostIsNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCost)))
+	})
+}
+
+// CostNotNil applies the NotNil predicate on the "cost" field.
+func CostNotNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCost)))
+	})
+}
+
+// StationEQ applies the EQ predicate on the "station" field.
+func StationEQ(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStation), v))
+	})
+}
+
+// StationNEQ applies the NEQ predicate on the "station" field.
+func StationNEQ(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStation), v))
+	})
+}
+
+// StationIn applies the In predicate on the "station" field.
+func StationIn(vs ...string) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStation), v...))
+	})
+}
+
+// StationNotIn applies the NotIn predicate on the "station" field.
+func StationNotIn(vs ...string) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStation), v...))
+	})
+}
+
+// StationGT applies the GT predicate on the "station" field.
+func StationGT(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStation), v))
+	})
+}
+
+// StationGTE applies the GTE predicate on the "station" field.
+func StationGTE(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStation), v))
+	})
+}
+
+// StationLT applies the LT predicate on the "station" field.
+func StationLT(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStation), v))
+	})
+}
+
+// StationLTE applies the LTE predicate on the "station" field.
+func StationLTE(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStation), v))
+	})
+}
+
+// StationContains applies the Contains predicate on the "station" field.
+func StationContains(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStation), v))
+	})
+}
+
+// StationHasPrefix applies the HasPrefix predicate on the "station" field.
+func StationHasPrefix(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStation), v))
+	})
+}
+
+// StationHasSuffix applies the HasSuffix predicate on the "station" field.
+func StationHasSuffix(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStation), v))
+	})
+}
+
+// StationIsNil applies the IsNil predicate on the "station" field.
+func StationIsNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStation)))
+	})
+}
+
+// StationNotNil applies the NotNil predicate on the "station" field.
+func StationNotNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStation)))
+	})
+}
+
+// StationEqualFold applies the EqualFold predicate on the "station" field.
+func StationEqualFold(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStation), v))
+	})
+}
+
+// StationContainsFold applies the ContainsFold predicate on the "station" field.
+func StationContainsFold(v string) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStation), v))
+	})
+}
+
+// OdometerEQ applies the EQ predicate on the "odometer" field.
+func OdometerEQ(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOdometer), v))
+	})
+}
+
+// OdometerNEQ applies the NEQ predicate on the "odometer" field.
+func OdometerNEQ(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOdometer), v))
+	})
+}
+
+// OdometerIn applies the In predicate on the "odometer" field.
+func OdometerIn(vs ...int64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOdometer), v...))
+	})
+}
+
+// OdometerNotIn applies the NotIn predicate on the "odometer" field.
+func OdometerNotIn(vs ...int64) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOdometer), v...))
+	})
+}
+
+// OdometerGT applies the GT predicate on the "odometer" field.
+func OdometerGT(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOdometer), v))
+	})
+}
+
+// OdometerGTE applies the GTE predicate on the "odometer" field.
+func OdometerGTE(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOdometer), v))
+	})
+}
+
+// OdometerLT applies the LT predicate on the "odometer" field.
+func OdometerLT(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOdometer), v))
+	})
+}
+
+// OdometerLTE applies the LTE predicate on the "odometer" field.
+func OdometerLTE(v int64) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOdometer), v))
+	})
+}
+
+// OdometerIsNil applies the IsNil predicate on the "odometer" field.
+func OdometerIsNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOdometer)))
+	})
+}
+
+// OdometerNotNil applies the NotNil predicate on the "odometer" field.
+func OdometerNotNil() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOdometer)))
+	})
+}
+
+// RefueledAtEQ applies the EQ predicate on the "refueled_at" field.
+func RefueledAtEQ(v time.Time) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRefueledAt), v))
+	})
+}
+
+// RefueledAtNEQ applies the NEQ predicate on the "refueled_at" field.
+func RefueledAtNEQ(v time.Time) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRefueledAt), v))
+	})
+}
+
+// RefueledAtIn applies the In predicate on the "refueled_at" field.
+func RefueledAtIn(vs ...time.Time) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRefueledAt), v...))
+	})
+}
+
+// RefueledAtNotIn applies the NotIn predicate on the "refueled_at" field.
+func RefueledAtNotIn(vs ...time.Time) predicate.Refuel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Refuel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRefueledAt), v...))
+	})
+}
+
+// RefueledAtGT applies the GT predicate on the "refueled_at" field.
+func RefueledAtGT(v time.Time) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRefueledAt), v))
+	})
+}
+
+// RefueledAtGTE applies the GTE predicate on the "refueled_at" field.
+func RefueledAtGTE(v time.Time) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRefueledAt), v))
+	})
+}
+
+// RefueledAtLT applies the LT predicate on the "refueled_at" field.
+func RefueledAtLT(v time.Time) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRefueledAt), v))
+	})
+}
+
+// RefueledAtLTE applies the LTE predicate on the "refueled_at" field.
+func RefueledAtLTE(v time.Time) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRefueledAt), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Refuel) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Refuel) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Refuel) predicate.Refuel {
+	return predicate.Refuel(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
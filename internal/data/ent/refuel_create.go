@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/refuel"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RefuelCreate is the builder for creating a Refuel entity.
+type RefuelCreate struct {
+	config
+	mutation *RefuelMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (rc *RefuelCreate) SetTenantID(i int64) *RefuelCreate {
+	rc.mutation.SetTenantID(i)
+	return rc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (rc *RefuelCreate) SetNillableTenantID(i *int64) *RefuelCreate {
+	if i != nil {
+		rc.SetTenantID(*i)
+	}
+	return rc
+}
+
+// SetCarID sets the "car_id" field.
+func (rc *RefuelCreate) SetCarID(i int64) *RefuelCreate {
+	rc.mutation.SetCarID(i)
+	return rc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (rc *RefuelCreate) SetNillableCarID(i *int64) *RefuelCreate {
+	if i != nil {
+		rc.SetCarID(*i)
+	}
+	return rc
+}
+
+// SetAmount sets the "amount" field.
+func (rc *RefuelCreate) SetAmount(f float64) *RefuelCreate {
+	rc.mutation.SetAmount(f)
+	return rc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (rc *RefuelCreate) SetNillableAmount(f *float64) *RefuelCreate {
+	if f != nil {
+		rc.SetAmount(*f)
+	}
+	return rc
+}
+
+// SetUnit sets the "unit" field.
+func (rc *RefuelCreate) SetUnit(s string) *RefuelCreate {
+	rc.mutation.SetUnit(s)
+	return rc
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (rc *RefuelCreate) SetNillableUnit(s *string) *RefuelCreate {
+	if s != nil {
+		rc.SetUnit(*s)
+	}
+	return rc
+}
+
+// SetCost sets the "cost" field.
+func (rc *RefuelCreate) SetCost(f float64) *RefuelCreate {
+	rc.mutation.SetCost(f)
+	return rc
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (rc *RefuelCreate) SetNillableCost(f *float64) *RefuelCreate {
+	if f != nil {
+		rc.SetCost(*f)
+	}
+	return rc
+}
+
+// SetStation sets the "station" field.
+func (rc *RefuelCreate) SetStation(s string) *RefuelCreate {
+	rc.mutation.SetStation(s)
+	return rc
+}
+
+// SetNillableStation sets the "station" field if the given value is not nil.
+func (rc *RefuelCreate) SetNillableStation(s *string) *RefuelCreate {
+	if s != nil {
+		rc.SetStation(*s)
+	}
+	return rc
+}
+
+// SetOdometer sets the "odometer" field.
+func (rc *RefuelCreate) SetOdometer(i int64) *RefuelCreate {
+	rc.mutation.SetOdometer(i)
+	return rc
+}
+
+// SetNillableOdometer sets the "odometer" field if the given value is not nil.
+func (rc *RefuelCreate) SetNillableOdometer(i *int64) *RefuelCreate {
+	if i != nil {
+		rc.SetOdometer(*i)
+	}
+	return rc
+}
+
+// SetRefueledAt sets the "refueled_at" field.
+func (rc *RefuelCreate) SetRefueledAt(t time.Time) *RefuelCreate {
+	rc.mutation.SetRefueledAt(t)
+	return rc
+}
+
+// SetNillableRefueledAt sets the "refueled_at" field if the given value is not nil.
+func (rc *RefuelCreate) SetNillableRefueledAt(t *time.Time) *RefuelCreate {
+	if t != nil {
+		rc.SetRefueledAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *RefuelCreate) SetID(i int64) *RefuelCreate {
+	rc.mutation.SetID(i)
+	return rc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (rc *RefuelCreate) SetCar(c *Car) *RefuelCreate {
+	return rc.SetCarID(c.ID)
+}
+
+// Mutation returns the RefuelMutation object of the builder.
+func (rc *RefuelCreate) Mutation() *RefuelMutation {
+	return rc.mutation
+}
+
+// Save creates the Refuel in the database.
+func (rc *RefuelCreate) Save(ctx context.Context) (*Refuel, error) {
+	var (
+		err  error
+		node *Refuel
+	)
+	if err := rc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rc.hooks) == 0 {
+		if err = rc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RefuelMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rc.check(); err != nil {
+				return nil, err
+			}
+			rc.mutation = mutation
+			if node, err = rc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rc.hooks) - 1; i >= 0; i-- {
+			if rc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Refuel)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RefuelMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RefuelCreate) SaveX(ctx context.Context) *Refuel {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RefuelCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RefuelCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RefuelCreate) defaults() error {
+	if _, ok := rc.mutation.RefueledAt(); !ok {
+		if refuel.DefaultRefueledAt == nil {
+			return fmt.Errorf("ent: uninitialized refuel.DefaultRefueledAt (forgotten import ent/runtime?)")
+		}
+		v := refuel.DefaultRefueledAt()
+		rc.mutation.SetRefueledAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RefuelCreate) check() error {
+	if _, ok := rc.mutation.RefueledAt(); !ok {
+		return &ValidationError{Name: "refueled_at", err: errors.New(`ent: missing required field "Refuel.refueled_at"`)}
+	}
+	return nil
+}
+
+func (rc *RefuelCreate) sqlSave(ctx context.Context) (*Refuel, error) {
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (rc *RefuelCreate) createSpec() (*Refuel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Refuel{config: rc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: refuel.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: refuel.FieldID,
+			},
+		}
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := rc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := rc.mutation.Unit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refuel.FieldUnit,
+		})
+		_node.Unit = value
+	}
+	if value, ok := rc.mutation.Cost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldCost,
+		})
+		_node.Cost = value
+	}
+	if value, ok := rc.mutation.Station(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refuel.FieldStation,
+		})
+		_node.Station = value
+	}
+	if value, ok := rc.mutation.Odometer(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldOdometer,
+		})
+		_node.Odometer = value
+	}
+	if value, ok := rc.mutation.RefueledAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refuel.FieldRefueledAt,
+		})
+		_node.RefueledAt = value
+	}
+	if nodes := rc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refuel.CarTable,
+			Columns: []string{refuel.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RefuelCreateBulk is the builder for creating many Refuel entities in bulk.
+type RefuelCreateBulk struct {
+	config
+	builders []*RefuelCreate
+}
+
+// Save creates the Refuel entities in the database.
+func (rcb *RefuelCreateBulk) Save(ctx context.Context) ([]*Refuel, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Refuel, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RefuelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RefuelCreateBulk) SaveX(ctx context.Context) []*Refuel {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RefuelCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RefuelCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,929 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/refuel"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RefuelUpdate is the builder for updating Refuel entities.
+type RefuelUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RefuelMutation
+}
+
+// Where appends a list predicates to the RefuelUpdate builder.
+func (ru *RefuelUpdate) Where(ps ...predicate.Refuel) *RefuelUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ru *RefuelUpdate) SetTenantID(i int64) *RefuelUpdate {
+	ru.mutation.ResetTenantID()
+	ru.mutation.SetTenantID(i)
+	return ru
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ru *RefuelUpdate) SetNillableTenantID(i *int64) *RefuelUpdate {
+	if i != nil {
+		ru.SetTenantID(*i)
+	}
+	return ru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ru *RefuelUpdate) AddTenantID(i int64) *RefuelUpdate {
+	ru.mutation.AddTenantID(i)
+	return ru
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (ru *RefuelUpdate) ClearTenantID() *RefuelUpdate {
+	ru.mutation.ClearTenantID()
+	return ru
+}
+
+// SetCarID sets the "car_id" field.
+func (ru *RefuelUpdate) SetCarID(i int64) *RefuelUpdate {
+	ru.mutation.SetCarID(i)
+	return ru
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ru *RefuelUpdate) SetNillableCarID(i *int64) *RefuelUpdate {
+	if i != nil {
+		ru.SetCarID(*i)
+	}
+	return ru
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (ru *RefuelUpdate) ClearCarID() *RefuelUpdate {
+	ru.mutation.ClearCarID()
+	return ru
+}
+
+// SetAmount sets the "amount" field.
+func (ru *RefuelUpdate) SetAmount(f float64) *RefuelUpdate {
+	ru.mutation.ResetAmount()
+	ru.mutation.SetAmount(f)
+	return ru
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ru *RefuelUpdate) SetNillableAmount(f *float64) *RefuelUpdate {
+	if f != nil {
+		ru.SetAmount(*f)
+	}
+	return ru
+}
+
+// AddAmount adds f to the "amount" field.
+func (ru *RefuelUpdate) AddAmount(f float64) *RefuelUpdate {
+	ru.mutation.AddAmount(f)
+	return ru
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (ru *RefuelUpdate) ClearAmount() *RefuelUpdate {
+	ru.mutation.ClearAmount()
+	return ru
+}
+
+// SetUnit sets the "unit" field.
+func (ru *RefuelUpdate) SetUnit(s string) *RefuelUpdate {
+	ru.mutation.SetUnit(s)
+	return ru
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (ru *RefuelUpdate) SetNillableUnit(s *string) *RefuelUpdate {
+	if s != nil {
+		ru.SetUnit(*s)
+	}
+	return ru
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (ru *RefuelUpdate) ClearUnit() *RefuelUpdate {
+	ru.mutation.ClearUnit()
+	return ru
+}
+
+// SetCost sets the "cost" field.
+func (ru *RefuelUpdate) SetCost(f float64) *RefuelUpdate {
+	ru.mutation.ResetCost()
+	ru.mutation.SetCost(f)
+	return ru
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (ru *RefuelUpdate) SetNillableCost(f *float64) *RefuelUpdate {
+	if f != nil {
+		ru.SetCost(*f)
+	}
+	return ru
+}
+
+// AddCost adds f to the "cost" field.
+func (ru *RefuelUpdate) AddCost(f float64) *RefuelUpdate {
+	ru.mutation.AddCost(f)
+	return ru
+}
+
+// ClearCost clears the value of the "cost" field.
+func (ru *RefuelUpdate) ClearCost() *RefuelUpdate {
+	ru.mutation.ClearCost()
+	return ru
+}
+
+// SetStation sets the "station" field.
+func (ru *RefuelUpdate) SetStation(s string) *RefuelUpdate {
+	ru.mutation.SetStation(s)
+	return ru
+}
+
+// SetNillableStation sets the "station" field if the given value is not nil.
+func (ru *RefuelUpdate) SetNillableStation(s *string) *RefuelUpdate {
+	if s != nil {
+		ru.SetStation(*s)
+	}
+	return ru
+}
+
+// ClearStation clears the value of the "station" field.
+func (ru *RefuelUpdate) ClearStation() *RefuelUpdate {
+	ru.mutation.ClearStation()
+	return ru
+}
+
+// SetOdometer sets the "odometer" field.
+func (ru *RefuelUpdate) SetOdometer(i int64) *RefuelUpdate {
+	ru.mutation.ResetOdometer()
+	ru.mutation.SetOdometer(i)
+	return ru
+}
+
+// SetNillableOdometer sets the "odometer" field if the given value is not nil.
+func (ru *RefuelUpdate) SetNillableOdometer(i *int64) *RefuelUpdate {
+	if i != nil {
+		ru.SetOdometer(*i)
+	}
+	return ru
+}
+
+// AddOdometer adds i to the "odometer" field.
+func (ru *RefuelUpdate) AddOdometer(i int64) *RefuelUpdate {
+	ru.mutation.AddOdometer(i)
+	return ru
+}
+
+// ClearOdometer clears the value of the "odometer" field.
+func (ru *RefuelUpdate) ClearOdometer() *RefuelUpdate {
+	ru.mutation.ClearOdometer()
+	return ru
+}
+
+// SetRefueledAt sets the "refueled_at" field.
+func (ru *RefuelUpdate) SetRefueledAt(t time.Time) *RefuelUpdate {
+	ru.mutation.SetRefueledAt(t)
+	return ru
+}
+
+// SetNillableRefueledAt sets the "refueled_at" field if the given value is not nil.
+func (ru *RefuelUpdate) SetNillableRefueledAt(t *time.Time) *RefuelUpdate {
+	if t != nil {
+		ru.SetRefueledAt(*t)
+	}
+	return ru
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ru *RefuelUpdate) SetCar(c *Car) *RefuelUpdate {
+	return ru.SetCarID(c.ID)
+}
+
+// Mutation returns the RefuelMutation object of the builder.
+func (ru *RefuelUpdate) Mutation() *RefuelMutation {
+	return ru.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (ru *RefuelUpdate) ClearCar() *RefuelUpdate {
+	ru.mutation.ClearCar()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RefuelUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ru.hooks) == 0 {
+		affected, err = ru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RefuelMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ru.mutation = mutation
+			affected, err = ru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ru.hooks) - 1; i >= 0; i-- {
+			if ru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RefuelUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RefuelUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RefuelUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ru *RefuelUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   refuel.Table,
+			Columns: refuel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: refuel.FieldID,
+			},
+		},
+	}
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldTenantID,
+		})
+	}
+	if value, ok := ru.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldTenantID,
+		})
+	}
+	if ru.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: refuel.FieldTenantID,
+		})
+	}
+	if value, ok := ru.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldAmount,
+		})
+	}
+	if value, ok := ru.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldAmount,
+		})
+	}
+	if ru.mutation.AmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: refuel.FieldAmount,
+		})
+	}
+	if value, ok := ru.mutation.Unit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refuel.FieldUnit,
+		})
+	}
+	if ru.mutation.UnitCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: refuel.FieldUnit,
+		})
+	}
+	if value, ok := ru.mutation.Cost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldCost,
+		})
+	}
+	if value, ok := ru.mutation.AddedCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldCost,
+		})
+	}
+	if ru.mutation.CostCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: refuel.FieldCost,
+		})
+	}
+	if value, ok := ru.mutation.Station(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refuel.FieldStation,
+		})
+	}
+	if ru.mutation.StationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: refuel.FieldStation,
+		})
+	}
+	if value, ok := ru.mutation.Odometer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldOdometer,
+		})
+	}
+	if value, ok := ru.mutation.AddedOdometer(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldOdometer,
+		})
+	}
+	if ru.mutation.OdometerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: refuel.FieldOdometer,
+		})
+	}
+	if value, ok := ru.mutation.RefueledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refuel.FieldRefueledAt,
+		})
+	}
+	if ru.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refuel.CarTable,
+			Columns: []string{refuel.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refuel.CarTable,
+			Columns: []string{refuel.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refuel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RefuelUpdateOne is the builder for updating a single Refuel entity.
+type RefuelUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RefuelMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ruo *RefuelUpdateOne) SetTenantID(i int64) *RefuelUpdateOne {
+	ruo.mutation.ResetTenantID()
+	ruo.mutation.SetTenantID(i)
+	return ruo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ruo *RefuelUpdateOne) SetNillableTenantID(i *int64) *RefuelUpdateOne {
+	if i != nil {
+		ruo.SetTenantID(*i)
+	}
+	return ruo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ruo *RefuelUpdateOne) AddTenantID(i int64) *RefuelUpdateOne {
+	ruo.mutation.AddTenantID(i)
+	return ruo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (ruo *RefuelUpdateOne) ClearTenantID() *RefuelUpdateOne {
+	ruo.mutation.ClearTenantID()
+	return ruo
+}
+
+// SetCarID sets the "car_id" field.
+func (ruo *RefuelUpdateOne) SetCarID(i int64) *RefuelUpdateOne {
+	ruo.mutation.SetCarID(i)
+	return ruo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ruo *RefuelUpdateOne) SetNillableCarID(i *int64) *RefuelUpdateOne {
+	if i != nil {
+		ruo.SetCarID(*i)
+	}
+	return ruo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (ruo *RefuelUpdateOne) ClearCarID() *RefuelUpdateOne {
+	ruo.mutation.ClearCarID()
+	return ruo
+}
+
+// SetAmount sets the "amount" field.
+func (ruo *RefuelUpdateOne) SetAmount(f float64) *RefuelUpdateOne {
+	ruo.mutation.ResetAmount()
+	ruo.mutation.SetAmount(f)
+	return ruo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ruo *RefuelUpdateOne) SetNillableAmount(f *float64) *RefuelUpdateOne {
+	if f != nil {
+		ruo.SetAmount(*f)
+	}
+	return ruo
+}
+
+// AddAmount adds f to the "amount" field.
+func (ruo *RefuelUpdateOne) AddAmount(f float64) *RefuelUpdateOne {
+	ruo.mutation.AddAmount(f)
+	return ruo
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (ruo *RefuelUpdateOne) ClearAmount() *RefuelUpdateOne {
+	ruo.mutation.ClearAmount()
+	return ruo
+}
+
+// SetUnit sets the "unit" field.
+func (ruo *RefuelUpdateOne) SetUnit(s string) *RefuelUpdateOne {
+	ruo.mutation.SetUnit(s)
+	return ruo
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (ruo *RefuelUpdateOne) SetNillableUnit(s *string) *RefuelUpdateOne {
+	if s != nil {
+		ruo.SetUnit(*s)
+	}
+	return ruo
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (ruo *RefuelUpdateOne) ClearUnit() *RefuelUpdateOne {
+	ruo.mutation.ClearUnit()
+	return ruo
+}
+
+// SetCost sets the "cost" field.
+func (ruo *RefuelUpdateOne) SetCost(f float64) *RefuelUpdateOne {
+	ruo.mutation.ResetCost()
+	ruo.mutation.SetCost(f)
+	return ruo
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (ruo *RefuelUpdateOne) SetNillableCost(f *float64) *RefuelUpdateOne {
+	if f != nil {
+		ruo.SetCost(*f)
+	}
+	return ruo
+}
+
+// AddCost adds f to the "cost" field.
+func (ruo *RefuelUpdateOne) AddCost(f float64) *RefuelUpdateOne {
+	ruo.mutation.AddCost(f)
+	return ruo
+}
+
+// ClearCost clears the value of the "cost" field.
+func (ruo *RefuelUpdateOne) ClearCost() *RefuelUpdateOne {
+	ruo.mutation.ClearCost()
+	return ruo
+}
+
+// SetStation sets the "station" field.
+func (ruo *RefuelUpdateOne) SetStation(s string) *RefuelUpdateOne {
+	ruo.mutation.SetStation(s)
+	return ruo
+}
+
+// SetNillableStation sets the "station" field if the given value is not nil.
+func (ruo *RefuelUpdateOne) SetNillableStation(s *string) *RefuelUpdateOne {
+	if s != nil {
+		ruo.SetStation(*s)
+	}
+	return ruo
+}
+
+// ClearStation clears the value of the "station" field.
+func (ruo *RefuelUpdateOne) ClearStation() *RefuelUpdateOne {
+	ruo.mutation.ClearStation()
+	return ruo
+}
+
+// SetOdometer sets the "odometer" field.
+func (ruo *RefuelUpdateOne) SetOdometer(i int64) *RefuelUpdateOne {
+	ruo.mutation.ResetOdometer()
+	ruo.mutation.SetOdometer(i)
+	return ruo
+}
+
+// SetNillableOdometer sets the "odometer" field if the given value is not nil.
+func (ruo *RefuelUpdateOne) SetNillableOdometer(i *int64) *RefuelUpdateOne {
+	if i != nil {
+		ruo.SetOdometer(*i)
+	}
+	return ruo
+}
+
+// AddOdometer adds i to the "odometer" field.
+func (ruo *RefuelUpdateOne) AddOdometer(i int64) *RefuelUpdateOne {
+	ruo.mutation.AddOdometer(i)
+	return ruo
+}
+
+// ClearOdometer clears the value of the "odometer" field.
+func (ruo *RefuelUpdateOne) ClearOdometer() *RefuelUpdateOne {
+	ruo.mutation.ClearOdometer()
+	return ruo
+}
+
+// SetRefueledAt sets the "refueled_at" field.
+func (ruo *RefuelUpdateOne) SetRefueledAt(t time.Time) *RefuelUpdateOne {
+	ruo.mutation.SetRefueledAt(t)
+	return ruo
+}
+
+// SetNillableRefueledAt sets the "refueled_at" field if the given value is not nil.
+func (ruo *RefuelUpdateOne) SetNillableRefueledAt(t *time.Time) *RefuelUpdateOne {
+	if t != nil {
+		ruo.SetRefueledAt(*t)
+	}
+	return ruo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ruo *RefuelUpdateOne) SetCar(c *Car) *RefuelUpdateOne {
+	return ruo.SetCarID(c.ID)
+}
+
+// Mutation returns the RefuelMutation object of the builder.
+func (ruo *RefuelUpdateOne) Mutation() *RefuelMutation {
+	return ruo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (ruo *RefuelUpdateOne) ClearCar() *RefuelUpdateOne {
+	ruo.mutation.ClearCar()
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RefuelUpdateOne) Select(field string, fields ...string) *RefuelUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Refuel entity.
+func (ruo *RefuelUpdateOne) Save(ctx context.Context) (*Refuel, error) {
+	var (
+		err  error
+		node *Refuel
+	)
+	if len(ruo.hooks) == 0 {
+		node, err = ruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RefuelMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ruo.mutation = mutation
+			node, err = ruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ruo.hooks) - 1; i >= 0; i-- {
+			if ruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Refuel)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RefuelMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RefuelUpdateOne) SaveX(ctx context.Context) *Refuel {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RefuelUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RefuelUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ruo *RefuelUpdateOne) sqlSave(ctx context.Context) (_node *Refuel, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   refuel.Table,
+			Columns: refuel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: refuel.FieldID,
+			},
+		},
+	}
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Refuel.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, refuel.FieldID)
+		for _, f := range fields {
+			if !refuel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != refuel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldTenantID,
+		})
+	}
+	if value, ok := ruo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldTenantID,
+		})
+	}
+	if ruo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: refuel.FieldTenantID,
+		})
+	}
+	if value, ok := ruo.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldAmount,
+		})
+	}
+	if value, ok := ruo.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldAmount,
+		})
+	}
+	if ruo.mutation.AmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: refuel.FieldAmount,
+		})
+	}
+	if value, ok := ruo.mutation.Unit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refuel.FieldUnit,
+		})
+	}
+	if ruo.mutation.UnitCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: refuel.FieldUnit,
+		})
+	}
+	if value, ok := ruo.mutation.Cost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldCost,
+		})
+	}
+	if value, ok := ruo.mutation.AddedCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: refuel.FieldCost,
+		})
+	}
+	if ruo.mutation.CostCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: refuel.FieldCost,
+		})
+	}
+	if value, ok := ruo.mutation.Station(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refuel.FieldStation,
+		})
+	}
+	if ruo.mutation.StationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: refuel.FieldStation,
+		})
+	}
+	if value, ok := ruo.mutation.Odometer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldOdometer,
+		})
+	}
+	if value, ok := ruo.mutation.AddedOdometer(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: refuel.FieldOdometer,
+		})
+	}
+	if ruo.mutation.OdometerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: refuel.FieldOdometer,
+		})
+	}
+	if value, ok := ruo.mutation.RefueledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refuel.FieldRefueledAt,
+		})
+	}
+	if ruo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refuel.CarTable,
+			Columns: []string{refuel.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refuel.CarTable,
+			Columns: []string{refuel.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Refuel{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refuel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
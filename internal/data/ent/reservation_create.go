@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/reservation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReservationCreate is the builder for creating a Reservation entity.
+type ReservationCreate struct {
+	config
+	mutation *ReservationMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (rc *ReservationCreate) SetTenantID(i int64) *ReservationCreate {
+	rc.mutation.SetTenantID(i)
+	return rc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableTenantID(i *int64) *ReservationCreate {
+	if i != nil {
+		rc.SetTenantID(*i)
+	}
+	return rc
+}
+
+// SetCarID sets the "car_id" field.
+func (rc *ReservationCreate) SetCarID(i int64) *ReservationCreate {
+	rc.mutation.SetCarID(i)
+	return rc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCarID(i *int64) *ReservationCreate {
+	if i != nil {
+		rc.SetCarID(*i)
+	}
+	return rc
+}
+
+// SetUserID sets the "user_id" field.
+func (rc *ReservationCreate) SetUserID(i int64) *ReservationCreate {
+	rc.mutation.SetUserID(i)
+	return rc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableUserID(i *int64) *ReservationCreate {
+	if i != nil {
+		rc.SetUserID(*i)
+	}
+	return rc
+}
+
+// SetStartAt sets the "start_at" field.
+func (rc *ReservationCreate) SetStartAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetStartAt(t)
+	return rc
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableStartAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetStartAt(*t)
+	}
+	return rc
+}
+
+// SetEndAt sets the "end_at" field.
+func (rc *ReservationCreate) SetEndAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetEndAt(t)
+	return rc
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableEndAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetEndAt(*t)
+	}
+	return rc
+}
+
+// SetPurpose sets the "purpose" field.
+func (rc *ReservationCreate) SetPurpose(s string) *ReservationCreate {
+	rc.mutation.SetPurpose(s)
+	return rc
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillablePurpose(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetPurpose(*s)
+	}
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReservationCreate) SetStatus(s string) *ReservationCreate {
+	rc.mutation.SetStatus(s)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableStatus(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetStatus(*s)
+	}
+	return rc
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (rc *ReservationCreate) SetCancelledAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetCancelledAt(t)
+	return rc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCancelledAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetCancelledAt(*t)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReservationCreate) SetCreatedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCreatedAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReservationCreate) SetID(i int64) *ReservationCreate {
+	rc.mutation.SetID(i)
+	return rc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (rc *ReservationCreate) SetCar(c *Car) *ReservationCreate {
+	return rc.SetCarID(c.ID)
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (rc *ReservationCreate) Mutation() *ReservationMutation {
+	return rc.mutation
+}
+
+// Save creates the Reservation in the database.
+func (rc *ReservationCreate) Save(ctx context.Context) (*Reservation, error) {
+	var (
+		err  error
+		node *Reservation
+	)
+	if err := rc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rc.hooks) == 0 {
+		if err = rc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReservationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rc.check(); err != nil {
+				return nil, err
+			}
+			rc.mutation = mutation
+			if node, err = rc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rc.hooks) - 1; i >= 0; i-- {
+			if rc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Reservation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReservationMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReservationCreate) SaveX(ctx context.Context) *Reservation {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReservationCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReservationCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReservationCreate) defaults() error {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := reservation.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		if reservation.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reservation.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := reservation.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReservationCreate) check() error {
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Reservation.status"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reservation.created_at"`)}
+	}
+	return nil
+}
+
+func (rc *ReservationCreate) sqlSave(ctx context.Context) (*Reservation, error) {
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (rc *ReservationCreate) createSpec() (*Reservation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reservation{config: rc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: reservation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: reservation.FieldID,
+			},
+		}
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := rc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := rc.mutation.StartAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldStartAt,
+		})
+		_node.StartAt = value
+	}
+	if value, ok := rc.mutation.EndAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldEndAt,
+		})
+		_node.EndAt = value
+	}
+	if value, ok := rc.mutation.Purpose(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reservation.FieldPurpose,
+		})
+		_node.Purpose = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reservation.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.CancelledAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldCancelledAt,
+		})
+		_node.CancelledAt = &value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := rc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.CarTable,
+			Columns: []string{reservation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReservationCreateBulk is the builder for creating many Reservation entities in bulk.
+type ReservationCreateBulk struct {
+	config
+	builders []*ReservationCreate
+}
+
+// Save creates the Reservation entities in the database.
+func (rcb *ReservationCreateBulk) Save(ctx context.Context) ([]*Reservation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reservation, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReservationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) SaveX(ctx context.Context) []*Reservation {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReservationCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
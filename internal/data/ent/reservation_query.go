@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/reservation"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReservationQuery is the builder for querying Reservation entities.
+type ReservationQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Reservation
+	// eager-loading edges.
+	withCar   *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReservationQuery builder.
+func (rq *ReservationQuery) Where(ps ...predicate.Reservation) *ReservationQuery {
+	rq.predicates = append(rq.predicates, ps...)
+	return rq
+}
+
+// Limit adds a limit step to the query.
+func (rq *ReservationQuery) Limit(limit int) *ReservationQuery {
+	rq.limit = &limit
+	return rq
+}
+
+// Offset adds an offset step to the query.
+func (rq *ReservationQuery) Offset(offset int) *ReservationQuery {
+	rq.offset = &offset
+	return rq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rq *ReservationQuery) Unique(unique bool) *ReservationQuery {
+	rq.unique = &unique
+	return rq
+}
+
+// Order adds an order step to the query.
+func (rq *ReservationQuery) Order(o ...OrderFunc) *ReservationQuery {
+	rq.order = append(rq.order, o...)
+	return rq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (rq *ReservationQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reservation.Table, reservation.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reservation.CarTable, reservation.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Reservation entity from the query.
+// Returns a *NotFoundError when no Reservation was found.
+func (rq *ReservationQuery) First(ctx context.Context) (*Reservation, error) {
+	nodes, err := rq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reservation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rq *ReservationQuery) FirstX(ctx context.Context) *Reservation {
+	node, err := rq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Reservation ID from the query.
+// Returns a *NotFoundError when no Reservation ID was found.
+func (rq *ReservationQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = rq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reservation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rq *ReservationQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := rq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Reservation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Reservation entity is found.
+// Returns a *NotFoundError when no Reservation entities are found.
+func (rq *ReservationQuery) Only(ctx context.Context) (*Reservation, error) {
+	nodes, err := rq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reservation.Label}
+	default:
+		return nil, &NotSingularError{reservation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rq *ReservationQuery) OnlyX(ctx context.Context) *Reservation {
+	node, err := rq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Reservation ID in the query.
+// Returns a *NotSingularError when more than one Reservation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rq *ReservationQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = rq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reservation.Label}
+	default:
+		err = &NotSingularError{reservation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rq *ReservationQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := rq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Reservations.
+func (rq *ReservationQuery) All(ctx context.Context) ([]*Reservation, error) {
+	if err := rq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rq *ReservationQuery) AllX(ctx context.Context) []*Reservation {
+	nodes, err := rq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Reservation IDs.
+func (rq *ReservationQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := rq.Select(reservation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rq *ReservationQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := rq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rq *ReservationQuery) Count(ctx context.Context) (int, error) {
+	if err := rq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rq *ReservationQuery) CountX(ctx context.Context) int {
+	count, err := rq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rq *ReservationQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rq *ReservationQuery) ExistX(ctx context.Context) bool {
+	exist, err := rq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReservationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rq *ReservationQuery) Clone() *ReservationQuery {
+	if rq == nil {
+		return nil
+	}
+	return &ReservationQuery{
+		config:     rq.config,
+		limit:      rq.limit,
+		offset:     rq.offset,
+		order:      append([]OrderFunc{}, rq.order...),
+		predicates: append([]predicate.Reservation{}, rq.predicates...),
+		withCar:    rq.withCar.Clone(),
+		// clone intermediate query.
+		sql:    rq.sql.Clone(),
+		path:   rq.path,
+		unique: rq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ReservationQuery) WithCar(opts ...func(*CarQuery)) *ReservationQuery {
+	query := &CarQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withCar = query
+	return rq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Reservation.Query().
+//		GroupBy(reservation.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (rq *ReservationQuery) GroupBy(field string, fields ...string) *ReservationGroupBy {
+	grbuild := &ReservationGroupBy{config: rq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rq.sqlQuery(ctx), nil
+	}
+	grbuild.label = reservation.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.Reservation.Query().
+//		Select(reservation.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (rq *ReservationQuery) Select(fields ...string) *ReservationSelect {
+	rq.fields = append(rq.fields, fields...)
+	selbuild := &ReservationSelect{ReservationQuery: rq}
+	selbuild.label = reservation.Label
+	selbuild.flds, selbuild.scan = &rq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (rq *ReservationQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rq.fields {
+		if !reservation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rq.path != nil {
+		prev, err := rq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rq.sql = prev
+	}
+	if reservation.Policy == nil {
+		return errors.New("ent: uninitialized reservation.Policy (forgotten import ent/runtime?)")
+	}
+	if err := reservation.Policy.EvalQuery(ctx, rq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (rq *ReservationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Reservation, error) {
+	var (
+		nodes       = []*Reservation{}
+		_spec       = rq.querySpec()
+		loadedTypes = [1]bool{
+			rq.withCar != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Reservation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Reservation{config: rq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := rq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*Reservation)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (rq *ReservationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rq.querySpec()
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
+	_spec.Node.Columns = rq.fields
+	if len(rq.fields) > 0 {
+		_spec.Unique = rq.unique != nil && *rq.unique
+	}
+	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+}
+
+func (rq *ReservationQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rq *ReservationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reservation.Table,
+			Columns: reservation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: reservation.FieldID,
+			},
+		},
+		From:   rq.sql,
+		Unique: true,
+	}
+	if unique := rq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reservation.FieldID)
+		for i := range fields {
+			if fields[i] != reservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rq *ReservationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rq.driver.Dialect())
+	t1 := builder.Table(reservation.Table)
+	columns := rq.fields
+	if len(columns) == 0 {
+		columns = reservation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rq.sql != nil {
+		selector = rq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rq.unique != nil && *rq.unique {
+		selector.Distinct()
+	}
+	for _, m := range rq.modifiers {
+		m(selector)
+	}
+	for _, p := range rq.predicates {
+		p(selector)
+	}
+	for _, p := range rq.order {
+		p(selector)
+	}
+	if offset := rq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rq *ReservationQuery) ForUpdate(opts ...sql.LockOption) *ReservationQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rq *ReservationQuery) ForShare(opts ...sql.LockOption) *ReservationQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rq *ReservationQuery) Modify(modifiers ...func(s *sql.Selector)) *ReservationSelect {
+	rq.modifiers = append(rq.modifiers, modifiers...)
+	return rq.Select()
+}
+
+// ReservationGroupBy is the group-by builder for Reservation entities.
+type ReservationGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rgb *ReservationGroupBy) Aggregate(fns ...AggregateFunc) *ReservationGroupBy {
+	rgb.fns = append(rgb.fns, fns...)
+	return rgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rgb *ReservationGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rgb.sql = query
+	return rgb.sqlScan(ctx, v)
+}
+
+func (rgb *ReservationGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rgb.fields {
+		if !reservation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rgb *ReservationGroupBy) sqlQuery() *sql.Selector {
+	selector := rgb.sql.Select()
+	aggregation := make([]string, 0, len(rgb.fns))
+	for _, fn := range rgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rgb.fields)+len(rgb.fns))
+		for _, f := range rgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rgb.fields...)...)
+}
+
+// ReservationSelect is the builder for selecting fields of Reservation entities.
+type ReservationSelect struct {
+	*ReservationQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rs *ReservationSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rs.sql = rs.ReservationQuery.sqlQuery(ctx)
+	return rs.sqlScan(ctx, v)
+}
+
+func (rs *ReservationSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rs.sql.Query()
+	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rs *ReservationSelect) Modify(modifiers ...func(s *sql.Selector)) *ReservationSelect {
+	rs.modifiers = append(rs.modifiers, modifiers...)
+	return rs
+}
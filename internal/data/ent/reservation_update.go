@@ -0,0 +1,915 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/reservation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReservationUpdate is the builder for updating Reservation entities.
+type ReservationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReservationMutation
+}
+
+// Where appends a list predicates to the ReservationUpdate builder.
+func (ru *ReservationUpdate) Where(ps ...predicate.Reservation) *ReservationUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ru *ReservationUpdate) SetTenantID(i int64) *ReservationUpdate {
+	ru.mutation.ResetTenantID()
+	ru.mutation.SetTenantID(i)
+	return ru
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableTenantID(i *int64) *ReservationUpdate {
+	if i != nil {
+		ru.SetTenantID(*i)
+	}
+	return ru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ru *ReservationUpdate) AddTenantID(i int64) *ReservationUpdate {
+	ru.mutation.AddTenantID(i)
+	return ru
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (ru *ReservationUpdate) ClearTenantID() *ReservationUpdate {
+	ru.mutation.ClearTenantID()
+	return ru
+}
+
+// SetCarID sets the "car_id" field.
+func (ru *ReservationUpdate) SetCarID(i int64) *ReservationUpdate {
+	ru.mutation.SetCarID(i)
+	return ru
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableCarID(i *int64) *ReservationUpdate {
+	if i != nil {
+		ru.SetCarID(*i)
+	}
+	return ru
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (ru *ReservationUpdate) ClearCarID() *ReservationUpdate {
+	ru.mutation.ClearCarID()
+	return ru
+}
+
+// SetUserID sets the "user_id" field.
+func (ru *ReservationUpdate) SetUserID(i int64) *ReservationUpdate {
+	ru.mutation.ResetUserID()
+	ru.mutation.SetUserID(i)
+	return ru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableUserID(i *int64) *ReservationUpdate {
+	if i != nil {
+		ru.SetUserID(*i)
+	}
+	return ru
+}
+
+// AddUserID adds i to the "user_id" field.
+func (ru *ReservationUpdate) AddUserID(i int64) *ReservationUpdate {
+	ru.mutation.AddUserID(i)
+	return ru
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ru *ReservationUpdate) ClearUserID() *ReservationUpdate {
+	ru.mutation.ClearUserID()
+	return ru
+}
+
+// SetStartAt sets the "start_at" field.
+func (ru *ReservationUpdate) SetStartAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetStartAt(t)
+	return ru
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableStartAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetStartAt(*t)
+	}
+	return ru
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (ru *ReservationUpdate) ClearStartAt() *ReservationUpdate {
+	ru.mutation.ClearStartAt()
+	return ru
+}
+
+// SetEndAt sets the "end_at" field.
+func (ru *ReservationUpdate) SetEndAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetEndAt(t)
+	return ru
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableEndAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetEndAt(*t)
+	}
+	return ru
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (ru *ReservationUpdate) ClearEndAt() *ReservationUpdate {
+	ru.mutation.ClearEndAt()
+	return ru
+}
+
+// SetPurpose sets the "purpose" field.
+func (ru *ReservationUpdate) SetPurpose(s string) *ReservationUpdate {
+	ru.mutation.SetPurpose(s)
+	return ru
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillablePurpose(s *string) *ReservationUpdate {
+	if s != nil {
+		ru.SetPurpose(*s)
+	}
+	return ru
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (ru *ReservationUpdate) ClearPurpose() *ReservationUpdate {
+	ru.mutation.ClearPurpose()
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReservationUpdate) SetStatus(s string) *ReservationUpdate {
+	ru.mutation.SetStatus(s)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableStatus(s *string) *ReservationUpdate {
+	if s != nil {
+		ru.SetStatus(*s)
+	}
+	return ru
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (ru *ReservationUpdate) SetCancelledAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetCancelledAt(t)
+	return ru
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableCancelledAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetCancelledAt(*t)
+	}
+	return ru
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (ru *ReservationUpdate) ClearCancelledAt() *ReservationUpdate {
+	ru.mutation.ClearCancelledAt()
+	return ru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ru *ReservationUpdate) SetCreatedAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetCreatedAt(t)
+	return ru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableCreatedAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetCreatedAt(*t)
+	}
+	return ru
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ru *ReservationUpdate) SetCar(c *Car) *ReservationUpdate {
+	return ru.SetCarID(c.ID)
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ru *ReservationUpdate) Mutation() *ReservationMutation {
+	return ru.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (ru *ReservationUpdate) ClearCar() *ReservationUpdate {
+	ru.mutation.ClearCar()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReservationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ru.hooks) == 0 {
+		affected, err = ru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReservationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ru.mutation = mutation
+			affected, err = ru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ru.hooks) - 1; i >= 0; i-- {
+			if ru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReservationUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReservationUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReservationUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ru *ReservationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reservation.Table,
+			Columns: reservation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: reservation.FieldID,
+			},
+		},
+	}
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldTenantID,
+		})
+	}
+	if value, ok := ru.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldTenantID,
+		})
+	}
+	if ru.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: reservation.FieldTenantID,
+		})
+	}
+	if value, ok := ru.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldUserID,
+		})
+	}
+	if value, ok := ru.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldUserID,
+		})
+	}
+	if ru.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: reservation.FieldUserID,
+		})
+	}
+	if value, ok := ru.mutation.StartAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldStartAt,
+		})
+	}
+	if ru.mutation.StartAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reservation.FieldStartAt,
+		})
+	}
+	if value, ok := ru.mutation.EndAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldEndAt,
+		})
+	}
+	if ru.mutation.EndAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reservation.FieldEndAt,
+		})
+	}
+	if value, ok := ru.mutation.Purpose(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reservation.FieldPurpose,
+		})
+	}
+	if ru.mutation.PurposeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reservation.FieldPurpose,
+		})
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reservation.FieldStatus,
+		})
+	}
+	if value, ok := ru.mutation.CancelledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldCancelledAt,
+		})
+	}
+	if ru.mutation.CancelledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reservation.FieldCancelledAt,
+		})
+	}
+	if value, ok := ru.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldCreatedAt,
+		})
+	}
+	if ru.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.CarTable,
+			Columns: []string{reservation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.CarTable,
+			Columns: []string{reservation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ReservationUpdateOne is the builder for updating a single Reservation entity.
+type ReservationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReservationMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ruo *ReservationUpdateOne) SetTenantID(i int64) *ReservationUpdateOne {
+	ruo.mutation.ResetTenantID()
+	ruo.mutation.SetTenantID(i)
+	return ruo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableTenantID(i *int64) *ReservationUpdateOne {
+	if i != nil {
+		ruo.SetTenantID(*i)
+	}
+	return ruo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ruo *ReservationUpdateOne) AddTenantID(i int64) *ReservationUpdateOne {
+	ruo.mutation.AddTenantID(i)
+	return ruo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (ruo *ReservationUpdateOne) ClearTenantID() *ReservationUpdateOne {
+	ruo.mutation.ClearTenantID()
+	return ruo
+}
+
+// SetCarID sets the "car_id" field.
+func (ruo *ReservationUpdateOne) SetCarID(i int64) *ReservationUpdateOne {
+	ruo.mutation.SetCarID(i)
+	return ruo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableCarID(i *int64) *ReservationUpdateOne {
+	if i != nil {
+		ruo.SetCarID(*i)
+	}
+	return ruo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (ruo *ReservationUpdateOne) ClearCarID() *ReservationUpdateOne {
+	ruo.mutation.ClearCarID()
+	return ruo
+}
+
+// SetUserID sets the "user_id" field.
+func (ruo *ReservationUpdateOne) SetUserID(i int64) *ReservationUpdateOne {
+	ruo.mutation.ResetUserID()
+	ruo.mutation.SetUserID(i)
+	return ruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableUserID(i *int64) *ReservationUpdateOne {
+	if i != nil {
+		ruo.SetUserID(*i)
+	}
+	return ruo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (ruo *ReservationUpdateOne) AddUserID(i int64) *ReservationUpdateOne {
+	ruo.mutation.AddUserID(i)
+	return ruo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ruo *ReservationUpdateOne) ClearUserID() *ReservationUpdateOne {
+	ruo.mutation.ClearUserID()
+	return ruo
+}
+
+// SetStartAt sets the "start_at" field.
+func (ruo *ReservationUpdateOne) SetStartAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetStartAt(t)
+	return ruo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableStartAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetStartAt(*t)
+	}
+	return ruo
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (ruo *ReservationUpdateOne) ClearStartAt() *ReservationUpdateOne {
+	ruo.mutation.ClearStartAt()
+	return ruo
+}
+
+// SetEndAt sets the "end_at" field.
+func (ruo *ReservationUpdateOne) SetEndAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetEndAt(t)
+	return ruo
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableEndAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetEndAt(*t)
+	}
+	return ruo
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (ruo *ReservationUpdateOne) ClearEndAt() *ReservationUpdateOne {
+	ruo.mutation.ClearEndAt()
+	return ruo
+}
+
+// SetPurpose sets the "purpose" field.
+func (ruo *ReservationUpdateOne) SetPurpose(s string) *ReservationUpdateOne {
+	ruo.mutation.SetPurpose(s)
+	return ruo
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillablePurpose(s *string) *ReservationUpdateOne {
+	if s != nil {
+		ruo.SetPurpose(*s)
+	}
+	return ruo
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (ruo *ReservationUpdateOne) ClearPurpose() *ReservationUpdateOne {
+	ruo.mutation.ClearPurpose()
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReservationUpdateOne) SetStatus(s string) *ReservationUpdateOne {
+	ruo.mutation.SetStatus(s)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableStatus(s *string) *ReservationUpdateOne {
+	if s != nil {
+		ruo.SetStatus(*s)
+	}
+	return ruo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (ruo *ReservationUpdateOne) SetCancelledAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetCancelledAt(t)
+	return ruo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableCancelledAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetCancelledAt(*t)
+	}
+	return ruo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (ruo *ReservationUpdateOne) ClearCancelledAt() *ReservationUpdateOne {
+	ruo.mutation.ClearCancelledAt()
+	return ruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ruo *ReservationUpdateOne) SetCreatedAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetCreatedAt(t)
+	return ruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableCreatedAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetCreatedAt(*t)
+	}
+	return ruo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (ruo *ReservationUpdateOne) SetCar(c *Car) *ReservationUpdateOne {
+	return ruo.SetCarID(c.ID)
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ruo *ReservationUpdateOne) Mutation() *ReservationMutation {
+	return ruo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (ruo *ReservationUpdateOne) ClearCar() *ReservationUpdateOne {
+	ruo.mutation.ClearCar()
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReservationUpdateOne) Select(field string, fields ...string) *ReservationUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reservation entity.
+func (ruo *ReservationUpdateOne) Save(ctx context.Context) (*Reservation, error) {
+	var (
+		err  error
+		node *Reservation
+	)
+	if len(ruo.hooks) == 0 {
+		node, err = ruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReservationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ruo.mutation = mutation
+			node, err = ruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ruo.hooks) - 1; i >= 0; i-- {
+			if ruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Reservation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReservationMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) SaveX(ctx context.Context) *Reservation {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReservationUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ruo *ReservationUpdateOne) sqlSave(ctx context.Context) (_node *Reservation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reservation.Table,
+			Columns: reservation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: reservation.FieldID,
+			},
+		},
+	}
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reservation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reservation.FieldID)
+		for _, f := range fields {
+			if !reservation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldTenantID,
+		})
+	}
+	if value, ok := ruo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldTenantID,
+		})
+	}
+	if ruo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: reservation.FieldTenantID,
+		})
+	}
+	if value, ok := ruo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldUserID,
+		})
+	}
+	if value, ok := ruo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: reservation.FieldUserID,
+		})
+	}
+	if ruo.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: reservation.FieldUserID,
+		})
+	}
+	if value, ok := ruo.mutation.StartAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldStartAt,
+		})
+	}
+	if ruo.mutation.StartAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reservation.FieldStartAt,
+		})
+	}
+	if value, ok := ruo.mutation.EndAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldEndAt,
+		})
+	}
+	if ruo.mutation.EndAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reservation.FieldEndAt,
+		})
+	}
+	if value, ok := ruo.mutation.Purpose(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reservation.FieldPurpose,
+		})
+	}
+	if ruo.mutation.PurposeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reservation.FieldPurpose,
+		})
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reservation.FieldStatus,
+		})
+	}
+	if value, ok := ruo.mutation.CancelledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldCancelledAt,
+		})
+	}
+	if ruo.mutation.CancelledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: reservation.FieldCancelledAt,
+		})
+	}
+	if value, ok := ruo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldCreatedAt,
+		})
+	}
+	if ruo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.CarTable,
+			Columns: []string{reservation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.CarTable,
+			Columns: []string{reservation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Reservation{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
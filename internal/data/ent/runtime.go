@@ -2,20 +2,4 @@
 
 package ent
 
-import (
-	"car-service/internal/data/ent/car"
-	"car-service/internal/data/ent/schema"
-	"time"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	carFields := schema.Car{}.Fields()
-	_ = carFields
-	// carDescRegisteredAt is the schema descriptor for registered_at field.
-	carDescRegisteredAt := carFields[3].Descriptor()
-	// car.DefaultRegisteredAt holds the default value on creation for the registered_at field.
-	car.DefaultRegisteredAt = carDescRegisteredAt.Default.(func() time.Time)
-}
+// The schema-stitching logic is generated in car-service/internal/data/ent/runtime/runtime.go
@@ -2,7 +2,557 @@
 
 package runtime
 
-// The schema-stitching logic is generated in car-service/internal/data/ent/runtime.go
+import (
+	"car-service/internal/data/ent/attachment"
+	"car-service/internal/data/ent/attributedefinition"
+	"car-service/internal/data/ent/auditlog"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/chargingsession"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/geofence"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/jobrun"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/maintenancerecord"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/ownerhistory"
+	"car-service/internal/data/ent/recall"
+	"car-service/internal/data/ent/refuel"
+	"car-service/internal/data/ent/reservation"
+	"car-service/internal/data/ent/schema"
+	"car-service/internal/data/ent/tag"
+	"car-service/internal/data/ent/traderecord"
+	"car-service/internal/data/ent/transfer"
+	"car-service/internal/data/ent/trip"
+	"car-service/internal/data/ent/violation"
+	"car-service/internal/data/ent/warrantydefinition"
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	attachmentMixin := schema.Attachment{}.Mixin()
+	attachment.Policy = privacy.NewPolicies(attachmentMixin[0], schema.Attachment{})
+	attachment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := attachment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	attachmentMixinHooks0 := attachmentMixin[0].Hooks()
+
+	attachment.Hooks[1] = attachmentMixinHooks0[0]
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentFields[8].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	attributedefinitionMixin := schema.AttributeDefinition{}.Mixin()
+	attributedefinition.Policy = privacy.NewPolicies(attributedefinitionMixin[0], schema.AttributeDefinition{})
+	attributedefinition.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := attributedefinition.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	attributedefinitionMixinHooks0 := attributedefinitionMixin[0].Hooks()
+
+	attributedefinition.Hooks[1] = attributedefinitionMixinHooks0[0]
+	attributedefinitionFields := schema.AttributeDefinition{}.Fields()
+	_ = attributedefinitionFields
+	// attributedefinitionDescRequired is the schema descriptor for required field.
+	attributedefinitionDescRequired := attributedefinitionFields[3].Descriptor()
+	// attributedefinition.DefaultRequired holds the default value on creation for the required field.
+	attributedefinition.DefaultRequired = attributedefinitionDescRequired.Default.(bool)
+	// attributedefinitionDescCreatedAt is the schema descriptor for created_at field.
+	attributedefinitionDescCreatedAt := attributedefinitionFields[5].Descriptor()
+	// attributedefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attributedefinition.DefaultCreatedAt = attributedefinitionDescCreatedAt.Default.(func() time.Time)
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlog.Policy = privacy.NewPolicies(auditlogMixin[0], schema.AuditLog{})
+	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditlog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditlogMixinHooks0 := auditlogMixin[0].Hooks()
+
+	auditlog.Hooks[1] = auditlogMixinHooks0[0]
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[6].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	carMixin := schema.Car{}.Mixin()
+	car.Policy = privacy.NewPolicies(carMixin[0], schema.Car{})
+	car.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := car.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	carMixinHooks0 := carMixin[0].Hooks()
+
+	car.Hooks[1] = carMixinHooks0[0]
+	carFields := schema.Car{}.Fields()
+	_ = carFields
+	// carDescRegisteredAt is the schema descriptor for registered_at field.
+	carDescRegisteredAt := carFields[7].Descriptor()
+	// car.DefaultRegisteredAt holds the default value on creation for the registered_at field.
+	car.DefaultRegisteredAt = carDescRegisteredAt.Default.(func() time.Time)
+	carrecallMixin := schema.CarRecall{}.Mixin()
+	carrecall.Policy = privacy.NewPolicies(carrecallMixin[0], schema.CarRecall{})
+	carrecall.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := carrecall.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	carrecallMixinHooks0 := carrecallMixin[0].Hooks()
+
+	carrecall.Hooks[1] = carrecallMixinHooks0[0]
+	carrecallFields := schema.CarRecall{}.Fields()
+	_ = carrecallFields
+	// carrecallDescStatus is the schema descriptor for status field.
+	carrecallDescStatus := carrecallFields[3].Descriptor()
+	// carrecall.DefaultStatus holds the default value on creation for the status field.
+	carrecall.DefaultStatus = carrecallDescStatus.Default.(string)
+	// carrecallDescCreatedAt is the schema descriptor for created_at field.
+	carrecallDescCreatedAt := carrecallFields[6].Descriptor()
+	// carrecall.DefaultCreatedAt holds the default value on creation for the created_at field.
+	carrecall.DefaultCreatedAt = carrecallDescCreatedAt.Default.(func() time.Time)
+	// carrecallDescUpdatedAt is the schema descriptor for updated_at field.
+	carrecallDescUpdatedAt := carrecallFields[7].Descriptor()
+	// carrecall.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	carrecall.DefaultUpdatedAt = carrecallDescUpdatedAt.Default.(func() time.Time)
+	carwarrantyMixin := schema.CarWarranty{}.Mixin()
+	carwarranty.Policy = privacy.NewPolicies(carwarrantyMixin[0], schema.CarWarranty{})
+	carwarranty.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := carwarranty.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	carwarrantyMixinHooks0 := carwarrantyMixin[0].Hooks()
+
+	carwarranty.Hooks[1] = carwarrantyMixinHooks0[0]
+	carwarrantyFields := schema.CarWarranty{}.Fields()
+	_ = carwarrantyFields
+	// carwarrantyDescCreatedAt is the schema descriptor for created_at field.
+	carwarrantyDescCreatedAt := carwarrantyFields[8].Descriptor()
+	// carwarranty.DefaultCreatedAt holds the default value on creation for the created_at field.
+	carwarranty.DefaultCreatedAt = carwarrantyDescCreatedAt.Default.(func() time.Time)
+	chargingsessionMixin := schema.ChargingSession{}.Mixin()
+	chargingsession.Policy = privacy.NewPolicies(chargingsessionMixin[0], schema.ChargingSession{})
+	chargingsession.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := chargingsession.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	chargingsessionMixinHooks0 := chargingsessionMixin[0].Hooks()
+
+	chargingsession.Hooks[1] = chargingsessionMixinHooks0[0]
+	chargingsessionFields := schema.ChargingSession{}.Fields()
+	_ = chargingsessionFields
+	// chargingsessionDescCreatedAt is the schema descriptor for created_at field.
+	chargingsessionDescCreatedAt := chargingsessionFields[11].Descriptor()
+	// chargingsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chargingsession.DefaultCreatedAt = chargingsessionDescCreatedAt.Default.(func() time.Time)
+	fleetMixin := schema.Fleet{}.Mixin()
+	fleet.Policy = privacy.NewPolicies(fleetMixin[0], schema.Fleet{})
+	fleet.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := fleet.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	fleetMixinHooks0 := fleetMixin[0].Hooks()
+
+	fleet.Hooks[1] = fleetMixinHooks0[0]
+	fleetFields := schema.Fleet{}.Fields()
+	_ = fleetFields
+	// fleetDescCreatedAt is the schema descriptor for created_at field.
+	fleetDescCreatedAt := fleetFields[3].Descriptor()
+	// fleet.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fleet.DefaultCreatedAt = fleetDescCreatedAt.Default.(func() time.Time)
+	geofenceMixin := schema.Geofence{}.Mixin()
+	geofence.Policy = privacy.NewPolicies(geofenceMixin[0], schema.Geofence{})
+	geofence.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := geofence.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	geofenceMixinHooks0 := geofenceMixin[0].Hooks()
+
+	geofence.Hooks[1] = geofenceMixinHooks0[0]
+	geofenceFields := schema.Geofence{}.Fields()
+	_ = geofenceFields
+	// geofenceDescAlertOn is the schema descriptor for alert_on field.
+	geofenceDescAlertOn := geofenceFields[7].Descriptor()
+	// geofence.DefaultAlertOn holds the default value on creation for the alert_on field.
+	geofence.DefaultAlertOn = geofenceDescAlertOn.Default.(string)
+	// geofenceDescEnabled is the schema descriptor for enabled field.
+	geofenceDescEnabled := geofenceFields[8].Descriptor()
+	// geofence.DefaultEnabled holds the default value on creation for the enabled field.
+	geofence.DefaultEnabled = geofenceDescEnabled.Default.(bool)
+	// geofenceDescCreatedAt is the schema descriptor for created_at field.
+	geofenceDescCreatedAt := geofenceFields[9].Descriptor()
+	// geofence.DefaultCreatedAt holds the default value on creation for the created_at field.
+	geofence.DefaultCreatedAt = geofenceDescCreatedAt.Default.(func() time.Time)
+	// geofenceDescUpdatedAt is the schema descriptor for updated_at field.
+	geofenceDescUpdatedAt := geofenceFields[10].Descriptor()
+	// geofence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	geofence.DefaultUpdatedAt = geofenceDescUpdatedAt.Default.(func() time.Time)
+	incidentMixin := schema.Incident{}.Mixin()
+	incident.Policy = privacy.NewPolicies(incidentMixin[0], schema.Incident{})
+	incident.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := incident.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	incidentMixinHooks0 := incidentMixin[0].Hooks()
+
+	incident.Hooks[1] = incidentMixinHooks0[0]
+	incidentFields := schema.Incident{}.Fields()
+	_ = incidentFields
+	// incidentDescStatus is the schema descriptor for status field.
+	incidentDescStatus := incidentFields[9].Descriptor()
+	// incident.DefaultStatus holds the default value on creation for the status field.
+	incident.DefaultStatus = incidentDescStatus.Default.(string)
+	// incidentDescCreatedAt is the schema descriptor for created_at field.
+	incidentDescCreatedAt := incidentFields[15].Descriptor()
+	// incident.DefaultCreatedAt holds the default value on creation for the created_at field.
+	incident.DefaultCreatedAt = incidentDescCreatedAt.Default.(func() time.Time)
+	// incidentDescUpdatedAt is the schema descriptor for updated_at field.
+	incidentDescUpdatedAt := incidentFields[16].Descriptor()
+	// incident.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	incident.DefaultUpdatedAt = incidentDescUpdatedAt.Default.(func() time.Time)
+	insurancepolicyMixin := schema.InsurancePolicy{}.Mixin()
+	insurancepolicy.Policy = privacy.NewPolicies(insurancepolicyMixin[0], schema.InsurancePolicy{})
+	insurancepolicy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := insurancepolicy.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	insurancepolicyMixinHooks0 := insurancepolicyMixin[0].Hooks()
+
+	insurancepolicy.Hooks[1] = insurancepolicyMixinHooks0[0]
+	jobrunFields := schema.JobRun{}.Fields()
+	_ = jobrunFields
+	// jobrunDescStatus is the schema descriptor for status field.
+	jobrunDescStatus := jobrunFields[5].Descriptor()
+	// jobrun.DefaultStatus holds the default value on creation for the status field.
+	jobrun.DefaultStatus = jobrunDescStatus.Default.(string)
+	// jobrunDescCreatedAt is the schema descriptor for created_at field.
+	jobrunDescCreatedAt := jobrunFields[8].Descriptor()
+	// jobrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	jobrun.DefaultCreatedAt = jobrunDescCreatedAt.Default.(func() time.Time)
+	leasecontractMixin := schema.LeaseContract{}.Mixin()
+	leasecontract.Policy = privacy.NewPolicies(leasecontractMixin[0], schema.LeaseContract{})
+	leasecontract.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := leasecontract.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	leasecontractMixinHooks0 := leasecontractMixin[0].Hooks()
+
+	leasecontract.Hooks[1] = leasecontractMixinHooks0[0]
+	leasecontractFields := schema.LeaseContract{}.Fields()
+	_ = leasecontractFields
+	// leasecontractDescTransferAllowed is the schema descriptor for transfer_allowed field.
+	leasecontractDescTransferAllowed := leasecontractFields[11].Descriptor()
+	// leasecontract.DefaultTransferAllowed holds the default value on creation for the transfer_allowed field.
+	leasecontract.DefaultTransferAllowed = leasecontractDescTransferAllowed.Default.(bool)
+	// leasecontractDescStatus is the schema descriptor for status field.
+	leasecontractDescStatus := leasecontractFields[12].Descriptor()
+	// leasecontract.DefaultStatus holds the default value on creation for the status field.
+	leasecontract.DefaultStatus = leasecontractDescStatus.Default.(string)
+	// leasecontractDescCreatedAt is the schema descriptor for created_at field.
+	leasecontractDescCreatedAt := leasecontractFields[14].Descriptor()
+	// leasecontract.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leasecontract.DefaultCreatedAt = leasecontractDescCreatedAt.Default.(func() time.Time)
+	listingMixin := schema.Listing{}.Mixin()
+	listing.Policy = privacy.NewPolicies(listingMixin[0], schema.Listing{})
+	listing.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := listing.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	listingMixinHooks0 := listingMixin[0].Hooks()
+
+	listing.Hooks[1] = listingMixinHooks0[0]
+	listingFields := schema.Listing{}.Fields()
+	_ = listingFields
+	// listingDescStatus is the schema descriptor for status field.
+	listingDescStatus := listingFields[5].Descriptor()
+	// listing.DefaultStatus holds the default value on creation for the status field.
+	listing.DefaultStatus = listingDescStatus.Default.(string)
+	// listingDescCreatedAt is the schema descriptor for created_at field.
+	listingDescCreatedAt := listingFields[9].Descriptor()
+	// listing.DefaultCreatedAt holds the default value on creation for the created_at field.
+	listing.DefaultCreatedAt = listingDescCreatedAt.Default.(func() time.Time)
+	maintenancerecordMixin := schema.MaintenanceRecord{}.Mixin()
+	maintenancerecord.Policy = privacy.NewPolicies(maintenancerecordMixin[0], schema.MaintenanceRecord{})
+	maintenancerecord.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := maintenancerecord.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	maintenancerecordMixinHooks0 := maintenancerecordMixin[0].Hooks()
+
+	maintenancerecord.Hooks[1] = maintenancerecordMixinHooks0[0]
+	maintenancerecordFields := schema.MaintenanceRecord{}.Fields()
+	_ = maintenancerecordFields
+	// maintenancerecordDescServicedAt is the schema descriptor for serviced_at field.
+	maintenancerecordDescServicedAt := maintenancerecordFields[2].Descriptor()
+	// maintenancerecord.DefaultServicedAt holds the default value on creation for the serviced_at field.
+	maintenancerecord.DefaultServicedAt = maintenancerecordDescServicedAt.Default.(func() time.Time)
+	odometerreadingMixin := schema.OdometerReading{}.Mixin()
+	odometerreading.Policy = privacy.NewPolicies(odometerreadingMixin[0], schema.OdometerReading{})
+	odometerreading.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := odometerreading.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	odometerreadingMixinHooks0 := odometerreadingMixin[0].Hooks()
+
+	odometerreading.Hooks[1] = odometerreadingMixinHooks0[0]
+	odometerreadingFields := schema.OdometerReading{}.Fields()
+	_ = odometerreadingFields
+	// odometerreadingDescSuspicious is the schema descriptor for suspicious field.
+	odometerreadingDescSuspicious := odometerreadingFields[4].Descriptor()
+	// odometerreading.DefaultSuspicious holds the default value on creation for the suspicious field.
+	odometerreading.DefaultSuspicious = odometerreadingDescSuspicious.Default.(bool)
+	// odometerreadingDescRecordedAt is the schema descriptor for recorded_at field.
+	odometerreadingDescRecordedAt := odometerreadingFields[5].Descriptor()
+	// odometerreading.DefaultRecordedAt holds the default value on creation for the recorded_at field.
+	odometerreading.DefaultRecordedAt = odometerreadingDescRecordedAt.Default.(func() time.Time)
+	ownerhistoryMixin := schema.OwnerHistory{}.Mixin()
+	ownerhistory.Policy = privacy.NewPolicies(ownerhistoryMixin[0], schema.OwnerHistory{})
+	ownerhistory.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := ownerhistory.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	ownerhistoryMixinHooks0 := ownerhistoryMixin[0].Hooks()
+
+	ownerhistory.Hooks[1] = ownerhistoryMixinHooks0[0]
+	ownerhistoryFields := schema.OwnerHistory{}.Fields()
+	_ = ownerhistoryFields
+	// ownerhistoryDescStartedAt is the schema descriptor for started_at field.
+	ownerhistoryDescStartedAt := ownerhistoryFields[3].Descriptor()
+	// ownerhistory.DefaultStartedAt holds the default value on creation for the started_at field.
+	ownerhistory.DefaultStartedAt = ownerhistoryDescStartedAt.Default.(func() time.Time)
+	recallFields := schema.Recall{}.Fields()
+	_ = recallFields
+	// recallDescCreatedAt is the schema descriptor for created_at field.
+	recallDescCreatedAt := recallFields[13].Descriptor()
+	// recall.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recall.DefaultCreatedAt = recallDescCreatedAt.Default.(func() time.Time)
+	refuelMixin := schema.Refuel{}.Mixin()
+	refuel.Policy = privacy.NewPolicies(refuelMixin[0], schema.Refuel{})
+	refuel.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := refuel.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	refuelMixinHooks0 := refuelMixin[0].Hooks()
+
+	refuel.Hooks[1] = refuelMixinHooks0[0]
+	refuelFields := schema.Refuel{}.Fields()
+	_ = refuelFields
+	// refuelDescRefueledAt is the schema descriptor for refueled_at field.
+	refuelDescRefueledAt := refuelFields[7].Descriptor()
+	// refuel.DefaultRefueledAt holds the default value on creation for the refueled_at field.
+	refuel.DefaultRefueledAt = refuelDescRefueledAt.Default.(func() time.Time)
+	reservationMixin := schema.Reservation{}.Mixin()
+	reservation.Policy = privacy.NewPolicies(reservationMixin[0], schema.Reservation{})
+	reservation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := reservation.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	reservationMixinHooks0 := reservationMixin[0].Hooks()
+
+	reservation.Hooks[1] = reservationMixinHooks0[0]
+	reservationFields := schema.Reservation{}.Fields()
+	_ = reservationFields
+	// reservationDescStatus is the schema descriptor for status field.
+	reservationDescStatus := reservationFields[6].Descriptor()
+	// reservation.DefaultStatus holds the default value on creation for the status field.
+	reservation.DefaultStatus = reservationDescStatus.Default.(string)
+	// reservationDescCreatedAt is the schema descriptor for created_at field.
+	reservationDescCreatedAt := reservationFields[8].Descriptor()
+	// reservation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reservation.DefaultCreatedAt = reservationDescCreatedAt.Default.(func() time.Time)
+	tagMixin := schema.Tag{}.Mixin()
+	tag.Policy = privacy.NewPolicies(tagMixin[0], schema.Tag{})
+	tag.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tag.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tagMixinHooks0 := tagMixin[0].Hooks()
+
+	tag.Hooks[1] = tagMixinHooks0[0]
+	traderecordMixin := schema.TradeRecord{}.Mixin()
+	traderecord.Policy = privacy.NewPolicies(traderecordMixin[0], schema.TradeRecord{})
+	traderecord.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := traderecord.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	traderecordMixinHooks0 := traderecordMixin[0].Hooks()
+
+	traderecord.Hooks[1] = traderecordMixinHooks0[0]
+	traderecordFields := schema.TradeRecord{}.Fields()
+	_ = traderecordFields
+	// traderecordDescTradedAt is the schema descriptor for traded_at field.
+	traderecordDescTradedAt := traderecordFields[9].Descriptor()
+	// traderecord.DefaultTradedAt holds the default value on creation for the traded_at field.
+	traderecord.DefaultTradedAt = traderecordDescTradedAt.Default.(func() time.Time)
+	transferMixin := schema.Transfer{}.Mixin()
+	transfer.Policy = privacy.NewPolicies(transferMixin[0], schema.Transfer{})
+	transfer.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := transfer.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	transferMixinHooks0 := transferMixin[0].Hooks()
+
+	transfer.Hooks[1] = transferMixinHooks0[0]
+	transferFields := schema.Transfer{}.Fields()
+	_ = transferFields
+	// transferDescStatus is the schema descriptor for status field.
+	transferDescStatus := transferFields[4].Descriptor()
+	// transfer.DefaultStatus holds the default value on creation for the status field.
+	transfer.DefaultStatus = transferDescStatus.Default.(string)
+	// transferDescRequireApproval is the schema descriptor for require_approval field.
+	transferDescRequireApproval := transferFields[5].Descriptor()
+	// transfer.DefaultRequireApproval holds the default value on creation for the require_approval field.
+	transfer.DefaultRequireApproval = transferDescRequireApproval.Default.(bool)
+	// transferDescCreatedAt is the schema descriptor for created_at field.
+	transferDescCreatedAt := transferFields[10].Descriptor()
+	// transfer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transfer.DefaultCreatedAt = transferDescCreatedAt.Default.(func() time.Time)
+	tripMixin := schema.Trip{}.Mixin()
+	trip.Policy = privacy.NewPolicies(tripMixin[0], schema.Trip{})
+	trip.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := trip.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tripMixinHooks0 := tripMixin[0].Hooks()
+
+	trip.Hooks[1] = tripMixinHooks0[0]
+	tripFields := schema.Trip{}.Fields()
+	_ = tripFields
+	// tripDescCreatedAt is the schema descriptor for created_at field.
+	tripDescCreatedAt := tripFields[8].Descriptor()
+	// trip.DefaultCreatedAt holds the default value on creation for the created_at field.
+	trip.DefaultCreatedAt = tripDescCreatedAt.Default.(func() time.Time)
+	violationMixin := schema.Violation{}.Mixin()
+	violation.Policy = privacy.NewPolicies(violationMixin[0], schema.Violation{})
+	violation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := violation.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	violationMixinHooks0 := violationMixin[0].Hooks()
+
+	violation.Hooks[1] = violationMixinHooks0[0]
+	violationFields := schema.Violation{}.Fields()
+	_ = violationFields
+	// violationDescStatus is the schema descriptor for status field.
+	violationDescStatus := violationFields[8].Descriptor()
+	// violation.DefaultStatus holds the default value on creation for the status field.
+	violation.DefaultStatus = violationDescStatus.Default.(string)
+	// violationDescCreatedAt is the schema descriptor for created_at field.
+	violationDescCreatedAt := violationFields[11].Descriptor()
+	// violation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	violation.DefaultCreatedAt = violationDescCreatedAt.Default.(func() time.Time)
+	warrantydefinitionFields := schema.WarrantyDefinition{}.Fields()
+	_ = warrantydefinitionFields
+	// warrantydefinitionDescCreatedAt is the schema descriptor for created_at field.
+	warrantydefinitionDescCreatedAt := warrantydefinitionFields[6].Descriptor()
+	// warrantydefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	warrantydefinition.DefaultCreatedAt = warrantydefinitionDescCreatedAt.Default.(func() time.Time)
+}
 
 const (
 	Version = "v0.11.0"                                         // Version of ent codegen.
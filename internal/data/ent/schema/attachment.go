@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Attachment holds the schema definition for the Attachment entity.
+type Attachment struct {
+	ent.Schema
+}
+
+func (Attachment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "attachment"},
+	}
+}
+
+func (Attachment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.String("kind").
+			Optional(),
+		field.String("file_name").
+			Optional(),
+		field.String("content_type").
+			Optional(),
+		field.Int64("size").
+			Optional(),
+		field.String("checksum").
+			Optional(),
+		field.String("storage_key").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Attachment.
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("attachments").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Attachment.
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AttributeDefinition holds the schema definition for the AttributeDefinition entity.
+type AttributeDefinition struct {
+	ent.Schema
+}
+
+func (AttributeDefinition) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "attribute_definition"},
+	}
+}
+
+func (AttributeDefinition) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the AttributeDefinition.
+func (AttributeDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("name"),
+		field.String("value_type").
+			Optional(),
+		field.Bool("required").
+			Default(false),
+		// 枚举可选值，JSON数组
+		field.Text("enum_values").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the AttributeDefinition.
+func (AttributeDefinition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("values", CarAttribute.Type),
+	}
+}
+
+// Indexes of the AttributeDefinition.
+func (AttributeDefinition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+	}
+}
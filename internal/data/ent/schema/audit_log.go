@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "audit_log"},
+	}
+}
+
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id"),
+		field.String("op"),
+		field.Int64("actor").
+			Optional(),
+		field.Text("diff").
+			Optional(),
+		field.String("trace_id").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "created_at"),
+		index.Fields("actor", "created_at"),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Brand holds the schema definition for the Brand entity.
+type Brand struct {
+	ent.Schema
+}
+
+func (Brand) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "brand"},
+	}
+}
+
+// Fields of the Brand.
+func (Brand) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("name").
+			Unique(),
+	}
+}
+
+// Edges of the Brand.
+func (Brand) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("vehicle_models", VehicleModel.Type),
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"time"
 )
 
@@ -20,6 +22,12 @@ func (Car) Annotations() []schema.Annotation {
 	}
 }
 
+func (Car) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Car.
 func (Car) Fields() []ent.Field {
 	return []ent.Field{
@@ -28,13 +36,69 @@ func (Car) Fields() []ent.Field {
 			Optional(),
 		field.String("model").
 			Optional(),
+		field.Int64("model_id").
+			Optional(),
+		field.String("vin").
+			Optional(),
+		field.String("plate").
+			Optional(),
+		// 车主名称冗余存储，仅用于全文检索
+		field.String("owner_name").
+			Optional(),
 		field.Time("registered_at").
-			Default(time.Now().Local).
+			Default(time.Now).
 			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		// 电池标称容量（kWh），大于0表示电动车
+		field.Float("battery_capacity").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(8,2)"}),
+		// 最近一次上报的电池健康度（%）
+		field.Float("battery_soh").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(5,2)"}),
 	}
 }
 
 // Edges of the Car.
 func (Car) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("vehicle_model", VehicleModel.Type).
+			Ref("cars").
+			Field("model_id").
+			Unique(),
+		edge.To("maintenance_records", MaintenanceRecord.Type),
+		edge.To("insurance_policies", InsurancePolicy.Type),
+		edge.To("transfers", Transfer.Type),
+		edge.To("odometer_readings", OdometerReading.Type),
+		edge.To("attachments", Attachment.Type),
+		edge.From("fleets", Fleet.Type).
+			Ref("cars"),
+		edge.From("tags", Tag.Type).
+			Ref("cars"),
+		edge.To("attributes", CarAttribute.Type),
+		edge.To("reservations", Reservation.Type),
+		edge.To("trips", Trip.Type),
+		edge.To("refuels", Refuel.Type),
+		edge.To("recalls", CarRecall.Type),
+		edge.To("owner_histories", OwnerHistory.Type),
+		edge.To("violations", Violation.Type),
+		edge.To("trade_records", TradeRecord.Type),
+		edge.To("listings", Listing.Type),
+		edge.To("warranties", CarWarranty.Type),
+		edge.To("incidents", Incident.Type),
+		edge.To("lease_contracts", LeaseContract.Type),
+		edge.To("charging_sessions", ChargingSession.Type),
+		edge.From("geofences", Geofence.Type).
+			Ref("cars"),
+	}
+}
+
+// Indexes of the Car.
+func (Car) Indexes() []ent.Index {
+	return []ent.Index{
+		// 自动建表使用默认分词器，需执行migrations/0001_car_fulltext_ngram.sql改用ngram分词器，
+		// 以支持中文及模糊匹配，要求MySQL 5.7.6及以上版本
+		index.Fields("model", "vin", "plate", "owner_name").
+			Annotations(entsql.IndexType("FULLTEXT")),
+	}
 }
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CarAttribute holds the schema definition for the CarAttribute entity.
+type CarAttribute struct {
+	ent.Schema
+}
+
+func (CarAttribute) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "car_attribute"},
+	}
+}
+
+// Fields of the CarAttribute.
+func (CarAttribute) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("definition_id").
+			Optional(),
+		field.String("value"),
+	}
+}
+
+// Edges of the CarAttribute.
+func (CarAttribute) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("attributes").
+			Field("car_id").
+			Unique(),
+		edge.From("definition", AttributeDefinition.Type).
+			Ref("values").
+			Field("definition_id").
+			Unique(),
+	}
+}
+
+// Indexes of the CarAttribute.
+func (CarAttribute) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "definition_id").
+			Unique(),
+		index.Fields("definition_id", "value"),
+	}
+}
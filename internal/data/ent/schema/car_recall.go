@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// CarRecall holds the schema definition for the CarRecall entity.
+type CarRecall struct {
+	ent.Schema
+}
+
+func (CarRecall) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "car_recall"},
+	}
+}
+
+func (CarRecall) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the CarRecall.
+func (CarRecall) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("recall_id").
+			Optional(),
+		field.String("status").
+			Default("open"),
+		field.String("notes").
+			Optional(),
+		field.Time("remedied_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("updated_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the CarRecall.
+func (CarRecall) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("recalls").
+			Field("car_id").
+			Unique(),
+		edge.From("recall", Recall.Type).
+			Ref("car_recalls").
+			Field("recall_id").
+			Unique(),
+	}
+}
+
+// Indexes of the CarRecall.
+func (CarRecall) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "recall_id").
+			Unique(),
+		index.Fields("recall_id"),
+	}
+}
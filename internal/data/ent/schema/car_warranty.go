@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// CarWarranty holds the schema definition for the CarWarranty entity.
+// 汽车的质保实例，生成时复制条款内容，之后修改条款不影响已生成的质保
+type CarWarranty struct {
+	ent.Schema
+}
+
+func (CarWarranty) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "car_warranty"},
+	}
+}
+
+func (CarWarranty) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the CarWarranty.
+func (CarWarranty) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("definition_id").
+			Optional(),
+		field.String("name").
+			Optional(),
+		field.Text("components").
+			Optional(),
+		field.Time("start_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("end_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Int64("mileage_limit").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the CarWarranty.
+func (CarWarranty) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("warranties").
+			Field("car_id").
+			Unique(),
+		edge.From("definition", WarrantyDefinition.Type).
+			Ref("car_warranties").
+			Field("definition_id").
+			Unique(),
+	}
+}
+
+// Indexes of the CarWarranty.
+func (CarWarranty) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "definition_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// ChargingSession holds the schema definition for the ChargingSession entity.
+type ChargingSession struct {
+	ent.Schema
+}
+
+func (ChargingSession) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "charging_session"},
+	}
+}
+
+func (ChargingSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the ChargingSession.
+func (ChargingSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Time("started_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("ended_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Float("energy").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(8,2)"}),
+		// ac、dc
+		field.String("charger_type").
+			Optional(),
+		field.Float("cost").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(10,2)"}),
+		field.String("station").
+			Optional(),
+		// 充电前后的电量百分比，用于折算电池实际容量
+		field.Float("start_soc").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(5,2)"}),
+		field.Float("end_soc").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(5,2)"}),
+		// 充电结束时电池管理系统上报的健康度，0表示未上报
+		field.Float("soh").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(5,2)"}),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the ChargingSession.
+func (ChargingSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("charging_sessions").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the ChargingSession.
+func (ChargingSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "ended_at"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Fleet holds the schema definition for the Fleet entity.
+type Fleet struct {
+	ent.Schema
+}
+
+func (Fleet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "fleet"},
+	}
+}
+
+func (Fleet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Fleet.
+func (Fleet) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("name"),
+		field.String("description").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Fleet.
+func (Fleet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("cars", Car.Type),
+	}
+}
+
+// Indexes of the Fleet.
+func (Fleet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Geofence holds the schema definition for the Geofence entity.
+type Geofence struct {
+	ent.Schema
+}
+
+func (Geofence) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "geofence"},
+	}
+}
+
+func (Geofence) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Geofence.
+func (Geofence) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("name"),
+		// 多边形顶点，JSON数组[{"lat":..,"lng":..}]
+		field.Text("polygon").
+			Optional(),
+		// 生效的星期，JSON数组，0表示周日，为空表示每天
+		field.String("days").
+			Optional(),
+		// 每天生效的时段，格式HH:MM，为空表示全天，开始晚于结束表示跨零点
+		field.String("start_time").
+			Optional(),
+		field.String("end_time").
+			Optional(),
+		// 生效时段所在的IANA时区，为空表示服务器时区
+		field.String("time_zone").
+			Optional(),
+		// enter、exit、both
+		field.String("alert_on").
+			Default("both"),
+		field.Bool("enabled").
+			Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("updated_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Geofence.
+func (Geofence) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("cars", Car.Type),
+	}
+}
+
+// Indexes of the Geofence.
+func (Geofence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+	}
+}
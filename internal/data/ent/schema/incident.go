@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Incident holds the schema definition for the Incident entity.
+type Incident struct {
+	ent.Schema
+}
+
+func (Incident) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "incident"},
+	}
+}
+
+func (Incident) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Incident.
+func (Incident) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("reporter_id").
+			Optional(),
+		field.Time("occurred_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.String("location").
+			Optional(),
+		// minor、moderate、severe、total_loss
+		field.String("severity").
+			Optional(),
+		field.Text("description").
+			Optional(),
+		// 现场照片的附件ID，JSON数组
+		field.Text("photo_ids").
+			Optional(),
+		// 第三方信息，JSON数组
+		field.Text("third_parties").
+			Optional(),
+		field.String("status").
+			Default("reported"),
+		field.Int64("policy_id").
+			Optional(),
+		field.String("claim_no").
+			Optional(),
+		field.Float("claim_amount").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(12,2)"}),
+		field.Float("settled_amount").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(12,2)"}),
+		field.Time("settled_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("updated_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Incident.
+func (Incident) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("incidents").
+			Field("car_id").
+			Unique(),
+		edge.From("policy", InsurancePolicy.Type).
+			Ref("incidents").
+			Field("policy_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Incident.
+func (Incident) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "occurred_at"),
+		index.Fields("status"),
+	}
+}
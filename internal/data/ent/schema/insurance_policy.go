@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InsurancePolicy holds the schema definition for the InsurancePolicy entity.
+type InsurancePolicy struct {
+	ent.Schema
+}
+
+func (InsurancePolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "insurance_policy"},
+	}
+}
+
+func (InsurancePolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the InsurancePolicy.
+func (InsurancePolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.String("provider").
+			Optional(),
+		field.String("policy_number").
+			Optional(),
+		field.String("coverage").
+			Optional(),
+		field.Time("start_date").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("end_date").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Float("premium").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(10,2)"}),
+		field.Time("reminded_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the InsurancePolicy.
+func (InsurancePolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("insurance_policies").
+			Field("car_id").
+			Unique(),
+		edge.To("incidents", Incident.Type),
+	}
+}
+
+// Indexes of the InsurancePolicy.
+func (InsurancePolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id"),
+		index.Fields("end_date"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// JobRun holds the schema definition for the JobRun entity.
+type JobRun struct {
+	ent.Schema
+}
+
+func (JobRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "job_run"},
+	}
+}
+
+// Fields of the JobRun.
+func (JobRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("name"),
+		// 按cron表达式计划的执行时间
+		field.Time("scheduled_at").
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("started_at").
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("finished_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		// running、succeeded、failed
+		field.String("status").
+			Default("running"),
+		field.Text("error").
+			Optional(),
+		// 执行任务的实例
+		field.String("instance").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the JobRun.
+func (JobRun) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the JobRun.
+func (JobRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "scheduled_at"),
+		index.Fields("started_at"),
+	}
+}
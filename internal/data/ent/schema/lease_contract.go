@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// LeaseContract holds the schema definition for the LeaseContract entity.
+type LeaseContract struct {
+	ent.Schema
+}
+
+func (LeaseContract) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "lease_contract"},
+	}
+}
+
+func (LeaseContract) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the LeaseContract.
+func (LeaseContract) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.String("lessor").
+			Optional(),
+		field.String("contract_no").
+			Optional(),
+		field.Int64("lessee_id").
+			Optional(),
+		field.Time("start_date").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("end_date").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Float("monthly_payment").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(12,2)"}),
+		// 每年允许行驶的里程，0表示不限
+		field.Int64("mileage_allowance").
+			Optional(),
+		// 超出里程每公里的费用
+		field.Float("excess_rate").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(10,2)"}),
+		// 起租时的里程，为空时取里程读数
+		field.Int64("start_mileage").
+			Optional().
+			Nillable(),
+		// 租期内是否允许过户
+		field.Bool("transfer_allowed").
+			Default(false),
+		field.String("status").
+			Default("active"),
+		field.Time("terminated_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the LeaseContract.
+func (LeaseContract) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("lease_contracts").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the LeaseContract.
+func (LeaseContract) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "status", "end_date"),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Listing holds the schema definition for the Listing entity.
+type Listing struct {
+	ent.Schema
+}
+
+func (Listing) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "listing"},
+	}
+}
+
+func (Listing) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Listing.
+func (Listing) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("seller_id").
+			Optional(),
+		field.Float("price").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(12,2)"}),
+		field.Text("description").
+			Optional(),
+		field.String("status").
+			Default("active"),
+		field.Time("expires_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Int64("buyer_id").
+			Optional(),
+		field.Time("closed_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Listing.
+func (Listing) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("listings").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Listing.
+func (Listing) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "expires_at"),
+		index.Fields("car_id"),
+		index.Fields("seller_id"),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// MaintenanceRecord holds the schema definition for the MaintenanceRecord entity.
+type MaintenanceRecord struct {
+	ent.Schema
+}
+
+func (MaintenanceRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "maintenance_record"},
+	}
+}
+
+func (MaintenanceRecord) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the MaintenanceRecord.
+func (MaintenanceRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Time("serviced_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Int64("mileage").
+			Optional(),
+		field.String("type").
+			Optional(),
+		field.Float("cost").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(10,2)"}),
+		field.String("shop").
+			Optional(),
+		field.Text("notes").
+			Optional(),
+	}
+}
+
+// Edges of the MaintenanceRecord.
+func (MaintenanceRecord) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("maintenance_records").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the MaintenanceRecord.
+func (MaintenanceRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "serviced_at"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// OdometerReading holds the schema definition for the OdometerReading entity.
+type OdometerReading struct {
+	ent.Schema
+}
+
+func (OdometerReading) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "odometer_reading"},
+	}
+}
+
+func (OdometerReading) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the OdometerReading.
+func (OdometerReading) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("mileage").
+			Optional(),
+		field.String("source").
+			Optional(),
+		field.Bool("suspicious").
+			Default(false),
+		field.Time("recorded_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the OdometerReading.
+func (OdometerReading) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("odometer_readings").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the OdometerReading.
+func (OdometerReading) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "recorded_at"),
+	}
+}
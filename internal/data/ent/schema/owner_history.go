@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// OwnerHistory holds the schema definition for the OwnerHistory entity.
+// 汽车每一任车主的持有区间，ended_at为空表示当前车主
+type OwnerHistory struct {
+	ent.Schema
+}
+
+func (OwnerHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "owner_history"},
+	}
+}
+
+func (OwnerHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the OwnerHistory.
+func (OwnerHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("user_id").
+			Optional(),
+		field.Time("started_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("ended_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the OwnerHistory.
+func (OwnerHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("owner_histories").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the OwnerHistory.
+func (OwnerHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "started_at"),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Recall holds the schema definition for the Recall entity.
+// 召回活动由厂商发布，不区分租户
+type Recall struct {
+	ent.Schema
+}
+
+func (Recall) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "recall"},
+	}
+}
+
+// Fields of the Recall.
+func (Recall) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		// 厂商召回编号
+		field.String("campaign_no"),
+		field.String("manufacturer").
+			Optional(),
+		// 匹配车型，model_id为空时按车型名称匹配
+		field.Int64("model_id").
+			Optional(),
+		field.String("model").
+			Optional(),
+		// 年款范围，按汽车登记年份匹配，为0表示不限
+		field.Int("year_from").
+			Optional(),
+		field.Int("year_to").
+			Optional(),
+		// VIN范围，为空表示不限
+		field.String("vin_from").
+			Optional(),
+		field.String("vin_to").
+			Optional(),
+		field.String("component").
+			Optional(),
+		field.Text("description").
+			Optional(),
+		field.Text("remedy").
+			Optional(),
+		field.Time("issued_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Recall.
+func (Recall) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("car_recalls", CarRecall.Type),
+	}
+}
+
+// Indexes of the Recall.
+func (Recall) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("campaign_no").
+			Unique(),
+		index.Fields("model_id"),
+	}
+}
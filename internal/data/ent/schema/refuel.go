@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Refuel holds the schema definition for the Refuel entity.
+type Refuel struct {
+	ent.Schema
+}
+
+func (Refuel) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "refuel"},
+	}
+}
+
+func (Refuel) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Refuel.
+func (Refuel) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		// 加油升数或充电度数
+		field.Float("amount").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(10,2)"}),
+		// L或kWh
+		field.String("unit").
+			Optional(),
+		field.Float("cost").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(10,2)"}),
+		field.String("station").
+			Optional(),
+		field.Int64("odometer").
+			Optional(),
+		field.Time("refueled_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Refuel.
+func (Refuel) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("refuels").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Refuel.
+func (Refuel) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "refueled_at"),
+	}
+}
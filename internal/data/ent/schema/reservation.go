@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Reservation holds the schema definition for the Reservation entity.
+type Reservation struct {
+	ent.Schema
+}
+
+func (Reservation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "reservation"},
+	}
+}
+
+func (Reservation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Reservation.
+func (Reservation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("user_id").
+			Optional(),
+		field.Time("start_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("end_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.String("purpose").
+			Optional(),
+		field.String("status").
+			Default("booked"),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Reservation.
+func (Reservation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("reservations").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Reservation.
+func (Reservation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "start_at", "end_at"),
+		index.Fields("user_id"),
+	}
+}
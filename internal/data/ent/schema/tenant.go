@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"car-service/internal/data/ent/privacy"
+	"car-service/internal/pkg/auth"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"entgo.io/ent"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TenantMixin 按租户隔离数据，查询和变更均限定在请求所属租户内，管理员不受限制
+type TenantMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TenantMixin.
+func (TenantMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("tenant_id").
+			Optional(),
+	}
+}
+
+// Indexes of the TenantMixin.
+func (TenantMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+	}
+}
+
+// Hooks of the TenantMixin.
+func (TenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		setTenantHook,
+	}
+}
+
+// Policy of the TenantMixin.
+func (TenantMixin) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			allowIfAdmin(),
+			filterTenantRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			allowIfAdmin(),
+			denyMismatchedTenantRule(),
+			filterTenantRule(),
+		},
+	}
+}
+
+type tenantMutation interface {
+	TenantID() (int64, bool)
+	SetTenantID(int64)
+}
+
+// setTenantHook 新建时未指定租户则取请求所属租户
+func setTenantHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		tm, ok := m.(tenantMutation)
+		if !ok || !m.Op().Is(ent.OpCreate) {
+			return next.Mutate(ctx, m)
+		}
+		if _, ok := tm.TenantID(); !ok {
+			tid, ok := auth.GetTenantId(ctx)
+			if !ok {
+				return nil, ex.TenantRequired
+			}
+			tm.SetTenantID(tid)
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+func allowIfAdmin() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if auth.IsAdmin(ctx) {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// filterTenantRule 为查询、更新和删除追加租户条件
+func filterTenantRule() privacy.QueryMutationRule {
+	type tenantFilter interface {
+		WhereTenantID(entql.Int64P)
+	}
+	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		tid, ok := auth.GetTenantId(ctx)
+		if !ok {
+			return ex.TenantRequired
+		}
+		tf, ok := f.(tenantFilter)
+		if !ok {
+			return privacy.Denyf("unexpected filter type %T", f)
+		}
+		tf.WhereTenantID(entql.Int64EQ(tid))
+		return privacy.Skip
+	})
+}
+
+// denyMismatchedTenantRule 禁止写入其他租户或变更所属租户
+func denyMismatchedTenantRule() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		tm, ok := m.(tenantMutation)
+		if !ok {
+			return privacy.Skip
+		}
+		if tid, ok := tm.TenantID(); ok {
+			if cur, ok := auth.GetTenantId(ctx); !ok || cur != tid {
+				return ex.TenantMismatch
+			}
+		}
+		return privacy.Skip
+	})
+}
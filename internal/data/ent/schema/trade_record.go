@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// TradeRecord holds the schema definition for the TradeRecord entity.
+// 每次交易的成交价及当时的估值，成交价作为同车型估值的参考
+type TradeRecord struct {
+	ent.Schema
+}
+
+func (TradeRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "trade_record"},
+	}
+}
+
+func (TradeRecord) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the TradeRecord.
+func (TradeRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("model_id").
+			Optional(),
+		field.Int64("seller_id").
+			Optional(),
+		field.Int64("buyer_id").
+			Optional(),
+		// 成交价，未提供时为0，不作为参考
+		field.Float("price").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(12,2)"}),
+		field.Float("estimated_value").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(12,2)"}),
+		field.Int64("mileage").
+			Optional(),
+		field.Int("age_months").
+			Optional(),
+		field.Time("traded_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the TradeRecord.
+func (TradeRecord) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("trade_records").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the TradeRecord.
+func (TradeRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("model_id", "traded_at"),
+		index.Fields("car_id"),
+	}
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Transfer holds the schema definition for the Transfer entity.
+type Transfer struct {
+	ent.Schema
+}
+
+func (Transfer) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "transfer"},
+	}
+}
+
+func (Transfer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Transfer.
+func (Transfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("seller_id").
+			Optional(),
+		field.Int64("buyer_id").
+			Optional(),
+		field.String("status").
+			Default("pending"),
+		field.Bool("require_approval").
+			Default(false),
+		field.Int64("approved_by").
+			Optional(),
+		field.Time("expires_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("accepted_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Transfer.
+func (Transfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("transfers").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Transfer.
+func (Transfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "status"),
+		index.Fields("status", "expires_at"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Trip holds the schema definition for the Trip entity.
+type Trip struct {
+	ent.Schema
+}
+
+func (Trip) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "trip"},
+	}
+}
+
+func (Trip) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Trip.
+func (Trip) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		field.Int64("driver_id").
+			Optional(),
+		field.String("purpose").
+			Optional(),
+		field.Int64("start_odometer").
+			Optional(),
+		field.Int64("end_odometer").
+			Optional(),
+		field.Time("started_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("ended_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Trip.
+func (Trip) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("trips").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Trip.
+func (Trip) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "ended_at"),
+		index.Fields("driver_id"),
+	}
+}
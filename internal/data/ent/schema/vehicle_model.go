@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VehicleModel holds the schema definition for the VehicleModel entity.
+type VehicleModel struct {
+	ent.Schema
+}
+
+func (VehicleModel) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vehicle_model"},
+	}
+}
+
+// Fields of the VehicleModel.
+func (VehicleModel) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("brand_id").
+			Optional(),
+		field.String("name").
+			Optional(),
+		field.String("fuel_type").
+			Optional(),
+		field.Int32("seats").
+			Optional(),
+		field.String("body_type").
+			Optional(),
+	}
+}
+
+// Edges of the VehicleModel.
+func (VehicleModel) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("brand", Brand.Type).
+			Ref("vehicle_models").
+			Field("brand_id").
+			Unique(),
+		edge.To("cars", Car.Type),
+	}
+}
+
+// Indexes of the VehicleModel.
+func (VehicleModel) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("brand_id", "name").
+			Unique(),
+	}
+}
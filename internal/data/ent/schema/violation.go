@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Violation holds the schema definition for the Violation entity.
+type Violation struct {
+	ent.Schema
+}
+
+func (Violation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "violation"},
+	}
+}
+
+func (Violation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Violation.
+func (Violation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("car_id").
+			Optional(),
+		// 违章发生时的车主
+		field.Int64("user_id").
+			Optional(),
+		// 交管部门的违章编号，用于导入去重
+		field.String("reference_no").
+			Optional(),
+		field.Time("occurred_at").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		field.String("location").
+			Optional(),
+		field.Float("amount").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "decimal(10,2)"}),
+		field.String("description").
+			Optional(),
+		field.String("status").
+			Default("unpaid"),
+		field.Time("resolved_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+		// 最后一次变更处理状态的操作人
+		field.Int64("resolved_by").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the Violation.
+func (Violation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("car", Car.Type).
+			Ref("violations").
+			Field("car_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Violation.
+func (Violation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "reference_no").
+			Unique(),
+		index.Fields("car_id", "occurred_at"),
+		index.Fields("user_id", "status"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// WarrantyDefinition holds the schema definition for the WarrantyDefinition entity.
+// 车型的质保条款，与车型目录一样不区分租户
+type WarrantyDefinition struct {
+	ent.Schema
+}
+
+func (WarrantyDefinition) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "warranty_definition"},
+	}
+}
+
+// Fields of the WarrantyDefinition.
+func (WarrantyDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("model_id").
+			Optional(),
+		field.String("name"),
+		// 覆盖的部件，JSON数组
+		field.Text("components").
+			Optional(),
+		field.Int("duration_months").
+			Optional(),
+		// 里程上限，0表示不限
+		field.Int64("mileage_limit").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.MySQL: "datetime"}),
+	}
+}
+
+// Edges of the WarrantyDefinition.
+func (WarrantyDefinition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("car_warranties", CarWarranty.Type),
+	}
+}
+
+// Indexes of the WarrantyDefinition.
+func (WarrantyDefinition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("model_id"),
+	}
+}
@@ -0,0 +1,61 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tag
+
+import (
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the tag type in the database.
+	Label = "tag"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeCars holds the string denoting the cars edge name in mutations.
+	EdgeCars = "cars"
+	// Table holds the table name of the tag in the database.
+	Table = "tag"
+	// CarsTable is the table that holds the cars relation/edge. The primary key declared below.
+	CarsTable = "tag_cars"
+	// CarsInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarsInverseTable = "car"
+)
+
+// Columns holds all SQL columns for tag fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldName,
+}
+
+var (
+	// CarsPrimaryKey and CarsColumn2 are the table columns denoting the
+	// primary key for the cars relation (M2M).
+	CarsPrimaryKey = []string{"tag_id", "car_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+)
@@ -4,22 +4,1206 @@ package ent
 
 import "car-service/internal/biz"
 
+func (au *AttachmentUpdate) SetAttachment(input *biz.Attachment) *AttachmentUpdate {
+
+	au.SetNillableTenantID(input.TenantID)
+
+	au.SetNillableCarID(input.CarID)
+
+	au.SetNillableKind(input.Kind)
+
+	au.SetNillableFileName(input.FileName)
+
+	au.SetNillableContentType(input.ContentType)
+
+	au.SetNillableSize(input.Size)
+
+	au.SetNillableChecksum(input.Checksum)
+
+	au.SetNillableStorageKey(input.StorageKey)
+
+	au.SetNillableCreatedAt(input.CreatedAt)
+	return au
+}
+
+func (ac *AttachmentCreate) SetAttachment(input *biz.Attachment) *AttachmentCreate {
+
+	ac.SetNillableTenantID(input.TenantID)
+
+	ac.SetNillableCarID(input.CarID)
+
+	ac.SetNillableKind(input.Kind)
+
+	ac.SetNillableFileName(input.FileName)
+
+	ac.SetNillableContentType(input.ContentType)
+
+	ac.SetNillableSize(input.Size)
+
+	ac.SetNillableChecksum(input.Checksum)
+
+	ac.SetNillableStorageKey(input.StorageKey)
+
+	ac.SetNillableCreatedAt(input.CreatedAt)
+	return ac
+}
+
+func (adu *AttributeDefinitionUpdate) SetAttributeDefinition(input *biz.AttributeDefinition) *AttributeDefinitionUpdate {
+
+	adu.SetNillableTenantID(input.TenantID)
+
+	adu.SetName(input.Name)
+
+	adu.SetNillableValueType(input.ValueType)
+
+	adu.SetNillableRequired(input.Required)
+
+	adu.SetNillableEnumValues(input.EnumValues)
+
+	adu.SetNillableCreatedAt(input.CreatedAt)
+	return adu
+}
+
+func (adc *AttributeDefinitionCreate) SetAttributeDefinition(input *biz.AttributeDefinition) *AttributeDefinitionCreate {
+
+	adc.SetNillableTenantID(input.TenantID)
+
+	adc.SetName(input.Name)
+
+	adc.SetNillableValueType(input.ValueType)
+
+	adc.SetNillableRequired(input.Required)
+
+	adc.SetNillableEnumValues(input.EnumValues)
+
+	adc.SetNillableCreatedAt(input.CreatedAt)
+	return adc
+}
+
+func (alu *AuditLogUpdate) SetAuditLog(input *biz.AuditLog) *AuditLogUpdate {
+
+	alu.SetNillableTenantID(input.TenantID)
+
+	alu.SetCarID(input.CarID)
+
+	alu.SetOp(input.Op)
+
+	alu.SetNillableActor(input.Actor)
+
+	alu.SetNillableDiff(input.Diff)
+
+	alu.SetNillableTraceID(input.TraceID)
+
+	alu.SetNillableCreatedAt(input.CreatedAt)
+	return alu
+}
+
+func (alc *AuditLogCreate) SetAuditLog(input *biz.AuditLog) *AuditLogCreate {
+
+	alc.SetNillableTenantID(input.TenantID)
+
+	alc.SetCarID(input.CarID)
+
+	alc.SetOp(input.Op)
+
+	alc.SetNillableActor(input.Actor)
+
+	alc.SetNillableDiff(input.Diff)
+
+	alc.SetNillableTraceID(input.TraceID)
+
+	alc.SetNillableCreatedAt(input.CreatedAt)
+	return alc
+}
+
+func (bu *BrandUpdate) SetBrand(input *biz.Brand) *BrandUpdate {
+
+	bu.SetName(input.Name)
+	return bu
+}
+
+func (bc *BrandCreate) SetBrand(input *biz.Brand) *BrandCreate {
+
+	bc.SetName(input.Name)
+	return bc
+}
+
 func (cu *CarUpdate) SetCar(input *biz.Car) *CarUpdate {
 
+	cu.SetNillableTenantID(input.TenantID)
+
 	cu.SetNillableUserID(input.UserID)
 
 	cu.SetNillableModel(input.Model)
 
+	cu.SetNillableModelID(input.ModelID)
+
+	cu.SetNillableVin(input.Vin)
+
+	cu.SetNillablePlate(input.Plate)
+
+	cu.SetNillableOwnerName(input.OwnerName)
+
 	cu.SetNillableRegisteredAt(input.RegisteredAt)
+
+	cu.SetNillableBatteryCapacity(input.BatteryCapacity)
+
+	cu.SetNillableBatterySoh(input.BatterySoh)
 	return cu
 }
 
 func (cc *CarCreate) SetCar(input *biz.Car) *CarCreate {
 
+	cc.SetNillableTenantID(input.TenantID)
+
 	cc.SetNillableUserID(input.UserID)
 
 	cc.SetNillableModel(input.Model)
 
+	cc.SetNillableModelID(input.ModelID)
+
+	cc.SetNillableVin(input.Vin)
+
+	cc.SetNillablePlate(input.Plate)
+
+	cc.SetNillableOwnerName(input.OwnerName)
+
 	cc.SetNillableRegisteredAt(input.RegisteredAt)
+
+	cc.SetNillableBatteryCapacity(input.BatteryCapacity)
+
+	cc.SetNillableBatterySoh(input.BatterySoh)
 	return cc
 }
+
+func (cau *CarAttributeUpdate) SetCarAttribute(input *biz.CarAttribute) *CarAttributeUpdate {
+
+	cau.SetNillableCarID(input.CarID)
+
+	cau.SetNillableDefinitionID(input.DefinitionID)
+
+	cau.SetValue(input.Value)
+	return cau
+}
+
+func (cac *CarAttributeCreate) SetCarAttribute(input *biz.CarAttribute) *CarAttributeCreate {
+
+	cac.SetNillableCarID(input.CarID)
+
+	cac.SetNillableDefinitionID(input.DefinitionID)
+
+	cac.SetValue(input.Value)
+	return cac
+}
+
+func (cru *CarRecallUpdate) SetCarRecall(input *biz.CarRecall) *CarRecallUpdate {
+
+	cru.SetNillableTenantID(input.TenantID)
+
+	cru.SetNillableCarID(input.CarID)
+
+	cru.SetNillableRecallID(input.RecallID)
+
+	cru.SetNillableStatus(input.Status)
+
+	cru.SetNillableNotes(input.Notes)
+
+	cru.SetNillableRemediedAt(input.RemediedAt)
+
+	cru.SetNillableCreatedAt(input.CreatedAt)
+
+	cru.SetNillableUpdatedAt(input.UpdatedAt)
+	return cru
+}
+
+func (crc *CarRecallCreate) SetCarRecall(input *biz.CarRecall) *CarRecallCreate {
+
+	crc.SetNillableTenantID(input.TenantID)
+
+	crc.SetNillableCarID(input.CarID)
+
+	crc.SetNillableRecallID(input.RecallID)
+
+	crc.SetNillableStatus(input.Status)
+
+	crc.SetNillableNotes(input.Notes)
+
+	crc.SetNillableRemediedAt(input.RemediedAt)
+
+	crc.SetNillableCreatedAt(input.CreatedAt)
+
+	crc.SetNillableUpdatedAt(input.UpdatedAt)
+	return crc
+}
+
+func (cwu *CarWarrantyUpdate) SetCarWarranty(input *biz.CarWarranty) *CarWarrantyUpdate {
+
+	cwu.SetNillableTenantID(input.TenantID)
+
+	cwu.SetNillableCarID(input.CarID)
+
+	cwu.SetNillableDefinitionID(input.DefinitionID)
+
+	cwu.SetNillableName(input.Name)
+
+	cwu.SetNillableComponents(input.Components)
+
+	cwu.SetNillableStartAt(input.StartAt)
+
+	cwu.SetNillableEndAt(input.EndAt)
+
+	cwu.SetNillableMileageLimit(input.MileageLimit)
+
+	cwu.SetNillableCreatedAt(input.CreatedAt)
+	return cwu
+}
+
+func (cwc *CarWarrantyCreate) SetCarWarranty(input *biz.CarWarranty) *CarWarrantyCreate {
+
+	cwc.SetNillableTenantID(input.TenantID)
+
+	cwc.SetNillableCarID(input.CarID)
+
+	cwc.SetNillableDefinitionID(input.DefinitionID)
+
+	cwc.SetNillableName(input.Name)
+
+	cwc.SetNillableComponents(input.Components)
+
+	cwc.SetNillableStartAt(input.StartAt)
+
+	cwc.SetNillableEndAt(input.EndAt)
+
+	cwc.SetNillableMileageLimit(input.MileageLimit)
+
+	cwc.SetNillableCreatedAt(input.CreatedAt)
+	return cwc
+}
+
+func (csu *ChargingSessionUpdate) SetChargingSession(input *biz.ChargingSession) *ChargingSessionUpdate {
+
+	csu.SetNillableTenantID(input.TenantID)
+
+	csu.SetNillableCarID(input.CarID)
+
+	csu.SetNillableStartedAt(input.StartedAt)
+
+	csu.SetNillableEndedAt(input.EndedAt)
+
+	csu.SetNillableEnergy(input.Energy)
+
+	csu.SetNillableChargerType(input.ChargerType)
+
+	csu.SetNillableCost(input.Cost)
+
+	csu.SetNillableStation(input.Station)
+
+	csu.SetNillableStartSoc(input.StartSoc)
+
+	csu.SetNillableEndSoc(input.EndSoc)
+
+	csu.SetNillableSoh(input.Soh)
+
+	csu.SetNillableCreatedAt(input.CreatedAt)
+	return csu
+}
+
+func (csc *ChargingSessionCreate) SetChargingSession(input *biz.ChargingSession) *ChargingSessionCreate {
+
+	csc.SetNillableTenantID(input.TenantID)
+
+	csc.SetNillableCarID(input.CarID)
+
+	csc.SetNillableStartedAt(input.StartedAt)
+
+	csc.SetNillableEndedAt(input.EndedAt)
+
+	csc.SetNillableEnergy(input.Energy)
+
+	csc.SetNillableChargerType(input.ChargerType)
+
+	csc.SetNillableCost(input.Cost)
+
+	csc.SetNillableStation(input.Station)
+
+	csc.SetNillableStartSoc(input.StartSoc)
+
+	csc.SetNillableEndSoc(input.EndSoc)
+
+	csc.SetNillableSoh(input.Soh)
+
+	csc.SetNillableCreatedAt(input.CreatedAt)
+	return csc
+}
+
+func (fu *FleetUpdate) SetFleet(input *biz.Fleet) *FleetUpdate {
+
+	fu.SetNillableTenantID(input.TenantID)
+
+	fu.SetName(input.Name)
+
+	fu.SetNillableDescription(input.Description)
+
+	fu.SetNillableCreatedAt(input.CreatedAt)
+	return fu
+}
+
+func (fc *FleetCreate) SetFleet(input *biz.Fleet) *FleetCreate {
+
+	fc.SetNillableTenantID(input.TenantID)
+
+	fc.SetName(input.Name)
+
+	fc.SetNillableDescription(input.Description)
+
+	fc.SetNillableCreatedAt(input.CreatedAt)
+	return fc
+}
+
+func (gu *GeofenceUpdate) SetGeofence(input *biz.Geofence) *GeofenceUpdate {
+
+	gu.SetNillableTenantID(input.TenantID)
+
+	gu.SetName(input.Name)
+
+	gu.SetNillablePolygon(input.Polygon)
+
+	gu.SetNillableDays(input.Days)
+
+	gu.SetNillableStartTime(input.StartTime)
+
+	gu.SetNillableEndTime(input.EndTime)
+
+	gu.SetNillableTimeZone(input.TimeZone)
+
+	gu.SetNillableAlertOn(input.AlertOn)
+
+	gu.SetNillableEnabled(input.Enabled)
+
+	gu.SetNillableCreatedAt(input.CreatedAt)
+
+	gu.SetNillableUpdatedAt(input.UpdatedAt)
+	return gu
+}
+
+func (gc *GeofenceCreate) SetGeofence(input *biz.Geofence) *GeofenceCreate {
+
+	gc.SetNillableTenantID(input.TenantID)
+
+	gc.SetName(input.Name)
+
+	gc.SetNillablePolygon(input.Polygon)
+
+	gc.SetNillableDays(input.Days)
+
+	gc.SetNillableStartTime(input.StartTime)
+
+	gc.SetNillableEndTime(input.EndTime)
+
+	gc.SetNillableTimeZone(input.TimeZone)
+
+	gc.SetNillableAlertOn(input.AlertOn)
+
+	gc.SetNillableEnabled(input.Enabled)
+
+	gc.SetNillableCreatedAt(input.CreatedAt)
+
+	gc.SetNillableUpdatedAt(input.UpdatedAt)
+	return gc
+}
+
+func (iu *IncidentUpdate) SetIncident(input *biz.Incident) *IncidentUpdate {
+
+	iu.SetNillableTenantID(input.TenantID)
+
+	iu.SetNillableCarID(input.CarID)
+
+	iu.SetNillableReporterID(input.ReporterID)
+
+	iu.SetNillableOccurredAt(input.OccurredAt)
+
+	iu.SetNillableLocation(input.Location)
+
+	iu.SetNillableSeverity(input.Severity)
+
+	iu.SetNillableDescription(input.Description)
+
+	iu.SetNillablePhotoIds(input.PhotoIds)
+
+	iu.SetNillableThirdParties(input.ThirdParties)
+
+	iu.SetNillableStatus(input.Status)
+
+	iu.SetNillablePolicyID(input.PolicyID)
+
+	iu.SetNillableClaimNo(input.ClaimNo)
+
+	iu.SetNillableClaimAmount(input.ClaimAmount)
+
+	iu.SetNillableSettledAmount(input.SettledAmount)
+
+	iu.SetNillableSettledAt(input.SettledAt)
+
+	iu.SetNillableCreatedAt(input.CreatedAt)
+
+	iu.SetNillableUpdatedAt(input.UpdatedAt)
+	return iu
+}
+
+func (ic *IncidentCreate) SetIncident(input *biz.Incident) *IncidentCreate {
+
+	ic.SetNillableTenantID(input.TenantID)
+
+	ic.SetNillableCarID(input.CarID)
+
+	ic.SetNillableReporterID(input.ReporterID)
+
+	ic.SetNillableOccurredAt(input.OccurredAt)
+
+	ic.SetNillableLocation(input.Location)
+
+	ic.SetNillableSeverity(input.Severity)
+
+	ic.SetNillableDescription(input.Description)
+
+	ic.SetNillablePhotoIds(input.PhotoIds)
+
+	ic.SetNillableThirdParties(input.ThirdParties)
+
+	ic.SetNillableStatus(input.Status)
+
+	ic.SetNillablePolicyID(input.PolicyID)
+
+	ic.SetNillableClaimNo(input.ClaimNo)
+
+	ic.SetNillableClaimAmount(input.ClaimAmount)
+
+	ic.SetNillableSettledAmount(input.SettledAmount)
+
+	ic.SetNillableSettledAt(input.SettledAt)
+
+	ic.SetNillableCreatedAt(input.CreatedAt)
+
+	ic.SetNillableUpdatedAt(input.UpdatedAt)
+	return ic
+}
+
+func (ipu *InsurancePolicyUpdate) SetInsurancePolicy(input *biz.InsurancePolicy) *InsurancePolicyUpdate {
+
+	ipu.SetNillableTenantID(input.TenantID)
+
+	ipu.SetNillableCarID(input.CarID)
+
+	ipu.SetNillableProvider(input.Provider)
+
+	ipu.SetNillablePolicyNumber(input.PolicyNumber)
+
+	ipu.SetNillableCoverage(input.Coverage)
+
+	ipu.SetNillableStartDate(input.StartDate)
+
+	ipu.SetNillableEndDate(input.EndDate)
+
+	ipu.SetNillablePremium(input.Premium)
+
+	ipu.SetNillableRemindedAt(input.RemindedAt)
+	return ipu
+}
+
+func (ipc *InsurancePolicyCreate) SetInsurancePolicy(input *biz.InsurancePolicy) *InsurancePolicyCreate {
+
+	ipc.SetNillableTenantID(input.TenantID)
+
+	ipc.SetNillableCarID(input.CarID)
+
+	ipc.SetNillableProvider(input.Provider)
+
+	ipc.SetNillablePolicyNumber(input.PolicyNumber)
+
+	ipc.SetNillableCoverage(input.Coverage)
+
+	ipc.SetNillableStartDate(input.StartDate)
+
+	ipc.SetNillableEndDate(input.EndDate)
+
+	ipc.SetNillablePremium(input.Premium)
+
+	ipc.SetNillableRemindedAt(input.RemindedAt)
+	return ipc
+}
+
+func (jru *JobRunUpdate) SetJobRun(input *biz.JobRun) *JobRunUpdate {
+
+	jru.SetName(input.Name)
+
+	jru.SetScheduledAt(input.ScheduledAt)
+
+	jru.SetStartedAt(input.StartedAt)
+
+	jru.SetNillableFinishedAt(input.FinishedAt)
+
+	jru.SetNillableStatus(input.Status)
+
+	jru.SetNillableError(input.Error)
+
+	jru.SetNillableInstance(input.Instance)
+
+	jru.SetNillableCreatedAt(input.CreatedAt)
+	return jru
+}
+
+func (jrc *JobRunCreate) SetJobRun(input *biz.JobRun) *JobRunCreate {
+
+	jrc.SetName(input.Name)
+
+	jrc.SetScheduledAt(input.ScheduledAt)
+
+	jrc.SetStartedAt(input.StartedAt)
+
+	jrc.SetNillableFinishedAt(input.FinishedAt)
+
+	jrc.SetNillableStatus(input.Status)
+
+	jrc.SetNillableError(input.Error)
+
+	jrc.SetNillableInstance(input.Instance)
+
+	jrc.SetNillableCreatedAt(input.CreatedAt)
+	return jrc
+}
+
+func (lcu *LeaseContractUpdate) SetLeaseContract(input *biz.LeaseContract) *LeaseContractUpdate {
+
+	lcu.SetNillableTenantID(input.TenantID)
+
+	lcu.SetNillableCarID(input.CarID)
+
+	lcu.SetNillableLessor(input.Lessor)
+
+	lcu.SetNillableContractNo(input.ContractNo)
+
+	lcu.SetNillableLesseeID(input.LesseeID)
+
+	lcu.SetNillableStartDate(input.StartDate)
+
+	lcu.SetNillableEndDate(input.EndDate)
+
+	lcu.SetNillableMonthlyPayment(input.MonthlyPayment)
+
+	lcu.SetNillableMileageAllowance(input.MileageAllowance)
+
+	lcu.SetNillableExcessRate(input.ExcessRate)
+
+	lcu.SetNillableStartMileage(input.StartMileage)
+
+	lcu.SetNillableTransferAllowed(input.TransferAllowed)
+
+	lcu.SetNillableStatus(input.Status)
+
+	lcu.SetNillableTerminatedAt(input.TerminatedAt)
+
+	lcu.SetNillableCreatedAt(input.CreatedAt)
+	return lcu
+}
+
+func (lcc *LeaseContractCreate) SetLeaseContract(input *biz.LeaseContract) *LeaseContractCreate {
+
+	lcc.SetNillableTenantID(input.TenantID)
+
+	lcc.SetNillableCarID(input.CarID)
+
+	lcc.SetNillableLessor(input.Lessor)
+
+	lcc.SetNillableContractNo(input.ContractNo)
+
+	lcc.SetNillableLesseeID(input.LesseeID)
+
+	lcc.SetNillableStartDate(input.StartDate)
+
+	lcc.SetNillableEndDate(input.EndDate)
+
+	lcc.SetNillableMonthlyPayment(input.MonthlyPayment)
+
+	lcc.SetNillableMileageAllowance(input.MileageAllowance)
+
+	lcc.SetNillableExcessRate(input.ExcessRate)
+
+	lcc.SetNillableStartMileage(input.StartMileage)
+
+	lcc.SetNillableTransferAllowed(input.TransferAllowed)
+
+	lcc.SetNillableStatus(input.Status)
+
+	lcc.SetNillableTerminatedAt(input.TerminatedAt)
+
+	lcc.SetNillableCreatedAt(input.CreatedAt)
+	return lcc
+}
+
+func (lu *ListingUpdate) SetListing(input *biz.Listing) *ListingUpdate {
+
+	lu.SetNillableTenantID(input.TenantID)
+
+	lu.SetNillableCarID(input.CarID)
+
+	lu.SetNillableSellerID(input.SellerID)
+
+	lu.SetNillablePrice(input.Price)
+
+	lu.SetNillableDescription(input.Description)
+
+	lu.SetNillableStatus(input.Status)
+
+	lu.SetNillableExpiresAt(input.ExpiresAt)
+
+	lu.SetNillableBuyerID(input.BuyerID)
+
+	lu.SetNillableClosedAt(input.ClosedAt)
+
+	lu.SetNillableCreatedAt(input.CreatedAt)
+	return lu
+}
+
+func (lc *ListingCreate) SetListing(input *biz.Listing) *ListingCreate {
+
+	lc.SetNillableTenantID(input.TenantID)
+
+	lc.SetNillableCarID(input.CarID)
+
+	lc.SetNillableSellerID(input.SellerID)
+
+	lc.SetNillablePrice(input.Price)
+
+	lc.SetNillableDescription(input.Description)
+
+	lc.SetNillableStatus(input.Status)
+
+	lc.SetNillableExpiresAt(input.ExpiresAt)
+
+	lc.SetNillableBuyerID(input.BuyerID)
+
+	lc.SetNillableClosedAt(input.ClosedAt)
+
+	lc.SetNillableCreatedAt(input.CreatedAt)
+	return lc
+}
+
+func (mru *MaintenanceRecordUpdate) SetMaintenanceRecord(input *biz.MaintenanceRecord) *MaintenanceRecordUpdate {
+
+	mru.SetNillableTenantID(input.TenantID)
+
+	mru.SetNillableCarID(input.CarID)
+
+	mru.SetNillableServicedAt(input.ServicedAt)
+
+	mru.SetNillableMileage(input.Mileage)
+
+	mru.SetNillableType(input.Type)
+
+	mru.SetNillableCost(input.Cost)
+
+	mru.SetNillableShop(input.Shop)
+
+	mru.SetNillableNotes(input.Notes)
+	return mru
+}
+
+func (mrc *MaintenanceRecordCreate) SetMaintenanceRecord(input *biz.MaintenanceRecord) *MaintenanceRecordCreate {
+
+	mrc.SetNillableTenantID(input.TenantID)
+
+	mrc.SetNillableCarID(input.CarID)
+
+	mrc.SetNillableServicedAt(input.ServicedAt)
+
+	mrc.SetNillableMileage(input.Mileage)
+
+	mrc.SetNillableType(input.Type)
+
+	mrc.SetNillableCost(input.Cost)
+
+	mrc.SetNillableShop(input.Shop)
+
+	mrc.SetNillableNotes(input.Notes)
+	return mrc
+}
+
+func (oru *OdometerReadingUpdate) SetOdometerReading(input *biz.OdometerReading) *OdometerReadingUpdate {
+
+	oru.SetNillableTenantID(input.TenantID)
+
+	oru.SetNillableCarID(input.CarID)
+
+	oru.SetNillableMileage(input.Mileage)
+
+	oru.SetNillableSource(input.Source)
+
+	oru.SetNillableSuspicious(input.Suspicious)
+
+	oru.SetNillableRecordedAt(input.RecordedAt)
+	return oru
+}
+
+func (orc *OdometerReadingCreate) SetOdometerReading(input *biz.OdometerReading) *OdometerReadingCreate {
+
+	orc.SetNillableTenantID(input.TenantID)
+
+	orc.SetNillableCarID(input.CarID)
+
+	orc.SetNillableMileage(input.Mileage)
+
+	orc.SetNillableSource(input.Source)
+
+	orc.SetNillableSuspicious(input.Suspicious)
+
+	orc.SetNillableRecordedAt(input.RecordedAt)
+	return orc
+}
+
+func (ohu *OwnerHistoryUpdate) SetOwnerHistory(input *biz.OwnerHistory) *OwnerHistoryUpdate {
+
+	ohu.SetNillableTenantID(input.TenantID)
+
+	ohu.SetNillableCarID(input.CarID)
+
+	ohu.SetNillableUserID(input.UserID)
+
+	ohu.SetNillableStartedAt(input.StartedAt)
+
+	ohu.SetNillableEndedAt(input.EndedAt)
+	return ohu
+}
+
+func (ohc *OwnerHistoryCreate) SetOwnerHistory(input *biz.OwnerHistory) *OwnerHistoryCreate {
+
+	ohc.SetNillableTenantID(input.TenantID)
+
+	ohc.SetNillableCarID(input.CarID)
+
+	ohc.SetNillableUserID(input.UserID)
+
+	ohc.SetNillableStartedAt(input.StartedAt)
+
+	ohc.SetNillableEndedAt(input.EndedAt)
+	return ohc
+}
+
+func (ru *RecallUpdate) SetRecall(input *biz.Recall) *RecallUpdate {
+
+	ru.SetCampaignNo(input.CampaignNo)
+
+	ru.SetNillableManufacturer(input.Manufacturer)
+
+	ru.SetNillableModelID(input.ModelID)
+
+	ru.SetNillableModel(input.Model)
+
+	ru.SetNillableYearFrom(input.YearFrom)
+
+	ru.SetNillableYearTo(input.YearTo)
+
+	ru.SetNillableVinFrom(input.VinFrom)
+
+	ru.SetNillableVinTo(input.VinTo)
+
+	ru.SetNillableComponent(input.Component)
+
+	ru.SetNillableDescription(input.Description)
+
+	ru.SetNillableRemedy(input.Remedy)
+
+	ru.SetNillableIssuedAt(input.IssuedAt)
+
+	ru.SetNillableCreatedAt(input.CreatedAt)
+	return ru
+}
+
+func (rc *RecallCreate) SetRecall(input *biz.Recall) *RecallCreate {
+
+	rc.SetCampaignNo(input.CampaignNo)
+
+	rc.SetNillableManufacturer(input.Manufacturer)
+
+	rc.SetNillableModelID(input.ModelID)
+
+	rc.SetNillableModel(input.Model)
+
+	rc.SetNillableYearFrom(input.YearFrom)
+
+	rc.SetNillableYearTo(input.YearTo)
+
+	rc.SetNillableVinFrom(input.VinFrom)
+
+	rc.SetNillableVinTo(input.VinTo)
+
+	rc.SetNillableComponent(input.Component)
+
+	rc.SetNillableDescription(input.Description)
+
+	rc.SetNillableRemedy(input.Remedy)
+
+	rc.SetNillableIssuedAt(input.IssuedAt)
+
+	rc.SetNillableCreatedAt(input.CreatedAt)
+	return rc
+}
+
+func (ru *RefuelUpdate) SetRefuel(input *biz.Refuel) *RefuelUpdate {
+
+	ru.SetNillableTenantID(input.TenantID)
+
+	ru.SetNillableCarID(input.CarID)
+
+	ru.SetNillableAmount(input.Amount)
+
+	ru.SetNillableUnit(input.Unit)
+
+	ru.SetNillableCost(input.Cost)
+
+	ru.SetNillableStation(input.Station)
+
+	ru.SetNillableOdometer(input.Odometer)
+
+	ru.SetNillableRefueledAt(input.RefueledAt)
+	return ru
+}
+
+func (rc *RefuelCreate) SetRefuel(input *biz.Refuel) *RefuelCreate {
+
+	rc.SetNillableTenantID(input.TenantID)
+
+	rc.SetNillableCarID(input.CarID)
+
+	rc.SetNillableAmount(input.Amount)
+
+	rc.SetNillableUnit(input.Unit)
+
+	rc.SetNillableCost(input.Cost)
+
+	rc.SetNillableStation(input.Station)
+
+	rc.SetNillableOdometer(input.Odometer)
+
+	rc.SetNillableRefueledAt(input.RefueledAt)
+	return rc
+}
+
+func (ru *ReservationUpdate) SetReservation(input *biz.Reservation) *ReservationUpdate {
+
+	ru.SetNillableTenantID(input.TenantID)
+
+	ru.SetNillableCarID(input.CarID)
+
+	ru.SetNillableUserID(input.UserID)
+
+	ru.SetNillableStartAt(input.StartAt)
+
+	ru.SetNillableEndAt(input.EndAt)
+
+	ru.SetNillablePurpose(input.Purpose)
+
+	ru.SetNillableStatus(input.Status)
+
+	ru.SetNillableCancelledAt(input.CancelledAt)
+
+	ru.SetNillableCreatedAt(input.CreatedAt)
+	return ru
+}
+
+func (rc *ReservationCreate) SetReservation(input *biz.Reservation) *ReservationCreate {
+
+	rc.SetNillableTenantID(input.TenantID)
+
+	rc.SetNillableCarID(input.CarID)
+
+	rc.SetNillableUserID(input.UserID)
+
+	rc.SetNillableStartAt(input.StartAt)
+
+	rc.SetNillableEndAt(input.EndAt)
+
+	rc.SetNillablePurpose(input.Purpose)
+
+	rc.SetNillableStatus(input.Status)
+
+	rc.SetNillableCancelledAt(input.CancelledAt)
+
+	rc.SetNillableCreatedAt(input.CreatedAt)
+	return rc
+}
+
+func (tu *TagUpdate) SetTag(input *biz.Tag) *TagUpdate {
+
+	tu.SetNillableTenantID(input.TenantID)
+
+	tu.SetName(input.Name)
+	return tu
+}
+
+func (tc *TagCreate) SetTag(input *biz.Tag) *TagCreate {
+
+	tc.SetNillableTenantID(input.TenantID)
+
+	tc.SetName(input.Name)
+	return tc
+}
+
+func (tru *TradeRecordUpdate) SetTradeRecord(input *biz.TradeRecord) *TradeRecordUpdate {
+
+	tru.SetNillableTenantID(input.TenantID)
+
+	tru.SetNillableCarID(input.CarID)
+
+	tru.SetNillableModelID(input.ModelID)
+
+	tru.SetNillableSellerID(input.SellerID)
+
+	tru.SetNillableBuyerID(input.BuyerID)
+
+	tru.SetNillablePrice(input.Price)
+
+	tru.SetNillableEstimatedValue(input.EstimatedValue)
+
+	tru.SetNillableMileage(input.Mileage)
+
+	tru.SetNillableAgeMonths(input.AgeMonths)
+
+	tru.SetNillableTradedAt(input.TradedAt)
+	return tru
+}
+
+func (trc *TradeRecordCreate) SetTradeRecord(input *biz.TradeRecord) *TradeRecordCreate {
+
+	trc.SetNillableTenantID(input.TenantID)
+
+	trc.SetNillableCarID(input.CarID)
+
+	trc.SetNillableModelID(input.ModelID)
+
+	trc.SetNillableSellerID(input.SellerID)
+
+	trc.SetNillableBuyerID(input.BuyerID)
+
+	trc.SetNillablePrice(input.Price)
+
+	trc.SetNillableEstimatedValue(input.EstimatedValue)
+
+	trc.SetNillableMileage(input.Mileage)
+
+	trc.SetNillableAgeMonths(input.AgeMonths)
+
+	trc.SetNillableTradedAt(input.TradedAt)
+	return trc
+}
+
+func (tu *TransferUpdate) SetTransfer(input *biz.Transfer) *TransferUpdate {
+
+	tu.SetNillableTenantID(input.TenantID)
+
+	tu.SetNillableCarID(input.CarID)
+
+	tu.SetNillableSellerID(input.SellerID)
+
+	tu.SetNillableBuyerID(input.BuyerID)
+
+	tu.SetNillableStatus(input.Status)
+
+	tu.SetNillableRequireApproval(input.RequireApproval)
+
+	tu.SetNillableApprovedBy(input.ApprovedBy)
+
+	tu.SetNillableExpiresAt(input.ExpiresAt)
+
+	tu.SetNillableAcceptedAt(input.AcceptedAt)
+
+	tu.SetNillableCompletedAt(input.CompletedAt)
+
+	tu.SetNillableCreatedAt(input.CreatedAt)
+	return tu
+}
+
+func (tc *TransferCreate) SetTransfer(input *biz.Transfer) *TransferCreate {
+
+	tc.SetNillableTenantID(input.TenantID)
+
+	tc.SetNillableCarID(input.CarID)
+
+	tc.SetNillableSellerID(input.SellerID)
+
+	tc.SetNillableBuyerID(input.BuyerID)
+
+	tc.SetNillableStatus(input.Status)
+
+	tc.SetNillableRequireApproval(input.RequireApproval)
+
+	tc.SetNillableApprovedBy(input.ApprovedBy)
+
+	tc.SetNillableExpiresAt(input.ExpiresAt)
+
+	tc.SetNillableAcceptedAt(input.AcceptedAt)
+
+	tc.SetNillableCompletedAt(input.CompletedAt)
+
+	tc.SetNillableCreatedAt(input.CreatedAt)
+	return tc
+}
+
+func (tu *TripUpdate) SetTrip(input *biz.Trip) *TripUpdate {
+
+	tu.SetNillableTenantID(input.TenantID)
+
+	tu.SetNillableCarID(input.CarID)
+
+	tu.SetNillableDriverID(input.DriverID)
+
+	tu.SetNillablePurpose(input.Purpose)
+
+	tu.SetNillableStartOdometer(input.StartOdometer)
+
+	tu.SetNillableEndOdometer(input.EndOdometer)
+
+	tu.SetNillableStartedAt(input.StartedAt)
+
+	tu.SetNillableEndedAt(input.EndedAt)
+
+	tu.SetNillableCreatedAt(input.CreatedAt)
+	return tu
+}
+
+func (tc *TripCreate) SetTrip(input *biz.Trip) *TripCreate {
+
+	tc.SetNillableTenantID(input.TenantID)
+
+	tc.SetNillableCarID(input.CarID)
+
+	tc.SetNillableDriverID(input.DriverID)
+
+	tc.SetNillablePurpose(input.Purpose)
+
+	tc.SetNillableStartOdometer(input.StartOdometer)
+
+	tc.SetNillableEndOdometer(input.EndOdometer)
+
+	tc.SetNillableStartedAt(input.StartedAt)
+
+	tc.SetNillableEndedAt(input.EndedAt)
+
+	tc.SetNillableCreatedAt(input.CreatedAt)
+	return tc
+}
+
+func (vmu *VehicleModelUpdate) SetVehicleModel(input *biz.VehicleModel) *VehicleModelUpdate {
+
+	vmu.SetNillableBrandID(input.BrandID)
+
+	vmu.SetNillableName(input.Name)
+
+	vmu.SetNillableFuelType(input.FuelType)
+
+	vmu.SetNillableSeats(input.Seats)
+
+	vmu.SetNillableBodyType(input.BodyType)
+	return vmu
+}
+
+func (vmc *VehicleModelCreate) SetVehicleModel(input *biz.VehicleModel) *VehicleModelCreate {
+
+	vmc.SetNillableBrandID(input.BrandID)
+
+	vmc.SetNillableName(input.Name)
+
+	vmc.SetNillableFuelType(input.FuelType)
+
+	vmc.SetNillableSeats(input.Seats)
+
+	vmc.SetNillableBodyType(input.BodyType)
+	return vmc
+}
+
+func (vu *ViolationUpdate) SetViolation(input *biz.Violation) *ViolationUpdate {
+
+	vu.SetNillableTenantID(input.TenantID)
+
+	vu.SetNillableCarID(input.CarID)
+
+	vu.SetNillableUserID(input.UserID)
+
+	vu.SetNillableReferenceNo(input.ReferenceNo)
+
+	vu.SetNillableOccurredAt(input.OccurredAt)
+
+	vu.SetNillableLocation(input.Location)
+
+	vu.SetNillableAmount(input.Amount)
+
+	vu.SetNillableDescription(input.Description)
+
+	vu.SetNillableStatus(input.Status)
+
+	vu.SetNillableResolvedAt(input.ResolvedAt)
+
+	vu.SetNillableResolvedBy(input.ResolvedBy)
+
+	vu.SetNillableCreatedAt(input.CreatedAt)
+	return vu
+}
+
+func (vc *ViolationCreate) SetViolation(input *biz.Violation) *ViolationCreate {
+
+	vc.SetNillableTenantID(input.TenantID)
+
+	vc.SetNillableCarID(input.CarID)
+
+	vc.SetNillableUserID(input.UserID)
+
+	vc.SetNillableReferenceNo(input.ReferenceNo)
+
+	vc.SetNillableOccurredAt(input.OccurredAt)
+
+	vc.SetNillableLocation(input.Location)
+
+	vc.SetNillableAmount(input.Amount)
+
+	vc.SetNillableDescription(input.Description)
+
+	vc.SetNillableStatus(input.Status)
+
+	vc.SetNillableResolvedAt(input.ResolvedAt)
+
+	vc.SetNillableResolvedBy(input.ResolvedBy)
+
+	vc.SetNillableCreatedAt(input.CreatedAt)
+	return vc
+}
+
+func (wdu *WarrantyDefinitionUpdate) SetWarrantyDefinition(input *biz.WarrantyDefinition) *WarrantyDefinitionUpdate {
+
+	wdu.SetNillableModelID(input.ModelID)
+
+	wdu.SetName(input.Name)
+
+	wdu.SetNillableComponents(input.Components)
+
+	wdu.SetNillableDurationMonths(input.DurationMonths)
+
+	wdu.SetNillableMileageLimit(input.MileageLimit)
+
+	wdu.SetNillableCreatedAt(input.CreatedAt)
+	return wdu
+}
+
+func (wdc *WarrantyDefinitionCreate) SetWarrantyDefinition(input *biz.WarrantyDefinition) *WarrantyDefinitionCreate {
+
+	wdc.SetNillableModelID(input.ModelID)
+
+	wdc.SetName(input.Name)
+
+	wdc.SetNillableComponents(input.Components)
+
+	wdc.SetNillableDurationMonths(input.DurationMonths)
+
+	wdc.SetNillableMileageLimit(input.MileageLimit)
+
+	wdc.SetNillableCreatedAt(input.CreatedAt)
+	return wdc
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/traderecord"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// TradeRecord is the model entity for the TradeRecord schema.
+type TradeRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID int64 `json:"model_id,omitempty"`
+	// SellerID holds the value of the "seller_id" field.
+	SellerID int64 `json:"seller_id,omitempty"`
+	// BuyerID holds the value of the "buyer_id" field.
+	BuyerID int64 `json:"buyer_id,omitempty"`
+	// Price holds the value of the "price" field.
+	Price float64 `json:"price,omitempty"`
+	// EstimatedValue holds the value of the "estimated_value" field.
+	EstimatedValue float64 `json:"estimated_value,omitempty"`
+	// Mileage holds the value of the "mileage" field.
+	Mileage int64 `json:"mileage,omitempty"`
+	// AgeMonths holds the value of the "age_months" field.
+	AgeMonths int `json:"age_months,omitempty"`
+	// TradedAt holds the value of the "traded_at" field.
+	TradedAt time.Time `json:"traded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TradeRecordQuery when eager-loading is set.
+	Edges TradeRecordEdges `json:"edges"`
+}
+
+// TradeRecordEdges holds the relations/edges for other nodes in the graph.
+type TradeRecordEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TradeRecordEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TradeRecord) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case traderecord.FieldPrice, traderecord.FieldEstimatedValue:
+			values[i] = new(sql.NullFloat64)
+		case traderecord.FieldID, traderecord.FieldTenantID, traderecord.FieldCarID, traderecord.FieldModelID, traderecord.FieldSellerID, traderecord.FieldBuyerID, traderecord.FieldMileage, traderecord.FieldAgeMonths:
+			values[i] = new(sql.NullInt64)
+		case traderecord.FieldTradedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type TradeRecord", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TradeRecord fields.
+func (tr *TradeRecord) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case traderecord.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tr.ID = int64(value.Int64)
+		case traderecord.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				tr.TenantID = value.Int64
+			}
+		case traderecord.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				tr.CarID = value.Int64
+			}
+		case traderecord.FieldModelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				tr.ModelID = value.Int64
+			}
+		case traderecord.FieldSellerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_id", values[i])
+			} else if value.Valid {
+				tr.SellerID = value.Int64
+			}
+		case traderecord.FieldBuyerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_id", values[i])
+			} else if value.Valid {
+				tr.BuyerID = value.Int64
+			}
+		case traderecord.FieldPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				tr.Price = value.Float64
+			}
+		case traderecord.FieldEstimatedValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field estimated_value", values[i])
+			} else if value.Valid {
+				tr.EstimatedValue = value.Float64
+			}
+		case traderecord.FieldMileage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mileage", values[i])
+			} else if value.Valid {
+				tr.Mileage = value.Int64
+			}
+		case traderecord.FieldAgeMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field age_months", values[i])
+			} else if value.Valid {
+				tr.AgeMonths = int(value.Int64)
+			}
+		case traderecord.FieldTradedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field traded_at", values[i])
+			} else if value.Valid {
+				tr.TradedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the TradeRecord entity.
+func (tr *TradeRecord) QueryCar() *CarQuery {
+	return (&TradeRecordClient{config: tr.config}).QueryCar(tr)
+}
+
+// Update returns a builder for updating this TradeRecord.
+// Note that you need to call TradeRecord.Unwrap() before calling this method if this TradeRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TradeRecord) Update() *TradeRecordUpdateOne {
+	return (&TradeRecordClient{config: tr.config}).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TradeRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TradeRecord) Unwrap() *TradeRecord {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TradeRecord is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TradeRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("TradeRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("model_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.ModelID))
+	builder.WriteString(", ")
+	builder.WriteString("seller_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.SellerID))
+	builder.WriteString(", ")
+	builder.WriteString("buyer_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.BuyerID))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Price))
+	builder.WriteString(", ")
+	builder.WriteString("estimated_value=")
+	builder.WriteString(fmt.Sprintf("%v", tr.EstimatedValue))
+	builder.WriteString(", ")
+	builder.WriteString("mileage=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Mileage))
+	builder.WriteString(", ")
+	builder.WriteString("age_months=")
+	builder.WriteString(fmt.Sprintf("%v", tr.AgeMonths))
+	builder.WriteString(", ")
+	builder.WriteString("traded_at=")
+	builder.WriteString(tr.TradedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TradeRecords is a parsable slice of TradeRecord.
+type TradeRecords []*TradeRecord
+
+func (tr TradeRecords) config(cfg config) {
+	for _i := range tr {
+		tr[_i].config = cfg
+	}
+}
@@ -0,0 +1,85 @@
+// Code generated by ent, DO NOT EDIT.
+
+package traderecord
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the traderecord type in the database.
+	Label = "trade_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCarID holds the string denoting the car_id field in the database.
+	FieldCarID = "car_id"
+	// FieldModelID holds the string denoting the model_id field in the database.
+	FieldModelID = "model_id"
+	// FieldSellerID holds the string denoting the seller_id field in the database.
+	FieldSellerID = "seller_id"
+	// FieldBuyerID holds the string denoting the buyer_id field in the database.
+	FieldBuyerID = "buyer_id"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldEstimatedValue holds the string denoting the estimated_value field in the database.
+	FieldEstimatedValue = "estimated_value"
+	// FieldMileage holds the string denoting the mileage field in the database.
+	FieldMileage = "mileage"
+	// FieldAgeMonths holds the string denoting the age_months field in the database.
+	FieldAgeMonths = "age_months"
+	// FieldTradedAt holds the string denoting the traded_at field in the database.
+	FieldTradedAt = "traded_at"
+	// EdgeCar holds the string denoting the car edge name in mutations.
+	EdgeCar = "car"
+	// Table holds the table name of the traderecord in the database.
+	Table = "trade_record"
+	// CarTable is the table that holds the car relation/edge.
+	CarTable = "trade_record"
+	// CarInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarInverseTable = "car"
+	// CarColumn is the table column denoting the car relation/edge.
+	CarColumn = "car_id"
+)
+
+// Columns holds all SQL columns for traderecord fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCarID,
+	FieldModelID,
+	FieldSellerID,
+	FieldBuyerID,
+	FieldPrice,
+	FieldEstimatedValue,
+	FieldMileage,
+	FieldAgeMonths,
+	FieldTradedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "car-service/internal/data/ent/runtime"
+//
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultTradedAt holds the default value on creation for the "traded_at" field.
+	DefaultTradedAt func() time.Time
+)
@@ -0,0 +1,1070 @@
+// Code generated by ent, DO NOT EDIT.
+
+package traderecord
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// ModelID applies equality check predicate on the "model_id" field. It's identical to ModelIDEQ.
+func ModelID(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModelID), v))
+	})
+}
+
+// SellerID applies equality check predicate on the "seller_id" field. It's identical to SellerIDEQ.
+func SellerID(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSellerID), v))
+	})
+}
+
+// BuyerID applies equality check predicate on the "buyer_id" field. It's identical to BuyerIDEQ.
+func BuyerID(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBuyerID), v))
+	})
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrice), v))
+	})
+}
+
+// EstimatedValue applies equality check predicate on the "estimated_value" field. It's identical to EstimatedValueEQ.
+func EstimatedValue(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEstimatedValue), v))
+	})
+}
+
+// Mileage applies equality check predicate on the "mileage" field. It's identical to MileageEQ.
+func Mileage(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileage), v))
+	})
+}
+
+// AgeMonths applies equality check predicate on the "age_months" field. It's identical to AgeMonthsEQ.
+func AgeMonths(v int) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAgeMonths), v))
+	})
+}
+
+// TradedAt applies equality check predicate on the "traded_at" field. It's identical to TradedAtEQ.
+func TradedAt(v time.Time) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTradedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// ModelIDEQ applies the EQ predicate on the "model_id" field.
+func ModelIDEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDNEQ applies the NEQ predicate on the "model_id" field.
+func ModelIDNEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDIn applies the In predicate on the "model_id" field.
+func ModelIDIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldModelID), v...))
+	})
+}
+
+// ModelIDNotIn applies the NotIn predicate on the "model_id" field.
+func ModelIDNotIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldModelID), v...))
+	})
+}
+
+// ModelIDGT applies the GT predicate on the "model_id" field.
+func ModelIDGT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDGTE applies the GTE predicate on the "model_id" field.
+func ModelIDGTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDLT applies the LT predicate on the "model_id" field.
+func ModelIDLT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDLTE applies the LTE predicate on the "model_id" field.
+func ModelIDLTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDIsNil applies the IsNil predicate on the "model_id" field.
+func ModelIDIsNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldModelID)))
+	})
+}
+
+// ModelIDNotNil applies the NotNil predicate on the "model_id" field.
+func ModelIDNotNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldModelID)))
+	})
+}
+
+// SellerIDEQ applies the EQ predicate on the "seller_id" field.
+func SellerIDEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSellerID), v))
+	})
+}
+
+// SellerIDNEQ applies the NEQ predicate on the "seller_id" field.
+func SellerIDNEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSellerID), v))
+	})
+}
+
+// SellerIDIn applies the In predicate on the "seller_id" field.
+func SellerIDIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSellerID), v...))
+	})
+}
+
+// SellerIDNotIn applies the NotIn predicate on the "seller_id" field.
+func SellerIDNotIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSellerID), v...))
+	})
+}
+
+// SellerIDGT applies the GT predicate on the "seller_id" field.
+func SellerIDGT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSellerID), v))
+	})
+}
+
+// SellerIDGTE applies the GTE predicate on the "seller_id" field.
+func SellerIDGTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSellerID), v))
+	})
+}
+
+// SellerIDLT applies the LT predicate on the "seller_id" field.
+func SellerIDLT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSellerID), v))
+	})
+}
+
+// SellerIDLTE applies the LTE predicate on the "seller_id" field.
+func SellerIDLTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSellerID), v))
+	})
+}
+
+// SellerIDIsNil applies the IsNil predicate on the "seller_id" field.
+func SellerIDIsNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSellerID)))
+	})
+}
+
+// SellerIDNotNil applies the NotNil predicate on the "seller_id" field.
+func SellerIDNotNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSellerID)))
+	})
+}
+
+// BuyerIDEQ applies the EQ predicate on the "buyer_id" field.
+func BuyerIDEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBuyerID), v))
+	})
+}
+
+// BuyerIDNEQ applies the NEQ predicate on the "buyer_id" field.
+func BuyerIDNEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBuyerID), v))
+	})
+}
+
+// BuyerIDIn applies the In predicate on the "buyer_id" field.
+func BuyerIDIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBuyerID), v...))
+	})
+}
+
+// BuyerIDNotIn applies the NotIn predicate on the "buyer_id" field.
+func BuyerIDNotIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBuyerID), v...))
+	})
+}
+
+// BuyerIDGT applies the GT predicate on the "buyer_id" field.
+func BuyerIDGT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBuyerID), v))
+	})
+}
+
+// BuyerIDGTE applies the GTE predicate on the "buyer_id" field.
+func BuyerIDGTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBuyerID), v))
+	})
+}
+
+// BuyerIDLT applies the LT predicate on the "buyer_id" field.
+func BuyerIDLT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBuyerID), v))
+	})
+}
+
+// BuyerIDLTE applies the LTE predicate on the "buyer_id" field.
+func BuyerIDLTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBuyerID), v))
+	})
+}
+
+// BuyerIDIsNil applies the IsNil predicate on the "buyer_id" field.
+func BuyerIDIsNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBuyerID)))
+	})
+}
+
+// BuyerIDNotNil applies the NotNil predicate on the "buyer_id" field.
+func BuyerIDNotNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBuyerID)))
+	})
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrice), v))
+	})
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPrice), v))
+	})
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...float64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPrice), v...))
+	})
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...float64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPrice), v...))
+	})
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPrice), v))
+	})
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPrice), v))
+	})
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPrice), v))
+	})
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPrice), v))
+	})
+}
+
+// PriceIsNil applies the IsNil predicate on the "price" field.
+func PriceIsNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPrice)))
+	})
+}
+
+// PriceNotNil applies the NotNil predicate on the "price" field.
+func PriceNotNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPrice)))
+	})
+}
+
+// EstimatedValueEQ applies the EQ predicate on the "estimated_value" field.
+func EstimatedValueEQ(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEstimatedValue), v))
+	})
+}
+
+// EstimatedValueNEQ applies the NEQ predicate on the "estimated_value" field.
+func EstimatedValueNEQ(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEstimatedValue), v))
+	})
+}
+
+// EstimatedValueIn applies the In predicate on the "estimated_value" field.
+func EstimatedValueIn(vs ...float64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEstimatedValue), v...))
+	})
+}
+
+// EstimatedValueNotIn applies the NotIn predicate on the "estimated_value" field.
+func EstimatedValueNotIn(vs ...float64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEstimatedValue), v...))
+	})
+}
+
+// EstimatedValueGT applies the GT predicate on the "estimated_value" field.
+func EstimatedValueGT(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEstimatedValue), v))
+	})
+}
+
+// EstimatedValueGTE applies the GTE predicate on the "estimated_value" field.
+func EstimatedValueGTE(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEstimatedValue), v))
+	})
+}
+
+// EstimatedValueLT applies the LT predicate on the "estimated_value" field.
+func EstimatedValueLT(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEstimatedValue), v))
+	})
+}
+
+// EstimatedValueLTE applies the LTE predicate on the "estimated_value" field.
+func EstimatedValueLTE(v float64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEstimatedValue), v))
+	})
+}
+
+// EstimatedValueIsNil applies the IsNil predicate on the "estimated_value" field.
+func EstimatedValueIsNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEstimatedValue)))
+	})
+}
+
+// EstimatedValueNotNil applies the NotNil predicate on the "estimated_value" field.
+func EstimatedValueNotNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEstimatedValue)))
+	})
+}
+
+// MileageEQ applies the EQ predicate on the "mileage" field.
+func MileageEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileage), v))
+	})
+}
+
+// MileageNEQ applies the NEQ predicate on the "mileage" field.
+func MileageNEQ(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMileage), v))
+	})
+}
+
+// MileageIn applies the In predicate on the "mileage" field.
+func MileageIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMileage), v...))
+	})
+}
+
+// MileageNotIn applies the NotIn predicate on the "mileage" field.
+func MileageNotIn(vs ...int64) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMileage), v...))
+	})
+}
+
+// MileageGT applies the GT predicate on the "mileage" field.
+func MileageGT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMileage), v))
+	})
+}
+
+// MileageGTE applies the GTE predicate on the "mileage" field.
+func MileageGTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMileage), v))
+	})
+}
+
+// MileageLT applies the LT predicate on the "mileage" field.
+func MileageLT(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMileage), v))
+	})
+}
+
+// MileageLTE applies the LTE predicate on the "mileage" field.
+func MileageLTE(v int64) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMileage), v))
+	})
+}
+
+// MileageIsNil applies the IsNil predicate on the "mileage" field.
+func MileageIsNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMileage)))
+	})
+}
+
+// MileageNotNil applies the NotNil predicate on the "mileage" field.
+func MileageNotNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMileage)))
+	})
+}
+
+// AgeMonthsEQ applies the EQ predicate on the "age_months" field.
+func AgeMonthsEQ(v int) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAgeMonths), v))
+	})
+}
+
+// AgeMonthsNEQ applies the NEQ predicate on the "age_months" field.
+func AgeMonthsNEQ(v int) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAgeMonths), v))
+	})
+}
+
+// AgeMonthsIn applies the In predicate on the "age_months" field.
+func AgeMonthsIn(vs ...int) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAgeMonths), v...))
+	})
+}
+
+// AgeMonthsNotIn applies the NotIn predicate on the "age_months" field.
+func AgeMonthsNotIn(vs ...int) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAgeMonths), v...))
+	})
+}
+
+// AgeMonthsGT applies the GT predicate on the "age_months" field.
+func AgeMonthsGT(v int) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAgeMonths), v))
+	})
+}
+
+// AgeMonthsGTE applies the GTE predicate on the "age_months" field.
+func AgeMonthsGTE(v int) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAgeMonths), v))
+	})
+}
+
+// AgeMonthsLT applies the LT predicate on the "age_months" field.
+func AgeMonthsLT(v int) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAgeMonths), v))
+	})
+}
+
+// AgeMonthsLTE applies the LTE predicate on the "age_months" field.
+func AgeMonthsLTE(v int) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAgeMonths), v))
+	})
+}
+
+// AgeMonthsIsNil applies the IsNil predicate on the "age_months" field.
+func AgeMonthsIsNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAgeMonths)))
+	})
+}
+
+// AgeMonthsNotNil applies the NotNil predicate on the "age_months" field.
+func AgeMonthsNotNil() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAgeMonths)))
+	})
+}
+
+// TradedAtEQ applies the EQ predicate on the "traded_at" field.
+func TradedAtEQ(v time.Time) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTradedAt), v))
+	})
+}
+
+// TradedAtNEQ applies the NEQ predicate on the "traded_at" field.
+func TradedAtNEQ(v time.Time) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTradedAt), v))
+	})
+}
+
+// TradedAtIn applies the In predicate on the "traded_at" field.
+func TradedAtIn(vs ...time.Time) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTradedAt), v...))
+	})
+}
+
+// TradedAtNotIn applies the NotIn predicate on the "traded_at" field.
+func TradedAtNotIn(vs ...time.Time) predicate.TradeRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTradedAt), v...))
+	})
+}
+
+// TradedAtGT applies the GT predicate on the "traded_at" field.
+func TradedAtGT(v time.Time) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTradedAt), v))
+	})
+}
+
+// TradedAtGTE applies the GTE predicate on the "traded_at" field.
+func TradedAtGTE(v time.Time) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTradedAt), v))
+	})
+}
+
+// TradedAtLT applies the LT predicate on the "traded_at" field.
+func TradedAtLT(v time.Time) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTradedAt), v))
+	})
+}
+
+// TradedAtLTE applies the LTE predicate on the "traded_at" field.
+func TradedAtLTE(v time.Time) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTradedAt), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TradeRecord) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TradeRecord) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TradeRecord) predicate.TradeRecord {
+	return predicate.TradeRecord(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/traderecord"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TradeRecordCreate is the builder for creating a TradeRecord entity.
+type TradeRecordCreate struct {
+	config
+	mutation *TradeRecordMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (trc *TradeRecordCreate) SetTenantID(i int64) *TradeRecordCreate {
+	trc.mutation.SetTenantID(i)
+	return trc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillableTenantID(i *int64) *TradeRecordCreate {
+	if i != nil {
+		trc.SetTenantID(*i)
+	}
+	return trc
+}
+
+// SetCarID sets the "car_id" field.
+func (trc *TradeRecordCreate) SetCarID(i int64) *TradeRecordCreate {
+	trc.mutation.SetCarID(i)
+	return trc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillableCarID(i *int64) *TradeRecordCreate {
+	if i != nil {
+		trc.SetCarID(*i)
+	}
+	return trc
+}
+
+// SetModelID sets the "model_id" field.
+func (trc *TradeRecordCreate) SetModelID(i int64) *TradeRecordCreate {
+	trc.mutation.SetModelID(i)
+	return trc
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillableModelID(i *int64) *TradeRecordCreate {
+	if i != nil {
+		trc.SetModelID(*i)
+	}
+	return trc
+}
+
+// SetSellerID sets the "seller_id" field.
+func (trc *TradeRecordCreate) SetSellerID(i int64) *TradeRecordCreate {
+	trc.mutation.SetSellerID(i)
+	return trc
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillableSellerID(i *int64) *TradeRecordCreate {
+	if i != nil {
+		trc.SetSellerID(*i)
+	}
+	return trc
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (trc *TradeRecordCreate) SetBuyerID(i int64) *TradeRecordCreate {
+	trc.mutation.SetBuyerID(i)
+	return trc
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillableBuyerID(i *int64) *TradeRecordCreate {
+	if i != nil {
+		trc.SetBuyerID(*i)
+	}
+	return trc
+}
+
+// SetPrice sets the "price" field.
+func (trc *TradeRecordCreate) SetPrice(f float64) *TradeRecordCreate {
+	trc.mutation.SetPrice(f)
+	return trc
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillablePrice(f *float64) *TradeRecordCreate {
+	if f != nil {
+		trc.SetPrice(*f)
+	}
+	return trc
+}
+
+// SetEstimatedValue sets the "estimated_value" field.
+func (trc *TradeRecordCreate) SetEstimatedValue(f float64) *TradeRecordCreate {
+	trc.mutation.SetEstimatedValue(f)
+	return trc
+}
+
+// SetNillableEstimatedValue sets the "estimated_value" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillableEstimatedValue(f *float64) *TradeRecordCreate {
+	if f != nil {
+		trc.SetEstimatedValue(*f)
+	}
+	return trc
+}
+
+// SetMileage sets the "mileage" field.
+func (trc *TradeRecordCreate) SetMileage(i int64) *TradeRecordCreate {
+	trc.mutation.SetMileage(i)
+	return trc
+}
+
+// SetNillableMileage sets the "mileage" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillableMileage(i *int64) *TradeRecordCreate {
+	if i != nil {
+		trc.SetMileage(*i)
+	}
+	return trc
+}
+
+// SetAgeMonths sets the "age_months" field.
+func (trc *TradeRecordCreate) SetAgeMonths(i int) *TradeRecordCreate {
+	trc.mutation.SetAgeMonths(i)
+	return trc
+}
+
+// SetNillableAgeMonths sets the "age_months" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillableAgeMonths(i *int) *TradeRecordCreate {
+	if i != nil {
+		trc.SetAgeMonths(*i)
+	}
+	return trc
+}
+
+// SetTradedAt sets the "traded_at" field.
+func (trc *TradeRecordCreate) SetTradedAt(t time.Time) *TradeRecordCreate {
+	trc.mutation.SetTradedAt(t)
+	return trc
+}
+
+// SetNillableTradedAt sets the "traded_at" field if the given value is not nil.
+func (trc *TradeRecordCreate) SetNillableTradedAt(t *time.Time) *TradeRecordCreate {
+	if t != nil {
+		trc.SetTradedAt(*t)
+	}
+	return trc
+}
+
+// SetID sets the "id" field.
+func (trc *TradeRecordCreate) SetID(i int64) *TradeRecordCreate {
+	trc.mutation.SetID(i)
+	return trc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (trc *TradeRecordCreate) SetCar(c *Car) *TradeRecordCreate {
+	return trc.SetCarID(c.ID)
+}
+
+// Mutation returns the TradeRecordMutation object of the builder.
+func (trc *TradeRecordCreate) Mutation() *TradeRecordMutation {
+	return trc.mutation
+}
+
+// Save creates the TradeRecord in the database.
+func (trc *TradeRecordCreate) Save(ctx context.Context) (*TradeRecord, error) {
+	var (
+		err  error
+		node *TradeRecord
+	)
+	if err := trc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(trc.hooks) == 0 {
+		if err = trc.check(); err != nil {
+			return nil, err
+		}
+		node, err = trc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TradeRecordMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = trc.check(); err != nil {
+				return nil, err
+			}
+			trc.mutation = mutation
+			if node, err = trc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(trc.hooks) - 1; i >= 0; i-- {
+			if trc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = trc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, trc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*TradeRecord)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TradeRecordMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TradeRecordCreate) SaveX(ctx context.Context) *TradeRecord {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TradeRecordCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TradeRecordCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TradeRecordCreate) defaults() error {
+	if _, ok := trc.mutation.TradedAt(); !ok {
+		if traderecord.DefaultTradedAt == nil {
+			return fmt.Errorf("ent: uninitialized traderecord.DefaultTradedAt (forgotten import ent/runtime?)")
+		}
+		v := traderecord.DefaultTradedAt()
+		trc.mutation.SetTradedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TradeRecordCreate) check() error {
+	if _, ok := trc.mutation.TradedAt(); !ok {
+		return &ValidationError{Name: "traded_at", err: errors.New(`ent: missing required field "TradeRecord.traded_at"`)}
+	}
+	return nil
+}
+
+func (trc *TradeRecordCreate) sqlSave(ctx context.Context) (*TradeRecord, error) {
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (trc *TradeRecordCreate) createSpec() (*TradeRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TradeRecord{config: trc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: traderecord.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: traderecord.FieldID,
+			},
+		}
+	)
+	if id, ok := trc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := trc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := trc.mutation.ModelID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldModelID,
+		})
+		_node.ModelID = value
+	}
+	if value, ok := trc.mutation.SellerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldSellerID,
+		})
+		_node.SellerID = value
+	}
+	if value, ok := trc.mutation.BuyerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldBuyerID,
+		})
+		_node.BuyerID = value
+	}
+	if value, ok := trc.mutation.Price(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldPrice,
+		})
+		_node.Price = value
+	}
+	if value, ok := trc.mutation.EstimatedValue(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldEstimatedValue,
+		})
+		_node.EstimatedValue = value
+	}
+	if value, ok := trc.mutation.Mileage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldMileage,
+		})
+		_node.Mileage = value
+	}
+	if value, ok := trc.mutation.AgeMonths(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: traderecord.FieldAgeMonths,
+		})
+		_node.AgeMonths = value
+	}
+	if value, ok := trc.mutation.TradedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: traderecord.FieldTradedAt,
+		})
+		_node.TradedAt = value
+	}
+	if nodes := trc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   traderecord.CarTable,
+			Columns: []string{traderecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TradeRecordCreateBulk is the builder for creating many TradeRecord entities in bulk.
+type TradeRecordCreateBulk struct {
+	config
+	builders []*TradeRecordCreate
+}
+
+// Save creates the TradeRecord entities in the database.
+func (trcb *TradeRecordCreateBulk) Save(ctx context.Context) ([]*TradeRecord, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TradeRecord, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TradeRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TradeRecordCreateBulk) SaveX(ctx context.Context) []*TradeRecord {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TradeRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TradeRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/traderecord"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TradeRecordQuery is the builder for querying TradeRecord entities.
+type TradeRecordQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.TradeRecord
+	// eager-loading edges.
+	withCar   *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TradeRecordQuery builder.
+func (trq *TradeRecordQuery) Where(ps ...predicate.TradeRecord) *TradeRecordQuery {
+	trq.predicates = append(trq.predicates, ps...)
+	return trq
+}
+
+// Limit adds a limit step to the query.
+func (trq *TradeRecordQuery) Limit(limit int) *TradeRecordQuery {
+	trq.limit = &limit
+	return trq
+}
+
+// Offset adds an offset step to the query.
+func (trq *TradeRecordQuery) Offset(offset int) *TradeRecordQuery {
+	trq.offset = &offset
+	return trq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trq *TradeRecordQuery) Unique(unique bool) *TradeRecordQuery {
+	trq.unique = &unique
+	return trq
+}
+
+// Order adds an order step to the query.
+func (trq *TradeRecordQuery) Order(o ...OrderFunc) *TradeRecordQuery {
+	trq.order = append(trq.order, o...)
+	return trq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (trq *TradeRecordQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: trq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(traderecord.Table, traderecord.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, traderecord.CarTable, traderecord.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TradeRecord entity from the query.
+// Returns a *NotFoundError when no TradeRecord was found.
+func (trq *TradeRecordQuery) First(ctx context.Context) (*TradeRecord, error) {
+	nodes, err := trq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{traderecord.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trq *TradeRecordQuery) FirstX(ctx context.Context) *TradeRecord {
+	node, err := trq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TradeRecord ID from the query.
+// Returns a *NotFoundError when no TradeRecord ID was found.
+func (trq *TradeRecordQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = trq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{traderecord.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trq *TradeRecordQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := trq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TradeRecord entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TradeRecord entity is found.
+// Returns a *NotFoundError when no TradeRecord entities are found.
+func (trq *TradeRecordQuery) Only(ctx context.Context) (*TradeRecord, error) {
+	nodes, err := trq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{traderecord.Label}
+	default:
+		return nil, &NotSingularError{traderecord.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trq *TradeRecordQuery) OnlyX(ctx context.Context) *TradeRecord {
+	node, err := trq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TradeRecord ID in the query.
+// Returns a *NotSingularError when more than one TradeRecord ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trq *TradeRecordQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = trq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{traderecord.Label}
+	default:
+		err = &NotSingularError{traderecord.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trq *TradeRecordQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := trq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TradeRecords.
+func (trq *TradeRecordQuery) All(ctx context.Context) ([]*TradeRecord, error) {
+	if err := trq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return trq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trq *TradeRecordQuery) AllX(ctx context.Context) []*TradeRecord {
+	nodes, err := trq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TradeRecord IDs.
+func (trq *TradeRecordQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := trq.Select(traderecord.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trq *TradeRecordQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := trq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trq *TradeRecordQuery) Count(ctx context.Context) (int, error) {
+	if err := trq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return trq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trq *TradeRecordQuery) CountX(ctx context.Context) int {
+	count, err := trq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trq *TradeRecordQuery) Exist(ctx context.Context) (bool, error) {
+	if err := trq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return trq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trq *TradeRecordQuery) ExistX(ctx context.Context) bool {
+	exist, err := trq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TradeRecordQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trq *TradeRecordQuery) Clone() *TradeRecordQuery {
+	if trq == nil {
+		return nil
+	}
+	return &TradeRecordQuery{
+		config:     trq.config,
+		limit:      trq.limit,
+		offset:     trq.offset,
+		order:      append([]OrderFunc{}, trq.order...),
+		predicates: append([]predicate.TradeRecord{}, trq.predicates...),
+		withCar:    trq.withCar.Clone(),
+		// clone intermediate query.
+		sql:    trq.sql.Clone(),
+		path:   trq.path,
+		unique: trq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (trq *TradeRecordQuery) WithCar(opts ...func(*CarQuery)) *TradeRecordQuery {
+	query := &CarQuery{config: trq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	trq.withCar = query
+	return trq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TradeRecord.Query().
+//		GroupBy(traderecord.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (trq *TradeRecordQuery) GroupBy(field string, fields ...string) *TradeRecordGroupBy {
+	grbuild := &TradeRecordGroupBy{config: trq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return trq.sqlQuery(ctx), nil
+	}
+	grbuild.label = traderecord.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.TradeRecord.Query().
+//		Select(traderecord.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (trq *TradeRecordQuery) Select(fields ...string) *TradeRecordSelect {
+	trq.fields = append(trq.fields, fields...)
+	selbuild := &TradeRecordSelect{TradeRecordQuery: trq}
+	selbuild.label = traderecord.Label
+	selbuild.flds, selbuild.scan = &trq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (trq *TradeRecordQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range trq.fields {
+		if !traderecord.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if trq.path != nil {
+		prev, err := trq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trq.sql = prev
+	}
+	if traderecord.Policy == nil {
+		return errors.New("ent: uninitialized traderecord.Policy (forgotten import ent/runtime?)")
+	}
+	if err := traderecord.Policy.EvalQuery(ctx, trq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (trq *TradeRecordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TradeRecord, error) {
+	var (
+		nodes       = []*TradeRecord{}
+		_spec       = trq.querySpec()
+		loadedTypes = [1]bool{
+			trq.withCar != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*TradeRecord).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &TradeRecord{config: trq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := trq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*TradeRecord)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (trq *TradeRecordQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trq.querySpec()
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	_spec.Node.Columns = trq.fields
+	if len(trq.fields) > 0 {
+		_spec.Unique = trq.unique != nil && *trq.unique
+	}
+	return sqlgraph.CountNodes(ctx, trq.driver, _spec)
+}
+
+func (trq *TradeRecordQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := trq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (trq *TradeRecordQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   traderecord.Table,
+			Columns: traderecord.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: traderecord.FieldID,
+			},
+		},
+		From:   trq.sql,
+		Unique: true,
+	}
+	if unique := trq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := trq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, traderecord.FieldID)
+		for i := range fields {
+			if fields[i] != traderecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := trq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trq *TradeRecordQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trq.driver.Dialect())
+	t1 := builder.Table(traderecord.Table)
+	columns := trq.fields
+	if len(columns) == 0 {
+		columns = traderecord.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trq.sql != nil {
+		selector = trq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trq.unique != nil && *trq.unique {
+		selector.Distinct()
+	}
+	for _, m := range trq.modifiers {
+		m(selector)
+	}
+	for _, p := range trq.predicates {
+		p(selector)
+	}
+	for _, p := range trq.order {
+		p(selector)
+	}
+	if offset := trq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (trq *TradeRecordQuery) ForUpdate(opts ...sql.LockOption) *TradeRecordQuery {
+	if trq.driver.Dialect() == dialect.Postgres {
+		trq.Unique(false)
+	}
+	trq.modifiers = append(trq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return trq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (trq *TradeRecordQuery) ForShare(opts ...sql.LockOption) *TradeRecordQuery {
+	if trq.driver.Dialect() == dialect.Postgres {
+		trq.Unique(false)
+	}
+	trq.modifiers = append(trq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return trq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (trq *TradeRecordQuery) Modify(modifiers ...func(s *sql.Selector)) *TradeRecordSelect {
+	trq.modifiers = append(trq.modifiers, modifiers...)
+	return trq.Select()
+}
+
+// TradeRecordGroupBy is the group-by builder for TradeRecord entities.
+type TradeRecordGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trgb *TradeRecordGroupBy) Aggregate(fns ...AggregateFunc) *TradeRecordGroupBy {
+	trgb.fns = append(trgb.fns, fns...)
+	return trgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (trgb *TradeRecordGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := trgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	trgb.sql = query
+	return trgb.sqlScan(ctx, v)
+}
+
+func (trgb *TradeRecordGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range trgb.fields {
+		if !traderecord.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := trgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (trgb *TradeRecordGroupBy) sqlQuery() *sql.Selector {
+	selector := trgb.sql.Select()
+	aggregation := make([]string, 0, len(trgb.fns))
+	for _, fn := range trgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(trgb.fields)+len(trgb.fns))
+		for _, f := range trgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(trgb.fields...)...)
+}
+
+// TradeRecordSelect is the builder for selecting fields of TradeRecord entities.
+type TradeRecordSelect struct {
+	*TradeRecordQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trs *TradeRecordSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := trs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	trs.sql = trs.TradeRecordQuery.sqlQuery(ctx)
+	return trs.sqlScan(ctx, v)
+}
+
+func (trs *TradeRecordSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := trs.sql.Query()
+	if err := trs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (trs *TradeRecordSelect) Modify(modifiers ...func(s *sql.Selector)) *TradeRecordSelect {
+	trs.modifiers = append(trs.modifiers, modifiers...)
+	return trs
+}
@@ -0,0 +1,1173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/traderecord"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TradeRecordUpdate is the builder for updating TradeRecord entities.
+type TradeRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TradeRecordMutation
+}
+
+// Where appends a list predicates to the TradeRecordUpdate builder.
+func (tru *TradeRecordUpdate) Where(ps ...predicate.TradeRecord) *TradeRecordUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tru *TradeRecordUpdate) SetTenantID(i int64) *TradeRecordUpdate {
+	tru.mutation.ResetTenantID()
+	tru.mutation.SetTenantID(i)
+	return tru
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillableTenantID(i *int64) *TradeRecordUpdate {
+	if i != nil {
+		tru.SetTenantID(*i)
+	}
+	return tru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (tru *TradeRecordUpdate) AddTenantID(i int64) *TradeRecordUpdate {
+	tru.mutation.AddTenantID(i)
+	return tru
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (tru *TradeRecordUpdate) ClearTenantID() *TradeRecordUpdate {
+	tru.mutation.ClearTenantID()
+	return tru
+}
+
+// SetCarID sets the "car_id" field.
+func (tru *TradeRecordUpdate) SetCarID(i int64) *TradeRecordUpdate {
+	tru.mutation.SetCarID(i)
+	return tru
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillableCarID(i *int64) *TradeRecordUpdate {
+	if i != nil {
+		tru.SetCarID(*i)
+	}
+	return tru
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (tru *TradeRecordUpdate) ClearCarID() *TradeRecordUpdate {
+	tru.mutation.ClearCarID()
+	return tru
+}
+
+// SetModelID sets the "model_id" field.
+func (tru *TradeRecordUpdate) SetModelID(i int64) *TradeRecordUpdate {
+	tru.mutation.ResetModelID()
+	tru.mutation.SetModelID(i)
+	return tru
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillableModelID(i *int64) *TradeRecordUpdate {
+	if i != nil {
+		tru.SetModelID(*i)
+	}
+	return tru
+}
+
+// AddModelID adds i to the "model_id" field.
+func (tru *TradeRecordUpdate) AddModelID(i int64) *TradeRecordUpdate {
+	tru.mutation.AddModelID(i)
+	return tru
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (tru *TradeRecordUpdate) ClearModelID() *TradeRecordUpdate {
+	tru.mutation.ClearModelID()
+	return tru
+}
+
+// SetSellerID sets the "seller_id" field.
+func (tru *TradeRecordUpdate) SetSellerID(i int64) *TradeRecordUpdate {
+	tru.mutation.ResetSellerID()
+	tru.mutation.SetSellerID(i)
+	return tru
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillableSellerID(i *int64) *TradeRecordUpdate {
+	if i != nil {
+		tru.SetSellerID(*i)
+	}
+	return tru
+}
+
+// AddSellerID adds i to the "seller_id" field.
+func (tru *TradeRecordUpdate) AddSellerID(i int64) *TradeRecordUpdate {
+	tru.mutation.AddSellerID(i)
+	return tru
+}
+
+// ClearSellerID clears the value of the "seller_id" field.
+func (tru *TradeRecordUpdate) ClearSellerID() *TradeRecordUpdate {
+	tru.mutation.ClearSellerID()
+	return tru
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (tru *TradeRecordUpdate) SetBuyerID(i int64) *TradeRecordUpdate {
+	tru.mutation.ResetBuyerID()
+	tru.mutation.SetBuyerID(i)
+	return tru
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillableBuyerID(i *int64) *TradeRecordUpdate {
+	if i != nil {
+		tru.SetBuyerID(*i)
+	}
+	return tru
+}
+
+// AddBuyerID adds i to the "buyer_id" field.
+func (tru *TradeRecordUpdate) AddBuyerID(i int64) *TradeRecordUpdate {
+	tru.mutation.AddBuyerID(i)
+	return tru
+}
+
+// ClearBuyerID clears the value of the "buyer_id" field.
+func (tru *TradeRecordUpdate) ClearBuyerID() *TradeRecordUpdate {
+	tru.mutation.ClearBuyerID()
+	return tru
+}
+
+// SetPrice sets the "price" field.
+func (tru *TradeRecordUpdate) SetPrice(f float64) *TradeRecordUpdate {
+	tru.mutation.ResetPrice()
+	tru.mutation.SetPrice(f)
+	return tru
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillablePrice(f *float64) *TradeRecordUpdate {
+	if f != nil {
+		tru.SetPrice(*f)
+	}
+	return tru
+}
+
+// AddPrice adds f to the "price" field.
+func (tru *TradeRecordUpdate) AddPrice(f float64) *TradeRecordUpdate {
+	tru.mutation.AddPrice(f)
+	return tru
+}
+
+// ClearPrice clears the value of the "price" field.
+func (tru *TradeRecordUpdate) ClearPrice() *TradeRecordUpdate {
+	tru.mutation.ClearPrice()
+	return tru
+}
+
+// SetEstimatedValue sets the "estimated_value" field.
+func (tru *TradeRecordUpdate) SetEstimatedValue(f float64) *TradeRecordUpdate {
+	tru.mutation.ResetEstimatedValue()
+	tru.mutation.SetEstimatedValue(f)
+	return tru
+}
+
+// SetNillableEstimatedValue sets the "estimated_value" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillableEstimatedValue(f *float64) *TradeRecordUpdate {
+	if f != nil {
+		tru.SetEstimatedValue(*f)
+	}
+	return tru
+}
+
+// AddEstimatedValue adds f to the "estimated_value" field.
+func (tru *TradeRecordUpdate) AddEstimatedValue(f float64) *TradeRecordUpdate {
+	tru.mutation.AddEstimatedValue(f)
+	return tru
+}
+
+// ClearEstimatedValue clears the value of the "estimated_value" field.
+func (tru *TradeRecordUpdate) ClearEstimatedValue() *TradeRecordUpdate {
+	tru.mutation.ClearEstimatedValue()
+	return tru
+}
+
+// SetMileage sets the "mileage" field.
+func (tru *TradeRecordUpdate) SetMileage(i int64) *TradeRecordUpdate {
+	tru.mutation.ResetMileage()
+	tru.mutation.SetMileage(i)
+	return tru
+}
+
+// SetNillableMileage sets the "mileage" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillableMileage(i *int64) *TradeRecordUpdate {
+	if i != nil {
+		tru.SetMileage(*i)
+	}
+	return tru
+}
+
+// AddMileage adds i to the "mileage" field.
+func (tru *TradeRecordUpdate) AddMileage(i int64) *TradeRecordUpdate {
+	tru.mutation.AddMileage(i)
+	return tru
+}
+
+// ClearMileage clears the value of the "mileage" field.
+func (tru *TradeRecordUpdate) ClearMileage() *TradeRecordUpdate {
+	tru.mutation.ClearMileage()
+	return tru
+}
+
+// SetAgeMonths sets the "age_months" field.
+func (tru *TradeRecordUpdate) SetAgeMonths(i int) *TradeRecordUpdate {
+	tru.mutation.ResetAgeMonths()
+	tru.mutation.SetAgeMonths(i)
+	return tru
+}
+
+// SetNillableAgeMonths sets the "age_months" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillableAgeMonths(i *int) *TradeRecordUpdate {
+	if i != nil {
+		tru.SetAgeMonths(*i)
+	}
+	return tru
+}
+
+// AddAgeMonths adds i to the "age_months" field.
+func (tru *TradeRecordUpdate) AddAgeMonths(i int) *TradeRecordUpdate {
+	tru.mutation.AddAgeMonths(i)
+	return tru
+}
+
+// ClearAgeMonths clears the value of the "age_months" field.
+func (tru *TradeRecordUpdate) ClearAgeMonths() *TradeRecordUpdate {
+	tru.mutation.ClearAgeMonths()
+	return tru
+}
+
+// SetTradedAt sets the "traded_at" field.
+func (tru *TradeRecordUpdate) SetTradedAt(t time.Time) *TradeRecordUpdate {
+	tru.mutation.SetTradedAt(t)
+	return tru
+}
+
+// SetNillableTradedAt sets the "traded_at" field if the given value is not nil.
+func (tru *TradeRecordUpdate) SetNillableTradedAt(t *time.Time) *TradeRecordUpdate {
+	if t != nil {
+		tru.SetTradedAt(*t)
+	}
+	return tru
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (tru *TradeRecordUpdate) SetCar(c *Car) *TradeRecordUpdate {
+	return tru.SetCarID(c.ID)
+}
+
+// Mutation returns the TradeRecordMutation object of the builder.
+func (tru *TradeRecordUpdate) Mutation() *TradeRecordMutation {
+	return tru.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (tru *TradeRecordUpdate) ClearCar() *TradeRecordUpdate {
+	tru.mutation.ClearCar()
+	return tru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TradeRecordUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tru.hooks) == 0 {
+		affected, err = tru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TradeRecordMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tru.mutation = mutation
+			affected, err = tru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tru.hooks) - 1; i >= 0; i-- {
+			if tru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TradeRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TradeRecordUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TradeRecordUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tru *TradeRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   traderecord.Table,
+			Columns: traderecord.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: traderecord.FieldID,
+			},
+		},
+	}
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldTenantID,
+		})
+	}
+	if value, ok := tru.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldTenantID,
+		})
+	}
+	if tru.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldTenantID,
+		})
+	}
+	if value, ok := tru.mutation.ModelID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldModelID,
+		})
+	}
+	if value, ok := tru.mutation.AddedModelID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldModelID,
+		})
+	}
+	if tru.mutation.ModelIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldModelID,
+		})
+	}
+	if value, ok := tru.mutation.SellerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldSellerID,
+		})
+	}
+	if value, ok := tru.mutation.AddedSellerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldSellerID,
+		})
+	}
+	if tru.mutation.SellerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldSellerID,
+		})
+	}
+	if value, ok := tru.mutation.BuyerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldBuyerID,
+		})
+	}
+	if value, ok := tru.mutation.AddedBuyerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldBuyerID,
+		})
+	}
+	if tru.mutation.BuyerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldBuyerID,
+		})
+	}
+	if value, ok := tru.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldPrice,
+		})
+	}
+	if value, ok := tru.mutation.AddedPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldPrice,
+		})
+	}
+	if tru.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: traderecord.FieldPrice,
+		})
+	}
+	if value, ok := tru.mutation.EstimatedValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldEstimatedValue,
+		})
+	}
+	if value, ok := tru.mutation.AddedEstimatedValue(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldEstimatedValue,
+		})
+	}
+	if tru.mutation.EstimatedValueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: traderecord.FieldEstimatedValue,
+		})
+	}
+	if value, ok := tru.mutation.Mileage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldMileage,
+		})
+	}
+	if value, ok := tru.mutation.AddedMileage(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldMileage,
+		})
+	}
+	if tru.mutation.MileageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldMileage,
+		})
+	}
+	if value, ok := tru.mutation.AgeMonths(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: traderecord.FieldAgeMonths,
+		})
+	}
+	if value, ok := tru.mutation.AddedAgeMonths(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: traderecord.FieldAgeMonths,
+		})
+	}
+	if tru.mutation.AgeMonthsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: traderecord.FieldAgeMonths,
+		})
+	}
+	if value, ok := tru.mutation.TradedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: traderecord.FieldTradedAt,
+		})
+	}
+	if tru.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   traderecord.CarTable,
+			Columns: []string{traderecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   traderecord.CarTable,
+			Columns: []string{traderecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{traderecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TradeRecordUpdateOne is the builder for updating a single TradeRecord entity.
+type TradeRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TradeRecordMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (truo *TradeRecordUpdateOne) SetTenantID(i int64) *TradeRecordUpdateOne {
+	truo.mutation.ResetTenantID()
+	truo.mutation.SetTenantID(i)
+	return truo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillableTenantID(i *int64) *TradeRecordUpdateOne {
+	if i != nil {
+		truo.SetTenantID(*i)
+	}
+	return truo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (truo *TradeRecordUpdateOne) AddTenantID(i int64) *TradeRecordUpdateOne {
+	truo.mutation.AddTenantID(i)
+	return truo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (truo *TradeRecordUpdateOne) ClearTenantID() *TradeRecordUpdateOne {
+	truo.mutation.ClearTenantID()
+	return truo
+}
+
+// SetCarID sets the "car_id" field.
+func (truo *TradeRecordUpdateOne) SetCarID(i int64) *TradeRecordUpdateOne {
+	truo.mutation.SetCarID(i)
+	return truo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillableCarID(i *int64) *TradeRecordUpdateOne {
+	if i != nil {
+		truo.SetCarID(*i)
+	}
+	return truo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (truo *TradeRecordUpdateOne) ClearCarID() *TradeRecordUpdateOne {
+	truo.mutation.ClearCarID()
+	return truo
+}
+
+// SetModelID sets the "model_id" field.
+func (truo *TradeRecordUpdateOne) SetModelID(i int64) *TradeRecordUpdateOne {
+	truo.mutation.ResetModelID()
+	truo.mutation.SetModelID(i)
+	return truo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillableModelID(i *int64) *TradeRecordUpdateOne {
+	if i != nil {
+		truo.SetModelID(*i)
+	}
+	return truo
+}
+
+// AddModelID adds i to the "model_id" field.
+func (truo *TradeRecordUpdateOne) AddModelID(i int64) *TradeRecordUpdateOne {
+	truo.mutation.AddModelID(i)
+	return truo
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (truo *TradeRecordUpdateOne) ClearModelID() *TradeRecordUpdateOne {
+	truo.mutation.ClearModelID()
+	return truo
+}
+
+// SetSellerID sets the "seller_id" field.
+func (truo *TradeRecordUpdateOne) SetSellerID(i int64) *TradeRecordUpdateOne {
+	truo.mutation.ResetSellerID()
+	truo.mutation.SetSellerID(i)
+	return truo
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillableSellerID(i *int64) *TradeRecordUpdateOne {
+	if i != nil {
+		truo.SetSellerID(*i)
+	}
+	return truo
+}
+
+// AddSellerID adds i to the "seller_id" field.
+func (truo *TradeRecordUpdateOne) AddSellerID(i int64) *TradeRecordUpdateOne {
+	truo.mutation.AddSellerID(i)
+	return truo
+}
+
+// ClearSellerID clears the value of the "seller_id" field.
+func (truo *TradeRecordUpdateOne) ClearSellerID() *TradeRecordUpdateOne {
+	truo.mutation.ClearSellerID()
+	return truo
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (truo *TradeRecordUpdateOne) SetBuyerID(i int64) *TradeRecordUpdateOne {
+	truo.mutation.ResetBuyerID()
+	truo.mutation.SetBuyerID(i)
+	return truo
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillableBuyerID(i *int64) *TradeRecordUpdateOne {
+	if i != nil {
+		truo.SetBuyerID(*i)
+	}
+	return truo
+}
+
+// AddBuyerID adds i to the "buyer_id" field.
+func (truo *TradeRecordUpdateOne) AddBuyerID(i int64) *TradeRecordUpdateOne {
+	truo.mutation.AddBuyerID(i)
+	return truo
+}
+
+// ClearBuyerID clears the value of the "buyer_id" field.
+func (truo *TradeRecordUpdateOne) ClearBuyerID() *TradeRecordUpdateOne {
+	truo.mutation.ClearBuyerID()
+	return truo
+}
+
+// SetPrice sets the "price" field.
+func (truo *TradeRecordUpdateOne) SetPrice(f float64) *TradeRecordUpdateOne {
+	truo.mutation.ResetPrice()
+	truo.mutation.SetPrice(f)
+	return truo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillablePrice(f *float64) *TradeRecordUpdateOne {
+	if f != nil {
+		truo.SetPrice(*f)
+	}
+	return truo
+}
+
+// AddPrice adds f to the "price" field.
+func (truo *TradeRecordUpdateOne) AddPrice(f float64) *TradeRecordUpdateOne {
+	truo.mutation.AddPrice(f)
+	return truo
+}
+
+// ClearPrice clears the value of the "price" field.
+func (truo *TradeRecordUpdateOne) ClearPrice() *TradeRecordUpdateOne {
+	truo.mutation.ClearPrice()
+	return truo
+}
+
+// SetEstimatedValue sets the "estimated_value" field.
+func (truo *TradeRecordUpdateOne) SetEstimatedValue(f float64) *TradeRecordUpdateOne {
+	truo.mutation.ResetEstimatedValue()
+	truo.mutation.SetEstimatedValue(f)
+	return truo
+}
+
+// SetNillableEstimatedValue sets the "estimated_value" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillableEstimatedValue(f *float64) *TradeRecordUpdateOne {
+	if f != nil {
+		truo.SetEstimatedValue(*f)
+	}
+	return truo
+}
+
+// AddEstimatedValue adds f to the "estimated_value" field.
+func (truo *TradeRecordUpdateOne) AddEstimatedValue(f float64) *TradeRecordUpdateOne {
+	truo.mutation.AddEstimatedValue(f)
+	return truo
+}
+
+// ClearEstimatedValue clears the value of the "estimated_value" field.
+func (truo *TradeRecordUpdateOne) ClearEstimatedValue() *TradeRecordUpdateOne {
+	truo.mutation.ClearEstimatedValue()
+	return truo
+}
+
+// SetMileage sets the "mileage" field.
+func (truo *TradeRecordUpdateOne) SetMileage(i int64) *TradeRecordUpdateOne {
+	truo.mutation.ResetMileage()
+	truo.mutation.SetMileage(i)
+	return truo
+}
+
+// SetNillableMileage sets the "mileage" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillableMileage(i *int64) *TradeRecordUpdateOne {
+	if i != nil {
+		truo.SetMileage(*i)
+	}
+	return truo
+}
+
+// AddMileage adds i to the "mileage" field.
+func (truo *TradeRecordUpdateOne) AddMileage(i int64) *TradeRecordUpdateOne {
+	truo.mutation.AddMileage(i)
+	return truo
+}
+
+// ClearMileage clears the value of the "mileage" field.
+func (truo *TradeRecordUpdateOne) ClearMileage() *TradeRecordUpdateOne {
+	truo.mutation.ClearMileage()
+	return truo
+}
+
+// SetAgeMonths sets the "age_months" field.
+func (truo *TradeRecordUpdateOne) SetAgeMonths(i int) *TradeRecordUpdateOne {
+	truo.mutation.ResetAgeMonths()
+	truo.mutation.SetAgeMonths(i)
+	return truo
+}
+
+// SetNillableAgeMonths sets the "age_months" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillableAgeMonths(i *int) *TradeRecordUpdateOne {
+	if i != nil {
+		truo.SetAgeMonths(*i)
+	}
+	return truo
+}
+
+// AddAgeMonths adds i to the "age_months" field.
+func (truo *TradeRecordUpdateOne) AddAgeMonths(i int) *TradeRecordUpdateOne {
+	truo.mutation.AddAgeMonths(i)
+	return truo
+}
+
+// ClearAgeMonths clears the value of the "age_months" field.
+func (truo *TradeRecordUpdateOne) ClearAgeMonths() *TradeRecordUpdateOne {
+	truo.mutation.ClearAgeMonths()
+	return truo
+}
+
+// SetTradedAt sets the "traded_at" field.
+func (truo *TradeRecordUpdateOne) SetTradedAt(t time.Time) *TradeRecordUpdateOne {
+	truo.mutation.SetTradedAt(t)
+	return truo
+}
+
+// SetNillableTradedAt sets the "traded_at" field if the given value is not nil.
+func (truo *TradeRecordUpdateOne) SetNillableTradedAt(t *time.Time) *TradeRecordUpdateOne {
+	if t != nil {
+		truo.SetTradedAt(*t)
+	}
+	return truo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (truo *TradeRecordUpdateOne) SetCar(c *Car) *TradeRecordUpdateOne {
+	return truo.SetCarID(c.ID)
+}
+
+// Mutation returns the TradeRecordMutation object of the builder.
+func (truo *TradeRecordUpdateOne) Mutation() *TradeRecordMutation {
+	return truo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (truo *TradeRecordUpdateOne) ClearCar() *TradeRecordUpdateOne {
+	truo.mutation.ClearCar()
+	return truo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TradeRecordUpdateOne) Select(field string, fields ...string) *TradeRecordUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TradeRecord entity.
+func (truo *TradeRecordUpdateOne) Save(ctx context.Context) (*TradeRecord, error) {
+	var (
+		err  error
+		node *TradeRecord
+	)
+	if len(truo.hooks) == 0 {
+		node, err = truo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TradeRecordMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			truo.mutation = mutation
+			node, err = truo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(truo.hooks) - 1; i >= 0; i-- {
+			if truo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = truo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, truo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*TradeRecord)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TradeRecordMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TradeRecordUpdateOne) SaveX(ctx context.Context) *TradeRecord {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TradeRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TradeRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (truo *TradeRecordUpdateOne) sqlSave(ctx context.Context) (_node *TradeRecord, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   traderecord.Table,
+			Columns: traderecord.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: traderecord.FieldID,
+			},
+		},
+	}
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TradeRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, traderecord.FieldID)
+		for _, f := range fields {
+			if !traderecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != traderecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldTenantID,
+		})
+	}
+	if value, ok := truo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldTenantID,
+		})
+	}
+	if truo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldTenantID,
+		})
+	}
+	if value, ok := truo.mutation.ModelID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldModelID,
+		})
+	}
+	if value, ok := truo.mutation.AddedModelID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldModelID,
+		})
+	}
+	if truo.mutation.ModelIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldModelID,
+		})
+	}
+	if value, ok := truo.mutation.SellerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldSellerID,
+		})
+	}
+	if value, ok := truo.mutation.AddedSellerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldSellerID,
+		})
+	}
+	if truo.mutation.SellerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldSellerID,
+		})
+	}
+	if value, ok := truo.mutation.BuyerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldBuyerID,
+		})
+	}
+	if value, ok := truo.mutation.AddedBuyerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldBuyerID,
+		})
+	}
+	if truo.mutation.BuyerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldBuyerID,
+		})
+	}
+	if value, ok := truo.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldPrice,
+		})
+	}
+	if value, ok := truo.mutation.AddedPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldPrice,
+		})
+	}
+	if truo.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: traderecord.FieldPrice,
+		})
+	}
+	if value, ok := truo.mutation.EstimatedValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldEstimatedValue,
+		})
+	}
+	if value, ok := truo.mutation.AddedEstimatedValue(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: traderecord.FieldEstimatedValue,
+		})
+	}
+	if truo.mutation.EstimatedValueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: traderecord.FieldEstimatedValue,
+		})
+	}
+	if value, ok := truo.mutation.Mileage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldMileage,
+		})
+	}
+	if value, ok := truo.mutation.AddedMileage(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: traderecord.FieldMileage,
+		})
+	}
+	if truo.mutation.MileageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: traderecord.FieldMileage,
+		})
+	}
+	if value, ok := truo.mutation.AgeMonths(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: traderecord.FieldAgeMonths,
+		})
+	}
+	if value, ok := truo.mutation.AddedAgeMonths(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: traderecord.FieldAgeMonths,
+		})
+	}
+	if truo.mutation.AgeMonthsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: traderecord.FieldAgeMonths,
+		})
+	}
+	if value, ok := truo.mutation.TradedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: traderecord.FieldTradedAt,
+		})
+	}
+	if truo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   traderecord.CarTable,
+			Columns: []string{traderecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   traderecord.CarTable,
+			Columns: []string{traderecord.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TradeRecord{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{traderecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/transfer"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Transfer is the model entity for the Transfer schema.
+type Transfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// SellerID holds the value of the "seller_id" field.
+	SellerID int64 `json:"seller_id,omitempty"`
+	// BuyerID holds the value of the "buyer_id" field.
+	BuyerID int64 `json:"buyer_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// RequireApproval holds the value of the "require_approval" field.
+	RequireApproval bool `json:"require_approval,omitempty"`
+	// ApprovedBy holds the value of the "approved_by" field.
+	ApprovedBy int64 `json:"approved_by,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TransferQuery when eager-loading is set.
+	Edges TransferEdges `json:"edges"`
+}
+
+// TransferEdges holds the relations/edges for other nodes in the graph.
+type TransferEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Transfer) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transfer.FieldRequireApproval:
+			values[i] = new(sql.NullBool)
+		case transfer.FieldID, transfer.FieldTenantID, transfer.FieldCarID, transfer.FieldSellerID, transfer.FieldBuyerID, transfer.FieldApprovedBy:
+			values[i] = new(sql.NullInt64)
+		case transfer.FieldStatus:
+			values[i] = new(sql.NullString)
+		case transfer.FieldExpiresAt, transfer.FieldAcceptedAt, transfer.FieldCompletedAt, transfer.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Transfer", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Transfer fields.
+func (t *Transfer) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transfer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int64(value.Int64)
+		case transfer.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				t.TenantID = value.Int64
+			}
+		case transfer.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				t.CarID = value.Int64
+			}
+		case transfer.FieldSellerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_id", values[i])
+			} else if value.Valid {
+				t.SellerID = value.Int64
+			}
+		case transfer.FieldBuyerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_id", values[i])
+			} else if value.Valid {
+				t.BuyerID = value.Int64
+			}
+		case transfer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				t.Status = value.String
+			}
+		case transfer.FieldRequireApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_approval", values[i])
+			} else if value.Valid {
+				t.RequireApproval = value.Bool
+			}
+		case transfer.FieldApprovedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by", values[i])
+			} else if value.Valid {
+				t.ApprovedBy = value.Int64
+			}
+		case transfer.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				t.ExpiresAt = value.Time
+			}
+		case transfer.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				t.AcceptedAt = new(time.Time)
+				*t.AcceptedAt = value.Time
+			}
+		case transfer.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				t.CompletedAt = new(time.Time)
+				*t.CompletedAt = value.Time
+			}
+		case transfer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the Transfer entity.
+func (t *Transfer) QueryCar() *CarQuery {
+	return (&TransferClient{config: t.config}).QueryCar(t)
+}
+
+// Update returns a builder for updating this Transfer.
+// Note that you need to call Transfer.Unwrap() before calling this method if this Transfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Transfer) Update() *TransferUpdateOne {
+	return (&TransferClient{config: t.config}).UpdateOne(t)
+}
+
+// Unwrap unwraps the Transfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Transfer) Unwrap() *Transfer {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Transfer is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Transfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Transfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("seller_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.SellerID))
+	builder.WriteString(", ")
+	builder.WriteString("buyer_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.BuyerID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(t.Status)
+	builder.WriteString(", ")
+	builder.WriteString("require_approval=")
+	builder.WriteString(fmt.Sprintf("%v", t.RequireApproval))
+	builder.WriteString(", ")
+	builder.WriteString("approved_by=")
+	builder.WriteString(fmt.Sprintf("%v", t.ApprovedBy))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(t.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := t.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Transfers is a parsable slice of Transfer.
+type Transfers []*Transfer
+
+func (t Transfers) config(cfg config) {
+	for _i := range t {
+		t[_i].config = cfg
+	}
+}
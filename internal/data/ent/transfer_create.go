@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/transfer"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferCreate is the builder for creating a Transfer entity.
+type TransferCreate struct {
+	config
+	mutation *TransferMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tc *TransferCreate) SetTenantID(i int64) *TransferCreate {
+	tc.mutation.SetTenantID(i)
+	return tc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableTenantID(i *int64) *TransferCreate {
+	if i != nil {
+		tc.SetTenantID(*i)
+	}
+	return tc
+}
+
+// SetCarID sets the "car_id" field.
+func (tc *TransferCreate) SetCarID(i int64) *TransferCreate {
+	tc.mutation.SetCarID(i)
+	return tc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableCarID(i *int64) *TransferCreate {
+	if i != nil {
+		tc.SetCarID(*i)
+	}
+	return tc
+}
+
+// SetSellerID sets the "seller_id" field.
+func (tc *TransferCreate) SetSellerID(i int64) *TransferCreate {
+	tc.mutation.SetSellerID(i)
+	return tc
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableSellerID(i *int64) *TransferCreate {
+	if i != nil {
+		tc.SetSellerID(*i)
+	}
+	return tc
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (tc *TransferCreate) SetBuyerID(i int64) *TransferCreate {
+	tc.mutation.SetBuyerID(i)
+	return tc
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableBuyerID(i *int64) *TransferCreate {
+	if i != nil {
+		tc.SetBuyerID(*i)
+	}
+	return tc
+}
+
+// SetStatus sets the "status" field.
+func (tc *TransferCreate) SetStatus(s string) *TransferCreate {
+	tc.mutation.SetStatus(s)
+	return tc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableStatus(s *string) *TransferCreate {
+	if s != nil {
+		tc.SetStatus(*s)
+	}
+	return tc
+}
+
+// SetRequireApproval sets the "require_approval" field.
+func (tc *TransferCreate) SetRequireApproval(b bool) *TransferCreate {
+	tc.mutation.SetRequireApproval(b)
+	return tc
+}
+
+// SetNillableRequireApproval sets the "require_approval" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableRequireApproval(b *bool) *TransferCreate {
+	if b != nil {
+		tc.SetRequireApproval(*b)
+	}
+	return tc
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (tc *TransferCreate) SetApprovedBy(i int64) *TransferCreate {
+	tc.mutation.SetApprovedBy(i)
+	return tc
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableApprovedBy(i *int64) *TransferCreate {
+	if i != nil {
+		tc.SetApprovedBy(*i)
+	}
+	return tc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tc *TransferCreate) SetExpiresAt(t time.Time) *TransferCreate {
+	tc.mutation.SetExpiresAt(t)
+	return tc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableExpiresAt(t *time.Time) *TransferCreate {
+	if t != nil {
+		tc.SetExpiresAt(*t)
+	}
+	return tc
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (tc *TransferCreate) SetAcceptedAt(t time.Time) *TransferCreate {
+	tc.mutation.SetAcceptedAt(t)
+	return tc
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableAcceptedAt(t *time.Time) *TransferCreate {
+	if t != nil {
+		tc.SetAcceptedAt(*t)
+	}
+	return tc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tc *TransferCreate) SetCompletedAt(t time.Time) *TransferCreate {
+	tc.mutation.SetCompletedAt(t)
+	return tc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableCompletedAt(t *time.Time) *TransferCreate {
+	if t != nil {
+		tc.SetCompletedAt(*t)
+	}
+	return tc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tc *TransferCreate) SetCreatedAt(t time.Time) *TransferCreate {
+	tc.mutation.SetCreatedAt(t)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableCreatedAt(t *time.Time) *TransferCreate {
+	if t != nil {
+		tc.SetCreatedAt(*t)
+	}
+	return tc
+}
+
+// SetID sets the "id" field.
+func (tc *TransferCreate) SetID(i int64) *TransferCreate {
+	tc.mutation.SetID(i)
+	return tc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (tc *TransferCreate) SetCar(c *Car) *TransferCreate {
+	return tc.SetCarID(c.ID)
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (tc *TransferCreate) Mutation() *TransferMutation {
+	return tc.mutation
+}
+
+// Save creates the Transfer in the database.
+func (tc *TransferCreate) Save(ctx context.Context) (*Transfer, error) {
+	var (
+		err  error
+		node *Transfer
+	)
+	if err := tc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(tc.hooks) == 0 {
+		if err = tc.check(); err != nil {
+			return nil, err
+		}
+		node, err = tc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TransferMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tc.check(); err != nil {
+				return nil, err
+			}
+			tc.mutation = mutation
+			if node, err = tc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tc.hooks) - 1; i >= 0; i-- {
+			if tc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, tc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Transfer)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TransferMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TransferCreate) SaveX(ctx context.Context) *Transfer {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TransferCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TransferCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TransferCreate) defaults() error {
+	if _, ok := tc.mutation.Status(); !ok {
+		v := transfer.DefaultStatus
+		tc.mutation.SetStatus(v)
+	}
+	if _, ok := tc.mutation.RequireApproval(); !ok {
+		v := transfer.DefaultRequireApproval
+		tc.mutation.SetRequireApproval(v)
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		if transfer.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized transfer.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := transfer.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TransferCreate) check() error {
+	if _, ok := tc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Transfer.status"`)}
+	}
+	if _, ok := tc.mutation.RequireApproval(); !ok {
+		return &ValidationError{Name: "require_approval", err: errors.New(`ent: missing required field "Transfer.require_approval"`)}
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Transfer.created_at"`)}
+	}
+	return nil
+}
+
+func (tc *TransferCreate) sqlSave(ctx context.Context) (*Transfer, error) {
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (tc *TransferCreate) createSpec() (*Transfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Transfer{config: tc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: transfer.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: transfer.FieldID,
+			},
+		}
+	)
+	if id, ok := tc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := tc.mutation.SellerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldSellerID,
+		})
+		_node.SellerID = value
+	}
+	if value, ok := tc.mutation.BuyerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldBuyerID,
+		})
+		_node.BuyerID = value
+	}
+	if value, ok := tc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: transfer.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := tc.mutation.RequireApproval(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: transfer.FieldRequireApproval,
+		})
+		_node.RequireApproval = value
+	}
+	if value, ok := tc.mutation.ApprovedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldApprovedBy,
+		})
+		_node.ApprovedBy = value
+	}
+	if value, ok := tc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := tc.mutation.AcceptedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldAcceptedAt,
+		})
+		_node.AcceptedAt = &value
+	}
+	if value, ok := tc.mutation.CompletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldCompletedAt,
+		})
+		_node.CompletedAt = &value
+	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := tc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.CarTable,
+			Columns: []string{transfer.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TransferCreateBulk is the builder for creating many Transfer entities in bulk.
+type TransferCreateBulk struct {
+	config
+	builders []*TransferCreate
+}
+
+// Save creates the Transfer entities in the database.
+func (tcb *TransferCreateBulk) Save(ctx context.Context) ([]*Transfer, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Transfer, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TransferCreateBulk) SaveX(ctx context.Context) []*Transfer {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TransferCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1079 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/transfer"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferUpdate is the builder for updating Transfer entities.
+type TransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TransferMutation
+}
+
+// Where appends a list predicates to the TransferUpdate builder.
+func (tu *TransferUpdate) Where(ps ...predicate.Transfer) *TransferUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tu *TransferUpdate) SetTenantID(i int64) *TransferUpdate {
+	tu.mutation.ResetTenantID()
+	tu.mutation.SetTenantID(i)
+	return tu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableTenantID(i *int64) *TransferUpdate {
+	if i != nil {
+		tu.SetTenantID(*i)
+	}
+	return tu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (tu *TransferUpdate) AddTenantID(i int64) *TransferUpdate {
+	tu.mutation.AddTenantID(i)
+	return tu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (tu *TransferUpdate) ClearTenantID() *TransferUpdate {
+	tu.mutation.ClearTenantID()
+	return tu
+}
+
+// SetCarID sets the "car_id" field.
+func (tu *TransferUpdate) SetCarID(i int64) *TransferUpdate {
+	tu.mutation.SetCarID(i)
+	return tu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableCarID(i *int64) *TransferUpdate {
+	if i != nil {
+		tu.SetCarID(*i)
+	}
+	return tu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (tu *TransferUpdate) ClearCarID() *TransferUpdate {
+	tu.mutation.ClearCarID()
+	return tu
+}
+
+// SetSellerID sets the "seller_id" field.
+func (tu *TransferUpdate) SetSellerID(i int64) *TransferUpdate {
+	tu.mutation.ResetSellerID()
+	tu.mutation.SetSellerID(i)
+	return tu
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableSellerID(i *int64) *TransferUpdate {
+	if i != nil {
+		tu.SetSellerID(*i)
+	}
+	return tu
+}
+
+// AddSellerID adds i to the "seller_id" field.
+func (tu *TransferUpdate) AddSellerID(i int64) *TransferUpdate {
+	tu.mutation.AddSellerID(i)
+	return tu
+}
+
+// ClearSellerID clears the value of the "seller_id" field.
+func (tu *TransferUpdate) ClearSellerID() *TransferUpdate {
+	tu.mutation.ClearSellerID()
+	return tu
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (tu *TransferUpdate) SetBuyerID(i int64) *TransferUpdate {
+	tu.mutation.ResetBuyerID()
+	tu.mutation.SetBuyerID(i)
+	return tu
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableBuyerID(i *int64) *TransferUpdate {
+	if i != nil {
+		tu.SetBuyerID(*i)
+	}
+	return tu
+}
+
+// AddBuyerID adds i to the "buyer_id" field.
+func (tu *TransferUpdate) AddBuyerID(i int64) *TransferUpdate {
+	tu.mutation.AddBuyerID(i)
+	return tu
+}
+
+// ClearBuyerID clears the value of the "buyer_id" field.
+func (tu *TransferUpdate) ClearBuyerID() *TransferUpdate {
+	tu.mutation.ClearBuyerID()
+	return tu
+}
+
+// SetStatus sets the "status" field.
+func (tu *TransferUpdate) SetStatus(s string) *TransferUpdate {
+	tu.mutation.SetStatus(s)
+	return tu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableStatus(s *string) *TransferUpdate {
+	if s != nil {
+		tu.SetStatus(*s)
+	}
+	return tu
+}
+
+// SetRequireApproval sets the "require_approval" field.
+func (tu *TransferUpdate) SetRequireApproval(b bool) *TransferUpdate {
+	tu.mutation.SetRequireApproval(b)
+	return tu
+}
+
+// SetNillableRequireApproval sets the "require_approval" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableRequireApproval(b *bool) *TransferUpdate {
+	if b != nil {
+		tu.SetRequireApproval(*b)
+	}
+	return tu
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (tu *TransferUpdate) SetApprovedBy(i int64) *TransferUpdate {
+	tu.mutation.ResetApprovedBy()
+	tu.mutation.SetApprovedBy(i)
+	return tu
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableApprovedBy(i *int64) *TransferUpdate {
+	if i != nil {
+		tu.SetApprovedBy(*i)
+	}
+	return tu
+}
+
+// AddApprovedBy adds i to the "approved_by" field.
+func (tu *TransferUpdate) AddApprovedBy(i int64) *TransferUpdate {
+	tu.mutation.AddApprovedBy(i)
+	return tu
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (tu *TransferUpdate) ClearApprovedBy() *TransferUpdate {
+	tu.mutation.ClearApprovedBy()
+	return tu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tu *TransferUpdate) SetExpiresAt(t time.Time) *TransferUpdate {
+	tu.mutation.SetExpiresAt(t)
+	return tu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableExpiresAt(t *time.Time) *TransferUpdate {
+	if t != nil {
+		tu.SetExpiresAt(*t)
+	}
+	return tu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (tu *TransferUpdate) ClearExpiresAt() *TransferUpdate {
+	tu.mutation.ClearExpiresAt()
+	return tu
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (tu *TransferUpdate) SetAcceptedAt(t time.Time) *TransferUpdate {
+	tu.mutation.SetAcceptedAt(t)
+	return tu
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableAcceptedAt(t *time.Time) *TransferUpdate {
+	if t != nil {
+		tu.SetAcceptedAt(*t)
+	}
+	return tu
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (tu *TransferUpdate) ClearAcceptedAt() *TransferUpdate {
+	tu.mutation.ClearAcceptedAt()
+	return tu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tu *TransferUpdate) SetCompletedAt(t time.Time) *TransferUpdate {
+	tu.mutation.SetCompletedAt(t)
+	return tu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableCompletedAt(t *time.Time) *TransferUpdate {
+	if t != nil {
+		tu.SetCompletedAt(*t)
+	}
+	return tu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tu *TransferUpdate) ClearCompletedAt() *TransferUpdate {
+	tu.mutation.ClearCompletedAt()
+	return tu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tu *TransferUpdate) SetCreatedAt(t time.Time) *TransferUpdate {
+	tu.mutation.SetCreatedAt(t)
+	return tu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableCreatedAt(t *time.Time) *TransferUpdate {
+	if t != nil {
+		tu.SetCreatedAt(*t)
+	}
+	return tu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (tu *TransferUpdate) SetCar(c *Car) *TransferUpdate {
+	return tu.SetCarID(c.ID)
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (tu *TransferUpdate) Mutation() *TransferMutation {
+	return tu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (tu *TransferUpdate) ClearCar() *TransferUpdate {
+	tu.mutation.ClearCar()
+	return tu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TransferUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tu.hooks) == 0 {
+		affected, err = tu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TransferMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tu.mutation = mutation
+			affected, err = tu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tu.hooks) - 1; i >= 0; i-- {
+			if tu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TransferUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TransferUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tu *TransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   transfer.Table,
+			Columns: transfer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: transfer.FieldID,
+			},
+		},
+	}
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldTenantID,
+		})
+	}
+	if value, ok := tu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldTenantID,
+		})
+	}
+	if tu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: transfer.FieldTenantID,
+		})
+	}
+	if value, ok := tu.mutation.SellerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldSellerID,
+		})
+	}
+	if value, ok := tu.mutation.AddedSellerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldSellerID,
+		})
+	}
+	if tu.mutation.SellerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: transfer.FieldSellerID,
+		})
+	}
+	if value, ok := tu.mutation.BuyerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldBuyerID,
+		})
+	}
+	if value, ok := tu.mutation.AddedBuyerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldBuyerID,
+		})
+	}
+	if tu.mutation.BuyerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: transfer.FieldBuyerID,
+		})
+	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: transfer.FieldStatus,
+		})
+	}
+	if value, ok := tu.mutation.RequireApproval(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: transfer.FieldRequireApproval,
+		})
+	}
+	if value, ok := tu.mutation.ApprovedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldApprovedBy,
+		})
+	}
+	if value, ok := tu.mutation.AddedApprovedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldApprovedBy,
+		})
+	}
+	if tu.mutation.ApprovedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: transfer.FieldApprovedBy,
+		})
+	}
+	if value, ok := tu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldExpiresAt,
+		})
+	}
+	if tu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: transfer.FieldExpiresAt,
+		})
+	}
+	if value, ok := tu.mutation.AcceptedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldAcceptedAt,
+		})
+	}
+	if tu.mutation.AcceptedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: transfer.FieldAcceptedAt,
+		})
+	}
+	if value, ok := tu.mutation.CompletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldCompletedAt,
+		})
+	}
+	if tu.mutation.CompletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: transfer.FieldCompletedAt,
+		})
+	}
+	if value, ok := tu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldCreatedAt,
+		})
+	}
+	if tu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.CarTable,
+			Columns: []string{transfer.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.CarTable,
+			Columns: []string{transfer.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TransferUpdateOne is the builder for updating a single Transfer entity.
+type TransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TransferMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tuo *TransferUpdateOne) SetTenantID(i int64) *TransferUpdateOne {
+	tuo.mutation.ResetTenantID()
+	tuo.mutation.SetTenantID(i)
+	return tuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableTenantID(i *int64) *TransferUpdateOne {
+	if i != nil {
+		tuo.SetTenantID(*i)
+	}
+	return tuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (tuo *TransferUpdateOne) AddTenantID(i int64) *TransferUpdateOne {
+	tuo.mutation.AddTenantID(i)
+	return tuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (tuo *TransferUpdateOne) ClearTenantID() *TransferUpdateOne {
+	tuo.mutation.ClearTenantID()
+	return tuo
+}
+
+// SetCarID sets the "car_id" field.
+func (tuo *TransferUpdateOne) SetCarID(i int64) *TransferUpdateOne {
+	tuo.mutation.SetCarID(i)
+	return tuo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableCarID(i *int64) *TransferUpdateOne {
+	if i != nil {
+		tuo.SetCarID(*i)
+	}
+	return tuo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (tuo *TransferUpdateOne) ClearCarID() *TransferUpdateOne {
+	tuo.mutation.ClearCarID()
+	return tuo
+}
+
+// SetSellerID sets the "seller_id" field.
+func (tuo *TransferUpdateOne) SetSellerID(i int64) *TransferUpdateOne {
+	tuo.mutation.ResetSellerID()
+	tuo.mutation.SetSellerID(i)
+	return tuo
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableSellerID(i *int64) *TransferUpdateOne {
+	if i != nil {
+		tuo.SetSellerID(*i)
+	}
+	return tuo
+}
+
+// AddSellerID adds i to the "seller_id" field.
+func (tuo *TransferUpdateOne) AddSellerID(i int64) *TransferUpdateOne {
+	tuo.mutation.AddSellerID(i)
+	return tuo
+}
+
+// ClearSellerID clears the value of the "seller_id" field.
+func (tuo *TransferUpdateOne) ClearSellerID() *TransferUpdateOne {
+	tuo.mutation.ClearSellerID()
+	return tuo
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (tuo *TransferUpdateOne) SetBuyerID(i int64) *TransferUpdateOne {
+	tuo.mutation.ResetBuyerID()
+	tuo.mutation.SetBuyerID(i)
+	return tuo
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableBuyerID(i *int64) *TransferUpdateOne {
+	if i != nil {
+		tuo.SetBuyerID(*i)
+	}
+	return tuo
+}
+
+// AddBuyerID adds i to the "buyer_id" field.
+func (tuo *TransferUpdateOne) AddBuyerID(i int64) *TransferUpdateOne {
+	tuo.mutation.AddBuyerID(i)
+	return tuo
+}
+
+// ClearBuyerID clears the value of the "buyer_id" field.
+func (tuo *TransferUpdateOne) ClearBuyerID() *TransferUpdateOne {
+	tuo.mutation.ClearBuyerID()
+	return tuo
+}
+
+// SetStatus sets the "status" field.
+func (tuo *TransferUpdateOne) SetStatus(s string) *TransferUpdateOne {
+	tuo.mutation.SetStatus(s)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableStatus(s *string) *TransferUpdateOne {
+	if s != nil {
+		tuo.SetStatus(*s)
+	}
+	return tuo
+}
+
+// SetRequireApproval sets the "require_approval" field.
+func (tuo *TransferUpdateOne) SetRequireApproval(b bool) *TransferUpdateOne {
+	tuo.mutation.SetRequireApproval(b)
+	return tuo
+}
+
+// SetNillableRequireApproval sets the "require_approval" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableRequireApproval(b *bool) *TransferUpdateOne {
+	if b != nil {
+		tuo.SetRequireApproval(*b)
+	}
+	return tuo
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (tuo *TransferUpdateOne) SetApprovedBy(i int64) *TransferUpdateOne {
+	tuo.mutation.ResetApprovedBy()
+	tuo.mutation.SetApprovedBy(i)
+	return tuo
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableApprovedBy(i *int64) *TransferUpdateOne {
+	if i != nil {
+		tuo.SetApprovedBy(*i)
+	}
+	return tuo
+}
+
+// AddApprovedBy adds i to the "approved_by" field.
+func (tuo *TransferUpdateOne) AddApprovedBy(i int64) *TransferUpdateOne {
+	tuo.mutation.AddApprovedBy(i)
+	return tuo
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (tuo *TransferUpdateOne) ClearApprovedBy() *TransferUpdateOne {
+	tuo.mutation.ClearApprovedBy()
+	return tuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tuo *TransferUpdateOne) SetExpiresAt(t time.Time) *TransferUpdateOne {
+	tuo.mutation.SetExpiresAt(t)
+	return tuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableExpiresAt(t *time.Time) *TransferUpdateOne {
+	if t != nil {
+		tuo.SetExpiresAt(*t)
+	}
+	return tuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (tuo *TransferUpdateOne) ClearExpiresAt() *TransferUpdateOne {
+	tuo.mutation.ClearExpiresAt()
+	return tuo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (tuo *TransferUpdateOne) SetAcceptedAt(t time.Time) *TransferUpdateOne {
+	tuo.mutation.SetAcceptedAt(t)
+	return tuo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableAcceptedAt(t *time.Time) *TransferUpdateOne {
+	if t != nil {
+		tuo.SetAcceptedAt(*t)
+	}
+	return tuo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (tuo *TransferUpdateOne) ClearAcceptedAt() *TransferUpdateOne {
+	tuo.mutation.ClearAcceptedAt()
+	return tuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tuo *TransferUpdateOne) SetCompletedAt(t time.Time) *TransferUpdateOne {
+	tuo.mutation.SetCompletedAt(t)
+	return tuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableCompletedAt(t *time.Time) *TransferUpdateOne {
+	if t != nil {
+		tuo.SetCompletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tuo *TransferUpdateOne) ClearCompletedAt() *TransferUpdateOne {
+	tuo.mutation.ClearCompletedAt()
+	return tuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tuo *TransferUpdateOne) SetCreatedAt(t time.Time) *TransferUpdateOne {
+	tuo.mutation.SetCreatedAt(t)
+	return tuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableCreatedAt(t *time.Time) *TransferUpdateOne {
+	if t != nil {
+		tuo.SetCreatedAt(*t)
+	}
+	return tuo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (tuo *TransferUpdateOne) SetCar(c *Car) *TransferUpdateOne {
+	return tuo.SetCarID(c.ID)
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (tuo *TransferUpdateOne) Mutation() *TransferMutation {
+	return tuo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (tuo *TransferUpdateOne) ClearCar() *TransferUpdateOne {
+	tuo.mutation.ClearCar()
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TransferUpdateOne) Select(field string, fields ...string) *TransferUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Transfer entity.
+func (tuo *TransferUpdateOne) Save(ctx context.Context) (*Transfer, error) {
+	var (
+		err  error
+		node *Transfer
+	)
+	if len(tuo.hooks) == 0 {
+		node, err = tuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TransferMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tuo.mutation = mutation
+			node, err = tuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tuo.hooks) - 1; i >= 0; i-- {
+			if tuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, tuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Transfer)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TransferMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TransferUpdateOne) SaveX(ctx context.Context) *Transfer {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TransferUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tuo *TransferUpdateOne) sqlSave(ctx context.Context) (_node *Transfer, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   transfer.Table,
+			Columns: transfer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: transfer.FieldID,
+			},
+		},
+	}
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Transfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transfer.FieldID)
+		for _, f := range fields {
+			if !transfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldTenantID,
+		})
+	}
+	if value, ok := tuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldTenantID,
+		})
+	}
+	if tuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: transfer.FieldTenantID,
+		})
+	}
+	if value, ok := tuo.mutation.SellerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldSellerID,
+		})
+	}
+	if value, ok := tuo.mutation.AddedSellerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldSellerID,
+		})
+	}
+	if tuo.mutation.SellerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: transfer.FieldSellerID,
+		})
+	}
+	if value, ok := tuo.mutation.BuyerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldBuyerID,
+		})
+	}
+	if value, ok := tuo.mutation.AddedBuyerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldBuyerID,
+		})
+	}
+	if tuo.mutation.BuyerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: transfer.FieldBuyerID,
+		})
+	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: transfer.FieldStatus,
+		})
+	}
+	if value, ok := tuo.mutation.RequireApproval(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: transfer.FieldRequireApproval,
+		})
+	}
+	if value, ok := tuo.mutation.ApprovedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldApprovedBy,
+		})
+	}
+	if value, ok := tuo.mutation.AddedApprovedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: transfer.FieldApprovedBy,
+		})
+	}
+	if tuo.mutation.ApprovedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: transfer.FieldApprovedBy,
+		})
+	}
+	if value, ok := tuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldExpiresAt,
+		})
+	}
+	if tuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: transfer.FieldExpiresAt,
+		})
+	}
+	if value, ok := tuo.mutation.AcceptedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldAcceptedAt,
+		})
+	}
+	if tuo.mutation.AcceptedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: transfer.FieldAcceptedAt,
+		})
+	}
+	if value, ok := tuo.mutation.CompletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldCompletedAt,
+		})
+	}
+	if tuo.mutation.CompletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: transfer.FieldCompletedAt,
+		})
+	}
+	if value, ok := tuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: transfer.FieldCreatedAt,
+		})
+	}
+	if tuo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.CarTable,
+			Columns: []string{transfer.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.CarTable,
+			Columns: []string{transfer.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Transfer{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/trip"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Trip is the model entity for the Trip schema.
+type Trip struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// DriverID holds the value of the "driver_id" field.
+	DriverID int64 `json:"driver_id,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose string `json:"purpose,omitempty"`
+	// StartOdometer holds the value of the "start_odometer" field.
+	StartOdometer int64 `json:"start_odometer,omitempty"`
+	// EndOdometer holds the value of the "end_odometer" field.
+	EndOdometer int64 `json:"end_odometer,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt time.Time `json:"ended_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TripQuery when eager-loading is set.
+	Edges TripEdges `json:"edges"`
+}
+
+// TripEdges holds the relations/edges for other nodes in the graph.
+type TripEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TripEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Trip) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trip.FieldID, trip.FieldTenantID, trip.FieldCarID, trip.FieldDriverID, trip.FieldStartOdometer, trip.FieldEndOdometer:
+			values[i] = new(sql.NullInt64)
+		case trip.FieldPurpose:
+			values[i] = new(sql.NullString)
+		case trip.FieldStartedAt, trip.FieldEndedAt, trip.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Trip", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Trip fields.
+func (t *Trip) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trip.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int64(value.Int64)
+		case trip.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				t.TenantID = value.Int64
+			}
+		case trip.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				t.CarID = value.Int64
+			}
+		case trip.FieldDriverID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field driver_id", values[i])
+			} else if value.Valid {
+				t.DriverID = value.Int64
+			}
+		case trip.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				t.Purpose = value.String
+			}
+		case trip.FieldStartOdometer:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_odometer", values[i])
+			} else if value.Valid {
+				t.StartOdometer = value.Int64
+			}
+		case trip.FieldEndOdometer:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_odometer", values[i])
+			} else if value.Valid {
+				t.EndOdometer = value.Int64
+			}
+		case trip.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				t.StartedAt = value.Time
+			}
+		case trip.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				t.EndedAt = value.Time
+			}
+		case trip.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the Trip entity.
+func (t *Trip) QueryCar() *CarQuery {
+	return (&TripClient{config: t.config}).QueryCar(t)
+}
+
+// Update returns a builder for updating this Trip.
+// Note that you need to call Trip.Unwrap() before calling this method if this Trip
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Trip) Update() *TripUpdateOne {
+	return (&TripClient{config: t.config}).UpdateOne(t)
+}
+
+// Unwrap unwraps the Trip entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Trip) Unwrap() *Trip {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Trip is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Trip) String() string {
+	var builder strings.Builder
+	builder.WriteString("Trip(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("driver_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.DriverID))
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(t.Purpose)
+	builder.WriteString(", ")
+	builder.WriteString("start_odometer=")
+	builder.WriteString(fmt.Sprintf("%v", t.StartOdometer))
+	builder.WriteString(", ")
+	builder.WriteString("end_odometer=")
+	builder.WriteString(fmt.Sprintf("%v", t.EndOdometer))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(t.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ended_at=")
+	builder.WriteString(t.EndedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Trips is a parsable slice of Trip.
+type Trips []*Trip
+
+func (t Trips) config(cfg config) {
+	for _i := range t {
+		t[_i].config = cfg
+	}
+}
@@ -0,0 +1,1008 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trip
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CarID applies equality check predicate on the "car_id" field. It's identical to CarIDEQ.
+func CarID(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// DriverID applies equality check predicate on the "driver_id" field. It's identical to DriverIDEQ.
+func DriverID(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDriverID), v))
+	})
+}
+
+// Purpose applies equality check predicate on the "purpose" field. It's identical to PurposeEQ.
+func Purpose(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPurpose), v))
+	})
+}
+
+// StartOdometer applies equality check predicate on the "start_odometer" field. It's identical to StartOdometerEQ.
+func StartOdometer(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartOdometer), v))
+	})
+}
+
+// EndOdometer applies equality check predicate on the "end_odometer" field. It's identical to EndOdometerEQ.
+func EndOdometer(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndOdometer), v))
+	})
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartedAt), v))
+	})
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndedAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenantID)))
+	})
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenantID)))
+	})
+}
+
+// CarIDEQ applies the EQ predicate on the "car_id" field.
+func CarIDEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDNEQ applies the NEQ predicate on the "car_id" field.
+func CarIDNEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCarID), v))
+	})
+}
+
+// CarIDIn applies the In predicate on the "car_id" field.
+func CarIDIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDNotIn applies the NotIn predicate on the "car_id" field.
+func CarIDNotIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCarID), v...))
+	})
+}
+
+// CarIDIsNil applies the IsNil predicate on the "car_id" field.
+func CarIDIsNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCarID)))
+	})
+}
+
+// CarIDNotNil applies the NotNil predicate on the "car_id" field.
+func CarIDNotNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCarID)))
+	})
+}
+
+// DriverIDEQ applies the EQ predicate on the "driver_id" field.
+func DriverIDEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDriverID), v))
+	})
+}
+
+// DriverIDNEQ applies the NEQ predicate on the "driver_id" field.
+func DriverIDNEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDriverID), v))
+	})
+}
+
+// DriverIDIn applies the In predicate on the "driver_id" field.
+func DriverIDIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDriverID), v...))
+	})
+}
+
+// DriverIDNotIn applies the NotIn predicate on the "driver_id" field.
+func DriverIDNotIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDriverID), v...))
+	})
+}
+
+// DriverIDGT applies the GT predicate on the "driver_id" field.
+func DriverIDGT(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDriverID), v))
+	})
+}
+
+// DriverIDGTE applies the GTE predicate on the "driver_id" field.
+func DriverIDGTE(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDriverID), v))
+	})
+}
+
+// DriverIDLT applies the LT predicate on the "driver_id" field.
+func DriverIDLT(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDriverID), v))
+	})
+}
+
+// DriverIDLTE applies the LTE predicate on the "driver_id" field.
+func DriverIDLTE(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDriverID), v))
+	})
+}
+
+// DriverIDIsNil applies the IsNil predicate on the "driver_id" field.
+func DriverIDIsNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDriverID)))
+	})
+}
+
+// DriverIDNotNil applies the NotNil predicate on the "driver_id" field.
+func DriverIDNotNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDriverID)))
+	})
+}
+
+// PurposeEQ applies the EQ predicate on the "purpose" field.
+func PurposeEQ(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeNEQ applies the NEQ predicate on the "purpose" field.
+func PurposeNEQ(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeIn applies the In predicate on the "purpose" field.
+func PurposeIn(vs ...string) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPurpose), v...))
+	})
+}
+
+// PurposeNotIn applies the NotIn predicate on the "purpose" field.
+func PurposeNotIn(vs ...string) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPurpose), v...))
+	})
+}
+
+// PurposeGT applies the GT predicate on the "purpose" field.
+func PurposeGT(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeGTE applies the GTE predicate on the "purpose" field.
+func PurposeGTE(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeLT applies the LT predicate on the "purpose" field.
+func PurposeLT(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeLTE applies the LTE predicate on the "purpose" field.
+func PurposeLTE(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeContains applies the Contains predicate on the "purpose" field.
+func PurposeContains(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeHasPrefix applies the HasPrefix predicate on the "purpose" field.
+func PurposeHasPrefix(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeHasSuffix applies the HasSuffix predicate on the "purpose" field.
+func PurposeHasSuffix(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeIsNil applies the IsNil predicate on the "purpose" field.
+func PurposeIsNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPurpose)))
+	})
+}
+
+// PurposeNotNil applies the NotNil predicate on the "purpose" field.
+func PurposeNotNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPurpose)))
+	})
+}
+
+// PurposeEqualFold applies the EqualFold predicate on the "purpose" field.
+func PurposeEqualFold(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPurpose), v))
+	})
+}
+
+// PurposeContainsFold applies the ContainsFold predicate on the "purpose" field.
+func PurposeContainsFold(v string) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPurpose), v))
+	})
+}
+
+// StartOdometerEQ applies the EQ predicate on the "start_odometer" field.
+func StartOdometerEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartOdometer), v))
+	})
+}
+
+// StartOdometerNEQ applies the NEQ predicate on the "start_odometer" field.
+func StartOdometerNEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartOdometer), v))
+	})
+}
+
+// StartOdometerIn applies the In predicate on the "start_odometer" field.
+func StartOdometerIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartOdometer), v...))
+	})
+}
+
+// StartOdometerNotIn applies the NotIn predicate on the "start_odometer" field.
+func StartOdometerNotIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartOdometer), v...))
+	})
+}
+
+// StartOdometerGT applies the GT predicate on the "start_odometer" field.
+func StartOdometerGT(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartOdometer), v))
+	})
+}
+
+// StartOdometerGTE applies the GTE predicate on the "start_odometer" field.
+func StartOdometerGTE(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartOdometer), v))
+	})
+}
+
+// StartOdometerLT applies the LT predicate on the "start_odometer" field.
+func StartOdometerLT(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartOdometer), v))
+	})
+}
+
+// StartOdometerLTE applies the LTE predicate on the "start_odometer" field.
+func StartOdometerLTE(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartOdometer), v))
+	})
+}
+
+// StartOdometerIsNil applies the IsNil predicate on the "start_odometer" field.
+func StartOdometerIsNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartOdometer)))
+	})
+}
+
+// StartOdometerNotNil applies the NotNil predicate on the "start_odometer" field.
+func StartOdometerNotNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartOdometer)))
+	})
+}
+
+// EndOdometerEQ applies the EQ predicate on the "end_odometer" field.
+func EndOdometerEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndOdometer), v))
+	})
+}
+
+// EndOdometerNEQ applies the NEQ predicate on the "end_odometer" field.
+func EndOdometerNEQ(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndOdometer), v))
+	})
+}
+
+// EndOdometerIn applies the In predicate on the "end_odometer" field.
+func EndOdometerIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndOdometer), v...))
+	})
+}
+
+// EndOdometerNotIn applies the NotIn predicate on the "end_odometer" field.
+func EndOdometerNotIn(vs ...int64) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndOdometer), v...))
+	})
+}
+
+// EndOdometerGT applies the GT predicate on the "end_odometer" field.
+func EndOdometerGT(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndOdometer), v))
+	})
+}
+
+// EndOdometerGTE applies the GTE predicate on the "end_odometer" field.
+func EndOdometerGTE(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndOdometer), v))
+	})
+}
+
+// EndOdometerLT applies the LT predicate on the "end_odometer" field.
+func EndOdometerLT(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndOdometer), v))
+	})
+}
+
+// EndOdometerLTE applies the LTE predicate on the "end_odometer" field.
+func EndOdometerLTE(v int64) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndOdometer), v))
+	})
+}
+
+// EndOdometerIsNil applies the IsNil predicate on the "end_odometer" field.
+func EndOdometerIsNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndOdometer)))
+	})
+}
+
+// EndOdometerNotNil applies the NotNil predicate on the "end_odometer" field.
+func EndOdometerNotNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndOdometer)))
+	})
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartedAt), v...))
+	})
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartedAt), v...))
+	})
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartedAt)))
+	})
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartedAt)))
+	})
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndedAt), v...))
+	})
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndedAt), v...))
+	})
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndedAt)))
+	})
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndedAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Trip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Trip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasCar applies the HasEdge predicate on the "car" edge.
+func HasCar() predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWith applies the HasEdge predicate on the "car" edge with a given conditions (other predicates).
+func HasCarWith(preds ...predicate.Car) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarTable, CarColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Trip) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Trip) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Trip) predicate.Trip {
+	return predicate.Trip(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/trip"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TripCreate is the builder for creating a Trip entity.
+type TripCreate struct {
+	config
+	mutation *TripMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tc *TripCreate) SetTenantID(i int64) *TripCreate {
+	tc.mutation.SetTenantID(i)
+	return tc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (tc *TripCreate) SetNillableTenantID(i *int64) *TripCreate {
+	if i != nil {
+		tc.SetTenantID(*i)
+	}
+	return tc
+}
+
+// SetCarID sets the "car_id" field.
+func (tc *TripCreate) SetCarID(i int64) *TripCreate {
+	tc.mutation.SetCarID(i)
+	return tc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (tc *TripCreate) SetNillableCarID(i *int64) *TripCreate {
+	if i != nil {
+		tc.SetCarID(*i)
+	}
+	return tc
+}
+
+// SetDriverID sets the "driver_id" field.
+func (tc *TripCreate) SetDriverID(i int64) *TripCreate {
+	tc.mutation.SetDriverID(i)
+	return tc
+}
+
+// SetNillableDriverID sets the "driver_id" field if the given value is not nil.
+func (tc *TripCreate) SetNillableDriverID(i *int64) *TripCreate {
+	if i != nil {
+		tc.SetDriverID(*i)
+	}
+	return tc
+}
+
+// SetPurpose sets the "purpose" field.
+func (tc *TripCreate) SetPurpose(s string) *TripCreate {
+	tc.mutation.SetPurpose(s)
+	return tc
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (tc *TripCreate) SetNillablePurpose(s *string) *TripCreate {
+	if s != nil {
+		tc.SetPurpose(*s)
+	}
+	return tc
+}
+
+// SetStartOdometer sets the "start_odometer" field.
+func (tc *TripCreate) SetStartOdometer(i int64) *TripCreate {
+	tc.mutation.SetStartOdometer(i)
+	return tc
+}
+
+// SetNillableStartOdometer sets the "start_odometer" field if the given value is not nil.
+func (tc *TripCreate) SetNillableStartOdometer(i *int64) *TripCreate {
+	if i != nil {
+		tc.SetStartOdometer(*i)
+	}
+	return tc
+}
+
+// SetEndOdometer sets the "end_odometer" field.
+func (tc *TripCreate) SetEndOdometer(i int64) *TripCreate {
+	tc.mutation.SetEndOdometer(i)
+	return tc
+}
+
+// SetNillableEndOdometer sets the "end_odometer" field if the given value is not nil.
+func (tc *TripCreate) SetNillableEndOdometer(i *int64) *TripCreate {
+	if i != nil {
+		tc.SetEndOdometer(*i)
+	}
+	return tc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tc *TripCreate) SetStartedAt(t time.Time) *TripCreate {
+	tc.mutation.SetStartedAt(t)
+	return tc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tc *TripCreate) SetNillableStartedAt(t *time.Time) *TripCreate {
+	if t != nil {
+		tc.SetStartedAt(*t)
+	}
+	return tc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (tc *TripCreate) SetEndedAt(t time.Time) *TripCreate {
+	tc.mutation.SetEndedAt(t)
+	return tc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (tc *TripCreate) SetNillableEndedAt(t *time.Time) *TripCreate {
+	if t != nil {
+		tc.SetEndedAt(*t)
+	}
+	return tc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tc *TripCreate) SetCreatedAt(t time.Time) *TripCreate {
+	tc.mutation.SetCreatedAt(t)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TripCreate) SetNillableCreatedAt(t *time.Time) *TripCreate {
+	if t != nil {
+		tc.SetCreatedAt(*t)
+	}
+	return tc
+}
+
+// SetID sets the "id" field.
+func (tc *TripCreate) SetID(i int64) *TripCreate {
+	tc.mutation.SetID(i)
+	return tc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (tc *TripCreate) SetCar(c *Car) *TripCreate {
+	return tc.SetCarID(c.ID)
+}
+
+// Mutation returns the TripMutation object of the builder.
+func (tc *TripCreate) Mutation() *TripMutation {
+	return tc.mutation
+}
+
+// Save creates the Trip in the database.
+func (tc *TripCreate) Save(ctx context.Context) (*Trip, error) {
+	var (
+		err  error
+		node *Trip
+	)
+	if err := tc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(tc.hooks) == 0 {
+		if err = tc.check(); err != nil {
+			return nil, err
+		}
+		node, err = tc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TripMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tc.check(); err != nil {
+				return nil, err
+			}
+			tc.mutation = mutation
+			if node, err = tc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tc.hooks) - 1; i >= 0; i-- {
+			if tc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, tc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Trip)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TripMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TripCreate) SaveX(ctx context.Context) *Trip {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TripCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TripCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TripCreate) defaults() error {
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		if trip.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized trip.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := trip.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TripCreate) check() error {
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Trip.created_at"`)}
+	}
+	return nil
+}
+
+func (tc *TripCreate) sqlSave(ctx context.Context) (*Trip, error) {
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (tc *TripCreate) createSpec() (*Trip, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Trip{config: tc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: trip.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: trip.FieldID,
+			},
+		}
+	)
+	if id, ok := tc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := tc.mutation.DriverID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldDriverID,
+		})
+		_node.DriverID = value
+	}
+	if value, ok := tc.mutation.Purpose(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: trip.FieldPurpose,
+		})
+		_node.Purpose = value
+	}
+	if value, ok := tc.mutation.StartOdometer(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldStartOdometer,
+		})
+		_node.StartOdometer = value
+	}
+	if value, ok := tc.mutation.EndOdometer(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldEndOdometer,
+		})
+		_node.EndOdometer = value
+	}
+	if value, ok := tc.mutation.StartedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trip.FieldStartedAt,
+		})
+		_node.StartedAt = value
+	}
+	if value, ok := tc.mutation.EndedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trip.FieldEndedAt,
+		})
+		_node.EndedAt = value
+	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trip.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := tc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trip.CarTable,
+			Columns: []string{trip.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TripCreateBulk is the builder for creating many Trip entities in bulk.
+type TripCreateBulk struct {
+	config
+	builders []*TripCreate
+}
+
+// Save creates the Trip entities in the database.
+func (tcb *TripCreateBulk) Save(ctx context.Context) ([]*Trip, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Trip, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TripMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TripCreateBulk) SaveX(ctx context.Context) []*Trip {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TripCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TripCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
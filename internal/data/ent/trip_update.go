@@ -0,0 +1,995 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/trip"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TripUpdate is the builder for updating Trip entities.
+type TripUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TripMutation
+}
+
+// Where appends a list predicates to the TripUpdate builder.
+func (tu *TripUpdate) Where(ps ...predicate.Trip) *TripUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tu *TripUpdate) SetTenantID(i int64) *TripUpdate {
+	tu.mutation.ResetTenantID()
+	tu.mutation.SetTenantID(i)
+	return tu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (tu *TripUpdate) SetNillableTenantID(i *int64) *TripUpdate {
+	if i != nil {
+		tu.SetTenantID(*i)
+	}
+	return tu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (tu *TripUpdate) AddTenantID(i int64) *TripUpdate {
+	tu.mutation.AddTenantID(i)
+	return tu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (tu *TripUpdate) ClearTenantID() *TripUpdate {
+	tu.mutation.ClearTenantID()
+	return tu
+}
+
+// SetCarID sets the "car_id" field.
+func (tu *TripUpdate) SetCarID(i int64) *TripUpdate {
+	tu.mutation.SetCarID(i)
+	return tu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (tu *TripUpdate) SetNillableCarID(i *int64) *TripUpdate {
+	if i != nil {
+		tu.SetCarID(*i)
+	}
+	return tu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (tu *TripUpdate) ClearCarID() *TripUpdate {
+	tu.mutation.ClearCarID()
+	return tu
+}
+
+// SetDriverID sets the "driver_id" field.
+func (tu *TripUpdate) SetDriverID(i int64) *TripUpdate {
+	tu.mutation.ResetDriverID()
+	tu.mutation.SetDriverID(i)
+	return tu
+}
+
+// SetNillableDriverID sets the "driver_id" field if the given value is not nil.
+func (tu *TripUpdate) SetNillableDriverID(i *int64) *TripUpdate {
+	if i != nil {
+		tu.SetDriverID(*i)
+	}
+	return tu
+}
+
+// AddDriverID adds i to the "driver_id" field.
+func (tu *TripUpdate) AddDriverID(i int64) *TripUpdate {
+	tu.mutation.AddDriverID(i)
+	return tu
+}
+
+// ClearDriverID clears the value of the "driver_id" field.
+func (tu *TripUpdate) ClearDriverID() *TripUpdate {
+	tu.mutation.ClearDriverID()
+	return tu
+}
+
+// SetPurpose sets the "purpose" field.
+func (tu *TripUpdate) SetPurpose(s string) *TripUpdate {
+	tu.mutation.SetPurpose(s)
+	return tu
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (tu *TripUpdate) SetNillablePurpose(s *string) *TripUpdate {
+	if s != nil {
+		tu.SetPurpose(*s)
+	}
+	return tu
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (tu *TripUpdate) ClearPurpose() *TripUpdate {
+	tu.mutation.ClearPurpose()
+	return tu
+}
+
+// SetStartOdometer sets the "start_odometer" field.
+func (tu *TripUpdate) SetStartOdometer(i int64) *TripUpdate {
+	tu.mutation.ResetStartOdometer()
+	tu.mutation.SetStartOdometer(i)
+	return tu
+}
+
+// SetNillableStartOdometer sets the "start_odometer" field if the given value is not nil.
+func (tu *TripUpdate) SetNillableStartOdometer(i *int64) *TripUpdate {
+	if i != nil {
+		tu.SetStartOdometer(*i)
+	}
+	return tu
+}
+
+// AddStartOdometer adds i to the "start_odometer" field.
+func (tu *TripUpdate) AddStartOdometer(i int64) *TripUpdate {
+	tu.mutation.AddStartOdometer(i)
+	return tu
+}
+
+// ClearStartOdometer clears the value of the "start_odometer" field.
+func (tu *TripUpdate) ClearStartOdometer() *TripUpdate {
+	tu.mutation.ClearStartOdometer()
+	return tu
+}
+
+// SetEndOdometer sets the "end_odometer" field.
+func (tu *TripUpdate) SetEndOdometer(i int64) *TripUpdate {
+	tu.mutation.ResetEndOdometer()
+	tu.mutation.SetEndOdometer(i)
+	return tu
+}
+
+// SetNillableEndOdometer sets the "end_odometer" field if the given value is not nil.
+func (tu *TripUpdate) SetNillableEndOdometer(i *int64) *TripUpdate {
+	if i != nil {
+		tu.SetEndOdometer(*i)
+	}
+	return tu
+}
+
+// AddEndOdometer adds i to the "end_odometer" field.
+func (tu *TripUpdate) AddEndOdometer(i int64) *TripUpdate {
+	tu.mutation.AddEndOdometer(i)
+	return tu
+}
+
+// ClearEndOdometer clears the value of the "end_odometer" field.
+func (tu *TripUpdate) ClearEndOdometer() *TripUpdate {
+	tu.mutation.ClearEndOdometer()
+	return tu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tu *TripUpdate) SetStartedAt(t time.Time) *TripUpdate {
+	tu.mutation.SetStartedAt(t)
+	return tu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tu *TripUpdate) SetNillableStartedAt(t *time.Time) *TripUpdate {
+	if t != nil {
+		tu.SetStartedAt(*t)
+	}
+	return tu
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (tu *TripUpdate) ClearStartedAt() *TripUpdate {
+	tu.mutation.ClearStartedAt()
+	return tu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (tu *TripUpdate) SetEndedAt(t time.Time) *TripUpdate {
+	tu.mutation.SetEndedAt(t)
+	return tu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (tu *TripUpdate) SetNillableEndedAt(t *time.Time) *TripUpdate {
+	if t != nil {
+		tu.SetEndedAt(*t)
+	}
+	return tu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (tu *TripUpdate) ClearEndedAt() *TripUpdate {
+	tu.mutation.ClearEndedAt()
+	return tu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tu *TripUpdate) SetCreatedAt(t time.Time) *TripUpdate {
+	tu.mutation.SetCreatedAt(t)
+	return tu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tu *TripUpdate) SetNillableCreatedAt(t *time.Time) *TripUpdate {
+	if t != nil {
+		tu.SetCreatedAt(*t)
+	}
+	return tu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (tu *TripUpdate) SetCar(c *Car) *TripUpdate {
+	return tu.SetCarID(c.ID)
+}
+
+// Mutation returns the TripMutation object of the builder.
+func (tu *TripUpdate) Mutation() *TripMutation {
+	return tu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (tu *TripUpdate) ClearCar() *TripUpdate {
+	tu.mutation.ClearCar()
+	return tu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TripUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tu.hooks) == 0 {
+		affected, err = tu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TripMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tu.mutation = mutation
+			affected, err = tu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tu.hooks) - 1; i >= 0; i-- {
+			if tu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TripUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TripUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TripUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tu *TripUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   trip.Table,
+			Columns: trip.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: trip.FieldID,
+			},
+		},
+	}
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldTenantID,
+		})
+	}
+	if value, ok := tu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldTenantID,
+		})
+	}
+	if tu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: trip.FieldTenantID,
+		})
+	}
+	if value, ok := tu.mutation.DriverID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldDriverID,
+		})
+	}
+	if value, ok := tu.mutation.AddedDriverID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldDriverID,
+		})
+	}
+	if tu.mutation.DriverIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: trip.FieldDriverID,
+		})
+	}
+	if value, ok := tu.mutation.Purpose(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: trip.FieldPurpose,
+		})
+	}
+	if tu.mutation.PurposeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: trip.FieldPurpose,
+		})
+	}
+	if value, ok := tu.mutation.StartOdometer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldStartOdometer,
+		})
+	}
+	if value, ok := tu.mutation.AddedStartOdometer(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldStartOdometer,
+		})
+	}
+	if tu.mutation.StartOdometerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: trip.FieldStartOdometer,
+		})
+	}
+	if value, ok := tu.mutation.EndOdometer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldEndOdometer,
+		})
+	}
+	if value, ok := tu.mutation.AddedEndOdometer(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldEndOdometer,
+		})
+	}
+	if tu.mutation.EndOdometerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: trip.FieldEndOdometer,
+		})
+	}
+	if value, ok := tu.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trip.FieldStartedAt,
+		})
+	}
+	if tu.mutation.StartedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: trip.FieldStartedAt,
+		})
+	}
+	if value, ok := tu.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trip.FieldEndedAt,
+		})
+	}
+	if tu.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: trip.FieldEndedAt,
+		})
+	}
+	if value, ok := tu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trip.FieldCreatedAt,
+		})
+	}
+	if tu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trip.CarTable,
+			Columns: []string{trip.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trip.CarTable,
+			Columns: []string{trip.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trip.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TripUpdateOne is the builder for updating a single Trip entity.
+type TripUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TripMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tuo *TripUpdateOne) SetTenantID(i int64) *TripUpdateOne {
+	tuo.mutation.ResetTenantID()
+	tuo.mutation.SetTenantID(i)
+	return tuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (tuo *TripUpdateOne) SetNillableTenantID(i *int64) *TripUpdateOne {
+	if i != nil {
+		tuo.SetTenantID(*i)
+	}
+	return tuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (tuo *TripUpdateOne) AddTenantID(i int64) *TripUpdateOne {
+	tuo.mutation.AddTenantID(i)
+	return tuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (tuo *TripUpdateOne) ClearTenantID() *TripUpdateOne {
+	tuo.mutation.ClearTenantID()
+	return tuo
+}
+
+// SetCarID sets the "car_id" field.
+func (tuo *TripUpdateOne) SetCarID(i int64) *TripUpdateOne {
+	tuo.mutation.SetCarID(i)
+	return tuo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (tuo *TripUpdateOne) SetNillableCarID(i *int64) *TripUpdateOne {
+	if i != nil {
+		tuo.SetCarID(*i)
+	}
+	return tuo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (tuo *TripUpdateOne) ClearCarID() *TripUpdateOne {
+	tuo.mutation.ClearCarID()
+	return tuo
+}
+
+// SetDriverID sets the "driver_id" field.
+func (tuo *TripUpdateOne) SetDriverID(i int64) *TripUpdateOne {
+	tuo.mutation.ResetDriverID()
+	tuo.mutation.SetDriverID(i)
+	return tuo
+}
+
+// SetNillableDriverID sets the "driver_id" field if the given value is not nil.
+func (tuo *TripUpdateOne) SetNillableDriverID(i *int64) *TripUpdateOne {
+	if i != nil {
+		tuo.SetDriverID(*i)
+	}
+	return tuo
+}
+
+// AddDriverID adds i to the "driver_id" field.
+func (tuo *TripUpdateOne) AddDriverID(i int64) *TripUpdateOne {
+	tuo.mutation.AddDriverID(i)
+	return tuo
+}
+
+// ClearDriverID clears the value of the "driver_id" field.
+func (tuo *TripUpdateOne) ClearDriverID() *TripUpdateOne {
+	tuo.mutation.ClearDriverID()
+	return tuo
+}
+
+// SetPurpose sets the "purpose" field.
+func (tuo *TripUpdateOne) SetPurpose(s string) *TripUpdateOne {
+	tuo.mutation.SetPurpose(s)
+	return tuo
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (tuo *TripUpdateOne) SetNillablePurpose(s *string) *TripUpdateOne {
+	if s != nil {
+		tuo.SetPurpose(*s)
+	}
+	return tuo
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (tuo *TripUpdateOne) ClearPurpose() *TripUpdateOne {
+	tuo.mutation.ClearPurpose()
+	return tuo
+}
+
+// SetStartOdometer sets the "start_odometer" field.
+func (tuo *TripUpdateOne) SetStartOdometer(i int64) *TripUpdateOne {
+	tuo.mutation.ResetStartOdometer()
+	tuo.mutation.SetStartOdometer(i)
+	return tuo
+}
+
+// SetNillableStartOdometer sets the "start_odometer" field if the given value is not nil.
+func (tuo *TripUpdateOne) SetNillableStartOdometer(i *int64) *TripUpdateOne {
+	if i != nil {
+		tuo.SetStartOdometer(*i)
+	}
+	return tuo
+}
+
+// AddStartOdometer adds i to the "start_odometer" field.
+func (tuo *TripUpdateOne) AddStartOdometer(i int64) *TripUpdateOne {
+	tuo.mutation.AddStartOdometer(i)
+	return tuo
+}
+
+// ClearStartOdometer clears the value of the "start_odometer" field.
+func (tuo *TripUpdateOne) ClearStartOdometer() *TripUpdateOne {
+	tuo.mutation.ClearStartOdometer()
+	return tuo
+}
+
+// SetEndOdometer sets the "end_odometer" field.
+func (tuo *TripUpdateOne) SetEndOdometer(i int64) *TripUpdateOne {
+	tuo.mutation.ResetEndOdometer()
+	tuo.mutation.SetEndOdometer(i)
+	return tuo
+}
+
+// SetNillableEndOdometer sets the "end_odometer" field if the given value is not nil.
+func (tuo *TripUpdateOne) SetNillableEndOdometer(i *int64) *TripUpdateOne {
+	if i != nil {
+		tuo.SetEndOdometer(*i)
+	}
+	return tuo
+}
+
+// AddEndOdometer adds i to the "end_odometer" field.
+func (tuo *TripUpdateOne) AddEndOdometer(i int64) *TripUpdateOne {
+	tuo.mutation.AddEndOdometer(i)
+	return tuo
+}
+
+// ClearEndOdometer clears the value of the "end_odometer" field.
+func (tuo *TripUpdateOne) ClearEndOdometer() *TripUpdateOne {
+	tuo.mutation.ClearEndOdometer()
+	return tuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tuo *TripUpdateOne) SetStartedAt(t time.Time) *TripUpdateOne {
+	tuo.mutation.SetStartedAt(t)
+	return tuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tuo *TripUpdateOne) SetNillableStartedAt(t *time.Time) *TripUpdateOne {
+	if t != nil {
+		tuo.SetStartedAt(*t)
+	}
+	return tuo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (tuo *TripUpdateOne) ClearStartedAt() *TripUpdateOne {
+	tuo.mutation.ClearStartedAt()
+	return tuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (tuo *TripUpdateOne) SetEndedAt(t time.Time) *TripUpdateOne {
+	tuo.mutation.SetEndedAt(t)
+	return tuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (tuo *TripUpdateOne) SetNillableEndedAt(t *time.Time) *TripUpdateOne {
+	if t != nil {
+		tuo.SetEndedAt(*t)
+	}
+	return tuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (tuo *TripUpdateOne) ClearEndedAt() *TripUpdateOne {
+	tuo.mutation.ClearEndedAt()
+	return tuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tuo *TripUpdateOne) SetCreatedAt(t time.Time) *TripUpdateOne {
+	tuo.mutation.SetCreatedAt(t)
+	return tuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tuo *TripUpdateOne) SetNillableCreatedAt(t *time.Time) *TripUpdateOne {
+	if t != nil {
+		tuo.SetCreatedAt(*t)
+	}
+	return tuo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (tuo *TripUpdateOne) SetCar(c *Car) *TripUpdateOne {
+	return tuo.SetCarID(c.ID)
+}
+
+// Mutation returns the TripMutation object of the builder.
+func (tuo *TripUpdateOne) Mutation() *TripMutation {
+	return tuo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (tuo *TripUpdateOne) ClearCar() *TripUpdateOne {
+	tuo.mutation.ClearCar()
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TripUpdateOne) Select(field string, fields ...string) *TripUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Trip entity.
+func (tuo *TripUpdateOne) Save(ctx context.Context) (*Trip, error) {
+	var (
+		err  error
+		node *Trip
+	)
+	if len(tuo.hooks) == 0 {
+		node, err = tuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TripMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tuo.mutation = mutation
+			node, err = tuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tuo.hooks) - 1; i >= 0; i-- {
+			if tuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, tuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Trip)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TripMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TripUpdateOne) SaveX(ctx context.Context) *Trip {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TripUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TripUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tuo *TripUpdateOne) sqlSave(ctx context.Context) (_node *Trip, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   trip.Table,
+			Columns: trip.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: trip.FieldID,
+			},
+		},
+	}
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Trip.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trip.FieldID)
+		for _, f := range fields {
+			if !trip.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != trip.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldTenantID,
+		})
+	}
+	if value, ok := tuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldTenantID,
+		})
+	}
+	if tuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: trip.FieldTenantID,
+		})
+	}
+	if value, ok := tuo.mutation.DriverID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldDriverID,
+		})
+	}
+	if value, ok := tuo.mutation.AddedDriverID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldDriverID,
+		})
+	}
+	if tuo.mutation.DriverIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: trip.FieldDriverID,
+		})
+	}
+	if value, ok := tuo.mutation.Purpose(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: trip.FieldPurpose,
+		})
+	}
+	if tuo.mutation.PurposeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: trip.FieldPurpose,
+		})
+	}
+	if value, ok := tuo.mutation.StartOdometer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldStartOdometer,
+		})
+	}
+	if value, ok := tuo.mutation.AddedStartOdometer(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldStartOdometer,
+		})
+	}
+	if tuo.mutation.StartOdometerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: trip.FieldStartOdometer,
+		})
+	}
+	if value, ok := tuo.mutation.EndOdometer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldEndOdometer,
+		})
+	}
+	if value, ok := tuo.mutation.AddedEndOdometer(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: trip.FieldEndOdometer,
+		})
+	}
+	if tuo.mutation.EndOdometerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: trip.FieldEndOdometer,
+		})
+	}
+	if value, ok := tuo.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trip.FieldStartedAt,
+		})
+	}
+	if tuo.mutation.StartedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: trip.FieldStartedAt,
+		})
+	}
+	if value, ok := tuo.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trip.FieldEndedAt,
+		})
+	}
+	if tuo.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: trip.FieldEndedAt,
+		})
+	}
+	if value, ok := tuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trip.FieldCreatedAt,
+		})
+	}
+	if tuo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trip.CarTable,
+			Columns: []string{trip.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trip.CarTable,
+			Columns: []string{trip.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Trip{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trip.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
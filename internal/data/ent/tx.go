@@ -14,8 +14,64 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// AttributeDefinition is the client for interacting with the AttributeDefinition builders.
+	AttributeDefinition *AttributeDefinitionClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Brand is the client for interacting with the Brand builders.
+	Brand *BrandClient
 	// Car is the client for interacting with the Car builders.
 	Car *CarClient
+	// CarAttribute is the client for interacting with the CarAttribute builders.
+	CarAttribute *CarAttributeClient
+	// CarRecall is the client for interacting with the CarRecall builders.
+	CarRecall *CarRecallClient
+	// CarWarranty is the client for interacting with the CarWarranty builders.
+	CarWarranty *CarWarrantyClient
+	// ChargingSession is the client for interacting with the ChargingSession builders.
+	ChargingSession *ChargingSessionClient
+	// Fleet is the client for interacting with the Fleet builders.
+	Fleet *FleetClient
+	// Geofence is the client for interacting with the Geofence builders.
+	Geofence *GeofenceClient
+	// Incident is the client for interacting with the Incident builders.
+	Incident *IncidentClient
+	// InsurancePolicy is the client for interacting with the InsurancePolicy builders.
+	InsurancePolicy *InsurancePolicyClient
+	// JobRun is the client for interacting with the JobRun builders.
+	JobRun *JobRunClient
+	// LeaseContract is the client for interacting with the LeaseContract builders.
+	LeaseContract *LeaseContractClient
+	// Listing is the client for interacting with the Listing builders.
+	Listing *ListingClient
+	// MaintenanceRecord is the client for interacting with the MaintenanceRecord builders.
+	MaintenanceRecord *MaintenanceRecordClient
+	// OdometerReading is the client for interacting with the OdometerReading builders.
+	OdometerReading *OdometerReadingClient
+	// OwnerHistory is the client for interacting with the OwnerHistory builders.
+	OwnerHistory *OwnerHistoryClient
+	// Recall is the client for interacting with the Recall builders.
+	Recall *RecallClient
+	// Refuel is the client for interacting with the Refuel builders.
+	Refuel *RefuelClient
+	// Reservation is the client for interacting with the Reservation builders.
+	Reservation *ReservationClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TradeRecord is the client for interacting with the TradeRecord builders.
+	TradeRecord *TradeRecordClient
+	// Transfer is the client for interacting with the Transfer builders.
+	Transfer *TransferClient
+	// Trip is the client for interacting with the Trip builders.
+	Trip *TripClient
+	// VehicleModel is the client for interacting with the VehicleModel builders.
+	VehicleModel *VehicleModelClient
+	// Violation is the client for interacting with the Violation builders.
+	Violation *ViolationClient
+	// WarrantyDefinition is the client for interacting with the WarrantyDefinition builders.
+	WarrantyDefinition *WarrantyDefinitionClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,7 +207,35 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.AttributeDefinition = NewAttributeDefinitionClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Brand = NewBrandClient(tx.config)
 	tx.Car = NewCarClient(tx.config)
+	tx.CarAttribute = NewCarAttributeClient(tx.config)
+	tx.CarRecall = NewCarRecallClient(tx.config)
+	tx.CarWarranty = NewCarWarrantyClient(tx.config)
+	tx.ChargingSession = NewChargingSessionClient(tx.config)
+	tx.Fleet = NewFleetClient(tx.config)
+	tx.Geofence = NewGeofenceClient(tx.config)
+	tx.Incident = NewIncidentClient(tx.config)
+	tx.InsurancePolicy = NewInsurancePolicyClient(tx.config)
+	tx.JobRun = NewJobRunClient(tx.config)
+	tx.LeaseContract = NewLeaseContractClient(tx.config)
+	tx.Listing = NewListingClient(tx.config)
+	tx.MaintenanceRecord = NewMaintenanceRecordClient(tx.config)
+	tx.OdometerReading = NewOdometerReadingClient(tx.config)
+	tx.OwnerHistory = NewOwnerHistoryClient(tx.config)
+	tx.Recall = NewRecallClient(tx.config)
+	tx.Refuel = NewRefuelClient(tx.config)
+	tx.Reservation = NewReservationClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.TradeRecord = NewTradeRecordClient(tx.config)
+	tx.Transfer = NewTransferClient(tx.config)
+	tx.Trip = NewTripClient(tx.config)
+	tx.VehicleModel = NewVehicleModelClient(tx.config)
+	tx.Violation = NewViolationClient(tx.config)
+	tx.WarrantyDefinition = NewWarrantyDefinitionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +245,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Car.QueryXXX(), the query will be executed
+// applies a query, for example: Attachment.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
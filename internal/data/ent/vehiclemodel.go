@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/brand"
+	"car-service/internal/data/ent/vehiclemodel"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// VehicleModel is the model entity for the VehicleModel schema.
+type VehicleModel struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// BrandID holds the value of the "brand_id" field.
+	BrandID int64 `json:"brand_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// FuelType holds the value of the "fuel_type" field.
+	FuelType string `json:"fuel_type,omitempty"`
+	// Seats holds the value of the "seats" field.
+	Seats int32 `json:"seats,omitempty"`
+	// BodyType holds the value of the "body_type" field.
+	BodyType string `json:"body_type,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VehicleModelQuery when eager-loading is set.
+	Edges VehicleModelEdges `json:"edges"`
+}
+
+// VehicleModelEdges holds the relations/edges for other nodes in the graph.
+type VehicleModelEdges struct {
+	// Brand holds the value of the brand edge.
+	Brand *Brand `json:"brand,omitempty"`
+	// Cars holds the value of the cars edge.
+	Cars []*Car `json:"cars,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BrandOrErr returns the Brand value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VehicleModelEdges) BrandOrErr() (*Brand, error) {
+	if e.loadedTypes[0] {
+		if e.Brand == nil {
+			// The edge brand was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: brand.Label}
+		}
+		return e.Brand, nil
+	}
+	return nil, &NotLoadedError{edge: "brand"}
+}
+
+// CarsOrErr returns the Cars value or an error if the edge
+// was not loaded in eager-loading.
+func (e VehicleModelEdges) CarsOrErr() ([]*Car, error) {
+	if e.loadedTypes[1] {
+		return e.Cars, nil
+	}
+	return nil, &NotLoadedError{edge: "cars"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VehicleModel) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vehiclemodel.FieldID, vehiclemodel.FieldBrandID, vehiclemodel.FieldSeats:
+			values[i] = new(sql.NullInt64)
+		case vehiclemodel.FieldName, vehiclemodel.FieldFuelType, vehiclemodel.FieldBodyType:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type VehicleModel", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VehicleModel fields.
+func (vm *VehicleModel) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vehiclemodel.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vm.ID = int64(value.Int64)
+		case vehiclemodel.FieldBrandID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field brand_id", values[i])
+			} else if value.Valid {
+				vm.BrandID = value.Int64
+			}
+		case vehiclemodel.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				vm.Name = value.String
+			}
+		case vehiclemodel.FieldFuelType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fuel_type", values[i])
+			} else if value.Valid {
+				vm.FuelType = value.String
+			}
+		case vehiclemodel.FieldSeats:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seats", values[i])
+			} else if value.Valid {
+				vm.Seats = int32(value.Int64)
+			}
+		case vehiclemodel.FieldBodyType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body_type", values[i])
+			} else if value.Valid {
+				vm.BodyType = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryBrand queries the "brand" edge of the VehicleModel entity.
+func (vm *VehicleModel) QueryBrand() *BrandQuery {
+	return (&VehicleModelClient{config: vm.config}).QueryBrand(vm)
+}
+
+// QueryCars queries the "cars" edge of the VehicleModel entity.
+func (vm *VehicleModel) QueryCars() *CarQuery {
+	return (&VehicleModelClient{config: vm.config}).QueryCars(vm)
+}
+
+// Update returns a builder for updating this VehicleModel.
+// Note that you need to call VehicleModel.Unwrap() before calling this method if this VehicleModel
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vm *VehicleModel) Update() *VehicleModelUpdateOne {
+	return (&VehicleModelClient{config: vm.config}).UpdateOne(vm)
+}
+
+// Unwrap unwraps the VehicleModel entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vm *VehicleModel) Unwrap() *VehicleModel {
+	_tx, ok := vm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VehicleModel is not a transactional entity")
+	}
+	vm.config.driver = _tx.drv
+	return vm
+}
+
+// String implements the fmt.Stringer.
+func (vm *VehicleModel) String() string {
+	var builder strings.Builder
+	builder.WriteString("VehicleModel(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vm.ID))
+	builder.WriteString("brand_id=")
+	builder.WriteString(fmt.Sprintf("%v", vm.BrandID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(vm.Name)
+	builder.WriteString(", ")
+	builder.WriteString("fuel_type=")
+	builder.WriteString(vm.FuelType)
+	builder.WriteString(", ")
+	builder.WriteString("seats=")
+	builder.WriteString(fmt.Sprintf("%v", vm.Seats))
+	builder.WriteString(", ")
+	builder.WriteString("body_type=")
+	builder.WriteString(vm.BodyType)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VehicleModels is a parsable slice of VehicleModel.
+type VehicleModels []*VehicleModel
+
+func (vm VehicleModels) config(cfg config) {
+	for _i := range vm {
+		vm[_i].config = cfg
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehiclemodel
+
+const (
+	// Label holds the string label denoting the vehiclemodel type in the database.
+	Label = "vehicle_model"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBrandID holds the string denoting the brand_id field in the database.
+	FieldBrandID = "brand_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldFuelType holds the string denoting the fuel_type field in the database.
+	FieldFuelType = "fuel_type"
+	// FieldSeats holds the string denoting the seats field in the database.
+	FieldSeats = "seats"
+	// FieldBodyType holds the string denoting the body_type field in the database.
+	FieldBodyType = "body_type"
+	// EdgeBrand holds the string denoting the brand edge name in mutations.
+	EdgeBrand = "brand"
+	// EdgeCars holds the string denoting the cars edge name in mutations.
+	EdgeCars = "cars"
+	// Table holds the table name of the vehiclemodel in the database.
+	Table = "vehicle_model"
+	// BrandTable is the table that holds the brand relation/edge.
+	BrandTable = "vehicle_model"
+	// BrandInverseTable is the table name for the Brand entity.
+	// It exists in this package in order to avoid circular dependency with the "brand" package.
+	BrandInverseTable = "brand"
+	// BrandColumn is the table column denoting the brand relation/edge.
+	BrandColumn = "brand_id"
+	// CarsTable is the table that holds the cars relation/edge.
+	CarsTable = "car"
+	// CarsInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CarsInverseTable = "car"
+	// CarsColumn is the table column denoting the cars relation/edge.
+	CarsColumn = "model_id"
+)
+
+// Columns holds all SQL columns for vehiclemodel fields.
+var Columns = []string{
+	FieldID,
+	FieldBrandID,
+	FieldName,
+	FieldFuelType,
+	FieldSeats,
+	FieldBodyType,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
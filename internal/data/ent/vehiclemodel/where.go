@@ -0,0 +1,731 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehiclemodel
+
+import (
+	"car-service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// BrandID applies equality check predicate on the "brand_id" field. It's identical to BrandIDEQ.
+func BrandID(v int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBrandID), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// FuelType applies equality check predicate on the "fuel_type" field. It's identical to FuelTypeEQ.
+func FuelType(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFuelType), v))
+	})
+}
+
+// Seats applies equality check predicate on the "seats" field. It's identical to SeatsEQ.
+func Seats(v int32) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeats), v))
+	})
+}
+
+// BodyType applies equality check predicate on the "body_type" field. It's identical to BodyTypeEQ.
+func BodyType(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBodyType), v))
+	})
+}
+
+// BrandIDEQ applies the EQ predicate on the "brand_id" field.
+func BrandIDEQ(v int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBrandID), v))
+	})
+}
+
+// BrandIDNEQ applies the NEQ predicate on the "brand_id" field.
+func BrandIDNEQ(v int64) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBrandID), v))
+	})
+}
+
+// BrandIDIn applies the In predicate on the "brand_id" field.
+func BrandIDIn(vs ...int64) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBrandID), v...))
+	})
+}
+
+// BrandIDNotIn applies the NotIn predicate on the "brand_id" field.
+func BrandIDNotIn(vs ...int64) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBrandID), v...))
+	})
+}
+
+// BrandIDIsNil applies the IsNil predicate on the "brand_id" field.
+func BrandIDIsNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBrandID)))
+	})
+}
+
+// BrandIDNotNil applies the NotNil predicate on the "brand_id" field.
+func BrandIDNotNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBrandID)))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldName)))
+	})
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldName)))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// FuelTypeEQ applies the EQ predicate on the "fuel_type" field.
+func FuelTypeEQ(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeNEQ applies the NEQ predicate on the "fuel_type" field.
+func FuelTypeNEQ(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeIn applies the In predicate on the "fuel_type" field.
+func FuelTypeIn(vs ...string) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFuelType), v...))
+	})
+}
+
+// FuelTypeNotIn applies the NotIn predicate on the "fuel_type" field.
+func FuelTypeNotIn(vs ...string) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFuelType), v...))
+	})
+}
+
+// FuelTypeGT applies the GT predicate on the "fuel_type" field.
+func FuelTypeGT(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeGTE applies the GTE predicate on the "fuel_type" field.
+func FuelTypeGTE(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeLT applies the LT predicate on the "fuel_type" field.
+func FuelTypeLT(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeLTE applies the LTE predicate on the "fuel_type" field.
+func FuelTypeLTE(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeContains applies the Contains predicate on the "fuel_type" field.
+func FuelTypeContains(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeHasPrefix applies the HasPrefix predicate on the "fuel_type" field.
+func FuelTypeHasPrefix(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeHasSuffix applies the HasSuffix predicate on the "fuel_type" field.
+func FuelTypeHasSuffix(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeIsNil applies the IsNil predicate on the "fuel_type" field.
+func FuelTypeIsNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldFuelType)))
+	})
+}
+
+// FuelTypeNotNil applies the NotNil predicate on the "fuel_type" field.
+func FuelTypeNotNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldFuelType)))
+	})
+}
+
+// FuelTypeEqualFold applies the EqualFold predicate on the "fuel_type" field.
+func FuelTypeEqualFold(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldFuelType), v))
+	})
+}
+
+// FuelTypeContainsFold applies the ContainsFold predicate on the "fuel_type" field.
+func FuelTypeContainsFold(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldFuelType), v))
+	})
+}
+
+// SeatsEQ applies the EQ predicate on the "seats" field.
+func SeatsEQ(v int32) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeats), v))
+	})
+}
+
+// SeatsNEQ applies the NEQ predicate on the "seats" field.
+func SeatsNEQ(v int32) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSeats), v))
+	})
+}
+
+// SeatsIn applies the In predicate on the "seats" field.
+func SeatsIn(vs ...int32) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSeats), v...))
+	})
+}
+
+// SeatsNotIn applies the NotIn predicate on the "seats" field.
+func SeatsNotIn(vs ...int32) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSeats), v...))
+	})
+}
+
+// SeatsGT applies the GT predicate on the "seats" field.
+func SeatsGT(v int32) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSeats), v))
+	})
+}
+
+// SeatsGTE applies the GTE predicate on the "seats" field.
+func SeatsGTE(v int32) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSeats), v))
+	})
+}
+
+// SeatsLT applies the LT predicate on the "seats" field.
+func SeatsLT(v int32) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSeats), v))
+	})
+}
+
+// SeatsLTE applies the LTE predicate on the "seats" field.
+func SeatsLTE(v int32) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSeats), v))
+	})
+}
+
+// SeatsIsNil applies the IsNil predicate on the "seats" field.
+func SeatsIsNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSeats)))
+	})
+}
+
+// SeatsNotNil applies the NotNil predicate on the "seats" field.
+func SeatsNotNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSeats)))
+	})
+}
+
+// BodyTypeEQ applies the EQ predicate on the "body_type" field.
+func BodyTypeEQ(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeNEQ applies the NEQ predicate on the "body_type" field.
+func BodyTypeNEQ(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeIn applies the In predicate on the "body_type" field.
+func BodyTypeIn(vs ...string) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBodyType), v...))
+	})
+}
+
+// BodyTypeNotIn applies the NotIn predicate on the "body_type" field.
+func BodyTypeNotIn(vs ...string) predicate.VehicleModel {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBodyType), v...))
+	})
+}
+
+// BodyTypeGT applies the GT predicate on the "body_type" field.
+func BodyTypeGT(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeGTE applies the GTE predicate on the "body_type" field.
+func BodyTypeGTE(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeLT applies the LT predicate on the "body_type" field.
+func BodyTypeLT(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeLTE applies the LTE predicate on the "body_type" field.
+func BodyTypeLTE(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeContains applies the Contains predicate on the "body_type" field.
+func BodyTypeContains(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeHasPrefix applies the HasPrefix predicate on the "body_type" field.
+func BodyTypeHasPrefix(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeHasSuffix applies the HasSuffix predicate on the "body_type" field.
+func BodyTypeHasSuffix(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeIsNil applies the IsNil predicate on the "body_type" field.
+func BodyTypeIsNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBodyType)))
+	})
+}
+
+// BodyTypeNotNil applies the NotNil predicate on the "body_type" field.
+func BodyTypeNotNil() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBodyType)))
+	})
+}
+
+// BodyTypeEqualFold applies the EqualFold predicate on the "body_type" field.
+func BodyTypeEqualFold(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBodyType), v))
+	})
+}
+
+// BodyTypeContainsFold applies the ContainsFold predicate on the "body_type" field.
+func BodyTypeContainsFold(v string) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBodyType), v))
+	})
+}
+
+// HasBrand applies the HasEdge predicate on the "brand" edge.
+func HasBrand() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BrandTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BrandTable, BrandColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBrandWith applies the HasEdge predicate on the "brand" edge with a given conditions (other predicates).
+func HasBrandWith(preds ...predicate.Brand) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BrandInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BrandTable, BrandColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCars applies the HasEdge predicate on the "cars" edge.
+func HasCars() predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CarsTable, CarsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarsWith applies the HasEdge predicate on the "cars" edge with a given conditions (other predicates).
+func HasCarsWith(preds ...predicate.Car) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CarsTable, CarsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VehicleModel) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VehicleModel) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VehicleModel) predicate.VehicleModel {
+	return predicate.VehicleModel(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
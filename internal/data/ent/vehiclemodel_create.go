@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/brand"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/vehiclemodel"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleModelCreate is the builder for creating a VehicleModel entity.
+type VehicleModelCreate struct {
+	config
+	mutation *VehicleModelMutation
+	hooks    []Hook
+}
+
+// SetBrandID sets the "brand_id" field.
+func (vmc *VehicleModelCreate) SetBrandID(i int64) *VehicleModelCreate {
+	vmc.mutation.SetBrandID(i)
+	return vmc
+}
+
+// SetNillableBrandID sets the "brand_id" field if the given value is not nil.
+func (vmc *VehicleModelCreate) SetNillableBrandID(i *int64) *VehicleModelCreate {
+	if i != nil {
+		vmc.SetBrandID(*i)
+	}
+	return vmc
+}
+
+// SetName sets the "name" field.
+func (vmc *VehicleModelCreate) SetName(s string) *VehicleModelCreate {
+	vmc.mutation.SetName(s)
+	return vmc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vmc *VehicleModelCreate) SetNillableName(s *string) *VehicleModelCreate {
+	if s != nil {
+		vmc.SetName(*s)
+	}
+	return vmc
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (vmc *VehicleModelCreate) SetFuelType(s string) *VehicleModelCreate {
+	vmc.mutation.SetFuelType(s)
+	return vmc
+}
+
+// SetNillableFuelType sets the "fuel_type" field if the given value is not nil.
+func (vmc *VehicleModelCreate) SetNillableFuelType(s *string) *VehicleModelCreate {
+	if s != nil {
+		vmc.SetFuelType(*s)
+	}
+	return vmc
+}
+
+// SetSeats sets the "seats" field.
+func (vmc *VehicleModelCreate) SetSeats(i int32) *VehicleModelCreate {
+	vmc.mutation.SetSeats(i)
+	return vmc
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (vmc *VehicleModelCreate) SetNillableSeats(i *int32) *VehicleModelCreate {
+	if i != nil {
+		vmc.SetSeats(*i)
+	}
+	return vmc
+}
+
+// SetBodyType sets the "body_type" field.
+func (vmc *VehicleModelCreate) SetBodyType(s string) *VehicleModelCreate {
+	vmc.mutation.SetBodyType(s)
+	return vmc
+}
+
+// SetNillableBodyType sets the "body_type" field if the given value is not nil.
+func (vmc *VehicleModelCreate) SetNillableBodyType(s *string) *VehicleModelCreate {
+	if s != nil {
+		vmc.SetBodyType(*s)
+	}
+	return vmc
+}
+
+// SetID sets the "id" field.
+func (vmc *VehicleModelCreate) SetID(i int64) *VehicleModelCreate {
+	vmc.mutation.SetID(i)
+	return vmc
+}
+
+// SetBrand sets the "brand" edge to the Brand entity.
+func (vmc *VehicleModelCreate) SetBrand(b *Brand) *VehicleModelCreate {
+	return vmc.SetBrandID(b.ID)
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (vmc *VehicleModelCreate) AddCarIDs(ids ...int64) *VehicleModelCreate {
+	vmc.mutation.AddCarIDs(ids...)
+	return vmc
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (vmc *VehicleModelCreate) AddCars(c ...*Car) *VehicleModelCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vmc.AddCarIDs(ids...)
+}
+
+// Mutation returns the VehicleModelMutation object of the builder.
+func (vmc *VehicleModelCreate) Mutation() *VehicleModelMutation {
+	return vmc.mutation
+}
+
+// Save creates the VehicleModel in the database.
+func (vmc *VehicleModelCreate) Save(ctx context.Context) (*VehicleModel, error) {
+	var (
+		err  error
+		node *VehicleModel
+	)
+	if len(vmc.hooks) == 0 {
+		if err = vmc.check(); err != nil {
+			return nil, err
+		}
+		node, err = vmc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VehicleModelMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = vmc.check(); err != nil {
+				return nil, err
+			}
+			vmc.mutation = mutation
+			if node, err = vmc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(vmc.hooks) - 1; i >= 0; i-- {
+			if vmc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vmc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, vmc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*VehicleModel)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from VehicleModelMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vmc *VehicleModelCreate) SaveX(ctx context.Context) *VehicleModel {
+	v, err := vmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vmc *VehicleModelCreate) Exec(ctx context.Context) error {
+	_, err := vmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmc *VehicleModelCreate) ExecX(ctx context.Context) {
+	if err := vmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vmc *VehicleModelCreate) check() error {
+	return nil
+}
+
+func (vmc *VehicleModelCreate) sqlSave(ctx context.Context) (*VehicleModel, error) {
+	_node, _spec := vmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (vmc *VehicleModelCreate) createSpec() (*VehicleModel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VehicleModel{config: vmc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: vehiclemodel.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: vehiclemodel.FieldID,
+			},
+		}
+	)
+	if id, ok := vmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := vmc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vehiclemodel.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := vmc.mutation.FuelType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vehiclemodel.FieldFuelType,
+		})
+		_node.FuelType = value
+	}
+	if value, ok := vmc.mutation.Seats(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: vehiclemodel.FieldSeats,
+		})
+		_node.Seats = value
+	}
+	if value, ok := vmc.mutation.BodyType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vehiclemodel.FieldBodyType,
+		})
+		_node.BodyType = value
+	}
+	if nodes := vmc.mutation.BrandIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vehiclemodel.BrandTable,
+			Columns: []string{vehiclemodel.BrandColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: brand.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BrandID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := vmc.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehiclemodel.CarsTable,
+			Columns: []string{vehiclemodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// VehicleModelCreateBulk is the builder for creating many VehicleModel entities in bulk.
+type VehicleModelCreateBulk struct {
+	config
+	builders []*VehicleModelCreate
+}
+
+// Save creates the VehicleModel entities in the database.
+func (vmcb *VehicleModelCreateBulk) Save(ctx context.Context) ([]*VehicleModel, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(vmcb.builders))
+	nodes := make([]*VehicleModel, len(vmcb.builders))
+	mutators := make([]Mutator, len(vmcb.builders))
+	for i := range vmcb.builders {
+		func(i int, root context.Context) {
+			builder := vmcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VehicleModelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vmcb *VehicleModelCreateBulk) SaveX(ctx context.Context) []*VehicleModel {
+	v, err := vmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vmcb *VehicleModelCreateBulk) Exec(ctx context.Context) error {
+	_, err := vmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmcb *VehicleModelCreateBulk) ExecX(ctx context.Context) {
+	if err := vmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
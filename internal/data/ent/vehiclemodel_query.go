@@ -0,0 +1,708 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/brand"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/vehiclemodel"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleModelQuery is the builder for querying VehicleModel entities.
+type VehicleModelQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.VehicleModel
+	// eager-loading edges.
+	withBrand *BrandQuery
+	withCars  *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VehicleModelQuery builder.
+func (vmq *VehicleModelQuery) Where(ps ...predicate.VehicleModel) *VehicleModelQuery {
+	vmq.predicates = append(vmq.predicates, ps...)
+	return vmq
+}
+
+// Limit adds a limit step to the query.
+func (vmq *VehicleModelQuery) Limit(limit int) *VehicleModelQuery {
+	vmq.limit = &limit
+	return vmq
+}
+
+// Offset adds an offset step to the query.
+func (vmq *VehicleModelQuery) Offset(offset int) *VehicleModelQuery {
+	vmq.offset = &offset
+	return vmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vmq *VehicleModelQuery) Unique(unique bool) *VehicleModelQuery {
+	vmq.unique = &unique
+	return vmq
+}
+
+// Order adds an order step to the query.
+func (vmq *VehicleModelQuery) Order(o ...OrderFunc) *VehicleModelQuery {
+	vmq.order = append(vmq.order, o...)
+	return vmq
+}
+
+// QueryBrand chains the current query on the "brand" edge.
+func (vmq *VehicleModelQuery) QueryBrand() *BrandQuery {
+	query := &BrandQuery{config: vmq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehiclemodel.Table, vehiclemodel.FieldID, selector),
+			sqlgraph.To(brand.Table, brand.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vehiclemodel.BrandTable, vehiclemodel.BrandColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCars chains the current query on the "cars" edge.
+func (vmq *VehicleModelQuery) QueryCars() *CarQuery {
+	query := &CarQuery{config: vmq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehiclemodel.Table, vehiclemodel.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vehiclemodel.CarsTable, vehiclemodel.CarsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first VehicleModel entity from the query.
+// Returns a *NotFoundError when no VehicleModel was found.
+func (vmq *VehicleModelQuery) First(ctx context.Context) (*VehicleModel, error) {
+	nodes, err := vmq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{vehiclemodel.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vmq *VehicleModelQuery) FirstX(ctx context.Context) *VehicleModel {
+	node, err := vmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VehicleModel ID from the query.
+// Returns a *NotFoundError when no VehicleModel ID was found.
+func (vmq *VehicleModelQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = vmq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{vehiclemodel.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vmq *VehicleModelQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := vmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VehicleModel entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VehicleModel entity is found.
+// Returns a *NotFoundError when no VehicleModel entities are found.
+func (vmq *VehicleModelQuery) Only(ctx context.Context) (*VehicleModel, error) {
+	nodes, err := vmq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{vehiclemodel.Label}
+	default:
+		return nil, &NotSingularError{vehiclemodel.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vmq *VehicleModelQuery) OnlyX(ctx context.Context) *VehicleModel {
+	node, err := vmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VehicleModel ID in the query.
+// Returns a *NotSingularError when more than one VehicleModel ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vmq *VehicleModelQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = vmq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{vehiclemodel.Label}
+	default:
+		err = &NotSingularError{vehiclemodel.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vmq *VehicleModelQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := vmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VehicleModels.
+func (vmq *VehicleModelQuery) All(ctx context.Context) ([]*VehicleModel, error) {
+	if err := vmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return vmq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vmq *VehicleModelQuery) AllX(ctx context.Context) []*VehicleModel {
+	nodes, err := vmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VehicleModel IDs.
+func (vmq *VehicleModelQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := vmq.Select(vehiclemodel.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vmq *VehicleModelQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := vmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vmq *VehicleModelQuery) Count(ctx context.Context) (int, error) {
+	if err := vmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return vmq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vmq *VehicleModelQuery) CountX(ctx context.Context) int {
+	count, err := vmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vmq *VehicleModelQuery) Exist(ctx context.Context) (bool, error) {
+	if err := vmq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return vmq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vmq *VehicleModelQuery) ExistX(ctx context.Context) bool {
+	exist, err := vmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VehicleModelQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vmq *VehicleModelQuery) Clone() *VehicleModelQuery {
+	if vmq == nil {
+		return nil
+	}
+	return &VehicleModelQuery{
+		config:     vmq.config,
+		limit:      vmq.limit,
+		offset:     vmq.offset,
+		order:      append([]OrderFunc{}, vmq.order...),
+		predicates: append([]predicate.VehicleModel{}, vmq.predicates...),
+		withBrand:  vmq.withBrand.Clone(),
+		withCars:   vmq.withCars.Clone(),
+		// clone intermediate query.
+		sql:    vmq.sql.Clone(),
+		path:   vmq.path,
+		unique: vmq.unique,
+	}
+}
+
+// WithBrand tells the query-builder to eager-load the nodes that are connected to
+// the "brand" edge. The optional arguments are used to configure the query builder of the edge.
+func (vmq *VehicleModelQuery) WithBrand(opts ...func(*BrandQuery)) *VehicleModelQuery {
+	query := &BrandQuery{config: vmq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	vmq.withBrand = query
+	return vmq
+}
+
+// WithCars tells the query-builder to eager-load the nodes that are connected to
+// the "cars" edge. The optional arguments are used to configure the query builder of the edge.
+func (vmq *VehicleModelQuery) WithCars(opts ...func(*CarQuery)) *VehicleModelQuery {
+	query := &CarQuery{config: vmq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	vmq.withCars = query
+	return vmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BrandID int64 `json:"brand_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VehicleModel.Query().
+//		GroupBy(vehiclemodel.FieldBrandID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (vmq *VehicleModelQuery) GroupBy(field string, fields ...string) *VehicleModelGroupBy {
+	grbuild := &VehicleModelGroupBy{config: vmq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := vmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return vmq.sqlQuery(ctx), nil
+	}
+	grbuild.label = vehiclemodel.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BrandID int64 `json:"brand_id,omitempty"`
+//	}
+//
+//	client.VehicleModel.Query().
+//		Select(vehiclemodel.FieldBrandID).
+//		Scan(ctx, &v)
+//
+func (vmq *VehicleModelQuery) Select(fields ...string) *VehicleModelSelect {
+	vmq.fields = append(vmq.fields, fields...)
+	selbuild := &VehicleModelSelect{VehicleModelQuery: vmq}
+	selbuild.label = vehiclemodel.Label
+	selbuild.flds, selbuild.scan = &vmq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (vmq *VehicleModelQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range vmq.fields {
+		if !vehiclemodel.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vmq.path != nil {
+		prev, err := vmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vmq.sql = prev
+	}
+	return nil
+}
+
+func (vmq *VehicleModelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VehicleModel, error) {
+	var (
+		nodes       = []*VehicleModel{}
+		_spec       = vmq.querySpec()
+		loadedTypes = [2]bool{
+			vmq.withBrand != nil,
+			vmq.withCars != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*VehicleModel).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &VehicleModel{config: vmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(vmq.modifiers) > 0 {
+		_spec.Modifiers = vmq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := vmq.withBrand; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*VehicleModel)
+		for i := range nodes {
+			fk := nodes[i].BrandID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(brand.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "brand_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Brand = n
+			}
+		}
+	}
+
+	if query := vmq.withCars; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*VehicleModel)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Cars = []*Car{}
+		}
+		query.Where(predicate.Car(func(s *sql.Selector) {
+			s.Where(sql.InValues(vehiclemodel.CarsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.ModelID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "model_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Cars = append(node.Edges.Cars, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (vmq *VehicleModelQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vmq.querySpec()
+	if len(vmq.modifiers) > 0 {
+		_spec.Modifiers = vmq.modifiers
+	}
+	_spec.Node.Columns = vmq.fields
+	if len(vmq.fields) > 0 {
+		_spec.Unique = vmq.unique != nil && *vmq.unique
+	}
+	return sqlgraph.CountNodes(ctx, vmq.driver, _spec)
+}
+
+func (vmq *VehicleModelQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := vmq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (vmq *VehicleModelQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   vehiclemodel.Table,
+			Columns: vehiclemodel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: vehiclemodel.FieldID,
+			},
+		},
+		From:   vmq.sql,
+		Unique: true,
+	}
+	if unique := vmq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := vmq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vehiclemodel.FieldID)
+		for i := range fields {
+			if fields[i] != vehiclemodel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vmq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vmq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vmq *VehicleModelQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vmq.driver.Dialect())
+	t1 := builder.Table(vehiclemodel.Table)
+	columns := vmq.fields
+	if len(columns) == 0 {
+		columns = vehiclemodel.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vmq.sql != nil {
+		selector = vmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vmq.unique != nil && *vmq.unique {
+		selector.Distinct()
+	}
+	for _, m := range vmq.modifiers {
+		m(selector)
+	}
+	for _, p := range vmq.predicates {
+		p(selector)
+	}
+	for _, p := range vmq.order {
+		p(selector)
+	}
+	if offset := vmq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vmq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (vmq *VehicleModelQuery) ForUpdate(opts ...sql.LockOption) *VehicleModelQuery {
+	if vmq.driver.Dialect() == dialect.Postgres {
+		vmq.Unique(false)
+	}
+	vmq.modifiers = append(vmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return vmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (vmq *VehicleModelQuery) ForShare(opts ...sql.LockOption) *VehicleModelQuery {
+	if vmq.driver.Dialect() == dialect.Postgres {
+		vmq.Unique(false)
+	}
+	vmq.modifiers = append(vmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return vmq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vmq *VehicleModelQuery) Modify(modifiers ...func(s *sql.Selector)) *VehicleModelSelect {
+	vmq.modifiers = append(vmq.modifiers, modifiers...)
+	return vmq.Select()
+}
+
+// VehicleModelGroupBy is the group-by builder for VehicleModel entities.
+type VehicleModelGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vmgb *VehicleModelGroupBy) Aggregate(fns ...AggregateFunc) *VehicleModelGroupBy {
+	vmgb.fns = append(vmgb.fns, fns...)
+	return vmgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (vmgb *VehicleModelGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := vmgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	vmgb.sql = query
+	return vmgb.sqlScan(ctx, v)
+}
+
+func (vmgb *VehicleModelGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range vmgb.fields {
+		if !vehiclemodel.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := vmgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vmgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (vmgb *VehicleModelGroupBy) sqlQuery() *sql.Selector {
+	selector := vmgb.sql.Select()
+	aggregation := make([]string, 0, len(vmgb.fns))
+	for _, fn := range vmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(vmgb.fields)+len(vmgb.fns))
+		for _, f := range vmgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(vmgb.fields...)...)
+}
+
+// VehicleModelSelect is the builder for selecting fields of VehicleModel entities.
+type VehicleModelSelect struct {
+	*VehicleModelQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vms *VehicleModelSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := vms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	vms.sql = vms.VehicleModelQuery.sqlQuery(ctx)
+	return vms.sqlScan(ctx, v)
+}
+
+func (vms *VehicleModelSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := vms.sql.Query()
+	if err := vms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vms *VehicleModelSelect) Modify(modifiers ...func(s *sql.Selector)) *VehicleModelSelect {
+	vms.modifiers = append(vms.modifiers, modifiers...)
+	return vms
+}
@@ -0,0 +1,851 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/brand"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/vehiclemodel"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleModelUpdate is the builder for updating VehicleModel entities.
+type VehicleModelUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VehicleModelMutation
+}
+
+// Where appends a list predicates to the VehicleModelUpdate builder.
+func (vmu *VehicleModelUpdate) Where(ps ...predicate.VehicleModel) *VehicleModelUpdate {
+	vmu.mutation.Where(ps...)
+	return vmu
+}
+
+// SetBrandID sets the "brand_id" field.
+func (vmu *VehicleModelUpdate) SetBrandID(i int64) *VehicleModelUpdate {
+	vmu.mutation.SetBrandID(i)
+	return vmu
+}
+
+// SetNillableBrandID sets the "brand_id" field if the given value is not nil.
+func (vmu *VehicleModelUpdate) SetNillableBrandID(i *int64) *VehicleModelUpdate {
+	if i != nil {
+		vmu.SetBrandID(*i)
+	}
+	return vmu
+}
+
+// ClearBrandID clears the value of the "brand_id" field.
+func (vmu *VehicleModelUpdate) ClearBrandID() *VehicleModelUpdate {
+	vmu.mutation.ClearBrandID()
+	return vmu
+}
+
+// SetName sets the "name" field.
+func (vmu *VehicleModelUpdate) SetName(s string) *VehicleModelUpdate {
+	vmu.mutation.SetName(s)
+	return vmu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vmu *VehicleModelUpdate) SetNillableName(s *string) *VehicleModelUpdate {
+	if s != nil {
+		vmu.SetName(*s)
+	}
+	return vmu
+}
+
+// ClearName clears the value of the "name" field.
+func (vmu *VehicleModelUpdate) ClearName() *VehicleModelUpdate {
+	vmu.mutation.ClearName()
+	return vmu
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (vmu *VehicleModelUpdate) SetFuelType(s string) *VehicleModelUpdate {
+	vmu.mutation.SetFuelType(s)
+	return vmu
+}
+
+// SetNillableFuelType sets the "fuel_type" field if the given value is not nil.
+func (vmu *VehicleModelUpdate) SetNillableFuelType(s *string) *VehicleModelUpdate {
+	if s != nil {
+		vmu.SetFuelType(*s)
+	}
+	return vmu
+}
+
+// ClearFuelType clears the value of the "fuel_type" field.
+func (vmu *VehicleModelUpdate) ClearFuelType() *VehicleModelUpdate {
+	vmu.mutation.ClearFuelType()
+	return vmu
+}
+
+// SetSeats sets the "seats" field.
+func (vmu *VehicleModelUpdate) SetSeats(i int32) *VehicleModelUpdate {
+	vmu.mutation.ResetSeats()
+	vmu.mutation.SetSeats(i)
+	return vmu
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (vmu *VehicleModelUpdate) SetNillableSeats(i *int32) *VehicleModelUpdate {
+	if i != nil {
+		vmu.SetSeats(*i)
+	}
+	return vmu
+}
+
+// AddSeats adds i to the "seats" field.
+func (vmu *VehicleModelUpdate) AddSeats(i int32) *VehicleModelUpdate {
+	vmu.mutation.AddSeats(i)
+	return vmu
+}
+
+// ClearSeats clears the value of the "seats" field.
+func (vmu *VehicleModelUpdate) ClearSeats() *VehicleModelUpdate {
+	vmu.mutation.ClearSeats()
+	return vmu
+}
+
+// SetBodyType sets the "body_type" field.
+func (vmu *VehicleModelUpdate) SetBodyType(s string) *VehicleModelUpdate {
+	vmu.mutation.SetBodyType(s)
+	return vmu
+}
+
+// SetNillableBodyType sets the "body_type" field if the given value is not nil.
+func (vmu *VehicleModelUpdate) SetNillableBodyType(s *string) *VehicleModelUpdate {
+	if s != nil {
+		vmu.SetBodyType(*s)
+	}
+	return vmu
+}
+
+// ClearBodyType clears the value of the "body_type" field.
+func (vmu *VehicleModelUpdate) ClearBodyType() *VehicleModelUpdate {
+	vmu.mutation.ClearBodyType()
+	return vmu
+}
+
+// SetBrand sets the "brand" edge to the Brand entity.
+func (vmu *VehicleModelUpdate) SetBrand(b *Brand) *VehicleModelUpdate {
+	return vmu.SetBrandID(b.ID)
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (vmu *VehicleModelUpdate) AddCarIDs(ids ...int64) *VehicleModelUpdate {
+	vmu.mutation.AddCarIDs(ids...)
+	return vmu
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (vmu *VehicleModelUpdate) AddCars(c ...*Car) *VehicleModelUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vmu.AddCarIDs(ids...)
+}
+
+// Mutation returns the VehicleModelMutation object of the builder.
+func (vmu *VehicleModelUpdate) Mutation() *VehicleModelMutation {
+	return vmu.mutation
+}
+
+// ClearBrand clears the "brand" edge to the Brand entity.
+func (vmu *VehicleModelUpdate) ClearBrand() *VehicleModelUpdate {
+	vmu.mutation.ClearBrand()
+	return vmu
+}
+
+// ClearCars clears all "cars" edges to the Car entity.
+func (vmu *VehicleModelUpdate) ClearCars() *VehicleModelUpdate {
+	vmu.mutation.ClearCars()
+	return vmu
+}
+
+// RemoveCarIDs removes the "cars" edge to Car entities by IDs.
+func (vmu *VehicleModelUpdate) RemoveCarIDs(ids ...int64) *VehicleModelUpdate {
+	vmu.mutation.RemoveCarIDs(ids...)
+	return vmu
+}
+
+// RemoveCars removes "cars" edges to Car entities.
+func (vmu *VehicleModelUpdate) RemoveCars(c ...*Car) *VehicleModelUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vmu.RemoveCarIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vmu *VehicleModelUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(vmu.hooks) == 0 {
+		affected, err = vmu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VehicleModelMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			vmu.mutation = mutation
+			affected, err = vmu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(vmu.hooks) - 1; i >= 0; i-- {
+			if vmu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vmu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, vmu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vmu *VehicleModelUpdate) SaveX(ctx context.Context) int {
+	affected, err := vmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vmu *VehicleModelUpdate) Exec(ctx context.Context) error {
+	_, err := vmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmu *VehicleModelUpdate) ExecX(ctx context.Context) {
+	if err := vmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (vmu *VehicleModelUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   vehiclemodel.Table,
+			Columns: vehiclemodel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: vehiclemodel.FieldID,
+			},
+		},
+	}
+	if ps := vmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vmu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vehiclemodel.FieldName,
+		})
+	}
+	if vmu.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: vehiclemodel.FieldName,
+		})
+	}
+	if value, ok := vmu.mutation.FuelType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vehiclemodel.FieldFuelType,
+		})
+	}
+	if vmu.mutation.FuelTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: vehiclemodel.FieldFuelType,
+		})
+	}
+	if value, ok := vmu.mutation.Seats(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: vehiclemodel.FieldSeats,
+		})
+	}
+	if value, ok := vmu.mutation.AddedSeats(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: vehiclemodel.FieldSeats,
+		})
+	}
+	if vmu.mutation.SeatsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Column: vehiclemodel.FieldSeats,
+		})
+	}
+	if value, ok := vmu.mutation.BodyType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vehiclemodel.FieldBodyType,
+		})
+	}
+	if vmu.mutation.BodyTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: vehiclemodel.FieldBodyType,
+		})
+	}
+	if vmu.mutation.BrandCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vehiclemodel.BrandTable,
+			Columns: []string{vehiclemodel.BrandColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: brand.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vmu.mutation.BrandIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vehiclemodel.BrandTable,
+			Columns: []string{vehiclemodel.BrandColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: brand.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vmu.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehiclemodel.CarsTable,
+			Columns: []string{vehiclemodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vmu.mutation.RemovedCarsIDs(); len(nodes) > 0 && !vmu.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehiclemodel.CarsTable,
+			Columns: []string{vehiclemodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vmu.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehiclemodel.CarsTable,
+			Columns: []string{vehiclemodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclemodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// VehicleModelUpdateOne is the builder for updating a single VehicleModel entity.
+type VehicleModelUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VehicleModelMutation
+}
+
+// SetBrandID sets the "brand_id" field.
+func (vmuo *VehicleModelUpdateOne) SetBrandID(i int64) *VehicleModelUpdateOne {
+	vmuo.mutation.SetBrandID(i)
+	return vmuo
+}
+
+// SetNillableBrandID sets the "brand_id" field if the given value is not nil.
+func (vmuo *VehicleModelUpdateOne) SetNillableBrandID(i *int64) *VehicleModelUpdateOne {
+	if i != nil {
+		vmuo.SetBrandID(*i)
+	}
+	return vmuo
+}
+
+// ClearBrandID clears the value of the "brand_id" field.
+func (vmuo *VehicleModelUpdateOne) ClearBrandID() *VehicleModelUpdateOne {
+	vmuo.mutation.ClearBrandID()
+	return vmuo
+}
+
+// SetName sets the "name" field.
+func (vmuo *VehicleModelUpdateOne) SetName(s string) *VehicleModelUpdateOne {
+	vmuo.mutation.SetName(s)
+	return vmuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vmuo *VehicleModelUpdateOne) SetNillableName(s *string) *VehicleModelUpdateOne {
+	if s != nil {
+		vmuo.SetName(*s)
+	}
+	return vmuo
+}
+
+// ClearName clears the value of the "name" field.
+func (vmuo *VehicleModelUpdateOne) ClearName() *VehicleModelUpdateOne {
+	vmuo.mutation.ClearName()
+	return vmuo
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (vmuo *VehicleModelUpdateOne) SetFuelType(s string) *VehicleModelUpdateOne {
+	vmuo.mutation.SetFuelType(s)
+	return vmuo
+}
+
+// SetNillableFuelType sets the "fuel_type" field if the given value is not nil.
+func (vmuo *VehicleModelUpdateOne) SetNillableFuelType(s *string) *VehicleModelUpdateOne {
+	if s != nil {
+		vmuo.SetFuelType(*s)
+	}
+	return vmuo
+}
+
+// ClearFuelType clears the value of the "fuel_type" field.
+func (vmuo *VehicleModelUpdateOne) ClearFuelType() *VehicleModelUpdateOne {
+	vmuo.mutation.ClearFuelType()
+	return vmuo
+}
+
+// SetSeats sets the "seats" field.
+func (vmuo *VehicleModelUpdateOne) SetSeats(i int32) *VehicleModelUpdateOne {
+	vmuo.mutation.ResetSeats()
+	vmuo.mutation.SetSeats(i)
+	return vmuo
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (vmuo *VehicleModelUpdateOne) SetNillableSeats(i *int32) *VehicleModelUpdateOne {
+	if i != nil {
+		vmuo.SetSeats(*i)
+	}
+	return vmuo
+}
+
+// AddSeats adds i to the "seats" field.
+func (vmuo *VehicleModelUpdateOne) AddSeats(i int32) *VehicleModelUpdateOne {
+	vmuo.mutation.AddSeats(i)
+	return vmuo
+}
+
+// ClearSeats clears the value of the "seats" field.
+func (vmuo *VehicleModelUpdateOne) ClearSeats() *VehicleModelUpdateOne {
+	vmuo.mutation.ClearSeats()
+	return vmuo
+}
+
+// SetBodyType sets the "body_type" field.
+func (vmuo *VehicleModelUpdateOne) SetBodyType(s string) *VehicleModelUpdateOne {
+	vmuo.mutation.SetBodyType(s)
+	return vmuo
+}
+
+// SetNillableBodyType sets the "body_type" field if the given value is not nil.
+func (vmuo *VehicleModelUpdateOne) SetNillableBodyType(s *string) *VehicleModelUpdateOne {
+	if s != nil {
+		vmuo.SetBodyType(*s)
+	}
+	return vmuo
+}
+
+// ClearBodyType clears the value of the "body_type" field.
+func (vmuo *VehicleModelUpdateOne) ClearBodyType() *VehicleModelUpdateOne {
+	vmuo.mutation.ClearBodyType()
+	return vmuo
+}
+
+// SetBrand sets the "brand" edge to the Brand entity.
+func (vmuo *VehicleModelUpdateOne) SetBrand(b *Brand) *VehicleModelUpdateOne {
+	return vmuo.SetBrandID(b.ID)
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (vmuo *VehicleModelUpdateOne) AddCarIDs(ids ...int64) *VehicleModelUpdateOne {
+	vmuo.mutation.AddCarIDs(ids...)
+	return vmuo
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (vmuo *VehicleModelUpdateOne) AddCars(c ...*Car) *VehicleModelUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vmuo.AddCarIDs(ids...)
+}
+
+// Mutation returns the VehicleModelMutation object of the builder.
+func (vmuo *VehicleModelUpdateOne) Mutation() *VehicleModelMutation {
+	return vmuo.mutation
+}
+
+// ClearBrand clears the "brand" edge to the Brand entity.
+func (vmuo *VehicleModelUpdateOne) ClearBrand() *VehicleModelUpdateOne {
+	vmuo.mutation.ClearBrand()
+	return vmuo
+}
+
+// ClearCars clears all "cars" edges to the Car entity.
+func (vmuo *VehicleModelUpdateOne) ClearCars() *VehicleModelUpdateOne {
+	vmuo.mutation.ClearCars()
+	return vmuo
+}
+
+// RemoveCarIDs removes the "cars" edge to Car entities by IDs.
+func (vmuo *VehicleModelUpdateOne) RemoveCarIDs(ids ...int64) *VehicleModelUpdateOne {
+	vmuo.mutation.RemoveCarIDs(ids...)
+	return vmuo
+}
+
+// RemoveCars removes "cars" edges to Car entities.
+func (vmuo *VehicleModelUpdateOne) RemoveCars(c ...*Car) *VehicleModelUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vmuo.RemoveCarIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vmuo *VehicleModelUpdateOne) Select(field string, fields ...string) *VehicleModelUpdateOne {
+	vmuo.fields = append([]string{field}, fields...)
+	return vmuo
+}
+
+// Save executes the query and returns the updated VehicleModel entity.
+func (vmuo *VehicleModelUpdateOne) Save(ctx context.Context) (*VehicleModel, error) {
+	var (
+		err  error
+		node *VehicleModel
+	)
+	if len(vmuo.hooks) == 0 {
+		node, err = vmuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VehicleModelMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			vmuo.mutation = mutation
+			node, err = vmuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(vmuo.hooks) - 1; i >= 0; i-- {
+			if vmuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vmuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, vmuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*VehicleModel)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from VehicleModelMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vmuo *VehicleModelUpdateOne) SaveX(ctx context.Context) *VehicleModel {
+	node, err := vmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vmuo *VehicleModelUpdateOne) Exec(ctx context.Context) error {
+	_, err := vmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmuo *VehicleModelUpdateOne) ExecX(ctx context.Context) {
+	if err := vmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (vmuo *VehicleModelUpdateOne) sqlSave(ctx context.Context) (_node *VehicleModel, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   vehiclemodel.Table,
+			Columns: vehiclemodel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: vehiclemodel.FieldID,
+			},
+		},
+	}
+	id, ok := vmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VehicleModel.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vehiclemodel.FieldID)
+		for _, f := range fields {
+			if !vehiclemodel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vehiclemodel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vmuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vehiclemodel.FieldName,
+		})
+	}
+	if vmuo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: vehiclemodel.FieldName,
+		})
+	}
+	if value, ok := vmuo.mutation.FuelType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vehiclemodel.FieldFuelType,
+		})
+	}
+	if vmuo.mutation.FuelTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: vehiclemodel.FieldFuelType,
+		})
+	}
+	if value, ok := vmuo.mutation.Seats(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: vehiclemodel.FieldSeats,
+		})
+	}
+	if value, ok := vmuo.mutation.AddedSeats(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: vehiclemodel.FieldSeats,
+		})
+	}
+	if vmuo.mutation.SeatsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Column: vehiclemodel.FieldSeats,
+		})
+	}
+	if value, ok := vmuo.mutation.BodyType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vehiclemodel.FieldBodyType,
+		})
+	}
+	if vmuo.mutation.BodyTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: vehiclemodel.FieldBodyType,
+		})
+	}
+	if vmuo.mutation.BrandCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vehiclemodel.BrandTable,
+			Columns: []string{vehiclemodel.BrandColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: brand.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vmuo.mutation.BrandIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vehiclemodel.BrandTable,
+			Columns: []string{vehiclemodel.BrandColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: brand.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vmuo.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehiclemodel.CarsTable,
+			Columns: []string{vehiclemodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vmuo.mutation.RemovedCarsIDs(); len(nodes) > 0 && !vmuo.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehiclemodel.CarsTable,
+			Columns: []string{vehiclemodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vmuo.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehiclemodel.CarsTable,
+			Columns: []string{vehiclemodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VehicleModel{config: vmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclemodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/violation"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Violation is the model entity for the Violation schema.
+type Violation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// CarID holds the value of the "car_id" field.
+	CarID int64 `json:"car_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// ReferenceNo holds the value of the "reference_no" field.
+	ReferenceNo string `json:"reference_no,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// ResolvedBy holds the value of the "resolved_by" field.
+	ResolvedBy int64 `json:"resolved_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ViolationQuery when eager-loading is set.
+	Edges ViolationEdges `json:"edges"`
+}
+
+// ViolationEdges holds the relations/edges for other nodes in the graph.
+type ViolationEdges struct {
+	// Car holds the value of the car edge.
+	Car *Car `json:"car,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarOrErr returns the Car value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ViolationEdges) CarOrErr() (*Car, error) {
+	if e.loadedTypes[0] {
+		if e.Car == nil {
+			// The edge car was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: car.Label}
+		}
+		return e.Car, nil
+	}
+	return nil, &NotLoadedError{edge: "car"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Violation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case violation.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case violation.FieldID, violation.FieldTenantID, violation.FieldCarID, violation.FieldUserID, violation.FieldResolvedBy:
+			values[i] = new(sql.NullInt64)
+		case violation.FieldReferenceNo, violation.FieldLocation, violation.FieldDescription, violation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case violation.FieldOccurredAt, violation.FieldResolvedAt, violation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Violation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Violation fields.
+func (v *Violation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case violation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			v.ID = int64(value.Int64)
+		case violation.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				v.TenantID = value.Int64
+			}
+		case violation.FieldCarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field car_id", values[i])
+			} else if value.Valid {
+				v.CarID = value.Int64
+			}
+		case violation.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				v.UserID = value.Int64
+			}
+		case violation.FieldReferenceNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_no", values[i])
+			} else if value.Valid {
+				v.ReferenceNo = value.String
+			}
+		case violation.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				v.OccurredAt = value.Time
+			}
+		case violation.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				v.Location = value.String
+			}
+		case violation.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				v.Amount = value.Float64
+			}
+		case violation.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				v.Description = value.String
+			}
+		case violation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				v.Status = value.String
+			}
+		case violation.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				v.ResolvedAt = new(time.Time)
+				*v.ResolvedAt = value.Time
+			}
+		case violation.FieldResolvedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_by", values[i])
+			} else if value.Valid {
+				v.ResolvedBy = value.Int64
+			}
+		case violation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				v.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCar queries the "car" edge of the Violation entity.
+func (v *Violation) QueryCar() *CarQuery {
+	return (&ViolationClient{config: v.config}).QueryCar(v)
+}
+
+// Update returns a builder for updating this Violation.
+// Note that you need to call Violation.Unwrap() before calling this method if this Violation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (v *Violation) Update() *ViolationUpdateOne {
+	return (&ViolationClient{config: v.config}).UpdateOne(v)
+}
+
+// Unwrap unwraps the Violation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (v *Violation) Unwrap() *Violation {
+	_tx, ok := v.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Violation is not a transactional entity")
+	}
+	v.config.driver = _tx.drv
+	return v
+}
+
+// String implements the fmt.Stringer.
+func (v *Violation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Violation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", v.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", v.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("car_id=")
+	builder.WriteString(fmt.Sprintf("%v", v.CarID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", v.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("reference_no=")
+	builder.WriteString(v.ReferenceNo)
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(v.OccurredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(v.Location)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", v.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(v.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(v.Status)
+	builder.WriteString(", ")
+	if v := v.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("resolved_by=")
+	builder.WriteString(fmt.Sprintf("%v", v.ResolvedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(v.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Violations is a parsable slice of Violation.
+type Violations []*Violation
+
+func (v Violations) config(cfg config) {
+	for _i := range v {
+		v[_i].config = cfg
+	}
+}
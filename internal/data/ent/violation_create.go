@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/violation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ViolationCreate is the builder for creating a Violation entity.
+type ViolationCreate struct {
+	config
+	mutation *ViolationMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (vc *ViolationCreate) SetTenantID(i int64) *ViolationCreate {
+	vc.mutation.SetTenantID(i)
+	return vc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableTenantID(i *int64) *ViolationCreate {
+	if i != nil {
+		vc.SetTenantID(*i)
+	}
+	return vc
+}
+
+// SetCarID sets the "car_id" field.
+func (vc *ViolationCreate) SetCarID(i int64) *ViolationCreate {
+	vc.mutation.SetCarID(i)
+	return vc
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableCarID(i *int64) *ViolationCreate {
+	if i != nil {
+		vc.SetCarID(*i)
+	}
+	return vc
+}
+
+// SetUserID sets the "user_id" field.
+func (vc *ViolationCreate) SetUserID(i int64) *ViolationCreate {
+	vc.mutation.SetUserID(i)
+	return vc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableUserID(i *int64) *ViolationCreate {
+	if i != nil {
+		vc.SetUserID(*i)
+	}
+	return vc
+}
+
+// SetReferenceNo sets the "reference_no" field.
+func (vc *ViolationCreate) SetReferenceNo(s string) *ViolationCreate {
+	vc.mutation.SetReferenceNo(s)
+	return vc
+}
+
+// SetNillableReferenceNo sets the "reference_no" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableReferenceNo(s *string) *ViolationCreate {
+	if s != nil {
+		vc.SetReferenceNo(*s)
+	}
+	return vc
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (vc *ViolationCreate) SetOccurredAt(t time.Time) *ViolationCreate {
+	vc.mutation.SetOccurredAt(t)
+	return vc
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableOccurredAt(t *time.Time) *ViolationCreate {
+	if t != nil {
+		vc.SetOccurredAt(*t)
+	}
+	return vc
+}
+
+// SetLocation sets the "location" field.
+func (vc *ViolationCreate) SetLocation(s string) *ViolationCreate {
+	vc.mutation.SetLocation(s)
+	return vc
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableLocation(s *string) *ViolationCreate {
+	if s != nil {
+		vc.SetLocation(*s)
+	}
+	return vc
+}
+
+// SetAmount sets the "amount" field.
+func (vc *ViolationCreate) SetAmount(f float64) *ViolationCreate {
+	vc.mutation.SetAmount(f)
+	return vc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableAmount(f *float64) *ViolationCreate {
+	if f != nil {
+		vc.SetAmount(*f)
+	}
+	return vc
+}
+
+// SetDescription sets the "description" field.
+func (vc *ViolationCreate) SetDescription(s string) *ViolationCreate {
+	vc.mutation.SetDescription(s)
+	return vc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableDescription(s *string) *ViolationCreate {
+	if s != nil {
+		vc.SetDescription(*s)
+	}
+	return vc
+}
+
+// SetStatus sets the "status" field.
+func (vc *ViolationCreate) SetStatus(s string) *ViolationCreate {
+	vc.mutation.SetStatus(s)
+	return vc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableStatus(s *string) *ViolationCreate {
+	if s != nil {
+		vc.SetStatus(*s)
+	}
+	return vc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (vc *ViolationCreate) SetResolvedAt(t time.Time) *ViolationCreate {
+	vc.mutation.SetResolvedAt(t)
+	return vc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableResolvedAt(t *time.Time) *ViolationCreate {
+	if t != nil {
+		vc.SetResolvedAt(*t)
+	}
+	return vc
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (vc *ViolationCreate) SetResolvedBy(i int64) *ViolationCreate {
+	vc.mutation.SetResolvedBy(i)
+	return vc
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableResolvedBy(i *int64) *ViolationCreate {
+	if i != nil {
+		vc.SetResolvedBy(*i)
+	}
+	return vc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vc *ViolationCreate) SetCreatedAt(t time.Time) *ViolationCreate {
+	vc.mutation.SetCreatedAt(t)
+	return vc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vc *ViolationCreate) SetNillableCreatedAt(t *time.Time) *ViolationCreate {
+	if t != nil {
+		vc.SetCreatedAt(*t)
+	}
+	return vc
+}
+
+// SetID sets the "id" field.
+func (vc *ViolationCreate) SetID(i int64) *ViolationCreate {
+	vc.mutation.SetID(i)
+	return vc
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (vc *ViolationCreate) SetCar(c *Car) *ViolationCreate {
+	return vc.SetCarID(c.ID)
+}
+
+// Mutation returns the ViolationMutation object of the builder.
+func (vc *ViolationCreate) Mutation() *ViolationMutation {
+	return vc.mutation
+}
+
+// Save creates the Violation in the database.
+func (vc *ViolationCreate) Save(ctx context.Context) (*Violation, error) {
+	var (
+		err  error
+		node *Violation
+	)
+	if err := vc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(vc.hooks) == 0 {
+		if err = vc.check(); err != nil {
+			return nil, err
+		}
+		node, err = vc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ViolationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = vc.check(); err != nil {
+				return nil, err
+			}
+			vc.mutation = mutation
+			if node, err = vc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(vc.hooks) - 1; i >= 0; i-- {
+			if vc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, vc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Violation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ViolationMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vc *ViolationCreate) SaveX(ctx context.Context) *Violation {
+	v, err := vc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vc *ViolationCreate) Exec(ctx context.Context) error {
+	_, err := vc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vc *ViolationCreate) ExecX(ctx context.Context) {
+	if err := vc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vc *ViolationCreate) defaults() error {
+	if _, ok := vc.mutation.Status(); !ok {
+		v := violation.DefaultStatus
+		vc.mutation.SetStatus(v)
+	}
+	if _, ok := vc.mutation.CreatedAt(); !ok {
+		if violation.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized violation.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := violation.DefaultCreatedAt()
+		vc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vc *ViolationCreate) check() error {
+	if _, ok := vc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Violation.status"`)}
+	}
+	if _, ok := vc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Violation.created_at"`)}
+	}
+	return nil
+}
+
+func (vc *ViolationCreate) sqlSave(ctx context.Context) (*Violation, error) {
+	_node, _spec := vc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (vc *ViolationCreate) createSpec() (*Violation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Violation{config: vc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: violation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: violation.FieldID,
+			},
+		}
+	)
+	if id, ok := vc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := vc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := vc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := vc.mutation.ReferenceNo(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldReferenceNo,
+		})
+		_node.ReferenceNo = value
+	}
+	if value, ok := vc.mutation.OccurredAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: violation.FieldOccurredAt,
+		})
+		_node.OccurredAt = value
+	}
+	if value, ok := vc.mutation.Location(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldLocation,
+		})
+		_node.Location = value
+	}
+	if value, ok := vc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: violation.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := vc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := vc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := vc.mutation.ResolvedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: violation.FieldResolvedAt,
+		})
+		_node.ResolvedAt = &value
+	}
+	if value, ok := vc.mutation.ResolvedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldResolvedBy,
+		})
+		_node.ResolvedBy = value
+	}
+	if value, ok := vc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: violation.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := vc.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   violation.CarTable,
+			Columns: []string{violation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ViolationCreateBulk is the builder for creating many Violation entities in bulk.
+type ViolationCreateBulk struct {
+	config
+	builders []*ViolationCreate
+}
+
+// Save creates the Violation entities in the database.
+func (vcb *ViolationCreateBulk) Save(ctx context.Context) ([]*Violation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(vcb.builders))
+	nodes := make([]*Violation, len(vcb.builders))
+	mutators := make([]Mutator, len(vcb.builders))
+	for i := range vcb.builders {
+		func(i int, root context.Context) {
+			builder := vcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ViolationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcb *ViolationCreateBulk) SaveX(ctx context.Context) []*Violation {
+	v, err := vcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcb *ViolationCreateBulk) Exec(ctx context.Context) error {
+	_, err := vcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcb *ViolationCreateBulk) ExecX(ctx context.Context) {
+	if err := vcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/violation"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ViolationQuery is the builder for querying Violation entities.
+type ViolationQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Violation
+	// eager-loading edges.
+	withCar   *CarQuery
+	modifiers []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ViolationQuery builder.
+func (vq *ViolationQuery) Where(ps ...predicate.Violation) *ViolationQuery {
+	vq.predicates = append(vq.predicates, ps...)
+	return vq
+}
+
+// Limit adds a limit step to the query.
+func (vq *ViolationQuery) Limit(limit int) *ViolationQuery {
+	vq.limit = &limit
+	return vq
+}
+
+// Offset adds an offset step to the query.
+func (vq *ViolationQuery) Offset(offset int) *ViolationQuery {
+	vq.offset = &offset
+	return vq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vq *ViolationQuery) Unique(unique bool) *ViolationQuery {
+	vq.unique = &unique
+	return vq
+}
+
+// Order adds an order step to the query.
+func (vq *ViolationQuery) Order(o ...OrderFunc) *ViolationQuery {
+	vq.order = append(vq.order, o...)
+	return vq
+}
+
+// QueryCar chains the current query on the "car" edge.
+func (vq *ViolationQuery) QueryCar() *CarQuery {
+	query := &CarQuery{config: vq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(violation.Table, violation.FieldID, selector),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, violation.CarTable, violation.CarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Violation entity from the query.
+// Returns a *NotFoundError when no Violation was found.
+func (vq *ViolationQuery) First(ctx context.Context) (*Violation, error) {
+	nodes, err := vq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{violation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vq *ViolationQuery) FirstX(ctx context.Context) *Violation {
+	node, err := vq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Violation ID from the query.
+// Returns a *NotFoundError when no Violation ID was found.
+func (vq *ViolationQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = vq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{violation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vq *ViolationQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := vq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Violation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Violation entity is found.
+// Returns a *NotFoundError when no Violation entities are found.
+func (vq *ViolationQuery) Only(ctx context.Context) (*Violation, error) {
+	nodes, err := vq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{violation.Label}
+	default:
+		return nil, &NotSingularError{violation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vq *ViolationQuery) OnlyX(ctx context.Context) *Violation {
+	node, err := vq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Violation ID in the query.
+// Returns a *NotSingularError when more than one Violation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vq *ViolationQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = vq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{violation.Label}
+	default:
+		err = &NotSingularError{violation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vq *ViolationQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := vq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Violations.
+func (vq *ViolationQuery) All(ctx context.Context) ([]*Violation, error) {
+	if err := vq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return vq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vq *ViolationQuery) AllX(ctx context.Context) []*Violation {
+	nodes, err := vq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Violation IDs.
+func (vq *ViolationQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := vq.Select(violation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vq *ViolationQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := vq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vq *ViolationQuery) Count(ctx context.Context) (int, error) {
+	if err := vq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return vq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vq *ViolationQuery) CountX(ctx context.Context) int {
+	count, err := vq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vq *ViolationQuery) Exist(ctx context.Context) (bool, error) {
+	if err := vq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return vq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vq *ViolationQuery) ExistX(ctx context.Context) bool {
+	exist, err := vq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ViolationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vq *ViolationQuery) Clone() *ViolationQuery {
+	if vq == nil {
+		return nil
+	}
+	return &ViolationQuery{
+		config:     vq.config,
+		limit:      vq.limit,
+		offset:     vq.offset,
+		order:      append([]OrderFunc{}, vq.order...),
+		predicates: append([]predicate.Violation{}, vq.predicates...),
+		withCar:    vq.withCar.Clone(),
+		// clone intermediate query.
+		sql:    vq.sql.Clone(),
+		path:   vq.path,
+		unique: vq.unique,
+	}
+}
+
+// WithCar tells the query-builder to eager-load the nodes that are connected to
+// the "car" edge. The optional arguments are used to configure the query builder of the edge.
+func (vq *ViolationQuery) WithCar(opts ...func(*CarQuery)) *ViolationQuery {
+	query := &CarQuery{config: vq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	vq.withCar = query
+	return vq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Violation.Query().
+//		GroupBy(violation.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (vq *ViolationQuery) GroupBy(field string, fields ...string) *ViolationGroupBy {
+	grbuild := &ViolationGroupBy{config: vq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := vq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return vq.sqlQuery(ctx), nil
+	}
+	grbuild.label = violation.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.Violation.Query().
+//		Select(violation.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (vq *ViolationQuery) Select(fields ...string) *ViolationSelect {
+	vq.fields = append(vq.fields, fields...)
+	selbuild := &ViolationSelect{ViolationQuery: vq}
+	selbuild.label = violation.Label
+	selbuild.flds, selbuild.scan = &vq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (vq *ViolationQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range vq.fields {
+		if !violation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vq.path != nil {
+		prev, err := vq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vq.sql = prev
+	}
+	if violation.Policy == nil {
+		return errors.New("ent: uninitialized violation.Policy (forgotten import ent/runtime?)")
+	}
+	if err := violation.Policy.EvalQuery(ctx, vq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (vq *ViolationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Violation, error) {
+	var (
+		nodes       = []*Violation{}
+		_spec       = vq.querySpec()
+		loadedTypes = [1]bool{
+			vq.withCar != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Violation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Violation{config: vq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(vq.modifiers) > 0 {
+		_spec.Modifiers = vq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := vq.withCar; query != nil {
+		ids := make([]int64, 0, len(nodes))
+		nodeids := make(map[int64][]*Violation)
+		for i := range nodes {
+			fk := nodes[i].CarID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(car.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "car_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Car = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (vq *ViolationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vq.querySpec()
+	if len(vq.modifiers) > 0 {
+		_spec.Modifiers = vq.modifiers
+	}
+	_spec.Node.Columns = vq.fields
+	if len(vq.fields) > 0 {
+		_spec.Unique = vq.unique != nil && *vq.unique
+	}
+	return sqlgraph.CountNodes(ctx, vq.driver, _spec)
+}
+
+func (vq *ViolationQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := vq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (vq *ViolationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   violation.Table,
+			Columns: violation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: violation.FieldID,
+			},
+		},
+		From:   vq.sql,
+		Unique: true,
+	}
+	if unique := vq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := vq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, violation.FieldID)
+		for i := range fields {
+			if fields[i] != violation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vq *ViolationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vq.driver.Dialect())
+	t1 := builder.Table(violation.Table)
+	columns := vq.fields
+	if len(columns) == 0 {
+		columns = violation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vq.sql != nil {
+		selector = vq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vq.unique != nil && *vq.unique {
+		selector.Distinct()
+	}
+	for _, m := range vq.modifiers {
+		m(selector)
+	}
+	for _, p := range vq.predicates {
+		p(selector)
+	}
+	for _, p := range vq.order {
+		p(selector)
+	}
+	if offset := vq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (vq *ViolationQuery) ForUpdate(opts ...sql.LockOption) *ViolationQuery {
+	if vq.driver.Dialect() == dialect.Postgres {
+		vq.Unique(false)
+	}
+	vq.modifiers = append(vq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return vq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (vq *ViolationQuery) ForShare(opts ...sql.LockOption) *ViolationQuery {
+	if vq.driver.Dialect() == dialect.Postgres {
+		vq.Unique(false)
+	}
+	vq.modifiers = append(vq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return vq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vq *ViolationQuery) Modify(modifiers ...func(s *sql.Selector)) *ViolationSelect {
+	vq.modifiers = append(vq.modifiers, modifiers...)
+	return vq.Select()
+}
+
+// ViolationGroupBy is the group-by builder for Violation entities.
+type ViolationGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vgb *ViolationGroupBy) Aggregate(fns ...AggregateFunc) *ViolationGroupBy {
+	vgb.fns = append(vgb.fns, fns...)
+	return vgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (vgb *ViolationGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := vgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	vgb.sql = query
+	return vgb.sqlScan(ctx, v)
+}
+
+func (vgb *ViolationGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range vgb.fields {
+		if !violation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := vgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (vgb *ViolationGroupBy) sqlQuery() *sql.Selector {
+	selector := vgb.sql.Select()
+	aggregation := make([]string, 0, len(vgb.fns))
+	for _, fn := range vgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(vgb.fields)+len(vgb.fns))
+		for _, f := range vgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(vgb.fields...)...)
+}
+
+// ViolationSelect is the builder for selecting fields of Violation entities.
+type ViolationSelect struct {
+	*ViolationQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vs *ViolationSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := vs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	vs.sql = vs.ViolationQuery.sqlQuery(ctx)
+	return vs.sqlScan(ctx, v)
+}
+
+func (vs *ViolationSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := vs.sql.Query()
+	if err := vs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vs *ViolationSelect) Modify(modifiers ...func(s *sql.Selector)) *ViolationSelect {
+	vs.modifiers = append(vs.modifiers, modifiers...)
+	return vs
+}
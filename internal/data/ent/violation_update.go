@@ -0,0 +1,1169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/violation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ViolationUpdate is the builder for updating Violation entities.
+type ViolationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ViolationMutation
+}
+
+// Where appends a list predicates to the ViolationUpdate builder.
+func (vu *ViolationUpdate) Where(ps ...predicate.Violation) *ViolationUpdate {
+	vu.mutation.Where(ps...)
+	return vu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (vu *ViolationUpdate) SetTenantID(i int64) *ViolationUpdate {
+	vu.mutation.ResetTenantID()
+	vu.mutation.SetTenantID(i)
+	return vu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableTenantID(i *int64) *ViolationUpdate {
+	if i != nil {
+		vu.SetTenantID(*i)
+	}
+	return vu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (vu *ViolationUpdate) AddTenantID(i int64) *ViolationUpdate {
+	vu.mutation.AddTenantID(i)
+	return vu
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (vu *ViolationUpdate) ClearTenantID() *ViolationUpdate {
+	vu.mutation.ClearTenantID()
+	return vu
+}
+
+// SetCarID sets the "car_id" field.
+func (vu *ViolationUpdate) SetCarID(i int64) *ViolationUpdate {
+	vu.mutation.SetCarID(i)
+	return vu
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableCarID(i *int64) *ViolationUpdate {
+	if i != nil {
+		vu.SetCarID(*i)
+	}
+	return vu
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (vu *ViolationUpdate) ClearCarID() *ViolationUpdate {
+	vu.mutation.ClearCarID()
+	return vu
+}
+
+// SetUserID sets the "user_id" field.
+func (vu *ViolationUpdate) SetUserID(i int64) *ViolationUpdate {
+	vu.mutation.ResetUserID()
+	vu.mutation.SetUserID(i)
+	return vu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableUserID(i *int64) *ViolationUpdate {
+	if i != nil {
+		vu.SetUserID(*i)
+	}
+	return vu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (vu *ViolationUpdate) AddUserID(i int64) *ViolationUpdate {
+	vu.mutation.AddUserID(i)
+	return vu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (vu *ViolationUpdate) ClearUserID() *ViolationUpdate {
+	vu.mutation.ClearUserID()
+	return vu
+}
+
+// SetReferenceNo sets the "reference_no" field.
+func (vu *ViolationUpdate) SetReferenceNo(s string) *ViolationUpdate {
+	vu.mutation.SetReferenceNo(s)
+	return vu
+}
+
+// SetNillableReferenceNo sets the "reference_no" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableReferenceNo(s *string) *ViolationUpdate {
+	if s != nil {
+		vu.SetReferenceNo(*s)
+	}
+	return vu
+}
+
+// ClearReferenceNo clears the value of the "reference_no" field.
+func (vu *ViolationUpdate) ClearReferenceNo() *ViolationUpdate {
+	vu.mutation.ClearReferenceNo()
+	return vu
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (vu *ViolationUpdate) SetOccurredAt(t time.Time) *ViolationUpdate {
+	vu.mutation.SetOccurredAt(t)
+	return vu
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableOccurredAt(t *time.Time) *ViolationUpdate {
+	if t != nil {
+		vu.SetOccurredAt(*t)
+	}
+	return vu
+}
+
+// ClearOccurredAt clears the value of the "occurred_at" field.
+func (vu *ViolationUpdate) ClearOccurredAt() *ViolationUpdate {
+	vu.mutation.ClearOccurredAt()
+	return vu
+}
+
+// SetLocation sets the "location" field.
+func (vu *ViolationUpdate) SetLocation(s string) *ViolationUpdate {
+	vu.mutation.SetLocation(s)
+	return vu
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableLocation(s *string) *ViolationUpdate {
+	if s != nil {
+		vu.SetLocation(*s)
+	}
+	return vu
+}
+
+// ClearLocation clears the value of the "location" field.
+func (vu *ViolationUpdate) ClearLocation() *ViolationUpdate {
+	vu.mutation.ClearLocation()
+	return vu
+}
+
+// SetAmount sets the "amount" field.
+func (vu *ViolationUpdate) SetAmount(f float64) *ViolationUpdate {
+	vu.mutation.ResetAmount()
+	vu.mutation.SetAmount(f)
+	return vu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableAmount(f *float64) *ViolationUpdate {
+	if f != nil {
+		vu.SetAmount(*f)
+	}
+	return vu
+}
+
+// AddAmount adds f to the "amount" field.
+func (vu *ViolationUpdate) AddAmount(f float64) *ViolationUpdate {
+	vu.mutation.AddAmount(f)
+	return vu
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (vu *ViolationUpdate) ClearAmount() *ViolationUpdate {
+	vu.mutation.ClearAmount()
+	return vu
+}
+
+// SetDescription sets the "description" field.
+func (vu *ViolationUpdate) SetDescription(s string) *ViolationUpdate {
+	vu.mutation.SetDescription(s)
+	return vu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableDescription(s *string) *ViolationUpdate {
+	if s != nil {
+		vu.SetDescription(*s)
+	}
+	return vu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (vu *ViolationUpdate) ClearDescription() *ViolationUpdate {
+	vu.mutation.ClearDescription()
+	return vu
+}
+
+// SetStatus sets the "status" field.
+func (vu *ViolationUpdate) SetStatus(s string) *ViolationUpdate {
+	vu.mutation.SetStatus(s)
+	return vu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableStatus(s *string) *ViolationUpdate {
+	if s != nil {
+		vu.SetStatus(*s)
+	}
+	return vu
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (vu *ViolationUpdate) SetResolvedAt(t time.Time) *ViolationUpdate {
+	vu.mutation.SetResolvedAt(t)
+	return vu
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableResolvedAt(t *time.Time) *ViolationUpdate {
+	if t != nil {
+		vu.SetResolvedAt(*t)
+	}
+	return vu
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (vu *ViolationUpdate) ClearResolvedAt() *ViolationUpdate {
+	vu.mutation.ClearResolvedAt()
+	return vu
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (vu *ViolationUpdate) SetResolvedBy(i int64) *ViolationUpdate {
+	vu.mutation.ResetResolvedBy()
+	vu.mutation.SetResolvedBy(i)
+	return vu
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableResolvedBy(i *int64) *ViolationUpdate {
+	if i != nil {
+		vu.SetResolvedBy(*i)
+	}
+	return vu
+}
+
+// AddResolvedBy adds i to the "resolved_by" field.
+func (vu *ViolationUpdate) AddResolvedBy(i int64) *ViolationUpdate {
+	vu.mutation.AddResolvedBy(i)
+	return vu
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (vu *ViolationUpdate) ClearResolvedBy() *ViolationUpdate {
+	vu.mutation.ClearResolvedBy()
+	return vu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vu *ViolationUpdate) SetCreatedAt(t time.Time) *ViolationUpdate {
+	vu.mutation.SetCreatedAt(t)
+	return vu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vu *ViolationUpdate) SetNillableCreatedAt(t *time.Time) *ViolationUpdate {
+	if t != nil {
+		vu.SetCreatedAt(*t)
+	}
+	return vu
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (vu *ViolationUpdate) SetCar(c *Car) *ViolationUpdate {
+	return vu.SetCarID(c.ID)
+}
+
+// Mutation returns the ViolationMutation object of the builder.
+func (vu *ViolationUpdate) Mutation() *ViolationMutation {
+	return vu.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (vu *ViolationUpdate) ClearCar() *ViolationUpdate {
+	vu.mutation.ClearCar()
+	return vu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vu *ViolationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(vu.hooks) == 0 {
+		affected, err = vu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ViolationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			vu.mutation = mutation
+			affected, err = vu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(vu.hooks) - 1; i >= 0; i-- {
+			if vu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, vu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vu *ViolationUpdate) SaveX(ctx context.Context) int {
+	affected, err := vu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vu *ViolationUpdate) Exec(ctx context.Context) error {
+	_, err := vu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vu *ViolationUpdate) ExecX(ctx context.Context) {
+	if err := vu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (vu *ViolationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   violation.Table,
+			Columns: violation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: violation.FieldID,
+			},
+		},
+	}
+	if ps := vu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldTenantID,
+		})
+	}
+	if value, ok := vu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldTenantID,
+		})
+	}
+	if vu.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: violation.FieldTenantID,
+		})
+	}
+	if value, ok := vu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldUserID,
+		})
+	}
+	if value, ok := vu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldUserID,
+		})
+	}
+	if vu.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: violation.FieldUserID,
+		})
+	}
+	if value, ok := vu.mutation.ReferenceNo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldReferenceNo,
+		})
+	}
+	if vu.mutation.ReferenceNoCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: violation.FieldReferenceNo,
+		})
+	}
+	if value, ok := vu.mutation.OccurredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: violation.FieldOccurredAt,
+		})
+	}
+	if vu.mutation.OccurredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: violation.FieldOccurredAt,
+		})
+	}
+	if value, ok := vu.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldLocation,
+		})
+	}
+	if vu.mutation.LocationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: violation.FieldLocation,
+		})
+	}
+	if value, ok := vu.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: violation.FieldAmount,
+		})
+	}
+	if value, ok := vu.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: violation.FieldAmount,
+		})
+	}
+	if vu.mutation.AmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: violation.FieldAmount,
+		})
+	}
+	if value, ok := vu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldDescription,
+		})
+	}
+	if vu.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: violation.FieldDescription,
+		})
+	}
+	if value, ok := vu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldStatus,
+		})
+	}
+	if value, ok := vu.mutation.ResolvedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: violation.FieldResolvedAt,
+		})
+	}
+	if vu.mutation.ResolvedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: violation.FieldResolvedAt,
+		})
+	}
+	if value, ok := vu.mutation.ResolvedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldResolvedBy,
+		})
+	}
+	if value, ok := vu.mutation.AddedResolvedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldResolvedBy,
+		})
+	}
+	if vu.mutation.ResolvedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: violation.FieldResolvedBy,
+		})
+	}
+	if value, ok := vu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: violation.FieldCreatedAt,
+		})
+	}
+	if vu.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   violation.CarTable,
+			Columns: []string{violation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vu.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   violation.CarTable,
+			Columns: []string{violation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{violation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ViolationUpdateOne is the builder for updating a single Violation entity.
+type ViolationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ViolationMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (vuo *ViolationUpdateOne) SetTenantID(i int64) *ViolationUpdateOne {
+	vuo.mutation.ResetTenantID()
+	vuo.mutation.SetTenantID(i)
+	return vuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableTenantID(i *int64) *ViolationUpdateOne {
+	if i != nil {
+		vuo.SetTenantID(*i)
+	}
+	return vuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (vuo *ViolationUpdateOne) AddTenantID(i int64) *ViolationUpdateOne {
+	vuo.mutation.AddTenantID(i)
+	return vuo
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (vuo *ViolationUpdateOne) ClearTenantID() *ViolationUpdateOne {
+	vuo.mutation.ClearTenantID()
+	return vuo
+}
+
+// SetCarID sets the "car_id" field.
+func (vuo *ViolationUpdateOne) SetCarID(i int64) *ViolationUpdateOne {
+	vuo.mutation.SetCarID(i)
+	return vuo
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableCarID(i *int64) *ViolationUpdateOne {
+	if i != nil {
+		vuo.SetCarID(*i)
+	}
+	return vuo
+}
+
+// ClearCarID clears the value of the "car_id" field.
+func (vuo *ViolationUpdateOne) ClearCarID() *ViolationUpdateOne {
+	vuo.mutation.ClearCarID()
+	return vuo
+}
+
+// SetUserID sets the "user_id" field.
+func (vuo *ViolationUpdateOne) SetUserID(i int64) *ViolationUpdateOne {
+	vuo.mutation.ResetUserID()
+	vuo.mutation.SetUserID(i)
+	return vuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableUserID(i *int64) *ViolationUpdateOne {
+	if i != nil {
+		vuo.SetUserID(*i)
+	}
+	return vuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (vuo *ViolationUpdateOne) AddUserID(i int64) *ViolationUpdateOne {
+	vuo.mutation.AddUserID(i)
+	return vuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (vuo *ViolationUpdateOne) ClearUserID() *ViolationUpdateOne {
+	vuo.mutation.ClearUserID()
+	return vuo
+}
+
+// SetReferenceNo sets the "reference_no" field.
+func (vuo *ViolationUpdateOne) SetReferenceNo(s string) *ViolationUpdateOne {
+	vuo.mutation.SetReferenceNo(s)
+	return vuo
+}
+
+// SetNillableReferenceNo sets the "reference_no" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableReferenceNo(s *string) *ViolationUpdateOne {
+	if s != nil {
+		vuo.SetReferenceNo(*s)
+	}
+	return vuo
+}
+
+// ClearReferenceNo clears the value of the "reference_no" field.
+func (vuo *ViolationUpdateOne) ClearReferenceNo() *ViolationUpdateOne {
+	vuo.mutation.ClearReferenceNo()
+	return vuo
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (vuo *ViolationUpdateOne) SetOccurredAt(t time.Time) *ViolationUpdateOne {
+	vuo.mutation.SetOccurredAt(t)
+	return vuo
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableOccurredAt(t *time.Time) *ViolationUpdateOne {
+	if t != nil {
+		vuo.SetOccurredAt(*t)
+	}
+	return vuo
+}
+
+// ClearOccurredAt clears the value of the "occurred_at" field.
+func (vuo *ViolationUpdateOne) ClearOccurredAt() *ViolationUpdateOne {
+	vuo.mutation.ClearOccurredAt()
+	return vuo
+}
+
+// SetLocation sets the "location" field.
+func (vuo *ViolationUpdateOne) SetLocation(s string) *ViolationUpdateOne {
+	vuo.mutation.SetLocation(s)
+	return vuo
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableLocation(s *string) *ViolationUpdateOne {
+	if s != nil {
+		vuo.SetLocation(*s)
+	}
+	return vuo
+}
+
+// ClearLocation clears the value of the "location" field.
+func (vuo *ViolationUpdateOne) ClearLocation() *ViolationUpdateOne {
+	vuo.mutation.ClearLocation()
+	return vuo
+}
+
+// SetAmount sets the "amount" field.
+func (vuo *ViolationUpdateOne) SetAmount(f float64) *ViolationUpdateOne {
+	vuo.mutation.ResetAmount()
+	vuo.mutation.SetAmount(f)
+	return vuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableAmount(f *float64) *ViolationUpdateOne {
+	if f != nil {
+		vuo.SetAmount(*f)
+	}
+	return vuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (vuo *ViolationUpdateOne) AddAmount(f float64) *ViolationUpdateOne {
+	vuo.mutation.AddAmount(f)
+	return vuo
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (vuo *ViolationUpdateOne) ClearAmount() *ViolationUpdateOne {
+	vuo.mutation.ClearAmount()
+	return vuo
+}
+
+// SetDescription sets the "description" field.
+func (vuo *ViolationUpdateOne) SetDescription(s string) *ViolationUpdateOne {
+	vuo.mutation.SetDescription(s)
+	return vuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableDescription(s *string) *ViolationUpdateOne {
+	if s != nil {
+		vuo.SetDescription(*s)
+	}
+	return vuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (vuo *ViolationUpdateOne) ClearDescription() *ViolationUpdateOne {
+	vuo.mutation.ClearDescription()
+	return vuo
+}
+
+// SetStatus sets the "status" field.
+func (vuo *ViolationUpdateOne) SetStatus(s string) *ViolationUpdateOne {
+	vuo.mutation.SetStatus(s)
+	return vuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableStatus(s *string) *ViolationUpdateOne {
+	if s != nil {
+		vuo.SetStatus(*s)
+	}
+	return vuo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (vuo *ViolationUpdateOne) SetResolvedAt(t time.Time) *ViolationUpdateOne {
+	vuo.mutation.SetResolvedAt(t)
+	return vuo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableResolvedAt(t *time.Time) *ViolationUpdateOne {
+	if t != nil {
+		vuo.SetResolvedAt(*t)
+	}
+	return vuo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (vuo *ViolationUpdateOne) ClearResolvedAt() *ViolationUpdateOne {
+	vuo.mutation.ClearResolvedAt()
+	return vuo
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (vuo *ViolationUpdateOne) SetResolvedBy(i int64) *ViolationUpdateOne {
+	vuo.mutation.ResetResolvedBy()
+	vuo.mutation.SetResolvedBy(i)
+	return vuo
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableResolvedBy(i *int64) *ViolationUpdateOne {
+	if i != nil {
+		vuo.SetResolvedBy(*i)
+	}
+	return vuo
+}
+
+// AddResolvedBy adds i to the "resolved_by" field.
+func (vuo *ViolationUpdateOne) AddResolvedBy(i int64) *ViolationUpdateOne {
+	vuo.mutation.AddResolvedBy(i)
+	return vuo
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (vuo *ViolationUpdateOne) ClearResolvedBy() *ViolationUpdateOne {
+	vuo.mutation.ClearResolvedBy()
+	return vuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vuo *ViolationUpdateOne) SetCreatedAt(t time.Time) *ViolationUpdateOne {
+	vuo.mutation.SetCreatedAt(t)
+	return vuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vuo *ViolationUpdateOne) SetNillableCreatedAt(t *time.Time) *ViolationUpdateOne {
+	if t != nil {
+		vuo.SetCreatedAt(*t)
+	}
+	return vuo
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (vuo *ViolationUpdateOne) SetCar(c *Car) *ViolationUpdateOne {
+	return vuo.SetCarID(c.ID)
+}
+
+// Mutation returns the ViolationMutation object of the builder.
+func (vuo *ViolationUpdateOne) Mutation() *ViolationMutation {
+	return vuo.mutation
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (vuo *ViolationUpdateOne) ClearCar() *ViolationUpdateOne {
+	vuo.mutation.ClearCar()
+	return vuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vuo *ViolationUpdateOne) Select(field string, fields ...string) *ViolationUpdateOne {
+	vuo.fields = append([]string{field}, fields...)
+	return vuo
+}
+
+// Save executes the query and returns the updated Violation entity.
+func (vuo *ViolationUpdateOne) Save(ctx context.Context) (*Violation, error) {
+	var (
+		err  error
+		node *Violation
+	)
+	if len(vuo.hooks) == 0 {
+		node, err = vuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ViolationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			vuo.mutation = mutation
+			node, err = vuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(vuo.hooks) - 1; i >= 0; i-- {
+			if vuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, vuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Violation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ViolationMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vuo *ViolationUpdateOne) SaveX(ctx context.Context) *Violation {
+	node, err := vuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vuo *ViolationUpdateOne) Exec(ctx context.Context) error {
+	_, err := vuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vuo *ViolationUpdateOne) ExecX(ctx context.Context) {
+	if err := vuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (vuo *ViolationUpdateOne) sqlSave(ctx context.Context) (_node *Violation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   violation.Table,
+			Columns: violation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: violation.FieldID,
+			},
+		},
+	}
+	id, ok := vuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Violation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, violation.FieldID)
+		for _, f := range fields {
+			if !violation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != violation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldTenantID,
+		})
+	}
+	if value, ok := vuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldTenantID,
+		})
+	}
+	if vuo.mutation.TenantIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: violation.FieldTenantID,
+		})
+	}
+	if value, ok := vuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldUserID,
+		})
+	}
+	if value, ok := vuo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldUserID,
+		})
+	}
+	if vuo.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: violation.FieldUserID,
+		})
+	}
+	if value, ok := vuo.mutation.ReferenceNo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldReferenceNo,
+		})
+	}
+	if vuo.mutation.ReferenceNoCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: violation.FieldReferenceNo,
+		})
+	}
+	if value, ok := vuo.mutation.OccurredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: violation.FieldOccurredAt,
+		})
+	}
+	if vuo.mutation.OccurredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: violation.FieldOccurredAt,
+		})
+	}
+	if value, ok := vuo.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldLocation,
+		})
+	}
+	if vuo.mutation.LocationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: violation.FieldLocation,
+		})
+	}
+	if value, ok := vuo.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: violation.FieldAmount,
+		})
+	}
+	if value, ok := vuo.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: violation.FieldAmount,
+		})
+	}
+	if vuo.mutation.AmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: violation.FieldAmount,
+		})
+	}
+	if value, ok := vuo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldDescription,
+		})
+	}
+	if vuo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: violation.FieldDescription,
+		})
+	}
+	if value, ok := vuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: violation.FieldStatus,
+		})
+	}
+	if value, ok := vuo.mutation.ResolvedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: violation.FieldResolvedAt,
+		})
+	}
+	if vuo.mutation.ResolvedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: violation.FieldResolvedAt,
+		})
+	}
+	if value, ok := vuo.mutation.ResolvedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldResolvedBy,
+		})
+	}
+	if value, ok := vuo.mutation.AddedResolvedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: violation.FieldResolvedBy,
+		})
+	}
+	if vuo.mutation.ResolvedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: violation.FieldResolvedBy,
+		})
+	}
+	if value, ok := vuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: violation.FieldCreatedAt,
+		})
+	}
+	if vuo.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   violation.CarTable,
+			Columns: []string{violation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vuo.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   violation.CarTable,
+			Columns: []string{violation.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: car.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Violation{config: vuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{violation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/warrantydefinition"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// WarrantyDefinition is the model entity for the WarrantyDefinition schema.
+type WarrantyDefinition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID int64 `json:"model_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Components holds the value of the "components" field.
+	Components string `json:"components,omitempty"`
+	// DurationMonths holds the value of the "duration_months" field.
+	DurationMonths int `json:"duration_months,omitempty"`
+	// MileageLimit holds the value of the "mileage_limit" field.
+	MileageLimit int64 `json:"mileage_limit,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WarrantyDefinitionQuery when eager-loading is set.
+	Edges WarrantyDefinitionEdges `json:"edges"`
+}
+
+// WarrantyDefinitionEdges holds the relations/edges for other nodes in the graph.
+type WarrantyDefinitionEdges struct {
+	// CarWarranties holds the value of the car_warranties edge.
+	CarWarranties []*CarWarranty `json:"car_warranties,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CarWarrantiesOrErr returns the CarWarranties value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarrantyDefinitionEdges) CarWarrantiesOrErr() ([]*CarWarranty, error) {
+	if e.loadedTypes[0] {
+		return e.CarWarranties, nil
+	}
+	return nil, &NotLoadedError{edge: "car_warranties"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WarrantyDefinition) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case warrantydefinition.FieldID, warrantydefinition.FieldModelID, warrantydefinition.FieldDurationMonths, warrantydefinition.FieldMileageLimit:
+			values[i] = new(sql.NullInt64)
+		case warrantydefinition.FieldName, warrantydefinition.FieldComponents:
+			values[i] = new(sql.NullString)
+		case warrantydefinition.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WarrantyDefinition", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WarrantyDefinition fields.
+func (wd *WarrantyDefinition) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case warrantydefinition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wd.ID = int64(value.Int64)
+		case warrantydefinition.FieldModelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				wd.ModelID = value.Int64
+			}
+		case warrantydefinition.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				wd.Name = value.String
+			}
+		case warrantydefinition.FieldComponents:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field components", values[i])
+			} else if value.Valid {
+				wd.Components = value.String
+			}
+		case warrantydefinition.FieldDurationMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_months", values[i])
+			} else if value.Valid {
+				wd.DurationMonths = int(value.Int64)
+			}
+		case warrantydefinition.FieldMileageLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mileage_limit", values[i])
+			} else if value.Valid {
+				wd.MileageLimit = value.Int64
+			}
+		case warrantydefinition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wd.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCarWarranties queries the "car_warranties" edge of the WarrantyDefinition entity.
+func (wd *WarrantyDefinition) QueryCarWarranties() *CarWarrantyQuery {
+	return (&WarrantyDefinitionClient{config: wd.config}).QueryCarWarranties(wd)
+}
+
+// Update returns a builder for updating this WarrantyDefinition.
+// Note that you need to call WarrantyDefinition.Unwrap() before calling this method if this WarrantyDefinition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wd *WarrantyDefinition) Update() *WarrantyDefinitionUpdateOne {
+	return (&WarrantyDefinitionClient{config: wd.config}).UpdateOne(wd)
+}
+
+// Unwrap unwraps the WarrantyDefinition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wd *WarrantyDefinition) Unwrap() *WarrantyDefinition {
+	_tx, ok := wd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WarrantyDefinition is not a transactional entity")
+	}
+	wd.config.driver = _tx.drv
+	return wd
+}
+
+// String implements the fmt.Stringer.
+func (wd *WarrantyDefinition) String() string {
+	var builder strings.Builder
+	builder.WriteString("WarrantyDefinition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wd.ID))
+	builder.WriteString("model_id=")
+	builder.WriteString(fmt.Sprintf("%v", wd.ModelID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(wd.Name)
+	builder.WriteString(", ")
+	builder.WriteString("components=")
+	builder.WriteString(wd.Components)
+	builder.WriteString(", ")
+	builder.WriteString("duration_months=")
+	builder.WriteString(fmt.Sprintf("%v", wd.DurationMonths))
+	builder.WriteString(", ")
+	builder.WriteString("mileage_limit=")
+	builder.WriteString(fmt.Sprintf("%v", wd.MileageLimit))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WarrantyDefinitions is a parsable slice of WarrantyDefinition.
+type WarrantyDefinitions []*WarrantyDefinition
+
+func (wd WarrantyDefinitions) config(cfg config) {
+	for _i := range wd {
+		wd[_i].config = cfg
+	}
+}
@@ -0,0 +1,63 @@
+// Code generated by ent, DO NOT EDIT.
+
+package warrantydefinition
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the warrantydefinition type in the database.
+	Label = "warranty_definition"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldModelID holds the string denoting the model_id field in the database.
+	FieldModelID = "model_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldComponents holds the string denoting the components field in the database.
+	FieldComponents = "components"
+	// FieldDurationMonths holds the string denoting the duration_months field in the database.
+	FieldDurationMonths = "duration_months"
+	// FieldMileageLimit holds the string denoting the mileage_limit field in the database.
+	FieldMileageLimit = "mileage_limit"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCarWarranties holds the string denoting the car_warranties edge name in mutations.
+	EdgeCarWarranties = "car_warranties"
+	// Table holds the table name of the warrantydefinition in the database.
+	Table = "warranty_definition"
+	// CarWarrantiesTable is the table that holds the car_warranties relation/edge.
+	CarWarrantiesTable = "car_warranty"
+	// CarWarrantiesInverseTable is the table name for the CarWarranty entity.
+	// It exists in this package in order to avoid circular dependency with the "carwarranty" package.
+	CarWarrantiesInverseTable = "car_warranty"
+	// CarWarrantiesColumn is the table column denoting the car_warranties relation/edge.
+	CarWarrantiesColumn = "definition_id"
+)
+
+// Columns holds all SQL columns for warrantydefinition fields.
+var Columns = []string{
+	FieldID,
+	FieldModelID,
+	FieldName,
+	FieldComponents,
+	FieldDurationMonths,
+	FieldMileageLimit,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package warrantydefinition
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ModelID applies equality check predicate on the "model_id" field. It's identical to ModelIDEQ.
+func ModelID(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModelID), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Components applies equality check predicate on the "components" field. It's identical to ComponentsEQ.
+func Components(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldComponents), v))
+	})
+}
+
+// DurationMonths applies equality check predicate on the "duration_months" field. It's identical to DurationMonthsEQ.
+func DurationMonths(v int) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDurationMonths), v))
+	})
+}
+
+// MileageLimit applies equality check predicate on the "mileage_limit" field. It's identical to MileageLimitEQ.
+func MileageLimit(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileageLimit), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ModelIDEQ applies the EQ predicate on the "model_id" field.
+func ModelIDEQ(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDNEQ applies the NEQ predicate on the "model_id" field.
+func ModelIDNEQ(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDIn applies the In predicate on the "model_id" field.
+func ModelIDIn(vs ...int64) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldModelID), v...))
+	})
+}
+
+// ModelIDNotIn applies the NotIn predicate on the "model_id" field.
+func ModelIDNotIn(vs ...int64) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldModelID), v...))
+	})
+}
+
+// ModelIDGT applies the GT predicate on the "model_id" field.
+func ModelIDGT(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDGTE applies the GTE predicate on the "model_id" field.
+func ModelIDGTE(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDLT applies the LT predicate on the "model_id" field.
+func ModelIDLT(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDLTE applies the LTE predicate on the "model_id" field.
+func ModelIDLTE(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldModelID), v))
+	})
+}
+
+// ModelIDIsNil applies the IsNil predicate on the "model_id" field.
+func ModelIDIsNil() predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldModelID)))
+	})
+}
+
+// ModelIDNotNil applies the NotNil predicate on the "model_id" field.
+func ModelIDNotNil() predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldModelID)))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// ComponentsEQ applies the EQ predicate on the "components" field.
+func ComponentsEQ(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsNEQ applies the NEQ predicate on the "components" field.
+func ComponentsNEQ(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsIn applies the In predicate on the "components" field.
+func ComponentsIn(vs ...string) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldComponents), v...))
+	})
+}
+
+// ComponentsNotIn applies the NotIn predicate on the "components" field.
+func ComponentsNotIn(vs ...string) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldComponents), v...))
+	})
+}
+
+// ComponentsGT applies the GT predicate on the "components" field.
+func ComponentsGT(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsGTE applies the GTE predicate on the "components" field.
+func ComponentsGTE(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsLT applies the LT predicate on the "components" field.
+func ComponentsLT(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsLTE applies the LTE predicate on the "components" field.
+func ComponentsLTE(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsContains applies the Contains predicate on the "components" field.
+func ComponentsContains(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsHasPrefix applies the HasPrefix predicate on the "components" field.
+func ComponentsHasPrefix(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsHasSuffix applies the HasSuffix predicate on the "components" field.
+func ComponentsHasSuffix(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsIsNil applies the IsNil predicate on the "components" field.
+func ComponentsIsNil() predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldComponents)))
+	})
+}
+
+// ComponentsNotNil applies the NotNil predicate on the "components" field.
+func ComponentsNotNil() predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldComponents)))
+	})
+}
+
+// ComponentsEqualFold applies the EqualFold predicate on the "components" field.
+func ComponentsEqualFold(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldComponents), v))
+	})
+}
+
+// ComponentsContainsFold applies the ContainsFold predicate on the "components" field.
+func ComponentsContainsFold(v string) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldComponents), v))
+	})
+}
+
+// DurationMonthsEQ applies the EQ predicate on the "duration_months" field.
+func DurationMonthsEQ(v int) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDurationMonths), v))
+	})
+}
+
+// DurationMonthsNEQ applies the NEQ predicate on the "duration_months" field.
+func DurationMonthsNEQ(v int) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDurationMonths), v))
+	})
+}
+
+// DurationMonthsIn applies the In predicate on the "duration_months" field.
+func DurationMonthsIn(vs ...int) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDurationMonths), v...))
+	})
+}
+
+// DurationMonthsNotIn applies the NotIn predicate on the "duration_months" field.
+func DurationMonthsNotIn(vs ...int) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDurationMonths), v...))
+	})
+}
+
+// DurationMonthsGT applies the GT predicate on the "duration_months" field.
+func DurationMonthsGT(v int) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDurationMonths), v))
+	})
+}
+
+// DurationMonthsGTE applies the GTE predicate on the "duration_months" field.
+func DurationMonthsGTE(v int) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDurationMonths), v))
+	})
+}
+
+// DurationMonthsLT applies the LT predicate on the "duration_months" field.
+func DurationMonthsLT(v int) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDurationMonths), v))
+	})
+}
+
+// DurationMonthsLTE applies the LTE predicate on the "duration_months" field.
+func DurationMonthsLTE(v int) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDurationMonths), v))
+	})
+}
+
+// DurationMonthsIsNil applies the IsNil predicate on the "duration_months" field.
+func DurationMonthsIsNil() predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDurationMonths)))
+	})
+}
+
+// DurationMonthsNotNil applies the NotNil predicate on the "duration_months" field.
+func DurationMonthsNotNil() predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDurationMonths)))
+	})
+}
+
+// MileageLimitEQ applies the EQ predicate on the "mileage_limit" field.
+func MileageLimitEQ(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitNEQ applies the NEQ predicate on the "mileage_limit" field.
+func MileageLimitNEQ(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitIn applies the In predicate on the "mileage_limit" field.
+func MileageLimitIn(vs ...int64) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMileageLimit), v...))
+	})
+}
+
+// MileageLimitNotIn applies the NotIn predicate on the "mileage_limit" field.
+func MileageLimitNotIn(vs ...int64) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMileageLimit), v...))
+	})
+}
+
+// MileageLimitGT applies the GT predicate on the "mileage_limit" field.
+func MileageLimitGT(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitGTE applies the GTE predicate on the "mileage_limit" field.
+func MileageLimitGTE(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitLT applies the LT predicate on the "mileage_limit" field.
+func MileageLimitLT(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitLTE applies the LTE predicate on the "mileage_limit" field.
+func MileageLimitLTE(v int64) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMileageLimit), v))
+	})
+}
+
+// MileageLimitIsNil applies the IsNil predicate on the "mileage_limit" field.
+func MileageLimitIsNil() predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMileageLimit)))
+	})
+}
+
+// MileageLimitNotNil applies the NotNil predicate on the "mileage_limit" field.
+func MileageLimitNotNil() predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMileageLimit)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WarrantyDefinition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasCarWarranties applies the HasEdge predicate on the "car_warranties" edge.
+func HasCarWarranties() predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarWarrantiesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CarWarrantiesTable, CarWarrantiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarWarrantiesWith applies the HasEdge predicate on the "car_warranties" edge with a given conditions (other predicates).
+func HasCarWarrantiesWith(preds ...predicate.CarWarranty) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CarWarrantiesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CarWarrantiesTable, CarWarrantiesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WarrantyDefinition) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WarrantyDefinition) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WarrantyDefinition) predicate.WarrantyDefinition {
+	return predicate.WarrantyDefinition(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
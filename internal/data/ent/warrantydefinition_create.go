@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/warrantydefinition"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WarrantyDefinitionCreate is the builder for creating a WarrantyDefinition entity.
+type WarrantyDefinitionCreate struct {
+	config
+	mutation *WarrantyDefinitionMutation
+	hooks    []Hook
+}
+
+// SetModelID sets the "model_id" field.
+func (wdc *WarrantyDefinitionCreate) SetModelID(i int64) *WarrantyDefinitionCreate {
+	wdc.mutation.SetModelID(i)
+	return wdc
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (wdc *WarrantyDefinitionCreate) SetNillableModelID(i *int64) *WarrantyDefinitionCreate {
+	if i != nil {
+		wdc.SetModelID(*i)
+	}
+	return wdc
+}
+
+// SetName sets the "name" field.
+func (wdc *WarrantyDefinitionCreate) SetName(s string) *WarrantyDefinitionCreate {
+	wdc.mutation.SetName(s)
+	return wdc
+}
+
+// SetComponents sets the "components" field.
+func (wdc *WarrantyDefinitionCreate) SetComponents(s string) *WarrantyDefinitionCreate {
+	wdc.mutation.SetComponents(s)
+	return wdc
+}
+
+// SetNillableComponents sets the "components" field if the given value is not nil.
+func (wdc *WarrantyDefinitionCreate) SetNillableComponents(s *string) *WarrantyDefinitionCreate {
+	if s != nil {
+		wdc.SetComponents(*s)
+	}
+	return wdc
+}
+
+// SetDurationMonths sets the "duration_months" field.
+func (wdc *WarrantyDefinitionCreate) SetDurationMonths(i int) *WarrantyDefinitionCreate {
+	wdc.mutation.SetDurationMonths(i)
+	return wdc
+}
+
+// SetNillableDurationMonths sets the "duration_months" field if the given value is not nil.
+func (wdc *WarrantyDefinitionCreate) SetNillableDurationMonths(i *int) *WarrantyDefinitionCreate {
+	if i != nil {
+		wdc.SetDurationMonths(*i)
+	}
+	return wdc
+}
+
+// SetMileageLimit sets the "mileage_limit" field.
+func (wdc *WarrantyDefinitionCreate) SetMileageLimit(i int64) *WarrantyDefinitionCreate {
+	wdc.mutation.SetMileageLimit(i)
+	return wdc
+}
+
+// SetNillableMileageLimit sets the "mileage_limit" field if the given value is not nil.
+func (wdc *WarrantyDefinitionCreate) SetNillableMileageLimit(i *int64) *WarrantyDefinitionCreate {
+	if i != nil {
+		wdc.SetMileageLimit(*i)
+	}
+	return wdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdc *WarrantyDefinitionCreate) SetCreatedAt(t time.Time) *WarrantyDefinitionCreate {
+	wdc.mutation.SetCreatedAt(t)
+	return wdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdc *WarrantyDefinitionCreate) SetNillableCreatedAt(t *time.Time) *WarrantyDefinitionCreate {
+	if t != nil {
+		wdc.SetCreatedAt(*t)
+	}
+	return wdc
+}
+
+// SetID sets the "id" field.
+func (wdc *WarrantyDefinitionCreate) SetID(i int64) *WarrantyDefinitionCreate {
+	wdc.mutation.SetID(i)
+	return wdc
+}
+
+// AddCarWarrantyIDs adds the "car_warranties" edge to the CarWarranty entity by IDs.
+func (wdc *WarrantyDefinitionCreate) AddCarWarrantyIDs(ids ...int64) *WarrantyDefinitionCreate {
+	wdc.mutation.AddCarWarrantyIDs(ids...)
+	return wdc
+}
+
+// AddCarWarranties adds the "car_warranties" edges to the CarWarranty entity.
+func (wdc *WarrantyDefinitionCreate) AddCarWarranties(c ...*CarWarranty) *WarrantyDefinitionCreate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wdc.AddCarWarrantyIDs(ids...)
+}
+
+// Mutation returns the WarrantyDefinitionMutation object of the builder.
+func (wdc *WarrantyDefinitionCreate) Mutation() *WarrantyDefinitionMutation {
+	return wdc.mutation
+}
+
+// Save creates the WarrantyDefinition in the database.
+func (wdc *WarrantyDefinitionCreate) Save(ctx context.Context) (*WarrantyDefinition, error) {
+	var (
+		err  error
+		node *WarrantyDefinition
+	)
+	wdc.defaults()
+	if len(wdc.hooks) == 0 {
+		if err = wdc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wdc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WarrantyDefinitionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wdc.check(); err != nil {
+				return nil, err
+			}
+			wdc.mutation = mutation
+			if node, err = wdc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wdc.hooks) - 1; i >= 0; i-- {
+			if wdc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wdc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wdc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WarrantyDefinition)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WarrantyDefinitionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WarrantyDefinitionCreate) SaveX(ctx context.Context) *WarrantyDefinition {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WarrantyDefinitionCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WarrantyDefinitionCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WarrantyDefinitionCreate) defaults() {
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		v := warrantydefinition.DefaultCreatedAt()
+		wdc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WarrantyDefinitionCreate) check() error {
+	if _, ok := wdc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WarrantyDefinition.name"`)}
+	}
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WarrantyDefinition.created_at"`)}
+	}
+	return nil
+}
+
+func (wdc *WarrantyDefinitionCreate) sqlSave(ctx context.Context) (*WarrantyDefinition, error) {
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (wdc *WarrantyDefinitionCreate) createSpec() (*WarrantyDefinition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WarrantyDefinition{config: wdc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: warrantydefinition.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: warrantydefinition.FieldID,
+			},
+		}
+	)
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wdc.mutation.ModelID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldModelID,
+		})
+		_node.ModelID = value
+	}
+	if value, ok := wdc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantydefinition.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := wdc.mutation.Components(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantydefinition.FieldComponents,
+		})
+		_node.Components = value
+	}
+	if value, ok := wdc.mutation.DurationMonths(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantydefinition.FieldDurationMonths,
+		})
+		_node.DurationMonths = value
+	}
+	if value, ok := wdc.mutation.MileageLimit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldMileageLimit,
+		})
+		_node.MileageLimit = value
+	}
+	if value, ok := wdc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: warrantydefinition.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := wdc.mutation.CarWarrantiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantydefinition.CarWarrantiesTable,
+			Columns: []string{warrantydefinition.CarWarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WarrantyDefinitionCreateBulk is the builder for creating many WarrantyDefinition entities in bulk.
+type WarrantyDefinitionCreateBulk struct {
+	config
+	builders []*WarrantyDefinitionCreate
+}
+
+// Save creates the WarrantyDefinition entities in the database.
+func (wdcb *WarrantyDefinitionCreateBulk) Save(ctx context.Context) ([]*WarrantyDefinition, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WarrantyDefinition, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WarrantyDefinitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WarrantyDefinitionCreateBulk) SaveX(ctx context.Context) []*WarrantyDefinition {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WarrantyDefinitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WarrantyDefinitionCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
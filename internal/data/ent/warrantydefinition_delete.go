@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/warrantydefinition"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WarrantyDefinitionDelete is the builder for deleting a WarrantyDefinition entity.
+type WarrantyDefinitionDelete struct {
+	config
+	hooks    []Hook
+	mutation *WarrantyDefinitionMutation
+}
+
+// Where appends a list predicates to the WarrantyDefinitionDelete builder.
+func (wdd *WarrantyDefinitionDelete) Where(ps ...predicate.WarrantyDefinition) *WarrantyDefinitionDelete {
+	wdd.mutation.Where(ps...)
+	return wdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wdd *WarrantyDefinitionDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wdd.hooks) == 0 {
+		affected, err = wdd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WarrantyDefinitionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wdd.mutation = mutation
+			affected, err = wdd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wdd.hooks) - 1; i >= 0; i-- {
+			if wdd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wdd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdd *WarrantyDefinitionDelete) ExecX(ctx context.Context) int {
+	n, err := wdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wdd *WarrantyDefinitionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: warrantydefinition.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: warrantydefinition.FieldID,
+			},
+		},
+	}
+	if ps := wdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// WarrantyDefinitionDeleteOne is the builder for deleting a single WarrantyDefinition entity.
+type WarrantyDefinitionDeleteOne struct {
+	wdd *WarrantyDefinitionDelete
+}
+
+// Exec executes the deletion query.
+func (wddo *WarrantyDefinitionDeleteOne) Exec(ctx context.Context) error {
+	n, err := wddo.wdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{warrantydefinition.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wddo *WarrantyDefinitionDeleteOne) ExecX(ctx context.Context) {
+	wddo.wdd.ExecX(ctx)
+}
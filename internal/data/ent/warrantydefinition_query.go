@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/warrantydefinition"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WarrantyDefinitionQuery is the builder for querying WarrantyDefinition entities.
+type WarrantyDefinitionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WarrantyDefinition
+	// eager-loading edges.
+	withCarWarranties *CarWarrantyQuery
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WarrantyDefinitionQuery builder.
+func (wdq *WarrantyDefinitionQuery) Where(ps ...predicate.WarrantyDefinition) *WarrantyDefinitionQuery {
+	wdq.predicates = append(wdq.predicates, ps...)
+	return wdq
+}
+
+// Limit adds a limit step to the query.
+func (wdq *WarrantyDefinitionQuery) Limit(limit int) *WarrantyDefinitionQuery {
+	wdq.limit = &limit
+	return wdq
+}
+
+// Offset adds an offset step to the query.
+func (wdq *WarrantyDefinitionQuery) Offset(offset int) *WarrantyDefinitionQuery {
+	wdq.offset = &offset
+	return wdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wdq *WarrantyDefinitionQuery) Unique(unique bool) *WarrantyDefinitionQuery {
+	wdq.unique = &unique
+	return wdq
+}
+
+// Order adds an order step to the query.
+func (wdq *WarrantyDefinitionQuery) Order(o ...OrderFunc) *WarrantyDefinitionQuery {
+	wdq.order = append(wdq.order, o...)
+	return wdq
+}
+
+// QueryCarWarranties chains the current query on the "car_warranties" edge.
+func (wdq *WarrantyDefinitionQuery) QueryCarWarranties() *CarWarrantyQuery {
+	query := &CarWarrantyQuery{config: wdq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warrantydefinition.Table, warrantydefinition.FieldID, selector),
+			sqlgraph.To(carwarranty.Table, carwarranty.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warrantydefinition.CarWarrantiesTable, warrantydefinition.CarWarrantiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WarrantyDefinition entity from the query.
+// Returns a *NotFoundError when no WarrantyDefinition was found.
+func (wdq *WarrantyDefinitionQuery) First(ctx context.Context) (*WarrantyDefinition, error) {
+	nodes, err := wdq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{warrantydefinition.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wdq *WarrantyDefinitionQuery) FirstX(ctx context.Context) *WarrantyDefinition {
+	node, err := wdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WarrantyDefinition ID from the query.
+// Returns a *NotFoundError when no WarrantyDefinition ID was found.
+func (wdq *WarrantyDefinitionQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = wdq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{warrantydefinition.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wdq *WarrantyDefinitionQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := wdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WarrantyDefinition entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WarrantyDefinition entity is found.
+// Returns a *NotFoundError when no WarrantyDefinition entities are found.
+func (wdq *WarrantyDefinitionQuery) Only(ctx context.Context) (*WarrantyDefinition, error) {
+	nodes, err := wdq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{warrantydefinition.Label}
+	default:
+		return nil, &NotSingularError{warrantydefinition.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wdq *WarrantyDefinitionQuery) OnlyX(ctx context.Context) *WarrantyDefinition {
+	node, err := wdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WarrantyDefinition ID in the query.
+// Returns a *NotSingularError when more than one WarrantyDefinition ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wdq *WarrantyDefinitionQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = wdq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{warrantydefinition.Label}
+	default:
+		err = &NotSingularError{warrantydefinition.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wdq *WarrantyDefinitionQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := wdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WarrantyDefinitions.
+func (wdq *WarrantyDefinitionQuery) All(ctx context.Context) ([]*WarrantyDefinition, error) {
+	if err := wdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wdq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wdq *WarrantyDefinitionQuery) AllX(ctx context.Context) []*WarrantyDefinition {
+	nodes, err := wdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WarrantyDefinition IDs.
+func (wdq *WarrantyDefinitionQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := wdq.Select(warrantydefinition.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wdq *WarrantyDefinitionQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := wdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wdq *WarrantyDefinitionQuery) Count(ctx context.Context) (int, error) {
+	if err := wdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wdq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wdq *WarrantyDefinitionQuery) CountX(ctx context.Context) int {
+	count, err := wdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wdq *WarrantyDefinitionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wdq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wdq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wdq *WarrantyDefinitionQuery) ExistX(ctx context.Context) bool {
+	exist, err := wdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WarrantyDefinitionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wdq *WarrantyDefinitionQuery) Clone() *WarrantyDefinitionQuery {
+	if wdq == nil {
+		return nil
+	}
+	return &WarrantyDefinitionQuery{
+		config:            wdq.config,
+		limit:             wdq.limit,
+		offset:            wdq.offset,
+		order:             append([]OrderFunc{}, wdq.order...),
+		predicates:        append([]predicate.WarrantyDefinition{}, wdq.predicates...),
+		withCarWarranties: wdq.withCarWarranties.Clone(),
+		// clone intermediate query.
+		sql:    wdq.sql.Clone(),
+		path:   wdq.path,
+		unique: wdq.unique,
+	}
+}
+
+// WithCarWarranties tells the query-builder to eager-load the nodes that are connected to
+// the "car_warranties" edge. The optional arguments are used to configure the query builder of the edge.
+func (wdq *WarrantyDefinitionQuery) WithCarWarranties(opts ...func(*CarWarrantyQuery)) *WarrantyDefinitionQuery {
+	query := &CarWarrantyQuery{config: wdq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wdq.withCarWarranties = query
+	return wdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ModelID int64 `json:"model_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WarrantyDefinition.Query().
+//		GroupBy(warrantydefinition.FieldModelID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (wdq *WarrantyDefinitionQuery) GroupBy(field string, fields ...string) *WarrantyDefinitionGroupBy {
+	grbuild := &WarrantyDefinitionGroupBy{config: wdq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wdq.sqlQuery(ctx), nil
+	}
+	grbuild.label = warrantydefinition.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ModelID int64 `json:"model_id,omitempty"`
+//	}
+//
+//	client.WarrantyDefinition.Query().
+//		Select(warrantydefinition.FieldModelID).
+//		Scan(ctx, &v)
+//
+func (wdq *WarrantyDefinitionQuery) Select(fields ...string) *WarrantyDefinitionSelect {
+	wdq.fields = append(wdq.fields, fields...)
+	selbuild := &WarrantyDefinitionSelect{WarrantyDefinitionQuery: wdq}
+	selbuild.label = warrantydefinition.Label
+	selbuild.flds, selbuild.scan = &wdq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wdq *WarrantyDefinitionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wdq.fields {
+		if !warrantydefinition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wdq.path != nil {
+		prev, err := wdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wdq.sql = prev
+	}
+	return nil
+}
+
+func (wdq *WarrantyDefinitionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WarrantyDefinition, error) {
+	var (
+		nodes       = []*WarrantyDefinition{}
+		_spec       = wdq.querySpec()
+		loadedTypes = [1]bool{
+			wdq.withCarWarranties != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*WarrantyDefinition).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &WarrantyDefinition{config: wdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(wdq.modifiers) > 0 {
+		_spec.Modifiers = wdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := wdq.withCarWarranties; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int64]*WarrantyDefinition)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.CarWarranties = []*CarWarranty{}
+		}
+		query.Where(predicate.CarWarranty(func(s *sql.Selector) {
+			s.Where(sql.InValues(warrantydefinition.CarWarrantiesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.DefinitionID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "definition_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.CarWarranties = append(node.Edges.CarWarranties, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (wdq *WarrantyDefinitionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wdq.querySpec()
+	if len(wdq.modifiers) > 0 {
+		_spec.Modifiers = wdq.modifiers
+	}
+	_spec.Node.Columns = wdq.fields
+	if len(wdq.fields) > 0 {
+		_spec.Unique = wdq.unique != nil && *wdq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wdq.driver, _spec)
+}
+
+func (wdq *WarrantyDefinitionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wdq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wdq *WarrantyDefinitionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   warrantydefinition.Table,
+			Columns: warrantydefinition.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: warrantydefinition.FieldID,
+			},
+		},
+		From:   wdq.sql,
+		Unique: true,
+	}
+	if unique := wdq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wdq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, warrantydefinition.FieldID)
+		for i := range fields {
+			if fields[i] != warrantydefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wdq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wdq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wdq *WarrantyDefinitionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wdq.driver.Dialect())
+	t1 := builder.Table(warrantydefinition.Table)
+	columns := wdq.fields
+	if len(columns) == 0 {
+		columns = warrantydefinition.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wdq.sql != nil {
+		selector = wdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wdq.unique != nil && *wdq.unique {
+		selector.Distinct()
+	}
+	for _, m := range wdq.modifiers {
+		m(selector)
+	}
+	for _, p := range wdq.predicates {
+		p(selector)
+	}
+	for _, p := range wdq.order {
+		p(selector)
+	}
+	if offset := wdq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wdq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wdq *WarrantyDefinitionQuery) ForUpdate(opts ...sql.LockOption) *WarrantyDefinitionQuery {
+	if wdq.driver.Dialect() == dialect.Postgres {
+		wdq.Unique(false)
+	}
+	wdq.modifiers = append(wdq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wdq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wdq *WarrantyDefinitionQuery) ForShare(opts ...sql.LockOption) *WarrantyDefinitionQuery {
+	if wdq.driver.Dialect() == dialect.Postgres {
+		wdq.Unique(false)
+	}
+	wdq.modifiers = append(wdq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wdq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wdq *WarrantyDefinitionQuery) Modify(modifiers ...func(s *sql.Selector)) *WarrantyDefinitionSelect {
+	wdq.modifiers = append(wdq.modifiers, modifiers...)
+	return wdq.Select()
+}
+
+// WarrantyDefinitionGroupBy is the group-by builder for WarrantyDefinition entities.
+type WarrantyDefinitionGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wdgb *WarrantyDefinitionGroupBy) Aggregate(fns ...AggregateFunc) *WarrantyDefinitionGroupBy {
+	wdgb.fns = append(wdgb.fns, fns...)
+	return wdgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wdgb *WarrantyDefinitionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wdgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wdgb.sql = query
+	return wdgb.sqlScan(ctx, v)
+}
+
+func (wdgb *WarrantyDefinitionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wdgb.fields {
+		if !warrantydefinition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wdgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wdgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wdgb *WarrantyDefinitionGroupBy) sqlQuery() *sql.Selector {
+	selector := wdgb.sql.Select()
+	aggregation := make([]string, 0, len(wdgb.fns))
+	for _, fn := range wdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wdgb.fields)+len(wdgb.fns))
+		for _, f := range wdgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wdgb.fields...)...)
+}
+
+// WarrantyDefinitionSelect is the builder for selecting fields of WarrantyDefinition entities.
+type WarrantyDefinitionSelect struct {
+	*WarrantyDefinitionQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wds *WarrantyDefinitionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wds.sql = wds.WarrantyDefinitionQuery.sqlQuery(ctx)
+	return wds.sqlScan(ctx, v)
+}
+
+func (wds *WarrantyDefinitionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wds.sql.Query()
+	if err := wds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wds *WarrantyDefinitionSelect) Modify(modifiers ...func(s *sql.Selector)) *WarrantyDefinitionSelect {
+	wds.modifiers = append(wds.modifiers, modifiers...)
+	return wds
+}
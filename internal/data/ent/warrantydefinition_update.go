@@ -0,0 +1,843 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/warrantydefinition"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WarrantyDefinitionUpdate is the builder for updating WarrantyDefinition entities.
+type WarrantyDefinitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WarrantyDefinitionMutation
+}
+
+// Where appends a list predicates to the WarrantyDefinitionUpdate builder.
+func (wdu *WarrantyDefinitionUpdate) Where(ps ...predicate.WarrantyDefinition) *WarrantyDefinitionUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetModelID sets the "model_id" field.
+func (wdu *WarrantyDefinitionUpdate) SetModelID(i int64) *WarrantyDefinitionUpdate {
+	wdu.mutation.ResetModelID()
+	wdu.mutation.SetModelID(i)
+	return wdu
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (wdu *WarrantyDefinitionUpdate) SetNillableModelID(i *int64) *WarrantyDefinitionUpdate {
+	if i != nil {
+		wdu.SetModelID(*i)
+	}
+	return wdu
+}
+
+// AddModelID adds i to the "model_id" field.
+func (wdu *WarrantyDefinitionUpdate) AddModelID(i int64) *WarrantyDefinitionUpdate {
+	wdu.mutation.AddModelID(i)
+	return wdu
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (wdu *WarrantyDefinitionUpdate) ClearModelID() *WarrantyDefinitionUpdate {
+	wdu.mutation.ClearModelID()
+	return wdu
+}
+
+// SetName sets the "name" field.
+func (wdu *WarrantyDefinitionUpdate) SetName(s string) *WarrantyDefinitionUpdate {
+	wdu.mutation.SetName(s)
+	return wdu
+}
+
+// SetComponents sets the "components" field.
+func (wdu *WarrantyDefinitionUpdate) SetComponents(s string) *WarrantyDefinitionUpdate {
+	wdu.mutation.SetComponents(s)
+	return wdu
+}
+
+// SetNillableComponents sets the "components" field if the given value is not nil.
+func (wdu *WarrantyDefinitionUpdate) SetNillableComponents(s *string) *WarrantyDefinitionUpdate {
+	if s != nil {
+		wdu.SetComponents(*s)
+	}
+	return wdu
+}
+
+// ClearComponents clears the value of the "components" field.
+func (wdu *WarrantyDefinitionUpdate) ClearComponents() *WarrantyDefinitionUpdate {
+	wdu.mutation.ClearComponents()
+	return wdu
+}
+
+// SetDurationMonths sets the "duration_months" field.
+func (wdu *WarrantyDefinitionUpdate) SetDurationMonths(i int) *WarrantyDefinitionUpdate {
+	wdu.mutation.ResetDurationMonths()
+	wdu.mutation.SetDurationMonths(i)
+	return wdu
+}
+
+// SetNillableDurationMonths sets the "duration_months" field if the given value is not nil.
+func (wdu *WarrantyDefinitionUpdate) SetNillableDurationMonths(i *int) *WarrantyDefinitionUpdate {
+	if i != nil {
+		wdu.SetDurationMonths(*i)
+	}
+	return wdu
+}
+
+// AddDurationMonths adds i to the "duration_months" field.
+func (wdu *WarrantyDefinitionUpdate) AddDurationMonths(i int) *WarrantyDefinitionUpdate {
+	wdu.mutation.AddDurationMonths(i)
+	return wdu
+}
+
+// ClearDurationMonths clears the value of the "duration_months" field.
+func (wdu *WarrantyDefinitionUpdate) ClearDurationMonths() *WarrantyDefinitionUpdate {
+	wdu.mutation.ClearDurationMonths()
+	return wdu
+}
+
+// SetMileageLimit sets the "mileage_limit" field.
+func (wdu *WarrantyDefinitionUpdate) SetMileageLimit(i int64) *WarrantyDefinitionUpdate {
+	wdu.mutation.ResetMileageLimit()
+	wdu.mutation.SetMileageLimit(i)
+	return wdu
+}
+
+// SetNillableMileageLimit sets the "mileage_limit" field if the given value is not nil.
+func (wdu *WarrantyDefinitionUpdate) SetNillableMileageLimit(i *int64) *WarrantyDefinitionUpdate {
+	if i != nil {
+		wdu.SetMileageLimit(*i)
+	}
+	return wdu
+}
+
+// AddMileageLimit adds i to the "mileage_limit" field.
+func (wdu *WarrantyDefinitionUpdate) AddMileageLimit(i int64) *WarrantyDefinitionUpdate {
+	wdu.mutation.AddMileageLimit(i)
+	return wdu
+}
+
+// ClearMileageLimit clears the value of the "mileage_limit" field.
+func (wdu *WarrantyDefinitionUpdate) ClearMileageLimit() *WarrantyDefinitionUpdate {
+	wdu.mutation.ClearMileageLimit()
+	return wdu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdu *WarrantyDefinitionUpdate) SetCreatedAt(t time.Time) *WarrantyDefinitionUpdate {
+	wdu.mutation.SetCreatedAt(t)
+	return wdu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdu *WarrantyDefinitionUpdate) SetNillableCreatedAt(t *time.Time) *WarrantyDefinitionUpdate {
+	if t != nil {
+		wdu.SetCreatedAt(*t)
+	}
+	return wdu
+}
+
+// AddCarWarrantyIDs adds the "car_warranties" edge to the CarWarranty entity by IDs.
+func (wdu *WarrantyDefinitionUpdate) AddCarWarrantyIDs(ids ...int64) *WarrantyDefinitionUpdate {
+	wdu.mutation.AddCarWarrantyIDs(ids...)
+	return wdu
+}
+
+// AddCarWarranties adds the "car_warranties" edges to the CarWarranty entity.
+func (wdu *WarrantyDefinitionUpdate) AddCarWarranties(c ...*CarWarranty) *WarrantyDefinitionUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wdu.AddCarWarrantyIDs(ids...)
+}
+
+// Mutation returns the WarrantyDefinitionMutation object of the builder.
+func (wdu *WarrantyDefinitionUpdate) Mutation() *WarrantyDefinitionMutation {
+	return wdu.mutation
+}
+
+// ClearCarWarranties clears all "car_warranties" edges to the CarWarranty entity.
+func (wdu *WarrantyDefinitionUpdate) ClearCarWarranties() *WarrantyDefinitionUpdate {
+	wdu.mutation.ClearCarWarranties()
+	return wdu
+}
+
+// RemoveCarWarrantyIDs removes the "car_warranties" edge to CarWarranty entities by IDs.
+func (wdu *WarrantyDefinitionUpdate) RemoveCarWarrantyIDs(ids ...int64) *WarrantyDefinitionUpdate {
+	wdu.mutation.RemoveCarWarrantyIDs(ids...)
+	return wdu
+}
+
+// RemoveCarWarranties removes "car_warranties" edges to CarWarranty entities.
+func (wdu *WarrantyDefinitionUpdate) RemoveCarWarranties(c ...*CarWarranty) *WarrantyDefinitionUpdate {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wdu.RemoveCarWarrantyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WarrantyDefinitionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wdu.hooks) == 0 {
+		affected, err = wdu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WarrantyDefinitionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wdu.mutation = mutation
+			affected, err = wdu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wdu.hooks) - 1; i >= 0; i-- {
+			if wdu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wdu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WarrantyDefinitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WarrantyDefinitionUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WarrantyDefinitionUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wdu *WarrantyDefinitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   warrantydefinition.Table,
+			Columns: warrantydefinition.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: warrantydefinition.FieldID,
+			},
+		},
+	}
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.ModelID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldModelID,
+		})
+	}
+	if value, ok := wdu.mutation.AddedModelID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldModelID,
+		})
+	}
+	if wdu.mutation.ModelIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: warrantydefinition.FieldModelID,
+		})
+	}
+	if value, ok := wdu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantydefinition.FieldName,
+		})
+	}
+	if value, ok := wdu.mutation.Components(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantydefinition.FieldComponents,
+		})
+	}
+	if wdu.mutation.ComponentsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: warrantydefinition.FieldComponents,
+		})
+	}
+	if value, ok := wdu.mutation.DurationMonths(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantydefinition.FieldDurationMonths,
+		})
+	}
+	if value, ok := wdu.mutation.AddedDurationMonths(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantydefinition.FieldDurationMonths,
+		})
+	}
+	if wdu.mutation.DurationMonthsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: warrantydefinition.FieldDurationMonths,
+		})
+	}
+	if value, ok := wdu.mutation.MileageLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldMileageLimit,
+		})
+	}
+	if value, ok := wdu.mutation.AddedMileageLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldMileageLimit,
+		})
+	}
+	if wdu.mutation.MileageLimitCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: warrantydefinition.FieldMileageLimit,
+		})
+	}
+	if value, ok := wdu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: warrantydefinition.FieldCreatedAt,
+		})
+	}
+	if wdu.mutation.CarWarrantiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantydefinition.CarWarrantiesTable,
+			Columns: []string{warrantydefinition.CarWarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.RemovedCarWarrantiesIDs(); len(nodes) > 0 && !wdu.mutation.CarWarrantiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantydefinition.CarWarrantiesTable,
+			Columns: []string{warrantydefinition.CarWarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.CarWarrantiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantydefinition.CarWarrantiesTable,
+			Columns: []string{warrantydefinition.CarWarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{warrantydefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WarrantyDefinitionUpdateOne is the builder for updating a single WarrantyDefinition entity.
+type WarrantyDefinitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WarrantyDefinitionMutation
+}
+
+// SetModelID sets the "model_id" field.
+func (wduo *WarrantyDefinitionUpdateOne) SetModelID(i int64) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.ResetModelID()
+	wduo.mutation.SetModelID(i)
+	return wduo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (wduo *WarrantyDefinitionUpdateOne) SetNillableModelID(i *int64) *WarrantyDefinitionUpdateOne {
+	if i != nil {
+		wduo.SetModelID(*i)
+	}
+	return wduo
+}
+
+// AddModelID adds i to the "model_id" field.
+func (wduo *WarrantyDefinitionUpdateOne) AddModelID(i int64) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.AddModelID(i)
+	return wduo
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (wduo *WarrantyDefinitionUpdateOne) ClearModelID() *WarrantyDefinitionUpdateOne {
+	wduo.mutation.ClearModelID()
+	return wduo
+}
+
+// SetName sets the "name" field.
+func (wduo *WarrantyDefinitionUpdateOne) SetName(s string) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.SetName(s)
+	return wduo
+}
+
+// SetComponents sets the "components" field.
+func (wduo *WarrantyDefinitionUpdateOne) SetComponents(s string) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.SetComponents(s)
+	return wduo
+}
+
+// SetNillableComponents sets the "components" field if the given value is not nil.
+func (wduo *WarrantyDefinitionUpdateOne) SetNillableComponents(s *string) *WarrantyDefinitionUpdateOne {
+	if s != nil {
+		wduo.SetComponents(*s)
+	}
+	return wduo
+}
+
+// ClearComponents clears the value of the "components" field.
+func (wduo *WarrantyDefinitionUpdateOne) ClearComponents() *WarrantyDefinitionUpdateOne {
+	wduo.mutation.ClearComponents()
+	return wduo
+}
+
+// SetDurationMonths sets the "duration_months" field.
+func (wduo *WarrantyDefinitionUpdateOne) SetDurationMonths(i int) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.ResetDurationMonths()
+	wduo.mutation.SetDurationMonths(i)
+	return wduo
+}
+
+// SetNillableDurationMonths sets the "duration_months" field if the given value is not nil.
+func (wduo *WarrantyDefinitionUpdateOne) SetNillableDurationMonths(i *int) *WarrantyDefinitionUpdateOne {
+	if i != nil {
+		wduo.SetDurationMonths(*i)
+	}
+	return wduo
+}
+
+// AddDurationMonths adds i to the "duration_months" field.
+func (wduo *WarrantyDefinitionUpdateOne) AddDurationMonths(i int) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.AddDurationMonths(i)
+	return wduo
+}
+
+// ClearDurationMonths clears the value of the "duration_months" field.
+func (wduo *WarrantyDefinitionUpdateOne) ClearDurationMonths() *WarrantyDefinitionUpdateOne {
+	wduo.mutation.ClearDurationMonths()
+	return wduo
+}
+
+// SetMileageLimit sets the "mileage_limit" field.
+func (wduo *WarrantyDefinitionUpdateOne) SetMileageLimit(i int64) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.ResetMileageLimit()
+	wduo.mutation.SetMileageLimit(i)
+	return wduo
+}
+
+// SetNillableMileageLimit sets the "mileage_limit" field if the given value is not nil.
+func (wduo *WarrantyDefinitionUpdateOne) SetNillableMileageLimit(i *int64) *WarrantyDefinitionUpdateOne {
+	if i != nil {
+		wduo.SetMileageLimit(*i)
+	}
+	return wduo
+}
+
+// AddMileageLimit adds i to the "mileage_limit" field.
+func (wduo *WarrantyDefinitionUpdateOne) AddMileageLimit(i int64) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.AddMileageLimit(i)
+	return wduo
+}
+
+// ClearMileageLimit clears the value of the "mileage_limit" field.
+func (wduo *WarrantyDefinitionUpdateOne) ClearMileageLimit() *WarrantyDefinitionUpdateOne {
+	wduo.mutation.ClearMileageLimit()
+	return wduo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wduo *WarrantyDefinitionUpdateOne) SetCreatedAt(t time.Time) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.SetCreatedAt(t)
+	return wduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wduo *WarrantyDefinitionUpdateOne) SetNillableCreatedAt(t *time.Time) *WarrantyDefinitionUpdateOne {
+	if t != nil {
+		wduo.SetCreatedAt(*t)
+	}
+	return wduo
+}
+
+// AddCarWarrantyIDs adds the "car_warranties" edge to the CarWarranty entity by IDs.
+func (wduo *WarrantyDefinitionUpdateOne) AddCarWarrantyIDs(ids ...int64) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.AddCarWarrantyIDs(ids...)
+	return wduo
+}
+
+// AddCarWarranties adds the "car_warranties" edges to the CarWarranty entity.
+func (wduo *WarrantyDefinitionUpdateOne) AddCarWarranties(c ...*CarWarranty) *WarrantyDefinitionUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wduo.AddCarWarrantyIDs(ids...)
+}
+
+// Mutation returns the WarrantyDefinitionMutation object of the builder.
+func (wduo *WarrantyDefinitionUpdateOne) Mutation() *WarrantyDefinitionMutation {
+	return wduo.mutation
+}
+
+// ClearCarWarranties clears all "car_warranties" edges to the CarWarranty entity.
+func (wduo *WarrantyDefinitionUpdateOne) ClearCarWarranties() *WarrantyDefinitionUpdateOne {
+	wduo.mutation.ClearCarWarranties()
+	return wduo
+}
+
+// RemoveCarWarrantyIDs removes the "car_warranties" edge to CarWarranty entities by IDs.
+func (wduo *WarrantyDefinitionUpdateOne) RemoveCarWarrantyIDs(ids ...int64) *WarrantyDefinitionUpdateOne {
+	wduo.mutation.RemoveCarWarrantyIDs(ids...)
+	return wduo
+}
+
+// RemoveCarWarranties removes "car_warranties" edges to CarWarranty entities.
+func (wduo *WarrantyDefinitionUpdateOne) RemoveCarWarranties(c ...*CarWarranty) *WarrantyDefinitionUpdateOne {
+	ids := make([]int64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wduo.RemoveCarWarrantyIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WarrantyDefinitionUpdateOne) Select(field string, fields ...string) *WarrantyDefinitionUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WarrantyDefinition entity.
+func (wduo *WarrantyDefinitionUpdateOne) Save(ctx context.Context) (*WarrantyDefinition, error) {
+	var (
+		err  error
+		node *WarrantyDefinition
+	)
+	if len(wduo.hooks) == 0 {
+		node, err = wduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WarrantyDefinitionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wduo.mutation = mutation
+			node, err = wduo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wduo.hooks) - 1; i >= 0; i-- {
+			if wduo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wduo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wduo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WarrantyDefinition)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WarrantyDefinitionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WarrantyDefinitionUpdateOne) SaveX(ctx context.Context) *WarrantyDefinition {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WarrantyDefinitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WarrantyDefinitionUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wduo *WarrantyDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *WarrantyDefinition, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   warrantydefinition.Table,
+			Columns: warrantydefinition.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: warrantydefinition.FieldID,
+			},
+		},
+	}
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WarrantyDefinition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, warrantydefinition.FieldID)
+		for _, f := range fields {
+			if !warrantydefinition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != warrantydefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.ModelID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldModelID,
+		})
+	}
+	if value, ok := wduo.mutation.AddedModelID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldModelID,
+		})
+	}
+	if wduo.mutation.ModelIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: warrantydefinition.FieldModelID,
+		})
+	}
+	if value, ok := wduo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantydefinition.FieldName,
+		})
+	}
+	if value, ok := wduo.mutation.Components(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantydefinition.FieldComponents,
+		})
+	}
+	if wduo.mutation.ComponentsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: warrantydefinition.FieldComponents,
+		})
+	}
+	if value, ok := wduo.mutation.DurationMonths(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantydefinition.FieldDurationMonths,
+		})
+	}
+	if value, ok := wduo.mutation.AddedDurationMonths(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantydefinition.FieldDurationMonths,
+		})
+	}
+	if wduo.mutation.DurationMonthsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: warrantydefinition.FieldDurationMonths,
+		})
+	}
+	if value, ok := wduo.mutation.MileageLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldMileageLimit,
+		})
+	}
+	if value, ok := wduo.mutation.AddedMileageLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: warrantydefinition.FieldMileageLimit,
+		})
+	}
+	if wduo.mutation.MileageLimitCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: warrantydefinition.FieldMileageLimit,
+		})
+	}
+	if value, ok := wduo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: warrantydefinition.FieldCreatedAt,
+		})
+	}
+	if wduo.mutation.CarWarrantiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantydefinition.CarWarrantiesTable,
+			Columns: []string{warrantydefinition.CarWarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.RemovedCarWarrantiesIDs(); len(nodes) > 0 && !wduo.mutation.CarWarrantiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantydefinition.CarWarrantiesTable,
+			Columns: []string{warrantydefinition.CarWarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.CarWarrantiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantydefinition.CarWarrantiesTable,
+			Columns: []string{warrantydefinition.CarWarrantiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: carwarranty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WarrantyDefinition{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{warrantydefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,171 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"encoding/json"
+	"github.com/rueian/rueidis"
+	"github.com/rueian/rueidis/rueidiscompat"
+	"os"
+	"strings"
+	"time"
+)
+
+// 领域事件统一写入redis stream，key为topic
+const eventStreamPrefix = "car-service:event:"
+
+const (
+	// 每次读取的消息数及无新消息时的阻塞时间
+	eventReadCount = 20
+	eventReadBlock = 5 * time.Second
+	// 每隔eventPendingInterval认领一次空闲超过eventPendingIdle的未确认消息，
+	// 包括本实例处理失败的及其他实例崩溃遗留的
+	eventPendingInterval = time.Minute
+	eventPendingIdle     = time.Minute
+	// 每个stream保留的大致消息数
+	eventStreamMaxLen = 100000
+)
+
+func NewEventPublisher(d *Data) biz.EventPublisher {
+	return d
+}
+
+func NewEventConsumer(d *Data) biz.EventConsumer {
+	return d
+}
+
+func (d *Data) Publish(ctx context.Context, topic string, event interface{}) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return d.rdsCmd.XAdd(ctx, rueidiscompat.XAddArgs{
+		Stream: eventStreamPrefix + topic,
+		MaxLen: eventStreamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{"data": string(b)},
+	}).Err()
+}
+
+// Consume 消费组不存在时创建，只消费创建之后的事件；启动时先重新处理本实例未确认的消息，
+// 再阻塞读取新消息，并定期认领空闲的未确认消息重新处理，直到ctx取消
+func (d *Data) Consume(ctx context.Context, group string, topics []string,
+	handle func(ctx context.Context, topic string, payload []byte) error) error {
+	consumer, _ := os.Hostname()
+	streams := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		stream := eventStreamPrefix + topic
+		err := d.rdsCmd.XGroupCreateMkStream(ctx, stream, group, "$").Err()
+		if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+			return err
+		}
+		streams = append(streams, stream)
+	}
+
+	// 启动时立即重新处理本实例未确认的消息，逐条推进读取位置
+	for _, stream := range streams {
+		last := "0"
+		for ctx.Err() == nil {
+			res, err := d.rdsCmd.XReadGroup(ctx, rueidiscompat.XReadGroupArgs{
+				Group:    group,
+				Consumer: consumer,
+				Streams:  []string{stream, last},
+				Count:    eventReadCount,
+				Block:    -1,
+			}).Result()
+			if err != nil && !rueidis.IsRedisNil(err) {
+				return err
+			}
+			if len(res) == 0 || len(res[0].Messages) == 0 {
+				break
+			}
+			d.handleMessages(ctx, group, res, handle)
+			last = res[0].Messages[len(res[0].Messages)-1].ID
+		}
+	}
+
+	ids := make([]string, len(streams))
+	for i := range ids {
+		ids[i] = ">"
+	}
+	nextClaim := time.Now().Add(eventPendingInterval)
+	for ctx.Err() == nil {
+		if time.Now().After(nextClaim) {
+			if err := d.claimPending(ctx, group, consumer, streams, handle); err != nil && ctx.Err() == nil {
+				d.log.Errorf("认领未确认的领域事件失败: %v", err)
+			}
+			nextClaim = time.Now().Add(eventPendingInterval)
+		}
+		res, err := d.rdsCmd.XReadGroup(ctx, rueidiscompat.XReadGroupArgs{
+			Group:    group,
+			Consumer: consumer,
+			Streams:  append(append([]string{}, streams...), ids...),
+			Count:    eventReadCount,
+			Block:    eventReadBlock,
+		}).Result()
+		if rueidis.IsRedisNil(err) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			d.log.Errorf("读取领域事件失败: %v", err)
+			select {
+			case <-time.After(time.Second):
+			case <-ctx.Done():
+			}
+			continue
+		}
+		d.handleMessages(ctx, group, res, handle)
+	}
+	return nil
+}
+
+// claimPending 通过XAUTOCLAIM将空闲的未确认消息转给本实例并重新处理
+func (d *Data) claimPending(ctx context.Context, group, consumer string, streams []string,
+	handle func(ctx context.Context, topic string, payload []byte) error) error {
+	for _, stream := range streams {
+		start := "0-0"
+		for ctx.Err() == nil {
+			msgs, next, err := d.rdsCmd.XAutoClaim(ctx, rueidiscompat.XAutoClaimArgs{
+				Stream:   stream,
+				Group:    group,
+				Consumer: consumer,
+				MinIdle:  eventPendingIdle,
+				Start:    start,
+				Count:    eventReadCount,
+			}).Result()
+			if err != nil {
+				return err
+			}
+			if len(msgs) > 0 {
+				d.handleMessages(ctx, group, []rueidiscompat.XStream{{Stream: stream, Messages: msgs}}, handle)
+			}
+			if next == "" || next == "0-0" {
+				break
+			}
+			start = next
+		}
+	}
+	return nil
+}
+
+// handleMessages 处理成功的消息才确认，已被删除的消息直接确认
+func (d *Data) handleMessages(ctx context.Context, group string, res []rueidiscompat.XStream,
+	handle func(ctx context.Context, topic string, payload []byte) error) {
+	for _, s := range res {
+		topic := strings.TrimPrefix(s.Stream, eventStreamPrefix)
+		for _, m := range s.Messages {
+			if data, ok := m.Values["data"].(string); ok {
+				if err := handle(ctx, topic, []byte(data)); err != nil {
+					d.log.Errorf("处理领域事件失败，稍后重新处理: %s %s, %v", topic, m.ID, err)
+					continue
+				}
+			}
+			if err := d.rdsCmd.XAck(ctx, s.Stream, group, m.ID).Err(); err != nil {
+				d.log.Errorf("确认领域事件失败: %s %s, %v", topic, m.ID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/fleet"
+	"car-service/internal/data/ent/tag"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+)
+
+type fleetRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewFleetRepo(data *Data, logger log.Logger) biz.FleetRepo {
+	return &fleetRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r fleetRepo) ListFleet(ctx context.Context, page, pageSize int) ([]*biz.FleetReply, int, error) {
+	var list []*biz.FleetReply
+
+	q := r.data.db.Fleet.Query()
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	fleets := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Asc(fleet.FieldName)).
+		AllX(ctx)
+
+	for _, f := range fleets {
+		list = append(list, convertFleet(f))
+	}
+	return list, total, nil
+}
+
+func (r fleetRepo) GetFleetById(ctx context.Context, id int64) (*biz.FleetReply, error) {
+	f, err := r.data.db.Fleet.Get(ctx, id)
+	if err != nil {
+		return nil, ex.FleetNotFound
+	}
+	return convertFleet(f), nil
+}
+
+func (r fleetRepo) SaveFleet(ctx context.Context, f *biz.Fleet) (int64, error) {
+	rsp, err := r.data.db.Fleet.
+		Create().
+		SetFleet(f).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r fleetRepo) UpdateFleet(ctx context.Context, f *biz.Fleet) error {
+	return r.data.db.Fleet.
+		Update().
+		Where(fleet.ID(f.ID)).
+		SetFleet(f).
+		Exec(ctx)
+}
+
+func (r fleetRepo) DeleteFleet(ctx context.Context, id int64) error {
+	return r.data.db.Fleet.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func (r fleetRepo) AddFleetCars(ctx context.Context, fleetId int64, carIds []int64) error {
+	if _, err := r.data.db.Fleet.Get(ctx, fleetId); err != nil {
+		return ex.FleetNotFound
+	}
+	// 校验汽车均存在
+	n, err := r.data.db.Car.Query().
+		Where(car.IDIn(carIds...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if n != len(carIds) {
+		return ex.CarNotFound
+	}
+
+	// 过滤掉已在车队中的汽车
+	added, err := r.data.db.Car.Query().
+		Where(car.IDIn(carIds...), car.HasFleetsWith(fleet.ID(fleetId))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	exists := make(map[int64]bool, len(added))
+	for _, id := range added {
+		exists[id] = true
+	}
+	ids := make([]int64, 0, len(carIds))
+	for _, id := range carIds {
+		if !exists[id] {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.data.db.Fleet.
+		UpdateOneID(fleetId).
+		AddCarIDs(ids...).
+		Exec(ctx)
+}
+
+func (r fleetRepo) RemoveFleetCars(ctx context.Context, fleetId int64, carIds []int64) error {
+	err := r.data.db.Fleet.
+		UpdateOneID(fleetId).
+		RemoveCarIDs(carIds...).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return ex.FleetNotFound
+	}
+	return err
+}
+
+func (r fleetRepo) ListTag(ctx context.Context) ([]*biz.TagReply, error) {
+	tags, err := r.data.db.Tag.Query().
+		Order(ent.Asc(tag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.TagReply, 0, len(tags))
+	for _, t := range tags {
+		list = append(list, &biz.TagReply{Id: t.ID, Name: t.Name})
+	}
+	return list, nil
+}
+
+func (r fleetRepo) TagCar(ctx context.Context, carId int64, names []string) error {
+	if _, err := r.data.db.Car.Get(ctx, carId); err != nil {
+		return ex.CarNotFound
+	}
+	ids, err := r.ensureTags(ctx, names)
+	if err != nil {
+		return err
+	}
+	// 仅关联尚未关联该汽车的标签
+	return r.data.db.Tag.
+		Update().
+		Where(tag.IDIn(ids...), tag.Not(tag.HasCarsWith(car.ID(carId)))).
+		AddCarIDs(carId).
+		Exec(ctx)
+}
+
+func (r fleetRepo) UntagCar(ctx context.Context, carId int64, names []string) error {
+	return r.data.db.Tag.
+		Update().
+		Where(tag.NameIn(names...), tag.HasCarsWith(car.ID(carId))).
+		RemoveCarIDs(carId).
+		Exec(ctx)
+}
+
+// ensureTags 返回标签ID，不存在的标签自动创建
+func (r fleetRepo) ensureTags(ctx context.Context, names []string) ([]int64, error) {
+	tags, err := r.data.db.Tag.Query().
+		Where(tag.NameIn(names...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		exists[t.Name] = true
+	}
+
+	bulk := make([]*ent.TagCreate, 0)
+	for _, n := range names {
+		if !exists[n] {
+			bulk = append(bulk, r.data.db.Tag.Create().SetTag(&biz.Tag{Name: n}))
+		}
+	}
+	if len(bulk) > 0 {
+		// 并发创建同名标签时唯一索引冲突，重新查询即可
+		if err := r.data.db.Tag.CreateBulk(bulk...).Exec(ctx); err != nil && !ent.IsConstraintError(err) {
+			return nil, err
+		}
+	}
+
+	return r.data.db.Tag.Query().
+		Where(tag.NameIn(names...)).
+		IDs(ctx)
+}
+
+// carTags 查询汽车的标签名称
+func (d *Data) carTags(ctx context.Context, carIds ...int64) (map[int64][]string, error) {
+	tags, err := d.db.Tag.Query().
+		Where(tag.HasCarsWith(car.IDIn(carIds...))).
+		WithCars(func(q *ent.CarQuery) {
+			q.Where(car.IDIn(carIds...)).Select(car.FieldID)
+		}).
+		Order(ent.Asc(tag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int64][]string, len(carIds))
+	for _, t := range tags {
+		for _, c := range t.Edges.Cars {
+			m[c.ID] = append(m[c.ID], t.Name)
+		}
+	}
+	return m, nil
+}
+
+func convertFleet(f *ent.Fleet) *biz.FleetReply {
+	return &biz.FleetReply{
+		Id:          f.ID,
+		Name:        f.Name,
+		Description: f.Description,
+		CreatedAt:   f.CreatedAt,
+	}
+}
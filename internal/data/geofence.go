@@ -0,0 +1,192 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/geofence"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"strconv"
+	"time"
+)
+
+// 汽车在各围栏内外状态的缓存key前缀，hash字段为围栏ID，值为"上报时间毫秒:是否在内"
+const geofenceStateKeyPrefix = "car-service:geofence:state:"
+
+// 仅当上报时间不早于已记录的状态时才覆盖，返回0表示无记录，1在内，2在外，3过期
+const swapGeofenceStateScript = `local cur = redis.call("HGET", KEYS[1], ARGV[1])
+local prev = 0
+if cur then
+  local sep = string.find(cur, ":")
+  if tonumber(string.sub(cur, 1, sep - 1)) > tonumber(ARGV[2]) then return 3 end
+  if string.sub(cur, sep + 1) == "1" then prev = 1 else prev = 2 end
+end
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2] .. ":" .. ARGV[3])
+return prev`
+
+type geofenceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewGeofenceRepo(data *Data, logger log.Logger) biz.GeofenceRepo {
+	return &geofenceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r geofenceRepo) ListGeofence(ctx context.Context, page, pageSize int) ([]*biz.GeofenceReply, int, error) {
+	var list []*biz.GeofenceReply
+
+	q := r.data.db.Geofence.Query()
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	fences := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Asc(geofence.FieldName)).
+		WithCars(func(q *ent.CarQuery) {
+			q.Select(car.FieldID)
+		}).
+		AllX(ctx)
+
+	for _, f := range fences {
+		list = append(list, convertGeofence(f))
+	}
+	return list, total, nil
+}
+
+func (r geofenceRepo) GetById(ctx context.Context, id int64) (*biz.GeofenceReply, error) {
+	f, err := r.data.db.Geofence.Query().
+		Where(geofence.ID(id)).
+		WithCars(func(q *ent.CarQuery) {
+			q.Select(car.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, ex.GeofenceNotFound
+	}
+	return convertGeofence(f), nil
+}
+
+func (r geofenceRepo) ListByCar(ctx context.Context, carId int64) ([]*biz.GeofenceReply, error) {
+	fences, err := r.data.db.Geofence.Query().
+		Where(
+			geofence.Enabled(true),
+			geofence.HasCarsWith(car.ID(carId)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.GeofenceReply, 0, len(fences))
+	for _, f := range fences {
+		list = append(list, convertGeofence(f))
+	}
+	return list, nil
+}
+
+func (r geofenceRepo) Save(ctx context.Context, g *biz.Geofence, carIds []int64) (int64, error) {
+	if err := r.checkCars(ctx, carIds); err != nil {
+		return 0, err
+	}
+	rsp, err := r.data.Geofence(ctx).
+		Create().
+		SetGeofence(g).
+		AddCarIDs(carIds...).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return 0, ex.GeofenceNameExists
+	}
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r geofenceRepo) Update(ctx context.Context, g *biz.Geofence, carIds []int64) error {
+	if err := r.checkCars(ctx, carIds); err != nil {
+		return err
+	}
+	err := r.data.Geofence(ctx).
+		Update().
+		Where(geofence.ID(g.ID)).
+		SetGeofence(g).
+		ClearCars().
+		AddCarIDs(carIds...).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return ex.GeofenceNameExists
+	}
+	return err
+}
+
+func (r geofenceRepo) Delete(ctx context.Context, id int64) error {
+	return r.data.db.Geofence.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func (r geofenceRepo) SwapState(ctx context.Context, carId, fenceId int64, inside bool, at time.Time) (int, error) {
+	flag := "0"
+	if inside {
+		flag = "1"
+	}
+	return r.data.rdsCmd.Eval(ctx, swapGeofenceStateScript, []string{geofenceStateKey(carId)},
+		strconv.FormatInt(fenceId, 10), at.UnixMilli(), flag).Int()
+}
+
+// checkCars 校验汽车均存在且属于当前租户
+func (r geofenceRepo) checkCars(ctx context.Context, carIds []int64) error {
+	if len(carIds) == 0 {
+		return nil
+	}
+	n, err := r.data.Car(ctx).Query().
+		Where(car.IDIn(carIds...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if n != len(carIds) {
+		return ex.CarNotFound
+	}
+	return nil
+}
+
+func convertGeofence(f *ent.Geofence) *biz.GeofenceReply {
+	g := &biz.GeofenceReply{
+		Id:      f.ID,
+		Name:    f.Name,
+		Polygon: biz.Polygon{},
+		Schedule: biz.GeofenceSchedule{
+			Start:    f.StartTime,
+			End:      f.EndTime,
+			TimeZone: f.TimeZone,
+		},
+		AlertOn:   f.AlertOn,
+		Enabled:   f.Enabled,
+		CarIds:    make([]int64, 0, len(f.Edges.Cars)),
+		CreatedAt: f.CreatedAt,
+		UpdatedAt: f.UpdatedAt,
+	}
+	if f.Polygon != "" {
+		_ = json.Unmarshal([]byte(f.Polygon), &g.Polygon)
+	}
+	if f.Days != "" {
+		_ = json.Unmarshal([]byte(f.Days), &g.Schedule.Days)
+	}
+	for _, c := range f.Edges.Cars {
+		g.CarIds = append(g.CarIds, c.ID)
+	}
+	return g
+}
+
+func geofenceStateKey(carId int64) string {
+	return geofenceStateKeyPrefix + strconv.FormatInt(carId, 10)
+}
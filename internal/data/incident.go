@@ -0,0 +1,112 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/incident"
+	"car-service/internal/data/ent/predicate"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+)
+
+type incidentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewIncidentRepo(data *Data, logger log.Logger) biz.IncidentRepo {
+	return &incidentRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r incidentRepo) ListIncident(ctx context.Context, page, pageSize int, filter *biz.IncidentFilter) ([]*biz.IncidentReply, int, error) {
+	var list []*biz.IncidentReply
+	// 组装查询条件
+	cond := make([]predicate.Incident, 0)
+	if filter.CarId != nil {
+		cond = append(cond, incident.CarID(*filter.CarId))
+	}
+	if filter.Status != nil {
+		cond = append(cond, incident.Status(*filter.Status))
+	}
+
+	q := r.data.db.Incident.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	incidents := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(incident.FieldOccurredAt), ent.Desc(incident.FieldID)).
+		AllX(ctx)
+
+	for _, i := range incidents {
+		list = append(list, convertIncident(i))
+	}
+	return list, total, nil
+}
+
+func (r incidentRepo) GetById(ctx context.Context, id int64) (*biz.IncidentReply, error) {
+	i, err := r.data.db.Incident.Get(ctx, id)
+	if err != nil {
+		return nil, ex.IncidentNotFound
+	}
+	return convertIncident(i), nil
+}
+
+func (r incidentRepo) Save(ctx context.Context, i *biz.Incident) (int64, error) {
+	rsp, err := r.data.db.Incident.
+		Create().
+		SetIncident(i).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r incidentRepo) Transit(ctx context.Context, i *biz.Incident, from string) error {
+	n, err := r.data.db.Incident.
+		Update().
+		Where(incident.ID(i.ID), incident.Status(from)).
+		SetIncident(i).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ex.IncidentStatusConflict
+	}
+	return nil
+}
+
+func convertIncident(i *ent.Incident) *biz.IncidentReply {
+	rsp := &biz.IncidentReply{
+		Id:            i.ID,
+		CarId:         i.CarID,
+		ReporterId:    i.ReporterID,
+		OccurredAt:    i.OccurredAt,
+		Location:      i.Location,
+		Severity:      i.Severity,
+		Description:   i.Description,
+		Status:        i.Status,
+		PolicyId:      i.PolicyID,
+		ClaimNo:       i.ClaimNo,
+		ClaimAmount:   i.ClaimAmount,
+		SettledAmount: i.SettledAmount,
+		SettledAt:     i.SettledAt,
+		CreatedAt:     i.CreatedAt,
+		UpdatedAt:     i.UpdatedAt,
+	}
+	if i.PhotoIds != "" {
+		_ = json.Unmarshal([]byte(i.PhotoIds), &rsp.PhotoIds)
+	}
+	if i.ThirdParties != "" {
+		_ = json.Unmarshal([]byte(i.ThirdParties), &rsp.ThirdParties)
+	}
+	return rsp
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/insurancepolicy"
+	"car-service/internal/data/ent/predicate"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"time"
+)
+
+type insuranceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewInsuranceRepo(data *Data, logger log.Logger) biz.InsuranceRepo {
+	return &insuranceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r insuranceRepo) ListInsurancePolicy(ctx context.Context, page, pageSize int, carId *int64) ([]*biz.InsurancePolicyReply, int, error) {
+	var list []*biz.InsurancePolicyReply
+	// 组装查询条件
+	cond := make([]predicate.InsurancePolicy, 0)
+	if carId != nil {
+		cond = append(cond, insurancepolicy.CarID(*carId))
+	}
+
+	q := r.data.db.InsurancePolicy.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	policies := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(insurancepolicy.FieldEndDate)).
+		AllX(ctx)
+
+	for _, p := range policies {
+		list = append(list, convertInsurancePolicy(p))
+	}
+	return list, total, nil
+}
+
+func (r insuranceRepo) ListExpiring(ctx context.Context, from, to time.Time) ([]*biz.InsurancePolicyReply, error) {
+	policies, err := r.data.db.InsurancePolicy.Query().
+		Where(
+			insurancepolicy.EndDateGTE(from),
+			insurancepolicy.EndDateLTE(to),
+			insurancepolicy.RemindedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.InsurancePolicyReply, 0, len(policies))
+	for _, p := range policies {
+		list = append(list, convertInsurancePolicy(p))
+	}
+	return list, nil
+}
+
+func (r insuranceRepo) GetById(ctx context.Context, id int64) (*biz.InsurancePolicyReply, error) {
+	p, err := r.data.db.InsurancePolicy.Get(ctx, id)
+	if err != nil {
+		return nil, ex.InsurancePolicyNotFound
+	}
+	return convertInsurancePolicy(p), nil
+}
+
+func (r insuranceRepo) Save(ctx context.Context, p *biz.InsurancePolicy) (int64, error) {
+	rsp, err := r.data.db.InsurancePolicy.
+		Create().
+		SetInsurancePolicy(p).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r insuranceRepo) Update(ctx context.Context, p *biz.InsurancePolicy) error {
+	return r.data.db.InsurancePolicy.
+		Update().
+		Where(insurancepolicy.ID(p.ID)).
+		SetInsurancePolicy(p).
+		Exec(ctx)
+}
+
+func (r insuranceRepo) ClearReminded(ctx context.Context, id int64) error {
+	return r.data.db.InsurancePolicy.
+		UpdateOneID(id).
+		ClearRemindedAt().
+		Exec(ctx)
+}
+
+func (r insuranceRepo) Delete(ctx context.Context, id int64) error {
+	return r.data.db.InsurancePolicy.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func convertInsurancePolicy(p *ent.InsurancePolicy) *biz.InsurancePolicyReply {
+	return &biz.InsurancePolicyReply{
+		Id:           p.ID,
+		CarId:        p.CarID,
+		Provider:     p.Provider,
+		PolicyNumber: p.PolicyNumber,
+		Coverage:     p.Coverage,
+		StartDate:    p.StartDate,
+		EndDate:      p.EndDate,
+		Premium:      p.Premium,
+	}
+}
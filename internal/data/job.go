@@ -0,0 +1,86 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/jobrun"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"time"
+)
+
+type jobRunRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewJobRunRepo(data *Data, logger log.Logger) biz.JobRunRepo {
+	return &jobRunRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r jobRunRepo) ListJobRun(ctx context.Context, page, pageSize int, filter *biz.JobRunFilter) ([]*biz.JobRunReply, int, error) {
+	var list []*biz.JobRunReply
+	// 组装查询条件
+	cond := make([]predicate.JobRun, 0)
+	if filter.Name != nil {
+		cond = append(cond, jobrun.Name(*filter.Name))
+	}
+	if filter.Status != nil {
+		cond = append(cond, jobrun.Status(*filter.Status))
+	}
+
+	q := r.data.db.JobRun.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	runs := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(jobrun.FieldStartedAt), ent.Desc(jobrun.FieldID)).
+		AllX(ctx)
+
+	for _, j := range runs {
+		list = append(list, &biz.JobRunReply{
+			Id:          j.ID,
+			Name:        j.Name,
+			ScheduledAt: j.ScheduledAt,
+			StartedAt:   j.StartedAt,
+			FinishedAt:  j.FinishedAt,
+			Status:      j.Status,
+			Error:       j.Error,
+			Instance:    j.Instance,
+		})
+	}
+	return list, total, nil
+}
+
+func (r jobRunRepo) Save(ctx context.Context, j *biz.JobRun) (int64, error) {
+	rsp, err := r.data.db.JobRun.
+		Create().
+		SetJobRun(j).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r jobRunRepo) Finish(ctx context.Context, id int64, status, errMsg string, at time.Time) error {
+	return r.data.db.JobRun.
+		UpdateOneID(id).
+		SetStatus(status).
+		SetError(errMsg).
+		SetFinishedAt(at).
+		Exec(ctx)
+}
+
+func (r jobRunRepo) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.data.db.JobRun.
+		Delete().
+		Where(jobrun.StartedAtLT(before)).
+		Exec(ctx)
+}
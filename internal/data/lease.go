@@ -0,0 +1,129 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/leasecontract"
+	"car-service/internal/data/ent/predicate"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"time"
+)
+
+type leaseRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLeaseRepo(data *Data, logger log.Logger) biz.LeaseRepo {
+	return &leaseRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r leaseRepo) ListLease(ctx context.Context, page, pageSize int, filter *biz.LeaseFilter) ([]*biz.LeaseContractReply, int, error) {
+	var list []*biz.LeaseContractReply
+	// 组装查询条件
+	cond := make([]predicate.LeaseContract, 0)
+	if filter.CarId != nil {
+		cond = append(cond, leasecontract.CarID(*filter.CarId))
+	}
+	if filter.Status != nil {
+		cond = append(cond, leasecontract.Status(*filter.Status))
+	}
+
+	q := r.data.db.LeaseContract.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	leases := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(leasecontract.FieldStartDate), ent.Desc(leasecontract.FieldID)).
+		AllX(ctx)
+
+	for _, l := range leases {
+		list = append(list, convertLease(l))
+	}
+	return list, total, nil
+}
+
+func (r leaseRepo) GetById(ctx context.Context, id int64) (*biz.LeaseContractReply, error) {
+	l, err := r.data.db.LeaseContract.Get(ctx, id)
+	if err != nil {
+		return nil, ex.LeaseNotFound
+	}
+	return convertLease(l), nil
+}
+
+func (r leaseRepo) ExistsOverlap(ctx context.Context, carId int64, start, end time.Time) (bool, error) {
+	return r.data.LeaseContract(ctx).Query().
+		Where(
+			leasecontract.CarID(carId),
+			leasecontract.Status(biz.LeaseStatusActive),
+			leasecontract.StartDateLT(end),
+			leasecontract.EndDateGT(start),
+		).
+		Exist(ctx)
+}
+
+func (r leaseRepo) ExistsBlocking(ctx context.Context, carId int64, at time.Time) (bool, error) {
+	return r.data.LeaseContract(ctx).Query().
+		Where(
+			leasecontract.CarID(carId),
+			leasecontract.Status(biz.LeaseStatusActive),
+			leasecontract.TransferAllowed(false),
+			leasecontract.StartDateLTE(at),
+			leasecontract.EndDateGT(at),
+		).
+		Exist(ctx)
+}
+
+func (r leaseRepo) Save(ctx context.Context, l *biz.LeaseContract) (int64, error) {
+	rsp, err := r.data.LeaseContract(ctx).
+		Create().
+		SetLeaseContract(l).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r leaseRepo) Terminate(ctx context.Context, id int64, at time.Time) error {
+	n, err := r.data.LeaseContract(ctx).
+		Update().
+		Where(leasecontract.ID(id), leasecontract.Status(biz.LeaseStatusActive)).
+		SetStatus(biz.LeaseStatusTerminated).
+		SetTerminatedAt(at).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ex.LeaseStatusConflict
+	}
+	return nil
+}
+
+func convertLease(l *ent.LeaseContract) *biz.LeaseContractReply {
+	return &biz.LeaseContractReply{
+		Id:               l.ID,
+		CarId:            l.CarID,
+		Lessor:           l.Lessor,
+		ContractNo:       l.ContractNo,
+		LesseeId:         l.LesseeID,
+		StartDate:        l.StartDate,
+		EndDate:          l.EndDate,
+		MonthlyPayment:   l.MonthlyPayment,
+		MileageAllowance: l.MileageAllowance,
+		ExcessRate:       l.ExcessRate,
+		StartMileage:     l.StartMileage,
+		TransferAllowed:  l.TransferAllowed,
+		Status:           l.Status,
+		TerminatedAt:     l.TerminatedAt,
+		CreatedAt:        l.CreatedAt,
+	}
+}
@@ -0,0 +1,150 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/listing"
+	"car-service/internal/data/ent/predicate"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"time"
+)
+
+type listingRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewListingRepo(data *Data, logger log.Logger) biz.ListingRepo {
+	return &listingRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r listingRepo) SearchListing(ctx context.Context, page, pageSize int, filter *biz.ListingFilter) ([]*biz.ListingReply, int, error) {
+	var list []*biz.ListingReply
+	// 组装查询条件
+	cond := make([]predicate.Listing, 0)
+	if filter.Status != nil {
+		cond = append(cond, listing.Status(*filter.Status))
+	} else {
+		cond = append(cond, activeListing())
+	}
+	if filter.Keyword != nil && *filter.Keyword != "" {
+		cond = append(cond, listing.Or(
+			listing.DescriptionContains(*filter.Keyword),
+			listing.HasCarWith(car.ModelContainsFold(*filter.Keyword)),
+		))
+	}
+	if filter.ModelId != nil {
+		cond = append(cond, listing.HasCarWith(car.ModelID(*filter.ModelId)))
+	}
+	if filter.SellerId != nil {
+		cond = append(cond, listing.SellerID(*filter.SellerId))
+	}
+	if filter.MinPrice != nil {
+		cond = append(cond, listing.PriceGTE(*filter.MinPrice))
+	}
+	if filter.MaxPrice != nil {
+		cond = append(cond, listing.PriceLTE(*filter.MaxPrice))
+	}
+
+	order := ent.Desc(listing.FieldCreatedAt)
+	switch filter.Sort {
+	case biz.ListingSortPriceAsc:
+		order = ent.Asc(listing.FieldPrice)
+	case biz.ListingSortPriceDesc:
+		order = ent.Desc(listing.FieldPrice)
+	}
+
+	q := r.data.db.Listing.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	listings := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(order, ent.Desc(listing.FieldID)).
+		WithCar().
+		AllX(ctx)
+
+	for _, l := range listings {
+		list = append(list, convertListing(l))
+	}
+	return list, total, nil
+}
+
+func (r listingRepo) GetById(ctx context.Context, id int64) (*biz.ListingReply, error) {
+	l, err := r.data.db.Listing.Query().
+		Where(listing.ID(id)).
+		WithCar().
+		Only(ctx)
+	if err != nil {
+		return nil, ex.ListingNotFound
+	}
+	return convertListing(l), nil
+}
+
+func (r listingRepo) ExistsActive(ctx context.Context, carId int64) (bool, error) {
+	return r.data.db.Listing.Query().
+		Where(listing.CarID(carId), activeListing()).
+		Exist(ctx)
+}
+
+func (r listingRepo) Save(ctx context.Context, l *biz.Listing) (int64, error) {
+	rsp, err := r.data.db.Listing.
+		Create().
+		SetListing(l).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r listingRepo) Transit(ctx context.Context, l *biz.Listing) error {
+	n, err := r.data.Listing(ctx).
+		Update().
+		Where(listing.ID(l.ID), listing.Status(biz.ListingStatusActive)).
+		SetListing(l).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ex.ListingStatusConflict
+	}
+	return nil
+}
+
+// activeListing 在售且未过期
+func activeListing() predicate.Listing {
+	return listing.And(
+		listing.Status(biz.ListingStatusActive),
+		listing.ExpiresAtGT(time.Now()),
+	)
+}
+
+func convertListing(l *ent.Listing) *biz.ListingReply {
+	rsp := &biz.ListingReply{
+		Id:          l.ID,
+		CarId:       l.CarID,
+		SellerId:    l.SellerID,
+		Price:       l.Price,
+		Description: l.Description,
+		Status:      l.Status,
+		ExpiresAt:   l.ExpiresAt,
+		BuyerId:     l.BuyerID,
+		ClosedAt:    l.ClosedAt,
+		CreatedAt:   l.CreatedAt,
+	}
+	if c := l.Edges.Car; c != nil {
+		rsp.Model = c.Model
+		rsp.ModelId = c.ModelID
+		rsp.RegisteredAt = c.RegisteredAt
+	}
+	return rsp
+}
@@ -0,0 +1,35 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// 仅当锁仍归自己持有时才释放
+const unlockScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`
+
+func NewLocker(d *Data) biz.Locker {
+	return d
+}
+
+func (d *Data) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, false, err
+	}
+	token := hex.EncodeToString(b)
+
+	ok, err := d.rdsCmd.SetNX(ctx, key, token, ttl).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	unlock := func() {
+		if err := d.rdsCmd.Eval(context.Background(), unlockScript, []string{key}, token).Err(); err != nil {
+			d.log.Errorf("释放分布式锁失败: %s, %v", key, err)
+		}
+	}
+	return unlock, true, nil
+}
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/maintenancerecord"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+)
+
+type maintenanceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewMaintenanceRepo(data *Data, logger log.Logger) biz.MaintenanceRepo {
+	return &maintenanceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r maintenanceRepo) ListByCar(ctx context.Context, page, pageSize int, carId int64) ([]*biz.MaintenanceRecordReply, int, error) {
+	var list []*biz.MaintenanceRecordReply
+
+	q := r.data.db.MaintenanceRecord.Query().Where(maintenancerecord.CarID(carId))
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	records := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(maintenancerecord.FieldServicedAt)).
+		AllX(ctx)
+
+	for _, m := range records {
+		list = append(list, convertMaintenanceRecord(m))
+	}
+	return list, total, nil
+}
+
+func (r maintenanceRepo) GetById(ctx context.Context, id int64) (*biz.MaintenanceRecordReply, error) {
+	m, err := r.data.db.MaintenanceRecord.Get(ctx, id)
+	if err != nil {
+		return nil, ex.MaintenanceRecordNotFound
+	}
+	return convertMaintenanceRecord(m), nil
+}
+
+func (r maintenanceRepo) GetLatest(ctx context.Context, carId int64) (*biz.MaintenanceRecordReply, error) {
+	m, err := r.data.db.MaintenanceRecord.Query().
+		Where(maintenancerecord.CarID(carId)).
+		Order(ent.Desc(maintenancerecord.FieldServicedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertMaintenanceRecord(m), nil
+}
+
+func (r maintenanceRepo) Save(ctx context.Context, m *biz.MaintenanceRecord) (int64, error) {
+	rsp, err := r.data.db.MaintenanceRecord.
+		Create().
+		SetMaintenanceRecord(m).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r maintenanceRepo) Update(ctx context.Context, m *biz.MaintenanceRecord) error {
+	return r.data.db.MaintenanceRecord.
+		Update().
+		Where(maintenancerecord.ID(m.ID)).
+		SetMaintenanceRecord(m).
+		Exec(ctx)
+}
+
+func (r maintenanceRepo) Delete(ctx context.Context, id int64) error {
+	return r.data.db.MaintenanceRecord.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func convertMaintenanceRecord(m *ent.MaintenanceRecord) *biz.MaintenanceRecordReply {
+	return &biz.MaintenanceRecordReply{
+		Id:         m.ID,
+		CarId:      m.CarID,
+		ServicedAt: m.ServicedAt,
+		Mileage:    m.Mileage,
+		Type:       m.Type,
+		Cost:       m.Cost,
+		Shop:       m.Shop,
+		Notes:      m.Notes,
+	}
+}
@@ -0,0 +1,237 @@
+package data
+
+import (
+	"bytes"
+	"car-service/internal/biz"
+	"car-service/internal/conf"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/rueian/rueidis"
+	"mime"
+	"net/http"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const defaultWebhookTimeout = 5 * time.Second
+
+// 去重key的取值
+const (
+	notificationPending = "pending"
+	notificationSent    = "sent"
+)
+
+type notificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &notificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r notificationRepo) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return r.data.rdsCmd.SetNX(ctx, key, notificationPending, ttl).Result()
+}
+
+func (r notificationRepo) MarkSent(ctx context.Context, key string, ttl time.Duration) error {
+	return r.data.rdsCmd.Set(ctx, key, notificationSent, ttl).Err()
+}
+
+func (r notificationRepo) Sent(ctx context.Context, key string) (bool, error) {
+	v, err := r.data.rdsCmd.Get(ctx, key).Result()
+	if rueidis.IsRedisNil(err) {
+		return false, nil
+	}
+	return v == notificationSent, err
+}
+
+func (r notificationRepo) Release(ctx context.Context, key string) error {
+	return r.data.rdsCmd.Del(ctx, key).Err()
+}
+
+// NewNotificationChannels 按配置创建启用的投递渠道，配置不完整的渠道不启用
+func NewNotificationChannels(c *conf.Notification, logger log.Logger) []biz.NotificationChannel {
+	helper := log.NewHelper(logger)
+	channels := make([]biz.NotificationChannel, 0, len(c.GetChannels()))
+	for _, name := range c.GetChannels() {
+		switch name {
+		case biz.NotificationChannelLog:
+			channels = append(channels, &logChannel{log: helper})
+		case biz.NotificationChannelWebhook:
+			if c.GetWebhook().GetUrl() == "" {
+				helper.Warn("未配置webhook地址，不启用webhook通知")
+				continue
+			}
+			channels = append(channels, newWebhookChannel(c.GetWebhook()))
+		case biz.NotificationChannelSmtp:
+			if c.GetSmtp().GetAddr() == "" && c.GetSmtp().GetOutboxDir() == "" {
+				helper.Warn("未配置邮件服务器或本地发件箱，不启用邮件通知")
+				continue
+			}
+			channels = append(channels, &smtpChannel{c: c.GetSmtp(), log: helper})
+		default:
+			helper.Warnf("未知的通知渠道: %s", name)
+		}
+	}
+	return channels
+}
+
+// logChannel 将通知输出到日志，用于开发调试及留档
+type logChannel struct {
+	log *log.Helper
+}
+
+func (ch *logChannel) Name() string {
+	return biz.NotificationChannelLog
+}
+
+func (ch *logChannel) Send(ctx context.Context, n *biz.Notification) error {
+	ch.log.WithContext(ctx).Infof("通知[%s] 租户%d 用户%d 汽车%d %s: %s",
+		n.Topic, n.TenantId, n.UserId, n.CarId, n.Subject, n.Body)
+	return nil
+}
+
+// webhookChannel 以JSON POST通知，非2xx响应视为失败
+type webhookChannel struct {
+	c      *conf.Notification_Webhook
+	client *http.Client
+}
+
+func newWebhookChannel(c *conf.Notification_Webhook) *webhookChannel {
+	timeout := c.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return &webhookChannel{c: c, client: &http.Client{Timeout: timeout}}
+}
+
+func (ch *webhookChannel) Name() string {
+	return biz.NotificationChannelWebhook
+}
+
+func (ch *webhookChannel) Send(ctx context.Context, n *biz.Notification) error {
+	b, err := json.Marshal(map[string]interface{}{
+		"id":        n.Id,
+		"topic":     n.Topic,
+		"tenant_id": n.TenantId,
+		"user_id":   n.UserId,
+		"car_id":    n.CarId,
+		"locale":    n.Locale,
+		"subject":   n.Subject,
+		"body":      n.Body,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ch.c.GetUrl(), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// 接收方可据此去重
+	req.Header.Set("X-Notification-Id", n.Id)
+	if secret := ch.c.GetSecret(); secret != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(b)
+		req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	rsp, err := ch.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return fmt.Errorf("webhook响应状态码%d", rsp.StatusCode)
+	}
+	return nil
+}
+
+// smtpChannel 发送邮件到租户的收件地址；未配置邮件服务器时写入本地发件箱目录代替发送。
+// 同一事件通知租户内多个用户时只发送一封
+type smtpChannel struct {
+	c   *conf.Notification_Smtp
+	log *log.Helper
+}
+
+func (ch *smtpChannel) Name() string {
+	return biz.NotificationChannelSmtp
+}
+
+// Address 租户的收件地址，未配置时使用默认收件地址
+func (ch *smtpChannel) Address(n *biz.Notification) string {
+	if to, ok := ch.c.GetRecipients()[n.TenantId]; ok && to != "" {
+		return to
+	}
+	return ch.c.GetDefaultRecipient()
+}
+
+func (ch *smtpChannel) Send(ctx context.Context, n *biz.Notification) error {
+	to := ch.Address(n)
+	if to == "" {
+		ch.log.WithContext(ctx).Debugf("租户%d未配置收件地址，不发送邮件: %s", n.TenantId, n.Id)
+		return nil
+	}
+	msg := buildMail(ch.c.GetFrom(), to, n)
+
+	if ch.c.GetAddr() == "" {
+		return ch.writeOutbox(n, msg)
+	}
+	var auth smtp.Auth
+	if ch.c.GetUsername() != "" {
+		host := ch.c.GetAddr()
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		auth = smtp.PlainAuth("", ch.c.GetUsername(), ch.c.GetPassword(), host)
+	}
+	return smtp.SendMail(ch.c.GetAddr(), auth, ch.c.GetFrom(), []string{to}, msg)
+}
+
+// writeOutbox 先写临时文件再重命名，避免读取到未写完的邮件
+func (ch *smtpChannel) writeOutbox(n *biz.Notification, msg []byte) error {
+	dir := ch.c.GetOutboxDir()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	name := strings.ReplaceAll(n.Id, ":", "-") + ".eml"
+	tmp := filepath.Join(dir, "."+name)
+	if err := os.WriteFile(tmp, msg, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, name))
+}
+
+// buildMail 组装UTF-8纯文本邮件
+func buildMail(from, to string, n *biz.Notification) []byte {
+	var b bytes.Buffer
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", n.Subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("Message-ID: <" + strings.ReplaceAll(n.Id, ":", ".") + "@car-service>\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+	body := base64.StdEncoding.EncodeToString([]byte(n.Body))
+	// 每行不超过76个字符
+	for len(body) > 76 {
+		b.WriteString(body[:76] + "\r\n")
+		body = body[76:]
+	}
+	b.WriteString(body + "\r\n")
+	return b.Bytes()
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/odometerreading"
+	"car-service/internal/data/ent/predicate"
+	"context"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"time"
+)
+
+type odometerRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewOdometerRepo(data *Data, logger log.Logger) biz.OdometerRepo {
+	return &odometerRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r odometerRepo) ListOdometerReading(ctx context.Context, page, pageSize int, filter *biz.OdometerFilter) ([]*biz.OdometerReadingReply, int, error) {
+	var list []*biz.OdometerReadingReply
+	// 组装查询条件
+	cond := []predicate.OdometerReading{odometerreading.CarID(filter.CarId)}
+	if filter.StartTime != nil {
+		cond = append(cond, odometerreading.RecordedAtGTE(*filter.StartTime))
+	}
+	if filter.EndTime != nil {
+		cond = append(cond, odometerreading.RecordedAtLT(*filter.EndTime))
+	}
+
+	q := r.data.db.OdometerReading.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	readings := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(odometerreading.FieldRecordedAt)).
+		AllX(ctx)
+
+	for _, o := range readings {
+		list = append(list, convertOdometerReading(o))
+	}
+	return list, total, nil
+}
+
+func (r odometerRepo) GetNeighbours(ctx context.Context, carId int64, at time.Time) (*biz.OdometerReadingReply, *biz.OdometerReadingReply, error) {
+	var prev, next *biz.OdometerReadingReply
+	o, err := r.data.db.OdometerReading.Query().
+		Where(odometerreading.CarID(carId), odometerreading.Suspicious(false), odometerreading.RecordedAtLTE(at)).
+		Order(ent.Desc(odometerreading.FieldRecordedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if o != nil {
+		prev = convertOdometerReading(o)
+	}
+
+	o, err = r.data.db.OdometerReading.Query().
+		Where(odometerreading.CarID(carId), odometerreading.Suspicious(false), odometerreading.RecordedAtGT(at)).
+		Order(ent.Asc(odometerreading.FieldRecordedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if o != nil {
+		next = convertOdometerReading(o)
+	}
+	return prev, next, nil
+}
+
+func (r odometerRepo) Save(ctx context.Context, o *biz.OdometerReading) (int64, error) {
+	rsp, err := r.data.db.OdometerReading.
+		Create().
+		SetOdometerReading(o).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+// currentMileage 取每辆车最近一次的里程读数，疑似调表的读数不计，避免当前里程回退
+func (d *Data) currentMileage(ctx context.Context, carIds ...int64) (map[int64]int64, error) {
+	readings, err := d.db.OdometerReading.Query().
+		Where(
+			odometerreading.CarIDIn(carIds...),
+			odometerreading.Suspicious(false),
+			// 不存在更晚的正常读数
+			func(s *sql.Selector) {
+				t := sql.Table(odometerreading.Table).As("later")
+				s.Where(sql.NotExists(
+					sql.Select(t.C(odometerreading.FieldID)).
+						From(t).
+						Where(sql.And(
+							sql.ColumnsEQ(t.C(odometerreading.FieldCarID), s.C(odometerreading.FieldCarID)),
+							sql.ColumnsGT(t.C(odometerreading.FieldRecordedAt), s.C(odometerreading.FieldRecordedAt)),
+							sql.EQ(t.C(odometerreading.FieldSuspicious), false),
+						)),
+				))
+			},
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int64]int64, len(readings))
+	for _, o := range readings {
+		m[o.CarID] = o.Mileage
+	}
+	return m, nil
+}
+
+func convertOdometerReading(o *ent.OdometerReading) *biz.OdometerReadingReply {
+	return &biz.OdometerReadingReply{
+		Id:         o.ID,
+		CarId:      o.CarID,
+		Mileage:    o.Mileage,
+		Source:     o.Source,
+		Suspicious: o.Suspicious,
+		RecordedAt: o.RecordedAt,
+	}
+}
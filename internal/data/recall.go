@@ -0,0 +1,256 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/carrecall"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/recall"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"time"
+)
+
+type recallRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewRecallRepo(data *Data, logger log.Logger) biz.RecallRepo {
+	return &recallRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r recallRepo) ListRecall(ctx context.Context, page, pageSize int, modelId *int64) ([]*biz.RecallReply, int, error) {
+	var list []*biz.RecallReply
+	// 组装查询条件
+	cond := make([]predicate.Recall, 0)
+	if modelId != nil {
+		cond = append(cond, recall.ModelID(*modelId))
+	}
+
+	q := r.data.db.Recall.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	recalls := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(recall.FieldIssuedAt)).
+		AllX(ctx)
+
+	for _, rc := range recalls {
+		list = append(list, convertRecall(rc))
+	}
+	return list, total, nil
+}
+
+func (r recallRepo) GetById(ctx context.Context, id int64) (*biz.RecallReply, error) {
+	rc, err := r.data.db.Recall.Get(ctx, id)
+	if err != nil {
+		return nil, ex.RecallNotFound
+	}
+	return convertRecall(rc), nil
+}
+
+func (r recallRepo) Upsert(ctx context.Context, rc *biz.Recall) (int64, error) {
+	id, err := r.data.db.Recall.Query().
+		Where(recall.CampaignNo(rc.CampaignNo)).
+		OnlyID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, err
+	}
+	if err == nil {
+		return id, r.data.db.Recall.
+			Update().
+			Where(recall.ID(id)).
+			SetRecall(rc).
+			Exec(ctx)
+	}
+
+	rsp, err := r.data.db.Recall.
+		Create().
+		SetRecall(rc).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r recallRepo) ListCandidateCars(ctx context.Context, rc *biz.RecallReply) ([]*biz.CarReply, error) {
+	// 组装查询条件，车型名称的归一化比较在biz中完成
+	cond := make([]predicate.Car, 0)
+	if rc.ModelId > 0 {
+		cond = append(cond, car.ModelID(rc.ModelId))
+	} else {
+		cond = append(cond, car.ModelNotNil())
+	}
+	if rc.YearFrom > 0 {
+		cond = append(cond, car.RegisteredAtGTE(time.Date(rc.YearFrom, 1, 1, 0, 0, 0, 0, time.Local)))
+	}
+	if rc.YearTo > 0 {
+		cond = append(cond, car.RegisteredAtLT(time.Date(rc.YearTo+1, 1, 1, 0, 0, 0, 0, time.Local)))
+	}
+	if rc.VinFrom != "" {
+		cond = append(cond, car.VinGTE(rc.VinFrom))
+	}
+	if rc.VinTo != "" {
+		cond = append(cond, car.VinLTE(rc.VinTo))
+	}
+
+	cars, err := r.data.db.Car.Query().
+		Where(cond...).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.CarReply, 0, len(cars))
+	for _, c := range cars {
+		list = append(list, &biz.CarReply{
+			Id:           c.ID,
+			TenantId:     c.TenantID,
+			UserId:       c.UserID,
+			Model:        c.Model,
+			ModelId:      c.ModelID,
+			Vin:          c.Vin,
+			Plate:        c.Plate,
+			RegisteredAt: c.RegisteredAt,
+		})
+	}
+	return list, nil
+}
+
+func (r recallRepo) LinkCars(ctx context.Context, recallId int64, cars []*biz.CarReply) ([]int64, error) {
+	linked, err := r.data.db.CarRecall.Query().
+		Where(carrecall.RecallID(recallId)).
+		Select(carrecall.FieldCarID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[int64]bool, len(linked))
+	for _, cr := range linked {
+		exists[cr.CarID] = true
+	}
+
+	carIds := make([]int64, 0, len(cars))
+	bulk := make([]*ent.CarRecallCreate, 0, len(cars))
+	for _, c := range cars {
+		if exists[c.Id] {
+			continue
+		}
+		carIds = append(carIds, c.Id)
+		bulk = append(bulk, r.data.db.CarRecall.Create().
+			SetTenantID(c.TenantId).
+			SetCarID(c.Id).
+			SetRecallID(recallId))
+	}
+	if len(bulk) == 0 {
+		return carIds, nil
+	}
+	if err := r.data.db.CarRecall.CreateBulk(bulk...).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return carIds, nil
+}
+
+func (r recallRepo) ListCarRecall(ctx context.Context, page, pageSize int, filter *biz.CarRecallFilter) ([]*biz.CarRecallReply, int, error) {
+	var list []*biz.CarRecallReply
+	// 组装查询条件
+	cond := make([]predicate.CarRecall, 0)
+	if filter.CarId != nil {
+		cond = append(cond, carrecall.CarID(*filter.CarId))
+	}
+	if filter.RecallId != nil {
+		cond = append(cond, carrecall.RecallID(*filter.RecallId))
+	}
+	if filter.Status != nil {
+		cond = append(cond, carrecall.Status(*filter.Status))
+	}
+	if filter.OpenOnly {
+		cond = append(cond, carrecall.StatusIn(biz.CarRecallStatusOpen, biz.CarRecallStatusScheduled))
+	}
+
+	q := r.data.db.CarRecall.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	carRecalls := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(carrecall.FieldCreatedAt)).
+		WithRecall().
+		AllX(ctx)
+
+	for _, cr := range carRecalls {
+		list = append(list, convertCarRecall(cr))
+	}
+	return list, total, nil
+}
+
+func (r recallRepo) GetCarRecallById(ctx context.Context, id int64) (*biz.CarRecallReply, error) {
+	cr, err := r.data.db.CarRecall.Query().
+		Where(carrecall.ID(id)).
+		WithRecall().
+		Only(ctx)
+	if err != nil {
+		return nil, ex.CarRecallNotFound
+	}
+	return convertCarRecall(cr), nil
+}
+
+func (r recallRepo) TransitCarRecall(ctx context.Context, cr *biz.CarRecall, from string) error {
+	n, err := r.data.db.CarRecall.
+		Update().
+		Where(carrecall.ID(cr.ID), carrecall.Status(from)).
+		SetCarRecall(cr).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ex.CarRecallStatusConflict
+	}
+	return nil
+}
+
+func convertRecall(rc *ent.Recall) *biz.RecallReply {
+	return &biz.RecallReply{
+		Id:           rc.ID,
+		CampaignNo:   rc.CampaignNo,
+		Manufacturer: rc.Manufacturer,
+		ModelId:      rc.ModelID,
+		Model:        rc.Model,
+		YearFrom:     rc.YearFrom,
+		YearTo:       rc.YearTo,
+		VinFrom:      rc.VinFrom,
+		VinTo:        rc.VinTo,
+		Component:    rc.Component,
+		Description:  rc.Description,
+		Remedy:       rc.Remedy,
+		IssuedAt:     rc.IssuedAt,
+		CreatedAt:    rc.CreatedAt,
+	}
+}
+
+func convertCarRecall(cr *ent.CarRecall) *biz.CarRecallReply {
+	rsp := &biz.CarRecallReply{
+		Id:         cr.ID,
+		CarId:      cr.CarID,
+		RecallId:   cr.RecallID,
+		Status:     cr.Status,
+		Notes:      cr.Notes,
+		RemediedAt: cr.RemediedAt,
+		CreatedAt:  cr.CreatedAt,
+		UpdatedAt:  cr.UpdatedAt,
+	}
+	if cr.Edges.Recall != nil {
+		rsp.Recall = convertRecall(cr.Edges.Recall)
+	}
+	return rsp
+}
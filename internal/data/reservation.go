@@ -0,0 +1,124 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/reservation"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"time"
+)
+
+type reservationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewReservationRepo(data *Data, logger log.Logger) biz.ReservationRepo {
+	return &reservationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r reservationRepo) ListReservation(ctx context.Context, page, pageSize int, filter *biz.ReservationFilter) ([]*biz.ReservationReply, int, error) {
+	var list []*biz.ReservationReply
+	// 组装查询条件
+	cond := make([]predicate.Reservation, 0)
+	if filter.CarId != nil {
+		cond = append(cond, reservation.CarID(*filter.CarId))
+	}
+	if filter.UserId != nil {
+		cond = append(cond, reservation.UserID(*filter.UserId))
+	}
+	if filter.Status != nil {
+		cond = append(cond, reservation.Status(*filter.Status))
+	}
+	if filter.From != nil {
+		cond = append(cond, reservation.EndAtGT(*filter.From))
+	}
+	if filter.To != nil {
+		cond = append(cond, reservation.StartAtLT(*filter.To))
+	}
+
+	q := r.data.db.Reservation.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	reservations := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Asc(reservation.FieldStartAt)).
+		AllX(ctx)
+
+	for _, rv := range reservations {
+		list = append(list, convertReservation(rv))
+	}
+	return list, total, nil
+}
+
+func (r reservationRepo) GetById(ctx context.Context, id int64) (*biz.ReservationReply, error) {
+	rv, err := r.data.Reservation(ctx).Get(ctx, id)
+	if err != nil {
+		return nil, ex.ReservationNotFound
+	}
+	return convertReservation(rv), nil
+}
+
+func (r reservationRepo) ExistsOverlap(ctx context.Context, carId int64, start, end time.Time) (bool, error) {
+	return r.data.Reservation(ctx).Query().
+		Where(reservation.CarID(carId), overlaps(start, end)).
+		Exist(ctx)
+}
+
+func (r reservationRepo) Save(ctx context.Context, rv *biz.Reservation) (int64, error) {
+	rsp, err := r.data.Reservation(ctx).
+		Create().
+		SetReservation(rv).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r reservationRepo) Cancel(ctx context.Context, id int64, at time.Time) error {
+	n, err := r.data.Reservation(ctx).
+		Update().
+		Where(reservation.ID(id), reservation.Status(biz.ReservationStatusBooked)).
+		SetStatus(biz.ReservationStatusCancelled).
+		SetCancelledAt(at).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ex.ReservationStatusConflict
+	}
+	return nil
+}
+
+// overlaps 与时间段[start, end)有交集的有效预约
+func overlaps(start, end time.Time) predicate.Reservation {
+	return reservation.And(
+		reservation.Status(biz.ReservationStatusBooked),
+		reservation.StartAtLT(end),
+		reservation.EndAtGT(start),
+	)
+}
+
+func convertReservation(rv *ent.Reservation) *biz.ReservationReply {
+	return &biz.ReservationReply{
+		Id:          rv.ID,
+		CarId:       rv.CarID,
+		UserId:      rv.UserID,
+		StartAt:     rv.StartAt,
+		EndAt:       rv.EndAt,
+		Purpose:     rv.Purpose,
+		Status:      rv.Status,
+		CancelledAt: rv.CancelledAt,
+		CreatedAt:   rv.CreatedAt,
+	}
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/migrate"
+	"car-service/internal/pkg/auth"
+	"context"
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"testing"
+	"time"
+)
+
+func TestReservationOverlaps(t *testing.T) {
+	client, err := ent.Open(dialect.SQLite, "file:reservation?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	ctx := auth.NewSystemContext(context.Background())
+	if err := client.Schema.Create(ctx, migrate.WithForeignKeys(false)); err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(hour int) time.Time {
+		return time.Date(2024, 5, 1, hour, 0, 0, 0, time.UTC)
+	}
+	// 有效预约[10:00, 12:00)，已取消的预约[14:00, 16:00)
+	client.Reservation.Create().
+		SetTenantID(1).SetCarID(1).SetUserID(1).
+		SetStartAt(at(10)).SetEndAt(at(12)).
+		SetStatus(biz.ReservationStatusBooked).
+		SaveX(ctx)
+	client.Reservation.Create().
+		SetTenantID(1).SetCarID(1).SetUserID(1).
+		SetStartAt(at(14)).SetEndAt(at(16)).
+		SetStatus(biz.ReservationStatusCancelled).
+		SaveX(ctx)
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       bool
+	}{
+		{"ends when booking starts", at(8), at(10), false},
+		{"starts when booking ends", at(12), at(14), false},
+		{"overlaps start", at(9), at(11), true},
+		{"overlaps end", at(11), at(13), true},
+		{"inside booking", at(10), at(11), true},
+		{"covers booking", at(9), at(13), true},
+		{"same period", at(10), at(12), true},
+		{"cancelled booking ignored", at(14), at(16), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.Reservation.Query().
+				Where(overlaps(tt.start, tt.end)).
+				Exist(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("overlaps(%s, %s) = %v, want %v",
+					tt.start.Format("15:04"), tt.end.Format("15:04"), got, tt.want)
+			}
+		})
+	}
+}
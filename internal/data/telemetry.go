@@ -0,0 +1,195 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/conf"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/rueian/rueidis"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// 遥测数据按月分表，表名为前缀加年月，如telemetry_point_202401
+	telemetryTablePrefix = "telemetry_point_"
+	telemetryTableLayout = "200601"
+	// 单条insert语句的最大行数，避免超出占位符数量限制
+	telemetryInsertRows = 1000
+	// 汽车最后位置的缓存key前缀
+	locationKeyPrefix = "car-service:location:"
+)
+
+// 分表不经过ent迁移，首次写入某月数据时自动建表
+const telemetryTableDDL = `CREATE TABLE IF NOT EXISTS %s (
+    id          BIGINT   NOT NULL AUTO_INCREMENT,
+    tenant_id   BIGINT   NOT NULL,
+    car_id      BIGINT   NOT NULL,
+    recorded_at DATETIME NOT NULL,
+    lat         DOUBLE   NOT NULL,
+    lng         DOUBLE   NOT NULL,
+    speed       DOUBLE   NULL,
+    fuel_level  DOUBLE   NULL,
+    created_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    KEY idx_car_recorded (car_id, recorded_at),
+    KEY idx_tenant (tenant_id)
+)`
+
+// 仅当上报时间晚于已缓存的位置时才覆盖
+const setLocationScript = `local cur = redis.call("HGET", KEYS[1], "ts")
+if cur and tonumber(cur) >= tonumber(ARGV[1]) then return 0 end
+redis.call("HSET", KEYS[1], "ts", ARGV[1], "data", ARGV[2])
+if tonumber(ARGV[3]) > 0 then redis.call("PEXPIRE", KEYS[1], ARGV[3]) end
+return 1`
+
+type telemetryRepo struct {
+	data *Data
+	c    *conf.Telemetry
+	// 已确认存在的分表
+	tables *sync.Map
+	log    *log.Helper
+}
+
+func NewTelemetryRepo(data *Data, c *conf.Telemetry, logger log.Logger) biz.TelemetryRepo {
+	return &telemetryRepo{
+		data:   data,
+		c:      c,
+		tables: &sync.Map{},
+		log:    log.NewHelper(logger),
+	}
+}
+
+// SaveBatch 建表语句会隐式提交事务，需在开启事务前完成
+func (r telemetryRepo) SaveBatch(ctx context.Context, points []*biz.TelemetryPoint) error {
+	byTable := make(map[string][]*biz.TelemetryPoint)
+	for _, p := range points {
+		table := telemetryTablePrefix + p.RecordedAt.Format(telemetryTableLayout)
+		byTable[table] = append(byTable[table], p)
+	}
+	for table := range byTable {
+		if err := r.ensureTable(ctx, table); err != nil {
+			return err
+		}
+	}
+
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for table, list := range byTable {
+		for start := 0; start < len(list); start += telemetryInsertRows {
+			end := start + telemetryInsertRows
+			if end > len(list) {
+				end = len(list)
+			}
+			query, args := telemetryInsert(table, list[start:end])
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				_ = tx.Rollback()
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+func (r telemetryRepo) SetLastLocation(ctx context.Context, points []*biz.TelemetryPoint) error {
+	ttl := r.c.GetLocationTtl().AsDuration().Milliseconds()
+	for _, p := range points {
+		b, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		err = r.data.rdsCmd.Eval(ctx, setLocationScript, []string{locationKey(p.CarId)},
+			p.RecordedAt.UnixMilli(), string(b), ttl).Err()
+		if err != nil && !rueidis.IsRedisNil(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r telemetryRepo) GetLastLocation(ctx context.Context, carId int64) (*biz.TelemetryPoint, error) {
+	v, err := r.data.rdsCmd.HGet(ctx, locationKey(carId), "data").Result()
+	if rueidis.IsRedisNil(err) {
+		return nil, ex.LocationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	p := &biz.TelemetryPoint{}
+	if err := json.Unmarshal([]byte(v), p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r telemetryRepo) DropTablesBefore(ctx context.Context, month time.Time) ([]string, error) {
+	rows, err := r.data.db.QueryContext(ctx, `SELECT table_name FROM information_schema.tables
+WHERE table_schema = DATABASE() AND table_name LIKE ?`, strings.ReplaceAll(telemetryTablePrefix, "_", "\\_")+"%")
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	cutoff := month.Format(telemetryTableLayout)
+	dropped := make([]string, 0)
+	for _, table := range tables {
+		// 只处理按约定命名的分表
+		suffix := strings.TrimPrefix(table, telemetryTablePrefix)
+		if _, err := time.Parse(telemetryTableLayout, suffix); err != nil || suffix >= cutoff {
+			continue
+		}
+		if _, err := r.data.db.ExecContext(ctx, "DROP TABLE IF EXISTS "+table); err != nil {
+			return dropped, err
+		}
+		r.tables.Delete(table)
+		dropped = append(dropped, table)
+	}
+	return dropped, nil
+}
+
+func (r telemetryRepo) ensureTable(ctx context.Context, table string) error {
+	if _, ok := r.tables.Load(table); ok {
+		return nil
+	}
+	if _, err := r.data.db.ExecContext(ctx, fmt.Sprintf(telemetryTableDDL, table)); err != nil {
+		return err
+	}
+	r.tables.Store(table, struct{}{})
+	return nil
+}
+
+// telemetryInsert 组装多行insert语句
+func telemetryInsert(table string, points []*biz.TelemetryPoint) (string, []interface{}) {
+	rows := make([]string, 0, len(points))
+	args := make([]interface{}, 0, len(points)*7)
+	for _, p := range points {
+		rows = append(rows, "(?, ?, ?, ?, ?, ?, ?)")
+		args = append(args, p.TenantId, p.CarId, p.RecordedAt, p.Lat, p.Lng, p.Speed, p.FuelLevel)
+	}
+	query := fmt.Sprintf("INSERT INTO %s (tenant_id, car_id, recorded_at, lat, lng, speed, fuel_level) VALUES %s",
+		table, strings.Join(rows, ", "))
+	return query, args
+}
+
+func locationKey(carId int64) string {
+	return locationKeyPrefix + strconv.FormatInt(carId, 10)
+}
@@ -0,0 +1,133 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/transfer"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"time"
+)
+
+type transferRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTransferRepo(data *Data, logger log.Logger) biz.TransferRepo {
+	return &transferRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r transferRepo) ListTransfer(ctx context.Context, page, pageSize int, filter *biz.TransferFilter) ([]*biz.TransferReply, int, error) {
+	var list []*biz.TransferReply
+	// 组装查询条件
+	cond := make([]predicate.Transfer, 0)
+	if filter.CarId != nil {
+		cond = append(cond, transfer.CarID(*filter.CarId))
+	}
+	if filter.UserId != nil {
+		cond = append(cond, transfer.Or(
+			transfer.SellerID(*filter.UserId),
+			transfer.BuyerID(*filter.UserId),
+		))
+	}
+	if filter.Status != nil {
+		cond = append(cond, transfer.Status(*filter.Status))
+	}
+
+	q := r.data.db.Transfer.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	transfers := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(transfer.FieldCreatedAt)).
+		AllX(ctx)
+
+	for _, t := range transfers {
+		list = append(list, convertTransfer(t))
+	}
+	return list, total, nil
+}
+
+func (r transferRepo) GetById(ctx context.Context, id int64) (*biz.TransferReply, error) {
+	t, err := r.data.Transfer(ctx).Get(ctx, id)
+	if err != nil {
+		return nil, ex.TransferNotFound
+	}
+	return convertTransfer(t), nil
+}
+
+func (r transferRepo) ExistsInProgress(ctx context.Context, carId int64) (bool, error) {
+	return r.data.Transfer(ctx).Query().
+		Where(transfer.CarID(carId), inProgress()).
+		Exist(ctx)
+}
+
+func (r transferRepo) Save(ctx context.Context, t *biz.Transfer) (int64, error) {
+	rsp, err := r.data.Transfer(ctx).
+		Create().
+		SetTransfer(t).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r transferRepo) Transit(ctx context.Context, t *biz.Transfer, from string) error {
+	n, err := r.data.Transfer(ctx).
+		Update().
+		Where(
+			transfer.ID(t.ID),
+			transfer.Status(from),
+			transfer.CompletedAtIsNil(),
+		).
+		SetTransfer(t).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ex.TransferStatusConflict
+	}
+	return nil
+}
+
+func (r transferRepo) ExpireBefore(ctx context.Context, t time.Time) (int, error) {
+	return r.data.Transfer(ctx).
+		Update().
+		Where(inProgress(), transfer.ExpiresAtLT(t)).
+		SetStatus(biz.TransferStatusExpired).
+		Save(ctx)
+}
+
+// inProgress 待接受或待审批的过户
+func inProgress() predicate.Transfer {
+	return transfer.And(
+		transfer.StatusIn(biz.TransferStatusPending, biz.TransferStatusAccepted),
+		transfer.CompletedAtIsNil(),
+	)
+}
+
+func convertTransfer(t *ent.Transfer) *biz.TransferReply {
+	return &biz.TransferReply{
+		Id:              t.ID,
+		CarId:           t.CarID,
+		SellerId:        t.SellerID,
+		BuyerId:         t.BuyerID,
+		Status:          t.Status,
+		RequireApproval: t.RequireApproval,
+		ApprovedBy:      t.ApprovedBy,
+		ExpiresAt:       t.ExpiresAt,
+		AcceptedAt:      t.AcceptedAt,
+		CompletedAt:     t.CompletedAt,
+		CreatedAt:       t.CreatedAt,
+	}
+}
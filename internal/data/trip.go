@@ -0,0 +1,205 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/refuel"
+	"car-service/internal/data/ent/trip"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"strings"
+)
+
+type tripRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTripRepo(data *Data, logger log.Logger) biz.TripRepo {
+	return &tripRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r tripRepo) ListTrip(ctx context.Context, page, pageSize int, carId *int64) ([]*biz.TripReply, int, error) {
+	var list []*biz.TripReply
+	// 组装查询条件
+	cond := make([]predicate.Trip, 0)
+	if carId != nil {
+		cond = append(cond, trip.CarID(*carId))
+	}
+
+	q := r.data.db.Trip.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	trips := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(trip.FieldEndedAt)).
+		AllX(ctx)
+
+	for _, t := range trips {
+		list = append(list, convertTrip(t))
+	}
+	return list, total, nil
+}
+
+func (r tripRepo) SaveTrip(ctx context.Context, t *biz.Trip) (int64, error) {
+	rsp, err := r.data.db.Trip.
+		Create().
+		SetTrip(t).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r tripRepo) ListRefuel(ctx context.Context, page, pageSize int, carId *int64) ([]*biz.RefuelReply, int, error) {
+	var list []*biz.RefuelReply
+	// 组装查询条件
+	cond := make([]predicate.Refuel, 0)
+	if carId != nil {
+		cond = append(cond, refuel.CarID(*carId))
+	}
+
+	q := r.data.db.Refuel.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	refuels := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(refuel.FieldRefueledAt)).
+		AllX(ctx)
+
+	for _, f := range refuels {
+		list = append(list, convertRefuel(f))
+	}
+	return list, total, nil
+}
+
+func (r tripRepo) SaveRefuel(ctx context.Context, f *biz.Refuel) (int64, error) {
+	rsp, err := r.data.db.Refuel.
+		Create().
+		SetRefuel(f).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+// FuelReport 行程里程与补能记录合并后按车和月份在数据库中汇总
+// 原生SQL不经过ent隐私规则，租户条件由调用方传入
+func (r tripRepo) FuelReport(ctx context.Context, filter *biz.FuelReportFilter) ([]*biz.FuelReport, error) {
+	tripWhere, tripArgs := fuelReportWhere(filter, trip.FieldEndedAt)
+	refuelWhere, refuelArgs := fuelReportWhere(filter, refuel.FieldRefueledAt)
+
+	period := func(column string) string {
+		if filter.Monthly {
+			return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m')", column)
+		}
+		return "''"
+	}
+	// 加油与充电按单位分列，费用可能为空
+	query := fmt.Sprintf(`SELECT car_id, period,
+       SUM(distance),
+       SUM(amount),
+       SUM(energy),
+       COALESCE(SUM(cost), 0),
+       COALESCE(SUM(amount) * 100 / NULLIF(SUM(distance), 0), 0),
+       COALESCE(SUM(energy) * 100 / NULLIF(SUM(distance), 0), 0),
+       COALESCE(SUM(cost) / NULLIF(SUM(distance), 0), 0)
+FROM (
+    SELECT %s AS car_id, %s AS period, %s - %s AS distance, 0 AS amount, 0 AS energy, 0 AS cost
+    FROM %s WHERE %s
+    UNION ALL
+    SELECT %s, %s, 0,
+           CASE WHEN %s = ? THEN %s ELSE 0 END,
+           CASE WHEN %s = ? THEN %s ELSE 0 END,
+           %s
+    FROM %s WHERE %s
+) t
+GROUP BY car_id, period
+ORDER BY car_id, period`,
+		trip.FieldCarID, period(trip.FieldEndedAt), trip.FieldEndOdometer, trip.FieldStartOdometer,
+		trip.Table, tripWhere,
+		refuel.FieldCarID, period(refuel.FieldRefueledAt),
+		refuel.FieldUnit, refuel.FieldAmount,
+		refuel.FieldUnit, refuel.FieldAmount,
+		refuel.FieldCost,
+		refuel.Table, refuelWhere,
+	)
+	args := append(tripArgs, biz.RefuelUnitLiter, biz.RefuelUnitKwh)
+	args = append(args, refuelArgs...)
+
+	rows, err := r.data.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*biz.FuelReport
+	for rows.Next() {
+		rp := &biz.FuelReport{}
+		if err := rows.Scan(&rp.CarId, &rp.Period, &rp.Distance, &rp.Amount, &rp.Energy, &rp.Cost,
+			&rp.AmountPer100Km, &rp.EnergyPer100Km, &rp.CostPerKm); err != nil {
+			return nil, err
+		}
+		list = append(list, rp)
+	}
+	return list, rows.Err()
+}
+
+// fuelReportWhere 组装行程或补能表的过滤条件，timeColumn为统计归属的时间字段
+func fuelReportWhere(filter *biz.FuelReportFilter, timeColumn string) (string, []interface{}) {
+	where := []string{"1 = 1"}
+	args := make([]interface{}, 0)
+	if filter.TenantId != nil {
+		where = append(where, "tenant_id = ?")
+		args = append(args, *filter.TenantId)
+	}
+	if filter.CarId != nil {
+		where = append(where, "car_id = ?")
+		args = append(args, *filter.CarId)
+	}
+	if filter.From != nil {
+		where = append(where, timeColumn+" >= ?")
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		where = append(where, timeColumn+" < ?")
+		args = append(args, *filter.To)
+	}
+	return strings.Join(where, " AND "), args
+}
+
+func convertTrip(t *ent.Trip) *biz.TripReply {
+	return &biz.TripReply{
+		Id:            t.ID,
+		CarId:         t.CarID,
+		DriverId:      t.DriverID,
+		Purpose:       t.Purpose,
+		StartOdometer: t.StartOdometer,
+		EndOdometer:   t.EndOdometer,
+		StartedAt:     t.StartedAt,
+		EndedAt:       t.EndedAt,
+	}
+}
+
+func convertRefuel(f *ent.Refuel) *biz.RefuelReply {
+	return &biz.RefuelReply{
+		Id:         f.ID,
+		CarId:      f.CarID,
+		Amount:     f.Amount,
+		Unit:       f.Unit,
+		Cost:       f.Cost,
+		Station:    f.Station,
+		Odometer:   f.Odometer,
+		RefueledAt: f.RefueledAt,
+	}
+}
@@ -0,0 +1,63 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/traderecord"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+type valuationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewValuationRepo(data *Data, logger log.Logger) biz.ValuationRepo {
+	return &valuationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r valuationRepo) ListComparables(ctx context.Context, modelId int64, since time.Time, limit int) ([]*biz.TradeRecordReply, error) {
+	records, err := r.data.db.TradeRecord.Query().
+		Where(
+			traderecord.ModelID(modelId),
+			traderecord.PriceGT(0),
+			traderecord.TradedAtGTE(since),
+		).
+		Order(ent.Desc(traderecord.FieldTradedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.TradeRecordReply, 0, len(records))
+	for _, t := range records {
+		list = append(list, &biz.TradeRecordReply{
+			Id:             t.ID,
+			CarId:          t.CarID,
+			ModelId:        t.ModelID,
+			Price:          t.Price,
+			EstimatedValue: t.EstimatedValue,
+			Mileage:        t.Mileage,
+			AgeMonths:      t.AgeMonths,
+			TradedAt:       t.TradedAt,
+		})
+	}
+	return list, nil
+}
+
+func (r valuationRepo) SaveTradeRecord(ctx context.Context, t *biz.TradeRecord) (int64, error) {
+	rsp, err := r.data.TradeRecord(ctx).
+		Create().
+		SetTradeRecord(t).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
@@ -0,0 +1,272 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/car"
+	"car-service/internal/data/ent/ownerhistory"
+	"car-service/internal/data/ent/predicate"
+	"car-service/internal/data/ent/violation"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lovechung/go-kit/util/pagination"
+	"time"
+)
+
+type violationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewViolationRepo(data *Data, logger log.Logger) biz.ViolationRepo {
+	return &violationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r violationRepo) ListViolation(ctx context.Context, page, pageSize int, filter *biz.ViolationFilter) ([]*biz.ViolationReply, int, error) {
+	var list []*biz.ViolationReply
+	// 组装查询条件
+	cond := make([]predicate.Violation, 0)
+	if filter.CarId != nil {
+		cond = append(cond, violation.CarID(*filter.CarId))
+	}
+	if filter.UserId != nil {
+		cond = append(cond, violation.UserID(*filter.UserId))
+	}
+	if filter.Status != nil {
+		cond = append(cond, violation.Status(*filter.Status))
+	}
+
+	q := r.data.db.Violation.Query().Where(cond...)
+	// 查询总数
+	total := q.CountX(ctx)
+	// 查询列表
+	violations := q.Offset(pagination.GetOffset(page, pageSize)).
+		Limit(pageSize).
+		Order(ent.Desc(violation.FieldOccurredAt)).
+		AllX(ctx)
+
+	for _, v := range violations {
+		list = append(list, convertViolation(v))
+	}
+	return list, total, nil
+}
+
+func (r violationRepo) GetById(ctx context.Context, id int64) (*biz.ViolationReply, error) {
+	v, err := r.data.db.Violation.Get(ctx, id)
+	if err != nil {
+		return nil, ex.ViolationNotFound
+	}
+	return convertViolation(v), nil
+}
+
+func (r violationRepo) ExistsReferenceNo(ctx context.Context, referenceNo string) (bool, error) {
+	return r.data.Violation(ctx).Query().
+		Where(violation.ReferenceNo(referenceNo)).
+		Exist(ctx)
+}
+
+func (r violationRepo) Save(ctx context.Context, v *biz.Violation) (int64, error) {
+	rsp, err := r.data.Violation(ctx).
+		Create().
+		SetViolation(v).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r violationRepo) Transit(ctx context.Context, v *biz.Violation, from string) error {
+	n, err := r.data.Violation(ctx).
+		Update().
+		Where(violation.ID(v.ID), violation.Status(from)).
+		SetViolation(v).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ex.ViolationStatusConflict
+	}
+	return nil
+}
+
+func (r violationRepo) OutstandingBalance(ctx context.Context, userId int64) (*biz.OutstandingBalance, error) {
+	var v []struct {
+		Count  int     `json:"count"`
+		Amount float64 `json:"sum"`
+	}
+	err := r.data.db.Violation.Query().
+		Where(
+			violation.UserID(userId),
+			violation.StatusIn(biz.ViolationStatusUnpaid, biz.ViolationStatusDisputed),
+		).
+		GroupBy(violation.FieldUserID).
+		Aggregate(ent.Count(), ent.Sum(violation.FieldAmount)).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &biz.OutstandingBalance{UserId: userId}
+	if len(v) > 0 {
+		rsp.Count = v[0].Count
+		rsp.Amount = v[0].Amount
+	}
+	return rsp, nil
+}
+
+func (r violationRepo) ListOwnerHistory(ctx context.Context, carId int64) ([]*biz.OwnerHistoryReply, error) {
+	histories, err := r.data.db.OwnerHistory.Query().
+		Where(ownerhistory.CarID(carId)).
+		Order(ent.Asc(ownerhistory.FieldStartedAt), ent.Asc(ownerhistory.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.OwnerHistoryReply, 0, len(histories))
+	for _, h := range histories {
+		list = append(list, &biz.OwnerHistoryReply{
+			Id:        h.ID,
+			CarId:     h.CarID,
+			UserId:    h.UserID,
+			StartedAt: h.StartedAt,
+			EndedAt:   h.EndedAt,
+		})
+	}
+	return list, nil
+}
+
+func (r violationRepo) OwnerAt(ctx context.Context, carId int64, at time.Time) (int64, bool, error) {
+	h, err := r.data.db.OwnerHistory.Query().
+		Where(
+			ownerhistory.CarID(carId),
+			ownerhistory.StartedAtLTE(at),
+			ownerhistory.Or(ownerhistory.EndedAtIsNil(), ownerhistory.EndedAtGT(at)),
+		).
+		Order(ent.Desc(ownerhistory.FieldStartedAt), ent.Desc(ownerhistory.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return h.UserID, true, nil
+}
+
+// carOwnerHistoryHook 新建汽车或车主变更时，结束上一任车主的持有区间并开始新的区间；
+// 启用车主记录前登记的汽车没有持有区间，按登记时间补上一任车主截至本次变更的区间
+func carOwnerHistoryHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		cm, ok := m.(*ent.CarMutation)
+		if !ok || cm.Op().Is(ent.OpDelete|ent.OpDeleteOne) {
+			return next.Mutate(ctx, m)
+		}
+		userId, ok := cm.UserID()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+
+		// 变更前先查出原车主
+		olds := make([]*ent.Car, 0)
+		if !cm.Op().Is(ent.OpCreate) {
+			ids, err := cm.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			olds, err = cm.Client().Car.Query().Where(car.IDIn(ids...)).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		if c, ok := v.(*ent.Car); ok && cm.Op().Is(ent.OpCreate) {
+			err = cm.Client().OwnerHistory.Create().
+				SetTenantID(c.TenantID).
+				SetCarID(c.ID).
+				SetUserID(c.UserID).
+				SetStartedAt(c.RegisteredAt).
+				Exec(ctx)
+			return v, err
+		}
+
+		now := time.Now()
+		builders := make([]*ent.OwnerHistoryCreate, 0)
+		changed := make([]int64, 0)
+		for _, c := range olds {
+			if c.UserID == userId {
+				continue
+			}
+			changed = append(changed, c.ID)
+			builders = append(builders, cm.Client().OwnerHistory.Create().
+				SetTenantID(c.TenantID).
+				SetCarID(c.ID).
+				SetUserID(userId).
+				SetStartedAt(now))
+		}
+		if len(builders) == 0 {
+			return v, nil
+		}
+		opened, err := cm.Client().OwnerHistory.Query().
+			Where(ownerhistory.CarIDIn(changed...), ownerhistory.EndedAtIsNil()).
+			Select(ownerhistory.FieldCarID).
+			Ints(ctx)
+		if err != nil {
+			return nil, err
+		}
+		hasOpen := make(map[int64]bool, len(opened))
+		for _, id := range opened {
+			hasOpen[int64(id)] = true
+		}
+		for _, c := range olds {
+			if c.UserID == userId || c.UserID == 0 || hasOpen[c.ID] {
+				continue
+			}
+			builders = append(builders, cm.Client().OwnerHistory.Create().
+				SetTenantID(c.TenantID).
+				SetCarID(c.ID).
+				SetUserID(c.UserID).
+				SetStartedAt(c.RegisteredAt).
+				SetEndedAt(now))
+		}
+		err = cm.Client().OwnerHistory.Update().
+			Where(ownerhistory.CarIDIn(changed...), ownerhistory.EndedAtIsNil()).
+			SetEndedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := cm.Client().OwnerHistory.CreateBulk(builders...).Exec(ctx); err != nil {
+			return nil, err
+		}
+		return v, nil
+	})
+}
+
+func convertViolation(v *ent.Violation) *biz.ViolationReply {
+	return &biz.ViolationReply{
+		Id:          v.ID,
+		CarId:       v.CarID,
+		UserId:      v.UserID,
+		ReferenceNo: v.ReferenceNo,
+		OccurredAt:  v.OccurredAt,
+		Location:    v.Location,
+		Amount:      v.Amount,
+		Description: v.Description,
+		Status:      v.Status,
+		ResolvedAt:  v.ResolvedAt,
+		ResolvedBy:  v.ResolvedBy,
+		CreatedAt:   v.CreatedAt,
+	}
+}
@@ -0,0 +1,135 @@
+package data
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/data/ent"
+	"car-service/internal/data/ent/carwarranty"
+	"car-service/internal/data/ent/warrantydefinition"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type warrantyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWarrantyRepo(data *Data, logger log.Logger) biz.WarrantyRepo {
+	return &warrantyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r warrantyRepo) ListDefinition(ctx context.Context, modelId int64) ([]*biz.WarrantyDefinitionReply, error) {
+	defs, err := r.data.db.WarrantyDefinition.Query().
+		Where(warrantydefinition.ModelID(modelId)).
+		Order(ent.Asc(warrantydefinition.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.WarrantyDefinitionReply, 0, len(defs))
+	for _, d := range defs {
+		list = append(list, convertWarrantyDefinition(d))
+	}
+	return list, nil
+}
+
+func (r warrantyRepo) GetDefinitionById(ctx context.Context, id int64) (*biz.WarrantyDefinitionReply, error) {
+	d, err := r.data.db.WarrantyDefinition.Get(ctx, id)
+	if err != nil {
+		return nil, ex.WarrantyDefinitionNotFound
+	}
+	return convertWarrantyDefinition(d), nil
+}
+
+func (r warrantyRepo) SaveDefinition(ctx context.Context, d *biz.WarrantyDefinition) (int64, error) {
+	rsp, err := r.data.db.WarrantyDefinition.
+		Create().
+		SetWarrantyDefinition(d).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (r warrantyRepo) UpdateDefinition(ctx context.Context, d *biz.WarrantyDefinition) error {
+	return r.data.db.WarrantyDefinition.
+		Update().
+		Where(warrantydefinition.ID(d.ID)).
+		SetWarrantyDefinition(d).
+		Exec(ctx)
+}
+
+func (r warrantyRepo) DeleteDefinition(ctx context.Context, id int64) error {
+	err := r.data.db.WarrantyDefinition.
+		DeleteOneID(id).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return ex.WarrantyDefinitionNotFound
+	}
+	return err
+}
+
+func (r warrantyRepo) ListCarWarranty(ctx context.Context, carId int64) ([]*biz.CarWarrantyReply, error) {
+	warranties, err := r.data.db.CarWarranty.Query().
+		Where(carwarranty.CarID(carId)).
+		Order(ent.Asc(carwarranty.FieldEndAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*biz.CarWarrantyReply, 0, len(warranties))
+	for _, w := range warranties {
+		reply := &biz.CarWarrantyReply{
+			Id:           w.ID,
+			CarId:        w.CarID,
+			DefinitionId: w.DefinitionID,
+			Name:         w.Name,
+			StartAt:      w.StartAt,
+			EndAt:        w.EndAt,
+			MileageLimit: w.MileageLimit,
+		}
+		if w.Components != "" {
+			_ = json.Unmarshal([]byte(w.Components), &reply.Components)
+		}
+		list = append(list, reply)
+	}
+	return list, nil
+}
+
+// SaveCarWarranties 并发补齐同一汽车的质保时唯一索引冲突，视为已由其他请求创建
+func (r warrantyRepo) SaveCarWarranties(ctx context.Context, warranties []*biz.CarWarranty) error {
+	bulk := make([]*ent.CarWarrantyCreate, 0, len(warranties))
+	for _, w := range warranties {
+		bulk = append(bulk, r.data.db.CarWarranty.Create().SetCarWarranty(w))
+	}
+	err := r.data.db.CarWarranty.
+		CreateBulk(bulk...).
+		Exec(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return err
+	}
+	return nil
+}
+
+func convertWarrantyDefinition(d *ent.WarrantyDefinition) *biz.WarrantyDefinitionReply {
+	reply := &biz.WarrantyDefinitionReply{
+		Id:             d.ID,
+		ModelId:        d.ModelID,
+		Name:           d.Name,
+		DurationMonths: d.DurationMonths,
+		MileageLimit:   d.MileageLimit,
+		CreatedAt:      d.CreatedAt,
+	}
+	if d.Components != "" {
+		_ = json.Unmarshal([]byte(d.Components), &reply.Components)
+	}
+	return reply
+}
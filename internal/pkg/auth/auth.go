@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/metadata"
+	"strconv"
+)
+
+// 网关透传的全局元数据key
+const (
+	userIdKey   = "x-md-global-user-id"
+	tenantIdKey = "x-md-global-tenant-id"
+	roleKey     = "x-md-global-role"
+)
+
+// RoleAdmin 管理员可跨租户访问
+const RoleAdmin = "admin"
+
+type systemKey struct{}
+
+// GetUserId 从请求元数据中获取当前操作人
+func GetUserId(ctx context.Context) (int64, bool) {
+	return getInt64(ctx, userIdKey)
+}
+
+// GetTenantId 从请求元数据中获取当前租户
+func GetTenantId(ctx context.Context) (int64, bool) {
+	return getInt64(ctx, tenantIdKey)
+}
+
+// IsAdmin 是否为管理员或系统内部调用
+func IsAdmin(ctx context.Context) bool {
+	if v, _ := ctx.Value(systemKey{}).(bool); v {
+		return true
+	}
+	md, ok := metadata.FromServerContext(ctx)
+	if !ok {
+		return false
+	}
+	return md.Get(roleKey) == RoleAdmin
+}
+
+// NewSystemContext 标记为系统内部调用（如定时任务），不受租户限制
+func NewSystemContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemKey{}, true)
+}
+
+func getInt64(ctx context.Context, key string) (int64, bool) {
+	md, ok := metadata.FromServerContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(md.Get(key), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
@@ -0,0 +1,130 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 各字段的取值范围：分 时 日 月 周，周的7同0表示周日
+var bounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// Schedule 标准5段cron表达式，按本地时区计算
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// 日和周都有限制时满足其一即可；与Vixie cron一致，以*开头（含*/n）的字段视为不限制
+	domStar, dowStar bool
+}
+
+// Parse 解析"分 时 日 月 周"格式的表达式，支持*、逗号列表、-范围及/步长
+func Parse(expr string) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron表达式须为5段: %q", expr)
+	}
+	var sets [5]uint64
+	for i, f := range fields {
+		set, err := parseField(f, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("cron表达式第%d段错误: %q, %v", i+1, expr, err)
+		}
+		sets[i] = set
+	}
+	// 周日统一为0
+	if sets[4]&(1<<7) != 0 {
+		sets[4] = sets[4]&^(1<<7) | 1
+	}
+	return &Schedule{
+		minute:  sets[0],
+		hour:    sets[1],
+		dom:     sets[2],
+		month:   sets[3],
+		dow:     sets[4],
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// Next 返回晚于t的下一次执行时间（精确到分钟），五年内无匹配时返回零值
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			// 按本地时间取整点，Truncate按UTC取整，在非整点偏移的时区会跳过半小时
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domOk := s.dom&(1<<uint(t.Day())) != 0
+	dowOk := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domOk && dowOk
+	}
+	return domOk || dowOk
+}
+
+// parseField 将一个字段解析为位集合
+func parseField(field string, min, max int) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		step, stepped := 1, false
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("无效的步长: %s", part)
+			}
+			step, stepped = n, true
+			part = part[:i]
+		}
+
+		lo, hi := min, max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			r := strings.SplitN(part, "-", 2)
+			var err error
+			if lo, err = strconv.Atoi(r[0]); err != nil {
+				return 0, fmt.Errorf("无效的范围: %s", part)
+			}
+			if hi, err = strconv.Atoi(r[1]); err != nil {
+				return 0, fmt.Errorf("无效的范围: %s", part)
+			}
+		default:
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return 0, fmt.Errorf("无效的取值: %s", part)
+			}
+			lo = n
+			// 带步长的单个值表示从该值开始到最大值
+			if !stepped {
+				hi = n
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("取值超出范围%d-%d: %s", min, max, part)
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,86 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{"too few fields", "* * * *"},
+		{"too many fields", "* * * * * *"},
+		{"empty", ""},
+		{"zero step", "*/0 * * * *"},
+		{"negative step", "*/-1 * * * *"},
+		{"non-numeric step", "*/x * * * *"},
+		{"missing step", "*/ * * * *"},
+		{"minute out of range", "60 * * * *"},
+		{"hour out of range", "0 24 * * *"},
+		{"day of month zero", "0 0 0 * *"},
+		{"month out of range", "0 0 1 13 *"},
+		{"day of week out of range", "0 0 * * 8"},
+		{"reversed range", "5-1 * * * *"},
+		{"open range", "1- * * * *"},
+		{"non-numeric value", "a * * * *"},
+		{"empty list item", "1,,2 * * * *"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.expr); err == nil {
+				t.Errorf("Parse(%q) succeeded, want error", tt.expr)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	// 2026-10-19为周一
+	at := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	ist := time.FixedZone("IST", 5*3600+1800)
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{"every minute", "* * * * *", at(2026, 10, 19, 10, 7), at(2026, 10, 19, 10, 8)},
+		{"drops seconds", "* * * * *", time.Date(2026, 10, 19, 10, 7, 30, 0, time.UTC), at(2026, 10, 19, 10, 8)},
+		{"star step", "*/15 * * * *", at(2026, 10, 19, 10, 7), at(2026, 10, 19, 10, 15)},
+		{"star step next hour", "*/15 * * * *", at(2026, 10, 19, 10, 50), at(2026, 10, 19, 11, 0)},
+		{"range step", "10-40/15 * * * *", at(2026, 10, 19, 10, 26), at(2026, 10, 19, 10, 40)},
+		{"range step past end", "10-40/15 * * * *", at(2026, 10, 19, 10, 41), at(2026, 10, 19, 11, 10)},
+		{"value step", "5/20 * * * *", at(2026, 10, 19, 10, 46), at(2026, 10, 19, 11, 5)},
+		{"value step of one", "0 20/1 * * *", at(2026, 10, 19, 21, 30), at(2026, 10, 19, 22, 0)},
+		{"hour range step", "0 9-17/4 * * *", at(2026, 10, 19, 10, 7), at(2026, 10, 19, 13, 0)},
+		{"list", "0 0 * * 1,3", at(2026, 10, 19, 10, 7), at(2026, 10, 21, 0, 0)},
+		{"seven is sunday", "0 0 * * 7", at(2026, 10, 19, 10, 7), at(2026, 10, 25, 0, 0)},
+		{"range through seven", "0 0 * * 6-7", at(2026, 10, 19, 10, 7), at(2026, 10, 24, 0, 0)},
+		{"dom or dow, dom first", "0 0 21 * 5", at(2026, 10, 19, 10, 7), at(2026, 10, 21, 0, 0)},
+		{"dom or dow, dow first", "0 0 21 * 5", at(2026, 10, 21, 0, 0), at(2026, 10, 23, 0, 0)},
+		{"dow only", "0 0 * * 5", at(2026, 10, 19, 10, 7), at(2026, 10, 23, 0, 0)},
+		{"dom only", "0 0 21 * *", at(2026, 10, 21, 0, 0), at(2026, 11, 21, 0, 0)},
+		{"starred dom step ands with dow", "0 0 */2 * 4", at(2026, 10, 19, 10, 7), at(2026, 10, 29, 0, 0)},
+		{"day 31 skips short months", "0 0 31 * *", at(2026, 10, 31, 0, 0), at(2026, 12, 31, 0, 0)},
+		{"leap day", "0 0 29 2 *", at(2026, 10, 19, 10, 7), at(2028, 2, 29, 0, 0)},
+		{"year rollover", "* * * * *", at(2026, 12, 31, 23, 59), at(2027, 1, 1, 0, 0)},
+		{"new year", "0 0 1 1 *", at(2026, 10, 19, 10, 7), at(2027, 1, 1, 0, 0)},
+		{"never matches", "0 0 30 2 *", at(2026, 10, 19, 10, 7), time.Time{}},
+		{"half-hour offset zone", "0 11 * * *", time.Date(2026, 10, 19, 10, 45, 0, 0, ist), time.Date(2026, 10, 19, 11, 0, 0, 0, ist)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.expr, err)
+			}
+			if got := s.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.from, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,5 +3,145 @@ package ex
 import "github.com/lovechung/api-base/api/car"
 
 var (
-	CarNotFound = car.ErrorCarNotFound("该汽车不存在")
+	CarNotFound   = car.ErrorCarNotFound("该汽车不存在")
+	InvalidTime   = car.ErrorInvalidParam("时间格式错误")
+	CarIdRequired = car.ErrorInvalidParam("汽车ID不能为空")
+	Unauthorized  = car.ErrorUnauthorized("缺少操作人信息")
+
+	SearchKeywordTooShort = car.ErrorInvalidParam("搜索关键词至少2个字符")
+
+	TenantRequired      = car.ErrorTenantForbidden("缺少租户信息")
+	TenantMismatch      = car.ErrorTenantForbidden("无权操作其他租户的数据")
+	TenantQuotaExceeded = car.ErrorTenantQuotaExceeded("汽车数量已达租户配额上限")
+
+	FleetNotFound     = car.ErrorFleetNotFound("该车队不存在")
+	FleetNameRequired = car.ErrorInvalidParam("车队名称不能为空")
+	TagNameRequired   = car.ErrorInvalidParam("标签不能为空")
+
+	AttributeDefinitionNotFound = car.ErrorAttributeDefinitionNotFound("该自定义属性不存在")
+	AttributeNameRequired       = car.ErrorInvalidParam("自定义属性名称不能为空")
+	InvalidAttributeType        = car.ErrorInvalidParam("不支持的自定义属性类型")
+	EnumValuesRequired          = car.ErrorInvalidParam("枚举类型必须提供可选值")
+	AttributeUndefined          = car.ErrorInvalidParam("未定义的自定义属性")
+	AttributeRequired           = car.ErrorInvalidParam("缺少必填的自定义属性")
+	InvalidAttributeValue       = car.ErrorInvalidParam("自定义属性值与类型不符")
+
+	ReservationNotFound       = car.ErrorReservationNotFound("该预约不存在")
+	ReservationConflict       = car.ErrorReservationConflict("该时间段汽车已被预约")
+	ReservationStatusConflict = car.ErrorReservationConflict("当前预约状态不允许该操作")
+	InvalidReservationPeriod  = car.ErrorInvalidParam("预约结束时间必须晚于开始时间且不能早于当前时间")
+	ReservationUserRequired   = car.ErrorInvalidParam("预约人不能为空")
+	NotReservationOwner       = car.ErrorReservationForbidden("只有预约人可以执行该操作")
+
+	InvalidTripOdometer = car.ErrorInvalidParam("行程里程不能为空且结束里程不能小于开始里程")
+	InvalidTripPeriod   = car.ErrorInvalidParam("结束时间必须晚于开始时间")
+	InvalidRefuelAmount = car.ErrorInvalidParam("补能数量必须大于0且费用不能小于0")
+	InvalidRefuelUnit   = car.ErrorInvalidParam("补能单位只能为L或kWh")
+
+	RecallNotFound          = car.ErrorRecallNotFound("该召回不存在")
+	CarRecallNotFound       = car.ErrorRecallNotFound("该汽车召回记录不存在")
+	RecallForbidden         = car.ErrorRecallForbidden("只有管理员可以导入和匹配召回")
+	CarRecallStatusConflict = car.ErrorRecallConflict("当前整改状态不允许该操作")
+	CampaignNoRequired      = car.ErrorInvalidParam("召回编号不能为空")
+	RecallModelRequired     = car.ErrorInvalidParam("召回车型不能为空")
+	InvalidRecallYearRange  = car.ErrorInvalidParam("召回起始年款不能晚于结束年款")
+	InvalidRecallVinRange   = car.ErrorInvalidParam("召回VIN起止长度须一致且起始不能大于结束")
+
+	ViolationNotFound       = car.ErrorViolationNotFound("该违章记录不存在")
+	ViolationStatusConflict = car.ErrorViolationConflict("当前违章处理状态不允许该操作")
+	ViolationOwnerUnknown   = car.ErrorViolationConflict("缺少违章发生时的车主记录，无法确定罚款归属")
+	ViolationWaiveForbidden = car.ErrorViolationForbidden("只有管理员可以撤销罚款")
+	ViolationTimeRequired   = car.ErrorInvalidParam("违章时间不能为空")
+	InvalidViolationAmount  = car.ErrorInvalidParam("罚款金额不能为空或小于0")
+
+	ValuationUnavailable = car.ErrorValuationUnavailable("该车型缺少基准价及参考成交价，无法估值")
+	InvalidTradePrice    = car.ErrorInvalidParam("成交价不能小于0")
+
+	ListingNotFound       = car.ErrorListingNotFound("该出售信息不存在")
+	ListingExists         = car.ErrorListingConflict("该汽车已有在售信息")
+	ListingStatusConflict = car.ErrorListingConflict("当前出售状态不允许该操作")
+	ListingExpired        = car.ErrorListingConflict("该出售信息已过期")
+	InvalidListingPrice   = car.ErrorInvalidParam("售价必须大于0")
+	InvalidListingExpiry  = car.ErrorInvalidParam("有效期必须晚于当前时间")
+
+	WarrantyDefinitionNotFound = car.ErrorWarrantyDefinitionNotFound("该质保条款不存在")
+	WarrantyNameRequired       = car.ErrorInvalidParam("质保条款名称不能为空")
+	InvalidWarrantyDuration    = car.ErrorInvalidParam("质保期限必须大于0个月")
+	WarrantyComponentRequired  = car.ErrorInvalidParam("检查的部件不能为空")
+
+	IncidentNotFound        = car.ErrorIncidentNotFound("该事故记录不存在")
+	IncidentStatusConflict  = car.ErrorIncidentConflict("当前事故处理状态不允许该操作")
+	IncidentPolicyMismatch  = car.ErrorIncidentConflict("保单不属于该汽车或事故发生时不在保障期内")
+	IncidentPolicyRequired  = car.ErrorIncidentConflict("提交理赔前请先关联保单")
+	IncidentPhotoMismatch   = car.ErrorInvalidParam("事故照片须为该汽车的附件")
+	InvalidIncidentTime     = car.ErrorInvalidParam("事故时间不能为空或晚于当前时间")
+	InvalidIncidentSeverity = car.ErrorInvalidParam("不支持的事故严重程度")
+	ThirdPartyNameRequired  = car.ErrorInvalidParam("第三方名称不能为空")
+	ClaimNoRequired         = car.ErrorInvalidParam("理赔单号不能为空")
+	InvalidClaimAmount      = car.ErrorInvalidParam("理赔金额不能为空或小于0")
+
+	LeaseNotFound           = car.ErrorLeaseNotFound("该租约不存在")
+	LeaseOverlap            = car.ErrorLeaseConflict("该汽车在此期间已有租约")
+	LeaseStatusConflict     = car.ErrorLeaseConflict("当前租约状态不允许该操作")
+	LeaseForbidsTransfer    = car.ErrorLeaseConflict("汽车处于租期内，租约禁止过户")
+	LeaseMileageUnavailable = car.ErrorLeaseConflict("缺少租期内的里程读数，无法计算超里程费用")
+	LessorRequired          = car.ErrorInvalidParam("出租方不能为空")
+	InvalidLeasePeriod      = car.ErrorInvalidParam("租约结束日期必须晚于开始日期")
+	InvalidLeasePayment     = car.ErrorInvalidParam("月租金不能为空或小于0")
+	InvalidMileageAllowance = car.ErrorInvalidParam("里程限额、起租里程及超里程单价不能小于0")
+
+	NotElectricCar         = car.ErrorChargingConflict("该汽车未登记电池容量，不是电动车")
+	ChargingSessionOverlap = car.ErrorChargingConflict("该时间段已有充电记录")
+	InvalidBatteryCapacity = car.ErrorInvalidParam("电池容量不能小于0")
+	InvalidStateOfHealth   = car.ErrorInvalidParam("电池健康度须在0到100之间")
+	InvalidStateOfCharge   = car.ErrorInvalidParam("电量百分比须在0到100之间且充电后不低于充电前")
+	InvalidChargingPeriod  = car.ErrorInvalidParam("充电结束时间须晚于开始时间且不晚于当前时间")
+	InvalidChargingEnergy  = car.ErrorInvalidParam("充电电量必须大于0")
+	InvalidChargerType     = car.ErrorInvalidParam("不支持的充电桩类型")
+	InvalidChargingCost    = car.ErrorInvalidParam("充电费用不能小于0")
+
+	TelemetryBusy    = car.ErrorTelemetryBusy("遥测数据写入繁忙，请稍后重试")
+	LocationNotFound = car.ErrorLocationNotFound("暂无该汽车的位置信息")
+
+	GeofenceNotFound        = car.ErrorGeofenceNotFound("该围栏不存在")
+	GeofenceNameExists      = car.ErrorGeofenceConflict("该围栏名称已存在")
+	GeofenceNameRequired    = car.ErrorInvalidParam("围栏名称不能为空")
+	InvalidPolygon          = car.ErrorInvalidParam("围栏至少需要3个坐标有效的顶点，且不能环绕极点")
+	InvalidGeofenceSchedule = car.ErrorInvalidParam("围栏生效时间格式错误，星期须为0到6，时间须为HH:MM且开始结束不能相同")
+	InvalidGeofenceTimeZone = car.ErrorInvalidParam("不支持的围栏时区，须为IANA时区名，如Asia/Shanghai")
+	InvalidGeofenceAlert    = car.ErrorInvalidParam("不支持的围栏告警类型")
+	InvalidCoordinate       = car.ErrorInvalidParam("经纬度超出有效范围")
+	InvalidPositionTime     = car.ErrorInvalidParam("位置上报时间不能晚于当前时间")
+
+	JobForbidden = car.ErrorJobForbidden("只有管理员可以查看定时任务执行记录")
+
+	MaintenanceRecordNotFound = car.ErrorMaintenanceRecordNotFound("该保养记录不存在")
+
+	InsurancePolicyNotFound = car.ErrorInsurancePolicyNotFound("该保单不存在")
+	InvalidPolicyPeriod     = car.ErrorInvalidParam("保单结束日期必须晚于开始日期")
+
+	VehicleModelNotFound = car.ErrorVehicleModelNotFound("该车型不存在")
+	BrandIdRequired      = car.ErrorInvalidParam("品牌ID不能为空")
+	ModelIdRequired      = car.ErrorInvalidParam("车型ID不能为空")
+	CatalogForbidden     = car.ErrorCatalogForbidden("只有管理员可以维护品牌及车型目录")
+
+	TransferNotFound          = car.ErrorTransferNotFound("该过户记录不存在")
+	TransferInProgress        = car.ErrorTransferConflict("该汽车已有进行中的过户")
+	TransferStatusConflict    = car.ErrorTransferConflict("当前过户状态不允许该操作")
+	TransferExpired           = car.ErrorTransferConflict("该过户已过期")
+	InvalidTransferBuyer      = car.ErrorInvalidParam("买方不能为空或为当前车主")
+	NotCarOwner               = car.ErrorTransferForbidden("只有车主可以执行该操作")
+	NotTransferBuyer          = car.ErrorTransferForbidden("只有买方可以执行该操作")
+	TransferRejectForbidden   = car.ErrorTransferForbidden("只有买方或管理员可以拒绝过户")
+	TransferApprovalForbidden = car.ErrorTransferForbidden("只有管理员可以审批过户")
+	CarOwnerChanged           = car.ErrorTransferConflict("车主已变更，过户失败")
+
+	InvalidMileage = car.ErrorInvalidParam("里程读数不能为空或小于0")
+
+	AttachmentNotFound         = car.ErrorAttachmentNotFound("该附件不存在")
+	AttachmentEmpty            = car.ErrorInvalidParam("附件内容不能为空")
+	AttachmentMetaRequired     = car.ErrorInvalidParam("请先发送附件元数据")
+	AttachmentTooLarge         = car.ErrorInvalidParam("附件大小超出限制")
+	AttachmentTypeNotAllowed   = car.ErrorInvalidParam("不支持的附件类型")
+	AttachmentChecksumMismatch = car.ErrorInvalidParam("附件校验和不一致")
 )
@@ -3,9 +3,11 @@ package server
 import (
 	"car-service/internal/conf"
 	"car-service/internal/service"
+	"context"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/metadata"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -16,10 +18,20 @@ import (
 	"go.opentelemetry.io/otel/metric/global"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/unit"
+	ggrpc "google.golang.org/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, cs *service.CarService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, cs *service.CarService, as *service.AuditService,
+	ms *service.MaintenanceService, is *service.InsuranceService, cgs *service.CatalogService,
+	ts *service.TransferService, ods *service.OdometerService, ats *service.AttachmentService,
+	fs *service.FleetService, ars *service.AttributeService, rs *service.ReservationService,
+	tps *service.TripService, rcs *service.RecallService,
+	vs *service.ViolationService, vls *service.ValuationService,
+	ls *service.ListingService, ws *service.WarrantyService,
+	ics *service.IncidentService, lcs *service.LeaseService,
+	chs *service.ChargingService, tms *service.TelemetryService, gfs *service.GeofenceService,
+	jbs *service.JobService, logger log.Logger) *grpc.Server {
 	meter := global.Meter("car-service")
 	requestHistogram, _ := meter.SyncInt64().Histogram("car_service_req", instrument.WithUnit(unit.Milliseconds))
 
@@ -29,12 +41,14 @@ func NewGRPCServer(c *conf.Server, cs *service.CarService, logger log.Logger) *g
 				recovery.Recovery(),
 				ratelimit.Server(),
 				tracing.Server(),
+				metadata.Server(),
 				metrics.Server(
 					metrics.WithSeconds(contrib.NewHistogram(requestHistogram)),
 				),
 				logging.Server(logger),
 			),
 		),
+		grpc.StreamInterceptor(streamMetadata()),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -47,5 +61,38 @@ func NewGRPCServer(c *conf.Server, cs *service.CarService, logger log.Logger) *g
 	}
 	srv := grpc.NewServer(opts...)
 	car.RegisterCarServer(srv, cs)
+	car.RegisterAuditServer(srv, as)
+	car.RegisterMaintenanceServer(srv, ms)
+	car.RegisterInsuranceServer(srv, is)
+	car.RegisterCatalogServer(srv, cgs)
+	car.RegisterTransferServer(srv, ts)
+	car.RegisterOdometerServer(srv, ods)
+	car.RegisterAttachmentServer(srv, ats)
+	car.RegisterFleetServer(srv, fs)
+	car.RegisterAttributeServer(srv, ars)
+	car.RegisterReservationServer(srv, rs)
+	car.RegisterTripServer(srv, tps)
+	car.RegisterRecallServer(srv, rcs)
+	car.RegisterViolationServer(srv, vs)
+	car.RegisterValuationServer(srv, vls)
+	car.RegisterListingServer(srv, ls)
+	car.RegisterWarrantyServer(srv, ws)
+	car.RegisterIncidentServer(srv, ics)
+	car.RegisterLeaseServer(srv, lcs)
+	car.RegisterChargingServer(srv, chs)
+	car.RegisterTelemetryServer(srv, tms)
+	car.RegisterGeofenceServer(srv, gfs)
+	car.RegisterJobServer(srv, jbs)
 	return srv
 }
+
+// streamMetadata kratos中间件只作用于一元调用，流式调用需在拦截器中将网关透传的元数据（租户、用户、角色）写入流的上下文
+func streamMetadata() ggrpc.StreamServerInterceptor {
+	m := metadata.Server()
+	return func(srv interface{}, ss ggrpc.ServerStream, _ *ggrpc.StreamServerInfo, handler ggrpc.StreamHandler) error {
+		_, err := m(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, handler(srv, grpc.NewWrappedStream(ctx, ss))
+		})(ss.Context(), nil)
+		return err
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"car-service/internal/pkg/auth"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// identity 服务端流式方法，依次返回流上下文中的租户、用户及是否管理员
+var identityDesc = ggrpc.ServiceDesc{
+	ServiceName: "test.Identity",
+	HandlerType: (*interface{})(nil),
+	Streams: []ggrpc.StreamDesc{{
+		StreamName:    "Watch",
+		ServerStreams: true,
+		Handler: func(_ interface{}, stream ggrpc.ServerStream) error {
+			if err := stream.RecvMsg(&emptypb.Empty{}); err != nil {
+				return err
+			}
+			ctx := stream.Context()
+			tenantId, _ := auth.GetTenantId(ctx)
+			userId, _ := auth.GetUserId(ctx)
+			var admin int64
+			if auth.IsAdmin(ctx) {
+				admin = 1
+			}
+			for _, v := range []int64{tenantId, userId, admin} {
+				if err := stream.SendMsg(wrapperspb.Int64(v)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}},
+}
+
+func TestStreamMetadata(t *testing.T) {
+	srv := grpc.NewServer(grpc.Address("127.0.0.1:0"), grpc.StreamInterceptor(streamMetadata()))
+	srv.RegisterService(&identityDesc, struct{}{})
+	endpoint, err := srv.Endpoint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = srv.Start(context.Background())
+	}()
+	defer func() {
+		_ = srv.Stop(context.Background())
+	}()
+
+	conn, err := ggrpc.Dial(endpoint.Host, ggrpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	tests := []struct {
+		name string
+		md   []string
+		want []int64
+	}{
+		{"no metadata", nil, []int64{0, 0, 0}},
+		{"tenant user", []string{"x-md-global-tenant-id", "3", "x-md-global-user-id", "7"}, []int64{3, 7, 0}},
+		{"admin", []string{"x-md-global-user-id", "1", "x-md-global-role", auth.RoleAdmin}, []int64{0, 1, 1}},
+		{"unrelated header", []string{"x-tenant-id", "3"}, []int64{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			ctx = grpcmd.AppendToOutgoingContext(ctx, tt.md...)
+			stream, err := conn.NewStream(ctx, &identityDesc.Streams[0], "/test.Identity/Watch")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := stream.SendMsg(&emptypb.Empty{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := stream.CloseSend(); err != nil {
+				t.Fatal(err)
+			}
+			for i, want := range tt.want {
+				got := &wrapperspb.Int64Value{}
+				if err := stream.RecvMsg(got); err != nil {
+					t.Fatalf("RecvMsg %d: %v", i, err)
+				}
+				if got.GetValue() != want {
+					t.Errorf("value %d = %d, want %d", i, got.GetValue(), want)
+				}
+			}
+		})
+	}
+}
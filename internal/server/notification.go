@@ -0,0 +1,57 @@
+package server
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+const notificationRestartDelay = 5 * time.Second
+
+// NotificationServer 后台消费领域事件并发送通知，停止时等待正在投递的通知完成
+type NotificationServer struct {
+	uc     *biz.NotificationUseCase
+	log    *log.Helper
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewNotificationServer(uc *biz.NotificationUseCase, logger log.Logger) *NotificationServer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &NotificationServer{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+}
+
+// Start 消费异常退出（如redis不可用）时间隔一段时间后重新开始
+func (s *NotificationServer) Start(context.Context) error {
+	defer close(s.done)
+	for {
+		err := s.uc.Run(s.ctx)
+		if err == nil || s.ctx.Err() != nil {
+			return nil
+		}
+		s.log.Errorf("通知消费异常退出，稍后重试: %v", err)
+		select {
+		case <-time.After(notificationRestartDelay):
+		case <-s.ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *NotificationServer) Stop(ctx context.Context) error {
+	s.cancel()
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		s.log.Warn("通知未发送完即停止")
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"car-service/internal/biz"
+	"car-service/internal/conf"
+	"car-service/internal/pkg/auth"
+	"car-service/internal/pkg/cron"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+	"os"
+	"sync"
+	"time"
+)
+
+// 未配置超时时间的任务默认超时
+const defaultJobTimeout = 10 * time.Minute
+
+type job struct {
+	name     string
+	schedule *cron.Schedule
+	timeout  time.Duration
+	run      func(ctx context.Context) error
+}
+
+// Scheduler 按配置的cron表达式执行后台定时任务，多副本下每次计划只由一个实例执行
+type Scheduler struct {
+	ju       *biz.JobUseCase
+	jobs     []*job
+	instance string
+	runs     syncint64.Counter
+	duration syncint64.Histogram
+	log      *log.Helper
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+func NewScheduler(c *conf.Scheduler, ju *biz.JobUseCase, iu *biz.InsuranceUseCase, tu *biz.TransferUseCase,
+	cu *biz.CarUseCase, tmu *biz.TelemetryUseCase, logger log.Logger) (*Scheduler, error) {
+	meter := global.Meter("car-service")
+	runs, _ := meter.SyncInt64().Counter("car_service_job_runs")
+	duration, _ := meter.SyncInt64().Histogram("car_service_job_duration", instrument.WithUnit(unit.Milliseconds))
+	instance, _ := os.Hostname()
+
+	s := &Scheduler{
+		ju:       ju,
+		instance: instance,
+		runs:     runs,
+		duration: duration,
+		log:      log.NewHelper(logger),
+		stop:     make(chan struct{}),
+	}
+	handlers := []struct {
+		name string
+		run  func(ctx context.Context) error
+	}{
+		{"insurance-remind", iu.RemindExpiring},
+		{"transfer-expire", tu.ExpireTransfers},
+		{"owner-name-sync", cu.SyncOwnerNames},
+		{"telemetry-purge", tmu.PurgeExpired},
+		{"job-run-purge", ju.PurgeJobRuns},
+	}
+	known := make(map[string]bool, len(handlers))
+	for _, h := range handlers {
+		known[h.name] = true
+		if err := s.register(h.name, c.GetJobs()[h.name], h.run); err != nil {
+			return nil, err
+		}
+	}
+	for name := range c.GetJobs() {
+		if !known[name] {
+			s.log.Warnf("未知的定时任务配置: %s", name)
+		}
+	}
+	return s, nil
+}
+
+func (s *Scheduler) register(name string, c *conf.Scheduler_Job, run func(ctx context.Context) error) error {
+	if c.GetCron() == "" {
+		s.log.Infof("未配置cron表达式，不启动定时任务: %s", name)
+		return nil
+	}
+	schedule, err := cron.Parse(c.GetCron())
+	if err != nil {
+		return fmt.Errorf("定时任务%s配置错误: %v", name, err)
+	}
+	timeout := c.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultJobTimeout
+	}
+	s.jobs = append(s.jobs, &job{name: name, schedule: schedule, timeout: timeout, run: run})
+	return nil
+}
+
+func (s *Scheduler) Start(ctx context.Context) error {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, j)
+	}
+	s.wg.Wait()
+	return nil
+}
+
+// Stop 等待执行中的任务结束，超过ctx的期限则不再等待
+func (s *Scheduler) Stop(ctx context.Context) error {
+	close(s.stop)
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.log.Warn("定时任务未执行完即停止")
+	}
+	return nil
+}
+
+// loop 执行耗时超过计划间隔时跳过错过的计划，不会重叠执行
+func (s *Scheduler) loop(ctx context.Context, j *job) {
+	defer s.wg.Done()
+	for next := j.schedule.Next(time.Now()); !next.IsZero(); next = j.schedule.Next(time.Now()) {
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-timer.C:
+			s.execute(ctx, j, next)
+		case <-s.stop:
+			timer.Stop()
+			return
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
+
+func (s *Scheduler) execute(ctx context.Context, j *job, scheduledAt time.Time) {
+	start := time.Now()
+	// 定时任务跨租户执行
+	status, err := s.ju.Run(auth.NewSystemContext(ctx), j.name, scheduledAt, j.timeout, s.instance, j.run)
+	s.runs.Add(ctx, 1, attribute.String("job", j.name), attribute.String("status", status))
+	if status == biz.JobStatusSkipped {
+		return
+	}
+	s.duration.Record(ctx, time.Since(start).Milliseconds(), attribute.String("job", j.name))
+	if err != nil {
+		s.log.Errorf("定时任务执行失败: %s, %v", j.name, err)
+	}
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TelemetryServer 后台批量写入遥测数据，停止时写完队列中剩余的数据
+type TelemetryServer struct {
+	uc   *biz.TelemetryUseCase
+	log  *log.Helper
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewTelemetryServer(uc *biz.TelemetryUseCase, logger log.Logger) *TelemetryServer {
+	return &TelemetryServer{
+		uc:   uc,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+func (s *TelemetryServer) Start(context.Context) error {
+	defer close(s.done)
+	s.uc.RunWriter(s.stop)
+	return nil
+}
+
+func (s *TelemetryServer) Stop(ctx context.Context) error {
+	close(s.stop)
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		s.log.Warn("遥测数据未写完即停止")
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
+)
+
+// 下载时每个分片的大小
+const chunkSize = 32 * 1024
+
+type AttachmentService struct {
+	v1.UnimplementedAttachmentServer
+
+	uc  *biz.AttachmentUseCase
+	log *log.Helper
+}
+
+func NewAttachmentService(uc *biz.AttachmentUseCase, logger log.Logger) *AttachmentService {
+	return &AttachmentService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *AttachmentService) ListAttachment(ctx context.Context, req *wrapperspb.Int64Value) (*v1.ListAttachmentReply, error) {
+	list, err := s.uc.ListAttachment(ctx, req.Value)
+
+	rsp := &v1.ListAttachmentReply{}
+	for _, a := range list {
+		rsp.List = append(rsp.List, ConvertToAttachmentReply(a))
+	}
+	return rsp, err
+}
+
+func ConvertToAttachmentReply(a *biz.AttachmentReply) *v1.AttachmentReply {
+	return &v1.AttachmentReply{
+		Id:          a.Id,
+		CarId:       a.CarId,
+		Kind:        a.Kind,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Checksum:    a.Checksum,
+		CreatedAt:   t.Format(a.CreatedAt),
+	}
+}
+
+// UploadAttachment 首个消息携带元数据，之后的消息依次携带文件分片
+func (s *AttachmentService) UploadAttachment(stream v1.Attachment_UploadAttachmentServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := req.GetMeta()
+	if meta == nil {
+		return ex.AttachmentMetaRequired
+	}
+
+	// 将分片流转换为io.Reader，边接收边写入存储
+	pr, pw := io.Pipe()
+	go func() {
+		if _, err := pw.Write(req.GetChunk()); err != nil {
+			return
+		}
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				_ = pw.Close()
+				return
+			}
+			if err != nil {
+				_ = pw.CloseWithError(err)
+				return
+			}
+			if _, err := pw.Write(req.GetChunk()); err != nil {
+				return
+			}
+		}
+	}()
+	defer pr.Close()
+
+	a, err := s.uc.Upload(stream.Context(), &biz.AttachmentMeta{
+		CarId:    meta.CarId,
+		Kind:     meta.Kind,
+		FileName: meta.FileName,
+		Checksum: meta.Checksum,
+	}, pr)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(ConvertToAttachmentReply(a))
+}
+
+// DownloadAttachment 首个消息返回元数据，之后的消息依次返回文件分片
+func (s *AttachmentService) DownloadAttachment(req *wrapperspb.Int64Value, stream v1.Attachment_DownloadAttachmentServer) error {
+	a, rc, err := s.uc.Download(stream.Context(), req.Value)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if err := stream.Send(&v1.DownloadAttachmentReply{Meta: ConvertToAttachmentReply(a)}); err != nil {
+		return err
+	}
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := rc.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&v1.DownloadAttachmentReply{Chunk: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *AttachmentService) DeleteAttachment(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.DeleteAttachment(ctx, req.Value)
+	return nil, err
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type AttributeService struct {
+	v1.UnimplementedAttributeServer
+
+	uc  *biz.AttributeUseCase
+	log *log.Helper
+}
+
+func NewAttributeService(uc *biz.AttributeUseCase, logger log.Logger) *AttributeService {
+	return &AttributeService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *AttributeService) ListAttributeDefinition(ctx context.Context, _ *emptypb.Empty) (*v1.ListAttributeDefinitionReply, error) {
+	list, err := s.uc.ListDefinition(ctx)
+
+	rsp := &v1.ListAttributeDefinitionReply{}
+	for _, d := range list {
+		rsp.List = append(rsp.List, &v1.AttributeDefinitionReply{
+			Id:         d.Id,
+			Name:       d.Name,
+			ValueType:  d.ValueType,
+			Required:   d.Required,
+			EnumValues: d.EnumValues,
+			CreatedAt:  t.Format(d.CreatedAt),
+		})
+	}
+	return rsp, err
+}
+
+func (s *AttributeService) SaveAttributeDefinition(ctx context.Context, req *v1.SaveAttributeDefinitionReq) (*emptypb.Empty, error) {
+	err := s.uc.SaveDefinition(ctx, &biz.AttributeDefinition{
+		Name:      req.Name,
+		ValueType: &req.ValueType,
+		Required:  req.Required,
+	}, req.EnumValues)
+	return nil, err
+}
+
+func (s *AttributeService) UpdateAttributeDefinition(ctx context.Context, req *v1.UpdateAttributeDefinitionReq) (*emptypb.Empty, error) {
+	err := s.uc.UpdateDefinition(ctx, &biz.AttributeDefinition{
+		ID:       req.Id,
+		Name:     req.Name,
+		Required: req.Required,
+	}, req.EnumValues)
+	return nil, err
+}
+
+func (s *AttributeService) DeleteAttributeDefinition(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.DeleteDefinition(ctx, req.Value)
+	return nil, err
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+)
+
+type AuditService struct {
+	v1.UnimplementedAuditServer
+
+	uc  *biz.AuditLogUseCase
+	log *log.Helper
+}
+
+func NewAuditService(uc *biz.AuditLogUseCase, logger log.Logger) *AuditService {
+	return &AuditService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *AuditService) ListAuditLog(ctx context.Context, req *v1.ListAuditLogReq) (*v1.ListAuditLogReply, error) {
+	startTime, err := parseTime(req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := parseTime(req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListAuditLog(ctx, page, pageSize, &biz.AuditLogFilter{
+		CarId:     req.CarId,
+		Actor:     req.Actor,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+
+	rsp := &v1.ListAuditLogReply{}
+	rsp.Total = int32(total)
+	for _, l := range list {
+		rsp.List = append(rsp.List, &v1.AuditLogReply{
+			Id:        l.Id,
+			CarId:     l.CarId,
+			Op:        l.Op,
+			Actor:     l.Actor,
+			Diff:      l.Diff,
+			TraceId:   l.TraceId,
+			CreatedAt: t.Format(l.CreatedAt),
+		})
+	}
+	return rsp, err
+}
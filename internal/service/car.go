@@ -24,7 +24,12 @@ func NewCarService(uc *biz.CarUseCase, logger log.Logger) *CarService {
 
 func (s *CarService) ListCar(ctx context.Context, req *v1.ListCarReq) (*v1.ListCarReply, error) {
 	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
-	list, total, err := s.uc.ListCar(ctx, page, pageSize, req.Model)
+	list, total, err := s.uc.ListCar(ctx, page, pageSize, &biz.CarFilter{
+		Model:      req.Model,
+		FleetId:    req.FleetId,
+		Tag:        req.Tag,
+		Attributes: req.Attributes,
+	})
 
 	rsp := &v1.ListCarReply{}
 	rsp.Total = int32(total)
@@ -35,6 +40,24 @@ func (s *CarService) ListCar(ctx context.Context, req *v1.ListCarReq) (*v1.ListC
 	return rsp, err
 }
 
+func (s *CarService) SearchCars(ctx context.Context, req *v1.SearchCarsReq) (*v1.SearchCarsReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.SearchCars(ctx, page, pageSize, req.Keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.SearchCarsReply{}
+	rsp.Total = int32(total)
+	for _, hit := range list {
+		rsp.List = append(rsp.List, &v1.SearchCarsReply_Hit{
+			Car:   ConvertToCarReply(hit.Car),
+			Score: hit.Score,
+		})
+	}
+	return rsp, nil
+}
+
 func (s *CarService) GetCar(ctx context.Context, req *wrapperspb.Int64Value) (*v1.CarReply, error) {
 	c, err := s.uc.GetCarById(ctx, req.Value)
 	if err != nil {
@@ -45,26 +68,52 @@ func (s *CarService) GetCar(ctx context.Context, req *wrapperspb.Int64Value) (*v
 
 func ConvertToCarReply(c *biz.CarReply) *v1.CarReply {
 	return &v1.CarReply{
-		Id:           c.Id,
-		Username:     c.UserName,
-		Model:        c.Model,
-		RegisteredAt: t.Format(c.RegisteredAt),
+		Id:              c.Id,
+		Username:        c.UserName,
+		Model:           c.Model,
+		ModelId:         c.ModelId,
+		Vin:             c.Vin,
+		Plate:           c.Plate,
+		RegisteredAt:    t.Format(c.RegisteredAt),
+		CurrentMileage:  c.CurrentMileage,
+		Tags:            c.Tags,
+		Attributes:      c.Attributes,
+		BatteryCapacity: c.BatteryCapacity,
+		BatterySoh:      c.BatterySoh,
 	}
 }
 
 func (s *CarService) SaveCar(ctx context.Context, req *v1.SaveCarReq) (*emptypb.Empty, error) {
 	err := s.uc.SaveCar(ctx, &biz.Car{
-		UserID: &req.UserId,
-		Model:  &req.Model,
-	})
+		UserID:          &req.UserId,
+		ModelID:         &req.ModelId,
+		Vin:             req.Vin,
+		Plate:           req.Plate,
+		TenantID:        req.TenantId,
+		BatteryCapacity: req.BatteryCapacity,
+	}, req.Attributes)
 	return nil, err
 }
 
+func (s *CarService) SetCarAttributes(ctx context.Context, req *v1.SetCarAttributesReq) (*emptypb.Empty, error) {
+	err := s.uc.SetCarAttributes(ctx, req.CarId, req.Attributes)
+	return nil, err
+}
+
+func (s *CarService) GetCarQuota(ctx context.Context, _ *emptypb.Empty) (*v1.CarQuotaReply, error) {
+	q, err := s.uc.GetCarQuota(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CarQuotaReply{
+		TenantId: q.TenantId,
+		Used:     int64(q.Used),
+		Limit:    q.Limit,
+	}, nil
+}
+
 func (s *CarService) TradeCar(ctx context.Context, req *v1.TradeCarReq) (*emptypb.Empty, error) {
-	err := s.uc.UpdateCar(ctx, &biz.Car{
-		ID:     req.Id,
-		UserID: &req.UserId,
-	})
+	err := s.uc.TradeCar(ctx, req.Id, req.UserId, req.Price, req.Mileage)
 	return nil, err
 }
 
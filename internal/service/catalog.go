@@ -0,0 +1,137 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type CatalogService struct {
+	v1.UnimplementedCatalogServer
+
+	uc  *biz.CatalogUseCase
+	log *log.Helper
+}
+
+func NewCatalogService(uc *biz.CatalogUseCase, logger log.Logger) *CatalogService {
+	return &CatalogService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *CatalogService) ListBrand(ctx context.Context, _ *emptypb.Empty) (*v1.ListBrandReply, error) {
+	list, err := s.uc.ListBrand(ctx)
+
+	rsp := &v1.ListBrandReply{}
+	for _, b := range list {
+		rsp.List = append(rsp.List, &v1.BrandReply{Id: b.Id, Name: b.Name})
+	}
+	return rsp, err
+}
+
+func (s *CatalogService) SaveBrand(ctx context.Context, req *v1.SaveBrandReq) (*emptypb.Empty, error) {
+	err := s.uc.SaveBrand(ctx, &biz.Brand{Name: req.Name})
+	return nil, err
+}
+
+func (s *CatalogService) UpdateBrand(ctx context.Context, req *v1.UpdateBrandReq) (*emptypb.Empty, error) {
+	err := s.uc.UpdateBrand(ctx, &biz.Brand{ID: req.Id, Name: req.Name})
+	return nil, err
+}
+
+func (s *CatalogService) DeleteBrand(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.DeleteBrand(ctx, req.Value)
+	return nil, err
+}
+
+func (s *CatalogService) ListVehicleModel(ctx context.Context, req *v1.ListVehicleModelReq) (*v1.ListVehicleModelReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListVehicleModel(ctx, page, pageSize, req.BrandId)
+
+	rsp := &v1.ListVehicleModelReply{}
+	rsp.Total = int32(total)
+	for _, m := range list {
+		rsp.List = append(rsp.List, ConvertToVehicleModelReply(m))
+	}
+	return rsp, err
+}
+
+func (s *CatalogService) GetVehicleModel(ctx context.Context, req *wrapperspb.Int64Value) (*v1.VehicleModelReply, error) {
+	m, err := s.uc.GetVehicleModelById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToVehicleModelReply(m), nil
+}
+
+func ConvertToVehicleModelReply(m *biz.VehicleModelReply) *v1.VehicleModelReply {
+	return &v1.VehicleModelReply{
+		Id:        m.Id,
+		BrandId:   m.BrandId,
+		BrandName: m.BrandName,
+		Name:      m.Name,
+		FuelType:  m.FuelType,
+		Seats:     m.Seats,
+		BodyType:  m.BodyType,
+	}
+}
+
+func (s *CatalogService) SaveVehicleModel(ctx context.Context, req *v1.SaveVehicleModelReq) (*emptypb.Empty, error) {
+	err := s.uc.SaveVehicleModel(ctx, &biz.VehicleModel{
+		BrandID:  &req.BrandId,
+		Name:     &req.Name,
+		FuelType: &req.FuelType,
+		Seats:    &req.Seats,
+		BodyType: &req.BodyType,
+	})
+	return nil, err
+}
+
+func (s *CatalogService) UpdateVehicleModel(ctx context.Context, req *v1.UpdateVehicleModelReq) (*emptypb.Empty, error) {
+	err := s.uc.UpdateVehicleModel(ctx, &biz.VehicleModel{
+		ID:       req.Id,
+		Name:     req.Name,
+		FuelType: req.FuelType,
+		Seats:    req.Seats,
+		BodyType: req.BodyType,
+	})
+	return nil, err
+}
+
+func (s *CatalogService) DeleteVehicleModel(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.DeleteVehicleModel(ctx, req.Value)
+	return nil, err
+}
+
+func (s *CatalogService) SuggestModelMapping(ctx context.Context, _ *emptypb.Empty) (*v1.SuggestModelMappingReply, error) {
+	list, err := s.uc.SuggestModelMapping(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.SuggestModelMappingReply{}
+	for _, m := range list {
+		mapping := &v1.ModelMappingReply{
+			Model:    m.Model,
+			CarCount: int32(m.CarCount),
+		}
+		for _, match := range m.Suggestions {
+			mapping.Suggestions = append(mapping.Suggestions, &v1.ModelMatchReply{
+				Model: ConvertToVehicleModelReply(match.Model),
+				Score: match.Score,
+			})
+		}
+		rsp.List = append(rsp.List, mapping)
+	}
+	return rsp, nil
+}
+
+func (s *CatalogService) ApplyModelMapping(ctx context.Context, req *v1.ApplyModelMappingReq) (*v1.ApplyModelMappingReply, error) {
+	affected, err := s.uc.ApplyModelMapping(ctx, req.Model, req.ModelId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ApplyModelMappingReply{Affected: int32(affected)}, nil
+}
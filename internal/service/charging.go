@@ -0,0 +1,124 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type ChargingService struct {
+	v1.UnimplementedChargingServer
+
+	uc  *biz.ChargingUseCase
+	log *log.Helper
+}
+
+func NewChargingService(uc *biz.ChargingUseCase, logger log.Logger) *ChargingService {
+	return &ChargingService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *ChargingService) SetBatteryInfo(ctx context.Context, req *v1.SetBatteryInfoReq) (*emptypb.Empty, error) {
+	err := s.uc.SetBatteryInfo(ctx, req.CarId, req.BatteryCapacity, req.StateOfHealth)
+	return nil, err
+}
+
+func (s *ChargingService) RecordChargingSession(ctx context.Context, req *v1.RecordChargingSessionReq) (*wrapperspb.Int64Value, error) {
+	startedAt, err := parseTime(&req.StartedAt)
+	if err != nil {
+		return nil, err
+	}
+	endedAt, err := parseTime(&req.EndedAt)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.uc.RecordChargingSession(ctx, &biz.ChargingSession{
+		CarID:       &req.CarId,
+		StartedAt:   startedAt,
+		EndedAt:     endedAt,
+		Energy:      &req.Energy,
+		ChargerType: &req.ChargerType,
+		Cost:        req.Cost,
+		Station:     req.Station,
+		StartSoc:    req.StartSoc,
+		EndSoc:      req.EndSoc,
+		Soh:         req.Soh,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+func (s *ChargingService) ListChargingSession(ctx context.Context, req *v1.ListChargingSessionReq) (*v1.ListChargingSessionReply, error) {
+	from, err := parseTime(req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseTime(req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListChargingSession(ctx, page, pageSize, &biz.ChargingFilter{
+		CarId: req.CarId,
+		From:  from,
+		To:    to,
+	})
+
+	rsp := &v1.ListChargingSessionReply{}
+	rsp.Total = int32(total)
+	for _, c := range list {
+		rsp.List = append(rsp.List, &v1.ChargingSessionReply{
+			Id:          c.Id,
+			CarId:       c.CarId,
+			StartedAt:   t.Format(c.StartedAt),
+			EndedAt:     t.Format(c.EndedAt),
+			Energy:      c.Energy,
+			ChargerType: c.ChargerType,
+			Cost:        c.Cost,
+			Station:     c.Station,
+			StartSoc:    c.StartSoc,
+			EndSoc:      c.EndSoc,
+			Soh:         c.Soh,
+		})
+	}
+	return rsp, err
+}
+
+func (s *ChargingService) GetBatteryDegradation(ctx context.Context, req *v1.GetBatteryDegradationReq) (*v1.BatteryDegradationReply, error) {
+	from, err := parseTime(req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseTime(req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	d, err := s.uc.GetBatteryDegradation(ctx, req.CarId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.BatteryDegradationReply{
+		CarId:           d.CarId,
+		NominalCapacity: d.NominalCapacity,
+		CurrentSoh:      d.CurrentSoh,
+		SohPerYear:      d.SohPerYear,
+	}
+	for _, p := range d.Points {
+		rsp.Points = append(rsp.Points, &v1.BatteryHealthPoint{
+			Month:             p.Month,
+			Sessions:          int32(p.Sessions),
+			Energy:            p.Energy,
+			AvgSoh:            p.AvgSoh,
+			MinSoh:            p.MinSoh,
+			EstimatedCapacity: p.EstimatedCapacity,
+		})
+	}
+	return rsp, nil
+}
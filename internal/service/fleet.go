@@ -0,0 +1,104 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type FleetService struct {
+	v1.UnimplementedFleetServer
+
+	uc  *biz.FleetUseCase
+	log *log.Helper
+}
+
+func NewFleetService(uc *biz.FleetUseCase, logger log.Logger) *FleetService {
+	return &FleetService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *FleetService) ListFleet(ctx context.Context, req *v1.ListFleetReq) (*v1.ListFleetReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListFleet(ctx, page, pageSize)
+
+	rsp := &v1.ListFleetReply{}
+	rsp.Total = int32(total)
+	for _, f := range list {
+		rsp.List = append(rsp.List, ConvertToFleetReply(f))
+	}
+	return rsp, err
+}
+
+func (s *FleetService) GetFleet(ctx context.Context, req *wrapperspb.Int64Value) (*v1.FleetReply, error) {
+	f, err := s.uc.GetFleetById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToFleetReply(f), nil
+}
+
+func ConvertToFleetReply(f *biz.FleetReply) *v1.FleetReply {
+	return &v1.FleetReply{
+		Id:          f.Id,
+		Name:        f.Name,
+		Description: f.Description,
+		CreatedAt:   t.Format(f.CreatedAt),
+	}
+}
+
+func (s *FleetService) SaveFleet(ctx context.Context, req *v1.SaveFleetReq) (*emptypb.Empty, error) {
+	err := s.uc.SaveFleet(ctx, &biz.Fleet{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	return nil, err
+}
+
+func (s *FleetService) UpdateFleet(ctx context.Context, req *v1.UpdateFleetReq) (*emptypb.Empty, error) {
+	err := s.uc.UpdateFleet(ctx, &biz.Fleet{
+		ID:          req.Id,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	return nil, err
+}
+
+func (s *FleetService) DeleteFleet(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.DeleteFleet(ctx, req.Value)
+	return nil, err
+}
+
+func (s *FleetService) AddFleetCars(ctx context.Context, req *v1.FleetCarsReq) (*emptypb.Empty, error) {
+	err := s.uc.AddFleetCars(ctx, req.FleetId, req.CarIds)
+	return nil, err
+}
+
+func (s *FleetService) RemoveFleetCars(ctx context.Context, req *v1.FleetCarsReq) (*emptypb.Empty, error) {
+	err := s.uc.RemoveFleetCars(ctx, req.FleetId, req.CarIds)
+	return nil, err
+}
+
+func (s *FleetService) ListTag(ctx context.Context, _ *emptypb.Empty) (*v1.ListTagReply, error) {
+	list, err := s.uc.ListTag(ctx)
+
+	rsp := &v1.ListTagReply{}
+	for _, tag := range list {
+		rsp.List = append(rsp.List, &v1.TagReply{Id: tag.Id, Name: tag.Name})
+	}
+	return rsp, err
+}
+
+func (s *FleetService) TagCar(ctx context.Context, req *v1.TagCarReq) (*emptypb.Empty, error) {
+	err := s.uc.TagCar(ctx, req.CarId, req.Names)
+	return nil, err
+}
+
+func (s *FleetService) UntagCar(ctx context.Context, req *v1.TagCarReq) (*emptypb.Empty, error) {
+	err := s.uc.UntagCar(ctx, req.CarId, req.Names)
+	return nil, err
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type GeofenceService struct {
+	v1.UnimplementedGeofenceServer
+
+	uc  *biz.GeofenceUseCase
+	log *log.Helper
+}
+
+func NewGeofenceService(uc *biz.GeofenceUseCase, logger log.Logger) *GeofenceService {
+	return &GeofenceService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *GeofenceService) ListGeofence(ctx context.Context, req *v1.ListGeofenceReq) (*v1.ListGeofenceReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListGeofence(ctx, page, pageSize)
+
+	rsp := &v1.ListGeofenceReply{}
+	rsp.Total = int32(total)
+	for _, g := range list {
+		rsp.List = append(rsp.List, ConvertToGeofenceReply(g))
+	}
+	return rsp, err
+}
+
+func (s *GeofenceService) GetGeofence(ctx context.Context, req *wrapperspb.Int64Value) (*v1.GeofenceReply, error) {
+	g, err := s.uc.GetGeofenceById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToGeofenceReply(g), nil
+}
+
+func (s *GeofenceService) SaveGeofence(ctx context.Context, req *v1.SaveGeofenceReq) (*wrapperspb.Int64Value, error) {
+	id, err := s.uc.SaveGeofence(ctx, &biz.GeofenceInput{
+		Name:     req.Name,
+		Polygon:  toPolygon(req.Polygon),
+		Schedule: toGeofenceSchedule(req.Schedule),
+		AlertOn:  req.AlertOn,
+		Enabled:  req.Enabled,
+		CarIds:   req.CarIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+func (s *GeofenceService) UpdateGeofence(ctx context.Context, req *v1.UpdateGeofenceReq) (*emptypb.Empty, error) {
+	err := s.uc.UpdateGeofence(ctx, &biz.GeofenceInput{
+		Id:       req.Id,
+		Name:     req.Name,
+		Polygon:  toPolygon(req.Polygon),
+		Schedule: toGeofenceSchedule(req.Schedule),
+		AlertOn:  req.AlertOn,
+		Enabled:  req.Enabled,
+		CarIds:   req.CarIds,
+	})
+	return nil, err
+}
+
+func (s *GeofenceService) DeleteGeofence(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.DeleteGeofence(ctx, req.Value)
+	return nil, err
+}
+
+func (s *GeofenceService) ReportPosition(ctx context.Context, req *v1.ReportPositionReq) (*v1.ReportPositionReply, error) {
+	recordedAt, err := parseTime(req.RecordedAt)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.uc.ReportPosition(ctx, req.CarId, biz.GeoPoint{Lat: req.Lat, Lng: req.Lng}, recordedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.ReportPositionReply{}
+	for _, e := range events {
+		rsp.Events = append(rsp.Events, &v1.GeofenceEventReply{
+			FenceId:    e.FenceId,
+			FenceName:  e.FenceName,
+			CarId:      e.CarId,
+			Type:       e.Type,
+			Lat:        e.Lat,
+			Lng:        e.Lng,
+			RecordedAt: t.Format(e.RecordedAt),
+		})
+	}
+	return rsp, nil
+}
+
+func ConvertToGeofenceReply(g *biz.GeofenceReply) *v1.GeofenceReply {
+	rsp := &v1.GeofenceReply{
+		Id:   g.Id,
+		Name: g.Name,
+		Schedule: &v1.GeofenceSchedule{
+			StartTime: g.Schedule.Start,
+			EndTime:   g.Schedule.End,
+			TimeZone:  g.Schedule.TimeZone,
+		},
+		AlertOn:   g.AlertOn,
+		Enabled:   g.Enabled,
+		CarIds:    g.CarIds,
+		CreatedAt: t.Format(g.CreatedAt),
+		UpdatedAt: t.Format(g.UpdatedAt),
+	}
+	for _, p := range g.Polygon {
+		rsp.Polygon = append(rsp.Polygon, &v1.GeoPoint{Lat: p.Lat, Lng: p.Lng})
+	}
+	for _, d := range g.Schedule.Days {
+		rsp.Schedule.Days = append(rsp.Schedule.Days, int32(d))
+	}
+	return rsp
+}
+
+func toPolygon(points []*v1.GeoPoint) biz.Polygon {
+	pg := make(biz.Polygon, 0, len(points))
+	for _, p := range points {
+		pg = append(pg, biz.GeoPoint{Lat: p.Lat, Lng: p.Lng})
+	}
+	return pg
+}
+
+// toGeofenceSchedule 未指定生效时间表示全天生效
+func toGeofenceSchedule(s *v1.GeofenceSchedule) biz.GeofenceSchedule {
+	if s == nil {
+		return biz.GeofenceSchedule{}
+	}
+	days := make([]int, 0, len(s.Days))
+	for _, d := range s.Days {
+		days = append(days, int(d))
+	}
+	return biz.GeofenceSchedule{Days: days, Start: s.StartTime, End: s.EndTime, TimeZone: s.TimeZone}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type IncidentService struct {
+	v1.UnimplementedIncidentServer
+
+	uc  *biz.IncidentUseCase
+	log *log.Helper
+}
+
+func NewIncidentService(uc *biz.IncidentUseCase, logger log.Logger) *IncidentService {
+	return &IncidentService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *IncidentService) ReportIncident(ctx context.Context, req *v1.ReportIncidentReq) (*wrapperspb.Int64Value, error) {
+	occurredAt, err := parseTime(&req.OccurredAt)
+	if err != nil {
+		return nil, err
+	}
+	parties := make([]*biz.ThirdParty, 0, len(req.ThirdParties))
+	for _, p := range req.ThirdParties {
+		party := &biz.ThirdParty{Name: p.Name}
+		if p.Phone != nil {
+			party.Phone = *p.Phone
+		}
+		if p.Plate != nil {
+			party.Plate = *p.Plate
+		}
+		if p.Insurer != nil {
+			party.Insurer = *p.Insurer
+		}
+		parties = append(parties, party)
+	}
+	id, err := s.uc.ReportIncident(ctx, &biz.Incident{
+		CarID:       &req.CarId,
+		OccurredAt:  occurredAt,
+		Location:    req.Location,
+		Severity:    &req.Severity,
+		Description: req.Description,
+		PolicyID:    req.PolicyId,
+	}, req.PhotoIds, parties)
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+// ListIncident 汽车的事故历史
+func (s *IncidentService) ListIncident(ctx context.Context, req *v1.ListIncidentReq) (*v1.ListIncidentReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListIncident(ctx, page, pageSize, &biz.IncidentFilter{
+		CarId:  req.CarId,
+		Status: req.Status,
+	})
+
+	rsp := &v1.ListIncidentReply{}
+	rsp.Total = int32(total)
+	for _, i := range list {
+		rsp.List = append(rsp.List, ConvertToIncidentReply(i))
+	}
+	return rsp, err
+}
+
+func (s *IncidentService) GetIncident(ctx context.Context, req *wrapperspb.Int64Value) (*v1.IncidentReply, error) {
+	i, err := s.uc.GetIncidentById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToIncidentReply(i), nil
+}
+
+func (s *IncidentService) LinkInsurancePolicy(ctx context.Context, req *v1.LinkInsurancePolicyReq) (*emptypb.Empty, error) {
+	err := s.uc.LinkInsurancePolicy(ctx, req.Id, req.PolicyId)
+	return nil, err
+}
+
+func (s *IncidentService) UpdateIncidentStatus(ctx context.Context, req *v1.UpdateIncidentStatusReq) (*emptypb.Empty, error) {
+	err := s.uc.UpdateIncidentStatus(ctx, req.Id, &biz.IncidentTransition{
+		Status:        req.Status,
+		ClaimNo:       req.ClaimNo,
+		ClaimAmount:   req.ClaimAmount,
+		SettledAmount: req.SettledAmount,
+	})
+	return nil, err
+}
+
+func ConvertToIncidentReply(i *biz.IncidentReply) *v1.IncidentReply {
+	rsp := &v1.IncidentReply{
+		Id:            i.Id,
+		CarId:         i.CarId,
+		ReporterId:    i.ReporterId,
+		OccurredAt:    t.Format(i.OccurredAt),
+		Location:      i.Location,
+		Severity:      i.Severity,
+		Description:   i.Description,
+		PhotoIds:      i.PhotoIds,
+		Status:        i.Status,
+		PolicyId:      i.PolicyId,
+		ClaimNo:       i.ClaimNo,
+		ClaimAmount:   i.ClaimAmount,
+		SettledAmount: i.SettledAmount,
+		SettledAt:     formatTime(i.SettledAt),
+		CreatedAt:     t.Format(i.CreatedAt),
+		UpdatedAt:     t.Format(i.UpdatedAt),
+	}
+	for _, p := range i.ThirdParties {
+		p := p
+		rsp.ThirdParties = append(rsp.ThirdParties, &v1.IncidentThirdParty{
+			Name:    p.Name,
+			Phone:   &p.Phone,
+			Plate:   &p.Plate,
+			Insurer: &p.Insurer,
+		})
+	}
+	return rsp
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type InsuranceService struct {
+	v1.UnimplementedInsuranceServer
+
+	uc  *biz.InsuranceUseCase
+	log *log.Helper
+}
+
+func NewInsuranceService(uc *biz.InsuranceUseCase, logger log.Logger) *InsuranceService {
+	return &InsuranceService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *InsuranceService) ListInsurancePolicy(ctx context.Context, req *v1.ListInsurancePolicyReq) (*v1.ListInsurancePolicyReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListInsurancePolicy(ctx, page, pageSize, req.CarId)
+
+	rsp := &v1.ListInsurancePolicyReply{}
+	rsp.Total = int32(total)
+	for _, p := range list {
+		rsp.List = append(rsp.List, ConvertToInsurancePolicyReply(p))
+	}
+	return rsp, err
+}
+
+func (s *InsuranceService) GetInsurancePolicy(ctx context.Context, req *wrapperspb.Int64Value) (*v1.InsurancePolicyReply, error) {
+	p, err := s.uc.GetInsurancePolicyById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToInsurancePolicyReply(p), nil
+}
+
+func ConvertToInsurancePolicyReply(p *biz.InsurancePolicyReply) *v1.InsurancePolicyReply {
+	return &v1.InsurancePolicyReply{
+		Id:           p.Id,
+		CarId:        p.CarId,
+		Provider:     p.Provider,
+		PolicyNumber: p.PolicyNumber,
+		Coverage:     p.Coverage,
+		StartDate:    t.Format(p.StartDate),
+		EndDate:      t.Format(p.EndDate),
+		Premium:      p.Premium,
+	}
+}
+
+func (s *InsuranceService) SaveInsurancePolicy(ctx context.Context, req *v1.SaveInsurancePolicyReq) (*emptypb.Empty, error) {
+	startDate, err := parseTime(&req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseTime(&req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	err = s.uc.SaveInsurancePolicy(ctx, &biz.InsurancePolicy{
+		CarID:        &req.CarId,
+		Provider:     &req.Provider,
+		PolicyNumber: &req.PolicyNumber,
+		Coverage:     &req.Coverage,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		Premium:      &req.Premium,
+	})
+	return nil, err
+}
+
+func (s *InsuranceService) UpdateInsurancePolicy(ctx context.Context, req *v1.UpdateInsurancePolicyReq) (*emptypb.Empty, error) {
+	startDate, err := parseTime(req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseTime(req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	err = s.uc.UpdateInsurancePolicy(ctx, &biz.InsurancePolicy{
+		ID:           req.Id,
+		Provider:     req.Provider,
+		PolicyNumber: req.PolicyNumber,
+		Coverage:     req.Coverage,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		Premium:      req.Premium,
+	})
+	return nil, err
+}
+
+func (s *InsuranceService) DeleteInsurancePolicy(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.DeleteInsurancePolicy(ctx, req.Value)
+	return nil, err
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+)
+
+type JobService struct {
+	v1.UnimplementedJobServer
+
+	uc  *biz.JobUseCase
+	log *log.Helper
+}
+
+func NewJobService(uc *biz.JobUseCase, logger log.Logger) *JobService {
+	return &JobService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *JobService) ListJobRun(ctx context.Context, req *v1.ListJobRunReq) (*v1.ListJobRunReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListJobRun(ctx, page, pageSize, &biz.JobRunFilter{
+		Name:   req.Name,
+		Status: req.Status,
+	})
+
+	rsp := &v1.ListJobRunReply{}
+	rsp.Total = int32(total)
+	for _, j := range list {
+		rsp.List = append(rsp.List, &v1.JobRunReply{
+			Id:          j.Id,
+			Name:        j.Name,
+			ScheduledAt: t.Format(j.ScheduledAt),
+			StartedAt:   t.Format(j.StartedAt),
+			FinishedAt:  formatTime(j.FinishedAt),
+			Status:      j.Status,
+			Error:       j.Error,
+			Instance:    j.Instance,
+		})
+	}
+	return rsp, err
+}
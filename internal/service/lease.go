@@ -0,0 +1,123 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type LeaseService struct {
+	v1.UnimplementedLeaseServer
+
+	uc  *biz.LeaseUseCase
+	log *log.Helper
+}
+
+func NewLeaseService(uc *biz.LeaseUseCase, logger log.Logger) *LeaseService {
+	return &LeaseService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *LeaseService) CreateLease(ctx context.Context, req *v1.CreateLeaseReq) (*wrapperspb.Int64Value, error) {
+	startDate, err := parseTime(&req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseTime(&req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.uc.CreateLease(ctx, &biz.LeaseContract{
+		CarID:            &req.CarId,
+		Lessor:           &req.Lessor,
+		ContractNo:       req.ContractNo,
+		LesseeID:         req.LesseeId,
+		StartDate:        startDate,
+		EndDate:          endDate,
+		MonthlyPayment:   &req.MonthlyPayment,
+		MileageAllowance: req.MileageAllowance,
+		ExcessRate:       req.ExcessRate,
+		StartMileage:     req.StartMileage,
+		TransferAllowed:  req.TransferAllowed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+func (s *LeaseService) ListLease(ctx context.Context, req *v1.ListLeaseReq) (*v1.ListLeaseReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListLease(ctx, page, pageSize, &biz.LeaseFilter{
+		CarId:  req.CarId,
+		Status: req.Status,
+	})
+
+	rsp := &v1.ListLeaseReply{}
+	rsp.Total = int32(total)
+	for _, l := range list {
+		rsp.List = append(rsp.List, ConvertToLeaseReply(l))
+	}
+	return rsp, err
+}
+
+func (s *LeaseService) GetLease(ctx context.Context, req *wrapperspb.Int64Value) (*v1.LeaseReply, error) {
+	l, err := s.uc.GetLeaseById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToLeaseReply(l), nil
+}
+
+func (s *LeaseService) TerminateLease(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.TerminateLease(ctx, req.Value)
+	return nil, err
+}
+
+func (s *LeaseService) GetExcessMileage(ctx context.Context, req *v1.GetExcessMileageReq) (*v1.ExcessMileageReply, error) {
+	asOf, err := parseTime(req.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.uc.GetExcessMileage(ctx, req.Id, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ExcessMileageReply{
+		LeaseId:      e.LeaseId,
+		CarId:        e.CarId,
+		PeriodStart:  t.Format(e.PeriodStart),
+		PeriodEnd:    t.Format(e.PeriodEnd),
+		StartMileage: e.StartMileage,
+		EndMileage:   e.EndMileage,
+		Driven:       e.Driven,
+		Allowance:    e.Allowance,
+		Excess:       e.Excess,
+		ExcessRate:   e.ExcessRate,
+		Charge:       e.Charge,
+	}, nil
+}
+
+func ConvertToLeaseReply(l *biz.LeaseContractReply) *v1.LeaseReply {
+	return &v1.LeaseReply{
+		Id:               l.Id,
+		CarId:            l.CarId,
+		Lessor:           l.Lessor,
+		ContractNo:       l.ContractNo,
+		LesseeId:         l.LesseeId,
+		StartDate:        t.Format(l.StartDate),
+		EndDate:          t.Format(l.EndDate),
+		MonthlyPayment:   l.MonthlyPayment,
+		MileageAllowance: l.MileageAllowance,
+		ExcessRate:       l.ExcessRate,
+		StartMileage:     l.StartMileage,
+		TransferAllowed:  l.TransferAllowed,
+		Status:           l.Status,
+		TerminatedAt:     formatTime(l.TerminatedAt),
+		CreatedAt:        t.Format(l.CreatedAt),
+	}
+}
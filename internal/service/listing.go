@@ -0,0 +1,94 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type ListingService struct {
+	v1.UnimplementedListingServer
+
+	uc  *biz.ListingUseCase
+	log *log.Helper
+}
+
+func NewListingService(uc *biz.ListingUseCase, logger log.Logger) *ListingService {
+	return &ListingService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *ListingService) CreateListing(ctx context.Context, req *v1.CreateListingReq) (*wrapperspb.Int64Value, error) {
+	expiresAt, err := parseTime(req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.uc.CreateListing(ctx, &biz.Listing{
+		CarID:       &req.CarId,
+		Price:       &req.Price,
+		Description: req.Description,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+func (s *ListingService) SearchListing(ctx context.Context, req *v1.SearchListingReq) (*v1.ListListingReply, error) {
+	filter := &biz.ListingFilter{
+		Keyword:  req.Keyword,
+		ModelId:  req.ModelId,
+		SellerId: req.SellerId,
+		MinPrice: req.MinPrice,
+		MaxPrice: req.MaxPrice,
+		Status:   req.Status,
+	}
+	if req.Sort != nil {
+		filter.Sort = *req.Sort
+	}
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.SearchListing(ctx, page, pageSize, filter)
+
+	rsp := &v1.ListListingReply{}
+	rsp.Total = int32(total)
+	for _, l := range list {
+		rsp.List = append(rsp.List, ConvertToListingReply(l))
+	}
+	return rsp, err
+}
+
+func (s *ListingService) GetListing(ctx context.Context, req *wrapperspb.Int64Value) (*v1.ListingReply, error) {
+	l, err := s.uc.GetListingById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToListingReply(l), nil
+}
+
+func (s *ListingService) CloseListing(ctx context.Context, req *v1.CloseListingReq) (*emptypb.Empty, error) {
+	err := s.uc.CloseListing(ctx, req.Id, req.BuyerId)
+	return nil, err
+}
+
+func ConvertToListingReply(l *biz.ListingReply) *v1.ListingReply {
+	return &v1.ListingReply{
+		Id:           l.Id,
+		CarId:        l.CarId,
+		SellerId:     l.SellerId,
+		Price:        l.Price,
+		Description:  l.Description,
+		Status:       l.Status,
+		ExpiresAt:    t.Format(l.ExpiresAt),
+		BuyerId:      l.BuyerId,
+		ClosedAt:     formatTime(l.ClosedAt),
+		CreatedAt:    t.Format(l.CreatedAt),
+		Model:        l.Model,
+		ModelId:      l.ModelId,
+		RegisteredAt: t.Format(l.RegisteredAt),
+	}
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type MaintenanceService struct {
+	v1.UnimplementedMaintenanceServer
+
+	uc  *biz.MaintenanceUseCase
+	log *log.Helper
+}
+
+func NewMaintenanceService(uc *biz.MaintenanceUseCase, logger log.Logger) *MaintenanceService {
+	return &MaintenanceService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *MaintenanceService) ListMaintenanceRecord(ctx context.Context, req *v1.ListMaintenanceRecordReq) (*v1.ListMaintenanceRecordReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListMaintenanceRecord(ctx, page, pageSize, req.CarId)
+
+	rsp := &v1.ListMaintenanceRecordReply{}
+	rsp.Total = int32(total)
+	for _, m := range list {
+		rsp.List = append(rsp.List, ConvertToMaintenanceRecordReply(m))
+	}
+	return rsp, err
+}
+
+func (s *MaintenanceService) GetMaintenanceRecord(ctx context.Context, req *wrapperspb.Int64Value) (*v1.MaintenanceRecordReply, error) {
+	m, err := s.uc.GetMaintenanceRecordById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToMaintenanceRecordReply(m), nil
+}
+
+func ConvertToMaintenanceRecordReply(m *biz.MaintenanceRecordReply) *v1.MaintenanceRecordReply {
+	return &v1.MaintenanceRecordReply{
+		Id:         m.Id,
+		CarId:      m.CarId,
+		ServicedAt: t.Format(m.ServicedAt),
+		Mileage:    m.Mileage,
+		Type:       m.Type,
+		Cost:       m.Cost,
+		Shop:       m.Shop,
+		Notes:      m.Notes,
+	}
+}
+
+func (s *MaintenanceService) SaveMaintenanceRecord(ctx context.Context, req *v1.SaveMaintenanceRecordReq) (*emptypb.Empty, error) {
+	servicedAt, err := parseTime(req.ServicedAt)
+	if err != nil {
+		return nil, err
+	}
+	err = s.uc.SaveMaintenanceRecord(ctx, &biz.MaintenanceRecord{
+		CarID:      &req.CarId,
+		ServicedAt: servicedAt,
+		Mileage:    &req.Mileage,
+		Type:       &req.Type,
+		Cost:       &req.Cost,
+		Shop:       &req.Shop,
+		Notes:      &req.Notes,
+	})
+	return nil, err
+}
+
+func (s *MaintenanceService) UpdateMaintenanceRecord(ctx context.Context, req *v1.UpdateMaintenanceRecordReq) (*emptypb.Empty, error) {
+	servicedAt, err := parseTime(req.ServicedAt)
+	if err != nil {
+		return nil, err
+	}
+	err = s.uc.UpdateMaintenanceRecord(ctx, &biz.MaintenanceRecord{
+		ID:         req.Id,
+		ServicedAt: servicedAt,
+		Mileage:    req.Mileage,
+		Type:       req.Type,
+		Cost:       req.Cost,
+		Shop:       req.Shop,
+		Notes:      req.Notes,
+	})
+	return nil, err
+}
+
+func (s *MaintenanceService) DeleteMaintenanceRecord(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.DeleteMaintenanceRecord(ctx, req.Value)
+	return nil, err
+}
+
+func (s *MaintenanceService) GetNextServiceDue(ctx context.Context, req *wrapperspb.Int64Value) (*v1.NextServiceDueReply, error) {
+	due, err := s.uc.GetNextServiceDue(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.NextServiceDueReply{
+		DueAt:      t.Format(due.DueAt),
+		DueMileage: due.DueMileage,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+)
+
+type OdometerService struct {
+	v1.UnimplementedOdometerServer
+
+	uc  *biz.OdometerUseCase
+	log *log.Helper
+}
+
+func NewOdometerService(uc *biz.OdometerUseCase, logger log.Logger) *OdometerService {
+	return &OdometerService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *OdometerService) RecordOdometerReading(ctx context.Context, req *v1.RecordOdometerReadingReq) (*v1.OdometerReadingReply, error) {
+	recordedAt, err := parseTime(req.RecordedAt)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.uc.RecordReading(ctx, &biz.OdometerReading{
+		CarID:      &req.CarId,
+		Mileage:    &req.Mileage,
+		Source:     &req.Source,
+		RecordedAt: recordedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToOdometerReadingReply(o), nil
+}
+
+func (s *OdometerService) ListOdometerReading(ctx context.Context, req *v1.ListOdometerReadingReq) (*v1.ListOdometerReadingReply, error) {
+	startTime, err := parseTime(req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := parseTime(req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListOdometerReading(ctx, page, pageSize, &biz.OdometerFilter{
+		CarId:     req.CarId,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+
+	rsp := &v1.ListOdometerReadingReply{}
+	rsp.Total = int32(total)
+	for _, o := range list {
+		rsp.List = append(rsp.List, ConvertToOdometerReadingReply(o))
+	}
+	return rsp, err
+}
+
+func ConvertToOdometerReadingReply(o *biz.OdometerReadingReply) *v1.OdometerReadingReply {
+	return &v1.OdometerReadingReply{
+		Id:         o.Id,
+		CarId:      o.CarId,
+		Mileage:    o.Mileage,
+		Source:     o.Source,
+		Suspicious: o.Suspicious,
+		RecordedAt: t.Format(o.RecordedAt),
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type RecallService struct {
+	v1.UnimplementedRecallServer
+
+	uc  *biz.RecallUseCase
+	log *log.Helper
+}
+
+func NewRecallService(uc *biz.RecallUseCase, logger log.Logger) *RecallService {
+	return &RecallService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *RecallService) ImportRecalls(ctx context.Context, req *v1.ImportRecallsReq) (*v1.ImportRecallsReply, error) {
+	recalls := make([]*biz.Recall, 0, len(req.List))
+	for _, item := range req.List {
+		issuedAt, err := parseTime(item.IssuedAt)
+		if err != nil {
+			return nil, err
+		}
+		recalls = append(recalls, &biz.Recall{
+			CampaignNo:   item.CampaignNo,
+			Manufacturer: item.Manufacturer,
+			ModelID:      item.ModelId,
+			Model:        item.Model,
+			YearFrom:     toIntPtr(item.YearFrom),
+			YearTo:       toIntPtr(item.YearTo),
+			VinFrom:      item.VinFrom,
+			VinTo:        item.VinTo,
+			Component:    item.Component,
+			Description:  item.Description,
+			Remedy:       item.Remedy,
+			IssuedAt:     issuedAt,
+		})
+	}
+	results, err := s.uc.ImportRecalls(ctx, recalls)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.ImportRecallsReply{}
+	for _, r := range results {
+		rsp.List = append(rsp.List, &v1.RecallImportResult{
+			RecallId:   r.RecallId,
+			CampaignNo: r.CampaignNo,
+			Matched:    int32(r.Matched),
+		})
+	}
+	return rsp, nil
+}
+
+func (s *RecallService) ListRecall(ctx context.Context, req *v1.ListRecallReq) (*v1.ListRecallReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListRecall(ctx, page, pageSize, req.ModelId)
+
+	rsp := &v1.ListRecallReply{}
+	rsp.Total = int32(total)
+	for _, r := range list {
+		rsp.List = append(rsp.List, ConvertToRecallReply(r))
+	}
+	return rsp, err
+}
+
+func (s *RecallService) GetRecall(ctx context.Context, req *wrapperspb.Int64Value) (*v1.RecallReply, error) {
+	r, err := s.uc.GetRecallById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToRecallReply(r), nil
+}
+
+func (s *RecallService) MatchRecall(ctx context.Context, req *wrapperspb.Int64Value) (*wrapperspb.Int32Value, error) {
+	n, err := s.uc.MatchRecall(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int32(int32(n)), nil
+}
+
+func (s *RecallService) ListCarRecall(ctx context.Context, req *v1.ListCarRecallReq) (*v1.ListCarRecallReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListCarRecall(ctx, page, pageSize, &biz.CarRecallFilter{
+		CarId:    req.CarId,
+		RecallId: req.RecallId,
+		Status:   req.Status,
+		OpenOnly: req.OpenOnly,
+	})
+
+	rsp := &v1.ListCarRecallReply{}
+	rsp.Total = int32(total)
+	for _, cr := range list {
+		rsp.List = append(rsp.List, ConvertToCarRecallReply(cr))
+	}
+	return rsp, err
+}
+
+func (s *RecallService) UpdateCarRecallStatus(ctx context.Context, req *v1.UpdateCarRecallStatusReq) (*emptypb.Empty, error) {
+	err := s.uc.UpdateCarRecallStatus(ctx, req.Id, req.Status, req.Notes)
+	return nil, err
+}
+
+func ConvertToRecallReply(r *biz.RecallReply) *v1.RecallReply {
+	return &v1.RecallReply{
+		Id:           r.Id,
+		CampaignNo:   r.CampaignNo,
+		Manufacturer: r.Manufacturer,
+		ModelId:      r.ModelId,
+		Model:        r.Model,
+		YearFrom:     int32(r.YearFrom),
+		YearTo:       int32(r.YearTo),
+		VinFrom:      r.VinFrom,
+		VinTo:        r.VinTo,
+		Component:    r.Component,
+		Description:  r.Description,
+		Remedy:       r.Remedy,
+		IssuedAt:     t.Format(r.IssuedAt),
+		CreatedAt:    t.Format(r.CreatedAt),
+	}
+}
+
+func ConvertToCarRecallReply(cr *biz.CarRecallReply) *v1.CarRecallReply {
+	rsp := &v1.CarRecallReply{
+		Id:         cr.Id,
+		CarId:      cr.CarId,
+		RecallId:   cr.RecallId,
+		Status:     cr.Status,
+		Notes:      cr.Notes,
+		RemediedAt: formatTime(cr.RemediedAt),
+		CreatedAt:  t.Format(cr.CreatedAt),
+		UpdatedAt:  t.Format(cr.UpdatedAt),
+	}
+	if cr.Recall != nil {
+		rsp.Recall = ConvertToRecallReply(cr.Recall)
+	}
+	return rsp
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	ex "car-service/internal/pkg/errors"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type ReservationService struct {
+	v1.UnimplementedReservationServer
+
+	uc  *biz.ReservationUseCase
+	log *log.Helper
+}
+
+func NewReservationService(uc *biz.ReservationUseCase, logger log.Logger) *ReservationService {
+	return &ReservationService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *ReservationService) ListReservation(ctx context.Context, req *v1.ListReservationReq) (*v1.ListReservationReply, error) {
+	from, err := parseTime(req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseTime(req.To)
+	if err != nil {
+		return nil, err
+	}
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListReservation(ctx, page, pageSize, &biz.ReservationFilter{
+		CarId:  req.CarId,
+		UserId: req.UserId,
+		Status: req.Status,
+		From:   from,
+		To:     to,
+	})
+
+	rsp := &v1.ListReservationReply{}
+	rsp.Total = int32(total)
+	for _, r := range list {
+		rsp.List = append(rsp.List, ConvertToReservationReply(r))
+	}
+	return rsp, err
+}
+
+func (s *ReservationService) GetReservation(ctx context.Context, req *wrapperspb.Int64Value) (*v1.ReservationReply, error) {
+	r, err := s.uc.GetReservationById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToReservationReply(r), nil
+}
+
+func ConvertToReservationReply(r *biz.ReservationReply) *v1.ReservationReply {
+	return &v1.ReservationReply{
+		Id:          r.Id,
+		CarId:       r.CarId,
+		UserId:      r.UserId,
+		StartAt:     t.Format(r.StartAt),
+		EndAt:       t.Format(r.EndAt),
+		Purpose:     r.Purpose,
+		Status:      r.Status,
+		CancelledAt: formatTime(r.CancelledAt),
+		CreatedAt:   t.Format(r.CreatedAt),
+	}
+}
+
+func (s *ReservationService) BookCar(ctx context.Context, req *v1.BookCarReq) (*wrapperspb.Int64Value, error) {
+	startAt, err := parseTime(&req.StartAt)
+	if err != nil {
+		return nil, err
+	}
+	endAt, err := parseTime(&req.EndAt)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.uc.BookCar(ctx, &biz.Reservation{
+		CarID:   &req.CarId,
+		UserID:  req.UserId,
+		StartAt: startAt,
+		EndAt:   endAt,
+		Purpose: req.Purpose,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+func (s *ReservationService) CancelReservation(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.CancelReservation(ctx, req.Value)
+	return nil, err
+}
+
+func (s *ReservationService) ListAvailableCar(ctx context.Context, req *v1.ListAvailableCarReq) (*v1.ListCarReply, error) {
+	startAt, err := parseTime(&req.StartAt)
+	if err != nil {
+		return nil, err
+	}
+	endAt, err := parseTime(&req.EndAt)
+	if err != nil {
+		return nil, err
+	}
+	if startAt == nil || endAt == nil {
+		return nil, ex.InvalidReservationPeriod
+	}
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListAvailableCar(ctx, page, pageSize, *startAt, *endAt, req.FleetId)
+
+	rsp := &v1.ListCarReply{}
+	rsp.Total = int32(total)
+	for _, c := range list {
+		rsp.List = append(rsp.List, ConvertToCarReply(c))
+	}
+	return rsp, err
+}
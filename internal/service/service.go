@@ -1,6 +1,45 @@
 package service
 
-import "github.com/google/wire"
+import (
+	ex "car-service/internal/pkg/errors"
+	"github.com/google/wire"
+	t "github.com/lovechung/go-kit/util/time"
+	"time"
+)
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewCarService)
+var ProviderSet = wire.NewSet(NewCarService, NewAuditService, NewMaintenanceService, NewInsuranceService,
+	NewCatalogService, NewTransferService, NewOdometerService, NewAttachmentService, NewFleetService,
+	NewAttributeService, NewReservationService, NewTripService, NewRecallService,
+	NewViolationService, NewValuationService, NewListingService,
+	NewWarrantyService, NewIncidentService, NewLeaseService, NewChargingService,
+	NewTelemetryService, NewGeofenceService, NewJobService)
+
+// parseTime 解析请求中的可选时间参数
+func parseTime(s *string) (*time.Time, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	v, err := time.ParseInLocation("2006-01-02 15:04:05", *s, time.Local)
+	if err != nil {
+		return nil, ex.InvalidTime
+	}
+	return &v, nil
+}
+
+// formatTime 格式化可选时间，为空时返回空字符串
+func formatTime(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+	return t.Format(*v)
+}
+
+// toIntPtr 转换请求中的可选整数参数
+func toIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
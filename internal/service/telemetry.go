@@ -0,0 +1,79 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
+)
+
+type TelemetryService struct {
+	v1.UnimplementedTelemetryServer
+
+	uc  *biz.TelemetryUseCase
+	log *log.Helper
+}
+
+func NewTelemetryService(uc *biz.TelemetryUseCase, logger log.Logger) *TelemetryService {
+	return &TelemetryService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// IngestTelemetry 车载终端持续上报数据点，结束时返回接受及拒绝的点数；
+// 写入繁忙时暂停读取上报流，持续繁忙则中断上报
+func (s *TelemetryService) IngestTelemetry(stream v1.Telemetry_IngestTelemetryServer) error {
+	ctx := stream.Context()
+	in := s.uc.NewIngestion()
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for _, p := range req.Points {
+			recordedAt, err := parseTime(&p.RecordedAt)
+			if err != nil || recordedAt == nil {
+				in.Reject()
+				continue
+			}
+			if err := in.Add(ctx, &biz.TelemetryPoint{
+				CarId:      p.CarId,
+				RecordedAt: *recordedAt,
+				Lat:        p.Lat,
+				Lng:        p.Lng,
+				Speed:      p.Speed,
+				FuelLevel:  p.FuelLevel,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	if err := in.Flush(ctx); err != nil {
+		return err
+	}
+
+	rsp := in.Result()
+	return stream.SendAndClose(&v1.IngestTelemetryReply{
+		Accepted: int32(rsp.Accepted),
+		Rejected: int32(rsp.Rejected),
+	})
+}
+
+func (s *TelemetryService) GetLastKnownLocation(ctx context.Context, req *wrapperspb.Int64Value) (*v1.LocationReply, error) {
+	p, err := s.uc.GetLastKnownLocation(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LocationReply{
+		CarId:      p.CarId,
+		RecordedAt: t.Format(p.RecordedAt),
+		Lat:        p.Lat,
+		Lng:        p.Lng,
+		Speed:      p.Speed,
+		FuelLevel:  p.FuelLevel,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type TransferService struct {
+	v1.UnimplementedTransferServer
+
+	uc  *biz.TransferUseCase
+	log *log.Helper
+}
+
+func NewTransferService(uc *biz.TransferUseCase, logger log.Logger) *TransferService {
+	return &TransferService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *TransferService) ListTransfer(ctx context.Context, req *v1.ListTransferReq) (*v1.ListTransferReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListTransfer(ctx, page, pageSize, &biz.TransferFilter{
+		CarId:  req.CarId,
+		UserId: req.UserId,
+		Status: req.Status,
+	})
+
+	rsp := &v1.ListTransferReply{}
+	rsp.Total = int32(total)
+	for _, tr := range list {
+		rsp.List = append(rsp.List, ConvertToTransferReply(tr))
+	}
+	return rsp, err
+}
+
+func (s *TransferService) GetTransfer(ctx context.Context, req *wrapperspb.Int64Value) (*v1.TransferReply, error) {
+	tr, err := s.uc.GetTransferById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToTransferReply(tr), nil
+}
+
+func ConvertToTransferReply(tr *biz.TransferReply) *v1.TransferReply {
+	return &v1.TransferReply{
+		Id:              tr.Id,
+		CarId:           tr.CarId,
+		SellerId:        tr.SellerId,
+		BuyerId:         tr.BuyerId,
+		Status:          tr.Status,
+		RequireApproval: tr.RequireApproval,
+		ApprovedBy:      tr.ApprovedBy,
+		ExpiresAt:       t.Format(tr.ExpiresAt),
+		AcceptedAt:      formatTime(tr.AcceptedAt),
+		CompletedAt:     formatTime(tr.CompletedAt),
+		CreatedAt:       t.Format(tr.CreatedAt),
+	}
+}
+
+func (s *TransferService) InitiateTransfer(ctx context.Context, req *v1.InitiateTransferReq) (*wrapperspb.Int64Value, error) {
+	id, err := s.uc.InitiateTransfer(ctx, req.CarId, req.BuyerId, req.RequireApproval)
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+func (s *TransferService) AcceptTransfer(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.AcceptTransfer(ctx, req.Value)
+	return nil, err
+}
+
+func (s *TransferService) ApproveTransfer(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.ApproveTransfer(ctx, req.Value)
+	return nil, err
+}
+
+func (s *TransferService) RejectTransfer(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.RejectTransfer(ctx, req.Value)
+	return nil, err
+}
+
+func (s *TransferService) CancelTransfer(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.CancelTransfer(ctx, req.Value)
+	return nil, err
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type TripService struct {
+	v1.UnimplementedTripServer
+
+	uc  *biz.TripUseCase
+	log *log.Helper
+}
+
+func NewTripService(uc *biz.TripUseCase, logger log.Logger) *TripService {
+	return &TripService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *TripService) RecordTrip(ctx context.Context, req *v1.RecordTripReq) (*wrapperspb.Int64Value, error) {
+	startedAt, err := parseTime(req.StartedAt)
+	if err != nil {
+		return nil, err
+	}
+	endedAt, err := parseTime(req.EndedAt)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.uc.RecordTrip(ctx, &biz.Trip{
+		CarID:         &req.CarId,
+		DriverID:      req.DriverId,
+		Purpose:       req.Purpose,
+		StartOdometer: &req.StartOdometer,
+		EndOdometer:   &req.EndOdometer,
+		StartedAt:     startedAt,
+		EndedAt:       endedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+func (s *TripService) ListTrip(ctx context.Context, req *v1.ListTripReq) (*v1.ListTripReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListTrip(ctx, page, pageSize, req.CarId)
+
+	rsp := &v1.ListTripReply{}
+	rsp.Total = int32(total)
+	for _, tr := range list {
+		rsp.List = append(rsp.List, ConvertToTripReply(tr))
+	}
+	return rsp, err
+}
+
+func (s *TripService) RecordRefuel(ctx context.Context, req *v1.RecordRefuelReq) (*wrapperspb.Int64Value, error) {
+	refueledAt, err := parseTime(req.RefueledAt)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.uc.RecordRefuel(ctx, &biz.Refuel{
+		CarID:      &req.CarId,
+		Amount:     &req.Amount,
+		Unit:       req.Unit,
+		Cost:       req.Cost,
+		Station:    req.Station,
+		Odometer:   req.Odometer,
+		RefueledAt: refueledAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+func (s *TripService) ListRefuel(ctx context.Context, req *v1.ListRefuelReq) (*v1.ListRefuelReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListRefuel(ctx, page, pageSize, req.CarId)
+
+	rsp := &v1.ListRefuelReply{}
+	rsp.Total = int32(total)
+	for _, f := range list {
+		rsp.List = append(rsp.List, ConvertToRefuelReply(f))
+	}
+	return rsp, err
+}
+
+func (s *TripService) GetFuelReport(ctx context.Context, req *v1.FuelReportReq) (*v1.FuelReportReply, error) {
+	from, err := parseTime(req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseTime(req.To)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.uc.FuelReport(ctx, &biz.FuelReportFilter{
+		CarId:   req.CarId,
+		From:    from,
+		To:      to,
+		Monthly: req.Monthly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.FuelReportReply{}
+	for _, r := range list {
+		rsp.List = append(rsp.List, &v1.FuelReportItem{
+			CarId:           r.CarId,
+			Period:          r.Period,
+			Distance:        r.Distance,
+			Amount:          r.Amount,
+			Energy:          r.Energy,
+			Cost:            r.Cost,
+			AmountPer_100Km: r.AmountPer100Km,
+			EnergyPer_100Km: r.EnergyPer100Km,
+			CostPerKm:       r.CostPerKm,
+		})
+	}
+	return rsp, nil
+}
+
+func ConvertToTripReply(tr *biz.TripReply) *v1.TripReply {
+	return &v1.TripReply{
+		Id:            tr.Id,
+		CarId:         tr.CarId,
+		DriverId:      tr.DriverId,
+		Purpose:       tr.Purpose,
+		StartOdometer: tr.StartOdometer,
+		EndOdometer:   tr.EndOdometer,
+		Distance:      tr.Distance(),
+		StartedAt:     t.Format(tr.StartedAt),
+		EndedAt:       t.Format(tr.EndedAt),
+	}
+}
+
+func ConvertToRefuelReply(f *biz.RefuelReply) *v1.RefuelReply {
+	return &v1.RefuelReply{
+		Id:         f.Id,
+		CarId:      f.CarId,
+		Amount:     f.Amount,
+		Unit:       f.Unit,
+		Cost:       f.Cost,
+		Station:    f.Station,
+		Odometer:   f.Odometer,
+		RefueledAt: t.Format(f.RefueledAt),
+	}
+}
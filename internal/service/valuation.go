@@ -0,0 +1,44 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+)
+
+type ValuationService struct {
+	v1.UnimplementedValuationServer
+
+	uc  *biz.ValuationUseCase
+	log *log.Helper
+}
+
+func NewValuationService(uc *biz.ValuationUseCase, logger log.Logger) *ValuationService {
+	return &ValuationService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *ValuationService) GetValuation(ctx context.Context, req *v1.GetValuationReq) (*v1.ValuationReply, error) {
+	v, err := s.uc.GetValuation(ctx, req.CarId, req.Mileage)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.ValuationReply{
+		CarId:       v.CarId,
+		ModelId:     v.ModelId,
+		Strategy:    v.Strategy,
+		BasePrice:   v.BasePrice,
+		Value:       v.Value,
+		Mileage:     v.Mileage,
+		AgeMonths:   int32(v.AgeMonths),
+		Comparables: int32(v.Comparables),
+	}
+	for _, p := range v.Curve {
+		rsp.Curve = append(rsp.Curve, &v1.ValuationPoint{
+			AgeYears: int32(p.AgeYears),
+			Value:    p.Value,
+		})
+	}
+	return rsp, nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/pagination"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type ViolationService struct {
+	v1.UnimplementedViolationServer
+
+	uc  *biz.ViolationUseCase
+	log *log.Helper
+}
+
+func NewViolationService(uc *biz.ViolationUseCase, logger log.Logger) *ViolationService {
+	return &ViolationService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *ViolationService) ImportViolations(ctx context.Context, req *v1.ImportViolationsReq) (*v1.ImportViolationsReply, error) {
+	violations := make([]*biz.Violation, 0, len(req.List))
+	for _, item := range req.List {
+		item := item
+		occurredAt, err := parseTime(&item.OccurredAt)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, &biz.Violation{
+			CarID:       &item.CarId,
+			ReferenceNo: item.ReferenceNo,
+			OccurredAt:  occurredAt,
+			Location:    item.Location,
+			Amount:      &item.Amount,
+			Description: item.Description,
+		})
+	}
+	rsp, err := s.uc.ImportViolations(ctx, violations)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ImportViolationsReply{
+		Imported: int32(rsp.Imported),
+		Skipped:  int32(rsp.Skipped),
+	}, nil
+}
+
+func (s *ViolationService) ListViolation(ctx context.Context, req *v1.ListViolationReq) (*v1.ListViolationReply, error) {
+	page, pageSize := pagination.GetPage(req.Page, req.PageSize)
+	list, total, err := s.uc.ListViolation(ctx, page, pageSize, &biz.ViolationFilter{
+		CarId:  req.CarId,
+		UserId: req.UserId,
+		Status: req.Status,
+	})
+
+	rsp := &v1.ListViolationReply{}
+	rsp.Total = int32(total)
+	for _, v := range list {
+		rsp.List = append(rsp.List, ConvertToViolationReply(v))
+	}
+	return rsp, err
+}
+
+func (s *ViolationService) GetViolation(ctx context.Context, req *wrapperspb.Int64Value) (*v1.ViolationReply, error) {
+	v, err := s.uc.GetViolationById(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToViolationReply(v), nil
+}
+
+func (s *ViolationService) ResolveViolation(ctx context.Context, req *v1.ResolveViolationReq) (*emptypb.Empty, error) {
+	err := s.uc.ResolveViolation(ctx, req.Id, req.Status)
+	return nil, err
+}
+
+func (s *ViolationService) GetOutstandingBalance(ctx context.Context, req *wrapperspb.Int64Value) (*v1.OutstandingBalanceReply, error) {
+	b, err := s.uc.GetOutstandingBalance(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.OutstandingBalanceReply{
+		UserId: b.UserId,
+		Count:  int32(b.Count),
+		Amount: b.Amount,
+	}, nil
+}
+
+func (s *ViolationService) ListOwnerHistory(ctx context.Context, req *wrapperspb.Int64Value) (*v1.ListOwnerHistoryReply, error) {
+	list, err := s.uc.ListOwnerHistory(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.ListOwnerHistoryReply{}
+	for _, h := range list {
+		rsp.List = append(rsp.List, &v1.OwnerHistoryReply{
+			Id:        h.Id,
+			CarId:     h.CarId,
+			UserId:    h.UserId,
+			StartedAt: t.Format(h.StartedAt),
+			EndedAt:   formatTime(h.EndedAt),
+		})
+	}
+	return rsp, nil
+}
+
+func ConvertToViolationReply(v *biz.ViolationReply) *v1.ViolationReply {
+	return &v1.ViolationReply{
+		Id:          v.Id,
+		CarId:       v.CarId,
+		UserId:      v.UserId,
+		ReferenceNo: v.ReferenceNo,
+		OccurredAt:  t.Format(v.OccurredAt),
+		Location:    v.Location,
+		Amount:      v.Amount,
+		Description: v.Description,
+		Status:      v.Status,
+		ResolvedAt:  formatTime(v.ResolvedAt),
+		ResolvedBy:  v.ResolvedBy,
+		CreatedAt:   t.Format(v.CreatedAt),
+	}
+}
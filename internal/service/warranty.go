@@ -0,0 +1,121 @@
+package service
+
+import (
+	"car-service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/lovechung/api-base/api/car"
+	"github.com/lovechung/go-kit/util/time"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type WarrantyService struct {
+	v1.UnimplementedWarrantyServer
+
+	uc  *biz.WarrantyUseCase
+	log *log.Helper
+}
+
+func NewWarrantyService(uc *biz.WarrantyUseCase, logger log.Logger) *WarrantyService {
+	return &WarrantyService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *WarrantyService) ListWarrantyDefinition(ctx context.Context, req *wrapperspb.Int64Value) (*v1.ListWarrantyDefinitionReply, error) {
+	list, err := s.uc.ListDefinition(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.ListWarrantyDefinitionReply{}
+	for _, d := range list {
+		rsp.List = append(rsp.List, &v1.WarrantyDefinitionReply{
+			Id:             d.Id,
+			ModelId:        d.ModelId,
+			Name:           d.Name,
+			Components:     d.Components,
+			DurationMonths: int32(d.DurationMonths),
+			MileageLimit:   d.MileageLimit,
+			CreatedAt:      t.Format(d.CreatedAt),
+		})
+	}
+	return rsp, nil
+}
+
+func (s *WarrantyService) SaveWarrantyDefinition(ctx context.Context, req *v1.SaveWarrantyDefinitionReq) (*wrapperspb.Int64Value, error) {
+	id, err := s.uc.SaveDefinition(ctx, &biz.WarrantyDefinition{
+		ModelID:        &req.ModelId,
+		Name:           req.Name,
+		DurationMonths: toIntPtr(&req.DurationMonths),
+		MileageLimit:   req.MileageLimit,
+	}, req.Components)
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Int64(id), nil
+}
+
+func (s *WarrantyService) UpdateWarrantyDefinition(ctx context.Context, req *v1.UpdateWarrantyDefinitionReq) (*emptypb.Empty, error) {
+	err := s.uc.UpdateDefinition(ctx, &biz.WarrantyDefinition{
+		ID:             req.Id,
+		Name:           req.Name,
+		DurationMonths: toIntPtr(&req.DurationMonths),
+		MileageLimit:   req.MileageLimit,
+	}, req.Components)
+	return nil, err
+}
+
+func (s *WarrantyService) DeleteWarrantyDefinition(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	err := s.uc.DeleteDefinition(ctx, req.Value)
+	return nil, err
+}
+
+func (s *WarrantyService) ListCarWarranty(ctx context.Context, req *wrapperspb.Int64Value) (*v1.ListCarWarrantyReply, error) {
+	list, err := s.uc.ListCarWarranty(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.ListCarWarrantyReply{}
+	for _, w := range list {
+		rsp.List = append(rsp.List, ConvertToCarWarrantyReply(w))
+	}
+	return rsp, nil
+}
+
+func (s *WarrantyService) CheckWarranty(ctx context.Context, req *v1.CheckWarrantyReq) (*v1.CheckWarrantyReply, error) {
+	date, err := parseTime(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.uc.CheckWarranty(ctx, req.CarId, req.Component, date, req.Mileage)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.CheckWarrantyReply{
+		CarId:     c.CarId,
+		Component: c.Component,
+		Date:      t.Format(c.Date),
+		Mileage:   c.Mileage,
+		Covered:   c.Covered,
+		Result:    c.Result,
+	}
+	if c.Warranty != nil {
+		rsp.Warranty = ConvertToCarWarrantyReply(c.Warranty)
+	}
+	return rsp, nil
+}
+
+func ConvertToCarWarrantyReply(w *biz.CarWarrantyReply) *v1.CarWarrantyReply {
+	return &v1.CarWarrantyReply{
+		Id:           w.Id,
+		CarId:        w.CarId,
+		DefinitionId: w.DefinitionId,
+		Name:         w.Name,
+		Components:   w.Components,
+		StartAt:      t.Format(w.StartAt),
+		EndAt:        t.Format(w.EndAt),
+		MileageLimit: w.MileageLimit,
+	}
+}
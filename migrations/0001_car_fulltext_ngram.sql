@@ -0,0 +1,5 @@
+-- 汽车全文检索索引改用ngram分词器，使中文、VIN及车牌的部分匹配和拼写错误的关键词也能检索到候选
+-- 需要MySQL 5.7.6及以上版本（InnoDB），MariaDB不支持ngram分词器
+-- 分词长度由服务端参数ngram_token_size控制，默认为2，与检索关键词的最小长度一致，不要调大
+ALTER TABLE `car` DROP INDEX `car_model_vin_plate_owner_name`;
+ALTER TABLE `car` ADD FULLTEXT INDEX `car_model_vin_plate_owner_name` (`model`, `vin`, `plate`, `owner_name`) WITH PARSER ngram;
@@ -0,0 +1,9 @@
+-- 审计日志、保养记录、保单、过户、里程读数及附件按租户隔离，ent建出tenant_id列后按所属汽车回填存量数据
+-- 已删除汽车的审计日志无法确定租户，tenant_id保持为空，仅管理员可见
+
+UPDATE `audit_log` t JOIN `car` c ON c.`id` = t.`car_id` SET t.`tenant_id` = c.`tenant_id` WHERE t.`tenant_id` IS NULL;
+UPDATE `maintenance_record` t JOIN `car` c ON c.`id` = t.`car_id` SET t.`tenant_id` = c.`tenant_id` WHERE t.`tenant_id` IS NULL;
+UPDATE `insurance_policy` t JOIN `car` c ON c.`id` = t.`car_id` SET t.`tenant_id` = c.`tenant_id` WHERE t.`tenant_id` IS NULL;
+UPDATE `transfer` t JOIN `car` c ON c.`id` = t.`car_id` SET t.`tenant_id` = c.`tenant_id` WHERE t.`tenant_id` IS NULL;
+UPDATE `odometer_reading` t JOIN `car` c ON c.`id` = t.`car_id` SET t.`tenant_id` = c.`tenant_id` WHERE t.`tenant_id` IS NULL;
+UPDATE `attachment` t JOIN `car` c ON c.`id` = t.`car_id` SET t.`tenant_id` = c.`tenant_id` WHERE t.`tenant_id` IS NULL;
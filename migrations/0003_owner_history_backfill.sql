@@ -0,0 +1,19 @@
+-- 车主记录启用前登记的汽车补一条从登记时间开始的当前车主持有区间，违章按发生时的车主归属
+INSERT INTO `owner_history` (`tenant_id`, `car_id`, `user_id`, `started_at`)
+SELECT c.`tenant_id`, c.`id`, c.`user_id`, c.`registered_at`
+FROM `car` c
+WHERE c.`user_id` IS NOT NULL
+  AND c.`user_id` <> 0
+  AND NOT EXISTS (SELECT 1 FROM `owner_history` h WHERE h.`car_id` = c.`id`);
+
+-- 执行本脚本前已交易过的汽车只有买方的区间，按最早一次成交记录补上卖方从登记时间到首个区间开始的区间
+INSERT INTO `owner_history` (`tenant_id`, `car_id`, `user_id`, `started_at`, `ended_at`)
+SELECT c.`tenant_id`, c.`id`, t.`seller_id`, c.`registered_at`, h.`first_started_at`
+FROM `car` c
+JOIN (SELECT `car_id`, MIN(`started_at`) AS `first_started_at` FROM `owner_history` GROUP BY `car_id`) h
+  ON h.`car_id` = c.`id`
+JOIN `trade_record` t ON t.`car_id` = c.`id`
+WHERE h.`first_started_at` > c.`registered_at`
+  AND t.`seller_id` IS NOT NULL
+  AND t.`seller_id` <> 0
+  AND t.`traded_at` = (SELECT MIN(`traded_at`) FROM `trade_record` WHERE `car_id` = c.`id`);
@@ -0,0 +1,5 @@
+-- 租户锁记录，新建汽车时在事务中锁定所属租户的记录，配额检查与新建串行执行，避免并发新建超出配额
+CREATE TABLE IF NOT EXISTS `tenant_lock` (
+  `tenant_id` BIGINT NOT NULL,
+  PRIMARY KEY (`tenant_id`)
+) ENGINE = InnoDB;